@@ -67,6 +67,7 @@ export FRACTURING_SPACE_PLAY_LAUNCH_GRANT_AUDIENCE="${FRACTURING_SPACE_PLAY_LAUN
 export FRACTURING_SPACE_PLAY_LAUNCH_GRANT_HMAC_KEY="${FRACTURING_SPACE_PLAY_LAUNCH_GRANT_HMAC_KEY:-MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=}"
 export FRACTURING_SPACE_PLAY_LAUNCH_GRANT_TTL="${FRACTURING_SPACE_PLAY_LAUNCH_GRANT_TTL:-2m}"
 export FRACTURING_SPACE_GAME_EVENT_HMAC_KEY="${FRACTURING_SPACE_GAME_EVENT_HMAC_KEY:-dev-secret}"
+export FRACTURING_SPACE_GAME_ENCRYPTION_KEY="${FRACTURING_SPACE_GAME_ENCRYPTION_KEY:-MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=}"
 export FRACTURING_SPACE_GAME_PORT="${FRACTURING_SPACE_GAME_PORT:-8082}"
 export FRACTURING_SPACE_GAME_ADDR="${FRACTURING_SPACE_GAME_ADDR:-localhost:8082}"
 export FRACTURING_SPACE_GAME_CONTENT_DB_PATH="${FRACTURING_SPACE_GAME_CONTENT_DB_PATH:-data/game-content.db}"
@@ -580,6 +580,159 @@ func (CampaignDebugEntryKind) EnumDescriptor() ([]byte, []int) {
 	return file_ai_v1_service_proto_rawDescGZIP(), []int{10}
 }
 
+type CampaignArtifactAuthorKind int32
+
+const (
+	CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_UNSPECIFIED CampaignArtifactAuthorKind = 0
+	CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_SYSTEM      CampaignArtifactAuthorKind = 1
+	CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_AI_TURN     CampaignArtifactAuthorKind = 2
+	CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_USER        CampaignArtifactAuthorKind = 3
+)
+
+// Enum value maps for CampaignArtifactAuthorKind.
+var (
+	CampaignArtifactAuthorKind_name = map[int32]string{
+		0: "CAMPAIGN_ARTIFACT_AUTHOR_KIND_UNSPECIFIED",
+		1: "CAMPAIGN_ARTIFACT_AUTHOR_KIND_SYSTEM",
+		2: "CAMPAIGN_ARTIFACT_AUTHOR_KIND_AI_TURN",
+		3: "CAMPAIGN_ARTIFACT_AUTHOR_KIND_USER",
+	}
+	CampaignArtifactAuthorKind_value = map[string]int32{
+		"CAMPAIGN_ARTIFACT_AUTHOR_KIND_UNSPECIFIED": 0,
+		"CAMPAIGN_ARTIFACT_AUTHOR_KIND_SYSTEM":      1,
+		"CAMPAIGN_ARTIFACT_AUTHOR_KIND_AI_TURN":     2,
+		"CAMPAIGN_ARTIFACT_AUTHOR_KIND_USER":        3,
+	}
+)
+
+func (x CampaignArtifactAuthorKind) Enum() *CampaignArtifactAuthorKind {
+	p := new(CampaignArtifactAuthorKind)
+	*p = x
+	return p
+}
+
+func (x CampaignArtifactAuthorKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignArtifactAuthorKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ai_v1_service_proto_enumTypes[11].Descriptor()
+}
+
+func (CampaignArtifactAuthorKind) Type() protoreflect.EnumType {
+	return &file_ai_v1_service_proto_enumTypes[11]
+}
+
+func (x CampaignArtifactAuthorKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignArtifactAuthorKind.Descriptor instead.
+func (CampaignArtifactAuthorKind) EnumDescriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+type CampaignArtifactDiffOp int32
+
+const (
+	CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_UNSPECIFIED CampaignArtifactDiffOp = 0
+	CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_EQUAL       CampaignArtifactDiffOp = 1
+	CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_INSERT      CampaignArtifactDiffOp = 2
+	CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_DELETE      CampaignArtifactDiffOp = 3
+)
+
+// Enum value maps for CampaignArtifactDiffOp.
+var (
+	CampaignArtifactDiffOp_name = map[int32]string{
+		0: "CAMPAIGN_ARTIFACT_DIFF_OP_UNSPECIFIED",
+		1: "CAMPAIGN_ARTIFACT_DIFF_OP_EQUAL",
+		2: "CAMPAIGN_ARTIFACT_DIFF_OP_INSERT",
+		3: "CAMPAIGN_ARTIFACT_DIFF_OP_DELETE",
+	}
+	CampaignArtifactDiffOp_value = map[string]int32{
+		"CAMPAIGN_ARTIFACT_DIFF_OP_UNSPECIFIED": 0,
+		"CAMPAIGN_ARTIFACT_DIFF_OP_EQUAL":       1,
+		"CAMPAIGN_ARTIFACT_DIFF_OP_INSERT":      2,
+		"CAMPAIGN_ARTIFACT_DIFF_OP_DELETE":      3,
+	}
+)
+
+func (x CampaignArtifactDiffOp) Enum() *CampaignArtifactDiffOp {
+	p := new(CampaignArtifactDiffOp)
+	*p = x
+	return p
+}
+
+func (x CampaignArtifactDiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignArtifactDiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_ai_v1_service_proto_enumTypes[12].Descriptor()
+}
+
+func (CampaignArtifactDiffOp) Type() protoreflect.EnumType {
+	return &file_ai_v1_service_proto_enumTypes[12]
+}
+
+func (x CampaignArtifactDiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignArtifactDiffOp.Descriptor instead.
+func (CampaignArtifactDiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+type SessionRecapDraftStatus int32
+
+const (
+	SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_UNSPECIFIED SessionRecapDraftStatus = 0
+	SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_PENDING     SessionRecapDraftStatus = 1
+	SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_PUBLISHED   SessionRecapDraftStatus = 2
+)
+
+// Enum value maps for SessionRecapDraftStatus.
+var (
+	SessionRecapDraftStatus_name = map[int32]string{
+		0: "SESSION_RECAP_DRAFT_STATUS_UNSPECIFIED",
+		1: "SESSION_RECAP_DRAFT_STATUS_PENDING",
+		2: "SESSION_RECAP_DRAFT_STATUS_PUBLISHED",
+	}
+	SessionRecapDraftStatus_value = map[string]int32{
+		"SESSION_RECAP_DRAFT_STATUS_UNSPECIFIED": 0,
+		"SESSION_RECAP_DRAFT_STATUS_PENDING":     1,
+		"SESSION_RECAP_DRAFT_STATUS_PUBLISHED":   2,
+	}
+)
+
+func (x SessionRecapDraftStatus) Enum() *SessionRecapDraftStatus {
+	p := new(SessionRecapDraftStatus)
+	*p = x
+	return p
+}
+
+func (x SessionRecapDraftStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionRecapDraftStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ai_v1_service_proto_enumTypes[13].Descriptor()
+}
+
+func (SessionRecapDraftStatus) Type() protoreflect.EnumType {
+	return &file_ai_v1_service_proto_enumTypes[13]
+}
+
+func (x SessionRecapDraftStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionRecapDraftStatus.Descriptor instead.
+func (SessionRecapDraftStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
 type Credential struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3060,6 +3213,134 @@ func (x *RunCampaignTurnResponse) GetRetrievedContexts() []*RetrievedContext {
 	return nil
 }
 
+type RunCampaignPlayerTurnRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionGrant    string                 `protobuf:"bytes,1,opt,name=session_grant,json=sessionGrant,proto3" json:"session_grant,omitempty"`
+	ReasoningEffort string                 `protobuf:"bytes,2,opt,name=reasoning_effort,json=reasoningEffort,proto3" json:"reasoning_effort,omitempty"`
+	TurnToken       string                 `protobuf:"bytes,3,opt,name=turn_token,json=turnToken,proto3" json:"turn_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RunCampaignPlayerTurnRequest) Reset() {
+	*x = RunCampaignPlayerTurnRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunCampaignPlayerTurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCampaignPlayerTurnRequest) ProtoMessage() {}
+
+func (x *RunCampaignPlayerTurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCampaignPlayerTurnRequest.ProtoReflect.Descriptor instead.
+func (*RunCampaignPlayerTurnRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RunCampaignPlayerTurnRequest) GetSessionGrant() string {
+	if x != nil {
+		return x.SessionGrant
+	}
+	return ""
+}
+
+func (x *RunCampaignPlayerTurnRequest) GetReasoningEffort() string {
+	if x != nil {
+		return x.ReasoningEffort
+	}
+	return ""
+}
+
+func (x *RunCampaignPlayerTurnRequest) GetTurnToken() string {
+	if x != nil {
+		return x.TurnToken
+	}
+	return ""
+}
+
+type RunCampaignPlayerTurnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OutputText    string                 `protobuf:"bytes,1,opt,name=output_text,json=outputText,proto3" json:"output_text,omitempty"`
+	Provider      Provider               `protobuf:"varint,2,opt,name=provider,proto3,enum=ai.v1.Provider" json:"provider,omitempty"`
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Usage         *Usage                 `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunCampaignPlayerTurnResponse) Reset() {
+	*x = RunCampaignPlayerTurnResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunCampaignPlayerTurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCampaignPlayerTurnResponse) ProtoMessage() {}
+
+func (x *RunCampaignPlayerTurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCampaignPlayerTurnResponse.ProtoReflect.Descriptor instead.
+func (*RunCampaignPlayerTurnResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RunCampaignPlayerTurnResponse) GetOutputText() string {
+	if x != nil {
+		return x.OutputText
+	}
+	return ""
+}
+
+func (x *RunCampaignPlayerTurnResponse) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNSPECIFIED
+}
+
+func (x *RunCampaignPlayerTurnResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RunCampaignPlayerTurnResponse) GetUsage() *Usage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
 type CampaignDebugEntry struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Sequence         int32                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
@@ -3078,7 +3359,7 @@ type CampaignDebugEntry struct {
 
 func (x *CampaignDebugEntry) Reset() {
 	*x = CampaignDebugEntry{}
-	mi := &file_ai_v1_service_proto_msgTypes[38]
+	mi := &file_ai_v1_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3090,7 +3371,7 @@ func (x *CampaignDebugEntry) String() string {
 func (*CampaignDebugEntry) ProtoMessage() {}
 
 func (x *CampaignDebugEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[38]
+	mi := &file_ai_v1_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,7 +3384,7 @@ func (x *CampaignDebugEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CampaignDebugEntry.ProtoReflect.Descriptor instead.
 func (*CampaignDebugEntry) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{38}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CampaignDebugEntry) GetSequence() int32 {
@@ -3198,7 +3479,7 @@ type CampaignDebugTurn struct {
 
 func (x *CampaignDebugTurn) Reset() {
 	*x = CampaignDebugTurn{}
-	mi := &file_ai_v1_service_proto_msgTypes[39]
+	mi := &file_ai_v1_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3210,7 +3491,7 @@ func (x *CampaignDebugTurn) String() string {
 func (*CampaignDebugTurn) ProtoMessage() {}
 
 func (x *CampaignDebugTurn) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[39]
+	mi := &file_ai_v1_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3223,7 +3504,7 @@ func (x *CampaignDebugTurn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CampaignDebugTurn.ProtoReflect.Descriptor instead.
 func (*CampaignDebugTurn) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{39}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CampaignDebugTurn) GetId() string {
@@ -3346,7 +3627,7 @@ type CampaignDebugTurnSummary struct {
 
 func (x *CampaignDebugTurnSummary) Reset() {
 	*x = CampaignDebugTurnSummary{}
-	mi := &file_ai_v1_service_proto_msgTypes[40]
+	mi := &file_ai_v1_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3358,7 +3639,7 @@ func (x *CampaignDebugTurnSummary) String() string {
 func (*CampaignDebugTurnSummary) ProtoMessage() {}
 
 func (x *CampaignDebugTurnSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[40]
+	mi := &file_ai_v1_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3371,7 +3652,7 @@ func (x *CampaignDebugTurnSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CampaignDebugTurnSummary.ProtoReflect.Descriptor instead.
 func (*CampaignDebugTurnSummary) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{40}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CampaignDebugTurnSummary) GetId() string {
@@ -3484,7 +3765,7 @@ type ListCampaignDebugTurnsRequest struct {
 
 func (x *ListCampaignDebugTurnsRequest) Reset() {
 	*x = ListCampaignDebugTurnsRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[41]
+	mi := &file_ai_v1_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3496,7 +3777,7 @@ func (x *ListCampaignDebugTurnsRequest) String() string {
 func (*ListCampaignDebugTurnsRequest) ProtoMessage() {}
 
 func (x *ListCampaignDebugTurnsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[41]
+	mi := &file_ai_v1_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3509,7 +3790,7 @@ func (x *ListCampaignDebugTurnsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCampaignDebugTurnsRequest.ProtoReflect.Descriptor instead.
 func (*ListCampaignDebugTurnsRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{41}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListCampaignDebugTurnsRequest) GetCampaignId() string {
@@ -3550,7 +3831,7 @@ type ListCampaignDebugTurnsResponse struct {
 
 func (x *ListCampaignDebugTurnsResponse) Reset() {
 	*x = ListCampaignDebugTurnsResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[42]
+	mi := &file_ai_v1_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3562,7 +3843,7 @@ func (x *ListCampaignDebugTurnsResponse) String() string {
 func (*ListCampaignDebugTurnsResponse) ProtoMessage() {}
 
 func (x *ListCampaignDebugTurnsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[42]
+	mi := &file_ai_v1_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3575,7 +3856,7 @@ func (x *ListCampaignDebugTurnsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCampaignDebugTurnsResponse.ProtoReflect.Descriptor instead.
 func (*ListCampaignDebugTurnsResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{42}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListCampaignDebugTurnsResponse) GetTurns() []*CampaignDebugTurnSummary {
@@ -3602,7 +3883,7 @@ type GetCampaignDebugTurnRequest struct {
 
 func (x *GetCampaignDebugTurnRequest) Reset() {
 	*x = GetCampaignDebugTurnRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[43]
+	mi := &file_ai_v1_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3614,7 +3895,7 @@ func (x *GetCampaignDebugTurnRequest) String() string {
 func (*GetCampaignDebugTurnRequest) ProtoMessage() {}
 
 func (x *GetCampaignDebugTurnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[43]
+	mi := &file_ai_v1_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3627,7 +3908,7 @@ func (x *GetCampaignDebugTurnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignDebugTurnRequest.ProtoReflect.Descriptor instead.
 func (*GetCampaignDebugTurnRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{43}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetCampaignDebugTurnRequest) GetCampaignId() string {
@@ -3653,7 +3934,7 @@ type GetCampaignDebugTurnResponse struct {
 
 func (x *GetCampaignDebugTurnResponse) Reset() {
 	*x = GetCampaignDebugTurnResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[44]
+	mi := &file_ai_v1_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3665,7 +3946,7 @@ func (x *GetCampaignDebugTurnResponse) String() string {
 func (*GetCampaignDebugTurnResponse) ProtoMessage() {}
 
 func (x *GetCampaignDebugTurnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[44]
+	mi := &file_ai_v1_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3678,7 +3959,7 @@ func (x *GetCampaignDebugTurnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignDebugTurnResponse.ProtoReflect.Descriptor instead.
 func (*GetCampaignDebugTurnResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{44}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetCampaignDebugTurnResponse) GetTurn() *CampaignDebugTurn {
@@ -3698,7 +3979,7 @@ type SubscribeCampaignDebugUpdatesRequest struct {
 
 func (x *SubscribeCampaignDebugUpdatesRequest) Reset() {
 	*x = SubscribeCampaignDebugUpdatesRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[45]
+	mi := &file_ai_v1_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3710,7 +3991,7 @@ func (x *SubscribeCampaignDebugUpdatesRequest) String() string {
 func (*SubscribeCampaignDebugUpdatesRequest) ProtoMessage() {}
 
 func (x *SubscribeCampaignDebugUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[45]
+	mi := &file_ai_v1_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3723,7 +4004,7 @@ func (x *SubscribeCampaignDebugUpdatesRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use SubscribeCampaignDebugUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeCampaignDebugUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{45}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SubscribeCampaignDebugUpdatesRequest) GetCampaignId() string {
@@ -3750,7 +4031,7 @@ type CampaignDebugTurnUpdate struct {
 
 func (x *CampaignDebugTurnUpdate) Reset() {
 	*x = CampaignDebugTurnUpdate{}
-	mi := &file_ai_v1_service_proto_msgTypes[46]
+	mi := &file_ai_v1_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3762,7 +4043,7 @@ func (x *CampaignDebugTurnUpdate) String() string {
 func (*CampaignDebugTurnUpdate) ProtoMessage() {}
 
 func (x *CampaignDebugTurnUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[46]
+	mi := &file_ai_v1_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3775,7 +4056,7 @@ func (x *CampaignDebugTurnUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CampaignDebugTurnUpdate.ProtoReflect.Descriptor instead.
 func (*CampaignDebugTurnUpdate) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{46}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CampaignDebugTurnUpdate) GetTurn() *CampaignDebugTurnSummary {
@@ -3806,7 +4087,7 @@ type CampaignArtifact struct {
 
 func (x *CampaignArtifact) Reset() {
 	*x = CampaignArtifact{}
-	mi := &file_ai_v1_service_proto_msgTypes[47]
+	mi := &file_ai_v1_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3818,7 +4099,7 @@ func (x *CampaignArtifact) String() string {
 func (*CampaignArtifact) ProtoMessage() {}
 
 func (x *CampaignArtifact) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[47]
+	mi := &file_ai_v1_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +4112,7 @@ func (x *CampaignArtifact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CampaignArtifact.ProtoReflect.Descriptor instead.
 func (*CampaignArtifact) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{47}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CampaignArtifact) GetCampaignId() string {
@@ -3886,7 +4167,7 @@ type EnsureCampaignArtifactsRequest struct {
 
 func (x *EnsureCampaignArtifactsRequest) Reset() {
 	*x = EnsureCampaignArtifactsRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[48]
+	mi := &file_ai_v1_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3898,7 +4179,7 @@ func (x *EnsureCampaignArtifactsRequest) String() string {
 func (*EnsureCampaignArtifactsRequest) ProtoMessage() {}
 
 func (x *EnsureCampaignArtifactsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[48]
+	mi := &file_ai_v1_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3911,7 +4192,7 @@ func (x *EnsureCampaignArtifactsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnsureCampaignArtifactsRequest.ProtoReflect.Descriptor instead.
 func (*EnsureCampaignArtifactsRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{48}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *EnsureCampaignArtifactsRequest) GetCampaignId() string {
@@ -3937,7 +4218,7 @@ type EnsureCampaignArtifactsResponse struct {
 
 func (x *EnsureCampaignArtifactsResponse) Reset() {
 	*x = EnsureCampaignArtifactsResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[49]
+	mi := &file_ai_v1_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3949,7 +4230,7 @@ func (x *EnsureCampaignArtifactsResponse) String() string {
 func (*EnsureCampaignArtifactsResponse) ProtoMessage() {}
 
 func (x *EnsureCampaignArtifactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[49]
+	mi := &file_ai_v1_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3962,7 +4243,7 @@ func (x *EnsureCampaignArtifactsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnsureCampaignArtifactsResponse.ProtoReflect.Descriptor instead.
 func (*EnsureCampaignArtifactsResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{49}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *EnsureCampaignArtifactsResponse) GetArtifacts() []*CampaignArtifact {
@@ -3981,7 +4262,7 @@ type ListCampaignArtifactsRequest struct {
 
 func (x *ListCampaignArtifactsRequest) Reset() {
 	*x = ListCampaignArtifactsRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[50]
+	mi := &file_ai_v1_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3993,7 +4274,7 @@ func (x *ListCampaignArtifactsRequest) String() string {
 func (*ListCampaignArtifactsRequest) ProtoMessage() {}
 
 func (x *ListCampaignArtifactsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[50]
+	mi := &file_ai_v1_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4006,38 +4287,927 @@ func (x *ListCampaignArtifactsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCampaignArtifactsRequest.ProtoReflect.Descriptor instead.
 func (*ListCampaignArtifactsRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{50}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListCampaignArtifactsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+type ListCampaignArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifacts     []*CampaignArtifact    `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignArtifactsResponse) Reset() {
+	*x = ListCampaignArtifactsResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignArtifactsResponse) ProtoMessage() {}
+
+func (x *ListCampaignArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListCampaignArtifactsResponse) GetArtifacts() []*CampaignArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type GetCampaignArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignArtifactRequest) Reset() {
+	*x = GetCampaignArtifactRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignArtifactRequest) ProtoMessage() {}
+
+func (x *GetCampaignArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignArtifactRequest.ProtoReflect.Descriptor instead.
+func (*GetCampaignArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetCampaignArtifactRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *GetCampaignArtifactRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetCampaignArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifact      *CampaignArtifact      `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignArtifactResponse) Reset() {
+	*x = GetCampaignArtifactResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignArtifactResponse) ProtoMessage() {}
+
+func (x *GetCampaignArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignArtifactResponse.ProtoReflect.Descriptor instead.
+func (*GetCampaignArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetCampaignArtifactResponse) GetArtifact() *CampaignArtifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+type UpsertCampaignArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertCampaignArtifactRequest) Reset() {
+	*x = UpsertCampaignArtifactRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertCampaignArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertCampaignArtifactRequest) ProtoMessage() {}
+
+func (x *UpsertCampaignArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertCampaignArtifactRequest.ProtoReflect.Descriptor instead.
+func (*UpsertCampaignArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpsertCampaignArtifactRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *UpsertCampaignArtifactRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UpsertCampaignArtifactRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpsertCampaignArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifact      *CampaignArtifact      `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertCampaignArtifactResponse) Reset() {
+	*x = UpsertCampaignArtifactResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertCampaignArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertCampaignArtifactResponse) ProtoMessage() {}
+
+func (x *UpsertCampaignArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertCampaignArtifactResponse.ProtoReflect.Descriptor instead.
+func (*UpsertCampaignArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpsertCampaignArtifactResponse) GetArtifact() *CampaignArtifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+type CampaignArtifactRevision struct {
+	state      protoimpl.MessageState     `protogen:"open.v1"`
+	CampaignId string                     `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Path       string                     `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Revision   int32                      `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	AuthorKind CampaignArtifactAuthorKind `protobuf:"varint,4,opt,name=author_kind,json=authorKind,proto3,enum=ai.v1.CampaignArtifactAuthorKind" json:"author_kind,omitempty"`
+	// AI turn ID for AI-authored revisions, user ID for user-authored ones.
+	AuthorId      string                 `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	SizeBytes     int32                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignArtifactRevision) Reset() {
+	*x = CampaignArtifactRevision{}
+	mi := &file_ai_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignArtifactRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignArtifactRevision) ProtoMessage() {}
+
+func (x *CampaignArtifactRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignArtifactRevision.ProtoReflect.Descriptor instead.
+func (*CampaignArtifactRevision) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CampaignArtifactRevision) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CampaignArtifactRevision) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CampaignArtifactRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CampaignArtifactRevision) GetAuthorKind() CampaignArtifactAuthorKind {
+	if x != nil {
+		return x.AuthorKind
+	}
+	return CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_UNSPECIFIED
+}
+
+func (x *CampaignArtifactRevision) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CampaignArtifactRevision) GetSizeBytes() int32 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CampaignArtifactRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCampaignArtifactRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignArtifactRevisionsRequest) Reset() {
+	*x = ListCampaignArtifactRevisionsRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignArtifactRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignArtifactRevisionsRequest) ProtoMessage() {}
+
+func (x *ListCampaignArtifactRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignArtifactRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignArtifactRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListCampaignArtifactRevisionsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListCampaignArtifactRevisionsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ListCampaignArtifactRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revisions ordered newest first.
+	Revisions     []*CampaignArtifactRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignArtifactRevisionsResponse) Reset() {
+	*x = ListCampaignArtifactRevisionsResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignArtifactRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignArtifactRevisionsResponse) ProtoMessage() {}
+
+func (x *ListCampaignArtifactRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignArtifactRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignArtifactRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListCampaignArtifactRevisionsResponse) GetRevisions() []*CampaignArtifactRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DiffCampaignArtifactRevisionsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId   string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Path         string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	FromRevision int32                  `protobuf:"varint,3,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// Zero compares against the latest revision.
+	ToRevision    int32 `protobuf:"varint,4,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffCampaignArtifactRevisionsRequest) Reset() {
+	*x = DiffCampaignArtifactRevisionsRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCampaignArtifactRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCampaignArtifactRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffCampaignArtifactRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCampaignArtifactRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffCampaignArtifactRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DiffCampaignArtifactRevisionsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DiffCampaignArtifactRevisionsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DiffCampaignArtifactRevisionsRequest) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffCampaignArtifactRevisionsRequest) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type CampaignArtifactDiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            CampaignArtifactDiffOp `protobuf:"varint,1,opt,name=op,proto3,enum=ai.v1.CampaignArtifactDiffOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignArtifactDiffLine) Reset() {
+	*x = CampaignArtifactDiffLine{}
+	mi := &file_ai_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignArtifactDiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignArtifactDiffLine) ProtoMessage() {}
+
+func (x *CampaignArtifactDiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignArtifactDiffLine.ProtoReflect.Descriptor instead.
+func (*CampaignArtifactDiffLine) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CampaignArtifactDiffLine) GetOp() CampaignArtifactDiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_UNSPECIFIED
+}
+
+func (x *CampaignArtifactDiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DiffCampaignArtifactRevisionsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	From          *CampaignArtifactRevision   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *CampaignArtifactRevision   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Lines         []*CampaignArtifactDiffLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	UnifiedDiff   string                      `protobuf:"bytes,4,opt,name=unified_diff,json=unifiedDiff,proto3" json:"unified_diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffCampaignArtifactRevisionsResponse) Reset() {
+	*x = DiffCampaignArtifactRevisionsResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCampaignArtifactRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCampaignArtifactRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffCampaignArtifactRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCampaignArtifactRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffCampaignArtifactRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DiffCampaignArtifactRevisionsResponse) GetFrom() *CampaignArtifactRevision {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffCampaignArtifactRevisionsResponse) GetTo() *CampaignArtifactRevision {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DiffCampaignArtifactRevisionsResponse) GetLines() []*CampaignArtifactDiffLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *DiffCampaignArtifactRevisionsResponse) GetUnifiedDiff() string {
+	if x != nil {
+		return x.UnifiedDiff
+	}
+	return ""
+}
+
+type RestoreCampaignArtifactRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCampaignArtifactRevisionRequest) Reset() {
+	*x = RestoreCampaignArtifactRevisionRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCampaignArtifactRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCampaignArtifactRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreCampaignArtifactRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCampaignArtifactRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCampaignArtifactRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RestoreCampaignArtifactRevisionRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RestoreCampaignArtifactRevisionRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RestoreCampaignArtifactRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RestoreCampaignArtifactRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifact      *CampaignArtifact      `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCampaignArtifactRevisionResponse) Reset() {
+	*x = RestoreCampaignArtifactRevisionResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCampaignArtifactRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCampaignArtifactRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreCampaignArtifactRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCampaignArtifactRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCampaignArtifactRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RestoreCampaignArtifactRevisionResponse) GetArtifact() *CampaignArtifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+type SessionRecapDraft struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CampaignId    string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                  `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Status        SessionRecapDraftStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ai.v1.SessionRecapDraftStatus" json:"status,omitempty"`
+	Markdown      string                  `protobuf:"bytes,4,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	AgentId       string                  `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PublishedAt   *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionRecapDraft) Reset() {
+	*x = SessionRecapDraft{}
+	mi := &file_ai_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionRecapDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRecapDraft) ProtoMessage() {}
+
+func (x *SessionRecapDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRecapDraft.ProtoReflect.Descriptor instead.
+func (*SessionRecapDraft) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SessionRecapDraft) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionRecapDraft) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionRecapDraft) GetStatus() SessionRecapDraftStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_UNSPECIFIED
+}
+
+func (x *SessionRecapDraft) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
 }
 
-func (x *ListCampaignArtifactsRequest) GetCampaignId() string {
+func (x *SessionRecapDraft) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *SessionRecapDraft) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SessionRecapDraft) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SessionRecapDraft) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+type DraftSessionRecapRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId  string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Markdown digest of the session events, GM interactions, and chat
+	// transcript collected by the caller.
+	SourceMarkdown string `protobuf:"bytes,3,opt,name=source_markdown,json=sourceMarkdown,proto3" json:"source_markdown,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DraftSessionRecapRequest) Reset() {
+	*x = DraftSessionRecapRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftSessionRecapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftSessionRecapRequest) ProtoMessage() {}
+
+func (x *DraftSessionRecapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftSessionRecapRequest.ProtoReflect.Descriptor instead.
+func (*DraftSessionRecapRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DraftSessionRecapRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-type ListCampaignArtifactsResponse struct {
+func (x *DraftSessionRecapRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DraftSessionRecapRequest) GetSourceMarkdown() string {
+	if x != nil {
+		return x.SourceMarkdown
+	}
+	return ""
+}
+
+type DraftSessionRecapResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Artifacts     []*CampaignArtifact    `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Draft         *SessionRecapDraft     `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCampaignArtifactsResponse) Reset() {
-	*x = ListCampaignArtifactsResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[51]
+func (x *DraftSessionRecapResponse) Reset() {
+	*x = DraftSessionRecapResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCampaignArtifactsResponse) String() string {
+func (x *DraftSessionRecapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCampaignArtifactsResponse) ProtoMessage() {}
+func (*DraftSessionRecapResponse) ProtoMessage() {}
 
-func (x *ListCampaignArtifactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[51]
+func (x *DraftSessionRecapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4048,41 +5218,41 @@ func (x *ListCampaignArtifactsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCampaignArtifactsResponse.ProtoReflect.Descriptor instead.
-func (*ListCampaignArtifactsResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use DraftSessionRecapResponse.ProtoReflect.Descriptor instead.
+func (*DraftSessionRecapResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListCampaignArtifactsResponse) GetArtifacts() []*CampaignArtifact {
+func (x *DraftSessionRecapResponse) GetDraft() *SessionRecapDraft {
 	if x != nil {
-		return x.Artifacts
+		return x.Draft
 	}
 	return nil
 }
 
-type GetCampaignArtifactRequest struct {
+type GetSessionRecapDraftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCampaignArtifactRequest) Reset() {
-	*x = GetCampaignArtifactRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[52]
+func (x *GetSessionRecapDraftRequest) Reset() {
+	*x = GetSessionRecapDraftRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCampaignArtifactRequest) String() string {
+func (x *GetSessionRecapDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCampaignArtifactRequest) ProtoMessage() {}
+func (*GetSessionRecapDraftRequest) ProtoMessage() {}
 
-func (x *GetCampaignArtifactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[52]
+func (x *GetSessionRecapDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4093,47 +5263,47 @@ func (x *GetCampaignArtifactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCampaignArtifactRequest.ProtoReflect.Descriptor instead.
-func (*GetCampaignArtifactRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetSessionRecapDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRecapDraftRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetCampaignArtifactRequest) GetCampaignId() string {
+func (x *GetSessionRecapDraftRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *GetCampaignArtifactRequest) GetPath() string {
+func (x *GetSessionRecapDraftRequest) GetSessionId() string {
 	if x != nil {
-		return x.Path
+		return x.SessionId
 	}
 	return ""
 }
 
-type GetCampaignArtifactResponse struct {
+type GetSessionRecapDraftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Artifact      *CampaignArtifact      `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Draft         *SessionRecapDraft     `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCampaignArtifactResponse) Reset() {
-	*x = GetCampaignArtifactResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[53]
+func (x *GetSessionRecapDraftResponse) Reset() {
+	*x = GetSessionRecapDraftResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCampaignArtifactResponse) String() string {
+func (x *GetSessionRecapDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCampaignArtifactResponse) ProtoMessage() {}
+func (*GetSessionRecapDraftResponse) ProtoMessage() {}
 
-func (x *GetCampaignArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[53]
+func (x *GetSessionRecapDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4144,42 +5314,42 @@ func (x *GetCampaignArtifactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCampaignArtifactResponse.ProtoReflect.Descriptor instead.
-func (*GetCampaignArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use GetSessionRecapDraftResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionRecapDraftResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetCampaignArtifactResponse) GetArtifact() *CampaignArtifact {
+func (x *GetSessionRecapDraftResponse) GetDraft() *SessionRecapDraft {
 	if x != nil {
-		return x.Artifact
+		return x.Draft
 	}
 	return nil
 }
 
-type UpsertCampaignArtifactRequest struct {
+type UpdateSessionRecapDraftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Markdown      string                 `protobuf:"bytes,3,opt,name=markdown,proto3" json:"markdown,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertCampaignArtifactRequest) Reset() {
-	*x = UpsertCampaignArtifactRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[54]
+func (x *UpdateSessionRecapDraftRequest) Reset() {
+	*x = UpdateSessionRecapDraftRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertCampaignArtifactRequest) String() string {
+func (x *UpdateSessionRecapDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertCampaignArtifactRequest) ProtoMessage() {}
+func (*UpdateSessionRecapDraftRequest) ProtoMessage() {}
 
-func (x *UpsertCampaignArtifactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[54]
+func (x *UpdateSessionRecapDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4190,54 +5360,54 @@ func (x *UpsertCampaignArtifactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertCampaignArtifactRequest.ProtoReflect.Descriptor instead.
-func (*UpsertCampaignArtifactRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use UpdateSessionRecapDraftRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSessionRecapDraftRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *UpsertCampaignArtifactRequest) GetCampaignId() string {
+func (x *UpdateSessionRecapDraftRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *UpsertCampaignArtifactRequest) GetPath() string {
+func (x *UpdateSessionRecapDraftRequest) GetSessionId() string {
 	if x != nil {
-		return x.Path
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *UpsertCampaignArtifactRequest) GetContent() string {
+func (x *UpdateSessionRecapDraftRequest) GetMarkdown() string {
 	if x != nil {
-		return x.Content
+		return x.Markdown
 	}
 	return ""
 }
 
-type UpsertCampaignArtifactResponse struct {
+type UpdateSessionRecapDraftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Artifact      *CampaignArtifact      `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Draft         *SessionRecapDraft     `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertCampaignArtifactResponse) Reset() {
-	*x = UpsertCampaignArtifactResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[55]
+func (x *UpdateSessionRecapDraftResponse) Reset() {
+	*x = UpdateSessionRecapDraftResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertCampaignArtifactResponse) String() string {
+func (x *UpdateSessionRecapDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertCampaignArtifactResponse) ProtoMessage() {}
+func (*UpdateSessionRecapDraftResponse) ProtoMessage() {}
 
-func (x *UpsertCampaignArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[55]
+func (x *UpdateSessionRecapDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4248,14 +5418,110 @@ func (x *UpsertCampaignArtifactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertCampaignArtifactResponse.ProtoReflect.Descriptor instead.
-func (*UpsertCampaignArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use UpdateSessionRecapDraftResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSessionRecapDraftResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpsertCampaignArtifactResponse) GetArtifact() *CampaignArtifact {
+func (x *UpdateSessionRecapDraftResponse) GetDraft() *SessionRecapDraft {
 	if x != nil {
-		return x.Artifact
+		return x.Draft
+	}
+	return nil
+}
+
+type MarkSessionRecapDraftPublishedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSessionRecapDraftPublishedRequest) Reset() {
+	*x = MarkSessionRecapDraftPublishedRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSessionRecapDraftPublishedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSessionRecapDraftPublishedRequest) ProtoMessage() {}
+
+func (x *MarkSessionRecapDraftPublishedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSessionRecapDraftPublishedRequest.ProtoReflect.Descriptor instead.
+func (*MarkSessionRecapDraftPublishedRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MarkSessionRecapDraftPublishedRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *MarkSessionRecapDraftPublishedRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type MarkSessionRecapDraftPublishedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *SessionRecapDraft     `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSessionRecapDraftPublishedResponse) Reset() {
+	*x = MarkSessionRecapDraftPublishedResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSessionRecapDraftPublishedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSessionRecapDraftPublishedResponse) ProtoMessage() {}
+
+func (x *MarkSessionRecapDraftPublishedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSessionRecapDraftPublishedResponse.ProtoReflect.Descriptor instead.
+func (*MarkSessionRecapDraftPublishedResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MarkSessionRecapDraftPublishedResponse) GetDraft() *SessionRecapDraft {
+	if x != nil {
+		return x.Draft
 	}
 	return nil
 }
@@ -4275,7 +5541,7 @@ type SystemReferenceDocument struct {
 
 func (x *SystemReferenceDocument) Reset() {
 	*x = SystemReferenceDocument{}
-	mi := &file_ai_v1_service_proto_msgTypes[56]
+	mi := &file_ai_v1_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4287,7 +5553,7 @@ func (x *SystemReferenceDocument) String() string {
 func (*SystemReferenceDocument) ProtoMessage() {}
 
 func (x *SystemReferenceDocument) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[56]
+	mi := &file_ai_v1_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4300,7 +5566,7 @@ func (x *SystemReferenceDocument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemReferenceDocument.ProtoReflect.Descriptor instead.
 func (*SystemReferenceDocument) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{56}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *SystemReferenceDocument) GetSystem() string {
@@ -4367,7 +5633,7 @@ type SystemReferenceDocumentSummary struct {
 
 func (x *SystemReferenceDocumentSummary) Reset() {
 	*x = SystemReferenceDocumentSummary{}
-	mi := &file_ai_v1_service_proto_msgTypes[57]
+	mi := &file_ai_v1_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4379,7 +5645,7 @@ func (x *SystemReferenceDocumentSummary) String() string {
 func (*SystemReferenceDocumentSummary) ProtoMessage() {}
 
 func (x *SystemReferenceDocumentSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[57]
+	mi := &file_ai_v1_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4392,7 +5658,7 @@ func (x *SystemReferenceDocumentSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemReferenceDocumentSummary.ProtoReflect.Descriptor instead.
 func (*SystemReferenceDocumentSummary) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{57}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *SystemReferenceDocumentSummary) GetSystem() string {
@@ -4455,7 +5721,7 @@ type SearchSystemReferenceRequest struct {
 
 func (x *SearchSystemReferenceRequest) Reset() {
 	*x = SearchSystemReferenceRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[58]
+	mi := &file_ai_v1_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4467,7 +5733,7 @@ func (x *SearchSystemReferenceRequest) String() string {
 func (*SearchSystemReferenceRequest) ProtoMessage() {}
 
 func (x *SearchSystemReferenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[58]
+	mi := &file_ai_v1_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4480,7 +5746,7 @@ func (x *SearchSystemReferenceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchSystemReferenceRequest.ProtoReflect.Descriptor instead.
 func (*SearchSystemReferenceRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{58}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *SearchSystemReferenceRequest) GetSystem() string {
@@ -4513,7 +5779,7 @@ type SearchSystemReferenceResponse struct {
 
 func (x *SearchSystemReferenceResponse) Reset() {
 	*x = SearchSystemReferenceResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[59]
+	mi := &file_ai_v1_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4525,7 +5791,7 @@ func (x *SearchSystemReferenceResponse) String() string {
 func (*SearchSystemReferenceResponse) ProtoMessage() {}
 
 func (x *SearchSystemReferenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[59]
+	mi := &file_ai_v1_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4538,7 +5804,7 @@ func (x *SearchSystemReferenceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchSystemReferenceResponse.ProtoReflect.Descriptor instead.
 func (*SearchSystemReferenceResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{59}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *SearchSystemReferenceResponse) GetResults() []*SystemReferenceDocumentSummary {
@@ -4558,7 +5824,7 @@ type ReadSystemReferenceDocumentRequest struct {
 
 func (x *ReadSystemReferenceDocumentRequest) Reset() {
 	*x = ReadSystemReferenceDocumentRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[60]
+	mi := &file_ai_v1_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4570,7 +5836,7 @@ func (x *ReadSystemReferenceDocumentRequest) String() string {
 func (*ReadSystemReferenceDocumentRequest) ProtoMessage() {}
 
 func (x *ReadSystemReferenceDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[60]
+	mi := &file_ai_v1_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4583,7 +5849,7 @@ func (x *ReadSystemReferenceDocumentRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ReadSystemReferenceDocumentRequest.ProtoReflect.Descriptor instead.
 func (*ReadSystemReferenceDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{60}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ReadSystemReferenceDocumentRequest) GetSystem() string {
@@ -4609,7 +5875,7 @@ type ReadSystemReferenceDocumentResponse struct {
 
 func (x *ReadSystemReferenceDocumentResponse) Reset() {
 	*x = ReadSystemReferenceDocumentResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[61]
+	mi := &file_ai_v1_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4621,7 +5887,7 @@ func (x *ReadSystemReferenceDocumentResponse) String() string {
 func (*ReadSystemReferenceDocumentResponse) ProtoMessage() {}
 
 func (x *ReadSystemReferenceDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[61]
+	mi := &file_ai_v1_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4634,7 +5900,7 @@ func (x *ReadSystemReferenceDocumentResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ReadSystemReferenceDocumentResponse.ProtoReflect.Descriptor instead.
 func (*ReadSystemReferenceDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{61}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ReadSystemReferenceDocumentResponse) GetDocument() *SystemReferenceDocument {
@@ -4654,7 +5920,7 @@ type StartProviderConnectRequest struct {
 
 func (x *StartProviderConnectRequest) Reset() {
 	*x = StartProviderConnectRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[62]
+	mi := &file_ai_v1_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4666,7 +5932,7 @@ func (x *StartProviderConnectRequest) String() string {
 func (*StartProviderConnectRequest) ProtoMessage() {}
 
 func (x *StartProviderConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[62]
+	mi := &file_ai_v1_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4679,7 +5945,7 @@ func (x *StartProviderConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartProviderConnectRequest.ProtoReflect.Descriptor instead.
 func (*StartProviderConnectRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{62}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *StartProviderConnectRequest) GetProvider() Provider {
@@ -4708,7 +5974,7 @@ type StartProviderConnectResponse struct {
 
 func (x *StartProviderConnectResponse) Reset() {
 	*x = StartProviderConnectResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[63]
+	mi := &file_ai_v1_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4720,7 +5986,7 @@ func (x *StartProviderConnectResponse) String() string {
 func (*StartProviderConnectResponse) ProtoMessage() {}
 
 func (x *StartProviderConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[63]
+	mi := &file_ai_v1_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4733,7 +5999,7 @@ func (x *StartProviderConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartProviderConnectResponse.ProtoReflect.Descriptor instead.
 func (*StartProviderConnectResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{63}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *StartProviderConnectResponse) GetConnectSessionId() string {
@@ -4775,7 +6041,7 @@ type FinishProviderConnectRequest struct {
 
 func (x *FinishProviderConnectRequest) Reset() {
 	*x = FinishProviderConnectRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[64]
+	mi := &file_ai_v1_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4787,7 +6053,7 @@ func (x *FinishProviderConnectRequest) String() string {
 func (*FinishProviderConnectRequest) ProtoMessage() {}
 
 func (x *FinishProviderConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[64]
+	mi := &file_ai_v1_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4800,7 +6066,7 @@ func (x *FinishProviderConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishProviderConnectRequest.ProtoReflect.Descriptor instead.
 func (*FinishProviderConnectRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{64}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *FinishProviderConnectRequest) GetConnectSessionId() string {
@@ -4833,7 +6099,7 @@ type FinishProviderConnectResponse struct {
 
 func (x *FinishProviderConnectResponse) Reset() {
 	*x = FinishProviderConnectResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[65]
+	mi := &file_ai_v1_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4845,7 +6111,7 @@ func (x *FinishProviderConnectResponse) String() string {
 func (*FinishProviderConnectResponse) ProtoMessage() {}
 
 func (x *FinishProviderConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[65]
+	mi := &file_ai_v1_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4858,7 +6124,7 @@ func (x *FinishProviderConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishProviderConnectResponse.ProtoReflect.Descriptor instead.
 func (*FinishProviderConnectResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{65}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *FinishProviderConnectResponse) GetProviderGrant() *ProviderGrant {
@@ -4880,7 +6146,7 @@ type ListProviderGrantsRequest struct {
 
 func (x *ListProviderGrantsRequest) Reset() {
 	*x = ListProviderGrantsRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[66]
+	mi := &file_ai_v1_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4892,7 +6158,7 @@ func (x *ListProviderGrantsRequest) String() string {
 func (*ListProviderGrantsRequest) ProtoMessage() {}
 
 func (x *ListProviderGrantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[66]
+	mi := &file_ai_v1_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,7 +6171,7 @@ func (x *ListProviderGrantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProviderGrantsRequest.ProtoReflect.Descriptor instead.
 func (*ListProviderGrantsRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{66}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *ListProviderGrantsRequest) GetPageSize() int32 {
@@ -4946,7 +6212,7 @@ type ListProviderGrantsResponse struct {
 
 func (x *ListProviderGrantsResponse) Reset() {
 	*x = ListProviderGrantsResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[67]
+	mi := &file_ai_v1_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4958,7 +6224,7 @@ func (x *ListProviderGrantsResponse) String() string {
 func (*ListProviderGrantsResponse) ProtoMessage() {}
 
 func (x *ListProviderGrantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[67]
+	mi := &file_ai_v1_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4971,7 +6237,7 @@ func (x *ListProviderGrantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProviderGrantsResponse.ProtoReflect.Descriptor instead.
 func (*ListProviderGrantsResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{67}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ListProviderGrantsResponse) GetProviderGrants() []*ProviderGrant {
@@ -4997,7 +6263,7 @@ type RevokeProviderGrantRequest struct {
 
 func (x *RevokeProviderGrantRequest) Reset() {
 	*x = RevokeProviderGrantRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[68]
+	mi := &file_ai_v1_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5009,7 +6275,7 @@ func (x *RevokeProviderGrantRequest) String() string {
 func (*RevokeProviderGrantRequest) ProtoMessage() {}
 
 func (x *RevokeProviderGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[68]
+	mi := &file_ai_v1_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5022,7 +6288,7 @@ func (x *RevokeProviderGrantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeProviderGrantRequest.ProtoReflect.Descriptor instead.
 func (*RevokeProviderGrantRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{68}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *RevokeProviderGrantRequest) GetProviderGrantId() string {
@@ -5041,7 +6307,7 @@ type RevokeProviderGrantResponse struct {
 
 func (x *RevokeProviderGrantResponse) Reset() {
 	*x = RevokeProviderGrantResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[69]
+	mi := &file_ai_v1_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5053,7 +6319,7 @@ func (x *RevokeProviderGrantResponse) String() string {
 func (*RevokeProviderGrantResponse) ProtoMessage() {}
 
 func (x *RevokeProviderGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[69]
+	mi := &file_ai_v1_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5066,7 +6332,7 @@ func (x *RevokeProviderGrantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeProviderGrantResponse.ProtoReflect.Descriptor instead.
 func (*RevokeProviderGrantResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{69}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *RevokeProviderGrantResponse) GetProviderGrant() *ProviderGrant {
@@ -5087,7 +6353,7 @@ type CreateAccessRequestRequest struct {
 
 func (x *CreateAccessRequestRequest) Reset() {
 	*x = CreateAccessRequestRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[70]
+	mi := &file_ai_v1_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5099,7 +6365,7 @@ func (x *CreateAccessRequestRequest) String() string {
 func (*CreateAccessRequestRequest) ProtoMessage() {}
 
 func (x *CreateAccessRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[70]
+	mi := &file_ai_v1_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5112,7 +6378,7 @@ func (x *CreateAccessRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccessRequestRequest.ProtoReflect.Descriptor instead.
 func (*CreateAccessRequestRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{70}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *CreateAccessRequestRequest) GetAgentId() string {
@@ -5145,7 +6411,7 @@ type CreateAccessRequestResponse struct {
 
 func (x *CreateAccessRequestResponse) Reset() {
 	*x = CreateAccessRequestResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[71]
+	mi := &file_ai_v1_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5157,7 +6423,7 @@ func (x *CreateAccessRequestResponse) String() string {
 func (*CreateAccessRequestResponse) ProtoMessage() {}
 
 func (x *CreateAccessRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[71]
+	mi := &file_ai_v1_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5170,7 +6436,7 @@ func (x *CreateAccessRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccessRequestResponse.ProtoReflect.Descriptor instead.
 func (*CreateAccessRequestResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{71}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CreateAccessRequestResponse) GetAccessRequest() *AccessRequest {
@@ -5191,7 +6457,7 @@ type ListAccessRequestsRequest struct {
 
 func (x *ListAccessRequestsRequest) Reset() {
 	*x = ListAccessRequestsRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[72]
+	mi := &file_ai_v1_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5203,7 +6469,7 @@ func (x *ListAccessRequestsRequest) String() string {
 func (*ListAccessRequestsRequest) ProtoMessage() {}
 
 func (x *ListAccessRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[72]
+	mi := &file_ai_v1_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5216,7 +6482,7 @@ func (x *ListAccessRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccessRequestsRequest.ProtoReflect.Descriptor instead.
 func (*ListAccessRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{72}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ListAccessRequestsRequest) GetRole() AccessRequestRole {
@@ -5250,7 +6516,7 @@ type ListAccessRequestsResponse struct {
 
 func (x *ListAccessRequestsResponse) Reset() {
 	*x = ListAccessRequestsResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[73]
+	mi := &file_ai_v1_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5262,7 +6528,7 @@ func (x *ListAccessRequestsResponse) String() string {
 func (*ListAccessRequestsResponse) ProtoMessage() {}
 
 func (x *ListAccessRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[73]
+	mi := &file_ai_v1_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5275,7 +6541,7 @@ func (x *ListAccessRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccessRequestsResponse.ProtoReflect.Descriptor instead.
 func (*ListAccessRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{73}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ListAccessRequestsResponse) GetAccessRequests() []*AccessRequest {
@@ -5306,7 +6572,7 @@ type ListAuditEventsRequest struct {
 
 func (x *ListAuditEventsRequest) Reset() {
 	*x = ListAuditEventsRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[74]
+	mi := &file_ai_v1_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5318,7 +6584,7 @@ func (x *ListAuditEventsRequest) String() string {
 func (*ListAuditEventsRequest) ProtoMessage() {}
 
 func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[74]
+	mi := &file_ai_v1_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5331,7 +6597,7 @@ func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{74}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *ListAuditEventsRequest) GetPageSize() int32 {
@@ -5386,7 +6652,7 @@ type ListAuditEventsResponse struct {
 
 func (x *ListAuditEventsResponse) Reset() {
 	*x = ListAuditEventsResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[75]
+	mi := &file_ai_v1_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5398,7 +6664,7 @@ func (x *ListAuditEventsResponse) String() string {
 func (*ListAuditEventsResponse) ProtoMessage() {}
 
 func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[75]
+	mi := &file_ai_v1_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5411,7 +6677,7 @@ func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{75}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *ListAuditEventsResponse) GetAuditEvents() []*AuditEvent {
@@ -5439,7 +6705,7 @@ type ReviewAccessRequestRequest struct {
 
 func (x *ReviewAccessRequestRequest) Reset() {
 	*x = ReviewAccessRequestRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[76]
+	mi := &file_ai_v1_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5451,7 +6717,7 @@ func (x *ReviewAccessRequestRequest) String() string {
 func (*ReviewAccessRequestRequest) ProtoMessage() {}
 
 func (x *ReviewAccessRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[76]
+	mi := &file_ai_v1_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5464,7 +6730,7 @@ func (x *ReviewAccessRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewAccessRequestRequest.ProtoReflect.Descriptor instead.
 func (*ReviewAccessRequestRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{76}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *ReviewAccessRequestRequest) GetAccessRequestId() string {
@@ -5497,7 +6763,7 @@ type ReviewAccessRequestResponse struct {
 
 func (x *ReviewAccessRequestResponse) Reset() {
 	*x = ReviewAccessRequestResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[77]
+	mi := &file_ai_v1_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5509,7 +6775,7 @@ func (x *ReviewAccessRequestResponse) String() string {
 func (*ReviewAccessRequestResponse) ProtoMessage() {}
 
 func (x *ReviewAccessRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[77]
+	mi := &file_ai_v1_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5522,7 +6788,7 @@ func (x *ReviewAccessRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewAccessRequestResponse.ProtoReflect.Descriptor instead.
 func (*ReviewAccessRequestResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{77}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *ReviewAccessRequestResponse) GetAccessRequest() *AccessRequest {
@@ -5542,7 +6808,7 @@ type RevokeAccessRequestRequest struct {
 
 func (x *RevokeAccessRequestRequest) Reset() {
 	*x = RevokeAccessRequestRequest{}
-	mi := &file_ai_v1_service_proto_msgTypes[78]
+	mi := &file_ai_v1_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5554,7 +6820,7 @@ func (x *RevokeAccessRequestRequest) String() string {
 func (*RevokeAccessRequestRequest) ProtoMessage() {}
 
 func (x *RevokeAccessRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[78]
+	mi := &file_ai_v1_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5567,7 +6833,7 @@ func (x *RevokeAccessRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAccessRequestRequest.ProtoReflect.Descriptor instead.
 func (*RevokeAccessRequestRequest) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{78}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *RevokeAccessRequestRequest) GetAccessRequestId() string {
@@ -5593,7 +6859,7 @@ type RevokeAccessRequestResponse struct {
 
 func (x *RevokeAccessRequestResponse) Reset() {
 	*x = RevokeAccessRequestResponse{}
-	mi := &file_ai_v1_service_proto_msgTypes[79]
+	mi := &file_ai_v1_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5605,7 +6871,7 @@ func (x *RevokeAccessRequestResponse) String() string {
 func (*RevokeAccessRequestResponse) ProtoMessage() {}
 
 func (x *RevokeAccessRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_v1_service_proto_msgTypes[79]
+	mi := &file_ai_v1_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5618,7 +6884,7 @@ func (x *RevokeAccessRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAccessRequestResponse.ProtoReflect.Descriptor instead.
 func (*RevokeAccessRequestResponse) Descriptor() ([]byte, []int) {
-	return file_ai_v1_service_proto_rawDescGZIP(), []int{79}
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *RevokeAccessRequestResponse) GetAccessRequest() *AccessRequest {
@@ -5628,6 +6894,184 @@ func (x *RevokeAccessRequestResponse) GetAccessRequest() *AccessRequest {
 	return nil
 }
 
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ExportUserDataResponse carries the AI-owned records for one user as a
+// JSON document.
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordsJson   string                 `protobuf:"bytes,1,opt,name=records_json,json=recordsJson,proto3" json:"records_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ExportUserDataResponse) GetRecordsJson() string {
+	if x != nil {
+		return x.RecordsJson
+	}
+	return ""
+}
+
+type DeleteUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserDataRequest) Reset() {
+	*x = DeleteUserDataRequest{}
+	mi := &file_ai_v1_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataRequest) ProtoMessage() {}
+
+func (x *DeleteUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserDataResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeletedRecords int32                  `protobuf:"varint,1,opt,name=deleted_records,json=deletedRecords,proto3" json:"deleted_records,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteUserDataResponse) Reset() {
+	*x = DeleteUserDataResponse{}
+	mi := &file_ai_v1_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataResponse) ProtoMessage() {}
+
+func (x *DeleteUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_v1_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_ai_v1_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeleteUserDataResponse) GetDeletedRecords() int32 {
+	if x != nil {
+		return x.DeletedRecords
+	}
+	return 0
+}
+
 var File_ai_v1_service_proto protoreflect.FileDescriptor
 
 var file_ai_v1_service_proto_rawDesc = string([]byte{
@@ -6033,209 +7477,377 @@ var file_ai_v1_service_proto_rawDesc = string([]byte{
 	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
 	0x69, 0x65, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x11, 0x72, 0x65,
 	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22,
-	0xfb, 0x02, 0x0a, 0x12, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1d, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6f, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x11,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6c,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd1, 0x04,
-	0x0a, 0x11, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54,
-	0x75, 0x72, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x8d, 0x01, 0x0a, 0x1c, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xa7, 0x01, 0x0a, 0x1d, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfb, 0x02, 0x0a, 0x12, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x73, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a,
-	0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65,
-	0x62, 0x75, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0xc4, 0x04, 0x0a, 0x18, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65,
-	0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a,
-	0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd1, 0x04, 0x0a, 0x11, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xc4, 0x04, 0x0a, 0x18,
 	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22,
-	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x75, 0x72, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75,
+	0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x7f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x57, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x1c, 0x47, 0x65,
 	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75,
-	0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x75, 0x72, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72,
-	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12,
-	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64,
-	0x22, 0x4c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x22, 0x66,
-	0x0a, 0x24, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x70,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf4, 0x01,
-	0x0a, 0x10, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
-	0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x71, 0x0a, 0x1e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x5f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x65, 0x64, 0x4d,
-	0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x58, 0x0a, 0x1f, 0x45, 0x6e, 0x73, 0x75, 0x72,
-	0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
-	0x73, 0x22, 0x3f, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
-	0x49, 0x64, 0x22, 0x56, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52,
-	0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x52, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
-	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x22, 0x6e, 0x0a, 0x1d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x75,
+	0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75,
+	0x72, 0x6e, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x22, 0x66, 0x0a, 0x24, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x94, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x04,
+	0x74, 0x75, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x54, 0x75, 0x72, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x74, 0x75, 0x72,
+	0x6e, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x71,
+	0x0a, 0x1e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x22, 0x58, 0x0a, 0x1f, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
 	0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
 	0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0x55, 0x0a, 0x1e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x52, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x6e, 0x0a, 0x1d, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x1e, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x22, 0xa6, 0x02, 0x0a, 0x18, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x42, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5b, 0x0a, 0x24, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x66, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xa1, 0x01, 0x0a, 0x24, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x18, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x2d, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x25, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x4c,
+	0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x69, 0x66, 0x66, 0x22, 0x79, 0x0a,
+	0x26, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x27, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
 	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x08,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0xc4, 0x01, 0x0a, 0x17, 0x53, 0x79, 0x73,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0xf7, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x18, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x4b, 0x0a, 0x19, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05,
+	0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x5d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x22, 0x7c, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x22, 0x51, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05,
+	0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x67, 0x0a, 0x25, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x58,
+	0x0a, 0x26, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0xc4, 0x01, 0x0a, 0x17, 0x53, 0x79, 0x73,
 	0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75,
 	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x0a, 0x0b,
@@ -6435,111 +8047,159 @@ var file_ai_v1_service_proto_rawDesc = string([]byte{
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x69,
 	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2a, 0x51, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14,
-	0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
-	0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x49, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50,
-	0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x54, 0x48, 0x52, 0x4f, 0x50, 0x49,
-	0x43, 0x10, 0x02, 0x2a, 0x72, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x52, 0x45, 0x44, 0x45,
-	0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x52,
-	0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x52, 0x45, 0x44,
-	0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
-	0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x44, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x2a, 0xac, 0x01,
-	0x0a, 0x0e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x20, 0x0a, 0x1c, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x01, 0x12, 0x2b,
-	0x0a, 0x27, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43,
-	0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x2f, 0x0a, 0x2b, 0x41,
-	0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x55,
-	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x2a, 0x9b, 0x01, 0x0a,
-	0x16, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x47, 0x45, 0x4e, 0x54,
-	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48,
-	0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28,
-	0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52,
-	0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
-	0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0xce, 0x01, 0x0a, 0x13, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47,
-	0x52, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f,
-	0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50,
-	0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21,
-	0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x52,
-	0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45,
-	0x53, 0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xc8, 0x01, 0x0a, 0x13,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x22, 0x0a,
-	0x1e, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45,
-	0x44, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56,
-	0x4f, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x7a, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x41,
-	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x4f,
-	0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
-	0x53, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45,
-	0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52,
-	0x10, 0x02, 0x2a, 0x87, 0x01, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x23,
-	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x44,
-	0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e,
-	0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x43,
-	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x02, 0x2a, 0xbe, 0x01, 0x0a,
-	0x17, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75,
-	0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x26, 0x43, 0x41, 0x4d, 0x50,
-	0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e,
-	0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24,
+	0x22, 0x30, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x3b, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x22,
+	0x30, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x41, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x2a, 0x51, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52,
+	0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x49, 0x10, 0x01, 0x12,
+	0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x54, 0x48,
+	0x52, 0x4f, 0x50, 0x49, 0x43, 0x10, 0x02, 0x2a, 0x72, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x43,
+	0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x44, 0x0a, 0x0b, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x47,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x47, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x01, 0x2a, 0xac, 0x01, 0x0a, 0x0e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45,
+	0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x2f, 0x0a, 0x2b, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e,
+	0x43, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03,
+	0x2a, 0x9b, 0x01, 0x0a, 0x16, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x25, 0x41,
+	0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45,
+	0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x2c, 0x0a, 0x28, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52,
+	0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0xce,
+	0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
+	0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12,
+	0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45,
+	0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0xc8, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44,
+	0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x7a, 0x0a, 0x11, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x23, 0x0a, 0x1f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f,
+	0x57, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x87, 0x01, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x23, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12, 0x20,
+	0x0a, 0x1c, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x02,
+	0x2a, 0xbe, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x26,
 	0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54,
-	0x55, 0x52, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
-	0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49,
-	0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xc5, 0x01,
-	0x0a, 0x16, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x25, 0x43, 0x41, 0x4d, 0x50,
-	0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59,
-	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x2c, 0x0a, 0x28, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f,
-	0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
-	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10,
-	0x01, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45,
-	0x42, 0x55, 0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54,
-	0x4f, 0x4f, 0x4c, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x43, 0x41,
-	0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x45, 0x4e, 0x54,
-	0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x4c, 0x5f, 0x52, 0x45, 0x53,
-	0x55, 0x4c, 0x54, 0x10, 0x03, 0x32, 0x8f, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x55, 0x52, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x41, 0x4d, 0x50,
+	0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x28, 0x0a, 0x24, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42,
+	0x55, 0x47, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x41,
+	0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54, 0x55, 0x52,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x2a, 0xc5, 0x01, 0x0a, 0x16, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x25,
+	0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x45,
+	0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2c, 0x0a, 0x28, 0x43, 0x41, 0x4d, 0x50, 0x41,
+	0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47,
+	0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x4c, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x29,
+	0x0a, 0x25, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x4c,
+	0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x03, 0x2a, 0xc8, 0x01, 0x0a, 0x1a, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x29, 0x43, 0x41, 0x4d, 0x50,
+	0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x41, 0x55,
+	0x54, 0x48, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x41, 0x4d, 0x50, 0x41,
+	0x49, 0x47, 0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10,
+	0x01, 0x12, 0x29, 0x0a, 0x25, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x52,
+	0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x41, 0x49, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22,
+	0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43,
+	0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x10, 0x03, 0x2a, 0xb4, 0x01, 0x0a, 0x16, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x4f, 0x70, 0x12,
+	0x29, 0x0a, 0x25, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49,
+	0x46, 0x41, 0x43, 0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x4f, 0x50, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x41,
+	0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f,
+	0x44, 0x49, 0x46, 0x46, 0x5f, 0x4f, 0x50, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12,
+	0x24, 0x0a, 0x20, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49,
+	0x46, 0x41, 0x43, 0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x53,
+	0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47,
+	0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f,
+	0x4f, 0x50, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x97, 0x01, 0x0a, 0x17,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x45, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x50, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x43, 0x41, 0x50, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x53,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x50, 0x5f, 0x44, 0x52, 0x41,
+	0x46, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53,
+	0x48, 0x45, 0x44, 0x10, 0x02, 0x32, 0x8f, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
 	0x74, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
 	0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72,
@@ -6605,137 +8265,206 @@ var file_ai_v1_service_proto_rawDesc = string([]byte{
 	0x74, 0x12, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65,
 	0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
 	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x70, 0x0a, 0x1c, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x1d, 0x2e, 0x61, 0x69,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd4, 0x01, 0x0a, 0x1c, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x52, 0x75, 0x6e,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x1d, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x69,
 	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x54,
-	0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x54, 0x75,
-	0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xce, 0x02, 0x0a, 0x14, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x24, 0x2e,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x52,
+	0x75, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x54, 0x75, 0x72, 0x6e, 0x12, 0x23, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x75,
+	0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xce, 0x02, 0x0a, 0x14, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
 	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75,
-	0x72, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54,
-	0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x1d, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x61,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54,
-	0x75, 0x72, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x32, 0xac, 0x03, 0x0a, 0x17,
+	0x6e, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6e, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x2b, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01,
+	0x32, 0xa7, 0x06, 0x0a, 0x17, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x17,
+	0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12,
+	0x23, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x12, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
 	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x73, 0x75, 0x72,
-	0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72,
-	0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
-	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x62, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
-	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x61,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
-	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x24, 0x2e,
-	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x65,
 	0x72, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf2, 0x01, 0x0a, 0x16, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23,
-	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x1b, 0x52, 0x65, 0x61,
-	0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0x94, 0x03, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x12, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12,
-	0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdd, 0x03, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x5c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x69, 0x2e,
+	0x63, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7a, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2b, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x1d, 0x44,
+	0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb7, 0x03, 0x0a, 0x13, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x12, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61,
+	0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61,
+	0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x1e, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x2c, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf2, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x62, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x94, 0x03, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xb2, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdd, 0x03, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x69, 0x2e,
 	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x76,
 	0x69, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69, 0x73, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x2f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x69, 0x2f,
-	0x76, 0x31, 0x3b, 0x61, 0x69, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69, 0x73, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x2f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x69, 0x2f, 0x76,
+	0x31, 0x3b, 0x61, 0x69, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -6750,263 +8479,327 @@ func file_ai_v1_service_proto_rawDescGZIP() []byte {
 	return file_ai_v1_service_proto_rawDescData
 }
 
-var file_ai_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_ai_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_ai_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_ai_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
 var file_ai_v1_service_proto_goTypes = []any{
-	(Provider)(0),                                // 0: ai.v1.Provider
-	(CredentialStatus)(0),                        // 1: ai.v1.CredentialStatus
-	(AgentStatus)(0),                             // 2: ai.v1.AgentStatus
-	(AgentAuthState)(0),                          // 3: ai.v1.AgentAuthState
-	(AgentAuthReferenceType)(0),                  // 4: ai.v1.AgentAuthReferenceType
-	(ProviderGrantStatus)(0),                     // 5: ai.v1.ProviderGrantStatus
-	(AccessRequestStatus)(0),                     // 6: ai.v1.AccessRequestStatus
-	(AccessRequestRole)(0),                       // 7: ai.v1.AccessRequestRole
-	(AccessRequestDecision)(0),                   // 8: ai.v1.AccessRequestDecision
-	(CampaignDebugTurnStatus)(0),                 // 9: ai.v1.CampaignDebugTurnStatus
-	(CampaignDebugEntryKind)(0),                  // 10: ai.v1.CampaignDebugEntryKind
-	(*Credential)(nil),                           // 11: ai.v1.Credential
-	(*AgentAuthReference)(nil),                   // 12: ai.v1.AgentAuthReference
-	(*Agent)(nil),                                // 13: ai.v1.Agent
-	(*ProviderGrant)(nil),                        // 14: ai.v1.ProviderGrant
-	(*AccessRequest)(nil),                        // 15: ai.v1.AccessRequest
-	(*AuditEvent)(nil),                           // 16: ai.v1.AuditEvent
-	(*CreateCredentialRequest)(nil),              // 17: ai.v1.CreateCredentialRequest
-	(*CreateCredentialResponse)(nil),             // 18: ai.v1.CreateCredentialResponse
-	(*ListCredentialsRequest)(nil),               // 19: ai.v1.ListCredentialsRequest
-	(*ListCredentialsResponse)(nil),              // 20: ai.v1.ListCredentialsResponse
-	(*RevokeCredentialRequest)(nil),              // 21: ai.v1.RevokeCredentialRequest
-	(*RevokeCredentialResponse)(nil),             // 22: ai.v1.RevokeCredentialResponse
-	(*CreateAgentRequest)(nil),                   // 23: ai.v1.CreateAgentRequest
-	(*CreateAgentResponse)(nil),                  // 24: ai.v1.CreateAgentResponse
-	(*ListAgentsRequest)(nil),                    // 25: ai.v1.ListAgentsRequest
-	(*ListAgentsResponse)(nil),                   // 26: ai.v1.ListAgentsResponse
-	(*ListProviderModelsRequest)(nil),            // 27: ai.v1.ListProviderModelsRequest
-	(*ProviderModel)(nil),                        // 28: ai.v1.ProviderModel
-	(*ListProviderModelsResponse)(nil),           // 29: ai.v1.ListProviderModelsResponse
-	(*ListAccessibleAgentsRequest)(nil),          // 30: ai.v1.ListAccessibleAgentsRequest
-	(*ListAccessibleAgentsResponse)(nil),         // 31: ai.v1.ListAccessibleAgentsResponse
-	(*GetAccessibleAgentRequest)(nil),            // 32: ai.v1.GetAccessibleAgentRequest
-	(*GetAccessibleAgentResponse)(nil),           // 33: ai.v1.GetAccessibleAgentResponse
-	(*ValidateCampaignAgentBindingRequest)(nil),  // 34: ai.v1.ValidateCampaignAgentBindingRequest
-	(*ValidateCampaignAgentBindingResponse)(nil), // 35: ai.v1.ValidateCampaignAgentBindingResponse
-	(*UpdateAgentRequest)(nil),                   // 36: ai.v1.UpdateAgentRequest
-	(*UpdateAgentResponse)(nil),                  // 37: ai.v1.UpdateAgentResponse
-	(*DeleteAgentRequest)(nil),                   // 38: ai.v1.DeleteAgentRequest
-	(*DeleteAgentResponse)(nil),                  // 39: ai.v1.DeleteAgentResponse
-	(*Usage)(nil),                                // 40: ai.v1.Usage
-	(*InvokeAgentRequest)(nil),                   // 41: ai.v1.InvokeAgentRequest
-	(*InvokeAgentResponse)(nil),                  // 42: ai.v1.InvokeAgentResponse
-	(*RetrievedContext)(nil),                     // 43: ai.v1.RetrievedContext
-	(*PromptContextPolicy)(nil),                  // 44: ai.v1.PromptContextPolicy
-	(*PromptAugmentationDiagnostics)(nil),        // 45: ai.v1.PromptAugmentationDiagnostics
-	(*PromptDiagnostics)(nil),                    // 46: ai.v1.PromptDiagnostics
-	(*RunCampaignTurnRequest)(nil),               // 47: ai.v1.RunCampaignTurnRequest
-	(*RunCampaignTurnResponse)(nil),              // 48: ai.v1.RunCampaignTurnResponse
-	(*CampaignDebugEntry)(nil),                   // 49: ai.v1.CampaignDebugEntry
-	(*CampaignDebugTurn)(nil),                    // 50: ai.v1.CampaignDebugTurn
-	(*CampaignDebugTurnSummary)(nil),             // 51: ai.v1.CampaignDebugTurnSummary
-	(*ListCampaignDebugTurnsRequest)(nil),        // 52: ai.v1.ListCampaignDebugTurnsRequest
-	(*ListCampaignDebugTurnsResponse)(nil),       // 53: ai.v1.ListCampaignDebugTurnsResponse
-	(*GetCampaignDebugTurnRequest)(nil),          // 54: ai.v1.GetCampaignDebugTurnRequest
-	(*GetCampaignDebugTurnResponse)(nil),         // 55: ai.v1.GetCampaignDebugTurnResponse
-	(*SubscribeCampaignDebugUpdatesRequest)(nil), // 56: ai.v1.SubscribeCampaignDebugUpdatesRequest
-	(*CampaignDebugTurnUpdate)(nil),              // 57: ai.v1.CampaignDebugTurnUpdate
-	(*CampaignArtifact)(nil),                     // 58: ai.v1.CampaignArtifact
-	(*EnsureCampaignArtifactsRequest)(nil),       // 59: ai.v1.EnsureCampaignArtifactsRequest
-	(*EnsureCampaignArtifactsResponse)(nil),      // 60: ai.v1.EnsureCampaignArtifactsResponse
-	(*ListCampaignArtifactsRequest)(nil),         // 61: ai.v1.ListCampaignArtifactsRequest
-	(*ListCampaignArtifactsResponse)(nil),        // 62: ai.v1.ListCampaignArtifactsResponse
-	(*GetCampaignArtifactRequest)(nil),           // 63: ai.v1.GetCampaignArtifactRequest
-	(*GetCampaignArtifactResponse)(nil),          // 64: ai.v1.GetCampaignArtifactResponse
-	(*UpsertCampaignArtifactRequest)(nil),        // 65: ai.v1.UpsertCampaignArtifactRequest
-	(*UpsertCampaignArtifactResponse)(nil),       // 66: ai.v1.UpsertCampaignArtifactResponse
-	(*SystemReferenceDocument)(nil),              // 67: ai.v1.SystemReferenceDocument
-	(*SystemReferenceDocumentSummary)(nil),       // 68: ai.v1.SystemReferenceDocumentSummary
-	(*SearchSystemReferenceRequest)(nil),         // 69: ai.v1.SearchSystemReferenceRequest
-	(*SearchSystemReferenceResponse)(nil),        // 70: ai.v1.SearchSystemReferenceResponse
-	(*ReadSystemReferenceDocumentRequest)(nil),   // 71: ai.v1.ReadSystemReferenceDocumentRequest
-	(*ReadSystemReferenceDocumentResponse)(nil),  // 72: ai.v1.ReadSystemReferenceDocumentResponse
-	(*StartProviderConnectRequest)(nil),          // 73: ai.v1.StartProviderConnectRequest
-	(*StartProviderConnectResponse)(nil),         // 74: ai.v1.StartProviderConnectResponse
-	(*FinishProviderConnectRequest)(nil),         // 75: ai.v1.FinishProviderConnectRequest
-	(*FinishProviderConnectResponse)(nil),        // 76: ai.v1.FinishProviderConnectResponse
-	(*ListProviderGrantsRequest)(nil),            // 77: ai.v1.ListProviderGrantsRequest
-	(*ListProviderGrantsResponse)(nil),           // 78: ai.v1.ListProviderGrantsResponse
-	(*RevokeProviderGrantRequest)(nil),           // 79: ai.v1.RevokeProviderGrantRequest
-	(*RevokeProviderGrantResponse)(nil),          // 80: ai.v1.RevokeProviderGrantResponse
-	(*CreateAccessRequestRequest)(nil),           // 81: ai.v1.CreateAccessRequestRequest
-	(*CreateAccessRequestResponse)(nil),          // 82: ai.v1.CreateAccessRequestResponse
-	(*ListAccessRequestsRequest)(nil),            // 83: ai.v1.ListAccessRequestsRequest
-	(*ListAccessRequestsResponse)(nil),           // 84: ai.v1.ListAccessRequestsResponse
-	(*ListAuditEventsRequest)(nil),               // 85: ai.v1.ListAuditEventsRequest
-	(*ListAuditEventsResponse)(nil),              // 86: ai.v1.ListAuditEventsResponse
-	(*ReviewAccessRequestRequest)(nil),           // 87: ai.v1.ReviewAccessRequestRequest
-	(*ReviewAccessRequestResponse)(nil),          // 88: ai.v1.ReviewAccessRequestResponse
-	(*RevokeAccessRequestRequest)(nil),           // 89: ai.v1.RevokeAccessRequestRequest
-	(*RevokeAccessRequestResponse)(nil),          // 90: ai.v1.RevokeAccessRequestResponse
-	(*timestamppb.Timestamp)(nil),                // 91: google.protobuf.Timestamp
+	(Provider)(0),                                   // 0: ai.v1.Provider
+	(CredentialStatus)(0),                           // 1: ai.v1.CredentialStatus
+	(AgentStatus)(0),                                // 2: ai.v1.AgentStatus
+	(AgentAuthState)(0),                             // 3: ai.v1.AgentAuthState
+	(AgentAuthReferenceType)(0),                     // 4: ai.v1.AgentAuthReferenceType
+	(ProviderGrantStatus)(0),                        // 5: ai.v1.ProviderGrantStatus
+	(AccessRequestStatus)(0),                        // 6: ai.v1.AccessRequestStatus
+	(AccessRequestRole)(0),                          // 7: ai.v1.AccessRequestRole
+	(AccessRequestDecision)(0),                      // 8: ai.v1.AccessRequestDecision
+	(CampaignDebugTurnStatus)(0),                    // 9: ai.v1.CampaignDebugTurnStatus
+	(CampaignDebugEntryKind)(0),                     // 10: ai.v1.CampaignDebugEntryKind
+	(CampaignArtifactAuthorKind)(0),                 // 11: ai.v1.CampaignArtifactAuthorKind
+	(CampaignArtifactDiffOp)(0),                     // 12: ai.v1.CampaignArtifactDiffOp
+	(SessionRecapDraftStatus)(0),                    // 13: ai.v1.SessionRecapDraftStatus
+	(*Credential)(nil),                              // 14: ai.v1.Credential
+	(*AgentAuthReference)(nil),                      // 15: ai.v1.AgentAuthReference
+	(*Agent)(nil),                                   // 16: ai.v1.Agent
+	(*ProviderGrant)(nil),                           // 17: ai.v1.ProviderGrant
+	(*AccessRequest)(nil),                           // 18: ai.v1.AccessRequest
+	(*AuditEvent)(nil),                              // 19: ai.v1.AuditEvent
+	(*CreateCredentialRequest)(nil),                 // 20: ai.v1.CreateCredentialRequest
+	(*CreateCredentialResponse)(nil),                // 21: ai.v1.CreateCredentialResponse
+	(*ListCredentialsRequest)(nil),                  // 22: ai.v1.ListCredentialsRequest
+	(*ListCredentialsResponse)(nil),                 // 23: ai.v1.ListCredentialsResponse
+	(*RevokeCredentialRequest)(nil),                 // 24: ai.v1.RevokeCredentialRequest
+	(*RevokeCredentialResponse)(nil),                // 25: ai.v1.RevokeCredentialResponse
+	(*CreateAgentRequest)(nil),                      // 26: ai.v1.CreateAgentRequest
+	(*CreateAgentResponse)(nil),                     // 27: ai.v1.CreateAgentResponse
+	(*ListAgentsRequest)(nil),                       // 28: ai.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),                      // 29: ai.v1.ListAgentsResponse
+	(*ListProviderModelsRequest)(nil),               // 30: ai.v1.ListProviderModelsRequest
+	(*ProviderModel)(nil),                           // 31: ai.v1.ProviderModel
+	(*ListProviderModelsResponse)(nil),              // 32: ai.v1.ListProviderModelsResponse
+	(*ListAccessibleAgentsRequest)(nil),             // 33: ai.v1.ListAccessibleAgentsRequest
+	(*ListAccessibleAgentsResponse)(nil),            // 34: ai.v1.ListAccessibleAgentsResponse
+	(*GetAccessibleAgentRequest)(nil),               // 35: ai.v1.GetAccessibleAgentRequest
+	(*GetAccessibleAgentResponse)(nil),              // 36: ai.v1.GetAccessibleAgentResponse
+	(*ValidateCampaignAgentBindingRequest)(nil),     // 37: ai.v1.ValidateCampaignAgentBindingRequest
+	(*ValidateCampaignAgentBindingResponse)(nil),    // 38: ai.v1.ValidateCampaignAgentBindingResponse
+	(*UpdateAgentRequest)(nil),                      // 39: ai.v1.UpdateAgentRequest
+	(*UpdateAgentResponse)(nil),                     // 40: ai.v1.UpdateAgentResponse
+	(*DeleteAgentRequest)(nil),                      // 41: ai.v1.DeleteAgentRequest
+	(*DeleteAgentResponse)(nil),                     // 42: ai.v1.DeleteAgentResponse
+	(*Usage)(nil),                                   // 43: ai.v1.Usage
+	(*InvokeAgentRequest)(nil),                      // 44: ai.v1.InvokeAgentRequest
+	(*InvokeAgentResponse)(nil),                     // 45: ai.v1.InvokeAgentResponse
+	(*RetrievedContext)(nil),                        // 46: ai.v1.RetrievedContext
+	(*PromptContextPolicy)(nil),                     // 47: ai.v1.PromptContextPolicy
+	(*PromptAugmentationDiagnostics)(nil),           // 48: ai.v1.PromptAugmentationDiagnostics
+	(*PromptDiagnostics)(nil),                       // 49: ai.v1.PromptDiagnostics
+	(*RunCampaignTurnRequest)(nil),                  // 50: ai.v1.RunCampaignTurnRequest
+	(*RunCampaignTurnResponse)(nil),                 // 51: ai.v1.RunCampaignTurnResponse
+	(*RunCampaignPlayerTurnRequest)(nil),            // 52: ai.v1.RunCampaignPlayerTurnRequest
+	(*RunCampaignPlayerTurnResponse)(nil),           // 53: ai.v1.RunCampaignPlayerTurnResponse
+	(*CampaignDebugEntry)(nil),                      // 54: ai.v1.CampaignDebugEntry
+	(*CampaignDebugTurn)(nil),                       // 55: ai.v1.CampaignDebugTurn
+	(*CampaignDebugTurnSummary)(nil),                // 56: ai.v1.CampaignDebugTurnSummary
+	(*ListCampaignDebugTurnsRequest)(nil),           // 57: ai.v1.ListCampaignDebugTurnsRequest
+	(*ListCampaignDebugTurnsResponse)(nil),          // 58: ai.v1.ListCampaignDebugTurnsResponse
+	(*GetCampaignDebugTurnRequest)(nil),             // 59: ai.v1.GetCampaignDebugTurnRequest
+	(*GetCampaignDebugTurnResponse)(nil),            // 60: ai.v1.GetCampaignDebugTurnResponse
+	(*SubscribeCampaignDebugUpdatesRequest)(nil),    // 61: ai.v1.SubscribeCampaignDebugUpdatesRequest
+	(*CampaignDebugTurnUpdate)(nil),                 // 62: ai.v1.CampaignDebugTurnUpdate
+	(*CampaignArtifact)(nil),                        // 63: ai.v1.CampaignArtifact
+	(*EnsureCampaignArtifactsRequest)(nil),          // 64: ai.v1.EnsureCampaignArtifactsRequest
+	(*EnsureCampaignArtifactsResponse)(nil),         // 65: ai.v1.EnsureCampaignArtifactsResponse
+	(*ListCampaignArtifactsRequest)(nil),            // 66: ai.v1.ListCampaignArtifactsRequest
+	(*ListCampaignArtifactsResponse)(nil),           // 67: ai.v1.ListCampaignArtifactsResponse
+	(*GetCampaignArtifactRequest)(nil),              // 68: ai.v1.GetCampaignArtifactRequest
+	(*GetCampaignArtifactResponse)(nil),             // 69: ai.v1.GetCampaignArtifactResponse
+	(*UpsertCampaignArtifactRequest)(nil),           // 70: ai.v1.UpsertCampaignArtifactRequest
+	(*UpsertCampaignArtifactResponse)(nil),          // 71: ai.v1.UpsertCampaignArtifactResponse
+	(*CampaignArtifactRevision)(nil),                // 72: ai.v1.CampaignArtifactRevision
+	(*ListCampaignArtifactRevisionsRequest)(nil),    // 73: ai.v1.ListCampaignArtifactRevisionsRequest
+	(*ListCampaignArtifactRevisionsResponse)(nil),   // 74: ai.v1.ListCampaignArtifactRevisionsResponse
+	(*DiffCampaignArtifactRevisionsRequest)(nil),    // 75: ai.v1.DiffCampaignArtifactRevisionsRequest
+	(*CampaignArtifactDiffLine)(nil),                // 76: ai.v1.CampaignArtifactDiffLine
+	(*DiffCampaignArtifactRevisionsResponse)(nil),   // 77: ai.v1.DiffCampaignArtifactRevisionsResponse
+	(*RestoreCampaignArtifactRevisionRequest)(nil),  // 78: ai.v1.RestoreCampaignArtifactRevisionRequest
+	(*RestoreCampaignArtifactRevisionResponse)(nil), // 79: ai.v1.RestoreCampaignArtifactRevisionResponse
+	(*SessionRecapDraft)(nil),                       // 80: ai.v1.SessionRecapDraft
+	(*DraftSessionRecapRequest)(nil),                // 81: ai.v1.DraftSessionRecapRequest
+	(*DraftSessionRecapResponse)(nil),               // 82: ai.v1.DraftSessionRecapResponse
+	(*GetSessionRecapDraftRequest)(nil),             // 83: ai.v1.GetSessionRecapDraftRequest
+	(*GetSessionRecapDraftResponse)(nil),            // 84: ai.v1.GetSessionRecapDraftResponse
+	(*UpdateSessionRecapDraftRequest)(nil),          // 85: ai.v1.UpdateSessionRecapDraftRequest
+	(*UpdateSessionRecapDraftResponse)(nil),         // 86: ai.v1.UpdateSessionRecapDraftResponse
+	(*MarkSessionRecapDraftPublishedRequest)(nil),   // 87: ai.v1.MarkSessionRecapDraftPublishedRequest
+	(*MarkSessionRecapDraftPublishedResponse)(nil),  // 88: ai.v1.MarkSessionRecapDraftPublishedResponse
+	(*SystemReferenceDocument)(nil),                 // 89: ai.v1.SystemReferenceDocument
+	(*SystemReferenceDocumentSummary)(nil),          // 90: ai.v1.SystemReferenceDocumentSummary
+	(*SearchSystemReferenceRequest)(nil),            // 91: ai.v1.SearchSystemReferenceRequest
+	(*SearchSystemReferenceResponse)(nil),           // 92: ai.v1.SearchSystemReferenceResponse
+	(*ReadSystemReferenceDocumentRequest)(nil),      // 93: ai.v1.ReadSystemReferenceDocumentRequest
+	(*ReadSystemReferenceDocumentResponse)(nil),     // 94: ai.v1.ReadSystemReferenceDocumentResponse
+	(*StartProviderConnectRequest)(nil),             // 95: ai.v1.StartProviderConnectRequest
+	(*StartProviderConnectResponse)(nil),            // 96: ai.v1.StartProviderConnectResponse
+	(*FinishProviderConnectRequest)(nil),            // 97: ai.v1.FinishProviderConnectRequest
+	(*FinishProviderConnectResponse)(nil),           // 98: ai.v1.FinishProviderConnectResponse
+	(*ListProviderGrantsRequest)(nil),               // 99: ai.v1.ListProviderGrantsRequest
+	(*ListProviderGrantsResponse)(nil),              // 100: ai.v1.ListProviderGrantsResponse
+	(*RevokeProviderGrantRequest)(nil),              // 101: ai.v1.RevokeProviderGrantRequest
+	(*RevokeProviderGrantResponse)(nil),             // 102: ai.v1.RevokeProviderGrantResponse
+	(*CreateAccessRequestRequest)(nil),              // 103: ai.v1.CreateAccessRequestRequest
+	(*CreateAccessRequestResponse)(nil),             // 104: ai.v1.CreateAccessRequestResponse
+	(*ListAccessRequestsRequest)(nil),               // 105: ai.v1.ListAccessRequestsRequest
+	(*ListAccessRequestsResponse)(nil),              // 106: ai.v1.ListAccessRequestsResponse
+	(*ListAuditEventsRequest)(nil),                  // 107: ai.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),                 // 108: ai.v1.ListAuditEventsResponse
+	(*ReviewAccessRequestRequest)(nil),              // 109: ai.v1.ReviewAccessRequestRequest
+	(*ReviewAccessRequestResponse)(nil),             // 110: ai.v1.ReviewAccessRequestResponse
+	(*RevokeAccessRequestRequest)(nil),              // 111: ai.v1.RevokeAccessRequestRequest
+	(*RevokeAccessRequestResponse)(nil),             // 112: ai.v1.RevokeAccessRequestResponse
+	(*ExportUserDataRequest)(nil),                   // 113: ai.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),                  // 114: ai.v1.ExportUserDataResponse
+	(*DeleteUserDataRequest)(nil),                   // 115: ai.v1.DeleteUserDataRequest
+	(*DeleteUserDataResponse)(nil),                  // 116: ai.v1.DeleteUserDataResponse
+	(*timestamppb.Timestamp)(nil),                   // 117: google.protobuf.Timestamp
 }
 var file_ai_v1_service_proto_depIdxs = []int32{
 	0,   // 0: ai.v1.Credential.provider:type_name -> ai.v1.Provider
 	1,   // 1: ai.v1.Credential.status:type_name -> ai.v1.CredentialStatus
-	91,  // 2: ai.v1.Credential.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 3: ai.v1.Credential.updated_at:type_name -> google.protobuf.Timestamp
-	91,  // 4: ai.v1.Credential.revoked_at:type_name -> google.protobuf.Timestamp
+	117, // 2: ai.v1.Credential.created_at:type_name -> google.protobuf.Timestamp
+	117, // 3: ai.v1.Credential.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 4: ai.v1.Credential.revoked_at:type_name -> google.protobuf.Timestamp
 	4,   // 5: ai.v1.AgentAuthReference.type:type_name -> ai.v1.AgentAuthReferenceType
 	0,   // 6: ai.v1.Agent.provider:type_name -> ai.v1.Provider
-	12,  // 7: ai.v1.Agent.auth_reference:type_name -> ai.v1.AgentAuthReference
+	15,  // 7: ai.v1.Agent.auth_reference:type_name -> ai.v1.AgentAuthReference
 	2,   // 8: ai.v1.Agent.status:type_name -> ai.v1.AgentStatus
-	91,  // 9: ai.v1.Agent.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 10: ai.v1.Agent.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 9: ai.v1.Agent.created_at:type_name -> google.protobuf.Timestamp
+	117, // 10: ai.v1.Agent.updated_at:type_name -> google.protobuf.Timestamp
 	3,   // 11: ai.v1.Agent.auth_state:type_name -> ai.v1.AgentAuthState
 	0,   // 12: ai.v1.ProviderGrant.provider:type_name -> ai.v1.Provider
 	5,   // 13: ai.v1.ProviderGrant.status:type_name -> ai.v1.ProviderGrantStatus
-	91,  // 14: ai.v1.ProviderGrant.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 15: ai.v1.ProviderGrant.updated_at:type_name -> google.protobuf.Timestamp
-	91,  // 16: ai.v1.ProviderGrant.revoked_at:type_name -> google.protobuf.Timestamp
-	91,  // 17: ai.v1.ProviderGrant.expires_at:type_name -> google.protobuf.Timestamp
-	91,  // 18: ai.v1.ProviderGrant.last_refreshed_at:type_name -> google.protobuf.Timestamp
+	117, // 14: ai.v1.ProviderGrant.created_at:type_name -> google.protobuf.Timestamp
+	117, // 15: ai.v1.ProviderGrant.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 16: ai.v1.ProviderGrant.revoked_at:type_name -> google.protobuf.Timestamp
+	117, // 17: ai.v1.ProviderGrant.expires_at:type_name -> google.protobuf.Timestamp
+	117, // 18: ai.v1.ProviderGrant.last_refreshed_at:type_name -> google.protobuf.Timestamp
 	6,   // 19: ai.v1.AccessRequest.status:type_name -> ai.v1.AccessRequestStatus
-	91,  // 20: ai.v1.AccessRequest.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 21: ai.v1.AccessRequest.updated_at:type_name -> google.protobuf.Timestamp
-	91,  // 22: ai.v1.AccessRequest.reviewed_at:type_name -> google.protobuf.Timestamp
-	91,  // 23: ai.v1.AccessRequest.revoked_at:type_name -> google.protobuf.Timestamp
-	91,  // 24: ai.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	117, // 20: ai.v1.AccessRequest.created_at:type_name -> google.protobuf.Timestamp
+	117, // 21: ai.v1.AccessRequest.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 22: ai.v1.AccessRequest.reviewed_at:type_name -> google.protobuf.Timestamp
+	117, // 23: ai.v1.AccessRequest.revoked_at:type_name -> google.protobuf.Timestamp
+	117, // 24: ai.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
 	0,   // 25: ai.v1.CreateCredentialRequest.provider:type_name -> ai.v1.Provider
-	11,  // 26: ai.v1.CreateCredentialResponse.credential:type_name -> ai.v1.Credential
-	11,  // 27: ai.v1.ListCredentialsResponse.credentials:type_name -> ai.v1.Credential
-	11,  // 28: ai.v1.RevokeCredentialResponse.credential:type_name -> ai.v1.Credential
+	14,  // 26: ai.v1.CreateCredentialResponse.credential:type_name -> ai.v1.Credential
+	14,  // 27: ai.v1.ListCredentialsResponse.credentials:type_name -> ai.v1.Credential
+	14,  // 28: ai.v1.RevokeCredentialResponse.credential:type_name -> ai.v1.Credential
 	0,   // 29: ai.v1.CreateAgentRequest.provider:type_name -> ai.v1.Provider
-	12,  // 30: ai.v1.CreateAgentRequest.auth_reference:type_name -> ai.v1.AgentAuthReference
-	13,  // 31: ai.v1.CreateAgentResponse.agent:type_name -> ai.v1.Agent
-	13,  // 32: ai.v1.ListAgentsResponse.agents:type_name -> ai.v1.Agent
+	15,  // 30: ai.v1.CreateAgentRequest.auth_reference:type_name -> ai.v1.AgentAuthReference
+	16,  // 31: ai.v1.CreateAgentResponse.agent:type_name -> ai.v1.Agent
+	16,  // 32: ai.v1.ListAgentsResponse.agents:type_name -> ai.v1.Agent
 	0,   // 33: ai.v1.ListProviderModelsRequest.provider:type_name -> ai.v1.Provider
-	12,  // 34: ai.v1.ListProviderModelsRequest.auth_reference:type_name -> ai.v1.AgentAuthReference
-	28,  // 35: ai.v1.ListProviderModelsResponse.models:type_name -> ai.v1.ProviderModel
-	13,  // 36: ai.v1.ListAccessibleAgentsResponse.agents:type_name -> ai.v1.Agent
-	13,  // 37: ai.v1.GetAccessibleAgentResponse.agent:type_name -> ai.v1.Agent
-	13,  // 38: ai.v1.ValidateCampaignAgentBindingResponse.agent:type_name -> ai.v1.Agent
-	12,  // 39: ai.v1.UpdateAgentRequest.auth_reference:type_name -> ai.v1.AgentAuthReference
-	13,  // 40: ai.v1.UpdateAgentResponse.agent:type_name -> ai.v1.Agent
+	15,  // 34: ai.v1.ListProviderModelsRequest.auth_reference:type_name -> ai.v1.AgentAuthReference
+	31,  // 35: ai.v1.ListProviderModelsResponse.models:type_name -> ai.v1.ProviderModel
+	16,  // 36: ai.v1.ListAccessibleAgentsResponse.agents:type_name -> ai.v1.Agent
+	16,  // 37: ai.v1.GetAccessibleAgentResponse.agent:type_name -> ai.v1.Agent
+	16,  // 38: ai.v1.ValidateCampaignAgentBindingResponse.agent:type_name -> ai.v1.Agent
+	15,  // 39: ai.v1.UpdateAgentRequest.auth_reference:type_name -> ai.v1.AgentAuthReference
+	16,  // 40: ai.v1.UpdateAgentResponse.agent:type_name -> ai.v1.Agent
 	0,   // 41: ai.v1.InvokeAgentResponse.provider:type_name -> ai.v1.Provider
-	40,  // 42: ai.v1.InvokeAgentResponse.usage:type_name -> ai.v1.Usage
-	44,  // 43: ai.v1.PromptDiagnostics.context_policy:type_name -> ai.v1.PromptContextPolicy
-	45,  // 44: ai.v1.PromptDiagnostics.augmentation:type_name -> ai.v1.PromptAugmentationDiagnostics
+	43,  // 42: ai.v1.InvokeAgentResponse.usage:type_name -> ai.v1.Usage
+	47,  // 43: ai.v1.PromptDiagnostics.context_policy:type_name -> ai.v1.PromptContextPolicy
+	48,  // 44: ai.v1.PromptDiagnostics.augmentation:type_name -> ai.v1.PromptAugmentationDiagnostics
 	0,   // 45: ai.v1.RunCampaignTurnResponse.provider:type_name -> ai.v1.Provider
-	40,  // 46: ai.v1.RunCampaignTurnResponse.usage:type_name -> ai.v1.Usage
-	46,  // 47: ai.v1.RunCampaignTurnResponse.prompt_diagnostics:type_name -> ai.v1.PromptDiagnostics
-	43,  // 48: ai.v1.RunCampaignTurnResponse.retrieved_contexts:type_name -> ai.v1.RetrievedContext
-	10,  // 49: ai.v1.CampaignDebugEntry.kind:type_name -> ai.v1.CampaignDebugEntryKind
-	91,  // 50: ai.v1.CampaignDebugEntry.created_at:type_name -> google.protobuf.Timestamp
-	40,  // 51: ai.v1.CampaignDebugEntry.usage:type_name -> ai.v1.Usage
-	0,   // 52: ai.v1.CampaignDebugTurn.provider:type_name -> ai.v1.Provider
-	9,   // 53: ai.v1.CampaignDebugTurn.status:type_name -> ai.v1.CampaignDebugTurnStatus
-	40,  // 54: ai.v1.CampaignDebugTurn.usage:type_name -> ai.v1.Usage
-	91,  // 55: ai.v1.CampaignDebugTurn.started_at:type_name -> google.protobuf.Timestamp
-	91,  // 56: ai.v1.CampaignDebugTurn.updated_at:type_name -> google.protobuf.Timestamp
-	91,  // 57: ai.v1.CampaignDebugTurn.completed_at:type_name -> google.protobuf.Timestamp
-	49,  // 58: ai.v1.CampaignDebugTurn.entries:type_name -> ai.v1.CampaignDebugEntry
-	0,   // 59: ai.v1.CampaignDebugTurnSummary.provider:type_name -> ai.v1.Provider
-	9,   // 60: ai.v1.CampaignDebugTurnSummary.status:type_name -> ai.v1.CampaignDebugTurnStatus
-	40,  // 61: ai.v1.CampaignDebugTurnSummary.usage:type_name -> ai.v1.Usage
-	91,  // 62: ai.v1.CampaignDebugTurnSummary.started_at:type_name -> google.protobuf.Timestamp
-	91,  // 63: ai.v1.CampaignDebugTurnSummary.updated_at:type_name -> google.protobuf.Timestamp
-	91,  // 64: ai.v1.CampaignDebugTurnSummary.completed_at:type_name -> google.protobuf.Timestamp
-	51,  // 65: ai.v1.ListCampaignDebugTurnsResponse.turns:type_name -> ai.v1.CampaignDebugTurnSummary
-	50,  // 66: ai.v1.GetCampaignDebugTurnResponse.turn:type_name -> ai.v1.CampaignDebugTurn
-	51,  // 67: ai.v1.CampaignDebugTurnUpdate.turn:type_name -> ai.v1.CampaignDebugTurnSummary
-	49,  // 68: ai.v1.CampaignDebugTurnUpdate.appended_entries:type_name -> ai.v1.CampaignDebugEntry
-	91,  // 69: ai.v1.CampaignArtifact.created_at:type_name -> google.protobuf.Timestamp
-	91,  // 70: ai.v1.CampaignArtifact.updated_at:type_name -> google.protobuf.Timestamp
-	58,  // 71: ai.v1.EnsureCampaignArtifactsResponse.artifacts:type_name -> ai.v1.CampaignArtifact
-	58,  // 72: ai.v1.ListCampaignArtifactsResponse.artifacts:type_name -> ai.v1.CampaignArtifact
-	58,  // 73: ai.v1.GetCampaignArtifactResponse.artifact:type_name -> ai.v1.CampaignArtifact
-	58,  // 74: ai.v1.UpsertCampaignArtifactResponse.artifact:type_name -> ai.v1.CampaignArtifact
-	68,  // 75: ai.v1.SearchSystemReferenceResponse.results:type_name -> ai.v1.SystemReferenceDocumentSummary
-	67,  // 76: ai.v1.ReadSystemReferenceDocumentResponse.document:type_name -> ai.v1.SystemReferenceDocument
-	0,   // 77: ai.v1.StartProviderConnectRequest.provider:type_name -> ai.v1.Provider
-	91,  // 78: ai.v1.StartProviderConnectResponse.expires_at:type_name -> google.protobuf.Timestamp
-	14,  // 79: ai.v1.FinishProviderConnectResponse.provider_grant:type_name -> ai.v1.ProviderGrant
-	0,   // 80: ai.v1.ListProviderGrantsRequest.provider:type_name -> ai.v1.Provider
-	5,   // 81: ai.v1.ListProviderGrantsRequest.status:type_name -> ai.v1.ProviderGrantStatus
-	14,  // 82: ai.v1.ListProviderGrantsResponse.provider_grants:type_name -> ai.v1.ProviderGrant
-	14,  // 83: ai.v1.RevokeProviderGrantResponse.provider_grant:type_name -> ai.v1.ProviderGrant
-	15,  // 84: ai.v1.CreateAccessRequestResponse.access_request:type_name -> ai.v1.AccessRequest
-	7,   // 85: ai.v1.ListAccessRequestsRequest.role:type_name -> ai.v1.AccessRequestRole
-	15,  // 86: ai.v1.ListAccessRequestsResponse.access_requests:type_name -> ai.v1.AccessRequest
-	91,  // 87: ai.v1.ListAuditEventsRequest.created_after:type_name -> google.protobuf.Timestamp
-	91,  // 88: ai.v1.ListAuditEventsRequest.created_before:type_name -> google.protobuf.Timestamp
-	16,  // 89: ai.v1.ListAuditEventsResponse.audit_events:type_name -> ai.v1.AuditEvent
-	8,   // 90: ai.v1.ReviewAccessRequestRequest.decision:type_name -> ai.v1.AccessRequestDecision
-	15,  // 91: ai.v1.ReviewAccessRequestResponse.access_request:type_name -> ai.v1.AccessRequest
-	15,  // 92: ai.v1.RevokeAccessRequestResponse.access_request:type_name -> ai.v1.AccessRequest
-	17,  // 93: ai.v1.CredentialService.CreateCredential:input_type -> ai.v1.CreateCredentialRequest
-	19,  // 94: ai.v1.CredentialService.ListCredentials:input_type -> ai.v1.ListCredentialsRequest
-	21,  // 95: ai.v1.CredentialService.RevokeCredential:input_type -> ai.v1.RevokeCredentialRequest
-	23,  // 96: ai.v1.AgentService.CreateAgent:input_type -> ai.v1.CreateAgentRequest
-	25,  // 97: ai.v1.AgentService.ListAgents:input_type -> ai.v1.ListAgentsRequest
-	27,  // 98: ai.v1.AgentService.ListProviderModels:input_type -> ai.v1.ListProviderModelsRequest
-	30,  // 99: ai.v1.AgentService.ListAccessibleAgents:input_type -> ai.v1.ListAccessibleAgentsRequest
-	32,  // 100: ai.v1.AgentService.GetAccessibleAgent:input_type -> ai.v1.GetAccessibleAgentRequest
-	34,  // 101: ai.v1.AgentService.ValidateCampaignAgentBinding:input_type -> ai.v1.ValidateCampaignAgentBindingRequest
-	36,  // 102: ai.v1.AgentService.UpdateAgent:input_type -> ai.v1.UpdateAgentRequest
-	38,  // 103: ai.v1.AgentService.DeleteAgent:input_type -> ai.v1.DeleteAgentRequest
-	41,  // 104: ai.v1.InvocationService.InvokeAgent:input_type -> ai.v1.InvokeAgentRequest
-	47,  // 105: ai.v1.CampaignOrchestrationService.RunCampaignTurn:input_type -> ai.v1.RunCampaignTurnRequest
-	52,  // 106: ai.v1.CampaignDebugService.ListCampaignDebugTurns:input_type -> ai.v1.ListCampaignDebugTurnsRequest
-	54,  // 107: ai.v1.CampaignDebugService.GetCampaignDebugTurn:input_type -> ai.v1.GetCampaignDebugTurnRequest
-	56,  // 108: ai.v1.CampaignDebugService.SubscribeCampaignDebugUpdates:input_type -> ai.v1.SubscribeCampaignDebugUpdatesRequest
-	59,  // 109: ai.v1.CampaignArtifactService.EnsureCampaignArtifacts:input_type -> ai.v1.EnsureCampaignArtifactsRequest
-	61,  // 110: ai.v1.CampaignArtifactService.ListCampaignArtifacts:input_type -> ai.v1.ListCampaignArtifactsRequest
-	63,  // 111: ai.v1.CampaignArtifactService.GetCampaignArtifact:input_type -> ai.v1.GetCampaignArtifactRequest
-	65,  // 112: ai.v1.CampaignArtifactService.UpsertCampaignArtifact:input_type -> ai.v1.UpsertCampaignArtifactRequest
-	69,  // 113: ai.v1.SystemReferenceService.SearchSystemReference:input_type -> ai.v1.SearchSystemReferenceRequest
-	71,  // 114: ai.v1.SystemReferenceService.ReadSystemReferenceDocument:input_type -> ai.v1.ReadSystemReferenceDocumentRequest
-	73,  // 115: ai.v1.ProviderGrantService.StartProviderConnect:input_type -> ai.v1.StartProviderConnectRequest
-	75,  // 116: ai.v1.ProviderGrantService.FinishProviderConnect:input_type -> ai.v1.FinishProviderConnectRequest
-	77,  // 117: ai.v1.ProviderGrantService.ListProviderGrants:input_type -> ai.v1.ListProviderGrantsRequest
-	79,  // 118: ai.v1.ProviderGrantService.RevokeProviderGrant:input_type -> ai.v1.RevokeProviderGrantRequest
-	81,  // 119: ai.v1.AccessRequestService.CreateAccessRequest:input_type -> ai.v1.CreateAccessRequestRequest
-	83,  // 120: ai.v1.AccessRequestService.ListAccessRequests:input_type -> ai.v1.ListAccessRequestsRequest
-	85,  // 121: ai.v1.AccessRequestService.ListAuditEvents:input_type -> ai.v1.ListAuditEventsRequest
-	87,  // 122: ai.v1.AccessRequestService.ReviewAccessRequest:input_type -> ai.v1.ReviewAccessRequestRequest
-	89,  // 123: ai.v1.AccessRequestService.RevokeAccessRequest:input_type -> ai.v1.RevokeAccessRequestRequest
-	18,  // 124: ai.v1.CredentialService.CreateCredential:output_type -> ai.v1.CreateCredentialResponse
-	20,  // 125: ai.v1.CredentialService.ListCredentials:output_type -> ai.v1.ListCredentialsResponse
-	22,  // 126: ai.v1.CredentialService.RevokeCredential:output_type -> ai.v1.RevokeCredentialResponse
-	24,  // 127: ai.v1.AgentService.CreateAgent:output_type -> ai.v1.CreateAgentResponse
-	26,  // 128: ai.v1.AgentService.ListAgents:output_type -> ai.v1.ListAgentsResponse
-	29,  // 129: ai.v1.AgentService.ListProviderModels:output_type -> ai.v1.ListProviderModelsResponse
-	31,  // 130: ai.v1.AgentService.ListAccessibleAgents:output_type -> ai.v1.ListAccessibleAgentsResponse
-	33,  // 131: ai.v1.AgentService.GetAccessibleAgent:output_type -> ai.v1.GetAccessibleAgentResponse
-	35,  // 132: ai.v1.AgentService.ValidateCampaignAgentBinding:output_type -> ai.v1.ValidateCampaignAgentBindingResponse
-	37,  // 133: ai.v1.AgentService.UpdateAgent:output_type -> ai.v1.UpdateAgentResponse
-	39,  // 134: ai.v1.AgentService.DeleteAgent:output_type -> ai.v1.DeleteAgentResponse
-	42,  // 135: ai.v1.InvocationService.InvokeAgent:output_type -> ai.v1.InvokeAgentResponse
-	48,  // 136: ai.v1.CampaignOrchestrationService.RunCampaignTurn:output_type -> ai.v1.RunCampaignTurnResponse
-	53,  // 137: ai.v1.CampaignDebugService.ListCampaignDebugTurns:output_type -> ai.v1.ListCampaignDebugTurnsResponse
-	55,  // 138: ai.v1.CampaignDebugService.GetCampaignDebugTurn:output_type -> ai.v1.GetCampaignDebugTurnResponse
-	57,  // 139: ai.v1.CampaignDebugService.SubscribeCampaignDebugUpdates:output_type -> ai.v1.CampaignDebugTurnUpdate
-	60,  // 140: ai.v1.CampaignArtifactService.EnsureCampaignArtifacts:output_type -> ai.v1.EnsureCampaignArtifactsResponse
-	62,  // 141: ai.v1.CampaignArtifactService.ListCampaignArtifacts:output_type -> ai.v1.ListCampaignArtifactsResponse
-	64,  // 142: ai.v1.CampaignArtifactService.GetCampaignArtifact:output_type -> ai.v1.GetCampaignArtifactResponse
-	66,  // 143: ai.v1.CampaignArtifactService.UpsertCampaignArtifact:output_type -> ai.v1.UpsertCampaignArtifactResponse
-	70,  // 144: ai.v1.SystemReferenceService.SearchSystemReference:output_type -> ai.v1.SearchSystemReferenceResponse
-	72,  // 145: ai.v1.SystemReferenceService.ReadSystemReferenceDocument:output_type -> ai.v1.ReadSystemReferenceDocumentResponse
-	74,  // 146: ai.v1.ProviderGrantService.StartProviderConnect:output_type -> ai.v1.StartProviderConnectResponse
-	76,  // 147: ai.v1.ProviderGrantService.FinishProviderConnect:output_type -> ai.v1.FinishProviderConnectResponse
-	78,  // 148: ai.v1.ProviderGrantService.ListProviderGrants:output_type -> ai.v1.ListProviderGrantsResponse
-	80,  // 149: ai.v1.ProviderGrantService.RevokeProviderGrant:output_type -> ai.v1.RevokeProviderGrantResponse
-	82,  // 150: ai.v1.AccessRequestService.CreateAccessRequest:output_type -> ai.v1.CreateAccessRequestResponse
-	84,  // 151: ai.v1.AccessRequestService.ListAccessRequests:output_type -> ai.v1.ListAccessRequestsResponse
-	86,  // 152: ai.v1.AccessRequestService.ListAuditEvents:output_type -> ai.v1.ListAuditEventsResponse
-	88,  // 153: ai.v1.AccessRequestService.ReviewAccessRequest:output_type -> ai.v1.ReviewAccessRequestResponse
-	90,  // 154: ai.v1.AccessRequestService.RevokeAccessRequest:output_type -> ai.v1.RevokeAccessRequestResponse
-	124, // [124:155] is the sub-list for method output_type
-	93,  // [93:124] is the sub-list for method input_type
-	93,  // [93:93] is the sub-list for extension type_name
-	93,  // [93:93] is the sub-list for extension extendee
-	0,   // [0:93] is the sub-list for field type_name
+	43,  // 46: ai.v1.RunCampaignTurnResponse.usage:type_name -> ai.v1.Usage
+	49,  // 47: ai.v1.RunCampaignTurnResponse.prompt_diagnostics:type_name -> ai.v1.PromptDiagnostics
+	46,  // 48: ai.v1.RunCampaignTurnResponse.retrieved_contexts:type_name -> ai.v1.RetrievedContext
+	0,   // 49: ai.v1.RunCampaignPlayerTurnResponse.provider:type_name -> ai.v1.Provider
+	43,  // 50: ai.v1.RunCampaignPlayerTurnResponse.usage:type_name -> ai.v1.Usage
+	10,  // 51: ai.v1.CampaignDebugEntry.kind:type_name -> ai.v1.CampaignDebugEntryKind
+	117, // 52: ai.v1.CampaignDebugEntry.created_at:type_name -> google.protobuf.Timestamp
+	43,  // 53: ai.v1.CampaignDebugEntry.usage:type_name -> ai.v1.Usage
+	0,   // 54: ai.v1.CampaignDebugTurn.provider:type_name -> ai.v1.Provider
+	9,   // 55: ai.v1.CampaignDebugTurn.status:type_name -> ai.v1.CampaignDebugTurnStatus
+	43,  // 56: ai.v1.CampaignDebugTurn.usage:type_name -> ai.v1.Usage
+	117, // 57: ai.v1.CampaignDebugTurn.started_at:type_name -> google.protobuf.Timestamp
+	117, // 58: ai.v1.CampaignDebugTurn.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 59: ai.v1.CampaignDebugTurn.completed_at:type_name -> google.protobuf.Timestamp
+	54,  // 60: ai.v1.CampaignDebugTurn.entries:type_name -> ai.v1.CampaignDebugEntry
+	0,   // 61: ai.v1.CampaignDebugTurnSummary.provider:type_name -> ai.v1.Provider
+	9,   // 62: ai.v1.CampaignDebugTurnSummary.status:type_name -> ai.v1.CampaignDebugTurnStatus
+	43,  // 63: ai.v1.CampaignDebugTurnSummary.usage:type_name -> ai.v1.Usage
+	117, // 64: ai.v1.CampaignDebugTurnSummary.started_at:type_name -> google.protobuf.Timestamp
+	117, // 65: ai.v1.CampaignDebugTurnSummary.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 66: ai.v1.CampaignDebugTurnSummary.completed_at:type_name -> google.protobuf.Timestamp
+	56,  // 67: ai.v1.ListCampaignDebugTurnsResponse.turns:type_name -> ai.v1.CampaignDebugTurnSummary
+	55,  // 68: ai.v1.GetCampaignDebugTurnResponse.turn:type_name -> ai.v1.CampaignDebugTurn
+	56,  // 69: ai.v1.CampaignDebugTurnUpdate.turn:type_name -> ai.v1.CampaignDebugTurnSummary
+	54,  // 70: ai.v1.CampaignDebugTurnUpdate.appended_entries:type_name -> ai.v1.CampaignDebugEntry
+	117, // 71: ai.v1.CampaignArtifact.created_at:type_name -> google.protobuf.Timestamp
+	117, // 72: ai.v1.CampaignArtifact.updated_at:type_name -> google.protobuf.Timestamp
+	63,  // 73: ai.v1.EnsureCampaignArtifactsResponse.artifacts:type_name -> ai.v1.CampaignArtifact
+	63,  // 74: ai.v1.ListCampaignArtifactsResponse.artifacts:type_name -> ai.v1.CampaignArtifact
+	63,  // 75: ai.v1.GetCampaignArtifactResponse.artifact:type_name -> ai.v1.CampaignArtifact
+	63,  // 76: ai.v1.UpsertCampaignArtifactResponse.artifact:type_name -> ai.v1.CampaignArtifact
+	11,  // 77: ai.v1.CampaignArtifactRevision.author_kind:type_name -> ai.v1.CampaignArtifactAuthorKind
+	117, // 78: ai.v1.CampaignArtifactRevision.created_at:type_name -> google.protobuf.Timestamp
+	72,  // 79: ai.v1.ListCampaignArtifactRevisionsResponse.revisions:type_name -> ai.v1.CampaignArtifactRevision
+	12,  // 80: ai.v1.CampaignArtifactDiffLine.op:type_name -> ai.v1.CampaignArtifactDiffOp
+	72,  // 81: ai.v1.DiffCampaignArtifactRevisionsResponse.from:type_name -> ai.v1.CampaignArtifactRevision
+	72,  // 82: ai.v1.DiffCampaignArtifactRevisionsResponse.to:type_name -> ai.v1.CampaignArtifactRevision
+	76,  // 83: ai.v1.DiffCampaignArtifactRevisionsResponse.lines:type_name -> ai.v1.CampaignArtifactDiffLine
+	63,  // 84: ai.v1.RestoreCampaignArtifactRevisionResponse.artifact:type_name -> ai.v1.CampaignArtifact
+	13,  // 85: ai.v1.SessionRecapDraft.status:type_name -> ai.v1.SessionRecapDraftStatus
+	117, // 86: ai.v1.SessionRecapDraft.created_at:type_name -> google.protobuf.Timestamp
+	117, // 87: ai.v1.SessionRecapDraft.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 88: ai.v1.SessionRecapDraft.published_at:type_name -> google.protobuf.Timestamp
+	80,  // 89: ai.v1.DraftSessionRecapResponse.draft:type_name -> ai.v1.SessionRecapDraft
+	80,  // 90: ai.v1.GetSessionRecapDraftResponse.draft:type_name -> ai.v1.SessionRecapDraft
+	80,  // 91: ai.v1.UpdateSessionRecapDraftResponse.draft:type_name -> ai.v1.SessionRecapDraft
+	80,  // 92: ai.v1.MarkSessionRecapDraftPublishedResponse.draft:type_name -> ai.v1.SessionRecapDraft
+	90,  // 93: ai.v1.SearchSystemReferenceResponse.results:type_name -> ai.v1.SystemReferenceDocumentSummary
+	89,  // 94: ai.v1.ReadSystemReferenceDocumentResponse.document:type_name -> ai.v1.SystemReferenceDocument
+	0,   // 95: ai.v1.StartProviderConnectRequest.provider:type_name -> ai.v1.Provider
+	117, // 96: ai.v1.StartProviderConnectResponse.expires_at:type_name -> google.protobuf.Timestamp
+	17,  // 97: ai.v1.FinishProviderConnectResponse.provider_grant:type_name -> ai.v1.ProviderGrant
+	0,   // 98: ai.v1.ListProviderGrantsRequest.provider:type_name -> ai.v1.Provider
+	5,   // 99: ai.v1.ListProviderGrantsRequest.status:type_name -> ai.v1.ProviderGrantStatus
+	17,  // 100: ai.v1.ListProviderGrantsResponse.provider_grants:type_name -> ai.v1.ProviderGrant
+	17,  // 101: ai.v1.RevokeProviderGrantResponse.provider_grant:type_name -> ai.v1.ProviderGrant
+	18,  // 102: ai.v1.CreateAccessRequestResponse.access_request:type_name -> ai.v1.AccessRequest
+	7,   // 103: ai.v1.ListAccessRequestsRequest.role:type_name -> ai.v1.AccessRequestRole
+	18,  // 104: ai.v1.ListAccessRequestsResponse.access_requests:type_name -> ai.v1.AccessRequest
+	117, // 105: ai.v1.ListAuditEventsRequest.created_after:type_name -> google.protobuf.Timestamp
+	117, // 106: ai.v1.ListAuditEventsRequest.created_before:type_name -> google.protobuf.Timestamp
+	19,  // 107: ai.v1.ListAuditEventsResponse.audit_events:type_name -> ai.v1.AuditEvent
+	8,   // 108: ai.v1.ReviewAccessRequestRequest.decision:type_name -> ai.v1.AccessRequestDecision
+	18,  // 109: ai.v1.ReviewAccessRequestResponse.access_request:type_name -> ai.v1.AccessRequest
+	18,  // 110: ai.v1.RevokeAccessRequestResponse.access_request:type_name -> ai.v1.AccessRequest
+	20,  // 111: ai.v1.CredentialService.CreateCredential:input_type -> ai.v1.CreateCredentialRequest
+	22,  // 112: ai.v1.CredentialService.ListCredentials:input_type -> ai.v1.ListCredentialsRequest
+	24,  // 113: ai.v1.CredentialService.RevokeCredential:input_type -> ai.v1.RevokeCredentialRequest
+	26,  // 114: ai.v1.AgentService.CreateAgent:input_type -> ai.v1.CreateAgentRequest
+	28,  // 115: ai.v1.AgentService.ListAgents:input_type -> ai.v1.ListAgentsRequest
+	30,  // 116: ai.v1.AgentService.ListProviderModels:input_type -> ai.v1.ListProviderModelsRequest
+	33,  // 117: ai.v1.AgentService.ListAccessibleAgents:input_type -> ai.v1.ListAccessibleAgentsRequest
+	35,  // 118: ai.v1.AgentService.GetAccessibleAgent:input_type -> ai.v1.GetAccessibleAgentRequest
+	37,  // 119: ai.v1.AgentService.ValidateCampaignAgentBinding:input_type -> ai.v1.ValidateCampaignAgentBindingRequest
+	39,  // 120: ai.v1.AgentService.UpdateAgent:input_type -> ai.v1.UpdateAgentRequest
+	41,  // 121: ai.v1.AgentService.DeleteAgent:input_type -> ai.v1.DeleteAgentRequest
+	44,  // 122: ai.v1.InvocationService.InvokeAgent:input_type -> ai.v1.InvokeAgentRequest
+	50,  // 123: ai.v1.CampaignOrchestrationService.RunCampaignTurn:input_type -> ai.v1.RunCampaignTurnRequest
+	52,  // 124: ai.v1.CampaignOrchestrationService.RunCampaignPlayerTurn:input_type -> ai.v1.RunCampaignPlayerTurnRequest
+	57,  // 125: ai.v1.CampaignDebugService.ListCampaignDebugTurns:input_type -> ai.v1.ListCampaignDebugTurnsRequest
+	59,  // 126: ai.v1.CampaignDebugService.GetCampaignDebugTurn:input_type -> ai.v1.GetCampaignDebugTurnRequest
+	61,  // 127: ai.v1.CampaignDebugService.SubscribeCampaignDebugUpdates:input_type -> ai.v1.SubscribeCampaignDebugUpdatesRequest
+	64,  // 128: ai.v1.CampaignArtifactService.EnsureCampaignArtifacts:input_type -> ai.v1.EnsureCampaignArtifactsRequest
+	66,  // 129: ai.v1.CampaignArtifactService.ListCampaignArtifacts:input_type -> ai.v1.ListCampaignArtifactsRequest
+	68,  // 130: ai.v1.CampaignArtifactService.GetCampaignArtifact:input_type -> ai.v1.GetCampaignArtifactRequest
+	70,  // 131: ai.v1.CampaignArtifactService.UpsertCampaignArtifact:input_type -> ai.v1.UpsertCampaignArtifactRequest
+	73,  // 132: ai.v1.CampaignArtifactService.ListCampaignArtifactRevisions:input_type -> ai.v1.ListCampaignArtifactRevisionsRequest
+	75,  // 133: ai.v1.CampaignArtifactService.DiffCampaignArtifactRevisions:input_type -> ai.v1.DiffCampaignArtifactRevisionsRequest
+	78,  // 134: ai.v1.CampaignArtifactService.RestoreCampaignArtifactRevision:input_type -> ai.v1.RestoreCampaignArtifactRevisionRequest
+	81,  // 135: ai.v1.SessionRecapService.DraftSessionRecap:input_type -> ai.v1.DraftSessionRecapRequest
+	83,  // 136: ai.v1.SessionRecapService.GetSessionRecapDraft:input_type -> ai.v1.GetSessionRecapDraftRequest
+	85,  // 137: ai.v1.SessionRecapService.UpdateSessionRecapDraft:input_type -> ai.v1.UpdateSessionRecapDraftRequest
+	87,  // 138: ai.v1.SessionRecapService.MarkSessionRecapDraftPublished:input_type -> ai.v1.MarkSessionRecapDraftPublishedRequest
+	91,  // 139: ai.v1.SystemReferenceService.SearchSystemReference:input_type -> ai.v1.SearchSystemReferenceRequest
+	93,  // 140: ai.v1.SystemReferenceService.ReadSystemReferenceDocument:input_type -> ai.v1.ReadSystemReferenceDocumentRequest
+	95,  // 141: ai.v1.ProviderGrantService.StartProviderConnect:input_type -> ai.v1.StartProviderConnectRequest
+	97,  // 142: ai.v1.ProviderGrantService.FinishProviderConnect:input_type -> ai.v1.FinishProviderConnectRequest
+	99,  // 143: ai.v1.ProviderGrantService.ListProviderGrants:input_type -> ai.v1.ListProviderGrantsRequest
+	101, // 144: ai.v1.ProviderGrantService.RevokeProviderGrant:input_type -> ai.v1.RevokeProviderGrantRequest
+	113, // 145: ai.v1.AccountDataService.ExportUserData:input_type -> ai.v1.ExportUserDataRequest
+	115, // 146: ai.v1.AccountDataService.DeleteUserData:input_type -> ai.v1.DeleteUserDataRequest
+	103, // 147: ai.v1.AccessRequestService.CreateAccessRequest:input_type -> ai.v1.CreateAccessRequestRequest
+	105, // 148: ai.v1.AccessRequestService.ListAccessRequests:input_type -> ai.v1.ListAccessRequestsRequest
+	107, // 149: ai.v1.AccessRequestService.ListAuditEvents:input_type -> ai.v1.ListAuditEventsRequest
+	109, // 150: ai.v1.AccessRequestService.ReviewAccessRequest:input_type -> ai.v1.ReviewAccessRequestRequest
+	111, // 151: ai.v1.AccessRequestService.RevokeAccessRequest:input_type -> ai.v1.RevokeAccessRequestRequest
+	21,  // 152: ai.v1.CredentialService.CreateCredential:output_type -> ai.v1.CreateCredentialResponse
+	23,  // 153: ai.v1.CredentialService.ListCredentials:output_type -> ai.v1.ListCredentialsResponse
+	25,  // 154: ai.v1.CredentialService.RevokeCredential:output_type -> ai.v1.RevokeCredentialResponse
+	27,  // 155: ai.v1.AgentService.CreateAgent:output_type -> ai.v1.CreateAgentResponse
+	29,  // 156: ai.v1.AgentService.ListAgents:output_type -> ai.v1.ListAgentsResponse
+	32,  // 157: ai.v1.AgentService.ListProviderModels:output_type -> ai.v1.ListProviderModelsResponse
+	34,  // 158: ai.v1.AgentService.ListAccessibleAgents:output_type -> ai.v1.ListAccessibleAgentsResponse
+	36,  // 159: ai.v1.AgentService.GetAccessibleAgent:output_type -> ai.v1.GetAccessibleAgentResponse
+	38,  // 160: ai.v1.AgentService.ValidateCampaignAgentBinding:output_type -> ai.v1.ValidateCampaignAgentBindingResponse
+	40,  // 161: ai.v1.AgentService.UpdateAgent:output_type -> ai.v1.UpdateAgentResponse
+	42,  // 162: ai.v1.AgentService.DeleteAgent:output_type -> ai.v1.DeleteAgentResponse
+	45,  // 163: ai.v1.InvocationService.InvokeAgent:output_type -> ai.v1.InvokeAgentResponse
+	51,  // 164: ai.v1.CampaignOrchestrationService.RunCampaignTurn:output_type -> ai.v1.RunCampaignTurnResponse
+	53,  // 165: ai.v1.CampaignOrchestrationService.RunCampaignPlayerTurn:output_type -> ai.v1.RunCampaignPlayerTurnResponse
+	58,  // 166: ai.v1.CampaignDebugService.ListCampaignDebugTurns:output_type -> ai.v1.ListCampaignDebugTurnsResponse
+	60,  // 167: ai.v1.CampaignDebugService.GetCampaignDebugTurn:output_type -> ai.v1.GetCampaignDebugTurnResponse
+	62,  // 168: ai.v1.CampaignDebugService.SubscribeCampaignDebugUpdates:output_type -> ai.v1.CampaignDebugTurnUpdate
+	65,  // 169: ai.v1.CampaignArtifactService.EnsureCampaignArtifacts:output_type -> ai.v1.EnsureCampaignArtifactsResponse
+	67,  // 170: ai.v1.CampaignArtifactService.ListCampaignArtifacts:output_type -> ai.v1.ListCampaignArtifactsResponse
+	69,  // 171: ai.v1.CampaignArtifactService.GetCampaignArtifact:output_type -> ai.v1.GetCampaignArtifactResponse
+	71,  // 172: ai.v1.CampaignArtifactService.UpsertCampaignArtifact:output_type -> ai.v1.UpsertCampaignArtifactResponse
+	74,  // 173: ai.v1.CampaignArtifactService.ListCampaignArtifactRevisions:output_type -> ai.v1.ListCampaignArtifactRevisionsResponse
+	77,  // 174: ai.v1.CampaignArtifactService.DiffCampaignArtifactRevisions:output_type -> ai.v1.DiffCampaignArtifactRevisionsResponse
+	79,  // 175: ai.v1.CampaignArtifactService.RestoreCampaignArtifactRevision:output_type -> ai.v1.RestoreCampaignArtifactRevisionResponse
+	82,  // 176: ai.v1.SessionRecapService.DraftSessionRecap:output_type -> ai.v1.DraftSessionRecapResponse
+	84,  // 177: ai.v1.SessionRecapService.GetSessionRecapDraft:output_type -> ai.v1.GetSessionRecapDraftResponse
+	86,  // 178: ai.v1.SessionRecapService.UpdateSessionRecapDraft:output_type -> ai.v1.UpdateSessionRecapDraftResponse
+	88,  // 179: ai.v1.SessionRecapService.MarkSessionRecapDraftPublished:output_type -> ai.v1.MarkSessionRecapDraftPublishedResponse
+	92,  // 180: ai.v1.SystemReferenceService.SearchSystemReference:output_type -> ai.v1.SearchSystemReferenceResponse
+	94,  // 181: ai.v1.SystemReferenceService.ReadSystemReferenceDocument:output_type -> ai.v1.ReadSystemReferenceDocumentResponse
+	96,  // 182: ai.v1.ProviderGrantService.StartProviderConnect:output_type -> ai.v1.StartProviderConnectResponse
+	98,  // 183: ai.v1.ProviderGrantService.FinishProviderConnect:output_type -> ai.v1.FinishProviderConnectResponse
+	100, // 184: ai.v1.ProviderGrantService.ListProviderGrants:output_type -> ai.v1.ListProviderGrantsResponse
+	102, // 185: ai.v1.ProviderGrantService.RevokeProviderGrant:output_type -> ai.v1.RevokeProviderGrantResponse
+	114, // 186: ai.v1.AccountDataService.ExportUserData:output_type -> ai.v1.ExportUserDataResponse
+	116, // 187: ai.v1.AccountDataService.DeleteUserData:output_type -> ai.v1.DeleteUserDataResponse
+	104, // 188: ai.v1.AccessRequestService.CreateAccessRequest:output_type -> ai.v1.CreateAccessRequestResponse
+	106, // 189: ai.v1.AccessRequestService.ListAccessRequests:output_type -> ai.v1.ListAccessRequestsResponse
+	108, // 190: ai.v1.AccessRequestService.ListAuditEvents:output_type -> ai.v1.ListAuditEventsResponse
+	110, // 191: ai.v1.AccessRequestService.ReviewAccessRequest:output_type -> ai.v1.ReviewAccessRequestResponse
+	112, // 192: ai.v1.AccessRequestService.RevokeAccessRequest:output_type -> ai.v1.RevokeAccessRequestResponse
+	152, // [152:193] is the sub-list for method output_type
+	111, // [111:152] is the sub-list for method input_type
+	111, // [111:111] is the sub-list for extension type_name
+	111, // [111:111] is the sub-list for extension extendee
+	0,   // [0:111] is the sub-list for field type_name
 }
 
 func init() { file_ai_v1_service_proto_init() }
@@ -7019,10 +8812,10 @@ func file_ai_v1_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ai_v1_service_proto_rawDesc), len(file_ai_v1_service_proto_rawDesc)),
-			NumEnums:      11,
-			NumMessages:   80,
+			NumEnums:      14,
+			NumMessages:   103,
 			NumExtensions: 0,
-			NumServices:   9,
+			NumServices:   11,
 		},
 		GoTypes:           file_ai_v1_service_proto_goTypes,
 		DependencyIndexes: file_ai_v1_service_proto_depIdxs,
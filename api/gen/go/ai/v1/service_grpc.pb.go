@@ -668,7 +668,8 @@ var InvocationService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CampaignOrchestrationService_RunCampaignTurn_FullMethodName = "/ai.v1.CampaignOrchestrationService/RunCampaignTurn"
+	CampaignOrchestrationService_RunCampaignTurn_FullMethodName       = "/ai.v1.CampaignOrchestrationService/RunCampaignTurn"
+	CampaignOrchestrationService_RunCampaignPlayerTurn_FullMethodName = "/ai.v1.CampaignOrchestrationService/RunCampaignPlayerTurn"
 )
 
 // CampaignOrchestrationServiceClient is the client API for CampaignOrchestrationService service.
@@ -676,6 +677,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CampaignOrchestrationServiceClient interface {
 	RunCampaignTurn(ctx context.Context, in *RunCampaignTurnRequest, opts ...grpc.CallOption) (*RunCampaignTurnResponse, error)
+	// RunCampaignPlayerTurn runs one turn for an AI-controlled player participant
+	// named by the session grant.
+	RunCampaignPlayerTurn(ctx context.Context, in *RunCampaignPlayerTurnRequest, opts ...grpc.CallOption) (*RunCampaignPlayerTurnResponse, error)
 }
 
 type campaignOrchestrationServiceClient struct {
@@ -696,11 +700,24 @@ func (c *campaignOrchestrationServiceClient) RunCampaignTurn(ctx context.Context
 	return out, nil
 }
 
+func (c *campaignOrchestrationServiceClient) RunCampaignPlayerTurn(ctx context.Context, in *RunCampaignPlayerTurnRequest, opts ...grpc.CallOption) (*RunCampaignPlayerTurnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunCampaignPlayerTurnResponse)
+	err := c.cc.Invoke(ctx, CampaignOrchestrationService_RunCampaignPlayerTurn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CampaignOrchestrationServiceServer is the server API for CampaignOrchestrationService service.
 // All implementations must embed UnimplementedCampaignOrchestrationServiceServer
 // for forward compatibility.
 type CampaignOrchestrationServiceServer interface {
 	RunCampaignTurn(context.Context, *RunCampaignTurnRequest) (*RunCampaignTurnResponse, error)
+	// RunCampaignPlayerTurn runs one turn for an AI-controlled player participant
+	// named by the session grant.
+	RunCampaignPlayerTurn(context.Context, *RunCampaignPlayerTurnRequest) (*RunCampaignPlayerTurnResponse, error)
 	mustEmbedUnimplementedCampaignOrchestrationServiceServer()
 }
 
@@ -714,6 +731,9 @@ type UnimplementedCampaignOrchestrationServiceServer struct{}
 func (UnimplementedCampaignOrchestrationServiceServer) RunCampaignTurn(context.Context, *RunCampaignTurnRequest) (*RunCampaignTurnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunCampaignTurn not implemented")
 }
+func (UnimplementedCampaignOrchestrationServiceServer) RunCampaignPlayerTurn(context.Context, *RunCampaignPlayerTurnRequest) (*RunCampaignPlayerTurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCampaignPlayerTurn not implemented")
+}
 func (UnimplementedCampaignOrchestrationServiceServer) mustEmbedUnimplementedCampaignOrchestrationServiceServer() {
 }
 func (UnimplementedCampaignOrchestrationServiceServer) testEmbeddedByValue() {}
@@ -754,6 +774,24 @@ func _CampaignOrchestrationService_RunCampaignTurn_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CampaignOrchestrationService_RunCampaignPlayerTurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunCampaignPlayerTurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignOrchestrationServiceServer).RunCampaignPlayerTurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignOrchestrationService_RunCampaignPlayerTurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignOrchestrationServiceServer).RunCampaignPlayerTurn(ctx, req.(*RunCampaignPlayerTurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CampaignOrchestrationService_ServiceDesc is the grpc.ServiceDesc for CampaignOrchestrationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -765,6 +803,10 @@ var CampaignOrchestrationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunCampaignTurn",
 			Handler:    _CampaignOrchestrationService_RunCampaignTurn_Handler,
 		},
+		{
+			MethodName: "RunCampaignPlayerTurn",
+			Handler:    _CampaignOrchestrationService_RunCampaignPlayerTurn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ai/v1/service.proto",
@@ -953,10 +995,13 @@ var CampaignDebugService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CampaignArtifactService_EnsureCampaignArtifacts_FullMethodName = "/ai.v1.CampaignArtifactService/EnsureCampaignArtifacts"
-	CampaignArtifactService_ListCampaignArtifacts_FullMethodName   = "/ai.v1.CampaignArtifactService/ListCampaignArtifacts"
-	CampaignArtifactService_GetCampaignArtifact_FullMethodName     = "/ai.v1.CampaignArtifactService/GetCampaignArtifact"
-	CampaignArtifactService_UpsertCampaignArtifact_FullMethodName  = "/ai.v1.CampaignArtifactService/UpsertCampaignArtifact"
+	CampaignArtifactService_EnsureCampaignArtifacts_FullMethodName         = "/ai.v1.CampaignArtifactService/EnsureCampaignArtifacts"
+	CampaignArtifactService_ListCampaignArtifacts_FullMethodName           = "/ai.v1.CampaignArtifactService/ListCampaignArtifacts"
+	CampaignArtifactService_GetCampaignArtifact_FullMethodName             = "/ai.v1.CampaignArtifactService/GetCampaignArtifact"
+	CampaignArtifactService_UpsertCampaignArtifact_FullMethodName          = "/ai.v1.CampaignArtifactService/UpsertCampaignArtifact"
+	CampaignArtifactService_ListCampaignArtifactRevisions_FullMethodName   = "/ai.v1.CampaignArtifactService/ListCampaignArtifactRevisions"
+	CampaignArtifactService_DiffCampaignArtifactRevisions_FullMethodName   = "/ai.v1.CampaignArtifactService/DiffCampaignArtifactRevisions"
+	CampaignArtifactService_RestoreCampaignArtifactRevision_FullMethodName = "/ai.v1.CampaignArtifactService/RestoreCampaignArtifactRevision"
 )
 
 // CampaignArtifactServiceClient is the client API for CampaignArtifactService service.
@@ -967,6 +1012,9 @@ type CampaignArtifactServiceClient interface {
 	ListCampaignArtifacts(ctx context.Context, in *ListCampaignArtifactsRequest, opts ...grpc.CallOption) (*ListCampaignArtifactsResponse, error)
 	GetCampaignArtifact(ctx context.Context, in *GetCampaignArtifactRequest, opts ...grpc.CallOption) (*GetCampaignArtifactResponse, error)
 	UpsertCampaignArtifact(ctx context.Context, in *UpsertCampaignArtifactRequest, opts ...grpc.CallOption) (*UpsertCampaignArtifactResponse, error)
+	ListCampaignArtifactRevisions(ctx context.Context, in *ListCampaignArtifactRevisionsRequest, opts ...grpc.CallOption) (*ListCampaignArtifactRevisionsResponse, error)
+	DiffCampaignArtifactRevisions(ctx context.Context, in *DiffCampaignArtifactRevisionsRequest, opts ...grpc.CallOption) (*DiffCampaignArtifactRevisionsResponse, error)
+	RestoreCampaignArtifactRevision(ctx context.Context, in *RestoreCampaignArtifactRevisionRequest, opts ...grpc.CallOption) (*RestoreCampaignArtifactRevisionResponse, error)
 }
 
 type campaignArtifactServiceClient struct {
@@ -1017,6 +1065,36 @@ func (c *campaignArtifactServiceClient) UpsertCampaignArtifact(ctx context.Conte
 	return out, nil
 }
 
+func (c *campaignArtifactServiceClient) ListCampaignArtifactRevisions(ctx context.Context, in *ListCampaignArtifactRevisionsRequest, opts ...grpc.CallOption) (*ListCampaignArtifactRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignArtifactRevisionsResponse)
+	err := c.cc.Invoke(ctx, CampaignArtifactService_ListCampaignArtifactRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignArtifactServiceClient) DiffCampaignArtifactRevisions(ctx context.Context, in *DiffCampaignArtifactRevisionsRequest, opts ...grpc.CallOption) (*DiffCampaignArtifactRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffCampaignArtifactRevisionsResponse)
+	err := c.cc.Invoke(ctx, CampaignArtifactService_DiffCampaignArtifactRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignArtifactServiceClient) RestoreCampaignArtifactRevision(ctx context.Context, in *RestoreCampaignArtifactRevisionRequest, opts ...grpc.CallOption) (*RestoreCampaignArtifactRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCampaignArtifactRevisionResponse)
+	err := c.cc.Invoke(ctx, CampaignArtifactService_RestoreCampaignArtifactRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CampaignArtifactServiceServer is the server API for CampaignArtifactService service.
 // All implementations must embed UnimplementedCampaignArtifactServiceServer
 // for forward compatibility.
@@ -1025,6 +1103,9 @@ type CampaignArtifactServiceServer interface {
 	ListCampaignArtifacts(context.Context, *ListCampaignArtifactsRequest) (*ListCampaignArtifactsResponse, error)
 	GetCampaignArtifact(context.Context, *GetCampaignArtifactRequest) (*GetCampaignArtifactResponse, error)
 	UpsertCampaignArtifact(context.Context, *UpsertCampaignArtifactRequest) (*UpsertCampaignArtifactResponse, error)
+	ListCampaignArtifactRevisions(context.Context, *ListCampaignArtifactRevisionsRequest) (*ListCampaignArtifactRevisionsResponse, error)
+	DiffCampaignArtifactRevisions(context.Context, *DiffCampaignArtifactRevisionsRequest) (*DiffCampaignArtifactRevisionsResponse, error)
+	RestoreCampaignArtifactRevision(context.Context, *RestoreCampaignArtifactRevisionRequest) (*RestoreCampaignArtifactRevisionResponse, error)
 	mustEmbedUnimplementedCampaignArtifactServiceServer()
 }
 
@@ -1047,6 +1128,15 @@ func (UnimplementedCampaignArtifactServiceServer) GetCampaignArtifact(context.Co
 func (UnimplementedCampaignArtifactServiceServer) UpsertCampaignArtifact(context.Context, *UpsertCampaignArtifactRequest) (*UpsertCampaignArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertCampaignArtifact not implemented")
 }
+func (UnimplementedCampaignArtifactServiceServer) ListCampaignArtifactRevisions(context.Context, *ListCampaignArtifactRevisionsRequest) (*ListCampaignArtifactRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaignArtifactRevisions not implemented")
+}
+func (UnimplementedCampaignArtifactServiceServer) DiffCampaignArtifactRevisions(context.Context, *DiffCampaignArtifactRevisionsRequest) (*DiffCampaignArtifactRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCampaignArtifactRevisions not implemented")
+}
+func (UnimplementedCampaignArtifactServiceServer) RestoreCampaignArtifactRevision(context.Context, *RestoreCampaignArtifactRevisionRequest) (*RestoreCampaignArtifactRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCampaignArtifactRevision not implemented")
+}
 func (UnimplementedCampaignArtifactServiceServer) mustEmbedUnimplementedCampaignArtifactServiceServer() {
 }
 func (UnimplementedCampaignArtifactServiceServer) testEmbeddedByValue() {}
@@ -1141,6 +1231,60 @@ func _CampaignArtifactService_UpsertCampaignArtifact_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CampaignArtifactService_ListCampaignArtifactRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignArtifactRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignArtifactServiceServer).ListCampaignArtifactRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignArtifactService_ListCampaignArtifactRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignArtifactServiceServer).ListCampaignArtifactRevisions(ctx, req.(*ListCampaignArtifactRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignArtifactService_DiffCampaignArtifactRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCampaignArtifactRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignArtifactServiceServer).DiffCampaignArtifactRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignArtifactService_DiffCampaignArtifactRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignArtifactServiceServer).DiffCampaignArtifactRevisions(ctx, req.(*DiffCampaignArtifactRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignArtifactService_RestoreCampaignArtifactRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCampaignArtifactRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignArtifactServiceServer).RestoreCampaignArtifactRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignArtifactService_RestoreCampaignArtifactRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignArtifactServiceServer).RestoreCampaignArtifactRevision(ctx, req.(*RestoreCampaignArtifactRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CampaignArtifactService_ServiceDesc is the grpc.ServiceDesc for CampaignArtifactService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1164,6 +1308,240 @@ var CampaignArtifactService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertCampaignArtifact",
 			Handler:    _CampaignArtifactService_UpsertCampaignArtifact_Handler,
 		},
+		{
+			MethodName: "ListCampaignArtifactRevisions",
+			Handler:    _CampaignArtifactService_ListCampaignArtifactRevisions_Handler,
+		},
+		{
+			MethodName: "DiffCampaignArtifactRevisions",
+			Handler:    _CampaignArtifactService_DiffCampaignArtifactRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreCampaignArtifactRevision",
+			Handler:    _CampaignArtifactService_RestoreCampaignArtifactRevision_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ai/v1/service.proto",
+}
+
+const (
+	SessionRecapService_DraftSessionRecap_FullMethodName              = "/ai.v1.SessionRecapService/DraftSessionRecap"
+	SessionRecapService_GetSessionRecapDraft_FullMethodName           = "/ai.v1.SessionRecapService/GetSessionRecapDraft"
+	SessionRecapService_UpdateSessionRecapDraft_FullMethodName        = "/ai.v1.SessionRecapService/UpdateSessionRecapDraft"
+	SessionRecapService_MarkSessionRecapDraftPublished_FullMethodName = "/ai.v1.SessionRecapService/MarkSessionRecapDraftPublished"
+)
+
+// SessionRecapServiceClient is the client API for SessionRecapService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SessionRecapService owns AI-drafted session recaps awaiting GM review.
+// Drafting is internal-only; GMs read, edit, and publish drafts.
+type SessionRecapServiceClient interface {
+	DraftSessionRecap(ctx context.Context, in *DraftSessionRecapRequest, opts ...grpc.CallOption) (*DraftSessionRecapResponse, error)
+	GetSessionRecapDraft(ctx context.Context, in *GetSessionRecapDraftRequest, opts ...grpc.CallOption) (*GetSessionRecapDraftResponse, error)
+	UpdateSessionRecapDraft(ctx context.Context, in *UpdateSessionRecapDraftRequest, opts ...grpc.CallOption) (*UpdateSessionRecapDraftResponse, error)
+	MarkSessionRecapDraftPublished(ctx context.Context, in *MarkSessionRecapDraftPublishedRequest, opts ...grpc.CallOption) (*MarkSessionRecapDraftPublishedResponse, error)
+}
+
+type sessionRecapServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionRecapServiceClient(cc grpc.ClientConnInterface) SessionRecapServiceClient {
+	return &sessionRecapServiceClient{cc}
+}
+
+func (c *sessionRecapServiceClient) DraftSessionRecap(ctx context.Context, in *DraftSessionRecapRequest, opts ...grpc.CallOption) (*DraftSessionRecapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftSessionRecapResponse)
+	err := c.cc.Invoke(ctx, SessionRecapService_DraftSessionRecap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecapServiceClient) GetSessionRecapDraft(ctx context.Context, in *GetSessionRecapDraftRequest, opts ...grpc.CallOption) (*GetSessionRecapDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionRecapDraftResponse)
+	err := c.cc.Invoke(ctx, SessionRecapService_GetSessionRecapDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecapServiceClient) UpdateSessionRecapDraft(ctx context.Context, in *UpdateSessionRecapDraftRequest, opts ...grpc.CallOption) (*UpdateSessionRecapDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSessionRecapDraftResponse)
+	err := c.cc.Invoke(ctx, SessionRecapService_UpdateSessionRecapDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecapServiceClient) MarkSessionRecapDraftPublished(ctx context.Context, in *MarkSessionRecapDraftPublishedRequest, opts ...grpc.CallOption) (*MarkSessionRecapDraftPublishedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkSessionRecapDraftPublishedResponse)
+	err := c.cc.Invoke(ctx, SessionRecapService_MarkSessionRecapDraftPublished_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionRecapServiceServer is the server API for SessionRecapService service.
+// All implementations must embed UnimplementedSessionRecapServiceServer
+// for forward compatibility.
+//
+// SessionRecapService owns AI-drafted session recaps awaiting GM review.
+// Drafting is internal-only; GMs read, edit, and publish drafts.
+type SessionRecapServiceServer interface {
+	DraftSessionRecap(context.Context, *DraftSessionRecapRequest) (*DraftSessionRecapResponse, error)
+	GetSessionRecapDraft(context.Context, *GetSessionRecapDraftRequest) (*GetSessionRecapDraftResponse, error)
+	UpdateSessionRecapDraft(context.Context, *UpdateSessionRecapDraftRequest) (*UpdateSessionRecapDraftResponse, error)
+	MarkSessionRecapDraftPublished(context.Context, *MarkSessionRecapDraftPublishedRequest) (*MarkSessionRecapDraftPublishedResponse, error)
+	mustEmbedUnimplementedSessionRecapServiceServer()
+}
+
+// UnimplementedSessionRecapServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSessionRecapServiceServer struct{}
+
+func (UnimplementedSessionRecapServiceServer) DraftSessionRecap(context.Context, *DraftSessionRecapRequest) (*DraftSessionRecapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DraftSessionRecap not implemented")
+}
+func (UnimplementedSessionRecapServiceServer) GetSessionRecapDraft(context.Context, *GetSessionRecapDraftRequest) (*GetSessionRecapDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionRecapDraft not implemented")
+}
+func (UnimplementedSessionRecapServiceServer) UpdateSessionRecapDraft(context.Context, *UpdateSessionRecapDraftRequest) (*UpdateSessionRecapDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSessionRecapDraft not implemented")
+}
+func (UnimplementedSessionRecapServiceServer) MarkSessionRecapDraftPublished(context.Context, *MarkSessionRecapDraftPublishedRequest) (*MarkSessionRecapDraftPublishedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkSessionRecapDraftPublished not implemented")
+}
+func (UnimplementedSessionRecapServiceServer) mustEmbedUnimplementedSessionRecapServiceServer() {}
+func (UnimplementedSessionRecapServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeSessionRecapServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionRecapServiceServer will
+// result in compilation errors.
+type UnsafeSessionRecapServiceServer interface {
+	mustEmbedUnimplementedSessionRecapServiceServer()
+}
+
+func RegisterSessionRecapServiceServer(s grpc.ServiceRegistrar, srv SessionRecapServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSessionRecapServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SessionRecapService_ServiceDesc, srv)
+}
+
+func _SessionRecapService_DraftSessionRecap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftSessionRecapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecapServiceServer).DraftSessionRecap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecapService_DraftSessionRecap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecapServiceServer).DraftSessionRecap(ctx, req.(*DraftSessionRecapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecapService_GetSessionRecapDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRecapDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecapServiceServer).GetSessionRecapDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecapService_GetSessionRecapDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecapServiceServer).GetSessionRecapDraft(ctx, req.(*GetSessionRecapDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecapService_UpdateSessionRecapDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSessionRecapDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecapServiceServer).UpdateSessionRecapDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecapService_UpdateSessionRecapDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecapServiceServer).UpdateSessionRecapDraft(ctx, req.(*UpdateSessionRecapDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecapService_MarkSessionRecapDraftPublished_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkSessionRecapDraftPublishedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecapServiceServer).MarkSessionRecapDraftPublished(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecapService_MarkSessionRecapDraftPublished_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecapServiceServer).MarkSessionRecapDraftPublished(ctx, req.(*MarkSessionRecapDraftPublishedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SessionRecapService_ServiceDesc is the grpc.ServiceDesc for SessionRecapService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionRecapService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai.v1.SessionRecapService",
+	HandlerType: (*SessionRecapServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DraftSessionRecap",
+			Handler:    _SessionRecapService_DraftSessionRecap_Handler,
+		},
+		{
+			MethodName: "GetSessionRecapDraft",
+			Handler:    _SessionRecapService_GetSessionRecapDraft_Handler,
+		},
+		{
+			MethodName: "UpdateSessionRecapDraft",
+			Handler:    _SessionRecapService_UpdateSessionRecapDraft_Handler,
+		},
+		{
+			MethodName: "MarkSessionRecapDraftPublished",
+			Handler:    _SessionRecapService_MarkSessionRecapDraftPublished_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ai/v1/service.proto",
@@ -1526,6 +1904,154 @@ var ProviderGrantService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "ai/v1/service.proto",
 }
 
+const (
+	AccountDataService_ExportUserData_FullMethodName = "/ai.v1.AccountDataService/ExportUserData"
+	AccountDataService_DeleteUserData_FullMethodName = "/ai.v1.AccountDataService/DeleteUserData"
+)
+
+// AccountDataServiceClient is the client API for AccountDataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AccountDataService exports and erases the AI records a user owns:
+// credentials, agents, provider grants, and access requests. Secrets are never
+// exported. Internal-only.
+type AccountDataServiceClient interface {
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
+}
+
+type accountDataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountDataServiceClient(cc grpc.ClientConnInterface) AccountDataServiceClient {
+	return &accountDataServiceClient{cc}
+}
+
+func (c *accountDataServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, AccountDataService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountDataServiceClient) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, AccountDataService_DeleteUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountDataServiceServer is the server API for AccountDataService service.
+// All implementations must embed UnimplementedAccountDataServiceServer
+// for forward compatibility.
+//
+// AccountDataService exports and erases the AI records a user owns:
+// credentials, agents, provider grants, and access requests. Secrets are never
+// exported. Internal-only.
+type AccountDataServiceServer interface {
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error)
+	mustEmbedUnimplementedAccountDataServiceServer()
+}
+
+// UnimplementedAccountDataServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountDataServiceServer struct{}
+
+func (UnimplementedAccountDataServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedAccountDataServiceServer) DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedAccountDataServiceServer) mustEmbedUnimplementedAccountDataServiceServer() {}
+func (UnimplementedAccountDataServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeAccountDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountDataServiceServer will
+// result in compilation errors.
+type UnsafeAccountDataServiceServer interface {
+	mustEmbedUnimplementedAccountDataServiceServer()
+}
+
+func RegisterAccountDataServiceServer(s grpc.ServiceRegistrar, srv AccountDataServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccountDataServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountDataService_ServiceDesc, srv)
+}
+
+func _AccountDataService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountDataServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountDataService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountDataServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountDataService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountDataServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountDataService_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountDataServiceServer).DeleteUserData(ctx, req.(*DeleteUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountDataService_ServiceDesc is the grpc.ServiceDesc for AccountDataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountDataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai.v1.AccountDataService",
+	HandlerType: (*AccountDataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportUserData",
+			Handler:    _AccountDataService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _AccountDataService_DeleteUserData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ai/v1/service.proto",
+}
+
 const (
 	AccessRequestService_CreateAccessRequest_FullMethodName = "/ai.v1.AccessRequestService/CreateAccessRequest"
 	AccessRequestService_ListAccessRequests_FullMethodName  = "/ai.v1.AccessRequestService/ListAccessRequests"
@@ -20,8 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AccountService_GetProfile_FullMethodName    = "/auth.v1.AccountService/GetProfile"
-	AccountService_UpdateProfile_FullMethodName = "/auth.v1.AccountService/UpdateProfile"
+	AccountService_GetProfile_FullMethodName              = "/auth.v1.AccountService/GetProfile"
+	AccountService_UpdateProfile_FullMethodName           = "/auth.v1.AccountService/UpdateProfile"
+	AccountService_RequestAccountExport_FullMethodName    = "/auth.v1.AccountService/RequestAccountExport"
+	AccountService_ListAccountExports_FullMethodName      = "/auth.v1.AccountService/ListAccountExports"
+	AccountService_GetAccountExportArchive_FullMethodName = "/auth.v1.AccountService/GetAccountExportArchive"
+	AccountService_ExportUserData_FullMethodName          = "/auth.v1.AccountService/ExportUserData"
+	AccountService_CompleteAccountExport_FullMethodName   = "/auth.v1.AccountService/CompleteAccountExport"
+	AccountService_DeleteAccount_FullMethodName           = "/auth.v1.AccountService/DeleteAccount"
 )
 
 // AccountServiceClient is the client API for AccountService service.
@@ -32,6 +38,16 @@ const (
 type AccountServiceClient interface {
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// Queue an archive of every service's records for the user.
+	RequestAccountExport(ctx context.Context, in *RequestAccountExportRequest, opts ...grpc.CallOption) (*RequestAccountExportResponse, error)
+	ListAccountExports(ctx context.Context, in *ListAccountExportsRequest, opts ...grpc.CallOption) (*ListAccountExportsResponse, error)
+	GetAccountExportArchive(ctx context.Context, in *GetAccountExportArchiveRequest, opts ...grpc.CallOption) (*GetAccountExportArchiveResponse, error)
+	// Internal: worker callbacks for the export job.
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	CompleteAccountExport(ctx context.Context, in *CompleteAccountExportRequest, opts ...grpc.CallOption) (*CompleteAccountExportResponse, error)
+	// Close the account: revoke credentials and sessions immediately and hand
+	// personal-data removal in other services to the integration outbox.
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
 }
 
 type accountServiceClient struct {
@@ -62,6 +78,66 @@ func (c *accountServiceClient) UpdateProfile(ctx context.Context, in *UpdateProf
 	return out, nil
 }
 
+func (c *accountServiceClient) RequestAccountExport(ctx context.Context, in *RequestAccountExportRequest, opts ...grpc.CallOption) (*RequestAccountExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestAccountExportResponse)
+	err := c.cc.Invoke(ctx, AccountService_RequestAccountExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAccountExports(ctx context.Context, in *ListAccountExportsRequest, opts ...grpc.CallOption) (*ListAccountExportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountExportsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListAccountExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccountExportArchive(ctx context.Context, in *GetAccountExportArchiveRequest, opts ...grpc.CallOption) (*GetAccountExportArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountExportArchiveResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountExportArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, AccountService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CompleteAccountExport(ctx context.Context, in *CompleteAccountExportRequest, opts ...grpc.CallOption) (*CompleteAccountExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteAccountExportResponse)
+	err := c.cc.Invoke(ctx, AccountService_CompleteAccountExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility.
@@ -70,6 +146,16 @@ func (c *accountServiceClient) UpdateProfile(ctx context.Context, in *UpdateProf
 type AccountServiceServer interface {
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// Queue an archive of every service's records for the user.
+	RequestAccountExport(context.Context, *RequestAccountExportRequest) (*RequestAccountExportResponse, error)
+	ListAccountExports(context.Context, *ListAccountExportsRequest) (*ListAccountExportsResponse, error)
+	GetAccountExportArchive(context.Context, *GetAccountExportArchiveRequest) (*GetAccountExportArchiveResponse, error)
+	// Internal: worker callbacks for the export job.
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	CompleteAccountExport(context.Context, *CompleteAccountExportRequest) (*CompleteAccountExportResponse, error)
+	// Close the account: revoke credentials and sessions immediately and hand
+	// personal-data removal in other services to the integration outbox.
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -86,6 +172,24 @@ func (UnimplementedAccountServiceServer) GetProfile(context.Context, *GetProfile
 func (UnimplementedAccountServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedAccountServiceServer) RequestAccountExport(context.Context, *RequestAccountExportRequest) (*RequestAccountExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountExport not implemented")
+}
+func (UnimplementedAccountServiceServer) ListAccountExports(context.Context, *ListAccountExportsRequest) (*ListAccountExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountExports not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccountExportArchive(context.Context, *GetAccountExportArchiveRequest) (*GetAccountExportArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountExportArchive not implemented")
+}
+func (UnimplementedAccountServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedAccountServiceServer) CompleteAccountExport(context.Context, *CompleteAccountExportRequest) (*CompleteAccountExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAccountExport not implemented")
+}
+func (UnimplementedAccountServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 func (UnimplementedAccountServiceServer) testEmbeddedByValue()                        {}
 
@@ -143,6 +247,114 @@ func _AccountService_UpdateProfile_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_RequestAccountExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RequestAccountExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RequestAccountExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RequestAccountExport(ctx, req.(*RequestAccountExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAccountExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAccountExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListAccountExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAccountExports(ctx, req.(*ListAccountExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountExportArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountExportArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountExportArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountExportArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountExportArchive(ctx, req.(*GetAccountExportArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CompleteAccountExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAccountExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CompleteAccountExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CompleteAccountExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CompleteAccountExport(ctx, req.(*CompleteAccountExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,6 +370,30 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _AccountService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "RequestAccountExport",
+			Handler:    _AccountService_RequestAccountExport_Handler,
+		},
+		{
+			MethodName: "ListAccountExports",
+			Handler:    _AccountService_ListAccountExports_Handler,
+		},
+		{
+			MethodName: "GetAccountExportArchive",
+			Handler:    _AccountService_GetAccountExportArchive_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _AccountService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "CompleteAccountExport",
+			Handler:    _AccountService_CompleteAccountExport_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _AccountService_DeleteAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth/v1/account.proto",
@@ -34,6 +34,13 @@ const (
 	AuthService_GetWebSession_FullMethodName                     = "/auth.v1.AuthService/GetWebSession"
 	AuthService_RevokeWebSession_FullMethodName                  = "/auth.v1.AuthService/RevokeWebSession"
 	AuthService_ListPasskeys_FullMethodName                      = "/auth.v1.AuthService/ListPasskeys"
+	AuthService_ListWebSessions_FullMethodName                   = "/auth.v1.AuthService/ListWebSessions"
+	AuthService_RevokeUserWebSession_FullMethodName              = "/auth.v1.AuthService/RevokeUserWebSession"
+	AuthService_RevokeOtherWebSessions_FullMethodName            = "/auth.v1.AuthService/RevokeOtherWebSessions"
+	AuthService_RenamePasskey_FullMethodName                     = "/auth.v1.AuthService/RenamePasskey"
+	AuthService_DeletePasskey_FullMethodName                     = "/auth.v1.AuthService/DeletePasskey"
+	AuthService_BeginRecoveryCodeRotation_FullMethodName         = "/auth.v1.AuthService/BeginRecoveryCodeRotation"
+	AuthService_FinishRecoveryCodeRotation_FullMethodName        = "/auth.v1.AuthService/FinishRecoveryCodeRotation"
 	AuthService_IssueJoinGrant_FullMethodName                    = "/auth.v1.AuthService/IssueJoinGrant"
 	AuthService_LookupUserByUsername_FullMethodName              = "/auth.v1.AuthService/LookupUserByUsername"
 	AuthService_CheckUsernameAvailability_FullMethodName         = "/auth.v1.AuthService/CheckUsernameAvailability"
@@ -77,6 +84,20 @@ type AuthServiceClient interface {
 	RevokeWebSession(ctx context.Context, in *RevokeWebSessionRequest, opts ...grpc.CallOption) (*RevokeWebSessionResponse, error)
 	// List registered passkeys for a user.
 	ListPasskeys(ctx context.Context, in *ListPasskeysRequest, opts ...grpc.CallOption) (*ListPasskeysResponse, error)
+	// List a user's active web sessions, most recently seen first.
+	ListWebSessions(ctx context.Context, in *ListWebSessionsRequest, opts ...grpc.CallOption) (*ListWebSessionsResponse, error)
+	// Revoke one web session owned by the user.
+	RevokeUserWebSession(ctx context.Context, in *RevokeUserWebSessionRequest, opts ...grpc.CallOption) (*RevokeUserWebSessionResponse, error)
+	// Revoke every active web session of the user except the kept one.
+	RevokeOtherWebSessions(ctx context.Context, in *RevokeOtherWebSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherWebSessionsResponse, error)
+	// Rename one passkey owned by the user.
+	RenamePasskey(ctx context.Context, in *RenamePasskeyRequest, opts ...grpc.CallOption) (*RenamePasskeyResponse, error)
+	// Delete one passkey owned by the user; the last passkey cannot be deleted.
+	DeletePasskey(ctx context.Context, in *DeletePasskeyRequest, opts ...grpc.CallOption) (*DeletePasskeyResponse, error)
+	// Begin a passkey re-authentication ceremony before rotating recovery codes.
+	BeginRecoveryCodeRotation(ctx context.Context, in *BeginRecoveryCodeRotationRequest, opts ...grpc.CallOption) (*BeginRecoveryCodeRotationResponse, error)
+	// Finish re-authentication and issue a fresh recovery code.
+	FinishRecoveryCodeRotation(ctx context.Context, in *FinishRecoveryCodeRotationRequest, opts ...grpc.CallOption) (*FinishRecoveryCodeRotationResponse, error)
 	// Issue a join grant for a campaign invite.
 	IssueJoinGrant(ctx context.Context, in *IssueJoinGrantRequest, opts ...grpc.CallOption) (*IssueJoinGrantResponse, error)
 	// Resolve a user by username.
@@ -241,6 +262,76 @@ func (c *authServiceClient) ListPasskeys(ctx context.Context, in *ListPasskeysRe
 	return out, nil
 }
 
+func (c *authServiceClient) ListWebSessions(ctx context.Context, in *ListWebSessionsRequest, opts ...grpc.CallOption) (*ListWebSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListWebSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeUserWebSession(ctx context.Context, in *RevokeUserWebSessionRequest, opts ...grpc.CallOption) (*RevokeUserWebSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserWebSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeUserWebSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeOtherWebSessions(ctx context.Context, in *RevokeOtherWebSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherWebSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeOtherWebSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeOtherWebSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RenamePasskey(ctx context.Context, in *RenamePasskeyRequest, opts ...grpc.CallOption) (*RenamePasskeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenamePasskeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_RenamePasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeletePasskey(ctx context.Context, in *DeletePasskeyRequest, opts ...grpc.CallOption) (*DeletePasskeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePasskeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeletePasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BeginRecoveryCodeRotation(ctx context.Context, in *BeginRecoveryCodeRotationRequest, opts ...grpc.CallOption) (*BeginRecoveryCodeRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginRecoveryCodeRotationResponse)
+	err := c.cc.Invoke(ctx, AuthService_BeginRecoveryCodeRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) FinishRecoveryCodeRotation(ctx context.Context, in *FinishRecoveryCodeRotationRequest, opts ...grpc.CallOption) (*FinishRecoveryCodeRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishRecoveryCodeRotationResponse)
+	err := c.cc.Invoke(ctx, AuthService_FinishRecoveryCodeRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) IssueJoinGrant(ctx context.Context, in *IssueJoinGrantRequest, opts ...grpc.CallOption) (*IssueJoinGrantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IssueJoinGrantResponse)
@@ -345,6 +436,20 @@ type AuthServiceServer interface {
 	RevokeWebSession(context.Context, *RevokeWebSessionRequest) (*RevokeWebSessionResponse, error)
 	// List registered passkeys for a user.
 	ListPasskeys(context.Context, *ListPasskeysRequest) (*ListPasskeysResponse, error)
+	// List a user's active web sessions, most recently seen first.
+	ListWebSessions(context.Context, *ListWebSessionsRequest) (*ListWebSessionsResponse, error)
+	// Revoke one web session owned by the user.
+	RevokeUserWebSession(context.Context, *RevokeUserWebSessionRequest) (*RevokeUserWebSessionResponse, error)
+	// Revoke every active web session of the user except the kept one.
+	RevokeOtherWebSessions(context.Context, *RevokeOtherWebSessionsRequest) (*RevokeOtherWebSessionsResponse, error)
+	// Rename one passkey owned by the user.
+	RenamePasskey(context.Context, *RenamePasskeyRequest) (*RenamePasskeyResponse, error)
+	// Delete one passkey owned by the user; the last passkey cannot be deleted.
+	DeletePasskey(context.Context, *DeletePasskeyRequest) (*DeletePasskeyResponse, error)
+	// Begin a passkey re-authentication ceremony before rotating recovery codes.
+	BeginRecoveryCodeRotation(context.Context, *BeginRecoveryCodeRotationRequest) (*BeginRecoveryCodeRotationResponse, error)
+	// Finish re-authentication and issue a fresh recovery code.
+	FinishRecoveryCodeRotation(context.Context, *FinishRecoveryCodeRotationRequest) (*FinishRecoveryCodeRotationResponse, error)
 	// Issue a join grant for a campaign invite.
 	IssueJoinGrant(context.Context, *IssueJoinGrantRequest) (*IssueJoinGrantResponse, error)
 	// Resolve a user by username.
@@ -411,6 +516,27 @@ func (UnimplementedAuthServiceServer) RevokeWebSession(context.Context, *RevokeW
 func (UnimplementedAuthServiceServer) ListPasskeys(context.Context, *ListPasskeysRequest) (*ListPasskeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPasskeys not implemented")
 }
+func (UnimplementedAuthServiceServer) ListWebSessions(context.Context, *ListWebSessionsRequest) (*ListWebSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeUserWebSession(context.Context, *RevokeUserWebSessionRequest) (*RevokeUserWebSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserWebSession not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeOtherWebSessions(context.Context, *RevokeOtherWebSessionsRequest) (*RevokeOtherWebSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherWebSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RenamePasskey(context.Context, *RenamePasskeyRequest) (*RenamePasskeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePasskey not implemented")
+}
+func (UnimplementedAuthServiceServer) DeletePasskey(context.Context, *DeletePasskeyRequest) (*DeletePasskeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePasskey not implemented")
+}
+func (UnimplementedAuthServiceServer) BeginRecoveryCodeRotation(context.Context, *BeginRecoveryCodeRotationRequest) (*BeginRecoveryCodeRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginRecoveryCodeRotation not implemented")
+}
+func (UnimplementedAuthServiceServer) FinishRecoveryCodeRotation(context.Context, *FinishRecoveryCodeRotationRequest) (*FinishRecoveryCodeRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishRecoveryCodeRotation not implemented")
+}
 func (UnimplementedAuthServiceServer) IssueJoinGrant(context.Context, *IssueJoinGrantRequest) (*IssueJoinGrantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueJoinGrant not implemented")
 }
@@ -705,6 +831,132 @@ func _AuthService_ListPasskeys_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ListWebSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListWebSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListWebSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListWebSessions(ctx, req.(*ListWebSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeUserWebSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserWebSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeUserWebSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeUserWebSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeUserWebSession(ctx, req.(*RevokeUserWebSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeOtherWebSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherWebSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeOtherWebSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeOtherWebSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeOtherWebSessions(ctx, req.(*RevokeOtherWebSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RenamePasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RenamePasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RenamePasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RenamePasskey(ctx, req.(*RenamePasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeletePasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeletePasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeletePasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeletePasskey(ctx, req.(*DeletePasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BeginRecoveryCodeRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginRecoveryCodeRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BeginRecoveryCodeRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BeginRecoveryCodeRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BeginRecoveryCodeRotation(ctx, req.(*BeginRecoveryCodeRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_FinishRecoveryCodeRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishRecoveryCodeRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).FinishRecoveryCodeRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_FinishRecoveryCodeRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).FinishRecoveryCodeRotation(ctx, req.(*FinishRecoveryCodeRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_IssueJoinGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IssueJoinGrantRequest)
 	if err := dec(in); err != nil {
@@ -894,6 +1146,34 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPasskeys",
 			Handler:    _AuthService_ListPasskeys_Handler,
 		},
+		{
+			MethodName: "ListWebSessions",
+			Handler:    _AuthService_ListWebSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserWebSession",
+			Handler:    _AuthService_RevokeUserWebSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherWebSessions",
+			Handler:    _AuthService_RevokeOtherWebSessions_Handler,
+		},
+		{
+			MethodName: "RenamePasskey",
+			Handler:    _AuthService_RenamePasskey_Handler,
+		},
+		{
+			MethodName: "DeletePasskey",
+			Handler:    _AuthService_DeletePasskey_Handler,
+		},
+		{
+			MethodName: "BeginRecoveryCodeRotation",
+			Handler:    _AuthService_BeginRecoveryCodeRotation_Handler,
+		},
+		{
+			MethodName: "FinishRecoveryCodeRotation",
+			Handler:    _AuthService_FinishRecoveryCodeRotation_Handler,
+		},
 		{
 			MethodName: "IssueJoinGrant",
 			Handler:    _AuthService_IssueJoinGrant_Handler,
@@ -20,9 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DiscoveryService_CreateDiscoveryEntry_FullMethodName = "/discovery.v1.DiscoveryService/CreateDiscoveryEntry"
-	DiscoveryService_GetDiscoveryEntry_FullMethodName    = "/discovery.v1.DiscoveryService/GetDiscoveryEntry"
-	DiscoveryService_ListDiscoveryEntries_FullMethodName = "/discovery.v1.DiscoveryService/ListDiscoveryEntries"
+	DiscoveryService_CreateDiscoveryEntry_FullMethodName    = "/discovery.v1.DiscoveryService/CreateDiscoveryEntry"
+	DiscoveryService_GetDiscoveryEntry_FullMethodName       = "/discovery.v1.DiscoveryService/GetDiscoveryEntry"
+	DiscoveryService_ListDiscoveryEntries_FullMethodName    = "/discovery.v1.DiscoveryService/ListDiscoveryEntries"
+	DiscoveryService_SetDiscoveryEntryHidden_FullMethodName = "/discovery.v1.DiscoveryService/SetDiscoveryEntryHidden"
+	DiscoveryService_PublishCampaignListing_FullMethodName  = "/discovery.v1.DiscoveryService/PublishCampaignListing"
+	DiscoveryService_GetCampaignListing_FullMethodName      = "/discovery.v1.DiscoveryService/GetCampaignListing"
+	DiscoveryService_CloseCampaignListing_FullMethodName    = "/discovery.v1.DiscoveryService/CloseCampaignListing"
+	DiscoveryService_GetCharacterTemplate_FullMethodName    = "/discovery.v1.DiscoveryService/GetCharacterTemplate"
 )
 
 // DiscoveryServiceClient is the client API for DiscoveryService service.
@@ -32,6 +37,19 @@ type DiscoveryServiceClient interface {
 	CreateDiscoveryEntry(ctx context.Context, in *CreateDiscoveryEntryRequest, opts ...grpc.CallOption) (*CreateDiscoveryEntryResponse, error)
 	GetDiscoveryEntry(ctx context.Context, in *GetDiscoveryEntryRequest, opts ...grpc.CallOption) (*GetDiscoveryEntryResponse, error)
 	ListDiscoveryEntries(ctx context.Context, in *ListDiscoveryEntriesRequest, opts ...grpc.CallOption) (*ListDiscoveryEntriesResponse, error)
+	// Hides or restores an entry in public discovery for operator moderation.
+	SetDiscoveryEntryHidden(ctx context.Context, in *SetDiscoveryEntryHiddenRequest, opts ...grpc.CallOption) (*SetDiscoveryEntryHiddenResponse, error)
+	// Publishes or updates the open-table listing for a live campaign. Callers
+	// are responsible for authorizing the campaign manager.
+	PublishCampaignListing(ctx context.Context, in *PublishCampaignListingRequest, opts ...grpc.CallOption) (*PublishCampaignListingResponse, error)
+	// Returns a campaign's open-table listing, or NOT_FOUND when it has none or
+	// moderation hid it.
+	GetCampaignListing(ctx context.Context, in *GetCampaignListingRequest, opts ...grpc.CallOption) (*GetCampaignListingResponse, error)
+	// Removes a campaign's open-table listing from discovery.
+	CloseCampaignListing(ctx context.Context, in *CloseCampaignListingRequest, opts ...grpc.CallOption) (*CloseCampaignListingResponse, error)
+	// Returns a pregenerated character template, including its full creation
+	// workflow, or NOT_FOUND when the entry is unknown or hidden.
+	GetCharacterTemplate(ctx context.Context, in *GetCharacterTemplateRequest, opts ...grpc.CallOption) (*GetCharacterTemplateResponse, error)
 }
 
 type discoveryServiceClient struct {
@@ -72,6 +90,56 @@ func (c *discoveryServiceClient) ListDiscoveryEntries(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *discoveryServiceClient) SetDiscoveryEntryHidden(ctx context.Context, in *SetDiscoveryEntryHiddenRequest, opts ...grpc.CallOption) (*SetDiscoveryEntryHiddenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDiscoveryEntryHiddenResponse)
+	err := c.cc.Invoke(ctx, DiscoveryService_SetDiscoveryEntryHidden_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) PublishCampaignListing(ctx context.Context, in *PublishCampaignListingRequest, opts ...grpc.CallOption) (*PublishCampaignListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishCampaignListingResponse)
+	err := c.cc.Invoke(ctx, DiscoveryService_PublishCampaignListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) GetCampaignListing(ctx context.Context, in *GetCampaignListingRequest, opts ...grpc.CallOption) (*GetCampaignListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignListingResponse)
+	err := c.cc.Invoke(ctx, DiscoveryService_GetCampaignListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) CloseCampaignListing(ctx context.Context, in *CloseCampaignListingRequest, opts ...grpc.CallOption) (*CloseCampaignListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseCampaignListingResponse)
+	err := c.cc.Invoke(ctx, DiscoveryService_CloseCampaignListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) GetCharacterTemplate(ctx context.Context, in *GetCharacterTemplateRequest, opts ...grpc.CallOption) (*GetCharacterTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCharacterTemplateResponse)
+	err := c.cc.Invoke(ctx, DiscoveryService_GetCharacterTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoveryServiceServer is the server API for DiscoveryService service.
 // All implementations must embed UnimplementedDiscoveryServiceServer
 // for forward compatibility.
@@ -79,6 +147,19 @@ type DiscoveryServiceServer interface {
 	CreateDiscoveryEntry(context.Context, *CreateDiscoveryEntryRequest) (*CreateDiscoveryEntryResponse, error)
 	GetDiscoveryEntry(context.Context, *GetDiscoveryEntryRequest) (*GetDiscoveryEntryResponse, error)
 	ListDiscoveryEntries(context.Context, *ListDiscoveryEntriesRequest) (*ListDiscoveryEntriesResponse, error)
+	// Hides or restores an entry in public discovery for operator moderation.
+	SetDiscoveryEntryHidden(context.Context, *SetDiscoveryEntryHiddenRequest) (*SetDiscoveryEntryHiddenResponse, error)
+	// Publishes or updates the open-table listing for a live campaign. Callers
+	// are responsible for authorizing the campaign manager.
+	PublishCampaignListing(context.Context, *PublishCampaignListingRequest) (*PublishCampaignListingResponse, error)
+	// Returns a campaign's open-table listing, or NOT_FOUND when it has none or
+	// moderation hid it.
+	GetCampaignListing(context.Context, *GetCampaignListingRequest) (*GetCampaignListingResponse, error)
+	// Removes a campaign's open-table listing from discovery.
+	CloseCampaignListing(context.Context, *CloseCampaignListingRequest) (*CloseCampaignListingResponse, error)
+	// Returns a pregenerated character template, including its full creation
+	// workflow, or NOT_FOUND when the entry is unknown or hidden.
+	GetCharacterTemplate(context.Context, *GetCharacterTemplateRequest) (*GetCharacterTemplateResponse, error)
 	mustEmbedUnimplementedDiscoveryServiceServer()
 }
 
@@ -98,6 +179,21 @@ func (UnimplementedDiscoveryServiceServer) GetDiscoveryEntry(context.Context, *G
 func (UnimplementedDiscoveryServiceServer) ListDiscoveryEntries(context.Context, *ListDiscoveryEntriesRequest) (*ListDiscoveryEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDiscoveryEntries not implemented")
 }
+func (UnimplementedDiscoveryServiceServer) SetDiscoveryEntryHidden(context.Context, *SetDiscoveryEntryHiddenRequest) (*SetDiscoveryEntryHiddenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiscoveryEntryHidden not implemented")
+}
+func (UnimplementedDiscoveryServiceServer) PublishCampaignListing(context.Context, *PublishCampaignListingRequest) (*PublishCampaignListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishCampaignListing not implemented")
+}
+func (UnimplementedDiscoveryServiceServer) GetCampaignListing(context.Context, *GetCampaignListingRequest) (*GetCampaignListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaignListing not implemented")
+}
+func (UnimplementedDiscoveryServiceServer) CloseCampaignListing(context.Context, *CloseCampaignListingRequest) (*CloseCampaignListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseCampaignListing not implemented")
+}
+func (UnimplementedDiscoveryServiceServer) GetCharacterTemplate(context.Context, *GetCharacterTemplateRequest) (*GetCharacterTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacterTemplate not implemented")
+}
 func (UnimplementedDiscoveryServiceServer) mustEmbedUnimplementedDiscoveryServiceServer() {}
 func (UnimplementedDiscoveryServiceServer) testEmbeddedByValue()                          {}
 
@@ -173,6 +269,96 @@ func _DiscoveryService_ListDiscoveryEntries_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscoveryService_SetDiscoveryEntryHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiscoveryEntryHiddenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).SetDiscoveryEntryHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoveryService_SetDiscoveryEntryHidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).SetDiscoveryEntryHidden(ctx, req.(*SetDiscoveryEntryHiddenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_PublishCampaignListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCampaignListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).PublishCampaignListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoveryService_PublishCampaignListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).PublishCampaignListing(ctx, req.(*PublishCampaignListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_GetCampaignListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).GetCampaignListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoveryService_GetCampaignListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).GetCampaignListing(ctx, req.(*GetCampaignListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_CloseCampaignListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseCampaignListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).CloseCampaignListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoveryService_CloseCampaignListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).CloseCampaignListing(ctx, req.(*CloseCampaignListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_GetCharacterTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCharacterTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).GetCharacterTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoveryService_GetCharacterTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).GetCharacterTemplate(ctx, req.(*GetCharacterTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DiscoveryService_ServiceDesc is the grpc.ServiceDesc for DiscoveryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +378,26 @@ var DiscoveryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDiscoveryEntries",
 			Handler:    _DiscoveryService_ListDiscoveryEntries_Handler,
 		},
+		{
+			MethodName: "SetDiscoveryEntryHidden",
+			Handler:    _DiscoveryService_SetDiscoveryEntryHidden_Handler,
+		},
+		{
+			MethodName: "PublishCampaignListing",
+			Handler:    _DiscoveryService_PublishCampaignListing_Handler,
+		},
+		{
+			MethodName: "GetCampaignListing",
+			Handler:    _DiscoveryService_GetCampaignListing_Handler,
+		},
+		{
+			MethodName: "CloseCampaignListing",
+			Handler:    _DiscoveryService_CloseCampaignListing_Handler,
+		},
+		{
+			MethodName: "GetCharacterTemplate",
+			Handler:    _DiscoveryService_GetCharacterTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "discovery/v1/discovery.proto",
@@ -326,8 +326,12 @@ type Campaign struct {
 	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
 	// Most recent effective session timestamp for this campaign.
 	LatestSessionAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=latest_session_at,json=latestSessionAt,proto3" json:"latest_session_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// True while an operator moderation lock blocks every campaign write.
+	ModerationLocked bool `protobuf:"varint,20,opt,name=moderation_locked,json=moderationLocked,proto3" json:"moderation_locked,omitempty"`
+	// Operator-supplied reason for the active moderation lock.
+	ModerationReason string `protobuf:"bytes,21,opt,name=moderation_reason,json=moderationReason,proto3" json:"moderation_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Campaign) Reset() {
@@ -493,6 +497,20 @@ func (x *Campaign) GetLatestSessionAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Campaign) GetModerationLocked() bool {
+	if x != nil {
+		return x.ModerationLocked
+	}
+	return false
+}
+
+func (x *Campaign) GetModerationReason() string {
+	if x != nil {
+		return x.ModerationReason
+	}
+	return ""
+}
+
 type CreateCampaignRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Display name for the campaign.
@@ -815,8 +833,13 @@ func (x *GetCampaignRequest) GetCampaignId() string {
 }
 
 type GetCampaignResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Campaign *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	// Latest campaign journal seq; zero under outbox apply, where the journal
+	// may be ahead of the projected campaign record. Send a non-zero value as
+	// the x-fracturing-space-expected-seq metadata on the next mutation to
+	// reject the write if the campaign changed in between.
+	HeadSeq       uint64 `protobuf:"varint,2,opt,name=head_seq,json=headSeq,proto3" json:"head_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -858,6 +881,13 @@ func (x *GetCampaignResponse) GetCampaign() *Campaign {
 	return nil
 }
 
+func (x *GetCampaignResponse) GetHeadSeq() uint64 {
+	if x != nil {
+		return x.HeadSeq
+	}
+	return 0
+}
+
 type CampaignSessionReadinessBlocker struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Stable machine-readable blocker code.
@@ -1172,7 +1202,10 @@ type UpdateCampaignRequest struct {
 	// Optional updated free-form theme prompt.
 	ThemePrompt *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=theme_prompt,json=themePrompt,proto3" json:"theme_prompt,omitempty"`
 	// Optional updated locale.
-	Locale        v1.Locale `protobuf:"varint,4,opt,name=locale,proto3,enum=common.v1.Locale" json:"locale,omitempty"`
+	Locale v1.Locale `protobuf:"varint,4,opt,name=locale,proto3,enum=common.v1.Locale" json:"locale,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,5,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1235,6 +1268,13 @@ func (x *UpdateCampaignRequest) GetLocale() v1.Locale {
 	return v1.Locale(0)
 }
 
+func (x *UpdateCampaignRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
 type UpdateCampaignResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
@@ -1282,7 +1322,10 @@ func (x *UpdateCampaignResponse) GetCampaign() *Campaign {
 type EndCampaignRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The campaign ID to end.
-	CampaignId    string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,2,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1324,6 +1367,13 @@ func (x *EndCampaignRequest) GetCampaignId() string {
 	return ""
 }
 
+func (x *EndCampaignRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
 type EndCampaignResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
@@ -1371,7 +1421,10 @@ func (x *EndCampaignResponse) GetCampaign() *Campaign {
 type ArchiveCampaignRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The campaign ID to archive.
-	CampaignId    string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,2,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1413,6 +1466,13 @@ func (x *ArchiveCampaignRequest) GetCampaignId() string {
 	return ""
 }
 
+func (x *ArchiveCampaignRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
 type ArchiveCampaignResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
@@ -1457,17 +1517,137 @@ func (x *ArchiveCampaignResponse) GetCampaign() *Campaign {
 	return nil
 }
 
+type SetCampaignModerationLockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The campaign ID to lock or unlock.
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// True to lock the campaign, false to lift an existing lock.
+	Locked bool `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
+	// Operator-supplied reason; required when locking.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,4,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCampaignModerationLockRequest) Reset() {
+	*x = SetCampaignModerationLockRequest{}
+	mi := &file_game_v1_campaign_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCampaignModerationLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCampaignModerationLockRequest) ProtoMessage() {}
+
+func (x *SetCampaignModerationLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_campaign_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCampaignModerationLockRequest.ProtoReflect.Descriptor instead.
+func (*SetCampaignModerationLockRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetCampaignModerationLockRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SetCampaignModerationLockRequest) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *SetCampaignModerationLockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetCampaignModerationLockRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
+type SetCampaignModerationLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCampaignModerationLockResponse) Reset() {
+	*x = SetCampaignModerationLockResponse{}
+	mi := &file_game_v1_campaign_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCampaignModerationLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCampaignModerationLockResponse) ProtoMessage() {}
+
+func (x *SetCampaignModerationLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_campaign_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCampaignModerationLockResponse.ProtoReflect.Descriptor instead.
+func (*SetCampaignModerationLockResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetCampaignModerationLockResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
 type RestoreCampaignRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The campaign ID to restore.
-	CampaignId    string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,2,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RestoreCampaignRequest) Reset() {
 	*x = RestoreCampaignRequest{}
-	mi := &file_game_v1_campaign_proto_msgTypes[18]
+	mi := &file_game_v1_campaign_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1479,7 +1659,7 @@ func (x *RestoreCampaignRequest) String() string {
 func (*RestoreCampaignRequest) ProtoMessage() {}
 
 func (x *RestoreCampaignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[18]
+	mi := &file_game_v1_campaign_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1492,7 +1672,7 @@ func (x *RestoreCampaignRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreCampaignRequest.ProtoReflect.Descriptor instead.
 func (*RestoreCampaignRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{18}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RestoreCampaignRequest) GetCampaignId() string {
@@ -1502,6 +1682,13 @@ func (x *RestoreCampaignRequest) GetCampaignId() string {
 	return ""
 }
 
+func (x *RestoreCampaignRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
 type RestoreCampaignResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
@@ -1511,7 +1698,7 @@ type RestoreCampaignResponse struct {
 
 func (x *RestoreCampaignResponse) Reset() {
 	*x = RestoreCampaignResponse{}
-	mi := &file_game_v1_campaign_proto_msgTypes[19]
+	mi := &file_game_v1_campaign_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1523,7 +1710,7 @@ func (x *RestoreCampaignResponse) String() string {
 func (*RestoreCampaignResponse) ProtoMessage() {}
 
 func (x *RestoreCampaignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[19]
+	mi := &file_game_v1_campaign_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +1723,7 @@ func (x *RestoreCampaignResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreCampaignResponse.ProtoReflect.Descriptor instead.
 func (*RestoreCampaignResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{19}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RestoreCampaignResponse) GetCampaign() *Campaign {
@@ -1553,14 +1740,17 @@ type SetCampaignCoverRequest struct {
 	// Built-in campaign cover asset identifier.
 	CoverAssetId string `protobuf:"bytes,2,opt,name=cover_asset_id,json=coverAssetId,proto3" json:"cover_asset_id,omitempty"`
 	// Optional built-in campaign cover set identifier.
-	CoverSetId    string `protobuf:"bytes,3,opt,name=cover_set_id,json=coverSetId,proto3" json:"cover_set_id,omitempty"`
+	CoverSetId string `protobuf:"bytes,3,opt,name=cover_set_id,json=coverSetId,proto3" json:"cover_set_id,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,4,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetCampaignCoverRequest) Reset() {
 	*x = SetCampaignCoverRequest{}
-	mi := &file_game_v1_campaign_proto_msgTypes[20]
+	mi := &file_game_v1_campaign_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1572,7 +1762,7 @@ func (x *SetCampaignCoverRequest) String() string {
 func (*SetCampaignCoverRequest) ProtoMessage() {}
 
 func (x *SetCampaignCoverRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[20]
+	mi := &file_game_v1_campaign_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,7 +1775,7 @@ func (x *SetCampaignCoverRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCampaignCoverRequest.ProtoReflect.Descriptor instead.
 func (*SetCampaignCoverRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{20}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SetCampaignCoverRequest) GetCampaignId() string {
@@ -1609,6 +1799,13 @@ func (x *SetCampaignCoverRequest) GetCoverSetId() string {
 	return ""
 }
 
+func (x *SetCampaignCoverRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
 type SetCampaignCoverResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
@@ -1618,7 +1815,7 @@ type SetCampaignCoverResponse struct {
 
 func (x *SetCampaignCoverResponse) Reset() {
 	*x = SetCampaignCoverResponse{}
-	mi := &file_game_v1_campaign_proto_msgTypes[21]
+	mi := &file_game_v1_campaign_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1630,7 +1827,7 @@ func (x *SetCampaignCoverResponse) String() string {
 func (*SetCampaignCoverResponse) ProtoMessage() {}
 
 func (x *SetCampaignCoverResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[21]
+	mi := &file_game_v1_campaign_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,7 +1840,7 @@ func (x *SetCampaignCoverResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCampaignCoverResponse.ProtoReflect.Descriptor instead.
 func (*SetCampaignCoverResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{21}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SetCampaignCoverResponse) GetCampaign() *Campaign {
@@ -1658,14 +1855,17 @@ type SetCampaignAIBindingRequest struct {
 	// The campaign ID to update.
 	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 	// The AI service opaque agent identifier to bind.
-	AiAgentId     string `protobuf:"bytes,2,opt,name=ai_agent_id,json=aiAgentId,proto3" json:"ai_agent_id,omitempty"`
+	AiAgentId string `protobuf:"bytes,2,opt,name=ai_agent_id,json=aiAgentId,proto3" json:"ai_agent_id,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,3,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetCampaignAIBindingRequest) Reset() {
 	*x = SetCampaignAIBindingRequest{}
-	mi := &file_game_v1_campaign_proto_msgTypes[22]
+	mi := &file_game_v1_campaign_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1677,7 +1877,7 @@ func (x *SetCampaignAIBindingRequest) String() string {
 func (*SetCampaignAIBindingRequest) ProtoMessage() {}
 
 func (x *SetCampaignAIBindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[22]
+	mi := &file_game_v1_campaign_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,7 +1890,7 @@ func (x *SetCampaignAIBindingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCampaignAIBindingRequest.ProtoReflect.Descriptor instead.
 func (*SetCampaignAIBindingRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{22}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SetCampaignAIBindingRequest) GetCampaignId() string {
@@ -1707,6 +1907,13 @@ func (x *SetCampaignAIBindingRequest) GetAiAgentId() string {
 	return ""
 }
 
+func (x *SetCampaignAIBindingRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
 type SetCampaignAIBindingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
@@ -1716,7 +1923,7 @@ type SetCampaignAIBindingResponse struct {
 
 func (x *SetCampaignAIBindingResponse) Reset() {
 	*x = SetCampaignAIBindingResponse{}
-	mi := &file_game_v1_campaign_proto_msgTypes[23]
+	mi := &file_game_v1_campaign_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1728,7 +1935,7 @@ func (x *SetCampaignAIBindingResponse) String() string {
 func (*SetCampaignAIBindingResponse) ProtoMessage() {}
 
 func (x *SetCampaignAIBindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[23]
+	mi := &file_game_v1_campaign_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +1948,7 @@ func (x *SetCampaignAIBindingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCampaignAIBindingResponse.ProtoReflect.Descriptor instead.
 func (*SetCampaignAIBindingResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{23}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SetCampaignAIBindingResponse) GetCampaign() *Campaign {
@@ -1754,14 +1961,17 @@ func (x *SetCampaignAIBindingResponse) GetCampaign() *Campaign {
 type ClearCampaignAIBindingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The campaign ID to update.
-	CampaignId    string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional campaign event seq the caller last observed. When set, the
+	// write fails with FAILED_PRECONDITION if the campaign has moved past it.
+	ExpectedSeq   uint64 `protobuf:"varint,2,opt,name=expected_seq,json=expectedSeq,proto3" json:"expected_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClearCampaignAIBindingRequest) Reset() {
 	*x = ClearCampaignAIBindingRequest{}
-	mi := &file_game_v1_campaign_proto_msgTypes[24]
+	mi := &file_game_v1_campaign_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1773,7 +1983,7 @@ func (x *ClearCampaignAIBindingRequest) String() string {
 func (*ClearCampaignAIBindingRequest) ProtoMessage() {}
 
 func (x *ClearCampaignAIBindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[24]
+	mi := &file_game_v1_campaign_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,7 +1996,7 @@ func (x *ClearCampaignAIBindingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCampaignAIBindingRequest.ProtoReflect.Descriptor instead.
 func (*ClearCampaignAIBindingRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{24}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ClearCampaignAIBindingRequest) GetCampaignId() string {
@@ -1796,6 +2006,13 @@ func (x *ClearCampaignAIBindingRequest) GetCampaignId() string {
 	return ""
 }
 
+func (x *ClearCampaignAIBindingRequest) GetExpectedSeq() uint64 {
+	if x != nil {
+		return x.ExpectedSeq
+	}
+	return 0
+}
+
 type ClearCampaignAIBindingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
@@ -1805,7 +2022,7 @@ type ClearCampaignAIBindingResponse struct {
 
 func (x *ClearCampaignAIBindingResponse) Reset() {
 	*x = ClearCampaignAIBindingResponse{}
-	mi := &file_game_v1_campaign_proto_msgTypes[25]
+	mi := &file_game_v1_campaign_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1817,7 +2034,7 @@ func (x *ClearCampaignAIBindingResponse) String() string {
 func (*ClearCampaignAIBindingResponse) ProtoMessage() {}
 
 func (x *ClearCampaignAIBindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[25]
+	mi := &file_game_v1_campaign_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1830,7 +2047,7 @@ func (x *ClearCampaignAIBindingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCampaignAIBindingResponse.ProtoReflect.Descriptor instead.
 func (*ClearCampaignAIBindingResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{25}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ClearCampaignAIBindingResponse) GetCampaign() *Campaign {
@@ -1845,14 +2062,17 @@ type IssueCampaignAISessionGrantRequest struct {
 	// The campaign ID to issue the grant for.
 	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 	// Expected active session ID.
-	SessionId     string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Optional AI-controlled player participant the grant acts as. When empty
+	// the grant is issued for the campaign's AI GM participant.
+	ParticipantId string `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IssueCampaignAISessionGrantRequest) Reset() {
 	*x = IssueCampaignAISessionGrantRequest{}
-	mi := &file_game_v1_campaign_proto_msgTypes[26]
+	mi := &file_game_v1_campaign_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1864,7 +2084,7 @@ func (x *IssueCampaignAISessionGrantRequest) String() string {
 func (*IssueCampaignAISessionGrantRequest) ProtoMessage() {}
 
 func (x *IssueCampaignAISessionGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[26]
+	mi := &file_game_v1_campaign_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1877,7 +2097,7 @@ func (x *IssueCampaignAISessionGrantRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use IssueCampaignAISessionGrantRequest.ProtoReflect.Descriptor instead.
 func (*IssueCampaignAISessionGrantRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{26}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *IssueCampaignAISessionGrantRequest) GetCampaignId() string {
@@ -1894,6 +2114,13 @@ func (x *IssueCampaignAISessionGrantRequest) GetSessionId() string {
 	return ""
 }
 
+func (x *IssueCampaignAISessionGrantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
 type IssueCampaignAISessionGrantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Grant         *AISessionGrant        `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
@@ -1903,7 +2130,7 @@ type IssueCampaignAISessionGrantResponse struct {
 
 func (x *IssueCampaignAISessionGrantResponse) Reset() {
 	*x = IssueCampaignAISessionGrantResponse{}
-	mi := &file_game_v1_campaign_proto_msgTypes[27]
+	mi := &file_game_v1_campaign_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1915,7 +2142,7 @@ func (x *IssueCampaignAISessionGrantResponse) String() string {
 func (*IssueCampaignAISessionGrantResponse) ProtoMessage() {}
 
 func (x *IssueCampaignAISessionGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[27]
+	mi := &file_game_v1_campaign_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,7 +2155,7 @@ func (x *IssueCampaignAISessionGrantResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use IssueCampaignAISessionGrantResponse.ProtoReflect.Descriptor instead.
 func (*IssueCampaignAISessionGrantResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{27}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *IssueCampaignAISessionGrantResponse) GetGrant() *AISessionGrant {
@@ -1958,7 +2185,7 @@ type AISessionGrant struct {
 
 func (x *AISessionGrant) Reset() {
 	*x = AISessionGrant{}
-	mi := &file_game_v1_campaign_proto_msgTypes[28]
+	mi := &file_game_v1_campaign_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1970,7 +2197,7 @@ func (x *AISessionGrant) String() string {
 func (*AISessionGrant) ProtoMessage() {}
 
 func (x *AISessionGrant) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[28]
+	mi := &file_game_v1_campaign_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,7 +2210,7 @@ func (x *AISessionGrant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AISessionGrant.ProtoReflect.Descriptor instead.
 func (*AISessionGrant) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{28}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AISessionGrant) GetToken() string {
@@ -2059,7 +2286,7 @@ type GetCampaignAIBindingUsageRequest struct {
 
 func (x *GetCampaignAIBindingUsageRequest) Reset() {
 	*x = GetCampaignAIBindingUsageRequest{}
-	mi := &file_game_v1_campaign_proto_msgTypes[29]
+	mi := &file_game_v1_campaign_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2071,7 +2298,7 @@ func (x *GetCampaignAIBindingUsageRequest) String() string {
 func (*GetCampaignAIBindingUsageRequest) ProtoMessage() {}
 
 func (x *GetCampaignAIBindingUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[29]
+	mi := &file_game_v1_campaign_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,7 +2311,7 @@ func (x *GetCampaignAIBindingUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignAIBindingUsageRequest.ProtoReflect.Descriptor instead.
 func (*GetCampaignAIBindingUsageRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{29}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetCampaignAIBindingUsageRequest) GetAiAgentId() string {
@@ -2106,7 +2333,7 @@ type GetCampaignAIBindingUsageResponse struct {
 
 func (x *GetCampaignAIBindingUsageResponse) Reset() {
 	*x = GetCampaignAIBindingUsageResponse{}
-	mi := &file_game_v1_campaign_proto_msgTypes[30]
+	mi := &file_game_v1_campaign_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2118,7 +2345,7 @@ func (x *GetCampaignAIBindingUsageResponse) String() string {
 func (*GetCampaignAIBindingUsageResponse) ProtoMessage() {}
 
 func (x *GetCampaignAIBindingUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[30]
+	mi := &file_game_v1_campaign_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,7 +2358,7 @@ func (x *GetCampaignAIBindingUsageResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetCampaignAIBindingUsageResponse.ProtoReflect.Descriptor instead.
 func (*GetCampaignAIBindingUsageResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{30}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetCampaignAIBindingUsageResponse) GetActiveCampaignCount() int32 {
@@ -2158,7 +2385,7 @@ type GetCampaignAIAuthStateRequest struct {
 
 func (x *GetCampaignAIAuthStateRequest) Reset() {
 	*x = GetCampaignAIAuthStateRequest{}
-	mi := &file_game_v1_campaign_proto_msgTypes[31]
+	mi := &file_game_v1_campaign_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2170,7 +2397,7 @@ func (x *GetCampaignAIAuthStateRequest) String() string {
 func (*GetCampaignAIAuthStateRequest) ProtoMessage() {}
 
 func (x *GetCampaignAIAuthStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[31]
+	mi := &file_game_v1_campaign_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,7 +2410,7 @@ func (x *GetCampaignAIAuthStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignAIAuthStateRequest.ProtoReflect.Descriptor instead.
 func (*GetCampaignAIAuthStateRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{31}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetCampaignAIAuthStateRequest) GetCampaignId() string {
@@ -2206,7 +2433,7 @@ type GetCampaignAIAuthStateResponse struct {
 
 func (x *GetCampaignAIAuthStateResponse) Reset() {
 	*x = GetCampaignAIAuthStateResponse{}
-	mi := &file_game_v1_campaign_proto_msgTypes[32]
+	mi := &file_game_v1_campaign_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2218,7 +2445,7 @@ func (x *GetCampaignAIAuthStateResponse) String() string {
 func (*GetCampaignAIAuthStateResponse) ProtoMessage() {}
 
 func (x *GetCampaignAIAuthStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_campaign_proto_msgTypes[32]
+	mi := &file_game_v1_campaign_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2231,7 +2458,7 @@ func (x *GetCampaignAIAuthStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignAIAuthStateResponse.ProtoReflect.Descriptor instead.
 func (*GetCampaignAIAuthStateResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_campaign_proto_rawDescGZIP(), []int{32}
+	return file_game_v1_campaign_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetCampaignAIAuthStateResponse) GetCampaignId() string {
@@ -2283,7 +2510,7 @@ var file_game_v1_campaign_proto_rawDesc = string([]byte{
 	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
 	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31,
 	0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xf3, 0x06, 0x0a, 0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12,
+	0x74, 0x6f, 0x22, 0xcd, 0x07, 0x0a, 0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20,
@@ -2338,204 +2565,244 @@ var file_game_v1_campaign_proto_rawDesc = string([]byte{
 	0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x22, 0xc7, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x0a, 0x07, 0x67, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x67, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x2f, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x42, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x70, 0x72,
-	0x6f, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6d,
-	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
-	0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x41, 0x0a, 0x11,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x10, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x14,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x33, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x70, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-	0x0a, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0xc7, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
+	0x28, 0x0a, 0x07, 0x67, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6d, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x06, 0x67, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a,
+	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x41, 0x0a, 0x11, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x10, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22,
+	0x70, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x09,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x52, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x12,
-	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0xa1, 0x02, 0x0a, 0x1f, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x3f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x02,
+	0x0a, 0x1e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x19, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x15,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x76, 0x0a, 0x18, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x12, 0x44, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x08,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x66, 0x0a, 0x23, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x3f, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x12, 0x29, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x22, 0x47,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x58, 0x0a, 0x12, 0x45, 0x6e, 0x64, 0x43, 0x61,
 	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
 	0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x44,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x22, 0xa1, 0x02, 0x0a, 0x1f, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73,
-	0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x02, 0x0a, 0x1e, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x3e, 0x0a,
-	0x1b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x19, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x58, 0x0a,
-	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x18, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x44, 0x0a,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x06, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x66, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69,
-	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09,
-	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x22, 0xd6, 0x01,
-	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x68,
-	0x65, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b,
-	0x74, 0x68, 0x65, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x06,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x71, 0x22, 0x44, 0x0a, 0x13, 0x45, 0x6e, 0x64, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x5c, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x71, 0x22, 0x48, 0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
 	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d,
 	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22,
-	0x35, 0x0a, 0x12, 0x45, 0x6e, 0x64, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x13, 0x45, 0x6e, 0x64, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x96, 0x01, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x22, 0x52, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
 	0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x39, 0x0a, 0x16,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x22, 0x39, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x17,
+	0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x5c, 0x0a, 0x16,
 	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x18, 0x53,
-	0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x5e, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x69, 0x5f, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x69, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x40, 0x0a, 0x1d, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
 	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x1e, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08,
-	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x64, 0x0a, 0x22, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x54,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x22, 0x48, 0x0a, 0x17, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x22, 0x49, 0x0a, 0x18,
+	0x53, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x69, 0x5f, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x69, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x22, 0x4d, 0x0a, 0x1c, 0x53,
+	0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x63, 0x0a, 0x1d, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x22,
+	0x4f, 0x0a, 0x1e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x22, 0x8b, 0x01, 0x0a, 0x22, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x41, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x54,
 	0x0a, 0x23, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
 	0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01,
@@ -2643,7 +2910,7 @@ var file_game_v1_campaign_proto_rawDesc = string([]byte{
 	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x52,
 	0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d,
 	0x41, 0x4e, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e,
-	0x54, 0x53, 0x10, 0x05, 0x32, 0xea, 0x07, 0x0a, 0x0f, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x54, 0x53, 0x10, 0x05, 0x32, 0xde, 0x08, 0x0a, 0x0f, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
 	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x6d,
 	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61,
@@ -2706,34 +2973,42 @@ var file_game_v1_campaign_proto_rawDesc = string([]byte{
 	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
 	0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xec, 0x02, 0x0a, 0x11, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x49, 0x73, 0x73, 0x75, 0x65,
-	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73,
-	0x73, 0x75, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x72, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
-	0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70,
+	0x65, 0x12, 0x72, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x29,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xec, 0x02, 0x0a, 0x11, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x41, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x41, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
+	0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70,
 	0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
-	0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x26, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d,
-	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x41,
-	0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x6f, 0x75, 0x69, 0x73, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x2f, 0x66, 0x72, 0x61, 0x63, 0x74,
-	0x75, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x67,
-	0x61, 0x6d, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x65, 0x12, 0x29, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x41, 0x49, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x49, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x49, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69, 0x73, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x2f, 0x66,
+	0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x67, 0x61, 0x6d, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -2749,7 +3024,7 @@ func file_game_v1_campaign_proto_rawDescGZIP() []byte {
 }
 
 var file_game_v1_campaign_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_game_v1_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_game_v1_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_game_v1_campaign_proto_goTypes = []any{
 	(CampaignStatus)(0),                         // 0: game.v1.CampaignStatus
 	(GmMode)(0),                                 // 1: game.v1.GmMode
@@ -2774,102 +3049,107 @@ var file_game_v1_campaign_proto_goTypes = []any{
 	(*EndCampaignResponse)(nil),                 // 20: game.v1.EndCampaignResponse
 	(*ArchiveCampaignRequest)(nil),              // 21: game.v1.ArchiveCampaignRequest
 	(*ArchiveCampaignResponse)(nil),             // 22: game.v1.ArchiveCampaignResponse
-	(*RestoreCampaignRequest)(nil),              // 23: game.v1.RestoreCampaignRequest
-	(*RestoreCampaignResponse)(nil),             // 24: game.v1.RestoreCampaignResponse
-	(*SetCampaignCoverRequest)(nil),             // 25: game.v1.SetCampaignCoverRequest
-	(*SetCampaignCoverResponse)(nil),            // 26: game.v1.SetCampaignCoverResponse
-	(*SetCampaignAIBindingRequest)(nil),         // 27: game.v1.SetCampaignAIBindingRequest
-	(*SetCampaignAIBindingResponse)(nil),        // 28: game.v1.SetCampaignAIBindingResponse
-	(*ClearCampaignAIBindingRequest)(nil),       // 29: game.v1.ClearCampaignAIBindingRequest
-	(*ClearCampaignAIBindingResponse)(nil),      // 30: game.v1.ClearCampaignAIBindingResponse
-	(*IssueCampaignAISessionGrantRequest)(nil),  // 31: game.v1.IssueCampaignAISessionGrantRequest
-	(*IssueCampaignAISessionGrantResponse)(nil), // 32: game.v1.IssueCampaignAISessionGrantResponse
-	(*AISessionGrant)(nil),                      // 33: game.v1.AISessionGrant
-	(*GetCampaignAIBindingUsageRequest)(nil),    // 34: game.v1.GetCampaignAIBindingUsageRequest
-	(*GetCampaignAIBindingUsageResponse)(nil),   // 35: game.v1.GetCampaignAIBindingUsageResponse
-	(*GetCampaignAIAuthStateRequest)(nil),       // 36: game.v1.GetCampaignAIAuthStateRequest
-	(*GetCampaignAIAuthStateResponse)(nil),      // 37: game.v1.GetCampaignAIAuthStateResponse
-	nil,                                         // 38: game.v1.CampaignSessionReadinessBlocker.MetadataEntry
-	(v1.GameSystem)(0),                          // 39: common.v1.GameSystem
-	(v1.Locale)(0),                              // 40: common.v1.Locale
-	(*timestamppb.Timestamp)(nil),               // 41: google.protobuf.Timestamp
-	(*Participant)(nil),                         // 42: game.v1.Participant
-	(*wrapperspb.StringValue)(nil),              // 43: google.protobuf.StringValue
+	(*SetCampaignModerationLockRequest)(nil),    // 23: game.v1.SetCampaignModerationLockRequest
+	(*SetCampaignModerationLockResponse)(nil),   // 24: game.v1.SetCampaignModerationLockResponse
+	(*RestoreCampaignRequest)(nil),              // 25: game.v1.RestoreCampaignRequest
+	(*RestoreCampaignResponse)(nil),             // 26: game.v1.RestoreCampaignResponse
+	(*SetCampaignCoverRequest)(nil),             // 27: game.v1.SetCampaignCoverRequest
+	(*SetCampaignCoverResponse)(nil),            // 28: game.v1.SetCampaignCoverResponse
+	(*SetCampaignAIBindingRequest)(nil),         // 29: game.v1.SetCampaignAIBindingRequest
+	(*SetCampaignAIBindingResponse)(nil),        // 30: game.v1.SetCampaignAIBindingResponse
+	(*ClearCampaignAIBindingRequest)(nil),       // 31: game.v1.ClearCampaignAIBindingRequest
+	(*ClearCampaignAIBindingResponse)(nil),      // 32: game.v1.ClearCampaignAIBindingResponse
+	(*IssueCampaignAISessionGrantRequest)(nil),  // 33: game.v1.IssueCampaignAISessionGrantRequest
+	(*IssueCampaignAISessionGrantResponse)(nil), // 34: game.v1.IssueCampaignAISessionGrantResponse
+	(*AISessionGrant)(nil),                      // 35: game.v1.AISessionGrant
+	(*GetCampaignAIBindingUsageRequest)(nil),    // 36: game.v1.GetCampaignAIBindingUsageRequest
+	(*GetCampaignAIBindingUsageResponse)(nil),   // 37: game.v1.GetCampaignAIBindingUsageResponse
+	(*GetCampaignAIAuthStateRequest)(nil),       // 38: game.v1.GetCampaignAIAuthStateRequest
+	(*GetCampaignAIAuthStateResponse)(nil),      // 39: game.v1.GetCampaignAIAuthStateResponse
+	nil,                                         // 40: game.v1.CampaignSessionReadinessBlocker.MetadataEntry
+	(v1.GameSystem)(0),                          // 41: common.v1.GameSystem
+	(v1.Locale)(0),                              // 42: common.v1.Locale
+	(*timestamppb.Timestamp)(nil),               // 43: google.protobuf.Timestamp
+	(*Participant)(nil),                         // 44: game.v1.Participant
+	(*wrapperspb.StringValue)(nil),              // 45: google.protobuf.StringValue
 }
 var file_game_v1_campaign_proto_depIdxs = []int32{
-	39, // 0: game.v1.Campaign.system:type_name -> common.v1.GameSystem
+	41, // 0: game.v1.Campaign.system:type_name -> common.v1.GameSystem
 	1,  // 1: game.v1.Campaign.gm_mode:type_name -> game.v1.GmMode
 	2,  // 2: game.v1.Campaign.intent:type_name -> game.v1.CampaignIntent
 	3,  // 3: game.v1.Campaign.access_policy:type_name -> game.v1.CampaignAccessPolicy
 	0,  // 4: game.v1.Campaign.status:type_name -> game.v1.CampaignStatus
-	40, // 5: game.v1.Campaign.locale:type_name -> common.v1.Locale
-	41, // 6: game.v1.Campaign.created_at:type_name -> google.protobuf.Timestamp
-	41, // 7: game.v1.Campaign.updated_at:type_name -> google.protobuf.Timestamp
-	41, // 8: game.v1.Campaign.completed_at:type_name -> google.protobuf.Timestamp
-	41, // 9: game.v1.Campaign.archived_at:type_name -> google.protobuf.Timestamp
-	41, // 10: game.v1.Campaign.latest_session_at:type_name -> google.protobuf.Timestamp
-	39, // 11: game.v1.CreateCampaignRequest.system:type_name -> common.v1.GameSystem
+	42, // 5: game.v1.Campaign.locale:type_name -> common.v1.Locale
+	43, // 6: game.v1.Campaign.created_at:type_name -> google.protobuf.Timestamp
+	43, // 7: game.v1.Campaign.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 8: game.v1.Campaign.completed_at:type_name -> google.protobuf.Timestamp
+	43, // 9: game.v1.Campaign.archived_at:type_name -> google.protobuf.Timestamp
+	43, // 10: game.v1.Campaign.latest_session_at:type_name -> google.protobuf.Timestamp
+	41, // 11: game.v1.CreateCampaignRequest.system:type_name -> common.v1.GameSystem
 	1,  // 12: game.v1.CreateCampaignRequest.gm_mode:type_name -> game.v1.GmMode
 	2,  // 13: game.v1.CreateCampaignRequest.intent:type_name -> game.v1.CampaignIntent
 	3,  // 14: game.v1.CreateCampaignRequest.access_policy:type_name -> game.v1.CampaignAccessPolicy
-	40, // 15: game.v1.CreateCampaignRequest.locale:type_name -> common.v1.Locale
+	42, // 15: game.v1.CreateCampaignRequest.locale:type_name -> common.v1.Locale
 	5,  // 16: game.v1.CreateCampaignResponse.campaign:type_name -> game.v1.Campaign
-	42, // 17: game.v1.CreateCampaignResponse.owner_participant:type_name -> game.v1.Participant
+	44, // 17: game.v1.CreateCampaignResponse.owner_participant:type_name -> game.v1.Participant
 	0,  // 18: game.v1.ListCampaignsRequest.statuses:type_name -> game.v1.CampaignStatus
 	5,  // 19: game.v1.ListCampaignsResponse.campaigns:type_name -> game.v1.Campaign
 	5,  // 20: game.v1.GetCampaignResponse.campaign:type_name -> game.v1.Campaign
-	38, // 21: game.v1.CampaignSessionReadinessBlocker.metadata:type_name -> game.v1.CampaignSessionReadinessBlocker.MetadataEntry
+	40, // 21: game.v1.CampaignSessionReadinessBlocker.metadata:type_name -> game.v1.CampaignSessionReadinessBlocker.MetadataEntry
 	13, // 22: game.v1.CampaignSessionReadinessBlocker.action:type_name -> game.v1.CampaignSessionReadinessAction
 	4,  // 23: game.v1.CampaignSessionReadinessAction.resolution_kind:type_name -> game.v1.CampaignSessionReadinessResolutionKind
 	12, // 24: game.v1.CampaignSessionReadiness.blockers:type_name -> game.v1.CampaignSessionReadinessBlocker
-	40, // 25: game.v1.GetCampaignSessionReadinessRequest.locale:type_name -> common.v1.Locale
+	42, // 25: game.v1.GetCampaignSessionReadinessRequest.locale:type_name -> common.v1.Locale
 	14, // 26: game.v1.GetCampaignSessionReadinessResponse.readiness:type_name -> game.v1.CampaignSessionReadiness
-	43, // 27: game.v1.UpdateCampaignRequest.name:type_name -> google.protobuf.StringValue
-	43, // 28: game.v1.UpdateCampaignRequest.theme_prompt:type_name -> google.protobuf.StringValue
-	40, // 29: game.v1.UpdateCampaignRequest.locale:type_name -> common.v1.Locale
+	45, // 27: game.v1.UpdateCampaignRequest.name:type_name -> google.protobuf.StringValue
+	45, // 28: game.v1.UpdateCampaignRequest.theme_prompt:type_name -> google.protobuf.StringValue
+	42, // 29: game.v1.UpdateCampaignRequest.locale:type_name -> common.v1.Locale
 	5,  // 30: game.v1.UpdateCampaignResponse.campaign:type_name -> game.v1.Campaign
 	5,  // 31: game.v1.EndCampaignResponse.campaign:type_name -> game.v1.Campaign
 	5,  // 32: game.v1.ArchiveCampaignResponse.campaign:type_name -> game.v1.Campaign
-	5,  // 33: game.v1.RestoreCampaignResponse.campaign:type_name -> game.v1.Campaign
-	5,  // 34: game.v1.SetCampaignCoverResponse.campaign:type_name -> game.v1.Campaign
-	5,  // 35: game.v1.SetCampaignAIBindingResponse.campaign:type_name -> game.v1.Campaign
-	5,  // 36: game.v1.ClearCampaignAIBindingResponse.campaign:type_name -> game.v1.Campaign
-	33, // 37: game.v1.IssueCampaignAISessionGrantResponse.grant:type_name -> game.v1.AISessionGrant
-	41, // 38: game.v1.AISessionGrant.issued_at:type_name -> google.protobuf.Timestamp
-	41, // 39: game.v1.AISessionGrant.expires_at:type_name -> google.protobuf.Timestamp
-	6,  // 40: game.v1.CampaignService.CreateCampaign:input_type -> game.v1.CreateCampaignRequest
-	8,  // 41: game.v1.CampaignService.ListCampaigns:input_type -> game.v1.ListCampaignsRequest
-	10, // 42: game.v1.CampaignService.GetCampaign:input_type -> game.v1.GetCampaignRequest
-	15, // 43: game.v1.CampaignService.GetCampaignSessionReadiness:input_type -> game.v1.GetCampaignSessionReadinessRequest
-	17, // 44: game.v1.CampaignService.UpdateCampaign:input_type -> game.v1.UpdateCampaignRequest
-	19, // 45: game.v1.CampaignService.EndCampaign:input_type -> game.v1.EndCampaignRequest
-	21, // 46: game.v1.CampaignService.ArchiveCampaign:input_type -> game.v1.ArchiveCampaignRequest
-	23, // 47: game.v1.CampaignService.RestoreCampaign:input_type -> game.v1.RestoreCampaignRequest
-	25, // 48: game.v1.CampaignService.SetCampaignCover:input_type -> game.v1.SetCampaignCoverRequest
-	27, // 49: game.v1.CampaignService.SetCampaignAIBinding:input_type -> game.v1.SetCampaignAIBindingRequest
-	29, // 50: game.v1.CampaignService.ClearCampaignAIBinding:input_type -> game.v1.ClearCampaignAIBindingRequest
-	31, // 51: game.v1.CampaignAIService.IssueCampaignAISessionGrant:input_type -> game.v1.IssueCampaignAISessionGrantRequest
-	34, // 52: game.v1.CampaignAIService.GetCampaignAIBindingUsage:input_type -> game.v1.GetCampaignAIBindingUsageRequest
-	36, // 53: game.v1.CampaignAIService.GetCampaignAIAuthState:input_type -> game.v1.GetCampaignAIAuthStateRequest
-	7,  // 54: game.v1.CampaignService.CreateCampaign:output_type -> game.v1.CreateCampaignResponse
-	9,  // 55: game.v1.CampaignService.ListCampaigns:output_type -> game.v1.ListCampaignsResponse
-	11, // 56: game.v1.CampaignService.GetCampaign:output_type -> game.v1.GetCampaignResponse
-	16, // 57: game.v1.CampaignService.GetCampaignSessionReadiness:output_type -> game.v1.GetCampaignSessionReadinessResponse
-	18, // 58: game.v1.CampaignService.UpdateCampaign:output_type -> game.v1.UpdateCampaignResponse
-	20, // 59: game.v1.CampaignService.EndCampaign:output_type -> game.v1.EndCampaignResponse
-	22, // 60: game.v1.CampaignService.ArchiveCampaign:output_type -> game.v1.ArchiveCampaignResponse
-	24, // 61: game.v1.CampaignService.RestoreCampaign:output_type -> game.v1.RestoreCampaignResponse
-	26, // 62: game.v1.CampaignService.SetCampaignCover:output_type -> game.v1.SetCampaignCoverResponse
-	28, // 63: game.v1.CampaignService.SetCampaignAIBinding:output_type -> game.v1.SetCampaignAIBindingResponse
-	30, // 64: game.v1.CampaignService.ClearCampaignAIBinding:output_type -> game.v1.ClearCampaignAIBindingResponse
-	32, // 65: game.v1.CampaignAIService.IssueCampaignAISessionGrant:output_type -> game.v1.IssueCampaignAISessionGrantResponse
-	35, // 66: game.v1.CampaignAIService.GetCampaignAIBindingUsage:output_type -> game.v1.GetCampaignAIBindingUsageResponse
-	37, // 67: game.v1.CampaignAIService.GetCampaignAIAuthState:output_type -> game.v1.GetCampaignAIAuthStateResponse
-	54, // [54:68] is the sub-list for method output_type
-	40, // [40:54] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	5,  // 33: game.v1.SetCampaignModerationLockResponse.campaign:type_name -> game.v1.Campaign
+	5,  // 34: game.v1.RestoreCampaignResponse.campaign:type_name -> game.v1.Campaign
+	5,  // 35: game.v1.SetCampaignCoverResponse.campaign:type_name -> game.v1.Campaign
+	5,  // 36: game.v1.SetCampaignAIBindingResponse.campaign:type_name -> game.v1.Campaign
+	5,  // 37: game.v1.ClearCampaignAIBindingResponse.campaign:type_name -> game.v1.Campaign
+	35, // 38: game.v1.IssueCampaignAISessionGrantResponse.grant:type_name -> game.v1.AISessionGrant
+	43, // 39: game.v1.AISessionGrant.issued_at:type_name -> google.protobuf.Timestamp
+	43, // 40: game.v1.AISessionGrant.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 41: game.v1.CampaignService.CreateCampaign:input_type -> game.v1.CreateCampaignRequest
+	8,  // 42: game.v1.CampaignService.ListCampaigns:input_type -> game.v1.ListCampaignsRequest
+	10, // 43: game.v1.CampaignService.GetCampaign:input_type -> game.v1.GetCampaignRequest
+	15, // 44: game.v1.CampaignService.GetCampaignSessionReadiness:input_type -> game.v1.GetCampaignSessionReadinessRequest
+	17, // 45: game.v1.CampaignService.UpdateCampaign:input_type -> game.v1.UpdateCampaignRequest
+	19, // 46: game.v1.CampaignService.EndCampaign:input_type -> game.v1.EndCampaignRequest
+	21, // 47: game.v1.CampaignService.ArchiveCampaign:input_type -> game.v1.ArchiveCampaignRequest
+	25, // 48: game.v1.CampaignService.RestoreCampaign:input_type -> game.v1.RestoreCampaignRequest
+	27, // 49: game.v1.CampaignService.SetCampaignCover:input_type -> game.v1.SetCampaignCoverRequest
+	29, // 50: game.v1.CampaignService.SetCampaignAIBinding:input_type -> game.v1.SetCampaignAIBindingRequest
+	31, // 51: game.v1.CampaignService.ClearCampaignAIBinding:input_type -> game.v1.ClearCampaignAIBindingRequest
+	23, // 52: game.v1.CampaignService.SetCampaignModerationLock:input_type -> game.v1.SetCampaignModerationLockRequest
+	33, // 53: game.v1.CampaignAIService.IssueCampaignAISessionGrant:input_type -> game.v1.IssueCampaignAISessionGrantRequest
+	36, // 54: game.v1.CampaignAIService.GetCampaignAIBindingUsage:input_type -> game.v1.GetCampaignAIBindingUsageRequest
+	38, // 55: game.v1.CampaignAIService.GetCampaignAIAuthState:input_type -> game.v1.GetCampaignAIAuthStateRequest
+	7,  // 56: game.v1.CampaignService.CreateCampaign:output_type -> game.v1.CreateCampaignResponse
+	9,  // 57: game.v1.CampaignService.ListCampaigns:output_type -> game.v1.ListCampaignsResponse
+	11, // 58: game.v1.CampaignService.GetCampaign:output_type -> game.v1.GetCampaignResponse
+	16, // 59: game.v1.CampaignService.GetCampaignSessionReadiness:output_type -> game.v1.GetCampaignSessionReadinessResponse
+	18, // 60: game.v1.CampaignService.UpdateCampaign:output_type -> game.v1.UpdateCampaignResponse
+	20, // 61: game.v1.CampaignService.EndCampaign:output_type -> game.v1.EndCampaignResponse
+	22, // 62: game.v1.CampaignService.ArchiveCampaign:output_type -> game.v1.ArchiveCampaignResponse
+	26, // 63: game.v1.CampaignService.RestoreCampaign:output_type -> game.v1.RestoreCampaignResponse
+	28, // 64: game.v1.CampaignService.SetCampaignCover:output_type -> game.v1.SetCampaignCoverResponse
+	30, // 65: game.v1.CampaignService.SetCampaignAIBinding:output_type -> game.v1.SetCampaignAIBindingResponse
+	32, // 66: game.v1.CampaignService.ClearCampaignAIBinding:output_type -> game.v1.ClearCampaignAIBindingResponse
+	24, // 67: game.v1.CampaignService.SetCampaignModerationLock:output_type -> game.v1.SetCampaignModerationLockResponse
+	34, // 68: game.v1.CampaignAIService.IssueCampaignAISessionGrant:output_type -> game.v1.IssueCampaignAISessionGrantResponse
+	37, // 69: game.v1.CampaignAIService.GetCampaignAIBindingUsage:output_type -> game.v1.GetCampaignAIBindingUsageResponse
+	39, // 70: game.v1.CampaignAIService.GetCampaignAIAuthState:output_type -> game.v1.GetCampaignAIAuthStateResponse
+	56, // [56:71] is the sub-list for method output_type
+	41, // [41:56] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_game_v1_campaign_proto_init() }
@@ -2884,7 +3164,7 @@ func file_game_v1_campaign_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_campaign_proto_rawDesc), len(file_game_v1_campaign_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   34,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
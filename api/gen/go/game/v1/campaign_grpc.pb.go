@@ -31,6 +31,7 @@ const (
 	CampaignService_SetCampaignCover_FullMethodName            = "/game.v1.CampaignService/SetCampaignCover"
 	CampaignService_SetCampaignAIBinding_FullMethodName        = "/game.v1.CampaignService/SetCampaignAIBinding"
 	CampaignService_ClearCampaignAIBinding_FullMethodName      = "/game.v1.CampaignService/ClearCampaignAIBinding"
+	CampaignService_SetCampaignModerationLock_FullMethodName   = "/game.v1.CampaignService/SetCampaignModerationLock"
 )
 
 // CampaignServiceClient is the client API for CampaignService service.
@@ -61,6 +62,8 @@ type CampaignServiceClient interface {
 	SetCampaignAIBinding(ctx context.Context, in *SetCampaignAIBindingRequest, opts ...grpc.CallOption) (*SetCampaignAIBindingResponse, error)
 	// Clear the AI agent binding from a campaign (owner-only).
 	ClearCampaignAIBinding(ctx context.Context, in *ClearCampaignAIBindingRequest, opts ...grpc.CallOption) (*ClearCampaignAIBindingResponse, error)
+	// Lock or unlock a campaign for operator moderation (admin override only).
+	SetCampaignModerationLock(ctx context.Context, in *SetCampaignModerationLockRequest, opts ...grpc.CallOption) (*SetCampaignModerationLockResponse, error)
 }
 
 type campaignServiceClient struct {
@@ -181,6 +184,16 @@ func (c *campaignServiceClient) ClearCampaignAIBinding(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *campaignServiceClient) SetCampaignModerationLock(ctx context.Context, in *SetCampaignModerationLockRequest, opts ...grpc.CallOption) (*SetCampaignModerationLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCampaignModerationLockResponse)
+	err := c.cc.Invoke(ctx, CampaignService_SetCampaignModerationLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CampaignServiceServer is the server API for CampaignService service.
 // All implementations must embed UnimplementedCampaignServiceServer
 // for forward compatibility.
@@ -209,6 +222,8 @@ type CampaignServiceServer interface {
 	SetCampaignAIBinding(context.Context, *SetCampaignAIBindingRequest) (*SetCampaignAIBindingResponse, error)
 	// Clear the AI agent binding from a campaign (owner-only).
 	ClearCampaignAIBinding(context.Context, *ClearCampaignAIBindingRequest) (*ClearCampaignAIBindingResponse, error)
+	// Lock or unlock a campaign for operator moderation (admin override only).
+	SetCampaignModerationLock(context.Context, *SetCampaignModerationLockRequest) (*SetCampaignModerationLockResponse, error)
 	mustEmbedUnimplementedCampaignServiceServer()
 }
 
@@ -252,6 +267,9 @@ func (UnimplementedCampaignServiceServer) SetCampaignAIBinding(context.Context,
 func (UnimplementedCampaignServiceServer) ClearCampaignAIBinding(context.Context, *ClearCampaignAIBindingRequest) (*ClearCampaignAIBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearCampaignAIBinding not implemented")
 }
+func (UnimplementedCampaignServiceServer) SetCampaignModerationLock(context.Context, *SetCampaignModerationLockRequest) (*SetCampaignModerationLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCampaignModerationLock not implemented")
+}
 func (UnimplementedCampaignServiceServer) mustEmbedUnimplementedCampaignServiceServer() {}
 func (UnimplementedCampaignServiceServer) testEmbeddedByValue()                         {}
 
@@ -471,6 +489,24 @@ func _CampaignService_ClearCampaignAIBinding_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CampaignService_SetCampaignModerationLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCampaignModerationLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).SetCampaignModerationLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_SetCampaignModerationLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).SetCampaignModerationLock(ctx, req.(*SetCampaignModerationLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CampaignService_ServiceDesc is the grpc.ServiceDesc for CampaignService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -522,6 +558,10 @@ var CampaignService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearCampaignAIBinding",
 			Handler:    _CampaignService_ClearCampaignAIBinding_Handler,
 		},
+		{
+			MethodName: "SetCampaignModerationLock",
+			Handler:    _CampaignService_SetCampaignModerationLock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "game/v1/campaign.proto",
@@ -22,6 +22,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// StateDiffChange classifies how one entity differs between two points.
+type StateDiffChange int32
+
+const (
+	StateDiffChange_STATE_DIFF_CHANGE_UNSPECIFIED StateDiffChange = 0
+	// The entity exists only at the second point.
+	StateDiffChange_STATE_DIFF_CHANGE_ADDED StateDiffChange = 1
+	// The entity exists only at the first point.
+	StateDiffChange_STATE_DIFF_CHANGE_REMOVED StateDiffChange = 2
+	// The entity exists at both points with different values.
+	StateDiffChange_STATE_DIFF_CHANGE_MODIFIED StateDiffChange = 3
+)
+
+// Enum value maps for StateDiffChange.
+var (
+	StateDiffChange_name = map[int32]string{
+		0: "STATE_DIFF_CHANGE_UNSPECIFIED",
+		1: "STATE_DIFF_CHANGE_ADDED",
+		2: "STATE_DIFF_CHANGE_REMOVED",
+		3: "STATE_DIFF_CHANGE_MODIFIED",
+	}
+	StateDiffChange_value = map[string]int32{
+		"STATE_DIFF_CHANGE_UNSPECIFIED": 0,
+		"STATE_DIFF_CHANGE_ADDED":       1,
+		"STATE_DIFF_CHANGE_REMOVED":     2,
+		"STATE_DIFF_CHANGE_MODIFIED":    3,
+	}
+)
+
+func (x StateDiffChange) Enum() *StateDiffChange {
+	p := new(StateDiffChange)
+	*p = x
+	return p
+}
+
+func (x StateDiffChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateDiffChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_v1_fork_proto_enumTypes[0].Descriptor()
+}
+
+func (StateDiffChange) Type() protoreflect.EnumType {
+	return &file_game_v1_fork_proto_enumTypes[0]
+}
+
+func (x StateDiffChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StateDiffChange.Descriptor instead.
+func (StateDiffChange) EnumDescriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{0}
+}
+
 // ForkPoint specifies where in a campaign's history to fork.
 type ForkPoint struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -79,6 +135,378 @@ func (x *ForkPoint) GetSessionId() string {
 	return ""
 }
 
+// StatePoint selects one reconstructed campaign state for comparison.
+type StatePoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The campaign to read (required).
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// The last event sequence to include.
+	// If 0 and session_id is empty, uses the latest event (current HEAD).
+	EventSeq uint64 `protobuf:"varint,2,opt,name=event_seq,json=eventSeq,proto3" json:"event_seq,omitempty"`
+	// The ended session whose closing state to read.
+	// Mutually exclusive with event_seq.
+	SessionId     string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatePoint) Reset() {
+	*x = StatePoint{}
+	mi := &file_game_v1_fork_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatePoint) ProtoMessage() {}
+
+func (x *StatePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_fork_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatePoint.ProtoReflect.Descriptor instead.
+func (*StatePoint) Descriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatePoint) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *StatePoint) GetEventSeq() uint64 {
+	if x != nil {
+		return x.EventSeq
+	}
+	return 0
+}
+
+func (x *StatePoint) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// FieldDiff records one descriptive field that differs between two points.
+type FieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	mi := &file_game_v1_fork_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_fork_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// ResourceDiff records one numeric track that differs between two points.
+type ResourceDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Before        int32                  `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         int32                  `protobuf:"varint,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceDiff) Reset() {
+	*x = ResourceDiff{}
+	mi := &file_game_v1_fork_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDiff) ProtoMessage() {}
+
+func (x *ResourceDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_fork_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDiff.ProtoReflect.Descriptor instead.
+func (*ResourceDiff) Descriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceDiff) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ResourceDiff) GetBefore() int32 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *ResourceDiff) GetAfter() int32 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+// EntityStateDiff describes how one character, scene, countdown, or
+// adversary differs between two points.
+type EntityStateDiff struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntityId string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Display name at the second point, or the first when removed.
+	Name      string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Change    StateDiffChange `protobuf:"varint,3,opt,name=change,proto3,enum=game.v1.StateDiffChange" json:"change,omitempty"`
+	Fields    []*FieldDiff    `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Resources []*ResourceDiff `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	// Condition labels present only at the second point.
+	ConditionsAdded []string `protobuf:"bytes,6,rep,name=conditions_added,json=conditionsAdded,proto3" json:"conditions_added,omitempty"`
+	// Condition labels present only at the first point.
+	ConditionsRemoved []string `protobuf:"bytes,7,rep,name=conditions_removed,json=conditionsRemoved,proto3" json:"conditions_removed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EntityStateDiff) Reset() {
+	*x = EntityStateDiff{}
+	mi := &file_game_v1_fork_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityStateDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityStateDiff) ProtoMessage() {}
+
+func (x *EntityStateDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_fork_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityStateDiff.ProtoReflect.Descriptor instead.
+func (*EntityStateDiff) Descriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EntityStateDiff) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EntityStateDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntityStateDiff) GetChange() StateDiffChange {
+	if x != nil {
+		return x.Change
+	}
+	return StateDiffChange_STATE_DIFF_CHANGE_UNSPECIFIED
+}
+
+func (x *EntityStateDiff) GetFields() []*FieldDiff {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *EntityStateDiff) GetResources() []*ResourceDiff {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *EntityStateDiff) GetConditionsAdded() []string {
+	if x != nil {
+		return x.ConditionsAdded
+	}
+	return nil
+}
+
+func (x *EntityStateDiff) GetConditionsRemoved() []string {
+	if x != nil {
+		return x.ConditionsRemoved
+	}
+	return nil
+}
+
+// CampaignStateDiff groups entity differences by kind. Unchanged entities
+// are omitted.
+type CampaignStateDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Campaign-level fields and system resources such as GM Fear.
+	CampaignFields    []*FieldDiff       `protobuf:"bytes,1,rep,name=campaign_fields,json=campaignFields,proto3" json:"campaign_fields,omitempty"`
+	CampaignResources []*ResourceDiff    `protobuf:"bytes,2,rep,name=campaign_resources,json=campaignResources,proto3" json:"campaign_resources,omitempty"`
+	Characters        []*EntityStateDiff `protobuf:"bytes,3,rep,name=characters,proto3" json:"characters,omitempty"`
+	Scenes            []*EntityStateDiff `protobuf:"bytes,4,rep,name=scenes,proto3" json:"scenes,omitempty"`
+	Countdowns        []*EntityStateDiff `protobuf:"bytes,5,rep,name=countdowns,proto3" json:"countdowns,omitempty"`
+	Adversaries       []*EntityStateDiff `protobuf:"bytes,6,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CampaignStateDiff) Reset() {
+	*x = CampaignStateDiff{}
+	mi := &file_game_v1_fork_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignStateDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStateDiff) ProtoMessage() {}
+
+func (x *CampaignStateDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_fork_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStateDiff.ProtoReflect.Descriptor instead.
+func (*CampaignStateDiff) Descriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CampaignStateDiff) GetCampaignFields() []*FieldDiff {
+	if x != nil {
+		return x.CampaignFields
+	}
+	return nil
+}
+
+func (x *CampaignStateDiff) GetCampaignResources() []*ResourceDiff {
+	if x != nil {
+		return x.CampaignResources
+	}
+	return nil
+}
+
+func (x *CampaignStateDiff) GetCharacters() []*EntityStateDiff {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *CampaignStateDiff) GetScenes() []*EntityStateDiff {
+	if x != nil {
+		return x.Scenes
+	}
+	return nil
+}
+
+func (x *CampaignStateDiff) GetCountdowns() []*EntityStateDiff {
+	if x != nil {
+		return x.Countdowns
+	}
+	return nil
+}
+
+func (x *CampaignStateDiff) GetAdversaries() []*EntityStateDiff {
+	if x != nil {
+		return x.Adversaries
+	}
+	return nil
+}
+
 // Lineage describes the ancestry chain of a campaign.
 type Lineage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -98,7 +526,7 @@ type Lineage struct {
 
 func (x *Lineage) Reset() {
 	*x = Lineage{}
-	mi := &file_game_v1_fork_proto_msgTypes[1]
+	mi := &file_game_v1_fork_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -110,7 +538,7 @@ func (x *Lineage) String() string {
 func (*Lineage) ProtoMessage() {}
 
 func (x *Lineage) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_fork_proto_msgTypes[1]
+	mi := &file_game_v1_fork_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +551,7 @@ func (x *Lineage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Lineage.ProtoReflect.Descriptor instead.
 func (*Lineage) Descriptor() ([]byte, []int) {
-	return file_game_v1_fork_proto_rawDescGZIP(), []int{1}
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Lineage) GetCampaignId() string {
@@ -179,7 +607,7 @@ type ForkCampaignRequest struct {
 
 func (x *ForkCampaignRequest) Reset() {
 	*x = ForkCampaignRequest{}
-	mi := &file_game_v1_fork_proto_msgTypes[2]
+	mi := &file_game_v1_fork_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +619,7 @@ func (x *ForkCampaignRequest) String() string {
 func (*ForkCampaignRequest) ProtoMessage() {}
 
 func (x *ForkCampaignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_fork_proto_msgTypes[2]
+	mi := &file_game_v1_fork_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +632,7 @@ func (x *ForkCampaignRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForkCampaignRequest.ProtoReflect.Descriptor instead.
 func (*ForkCampaignRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_fork_proto_rawDescGZIP(), []int{2}
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ForkCampaignRequest) GetSourceCampaignId() string {
@@ -249,7 +677,7 @@ type ForkCampaignResponse struct {
 
 func (x *ForkCampaignResponse) Reset() {
 	*x = ForkCampaignResponse{}
-	mi := &file_game_v1_fork_proto_msgTypes[3]
+	mi := &file_game_v1_fork_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +689,7 @@ func (x *ForkCampaignResponse) String() string {
 func (*ForkCampaignResponse) ProtoMessage() {}
 
 func (x *ForkCampaignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_fork_proto_msgTypes[3]
+	mi := &file_game_v1_fork_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +702,7 @@ func (x *ForkCampaignResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForkCampaignResponse.ProtoReflect.Descriptor instead.
 func (*ForkCampaignResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_fork_proto_rawDescGZIP(), []int{3}
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ForkCampaignResponse) GetCampaign() *Campaign {
@@ -308,7 +736,7 @@ type GetLineageRequest struct {
 
 func (x *GetLineageRequest) Reset() {
 	*x = GetLineageRequest{}
-	mi := &file_game_v1_fork_proto_msgTypes[4]
+	mi := &file_game_v1_fork_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -320,7 +748,7 @@ func (x *GetLineageRequest) String() string {
 func (*GetLineageRequest) ProtoMessage() {}
 
 func (x *GetLineageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_fork_proto_msgTypes[4]
+	mi := &file_game_v1_fork_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +761,7 @@ func (x *GetLineageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLineageRequest.ProtoReflect.Descriptor instead.
 func (*GetLineageRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_fork_proto_rawDescGZIP(), []int{4}
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetLineageRequest) GetCampaignId() string {
@@ -353,7 +781,7 @@ type GetLineageResponse struct {
 
 func (x *GetLineageResponse) Reset() {
 	*x = GetLineageResponse{}
-	mi := &file_game_v1_fork_proto_msgTypes[5]
+	mi := &file_game_v1_fork_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +793,7 @@ func (x *GetLineageResponse) String() string {
 func (*GetLineageResponse) ProtoMessage() {}
 
 func (x *GetLineageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_fork_proto_msgTypes[5]
+	mi := &file_game_v1_fork_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +806,7 @@ func (x *GetLineageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLineageResponse.ProtoReflect.Descriptor instead.
 func (*GetLineageResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_fork_proto_rawDescGZIP(), []int{5}
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetLineageResponse) GetLineage() *Lineage {
@@ -405,7 +833,7 @@ type ListForksRequest struct {
 
 func (x *ListForksRequest) Reset() {
 	*x = ListForksRequest{}
-	mi := &file_game_v1_fork_proto_msgTypes[6]
+	mi := &file_game_v1_fork_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -417,7 +845,7 @@ func (x *ListForksRequest) String() string {
 func (*ListForksRequest) ProtoMessage() {}
 
 func (x *ListForksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_fork_proto_msgTypes[6]
+	mi := &file_game_v1_fork_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +858,7 @@ func (x *ListForksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListForksRequest.ProtoReflect.Descriptor instead.
 func (*ListForksRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_fork_proto_rawDescGZIP(), []int{6}
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListForksRequest) GetSourceCampaignId() string {
@@ -473,7 +901,7 @@ type ListForksResponse struct {
 
 func (x *ListForksResponse) Reset() {
 	*x = ListForksResponse{}
-	mi := &file_game_v1_fork_proto_msgTypes[7]
+	mi := &file_game_v1_fork_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +913,7 @@ func (x *ListForksResponse) String() string {
 func (*ListForksResponse) ProtoMessage() {}
 
 func (x *ListForksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_fork_proto_msgTypes[7]
+	mi := &file_game_v1_fork_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +926,7 @@ func (x *ListForksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListForksResponse.ProtoReflect.Descriptor instead.
 func (*ListForksResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_fork_proto_rawDescGZIP(), []int{7}
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListForksResponse) GetCampaigns() []*Campaign {
@@ -515,6 +943,122 @@ func (x *ListForksResponse) GetNextPageToken() string {
 	return ""
 }
 
+type DiffCampaignStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The baseline state (required).
+	From *StatePoint `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The state compared against the baseline (required).
+	To            *StatePoint `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffCampaignStateRequest) Reset() {
+	*x = DiffCampaignStateRequest{}
+	mi := &file_game_v1_fork_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCampaignStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCampaignStateRequest) ProtoMessage() {}
+
+func (x *DiffCampaignStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_fork_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCampaignStateRequest.ProtoReflect.Descriptor instead.
+func (*DiffCampaignStateRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DiffCampaignStateRequest) GetFrom() *StatePoint {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffCampaignStateRequest) GetTo() *StatePoint {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DiffCampaignStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The baseline point with event_seq resolved.
+	From *StatePoint `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The compared point with event_seq resolved.
+	To            *StatePoint        `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Diff          *CampaignStateDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffCampaignStateResponse) Reset() {
+	*x = DiffCampaignStateResponse{}
+	mi := &file_game_v1_fork_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCampaignStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCampaignStateResponse) ProtoMessage() {}
+
+func (x *DiffCampaignStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_fork_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCampaignStateResponse.ProtoReflect.Descriptor instead.
+func (*DiffCampaignStateResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_fork_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiffCampaignStateResponse) GetFrom() *StatePoint {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffCampaignStateResponse) GetTo() *StatePoint {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DiffCampaignStateResponse) GetDiff() *CampaignStateDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
 var File_game_v1_fork_proto protoreflect.FileDescriptor
 
 var file_game_v1_fork_proto_rawDesc = string([]byte{
@@ -525,87 +1069,178 @@ var file_game_v1_fork_proto_rawDesc = string([]byte{
 	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x12,
 	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc2,
-	0x01, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x6f, 0x72,
-	0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x12,
-	0x2c, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65,
-	0x70, 0x74, 0x68, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x66, 0x6f, 0x72,
-	0x6b, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
-	0x6e, 0x65, 0x77, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x70, 0x79,
-	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
-	0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x2a, 0x0a,
-	0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65,
-	0x52, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x6f, 0x72,
-	0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x22,
-	0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61,
-	0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6c,
-	0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x07,
-	0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x22, 0x6c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x09, 0x63, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32,
-	0xe5, 0x01, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4b, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12,
-	0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x61,
-	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73,
-	0x12, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69, 0x73, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x2f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61,
-	0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x61, 0x6d, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x69,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x09, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x58, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0xaf, 0x02, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xf8, 0x02, 0x0a, 0x11, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x3b, 0x0a, 0x0f,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0e, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x11, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x38, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x65,
+	0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x06, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x71, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a,
+	0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x70, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x46, 0x6f, 0x72,
+	0x6b, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x12, 0x2a, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65,
+	0x61, 0x67, 0x65, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x71, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x22, 0x6c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52,
+	0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x68, 0x0a, 0x18, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x99, 0x01, 0x0a,
+	0x19, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x2a, 0x90, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x1d,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x32, 0xc1, 0x02, 0x0a, 0x0b,
+	0x46, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x46,
+	0x6f, 0x72, 0x6b, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x19, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f,
+	0x75, 0x69, 0x73, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x2f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x75,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x61,
+	0x6d, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -620,35 +1255,60 @@ func file_game_v1_fork_proto_rawDescGZIP() []byte {
 	return file_game_v1_fork_proto_rawDescData
 }
 
-var file_game_v1_fork_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_game_v1_fork_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_game_v1_fork_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_game_v1_fork_proto_goTypes = []any{
-	(*ForkPoint)(nil),            // 0: game.v1.ForkPoint
-	(*Lineage)(nil),              // 1: game.v1.Lineage
-	(*ForkCampaignRequest)(nil),  // 2: game.v1.ForkCampaignRequest
-	(*ForkCampaignResponse)(nil), // 3: game.v1.ForkCampaignResponse
-	(*GetLineageRequest)(nil),    // 4: game.v1.GetLineageRequest
-	(*GetLineageResponse)(nil),   // 5: game.v1.GetLineageResponse
-	(*ListForksRequest)(nil),     // 6: game.v1.ListForksRequest
-	(*ListForksResponse)(nil),    // 7: game.v1.ListForksResponse
-	(*Campaign)(nil),             // 8: game.v1.Campaign
+	(StateDiffChange)(0),              // 0: game.v1.StateDiffChange
+	(*ForkPoint)(nil),                 // 1: game.v1.ForkPoint
+	(*StatePoint)(nil),                // 2: game.v1.StatePoint
+	(*FieldDiff)(nil),                 // 3: game.v1.FieldDiff
+	(*ResourceDiff)(nil),              // 4: game.v1.ResourceDiff
+	(*EntityStateDiff)(nil),           // 5: game.v1.EntityStateDiff
+	(*CampaignStateDiff)(nil),         // 6: game.v1.CampaignStateDiff
+	(*Lineage)(nil),                   // 7: game.v1.Lineage
+	(*ForkCampaignRequest)(nil),       // 8: game.v1.ForkCampaignRequest
+	(*ForkCampaignResponse)(nil),      // 9: game.v1.ForkCampaignResponse
+	(*GetLineageRequest)(nil),         // 10: game.v1.GetLineageRequest
+	(*GetLineageResponse)(nil),        // 11: game.v1.GetLineageResponse
+	(*ListForksRequest)(nil),          // 12: game.v1.ListForksRequest
+	(*ListForksResponse)(nil),         // 13: game.v1.ListForksResponse
+	(*DiffCampaignStateRequest)(nil),  // 14: game.v1.DiffCampaignStateRequest
+	(*DiffCampaignStateResponse)(nil), // 15: game.v1.DiffCampaignStateResponse
+	(*Campaign)(nil),                  // 16: game.v1.Campaign
 }
 var file_game_v1_fork_proto_depIdxs = []int32{
-	0, // 0: game.v1.ForkCampaignRequest.fork_point:type_name -> game.v1.ForkPoint
-	8, // 1: game.v1.ForkCampaignResponse.campaign:type_name -> game.v1.Campaign
-	1, // 2: game.v1.ForkCampaignResponse.lineage:type_name -> game.v1.Lineage
-	1, // 3: game.v1.GetLineageResponse.lineage:type_name -> game.v1.Lineage
-	8, // 4: game.v1.ListForksResponse.campaigns:type_name -> game.v1.Campaign
-	2, // 5: game.v1.ForkService.ForkCampaign:input_type -> game.v1.ForkCampaignRequest
-	4, // 6: game.v1.ForkService.GetLineage:input_type -> game.v1.GetLineageRequest
-	6, // 7: game.v1.ForkService.ListForks:input_type -> game.v1.ListForksRequest
-	3, // 8: game.v1.ForkService.ForkCampaign:output_type -> game.v1.ForkCampaignResponse
-	5, // 9: game.v1.ForkService.GetLineage:output_type -> game.v1.GetLineageResponse
-	7, // 10: game.v1.ForkService.ListForks:output_type -> game.v1.ListForksResponse
-	8, // [8:11] is the sub-list for method output_type
-	5, // [5:8] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: game.v1.EntityStateDiff.change:type_name -> game.v1.StateDiffChange
+	3,  // 1: game.v1.EntityStateDiff.fields:type_name -> game.v1.FieldDiff
+	4,  // 2: game.v1.EntityStateDiff.resources:type_name -> game.v1.ResourceDiff
+	3,  // 3: game.v1.CampaignStateDiff.campaign_fields:type_name -> game.v1.FieldDiff
+	4,  // 4: game.v1.CampaignStateDiff.campaign_resources:type_name -> game.v1.ResourceDiff
+	5,  // 5: game.v1.CampaignStateDiff.characters:type_name -> game.v1.EntityStateDiff
+	5,  // 6: game.v1.CampaignStateDiff.scenes:type_name -> game.v1.EntityStateDiff
+	5,  // 7: game.v1.CampaignStateDiff.countdowns:type_name -> game.v1.EntityStateDiff
+	5,  // 8: game.v1.CampaignStateDiff.adversaries:type_name -> game.v1.EntityStateDiff
+	1,  // 9: game.v1.ForkCampaignRequest.fork_point:type_name -> game.v1.ForkPoint
+	16, // 10: game.v1.ForkCampaignResponse.campaign:type_name -> game.v1.Campaign
+	7,  // 11: game.v1.ForkCampaignResponse.lineage:type_name -> game.v1.Lineage
+	7,  // 12: game.v1.GetLineageResponse.lineage:type_name -> game.v1.Lineage
+	16, // 13: game.v1.ListForksResponse.campaigns:type_name -> game.v1.Campaign
+	2,  // 14: game.v1.DiffCampaignStateRequest.from:type_name -> game.v1.StatePoint
+	2,  // 15: game.v1.DiffCampaignStateRequest.to:type_name -> game.v1.StatePoint
+	2,  // 16: game.v1.DiffCampaignStateResponse.from:type_name -> game.v1.StatePoint
+	2,  // 17: game.v1.DiffCampaignStateResponse.to:type_name -> game.v1.StatePoint
+	6,  // 18: game.v1.DiffCampaignStateResponse.diff:type_name -> game.v1.CampaignStateDiff
+	8,  // 19: game.v1.ForkService.ForkCampaign:input_type -> game.v1.ForkCampaignRequest
+	10, // 20: game.v1.ForkService.GetLineage:input_type -> game.v1.GetLineageRequest
+	12, // 21: game.v1.ForkService.ListForks:input_type -> game.v1.ListForksRequest
+	14, // 22: game.v1.ForkService.DiffCampaignState:input_type -> game.v1.DiffCampaignStateRequest
+	9,  // 23: game.v1.ForkService.ForkCampaign:output_type -> game.v1.ForkCampaignResponse
+	11, // 24: game.v1.ForkService.GetLineage:output_type -> game.v1.GetLineageResponse
+	13, // 25: game.v1.ForkService.ListForks:output_type -> game.v1.ListForksResponse
+	15, // 26: game.v1.ForkService.DiffCampaignState:output_type -> game.v1.DiffCampaignStateResponse
+	23, // [23:27] is the sub-list for method output_type
+	19, // [19:23] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_game_v1_fork_proto_init() }
@@ -662,13 +1322,14 @@ func file_game_v1_fork_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_fork_proto_rawDesc), len(file_game_v1_fork_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_game_v1_fork_proto_goTypes,
 		DependencyIndexes: file_game_v1_fork_proto_depIdxs,
+		EnumInfos:         file_game_v1_fork_proto_enumTypes,
 		MessageInfos:      file_game_v1_fork_proto_msgTypes,
 	}.Build()
 	File_game_v1_fork_proto = out.File
@@ -20,9 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ForkService_ForkCampaign_FullMethodName = "/game.v1.ForkService/ForkCampaign"
-	ForkService_GetLineage_FullMethodName   = "/game.v1.ForkService/GetLineage"
-	ForkService_ListForks_FullMethodName    = "/game.v1.ForkService/ListForks"
+	ForkService_ForkCampaign_FullMethodName      = "/game.v1.ForkService/ForkCampaign"
+	ForkService_GetLineage_FullMethodName        = "/game.v1.ForkService/GetLineage"
+	ForkService_ListForks_FullMethodName         = "/game.v1.ForkService/ListForks"
+	ForkService_DiffCampaignState_FullMethodName = "/game.v1.ForkService/DiffCampaignState"
 )
 
 // ForkServiceClient is the client API for ForkService service.
@@ -37,6 +38,9 @@ type ForkServiceClient interface {
 	GetLineage(ctx context.Context, in *GetLineageRequest, opts ...grpc.CallOption) (*GetLineageResponse, error)
 	// List campaigns forked from a given campaign.
 	ListForks(ctx context.Context, in *ListForksRequest, opts ...grpc.CallOption) (*ListForksResponse, error)
+	// Compare reconstructed state at two points, possibly in different
+	// campaigns that share lineage.
+	DiffCampaignState(ctx context.Context, in *DiffCampaignStateRequest, opts ...grpc.CallOption) (*DiffCampaignStateResponse, error)
 }
 
 type forkServiceClient struct {
@@ -77,6 +81,16 @@ func (c *forkServiceClient) ListForks(ctx context.Context, in *ListForksRequest,
 	return out, nil
 }
 
+func (c *forkServiceClient) DiffCampaignState(ctx context.Context, in *DiffCampaignStateRequest, opts ...grpc.CallOption) (*DiffCampaignStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffCampaignStateResponse)
+	err := c.cc.Invoke(ctx, ForkService_DiffCampaignState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ForkServiceServer is the server API for ForkService service.
 // All implementations must embed UnimplementedForkServiceServer
 // for forward compatibility.
@@ -89,6 +103,9 @@ type ForkServiceServer interface {
 	GetLineage(context.Context, *GetLineageRequest) (*GetLineageResponse, error)
 	// List campaigns forked from a given campaign.
 	ListForks(context.Context, *ListForksRequest) (*ListForksResponse, error)
+	// Compare reconstructed state at two points, possibly in different
+	// campaigns that share lineage.
+	DiffCampaignState(context.Context, *DiffCampaignStateRequest) (*DiffCampaignStateResponse, error)
 	mustEmbedUnimplementedForkServiceServer()
 }
 
@@ -108,6 +125,9 @@ func (UnimplementedForkServiceServer) GetLineage(context.Context, *GetLineageReq
 func (UnimplementedForkServiceServer) ListForks(context.Context, *ListForksRequest) (*ListForksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListForks not implemented")
 }
+func (UnimplementedForkServiceServer) DiffCampaignState(context.Context, *DiffCampaignStateRequest) (*DiffCampaignStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCampaignState not implemented")
+}
 func (UnimplementedForkServiceServer) mustEmbedUnimplementedForkServiceServer() {}
 func (UnimplementedForkServiceServer) testEmbeddedByValue()                     {}
 
@@ -183,6 +203,24 @@ func _ForkService_ListForks_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ForkService_DiffCampaignState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCampaignStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForkServiceServer).DiffCampaignState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForkService_DiffCampaignState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForkServiceServer).DiffCampaignState(ctx, req.(*DiffCampaignStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ForkService_ServiceDesc is the grpc.ServiceDesc for ForkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +240,10 @@ var ForkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListForks",
 			Handler:    _ForkService_ListForks_Handler,
 		},
+		{
+			MethodName: "DiffCampaignState",
+			Handler:    _ForkService_DiffCampaignState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "game/v1/fork.proto",
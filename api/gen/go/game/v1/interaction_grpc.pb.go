@@ -774,11 +774,13 @@ var InteractionService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CampaignAIOrchestrationService_QueueAIGMTurn_FullMethodName    = "/game.v1.CampaignAIOrchestrationService/QueueAIGMTurn"
-	CampaignAIOrchestrationService_StartAIGMTurn_FullMethodName    = "/game.v1.CampaignAIOrchestrationService/StartAIGMTurn"
-	CampaignAIOrchestrationService_FailAIGMTurn_FullMethodName     = "/game.v1.CampaignAIOrchestrationService/FailAIGMTurn"
-	CampaignAIOrchestrationService_CompleteAIGMTurn_FullMethodName = "/game.v1.CampaignAIOrchestrationService/CompleteAIGMTurn"
-	CampaignAIOrchestrationService_ConcludeSession_FullMethodName  = "/game.v1.CampaignAIOrchestrationService/ConcludeSession"
+	CampaignAIOrchestrationService_QueueAIGMTurn_FullMethodName          = "/game.v1.CampaignAIOrchestrationService/QueueAIGMTurn"
+	CampaignAIOrchestrationService_StartAIGMTurn_FullMethodName          = "/game.v1.CampaignAIOrchestrationService/StartAIGMTurn"
+	CampaignAIOrchestrationService_FailAIGMTurn_FullMethodName           = "/game.v1.CampaignAIOrchestrationService/FailAIGMTurn"
+	CampaignAIOrchestrationService_CompleteAIGMTurn_FullMethodName       = "/game.v1.CampaignAIOrchestrationService/CompleteAIGMTurn"
+	CampaignAIOrchestrationService_ConcludeSession_FullMethodName        = "/game.v1.CampaignAIOrchestrationService/ConcludeSession"
+	CampaignAIOrchestrationService_ListDueAIPlayerTurns_FullMethodName   = "/game.v1.CampaignAIOrchestrationService/ListDueAIPlayerTurns"
+	CampaignAIOrchestrationService_GetSessionRecapSources_FullMethodName = "/game.v1.CampaignAIOrchestrationService/GetSessionRecapSources"
 )
 
 // CampaignAIOrchestrationServiceClient is the client API for CampaignAIOrchestrationService service.
@@ -794,6 +796,13 @@ type CampaignAIOrchestrationServiceClient interface {
 	FailAIGMTurn(ctx context.Context, in *FailAIGMTurnRequest, opts ...grpc.CallOption) (*FailAIGMTurnResponse, error)
 	CompleteAIGMTurn(ctx context.Context, in *CompleteAIGMTurnRequest, opts ...grpc.CallOption) (*CompleteAIGMTurnResponse, error)
 	ConcludeSession(ctx context.Context, in *ConcludeSessionRequest, opts ...grpc.CallOption) (*ConcludeSessionResponse, error)
+	// Lists AI-controlled player participants that should act now in one
+	// player phase. Humans always act first; AI players are only due once every
+	// human acting participant has yielded.
+	ListDueAIPlayerTurns(ctx context.Context, in *ListDueAIPlayerTurnsRequest, opts ...grpc.CallOption) (*ListDueAIPlayerTurnsResponse, error)
+	// Returns the journal events and GM interactions recorded for one session
+	// so an ended session can be drafted into a recap without a session grant.
+	GetSessionRecapSources(ctx context.Context, in *GetSessionRecapSourcesRequest, opts ...grpc.CallOption) (*GetSessionRecapSourcesResponse, error)
 }
 
 type campaignAIOrchestrationServiceClient struct {
@@ -854,6 +863,26 @@ func (c *campaignAIOrchestrationServiceClient) ConcludeSession(ctx context.Conte
 	return out, nil
 }
 
+func (c *campaignAIOrchestrationServiceClient) ListDueAIPlayerTurns(ctx context.Context, in *ListDueAIPlayerTurnsRequest, opts ...grpc.CallOption) (*ListDueAIPlayerTurnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDueAIPlayerTurnsResponse)
+	err := c.cc.Invoke(ctx, CampaignAIOrchestrationService_ListDueAIPlayerTurns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignAIOrchestrationServiceClient) GetSessionRecapSources(ctx context.Context, in *GetSessionRecapSourcesRequest, opts ...grpc.CallOption) (*GetSessionRecapSourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionRecapSourcesResponse)
+	err := c.cc.Invoke(ctx, CampaignAIOrchestrationService_GetSessionRecapSources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CampaignAIOrchestrationServiceServer is the server API for CampaignAIOrchestrationService service.
 // All implementations must embed UnimplementedCampaignAIOrchestrationServiceServer
 // for forward compatibility.
@@ -867,6 +896,13 @@ type CampaignAIOrchestrationServiceServer interface {
 	FailAIGMTurn(context.Context, *FailAIGMTurnRequest) (*FailAIGMTurnResponse, error)
 	CompleteAIGMTurn(context.Context, *CompleteAIGMTurnRequest) (*CompleteAIGMTurnResponse, error)
 	ConcludeSession(context.Context, *ConcludeSessionRequest) (*ConcludeSessionResponse, error)
+	// Lists AI-controlled player participants that should act now in one
+	// player phase. Humans always act first; AI players are only due once every
+	// human acting participant has yielded.
+	ListDueAIPlayerTurns(context.Context, *ListDueAIPlayerTurnsRequest) (*ListDueAIPlayerTurnsResponse, error)
+	// Returns the journal events and GM interactions recorded for one session
+	// so an ended session can be drafted into a recap without a session grant.
+	GetSessionRecapSources(context.Context, *GetSessionRecapSourcesRequest) (*GetSessionRecapSourcesResponse, error)
 	mustEmbedUnimplementedCampaignAIOrchestrationServiceServer()
 }
 
@@ -892,6 +928,12 @@ func (UnimplementedCampaignAIOrchestrationServiceServer) CompleteAIGMTurn(contex
 func (UnimplementedCampaignAIOrchestrationServiceServer) ConcludeSession(context.Context, *ConcludeSessionRequest) (*ConcludeSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConcludeSession not implemented")
 }
+func (UnimplementedCampaignAIOrchestrationServiceServer) ListDueAIPlayerTurns(context.Context, *ListDueAIPlayerTurnsRequest) (*ListDueAIPlayerTurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDueAIPlayerTurns not implemented")
+}
+func (UnimplementedCampaignAIOrchestrationServiceServer) GetSessionRecapSources(context.Context, *GetSessionRecapSourcesRequest) (*GetSessionRecapSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionRecapSources not implemented")
+}
 func (UnimplementedCampaignAIOrchestrationServiceServer) mustEmbedUnimplementedCampaignAIOrchestrationServiceServer() {
 }
 func (UnimplementedCampaignAIOrchestrationServiceServer) testEmbeddedByValue() {}
@@ -1004,6 +1046,42 @@ func _CampaignAIOrchestrationService_ConcludeSession_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CampaignAIOrchestrationService_ListDueAIPlayerTurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDueAIPlayerTurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignAIOrchestrationServiceServer).ListDueAIPlayerTurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignAIOrchestrationService_ListDueAIPlayerTurns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignAIOrchestrationServiceServer).ListDueAIPlayerTurns(ctx, req.(*ListDueAIPlayerTurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignAIOrchestrationService_GetSessionRecapSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRecapSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignAIOrchestrationServiceServer).GetSessionRecapSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignAIOrchestrationService_GetSessionRecapSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignAIOrchestrationServiceServer).GetSessionRecapSources(ctx, req.(*GetSessionRecapSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CampaignAIOrchestrationService_ServiceDesc is the grpc.ServiceDesc for CampaignAIOrchestrationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1031,6 +1109,14 @@ var CampaignAIOrchestrationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConcludeSession",
 			Handler:    _CampaignAIOrchestrationService_ConcludeSession_Handler,
 		},
+		{
+			MethodName: "ListDueAIPlayerTurns",
+			Handler:    _CampaignAIOrchestrationService_ListDueAIPlayerTurns_Handler,
+		},
+		{
+			MethodName: "GetSessionRecapSources",
+			Handler:    _CampaignAIOrchestrationService_GetSessionRecapSources_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "game/v1/interaction.proto",
@@ -20,12 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ParticipantService_CreateParticipant_FullMethodName = "/game.v1.ParticipantService/CreateParticipant"
-	ParticipantService_UpdateParticipant_FullMethodName = "/game.v1.ParticipantService/UpdateParticipant"
-	ParticipantService_DeleteParticipant_FullMethodName = "/game.v1.ParticipantService/DeleteParticipant"
-	ParticipantService_ListParticipants_FullMethodName  = "/game.v1.ParticipantService/ListParticipants"
-	ParticipantService_GetParticipant_FullMethodName    = "/game.v1.ParticipantService/GetParticipant"
-	ParticipantService_BindParticipant_FullMethodName   = "/game.v1.ParticipantService/BindParticipant"
+	ParticipantService_CreateParticipant_FullMethodName         = "/game.v1.ParticipantService/CreateParticipant"
+	ParticipantService_UpdateParticipant_FullMethodName         = "/game.v1.ParticipantService/UpdateParticipant"
+	ParticipantService_DeleteParticipant_FullMethodName         = "/game.v1.ParticipantService/DeleteParticipant"
+	ParticipantService_ListParticipants_FullMethodName          = "/game.v1.ParticipantService/ListParticipants"
+	ParticipantService_GetParticipant_FullMethodName            = "/game.v1.ParticipantService/GetParticipant"
+	ParticipantService_BindParticipant_FullMethodName           = "/game.v1.ParticipantService/BindParticipant"
+	ParticipantService_ListUserParticipants_FullMethodName      = "/game.v1.ParticipantService/ListUserParticipants"
+	ParticipantService_AnonymizeUserParticipants_FullMethodName = "/game.v1.ParticipantService/AnonymizeUserParticipants"
 )
 
 // ParticipantServiceClient is the client API for ParticipantService service.
@@ -48,6 +50,14 @@ type ParticipantServiceClient interface {
 	// Internal-only: caller must have verified authorization (e.g. join grant).
 	// Enforces: seat is active, not AI, not already bound, one user per campaign.
 	BindParticipant(ctx context.Context, in *BindParticipantRequest, opts ...grpc.CallOption) (*BindParticipantResponse, error)
+	// List every participant seat bound to a user across campaigns.
+	// Internal-only: used by account export and deletion.
+	ListUserParticipants(ctx context.Context, in *ListUserParticipantsRequest, opts ...grpc.CallOption) (*ListUserParticipantsResponse, error)
+	// Release every seat bound to a deleted user and replace the seat's
+	// personal fields with a placeholder. Journal entries are appended, never
+	// rewritten, so campaign hash chains stay valid; earlier payloads are
+	// redacted when read. Internal-only.
+	AnonymizeUserParticipants(ctx context.Context, in *AnonymizeUserParticipantsRequest, opts ...grpc.CallOption) (*AnonymizeUserParticipantsResponse, error)
 }
 
 type participantServiceClient struct {
@@ -118,6 +128,26 @@ func (c *participantServiceClient) BindParticipant(ctx context.Context, in *Bind
 	return out, nil
 }
 
+func (c *participantServiceClient) ListUserParticipants(ctx context.Context, in *ListUserParticipantsRequest, opts ...grpc.CallOption) (*ListUserParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserParticipantsResponse)
+	err := c.cc.Invoke(ctx, ParticipantService_ListUserParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *participantServiceClient) AnonymizeUserParticipants(ctx context.Context, in *AnonymizeUserParticipantsRequest, opts ...grpc.CallOption) (*AnonymizeUserParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserParticipantsResponse)
+	err := c.cc.Invoke(ctx, ParticipantService_AnonymizeUserParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ParticipantServiceServer is the server API for ParticipantService service.
 // All implementations must embed UnimplementedParticipantServiceServer
 // for forward compatibility.
@@ -138,6 +168,14 @@ type ParticipantServiceServer interface {
 	// Internal-only: caller must have verified authorization (e.g. join grant).
 	// Enforces: seat is active, not AI, not already bound, one user per campaign.
 	BindParticipant(context.Context, *BindParticipantRequest) (*BindParticipantResponse, error)
+	// List every participant seat bound to a user across campaigns.
+	// Internal-only: used by account export and deletion.
+	ListUserParticipants(context.Context, *ListUserParticipantsRequest) (*ListUserParticipantsResponse, error)
+	// Release every seat bound to a deleted user and replace the seat's
+	// personal fields with a placeholder. Journal entries are appended, never
+	// rewritten, so campaign hash chains stay valid; earlier payloads are
+	// redacted when read. Internal-only.
+	AnonymizeUserParticipants(context.Context, *AnonymizeUserParticipantsRequest) (*AnonymizeUserParticipantsResponse, error)
 	mustEmbedUnimplementedParticipantServiceServer()
 }
 
@@ -166,6 +204,12 @@ func (UnimplementedParticipantServiceServer) GetParticipant(context.Context, *Ge
 func (UnimplementedParticipantServiceServer) BindParticipant(context.Context, *BindParticipantRequest) (*BindParticipantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BindParticipant not implemented")
 }
+func (UnimplementedParticipantServiceServer) ListUserParticipants(context.Context, *ListUserParticipantsRequest) (*ListUserParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserParticipants not implemented")
+}
+func (UnimplementedParticipantServiceServer) AnonymizeUserParticipants(context.Context, *AnonymizeUserParticipantsRequest) (*AnonymizeUserParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeUserParticipants not implemented")
+}
 func (UnimplementedParticipantServiceServer) mustEmbedUnimplementedParticipantServiceServer() {}
 func (UnimplementedParticipantServiceServer) testEmbeddedByValue()                            {}
 
@@ -295,6 +339,42 @@ func _ParticipantService_BindParticipant_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ParticipantService_ListUserParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParticipantServiceServer).ListUserParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParticipantService_ListUserParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParticipantServiceServer).ListUserParticipants(ctx, req.(*ListUserParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParticipantService_AnonymizeUserParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParticipantServiceServer).AnonymizeUserParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParticipantService_AnonymizeUserParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParticipantServiceServer).AnonymizeUserParticipants(ctx, req.(*AnonymizeUserParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ParticipantService_ServiceDesc is the grpc.ServiceDesc for ParticipantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -326,6 +406,14 @@ var ParticipantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BindParticipant",
 			Handler:    _ParticipantService_BindParticipant_Handler,
 		},
+		{
+			MethodName: "ListUserParticipants",
+			Handler:    _ParticipantService_ListUserParticipants_Handler,
+		},
+		{
+			MethodName: "AnonymizeUserParticipants",
+			Handler:    _ParticipantService_AnonymizeUserParticipants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "game/v1/participant.proto",
@@ -25,6 +25,7 @@ const (
 	SessionService_ListActiveSessionsForUser_FullMethodName = "/game.v1.SessionService/ListActiveSessionsForUser"
 	SessionService_GetSession_FullMethodName                = "/game.v1.SessionService/GetSession"
 	SessionService_GetSessionRecap_FullMethodName           = "/game.v1.SessionService/GetSessionRecap"
+	SessionService_RecordSessionRecap_FullMethodName        = "/game.v1.SessionService/RecordSessionRecap"
 	SessionService_EndSession_FullMethodName                = "/game.v1.SessionService/EndSession"
 	SessionService_OpenSessionGate_FullMethodName           = "/game.v1.SessionService/OpenSessionGate"
 	SessionService_ResolveSessionGate_FullMethodName        = "/game.v1.SessionService/ResolveSessionGate"
@@ -51,6 +52,9 @@ type SessionServiceClient interface {
 	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
 	// Get the stored recap markdown for one session.
 	GetSessionRecap(ctx context.Context, in *GetSessionRecapRequest, opts ...grpc.CallOption) (*GetSessionRecapResponse, error)
+	// Record or replace the recap markdown for one session.
+	// Accepted while the session runs and after it has ended.
+	RecordSessionRecap(ctx context.Context, in *RecordSessionRecapRequest, opts ...grpc.CallOption) (*RecordSessionRecapResponse, error)
 	// End a session by campaign ID and session ID.
 	EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*EndSessionResponse, error)
 	// Open a gate that blocks action events until resolved.
@@ -125,6 +129,16 @@ func (c *sessionServiceClient) GetSessionRecap(ctx context.Context, in *GetSessi
 	return out, nil
 }
 
+func (c *sessionServiceClient) RecordSessionRecap(ctx context.Context, in *RecordSessionRecapRequest, opts ...grpc.CallOption) (*RecordSessionRecapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSessionRecapResponse)
+	err := c.cc.Invoke(ctx, SessionService_RecordSessionRecap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionServiceClient) EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*EndSessionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EndSessionResponse)
@@ -212,6 +226,9 @@ type SessionServiceServer interface {
 	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
 	// Get the stored recap markdown for one session.
 	GetSessionRecap(context.Context, *GetSessionRecapRequest) (*GetSessionRecapResponse, error)
+	// Record or replace the recap markdown for one session.
+	// Accepted while the session runs and after it has ended.
+	RecordSessionRecap(context.Context, *RecordSessionRecapRequest) (*RecordSessionRecapResponse, error)
 	// End a session by campaign ID and session ID.
 	EndSession(context.Context, *EndSessionRequest) (*EndSessionResponse, error)
 	// Open a gate that blocks action events until resolved.
@@ -251,6 +268,9 @@ func (UnimplementedSessionServiceServer) GetSession(context.Context, *GetSession
 func (UnimplementedSessionServiceServer) GetSessionRecap(context.Context, *GetSessionRecapRequest) (*GetSessionRecapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSessionRecap not implemented")
 }
+func (UnimplementedSessionServiceServer) RecordSessionRecap(context.Context, *RecordSessionRecapRequest) (*RecordSessionRecapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSessionRecap not implemented")
+}
 func (UnimplementedSessionServiceServer) EndSession(context.Context, *EndSessionRequest) (*EndSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndSession not implemented")
 }
@@ -383,6 +403,24 @@ func _SessionService_GetSessionRecap_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_RecordSessionRecap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSessionRecapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RecordSessionRecap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RecordSessionRecap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RecordSessionRecap(ctx, req.(*RecordSessionRecapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionService_EndSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EndSessionRequest)
 	if err := dec(in); err != nil {
@@ -536,6 +574,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSessionRecap",
 			Handler:    _SessionService_GetSessionRecap_Handler,
 		},
+		{
+			MethodName: "RecordSessionRecap",
+			Handler:    _SessionService_RecordSessionRecap_Handler,
+		},
 		{
 			MethodName: "EndSession",
 			Handler:    _SessionService_EndSession_Handler,
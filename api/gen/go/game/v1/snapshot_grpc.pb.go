@@ -23,6 +23,7 @@ const (
 	SnapshotService_GetSnapshot_FullMethodName         = "/game.v1.SnapshotService/GetSnapshot"
 	SnapshotService_PatchCharacterState_FullMethodName = "/game.v1.SnapshotService/PatchCharacterState"
 	SnapshotService_UpdateSnapshotState_FullMethodName = "/game.v1.SnapshotService/UpdateSnapshotState"
+	SnapshotService_CorrectEvent_FullMethodName        = "/game.v1.SnapshotService/CorrectEvent"
 )
 
 // SnapshotServiceClient is the client API for SnapshotService service.
@@ -38,6 +39,9 @@ type SnapshotServiceClient interface {
 	PatchCharacterState(ctx context.Context, in *PatchCharacterStateRequest, opts ...grpc.CallOption) (*PatchCharacterStateResponse, error)
 	// Update the system-specific snapshot projection (e.g., GM Fear for Daggerheart).
 	UpdateSnapshotState(ctx context.Context, in *UpdateSnapshotStateRequest, opts ...grpc.CallOption) (*UpdateSnapshotStateResponse, error)
+	// Correct a recent event by emitting compensating events that restore the
+	// state it changed. Restricted to participants who can manage sessions.
+	CorrectEvent(ctx context.Context, in *CorrectEventRequest, opts ...grpc.CallOption) (*CorrectEventResponse, error)
 }
 
 type snapshotServiceClient struct {
@@ -78,6 +82,16 @@ func (c *snapshotServiceClient) UpdateSnapshotState(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *snapshotServiceClient) CorrectEvent(ctx context.Context, in *CorrectEventRequest, opts ...grpc.CallOption) (*CorrectEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrectEventResponse)
+	err := c.cc.Invoke(ctx, SnapshotService_CorrectEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SnapshotServiceServer is the server API for SnapshotService service.
 // All implementations must embed UnimplementedSnapshotServiceServer
 // for forward compatibility.
@@ -91,6 +105,9 @@ type SnapshotServiceServer interface {
 	PatchCharacterState(context.Context, *PatchCharacterStateRequest) (*PatchCharacterStateResponse, error)
 	// Update the system-specific snapshot projection (e.g., GM Fear for Daggerheart).
 	UpdateSnapshotState(context.Context, *UpdateSnapshotStateRequest) (*UpdateSnapshotStateResponse, error)
+	// Correct a recent event by emitting compensating events that restore the
+	// state it changed. Restricted to participants who can manage sessions.
+	CorrectEvent(context.Context, *CorrectEventRequest) (*CorrectEventResponse, error)
 	mustEmbedUnimplementedSnapshotServiceServer()
 }
 
@@ -110,6 +127,9 @@ func (UnimplementedSnapshotServiceServer) PatchCharacterState(context.Context, *
 func (UnimplementedSnapshotServiceServer) UpdateSnapshotState(context.Context, *UpdateSnapshotStateRequest) (*UpdateSnapshotStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSnapshotState not implemented")
 }
+func (UnimplementedSnapshotServiceServer) CorrectEvent(context.Context, *CorrectEventRequest) (*CorrectEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectEvent not implemented")
+}
 func (UnimplementedSnapshotServiceServer) mustEmbedUnimplementedSnapshotServiceServer() {}
 func (UnimplementedSnapshotServiceServer) testEmbeddedByValue()                         {}
 
@@ -185,6 +205,24 @@ func _SnapshotService_UpdateSnapshotState_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SnapshotService_CorrectEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServiceServer).CorrectEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnapshotService_CorrectEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServiceServer).CorrectEvent(ctx, req.(*CorrectEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SnapshotService_ServiceDesc is the grpc.ServiceDesc for SnapshotService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -204,6 +242,10 @@ var SnapshotService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSnapshotState",
 			Handler:    _SnapshotService_UpdateSnapshotState_Handler,
 		},
+		{
+			MethodName: "CorrectEvent",
+			Handler:    _SnapshotService_CorrectEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "game/v1/snapshot.proto",
@@ -79,6 +79,214 @@ func (InviteStatus) EnumDescriptor() ([]byte, []int) {
 	return file_invite_v1_invite_proto_rawDescGZIP(), []int{0}
 }
 
+// InviteLinkStatus represents the lifecycle state of a reusable invite link.
+type InviteLinkStatus int32
+
+const (
+	InviteLinkStatus_INVITE_LINK_STATUS_UNSPECIFIED InviteLinkStatus = 0
+	InviteLinkStatus_INVITE_LINK_ACTIVE             InviteLinkStatus = 1
+	InviteLinkStatus_INVITE_LINK_REVOKED            InviteLinkStatus = 2
+)
+
+// Enum value maps for InviteLinkStatus.
+var (
+	InviteLinkStatus_name = map[int32]string{
+		0: "INVITE_LINK_STATUS_UNSPECIFIED",
+		1: "INVITE_LINK_ACTIVE",
+		2: "INVITE_LINK_REVOKED",
+	}
+	InviteLinkStatus_value = map[string]int32{
+		"INVITE_LINK_STATUS_UNSPECIFIED": 0,
+		"INVITE_LINK_ACTIVE":             1,
+		"INVITE_LINK_REVOKED":            2,
+	}
+)
+
+func (x InviteLinkStatus) Enum() *InviteLinkStatus {
+	p := new(InviteLinkStatus)
+	*p = x
+	return p
+}
+
+func (x InviteLinkStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InviteLinkStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_invite_v1_invite_proto_enumTypes[1].Descriptor()
+}
+
+func (InviteLinkStatus) Type() protoreflect.EnumType {
+	return &file_invite_v1_invite_proto_enumTypes[1]
+}
+
+func (x InviteLinkStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InviteLinkStatus.Descriptor instead.
+func (InviteLinkStatus) EnumDescriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{1}
+}
+
+// InviteLinkRole is the participant role granted to users who join through a
+// reusable invite link.
+type InviteLinkRole int32
+
+const (
+	InviteLinkRole_INVITE_LINK_ROLE_UNSPECIFIED InviteLinkRole = 0
+	InviteLinkRole_INVITE_LINK_ROLE_PLAYER      InviteLinkRole = 1
+	InviteLinkRole_INVITE_LINK_ROLE_GM          InviteLinkRole = 2
+)
+
+// Enum value maps for InviteLinkRole.
+var (
+	InviteLinkRole_name = map[int32]string{
+		0: "INVITE_LINK_ROLE_UNSPECIFIED",
+		1: "INVITE_LINK_ROLE_PLAYER",
+		2: "INVITE_LINK_ROLE_GM",
+	}
+	InviteLinkRole_value = map[string]int32{
+		"INVITE_LINK_ROLE_UNSPECIFIED": 0,
+		"INVITE_LINK_ROLE_PLAYER":      1,
+		"INVITE_LINK_ROLE_GM":          2,
+	}
+)
+
+func (x InviteLinkRole) Enum() *InviteLinkRole {
+	p := new(InviteLinkRole)
+	*p = x
+	return p
+}
+
+func (x InviteLinkRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InviteLinkRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_invite_v1_invite_proto_enumTypes[2].Descriptor()
+}
+
+func (InviteLinkRole) Type() protoreflect.EnumType {
+	return &file_invite_v1_invite_proto_enumTypes[2]
+}
+
+func (x InviteLinkRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InviteLinkRole.Descriptor instead.
+func (InviteLinkRole) EnumDescriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{2}
+}
+
+// InviteLinkClaimStatus represents the state of one user's claim on a link.
+type InviteLinkClaimStatus int32
+
+const (
+	InviteLinkClaimStatus_INVITE_LINK_CLAIM_STATUS_UNSPECIFIED InviteLinkClaimStatus = 0
+	InviteLinkClaimStatus_INVITE_LINK_CLAIM_PENDING_APPROVAL   InviteLinkClaimStatus = 1
+	InviteLinkClaimStatus_INVITE_LINK_CLAIM_JOINED             InviteLinkClaimStatus = 2
+	InviteLinkClaimStatus_INVITE_LINK_CLAIM_DECLINED           InviteLinkClaimStatus = 3
+)
+
+// Enum value maps for InviteLinkClaimStatus.
+var (
+	InviteLinkClaimStatus_name = map[int32]string{
+		0: "INVITE_LINK_CLAIM_STATUS_UNSPECIFIED",
+		1: "INVITE_LINK_CLAIM_PENDING_APPROVAL",
+		2: "INVITE_LINK_CLAIM_JOINED",
+		3: "INVITE_LINK_CLAIM_DECLINED",
+	}
+	InviteLinkClaimStatus_value = map[string]int32{
+		"INVITE_LINK_CLAIM_STATUS_UNSPECIFIED": 0,
+		"INVITE_LINK_CLAIM_PENDING_APPROVAL":   1,
+		"INVITE_LINK_CLAIM_JOINED":             2,
+		"INVITE_LINK_CLAIM_DECLINED":           3,
+	}
+)
+
+func (x InviteLinkClaimStatus) Enum() *InviteLinkClaimStatus {
+	p := new(InviteLinkClaimStatus)
+	*p = x
+	return p
+}
+
+func (x InviteLinkClaimStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InviteLinkClaimStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_invite_v1_invite_proto_enumTypes[3].Descriptor()
+}
+
+func (InviteLinkClaimStatus) Type() protoreflect.EnumType {
+	return &file_invite_v1_invite_proto_enumTypes[3]
+}
+
+func (x InviteLinkClaimStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InviteLinkClaimStatus.Descriptor instead.
+func (InviteLinkClaimStatus) EnumDescriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{3}
+}
+
+// CampaignApplicationStatus represents the review state of an application to
+// join a campaign through its open-table listing.
+type CampaignApplicationStatus int32
+
+const (
+	CampaignApplicationStatus_CAMPAIGN_APPLICATION_STATUS_UNSPECIFIED CampaignApplicationStatus = 0
+	CampaignApplicationStatus_CAMPAIGN_APPLICATION_PENDING            CampaignApplicationStatus = 1
+	CampaignApplicationStatus_CAMPAIGN_APPLICATION_ACCEPTED           CampaignApplicationStatus = 2
+	CampaignApplicationStatus_CAMPAIGN_APPLICATION_DECLINED           CampaignApplicationStatus = 3
+)
+
+// Enum value maps for CampaignApplicationStatus.
+var (
+	CampaignApplicationStatus_name = map[int32]string{
+		0: "CAMPAIGN_APPLICATION_STATUS_UNSPECIFIED",
+		1: "CAMPAIGN_APPLICATION_PENDING",
+		2: "CAMPAIGN_APPLICATION_ACCEPTED",
+		3: "CAMPAIGN_APPLICATION_DECLINED",
+	}
+	CampaignApplicationStatus_value = map[string]int32{
+		"CAMPAIGN_APPLICATION_STATUS_UNSPECIFIED": 0,
+		"CAMPAIGN_APPLICATION_PENDING":            1,
+		"CAMPAIGN_APPLICATION_ACCEPTED":           2,
+		"CAMPAIGN_APPLICATION_DECLINED":           3,
+	}
+)
+
+func (x CampaignApplicationStatus) Enum() *CampaignApplicationStatus {
+	p := new(CampaignApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x CampaignApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_invite_v1_invite_proto_enumTypes[4].Descriptor()
+}
+
+func (CampaignApplicationStatus) Type() protoreflect.EnumType {
+	return &file_invite_v1_invite_proto_enumTypes[4]
+}
+
+func (x CampaignApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignApplicationStatus.Descriptor instead.
+func (CampaignApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{4}
+}
+
 type IntegrationOutboxAckOutcome int32
 
 const (
@@ -115,11 +323,11 @@ func (x IntegrationOutboxAckOutcome) String() string {
 }
 
 func (IntegrationOutboxAckOutcome) Descriptor() protoreflect.EnumDescriptor {
-	return file_invite_v1_invite_proto_enumTypes[1].Descriptor()
+	return file_invite_v1_invite_proto_enumTypes[5].Descriptor()
 }
 
 func (IntegrationOutboxAckOutcome) Type() protoreflect.EnumType {
-	return &file_invite_v1_invite_proto_enumTypes[1]
+	return &file_invite_v1_invite_proto_enumTypes[5]
 }
 
 func (x IntegrationOutboxAckOutcome) Number() protoreflect.EnumNumber {
@@ -128,7 +336,7 @@ func (x IntegrationOutboxAckOutcome) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IntegrationOutboxAckOutcome.Descriptor instead.
 func (IntegrationOutboxAckOutcome) EnumDescriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{1}
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{5}
 }
 
 // Invite represents a seat-targeted campaign invite.
@@ -416,30 +624,42 @@ func (x *InviteUserSummary) GetUsername() string {
 	return ""
 }
 
-type CreateInviteRequest struct {
+// InviteLink is a shareable campaign invite that any signed-in user can claim
+// until it expires, runs out of uses, or is revoked.
+type InviteLink struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId             string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	ParticipantId          string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
-	RecipientUserId        string                 `protobuf:"bytes,3,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
-	CreatedByParticipantId string                 `protobuf:"bytes,4,opt,name=created_by_participant_id,json=createdByParticipantId,proto3" json:"created_by_participant_id,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *CreateInviteRequest) Reset() {
-	*x = CreateInviteRequest{}
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignId             string                 `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CreatedByParticipantId string                 `protobuf:"bytes,3,opt,name=created_by_participant_id,json=createdByParticipantId,proto3" json:"created_by_participant_id,omitempty"`
+	DefaultRole            InviteLinkRole         `protobuf:"varint,4,opt,name=default_role,json=defaultRole,proto3,enum=invite.v1.InviteLinkRole" json:"default_role,omitempty"`
+	// Zero means the link has no usage limit.
+	MaxUses int32 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// Counts joined claims plus claims awaiting GM approval.
+	UseCount int32 `protobuf:"varint,6,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	// Unset means the link never expires.
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,8,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	Status           InviteLinkStatus       `protobuf:"varint,9,opt,name=status,proto3,enum=invite.v1.InviteLinkStatus" json:"status,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InviteLink) Reset() {
+	*x = InviteLink{}
 	mi := &file_invite_v1_invite_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInviteRequest) String() string {
+func (x *InviteLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInviteRequest) ProtoMessage() {}
+func (*InviteLink) ProtoMessage() {}
 
-func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *InviteLink) ProtoReflect() protoreflect.Message {
 	mi := &file_invite_v1_invite_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,107 +671,119 @@ func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
-func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteLink.ProtoReflect.Descriptor instead.
+func (*InviteLink) Descriptor() ([]byte, []int) {
 	return file_invite_v1_invite_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateInviteRequest) GetCampaignId() string {
+func (x *InviteLink) GetId() string {
 	if x != nil {
-		return x.CampaignId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateInviteRequest) GetParticipantId() string {
+func (x *InviteLink) GetCampaignId() string {
 	if x != nil {
-		return x.ParticipantId
+		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *CreateInviteRequest) GetRecipientUserId() string {
+func (x *InviteLink) GetCreatedByParticipantId() string {
 	if x != nil {
-		return x.RecipientUserId
+		return x.CreatedByParticipantId
 	}
 	return ""
 }
 
-func (x *CreateInviteRequest) GetCreatedByParticipantId() string {
+func (x *InviteLink) GetDefaultRole() InviteLinkRole {
 	if x != nil {
-		return x.CreatedByParticipantId
+		return x.DefaultRole
 	}
-	return ""
+	return InviteLinkRole_INVITE_LINK_ROLE_UNSPECIFIED
 }
 
-type CreateInviteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InviteLink) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
 }
 
-func (x *CreateInviteResponse) Reset() {
-	*x = CreateInviteResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InviteLink) GetUseCount() int32 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
 }
 
-func (x *CreateInviteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InviteLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (*CreateInviteResponse) ProtoMessage() {}
+func (x *InviteLink) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
 
-func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[5]
+func (x *InviteLink) GetStatus() InviteLinkStatus {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return InviteLinkStatus_INVITE_LINK_STATUS_UNSPECIFIED
 }
 
-// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
-func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{5}
+func (x *InviteLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *CreateInviteResponse) GetInvite() *Invite {
+func (x *InviteLink) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Invite
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type ClaimInviteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	InviteId      string                 `protobuf:"bytes,2,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
-	JoinGrant     string                 `protobuf:"bytes,3,opt,name=join_grant,json=joinGrant,proto3" json:"join_grant,omitempty"`
+// InviteLinkClaim records one user claiming a reusable invite link.
+type InviteLinkClaim struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LinkId     string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	CampaignId string                 `protobuf:"bytes,3,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Set once the claimant has a participant seat.
+	ParticipantId string                 `protobuf:"bytes,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Status        InviteLinkClaimStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=invite.v1.InviteLinkClaimStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClaimInviteRequest) Reset() {
-	*x = ClaimInviteRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[6]
+func (x *InviteLinkClaim) Reset() {
+	*x = InviteLinkClaim{}
+	mi := &file_invite_v1_invite_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClaimInviteRequest) String() string {
+func (x *InviteLinkClaim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimInviteRequest) ProtoMessage() {}
+func (*InviteLinkClaim) ProtoMessage() {}
 
-func (x *ClaimInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[6]
+func (x *InviteLinkClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,55 +794,102 @@ func (x *ClaimInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimInviteRequest.ProtoReflect.Descriptor instead.
-func (*ClaimInviteRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use InviteLinkClaim.ProtoReflect.Descriptor instead.
+func (*InviteLinkClaim) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ClaimInviteRequest) GetCampaignId() string {
+func (x *InviteLinkClaim) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InviteLinkClaim) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *InviteLinkClaim) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *ClaimInviteRequest) GetInviteId() string {
+func (x *InviteLinkClaim) GetUserId() string {
 	if x != nil {
-		return x.InviteId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ClaimInviteRequest) GetJoinGrant() string {
+func (x *InviteLinkClaim) GetParticipantId() string {
 	if x != nil {
-		return x.JoinGrant
+		return x.ParticipantId
 	}
 	return ""
 }
 
-type ClaimInviteResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Invite        *Invite                   `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
-	Participant   *InviteParticipantSummary `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+func (x *InviteLinkClaim) GetStatus() InviteLinkClaimStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InviteLinkClaimStatus_INVITE_LINK_CLAIM_STATUS_UNSPECIFIED
+}
+
+func (x *InviteLinkClaim) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InviteLinkClaim) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CampaignApplication records one player asking to join a campaign that
+// advertises open seats.
+type CampaignApplication struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignId      string                 `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ApplicantUserId string                 `protobuf:"bytes,3,opt,name=applicant_user_id,json=applicantUserId,proto3" json:"applicant_user_id,omitempty"`
+	Message         string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Optional free-text pitch for the character the applicant would bring.
+	CharacterConcept string                    `protobuf:"bytes,5,opt,name=character_concept,json=characterConcept,proto3" json:"character_concept,omitempty"`
+	Status           CampaignApplicationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=invite.v1.CampaignApplicationStatus" json:"status,omitempty"`
+	// Set once accepted; the invite targets the seat created for the applicant.
+	InviteId      string                 `protobuf:"bytes,7,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,8,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClaimInviteResponse) Reset() {
-	*x = ClaimInviteResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[7]
+func (x *CampaignApplication) Reset() {
+	*x = CampaignApplication{}
+	mi := &file_invite_v1_invite_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClaimInviteResponse) String() string {
+func (x *CampaignApplication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimInviteResponse) ProtoMessage() {}
+func (*CampaignApplication) ProtoMessage() {}
 
-func (x *ClaimInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[7]
+func (x *CampaignApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,46 +900,170 @@ func (x *ClaimInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimInviteResponse.ProtoReflect.Descriptor instead.
-func (*ClaimInviteResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CampaignApplication.ProtoReflect.Descriptor instead.
+func (*CampaignApplication) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ClaimInviteResponse) GetInvite() *Invite {
+func (x *CampaignApplication) GetId() string {
 	if x != nil {
-		return x.Invite
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CampaignApplication) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CampaignApplication) GetApplicantUserId() string {
+	if x != nil {
+		return x.ApplicantUserId
+	}
+	return ""
+}
+
+func (x *CampaignApplication) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CampaignApplication) GetCharacterConcept() string {
+	if x != nil {
+		return x.CharacterConcept
+	}
+	return ""
+}
+
+func (x *CampaignApplication) GetStatus() CampaignApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CampaignApplicationStatus_CAMPAIGN_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *CampaignApplication) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *CampaignApplication) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *CampaignApplication) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ClaimInviteResponse) GetParticipant() *InviteParticipantSummary {
+func (x *CampaignApplication) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Participant
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type DeclineInviteRequest struct {
+type CreateInviteRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId             string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ParticipantId          string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	RecipientUserId        string                 `protobuf:"bytes,3,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	CreatedByParticipantId string                 `protobuf:"bytes,4,opt,name=created_by_participant_id,json=createdByParticipantId,proto3" json:"created_by_participant_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateInviteRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetCreatedByParticipantId() string {
+	if x != nil {
+		return x.CreatedByParticipantId
+	}
+	return ""
+}
+
+type CreateInviteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeclineInviteRequest) Reset() {
-	*x = DeclineInviteRequest{}
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
 	mi := &file_invite_v1_invite_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeclineInviteRequest) String() string {
+func (x *CreateInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeclineInviteRequest) ProtoMessage() {}
+func (*CreateInviteResponse) ProtoMessage() {}
 
-func (x *DeclineInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invite_v1_invite_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,39 +1075,41 @@ func (x *DeclineInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeclineInviteRequest.ProtoReflect.Descriptor instead.
-func (*DeclineInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
 	return file_invite_v1_invite_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeclineInviteRequest) GetInviteId() string {
+func (x *CreateInviteResponse) GetInvite() *Invite {
 	if x != nil {
-		return x.InviteId
+		return x.Invite
 	}
-	return ""
+	return nil
 }
 
-type DeclineInviteResponse struct {
+type ClaimInviteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	InviteId      string                 `protobuf:"bytes,2,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	JoinGrant     string                 `protobuf:"bytes,3,opt,name=join_grant,json=joinGrant,proto3" json:"join_grant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeclineInviteResponse) Reset() {
-	*x = DeclineInviteResponse{}
+func (x *ClaimInviteRequest) Reset() {
+	*x = ClaimInviteRequest{}
 	mi := &file_invite_v1_invite_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeclineInviteResponse) String() string {
+func (x *ClaimInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeclineInviteResponse) ProtoMessage() {}
+func (*ClaimInviteRequest) ProtoMessage() {}
 
-func (x *DeclineInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *ClaimInviteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invite_v1_invite_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,39 +1121,54 @@ func (x *DeclineInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeclineInviteResponse.ProtoReflect.Descriptor instead.
-func (*DeclineInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClaimInviteRequest.ProtoReflect.Descriptor instead.
+func (*ClaimInviteRequest) Descriptor() ([]byte, []int) {
 	return file_invite_v1_invite_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeclineInviteResponse) GetInvite() *Invite {
+func (x *ClaimInviteRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Invite
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
-type RevokeInviteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+func (x *ClaimInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *ClaimInviteRequest) GetJoinGrant() string {
+	if x != nil {
+		return x.JoinGrant
+	}
+	return ""
+}
+
+type ClaimInviteResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Invite        *Invite                   `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Participant   *InviteParticipantSummary `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeInviteRequest) Reset() {
-	*x = RevokeInviteRequest{}
+func (x *ClaimInviteResponse) Reset() {
+	*x = ClaimInviteResponse{}
 	mi := &file_invite_v1_invite_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeInviteRequest) String() string {
+func (x *ClaimInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeInviteRequest) ProtoMessage() {}
+func (*ClaimInviteResponse) ProtoMessage() {}
 
-func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *ClaimInviteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_invite_v1_invite_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -760,39 +1180,46 @@ func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
-func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClaimInviteResponse.ProtoReflect.Descriptor instead.
+func (*ClaimInviteResponse) Descriptor() ([]byte, []int) {
 	return file_invite_v1_invite_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RevokeInviteRequest) GetInviteId() string {
+func (x *ClaimInviteResponse) GetInvite() *Invite {
 	if x != nil {
-		return x.InviteId
+		return x.Invite
 	}
-	return ""
+	return nil
 }
 
-type RevokeInviteResponse struct {
+func (x *ClaimInviteResponse) GetParticipant() *InviteParticipantSummary {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type DeclineInviteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeInviteResponse) Reset() {
-	*x = RevokeInviteResponse{}
+func (x *DeclineInviteRequest) Reset() {
+	*x = DeclineInviteRequest{}
 	mi := &file_invite_v1_invite_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeInviteResponse) String() string {
+func (x *DeclineInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeInviteResponse) ProtoMessage() {}
+func (*DeclineInviteRequest) ProtoMessage() {}
 
-func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *DeclineInviteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_invite_v1_invite_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -804,40 +1231,1452 @@ func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
-func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeclineInviteRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInviteRequest) Descriptor() ([]byte, []int) {
 	return file_invite_v1_invite_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RevokeInviteResponse) GetInvite() *Invite {
+func (x *DeclineInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type DeclineInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInviteResponse) Reset() {
+	*x = DeclineInviteResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInviteResponse) ProtoMessage() {}
+
+func (x *DeclineInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInviteResponse.ProtoReflect.Descriptor instead.
+func (*DeclineInviteResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeclineInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RevokeInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type GetInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteRequest) Reset() {
+	*x = GetInviteRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteRequest) ProtoMessage() {}
+
+func (x *GetInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteRequest.ProtoReflect.Descriptor instead.
+func (*GetInviteRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type GetInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteResponse) Reset() {
+	*x = GetInviteResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteResponse) ProtoMessage() {}
+
+func (x *GetInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteResponse.ProtoReflect.Descriptor instead.
+func (*GetInviteResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type GetPublicInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicInviteRequest) Reset() {
+	*x = GetPublicInviteRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicInviteRequest) ProtoMessage() {}
+
+func (x *GetPublicInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicInviteRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicInviteRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPublicInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type GetPublicInviteResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Invite        *Invite                   `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Campaign      *InviteCampaignSummary    `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Participant   *InviteParticipantSummary `protobuf:"bytes,3,opt,name=participant,proto3" json:"participant,omitempty"`
+	CreatedByUser *InviteUserSummary        `protobuf:"bytes,4,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicInviteResponse) Reset() {
+	*x = GetPublicInviteResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicInviteResponse) ProtoMessage() {}
+
+func (x *GetPublicInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicInviteResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicInviteResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPublicInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *GetPublicInviteResponse) GetCampaign() *InviteCampaignSummary {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *GetPublicInviteResponse) GetParticipant() *InviteParticipantSummary {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *GetPublicInviteResponse) GetCreatedByUser() *InviteUserSummary {
+	if x != nil {
+		return x.CreatedByUser
+	}
+	return nil
+}
+
+type ListInvitesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId      string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	RecipientUserId string                 `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	Status          InviteStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=invite.v1.InviteStatus" json:"status,omitempty"`
+	PageSize        int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListInvitesRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListInvitesRequest) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *ListInvitesRequest) GetStatus() InviteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InviteStatus_INVITE_STATUS_UNSPECIFIED
+}
+
+func (x *ListInvitesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvitesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *ListInvitesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListPendingInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInvitesRequest) Reset() {
+	*x = ListPendingInvitesRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInvitesRequest) ProtoMessage() {}
+
+func (x *ListPendingInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListPendingInvitesRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListPendingInvitesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingInvitesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPendingInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInvitesResponse) Reset() {
+	*x = ListPendingInvitesResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInvitesResponse) ProtoMessage() {}
+
+func (x *ListPendingInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListPendingInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *ListPendingInvitesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListPendingInvitesForUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInvitesForUserRequest) Reset() {
+	*x = ListPendingInvitesForUserRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInvitesForUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInvitesForUserRequest) ProtoMessage() {}
+
+func (x *ListPendingInvitesForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInvitesForUserRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingInvitesForUserRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPendingInvitesForUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingInvitesForUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// PendingInviteForUserEntry wraps a pending invite with enriched campaign and
+// participant summaries for dashboard display.
+type PendingInviteForUserEntry struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Invite        *Invite                   `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Campaign      *InviteCampaignSummary    `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Participant   *InviteParticipantSummary `protobuf:"bytes,3,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingInviteForUserEntry) Reset() {
+	*x = PendingInviteForUserEntry{}
+	mi := &file_invite_v1_invite_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingInviteForUserEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingInviteForUserEntry) ProtoMessage() {}
+
+func (x *PendingInviteForUserEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingInviteForUserEntry.ProtoReflect.Descriptor instead.
+func (*PendingInviteForUserEntry) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PendingInviteForUserEntry) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *PendingInviteForUserEntry) GetCampaign() *InviteCampaignSummary {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *PendingInviteForUserEntry) GetParticipant() *InviteParticipantSummary {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type ListPendingInvitesForUserResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Invites       []*PendingInviteForUserEntry `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	NextPageToken string                       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInvitesForUserResponse) Reset() {
+	*x = ListPendingInvitesForUserResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInvitesForUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInvitesForUserResponse) ProtoMessage() {}
+
+func (x *ListPendingInvitesForUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInvitesForUserResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingInvitesForUserResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPendingInvitesForUserResponse) GetInvites() []*PendingInviteForUserEntry {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *ListPendingInvitesForUserResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateInviteLinkRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId             string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CreatedByParticipantId string                 `protobuf:"bytes,2,opt,name=created_by_participant_id,json=createdByParticipantId,proto3" json:"created_by_participant_id,omitempty"`
+	DefaultRole            InviteLinkRole         `protobuf:"varint,3,opt,name=default_role,json=defaultRole,proto3,enum=invite.v1.InviteLinkRole" json:"default_role,omitempty"`
+	MaxUses                int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	ExpiresAt              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RequiresApproval       bool                   `protobuf:"varint,6,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateInviteLinkRequest) Reset() {
+	*x = CreateInviteLinkRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteLinkRequest) ProtoMessage() {}
+
+func (x *CreateInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateInviteLinkRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CreateInviteLinkRequest) GetCreatedByParticipantId() string {
+	if x != nil {
+		return x.CreatedByParticipantId
+	}
+	return ""
+}
+
+func (x *CreateInviteLinkRequest) GetDefaultRole() InviteLinkRole {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return InviteLinkRole_INVITE_LINK_ROLE_UNSPECIFIED
+}
+
+func (x *CreateInviteLinkRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteLinkRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateInviteLinkRequest) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+type CreateInviteLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *InviteLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteLinkResponse) Reset() {
+	*x = CreateInviteLinkResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteLinkResponse) ProtoMessage() {}
+
+func (x *CreateInviteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateInviteLinkResponse) GetLink() *InviteLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type GetPublicInviteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicInviteLinkRequest) Reset() {
+	*x = GetPublicInviteLinkRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicInviteLinkRequest) ProtoMessage() {}
+
+func (x *GetPublicInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPublicInviteLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type GetPublicInviteLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *InviteLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Campaign      *InviteCampaignSummary `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	CreatedByUser *InviteUserSummary     `protobuf:"bytes,3,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicInviteLinkResponse) Reset() {
+	*x = GetPublicInviteLinkResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicInviteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicInviteLinkResponse) ProtoMessage() {}
+
+func (x *GetPublicInviteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicInviteLinkResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPublicInviteLinkResponse) GetLink() *InviteLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *GetPublicInviteLinkResponse) GetCampaign() *InviteCampaignSummary {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *GetPublicInviteLinkResponse) GetCreatedByUser() *InviteUserSummary {
+	if x != nil {
+		return x.CreatedByUser
+	}
+	return nil
+}
+
+type ListInviteLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteLinksRequest) Reset() {
+	*x = ListInviteLinksRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinksRequest) ProtoMessage() {}
+
+func (x *ListInviteLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListInviteLinksRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListInviteLinksRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListInviteLinksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInviteLinksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInviteLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*InviteLink          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteLinksResponse) Reset() {
+	*x = ListInviteLinksResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinksResponse) ProtoMessage() {}
+
+func (x *ListInviteLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListInviteLinksResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListInviteLinksResponse) GetLinks() []*InviteLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ListInviteLinksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RevokeInviteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteLinkRequest) Reset() {
+	*x = RevokeInviteLinkRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteLinkRequest) ProtoMessage() {}
+
+func (x *RevokeInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevokeInviteLinkRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RevokeInviteLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type RevokeInviteLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *InviteLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteLinkResponse) Reset() {
+	*x = RevokeInviteLinkResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteLinkResponse) ProtoMessage() {}
+
+func (x *RevokeInviteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevokeInviteLinkResponse) GetLink() *InviteLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type ClaimInviteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	JoinGrant     string                 `protobuf:"bytes,3,opt,name=join_grant,json=joinGrant,proto3" json:"join_grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInviteLinkRequest) Reset() {
+	*x = ClaimInviteLinkRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInviteLinkRequest) ProtoMessage() {}
+
+func (x *ClaimInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*ClaimInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClaimInviteLinkRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ClaimInviteLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *ClaimInviteLinkRequest) GetJoinGrant() string {
+	if x != nil {
+		return x.JoinGrant
+	}
+	return ""
+}
+
+type ClaimInviteLinkResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Claim         *InviteLinkClaim          `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	Participant   *InviteParticipantSummary `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInviteLinkResponse) Reset() {
+	*x = ClaimInviteLinkResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInviteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInviteLinkResponse) ProtoMessage() {}
+
+func (x *ClaimInviteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInviteLinkResponse.ProtoReflect.Descriptor instead.
+func (*ClaimInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ClaimInviteLinkResponse) GetClaim() *InviteLinkClaim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+func (x *ClaimInviteLinkResponse) GetParticipant() *InviteParticipantSummary {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type ListInviteLinkClaimsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional; narrows the history to one link.
+	LinkId        string `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteLinkClaimsRequest) Reset() {
+	*x = ListInviteLinkClaimsRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinkClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinkClaimsRequest) ProtoMessage() {}
+
+func (x *ListInviteLinkClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinkClaimsRequest.ProtoReflect.Descriptor instead.
+func (*ListInviteLinkClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListInviteLinkClaimsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListInviteLinkClaimsRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *ListInviteLinkClaimsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInviteLinkClaimsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInviteLinkClaimsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claims        []*InviteLinkClaim     `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteLinkClaimsResponse) Reset() {
+	*x = ListInviteLinkClaimsResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinkClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinkClaimsResponse) ProtoMessage() {}
+
+func (x *ListInviteLinkClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinkClaimsResponse.ProtoReflect.Descriptor instead.
+func (*ListInviteLinkClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListInviteLinkClaimsResponse) GetClaims() []*InviteLinkClaim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *ListInviteLinkClaimsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Invite
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type GetInviteRequest struct {
+type ApproveInviteLinkClaimRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ClaimId       string                 `protobuf:"bytes,2,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInviteRequest) Reset() {
-	*x = GetInviteRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[12]
+func (x *ApproveInviteLinkClaimRequest) Reset() {
+	*x = ApproveInviteLinkClaimRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInviteRequest) String() string {
+func (x *ApproveInviteLinkClaimRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInviteRequest) ProtoMessage() {}
+func (*ApproveInviteLinkClaimRequest) ProtoMessage() {}
 
-func (x *GetInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[12]
+func (x *ApproveInviteLinkClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,40 +2687,48 @@ func (x *GetInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInviteRequest.ProtoReflect.Descriptor instead.
-func (*GetInviteRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ApproveInviteLinkClaimRequest.ProtoReflect.Descriptor instead.
+func (*ApproveInviteLinkClaimRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetInviteRequest) GetInviteId() string {
+func (x *ApproveInviteLinkClaimRequest) GetCampaignId() string {
 	if x != nil {
-		return x.InviteId
+		return x.CampaignId
 	}
 	return ""
 }
 
-type GetInviteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+func (x *ApproveInviteLinkClaimRequest) GetClaimId() string {
+	if x != nil {
+		return x.ClaimId
+	}
+	return ""
+}
+
+type ApproveInviteLinkClaimResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Claim         *InviteLinkClaim          `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	Participant   *InviteParticipantSummary `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInviteResponse) Reset() {
-	*x = GetInviteResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[13]
+func (x *ApproveInviteLinkClaimResponse) Reset() {
+	*x = ApproveInviteLinkClaimResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInviteResponse) String() string {
+func (x *ApproveInviteLinkClaimResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInviteResponse) ProtoMessage() {}
+func (*ApproveInviteLinkClaimResponse) ProtoMessage() {}
 
-func (x *GetInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[13]
+func (x *ApproveInviteLinkClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,40 +2739,48 @@ func (x *GetInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInviteResponse.ProtoReflect.Descriptor instead.
-func (*GetInviteResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ApproveInviteLinkClaimResponse.ProtoReflect.Descriptor instead.
+func (*ApproveInviteLinkClaimResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetInviteResponse) GetInvite() *Invite {
+func (x *ApproveInviteLinkClaimResponse) GetClaim() *InviteLinkClaim {
 	if x != nil {
-		return x.Invite
+		return x.Claim
 	}
 	return nil
 }
 
-type GetPublicInviteRequest struct {
+func (x *ApproveInviteLinkClaimResponse) GetParticipant() *InviteParticipantSummary {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type DeclineInviteLinkClaimRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ClaimId       string                 `protobuf:"bytes,2,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPublicInviteRequest) Reset() {
-	*x = GetPublicInviteRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[14]
+func (x *DeclineInviteLinkClaimRequest) Reset() {
+	*x = DeclineInviteLinkClaimRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPublicInviteRequest) String() string {
+func (x *DeclineInviteLinkClaimRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPublicInviteRequest) ProtoMessage() {}
+func (*DeclineInviteLinkClaimRequest) ProtoMessage() {}
 
-func (x *GetPublicInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[14]
+func (x *DeclineInviteLinkClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,43 +2791,47 @@ func (x *GetPublicInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPublicInviteRequest.ProtoReflect.Descriptor instead.
-func (*GetPublicInviteRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeclineInviteLinkClaimRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInviteLinkClaimRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetPublicInviteRequest) GetInviteId() string {
+func (x *DeclineInviteLinkClaimRequest) GetCampaignId() string {
 	if x != nil {
-		return x.InviteId
+		return x.CampaignId
 	}
 	return ""
 }
 
-type GetPublicInviteResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Invite        *Invite                   `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
-	Campaign      *InviteCampaignSummary    `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
-	Participant   *InviteParticipantSummary `protobuf:"bytes,3,opt,name=participant,proto3" json:"participant,omitempty"`
-	CreatedByUser *InviteUserSummary        `protobuf:"bytes,4,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
+func (x *DeclineInviteLinkClaimRequest) GetClaimId() string {
+	if x != nil {
+		return x.ClaimId
+	}
+	return ""
+}
+
+type DeclineInviteLinkClaimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claim         *InviteLinkClaim       `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPublicInviteResponse) Reset() {
-	*x = GetPublicInviteResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[15]
+func (x *DeclineInviteLinkClaimResponse) Reset() {
+	*x = DeclineInviteLinkClaimResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPublicInviteResponse) String() string {
+func (x *DeclineInviteLinkClaimResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPublicInviteResponse) ProtoMessage() {}
+func (*DeclineInviteLinkClaimResponse) ProtoMessage() {}
 
-func (x *GetPublicInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[15]
+func (x *DeclineInviteLinkClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,65 +2842,42 @@ func (x *GetPublicInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPublicInviteResponse.ProtoReflect.Descriptor instead.
-func (*GetPublicInviteResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetPublicInviteResponse) GetInvite() *Invite {
-	if x != nil {
-		return x.Invite
-	}
-	return nil
-}
-
-func (x *GetPublicInviteResponse) GetCampaign() *InviteCampaignSummary {
-	if x != nil {
-		return x.Campaign
-	}
-	return nil
-}
-
-func (x *GetPublicInviteResponse) GetParticipant() *InviteParticipantSummary {
-	if x != nil {
-		return x.Participant
-	}
-	return nil
+// Deprecated: Use DeclineInviteLinkClaimResponse.ProtoReflect.Descriptor instead.
+func (*DeclineInviteLinkClaimResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetPublicInviteResponse) GetCreatedByUser() *InviteUserSummary {
+func (x *DeclineInviteLinkClaimResponse) GetClaim() *InviteLinkClaim {
 	if x != nil {
-		return x.CreatedByUser
+		return x.Claim
 	}
 	return nil
 }
 
-type ListInvitesRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId      string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	RecipientUserId string                 `protobuf:"bytes,2,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
-	Status          InviteStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=invite.v1.InviteStatus" json:"status,omitempty"`
-	PageSize        int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken       string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SubmitCampaignApplicationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId       string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CharacterConcept string                 `protobuf:"bytes,3,opt,name=character_concept,json=characterConcept,proto3" json:"character_concept,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListInvitesRequest) Reset() {
-	*x = ListInvitesRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[16]
+func (x *SubmitCampaignApplicationRequest) Reset() {
+	*x = SubmitCampaignApplicationRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInvitesRequest) String() string {
+func (x *SubmitCampaignApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvitesRequest) ProtoMessage() {}
+func (*SubmitCampaignApplicationRequest) ProtoMessage() {}
 
-func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[16]
+func (x *SubmitCampaignApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,69 +2888,54 @@ func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
-func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SubmitCampaignApplicationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitCampaignApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListInvitesRequest) GetCampaignId() string {
+func (x *SubmitCampaignApplicationRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *ListInvitesRequest) GetRecipientUserId() string {
+func (x *SubmitCampaignApplicationRequest) GetMessage() string {
 	if x != nil {
-		return x.RecipientUserId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ListInvitesRequest) GetStatus() InviteStatus {
-	if x != nil {
-		return x.Status
-	}
-	return InviteStatus_INVITE_STATUS_UNSPECIFIED
-}
-
-func (x *ListInvitesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListInvitesRequest) GetPageToken() string {
+func (x *SubmitCampaignApplicationRequest) GetCharacterConcept() string {
 	if x != nil {
-		return x.PageToken
+		return x.CharacterConcept
 	}
 	return ""
 }
 
-type ListInvitesResponse struct {
+type SubmitCampaignApplicationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Application   *CampaignApplication   `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInvitesResponse) Reset() {
-	*x = ListInvitesResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[17]
+func (x *SubmitCampaignApplicationResponse) Reset() {
+	*x = SubmitCampaignApplicationResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInvitesResponse) String() string {
+func (x *SubmitCampaignApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvitesResponse) ProtoMessage() {}
+func (*SubmitCampaignApplicationResponse) ProtoMessage() {}
 
-func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[17]
+func (x *SubmitCampaignApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,49 +2946,44 @@ func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
-func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SubmitCampaignApplicationResponse.ProtoReflect.Descriptor instead.
+func (*SubmitCampaignApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListInvitesResponse) GetInvites() []*Invite {
+func (x *SubmitCampaignApplicationResponse) GetApplication() *CampaignApplication {
 	if x != nil {
-		return x.Invites
+		return x.Application
 	}
 	return nil
 }
 
-func (x *ListInvitesResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type ListPendingInvitesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+type ListCampaignApplicationsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional; narrows the list to one review state.
+	Status        CampaignApplicationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=invite.v1.CampaignApplicationStatus" json:"status,omitempty"`
+	PageSize      int32                     `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                    `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingInvitesRequest) Reset() {
-	*x = ListPendingInvitesRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[18]
+func (x *ListCampaignApplicationsRequest) Reset() {
+	*x = ListCampaignApplicationsRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingInvitesRequest) String() string {
+func (x *ListCampaignApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingInvitesRequest) ProtoMessage() {}
+func (*ListCampaignApplicationsRequest) ProtoMessage() {}
 
-func (x *ListPendingInvitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[18]
+func (x *ListCampaignApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,55 +2994,62 @@ func (x *ListPendingInvitesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingInvitesRequest.ProtoReflect.Descriptor instead.
-func (*ListPendingInvitesRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListCampaignApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListPendingInvitesRequest) GetCampaignId() string {
+func (x *ListCampaignApplicationsRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *ListPendingInvitesRequest) GetPageSize() int32 {
+func (x *ListCampaignApplicationsRequest) GetStatus() CampaignApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CampaignApplicationStatus_CAMPAIGN_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *ListCampaignApplicationsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListPendingInvitesRequest) GetPageToken() string {
+func (x *ListCampaignApplicationsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListPendingInvitesResponse struct {
+type ListCampaignApplicationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	Applications  []*CampaignApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingInvitesResponse) Reset() {
-	*x = ListPendingInvitesResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[19]
+func (x *ListCampaignApplicationsResponse) Reset() {
+	*x = ListCampaignApplicationsResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingInvitesResponse) String() string {
+func (x *ListCampaignApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingInvitesResponse) ProtoMessage() {}
+func (*ListCampaignApplicationsResponse) ProtoMessage() {}
 
-func (x *ListPendingInvitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[19]
+func (x *ListCampaignApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,48 +3060,108 @@ func (x *ListPendingInvitesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingInvitesResponse.ProtoReflect.Descriptor instead.
-func (*ListPendingInvitesResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListCampaignApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListPendingInvitesResponse) GetInvites() []*Invite {
+func (x *ListCampaignApplicationsResponse) GetApplications() []*CampaignApplication {
 	if x != nil {
-		return x.Invites
+		return x.Applications
 	}
 	return nil
 }
 
-func (x *ListPendingInvitesResponse) GetNextPageToken() string {
+func (x *ListCampaignApplicationsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type ListPendingInvitesForUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+type AcceptCampaignApplicationRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId             string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ApplicationId          string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	CreatedByParticipantId string                 `protobuf:"bytes,3,opt,name=created_by_participant_id,json=createdByParticipantId,proto3" json:"created_by_participant_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AcceptCampaignApplicationRequest) Reset() {
+	*x = AcceptCampaignApplicationRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCampaignApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCampaignApplicationRequest) ProtoMessage() {}
+
+func (x *AcceptCampaignApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCampaignApplicationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCampaignApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AcceptCampaignApplicationRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *AcceptCampaignApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *AcceptCampaignApplicationRequest) GetCreatedByParticipantId() string {
+	if x != nil {
+		return x.CreatedByParticipantId
+	}
+	return ""
+}
+
+type AcceptCampaignApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *CampaignApplication   `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Invite        *Invite                `protobuf:"bytes,2,opt,name=invite,proto3" json:"invite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingInvitesForUserRequest) Reset() {
-	*x = ListPendingInvitesForUserRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[20]
+func (x *AcceptCampaignApplicationResponse) Reset() {
+	*x = AcceptCampaignApplicationResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingInvitesForUserRequest) String() string {
+func (x *AcceptCampaignApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingInvitesForUserRequest) ProtoMessage() {}
+func (*AcceptCampaignApplicationResponse) ProtoMessage() {}
 
-func (x *ListPendingInvitesForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[20]
+func (x *AcceptCampaignApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,51 +3172,48 @@ func (x *ListPendingInvitesForUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingInvitesForUserRequest.ProtoReflect.Descriptor instead.
-func (*ListPendingInvitesForUserRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AcceptCampaignApplicationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptCampaignApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListPendingInvitesForUserRequest) GetPageSize() int32 {
+func (x *AcceptCampaignApplicationResponse) GetApplication() *CampaignApplication {
 	if x != nil {
-		return x.PageSize
+		return x.Application
 	}
-	return 0
+	return nil
 }
 
-func (x *ListPendingInvitesForUserRequest) GetPageToken() string {
+func (x *AcceptCampaignApplicationResponse) GetInvite() *Invite {
 	if x != nil {
-		return x.PageToken
+		return x.Invite
 	}
-	return ""
+	return nil
 }
 
-// PendingInviteForUserEntry wraps a pending invite with enriched campaign and
-// participant summaries for dashboard display.
-type PendingInviteForUserEntry struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Invite        *Invite                   `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
-	Campaign      *InviteCampaignSummary    `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
-	Participant   *InviteParticipantSummary `protobuf:"bytes,3,opt,name=participant,proto3" json:"participant,omitempty"`
+type DeclineCampaignApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PendingInviteForUserEntry) Reset() {
-	*x = PendingInviteForUserEntry{}
-	mi := &file_invite_v1_invite_proto_msgTypes[21]
+func (x *DeclineCampaignApplicationRequest) Reset() {
+	*x = DeclineCampaignApplicationRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PendingInviteForUserEntry) String() string {
+func (x *DeclineCampaignApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PendingInviteForUserEntry) ProtoMessage() {}
+func (*DeclineCampaignApplicationRequest) ProtoMessage() {}
 
-func (x *PendingInviteForUserEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[21]
+func (x *DeclineCampaignApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,55 +3224,47 @@ func (x *PendingInviteForUserEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PendingInviteForUserEntry.ProtoReflect.Descriptor instead.
-func (*PendingInviteForUserEntry) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *PendingInviteForUserEntry) GetInvite() *Invite {
-	if x != nil {
-		return x.Invite
-	}
-	return nil
+// Deprecated: Use DeclineCampaignApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineCampaignApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *PendingInviteForUserEntry) GetCampaign() *InviteCampaignSummary {
+func (x *DeclineCampaignApplicationRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Campaign
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
-func (x *PendingInviteForUserEntry) GetParticipant() *InviteParticipantSummary {
+func (x *DeclineCampaignApplicationRequest) GetApplicationId() string {
 	if x != nil {
-		return x.Participant
+		return x.ApplicationId
 	}
-	return nil
+	return ""
 }
 
-type ListPendingInvitesForUserResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Invites       []*PendingInviteForUserEntry `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
-	NextPageToken string                       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type DeclineCampaignApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *CampaignApplication   `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPendingInvitesForUserResponse) Reset() {
-	*x = ListPendingInvitesForUserResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[22]
+func (x *DeclineCampaignApplicationResponse) Reset() {
+	*x = DeclineCampaignApplicationResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPendingInvitesForUserResponse) String() string {
+func (x *DeclineCampaignApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPendingInvitesForUserResponse) ProtoMessage() {}
+func (*DeclineCampaignApplicationResponse) ProtoMessage() {}
 
-func (x *ListPendingInvitesForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[22]
+func (x *DeclineCampaignApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,25 +3275,18 @@ func (x *ListPendingInvitesForUserResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPendingInvitesForUserResponse.ProtoReflect.Descriptor instead.
-func (*ListPendingInvitesForUserResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use DeclineCampaignApplicationResponse.ProtoReflect.Descriptor instead.
+func (*DeclineCampaignApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListPendingInvitesForUserResponse) GetInvites() []*PendingInviteForUserEntry {
+func (x *DeclineCampaignApplicationResponse) GetApplication() *CampaignApplication {
 	if x != nil {
-		return x.Invites
+		return x.Application
 	}
 	return nil
 }
 
-func (x *ListPendingInvitesForUserResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
 type IntegrationOutboxEvent struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1443,7 +3308,7 @@ type IntegrationOutboxEvent struct {
 
 func (x *IntegrationOutboxEvent) Reset() {
 	*x = IntegrationOutboxEvent{}
-	mi := &file_invite_v1_invite_proto_msgTypes[23]
+	mi := &file_invite_v1_invite_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1455,7 +3320,7 @@ func (x *IntegrationOutboxEvent) String() string {
 func (*IntegrationOutboxEvent) ProtoMessage() {}
 
 func (x *IntegrationOutboxEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[23]
+	mi := &file_invite_v1_invite_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,7 +3333,7 @@ func (x *IntegrationOutboxEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegrationOutboxEvent.ProtoReflect.Descriptor instead.
 func (*IntegrationOutboxEvent) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{23}
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *IntegrationOutboxEvent) GetId() string {
@@ -1574,7 +3439,7 @@ type LeaseIntegrationOutboxEventsRequest struct {
 
 func (x *LeaseIntegrationOutboxEventsRequest) Reset() {
 	*x = LeaseIntegrationOutboxEventsRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[24]
+	mi := &file_invite_v1_invite_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1586,7 +3451,7 @@ func (x *LeaseIntegrationOutboxEventsRequest) String() string {
 func (*LeaseIntegrationOutboxEventsRequest) ProtoMessage() {}
 
 func (x *LeaseIntegrationOutboxEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[24]
+	mi := &file_invite_v1_invite_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1599,7 +3464,7 @@ func (x *LeaseIntegrationOutboxEventsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use LeaseIntegrationOutboxEventsRequest.ProtoReflect.Descriptor instead.
 func (*LeaseIntegrationOutboxEventsRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{24}
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *LeaseIntegrationOutboxEventsRequest) GetConsumer() string {
@@ -1639,7 +3504,7 @@ type LeaseIntegrationOutboxEventsResponse struct {
 
 func (x *LeaseIntegrationOutboxEventsResponse) Reset() {
 	*x = LeaseIntegrationOutboxEventsResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[25]
+	mi := &file_invite_v1_invite_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1651,7 +3516,7 @@ func (x *LeaseIntegrationOutboxEventsResponse) String() string {
 func (*LeaseIntegrationOutboxEventsResponse) ProtoMessage() {}
 
 func (x *LeaseIntegrationOutboxEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[25]
+	mi := &file_invite_v1_invite_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,7 +3529,7 @@ func (x *LeaseIntegrationOutboxEventsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use LeaseIntegrationOutboxEventsResponse.ProtoReflect.Descriptor instead.
 func (*LeaseIntegrationOutboxEventsResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{25}
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *LeaseIntegrationOutboxEventsResponse) GetEvents() []*IntegrationOutboxEvent {
@@ -1687,7 +3552,7 @@ type AckIntegrationOutboxEventRequest struct {
 
 func (x *AckIntegrationOutboxEventRequest) Reset() {
 	*x = AckIntegrationOutboxEventRequest{}
-	mi := &file_invite_v1_invite_proto_msgTypes[26]
+	mi := &file_invite_v1_invite_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1699,7 +3564,7 @@ func (x *AckIntegrationOutboxEventRequest) String() string {
 func (*AckIntegrationOutboxEventRequest) ProtoMessage() {}
 
 func (x *AckIntegrationOutboxEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[26]
+	mi := &file_invite_v1_invite_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1712,7 +3577,7 @@ func (x *AckIntegrationOutboxEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckIntegrationOutboxEventRequest.ProtoReflect.Descriptor instead.
 func (*AckIntegrationOutboxEventRequest) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{26}
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AckIntegrationOutboxEventRequest) GetEventId() string {
@@ -1758,7 +3623,7 @@ type AckIntegrationOutboxEventResponse struct {
 
 func (x *AckIntegrationOutboxEventResponse) Reset() {
 	*x = AckIntegrationOutboxEventResponse{}
-	mi := &file_invite_v1_invite_proto_msgTypes[27]
+	mi := &file_invite_v1_invite_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1770,7 +3635,7 @@ func (x *AckIntegrationOutboxEventResponse) String() string {
 func (*AckIntegrationOutboxEventResponse) ProtoMessage() {}
 
 func (x *AckIntegrationOutboxEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invite_v1_invite_proto_msgTypes[27]
+	mi := &file_invite_v1_invite_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +3648,185 @@ func (x *AckIntegrationOutboxEventResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AckIntegrationOutboxEventResponse.ProtoReflect.Descriptor instead.
 func (*AckIntegrationOutboxEventResponse) Descriptor() ([]byte, []int) {
-	return file_invite_v1_invite_proto_rawDescGZIP(), []int{27}
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{54}
+}
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ExportUserDataResponse carries the invite-owned records for one user as a
+// JSON document.
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordsJson   string                 `protobuf:"bytes,1,opt,name=records_json,json=recordsJson,proto3" json:"records_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExportUserDataResponse) GetRecordsJson() string {
+	if x != nil {
+		return x.RecordsJson
+	}
+	return ""
+}
+
+type DeleteUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserDataRequest) Reset() {
+	*x = DeleteUserDataRequest{}
+	mi := &file_invite_v1_invite_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataRequest) ProtoMessage() {}
+
+func (x *DeleteUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserDataResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeletedRecords int32                  `protobuf:"varint,1,opt,name=deleted_records,json=deletedRecords,proto3" json:"deleted_records,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteUserDataResponse) Reset() {
+	*x = DeleteUserDataResponse{}
+	mi := &file_invite_v1_invite_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataResponse) ProtoMessage() {}
+
+func (x *DeleteUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_v1_invite_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_invite_v1_invite_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteUserDataResponse) GetDeletedRecords() int32 {
+	if x != nil {
+		return x.DeletedRecords
+	}
+	return 0
 }
 
 var File_invite_v1_invite_proto protoreflect.FileDescriptor
@@ -1832,306 +3875,720 @@ var file_invite_v1_invite_proto_rawDesc = string([]byte{
 	0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
 	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x04, 0x0a, 0x0a, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xcb, 0x02, 0x0a,
+	0x0f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb1, 0x03, 0x0a, 0x13, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49,
 	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x62, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22,
-	0x41, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x22, 0x71, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70,
-	0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x67,
-	0x72, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e,
-	0x47, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
-	0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22,
-	0x33, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc4,
+	0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
 	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
 	0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
 	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x14,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22,
-	0x2f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64,
-	0x22, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x22, 0x35, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x71, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x13,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x3c,
-	0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x45, 0x0a, 0x0b,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
-	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x12, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
-	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x26,
-	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x45,
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x15, 0x44, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x32,
+	0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x22, 0x41, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x8f, 0x02,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x12, 0x45, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x22,
+	0xce, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
 	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x71, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
-	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
-	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x08,
-	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x6a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a, 0x20, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x12, 0x45, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb6, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a,
+	0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0b,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x22, 0x45,
+	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x35, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x22, 0xcc, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x45, 0x0a, 0x0b, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x53, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x71,
+	0x0a, 0x16, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x22, 0x92, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12,
+	0x45, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7a, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x1e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x45, 0x0a, 0x0b, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69,
 	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x75, 0x6d,
 	0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x22, 0x8b, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0xc5, 0x04, 0x0a, 0x16, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x64, 0x65, 0x64, 0x75, 0x70, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x64, 0x65, 0x64, 0x75, 0x70, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e,
-	0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x44, 0x0a,
-	0x10, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x74, 0x22, 0x5b, 0x0a, 0x1d, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x64, 0x22, 0x52,
+	0x0a, 0x1e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x22, 0x8a, 0x01, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x22,
+	0x65, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x90,
+	0x01, 0x0a, 0x21, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x22, 0x6b, 0x0a, 0x21, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x66,
+	0x0a, 0x22, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x04, 0x0a, 0x16, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a,
+	0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x64, 0x75, 0x70, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x64, 0x75, 0x70, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x42, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x23, 0x4c, 0x65, 0x61, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7,
+	0x01, 0x0a, 0x23, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x74, 0x6c, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x6f,
+	0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x03, 0x6e, 0x6f, 0x77, 0x22, 0x61, 0x0a, 0x24, 0x4c, 0x65, 0x61, 0x73,
 	0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62,
-	0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x6d,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x74,
-	0x6c, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6e, 0x6f,
-	0x77, 0x22, 0x61, 0x0a, 0x24, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x20, 0x41, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
-	0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x20,
+	0x41, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x41, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x23, 0x0a, 0x21,
+	0x41, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x30, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x4a, 0x73, 0x6f, 0x6e,
+	0x22, 0x30, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x41, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x2a, 0x62, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x44,
+	0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x67, 0x0a, 0x10, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a,
+	0x1e, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56,
+	0x49, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x68, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4c,
+	0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x47, 0x4d, 0x10, 0x02, 0x2a, 0xa7, 0x01, 0x0a,
+	0x15, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x24, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x26, 0x0a, 0x22, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x50,
+	0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x49,
+	0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x4a, 0x4f,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x44, 0x45, 0x43, 0x4c,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xb0, 0x01, 0x0a, 0x19, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x27, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e,
+	0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x50,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f,
+	0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x45,
+	0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49,
+	0x47, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xce, 0x01, 0x0a, 0x1b, 0x49, 0x6e,
 	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x41,
-	0x63, 0x6b, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x23, 0x0a, 0x21, 0x41, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x62, 0x0a, 0x0c, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e,
-	0x56, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x03,
-	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xce,
-	0x01, 0x0a, 0x1b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
-	0x74, 0x62, 0x6f, 0x78, 0x41, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2e,
-	0x0a, 0x2a, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55,
-	0x54, 0x42, 0x4f, 0x58, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2c,
-	0x0a, 0x28, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55,
-	0x54, 0x42, 0x4f, 0x58, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
-	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24,
-	0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x42,
-	0x4f, 0x58, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52,
-	0x45, 0x54, 0x52, 0x59, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58, 0x5f, 0x41, 0x43, 0x4b,
-	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x03, 0x32,
-	0x97, 0x08, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x12, 0x1d, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61,
-	0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x52, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x12, 0x1b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x12, 0x21, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46, 0x6f,
-	0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x7f, 0x0a, 0x1c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x76, 0x0a, 0x19, 0x41, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b,
-	0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x49, 0x6e,
+	0x63, 0x6b, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x4e, 0x54,
+	0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58, 0x5f,
+	0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2c, 0x0a, 0x28, 0x49, 0x4e, 0x54,
+	0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58, 0x5f,
+	0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x49, 0x4e, 0x54, 0x45, 0x47,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58, 0x5f, 0x41, 0x43,
+	0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10,
+	0x02, 0x12, 0x27, 0x0a, 0x23, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x03, 0x32, 0xc0, 0x13, 0x0a, 0x0d, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x0b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x44,
+	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12,
+	0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x1d, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x22, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x25, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x22, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x44, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x1a, 0x44,
+	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x19, 0x41, 0x63, 0x6b, 0x49, 0x6e,
 	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69, 0x73, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x2f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x47, 0x5a,
+	0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x75, 0x69,
+	0x73, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x2f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x75, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -2146,98 +4603,192 @@ func file_invite_v1_invite_proto_rawDescGZIP() []byte {
 	return file_invite_v1_invite_proto_rawDescData
 }
 
-var file_invite_v1_invite_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_invite_v1_invite_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_invite_v1_invite_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_invite_v1_invite_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_invite_v1_invite_proto_goTypes = []any{
 	(InviteStatus)(0),                            // 0: invite.v1.InviteStatus
-	(IntegrationOutboxAckOutcome)(0),             // 1: invite.v1.IntegrationOutboxAckOutcome
-	(*Invite)(nil),                               // 2: invite.v1.Invite
-	(*InviteCampaignSummary)(nil),                // 3: invite.v1.InviteCampaignSummary
-	(*InviteParticipantSummary)(nil),             // 4: invite.v1.InviteParticipantSummary
-	(*InviteUserSummary)(nil),                    // 5: invite.v1.InviteUserSummary
-	(*CreateInviteRequest)(nil),                  // 6: invite.v1.CreateInviteRequest
-	(*CreateInviteResponse)(nil),                 // 7: invite.v1.CreateInviteResponse
-	(*ClaimInviteRequest)(nil),                   // 8: invite.v1.ClaimInviteRequest
-	(*ClaimInviteResponse)(nil),                  // 9: invite.v1.ClaimInviteResponse
-	(*DeclineInviteRequest)(nil),                 // 10: invite.v1.DeclineInviteRequest
-	(*DeclineInviteResponse)(nil),                // 11: invite.v1.DeclineInviteResponse
-	(*RevokeInviteRequest)(nil),                  // 12: invite.v1.RevokeInviteRequest
-	(*RevokeInviteResponse)(nil),                 // 13: invite.v1.RevokeInviteResponse
-	(*GetInviteRequest)(nil),                     // 14: invite.v1.GetInviteRequest
-	(*GetInviteResponse)(nil),                    // 15: invite.v1.GetInviteResponse
-	(*GetPublicInviteRequest)(nil),               // 16: invite.v1.GetPublicInviteRequest
-	(*GetPublicInviteResponse)(nil),              // 17: invite.v1.GetPublicInviteResponse
-	(*ListInvitesRequest)(nil),                   // 18: invite.v1.ListInvitesRequest
-	(*ListInvitesResponse)(nil),                  // 19: invite.v1.ListInvitesResponse
-	(*ListPendingInvitesRequest)(nil),            // 20: invite.v1.ListPendingInvitesRequest
-	(*ListPendingInvitesResponse)(nil),           // 21: invite.v1.ListPendingInvitesResponse
-	(*ListPendingInvitesForUserRequest)(nil),     // 22: invite.v1.ListPendingInvitesForUserRequest
-	(*PendingInviteForUserEntry)(nil),            // 23: invite.v1.PendingInviteForUserEntry
-	(*ListPendingInvitesForUserResponse)(nil),    // 24: invite.v1.ListPendingInvitesForUserResponse
-	(*IntegrationOutboxEvent)(nil),               // 25: invite.v1.IntegrationOutboxEvent
-	(*LeaseIntegrationOutboxEventsRequest)(nil),  // 26: invite.v1.LeaseIntegrationOutboxEventsRequest
-	(*LeaseIntegrationOutboxEventsResponse)(nil), // 27: invite.v1.LeaseIntegrationOutboxEventsResponse
-	(*AckIntegrationOutboxEventRequest)(nil),     // 28: invite.v1.AckIntegrationOutboxEventRequest
-	(*AckIntegrationOutboxEventResponse)(nil),    // 29: invite.v1.AckIntegrationOutboxEventResponse
-	(*timestamppb.Timestamp)(nil),                // 30: google.protobuf.Timestamp
+	(InviteLinkStatus)(0),                        // 1: invite.v1.InviteLinkStatus
+	(InviteLinkRole)(0),                          // 2: invite.v1.InviteLinkRole
+	(InviteLinkClaimStatus)(0),                   // 3: invite.v1.InviteLinkClaimStatus
+	(CampaignApplicationStatus)(0),               // 4: invite.v1.CampaignApplicationStatus
+	(IntegrationOutboxAckOutcome)(0),             // 5: invite.v1.IntegrationOutboxAckOutcome
+	(*Invite)(nil),                               // 6: invite.v1.Invite
+	(*InviteCampaignSummary)(nil),                // 7: invite.v1.InviteCampaignSummary
+	(*InviteParticipantSummary)(nil),             // 8: invite.v1.InviteParticipantSummary
+	(*InviteUserSummary)(nil),                    // 9: invite.v1.InviteUserSummary
+	(*InviteLink)(nil),                           // 10: invite.v1.InviteLink
+	(*InviteLinkClaim)(nil),                      // 11: invite.v1.InviteLinkClaim
+	(*CampaignApplication)(nil),                  // 12: invite.v1.CampaignApplication
+	(*CreateInviteRequest)(nil),                  // 13: invite.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),                 // 14: invite.v1.CreateInviteResponse
+	(*ClaimInviteRequest)(nil),                   // 15: invite.v1.ClaimInviteRequest
+	(*ClaimInviteResponse)(nil),                  // 16: invite.v1.ClaimInviteResponse
+	(*DeclineInviteRequest)(nil),                 // 17: invite.v1.DeclineInviteRequest
+	(*DeclineInviteResponse)(nil),                // 18: invite.v1.DeclineInviteResponse
+	(*RevokeInviteRequest)(nil),                  // 19: invite.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),                 // 20: invite.v1.RevokeInviteResponse
+	(*GetInviteRequest)(nil),                     // 21: invite.v1.GetInviteRequest
+	(*GetInviteResponse)(nil),                    // 22: invite.v1.GetInviteResponse
+	(*GetPublicInviteRequest)(nil),               // 23: invite.v1.GetPublicInviteRequest
+	(*GetPublicInviteResponse)(nil),              // 24: invite.v1.GetPublicInviteResponse
+	(*ListInvitesRequest)(nil),                   // 25: invite.v1.ListInvitesRequest
+	(*ListInvitesResponse)(nil),                  // 26: invite.v1.ListInvitesResponse
+	(*ListPendingInvitesRequest)(nil),            // 27: invite.v1.ListPendingInvitesRequest
+	(*ListPendingInvitesResponse)(nil),           // 28: invite.v1.ListPendingInvitesResponse
+	(*ListPendingInvitesForUserRequest)(nil),     // 29: invite.v1.ListPendingInvitesForUserRequest
+	(*PendingInviteForUserEntry)(nil),            // 30: invite.v1.PendingInviteForUserEntry
+	(*ListPendingInvitesForUserResponse)(nil),    // 31: invite.v1.ListPendingInvitesForUserResponse
+	(*CreateInviteLinkRequest)(nil),              // 32: invite.v1.CreateInviteLinkRequest
+	(*CreateInviteLinkResponse)(nil),             // 33: invite.v1.CreateInviteLinkResponse
+	(*GetPublicInviteLinkRequest)(nil),           // 34: invite.v1.GetPublicInviteLinkRequest
+	(*GetPublicInviteLinkResponse)(nil),          // 35: invite.v1.GetPublicInviteLinkResponse
+	(*ListInviteLinksRequest)(nil),               // 36: invite.v1.ListInviteLinksRequest
+	(*ListInviteLinksResponse)(nil),              // 37: invite.v1.ListInviteLinksResponse
+	(*RevokeInviteLinkRequest)(nil),              // 38: invite.v1.RevokeInviteLinkRequest
+	(*RevokeInviteLinkResponse)(nil),             // 39: invite.v1.RevokeInviteLinkResponse
+	(*ClaimInviteLinkRequest)(nil),               // 40: invite.v1.ClaimInviteLinkRequest
+	(*ClaimInviteLinkResponse)(nil),              // 41: invite.v1.ClaimInviteLinkResponse
+	(*ListInviteLinkClaimsRequest)(nil),          // 42: invite.v1.ListInviteLinkClaimsRequest
+	(*ListInviteLinkClaimsResponse)(nil),         // 43: invite.v1.ListInviteLinkClaimsResponse
+	(*ApproveInviteLinkClaimRequest)(nil),        // 44: invite.v1.ApproveInviteLinkClaimRequest
+	(*ApproveInviteLinkClaimResponse)(nil),       // 45: invite.v1.ApproveInviteLinkClaimResponse
+	(*DeclineInviteLinkClaimRequest)(nil),        // 46: invite.v1.DeclineInviteLinkClaimRequest
+	(*DeclineInviteLinkClaimResponse)(nil),       // 47: invite.v1.DeclineInviteLinkClaimResponse
+	(*SubmitCampaignApplicationRequest)(nil),     // 48: invite.v1.SubmitCampaignApplicationRequest
+	(*SubmitCampaignApplicationResponse)(nil),    // 49: invite.v1.SubmitCampaignApplicationResponse
+	(*ListCampaignApplicationsRequest)(nil),      // 50: invite.v1.ListCampaignApplicationsRequest
+	(*ListCampaignApplicationsResponse)(nil),     // 51: invite.v1.ListCampaignApplicationsResponse
+	(*AcceptCampaignApplicationRequest)(nil),     // 52: invite.v1.AcceptCampaignApplicationRequest
+	(*AcceptCampaignApplicationResponse)(nil),    // 53: invite.v1.AcceptCampaignApplicationResponse
+	(*DeclineCampaignApplicationRequest)(nil),    // 54: invite.v1.DeclineCampaignApplicationRequest
+	(*DeclineCampaignApplicationResponse)(nil),   // 55: invite.v1.DeclineCampaignApplicationResponse
+	(*IntegrationOutboxEvent)(nil),               // 56: invite.v1.IntegrationOutboxEvent
+	(*LeaseIntegrationOutboxEventsRequest)(nil),  // 57: invite.v1.LeaseIntegrationOutboxEventsRequest
+	(*LeaseIntegrationOutboxEventsResponse)(nil), // 58: invite.v1.LeaseIntegrationOutboxEventsResponse
+	(*AckIntegrationOutboxEventRequest)(nil),     // 59: invite.v1.AckIntegrationOutboxEventRequest
+	(*AckIntegrationOutboxEventResponse)(nil),    // 60: invite.v1.AckIntegrationOutboxEventResponse
+	(*ExportUserDataRequest)(nil),                // 61: invite.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),               // 62: invite.v1.ExportUserDataResponse
+	(*DeleteUserDataRequest)(nil),                // 63: invite.v1.DeleteUserDataRequest
+	(*DeleteUserDataResponse)(nil),               // 64: invite.v1.DeleteUserDataResponse
+	(*timestamppb.Timestamp)(nil),                // 65: google.protobuf.Timestamp
 }
 var file_invite_v1_invite_proto_depIdxs = []int32{
 	0,  // 0: invite.v1.Invite.status:type_name -> invite.v1.InviteStatus
-	30, // 1: invite.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
-	30, // 2: invite.v1.Invite.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 3: invite.v1.CreateInviteResponse.invite:type_name -> invite.v1.Invite
-	2,  // 4: invite.v1.ClaimInviteResponse.invite:type_name -> invite.v1.Invite
-	4,  // 5: invite.v1.ClaimInviteResponse.participant:type_name -> invite.v1.InviteParticipantSummary
-	2,  // 6: invite.v1.DeclineInviteResponse.invite:type_name -> invite.v1.Invite
-	2,  // 7: invite.v1.RevokeInviteResponse.invite:type_name -> invite.v1.Invite
-	2,  // 8: invite.v1.GetInviteResponse.invite:type_name -> invite.v1.Invite
-	2,  // 9: invite.v1.GetPublicInviteResponse.invite:type_name -> invite.v1.Invite
-	3,  // 10: invite.v1.GetPublicInviteResponse.campaign:type_name -> invite.v1.InviteCampaignSummary
-	4,  // 11: invite.v1.GetPublicInviteResponse.participant:type_name -> invite.v1.InviteParticipantSummary
-	5,  // 12: invite.v1.GetPublicInviteResponse.created_by_user:type_name -> invite.v1.InviteUserSummary
-	0,  // 13: invite.v1.ListInvitesRequest.status:type_name -> invite.v1.InviteStatus
-	2,  // 14: invite.v1.ListInvitesResponse.invites:type_name -> invite.v1.Invite
-	2,  // 15: invite.v1.ListPendingInvitesResponse.invites:type_name -> invite.v1.Invite
-	2,  // 16: invite.v1.PendingInviteForUserEntry.invite:type_name -> invite.v1.Invite
-	3,  // 17: invite.v1.PendingInviteForUserEntry.campaign:type_name -> invite.v1.InviteCampaignSummary
-	4,  // 18: invite.v1.PendingInviteForUserEntry.participant:type_name -> invite.v1.InviteParticipantSummary
-	23, // 19: invite.v1.ListPendingInvitesForUserResponse.invites:type_name -> invite.v1.PendingInviteForUserEntry
-	30, // 20: invite.v1.IntegrationOutboxEvent.next_attempt_at:type_name -> google.protobuf.Timestamp
-	30, // 21: invite.v1.IntegrationOutboxEvent.lease_expires_at:type_name -> google.protobuf.Timestamp
-	30, // 22: invite.v1.IntegrationOutboxEvent.processed_at:type_name -> google.protobuf.Timestamp
-	30, // 23: invite.v1.IntegrationOutboxEvent.created_at:type_name -> google.protobuf.Timestamp
-	30, // 24: invite.v1.IntegrationOutboxEvent.updated_at:type_name -> google.protobuf.Timestamp
-	30, // 25: invite.v1.LeaseIntegrationOutboxEventsRequest.now:type_name -> google.protobuf.Timestamp
-	25, // 26: invite.v1.LeaseIntegrationOutboxEventsResponse.events:type_name -> invite.v1.IntegrationOutboxEvent
-	1,  // 27: invite.v1.AckIntegrationOutboxEventRequest.outcome:type_name -> invite.v1.IntegrationOutboxAckOutcome
-	30, // 28: invite.v1.AckIntegrationOutboxEventRequest.next_attempt_at:type_name -> google.protobuf.Timestamp
-	6,  // 29: invite.v1.InviteService.CreateInvite:input_type -> invite.v1.CreateInviteRequest
-	8,  // 30: invite.v1.InviteService.ClaimInvite:input_type -> invite.v1.ClaimInviteRequest
-	10, // 31: invite.v1.InviteService.DeclineInvite:input_type -> invite.v1.DeclineInviteRequest
-	12, // 32: invite.v1.InviteService.RevokeInvite:input_type -> invite.v1.RevokeInviteRequest
-	14, // 33: invite.v1.InviteService.GetInvite:input_type -> invite.v1.GetInviteRequest
-	16, // 34: invite.v1.InviteService.GetPublicInvite:input_type -> invite.v1.GetPublicInviteRequest
-	18, // 35: invite.v1.InviteService.ListInvites:input_type -> invite.v1.ListInvitesRequest
-	20, // 36: invite.v1.InviteService.ListPendingInvites:input_type -> invite.v1.ListPendingInvitesRequest
-	22, // 37: invite.v1.InviteService.ListPendingInvitesForUser:input_type -> invite.v1.ListPendingInvitesForUserRequest
-	26, // 38: invite.v1.InviteService.LeaseIntegrationOutboxEvents:input_type -> invite.v1.LeaseIntegrationOutboxEventsRequest
-	28, // 39: invite.v1.InviteService.AckIntegrationOutboxEvent:input_type -> invite.v1.AckIntegrationOutboxEventRequest
-	7,  // 40: invite.v1.InviteService.CreateInvite:output_type -> invite.v1.CreateInviteResponse
-	9,  // 41: invite.v1.InviteService.ClaimInvite:output_type -> invite.v1.ClaimInviteResponse
-	11, // 42: invite.v1.InviteService.DeclineInvite:output_type -> invite.v1.DeclineInviteResponse
-	13, // 43: invite.v1.InviteService.RevokeInvite:output_type -> invite.v1.RevokeInviteResponse
-	15, // 44: invite.v1.InviteService.GetInvite:output_type -> invite.v1.GetInviteResponse
-	17, // 45: invite.v1.InviteService.GetPublicInvite:output_type -> invite.v1.GetPublicInviteResponse
-	19, // 46: invite.v1.InviteService.ListInvites:output_type -> invite.v1.ListInvitesResponse
-	21, // 47: invite.v1.InviteService.ListPendingInvites:output_type -> invite.v1.ListPendingInvitesResponse
-	24, // 48: invite.v1.InviteService.ListPendingInvitesForUser:output_type -> invite.v1.ListPendingInvitesForUserResponse
-	27, // 49: invite.v1.InviteService.LeaseIntegrationOutboxEvents:output_type -> invite.v1.LeaseIntegrationOutboxEventsResponse
-	29, // 50: invite.v1.InviteService.AckIntegrationOutboxEvent:output_type -> invite.v1.AckIntegrationOutboxEventResponse
-	40, // [40:51] is the sub-list for method output_type
-	29, // [29:40] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	65, // 1: invite.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	65, // 2: invite.v1.Invite.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: invite.v1.InviteLink.default_role:type_name -> invite.v1.InviteLinkRole
+	65, // 4: invite.v1.InviteLink.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: invite.v1.InviteLink.status:type_name -> invite.v1.InviteLinkStatus
+	65, // 6: invite.v1.InviteLink.created_at:type_name -> google.protobuf.Timestamp
+	65, // 7: invite.v1.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 8: invite.v1.InviteLinkClaim.status:type_name -> invite.v1.InviteLinkClaimStatus
+	65, // 9: invite.v1.InviteLinkClaim.created_at:type_name -> google.protobuf.Timestamp
+	65, // 10: invite.v1.InviteLinkClaim.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 11: invite.v1.CampaignApplication.status:type_name -> invite.v1.CampaignApplicationStatus
+	65, // 12: invite.v1.CampaignApplication.created_at:type_name -> google.protobuf.Timestamp
+	65, // 13: invite.v1.CampaignApplication.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 14: invite.v1.CreateInviteResponse.invite:type_name -> invite.v1.Invite
+	6,  // 15: invite.v1.ClaimInviteResponse.invite:type_name -> invite.v1.Invite
+	8,  // 16: invite.v1.ClaimInviteResponse.participant:type_name -> invite.v1.InviteParticipantSummary
+	6,  // 17: invite.v1.DeclineInviteResponse.invite:type_name -> invite.v1.Invite
+	6,  // 18: invite.v1.RevokeInviteResponse.invite:type_name -> invite.v1.Invite
+	6,  // 19: invite.v1.GetInviteResponse.invite:type_name -> invite.v1.Invite
+	6,  // 20: invite.v1.GetPublicInviteResponse.invite:type_name -> invite.v1.Invite
+	7,  // 21: invite.v1.GetPublicInviteResponse.campaign:type_name -> invite.v1.InviteCampaignSummary
+	8,  // 22: invite.v1.GetPublicInviteResponse.participant:type_name -> invite.v1.InviteParticipantSummary
+	9,  // 23: invite.v1.GetPublicInviteResponse.created_by_user:type_name -> invite.v1.InviteUserSummary
+	0,  // 24: invite.v1.ListInvitesRequest.status:type_name -> invite.v1.InviteStatus
+	6,  // 25: invite.v1.ListInvitesResponse.invites:type_name -> invite.v1.Invite
+	6,  // 26: invite.v1.ListPendingInvitesResponse.invites:type_name -> invite.v1.Invite
+	6,  // 27: invite.v1.PendingInviteForUserEntry.invite:type_name -> invite.v1.Invite
+	7,  // 28: invite.v1.PendingInviteForUserEntry.campaign:type_name -> invite.v1.InviteCampaignSummary
+	8,  // 29: invite.v1.PendingInviteForUserEntry.participant:type_name -> invite.v1.InviteParticipantSummary
+	30, // 30: invite.v1.ListPendingInvitesForUserResponse.invites:type_name -> invite.v1.PendingInviteForUserEntry
+	2,  // 31: invite.v1.CreateInviteLinkRequest.default_role:type_name -> invite.v1.InviteLinkRole
+	65, // 32: invite.v1.CreateInviteLinkRequest.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 33: invite.v1.CreateInviteLinkResponse.link:type_name -> invite.v1.InviteLink
+	10, // 34: invite.v1.GetPublicInviteLinkResponse.link:type_name -> invite.v1.InviteLink
+	7,  // 35: invite.v1.GetPublicInviteLinkResponse.campaign:type_name -> invite.v1.InviteCampaignSummary
+	9,  // 36: invite.v1.GetPublicInviteLinkResponse.created_by_user:type_name -> invite.v1.InviteUserSummary
+	10, // 37: invite.v1.ListInviteLinksResponse.links:type_name -> invite.v1.InviteLink
+	10, // 38: invite.v1.RevokeInviteLinkResponse.link:type_name -> invite.v1.InviteLink
+	11, // 39: invite.v1.ClaimInviteLinkResponse.claim:type_name -> invite.v1.InviteLinkClaim
+	8,  // 40: invite.v1.ClaimInviteLinkResponse.participant:type_name -> invite.v1.InviteParticipantSummary
+	11, // 41: invite.v1.ListInviteLinkClaimsResponse.claims:type_name -> invite.v1.InviteLinkClaim
+	11, // 42: invite.v1.ApproveInviteLinkClaimResponse.claim:type_name -> invite.v1.InviteLinkClaim
+	8,  // 43: invite.v1.ApproveInviteLinkClaimResponse.participant:type_name -> invite.v1.InviteParticipantSummary
+	11, // 44: invite.v1.DeclineInviteLinkClaimResponse.claim:type_name -> invite.v1.InviteLinkClaim
+	12, // 45: invite.v1.SubmitCampaignApplicationResponse.application:type_name -> invite.v1.CampaignApplication
+	4,  // 46: invite.v1.ListCampaignApplicationsRequest.status:type_name -> invite.v1.CampaignApplicationStatus
+	12, // 47: invite.v1.ListCampaignApplicationsResponse.applications:type_name -> invite.v1.CampaignApplication
+	12, // 48: invite.v1.AcceptCampaignApplicationResponse.application:type_name -> invite.v1.CampaignApplication
+	6,  // 49: invite.v1.AcceptCampaignApplicationResponse.invite:type_name -> invite.v1.Invite
+	12, // 50: invite.v1.DeclineCampaignApplicationResponse.application:type_name -> invite.v1.CampaignApplication
+	65, // 51: invite.v1.IntegrationOutboxEvent.next_attempt_at:type_name -> google.protobuf.Timestamp
+	65, // 52: invite.v1.IntegrationOutboxEvent.lease_expires_at:type_name -> google.protobuf.Timestamp
+	65, // 53: invite.v1.IntegrationOutboxEvent.processed_at:type_name -> google.protobuf.Timestamp
+	65, // 54: invite.v1.IntegrationOutboxEvent.created_at:type_name -> google.protobuf.Timestamp
+	65, // 55: invite.v1.IntegrationOutboxEvent.updated_at:type_name -> google.protobuf.Timestamp
+	65, // 56: invite.v1.LeaseIntegrationOutboxEventsRequest.now:type_name -> google.protobuf.Timestamp
+	56, // 57: invite.v1.LeaseIntegrationOutboxEventsResponse.events:type_name -> invite.v1.IntegrationOutboxEvent
+	5,  // 58: invite.v1.AckIntegrationOutboxEventRequest.outcome:type_name -> invite.v1.IntegrationOutboxAckOutcome
+	65, // 59: invite.v1.AckIntegrationOutboxEventRequest.next_attempt_at:type_name -> google.protobuf.Timestamp
+	13, // 60: invite.v1.InviteService.CreateInvite:input_type -> invite.v1.CreateInviteRequest
+	15, // 61: invite.v1.InviteService.ClaimInvite:input_type -> invite.v1.ClaimInviteRequest
+	17, // 62: invite.v1.InviteService.DeclineInvite:input_type -> invite.v1.DeclineInviteRequest
+	19, // 63: invite.v1.InviteService.RevokeInvite:input_type -> invite.v1.RevokeInviteRequest
+	21, // 64: invite.v1.InviteService.GetInvite:input_type -> invite.v1.GetInviteRequest
+	23, // 65: invite.v1.InviteService.GetPublicInvite:input_type -> invite.v1.GetPublicInviteRequest
+	25, // 66: invite.v1.InviteService.ListInvites:input_type -> invite.v1.ListInvitesRequest
+	27, // 67: invite.v1.InviteService.ListPendingInvites:input_type -> invite.v1.ListPendingInvitesRequest
+	29, // 68: invite.v1.InviteService.ListPendingInvitesForUser:input_type -> invite.v1.ListPendingInvitesForUserRequest
+	32, // 69: invite.v1.InviteService.CreateInviteLink:input_type -> invite.v1.CreateInviteLinkRequest
+	34, // 70: invite.v1.InviteService.GetPublicInviteLink:input_type -> invite.v1.GetPublicInviteLinkRequest
+	36, // 71: invite.v1.InviteService.ListInviteLinks:input_type -> invite.v1.ListInviteLinksRequest
+	38, // 72: invite.v1.InviteService.RevokeInviteLink:input_type -> invite.v1.RevokeInviteLinkRequest
+	40, // 73: invite.v1.InviteService.ClaimInviteLink:input_type -> invite.v1.ClaimInviteLinkRequest
+	42, // 74: invite.v1.InviteService.ListInviteLinkClaims:input_type -> invite.v1.ListInviteLinkClaimsRequest
+	44, // 75: invite.v1.InviteService.ApproveInviteLinkClaim:input_type -> invite.v1.ApproveInviteLinkClaimRequest
+	46, // 76: invite.v1.InviteService.DeclineInviteLinkClaim:input_type -> invite.v1.DeclineInviteLinkClaimRequest
+	48, // 77: invite.v1.InviteService.SubmitCampaignApplication:input_type -> invite.v1.SubmitCampaignApplicationRequest
+	50, // 78: invite.v1.InviteService.ListCampaignApplications:input_type -> invite.v1.ListCampaignApplicationsRequest
+	52, // 79: invite.v1.InviteService.AcceptCampaignApplication:input_type -> invite.v1.AcceptCampaignApplicationRequest
+	54, // 80: invite.v1.InviteService.DeclineCampaignApplication:input_type -> invite.v1.DeclineCampaignApplicationRequest
+	57, // 81: invite.v1.InviteService.LeaseIntegrationOutboxEvents:input_type -> invite.v1.LeaseIntegrationOutboxEventsRequest
+	59, // 82: invite.v1.InviteService.AckIntegrationOutboxEvent:input_type -> invite.v1.AckIntegrationOutboxEventRequest
+	61, // 83: invite.v1.InviteService.ExportUserData:input_type -> invite.v1.ExportUserDataRequest
+	63, // 84: invite.v1.InviteService.DeleteUserData:input_type -> invite.v1.DeleteUserDataRequest
+	14, // 85: invite.v1.InviteService.CreateInvite:output_type -> invite.v1.CreateInviteResponse
+	16, // 86: invite.v1.InviteService.ClaimInvite:output_type -> invite.v1.ClaimInviteResponse
+	18, // 87: invite.v1.InviteService.DeclineInvite:output_type -> invite.v1.DeclineInviteResponse
+	20, // 88: invite.v1.InviteService.RevokeInvite:output_type -> invite.v1.RevokeInviteResponse
+	22, // 89: invite.v1.InviteService.GetInvite:output_type -> invite.v1.GetInviteResponse
+	24, // 90: invite.v1.InviteService.GetPublicInvite:output_type -> invite.v1.GetPublicInviteResponse
+	26, // 91: invite.v1.InviteService.ListInvites:output_type -> invite.v1.ListInvitesResponse
+	28, // 92: invite.v1.InviteService.ListPendingInvites:output_type -> invite.v1.ListPendingInvitesResponse
+	31, // 93: invite.v1.InviteService.ListPendingInvitesForUser:output_type -> invite.v1.ListPendingInvitesForUserResponse
+	33, // 94: invite.v1.InviteService.CreateInviteLink:output_type -> invite.v1.CreateInviteLinkResponse
+	35, // 95: invite.v1.InviteService.GetPublicInviteLink:output_type -> invite.v1.GetPublicInviteLinkResponse
+	37, // 96: invite.v1.InviteService.ListInviteLinks:output_type -> invite.v1.ListInviteLinksResponse
+	39, // 97: invite.v1.InviteService.RevokeInviteLink:output_type -> invite.v1.RevokeInviteLinkResponse
+	41, // 98: invite.v1.InviteService.ClaimInviteLink:output_type -> invite.v1.ClaimInviteLinkResponse
+	43, // 99: invite.v1.InviteService.ListInviteLinkClaims:output_type -> invite.v1.ListInviteLinkClaimsResponse
+	45, // 100: invite.v1.InviteService.ApproveInviteLinkClaim:output_type -> invite.v1.ApproveInviteLinkClaimResponse
+	47, // 101: invite.v1.InviteService.DeclineInviteLinkClaim:output_type -> invite.v1.DeclineInviteLinkClaimResponse
+	49, // 102: invite.v1.InviteService.SubmitCampaignApplication:output_type -> invite.v1.SubmitCampaignApplicationResponse
+	51, // 103: invite.v1.InviteService.ListCampaignApplications:output_type -> invite.v1.ListCampaignApplicationsResponse
+	53, // 104: invite.v1.InviteService.AcceptCampaignApplication:output_type -> invite.v1.AcceptCampaignApplicationResponse
+	55, // 105: invite.v1.InviteService.DeclineCampaignApplication:output_type -> invite.v1.DeclineCampaignApplicationResponse
+	58, // 106: invite.v1.InviteService.LeaseIntegrationOutboxEvents:output_type -> invite.v1.LeaseIntegrationOutboxEventsResponse
+	60, // 107: invite.v1.InviteService.AckIntegrationOutboxEvent:output_type -> invite.v1.AckIntegrationOutboxEventResponse
+	62, // 108: invite.v1.InviteService.ExportUserData:output_type -> invite.v1.ExportUserDataResponse
+	64, // 109: invite.v1.InviteService.DeleteUserData:output_type -> invite.v1.DeleteUserDataResponse
+	85, // [85:110] is the sub-list for method output_type
+	60, // [60:85] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_invite_v1_invite_proto_init() }
@@ -2250,8 +4801,8 @@ func file_invite_v1_invite_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_invite_v1_invite_proto_rawDesc), len(file_invite_v1_invite_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   28,
+			NumEnums:      6,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
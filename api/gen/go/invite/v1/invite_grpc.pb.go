@@ -29,8 +29,22 @@ const (
 	InviteService_ListInvites_FullMethodName                  = "/invite.v1.InviteService/ListInvites"
 	InviteService_ListPendingInvites_FullMethodName           = "/invite.v1.InviteService/ListPendingInvites"
 	InviteService_ListPendingInvitesForUser_FullMethodName    = "/invite.v1.InviteService/ListPendingInvitesForUser"
+	InviteService_CreateInviteLink_FullMethodName             = "/invite.v1.InviteService/CreateInviteLink"
+	InviteService_GetPublicInviteLink_FullMethodName          = "/invite.v1.InviteService/GetPublicInviteLink"
+	InviteService_ListInviteLinks_FullMethodName              = "/invite.v1.InviteService/ListInviteLinks"
+	InviteService_RevokeInviteLink_FullMethodName             = "/invite.v1.InviteService/RevokeInviteLink"
+	InviteService_ClaimInviteLink_FullMethodName              = "/invite.v1.InviteService/ClaimInviteLink"
+	InviteService_ListInviteLinkClaims_FullMethodName         = "/invite.v1.InviteService/ListInviteLinkClaims"
+	InviteService_ApproveInviteLinkClaim_FullMethodName       = "/invite.v1.InviteService/ApproveInviteLinkClaim"
+	InviteService_DeclineInviteLinkClaim_FullMethodName       = "/invite.v1.InviteService/DeclineInviteLinkClaim"
+	InviteService_SubmitCampaignApplication_FullMethodName    = "/invite.v1.InviteService/SubmitCampaignApplication"
+	InviteService_ListCampaignApplications_FullMethodName     = "/invite.v1.InviteService/ListCampaignApplications"
+	InviteService_AcceptCampaignApplication_FullMethodName    = "/invite.v1.InviteService/AcceptCampaignApplication"
+	InviteService_DeclineCampaignApplication_FullMethodName   = "/invite.v1.InviteService/DeclineCampaignApplication"
 	InviteService_LeaseIntegrationOutboxEvents_FullMethodName = "/invite.v1.InviteService/LeaseIntegrationOutboxEvents"
 	InviteService_AckIntegrationOutboxEvent_FullMethodName    = "/invite.v1.InviteService/AckIntegrationOutboxEvent"
+	InviteService_ExportUserData_FullMethodName               = "/invite.v1.InviteService/ExportUserData"
+	InviteService_DeleteUserData_FullMethodName               = "/invite.v1.InviteService/DeleteUserData"
 )
 
 // InviteServiceClient is the client API for InviteService service.
@@ -57,10 +71,40 @@ type InviteServiceClient interface {
 	ListPendingInvites(ctx context.Context, in *ListPendingInvitesRequest, opts ...grpc.CallOption) (*ListPendingInvitesResponse, error)
 	// List pending invites for the current user.
 	ListPendingInvitesForUser(ctx context.Context, in *ListPendingInvitesForUserRequest, opts ...grpc.CallOption) (*ListPendingInvitesForUserResponse, error)
+	// Create a reusable invite link for a campaign.
+	CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error)
+	// Get the public landing data for a reusable invite link by ID.
+	GetPublicInviteLink(ctx context.Context, in *GetPublicInviteLinkRequest, opts ...grpc.CallOption) (*GetPublicInviteLinkResponse, error)
+	// List reusable invite links for a campaign.
+	ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...grpc.CallOption) (*ListInviteLinksResponse, error)
+	// Revoke a reusable invite link so it can no longer be claimed.
+	RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error)
+	// Claim a reusable invite link for the current user. Links without GM
+	// approval create the participant seat immediately.
+	ClaimInviteLink(ctx context.Context, in *ClaimInviteLinkRequest, opts ...grpc.CallOption) (*ClaimInviteLinkResponse, error)
+	// List the claim history for a campaign's invite links.
+	ListInviteLinkClaims(ctx context.Context, in *ListInviteLinkClaimsRequest, opts ...grpc.CallOption) (*ListInviteLinkClaimsResponse, error)
+	// Approve a claim awaiting GM approval and create the participant seat.
+	ApproveInviteLinkClaim(ctx context.Context, in *ApproveInviteLinkClaimRequest, opts ...grpc.CallOption) (*ApproveInviteLinkClaimResponse, error)
+	// Decline a claim awaiting GM approval and release its link use.
+	DeclineInviteLinkClaim(ctx context.Context, in *DeclineInviteLinkClaimRequest, opts ...grpc.CallOption) (*DeclineInviteLinkClaimResponse, error)
+	// Submit an application to join a campaign with open seats.
+	SubmitCampaignApplication(ctx context.Context, in *SubmitCampaignApplicationRequest, opts ...grpc.CallOption) (*SubmitCampaignApplicationResponse, error)
+	// List applications submitted to a campaign.
+	ListCampaignApplications(ctx context.Context, in *ListCampaignApplicationsRequest, opts ...grpc.CallOption) (*ListCampaignApplicationsResponse, error)
+	// Accept a pending application, creating a seat and an invite for the applicant.
+	AcceptCampaignApplication(ctx context.Context, in *AcceptCampaignApplicationRequest, opts ...grpc.CallOption) (*AcceptCampaignApplicationResponse, error)
+	// Decline a pending application.
+	DeclineCampaignApplication(ctx context.Context, in *DeclineCampaignApplicationRequest, opts ...grpc.CallOption) (*DeclineCampaignApplicationResponse, error)
 	// Lease due integration outbox events for background worker processing.
 	LeaseIntegrationOutboxEvents(ctx context.Context, in *LeaseIntegrationOutboxEventsRequest, opts ...grpc.CallOption) (*LeaseIntegrationOutboxEventsResponse, error)
 	// Acknowledge processing outcome for one leased integration outbox event.
 	AckIntegrationOutboxEvent(ctx context.Context, in *AckIntegrationOutboxEventRequest, opts ...grpc.CallOption) (*AckIntegrationOutboxEventResponse, error)
+	// Export invites addressed to a user.
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// Revoke pending invites addressed to a user and drop the recipient from
+	// every invite record.
+	DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
 }
 
 type inviteServiceClient struct {
@@ -161,6 +205,126 @@ func (c *inviteServiceClient) ListPendingInvitesForUser(ctx context.Context, in
 	return out, nil
 }
 
+func (c *inviteServiceClient) CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteLinkResponse)
+	err := c.cc.Invoke(ctx, InviteService_CreateInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) GetPublicInviteLink(ctx context.Context, in *GetPublicInviteLinkRequest, opts ...grpc.CallOption) (*GetPublicInviteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicInviteLinkResponse)
+	err := c.cc.Invoke(ctx, InviteService_GetPublicInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...grpc.CallOption) (*ListInviteLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInviteLinksResponse)
+	err := c.cc.Invoke(ctx, InviteService_ListInviteLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInviteLinkResponse)
+	err := c.cc.Invoke(ctx, InviteService_RevokeInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) ClaimInviteLink(ctx context.Context, in *ClaimInviteLinkRequest, opts ...grpc.CallOption) (*ClaimInviteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimInviteLinkResponse)
+	err := c.cc.Invoke(ctx, InviteService_ClaimInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) ListInviteLinkClaims(ctx context.Context, in *ListInviteLinkClaimsRequest, opts ...grpc.CallOption) (*ListInviteLinkClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInviteLinkClaimsResponse)
+	err := c.cc.Invoke(ctx, InviteService_ListInviteLinkClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) ApproveInviteLinkClaim(ctx context.Context, in *ApproveInviteLinkClaimRequest, opts ...grpc.CallOption) (*ApproveInviteLinkClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveInviteLinkClaimResponse)
+	err := c.cc.Invoke(ctx, InviteService_ApproveInviteLinkClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) DeclineInviteLinkClaim(ctx context.Context, in *DeclineInviteLinkClaimRequest, opts ...grpc.CallOption) (*DeclineInviteLinkClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineInviteLinkClaimResponse)
+	err := c.cc.Invoke(ctx, InviteService_DeclineInviteLinkClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) SubmitCampaignApplication(ctx context.Context, in *SubmitCampaignApplicationRequest, opts ...grpc.CallOption) (*SubmitCampaignApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitCampaignApplicationResponse)
+	err := c.cc.Invoke(ctx, InviteService_SubmitCampaignApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) ListCampaignApplications(ctx context.Context, in *ListCampaignApplicationsRequest, opts ...grpc.CallOption) (*ListCampaignApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignApplicationsResponse)
+	err := c.cc.Invoke(ctx, InviteService_ListCampaignApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) AcceptCampaignApplication(ctx context.Context, in *AcceptCampaignApplicationRequest, opts ...grpc.CallOption) (*AcceptCampaignApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCampaignApplicationResponse)
+	err := c.cc.Invoke(ctx, InviteService_AcceptCampaignApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) DeclineCampaignApplication(ctx context.Context, in *DeclineCampaignApplicationRequest, opts ...grpc.CallOption) (*DeclineCampaignApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineCampaignApplicationResponse)
+	err := c.cc.Invoke(ctx, InviteService_DeclineCampaignApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inviteServiceClient) LeaseIntegrationOutboxEvents(ctx context.Context, in *LeaseIntegrationOutboxEventsRequest, opts ...grpc.CallOption) (*LeaseIntegrationOutboxEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LeaseIntegrationOutboxEventsResponse)
@@ -181,6 +345,26 @@ func (c *inviteServiceClient) AckIntegrationOutboxEvent(ctx context.Context, in
 	return out, nil
 }
 
+func (c *inviteServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, InviteService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, InviteService_DeleteUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InviteServiceServer is the server API for InviteService service.
 // All implementations must embed UnimplementedInviteServiceServer
 // for forward compatibility.
@@ -205,10 +389,40 @@ type InviteServiceServer interface {
 	ListPendingInvites(context.Context, *ListPendingInvitesRequest) (*ListPendingInvitesResponse, error)
 	// List pending invites for the current user.
 	ListPendingInvitesForUser(context.Context, *ListPendingInvitesForUserRequest) (*ListPendingInvitesForUserResponse, error)
+	// Create a reusable invite link for a campaign.
+	CreateInviteLink(context.Context, *CreateInviteLinkRequest) (*CreateInviteLinkResponse, error)
+	// Get the public landing data for a reusable invite link by ID.
+	GetPublicInviteLink(context.Context, *GetPublicInviteLinkRequest) (*GetPublicInviteLinkResponse, error)
+	// List reusable invite links for a campaign.
+	ListInviteLinks(context.Context, *ListInviteLinksRequest) (*ListInviteLinksResponse, error)
+	// Revoke a reusable invite link so it can no longer be claimed.
+	RevokeInviteLink(context.Context, *RevokeInviteLinkRequest) (*RevokeInviteLinkResponse, error)
+	// Claim a reusable invite link for the current user. Links without GM
+	// approval create the participant seat immediately.
+	ClaimInviteLink(context.Context, *ClaimInviteLinkRequest) (*ClaimInviteLinkResponse, error)
+	// List the claim history for a campaign's invite links.
+	ListInviteLinkClaims(context.Context, *ListInviteLinkClaimsRequest) (*ListInviteLinkClaimsResponse, error)
+	// Approve a claim awaiting GM approval and create the participant seat.
+	ApproveInviteLinkClaim(context.Context, *ApproveInviteLinkClaimRequest) (*ApproveInviteLinkClaimResponse, error)
+	// Decline a claim awaiting GM approval and release its link use.
+	DeclineInviteLinkClaim(context.Context, *DeclineInviteLinkClaimRequest) (*DeclineInviteLinkClaimResponse, error)
+	// Submit an application to join a campaign with open seats.
+	SubmitCampaignApplication(context.Context, *SubmitCampaignApplicationRequest) (*SubmitCampaignApplicationResponse, error)
+	// List applications submitted to a campaign.
+	ListCampaignApplications(context.Context, *ListCampaignApplicationsRequest) (*ListCampaignApplicationsResponse, error)
+	// Accept a pending application, creating a seat and an invite for the applicant.
+	AcceptCampaignApplication(context.Context, *AcceptCampaignApplicationRequest) (*AcceptCampaignApplicationResponse, error)
+	// Decline a pending application.
+	DeclineCampaignApplication(context.Context, *DeclineCampaignApplicationRequest) (*DeclineCampaignApplicationResponse, error)
 	// Lease due integration outbox events for background worker processing.
 	LeaseIntegrationOutboxEvents(context.Context, *LeaseIntegrationOutboxEventsRequest) (*LeaseIntegrationOutboxEventsResponse, error)
 	// Acknowledge processing outcome for one leased integration outbox event.
 	AckIntegrationOutboxEvent(context.Context, *AckIntegrationOutboxEventRequest) (*AckIntegrationOutboxEventResponse, error)
+	// Export invites addressed to a user.
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// Revoke pending invites addressed to a user and drop the recipient from
+	// every invite record.
+	DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error)
 	mustEmbedUnimplementedInviteServiceServer()
 }
 
@@ -246,12 +460,54 @@ func (UnimplementedInviteServiceServer) ListPendingInvites(context.Context, *Lis
 func (UnimplementedInviteServiceServer) ListPendingInvitesForUser(context.Context, *ListPendingInvitesForUserRequest) (*ListPendingInvitesForUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPendingInvitesForUser not implemented")
 }
+func (UnimplementedInviteServiceServer) CreateInviteLink(context.Context, *CreateInviteLinkRequest) (*CreateInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteLink not implemented")
+}
+func (UnimplementedInviteServiceServer) GetPublicInviteLink(context.Context, *GetPublicInviteLinkRequest) (*GetPublicInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicInviteLink not implemented")
+}
+func (UnimplementedInviteServiceServer) ListInviteLinks(context.Context, *ListInviteLinksRequest) (*ListInviteLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInviteLinks not implemented")
+}
+func (UnimplementedInviteServiceServer) RevokeInviteLink(context.Context, *RevokeInviteLinkRequest) (*RevokeInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInviteLink not implemented")
+}
+func (UnimplementedInviteServiceServer) ClaimInviteLink(context.Context, *ClaimInviteLinkRequest) (*ClaimInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimInviteLink not implemented")
+}
+func (UnimplementedInviteServiceServer) ListInviteLinkClaims(context.Context, *ListInviteLinkClaimsRequest) (*ListInviteLinkClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInviteLinkClaims not implemented")
+}
+func (UnimplementedInviteServiceServer) ApproveInviteLinkClaim(context.Context, *ApproveInviteLinkClaimRequest) (*ApproveInviteLinkClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveInviteLinkClaim not implemented")
+}
+func (UnimplementedInviteServiceServer) DeclineInviteLinkClaim(context.Context, *DeclineInviteLinkClaimRequest) (*DeclineInviteLinkClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInviteLinkClaim not implemented")
+}
+func (UnimplementedInviteServiceServer) SubmitCampaignApplication(context.Context, *SubmitCampaignApplicationRequest) (*SubmitCampaignApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCampaignApplication not implemented")
+}
+func (UnimplementedInviteServiceServer) ListCampaignApplications(context.Context, *ListCampaignApplicationsRequest) (*ListCampaignApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaignApplications not implemented")
+}
+func (UnimplementedInviteServiceServer) AcceptCampaignApplication(context.Context, *AcceptCampaignApplicationRequest) (*AcceptCampaignApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCampaignApplication not implemented")
+}
+func (UnimplementedInviteServiceServer) DeclineCampaignApplication(context.Context, *DeclineCampaignApplicationRequest) (*DeclineCampaignApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineCampaignApplication not implemented")
+}
 func (UnimplementedInviteServiceServer) LeaseIntegrationOutboxEvents(context.Context, *LeaseIntegrationOutboxEventsRequest) (*LeaseIntegrationOutboxEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaseIntegrationOutboxEvents not implemented")
 }
 func (UnimplementedInviteServiceServer) AckIntegrationOutboxEvent(context.Context, *AckIntegrationOutboxEventRequest) (*AckIntegrationOutboxEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AckIntegrationOutboxEvent not implemented")
 }
+func (UnimplementedInviteServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedInviteServiceServer) DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
 func (UnimplementedInviteServiceServer) mustEmbedUnimplementedInviteServiceServer() {}
 func (UnimplementedInviteServiceServer) testEmbeddedByValue()                       {}
 
@@ -435,6 +691,222 @@ func _InviteService_ListPendingInvitesForUser_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InviteService_CreateInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).CreateInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_CreateInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).CreateInviteLink(ctx, req.(*CreateInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_GetPublicInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).GetPublicInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_GetPublicInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).GetPublicInviteLink(ctx, req.(*GetPublicInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_ListInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInviteLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).ListInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_ListInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).ListInviteLinks(ctx, req.(*ListInviteLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_RevokeInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).RevokeInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_RevokeInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).RevokeInviteLink(ctx, req.(*RevokeInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_ClaimInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).ClaimInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_ClaimInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).ClaimInviteLink(ctx, req.(*ClaimInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_ListInviteLinkClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInviteLinkClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).ListInviteLinkClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_ListInviteLinkClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).ListInviteLinkClaims(ctx, req.(*ListInviteLinkClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_ApproveInviteLinkClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveInviteLinkClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).ApproveInviteLinkClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_ApproveInviteLinkClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).ApproveInviteLinkClaim(ctx, req.(*ApproveInviteLinkClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_DeclineInviteLinkClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInviteLinkClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).DeclineInviteLinkClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_DeclineInviteLinkClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).DeclineInviteLinkClaim(ctx, req.(*DeclineInviteLinkClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_SubmitCampaignApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCampaignApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).SubmitCampaignApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_SubmitCampaignApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).SubmitCampaignApplication(ctx, req.(*SubmitCampaignApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_ListCampaignApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).ListCampaignApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_ListCampaignApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).ListCampaignApplications(ctx, req.(*ListCampaignApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_AcceptCampaignApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCampaignApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).AcceptCampaignApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_AcceptCampaignApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).AcceptCampaignApplication(ctx, req.(*AcceptCampaignApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_DeclineCampaignApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineCampaignApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).DeclineCampaignApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_DeclineCampaignApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).DeclineCampaignApplication(ctx, req.(*DeclineCampaignApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InviteService_LeaseIntegrationOutboxEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeaseIntegrationOutboxEventsRequest)
 	if err := dec(in); err != nil {
@@ -471,6 +943,42 @@ func _InviteService_AckIntegrationOutboxEvent_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InviteService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).DeleteUserData(ctx, req.(*DeleteUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InviteService_ServiceDesc is the grpc.ServiceDesc for InviteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -514,6 +1022,54 @@ var InviteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPendingInvitesForUser",
 			Handler:    _InviteService_ListPendingInvitesForUser_Handler,
 		},
+		{
+			MethodName: "CreateInviteLink",
+			Handler:    _InviteService_CreateInviteLink_Handler,
+		},
+		{
+			MethodName: "GetPublicInviteLink",
+			Handler:    _InviteService_GetPublicInviteLink_Handler,
+		},
+		{
+			MethodName: "ListInviteLinks",
+			Handler:    _InviteService_ListInviteLinks_Handler,
+		},
+		{
+			MethodName: "RevokeInviteLink",
+			Handler:    _InviteService_RevokeInviteLink_Handler,
+		},
+		{
+			MethodName: "ClaimInviteLink",
+			Handler:    _InviteService_ClaimInviteLink_Handler,
+		},
+		{
+			MethodName: "ListInviteLinkClaims",
+			Handler:    _InviteService_ListInviteLinkClaims_Handler,
+		},
+		{
+			MethodName: "ApproveInviteLinkClaim",
+			Handler:    _InviteService_ApproveInviteLinkClaim_Handler,
+		},
+		{
+			MethodName: "DeclineInviteLinkClaim",
+			Handler:    _InviteService_DeclineInviteLinkClaim_Handler,
+		},
+		{
+			MethodName: "SubmitCampaignApplication",
+			Handler:    _InviteService_SubmitCampaignApplication_Handler,
+		},
+		{
+			MethodName: "ListCampaignApplications",
+			Handler:    _InviteService_ListCampaignApplications_Handler,
+		},
+		{
+			MethodName: "AcceptCampaignApplication",
+			Handler:    _InviteService_AcceptCampaignApplication_Handler,
+		},
+		{
+			MethodName: "DeclineCampaignApplication",
+			Handler:    _InviteService_DeclineCampaignApplication_Handler,
+		},
 		{
 			MethodName: "LeaseIntegrationOutboxEvents",
 			Handler:    _InviteService_LeaseIntegrationOutboxEvents_Handler,
@@ -522,6 +1078,14 @@ var InviteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AckIntegrationOutboxEvent",
 			Handler:    _InviteService_AckIntegrationOutboxEvent_Handler,
 		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _InviteService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _InviteService_DeleteUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invite/v1/invite.proto",
@@ -20,22 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StatusService_ReportStatus_FullMethodName    = "/status.v1.StatusService/ReportStatus"
-	StatusService_GetSystemStatus_FullMethodName = "/status.v1.StatusService/GetSystemStatus"
-	StatusService_SetOverride_FullMethodName     = "/status.v1.StatusService/SetOverride"
-	StatusService_ClearOverride_FullMethodName   = "/status.v1.StatusService/ClearOverride"
+	StatusService_ReportStatus_FullMethodName         = "/status.v1.StatusService/ReportStatus"
+	StatusService_GetSystemStatus_FullMethodName      = "/status.v1.StatusService/GetSystemStatus"
+	StatusService_SetOverride_FullMethodName          = "/status.v1.StatusService/SetOverride"
+	StatusService_ClearOverride_FullMethodName        = "/status.v1.StatusService/ClearOverride"
+	StatusService_ListStatusHistory_FullMethodName    = "/status.v1.StatusService/ListStatusHistory"
+	StatusService_ListCapabilityUptime_FullMethodName = "/status.v1.StatusService/ListCapabilityUptime"
+	StatusService_ListIncidents_FullMethodName        = "/status.v1.StatusService/ListIncidents"
 )
 
 // StatusServiceClient is the client API for StatusService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// StatusService provides system-wide capability health aggregation and operator overrides.
+// StatusService provides system-wide capability health aggregation, operator
+// overrides, and the persisted status history and incident timeline.
 type StatusServiceClient interface {
 	ReportStatus(ctx context.Context, in *ReportStatusRequest, opts ...grpc.CallOption) (*ReportStatusResponse, error)
 	GetSystemStatus(ctx context.Context, in *GetSystemStatusRequest, opts ...grpc.CallOption) (*GetSystemStatusResponse, error)
 	SetOverride(ctx context.Context, in *SetOverrideRequest, opts ...grpc.CallOption) (*SetOverrideResponse, error)
 	ClearOverride(ctx context.Context, in *ClearOverrideRequest, opts ...grpc.CallOption) (*ClearOverrideResponse, error)
+	ListStatusHistory(ctx context.Context, in *ListStatusHistoryRequest, opts ...grpc.CallOption) (*ListStatusHistoryResponse, error)
+	ListCapabilityUptime(ctx context.Context, in *ListCapabilityUptimeRequest, opts ...grpc.CallOption) (*ListCapabilityUptimeResponse, error)
+	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
 }
 
 type statusServiceClient struct {
@@ -86,16 +93,50 @@ func (c *statusServiceClient) ClearOverride(ctx context.Context, in *ClearOverri
 	return out, nil
 }
 
+func (c *statusServiceClient) ListStatusHistory(ctx context.Context, in *ListStatusHistoryRequest, opts ...grpc.CallOption) (*ListStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, StatusService_ListStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusServiceClient) ListCapabilityUptime(ctx context.Context, in *ListCapabilityUptimeRequest, opts ...grpc.CallOption) (*ListCapabilityUptimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCapabilityUptimeResponse)
+	err := c.cc.Invoke(ctx, StatusService_ListCapabilityUptime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusServiceClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncidentsResponse)
+	err := c.cc.Invoke(ctx, StatusService_ListIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatusServiceServer is the server API for StatusService service.
 // All implementations must embed UnimplementedStatusServiceServer
 // for forward compatibility.
 //
-// StatusService provides system-wide capability health aggregation and operator overrides.
+// StatusService provides system-wide capability health aggregation, operator
+// overrides, and the persisted status history and incident timeline.
 type StatusServiceServer interface {
 	ReportStatus(context.Context, *ReportStatusRequest) (*ReportStatusResponse, error)
 	GetSystemStatus(context.Context, *GetSystemStatusRequest) (*GetSystemStatusResponse, error)
 	SetOverride(context.Context, *SetOverrideRequest) (*SetOverrideResponse, error)
 	ClearOverride(context.Context, *ClearOverrideRequest) (*ClearOverrideResponse, error)
+	ListStatusHistory(context.Context, *ListStatusHistoryRequest) (*ListStatusHistoryResponse, error)
+	ListCapabilityUptime(context.Context, *ListCapabilityUptimeRequest) (*ListCapabilityUptimeResponse, error)
+	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
 	mustEmbedUnimplementedStatusServiceServer()
 }
 
@@ -118,6 +159,15 @@ func (UnimplementedStatusServiceServer) SetOverride(context.Context, *SetOverrid
 func (UnimplementedStatusServiceServer) ClearOverride(context.Context, *ClearOverrideRequest) (*ClearOverrideResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearOverride not implemented")
 }
+func (UnimplementedStatusServiceServer) ListStatusHistory(context.Context, *ListStatusHistoryRequest) (*ListStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatusHistory not implemented")
+}
+func (UnimplementedStatusServiceServer) ListCapabilityUptime(context.Context, *ListCapabilityUptimeRequest) (*ListCapabilityUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCapabilityUptime not implemented")
+}
+func (UnimplementedStatusServiceServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
+}
 func (UnimplementedStatusServiceServer) mustEmbedUnimplementedStatusServiceServer() {}
 func (UnimplementedStatusServiceServer) testEmbeddedByValue()                       {}
 
@@ -211,6 +261,60 @@ func _StatusService_ClearOverride_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatusService_ListStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServiceServer).ListStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusService_ListStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServiceServer).ListStatusHistory(ctx, req.(*ListStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatusService_ListCapabilityUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCapabilityUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServiceServer).ListCapabilityUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusService_ListCapabilityUptime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServiceServer).ListCapabilityUptime(ctx, req.(*ListCapabilityUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatusService_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServiceServer).ListIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusService_ListIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServiceServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StatusService_ServiceDesc is the grpc.ServiceDesc for StatusService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,6 +338,18 @@ var StatusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearOverride",
 			Handler:    _StatusService_ClearOverride_Handler,
 		},
+		{
+			MethodName: "ListStatusHistory",
+			Handler:    _StatusService_ListStatusHistory_Handler,
+		},
+		{
+			MethodName: "ListCapabilityUptime",
+			Handler:    _StatusService_ListCapabilityUptime_Handler,
+		},
+		{
+			MethodName: "ListIncidents",
+			Handler:    _StatusService_ListIncidents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "status/v1/status.proto",
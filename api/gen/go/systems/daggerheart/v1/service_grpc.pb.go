@@ -61,6 +61,7 @@ const (
 	DaggerheartService_ResolveBlazeOfGlory_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/ResolveBlazeOfGlory"
 	DaggerheartService_SessionActionRoll_FullMethodName               = "/systems.daggerheart.v1.DaggerheartService/SessionActionRoll"
 	DaggerheartService_SessionDamageRoll_FullMethodName               = "/systems.daggerheart.v1.DaggerheartService/SessionDamageRoll"
+	DaggerheartService_SessionActionRollFlow_FullMethodName           = "/systems.daggerheart.v1.DaggerheartService/SessionActionRollFlow"
 	DaggerheartService_SessionAttackFlow_FullMethodName               = "/systems.daggerheart.v1.DaggerheartService/SessionAttackFlow"
 	DaggerheartService_SessionReactionFlow_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/SessionReactionFlow"
 	DaggerheartService_SessionAdversaryAttackRoll_FullMethodName      = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryAttackRoll"
@@ -177,6 +178,8 @@ type DaggerheartServiceClient interface {
 	SessionActionRoll(ctx context.Context, in *SessionActionRollRequest, opts ...grpc.CallOption) (*SessionActionRollResponse, error)
 	// Roll damage dice for a session (combines roll + event recording).
 	SessionDamageRoll(ctx context.Context, in *SessionDamageRollRequest, opts ...grpc.CallOption) (*SessionDamageRollResponse, error)
+	// Run an action roll flow (roll + mandatory outcome) as one atomic write.
+	SessionActionRollFlow(ctx context.Context, in *SessionActionRollFlowRequest, opts ...grpc.CallOption) (*SessionActionRollFlowResponse, error)
 	// Run a full attack flow (roll, outcome, damage roll, apply damage).
 	SessionAttackFlow(ctx context.Context, in *SessionAttackFlowRequest, opts ...grpc.CallOption) (*SessionAttackFlowResponse, error)
 	// Run a full reaction flow (roll, outcome, reaction outcome).
@@ -642,6 +645,16 @@ func (c *daggerheartServiceClient) SessionDamageRoll(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *daggerheartServiceClient) SessionActionRollFlow(ctx context.Context, in *SessionActionRollFlowRequest, opts ...grpc.CallOption) (*SessionActionRollFlowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionActionRollFlowResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_SessionActionRollFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daggerheartServiceClient) SessionAttackFlow(ctx context.Context, in *SessionAttackFlowRequest, opts ...grpc.CallOption) (*SessionAttackFlowResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SessionAttackFlowResponse)
@@ -981,6 +994,8 @@ type DaggerheartServiceServer interface {
 	SessionActionRoll(context.Context, *SessionActionRollRequest) (*SessionActionRollResponse, error)
 	// Roll damage dice for a session (combines roll + event recording).
 	SessionDamageRoll(context.Context, *SessionDamageRollRequest) (*SessionDamageRollResponse, error)
+	// Run an action roll flow (roll + mandatory outcome) as one atomic write.
+	SessionActionRollFlow(context.Context, *SessionActionRollFlowRequest) (*SessionActionRollFlowResponse, error)
 	// Run a full attack flow (roll, outcome, damage roll, apply damage).
 	SessionAttackFlow(context.Context, *SessionAttackFlowRequest) (*SessionAttackFlowResponse, error)
 	// Run a full reaction flow (roll, outcome, reaction outcome).
@@ -1159,6 +1174,9 @@ func (UnimplementedDaggerheartServiceServer) SessionActionRoll(context.Context,
 func (UnimplementedDaggerheartServiceServer) SessionDamageRoll(context.Context, *SessionDamageRollRequest) (*SessionDamageRollResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SessionDamageRoll not implemented")
 }
+func (UnimplementedDaggerheartServiceServer) SessionActionRollFlow(context.Context, *SessionActionRollFlowRequest) (*SessionActionRollFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionActionRollFlow not implemented")
+}
 func (UnimplementedDaggerheartServiceServer) SessionAttackFlow(context.Context, *SessionAttackFlowRequest) (*SessionAttackFlowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SessionAttackFlow not implemented")
 }
@@ -1993,6 +2011,24 @@ func _DaggerheartService_SessionDamageRoll_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaggerheartService_SessionActionRollFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionActionRollFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SessionActionRollFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_SessionActionRollFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).SessionActionRollFlow(ctx, req.(*SessionActionRollFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DaggerheartService_SessionAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SessionAttackFlowRequest)
 	if err := dec(in); err != nil {
@@ -2614,6 +2650,10 @@ var DaggerheartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SessionDamageRoll",
 			Handler:    _DaggerheartService_SessionDamageRoll_Handler,
 		},
+		{
+			MethodName: "SessionActionRollFlow",
+			Handler:    _DaggerheartService_SessionActionRollFlow_Handler,
+		},
 		{
 			MethodName: "SessionAttackFlow",
 			Handler:    _DaggerheartService_SessionAttackFlow_Handler,
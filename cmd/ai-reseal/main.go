@@ -0,0 +1,27 @@
+// Package main re-seals stored AI credentials and provider grants under the
+// active encryption key after a key rotation.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/config"
+	"github.com/louisbranch/fracturing.space/internal/tools/aireseal"
+	"github.com/louisbranch/fracturing.space/internal/tools/cli"
+)
+
+func main() {
+	cfg, err := aireseal.ParseConfig(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		config.Exitf("Error: %v", err)
+	}
+
+	ctx, stop := cli.WithSignalTimeout(context.Background(), cfg.Timeout)
+	defer stop()
+
+	if err := aireseal.Run(ctx, cfg, os.Stdout, os.Stderr); err != nil {
+		config.Exitf("Error: %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+// Package main starts the public HTTP/JSON API gateway process lifecycle.
+package main
+
+import (
+	"log"
+
+	gatewaycmd "github.com/louisbranch/fracturing.space/internal/cmd/gateway"
+	platformcmd "github.com/louisbranch/fracturing.space/internal/platform/cmd"
+)
+
+func main() {
+	if err := platformcmd.RunServiceMain(platformcmd.ServiceMainOptions[gatewaycmd.Config]{
+		Service:     platformcmd.ServiceGateway,
+		ParseConfig: gatewaycmd.ParseConfig,
+		Run:         gatewaycmd.Run,
+	}); err != nil {
+		log.Fatal(err)
+	}
+}
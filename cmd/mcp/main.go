@@ -0,0 +1,19 @@
+// Package main starts the external MCP server process lifecycle.
+package main
+
+import (
+	"log"
+
+	mcpcmd "github.com/louisbranch/fracturing.space/internal/cmd/mcp"
+	platformcmd "github.com/louisbranch/fracturing.space/internal/platform/cmd"
+)
+
+func main() {
+	if err := platformcmd.RunServiceMain(platformcmd.ServiceMainOptions[mcpcmd.Config]{
+		Service:     platformcmd.ServiceMCP,
+		ParseConfig: mcpcmd.ParseConfig,
+		Run:         mcpcmd.Run,
+	}); err != nil {
+		log.Fatal(err)
+	}
+}
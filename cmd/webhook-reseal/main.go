@@ -0,0 +1,27 @@
+// Package main re-seals stored campaign webhook signing secrets under the
+// active game encryption key after a key rotation.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/config"
+	"github.com/louisbranch/fracturing.space/internal/tools/cli"
+	"github.com/louisbranch/fracturing.space/internal/tools/webhookreseal"
+)
+
+func main() {
+	cfg, err := webhookreseal.ParseConfig(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		config.Exitf("Error: %v", err)
+	}
+
+	ctx, stop := cli.WithSignalTimeout(context.Background(), cfg.Timeout)
+	defer stop()
+
+	if err := webhookreseal.Run(ctx, cfg, os.Stdout, os.Stderr); err != nil {
+		config.Exitf("Error: %v", err)
+	}
+}
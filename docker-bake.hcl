@@ -27,6 +27,8 @@ group "default" {
     "status",
     "invite",
     "userhub",
+    "gateway",
+    "mcp",
     "web",
     "play",
     "caddy",
@@ -108,6 +110,18 @@ target "userhub" {
   tags     = ["${REGISTRY}/${NAMESPACE}/userhub:${IMAGE_TAG}"]
 }
 
+target "gateway" {
+  inherits = ["base"]
+  target   = "gateway"
+  tags     = ["${REGISTRY}/${NAMESPACE}/gateway:${IMAGE_TAG}"]
+}
+
+target "mcp" {
+  inherits = ["base"]
+  target   = "mcp"
+  tags     = ["${REGISTRY}/${NAMESPACE}/mcp:${IMAGE_TAG}"]
+}
+
 target "web" {
   inherits = ["base"]
   target   = "web"
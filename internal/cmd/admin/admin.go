@@ -21,6 +21,7 @@ type Config struct {
 	AuthAddr            string `env:"FRACTURING_SPACE_AUTH_ADDR"`
 	InviteAddr          string `env:"FRACTURING_SPACE_ADMIN_INVITE_ADDR"`
 	StatusAddr          string `env:"FRACTURING_SPACE_STATUS_ADDR"`
+	DiscoveryAddr       string `env:"FRACTURING_SPACE_DISCOVERY_ADDR"`
 	AuthIntrospectURL   string `env:"FRACTURING_SPACE_ADMIN_AUTH_INTROSPECT_URL"`
 	OAuthResourceSecret string `env:"FRACTURING_SPACE_ADMIN_OAUTH_RESOURCE_SECRET"`
 	LoginURL            string `env:"FRACTURING_SPACE_ADMIN_LOGIN_URL"`
@@ -36,6 +37,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	cfg.AuthAddr = serviceaddr.OrDefaultGRPCAddr(cfg.AuthAddr, serviceaddr.ServiceAuth)
 	cfg.InviteAddr = serviceaddr.OrDefaultGRPCAddr(cfg.InviteAddr, serviceaddr.ServiceInvite)
 	cfg.StatusAddr = serviceaddr.OrDefaultGRPCAddr(cfg.StatusAddr, serviceaddr.ServiceStatus)
+	cfg.DiscoveryAddr = serviceaddr.OrDefaultGRPCAddr(cfg.DiscoveryAddr, serviceaddr.ServiceDiscovery)
 
 	fs.StringVar(&cfg.HTTPAddr, "http-addr", cfg.HTTPAddr, "HTTP listen address")
 	fs.StringVar(&cfg.GRPCAddr, "grpc-addr", cfg.GRPCAddr, "game server address")
@@ -61,6 +63,9 @@ func logConfiguredAddresses(cfg Config) {
 	if addr := strings.TrimSpace(cfg.StatusAddr); addr != "" {
 		log.Printf("admin startup: dependency=status address=%s", addr)
 	}
+	if addr := strings.TrimSpace(cfg.DiscoveryAddr); addr != "" {
+		log.Printf("admin startup: dependency=discovery address=%s", addr)
+	}
 }
 
 // Run creates the admin control-plane and starts it for the current process.
@@ -86,6 +91,7 @@ func Run(ctx context.Context, cfg Config) error {
 			AuthAddr:       cfg.AuthAddr,
 			InviteAddr:     cfg.InviteAddr,
 			StatusAddr:     cfg.StatusAddr,
+			DiscoveryAddr:  cfg.DiscoveryAddr,
 			AuthConfig:     authCfg,
 			StatusReporter: reporter,
 		})
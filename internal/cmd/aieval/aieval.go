@@ -1,4 +1,4 @@
-// Package aieval parses CLI flags and runs one live AI GM evaluation lane for Promptfoo.
+// Package aieval parses CLI flags and runs one AI GM evaluation lane (live or cassette replay) for Promptfoo.
 package aieval
 
 import (
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	entrypoint "github.com/louisbranch/fracturing.space/internal/platform/cmd"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/cassette"
 	evalsupport "github.com/louisbranch/fracturing.space/internal/test/aieval"
 )
 
@@ -25,6 +26,11 @@ const (
 	integrationEvalCaseIDEnv     = "INTEGRATION_AI_EVAL_CASE_ID"
 	integrationEvalRunIDEnv      = "INTEGRATION_AI_EVAL_RUN_ID"
 	integrationPromptProfileEnv  = "INTEGRATION_AI_PROMPT_PROFILE"
+	integrationCassettePathEnv   = "INTEGRATION_AI_CASSETTE_PATH"
+	providerCassetteModeEnv      = "FRACTURING_SPACE_AI_PROVIDER_CASSETTE_MODE"
+	providerCassettePathEnv      = "FRACTURING_SPACE_AI_PROVIDER_CASSETTE_PATH"
+	defaultCassetteDir           = "internal/test/integration/fixtures/ai_cassettes"
+	cassetteReplayTestName       = "TestAIGMCampaignContextCassetteReplay"
 )
 
 var fileLinePrefixPattern = regexp.MustCompile(`^\s*\S+:\d+:\s*`)
@@ -40,6 +46,8 @@ type Config struct {
 	CaseID           string `env:"INTEGRATION_AI_EVAL_CASE_ID"`
 	RunID            string `env:"INTEGRATION_AI_EVAL_RUN_ID"`
 	JSONPath         string `env:"INTEGRATION_AI_EVAL_JSON_PATH"`
+	CassetteMode     string `env:"INTEGRATION_AI_CASSETTE_MODE"`
+	CassetteDir      string `env:"INTEGRATION_AI_CASSETTE_DIR"`
 }
 
 // ParseConfig parses environment and flags into Config.
@@ -58,6 +66,8 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	fs.StringVar(&cfg.CaseID, "case-id", cfg.CaseID, "stable eval case identifier used for isolated artifacts")
 	fs.StringVar(&cfg.RunID, "run-id", cfg.RunID, "optional parent eval run identifier")
 	fs.StringVar(&cfg.JSONPath, "json", cfg.JSONPath, "optional path to also write the final JSON output")
+	fs.StringVar(&cfg.CassetteMode, "cassette-mode", cfg.CassetteMode, "optional provider cassette mode: record (live lane) or replay (offline lane)")
+	fs.StringVar(&cfg.CassetteDir, "cassette-dir", cfg.CassetteDir, "directory holding <scenario>.json provider cassettes")
 	if err := entrypoint.ParseArgs(fs, args); err != nil {
 		return Config{}, err
 	}
@@ -68,10 +78,16 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	if _, ok := evalsupport.ScenarioByID(cfg.Scenario); !ok {
 		return Config{}, fmt.Errorf("unknown scenario %q", cfg.Scenario)
 	}
+	mode, err := cassette.ParseMode(cfg.CassetteMode)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.CassetteMode = string(mode)
 	return cfg, nil
 }
 
-// Run executes one live eval scenario through go test and writes the resulting JSON.
+// Run executes one eval scenario through go test and writes the resulting JSON.
+// Live runs may record a provider cassette; replay runs use the offline lane.
 func Run(ctx context.Context, cfg Config, out io.Writer, errOut io.Writer) error {
 	if out == nil {
 		out = io.Discard
@@ -92,17 +108,12 @@ func Run(ctx context.Context, cfg Config, out io.Writer, errOut io.Writer) error
 	caseID := resolvedCaseID(cfg, scenario)
 	outputPath := evalOutputPath(repoRoot, caseID)
 
-	cmdArgs := []string{
-		"test",
-		"-tags=integration liveai",
-		"./internal/test/integration",
-		"-run",
-		"^" + scenario.LiveTestName + "$",
-		"-count=1",
-	}
-	cmd := exec.CommandContext(ctx, "go", cmdArgs...)
+	cmd := exec.CommandContext(ctx, "go", buildTestArgs(cfg, scenario)...)
 	cmd.Dir = repoRoot
 	cmd.Env = buildCommandEnv(cfg, caseID, outputPath)
+	if path := cassettePath(repoRoot, cfg, scenario); path != "" {
+		cmd.Env = appendCassetteEnv(cmd.Env, cassette.Mode(cfg.CassetteMode), path)
+	}
 
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -137,6 +148,55 @@ func Run(ctx context.Context, cfg Config, out io.Writer, errOut io.Writer) error
 	return writeEvalOutput(result, out, cfg.JSONPath)
 }
 
+// buildTestArgs selects the live capture test, or the offline cassette lane
+// when replaying a recorded scenario.
+func buildTestArgs(cfg Config, scenario evalsupport.Scenario) []string {
+	if cassette.Mode(cfg.CassetteMode) == cassette.ModeReplay {
+		return []string{
+			"test",
+			"-tags=integration",
+			"./internal/test/integration",
+			"-run",
+			"^" + cassetteReplayTestName + "$/^" + scenario.ID + "$",
+			"-count=1",
+		}
+	}
+	return []string{
+		"test",
+		"-tags=integration liveai",
+		"./internal/test/integration",
+		"-run",
+		"^" + scenario.LiveTestName + "$",
+		"-count=1",
+	}
+}
+
+// cassettePath resolves the per-scenario cassette file, or "" when cassettes
+// are disabled.
+func cassettePath(repoRoot string, cfg Config, scenario evalsupport.Scenario) string {
+	if cassette.Mode(cfg.CassetteMode) == cassette.ModeOff {
+		return ""
+	}
+	dir := strings.TrimSpace(cfg.CassetteDir)
+	if dir == "" {
+		dir = defaultCassetteDir
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(repoRoot, dir)
+	}
+	return filepath.Join(dir, scenario.ID+".json")
+}
+
+// appendCassetteEnv routes the AI service provider through a recorder while
+// capturing, and points the offline lane at the cassette while replaying.
+func appendCassetteEnv(env []string, mode cassette.Mode, path string) []string {
+	if mode == cassette.ModeReplay {
+		return appendOrReplaceEnv(env, integrationCassettePathEnv, path)
+	}
+	env = appendOrReplaceEnv(env, providerCassetteModeEnv, string(mode))
+	return appendOrReplaceEnv(env, providerCassettePathEnv, path)
+}
+
 // buildCommandEnv injects only the eval-specific overrides while preserving the caller environment.
 func buildCommandEnv(cfg Config, caseID string, outputPath string) []string {
 	env := os.Environ()
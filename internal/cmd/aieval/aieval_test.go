@@ -122,3 +122,52 @@ func TestEnrichFailedResultAddsPromptContext(t *testing.T) {
 		t.Fatalf("metric status = %q, want invalid", result.MetricStatus)
 	}
 }
+
+func TestParseConfigRejectsUnknownCassetteMode(t *testing.T) {
+	fs := flag.NewFlagSet("aieval", flag.ContinueOnError)
+
+	if _, err := ParseConfig(fs, []string{"--scenario", "ai_gm_campaign_context_bootstrap", "--cassette-mode", "rewind"}); err == nil {
+		t.Fatal("expected unknown cassette mode to fail parsing")
+	}
+}
+
+func TestBuildTestArgsReplayUsesOfflineCassetteLane(t *testing.T) {
+	scenario, ok := evalsupport.ScenarioByID("ai_gm_campaign_context_bootstrap")
+	if !ok {
+		t.Fatal("expected bootstrap scenario")
+	}
+
+	args := buildTestArgs(Config{CassetteMode: "replay"}, scenario)
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "-tags=integration ./") {
+		t.Fatalf("replay args = %q, want integration-only tags", joined)
+	}
+	if !strings.Contains(joined, "^TestAIGMCampaignContextCassetteReplay$/^ai_gm_campaign_context_bootstrap$") {
+		t.Fatalf("replay args = %q, want cassette subtest", joined)
+	}
+
+	live := strings.Join(buildTestArgs(Config{}, scenario), " ")
+	if !strings.Contains(live, "liveai") || !strings.Contains(live, scenario.LiveTestName) {
+		t.Fatalf("live args = %q", live)
+	}
+}
+
+func TestAppendCassetteEnvRecordRoutesProviderThroughRecorder(t *testing.T) {
+	scenario, _ := evalsupport.ScenarioByID("ai_gm_campaign_context_bootstrap")
+	path := cassettePath("/repo", Config{CassetteMode: "record"}, scenario)
+	if path != "/repo/internal/test/integration/fixtures/ai_cassettes/ai_gm_campaign_context_bootstrap.json" {
+		t.Fatalf("cassette path = %q", path)
+	}
+
+	env := appendCassetteEnv(nil, "record", path)
+	want := []string{
+		"FRACTURING_SPACE_AI_PROVIDER_CASSETTE_MODE=record",
+		"FRACTURING_SPACE_AI_PROVIDER_CASSETTE_PATH=" + path,
+	}
+	if strings.Join(env, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("env = %v, want %v", env, want)
+	}
+	if got := cassettePath("/repo", Config{}, scenario); got != "" {
+		t.Fatalf("cassette path without mode = %q, want empty", got)
+	}
+}
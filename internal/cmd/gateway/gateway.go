@@ -0,0 +1,85 @@
+// Package gateway parses gateway command flags and boots the public HTTP/JSON
+// API gateway.
+package gateway
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	entrypoint "github.com/louisbranch/fracturing.space/internal/platform/cmd"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+	"github.com/louisbranch/fracturing.space/internal/platform/serviceaddr"
+	platformstatus "github.com/louisbranch/fracturing.space/internal/platform/status"
+	"github.com/louisbranch/fracturing.space/internal/services/gateway"
+)
+
+// Config holds the gateway command configuration.
+type Config struct {
+	HTTPAddr            string   `env:"FRACTURING_SPACE_GATEWAY_HTTP_ADDR"             envDefault:":8085"`
+	GameAddr            string   `env:"FRACTURING_SPACE_GAME_ADDR"`
+	AuthIntrospectURL   string   `env:"FRACTURING_SPACE_GATEWAY_AUTH_INTROSPECT_URL"`
+	OAuthResourceSecret string   `env:"FRACTURING_SPACE_GATEWAY_OAUTH_RESOURCE_SECRET"`
+	OAuthClientIDs      []string `env:"FRACTURING_SPACE_GATEWAY_OAUTH_CLIENT_IDS"      envSeparator:","`
+}
+
+// ParseConfig parses environment and flags into a Config.
+func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
+	var cfg Config
+	if err := entrypoint.ParseConfig(&cfg); err != nil {
+		return Config{}, err
+	}
+	cfg.GameAddr = serviceaddr.OrDefaultGRPCAddr(cfg.GameAddr, serviceaddr.ServiceGame)
+	if strings.TrimSpace(cfg.AuthIntrospectURL) == "" {
+		cfg.AuthIntrospectURL = serviceaddr.OrDefaultHTTPBaseURL("", serviceaddr.ServiceAuth) + "/introspect"
+	}
+
+	fs.StringVar(&cfg.HTTPAddr, "http-addr", cfg.HTTPAddr, "HTTP listen address")
+	fs.StringVar(&cfg.GameAddr, "game-addr", cfg.GameAddr, "game service gRPC address")
+	fs.StringVar(&cfg.AuthIntrospectURL, "auth-introspect-url", cfg.AuthIntrospectURL, "auth OAuth introspection URL")
+	if err := entrypoint.ParseArgs(fs, args); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Run creates the gateway server and serves until ctx is canceled.
+func Run(ctx context.Context, cfg Config) error {
+	return entrypoint.RunWithTelemetry(ctx, entrypoint.ServiceGateway, func(context.Context) error {
+		reporter := platformstatus.NewReporter("gateway", nil)
+		reporter.Register("gateway.api", platformstatus.Operational)
+
+		log.Printf("gateway startup: dependency=game address=%s", cfg.GameAddr)
+		log.Printf("gateway startup: dependency=auth introspect=%s", cfg.AuthIntrospectURL)
+
+		limiter, err := ratelimit.NewFromEnv(gateway.RateLimits)
+		if err != nil {
+			return fmt.Errorf("configure rate limits: %w", err)
+		}
+		defer limiter.Close()
+
+		server, err := gateway.NewServer(ctx, gateway.Config{
+			HTTPAddr:         cfg.HTTPAddr,
+			GameAddr:         cfg.GameAddr,
+			IntrospectURL:    cfg.AuthIntrospectURL,
+			ResourceSecret:   cfg.OAuthResourceSecret,
+			AllowedClientIDs: cfg.OAuthClientIDs,
+			RateLimiter:      limiter,
+			StatusReporter:   reporter,
+		})
+		if err != nil {
+			return fmt.Errorf("init gateway server: %w", err)
+		}
+		defer server.Close()
+
+		stopReporter := reporter.Start(ctx)
+		defer stopReporter()
+
+		if err := server.ListenAndServe(ctx); err != nil {
+			return fmt.Errorf("serve gateway: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+package gateway
+
+import (
+	"flag"
+	"testing"
+)
+
+func TestParseConfigDefaults(t *testing.T) {
+	fs := flag.NewFlagSet("gateway", flag.ContinueOnError)
+	cfg, err := ParseConfig(fs, nil)
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	if cfg.HTTPAddr != ":8085" {
+		t.Fatalf("expected default http addr, got %q", cfg.HTTPAddr)
+	}
+	if cfg.GameAddr != "game:8082" {
+		t.Fatalf("expected default game addr, got %q", cfg.GameAddr)
+	}
+	if cfg.AuthIntrospectURL != "http://auth:8084/introspect" {
+		t.Fatalf("expected default introspect url, got %q", cfg.AuthIntrospectURL)
+	}
+}
+
+func TestParseConfigOverrides(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_GATEWAY_HTTP_ADDR", "env-gateway")
+	t.Setenv("FRACTURING_SPACE_GAME_ADDR", "env-game")
+	t.Setenv("FRACTURING_SPACE_GATEWAY_AUTH_INTROSPECT_URL", "http://env-auth/introspect")
+	t.Setenv("FRACTURING_SPACE_GATEWAY_OAUTH_RESOURCE_SECRET", "secret")
+
+	fs := flag.NewFlagSet("gateway", flag.ContinueOnError)
+	cfg, err := ParseConfig(fs, []string{"-http-addr", "flag-gateway", "-game-addr", "flag-game"})
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	if cfg.HTTPAddr != "flag-gateway" {
+		t.Fatalf("expected flag http addr, got %q", cfg.HTTPAddr)
+	}
+	if cfg.GameAddr != "flag-game" {
+		t.Fatalf("expected flag game addr, got %q", cfg.GameAddr)
+	}
+	if cfg.AuthIntrospectURL != "http://env-auth/introspect" {
+		t.Fatalf("expected env introspect url, got %q", cfg.AuthIntrospectURL)
+	}
+	if cfg.OAuthResourceSecret != "secret" {
+		t.Fatalf("expected env resource secret, got %q", cfg.OAuthResourceSecret)
+	}
+}
@@ -15,6 +15,8 @@ type Config struct {
 	Port     int    `env:"FRACTURING_SPACE_INVITE_PORT" envDefault:"8095"`
 	GameAddr string `env:"FRACTURING_SPACE_INVITE_GAME_ADDR"`
 	AuthAddr string `env:"FRACTURING_SPACE_INVITE_AUTH_ADDR"`
+	// DiscoveryAddr backs campaign applications to open-table listings.
+	DiscoveryAddr string `env:"FRACTURING_SPACE_INVITE_DISCOVERY_ADDR"`
 }
 
 // ParseConfig parses environment and flags into Config.
@@ -25,6 +27,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	}
 	cfg.GameAddr = serviceaddr.OrDefaultGRPCAddr(cfg.GameAddr, serviceaddr.ServiceGame)
 	cfg.AuthAddr = serviceaddr.OrDefaultGRPCAddr(cfg.AuthAddr, serviceaddr.ServiceAuth)
+	cfg.DiscoveryAddr = serviceaddr.OrDefaultGRPCAddr(cfg.DiscoveryAddr, serviceaddr.ServiceDiscovery)
 	fs.IntVar(&cfg.Port, "port", cfg.Port, "The invite gRPC server port")
 	if err := entrypoint.ParseArgs(fs, args); err != nil {
 		return Config{}, err
@@ -36,9 +39,10 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 func Run(ctx context.Context, cfg Config) error {
 	return entrypoint.RunWithTelemetry(ctx, entrypoint.ServiceInvite, func(context.Context) error {
 		return server.Run(ctx, server.Config{
-			Port:     cfg.Port,
-			GameAddr: cfg.GameAddr,
-			AuthAddr: cfg.AuthAddr,
+			Port:          cfg.Port,
+			GameAddr:      cfg.GameAddr,
+			AuthAddr:      cfg.AuthAddr,
+			DiscoveryAddr: cfg.DiscoveryAddr,
 		})
 	})
 }
@@ -0,0 +1,124 @@
+// Package mcp parses MCP server command flags and boots the external MCP
+// server over stdio or streamable HTTP.
+package mcp
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	entrypoint "github.com/louisbranch/fracturing.space/internal/platform/cmd"
+	"github.com/louisbranch/fracturing.space/internal/platform/serviceaddr"
+	platformstatus "github.com/louisbranch/fracturing.space/internal/platform/status"
+	"github.com/louisbranch/fracturing.space/internal/services/mcp"
+)
+
+// Supported transports.
+const (
+	TransportHTTP  = "http"
+	TransportStdio = "stdio"
+)
+
+// Config holds the MCP command configuration.
+type Config struct {
+	Transport           string   `env:"FRACTURING_SPACE_MCP_TRANSPORT"               envDefault:"http"`
+	HTTPAddr            string   `env:"FRACTURING_SPACE_MCP_HTTP_ADDR"               envDefault:":8086"`
+	GameAddr            string   `env:"FRACTURING_SPACE_GAME_ADDR"`
+	AIAddr              string   `env:"FRACTURING_SPACE_AI_ADDR"`
+	AuthIntrospectURL   string   `env:"FRACTURING_SPACE_MCP_AUTH_INTROSPECT_URL"`
+	OAuthResourceSecret string   `env:"FRACTURING_SPACE_MCP_OAUTH_RESOURCE_SECRET"`
+	OAuthClientIDs      []string `env:"FRACTURING_SPACE_MCP_OAUTH_CLIENT_IDS"        envSeparator:","`
+	AllowedOrigins      []string `env:"FRACTURING_SPACE_MCP_ALLOWED_ORIGINS"         envSeparator:","`
+	// AccessToken and CampaignID bind a stdio process. The token is read from
+	// the environment only so it never shows up in process listings.
+	AccessToken string `env:"FRACTURING_SPACE_MCP_ACCESS_TOKEN"`
+	CampaignID  string `env:"FRACTURING_SPACE_MCP_CAMPAIGN_ID"`
+}
+
+// ParseConfig parses environment and flags into a Config.
+func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
+	var cfg Config
+	if err := entrypoint.ParseConfig(&cfg); err != nil {
+		return Config{}, err
+	}
+	cfg.GameAddr = serviceaddr.OrDefaultGRPCAddr(cfg.GameAddr, serviceaddr.ServiceGame)
+	cfg.AIAddr = serviceaddr.OrDefaultGRPCAddr(cfg.AIAddr, serviceaddr.ServiceAI)
+	if strings.TrimSpace(cfg.AuthIntrospectURL) == "" {
+		cfg.AuthIntrospectURL = serviceaddr.OrDefaultHTTPBaseURL("", serviceaddr.ServiceAuth) + "/introspect"
+	}
+
+	fs.StringVar(&cfg.Transport, "transport", cfg.Transport, "transport: http or stdio")
+	fs.StringVar(&cfg.HTTPAddr, "http-addr", cfg.HTTPAddr, "HTTP listen address")
+	fs.StringVar(&cfg.GameAddr, "game-addr", cfg.GameAddr, "game service gRPC address")
+	fs.StringVar(&cfg.AIAddr, "ai-addr", cfg.AIAddr, "AI service gRPC address")
+	fs.StringVar(&cfg.AuthIntrospectURL, "auth-introspect-url", cfg.AuthIntrospectURL, "auth OAuth introspection URL")
+	fs.StringVar(&cfg.CampaignID, "campaign-id", cfg.CampaignID, "campaign to bind a stdio session to")
+	if err := entrypoint.ParseArgs(fs, args); err != nil {
+		return Config{}, err
+	}
+
+	cfg.Transport = strings.ToLower(strings.TrimSpace(cfg.Transport))
+	switch cfg.Transport {
+	case TransportHTTP:
+	case TransportStdio:
+		if strings.TrimSpace(cfg.AccessToken) == "" {
+			return Config{}, fmt.Errorf("FRACTURING_SPACE_MCP_ACCESS_TOKEN is required for the stdio transport")
+		}
+		if strings.TrimSpace(cfg.CampaignID) == "" {
+			return Config{}, fmt.Errorf("campaign id is required for the stdio transport")
+		}
+	default:
+		return Config{}, fmt.Errorf("unsupported transport %q", cfg.Transport)
+	}
+	return cfg, nil
+}
+
+// Run creates the MCP server and serves the configured transport until ctx
+// is canceled or, for stdio, stdin closes.
+func Run(ctx context.Context, cfg Config) error {
+	return entrypoint.RunWithTelemetry(ctx, entrypoint.ServiceMCP, func(context.Context) error {
+		reporter := platformstatus.NewReporter("mcp", nil)
+		reporter.Register("mcp.api", platformstatus.Operational)
+
+		// Logs go to stderr, so they never interleave with stdio responses.
+		log.Printf("mcp startup: transport=%s", cfg.Transport)
+		log.Printf("mcp startup: dependency=game address=%s", cfg.GameAddr)
+		log.Printf("mcp startup: dependency=ai address=%s", cfg.AIAddr)
+		log.Printf("mcp startup: dependency=auth introspect=%s", cfg.AuthIntrospectURL)
+
+		serverCfg := mcp.Config{
+			GameAddr:         cfg.GameAddr,
+			AIAddr:           cfg.AIAddr,
+			IntrospectURL:    cfg.AuthIntrospectURL,
+			ResourceSecret:   cfg.OAuthResourceSecret,
+			AllowedClientIDs: cfg.OAuthClientIDs,
+			AllowedOrigins:   cfg.AllowedOrigins,
+			StatusReporter:   reporter,
+		}
+		if cfg.Transport == TransportHTTP {
+			serverCfg.HTTPAddr = cfg.HTTPAddr
+		}
+		server, err := mcp.NewServer(ctx, serverCfg)
+		if err != nil {
+			return fmt.Errorf("init mcp server: %w", err)
+		}
+		defer server.Close()
+
+		stopReporter := reporter.Start(ctx)
+		defer stopReporter()
+
+		if cfg.Transport == TransportStdio {
+			if err := server.ServeStdio(ctx, cfg.AccessToken, cfg.CampaignID, os.Stdin, os.Stdout); err != nil {
+				return fmt.Errorf("serve mcp stdio: %w", err)
+			}
+			return nil
+		}
+		if err := server.ListenAndServe(ctx); err != nil {
+			return fmt.Errorf("serve mcp: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,89 @@
+package mcp
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestParseConfigDefaults(t *testing.T) {
+	fs := flag.NewFlagSet("mcp", flag.ContinueOnError)
+	cfg, err := ParseConfig(fs, nil)
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	if cfg.Transport != TransportHTTP {
+		t.Fatalf("expected default transport, got %q", cfg.Transport)
+	}
+	if cfg.HTTPAddr != ":8086" {
+		t.Fatalf("expected default http addr, got %q", cfg.HTTPAddr)
+	}
+	if cfg.GameAddr != "game:8082" {
+		t.Fatalf("expected default game addr, got %q", cfg.GameAddr)
+	}
+	if cfg.AIAddr != "ai:8087" {
+		t.Fatalf("expected default ai addr, got %q", cfg.AIAddr)
+	}
+	if cfg.AuthIntrospectURL != "http://auth:8084/introspect" {
+		t.Fatalf("expected default introspect url, got %q", cfg.AuthIntrospectURL)
+	}
+}
+
+func TestParseConfigOverrides(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_MCP_HTTP_ADDR", "env-mcp")
+	t.Setenv("FRACTURING_SPACE_GAME_ADDR", "env-game")
+	t.Setenv("FRACTURING_SPACE_AI_ADDR", "env-ai")
+	t.Setenv("FRACTURING_SPACE_MCP_AUTH_INTROSPECT_URL", "http://env-auth/introspect")
+	t.Setenv("FRACTURING_SPACE_MCP_OAUTH_RESOURCE_SECRET", "secret")
+	t.Setenv("FRACTURING_SPACE_MCP_ALLOWED_ORIGINS", "https://a.example,https://b.example")
+
+	fs := flag.NewFlagSet("mcp", flag.ContinueOnError)
+	cfg, err := ParseConfig(fs, []string{"-http-addr", "flag-mcp", "-ai-addr", "flag-ai"})
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	if cfg.HTTPAddr != "flag-mcp" {
+		t.Fatalf("expected flag http addr, got %q", cfg.HTTPAddr)
+	}
+	if cfg.GameAddr != "env-game" {
+		t.Fatalf("expected env game addr, got %q", cfg.GameAddr)
+	}
+	if cfg.AIAddr != "flag-ai" {
+		t.Fatalf("expected flag ai addr, got %q", cfg.AIAddr)
+	}
+	if cfg.OAuthResourceSecret != "secret" {
+		t.Fatalf("expected env resource secret, got %q", cfg.OAuthResourceSecret)
+	}
+	if want := []string{"https://a.example", "https://b.example"}; !reflect.DeepEqual(cfg.AllowedOrigins, want) {
+		t.Fatalf("expected env allowed origins, got %v", cfg.AllowedOrigins)
+	}
+}
+
+func TestParseConfigStdio(t *testing.T) {
+	fs := flag.NewFlagSet("mcp", flag.ContinueOnError)
+	if _, err := ParseConfig(fs, []string{"-transport", "stdio", "-campaign-id", "camp-1"}); err == nil {
+		t.Fatal("expected error without an access token")
+	}
+
+	t.Setenv("FRACTURING_SPACE_MCP_ACCESS_TOKEN", "tok-1")
+	fs = flag.NewFlagSet("mcp", flag.ContinueOnError)
+	if _, err := ParseConfig(fs, []string{"-transport", "stdio"}); err == nil {
+		t.Fatal("expected error without a campaign id")
+	}
+
+	fs = flag.NewFlagSet("mcp", flag.ContinueOnError)
+	cfg, err := ParseConfig(fs, []string{"-transport", "STDIO", "-campaign-id", "camp-1"})
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	if cfg.Transport != TransportStdio || cfg.CampaignID != "camp-1" || cfg.AccessToken != "tok-1" {
+		t.Fatalf("unexpected stdio config %+v", cfg)
+	}
+}
+
+func TestParseConfigRejectsUnknownTransport(t *testing.T) {
+	fs := flag.NewFlagSet("mcp", flag.ContinueOnError)
+	if _, err := ParseConfig(fs, []string{"-transport", "sse"}); err == nil {
+		t.Fatal("expected error for unknown transport")
+	}
+}
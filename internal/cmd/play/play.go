@@ -6,27 +6,37 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"strings"
 
 	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
 	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	entrypoint "github.com/louisbranch/fracturing.space/internal/platform/cmd"
 	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
 	"github.com/louisbranch/fracturing.space/internal/platform/serviceaddr"
 	platformstatus "github.com/louisbranch/fracturing.space/internal/platform/status"
+	playtranscriptgrpc "github.com/louisbranch/fracturing.space/internal/services/play/api/grpc/transcript"
 	playapp "github.com/louisbranch/fracturing.space/internal/services/play/app"
 	playsqlite "github.com/louisbranch/fracturing.space/internal/services/play/storage/sqlite"
 	"github.com/louisbranch/fracturing.space/internal/services/play/transcript"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/httpx"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/playlaunchgrant"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"golang.org/x/sync/errgroup"
 	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	grpc_health_v1 "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // Config holds play command configuration.
 type Config struct {
 	HTTPAddr            string `env:"FRACTURING_SPACE_PLAY_HTTP_ADDR" envDefault:":8094"`
+	GRPCAddr            string `env:"FRACTURING_SPACE_PLAY_GRPC_ADDR" envDefault:":8096"`
+	InternalServices    string `env:"FRACTURING_SPACE_PLAY_INTERNAL_SERVICE_ALLOWLIST" envDefault:"worker"`
 	WebHTTPAddr         string `env:"FRACTURING_SPACE_WEB_HTTP_ADDR"`
 	AssetBaseURL        string `env:"FRACTURING_SPACE_ASSET_BASE_URL"`
 	AuthAddr            string `env:"FRACTURING_SPACE_AUTH_ADDR"`
@@ -36,6 +46,7 @@ type Config struct {
 	DBPath              string `env:"FRACTURING_SPACE_PLAY_DB_PATH" envDefault:"data/play.db"`
 	PlayUIDevServerURL  string `env:"FRACTURING_SPACE_PLAY_UI_DEV_SERVER_URL"`
 	TrustForwardedProto bool   `env:"FRACTURING_SPACE_PLAY_TRUST_FORWARDED_PROTO" envDefault:"false"`
+	IgnoreExpectedSeq   bool   `env:"FRACTURING_SPACE_PLAY_IGNORE_EXPECTED_SEQ" envDefault:"false"`
 }
 
 // ParseConfig parses environment and flags into a Config.
@@ -51,6 +62,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	cfg.StatusAddr = serviceaddr.OrDefaultGRPCAddr(cfg.StatusAddr, serviceaddr.ServiceStatus)
 
 	fs.StringVar(&cfg.HTTPAddr, "http-addr", cfg.HTTPAddr, "play HTTP listen address")
+	fs.StringVar(&cfg.GRPCAddr, "grpc-addr", cfg.GRPCAddr, "play internal gRPC listen address")
 	fs.StringVar(&cfg.WebHTTPAddr, "web-http-addr", cfg.WebHTTPAddr, "web HTTP listen address for browser fallback links")
 	fs.StringVar(&cfg.AssetBaseURL, "asset-base-url", cfg.AssetBaseURL, "asset CDN base URL for avatar delivery")
 	fs.StringVar(&cfg.AuthAddr, "auth-addr", cfg.AuthAddr, "auth service gRPC address")
@@ -59,6 +71,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	fs.StringVar(&cfg.DBPath, "db-path", cfg.DBPath, "play SQLite database path")
 	fs.StringVar(&cfg.PlayUIDevServerURL, "ui-dev-server-url", cfg.PlayUIDevServerURL, "optional play UI dev server URL")
 	fs.BoolVar(&cfg.TrustForwardedProto, "trust-forwarded-proto", cfg.TrustForwardedProto, "trust X-Forwarded-Proto when resolving request scheme")
+	fs.BoolVar(&cfg.IgnoreExpectedSeq, "ignore-expected-seq", cfg.IgnoreExpectedSeq, "drop X-Expected-Seq write guards when game applies projections through the outbox")
 	if err := entrypoint.ParseArgs(fs, args); err != nil {
 		return Config{}, err
 	}
@@ -77,6 +90,7 @@ func Run(ctx context.Context, cfg Config) error {
 			"play",
 			cfg.StatusAddr,
 			entrypoint.Capability("play.http", platformstatus.Operational),
+			entrypoint.Capability("play.grpc", platformstatus.Operational),
 			entrypoint.Capability("play.game.integration", platformstatus.Operational),
 			entrypoint.Capability("play.auth.integration", platformstatus.Operational),
 			entrypoint.Capability("play.ai.integration", platformstatus.Operational),
@@ -96,16 +110,74 @@ func Run(ctx context.Context, cfg Config) error {
 			PlayUIDevServerURL:  cfg.PlayUIDevServerURL,
 			RequestSchemePolicy: httpx.SchemePolicy{TrustForwardedProto: cfg.TrustForwardedProto},
 			LaunchGrant:         launchGrantCfg,
+			IgnoreExpectedSeq:   cfg.IgnoreExpectedSeq,
 		}, deps)
 		if err != nil {
 			return fmt.Errorf("init play server: %w", err)
 		}
 		defer server.Close()
-		if err := server.ListenAndServe(ctx); err != nil {
-			return fmt.Errorf("serve play: %w", err)
+
+		group, groupCtx := errgroup.WithContext(ctx)
+		group.Go(func() error {
+			if err := server.ListenAndServe(groupCtx); err != nil {
+				return fmt.Errorf("serve play: %w", err)
+			}
+			return nil
+		})
+		group.Go(func() error {
+			if err := serveInternalGRPC(groupCtx, cfg.GRPCAddr, resources.store, parseInternalServiceAllowlist(cfg.InternalServices)); err != nil {
+				return fmt.Errorf("serve play grpc: %w", err)
+			}
+			return nil
+		})
+		return group.Wait()
+	})
+}
+
+// serveInternalGRPC hosts the internal-only transcript API until ctx ends.
+// Browser traffic never reaches this listener; callers must present an
+// allowlisted service identity.
+func serveInternalGRPC(ctx context.Context, addr string, store transcript.Store, allowed map[string]struct{}) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", addr, err)
+	}
+	grpcServer := gogrpc.NewServer(gogrpc.StatsHandler(otelgrpc.NewServerHandler()))
+	healthServer := health.NewServer()
+	playv1.RegisterTranscriptServiceServer(grpcServer, playtranscriptgrpc.NewService(store, allowed))
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("play.v1.TranscriptService", grpc_health_v1.HealthCheckResponse_SERVING)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcServer.Serve(listener)
+	}()
+	select {
+	case <-ctx.Done():
+		healthServer.Shutdown()
+		grpcServer.GracefulStop()
+		<-serveErr
+		return nil
+	case err := <-serveErr:
+		if err != nil && !errors.Is(err, gogrpc.ErrServerStopped) {
+			return err
 		}
 		return nil
-	})
+	}
+}
+
+// parseInternalServiceAllowlist normalizes the comma-separated service IDs
+// allowed to call the internal play gRPC surface.
+func parseInternalServiceAllowlist(raw string) map[string]struct{} {
+	allowlist := make(map[string]struct{})
+	for _, value := range strings.Split(raw, ",") {
+		serviceID := strings.ToLower(strings.TrimSpace(value))
+		if serviceID == "" {
+			continue
+		}
+		allowlist[serviceID] = struct{}{}
+	}
+	return allowlist
 }
 
 // runtimeDependencies keeps the concrete resources opened by cmd/play so they
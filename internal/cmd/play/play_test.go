@@ -262,3 +262,17 @@ func (f *fakeTranscriptStore) Close() error {
 	}
 	return nil
 }
+
+func TestParseInternalServiceAllowlistNormalizesIDs(t *testing.T) {
+	t.Parallel()
+
+	allowed := parseInternalServiceAllowlist(" Worker, ,ai ")
+	if len(allowed) != 2 {
+		t.Fatalf("allowlist = %#v, want two entries", allowed)
+	}
+	for _, id := range []string{"worker", "ai"} {
+		if _, ok := allowed[id]; !ok {
+			t.Fatalf("allowlist missing %q: %#v", id, allowed)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/platform/assets/catalog"
 	entrypoint "github.com/louisbranch/fracturing.space/internal/platform/cmd"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	platformstatus "github.com/louisbranch/fracturing.space/internal/platform/status"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/playlaunchgrant"
 	"github.com/louisbranch/fracturing.space/internal/services/web"
@@ -19,6 +20,7 @@ type Config struct {
 	HTTPAddr            string `env:"FRACTURING_SPACE_WEB_HTTP_ADDR" envDefault:"localhost:8080"`
 	PlayHTTPAddr        string `env:"FRACTURING_SPACE_PLAY_HTTP_ADDR" envDefault:"localhost:8094"`
 	TrustForwardedProto bool   `env:"FRACTURING_SPACE_WEB_TRUST_FORWARDED_PROTO" envDefault:"false"`
+	TrustForwardedFor   bool   `env:"FRACTURING_SPACE_WEB_TRUST_FORWARDED_FOR" envDefault:"false"`
 	AuthAddr            string `env:"FRACTURING_SPACE_AUTH_ADDR"`
 	SocialAddr          string `env:"FRACTURING_SPACE_SOCIAL_ADDR"`
 	GameAddr            string `env:"FRACTURING_SPACE_GAME_ADDR"`
@@ -47,6 +49,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	applyDependencyAddressFlags(fs, &cfg)
 	fs.StringVar(&cfg.AssetBaseURL, "asset-base-url", cfg.AssetBaseURL, "Asset base URL for image delivery")
 	fs.BoolVar(&cfg.TrustForwardedProto, "trust-forwarded-proto", cfg.TrustForwardedProto, "Trust X-Forwarded-Proto when resolving request scheme")
+	fs.BoolVar(&cfg.TrustForwardedFor, "trust-forwarded-for", cfg.TrustForwardedFor, "Trust X-Forwarded-For when keying rate limits by client IP")
 	if err := entrypoint.ParseArgs(fs, args); err != nil {
 		return Config{}, err
 	}
@@ -73,7 +76,15 @@ func Run(ctx context.Context, cfg Config) error {
 			return fmt.Errorf("load play launch grant config: %w", err)
 		}
 
-		server, err := web.NewServer(ctx, cfg.serverConfig(runtimeDeps.bundle, playLaunchGrantCfg))
+		limiter, err := ratelimit.NewFromEnv(web.RateLimits)
+		if err != nil {
+			return fmt.Errorf("configure rate limits: %w", err)
+		}
+		defer limiter.Close()
+
+		serverCfg := cfg.serverConfig(runtimeDeps.bundle, playLaunchGrantCfg)
+		serverCfg.RateLimiter = limiter
+		server, err := web.NewServer(ctx, serverCfg)
 		if err != nil {
 			return fmt.Errorf("init web server: %w", err)
 		}
@@ -94,6 +105,7 @@ func (cfg Config) serverConfig(deps web.DependencyBundle, playLaunchGrant playla
 		Logger:              slog.Default(),
 		PlayLaunchGrant:     playLaunchGrant,
 		RequestSchemePolicy: requestmeta.SchemePolicy{TrustForwardedProto: cfg.TrustForwardedProto},
+		TrustForwardedFor:   cfg.TrustForwardedFor,
 		Dependencies:        &deps,
 	}
 }
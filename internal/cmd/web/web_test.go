@@ -479,7 +479,7 @@ func TestDependencyRequirementsOwnedSurfacesAreExplicit(t *testing.T) {
 		web.DependencyNameDiscovery:     {"discovery"},
 		web.DependencyNameUserHub:       {"dashboard", "dashboard-sync"},
 		web.DependencyNameNotifications: {"principal", "notifications"},
-		web.DependencyNameStatus:        {"dashboard.health"},
+		web.DependencyNameStatus:        {"dashboard.health", "status"},
 	}
 	for name, want := range tests {
 		if !slices.Equal(got[name], want) {
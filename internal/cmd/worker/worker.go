@@ -21,6 +21,7 @@ type Config struct {
 	InviteAddr        string        `env:"FRACTURING_SPACE_WORKER_INVITE_ADDR"`
 	NotificationsAddr string        `env:"FRACTURING_SPACE_WORKER_NOTIFICATIONS_ADDR"`
 	SocialAddr        string        `env:"FRACTURING_SPACE_WORKER_SOCIAL_ADDR"`
+	PlayAddr          string        `env:"FRACTURING_SPACE_WORKER_PLAY_ADDR"`
 	DBPath            string        `env:"FRACTURING_SPACE_WORKER_DB_PATH" envDefault:"data/worker.db"`
 	Consumer          string        `env:"FRACTURING_SPACE_WORKER_CONSUMER" envDefault:"worker-onboarding"`
 	PollInterval      time.Duration `env:"FRACTURING_SPACE_WORKER_POLL_INTERVAL" envDefault:"2s"`
@@ -28,7 +29,10 @@ type Config struct {
 	MaxAttempts       int           `env:"FRACTURING_SPACE_WORKER_MAX_ATTEMPTS" envDefault:"8"`
 	RetryBackoff      time.Duration `env:"FRACTURING_SPACE_WORKER_RETRY_BACKOFF" envDefault:"5s"`
 	RetryMaxDelay     time.Duration `env:"FRACTURING_SPACE_WORKER_RETRY_MAX_DELAY" envDefault:"5m"`
-	StatusAddr        string        `env:"FRACTURING_SPACE_STATUS_ADDR"`
+	// WebhookAllowLoopback lets webhook deliveries reach loopback receivers.
+	// Development only; production must leave it off.
+	WebhookAllowLoopback bool   `env:"FRACTURING_SPACE_WORKER_WEBHOOK_ALLOW_LOOPBACK" envDefault:"false"`
+	StatusAddr           string `env:"FRACTURING_SPACE_STATUS_ADDR"`
 }
 
 // ParseConfig parses environment and flags into a Config.
@@ -43,6 +47,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	cfg.InviteAddr = serviceaddr.OrDefaultGRPCAddr(cfg.InviteAddr, serviceaddr.ServiceInvite)
 	cfg.NotificationsAddr = serviceaddr.OrDefaultGRPCAddr(cfg.NotificationsAddr, serviceaddr.ServiceNotifications)
 	cfg.SocialAddr = serviceaddr.OrDefaultGRPCAddr(cfg.SocialAddr, serviceaddr.ServiceSocial)
+	cfg.PlayAddr = serviceaddr.OrDefaultGRPCAddr(cfg.PlayAddr, serviceaddr.ServicePlay)
 	cfg.StatusAddr = serviceaddr.OrDefaultGRPCAddr(cfg.StatusAddr, serviceaddr.ServiceStatus)
 	fs.IntVar(&cfg.Port, "port", cfg.Port, "The worker health gRPC server port")
 	fs.StringVar(&cfg.AuthAddr, "auth-addr", cfg.AuthAddr, "The auth gRPC server address")
@@ -51,6 +56,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	fs.StringVar(&cfg.InviteAddr, "invite-addr", cfg.InviteAddr, "The invite gRPC server address")
 	fs.StringVar(&cfg.NotificationsAddr, "notifications-addr", cfg.NotificationsAddr, "The notifications gRPC server address")
 	fs.StringVar(&cfg.SocialAddr, "social-addr", cfg.SocialAddr, "The social gRPC server address")
+	fs.StringVar(&cfg.PlayAddr, "play-addr", cfg.PlayAddr, "The play gRPC server address")
 	fs.StringVar(&cfg.DBPath, "db-path", cfg.DBPath, "The worker SQLite database path")
 	fs.StringVar(&cfg.Consumer, "consumer", cfg.Consumer, "Integration outbox consumer name")
 	fs.DurationVar(&cfg.PollInterval, "poll-interval", cfg.PollInterval, "Integration outbox poll interval")
@@ -58,6 +64,7 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	fs.IntVar(&cfg.MaxAttempts, "max-attempts", cfg.MaxAttempts, "Maximum processing attempts before dead-letter")
 	fs.DurationVar(&cfg.RetryBackoff, "retry-backoff", cfg.RetryBackoff, "Base retry backoff delay")
 	fs.DurationVar(&cfg.RetryMaxDelay, "retry-max-delay", cfg.RetryMaxDelay, "Maximum retry delay")
+	fs.BoolVar(&cfg.WebhookAllowLoopback, "webhook-allow-loopback", cfg.WebhookAllowLoopback, "Allow webhook deliveries to loopback addresses (development only)")
 	if err := entrypoint.ParseArgs(fs, args); err != nil {
 		return Config{}, err
 	}
@@ -88,6 +95,7 @@ func Run(ctx context.Context, cfg Config) error {
 			InviteAddr:        cfg.InviteAddr,
 			NotificationsAddr: cfg.NotificationsAddr,
 			SocialAddr:        cfg.SocialAddr,
+			PlayAddr:          cfg.PlayAddr,
 			DBPath:            cfg.DBPath,
 			Consumer:          cfg.Consumer,
 			PollInterval:      cfg.PollInterval,
@@ -95,6 +103,8 @@ func Run(ctx context.Context, cfg Config) error {
 			MaxAttempts:       cfg.MaxAttempts,
 			RetryBackoff:      cfg.RetryBackoff,
 			RetryMaxDelay:     cfg.RetryMaxDelay,
+
+			WebhookAllowLoopback: cfg.WebhookAllowLoopback,
 		})
 	})
 }
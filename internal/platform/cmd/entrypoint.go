@@ -26,6 +26,8 @@ const (
 	ServiceDiscovery     = "discovery"
 	ServiceAuth          = "auth"
 	ServiceGame          = "game"
+	ServiceGateway       = "gateway"
+	ServiceMCP           = "mcp"
 	ServicePlay          = "play"
 	ServiceNotifications = "notifications"
 	ServiceScenario      = "scenario"
@@ -109,20 +111,28 @@ func RunWithTelemetryAndOptions(ctx context.Context, service string, options Run
 	if err != nil {
 		return err
 	}
-	defer func() {
-		shutdownTimeout := options.ShutdownTimeout
-		if shutdownTimeout <= 0 {
-			shutdownTimeout = defaultOTelShutdownTimeout
-		}
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
-		defer cancel()
-		if err := shutdown(shutdownCtx); err != nil {
-			log.Printf("%s otel shutdown: %v", service, err)
-		}
-	}()
+	shutdownTimeout := options.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = defaultOTelShutdownTimeout
+	}
+	defer runShutdown(service, "otel", shutdownTimeout, shutdown)
+
+	shutdownMetrics, err := otel.SetupMetrics(ctx, service)
+	if err != nil {
+		return err
+	}
+	defer runShutdown(service, "metrics", shutdownTimeout, shutdownMetrics)
 	return run(ctx)
 }
 
+func runShutdown(service, name string, timeout time.Duration, shutdown func(context.Context) error) {
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := shutdown(shutdownCtx); err != nil {
+		log.Printf("%s %s shutdown: %v", service, name, err)
+	}
+}
+
 // RunServiceMain standardizes process bootstrap for long-running service
 // binaries.
 func RunServiceMain[T any](options ServiceMainOptions[T]) error {
@@ -58,6 +58,7 @@ const (
 	// Storage errors
 	CodeNotFound            Code = "NOT_FOUND"
 	CodeActiveSessionExists Code = "ACTIVE_SESSION_EXISTS"
+	CodeExpectedSeqMismatch Code = "EXPECTED_SEQ_MISMATCH"
 
 	// Dice/mechanics errors
 	CodeDiceMissing     Code = "DICE_MISSING"
@@ -151,6 +152,7 @@ func (c Code) GRPCCode() codes.Code {
 	case CodeCampaignInvalidStatusTransition,
 		CodeCampaignStatusDisallowsOp,
 		CodeActiveSessionExists,
+		CodeExpectedSeqMismatch,
 		CodeOutcomeAlreadyApplied,
 		CodeSnapshotInsufficientFear,
 		CodeSnapshotGMFearExceedsCap,
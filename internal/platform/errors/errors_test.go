@@ -112,6 +112,7 @@ func TestGRPCCodeMapping(t *testing.T) {
 		{apperrors.CodeCampaignInvalidStatusTransition, codes.FailedPrecondition},
 		{apperrors.CodeNotFound, codes.NotFound},
 		{apperrors.CodeActiveSessionExists, codes.FailedPrecondition},
+		{apperrors.CodeExpectedSeqMismatch, codes.FailedPrecondition},
 		{apperrors.CodeAIOrchestrationInvalidInput, codes.InvalidArgument},
 		{apperrors.CodeAIOrchestrationUnavailable, codes.FailedPrecondition},
 		{apperrors.CodeAIOrchestrationTimedOut, codes.DeadlineExceeded},
@@ -0,0 +1,135 @@
+package errors
+
+import (
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HTTPError is the JSON error body public HTTP surfaces return for a failed
+// gRPC call. It carries the same reason, metadata, and localized message that
+// ToGRPCStatus attaches, so HTTP and gRPC clients see one error contract.
+type HTTPError struct {
+	// Code is the canonical gRPC code name, e.g. "NOT_FOUND".
+	Code string `json:"code"`
+	// Reason is the domain error code when the status carried one.
+	Reason string `json:"reason,omitempty"`
+	// Message is the localized user-facing message when available, otherwise
+	// the status message.
+	Message  string            `json:"message"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// HTTPStatus maps a gRPC status code to the HTTP status public surfaces use.
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// HTTPStatus maps the domain code to an HTTP status through its gRPC code.
+func (c Code) HTTPStatus() int {
+	return HTTPStatus(c.GRPCCode())
+}
+
+// HTTPErrorFromGRPC converts a gRPC error into an HTTP status and body.
+// Internal and unknown failures keep a generic message so storage or
+// dependency details never leak to public callers.
+func HTTPErrorFromGRPC(err error) (int, HTTPError) {
+	st := status.Convert(err)
+	code := st.Code()
+	body := HTTPError{
+		Code:    grpcCodeName(code),
+		Message: st.Message(),
+	}
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		body.Message = "an unexpected error occurred"
+	}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.GetDomain() == Domain {
+				body.Reason = d.GetReason()
+				body.Metadata = d.GetMetadata()
+			}
+		case *errdetails.LocalizedMessage:
+			if msg := strings.TrimSpace(d.GetMessage()); msg != "" {
+				body.Message = msg
+			}
+		}
+	}
+	if body.Reason == string(CodeExpectedSeqMismatch) {
+		// A stale If-Match precondition, not a state conflict.
+		return http.StatusPreconditionFailed, body
+	}
+	return HTTPStatus(code), body
+}
+
+// grpcCodeName returns the canonical upper-snake name of a gRPC code.
+func grpcCodeName(code codes.Code) string {
+	switch code {
+	case codes.OK:
+		return "OK"
+	case codes.Canceled:
+		return "CANCELLED"
+	case codes.Unknown:
+		return "UNKNOWN"
+	case codes.InvalidArgument:
+		return "INVALID_ARGUMENT"
+	case codes.DeadlineExceeded:
+		return "DEADLINE_EXCEEDED"
+	case codes.NotFound:
+		return "NOT_FOUND"
+	case codes.AlreadyExists:
+		return "ALREADY_EXISTS"
+	case codes.PermissionDenied:
+		return "PERMISSION_DENIED"
+	case codes.ResourceExhausted:
+		return "RESOURCE_EXHAUSTED"
+	case codes.FailedPrecondition:
+		return "FAILED_PRECONDITION"
+	case codes.Aborted:
+		return "ABORTED"
+	case codes.OutOfRange:
+		return "OUT_OF_RANGE"
+	case codes.Unimplemented:
+		return "UNIMPLEMENTED"
+	case codes.Internal:
+		return "INTERNAL"
+	case codes.Unavailable:
+		return "UNAVAILABLE"
+	case codes.DataLoss:
+		return "DATA_LOSS"
+	case codes.Unauthenticated:
+		return "UNAUTHENTICATED"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -0,0 +1,76 @@
+package errors_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := map[codes.Code]int{
+		codes.InvalidArgument:    http.StatusBadRequest,
+		codes.Unauthenticated:    http.StatusUnauthorized,
+		codes.PermissionDenied:   http.StatusForbidden,
+		codes.NotFound:           http.StatusNotFound,
+		codes.FailedPrecondition: http.StatusConflict,
+		codes.ResourceExhausted:  http.StatusTooManyRequests,
+		codes.Unavailable:        http.StatusServiceUnavailable,
+		codes.Internal:           http.StatusInternalServerError,
+	}
+	for code, want := range tests {
+		if got := apperrors.HTTPStatus(code); got != want {
+			t.Errorf("HTTPStatus(%v) = %d, want %d", code, got, want)
+		}
+	}
+	if got := apperrors.CodeNotFound.HTTPStatus(); got != http.StatusNotFound {
+		t.Errorf("CodeNotFound.HTTPStatus() = %d, want %d", got, http.StatusNotFound)
+	}
+}
+
+func TestHTTPErrorFromGRPC_DomainError(t *testing.T) {
+	err := apperrors.HandleError(apperrors.WithMetadata(
+		apperrors.CodeCampaignInvalidStatusTransition,
+		"invalid status transition: DRAFT -> COMPLETED",
+		map[string]string{"FromStatus": "DRAFT", "ToStatus": "COMPLETED"},
+	), "en-US")
+
+	httpStatus, body := apperrors.HTTPErrorFromGRPC(err)
+	if httpStatus != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", httpStatus, http.StatusConflict)
+	}
+	if body.Code != "FAILED_PRECONDITION" || body.Reason != string(apperrors.CodeCampaignInvalidStatusTransition) {
+		t.Fatalf("body = %+v", body)
+	}
+	if body.Metadata["FromStatus"] != "DRAFT" {
+		t.Fatalf("metadata = %v, want FromStatus", body.Metadata)
+	}
+	if body.Message == "" || body.Message == "invalid status transition: DRAFT -> COMPLETED" {
+		t.Fatalf("message = %q, want localized message", body.Message)
+	}
+}
+
+func TestHTTPErrorFromGRPC_ExpectedSeqMismatchIsPreconditionFailed(t *testing.T) {
+	err := apperrors.HandleError(apperrors.New(apperrors.CodeExpectedSeqMismatch, "stale"), "en-US")
+	if httpStatus, _ := apperrors.HTTPErrorFromGRPC(err); httpStatus != http.StatusPreconditionFailed {
+		t.Fatalf("status = %d, want %d", httpStatus, http.StatusPreconditionFailed)
+	}
+}
+
+func TestHTTPErrorFromGRPC_HidesInternalDetail(t *testing.T) {
+	httpStatus, body := apperrors.HTTPErrorFromGRPC(status.Error(codes.Internal, "sqlite: disk I/O error"))
+	if httpStatus != http.StatusInternalServerError || body.Code != "INTERNAL" {
+		t.Fatalf("status = %d body = %+v", httpStatus, body)
+	}
+	if body.Message != "an unexpected error occurred" {
+		t.Fatalf("message = %q, want generic message", body.Message)
+	}
+
+	httpStatus, body = apperrors.HTTPErrorFromGRPC(errors.New("plain"))
+	if httpStatus != http.StatusInternalServerError || body.Code != "UNKNOWN" {
+		t.Fatalf("plain error status = %d body = %+v", httpStatus, body)
+	}
+}
@@ -43,6 +43,15 @@ const ServiceIDHeader = "x-fracturing-space-service-id"
 // LocaleHeader is the gRPC metadata key for the caller's preferred locale.
 const LocaleHeader = "x-fracturing-space-locale"
 
+// ExpectedSeqHeader is the gRPC metadata key for optimistic concurrency
+// preconditions: the campaign event seq the caller last observed.
+const ExpectedSeqHeader = "x-fracturing-space-expected-seq"
+
+// ClientIPHeader is the gRPC metadata key for the end-user address an edge
+// service observed, so internal rate limits can bucket by browser rather than
+// by the calling service.
+const ClientIPHeader = "x-fracturing-space-client-ip"
+
 // DefaultLocale is the fallback locale when no locale metadata is present.
 const DefaultLocale = "en-US"
 
@@ -99,6 +108,18 @@ func ServiceIDFromContext(ctx context.Context) string {
 	return metadataValueFromIncomingContext(ctx, ServiceIDHeader)
 }
 
+// ExpectedSeqFromContext returns the raw expected-seq precondition from
+// incoming metadata; parsing stays with the service that enforces it.
+func ExpectedSeqFromContext(ctx context.Context) string {
+	return metadataValueFromIncomingContext(ctx, ExpectedSeqHeader)
+}
+
+// ClientIPFromContext returns the forwarded end-user address from incoming
+// metadata.
+func ClientIPFromContext(ctx context.Context) string {
+	return metadataValueFromIncomingContext(ctx, ClientIPHeader)
+}
+
 // LocaleFromContext returns the caller locale from incoming metadata or the
 // project default when no locale is supplied.
 func LocaleFromContext(ctx context.Context) string {
@@ -67,6 +67,7 @@ func TestIncomingMetadataAccessors(t *testing.T) {
 		UserIDHeader, "user-1",
 		CampaignIDHeader, "campaign-1",
 		SessionIDHeader, "session-1",
+		ExpectedSeqHeader, "42",
 	))
 
 	if ParticipantIDFromContext(ctx) != "participant-1" {
@@ -81,6 +82,9 @@ func TestIncomingMetadataAccessors(t *testing.T) {
 	if SessionIDFromContext(ctx) != "session-1" {
 		t.Fatal("expected session id from metadata")
 	}
+	if ExpectedSeqFromContext(ctx) != "42" {
+		t.Fatal("expected expected seq from metadata")
+	}
 }
 
 func TestEnsureRequestMetadata(t *testing.T) {
@@ -1,5 +1,5 @@
-// Package otel provides opt-in OpenTelemetry distributed tracing for
-// Fracturing Space services.
+// Package otel provides opt-in OpenTelemetry distributed tracing and metrics
+// for Fracturing Space services.
 //
 // Tracing is controlled by two environment variables:
 //
@@ -8,6 +8,18 @@
 //   - FRACTURING_SPACE_OTEL_ENABLED — set to "false" to explicitly disable
 //     tracing even when an endpoint is configured.
 //
-// Call [Setup] early in each service's Run function and defer the returned
-// shutdown to flush pending spans on exit.
+// Metrics are exported in the Prometheus text format from a dedicated
+// listener serving /metrics:
+//
+//   - FRACTURING_SPACE_METRICS_ADDR — listener address shared by every
+//     service (e.g. :9464). When empty, metrics are disabled.
+//   - FRACTURING_SPACE_<SERVICE>_METRICS_ADDR — per-service override, such as
+//     FRACTURING_SPACE_GAME_METRICS_ADDR, for hosts running several services.
+//   - FRACTURING_SPACE_METRICS_ENABLED — set to "false" to explicitly disable
+//     metrics even when an address is configured.
+//
+// Call [Setup] and [SetupMetrics] early in each service's Run function and
+// defer the returned shutdowns to flush pending telemetry on exit. Domain
+// packages declare instruments against the global meter provider, so they
+// record nothing until SetupMetrics installs the SDK provider.
 package otel
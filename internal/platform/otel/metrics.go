@@ -0,0 +1,125 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// MetricsPath is the scrape path served by the metrics listener.
+const MetricsPath = "/metrics"
+
+const metricsReadHeaderTimeout = 5 * time.Second
+
+// SetupMetrics initialises the OpenTelemetry metrics pipeline for the given
+// service and serves a Prometheus scrape endpoint at [MetricsPath].
+//
+// Metrics are opt-in: the listener address comes from
+// FRACTURING_SPACE_<SERVICE>_METRICS_ADDR, falling back to
+// FRACTURING_SPACE_METRICS_ADDR. When neither is set, or
+// FRACTURING_SPACE_METRICS_ENABLED is "false", SetupMetrics returns a no-op
+// shutdown and instruments stay bound to the global no-op meter provider.
+//
+// The listener is dedicated rather than mounted on each service's health
+// surface because most services only expose gRPC health and the HTTP ones are
+// public; see docs/architecture/platform/observability-gaps.md.
+//
+// The returned shutdown function stops the listener and the meter provider and
+// should be deferred by the caller.
+func SetupMetrics(ctx context.Context, serviceName string) (shutdown func(context.Context) error, err error) {
+	noop := func(context.Context) error { return nil }
+
+	if strings.EqualFold(os.Getenv("FRACTURING_SPACE_METRICS_ENABLED"), "false") {
+		return noop, nil
+	}
+	addr := MetricsAddr(serviceName)
+	if addr == "" {
+		return noop, nil
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+	if err != nil {
+		return noop, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(serviceName),
+		),
+	)
+	if err != nil {
+		return noop, err
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return noop, fmt.Errorf("listen for metrics on %s: %w", addr, err)
+	}
+
+	mp := metric.NewMeterProvider(
+		metric.WithReader(exporter),
+		metric.WithResource(res),
+	)
+	otel.SetMeterProvider(mp)
+
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("metrics listener stopped", "service", serviceName, "addr", addr, "error", err)
+		}
+	}()
+	slog.Info("metrics listening", "service", serviceName, "addr", listener.Addr().String(), "path", MetricsPath)
+
+	return func(ctx context.Context) error {
+		return errors.Join(server.Shutdown(ctx), mp.Shutdown(ctx))
+	}, nil
+}
+
+// MetricsAddr resolves the metrics listener address for one service. A
+// service-specific variable lets local runs place every service on its own
+// port while containers share FRACTURING_SPACE_METRICS_ADDR.
+func MetricsAddr(serviceName string) string {
+	service := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(serviceName), "-", "_"))
+	if service != "" {
+		if addr := strings.TrimSpace(os.Getenv("FRACTURING_SPACE_" + service + "_METRICS_ADDR")); addr != "" {
+			return addr
+		}
+	}
+	return strings.TrimSpace(os.Getenv("FRACTURING_SPACE_METRICS_ADDR"))
+}
+
+// Instrument returns inst after routing a creation error to the global
+// OpenTelemetry error handler. Instrument constructors always return a usable
+// (possibly no-op) instrument, so package-level instruments can be declared
+// without failing service startup.
+func Instrument[T any](inst T, err error) T {
+	if err != nil {
+		otel.Handle(err)
+	}
+	return inst
+}
@@ -0,0 +1,101 @@
+package otel_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/otel"
+	globalotel "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestMetricsAddr_PrefersServiceOverride(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_METRICS_ADDR", ":9464")
+	t.Setenv("FRACTURING_SPACE_GAME_METRICS_ADDR", "127.0.0.1:9470")
+
+	if got := otel.MetricsAddr("game"); got != "127.0.0.1:9470" {
+		t.Fatalf("MetricsAddr(game) = %q, want service override", got)
+	}
+	if got := otel.MetricsAddr("worker"); got != ":9464" {
+		t.Fatalf("MetricsAddr(worker) = %q, want shared address", got)
+	}
+}
+
+func TestSetupMetrics_NoopWhenAddrEmpty(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_METRICS_ADDR", "")
+	t.Setenv("FRACTURING_SPACE_METRICS_ENABLED", "")
+
+	shutdown, err := otel.SetupMetrics(context.Background(), "test-service")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown error: %v", err)
+	}
+}
+
+func TestSetupMetrics_NoopWhenExplicitlyDisabled(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_METRICS_ADDR", "127.0.0.1:0")
+	t.Setenv("FRACTURING_SPACE_METRICS_ENABLED", "false")
+
+	shutdown, err := otel.SetupMetrics(context.Background(), "test-service")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown error: %v", err)
+	}
+}
+
+func TestSetupMetrics_ServesRecordedInstruments(t *testing.T) {
+	addr := freeAddr(t)
+	t.Setenv("FRACTURING_SPACE_METRICS_ADDR", "")
+	t.Setenv("FRACTURING_SPACE_METRICS_ENABLED", "")
+	t.Setenv("FRACTURING_SPACE_SCRAPE_TEST_METRICS_ADDR", addr)
+	t.Cleanup(func() { globalotel.SetMeterProvider(noop.NewMeterProvider()) })
+
+	shutdown, err := otel.SetupMetrics(context.Background(), "scrape-test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() {
+		if err := shutdown(context.Background()); err != nil {
+			t.Fatalf("shutdown error: %v", err)
+		}
+	}()
+
+	counter := otel.Instrument(globalotel.Meter("scrape-test").Int64Counter("fracturing.test.scrapes"))
+	counter.Add(context.Background(), 3)
+
+	resp, err := http.Get("http://" + addr + otel.MetricsPath)
+	if err != nil {
+		t.Fatalf("scrape metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read metrics: %v", err)
+	}
+	for _, want := range []string{"fracturing_test_scrapes_total", "go_goroutines"} {
+		if !strings.Contains(string(body), want) {
+			t.Fatalf("metrics body missing %q:\n%s", want, body)
+		}
+	}
+}
+
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	if err := listener.Close(); err != nil {
+		t.Fatalf("close listener: %v", err)
+	}
+	return addr
+}
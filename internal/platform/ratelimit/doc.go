@@ -0,0 +1,21 @@
+// Package ratelimit provides token-bucket throttling for gRPC and HTTP edges.
+//
+// A [Limiter] pairs named rules with a bucket [Store]. Rule names are the
+// full gRPC method (for example "/auth.v1.AuthService/BeginPasskeyLogin") or
+// an HTTP "METHOD /path" pattern, and each rule's [Policy] declares the bucket
+// size, refill rate, and whether buckets are keyed by user ID, client IP, or
+// campaign ID. Unary and stream interceptors reject with RESOURCE_EXHAUSTED and
+// a RetryInfo detail; the HTTP middleware rejects with 429 and Retry-After.
+//
+// Services declare defaults in code and operators adjust them through the
+// environment (see [LoadConfig]):
+//
+//   - FRACTURING_SPACE_RATE_LIMITS — rule overrides such as
+//     "/invite.v1.InviteService/CreateInvite=campaign:30/1h;POST /passkeys/login/start=off".
+//   - FRACTURING_SPACE_RATE_LIMIT_DB_PATH — optional SQLite file shared by
+//     replicas; when empty, buckets live in process memory.
+//   - FRACTURING_SPACE_RATE_LIMIT_ENABLED — set to "false" to disable limiting.
+//
+// Limiting fails open: a store error is logged and the request proceeds, so a
+// throttling outage never becomes a login outage.
+package ratelimit
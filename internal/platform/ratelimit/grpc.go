@@ -0,0 +1,146 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RetryAfterHeader is the response header carrying the retry hint in whole
+// seconds, for gRPC clients that do not decode status details.
+const RetryAfterHeader = "retry-after"
+
+// campaignScoped matches generated request messages that carry a campaign ID.
+type campaignScoped interface {
+	GetCampaignId() string
+}
+
+// viewerScoped and userScoped match generated request messages that name the
+// acting user, for internal callers that do not forward user metadata.
+type viewerScoped interface {
+	GetViewerUserId() string
+}
+
+type userScoped interface {
+	GetUserId() string
+}
+
+// UnaryServerInterceptor enforces rules keyed by full gRPC method name.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if l == nil {
+			return handler(ctx, req)
+		}
+		if err := l.checkGRPC(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor enforces rules when a stream opens. Streams only
+// see metadata at that point, so campaign keys come from the campaign header.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if l == nil {
+			return handler(srv, ss)
+		}
+		if err := l.checkGRPC(ss.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (l *Limiter) checkGRPC(ctx context.Context, method string, req any) error {
+	if _, ok := l.Policy(method); !ok {
+		return nil
+	}
+	decision := l.Allow(ctx, method, grpcSubject(ctx, req))
+	if decision.Allowed {
+		return nil
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, RetryAfterSeconds(decision.RetryAfter)))
+	return resourceExhausted(decision.RetryAfter)
+}
+
+// grpcSubject collects caller attributes from metadata, the request message,
+// and the transport peer. User metadata wins over request fields, while a
+// request's own campaign ID wins over the routing header. A client IP
+// forwarded by an edge service wins over the peer, which is only the caller.
+func grpcSubject(ctx context.Context, req any) Subject {
+	subject := Subject{
+		UserID:     grpcmeta.UserIDFromContext(ctx),
+		CampaignID: grpcmeta.CampaignIDFromContext(ctx),
+	}
+	if subject.UserID == "" {
+		if scoped, ok := req.(viewerScoped); ok {
+			subject.UserID = scoped.GetViewerUserId()
+		} else if scoped, ok := req.(userScoped); ok {
+			subject.UserID = scoped.GetUserId()
+		}
+	}
+	if scoped, ok := req.(campaignScoped); ok && scoped.GetCampaignId() != "" {
+		subject.CampaignID = scoped.GetCampaignId()
+	}
+	if forwarded := grpcmeta.ClientIPFromContext(ctx); forwarded != "" {
+		subject.IP = forwarded
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		subject.IP = hostOnly(p.Addr.String())
+	}
+	return subject
+}
+
+// resourceExhausted builds the rejection status with a RetryInfo detail.
+func resourceExhausted(retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit exceeded; retry after %ss", RetryAfterSeconds(retryAfter)))
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// RetryAfter extracts the retry hint from a rate-limit rejection.
+func RetryAfter(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// RetryAfterSeconds rounds a retry hint up to whole seconds, never below one.
+func RetryAfterSeconds(d time.Duration) string {
+	seconds := int64(math.Ceil(d.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return strconv.FormatInt(seconds, 10)
+}
+
+// hostOnly strips the port from an address when present.
+func hostOnly(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
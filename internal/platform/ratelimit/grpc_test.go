@@ -0,0 +1,157 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type serverStreamStub struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s serverStreamStub) Context() context.Context { return s.ctx }
+
+func peerContext(addr string) context.Context {
+	tcp, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcp})
+}
+
+func TestUnaryServerInterceptorRejectsWithRetryInfo(t *testing.T) {
+	t.Parallel()
+
+	const method = "/game.v1.CampaignService/GetCampaign"
+	limiter := New(NewMemoryStore(), Rules{method: {Key: KeyCampaign, Limit: 1, Period: 30 * time.Second}})
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	calls := 0
+	handler := func(context.Context, any) (any, error) {
+		calls++
+		return "ok", nil
+	}
+	ctx := peerContext("10.0.0.1:5000")
+
+	if _, err := interceptor(ctx, &gamev1.GetCampaignRequest{CampaignId: "c1"}, info, handler); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	_, err := interceptor(ctx, &gamev1.GetCampaignRequest{CampaignId: "c1"}, info, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("second call code = %v, want ResourceExhausted", status.Code(err))
+	}
+	if retry, ok := RetryAfter(err); !ok || retry != 30*time.Second {
+		t.Fatalf("RetryAfter = %v, %t; want 30s", retry, ok)
+	}
+	if _, err := interceptor(ctx, &gamev1.GetCampaignRequest{CampaignId: "c2"}, info, handler); err != nil {
+		t.Fatalf("other campaign: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+}
+
+func TestUnaryServerInterceptorPassesUnlistedMethodsAndNilLimiter(t *testing.T) {
+	t.Parallel()
+
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+	var nilLimiter *Limiter
+	if _, err := nilLimiter.UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/x"}, handler); err != nil {
+		t.Fatalf("nil limiter: %v", err)
+	}
+	limiter := New(NewMemoryStore(), Rules{"/listed": {Key: KeyIP, Limit: 1, Period: time.Minute}})
+	for range 3 {
+		if _, err := limiter.UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/unlisted"}, handler); err != nil {
+			t.Fatalf("unlisted method: %v", err)
+		}
+	}
+}
+
+func TestStreamServerInterceptorKeysByUserMetadata(t *testing.T) {
+	t.Parallel()
+
+	const method = "/ai.v1.AgentService/Stream"
+	limiter := New(NewMemoryStore(), Rules{method: {Key: KeyUser, Limit: 1, Period: time.Minute}})
+	interceptor := limiter.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: method}
+	handler := func(any, grpc.ServerStream) error { return nil }
+	stream := func(userID string) grpc.ServerStream {
+		ctx := metadata.NewIncomingContext(peerContext("10.0.0.1:5000"), metadata.Pairs(grpcmeta.UserIDHeader, userID))
+		return serverStreamStub{ctx: ctx}
+	}
+
+	if err := interceptor(nil, stream("u1"), info, handler); err != nil {
+		t.Fatalf("first stream: %v", err)
+	}
+	if err := interceptor(nil, stream("u1"), info, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("second stream code = %v, want ResourceExhausted", status.Code(err))
+	}
+	if err := interceptor(nil, stream("u2"), info, handler); err != nil {
+		t.Fatalf("other user on same IP: %v", err)
+	}
+}
+
+func TestGRPCSubjectPrefersMetadataUser(t *testing.T) {
+	t.Parallel()
+
+	ctx := peerContext("10.0.0.1:5000")
+	if got := grpcSubject(ctx, &socialv1.SearchUsersRequest{ViewerUserId: "viewer"}); got.UserID != "viewer" || got.IP != "10.0.0.1" {
+		t.Fatalf("subject = %+v, want viewer from request and peer IP", got)
+	}
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(grpcmeta.UserIDHeader, "caller"))
+	if got := grpcSubject(ctx, &authv1.IssueJoinGrantRequest{UserId: "other", CampaignId: "c1"}); got.UserID != "caller" || got.CampaignID != "c1" {
+		t.Fatalf("subject = %+v, want metadata user and request campaign", got)
+	}
+}
+
+func TestGRPCSubjectPrefersForwardedClientIP(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(NewMemoryStore(), Rules{
+		authv1.AuthService_BeginPasskeyLogin_FullMethodName: {Key: KeyIP, Limit: 1, Period: time.Minute},
+	})
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: authv1.AuthService_BeginPasskeyLogin_FullMethodName}
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+	call := func(clientIP string) error {
+		ctx := metadata.NewIncomingContext(peerContext("10.0.0.9:5000"), metadata.Pairs(grpcmeta.ClientIPHeader, clientIP))
+		_, err := interceptor(ctx, &authv1.BeginPasskeyLoginRequest{}, info, handler)
+		return err
+	}
+
+	if err := call("203.0.113.5"); err != nil {
+		t.Fatalf("client A: %v", err)
+	}
+	if err := call("203.0.113.6"); err != nil {
+		t.Fatalf("client B behind same caller: %v", err)
+	}
+	if err := call("203.0.113.5"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("client A second = %v, want %v", status.Code(err), codes.ResourceExhausted)
+	}
+}
+
+func TestRetryAfterIgnoresOtherErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := RetryAfter(status.Error(codes.ResourceExhausted, "quota")); ok {
+		t.Fatal("RetryAfter without RetryInfo = true")
+	}
+	if _, ok := RetryAfter(status.Error(codes.Unavailable, "down")); ok {
+		t.Fatal("RetryAfter for Unavailable = true")
+	}
+	if got := RetryAfterSeconds(100 * time.Millisecond); got != "1" {
+		t.Fatalf("RetryAfterSeconds(100ms) = %q, want 1", got)
+	}
+}
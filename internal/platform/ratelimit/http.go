@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strings"
+)
+
+// HTTPOptions configures how the HTTP middleware identifies callers.
+type HTTPOptions struct {
+	// UserID resolves the authenticated user for user-keyed rules. Nil keys
+	// every request by client IP.
+	UserID func(*http.Request) string
+	// TrustForwardedFor keys by the first X-Forwarded-For address. Enable it
+	// only behind a proxy that overwrites the header, otherwise clients can
+	// pick their own bucket.
+	TrustForwardedFor bool
+}
+
+// HTTPRule returns the rule name the middleware matches for r.
+func HTTPRule(method, path string) string {
+	return method + " " + path
+}
+
+// Middleware enforces rules keyed by "METHOD /path" and rejects with 429 plus
+// a Retry-After header. It returns nil for a nil limiter so callers can chain
+// it unconditionally.
+func (l *Limiter) Middleware(opts HTTPOptions) func(http.Handler) http.Handler {
+	if l == nil {
+		return nil
+	}
+	return func(next http.Handler) http.Handler {
+		if next == nil {
+			next = http.NotFoundHandler()
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rule := HTTPRule(r.Method, r.URL.Path)
+			policy, ok := l.Policy(rule)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			subject := Subject{IP: ClientIP(r, opts.TrustForwardedFor)}
+			// Resolving the user may cost a session lookup, so IP rules skip it.
+			if opts.UserID != nil && policy.Key != KeyIP {
+				subject.UserID = opts.UserID(r)
+			}
+			decision := l.Allow(r.Context(), rule, subject)
+			if !decision.Allowed {
+				w.Header().Set("Retry-After", RetryAfterSeconds(decision.RetryAfter))
+				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ClientIP returns the caller address, preferring the proxy-reported client
+// when trusted.
+func ClientIP(r *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			first, _, _ := strings.Cut(forwarded, ",")
+			if first = strings.TrimSpace(first); first != "" {
+				return first
+			}
+		}
+	}
+	return hostOnly(r.RemoteAddr)
+}
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMiddlewareRejectsWithRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(NewMemoryStore(), Rules{
+		HTTPRule(http.MethodPost, "/passkeys/login/start"): {Key: KeyIP, Limit: 1, Period: 90 * time.Second},
+	})
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	handler := limiter.Middleware(HTTPOptions{})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	serve := func(method, path, remote string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remote
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	if rr := serve(http.MethodPost, "/passkeys/login/start", "10.0.0.1:1234"); rr.Code != http.StatusNoContent {
+		t.Fatalf("first = %d, want %d", rr.Code, http.StatusNoContent)
+	}
+	rr := serve(http.MethodPost, "/passkeys/login/start", "10.0.0.1:4321")
+	if rr.Code != http.StatusTooManyRequests {
+		t.Fatalf("second = %d, want %d", rr.Code, http.StatusTooManyRequests)
+	}
+	if got := rr.Header().Get("Retry-After"); got != "90" {
+		t.Fatalf("Retry-After = %q, want 90", got)
+	}
+	if rr := serve(http.MethodPost, "/passkeys/login/start", "10.0.0.2:1234"); rr.Code != http.StatusNoContent {
+		t.Fatalf("other IP = %d, want %d", rr.Code, http.StatusNoContent)
+	}
+	if rr := serve(http.MethodGet, "/passkeys/login/start", "10.0.0.1:1234"); rr.Code != http.StatusNoContent {
+		t.Fatalf("unlisted method = %d, want %d", rr.Code, http.StatusNoContent)
+	}
+}
+
+func TestMiddlewareKeysByUserAndTrustedForwardedFor(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(NewMemoryStore(), Rules{
+		HTTPRule(http.MethodPost, "/invite"): {Key: KeyUser, Limit: 1, Period: time.Minute},
+		HTTPRule(http.MethodPost, "/login"):  {Key: KeyIP, Limit: 1, Period: time.Minute},
+	})
+	handler := limiter.Middleware(HTTPOptions{
+		UserID:            func(r *http.Request) string { return r.Header.Get("X-Test-User") },
+		TrustForwardedFor: true,
+	})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	serve := func(path, user, forwarded string) int {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("X-Test-User", user)
+		req.Header.Set("X-Forwarded-For", forwarded)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	if code := serve("/invite", "u1", ""); code != http.StatusOK {
+		t.Fatalf("u1 first = %d", code)
+	}
+	if code := serve("/invite", "u2", ""); code != http.StatusOK {
+		t.Fatalf("u2 behind same proxy = %d, want own bucket", code)
+	}
+	if code := serve("/invite", "u1", ""); code != http.StatusTooManyRequests {
+		t.Fatalf("u1 second = %d, want 429", code)
+	}
+	if code := serve("/login", "", "203.0.113.5, 192.0.2.1"); code != http.StatusOK {
+		t.Fatalf("client A = %d", code)
+	}
+	if code := serve("/login", "", "203.0.113.6"); code != http.StatusOK {
+		t.Fatalf("client B behind same proxy = %d, want own bucket", code)
+	}
+	if code := serve("/login", "", "203.0.113.5"); code != http.StatusTooManyRequests {
+		t.Fatalf("client A second = %d, want 429", code)
+	}
+}
+
+func TestMiddlewareNilLimiterIsNil(t *testing.T) {
+	t.Parallel()
+
+	var limiter *Limiter
+	if limiter.Middleware(HTTPOptions{}) != nil {
+		t.Fatal("nil limiter middleware != nil")
+	}
+}
@@ -0,0 +1,167 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/config"
+)
+
+// Subject identifies the caller attributes a rule may bucket by. Transports
+// fill in whatever they know; the rule's KeyKind picks the attribute.
+type Subject struct {
+	UserID string
+	// ClientID is the OAuth client acting for UserID. When set, user buckets
+	// are split per client so one integration cannot drain the user's others.
+	ClientID   string
+	IP         string
+	CampaignID string
+}
+
+// key returns the bucket key for kind, falling back to coarser attributes
+// when the preferred one is absent.
+func (s Subject) key(kind KeyKind) string {
+	userID := strings.TrimSpace(s.UserID)
+	clientID := strings.TrimSpace(s.ClientID)
+	ip := strings.TrimSpace(s.IP)
+	campaignID := strings.TrimSpace(s.CampaignID)
+	if ip == "" {
+		ip = "unknown"
+	}
+	switch kind {
+	case KeyCampaign:
+		if campaignID != "" {
+			return "campaign:" + campaignID
+		}
+		fallthrough
+	case KeyUser:
+		if userID != "" && clientID != "" {
+			return "user:" + userID + "|client:" + clientID
+		}
+		if userID != "" {
+			return "user:" + userID
+		}
+	}
+	return "ip:" + ip
+}
+
+// Limiter applies named rules against a bucket store. A nil *Limiter allows
+// every request, so services can leave limiting unconfigured.
+type Limiter struct {
+	store Store
+	rules Rules
+	now   func() time.Time
+	logf  func(format string, args ...any)
+	close func() error
+}
+
+// New returns a limiter enforcing rules against store.
+func New(store Store, rules Rules) *Limiter {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Limiter{
+		store: store,
+		rules: rules.Merge(nil),
+		now:   time.Now,
+		logf:  log.Printf,
+	}
+}
+
+// Policy returns the enabled policy for rule.
+func (l *Limiter) Policy(rule string) (Policy, bool) {
+	if l == nil {
+		return Policy{}, false
+	}
+	policy := l.rules[rule]
+	if policy == nil {
+		return Policy{}, false
+	}
+	return *policy, true
+}
+
+// Allow spends one token for subject under rule. Requests with no matching
+// rule are allowed, and store failures fail open after logging.
+func (l *Limiter) Allow(ctx context.Context, rule string, subject Subject) Decision {
+	policy, ok := l.Policy(rule)
+	if !ok {
+		return Decision{Allowed: true}
+	}
+	bucket := rule + "|" + subject.key(policy.Key)
+	decision, err := l.store.Take(ctx, bucket, policy, l.now())
+	if err != nil {
+		l.logf("rate limit store failed for %s, allowing request: %v", rule, err)
+		return Decision{Allowed: true}
+	}
+	if !decision.Allowed {
+		recordRejection(ctx, rule, policy.Key)
+	}
+	return decision
+}
+
+// Close releases the backing store when the limiter owns it.
+func (l *Limiter) Close() error {
+	if l == nil || l.close == nil {
+		return nil
+	}
+	return l.close()
+}
+
+// Config is the env-driven limiter configuration shared by every service.
+type Config struct {
+	Enabled bool   `env:"FRACTURING_SPACE_RATE_LIMIT_ENABLED" envDefault:"true"`
+	Rules   string `env:"FRACTURING_SPACE_RATE_LIMITS"`
+	DBPath  string `env:"FRACTURING_SPACE_RATE_LIMIT_DB_PATH"`
+}
+
+// LoadConfig reads limiter configuration from the environment.
+func LoadConfig() (Config, error) {
+	var cfg Config
+	if err := config.ParseEnv(&cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// NewFromConfig builds a limiter from service defaults plus cfg overrides. It
+// returns a nil limiter, which allows everything, when limiting is disabled.
+func NewFromConfig(cfg Config, defaults Rules) (*Limiter, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	overrides, err := ParseRules(cfg.Rules)
+	if err != nil {
+		return nil, err
+	}
+	for name, policy := range defaults {
+		if policy == nil {
+			continue
+		}
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("rate limit rule %q: %w", name, err)
+		}
+	}
+	if strings.TrimSpace(cfg.DBPath) == "" {
+		return New(NewMemoryStore(), defaults.Merge(overrides)), nil
+	}
+	store, err := OpenSQLiteStore(cfg.DBPath)
+	if err != nil {
+		return nil, fmt.Errorf("open rate limit store: %w", err)
+	}
+	limiter := New(store, defaults.Merge(overrides))
+	limiter.close = store.Close
+	return limiter, nil
+}
+
+// NewFromEnv loads configuration from the environment and builds a limiter
+// over the given service defaults.
+func NewFromEnv(defaults Rules) (*Limiter, error) {
+	cfg, err := LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("load rate limit config: %w", err)
+	}
+	return NewFromConfig(cfg, defaults)
+}
@@ -0,0 +1,134 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Policy, time.Time) (Decision, error) {
+	return Decision{}, errors.New("disk full")
+}
+
+func TestSubjectKeyFallsBackToCoarserAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		subject Subject
+		kind    KeyKind
+		want    string
+	}{
+		{Subject{UserID: "u1", IP: "10.0.0.1", CampaignID: "c1"}, KeyCampaign, "campaign:c1"},
+		{Subject{UserID: "u1", IP: "10.0.0.1"}, KeyCampaign, "user:u1"},
+		{Subject{IP: "10.0.0.1"}, KeyCampaign, "ip:10.0.0.1"},
+		{Subject{UserID: "u1", IP: "10.0.0.1"}, KeyUser, "user:u1"},
+		{Subject{UserID: "u1", ClientID: "cli", IP: "10.0.0.1"}, KeyUser, "user:u1|client:cli"},
+		{Subject{ClientID: "cli", IP: "10.0.0.1"}, KeyUser, "ip:10.0.0.1"},
+		{Subject{IP: "10.0.0.1"}, KeyUser, "ip:10.0.0.1"},
+		{Subject{UserID: "u1", IP: "10.0.0.1"}, KeyIP, "ip:10.0.0.1"},
+		{Subject{}, KeyIP, "ip:unknown"},
+	}
+	for _, tc := range tests {
+		if got := tc.subject.key(tc.kind); got != tc.want {
+			t.Fatalf("%+v.key(%s) = %q, want %q", tc.subject, tc.kind, got, tc.want)
+		}
+	}
+}
+
+func TestLimiterAllowsUnknownRulesAndNilLimiter(t *testing.T) {
+	t.Parallel()
+
+	var nilLimiter *Limiter
+	if d := nilLimiter.Allow(context.Background(), "any", Subject{}); !d.Allowed {
+		t.Fatalf("nil limiter decision = %+v, want allowed", d)
+	}
+	limiter := New(NewMemoryStore(), Rules{"known": {Key: KeyIP, Limit: 1, Period: time.Minute}})
+	for range 3 {
+		if d := limiter.Allow(context.Background(), "unknown", Subject{}); !d.Allowed {
+			t.Fatalf("unknown rule decision = %+v, want allowed", d)
+		}
+	}
+}
+
+func TestLimiterPartitionsBucketsByRuleAndSubject(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(NewMemoryStore(), Rules{
+		"a": {Key: KeyUser, Limit: 1, Period: time.Minute},
+		"b": {Key: KeyUser, Limit: 1, Period: time.Minute},
+	})
+	ctx := context.Background()
+	if !limiter.Allow(ctx, "a", Subject{UserID: "u1"}).Allowed {
+		t.Fatal("first request for u1 rejected")
+	}
+	if limiter.Allow(ctx, "a", Subject{UserID: "u1"}).Allowed {
+		t.Fatal("second request for u1 allowed")
+	}
+	if !limiter.Allow(ctx, "a", Subject{UserID: "u2"}).Allowed {
+		t.Fatal("u2 shares u1's bucket")
+	}
+	if !limiter.Allow(ctx, "b", Subject{UserID: "u1"}).Allowed {
+		t.Fatal("rule b shares rule a's bucket")
+	}
+}
+
+func TestLimiterFailsOpenOnStoreError(t *testing.T) {
+	t.Parallel()
+
+	limiter := New(failingStore{}, Rules{"a": {Key: KeyIP, Limit: 1, Period: time.Minute}})
+	var logged bool
+	limiter.logf = func(string, ...any) { logged = true }
+	if d := limiter.Allow(context.Background(), "a", Subject{}); !d.Allowed {
+		t.Fatalf("decision = %+v, want fail-open allow", d)
+	}
+	if !logged {
+		t.Fatal("store failure was not logged")
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Parallel()
+
+	defaults := Rules{
+		"a": {Key: KeyIP, Limit: 1, Period: time.Minute},
+		"b": {Key: KeyIP, Limit: 1, Period: time.Minute},
+	}
+
+	disabled, err := NewFromConfig(Config{Enabled: false}, defaults)
+	if err != nil || disabled != nil {
+		t.Fatalf("disabled = %v, %v; want nil limiter", disabled, err)
+	}
+
+	limiter, err := NewFromConfig(Config{Enabled: true, Rules: "a=off;c=user:5/1h"}, defaults)
+	if err != nil {
+		t.Fatalf("NewFromConfig: %v", err)
+	}
+	if _, ok := limiter.Policy("a"); ok {
+		t.Fatal("override did not disable rule a")
+	}
+	if p, ok := limiter.Policy("c"); !ok || p.Limit != 5 || p.Period != time.Hour {
+		t.Fatalf("rule c = %+v, %t", p, ok)
+	}
+
+	if _, err := NewFromConfig(Config{Enabled: true, Rules: "broken"}, defaults); err == nil {
+		t.Fatal("NewFromConfig with malformed overrides error = nil")
+	}
+	if _, err := NewFromConfig(Config{Enabled: true}, Rules{"bad": {Key: KeyIP}}); err == nil {
+		t.Fatal("NewFromConfig with invalid default error = nil")
+	}
+
+	shared, err := NewFromConfig(Config{Enabled: true, DBPath: filepath.Join(t.TempDir(), "rl.db")}, defaults)
+	if err != nil {
+		t.Fatalf("NewFromConfig sqlite: %v", err)
+	}
+	if _, ok := shared.store.(*SQLiteStore); !ok {
+		t.Fatalf("store = %T, want *SQLiteStore", shared.store)
+	}
+	if err := shared.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memorySweepEvery is how many takes pass between sweeps of idle buckets.
+const memorySweepEvery = 1024
+
+type memoryBucket struct {
+	tokens float64
+	last   time.Time
+	// fill is how long this bucket's policy takes to refill completely.
+	fill time.Duration
+}
+
+// MemoryStore keeps buckets in process memory. It suits single-replica
+// deployments and tests; replicas each enforce their own budget.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	takes   int
+}
+
+// NewMemoryStore returns an empty in-memory bucket store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+// Take spends one token from bucket when available.
+func (s *MemoryStore) Take(_ context.Context, bucket string, policy Policy, now time.Time) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.takes++
+	if s.takes%memorySweepEvery == 0 {
+		s.sweep(now)
+	}
+
+	b, ok := s.buckets[bucket]
+	if !ok {
+		b = &memoryBucket{tokens: float64(policy.Capacity()), last: now}
+		s.buckets[bucket] = b
+	}
+	b.fill = policy.fillDuration()
+	available := refill(b.tokens, b.last, policy, now)
+	remaining := available
+	if available >= 1 {
+		remaining--
+	}
+	if now.After(b.last) {
+		b.last = now
+	}
+	b.tokens = remaining
+	return decide(available, remaining, policy), nil
+}
+
+// sweep drops buckets that have been idle long enough to refill, since a
+// fresh bucket behaves identically. Callers must hold s.mu.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if now.Sub(b.last) >= b.fill {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// len returns the number of tracked buckets.
+func (s *MemoryStore) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
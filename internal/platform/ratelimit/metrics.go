@@ -0,0 +1,27 @@
+package ratelimit
+
+import (
+	"context"
+
+	platformotel "github.com/louisbranch/fracturing.space/internal/platform/otel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+
+var rejections = platformotel.Instrument(otel.Meter(meterName).Int64Counter(
+	"fracturing.ratelimit.rejections",
+	metric.WithDescription("Requests rejected by rate limit rules."),
+	metric.WithUnit("{request}"),
+))
+
+// recordRejection counts one rejected request. Rule names come from
+// configuration rather than callers, so the attribute set stays bounded.
+func recordRejection(ctx context.Context, rule string, key KeyKind) {
+	rejections.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("ratelimit.rule", rule),
+		attribute.String("ratelimit.key", string(key)),
+	))
+}
@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS contains embedded SQLite migrations for shared rate-limit buckets.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,176 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KeyKind selects which caller attribute partitions a rule's buckets.
+type KeyKind string
+
+const (
+	// KeyUser buckets by authenticated user ID, falling back to client IP for
+	// anonymous callers.
+	KeyUser KeyKind = "user"
+	// KeyIP buckets by client IP address.
+	KeyIP KeyKind = "ip"
+	// KeyCampaign buckets by campaign ID, falling back to user ID and then
+	// client IP when a request names no campaign.
+	KeyCampaign KeyKind = "campaign"
+)
+
+// Policy is one token-bucket configuration. Limit tokens refill evenly over
+// Period, and Burst caps how many may accumulate; zero Burst means Limit.
+type Policy struct {
+	Key    KeyKind
+	Limit  int
+	Period time.Duration
+	Burst  int
+}
+
+// Capacity returns the maximum number of tokens a bucket can hold.
+func (p Policy) Capacity() int {
+	if p.Burst > 0 {
+		return p.Burst
+	}
+	return p.Limit
+}
+
+// refillPerSecond returns the token refill rate.
+func (p Policy) refillPerSecond() float64 {
+	return float64(p.Limit) / p.Period.Seconds()
+}
+
+// fillDuration returns how long an empty bucket takes to refill completely.
+// Buckets idle longer than this are indistinguishable from new ones.
+func (p Policy) fillDuration() time.Duration {
+	return time.Duration(float64(p.Capacity()) / p.refillPerSecond() * float64(time.Second))
+}
+
+// Validate reports whether the policy can drive a token bucket.
+func (p Policy) Validate() error {
+	switch p.Key {
+	case KeyUser, KeyIP, KeyCampaign:
+	default:
+		return fmt.Errorf("unknown key kind %q", p.Key)
+	}
+	if p.Limit <= 0 {
+		return fmt.Errorf("limit must be positive")
+	}
+	if p.Period <= 0 {
+		return fmt.Errorf("period must be positive")
+	}
+	if p.Burst < 0 {
+		return fmt.Errorf("burst must not be negative")
+	}
+	return nil
+}
+
+// String renders the policy in the same form ParseRules accepts.
+func (p Policy) String() string {
+	s := fmt.Sprintf("%s:%d/%s", p.Key, p.Limit, p.Period)
+	if p.Burst > 0 {
+		s += ":" + strconv.Itoa(p.Burst)
+	}
+	return s
+}
+
+// Rules maps rule names to policies. A nil policy value disables a rule that
+// an earlier layer declared.
+type Rules map[string]*Policy
+
+// Merge returns a copy of r with overrides applied on top.
+func (r Rules) Merge(overrides Rules) Rules {
+	merged := make(Rules, len(r)+len(overrides))
+	for name, policy := range r {
+		merged[name] = policy
+	}
+	for name, policy := range overrides {
+		merged[name] = policy
+	}
+	return merged
+}
+
+// Names returns the enabled rule names in sorted order.
+func (r Rules) Names() []string {
+	names := make([]string, 0, len(r))
+	for name, policy := range r {
+		if policy != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseRules parses semicolon-separated "name=spec" entries, where spec is
+// "off" or "<key>:<limit>/<period>[:<burst>]", e.g.
+// "/social.v1.SocialService/SearchUsers=user:60/1m:20". Periods accept Go
+// durations, and a bare unit such as "m" means one of that unit.
+func ParseRules(raw string) (Rules, error) {
+	rules := Rules{}
+	for _, entry := range strings.Split(raw, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, spec, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("rate limit rule %q: want name=spec", entry)
+		}
+		spec = strings.TrimSpace(spec)
+		if strings.EqualFold(spec, "off") {
+			rules[name] = nil
+			continue
+		}
+		policy, err := parsePolicy(spec)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit rule %q: %w", name, err)
+		}
+		rules[name] = &policy
+	}
+	return rules, nil
+}
+
+func parsePolicy(spec string) (Policy, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Policy{}, fmt.Errorf("want <key>:<limit>/<period>[:<burst>], got %q", spec)
+	}
+	policy := Policy{Key: KeyKind(strings.ToLower(strings.TrimSpace(parts[0])))}
+
+	limit, period, ok := strings.Cut(parts[1], "/")
+	if !ok {
+		return Policy{}, fmt.Errorf("want <limit>/<period>, got %q", parts[1])
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(limit))
+	if err != nil {
+		return Policy{}, fmt.Errorf("parse limit: %w", err)
+	}
+	policy.Limit = n
+
+	period = strings.TrimSpace(period)
+	if period != "" && (period[0] < '0' || period[0] > '9') {
+		period = "1" + period
+	}
+	policy.Period, err = time.ParseDuration(period)
+	if err != nil {
+		return Policy{}, fmt.Errorf("parse period: %w", err)
+	}
+
+	if len(parts) == 3 {
+		burst, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return Policy{}, fmt.Errorf("parse burst: %w", err)
+		}
+		policy.Burst = burst
+	}
+	if err := policy.Validate(); err != nil {
+		return Policy{}, err
+	}
+	return policy, nil
+}
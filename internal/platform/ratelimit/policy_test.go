@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRules(t *testing.T) {
+	t.Parallel()
+
+	rules, err := ParseRules(" /auth.v1.AuthService/BeginPasskeyLogin=ip:10/1m ; POST /passkeys/login/start=off;/social.v1.SocialService/SearchUsers=user:60/m:20;")
+	if err != nil {
+		t.Fatalf("ParseRules: %v", err)
+	}
+	if got := rules["/auth.v1.AuthService/BeginPasskeyLogin"]; got == nil || *got != (Policy{Key: KeyIP, Limit: 10, Period: time.Minute}) {
+		t.Fatalf("login rule = %+v", got)
+	}
+	if got, ok := rules["POST /passkeys/login/start"]; !ok || got != nil {
+		t.Fatalf("disabled rule = %+v, %t; want explicit nil", got, ok)
+	}
+	search := rules["/social.v1.SocialService/SearchUsers"]
+	if search == nil || search.Period != time.Minute || search.Capacity() != 20 {
+		t.Fatalf("search rule = %+v", search)
+	}
+	if got := search.String(); got != "user:60/1m0s:20" {
+		t.Fatalf("String() = %q", got)
+	}
+}
+
+func TestParseRulesRejectsMalformedEntries(t *testing.T) {
+	t.Parallel()
+
+	for _, raw := range []string{
+		"no-spec",
+		"=ip:1/1m",
+		"x=ip:1",
+		"x=planet:1/1m",
+		"x=ip:0/1m",
+		"x=ip:1/0s",
+		"x=ip:1/1m:-1",
+		"x=ip:1/1m:2:3",
+		"x=ip:one/1m",
+		"x=ip:1/fortnight",
+	} {
+		if _, err := ParseRules(raw); err == nil {
+			t.Fatalf("ParseRules(%q) error = nil, want error", raw)
+		}
+	}
+}
+
+func TestRulesMergeDisablesWithNil(t *testing.T) {
+	t.Parallel()
+
+	base := Rules{
+		"a": {Key: KeyIP, Limit: 1, Period: time.Second},
+		"b": {Key: KeyIP, Limit: 1, Period: time.Second},
+	}
+	merged := base.Merge(Rules{"b": nil, "c": {Key: KeyUser, Limit: 2, Period: time.Second}})
+	if got := merged.Names(); len(got) != 2 || got[0] != "a" || got[1] != "c" {
+		t.Fatalf("Names() = %v, want [a c]", got)
+	}
+	if base["b"] == nil {
+		t.Fatal("Merge mutated the receiver")
+	}
+}
@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit/migrations"
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteconn"
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqlitemigrate"
+)
+
+// sqlitePruneEvery is how many takes pass between deletes of expired buckets.
+const sqlitePruneEvery = 1024
+
+// takeSQL refills and spends a bucket in one statement so concurrent replicas
+// serialize on SQLite's write lock instead of racing a read-modify-write. SET
+// expressions all see the pre-update row, so "available" is recomputed inline
+// and mirrors refill in store.go:
+//
+//	available = MIN(capacity, tokens + elapsed_ms * refill_per_ms)
+//
+// ?1 bucket, ?2 capacity, ?3 now (unix ms), ?4 refill per ms, ?5 expires_at.
+const takeSQL = `
+INSERT INTO rate_limit_buckets (bucket, tokens, allowed, updated_at, expires_at)
+VALUES (?1, ?2 - 1, 1, ?3, ?5)
+ON CONFLICT(bucket) DO UPDATE SET
+    tokens = CASE
+        WHEN MIN(?2, tokens + MAX(?3 - updated_at, 0) * ?4) >= 1
+        THEN MIN(?2, tokens + MAX(?3 - updated_at, 0) * ?4) - 1
+        ELSE MIN(?2, tokens + MAX(?3 - updated_at, 0) * ?4)
+    END,
+    allowed = MIN(?2, tokens + MAX(?3 - updated_at, 0) * ?4) >= 1,
+    updated_at = MAX(updated_at, ?3),
+    expires_at = ?5
+RETURNING tokens, allowed`
+
+// SQLiteStore keeps buckets in a SQLite file so replicas on one host, or on a
+// shared volume, enforce a single budget.
+type SQLiteStore struct {
+	sqlDB *sql.DB
+	takes atomic.Uint64
+}
+
+// OpenSQLiteStore opens a SQLite-backed bucket store and applies migrations.
+func OpenSQLiteStore(path string) (*SQLiteStore, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, fmt.Errorf("storage path is required")
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create storage dir: %w", err)
+		}
+	}
+	sqlDB, err := sqliteconn.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlitemigrate.ApplyMigrations(sqlDB, migrations.FS, "", time.Now); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("run migrations: %w", err)
+	}
+	return &SQLiteStore{sqlDB: sqlDB}, nil
+}
+
+// Close closes the SQLite handle.
+func (s *SQLiteStore) Close() error {
+	if s == nil || s.sqlDB == nil {
+		return nil
+	}
+	return s.sqlDB.Close()
+}
+
+// Take spends one token from bucket when available.
+func (s *SQLiteStore) Take(ctx context.Context, bucket string, policy Policy, now time.Time) (Decision, error) {
+	if s.takes.Add(1)%sqlitePruneEvery == 0 {
+		if err := s.prune(ctx, now); err != nil {
+			return Decision{}, err
+		}
+	}
+
+	nowMillis := now.UnixMilli()
+	expiresAt := now.Add(policy.fillDuration()).UnixMilli()
+	var (
+		tokens  float64
+		allowed bool
+	)
+	err := s.sqlDB.QueryRowContext(ctx, takeSQL,
+		bucket,
+		float64(policy.Capacity()),
+		nowMillis,
+		policy.refillPerSecond()/1000,
+		expiresAt,
+	).Scan(&tokens, &allowed)
+	if err != nil {
+		return Decision{}, fmt.Errorf("take rate limit token: %w", err)
+	}
+	if allowed {
+		return decide(tokens+1, tokens, policy), nil
+	}
+	return decide(tokens, tokens, policy), nil
+}
+
+// prune deletes buckets idle long enough to have refilled completely.
+func (s *SQLiteStore) prune(ctx context.Context, now time.Time) error {
+	if _, err := s.sqlDB.ExecContext(ctx,
+		`DELETE FROM rate_limit_buckets WHERE expires_at <= ?`, now.UnixMilli(),
+	); err != nil {
+		return fmt.Errorf("prune rate limit buckets: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Decision is the outcome of taking one token from a bucket.
+type Decision struct {
+	Allowed bool
+	// Remaining is the number of whole tokens left after this request.
+	Remaining int
+	// RetryAfter is how long until the next token is available; zero when
+	// the request was allowed.
+	RetryAfter time.Duration
+}
+
+// Store keeps token-bucket state. Take must be atomic per bucket so that
+// concurrent callers, including other replicas sharing the store, never spend
+// the same token twice.
+type Store interface {
+	Take(ctx context.Context, bucket string, policy Policy, now time.Time) (Decision, error)
+}
+
+// refill returns the tokens available at now for a bucket that held tokens at
+// last, capped at the policy capacity.
+func refill(tokens float64, last time.Time, policy Policy, now time.Time) float64 {
+	if elapsed := now.Sub(last); elapsed > 0 {
+		tokens += elapsed.Seconds() * policy.refillPerSecond()
+	}
+	return math.Min(tokens, float64(policy.Capacity()))
+}
+
+// decide builds the decision for a take that found available tokens and left
+// remaining tokens behind.
+func decide(available, remaining float64, policy Policy) Decision {
+	if available >= 1 {
+		return Decision{Allowed: true, Remaining: int(math.Floor(remaining))}
+	}
+	wait := (1 - available) / policy.refillPerSecond()
+	return Decision{RetryAfter: time.Duration(math.Ceil(wait * float64(time.Second)))}
+}
@@ -0,0 +1,143 @@
+package ratelimit
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func storeFactories(t *testing.T) map[string]func() Store {
+	t.Helper()
+	return map[string]func() Store{
+		"memory": func() Store { return NewMemoryStore() },
+		"sqlite": func() Store {
+			store, err := OpenSQLiteStore(filepath.Join(t.TempDir(), "ratelimit.db"))
+			if err != nil {
+				t.Fatalf("OpenSQLiteStore: %v", err)
+			}
+			t.Cleanup(func() { _ = store.Close() })
+			return store
+		},
+	}
+}
+
+func TestStoresSpendAndRefillTokens(t *testing.T) {
+	t.Parallel()
+
+	policy := Policy{Key: KeyIP, Limit: 2, Period: 10 * time.Second, Burst: 3}
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	for name, newStore := range storeFactories(t) {
+		t.Run(name, func(t *testing.T) {
+			store := newStore()
+			ctx := context.Background()
+
+			for i, wantRemaining := range []int{2, 1, 0} {
+				d, err := store.Take(ctx, "bucket", policy, base)
+				if err != nil {
+					t.Fatalf("Take %d: %v", i, err)
+				}
+				if !d.Allowed || d.Remaining != wantRemaining {
+					t.Fatalf("Take %d = %+v, want allowed with %d remaining", i, d, wantRemaining)
+				}
+			}
+
+			denied, err := store.Take(ctx, "bucket", policy, base.Add(time.Second))
+			if err != nil {
+				t.Fatalf("Take denied: %v", err)
+			}
+			// One second refills 0.2 tokens at 2 per 10s; 0.8 more needs 4s.
+			if denied.Allowed || denied.RetryAfter != 4*time.Second {
+				t.Fatalf("denied = %+v, want rejection with 4s retry", denied)
+			}
+
+			// The rejection spends nothing, so 5s total refills exactly one token.
+			allowed, err := store.Take(ctx, "bucket", policy, base.Add(5*time.Second))
+			if err != nil {
+				t.Fatalf("Take after refill: %v", err)
+			}
+			if !allowed.Allowed || allowed.Remaining != 0 {
+				t.Fatalf("after refill = %+v, want allowed with 0 remaining", allowed)
+			}
+
+			other, err := store.Take(ctx, "other", policy, base.Add(5*time.Second))
+			if err != nil {
+				t.Fatalf("Take other: %v", err)
+			}
+			if !other.Allowed || other.Remaining != 2 {
+				t.Fatalf("other bucket = %+v, want independent full bucket", other)
+			}
+		})
+	}
+}
+
+func TestStoresCapRefillAtCapacity(t *testing.T) {
+	t.Parallel()
+
+	policy := Policy{Key: KeyIP, Limit: 1, Period: time.Second}
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	for name, newStore := range storeFactories(t) {
+		t.Run(name, func(t *testing.T) {
+			store := newStore()
+			ctx := context.Background()
+			if _, err := store.Take(ctx, "bucket", policy, base); err != nil {
+				t.Fatalf("Take: %v", err)
+			}
+			if _, err := store.Take(ctx, "bucket", policy, base.Add(time.Hour)); err != nil {
+				t.Fatalf("Take: %v", err)
+			}
+			d, err := store.Take(ctx, "bucket", policy, base.Add(time.Hour))
+			if err != nil {
+				t.Fatalf("Take: %v", err)
+			}
+			if d.Allowed {
+				t.Fatalf("decision = %+v, want idle time capped at one token", d)
+			}
+		})
+	}
+}
+
+func TestSQLiteStoreSharesBucketsAcrossHandles(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "ratelimit.db")
+	first, err := OpenSQLiteStore(path)
+	if err != nil {
+		t.Fatalf("OpenSQLiteStore: %v", err)
+	}
+	defer first.Close()
+	second, err := OpenSQLiteStore(path)
+	if err != nil {
+		t.Fatalf("OpenSQLiteStore: %v", err)
+	}
+	defer second.Close()
+
+	policy := Policy{Key: KeyUser, Limit: 1, Period: time.Minute}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	if d, err := first.Take(context.Background(), "shared", policy, now); err != nil || !d.Allowed {
+		t.Fatalf("first replica = %+v, %v; want allowed", d, err)
+	}
+	if d, err := second.Take(context.Background(), "shared", policy, now); err != nil || d.Allowed {
+		t.Fatalf("second replica = %+v, %v; want rejected by shared bucket", d, err)
+	}
+}
+
+func TestMemoryStoreSweepsRefilledBuckets(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+	policy := Policy{Key: KeyIP, Limit: 1, Period: time.Second}
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	if _, err := store.Take(ctx, "idle", policy, base); err != nil {
+		t.Fatalf("Take: %v", err)
+	}
+	for i := 1; i < memorySweepEvery; i++ {
+		if _, err := store.Take(ctx, "busy", policy, base.Add(time.Minute)); err != nil {
+			t.Fatalf("Take: %v", err)
+		}
+	}
+	if got := store.len(); got != 1 {
+		t.Fatalf("tracked buckets = %d, want idle bucket swept", got)
+	}
+}
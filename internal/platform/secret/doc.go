@@ -0,0 +1,11 @@
+// Package secret defines the encryption seam for service secrets at rest.
+//
+// The Sealer interface provides Seal/Open operations used by service layers
+// to encrypt values before persistence: AI credential API keys and
+// provider-grant OAuth tokens, and game webhook signing secrets.
+// AESGCMSealer is the single-key primitive; Keyring is the runtime sealer and
+// supports multiple decrypt keys with one active encrypt key so stored values
+// can be rotated without downtime. ResealStored is the shared re-seal pass
+// each service's maintenance command runs over its own tables after a
+// rotation.
+package secret
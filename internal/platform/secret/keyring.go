@@ -0,0 +1,270 @@
+package secret
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// keyedPrefix marks sealed values that carry the ID of the key that sealed
+// them. Raw base64 never contains ':', so legacy unkeyed payloads can never be
+// mistaken for keyed ones.
+const keyedPrefix = "v1:"
+
+// DefaultKeyID names the key configured through the single-key legacy
+// setting so existing deployments join the keyring without renaming anything.
+const DefaultKeyID = "default"
+
+// Keyring seals with one active key and opens with any configured key.
+//
+// Sealed values have the form "v1:<key-id>:<payload>" where payload matches
+// the AESGCMSealer encoding. Values sealed before key IDs existed are opened by
+// trying every configured key; GCM authentication rejects the wrong ones.
+type Keyring struct {
+	activeID string
+	sealers  map[string]*AESGCMSealer
+	ids      []string
+}
+
+// NewKeyring builds a keyring from raw AES keys indexed by key ID. The active
+// key seals new values; every key remains available for opening.
+func NewKeyring(activeKeyID string, keys map[string][]byte) (*Keyring, error) {
+	activeKeyID = strings.TrimSpace(activeKeyID)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one key is required")
+	}
+	if activeKeyID == "" {
+		return nil, fmt.Errorf("active key id is required")
+	}
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %q is not configured", activeKeyID)
+	}
+
+	ring := &Keyring{
+		activeID: activeKeyID,
+		sealers:  make(map[string]*AESGCMSealer, len(keys)),
+		ids:      make([]string, 0, len(keys)),
+	}
+	for id, key := range keys {
+		if err := validateKeyID(id); err != nil {
+			return nil, err
+		}
+		sealer, err := NewAESGCMSealer(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+		ring.sealers[id] = sealer
+		ring.ids = append(ring.ids, id)
+	}
+	// Legacy opens try the active key first, then the rest in a stable order.
+	sort.Slice(ring.ids, func(i, j int) bool {
+		if ring.ids[i] == activeKeyID || ring.ids[j] == activeKeyID {
+			return ring.ids[i] == activeKeyID
+		}
+		return ring.ids[i] < ring.ids[j]
+	})
+	return ring, nil
+}
+
+// ActiveKeyID returns the ID of the key used to seal new values.
+func (r *Keyring) ActiveKeyID() string {
+	if r == nil {
+		return ""
+	}
+	return r.activeID
+}
+
+// Seal encrypts one plaintext value under the active key.
+func (r *Keyring) Seal(value string) (string, error) {
+	if r == nil || r.sealers[r.activeID] == nil {
+		return "", fmt.Errorf("sealer is not configured")
+	}
+	payload, err := r.sealers[r.activeID].Seal(value)
+	if err != nil {
+		return "", err
+	}
+	return keyedPrefix + r.activeID + ":" + payload, nil
+}
+
+// Open decrypts one value sealed by this keyring or by a legacy single-key
+// sealer using any configured key.
+func (r *Keyring) Open(sealed string) (string, error) {
+	if r == nil || len(r.sealers) == 0 {
+		return "", fmt.Errorf("sealer is not configured")
+	}
+	keyID, payload, keyed, err := splitSealed(sealed)
+	if err != nil {
+		return "", err
+	}
+	if keyed {
+		sealer, ok := r.sealers[keyID]
+		if !ok {
+			return "", fmt.Errorf("sealed value uses unknown key %q", keyID)
+		}
+		return sealer.Open(payload)
+	}
+
+	var lastErr error
+	for _, id := range r.ids {
+		plaintext, err := r.sealers[id].Open(payload)
+		if err == nil {
+			return plaintext, nil
+		}
+		lastErr = err
+	}
+	return "", lastErr
+}
+
+// NeedsReseal reports whether a sealed value was produced by anything other
+// than the active key, including legacy unkeyed values.
+func (r *Keyring) NeedsReseal(sealed string) bool {
+	keyID, _, keyed, err := splitSealed(sealed)
+	if err != nil || !keyed {
+		return true
+	}
+	return keyID != r.ActiveKeyID()
+}
+
+// Reseal opens a sealed value and seals it again under the active key.
+func (r *Keyring) Reseal(sealed string) (string, error) {
+	plaintext, err := r.Open(sealed)
+	if err != nil {
+		return "", err
+	}
+	return r.Seal(plaintext)
+}
+
+// KeyID returns the key ID embedded in a sealed value, or "" for legacy
+// values that predate key IDs.
+func KeyID(sealed string) string {
+	keyID, _, keyed, err := splitSealed(sealed)
+	if err != nil || !keyed {
+		return ""
+	}
+	return keyID
+}
+
+// KeyringConfig carries the operator-facing key settings shared by a service
+// runtime and its maintenance tooling.
+type KeyringConfig struct {
+	// LegacyKey is the single base64 key from before key IDs existed. It joins
+	// the keyring as DefaultKeyID.
+	LegacyKey string
+	// Keys is a comma-separated "<id>:<base64-key>" list.
+	Keys string
+	// ActiveKeyID selects the sealing key. It may be omitted when exactly one
+	// key is configured.
+	ActiveKeyID string
+}
+
+// Configured reports whether any key material is present.
+func (c KeyringConfig) Configured() bool {
+	return strings.TrimSpace(c.LegacyKey) != "" || strings.TrimSpace(c.Keys) != ""
+}
+
+// Build decodes the configured keys and returns the resulting keyring.
+func (c KeyringConfig) Build() (*Keyring, error) {
+	keys, err := ParseKeys(c.Keys)
+	if err != nil {
+		return nil, err
+	}
+	if legacy := strings.TrimSpace(c.LegacyKey); legacy != "" {
+		if _, exists := keys[DefaultKeyID]; exists {
+			return nil, fmt.Errorf("key %q is configured by both the legacy key and the key list", DefaultKeyID)
+		}
+		key, err := DecodeKey(legacy)
+		if err != nil {
+			return nil, fmt.Errorf("decode encryption key: %w", err)
+		}
+		keys[DefaultKeyID] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one key is required")
+	}
+
+	activeKeyID := strings.TrimSpace(c.ActiveKeyID)
+	if activeKeyID == "" {
+		if len(keys) != 1 {
+			return nil, fmt.Errorf("active key id is required when more than one key is configured")
+		}
+		for id := range keys {
+			activeKeyID = id
+		}
+	}
+	return NewKeyring(activeKeyID, keys)
+}
+
+// ParseKeys decodes a comma-separated "<id>:<base64-key>" list into raw keys.
+// Both raw and padded base64 encodings are accepted.
+func ParseKeys(spec string) (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("key entry %q must be <id>:<base64-key>", entry)
+		}
+		id = strings.TrimSpace(id)
+		if err := validateKeyID(id); err != nil {
+			return nil, err
+		}
+		if _, exists := keys[id]; exists {
+			return nil, fmt.Errorf("key %q is configured more than once", id)
+		}
+		key, err := DecodeKey(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("decode key %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return keys, nil
+}
+
+// DecodeKey accepts both raw and padded base64 encodings to reduce
+// operational friction across secret managers while preserving exact key bytes.
+func DecodeKey(value string) ([]byte, error) {
+	key, rawErr := base64.RawStdEncoding.DecodeString(value)
+	if rawErr == nil {
+		return key, nil
+	}
+	key, stdErr := base64.StdEncoding.DecodeString(value)
+	if stdErr == nil {
+		return key, nil
+	}
+	return nil, rawErr
+}
+
+// splitSealed separates the key ID from the payload of a keyed value.
+func splitSealed(sealed string) (keyID string, payload string, keyed bool, err error) {
+	rest, ok := strings.CutPrefix(sealed, keyedPrefix)
+	if !ok {
+		return "", sealed, false, nil
+	}
+	keyID, payload, ok = strings.Cut(rest, ":")
+	if !ok || keyID == "" {
+		return "", "", false, fmt.Errorf("sealed value has a malformed key id")
+	}
+	return keyID, payload, true, nil
+}
+
+// validateKeyID keeps key IDs short and free of separator characters.
+func validateKeyID(id string) error {
+	if id == "" {
+		return fmt.Errorf("key id is required")
+	}
+	if len(id) > 64 {
+		return fmt.Errorf("key id %q is longer than 64 characters", id)
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return fmt.Errorf("key id %q may only contain letters, digits, '-', '_', and '.'", id)
+		}
+	}
+	return nil
+}
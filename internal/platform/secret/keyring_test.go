@@ -0,0 +1,161 @@
+package secret
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+var (
+	oldKey = []byte("0123456789abcdef0123456789abcdef")
+	newKey = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func TestKeyringSealEmbedsActiveKeyID(t *testing.T) {
+	ring, err := NewKeyring("new", map[string][]byte{"old": oldKey, "new": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+
+	sealed, err := ring.Seal("sk-123")
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if !strings.HasPrefix(sealed, "v1:new:") {
+		t.Fatalf("sealed = %q, want v1:new: prefix", sealed)
+	}
+	if got := KeyID(sealed); got != "new" {
+		t.Fatalf("KeyID() = %q, want %q", got, "new")
+	}
+	if ring.NeedsReseal(sealed) {
+		t.Fatal("expected value sealed under the active key to be current")
+	}
+
+	opened, err := ring.Open(sealed)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if opened != "sk-123" {
+		t.Fatalf("opened = %q, want %q", opened, "sk-123")
+	}
+}
+
+func TestKeyringOpensRetiredAndLegacyValues(t *testing.T) {
+	previous, err := NewKeyring("old", map[string][]byte{"old": oldKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	keyed, err := previous.Seal("keyed")
+	if err != nil {
+		t.Fatalf("seal keyed: %v", err)
+	}
+	legacySealer, err := NewAESGCMSealer(oldKey)
+	if err != nil {
+		t.Fatalf("new sealer: %v", err)
+	}
+	legacy, err := legacySealer.Seal("legacy")
+	if err != nil {
+		t.Fatalf("seal legacy: %v", err)
+	}
+
+	ring, err := NewKeyring("new", map[string][]byte{"old": oldKey, "new": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	for sealed, want := range map[string]string{keyed: "keyed", legacy: "legacy"} {
+		if !ring.NeedsReseal(sealed) {
+			t.Fatalf("NeedsReseal(%q) = false, want true", sealed)
+		}
+		opened, err := ring.Open(sealed)
+		if err != nil {
+			t.Fatalf("open %q: %v", want, err)
+		}
+		if opened != want {
+			t.Fatalf("opened = %q, want %q", opened, want)
+		}
+		resealed, err := ring.Reseal(sealed)
+		if err != nil {
+			t.Fatalf("reseal %q: %v", want, err)
+		}
+		if KeyID(resealed) != "new" {
+			t.Fatalf("resealed key id = %q, want %q", KeyID(resealed), "new")
+		}
+	}
+}
+
+func TestKeyringOpenRejectsUnknownKey(t *testing.T) {
+	previous, err := NewKeyring("old", map[string][]byte{"old": oldKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	sealed, err := previous.Seal("sk-123")
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+
+	ring, err := NewKeyring("new", map[string][]byte{"new": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	if _, err := ring.Open(sealed); err == nil || !strings.Contains(err.Error(), "unknown key") {
+		t.Fatalf("open error = %v, want unknown key", err)
+	}
+	if _, err := ring.Open("v1::payload"); err == nil {
+		t.Fatal("expected error for malformed key id")
+	}
+}
+
+func TestNewKeyringValidatesInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		active string
+		keys   map[string][]byte
+	}{
+		{name: "no keys", active: "a"},
+		{name: "missing active", keys: map[string][]byte{"a": oldKey}},
+		{name: "unknown active", active: "b", keys: map[string][]byte{"a": oldKey}},
+		{name: "bad id", active: "a:b", keys: map[string][]byte{"a:b": oldKey}},
+		{name: "bad key", active: "a", keys: map[string][]byte{"a": []byte("short")}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewKeyring(tc.active, tc.keys); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestKeyringConfigBuild(t *testing.T) {
+	legacy := base64.RawStdEncoding.EncodeToString(oldKey)
+	next := base64.StdEncoding.EncodeToString(newKey)
+
+	ring, err := KeyringConfig{LegacyKey: legacy}.Build()
+	if err != nil {
+		t.Fatalf("build legacy keyring: %v", err)
+	}
+	if ring.ActiveKeyID() != DefaultKeyID {
+		t.Fatalf("ActiveKeyID() = %q, want %q", ring.ActiveKeyID(), DefaultKeyID)
+	}
+
+	ring, err = KeyringConfig{LegacyKey: legacy, Keys: "next:" + next, ActiveKeyID: "next"}.Build()
+	if err != nil {
+		t.Fatalf("build rotated keyring: %v", err)
+	}
+	if ring.ActiveKeyID() != "next" {
+		t.Fatalf("ActiveKeyID() = %q, want %q", ring.ActiveKeyID(), "next")
+	}
+
+	if _, err := (KeyringConfig{LegacyKey: legacy, Keys: "next:" + next}).Build(); err == nil {
+		t.Fatal("expected error when several keys have no active id")
+	}
+	if _, err := (KeyringConfig{LegacyKey: legacy, Keys: "default:" + next}).Build(); err == nil {
+		t.Fatal("expected error for duplicate default key")
+	}
+	if _, err := (KeyringConfig{Keys: "next"}).Build(); err == nil {
+		t.Fatal("expected error for malformed key entry")
+	}
+	if _, err := (KeyringConfig{}).Build(); err == nil {
+		t.Fatal("expected error without keys")
+	}
+}
@@ -0,0 +1,103 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// StoredValue is one sealed value identified by its row ID.
+type StoredValue struct {
+	ID         string
+	Ciphertext string
+}
+
+// ResealTable binds one table of sealed values to a re-seal pass. List pages
+// by row ID after afterID so an interrupted pass can resume. Replace is a
+// compare-and-swap against the value List returned and reports Conflict when
+// the row changed in between.
+type ResealTable struct {
+	Name     string
+	After    string
+	List     func(ctx context.Context, afterID string, limit int) ([]StoredValue, error)
+	Replace  func(ctx context.Context, id string, current string, replacement string) error
+	Conflict error
+}
+
+// ResealOptions controls one re-seal pass.
+type ResealOptions struct {
+	BatchSize int
+	DryRun    bool
+}
+
+// ResealReport summarizes one table's re-seal pass.
+type ResealReport struct {
+	Table    string
+	Scanned  int
+	Resealed int
+	Current  int
+	Changed  int
+	LastID   string
+}
+
+// ResealStored re-seals every value in table that the active key did not
+// seal. It writes one progress line per batch so operators can resume with the
+// reported last ID after an interruption. Rows already sealed under the active
+// key are skipped, so a pass can be started again at any time.
+func ResealStored(ctx context.Context, keyring *Keyring, table ResealTable, opts ResealOptions, out io.Writer) (ResealReport, error) {
+	report := ResealReport{Table: table.Name, LastID: strings.TrimSpace(table.After)}
+	if keyring == nil {
+		return report, errors.New("keyring is required")
+	}
+	if opts.BatchSize <= 0 {
+		return report, errors.New("-batch-size must be > 0")
+	}
+	if out == nil {
+		out = io.Discard
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		batch, err := table.List(ctx, report.LastID, opts.BatchSize)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", table.Name, err)
+		}
+		if len(batch) == 0 {
+			return report, nil
+		}
+		for _, value := range batch {
+			report.Scanned++
+			if !keyring.NeedsReseal(value.Ciphertext) {
+				report.Current++
+				report.LastID = value.ID
+				continue
+			}
+			resealed, err := keyring.Reseal(value.Ciphertext)
+			if err != nil {
+				return report, fmt.Errorf("%s %s: %w", table.Name, value.ID, err)
+			}
+			if !opts.DryRun {
+				err := table.Replace(ctx, value.ID, value.Ciphertext, resealed)
+				switch {
+				case errors.Is(err, table.Conflict):
+					// The service rewrote the row since it was read; a rerun
+					// picks it up if it still needs re-sealing.
+					report.Changed++
+					report.LastID = value.ID
+					continue
+				case err != nil:
+					return report, fmt.Errorf("%s %s: %w", table.Name, value.ID, err)
+				}
+			}
+			report.Resealed++
+			report.LastID = value.ID
+		}
+		fmt.Fprintf(out, "%s: scanned=%d resealed=%d last_id=%s\n", table.Name, report.Scanned, report.Resealed, report.LastID)
+		if len(batch) < opts.BatchSize {
+			return report, nil
+		}
+	}
+}
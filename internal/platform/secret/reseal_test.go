@@ -0,0 +1,125 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var errTestConflict = errors.New("conflict")
+
+// memoryTable is an ordered in-memory table with compare-and-swap replace.
+type memoryTable struct {
+	ids      []string
+	values   map[string]string
+	conflict map[string]bool
+}
+
+func (m *memoryTable) table(after string) ResealTable {
+	return ResealTable{
+		Name:  "values",
+		After: after,
+		List: func(_ context.Context, afterID string, limit int) ([]StoredValue, error) {
+			var out []StoredValue
+			for _, id := range m.ids {
+				if id > afterID && len(out) < limit {
+					out = append(out, StoredValue{ID: id, Ciphertext: m.values[id]})
+				}
+			}
+			return out, nil
+		},
+		Replace: func(_ context.Context, id, current, replacement string) error {
+			if m.conflict[id] || m.values[id] != current {
+				return errTestConflict
+			}
+			m.values[id] = replacement
+			return nil
+		},
+		Conflict: errTestConflict,
+	}
+}
+
+func TestResealStoredRotatesAndCountsConflicts(t *testing.T) {
+	old, err := NewKeyring("old", map[string][]byte{"old": oldKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	next, err := NewKeyring("new", map[string][]byte{"old": oldKey, "new": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	table := &memoryTable{values: map[string]string{}, conflict: map[string]bool{"c": true}}
+	for _, id := range []string{"a", "b", "c"} {
+		sealed, err := old.Seal("value-" + id)
+		if err != nil {
+			t.Fatalf("seal: %v", err)
+		}
+		table.ids = append(table.ids, id)
+		table.values[id] = sealed
+	}
+	current, err := next.Seal("value-d")
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	table.ids = append(table.ids, "d")
+	table.values["d"] = current
+
+	var out bytes.Buffer
+	report, err := ResealStored(context.Background(), next, table.table(""), ResealOptions{BatchSize: 2}, &out)
+	if err != nil {
+		t.Fatalf("ResealStored() error = %v", err)
+	}
+	if report.Scanned != 4 || report.Resealed != 2 || report.Changed != 1 || report.Current != 1 || report.LastID != "d" {
+		t.Fatalf("report = %+v", report)
+	}
+	if KeyID(table.values["a"]) != "new" || KeyID(table.values["c"]) != "old" {
+		t.Fatalf("values = %+v", table.values)
+	}
+	if !strings.Contains(out.String(), "values: scanned=2 resealed=2 last_id=b") {
+		t.Fatalf("output = %q", out.String())
+	}
+}
+
+func TestResealStoredDryRunResumesAfterCursor(t *testing.T) {
+	old, err := NewKeyring("old", map[string][]byte{"old": oldKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	next, err := NewKeyring("new", map[string][]byte{"old": oldKey, "new": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	table := &memoryTable{values: map[string]string{}}
+	for _, id := range []string{"a", "b"} {
+		sealed, err := old.Seal("value-" + id)
+		if err != nil {
+			t.Fatalf("seal: %v", err)
+		}
+		table.ids = append(table.ids, id)
+		table.values[id] = sealed
+	}
+
+	report, err := ResealStored(context.Background(), next, table.table("a"), ResealOptions{BatchSize: 10, DryRun: true}, nil)
+	if err != nil {
+		t.Fatalf("ResealStored() error = %v", err)
+	}
+	if report.Scanned != 1 || report.Resealed != 1 || report.LastID != "b" {
+		t.Fatalf("report = %+v", report)
+	}
+	if KeyID(table.values["b"]) != "old" {
+		t.Fatalf("dry run rewrote b: %q", table.values["b"])
+	}
+}
+
+func TestResealStoredRequiresBatchSize(t *testing.T) {
+	ring, err := NewKeyring("new", map[string][]byte{"new": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	_, err = ResealStored(context.Background(), ring, (&memoryTable{}).table(""), ResealOptions{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "-batch-size") {
+		t.Fatalf("ResealStored() error = %v", err)
+	}
+}
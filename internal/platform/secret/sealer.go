@@ -3,9 +3,10 @@
 // Sealing is isolated so core domain models stay transport-agnostic while storage
 // boundaries own the concrete crypto and key lifecycle.
 //
-// Key rotation: AESGCMSealer uses a single key. Changing the key invalidates all
-// existing sealed values. Callers that need rotation should re-seal stored values
-// under the new key before discarding the old one.
+// Key rotation: AESGCMSealer uses a single key and its payloads carry no key
+// identifier. Keyring wraps one AESGCMSealer per key, embeds the sealing key ID
+// in every value, and opens with any configured key, so operators can add a new
+// active key, re-seal stored values, and only then retire the old key.
 package secret
 
 import (
@@ -19,6 +19,10 @@ const (
 	ServiceAuth = "auth"
 	// ServiceGame is the game gRPC service identity.
 	ServiceGame = "game"
+	// ServiceGateway is the public HTTP/JSON API gateway identity.
+	ServiceGateway = "gateway"
+	// ServiceMCP is the external MCP server identity.
+	ServiceMCP = "mcp"
 	// ServiceJaeger is the jaeger HTTP service identity.
 	ServiceJaeger = "jaeger"
 	// ServicePlay is the play HTTP service identity.
@@ -48,14 +52,17 @@ var grpcPorts = map[string]int{
 	ServiceStatus:        8093,
 	ServiceUserHub:       8092,
 	ServiceInvite:        8095,
+	ServicePlay:          8096,
 }
 
 var httpPorts = map[string]int{
-	ServiceWeb:    8080,
-	ServiceAdmin:  8081,
-	ServiceAuth:   8084,
-	ServicePlay:   8094,
-	ServiceJaeger: 16686,
+	ServiceWeb:     8080,
+	ServiceAdmin:   8081,
+	ServiceAuth:    8084,
+	ServiceGateway: 8085,
+	ServiceMCP:     8086,
+	ServicePlay:    8094,
+	ServiceJaeger:  16686,
 }
 
 // DefaultGRPCAddr returns the canonical in-network gRPC address for a service.
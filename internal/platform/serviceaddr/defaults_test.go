@@ -28,11 +28,13 @@ func TestDefaultGRPCAddr(t *testing.T) {
 
 func TestDefaultHTTPAddr(t *testing.T) {
 	cases := map[string]string{
-		ServiceAuth:   "auth:8084",
-		ServiceWeb:    "web:8080",
-		ServiceAdmin:  "admin:8081",
-		ServicePlay:   "play:8094",
-		ServiceJaeger: "jaeger:16686",
+		ServiceAuth:    "auth:8084",
+		ServiceWeb:     "web:8080",
+		ServiceAdmin:   "admin:8081",
+		ServiceGateway: "gateway:8085",
+		ServiceMCP:     "mcp:8086",
+		ServicePlay:    "play:8094",
+		ServiceJaeger:  "jaeger:16686",
 	}
 	for service, want := range cases {
 		if got := DefaultHTTPAddr(service); got != want {
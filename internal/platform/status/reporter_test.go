@@ -35,6 +35,18 @@ func (f *fakeClient) ClearOverride(_ context.Context, _ *statusv1.ClearOverrideR
 	return &statusv1.ClearOverrideResponse{}, nil
 }
 
+func (f *fakeClient) ListStatusHistory(_ context.Context, _ *statusv1.ListStatusHistoryRequest, _ ...grpc.CallOption) (*statusv1.ListStatusHistoryResponse, error) {
+	return &statusv1.ListStatusHistoryResponse{}, nil
+}
+
+func (f *fakeClient) ListCapabilityUptime(_ context.Context, _ *statusv1.ListCapabilityUptimeRequest, _ ...grpc.CallOption) (*statusv1.ListCapabilityUptimeResponse, error) {
+	return &statusv1.ListCapabilityUptimeResponse{}, nil
+}
+
+func (f *fakeClient) ListIncidents(_ context.Context, _ *statusv1.ListIncidentsRequest, _ ...grpc.CallOption) (*statusv1.ListIncidentsResponse, error) {
+	return &statusv1.ListIncidentsResponse{}, nil
+}
+
 func (f *fakeClient) reportCount() int {
 	f.mu.Lock()
 	defer f.mu.Unlock()
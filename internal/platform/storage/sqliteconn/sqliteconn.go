@@ -48,6 +48,38 @@ func Open(path string) (*sql.DB, error) {
 	return sqlDB, nil
 }
 
+// OpenMemory opens a private in-memory SQLite database for throwaway state.
+//
+// The pool is pinned to one connection because every new connection to an
+// in-memory database sees its own empty schema. Journal mode and synchronous
+// pragmas do not apply to memory databases, so only foreign keys and the busy
+// timeout are enforced.
+func OpenMemory() (*sql.DB, error) {
+	dsn := fmt.Sprintf(":memory:?_pragma=foreign_keys(ON)&_pragma=busy_timeout(%d)", busyTimeoutMillis)
+	sqlDB, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite memory db: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	sqlDB.SetMaxIdleConns(1)
+	sqlDB.SetConnMaxLifetime(0)
+	sqlDB.SetConnMaxIdleTime(0)
+	if err := sqlDB.Ping(); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("ping sqlite memory db: %w", err)
+	}
+	var foreignKeys int
+	if err := sqlDB.QueryRow("PRAGMA foreign_keys").Scan(&foreignKeys); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("check sqlite foreign_keys pragma: %w", err)
+	}
+	if foreignKeys != 1 {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("sqlite foreign_keys mismatch: got %d want 1", foreignKeys)
+	}
+	return sqlDB, nil
+}
+
 // IsBusyOrLockedError reports whether err wraps SQLITE_BUSY or SQLITE_LOCKED.
 func IsBusyOrLockedError(err error) bool {
 	var sqliteErr *sqlite.Error
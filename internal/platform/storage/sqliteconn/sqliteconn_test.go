@@ -33,6 +33,28 @@ func TestOpenAppliesExpectedPragmas(t *testing.T) {
 	}
 }
 
+func TestOpenMemoryKeepsSchemaAcrossQueries(t *testing.T) {
+	t.Parallel()
+
+	db, err := OpenMemory()
+	if err != nil {
+		t.Fatalf("open memory: %v", err)
+	}
+	t.Cleanup(func() {
+		if closeErr := db.Close(); closeErr != nil {
+			t.Fatalf("close db: %v", closeErr)
+		}
+	})
+
+	if _, err := db.Exec("CREATE TABLE t (id INTEGER PRIMARY KEY)"); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM t").Scan(&count); err != nil {
+		t.Fatalf("query table: %v", err)
+	}
+}
+
 func TestVerifyPragmasDetectsMismatch(t *testing.T) {
 	t.Parallel()
 
@@ -75,12 +75,15 @@ func (h *Handler) moduleBuildInput() modules.BuildInput {
 	}
 	if s := h.server; s != nil {
 		input.AuthClient = s.AuthClient()
+		input.ModerationClient = s.ModerationClient()
+		input.DiscoveryClient = s.DiscoveryClient()
 		input.CampaignClient = s.CampaignClient()
 		input.CharacterClient = s.CharacterClient()
 		input.ParticipantClient = s.ParticipantClient()
 		input.InviteClient = s.InviteClient()
 		input.SessionClient = s.SessionClient()
 		input.EventClient = s.EventClient()
+		input.ForkClient = s.ForkClient()
 		input.StatisticsClient = s.StatisticsClient()
 		input.SystemClient = s.SystemClient()
 		input.DaggerheartContentClient = s.DaggerheartContentClient()
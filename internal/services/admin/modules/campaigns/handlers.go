@@ -21,4 +21,5 @@ type Handlers interface {
 	HandleSessionEvents(w http.ResponseWriter, r *http.Request, campaignID string, sessionID string)
 	HandleEventLog(w http.ResponseWriter, r *http.Request, campaignID string)
 	HandleEventLogTable(w http.ResponseWriter, r *http.Request, campaignID string)
+	HandleCampaignDiff(w http.ResponseWriter, r *http.Request, campaignID string)
 }
@@ -119,6 +119,12 @@ func (f *fakeHandlers) HandleEventLogTable(w http.ResponseWriter, _ *http.Reques
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (f *fakeHandlers) HandleCampaignDiff(w http.ResponseWriter, _ *http.Request, campaignID string) {
+	f.lastCall = "campaign_diff"
+	f.lastCampaign = campaignID
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func TestMount(t *testing.T) {
 	t.Parallel()
 
@@ -141,6 +147,7 @@ func TestMount(t *testing.T) {
 		{path: "/app/campaigns?fragment=rows", wantCode: http.StatusNoContent, wantCall: "campaigns_table"},
 		{path: "/app/campaigns/camp-1/characters?fragment=rows", wantCode: http.StatusNoContent, wantCall: "characters_table", wantCampaign: "camp-1"},
 		{path: "/app/campaigns/camp-1/events?fragment=rows", wantCode: http.StatusNoContent, wantCall: "event_log_table", wantCampaign: "camp-1"},
+		{path: "/app/campaigns/camp-1/diff?compare=1", wantCode: http.StatusNoContent, wantCall: "campaign_diff", wantCampaign: "camp-1"},
 		{path: "/app/campaigns/camp-1/characters/table", wantCode: http.StatusNotFound},
 	}
 
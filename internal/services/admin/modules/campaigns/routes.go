@@ -144,6 +144,15 @@ func newRoutes(h Handlers) *http.ServeMux {
 		h.HandleEventLog(w, r, campaignID)
 	})
 
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppCampaignDiffPattern, func(w http.ResponseWriter, r *http.Request) {
+		campaignID := strings.TrimSpace(r.PathValue("campaignID"))
+		if campaignID == "" {
+			http.NotFound(w, r)
+			return
+		}
+		h.HandleCampaignDiff(w, r, campaignID)
+	})
+
 	mux.HandleFunc(http.MethodGet+" "+routepath.CampaignsPrefix+"{campaignID}/{rest...}", http.NotFound)
 	return mux
 }
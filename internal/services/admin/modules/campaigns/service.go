@@ -36,6 +36,7 @@ type handlers struct {
 	inviteClient      invitev1.InviteServiceClient
 	sessionClient     statev1.SessionServiceClient
 	eventClient       statev1.EventServiceClient
+	forkClient        statev1.ForkServiceClient
 	authClient        authv1.AuthServiceClient
 }
 
@@ -50,6 +51,7 @@ func NewHandlers(
 	inviteClient invitev1.InviteServiceClient,
 	sessionClient statev1.SessionServiceClient,
 	eventClient statev1.EventServiceClient,
+	forkClient statev1.ForkServiceClient,
 	authClient authv1.AuthServiceClient,
 ) Handlers {
 	return &handlers{
@@ -60,6 +62,7 @@ func NewHandlers(
 		inviteClient:      inviteClient,
 		sessionClient:     sessionClient,
 		eventClient:       eventClient,
+		forkClient:        forkClient,
 		authClient:        authClient,
 	}
 }
@@ -523,14 +526,16 @@ func (s *handlers) HandleEventLog(w http.ResponseWriter, r *http.Request, campai
 	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
 	defer cancel()
 
-	filterExpr := eventview.BuildEventFilterExpression(filters)
-
-	eventsResp, err := s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
-		CampaignId: campaignID,
-		PageSize:   eventListPageSize,
-		OrderBy:    "seq desc",
-		Filter:     filterExpr,
-	})
+	filterExpr, err := eventview.BuildEventFilterExpression(filters)
+	var eventsResp *statev1.ListEventsResponse
+	if err == nil {
+		eventsResp, err = s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
+			CampaignId: campaignID,
+			PageSize:   eventListPageSize,
+			OrderBy:    "seq desc",
+			Filter:     filterExpr,
+		})
+	}
 	if err == nil && eventsResp != nil {
 		events = eventview.BuildEventRows(eventsResp.GetEvents(), loc)
 		totalCount = eventsResp.GetTotalSize()
@@ -565,16 +570,18 @@ func (s *handlers) HandleEventLogTable(w http.ResponseWriter, r *http.Request, c
 	defer cancel()
 
 	filters := eventview.ParseEventFilters(r)
-	filterExpr := eventview.BuildEventFilterExpression(filters)
+	filterExpr, err := eventview.BuildEventFilterExpression(filters)
 	pageToken := r.URL.Query().Get("page_token")
-
-	eventsResp, err := s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
-		CampaignId: campaignID,
-		PageSize:   eventListPageSize,
-		PageToken:  pageToken,
-		OrderBy:    "seq desc",
-		Filter:     filterExpr,
-	})
+	var eventsResp *statev1.ListEventsResponse
+	if err == nil {
+		eventsResp, err = s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
+			CampaignId: campaignID,
+			PageSize:   eventListPageSize,
+			PageToken:  pageToken,
+			OrderBy:    "seq desc",
+			Filter:     filterExpr,
+		})
+	}
 	if err != nil {
 		adminerrors.LogError(r, "list events: %v", err)
 		templ.Handler(templates.EmptyState(loc.Sprintf("error.events_unavailable"))).ServeHTTP(w, r)
@@ -632,6 +639,65 @@ func (s *handlers) renderInvitesTable(w http.ResponseWriter, r *http.Request, ro
 	templ.Handler(templates.InvitesTable(rows, message, loc)).ServeHTTP(w, r)
 }
 
+// HandleCampaignDiff renders the state comparison page and, once the form is
+// submitted, the diff between the requested points.
+func (s *handlers) HandleCampaignDiff(w http.ResponseWriter, r *http.Request, campaignID string) {
+	loc, lang := s.base.Localizer(w, r)
+	query := r.URL.Query()
+	view := templates.CampaignDiffView{
+		CampaignID:   campaignID,
+		CampaignName: s.getCampaignName(r, campaignID, loc),
+		Form: templates.CampaignDiffForm{
+			FromSeq:      strings.TrimSpace(query.Get("from_seq")),
+			ToCampaignID: strings.TrimSpace(query.Get("to_campaign_id")),
+			ToSeq:        strings.TrimSpace(query.Get("to_seq")),
+		},
+		Submitted: query.Has("compare"),
+	}
+
+	if view.Submitted {
+		s.loadCampaignDiff(r, &view, loc)
+	}
+
+	pageCtx := s.base.PageContext(lang, loc, r)
+	s.base.RenderPage(
+		w,
+		r,
+		templates.CampaignDiffPage(view, loc),
+		templates.CampaignDiffFullPage(view, pageCtx),
+		s.base.HTMXLocalizedPageTitle(loc, "title.campaign_diff", templates.AppName()),
+	)
+}
+
+func (s *handlers) loadCampaignDiff(r *http.Request, view *templates.CampaignDiffView, loc *message.Printer) {
+	fromSeq, fromErr := parseDiffSeq(view.Form.FromSeq)
+	toSeq, toErr := parseDiffSeq(view.Form.ToSeq)
+	if fromErr != nil || toErr != nil {
+		view.Message = loc.Sprintf("error.diff_invalid_seq")
+		return
+	}
+	toCampaignID := view.Form.ToCampaignID
+	if toCampaignID == "" {
+		toCampaignID = view.CampaignID
+	}
+
+	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
+	defer cancel()
+
+	response, err := s.forkClient.DiffCampaignState(ctx, &statev1.DiffCampaignStateRequest{
+		From: &statev1.StatePoint{CampaignId: view.CampaignID, EventSeq: fromSeq},
+		To:   &statev1.StatePoint{CampaignId: toCampaignID, EventSeq: toSeq},
+	})
+	if err != nil {
+		adminerrors.LogError(r, "diff campaign state: %v", err)
+		view.Message = loc.Sprintf("error.diff_unavailable")
+		return
+	}
+	view.FromLabel = formatStatePoint(response.GetFrom(), loc)
+	view.ToLabel = formatStatePoint(response.GetTo(), loc)
+	view.Sections = buildCampaignDiffSections(response.GetDiff(), loc)
+}
+
 func (s *handlers) getCampaignName(r *http.Request, campaignID string, loc *message.Printer) string {
 	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
 	defer cancel()
@@ -41,6 +41,7 @@ func TestCampaignServiceHandlersWithUnavailableClients(t *testing.T) {
 		invitev1.NewInviteServiceClient(conn),
 		statev1.NewSessionServiceClient(conn),
 		statev1.NewEventServiceClient(conn),
+		statev1.NewForkServiceClient(conn),
 		authv1.NewAuthServiceClient(conn),
 	)
 	svc, ok := svcIface.(*handlers)
@@ -113,6 +114,9 @@ func TestCampaignServiceHandlersWithUnavailableClients(t *testing.T) {
 	run("event log table", "/app/campaigns/camp-1/events?fragment=rows", func(w http.ResponseWriter, r *http.Request) {
 		svc.HandleEventLogTable(w, r, "camp-1")
 	}, http.StatusOK)
+	run("campaign diff", "/app/campaigns/camp-1/diff?compare=1", func(w http.ResponseWriter, r *http.Request) {
+		svc.HandleCampaignDiff(w, r, "camp-1")
+	}, http.StatusOK)
 }
 
 func TestCampaignServiceNameFallbacks(t *testing.T) {
@@ -211,6 +215,17 @@ func (c *fakeEventClient) ListEvents(context.Context, *statev1.ListEventsRequest
 	return c.listResp, nil
 }
 
+type fakeForkClient struct {
+	statev1.ForkServiceClient
+	diffResp *statev1.DiffCampaignStateResponse
+	lastDiff *statev1.DiffCampaignStateRequest
+}
+
+func (c *fakeForkClient) DiffCampaignState(_ context.Context, in *statev1.DiffCampaignStateRequest, _ ...grpc.CallOption) (*statev1.DiffCampaignStateResponse, error) {
+	c.lastDiff = in
+	return c.diffResp, nil
+}
+
 type fakeAuthClient struct {
 	authv1.AuthServiceClient
 	getUserResp *authv1.GetUserResponse
@@ -295,6 +310,11 @@ func TestCampaignServiceHandlersWithFakeClients(t *testing.T) {
 		inviteClient,
 		sessionClient,
 		eventClient,
+		&fakeForkClient{diffResp: &statev1.DiffCampaignStateResponse{
+			From: &statev1.StatePoint{CampaignId: "camp-1", EventSeq: 1},
+			To:   &statev1.StatePoint{CampaignId: "camp-1", EventSeq: 1},
+			Diff: &statev1.CampaignStateDiff{},
+		}},
 		authClient,
 	)
 	svc := svcIface.(*handlers)
@@ -315,6 +335,7 @@ func TestCampaignServiceHandlersWithFakeClients(t *testing.T) {
 		{name: "session events", path: "/app/campaigns/camp-1/sessions/s-1/events", call: func(w http.ResponseWriter, r *http.Request) { svc.HandleSessionEvents(w, r, "camp-1", "s-1") }},
 		{name: "event log", path: "/app/campaigns/camp-1/events", call: func(w http.ResponseWriter, r *http.Request) { svc.HandleEventLog(w, r, "camp-1") }},
 		{name: "event log table", path: "/app/campaigns/camp-1/events?fragment=rows", call: func(w http.ResponseWriter, r *http.Request) { svc.HandleEventLogTable(w, r, "camp-1") }},
+		{name: "campaign diff", path: "/app/campaigns/camp-1/diff?compare=1", call: func(w http.ResponseWriter, r *http.Request) { svc.HandleCampaignDiff(w, r, "camp-1") }},
 	}
 
 	for _, tc := range cases {
@@ -336,3 +357,63 @@ func TestCampaignServiceHandlersWithFakeClients(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleCampaignDiffRendersChanges(t *testing.T) {
+	forkClient := &fakeForkClient{diffResp: &statev1.DiffCampaignStateResponse{
+		From: &statev1.StatePoint{CampaignId: "camp-1", EventSeq: 4},
+		To:   &statev1.StatePoint{CampaignId: "camp-2", EventSeq: 9},
+		Diff: &statev1.CampaignStateDiff{
+			Characters: []*statev1.EntityStateDiff{{
+				EntityId:        "char-1",
+				Name:            "Hero",
+				Change:          statev1.StateDiffChange_STATE_DIFF_CHANGE_MODIFIED,
+				Resources:       []*statev1.ResourceDiff{{Resource: "hp", Before: 6, After: 3}},
+				ConditionsAdded: []string{"Restrained"},
+			}},
+		},
+	}}
+	var conn testUnavailableConn
+	svc := &handlers{
+		base:           modulehandler.NewBase(),
+		campaignClient: statev1.NewCampaignServiceClient(conn),
+		forkClient:     forkClient,
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/app/campaigns/camp-1/diff?compare=1&from_seq=4&to_campaign_id=camp-2", nil)
+	svc.HandleCampaignDiff(rec, req, "camp-1")
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := forkClient.lastDiff; got.GetFrom().GetEventSeq() != 4 || got.GetTo().GetCampaignId() != "camp-2" || got.GetTo().GetEventSeq() != 0 {
+		t.Fatalf("diff request = %v, want camp-1@4 vs camp-2@latest", got)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{"Hero", "hp: 6 → 3", "+ Restrained"} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("body missing %q", want)
+		}
+	}
+}
+
+func TestHandleCampaignDiffRejectsInvalidSeq(t *testing.T) {
+	forkClient := &fakeForkClient{}
+	var conn testUnavailableConn
+	svc := &handlers{
+		base:           modulehandler.NewBase(),
+		campaignClient: statev1.NewCampaignServiceClient(conn),
+		forkClient:     forkClient,
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/app/campaigns/camp-1/diff?compare=1&from_seq=abc", nil)
+	svc.HandleCampaignDiff(rec, req, "camp-1")
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if forkClient.lastDiff != nil {
+		t.Fatal("expected no diff call for invalid seq")
+	}
+}
@@ -281,7 +281,7 @@ func TestCampaignHelpersEventFilters(t *testing.T) {
 		t.Fatalf("eventview.ParseEventFilters() = %#v", filters)
 	}
 
-	got := eventview.BuildEventFilterExpression(templates.EventFilterOptions{
+	got, err := eventview.BuildEventFilterExpression(templates.EventFilterOptions{
 		SessionID:  "s-1",
 		EventType:  `a"b\c`,
 		ActorType:  "participant",
@@ -289,6 +289,9 @@ func TestCampaignHelpersEventFilters(t *testing.T) {
 		StartDate:  "2026-01-01",
 		EndDate:    "2026-01-02",
 	})
+	if err != nil {
+		t.Fatalf("eventview.BuildEventFilterExpression() error = %v", err)
+	}
 	if !strings.Contains(got, `session_id = "s-1"`) || !strings.Contains(got, `type = "a\"b\\c"`) {
 		t.Fatalf("eventview.BuildEventFilterExpression() = %q", got)
 	}
@@ -304,6 +307,28 @@ func TestCampaignHelpersEventFilters(t *testing.T) {
 	if !strings.Contains(pushURL, "page_token=p-1") || !strings.Contains(pushURL, "session_id=s-1") {
 		t.Fatalf("eventview.EventFilterPushURL() = %q", pushURL)
 	}
+
+	payloadReq := httptest.NewRequest(http.MethodGet, "/?event_type=sys.daggerheart.damage_applied&filter=+payload.marks+%3E%3D+2+", nil)
+	payloadFilters := eventview.ParseEventFilters(payloadReq)
+	if payloadFilters.Expression != "payload.marks >= 2" {
+		t.Fatalf("eventview.ParseEventFilters() expression = %q", payloadFilters.Expression)
+	}
+	if got, err := eventview.BuildEventFilterExpression(payloadFilters); err != nil || got != `type = "sys.daggerheart.damage_applied" AND (payload.marks >= 2)` {
+		t.Fatalf("eventview.BuildEventFilterExpression() = %q, %v", got, err)
+	}
+	if got, err := eventview.BuildEventFilterExpression(templates.EventFilterOptions{
+		SessionID:  "s-1",
+		Expression: `payload.reason = "a) OR (b"`,
+	}); err != nil || got != `session_id = "s-1" AND (payload.reason = "a) OR (b")` {
+		t.Fatalf("eventview.BuildEventFilterExpression() = %q, %v", got, err)
+	}
+	// An expression must not close the parentheses it is wrapped in and OR
+	// past the structured filters.
+	for _, expression := range []string{`x) OR (true`, `type = "a") OR (true`, `(x`} {
+		if got, err := eventview.BuildEventFilterExpression(templates.EventFilterOptions{SessionID: "s-1", Expression: expression}); err == nil {
+			t.Fatalf("eventview.BuildEventFilterExpression(%q) = %q, want error", expression, got)
+		}
+	}
 }
 
 func TestCampaignHelpersEventTypeFormatting(t *testing.T) {
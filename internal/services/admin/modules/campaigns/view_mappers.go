@@ -326,3 +326,96 @@ func formatCharacterKind(kind statev1.CharacterKind, loc *message.Printer) strin
 		return loc.Sprintf("label.unspecified")
 	}
 }
+
+// parseDiffSeq reads an optional positive event sequence; blank means latest.
+func parseDiffSeq(value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+func formatStatePoint(point *statev1.StatePoint, loc *message.Printer) string {
+	return loc.Sprintf("diff.point", point.GetCampaignId(), point.GetEventSeq())
+}
+
+// buildCampaignDiffSections renders each non-empty diff group as a table
+// section, keeping the RPC's kind order.
+func buildCampaignDiffSections(diff *statev1.CampaignStateDiff, loc *message.Printer) []templates.CampaignDiffSection {
+	var sections []templates.CampaignDiffSection
+	if details := diffDetailLines(diff.GetCampaignFields(), diff.GetCampaignResources(), nil, nil, loc); len(details) > 0 {
+		sections = append(sections, templates.CampaignDiffSection{
+			Title: loc.Sprintf("diff.section.campaign"),
+			Rows: []templates.CampaignDiffRow{{
+				Entity:      loc.Sprintf("label.campaign"),
+				Change:      loc.Sprintf("diff.change.modified"),
+				ChangeBadge: "warning",
+				Details:     details,
+			}},
+		})
+	}
+	groups := []struct {
+		titleKey string
+		entities []*statev1.EntityStateDiff
+	}{
+		{titleKey: "diff.section.characters", entities: diff.GetCharacters()},
+		{titleKey: "diff.section.scenes", entities: diff.GetScenes()},
+		{titleKey: "diff.section.countdowns", entities: diff.GetCountdowns()},
+		{titleKey: "diff.section.adversaries", entities: diff.GetAdversaries()},
+	}
+	for _, group := range groups {
+		if len(group.entities) == 0 {
+			continue
+		}
+		section := templates.CampaignDiffSection{Title: loc.Sprintf(group.titleKey)}
+		for _, entity := range group.entities {
+			section.Rows = append(section.Rows, buildCampaignDiffRow(entity, loc))
+		}
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+func buildCampaignDiffRow(entity *statev1.EntityStateDiff, loc *message.Printer) templates.CampaignDiffRow {
+	name := strings.TrimSpace(entity.GetName())
+	if name == "" {
+		name = entity.GetEntityId()
+	}
+	change, badge := loc.Sprintf("diff.change.modified"), "warning"
+	switch entity.GetChange() {
+	case statev1.StateDiffChange_STATE_DIFF_CHANGE_ADDED:
+		change, badge = loc.Sprintf("diff.change.added"), "success"
+	case statev1.StateDiffChange_STATE_DIFF_CHANGE_REMOVED:
+		change, badge = loc.Sprintf("diff.change.removed"), "error"
+	}
+	return templates.CampaignDiffRow{
+		Entity:      name,
+		Change:      change,
+		ChangeBadge: badge,
+		Details:     diffDetailLines(entity.GetFields(), entity.GetResources(), entity.GetConditionsAdded(), entity.GetConditionsRemoved(), loc),
+	}
+}
+
+func diffDetailLines(fields []*statev1.FieldDiff, resources []*statev1.ResourceDiff, added, removed []string, loc *message.Printer) []string {
+	lines := make([]string, 0, len(fields)+len(resources)+len(added)+len(removed))
+	for _, field := range fields {
+		lines = append(lines, loc.Sprintf("diff.line.field", field.GetField(), diffValueLabel(field.GetBefore()), diffValueLabel(field.GetAfter())))
+	}
+	for _, resource := range resources {
+		lines = append(lines, loc.Sprintf("diff.line.resource", resource.GetResource(), resource.GetBefore(), resource.GetAfter()))
+	}
+	for _, condition := range added {
+		lines = append(lines, loc.Sprintf("diff.line.condition_added", condition))
+	}
+	for _, condition := range removed {
+		lines = append(lines, loc.Sprintf("diff.line.condition_removed", condition))
+	}
+	return lines
+}
+
+func diffValueLabel(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "—"
+	}
+	return value
+}
@@ -52,6 +52,10 @@ func (c *activityCampaignClient) RestoreCampaign(context.Context, *statev1.Resto
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
+func (c *activityCampaignClient) SetCampaignModerationLock(context.Context, *statev1.SetCampaignModerationLockRequest, ...grpc.CallOption) (*statev1.SetCampaignModerationLockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
 func (c *activityCampaignClient) SetCampaignCover(context.Context, *statev1.SetCampaignCoverRequest, ...grpc.CallOption) (*statev1.SetCampaignCoverResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
@@ -3,11 +3,13 @@
 package eventview
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/admin/templates"
+	"go.einride.tech/aip/filtering"
 	"golang.org/x/text/message"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -160,6 +162,7 @@ func ParseEventFilters(r *http.Request) templates.EventFilterOptions {
 		EntityType: r.URL.Query().Get("entity_type"),
 		StartDate:  r.URL.Query().Get("start_date"),
 		EndDate:    r.URL.Query().Get("end_date"),
+		Expression: strings.TrimSpace(r.URL.Query().Get("filter")),
 	}
 }
 
@@ -172,7 +175,10 @@ func EscapeAIP160StringLiteral(v string) string {
 }
 
 // BuildEventFilterExpression builds an AIP-160 filter string from filter options.
-func BuildEventFilterExpression(filters templates.EventFilterOptions) string {
+// The free-form expression must parse as a complete filter on its own, so it
+// cannot close the parentheses it is wrapped in and escape the AND with the
+// structured filters.
+func BuildEventFilterExpression(filters templates.EventFilterOptions) (string, error) {
 	var parts []string
 
 	if filters.SessionID != "" {
@@ -193,8 +199,18 @@ func BuildEventFilterExpression(filters templates.EventFilterOptions) string {
 	if filters.EndDate != "" {
 		parts = append(parts, "ts <= timestamp(\""+EscapeAIP160StringLiteral(filters.EndDate)+"T23:59:59Z\")")
 	}
+	if filters.Expression != "" {
+		// Only syntax is checked here; the game service validates the fields,
+		// including payload fields.
+		var parser filtering.Parser
+		parser.Init(filters.Expression)
+		if _, err := parser.Parse(); err != nil {
+			return "", fmt.Errorf("parse filter expression: %w", err)
+		}
+		parts = append(parts, "("+filters.Expression+")")
+	}
 
-	return strings.Join(parts, " AND ")
+	return strings.Join(parts, " AND "), nil
 }
 
 // EventFilterPushURL builds an HTMX push URL for event filter pagination.
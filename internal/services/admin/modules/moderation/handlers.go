@@ -0,0 +1,13 @@
+package moderation
+
+import "net/http"
+
+// Handlers defines moderation handler methods consumed by this module's routes.
+type Handlers interface {
+	HandleModerationPage(w http.ResponseWriter, r *http.Request)
+	HandleModerationTable(w http.ResponseWriter, r *http.Request)
+	HandleSuspendUser(w http.ResponseWriter, r *http.Request)
+	HandleUnsuspendUser(w http.ResponseWriter, r *http.Request)
+	HandleCampaignLock(w http.ResponseWriter, r *http.Request)
+	HandleDiscoveryVisibility(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,25 @@
+package moderation
+
+import (
+	mod "github.com/louisbranch/fracturing.space/internal/services/admin/module"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/routepath"
+)
+
+// Module provides moderation routes.
+type Module struct {
+	handlers Handlers
+}
+
+// New returns a moderation module.
+func New(handlers Handlers) Module { return Module{handlers: handlers} }
+
+// ID returns a stable module identifier.
+func (Module) ID() string { return "moderation" }
+
+// Mount wires moderation routes.
+func (m Module) Mount() (mod.Mount, error) {
+	return mod.Mount{
+		Prefix:  routepath.ModerationPrefix,
+		Handler: newRoutes(m.handlers),
+	}, nil
+}
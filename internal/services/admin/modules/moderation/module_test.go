@@ -0,0 +1,104 @@
+package moderation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/admin/routepath"
+)
+
+type fakeHandlers struct {
+	lastCall string
+}
+
+func (f *fakeHandlers) record(w http.ResponseWriter, call string) {
+	f.lastCall = call
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeHandlers) HandleModerationPage(w http.ResponseWriter, _ *http.Request) {
+	f.record(w, "page")
+}
+
+func (f *fakeHandlers) HandleModerationTable(w http.ResponseWriter, _ *http.Request) {
+	f.record(w, "table")
+}
+
+func (f *fakeHandlers) HandleSuspendUser(w http.ResponseWriter, _ *http.Request) {
+	f.record(w, "suspend")
+}
+
+func (f *fakeHandlers) HandleUnsuspendUser(w http.ResponseWriter, _ *http.Request) {
+	f.record(w, "unsuspend")
+}
+
+func (f *fakeHandlers) HandleCampaignLock(w http.ResponseWriter, _ *http.Request) {
+	f.record(w, "campaign_lock")
+}
+
+func (f *fakeHandlers) HandleDiscoveryVisibility(w http.ResponseWriter, _ *http.Request) {
+	f.record(w, "discovery_visibility")
+}
+
+func TestMount(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeHandlers{}
+	m, err := New(svc).Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	if m.Prefix != routepath.ModerationPrefix {
+		t.Fatalf("prefix = %q, want %q", m.Prefix, routepath.ModerationPrefix)
+	}
+
+	tests := []struct {
+		method   string
+		path     string
+		wantCode int
+		wantCall string
+	}{
+		{method: http.MethodGet, path: "/app/moderation", wantCode: http.StatusNoContent, wantCall: "page"},
+		{method: http.MethodGet, path: "/app/moderation/", wantCode: http.StatusNoContent, wantCall: "page"},
+		{method: http.MethodGet, path: "/app/moderation?fragment=rows", wantCode: http.StatusNoContent, wantCall: "table"},
+		{method: http.MethodPost, path: "/app/moderation/suspend", wantCode: http.StatusNoContent, wantCall: "suspend"},
+		{method: http.MethodPost, path: "/app/moderation/unsuspend", wantCode: http.StatusNoContent, wantCall: "unsuspend"},
+		{method: http.MethodPost, path: "/app/moderation/campaign-lock", wantCode: http.StatusNoContent, wantCall: "campaign_lock"},
+		{method: http.MethodPost, path: "/app/moderation/discovery-visibility", wantCode: http.StatusNoContent, wantCall: "discovery_visibility"},
+		{method: http.MethodGet, path: "/app/moderation/suspend", wantCode: http.StatusNotFound},
+		{method: http.MethodGet, path: "/app/moderation/unknown", wantCode: http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			svc.lastCall = ""
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			rec := httptest.NewRecorder()
+			m.Handler.ServeHTTP(rec, req)
+			if rec.Code != tc.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tc.wantCode)
+			}
+			if svc.lastCall != tc.wantCall {
+				t.Fatalf("call = %q, want %q", svc.lastCall, tc.wantCall)
+			}
+		})
+	}
+}
+
+func TestMountNilService(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(nil).Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/app/moderation", nil)
+	rec := httptest.NewRecorder()
+	m.Handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
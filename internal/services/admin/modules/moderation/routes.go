@@ -0,0 +1,39 @@
+package moderation
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/admin/routepath"
+)
+
+func newRoutes(h Handlers) *http.ServeMux {
+	mux := http.NewServeMux()
+	if h == nil {
+		mux.HandleFunc(http.MethodGet+" "+routepath.AppModeration, http.NotFound)
+		mux.HandleFunc(http.MethodGet+" "+routepath.ModerationPrefix+"{$}", http.NotFound)
+		return mux
+	}
+	page := func(w http.ResponseWriter, r *http.Request) {
+		if wantsRowsFragment(r) {
+			h.HandleModerationTable(w, r)
+			return
+		}
+		h.HandleModerationPage(w, r)
+	}
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppModeration, page)
+	mux.HandleFunc(http.MethodGet+" "+routepath.ModerationPrefix+"{$}", page)
+	mux.HandleFunc(http.MethodPost+" "+routepath.ModerationSuspend, h.HandleSuspendUser)
+	mux.HandleFunc(http.MethodPost+" "+routepath.ModerationUnsuspend, h.HandleUnsuspendUser)
+	mux.HandleFunc(http.MethodPost+" "+routepath.ModerationCampaignLock, h.HandleCampaignLock)
+	mux.HandleFunc(http.MethodPost+" "+routepath.ModerationDiscoveryVisible, h.HandleDiscoveryVisibility)
+	mux.HandleFunc(http.MethodGet+" "+routepath.ModerationPrefix+"{rest...}", http.NotFound)
+	return mux
+}
+
+func wantsRowsFragment(r *http.Request) bool {
+	if r == nil || r.URL == nil {
+		return false
+	}
+	return strings.EqualFold(strings.TrimSpace(r.URL.Query().Get(routepath.FragmentQueryKey)), routepath.FragmentRows)
+}
@@ -0,0 +1,320 @@
+package moderation
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/requestctx"
+	adminerrors "github.com/louisbranch/fracturing.space/internal/services/admin/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/platform/modulehandler"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/routepath"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/templates"
+	"golang.org/x/text/message"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// auditPageSize caps the number of audit rows shown per page.
+	auditPageSize = 25
+	// maxSuspensionHours caps timed suspensions entered through the form.
+	maxSuspensionHours = 24 * 365
+)
+
+// Audit action names for moderation applied by services other than auth.
+const (
+	actionCampaignLock       = "campaign.lock"
+	actionCampaignUnlock     = "campaign.unlock"
+	actionDiscoveryEntryHide = "discovery_entry.hide"
+	actionDiscoveryEntryShow = "discovery_entry.show"
+)
+
+// handlers implements the moderation Handlers contract.
+type handlers struct {
+	base             modulehandler.Base
+	moderationClient authv1.ModerationServiceClient
+	campaignClient   statev1.CampaignServiceClient
+	discoveryClient  discoveryv1.DiscoveryServiceClient
+	now              func() time.Time
+}
+
+// NewHandlers returns the moderation handler implementation.
+func NewHandlers(
+	base modulehandler.Base,
+	moderationClient authv1.ModerationServiceClient,
+	campaignClient statev1.CampaignServiceClient,
+	discoveryClient discoveryv1.DiscoveryServiceClient,
+) Handlers {
+	return handlers{
+		base:             base,
+		moderationClient: moderationClient,
+		campaignClient:   campaignClient,
+		discoveryClient:  discoveryClient,
+		now:              time.Now,
+	}
+}
+
+// HandleModerationPage renders moderation forms and the audit log shell.
+func (s handlers) HandleModerationPage(w http.ResponseWriter, r *http.Request) {
+	loc, lang := s.base.Localizer(w, r)
+	pageCtx := s.base.PageContext(lang, loc, r)
+	query := r.URL.Query()
+	view := templates.ModerationPageView{
+		Message:    strings.TrimSpace(query.Get("message")),
+		UserID:     strings.TrimSpace(query.Get("user_id")),
+		CampaignID: strings.TrimSpace(query.Get("campaign_id")),
+		EntryID:    strings.TrimSpace(query.Get("entry_id")),
+		Filter:     parseAuditFilter(r),
+	}
+	s.base.RenderPage(
+		w,
+		r,
+		templates.ModerationPage(view, loc),
+		templates.ModerationFullPage(view, pageCtx),
+		s.base.HTMXLocalizedPageTitle(loc, "title.moderation", templates.AppName()),
+	)
+}
+
+// HandleModerationTable renders one page of the audit log via HTMX.
+func (s handlers) HandleModerationTable(w http.ResponseWriter, r *http.Request) {
+	loc, _ := s.base.Localizer(w, r)
+	filter := parseAuditFilter(r)
+	view := templates.ModerationAuditView{Filter: filter}
+
+	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
+	defer cancel()
+
+	resp, err := s.moderationClient.ListModerationActions(ctx, &authv1.ListModerationActionsRequest{
+		PageSize:   auditPageSize,
+		PageToken:  filter.PageToken,
+		TargetKind: targetKindToProto(filter.TargetKind),
+		TargetId:   filter.TargetID,
+	})
+	if err != nil {
+		adminerrors.LogError(r, "list moderation actions: %v", err)
+		view.Message = loc.Sprintf("moderation.audit.unavailable")
+		s.renderAuditTable(w, r, view, loc)
+		return
+	}
+	view.Rows = buildActionRows(resp.GetActions(), loc)
+	view.NextPageToken = resp.GetNextPageToken()
+	if len(view.Rows) == 0 {
+		view.Message = loc.Sprintf("moderation.audit.empty")
+	}
+	s.renderAuditTable(w, r, view, loc)
+}
+
+// HandleSuspendUser suspends a user and returns to the moderation page.
+func (s handlers) HandleSuspendUser(w http.ResponseWriter, r *http.Request) {
+	loc, actorID, ok := s.beginAction(w, r)
+	if !ok {
+		return
+	}
+	userID := strings.TrimSpace(r.FormValue("user_id"))
+	req := &authv1.SuspendUserRequest{
+		UserId:      userID,
+		Reason:      strings.TrimSpace(r.FormValue("reason")),
+		ActorUserId: actorID,
+	}
+	if raw := strings.TrimSpace(r.FormValue("duration_hours")); raw != "" {
+		hours, err := strconv.Atoi(raw)
+		if err != nil || hours <= 0 || hours > maxSuspensionHours {
+			s.redirectWithMessage(w, r, templates.ModerationUserURL(userID), loc.Sprintf("moderation.error.invalid_duration"))
+			return
+		}
+		req.ExpiresAt = timestamppb.New(s.now().Add(time.Duration(hours) * time.Hour))
+	}
+
+	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
+	defer cancel()
+	if _, err := s.moderationClient.SuspendUser(ctx, req); err != nil {
+		adminerrors.LogError(r, "suspend user: %v", err)
+		s.redirectWithMessage(w, r, templates.ModerationUserURL(userID), actionFailedMessage(loc, err))
+		return
+	}
+	s.redirectWithMessage(w, r, templates.ModerationUserURL(userID), loc.Sprintf("moderation.user.suspended", userID))
+}
+
+// HandleUnsuspendUser lifts a user suspension and returns to the moderation page.
+func (s handlers) HandleUnsuspendUser(w http.ResponseWriter, r *http.Request) {
+	loc, actorID, ok := s.beginAction(w, r)
+	if !ok {
+		return
+	}
+	userID := strings.TrimSpace(r.FormValue("user_id"))
+
+	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
+	defer cancel()
+	if _, err := s.moderationClient.UnsuspendUser(ctx, &authv1.UnsuspendUserRequest{
+		UserId:      userID,
+		Reason:      strings.TrimSpace(r.FormValue("reason")),
+		ActorUserId: actorID,
+	}); err != nil {
+		adminerrors.LogError(r, "unsuspend user: %v", err)
+		s.redirectWithMessage(w, r, templates.ModerationUserURL(userID), actionFailedMessage(loc, err))
+		return
+	}
+	s.redirectWithMessage(w, r, templates.ModerationUserURL(userID), loc.Sprintf("moderation.user.unsuspended", userID))
+}
+
+// HandleCampaignLock locks or unlocks a campaign and records the action.
+func (s handlers) HandleCampaignLock(w http.ResponseWriter, r *http.Request) {
+	loc, actorID, ok := s.beginAction(w, r)
+	if !ok {
+		return
+	}
+	campaignID := strings.TrimSpace(r.FormValue("campaign_id"))
+	reason := strings.TrimSpace(r.FormValue("reason"))
+	returnURL := templates.AppendQueryParam(routepath.AppModeration, "campaign_id", campaignID)
+
+	var locked bool
+	var action, done string
+	switch strings.TrimSpace(r.FormValue("action")) {
+	case "lock":
+		locked, action, done = true, actionCampaignLock, "moderation.campaign.locked"
+	case "unlock":
+		locked, action, done = false, actionCampaignUnlock, "moderation.campaign.unlocked"
+	default:
+		s.redirectWithMessage(w, r, returnURL, loc.Sprintf("moderation.error.invalid_action"))
+		return
+	}
+
+	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
+	defer cancel()
+	if _, err := s.campaignClient.SetCampaignModerationLock(ctx, &statev1.SetCampaignModerationLockRequest{
+		CampaignId: campaignID,
+		Locked:     locked,
+		Reason:     reason,
+	}); err != nil {
+		adminerrors.LogError(r, "set campaign moderation lock: %v", err)
+		s.redirectWithMessage(w, r, returnURL, actionFailedMessage(loc, err))
+		return
+	}
+	msg := loc.Sprintf(done, campaignID)
+	if !s.recordAction(r, action, authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN, campaignID, actorID, reason) {
+		msg = loc.Sprintf("moderation.error.audit_failed", msg)
+	}
+	s.redirectWithMessage(w, r, returnURL, msg)
+}
+
+// HandleDiscoveryVisibility hides or restores a discovery entry and records
+// the action.
+func (s handlers) HandleDiscoveryVisibility(w http.ResponseWriter, r *http.Request) {
+	loc, actorID, ok := s.beginAction(w, r)
+	if !ok {
+		return
+	}
+	entryID := strings.TrimSpace(r.FormValue("entry_id"))
+	reason := strings.TrimSpace(r.FormValue("reason"))
+	returnURL := templates.AppendQueryParam(routepath.AppModeration, "entry_id", entryID)
+
+	var hidden bool
+	var action, done string
+	switch strings.TrimSpace(r.FormValue("action")) {
+	case "hide":
+		hidden, action, done = true, actionDiscoveryEntryHide, "moderation.discovery.hidden"
+	case "show":
+		hidden, action, done = false, actionDiscoveryEntryShow, "moderation.discovery.shown"
+	default:
+		s.redirectWithMessage(w, r, returnURL, loc.Sprintf("moderation.error.invalid_action"))
+		return
+	}
+
+	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
+	defer cancel()
+	if _, err := s.discoveryClient.SetDiscoveryEntryHidden(ctx, &discoveryv1.SetDiscoveryEntryHiddenRequest{
+		EntryId: entryID,
+		Hidden:  hidden,
+		Reason:  reason,
+	}); err != nil {
+		adminerrors.LogError(r, "set discovery entry hidden: %v", err)
+		s.redirectWithMessage(w, r, returnURL, actionFailedMessage(loc, err))
+		return
+	}
+	msg := loc.Sprintf(done, entryID)
+	if !s.recordAction(r, action, authv1.ModerationTargetKind_MODERATION_TARGET_KIND_DISCOVERY_ENTRY, entryID, actorID, reason) {
+		msg = loc.Sprintf("moderation.error.audit_failed", msg)
+	}
+	s.redirectWithMessage(w, r, returnURL, msg)
+}
+
+// beginAction applies the shared guards for state-changing moderation forms:
+// same-origin submission, a parseable form, and an authenticated operator
+// whose ID is written to the audit log.
+func (s handlers) beginAction(w http.ResponseWriter, r *http.Request) (*message.Printer, string, bool) {
+	loc, _ := s.base.Localizer(w, r)
+	if !modulehandler.RequireSameOrigin(w, r, loc) {
+		return loc, "", false
+	}
+	if err := r.ParseForm(); err != nil {
+		adminerrors.LogError(r, "parse moderation form: %v", err)
+		s.redirectWithMessage(w, r, routepath.AppModeration, loc.Sprintf("moderation.error.parse_failed"))
+		return loc, "", false
+	}
+	actorID := strings.TrimSpace(requestctx.UserIDFromContext(r.Context()))
+	if actorID == "" {
+		s.redirectWithMessage(w, r, routepath.AppModeration, loc.Sprintf("moderation.error.operator_required"))
+		return loc, "", false
+	}
+	return loc, actorID, true
+}
+
+// recordAction writes a moderation audit entry for actions applied by other
+// services. It reports false when the audit write fails so the operator can
+// see that the log is incomplete.
+func (s handlers) recordAction(r *http.Request, action string, kind authv1.ModerationTargetKind, targetID, actorID, reason string) bool {
+	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
+	defer cancel()
+	if _, err := s.moderationClient.RecordModerationAction(ctx, &authv1.RecordModerationActionRequest{
+		Action:      action,
+		TargetKind:  kind,
+		TargetId:    targetID,
+		ActorUserId: actorID,
+		Reason:      reason,
+	}); err != nil {
+		adminerrors.LogError(r, "record moderation action: %v", err)
+		return false
+	}
+	return true
+}
+
+func (s handlers) redirectWithMessage(w http.ResponseWriter, r *http.Request, target, msg string) {
+	redirectURL := templates.AppendQueryParam(target, "message", msg)
+	if s.base.IsHTMXRequest(r) {
+		w.Header().Set("Location", redirectURL)
+		w.Header().Set("HX-Redirect", redirectURL)
+		w.WriteHeader(http.StatusSeeOther)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+func (s handlers) renderAuditTable(w http.ResponseWriter, r *http.Request, view templates.ModerationAuditView, loc *message.Printer) {
+	templ.Handler(templates.ModerationAuditTable(view, loc)).ServeHTTP(w, r)
+}
+
+// actionFailedMessage surfaces the backend validation message to operators;
+// moderation RPC errors are written for operator display.
+func actionFailedMessage(loc *message.Printer, err error) string {
+	return loc.Sprintf("moderation.error.action_failed", status.Convert(err).Message())
+}
+
+func parseAuditFilter(r *http.Request) templates.ModerationAuditFilter {
+	query := r.URL.Query()
+	filter := templates.ModerationAuditFilter{
+		TargetKind: strings.TrimSpace(query.Get("target_kind")),
+		TargetID:   strings.TrimSpace(query.Get("target_id")),
+		PageToken:  strings.TrimSpace(query.Get("page_token")),
+	}
+	if targetKindToProto(filter.TargetKind) == authv1.ModerationTargetKind_MODERATION_TARGET_KIND_UNSPECIFIED || filter.TargetID == "" {
+		filter.TargetKind = ""
+		filter.TargetID = ""
+	}
+	return filter
+}
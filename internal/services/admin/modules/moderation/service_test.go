@@ -0,0 +1,293 @@
+package moderation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/platform/modulehandler"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeModerationClient struct {
+	authv1.ModerationServiceClient
+	suspendReq   *authv1.SuspendUserRequest
+	suspendErr   error
+	recorded     []*authv1.RecordModerationActionRequest
+	listReq      *authv1.ListModerationActionsRequest
+	listResp     *authv1.ListModerationActionsResponse
+	listErr      error
+	unsuspendReq *authv1.UnsuspendUserRequest
+}
+
+func (c *fakeModerationClient) SuspendUser(_ context.Context, req *authv1.SuspendUserRequest, _ ...grpc.CallOption) (*authv1.SuspendUserResponse, error) {
+	c.suspendReq = req
+	if c.suspendErr != nil {
+		return nil, c.suspendErr
+	}
+	return &authv1.SuspendUserResponse{}, nil
+}
+
+func (c *fakeModerationClient) UnsuspendUser(_ context.Context, req *authv1.UnsuspendUserRequest, _ ...grpc.CallOption) (*authv1.UnsuspendUserResponse, error) {
+	c.unsuspendReq = req
+	return &authv1.UnsuspendUserResponse{}, nil
+}
+
+func (c *fakeModerationClient) RecordModerationAction(_ context.Context, req *authv1.RecordModerationActionRequest, _ ...grpc.CallOption) (*authv1.RecordModerationActionResponse, error) {
+	c.recorded = append(c.recorded, req)
+	return &authv1.RecordModerationActionResponse{}, nil
+}
+
+func (c *fakeModerationClient) ListModerationActions(_ context.Context, req *authv1.ListModerationActionsRequest, _ ...grpc.CallOption) (*authv1.ListModerationActionsResponse, error) {
+	c.listReq = req
+	if c.listErr != nil {
+		return nil, c.listErr
+	}
+	return c.listResp, nil
+}
+
+type fakeCampaignClient struct {
+	statev1.CampaignServiceClient
+	lockReq *statev1.SetCampaignModerationLockRequest
+	lockErr error
+}
+
+func (c *fakeCampaignClient) SetCampaignModerationLock(_ context.Context, req *statev1.SetCampaignModerationLockRequest, _ ...grpc.CallOption) (*statev1.SetCampaignModerationLockResponse, error) {
+	c.lockReq = req
+	if c.lockErr != nil {
+		return nil, c.lockErr
+	}
+	return &statev1.SetCampaignModerationLockResponse{}, nil
+}
+
+type fakeDiscoveryClient struct {
+	discoveryv1.DiscoveryServiceClient
+	hiddenReq *discoveryv1.SetDiscoveryEntryHiddenRequest
+}
+
+func (c *fakeDiscoveryClient) SetDiscoveryEntryHidden(_ context.Context, req *discoveryv1.SetDiscoveryEntryHiddenRequest, _ ...grpc.CallOption) (*discoveryv1.SetDiscoveryEntryHiddenResponse, error) {
+	c.hiddenReq = req
+	return &discoveryv1.SetDiscoveryEntryHiddenResponse{}, nil
+}
+
+func newTestHandlers(mod *fakeModerationClient, campaigns *fakeCampaignClient, discovery *fakeDiscoveryClient) handlers {
+	h := NewHandlers(modulehandler.NewBase(), mod, campaigns, discovery).(handlers)
+	h.now = func() time.Time { return time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC) }
+	return h
+}
+
+func newFormRequest(path string, form url.Values, operatorID string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "http://admin.test"+path, strings.NewReader(form.Encode()))
+	req.Host = "admin.test"
+	req.Header.Set("Origin", "http://admin.test")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if operatorID != "" {
+		req = req.WithContext(requestctx.WithUserID(req.Context(), operatorID))
+	}
+	return req
+}
+
+func redirectMessage(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusSeeOther)
+	}
+	location, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("parse location: %v", err)
+	}
+	return location.Query().Get("message")
+}
+
+func TestHandleSuspendUser(t *testing.T) {
+	t.Parallel()
+
+	mod := &fakeModerationClient{}
+	h := newTestHandlers(mod, &fakeCampaignClient{}, &fakeDiscoveryClient{})
+
+	rec := httptest.NewRecorder()
+	h.HandleSuspendUser(rec, newFormRequest("/app/moderation/suspend", url.Values{
+		"user_id":        {"user-1"},
+		"reason":         {"spam"},
+		"duration_hours": {"48"},
+	}, "op-1"))
+
+	if got := redirectMessage(t, rec); got != "User user-1 suspended." {
+		t.Fatalf("message = %q", got)
+	}
+	if mod.suspendReq.GetActorUserId() != "op-1" || mod.suspendReq.GetReason() != "spam" {
+		t.Fatalf("suspend request = %+v", mod.suspendReq)
+	}
+	wantExpiry := time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)
+	if !mod.suspendReq.GetExpiresAt().AsTime().Equal(wantExpiry) {
+		t.Fatalf("expires_at = %v, want %v", mod.suspendReq.GetExpiresAt().AsTime(), wantExpiry)
+	}
+}
+
+func TestHandleSuspendUserRejectsBadInput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cross-origin post", func(t *testing.T) {
+		mod := &fakeModerationClient{}
+		h := newTestHandlers(mod, &fakeCampaignClient{}, &fakeDiscoveryClient{})
+		req := newFormRequest("/app/moderation/suspend", url.Values{"user_id": {"user-1"}}, "op-1")
+		req.Header.Set("Origin", "http://evil.test")
+		rec := httptest.NewRecorder()
+		h.HandleSuspendUser(rec, req)
+		if rec.Code != http.StatusForbidden || mod.suspendReq != nil {
+			t.Fatalf("status = %d, suspend request = %+v", rec.Code, mod.suspendReq)
+		}
+	})
+
+	t.Run("missing operator", func(t *testing.T) {
+		mod := &fakeModerationClient{}
+		h := newTestHandlers(mod, &fakeCampaignClient{}, &fakeDiscoveryClient{})
+		rec := httptest.NewRecorder()
+		h.HandleSuspendUser(rec, newFormRequest("/app/moderation/suspend", url.Values{"user_id": {"user-1"}}, ""))
+		if got := redirectMessage(t, rec); !strings.Contains(got, "operator") || mod.suspendReq != nil {
+			t.Fatalf("message = %q, suspend request = %+v", got, mod.suspendReq)
+		}
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		mod := &fakeModerationClient{}
+		h := newTestHandlers(mod, &fakeCampaignClient{}, &fakeDiscoveryClient{})
+		rec := httptest.NewRecorder()
+		h.HandleSuspendUser(rec, newFormRequest("/app/moderation/suspend", url.Values{"user_id": {"user-1"}, "duration_hours": {"-3"}}, "op-1"))
+		if got := redirectMessage(t, rec); !strings.Contains(got, "Duration") || mod.suspendReq != nil {
+			t.Fatalf("message = %q, suspend request = %+v", got, mod.suspendReq)
+		}
+	})
+
+	t.Run("backend validation error", func(t *testing.T) {
+		mod := &fakeModerationClient{suspendErr: status.Error(codes.InvalidArgument, "Reason is required.")}
+		h := newTestHandlers(mod, &fakeCampaignClient{}, &fakeDiscoveryClient{})
+		rec := httptest.NewRecorder()
+		h.HandleSuspendUser(rec, newFormRequest("/app/moderation/suspend", url.Values{"user_id": {"user-1"}}, "op-1"))
+		if got := redirectMessage(t, rec); got != "Moderation action failed: Reason is required." {
+			t.Fatalf("message = %q", got)
+		}
+	})
+}
+
+func TestHandleCampaignLockRecordsAuditAction(t *testing.T) {
+	t.Parallel()
+
+	mod := &fakeModerationClient{}
+	campaigns := &fakeCampaignClient{}
+	h := newTestHandlers(mod, campaigns, &fakeDiscoveryClient{})
+
+	rec := httptest.NewRecorder()
+	h.HandleCampaignLock(rec, newFormRequest("/app/moderation/campaign-lock", url.Values{
+		"campaign_id": {"camp-1"},
+		"reason":      {"abuse report"},
+		"action":      {"lock"},
+	}, "op-1"))
+
+	if got := redirectMessage(t, rec); got != "Campaign camp-1 locked." {
+		t.Fatalf("message = %q", got)
+	}
+	if !campaigns.lockReq.GetLocked() || campaigns.lockReq.GetReason() != "abuse report" {
+		t.Fatalf("lock request = %+v", campaigns.lockReq)
+	}
+	if len(mod.recorded) != 1 || mod.recorded[0].GetAction() != actionCampaignLock ||
+		mod.recorded[0].GetTargetKind() != authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN {
+		t.Fatalf("recorded = %+v", mod.recorded)
+	}
+
+	failing := &fakeCampaignClient{lockErr: status.Error(codes.NotFound, "campaign not found")}
+	mod = &fakeModerationClient{}
+	h = newTestHandlers(mod, failing, &fakeDiscoveryClient{})
+	rec = httptest.NewRecorder()
+	h.HandleCampaignLock(rec, newFormRequest("/app/moderation/campaign-lock", url.Values{"campaign_id": {"camp-9"}, "action": {"unlock"}}, "op-1"))
+	if got := redirectMessage(t, rec); got != "Moderation action failed: campaign not found" || len(mod.recorded) != 0 {
+		t.Fatalf("message = %q, recorded = %+v", got, mod.recorded)
+	}
+}
+
+func TestHandleDiscoveryVisibility(t *testing.T) {
+	t.Parallel()
+
+	mod := &fakeModerationClient{}
+	discovery := &fakeDiscoveryClient{}
+	h := newTestHandlers(mod, &fakeCampaignClient{}, discovery)
+
+	rec := httptest.NewRecorder()
+	h.HandleDiscoveryVisibility(rec, newFormRequest("/app/moderation/discovery-visibility", url.Values{
+		"entry_id": {"entry-1"},
+		"reason":   {"copyright"},
+		"action":   {"hide"},
+	}, "op-1"))
+
+	if got := redirectMessage(t, rec); got != "Discovery entry entry-1 hidden." {
+		t.Fatalf("message = %q", got)
+	}
+	if !discovery.hiddenReq.GetHidden() || discovery.hiddenReq.GetEntryId() != "entry-1" {
+		t.Fatalf("hidden request = %+v", discovery.hiddenReq)
+	}
+	if len(mod.recorded) != 1 || mod.recorded[0].GetAction() != actionDiscoveryEntryHide {
+		t.Fatalf("recorded = %+v", mod.recorded)
+	}
+
+	rec = httptest.NewRecorder()
+	h.HandleDiscoveryVisibility(rec, newFormRequest("/app/moderation/discovery-visibility", url.Values{"entry_id": {"entry-1"}, "action": {"delete"}}, "op-1"))
+	if got := redirectMessage(t, rec); got != "Unknown moderation action." {
+		t.Fatalf("message = %q", got)
+	}
+}
+
+func TestHandleModerationTable(t *testing.T) {
+	t.Parallel()
+
+	mod := &fakeModerationClient{listResp: &authv1.ListModerationActionsResponse{
+		Actions: []*authv1.ModerationAction{{
+			Id:          "act-1",
+			Action:      "user.suspend",
+			TargetKind:  authv1.ModerationTargetKind_MODERATION_TARGET_KIND_USER,
+			TargetId:    "user-1",
+			ActorUserId: "op-1",
+			Reason:      "spam",
+			CreatedAt:   timestamppb.New(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)),
+		}},
+		NextPageToken: "next-1",
+	}}
+	h := newTestHandlers(mod, &fakeCampaignClient{}, &fakeDiscoveryClient{})
+
+	rec := httptest.NewRecorder()
+	h.HandleModerationTable(rec, httptest.NewRequest(http.MethodGet, "/app/moderation?fragment=rows&target_kind=user&target_id=user-1", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	if mod.listReq.GetTargetKind() != authv1.ModerationTargetKind_MODERATION_TARGET_KIND_USER || mod.listReq.GetTargetId() != "user-1" {
+		t.Fatalf("list request = %+v", mod.listReq)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{"user.suspend", "/app/users/user-1", "spam", "page_token=next-1"} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("body = %q, want substring %q", body, want)
+		}
+	}
+
+	mod = &fakeModerationClient{listErr: status.Error(codes.Unavailable, "down")}
+	h = newTestHandlers(mod, &fakeCampaignClient{}, &fakeDiscoveryClient{})
+	rec = httptest.NewRecorder()
+	h.HandleModerationTable(rec, httptest.NewRequest(http.MethodGet, "/app/moderation?fragment=rows&target_kind=bogus&target_id=x", nil))
+	if !strings.Contains(rec.Body.String(), "Moderation log is unavailable.") {
+		t.Fatalf("body = %q", rec.Body.String())
+	}
+	if mod.listReq.GetTargetKind() != authv1.ModerationTargetKind_MODERATION_TARGET_KIND_UNSPECIFIED || mod.listReq.GetTargetId() != "" {
+		t.Fatalf("invalid filter should be dropped, got %+v", mod.listReq)
+	}
+}
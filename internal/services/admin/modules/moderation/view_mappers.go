@@ -0,0 +1,66 @@
+package moderation
+
+import (
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/eventview"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/routepath"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/templates"
+	"golang.org/x/text/message"
+)
+
+// Audit filter values accepted in the target_kind query parameter.
+const (
+	targetKindUser           = "user"
+	targetKindCampaign       = "campaign"
+	targetKindDiscoveryEntry = "discovery_entry"
+)
+
+func targetKindToProto(kind string) authv1.ModerationTargetKind {
+	switch kind {
+	case targetKindUser:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_USER
+	case targetKindCampaign:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN
+	case targetKindDiscoveryEntry:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_DISCOVERY_ENTRY
+	default:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_UNSPECIFIED
+	}
+}
+
+func buildActionRows(actions []*authv1.ModerationAction, loc *message.Printer) []templates.ModerationActionRow {
+	rows := make([]templates.ModerationActionRow, 0, len(actions))
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+		kindLabel, targetURL := formatTarget(action.GetTargetKind(), action.GetTargetId(), loc)
+		rows = append(rows, templates.ModerationActionRow{
+			ID:          action.GetId(),
+			CreatedAt:   eventview.FormatTimestamp(action.GetCreatedAt()),
+			Action:      action.GetAction(),
+			TargetKind:  kindLabel,
+			TargetID:    action.GetTargetId(),
+			TargetURL:   targetURL,
+			ActorUserID: action.GetActorUserId(),
+			Reason:      action.GetReason(),
+			ExpiresAt:   eventview.FormatTimestamp(action.GetExpiresAt()),
+		})
+	}
+	return rows
+}
+
+// formatTarget returns the localized target kind and, when admin has a
+// detail page for the target, a link to it.
+func formatTarget(kind authv1.ModerationTargetKind, targetID string, loc *message.Printer) (string, string) {
+	switch kind {
+	case authv1.ModerationTargetKind_MODERATION_TARGET_KIND_USER:
+		return loc.Sprintf("moderation.target.user"), routepath.UserDetail(targetID)
+	case authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN:
+		return loc.Sprintf("moderation.target.campaign"), routepath.Campaign(targetID)
+	case authv1.ModerationTargetKind_MODERATION_TARGET_KIND_DISCOVERY_ENTRY:
+		return loc.Sprintf("moderation.target.discovery_entry"), ""
+	default:
+		return loc.Sprintf("label.unspecified"), ""
+	}
+}
@@ -2,6 +2,7 @@ package modules
 
 import (
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
@@ -10,6 +11,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/catalog"
 	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/dashboard"
 	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/icons"
+	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/moderation"
 	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/scenarios"
 	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/status"
 	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/systems"
@@ -24,12 +26,15 @@ type BuildInput struct {
 
 	// Individual gRPC clients used by modules.
 	AuthClient               authv1.AuthServiceClient
+	ModerationClient         authv1.ModerationServiceClient
+	DiscoveryClient          discoveryv1.DiscoveryServiceClient
 	CampaignClient           statev1.CampaignServiceClient
 	CharacterClient          statev1.CharacterServiceClient
 	ParticipantClient        statev1.ParticipantServiceClient
 	InviteClient             invitev1.InviteServiceClient
 	SessionClient            statev1.SessionServiceClient
 	EventClient              statev1.EventServiceClient
+	ForkClient               statev1.ForkServiceClient
 	StatisticsClient         statev1.StatisticsServiceClient
 	SystemClient             statev1.SystemServiceClient
 	DaggerheartContentClient daggerheartv1.DaggerheartContentServiceClient
@@ -67,12 +72,19 @@ func (Registry) Build(input BuildInput) BuildOutput {
 			input.InviteClient,
 			input.SessionClient,
 			input.EventClient,
+			input.ForkClient,
 			input.AuthClient,
 		)),
 		systems.New(systems.NewHandlers(input.Base, input.SystemClient)),
 		catalog.New(catalog.NewHandlers(input.Base, input.DaggerheartContentClient)),
 		icons.New(icons.NewHandlers(input.Base)),
 		users.New(users.NewHandlers(input.Base, input.AuthClient, input.InviteClient)),
+		moderation.New(moderation.NewHandlers(
+			input.Base,
+			input.ModerationClient,
+			input.CampaignClient,
+			input.DiscoveryClient,
+		)),
 		scenarios.New(scenarios.NewHandlers(input.Base, input.GRPCAddr, input.EventClient, input.CampaignClient)),
 		status.New(status.NewHandlers(input.Base, input.StatusClient)),
 	}}
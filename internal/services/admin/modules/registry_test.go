@@ -6,8 +6,8 @@ func TestNewRegistryBuildsDefaultModuleOrder(t *testing.T) {
 	t.Parallel()
 
 	built := NewRegistry().Build(BuildInput{})
-	if len(built.Modules) != 8 {
-		t.Fatalf("module count = %d, want %d", len(built.Modules), 8)
+	if len(built.Modules) != 9 {
+		t.Fatalf("module count = %d, want %d", len(built.Modules), 9)
 	}
 
 	want := []string{
@@ -17,6 +17,7 @@ func TestNewRegistryBuildsDefaultModuleOrder(t *testing.T) {
 		"catalog",
 		"icons",
 		"users",
+		"moderation",
 		"scenarios",
 		"status",
 	}
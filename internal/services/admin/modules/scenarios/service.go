@@ -6,7 +6,6 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -109,14 +108,17 @@ func (s *handlers) HandleScenarioEventsTable(w http.ResponseWriter, r *http.Requ
 	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
 	defer cancel()
 
-	filterExpr := eventview.BuildEventFilterExpression(filters)
-	eventsResp, err := s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
-		CampaignId: campaignID,
-		PageSize:   eventListPageSize,
-		PageToken:  pageToken,
-		OrderBy:    "seq desc",
-		Filter:     filterExpr,
-	})
+	filterExpr, err := eventview.BuildEventFilterExpression(filters)
+	var eventsResp *statev1.ListEventsResponse
+	if err == nil {
+		eventsResp, err = s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
+			CampaignId: campaignID,
+			PageSize:   eventListPageSize,
+			PageToken:  pageToken,
+			OrderBy:    "seq desc",
+			Filter:     filterExpr,
+		})
+	}
 	if err != nil {
 		adminerrors.LogError(r, "list scenario events: %v", err)
 		message = loc.Sprintf("error.events_unavailable")
@@ -154,7 +156,7 @@ func (s *handlers) HandleScenarioTimelineTable(w http.ResponseWriter, r *http.Re
 
 func (s *handlers) handleScenarioRun(w http.ResponseWriter, r *http.Request) {
 	loc, lang := s.base.Localizer(w, r)
-	if !requireSameOrigin(w, r, loc) {
+	if !modulehandler.RequireSameOrigin(w, r, loc) {
 		return
 	}
 	if err := r.ParseForm(); err != nil {
@@ -230,14 +232,17 @@ func (s *handlers) buildScenarioEventsView(r *http.Request, campaignID string, l
 	ctx, cancel := s.base.GameGRPCCallContext(r.Context())
 	defer cancel()
 
-	filterExpr := eventview.BuildEventFilterExpression(filters)
-	eventsResp, err := s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
-		CampaignId: campaignID,
-		PageSize:   eventListPageSize,
-		PageToken:  pageToken,
-		OrderBy:    "seq desc",
-		Filter:     filterExpr,
-	})
+	filterExpr, err := eventview.BuildEventFilterExpression(filters)
+	var eventsResp *statev1.ListEventsResponse
+	if err == nil {
+		eventsResp, err = s.eventClient.ListEvents(ctx, &statev1.ListEventsRequest{
+			CampaignId: campaignID,
+			PageSize:   eventListPageSize,
+			PageToken:  pageToken,
+			OrderBy:    "seq desc",
+			Filter:     filterExpr,
+		})
+	}
 	if err != nil {
 		adminerrors.LogError(r, "list scenario events: %v", err)
 		message = loc.Sprintf("error.events_unavailable")
@@ -395,57 +400,3 @@ func parseScenarioCampaignID(logs string) string {
 	}
 	return ""
 }
-
-func requireSameOrigin(w http.ResponseWriter, r *http.Request, loc *message.Printer) bool {
-	if r == nil {
-		http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
-		return false
-	}
-	if origin := strings.TrimSpace(r.Header.Get("Origin")); origin != "" {
-		if !sameOrigin(origin, r) {
-			http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
-			return false
-		}
-		return true
-	}
-	if referer := strings.TrimSpace(r.Referer()); referer != "" {
-		if !sameOrigin(referer, r) {
-			http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
-			return false
-		}
-		return true
-	}
-	http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
-	return false
-}
-
-func sameOrigin(rawURL string, r *http.Request) bool {
-	if rawURL == "" || rawURL == "null" || r == nil {
-		return false
-	}
-	parsed, err := url.Parse(rawURL)
-	if err != nil || parsed.Host == "" {
-		return false
-	}
-	if !strings.EqualFold(parsed.Host, r.Host) {
-		return false
-	}
-	if parsed.Scheme != "" {
-		return strings.EqualFold(parsed.Scheme, requestScheme(r))
-	}
-	return true
-}
-
-func requestScheme(r *http.Request) string {
-	if r == nil {
-		return "http"
-	}
-	if proto := strings.TrimSpace(r.Header.Get("X-Forwarded-Proto")); proto != "" {
-		parts := strings.Split(proto, ",")
-		return strings.ToLower(strings.TrimSpace(parts[0]))
-	}
-	if r.TLS != nil {
-		return "https"
-	}
-	return "http"
-}
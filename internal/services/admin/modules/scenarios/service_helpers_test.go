@@ -45,74 +45,6 @@ func TestScenarioHelpersScenarioScript(t *testing.T) {
 	}
 }
 
-func TestScenarioHelpersOriginChecks(t *testing.T) {
-	loc := i18nhttp.Printer(i18nhttp.Default())
-
-	req := httptest.NewRequest(http.MethodPost, "http://example.com/app/scenarios", nil)
-	req.Host = "example.com"
-	req.Header.Set("Origin", "http://example.com")
-	rec := httptest.NewRecorder()
-	if !requireSameOrigin(rec, req, loc) {
-		t.Fatal("requireSameOrigin() rejected valid Origin")
-	}
-
-	req = httptest.NewRequest(http.MethodPost, "http://example.com/app/scenarios", nil)
-	req.Host = "example.com"
-	req.Header.Set("Referer", "http://example.com/app/scenarios")
-	rec = httptest.NewRecorder()
-	if !requireSameOrigin(rec, req, loc) {
-		t.Fatal("requireSameOrigin() rejected valid Referer")
-	}
-
-	req = httptest.NewRequest(http.MethodPost, "http://example.com/app/scenarios", nil)
-	req.Host = "example.com"
-	rec = httptest.NewRecorder()
-	if requireSameOrigin(rec, req, loc) {
-		t.Fatal("requireSameOrigin() accepted missing Origin/Referer")
-	}
-	if rec.Code != http.StatusForbidden {
-		t.Fatalf("requireSameOrigin() status = %d", rec.Code)
-	}
-
-	if requireSameOrigin(httptest.NewRecorder(), nil, loc) {
-		t.Fatal("requireSameOrigin() accepted nil request")
-	}
-
-	request := httptest.NewRequest(http.MethodGet, "http://example.com/page", nil)
-	request.Host = "example.com"
-	if !sameOrigin("http://example.com", request) {
-		t.Fatal("sameOrigin() rejected matching origin")
-	}
-	if !sameOrigin("http://EXAMPLE.COM", request) {
-		t.Fatal("sameOrigin() should be case-insensitive")
-	}
-	if sameOrigin("http://other.example.com", request) {
-		t.Fatal("sameOrigin() accepted different host")
-	}
-	if sameOrigin("null", request) {
-		t.Fatal("sameOrigin() accepted null origin")
-	}
-	if sameOrigin("://bad", request) {
-		t.Fatal("sameOrigin() accepted invalid URL")
-	}
-	if sameOrigin("http://example.com", nil) {
-		t.Fatal("sameOrigin() accepted nil request")
-	}
-
-	defaultReq := httptest.NewRequest(http.MethodGet, "/", nil)
-	if got := requestScheme(defaultReq); got != "http" {
-		t.Fatalf("requestScheme(default) = %q", got)
-	}
-	fwdReq := httptest.NewRequest(http.MethodGet, "/", nil)
-	fwdReq.Header.Set("X-Forwarded-Proto", "https, http")
-	if got := requestScheme(fwdReq); got != "https" {
-		t.Fatalf("requestScheme(X-Forwarded-Proto) = %q", got)
-	}
-	if got := requestScheme(nil); got != "http" {
-		t.Fatalf("requestScheme(nil) = %q", got)
-	}
-}
-
 func TestScenarioHelpersFiltersAndFormatting(t *testing.T) {
 	loc := i18nhttp.Printer(i18nhttp.Default())
 	now := timestamppb.New(time.Date(2026, time.March, 2, 15, 4, 5, 0, time.UTC))
@@ -127,7 +59,7 @@ func TestScenarioHelpersFiltersAndFormatting(t *testing.T) {
 		t.Fatalf("eventview.ParseEventFilters() = %#v", filters)
 	}
 
-	expression := eventview.BuildEventFilterExpression(templates.EventFilterOptions{
+	expression, err := eventview.BuildEventFilterExpression(templates.EventFilterOptions{
 		SessionID:  "s-1",
 		EventType:  `a"b\c`,
 		ActorType:  "participant",
@@ -135,6 +67,9 @@ func TestScenarioHelpersFiltersAndFormatting(t *testing.T) {
 		StartDate:  "2026-01-01",
 		EndDate:    "2026-01-02",
 	})
+	if err != nil {
+		t.Fatalf("eventview.BuildEventFilterExpression() error = %v", err)
+	}
 	if !strings.Contains(expression, `session_id = "s-1"`) || !strings.Contains(expression, `type = "a\"b\\c"`) {
 		t.Fatalf("eventview.BuildEventFilterExpression() = %q", expression)
 	}
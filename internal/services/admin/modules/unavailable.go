@@ -4,6 +4,7 @@ import (
 	"context"
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
@@ -34,6 +35,12 @@ func (input *BuildInput) ensureClients() {
 	if input.AuthClient == nil {
 		input.AuthClient = authv1.NewAuthServiceClient(conn)
 	}
+	if input.ModerationClient == nil {
+		input.ModerationClient = authv1.NewModerationServiceClient(conn)
+	}
+	if input.DiscoveryClient == nil {
+		input.DiscoveryClient = discoveryv1.NewDiscoveryServiceClient(conn)
+	}
 	if input.CampaignClient == nil {
 		input.CampaignClient = statev1.NewCampaignServiceClient(conn)
 	}
@@ -52,6 +59,9 @@ func (input *BuildInput) ensureClients() {
 	if input.EventClient == nil {
 		input.EventClient = statev1.NewEventServiceClient(conn)
 	}
+	if input.ForkClient == nil {
+		input.ForkClient = statev1.NewForkServiceClient(conn)
+	}
 	if input.StatisticsClient == nil {
 		input.StatisticsClient = statev1.NewStatisticsServiceClient(conn)
 	}
@@ -63,6 +63,22 @@ func TestUserHelpersBuildersAndFormatters(t *testing.T) {
 	if got := buildUserDetail(nil); got != nil {
 		t.Fatalf("buildUserDetail(nil) = %#v", got)
 	}
+	suspended := buildUserDetail(&authv1.User{
+		Id:               "user-2",
+		SuspendedAt:      now,
+		SuspensionReason: "spam",
+	})
+	if !suspended.Suspended || suspended.SuspensionReason != "spam" || suspended.SuspendedUntil != "" {
+		t.Fatalf("buildUserDetail(suspended) = %#v", suspended)
+	}
+	lapsed := buildUserDetail(&authv1.User{
+		Id:             "user-3",
+		SuspendedAt:    now,
+		SuspendedUntil: timestamppb.New(time.Now().Add(-time.Hour)),
+	})
+	if lapsed.Suspended {
+		t.Fatalf("buildUserDetail(expired suspension) = %#v", lapsed)
+	}
 
 	label, variant := formatInviteStatus(invitev1.InviteStatus_PENDING, loc)
 	if label != loc.Sprintf("label.invite_pending") || variant != "warning" {
@@ -1,6 +1,8 @@
 package users
 
 import (
+	"time"
+
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/admin/modules/eventview"
@@ -36,12 +38,20 @@ func buildUserDetail(user *authv1.User) *templates.UserDetail {
 	if username == "" {
 		username = user.GetId()
 	}
-	return &templates.UserDetail{
+	detail := &templates.UserDetail{
 		ID:        user.GetId(),
 		Username:  username,
 		CreatedAt: eventview.FormatTimestamp(user.GetCreatedAt()),
 		UpdatedAt: eventview.FormatTimestamp(user.GetUpdatedAt()),
 	}
+	// Expired suspensions stay on the account row until lifted but no longer
+	// block sign-in, so only active ones are surfaced.
+	if until := user.GetSuspendedUntil(); user.GetSuspendedAt() != nil && (until == nil || until.AsTime().After(time.Now())) {
+		detail.Suspended = true
+		detail.SuspensionReason = user.GetSuspensionReason()
+		detail.SuspendedUntil = eventview.FormatTimestamp(user.GetSuspendedUntil())
+	}
+	return detail
 }
 
 func formatInviteStatus(status invitev1.InviteStatus, loc *message.Printer) (string, string) {
@@ -0,0 +1,65 @@
+package modulehandler
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/text/message"
+)
+
+// RequireSameOrigin rejects state-changing requests whose Origin or Referer
+// does not match the admin host. It writes a 403 and returns false on rejection.
+func RequireSameOrigin(w http.ResponseWriter, r *http.Request, loc *message.Printer) bool {
+	if r == nil {
+		http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
+		return false
+	}
+	if origin := strings.TrimSpace(r.Header.Get("Origin")); origin != "" {
+		if !sameOrigin(origin, r) {
+			http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
+			return false
+		}
+		return true
+	}
+	if referer := strings.TrimSpace(r.Referer()); referer != "" {
+		if !sameOrigin(referer, r) {
+			http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
+			return false
+		}
+		return true
+	}
+	http.Error(w, loc.Sprintf("error.csrf_invalid"), http.StatusForbidden)
+	return false
+}
+
+func sameOrigin(rawURL string, r *http.Request) bool {
+	if rawURL == "" || rawURL == "null" || r == nil {
+		return false
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	if !strings.EqualFold(parsed.Host, r.Host) {
+		return false
+	}
+	if parsed.Scheme != "" {
+		return strings.EqualFold(parsed.Scheme, requestScheme(r))
+	}
+	return true
+}
+
+func requestScheme(r *http.Request) string {
+	if r == nil {
+		return "http"
+	}
+	if proto := strings.TrimSpace(r.Header.Get("X-Forwarded-Proto")); proto != "" {
+		parts := strings.Split(proto, ",")
+		return strings.ToLower(strings.TrimSpace(parts[0]))
+	}
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
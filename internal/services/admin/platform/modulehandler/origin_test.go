@@ -0,0 +1,77 @@
+package modulehandler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/shared/i18nhttp"
+)
+
+func TestRequireSameOrigin(t *testing.T) {
+	loc := i18nhttp.Printer(i18nhttp.Default())
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/app/scenarios", nil)
+	req.Host = "example.com"
+	req.Header.Set("Origin", "http://example.com")
+	rec := httptest.NewRecorder()
+	if !RequireSameOrigin(rec, req, loc) {
+		t.Fatal("RequireSameOrigin() rejected valid Origin")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "http://example.com/app/scenarios", nil)
+	req.Host = "example.com"
+	req.Header.Set("Referer", "http://example.com/app/scenarios")
+	rec = httptest.NewRecorder()
+	if !RequireSameOrigin(rec, req, loc) {
+		t.Fatal("RequireSameOrigin() rejected valid Referer")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "http://example.com/app/scenarios", nil)
+	req.Host = "example.com"
+	rec = httptest.NewRecorder()
+	if RequireSameOrigin(rec, req, loc) {
+		t.Fatal("RequireSameOrigin() accepted missing Origin/Referer")
+	}
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("RequireSameOrigin() status = %d", rec.Code)
+	}
+
+	if RequireSameOrigin(httptest.NewRecorder(), nil, loc) {
+		t.Fatal("RequireSameOrigin() accepted nil request")
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/page", nil)
+	request.Host = "example.com"
+	if !sameOrigin("http://example.com", request) {
+		t.Fatal("sameOrigin() rejected matching origin")
+	}
+	if !sameOrigin("http://EXAMPLE.COM", request) {
+		t.Fatal("sameOrigin() should be case-insensitive")
+	}
+	if sameOrigin("http://other.example.com", request) {
+		t.Fatal("sameOrigin() accepted different host")
+	}
+	if sameOrigin("null", request) {
+		t.Fatal("sameOrigin() accepted null origin")
+	}
+	if sameOrigin("://bad", request) {
+		t.Fatal("sameOrigin() accepted invalid URL")
+	}
+	if sameOrigin("http://example.com", nil) {
+		t.Fatal("sameOrigin() accepted nil request")
+	}
+
+	defaultReq := httptest.NewRequest(http.MethodGet, "/", nil)
+	if got := requestScheme(defaultReq); got != "http" {
+		t.Fatalf("requestScheme(default) = %q", got)
+	}
+	fwdReq := httptest.NewRequest(http.MethodGet, "/", nil)
+	fwdReq.Header.Set("X-Forwarded-Proto", "https, http")
+	if got := requestScheme(fwdReq); got != "https" {
+		t.Fatalf("requestScheme(X-Forwarded-Proto) = %q", got)
+	}
+	if got := requestScheme(nil); got != "http" {
+		t.Fatalf("requestScheme(nil) = %q", got)
+	}
+}
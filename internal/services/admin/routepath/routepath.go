@@ -71,6 +71,17 @@ const (
 	UsersPrefix = "/app/users/"
 )
 
+const (
+	AppModeration              = "/app/moderation"
+	Moderation                 = AppModeration
+	ModerationRows             = "/app/moderation?fragment=rows"
+	ModerationPrefix           = "/app/moderation/"
+	ModerationSuspend          = "/app/moderation/suspend"
+	ModerationUnsuspend        = "/app/moderation/unsuspend"
+	ModerationCampaignLock     = "/app/moderation/campaign-lock"
+	ModerationDiscoveryVisible = "/app/moderation/discovery-visibility"
+)
+
 const (
 	AppScenarios    = "/app/scenarios"
 	Scenarios       = AppScenarios
@@ -89,6 +100,7 @@ const (
 	AppCampaignSessionPattern           = CampaignsPrefix + "{campaignID}/sessions/{sessionID}"
 	AppCampaignSessionEventsPattern     = CampaignsPrefix + "{campaignID}/sessions/{sessionID}/events"
 	AppCampaignEventsPattern            = CampaignsPrefix + "{campaignID}/events"
+	AppCampaignDiffPattern              = CampaignsPrefix + "{campaignID}/diff"
 )
 
 const (
@@ -198,6 +210,10 @@ func CampaignEventsTable(campaignID string) string {
 	return CampaignEventsRows(campaignID)
 }
 
+func CampaignDiff(campaignID string) string {
+	return Campaign(campaignID) + "/diff"
+}
+
 func System(systemID string) string {
 	return AppSystems + "/" + escapeSegment(systemID)
 }
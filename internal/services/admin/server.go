@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
@@ -33,6 +34,8 @@ type Config struct {
 	AuthAddr   string
 	InviteAddr string
 	StatusAddr string
+	// DiscoveryAddr connects the moderation module to discovery entry controls.
+	DiscoveryAddr string
 	// AuthConfig enables token-based authentication when set.
 	AuthConfig *AuthConfig
 	// StatusReporter receives health transitions for dependency capabilities.
@@ -48,6 +51,8 @@ type Server struct {
 	inviteMc *platformgrpc.ManagedConn
 	statusMc *platformgrpc.ManagedConn
 
+	discoveryMc *platformgrpc.ManagedConn
+
 	// Game service clients — created at construction, always non-nil when gameMc exists.
 	daggerheartClient daggerheartv1.DaggerheartServiceClient
 	contentClient     daggerheartv1.DaggerheartContentServiceClient
@@ -57,6 +62,7 @@ type Server struct {
 	participantClient statev1.ParticipantServiceClient
 	snapshotClient    statev1.SnapshotServiceClient
 	eventClient       statev1.EventServiceClient
+	forkClient        statev1.ForkServiceClient
 	statisticsClient  statev1.StatisticsServiceClient
 	systemClient      statev1.SystemServiceClient
 
@@ -64,8 +70,12 @@ type Server struct {
 	inviteClient invitev1.InviteServiceClient
 
 	// Auth service clients — created at construction, always non-nil when authMc exists.
-	authClient    authv1.AuthServiceClient
-	accountClient authv1.AccountServiceClient
+	authClient       authv1.AuthServiceClient
+	accountClient    authv1.AccountServiceClient
+	moderationClient authv1.ModerationServiceClient
+
+	// Discovery service client — created at construction, always non-nil when discoveryMc exists.
+	discoveryClient discoveryv1.DiscoveryServiceClient
 
 	statusClient statusv1.StatusServiceClient
 	httpServer   *http.Server
@@ -95,6 +105,22 @@ func (s *Server) AccountClient() authv1.AccountServiceClient {
 	return s.accountClient
 }
 
+// ModerationClient returns the current moderation client.
+func (s *Server) ModerationClient() authv1.ModerationServiceClient {
+	if s == nil {
+		return nil
+	}
+	return s.moderationClient
+}
+
+// DiscoveryClient returns the current discovery client.
+func (s *Server) DiscoveryClient() discoveryv1.DiscoveryServiceClient {
+	if s == nil {
+		return nil
+	}
+	return s.discoveryClient
+}
+
 // SessionClient returns the current session client.
 func (s *Server) SessionClient() statev1.SessionServiceClient {
 	if s == nil {
@@ -143,6 +169,14 @@ func (s *Server) EventClient() statev1.EventServiceClient {
 	return s.eventClient
 }
 
+// ForkClient returns the current fork client.
+func (s *Server) ForkClient() statev1.ForkServiceClient {
+	if s == nil {
+		return nil
+	}
+	return s.forkClient
+}
+
 // StatisticsClient returns the current statistics client.
 func (s *Server) StatisticsClient() statev1.StatisticsServiceClient {
 	if s == nil {
@@ -209,6 +243,7 @@ func NewServer(ctx context.Context, cfg Config) (*Server, error) {
 		srv.participantClient = statev1.NewParticipantServiceClient(conn)
 		srv.snapshotClient = statev1.NewSnapshotServiceClient(conn)
 		srv.eventClient = statev1.NewEventServiceClient(conn)
+		srv.forkClient = statev1.NewForkServiceClient(conn)
 		srv.statisticsClient = statev1.NewStatisticsServiceClient(conn)
 		srv.systemClient = statev1.NewSystemServiceClient(conn)
 	}
@@ -231,6 +266,7 @@ func NewServer(ctx context.Context, cfg Config) (*Server, error) {
 		conn := mc.Conn()
 		srv.authClient = authv1.NewAuthServiceClient(conn)
 		srv.accountClient = authv1.NewAccountServiceClient(conn)
+		srv.moderationClient = authv1.NewModerationServiceClient(conn)
 	}
 
 	// Invite service — optional, same as game.
@@ -255,6 +291,24 @@ func NewServer(ctx context.Context, cfg Config) (*Server, error) {
 		srv.inviteClient = invitev1.NewInviteServiceClient(mc.Conn())
 	}
 
+	// Discovery service — optional, only the moderation module uses it.
+	if addr := strings.TrimSpace(cfg.DiscoveryAddr); addr != "" {
+		mc, err := newManagedConn(ctx, platformgrpc.ManagedConnConfig{
+			Name:             "discovery",
+			Addr:             addr,
+			Mode:             platformgrpc.ModeOptional,
+			Logf:             logf,
+			StatusReporter:   cfg.StatusReporter,
+			StatusCapability: "admin.discovery.integration",
+		})
+		if err != nil {
+			srv.closeConns()
+			return nil, fmt.Errorf("admin: managed conn discovery: %w", err)
+		}
+		srv.discoveryMc = mc
+		srv.discoveryClient = discoveryv1.NewDiscoveryServiceClient(mc.Conn())
+	}
+
 	// Status service — optional.
 	if addr := strings.TrimSpace(cfg.StatusAddr); addr != "" {
 		mc, err := newManagedConn(ctx, platformgrpc.ManagedConnConfig{
@@ -344,6 +398,8 @@ func (s *Server) Close() {
 }
 
 func (s *Server) closeConns() {
+	closeManagedConn(s.discoveryMc, "discovery")
+	s.discoveryMc = nil
 	closeManagedConn(s.statusMc, "status")
 	s.statusMc = nil
 	closeManagedConn(s.inviteMc, "invite")
@@ -0,0 +1,45 @@
+// File diff.go defines view data for campaign state comparison templates.
+package templates
+
+// CampaignDiffForm holds the comparison inputs echoed back into the form.
+type CampaignDiffForm struct {
+	// FromSeq is the baseline event sequence; blank means latest.
+	FromSeq string
+	// ToCampaignID is the campaign compared against; blank means this campaign.
+	ToCampaignID string
+	// ToSeq is the compared event sequence; blank means latest.
+	ToSeq string
+}
+
+// CampaignDiffView holds data for rendering the state comparison page.
+type CampaignDiffView struct {
+	CampaignID   string
+	CampaignName string
+	Form         CampaignDiffForm
+	// Submitted reports whether a comparison was requested.
+	Submitted bool
+	// Message replaces the result when the comparison failed.
+	Message string
+	// FromLabel and ToLabel describe the resolved points.
+	FromLabel string
+	ToLabel   string
+	Sections  []CampaignDiffSection
+}
+
+// CampaignDiffSection groups changed entities of one kind.
+type CampaignDiffSection struct {
+	Title string
+	Rows  []CampaignDiffRow
+}
+
+// CampaignDiffRow describes one changed entity in readable form.
+type CampaignDiffRow struct {
+	// Entity is the display name, falling back to the entity ID.
+	Entity string
+	// Change is the localized change label.
+	Change string
+	// ChangeBadge is the badge variant for the change.
+	ChangeBadge string
+	// Details lists one readable line per changed value.
+	Details []string
+}
@@ -30,6 +30,8 @@ type EventFilterOptions struct {
 	EntityType string
 	StartDate  string
 	EndDate    string
+	// Expression is a free-form AIP-160 filter, including payload fields.
+	Expression string
 }
 
 // EventFilterBaseURL returns a base URL with filter query parameters applied.
@@ -53,6 +55,9 @@ func EventFilterBaseURL(baseURL string, filters EventFilterOptions) string {
 	if filters.EndDate != "" {
 		query.Set("end_date", filters.EndDate)
 	}
+	if filters.Expression != "" {
+		query.Set("filter", filters.Expression)
+	}
 	encoded := query.Encode()
 	if encoded == "" {
 		return baseURL
@@ -0,0 +1,76 @@
+// File moderation.go defines view data for moderation templates.
+package templates
+
+// ModerationPageView carries prefill values and flash messages for the
+// moderation page.
+type ModerationPageView struct {
+	Message    string
+	UserID     string
+	CampaignID string
+	EntryID    string
+	Filter     ModerationAuditFilter
+}
+
+// ModerationAuditFilter narrows the audit log to a single target.
+type ModerationAuditFilter struct {
+	TargetKind string
+	TargetID   string
+	PageToken  string
+}
+
+// ModerationAuditView holds one page of moderation audit rows.
+type ModerationAuditView struct {
+	Rows          []ModerationActionRow
+	Message       string
+	Filter        ModerationAuditFilter
+	NextPageToken string
+}
+
+// ModerationActionRow holds formatted audit log data for display.
+type ModerationActionRow struct {
+	ID          string
+	CreatedAt   string
+	Action      string
+	TargetKind  string
+	TargetID    string
+	TargetURL   string
+	ActorUserID string
+	Reason      string
+	ExpiresAt   string
+}
+
+// ModerationUserURL returns the moderation page prefilled for one user and
+// filtered to that user's audit history.
+func ModerationUserURL(userID string) string {
+	pageURL := AppendQueryParam("/app/moderation", "user_id", userID)
+	pageURL = AppendQueryParam(pageURL, "target_kind", "user")
+	return AppendQueryParam(pageURL, "target_id", userID)
+}
+
+// ModerationAuditRowsURL returns the rows fragment URL for an audit filter.
+func ModerationAuditRowsURL(filter ModerationAuditFilter) string {
+	return moderationAuditURL("/app/moderation?fragment=rows", filter)
+}
+
+// ModerationAuditPageURL returns the full page URL for an audit filter.
+func ModerationAuditPageURL(filter ModerationAuditFilter) string {
+	return moderationAuditURL("/app/moderation", filter)
+}
+
+// moderationAuditInitialRowsURL resumes the lazy-loaded table at the page
+// token carried by a full-page pagination link.
+func moderationAuditInitialRowsURL(filter ModerationAuditFilter) string {
+	rowsURL := ModerationAuditRowsURL(filter)
+	if filter.PageToken != "" {
+		rowsURL = AppendQueryParam(rowsURL, "page_token", filter.PageToken)
+	}
+	return rowsURL
+}
+
+func moderationAuditURL(baseURL string, filter ModerationAuditFilter) string {
+	if filter.TargetKind != "" && filter.TargetID != "" {
+		baseURL = AppendQueryParam(baseURL, "target_kind", filter.TargetKind)
+		baseURL = AppendQueryParam(baseURL, "target_id", filter.TargetID)
+	}
+	return baseURL
+}
@@ -39,6 +39,9 @@ type UserDetail struct {
 	Username              string
 	CreatedAt             string
 	UpdatedAt             string
+	Suspended             bool
+	SuspensionReason      string
+	SuspendedUntil        string
 	PendingInvites        []InviteRow
 	PendingInvitesMessage string
 }
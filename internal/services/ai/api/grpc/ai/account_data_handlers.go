@@ -0,0 +1,81 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InternalCallerAuthorizer reports whether the inbound caller is an allowed
+// internal service.
+type InternalCallerAuthorizer interface {
+	IsAllowedInternalServiceCaller(context.Context) bool
+}
+
+// AccountDataHandlers serves account export and deletion RPCs. Only internal
+// services may call them; users reach them through the auth account flow.
+type AccountDataHandlers struct {
+	aiv1.UnimplementedAccountDataServiceServer
+
+	svc        *service.AccountDataService
+	authorizer InternalCallerAuthorizer
+}
+
+// AccountDataHandlersConfig declares the dependencies for account-data RPCs.
+type AccountDataHandlersConfig struct {
+	AccountDataService *service.AccountDataService
+	Authorizer         InternalCallerAuthorizer
+}
+
+// NewAccountDataHandlers builds an account-data RPC server.
+func NewAccountDataHandlers(cfg AccountDataHandlersConfig) (*AccountDataHandlers, error) {
+	if cfg.AccountDataService == nil {
+		return nil, fmt.Errorf("ai: NewAccountDataHandlers: account data service is required")
+	}
+	return &AccountDataHandlers{svc: cfg.AccountDataService, authorizer: cfg.Authorizer}, nil
+}
+
+// ExportUserData returns the user's AI records as JSON.
+func (h *AccountDataHandlers) ExportUserData(ctx context.Context, in *aiv1.ExportUserDataRequest) (*aiv1.ExportUserDataResponse, error) {
+	if err := requireInternalAccountDataCaller(ctx, h.authorizer, in, "export user data request is required"); err != nil {
+		return nil, err
+	}
+	export, err := h.svc.Export(ctx, in.GetUserId())
+	if err != nil {
+		return nil, transportErrorToStatus(err, transportErrorConfig{Operation: "export user data"})
+	}
+	payload, err := json.Marshal(export)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal user data: %v", err)
+	}
+	return &aiv1.ExportUserDataResponse{RecordsJson: string(payload)}, nil
+}
+
+// DeleteUserData erases the user's AI records.
+func (h *AccountDataHandlers) DeleteUserData(ctx context.Context, in *aiv1.DeleteUserDataRequest) (*aiv1.DeleteUserDataResponse, error) {
+	if err := requireInternalAccountDataCaller(ctx, h.authorizer, in, "delete user data request is required"); err != nil {
+		return nil, err
+	}
+	deleted, err := h.svc.Delete(ctx, in.GetUserId())
+	if err != nil {
+		return nil, transportErrorToStatus(err, transportErrorConfig{Operation: "delete user data"})
+	}
+	return &aiv1.DeleteUserDataResponse{DeletedRecords: int32(deleted)}, nil
+}
+
+// requireInternalAccountDataCaller rejects missing requests and callers that
+// are not allowlisted internal services.
+func requireInternalAccountDataCaller[T any](ctx context.Context, authorizer InternalCallerAuthorizer, in *T, message string) error {
+	if err := requireUnaryRequest(in, message); err != nil {
+		return err
+	}
+	if authorizer == nil || !authorizer.IsAllowedInternalServiceCaller(ctx) {
+		return status.Error(codes.PermissionDenied, "account data operations are restricted to internal services")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package ai
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/credential"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accountDataFakeStore adds bulk deletion to the shared fake store.
+type accountDataFakeStore struct {
+	*fakeStore
+}
+
+func (s accountDataFakeStore) DeleteUserData(_ context.Context, userID string) (int, error) {
+	deleted := 0
+	for id, record := range s.Credentials {
+		if record.OwnerUserID == userID {
+			delete(s.Credentials, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func newTestAccountDataHandlers(t *testing.T, store accountDataFakeStore, allowInternal bool) *AccountDataHandlers {
+	t.Helper()
+	svc, err := service.NewAccountDataService(service.AccountDataServiceConfig{
+		CredentialStore:    store,
+		AgentStore:         store,
+		ProviderGrantStore: store,
+		AccessRequestStore: store,
+		UserDataStore:      store,
+	})
+	if err != nil {
+		t.Fatalf("NewAccountDataService: %v", err)
+	}
+	handlers, err := NewAccountDataHandlers(AccountDataHandlersConfig{
+		AccountDataService: svc,
+		Authorizer:         &fakeCampaignAuthorizer{allowInternalService: allowInternal},
+	})
+	if err != nil {
+		t.Fatalf("NewAccountDataHandlers: %v", err)
+	}
+	return handlers
+}
+
+func TestAccountDataExportOmitsSecrets(t *testing.T) {
+	store := accountDataFakeStore{fakeStore: newFakeStore()}
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	store.Credentials["cred-1"] = credential.Credential{
+		ID:               "cred-1",
+		OwnerUserID:      "user-1",
+		Provider:         provider.OpenAI,
+		Label:            "Main",
+		SecretCiphertext: "enc:top-secret",
+		Status:           credential.StatusActive,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	handlers := newTestAccountDataHandlers(t, store, true)
+
+	resp, err := handlers.ExportUserData(context.Background(), &aiv1.ExportUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("ExportUserData: %v", err)
+	}
+	records := resp.GetRecordsJson()
+	if !strings.Contains(records, `"label":"Main"`) {
+		t.Fatalf("records = %s, want credential label", records)
+	}
+	if strings.Contains(records, "top-secret") {
+		t.Fatalf("records leaked credential ciphertext: %s", records)
+	}
+
+	deleted, err := handlers.DeleteUserData(context.Background(), &aiv1.DeleteUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("DeleteUserData: %v", err)
+	}
+	if deleted.GetDeletedRecords() != 1 || len(store.Credentials) != 0 {
+		t.Fatalf("deleted = %d remaining = %d", deleted.GetDeletedRecords(), len(store.Credentials))
+	}
+}
+
+func TestAccountDataRequiresInternalCaller(t *testing.T) {
+	handlers := newTestAccountDataHandlers(t, accountDataFakeStore{fakeStore: newFakeStore()}, false)
+
+	_, err := handlers.DeleteUserData(context.Background(), &aiv1.DeleteUserDataRequest{UserId: "user-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
@@ -2,11 +2,16 @@ package ai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // CampaignArtifactHandlers serves campaign artifact RPCs with explicit deps.
@@ -99,9 +104,87 @@ func (h *CampaignArtifactHandlers) UpsertCampaignArtifact(ctx context.Context, i
 	if err := h.campaignContextValidator.validateCampaignContext(ctx, in.GetCampaignId(), gamev1.AuthorizationAction_AUTHORIZATION_ACTION_MANAGE); err != nil {
 		return nil, err
 	}
-	record, err := h.campaignArtifactManager.UpsertArtifact(ctx, in.GetCampaignId(), in.GetPath(), in.GetContent())
+	record, err := h.campaignArtifactManager.UpsertArtifact(withCallerArtifactAuthor(ctx), in.GetCampaignId(), in.GetPath(), in.GetContent())
 	if err != nil {
 		return nil, transportErrorToStatus(err, transportErrorConfig{Operation: "upsert campaign artifact"})
 	}
 	return &aiv1.UpsertCampaignArtifactResponse{Artifact: campaignArtifactToProto(record)}, nil
 }
+
+// ListCampaignArtifactRevisions returns the write history of one artifact.
+func (h *CampaignArtifactHandlers) ListCampaignArtifactRevisions(ctx context.Context, in *aiv1.ListCampaignArtifactRevisionsRequest) (*aiv1.ListCampaignArtifactRevisionsResponse, error) {
+	if err := requireUnaryRequest(in, "list campaign artifact revisions request is required"); err != nil {
+		return nil, err
+	}
+
+	if err := h.campaignContextValidator.validateCampaignContext(ctx, in.GetCampaignId(), gamev1.AuthorizationAction_AUTHORIZATION_ACTION_READ); err != nil {
+		return nil, err
+	}
+	records, err := h.campaignArtifactManager.ListRevisions(ctx, in.GetCampaignId(), in.GetPath())
+	if err != nil {
+		return nil, campaignArtifactRevisionErrorToStatus(err, "list campaign artifact revisions")
+	}
+	resp := &aiv1.ListCampaignArtifactRevisionsResponse{Revisions: make([]*aiv1.CampaignArtifactRevision, 0, len(records))}
+	for _, record := range records {
+		resp.Revisions = append(resp.Revisions, campaignArtifactRevisionToProto(record))
+	}
+	return resp, nil
+}
+
+// DiffCampaignArtifactRevisions compares two revisions of one artifact.
+func (h *CampaignArtifactHandlers) DiffCampaignArtifactRevisions(ctx context.Context, in *aiv1.DiffCampaignArtifactRevisionsRequest) (*aiv1.DiffCampaignArtifactRevisionsResponse, error) {
+	if err := requireUnaryRequest(in, "diff campaign artifact revisions request is required"); err != nil {
+		return nil, err
+	}
+	if in.GetFromRevision() < 1 {
+		return nil, status.Error(codes.InvalidArgument, "from_revision must be positive")
+	}
+	if in.GetToRevision() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "to_revision must not be negative")
+	}
+
+	if err := h.campaignContextValidator.validateCampaignContext(ctx, in.GetCampaignId(), gamev1.AuthorizationAction_AUTHORIZATION_ACTION_READ); err != nil {
+		return nil, err
+	}
+	diff, err := h.campaignArtifactManager.DiffRevisions(ctx, in.GetCampaignId(), in.GetPath(), int(in.GetFromRevision()), int(in.GetToRevision()))
+	if err != nil {
+		return nil, campaignArtifactRevisionErrorToStatus(err, "diff campaign artifact revisions")
+	}
+	return campaignArtifactDiffToProto(diff), nil
+}
+
+// RestoreCampaignArtifactRevision rolls one mutable artifact back to a
+// recorded revision.
+func (h *CampaignArtifactHandlers) RestoreCampaignArtifactRevision(ctx context.Context, in *aiv1.RestoreCampaignArtifactRevisionRequest) (*aiv1.RestoreCampaignArtifactRevisionResponse, error) {
+	if err := requireUnaryRequest(in, "restore campaign artifact revision request is required"); err != nil {
+		return nil, err
+	}
+	if in.GetRevision() < 1 {
+		return nil, status.Error(codes.InvalidArgument, "revision must be positive")
+	}
+
+	if err := h.campaignContextValidator.validateCampaignContext(ctx, in.GetCampaignId(), gamev1.AuthorizationAction_AUTHORIZATION_ACTION_MANAGE); err != nil {
+		return nil, err
+	}
+	record, err := h.campaignArtifactManager.RestoreRevision(withCallerArtifactAuthor(ctx), in.GetCampaignId(), in.GetPath(), int(in.GetRevision()))
+	if err != nil {
+		return nil, campaignArtifactRevisionErrorToStatus(err, "restore campaign artifact revision")
+	}
+	return &aiv1.RestoreCampaignArtifactRevisionResponse{Artifact: campaignArtifactToProto(record)}, nil
+}
+
+// withCallerArtifactAuthor attributes artifact writes to the calling user when
+// one is present; internal service callers write as the system.
+func withCallerArtifactAuthor(ctx context.Context) context.Context {
+	if userID := userIDFromContext(ctx); userID != "" {
+		return campaignartifact.WithAuthor(ctx, campaignartifact.Author{Kind: campaignartifact.AuthorKindUser, ID: userID})
+	}
+	return campaignartifact.WithAuthor(ctx, campaignartifact.Author{Kind: campaignartifact.AuthorKindSystem})
+}
+
+func campaignArtifactRevisionErrorToStatus(err error, operation string) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Error(codes.NotFound, "campaign artifact revision not found")
+	}
+	return transportErrorToStatus(err, transportErrorConfig{Operation: operation})
+}
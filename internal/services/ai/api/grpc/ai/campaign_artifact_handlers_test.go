@@ -2,13 +2,17 @@ package ai
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext"
 	"github.com/louisbranch/fracturing.space/internal/test/mock/aifakes"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestCampaignArtifactHandlersRoundTrip(t *testing.T) {
@@ -69,3 +73,98 @@ func TestCampaignArtifactHandlersRoundTrip(t *testing.T) {
 		t.Fatalf("memory content = %q", upsertResp.GetArtifact().GetContent())
 	}
 }
+
+func TestCampaignArtifactHandlersRevisionHistoryAndRestore(t *testing.T) {
+	store := aifakes.NewCampaignArtifactStore()
+	authorizer := &fakeCampaignAuthorizer{allowed: true}
+	svc, err := NewCampaignArtifactHandlers(CampaignArtifactHandlersConfig{
+		Manager:            campaigncontext.NewManager(campaigncontext.ManagerConfig{Store: store}),
+		CampaignAuthorizer: authorizer,
+	})
+	if err != nil {
+		t.Fatalf("NewCampaignArtifactHandlers: %v", err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDHeader, "user-1"))
+	for _, content := range []string{"Remember the debt.", "CORRUPTED"} {
+		if _, err := svc.UpsertCampaignArtifact(ctx, &aiv1.UpsertCampaignArtifactRequest{
+			CampaignId: "campaign-1",
+			Path:       campaigncontext.MemoryArtifactPath,
+			Content:    content,
+		}); err != nil {
+			t.Fatalf("UpsertCampaignArtifact(%q) error = %v", content, err)
+		}
+	}
+
+	listResp, err := svc.ListCampaignArtifactRevisions(ctx, &aiv1.ListCampaignArtifactRevisionsRequest{
+		CampaignId: "campaign-1",
+		Path:       campaigncontext.MemoryArtifactPath,
+	})
+	if err != nil {
+		t.Fatalf("ListCampaignArtifactRevisions() error = %v", err)
+	}
+	if len(listResp.GetRevisions()) != 2 {
+		t.Fatalf("revision count = %d, want 2", len(listResp.GetRevisions()))
+	}
+	latest := listResp.GetRevisions()[0]
+	if latest.GetRevision() != 2 || latest.GetAuthorKind() != aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_USER || latest.GetAuthorId() != "user-1" || latest.GetSizeBytes() != int32(len("CORRUPTED")) {
+		t.Fatalf("latest revision = %v", latest)
+	}
+	if authorizer.lastAction != gamev1.AuthorizationAction_AUTHORIZATION_ACTION_READ {
+		t.Fatalf("list action = %v, want read", authorizer.lastAction)
+	}
+
+	diffResp, err := svc.DiffCampaignArtifactRevisions(ctx, &aiv1.DiffCampaignArtifactRevisionsRequest{
+		CampaignId:   "campaign-1",
+		Path:         campaigncontext.MemoryArtifactPath,
+		FromRevision: 1,
+		ToRevision:   2,
+	})
+	if err != nil {
+		t.Fatalf("DiffCampaignArtifactRevisions() error = %v", err)
+	}
+	if !strings.Contains(diffResp.GetUnifiedDiff(), "+CORRUPTED") || len(diffResp.GetLines()) != 2 {
+		t.Fatalf("diff = %v", diffResp)
+	}
+
+	restoreResp, err := svc.RestoreCampaignArtifactRevision(ctx, &aiv1.RestoreCampaignArtifactRevisionRequest{
+		CampaignId: "campaign-1",
+		Path:       campaigncontext.MemoryArtifactPath,
+		Revision:   1,
+	})
+	if err != nil {
+		t.Fatalf("RestoreCampaignArtifactRevision() error = %v", err)
+	}
+	if restoreResp.GetArtifact().GetContent() != "Remember the debt." {
+		t.Fatalf("restored content = %q", restoreResp.GetArtifact().GetContent())
+	}
+	if authorizer.lastAction != gamev1.AuthorizationAction_AUTHORIZATION_ACTION_MANAGE {
+		t.Fatalf("restore action = %v, want manage", authorizer.lastAction)
+	}
+}
+
+func TestCampaignArtifactHandlersRevisionErrors(t *testing.T) {
+	svc, err := NewCampaignArtifactHandlers(CampaignArtifactHandlersConfig{
+		Manager:            campaigncontext.NewManager(campaigncontext.ManagerConfig{Store: aifakes.NewCampaignArtifactStore()}),
+		CampaignAuthorizer: &fakeCampaignAuthorizer{allowed: true},
+	})
+	if err != nil {
+		t.Fatalf("NewCampaignArtifactHandlers: %v", err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDHeader, "user-1"))
+
+	_, err = svc.RestoreCampaignArtifactRevision(ctx, &aiv1.RestoreCampaignArtifactRevisionRequest{CampaignId: "campaign-1", Path: "memory.md"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("status code = %v, want %v (err=%v)", status.Code(err), codes.InvalidArgument, err)
+	}
+
+	_, err = svc.RestoreCampaignArtifactRevision(ctx, &aiv1.RestoreCampaignArtifactRevisionRequest{CampaignId: "campaign-1", Path: "memory.md", Revision: 4})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("status code = %v, want %v (err=%v)", status.Code(err), codes.NotFound, err)
+	}
+
+	_, err = svc.DiffCampaignArtifactRevisions(ctx, &aiv1.DiffCampaignArtifactRevisionsRequest{CampaignId: "campaign-1", Path: "memory.md"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("status code = %v, want %v (err=%v)", status.Code(err), codes.InvalidArgument, err)
+	}
+}
@@ -62,3 +62,32 @@ func (h *CampaignOrchestrationHandlers) RunCampaignTurn(ctx context.Context, in
 		RetrievedContexts: retrievedContextsToProto(result.RetrievedContexts),
 	}, nil
 }
+
+// RunCampaignPlayerTurn validates a game-issued player session grant and runs
+// one turn for an AI-controlled player participant.
+func (h *CampaignOrchestrationHandlers) RunCampaignPlayerTurn(ctx context.Context, in *aiv1.RunCampaignPlayerTurnRequest) (*aiv1.RunCampaignPlayerTurnResponse, error) {
+	if err := requireUnaryRequest(in, "run campaign player turn request is required"); err != nil {
+		return nil, err
+	}
+
+	result, err := h.svc.RunCampaignPlayerTurn(ctx, service.RunCampaignPlayerTurnInput{
+		SessionGrant:    strings.TrimSpace(in.GetSessionGrant()),
+		ReasoningEffort: strings.TrimSpace(in.GetReasoningEffort()),
+		TurnToken:       strings.TrimSpace(in.GetTurnToken()),
+	})
+	if err != nil {
+		return nil, transportErrorToStatus(err, transportErrorConfig{
+			Operation:               "run campaign player turn",
+			DeadlineExceededCode:    apperrors.CodeAIOrchestrationTimedOut,
+			DeadlineExceededMessage: "campaign player turn timed out",
+			CanceledCode:            apperrors.CodeAIOrchestrationCanceled,
+			CanceledMessage:         "campaign player turn canceled",
+		})
+	}
+	return &aiv1.RunCampaignPlayerTurnResponse{
+		OutputText: result.OutputText,
+		Provider:   providerToProto(string(result.Provider)),
+		Model:      result.Model,
+		Usage:      usageToProto(result.Usage),
+	}, nil
+}
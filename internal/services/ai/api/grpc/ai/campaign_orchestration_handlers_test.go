@@ -260,3 +260,83 @@ func TestRunCampaignTurnRunsOrchestration(t *testing.T) {
 		t.Fatalf("usage.total_tokens = %d", resp.GetUsage().GetTotalTokens())
 	}
 }
+
+func TestRunCampaignPlayerTurnRunsPlayerRunner(t *testing.T) {
+	store := newFakeStore()
+	now := time.Now().UTC()
+	store.Credentials["cred-1"] = credential.Credential{
+		ID:               "cred-1",
+		OwnerUserID:      "user-1",
+		Provider:         provider.OpenAI,
+		Label:            "main",
+		Status:           credential.StatusActive,
+		SecretCiphertext: "enc:sk-1",
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	store.Agents["agent-1"] = agent.Agent{
+		ID:            "agent-1",
+		OwnerUserID:   "user-1",
+		Label:         "gm",
+		Provider:      provider.OpenAI,
+		Model:         "gpt-4.1-mini",
+		AuthReference: agent.CredentialAuthReference("cred-1"),
+		Status:        agent.StatusActive,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	gmRunner := &fakeCampaignTurnRunner{}
+	playerRunner := &fakeCampaignTurnRunner{
+		runResult: orchestration.Result{OutputText: "I keep watch.", Usage: provider.Usage{TotalTokens: 5}},
+	}
+	sessionGrantConfig := testAISessionGrantConfig()
+	svc := newCampaignOrchestrationHandlersWithOpts(t, store, store, &fakeSealer{}, campaignOrchestrationTestOpts{
+		campaignTurnRunner: gmRunner,
+		playerTurnRunner:   playerRunner,
+		sessionGrantConfig: &sessionGrantConfig,
+		campaignAuthStateReader: &fakeCampaignAIAuthStateClient{
+			authState: &gamev1.GetCampaignAIAuthStateResponse{
+				CampaignId:      "camp-1",
+				AiAgentId:       "agent-1",
+				ActiveSessionId: "sess-1",
+				AuthEpoch:       7,
+				ParticipantId:   "gm-1",
+			},
+		},
+	})
+
+	resp, err := svc.RunCampaignPlayerTurn(context.Background(), &aiv1.RunCampaignPlayerTurnRequest{
+		SessionGrant: mustIssueAISessionGrant(t, testAISessionGrantConfig(), aisessiongrant.IssueInput{
+			GrantID:       "grant-1",
+			CampaignID:    "camp-1",
+			SessionID:     "sess-1",
+			ParticipantID: "companion-1",
+			AuthEpoch:     7,
+		}),
+		TurnToken: "turn-1",
+	})
+	if err != nil {
+		t.Fatalf("run campaign player turn: %v", err)
+	}
+	if resp.GetOutputText() != "I keep watch." || resp.GetUsage().GetTotalTokens() != 5 {
+		t.Fatalf("response = %+v", resp)
+	}
+	if playerRunner.lastInput.ParticipantID != "companion-1" {
+		t.Fatalf("runner participant = %q", playerRunner.lastInput.ParticipantID)
+	}
+	if gmRunner.lastInput.CampaignID != "" {
+		t.Fatalf("gm runner input = %+v, want unused", gmRunner.lastInput)
+	}
+
+	_, err = svc.RunCampaignPlayerTurn(context.Background(), &aiv1.RunCampaignPlayerTurnRequest{
+		SessionGrant: mustIssueAISessionGrant(t, testAISessionGrantConfig(), aisessiongrant.IssueInput{
+			GrantID:       "grant-2",
+			CampaignID:    "camp-1",
+			SessionID:     "sess-1",
+			ParticipantID: "gm-1",
+			AuthEpoch:     7,
+		}),
+	})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+}
@@ -4,10 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/provideroauth"
-	"github.com/louisbranch/fracturing.space/internal/services/ai/secret"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/service"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/aisessiongrant"
@@ -46,6 +46,7 @@ type campaignOrchestrationTestOpts struct {
 	oauthAdapters           map[provider.Provider]provideroauth.Adapter
 	toolAdapters            map[provider.Provider]orchestration.Provider
 	campaignTurnRunner      orchestration.CampaignTurnRunner
+	playerTurnRunner        orchestration.CampaignTurnRunner
 	sessionGrantConfig      *aisessiongrant.Config
 	campaignAuthStateReader service.CampaignAuthStateReader
 }
@@ -93,6 +94,7 @@ func newCampaignOrchestrationHandlersWithOpts(t *testing.T, credentialStore stor
 		CampaignAuthStateReader: opts.campaignAuthStateReader,
 		ProviderRegistry:        mustProviderRegistryForTransportTests(t, oauthAdapters, nil, nil, toolAdapters),
 		CampaignTurnRunner:      opts.campaignTurnRunner,
+		PlayerTurnRunner:        opts.playerTurnRunner,
 		SessionGrantConfig:      opts.sessionGrantConfig,
 		AuthMaterialResolver:    authMaterialResolver,
 	})
@@ -3,8 +3,8 @@ package ai
 import (
 	"testing"
 
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
-	"github.com/louisbranch/fracturing.space/internal/services/ai/secret"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/service"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
 )
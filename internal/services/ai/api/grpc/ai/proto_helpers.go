@@ -16,6 +16,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/providergrant"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/service"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/sessionrecap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -310,6 +311,87 @@ func campaignArtifactToProto(record campaignartifact.Artifact) *aiv1.CampaignArt
 	}
 }
 
+func sessionRecapDraftToProto(record sessionrecap.Draft) *aiv1.SessionRecapDraft {
+	out := &aiv1.SessionRecapDraft{
+		CampaignId: record.CampaignID,
+		SessionId:  record.SessionID,
+		Status:     sessionRecapDraftStatusToProto(record.Status),
+		Markdown:   record.Markdown,
+		AgentId:    record.AgentID,
+		CreatedAt:  timestamppb.New(record.CreatedAt),
+		UpdatedAt:  timestamppb.New(record.UpdatedAt),
+	}
+	if record.PublishedAt != nil {
+		out.PublishedAt = timestamppb.New(*record.PublishedAt)
+	}
+	return out
+}
+
+func sessionRecapDraftStatusToProto(value sessionrecap.Status) aiv1.SessionRecapDraftStatus {
+	switch value {
+	case sessionrecap.StatusPending:
+		return aiv1.SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_PENDING
+	case sessionrecap.StatusPublished:
+		return aiv1.SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_PUBLISHED
+	default:
+		return aiv1.SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_UNSPECIFIED
+	}
+}
+
+func campaignArtifactRevisionToProto(record campaignartifact.Revision) *aiv1.CampaignArtifactRevision {
+	return &aiv1.CampaignArtifactRevision{
+		CampaignId: record.CampaignID,
+		Path:       record.Path,
+		Revision:   int32(record.Number),
+		AuthorKind: campaignArtifactAuthorKindToProto(record.Author.Kind),
+		AuthorId:   record.Author.ID,
+		SizeBytes:  int32(record.SizeBytes),
+		CreatedAt:  timestamppb.New(record.CreatedAt),
+	}
+}
+
+func campaignArtifactAuthorKindToProto(value campaignartifact.AuthorKind) aiv1.CampaignArtifactAuthorKind {
+	switch value {
+	case campaignartifact.AuthorKindSystem:
+		return aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_SYSTEM
+	case campaignartifact.AuthorKindAITurn:
+		return aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_AI_TURN
+	case campaignartifact.AuthorKindUser:
+		return aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_USER
+	default:
+		return aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_UNSPECIFIED
+	}
+}
+
+func campaignArtifactDiffToProto(diff campaignartifact.Diff) *aiv1.DiffCampaignArtifactRevisionsResponse {
+	resp := &aiv1.DiffCampaignArtifactRevisionsResponse{
+		From:        campaignArtifactRevisionToProto(diff.From),
+		To:          campaignArtifactRevisionToProto(diff.To),
+		Lines:       make([]*aiv1.CampaignArtifactDiffLine, 0, len(diff.Lines)),
+		UnifiedDiff: diff.Unified(),
+	}
+	for _, line := range diff.Lines {
+		resp.Lines = append(resp.Lines, &aiv1.CampaignArtifactDiffLine{
+			Op:   campaignArtifactDiffOpToProto(line.Op),
+			Text: line.Text,
+		})
+	}
+	return resp
+}
+
+func campaignArtifactDiffOpToProto(value campaignartifact.DiffOp) aiv1.CampaignArtifactDiffOp {
+	switch value {
+	case campaignartifact.DiffOpEqual:
+		return aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_EQUAL
+	case campaignartifact.DiffOpInsert:
+		return aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_INSERT
+	case campaignartifact.DiffOpDelete:
+		return aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_DELETE
+	default:
+		return aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_UNSPECIFIED
+	}
+}
+
 func campaignDebugTurnStatusToProto(value debugtrace.Status) aiv1.CampaignDebugTurnStatus {
 	switch value {
 	case debugtrace.StatusRunning:
@@ -0,0 +1,104 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SessionRecapHandlers serves session recap draft RPCs with explicit deps.
+type SessionRecapHandlers struct {
+	aiv1.UnimplementedSessionRecapServiceServer
+
+	svc                      *service.SessionRecapService
+	campaignAuthorizer       CampaignAccessAuthorizer
+	campaignContextValidator campaignContextValidator
+}
+
+// SessionRecapHandlersConfig declares the dependencies for session recap RPCs.
+type SessionRecapHandlersConfig struct {
+	SessionRecapService *service.SessionRecapService
+	CampaignAuthorizer  CampaignAccessAuthorizer
+}
+
+// NewSessionRecapHandlers builds a session-recap RPC server.
+func NewSessionRecapHandlers(cfg SessionRecapHandlersConfig) (*SessionRecapHandlers, error) {
+	if cfg.SessionRecapService == nil {
+		return nil, fmt.Errorf("ai: NewSessionRecapHandlers: session recap service is required")
+	}
+	return &SessionRecapHandlers{
+		svc:                      cfg.SessionRecapService,
+		campaignAuthorizer:       cfg.CampaignAuthorizer,
+		campaignContextValidator: newCampaignContextValidator(cfg.CampaignAuthorizer),
+	}, nil
+}
+
+// DraftSessionRecap drafts a recap with the campaign's bound agent. Only
+// internal service callers may draft; GMs edit and publish the result.
+func (h *SessionRecapHandlers) DraftSessionRecap(ctx context.Context, in *aiv1.DraftSessionRecapRequest) (*aiv1.DraftSessionRecapResponse, error) {
+	if err := requireUnaryRequest(in, "draft session recap request is required"); err != nil {
+		return nil, err
+	}
+	if h.campaignAuthorizer == nil || !h.campaignAuthorizer.IsAllowedInternalServiceCaller(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "session recap drafting is restricted to internal services")
+	}
+	draft, err := h.svc.DraftSessionRecap(ctx, service.DraftSessionRecapInput{
+		CampaignID:     in.GetCampaignId(),
+		SessionID:      in.GetSessionId(),
+		SourceMarkdown: in.GetSourceMarkdown(),
+	})
+	if err != nil {
+		return nil, transportErrorToStatus(err, transportErrorConfig{Operation: "draft session recap"})
+	}
+	return &aiv1.DraftSessionRecapResponse{Draft: sessionRecapDraftToProto(draft)}, nil
+}
+
+// GetSessionRecapDraft returns the recap draft for one session.
+func (h *SessionRecapHandlers) GetSessionRecapDraft(ctx context.Context, in *aiv1.GetSessionRecapDraftRequest) (*aiv1.GetSessionRecapDraftResponse, error) {
+	if err := requireUnaryRequest(in, "get session recap draft request is required"); err != nil {
+		return nil, err
+	}
+	if err := h.campaignContextValidator.validateCampaignContext(ctx, in.GetCampaignId(), gamev1.AuthorizationAction_AUTHORIZATION_ACTION_MANAGE); err != nil {
+		return nil, err
+	}
+	draft, err := h.svc.GetSessionRecapDraft(ctx, in.GetCampaignId(), in.GetSessionId())
+	if err != nil {
+		return nil, transportErrorToStatus(err, transportErrorConfig{Operation: "get session recap draft"})
+	}
+	return &aiv1.GetSessionRecapDraftResponse{Draft: sessionRecapDraftToProto(draft)}, nil
+}
+
+// UpdateSessionRecapDraft replaces the markdown of one pending draft.
+func (h *SessionRecapHandlers) UpdateSessionRecapDraft(ctx context.Context, in *aiv1.UpdateSessionRecapDraftRequest) (*aiv1.UpdateSessionRecapDraftResponse, error) {
+	if err := requireUnaryRequest(in, "update session recap draft request is required"); err != nil {
+		return nil, err
+	}
+	if err := h.campaignContextValidator.validateCampaignContext(ctx, in.GetCampaignId(), gamev1.AuthorizationAction_AUTHORIZATION_ACTION_MANAGE); err != nil {
+		return nil, err
+	}
+	draft, err := h.svc.UpdateSessionRecapDraft(ctx, in.GetCampaignId(), in.GetSessionId(), in.GetMarkdown())
+	if err != nil {
+		return nil, transportErrorToStatus(err, transportErrorConfig{Operation: "update session recap draft"})
+	}
+	return &aiv1.UpdateSessionRecapDraftResponse{Draft: sessionRecapDraftToProto(draft)}, nil
+}
+
+// MarkSessionRecapDraftPublished closes a draft after the GM recorded it.
+func (h *SessionRecapHandlers) MarkSessionRecapDraftPublished(ctx context.Context, in *aiv1.MarkSessionRecapDraftPublishedRequest) (*aiv1.MarkSessionRecapDraftPublishedResponse, error) {
+	if err := requireUnaryRequest(in, "mark session recap draft published request is required"); err != nil {
+		return nil, err
+	}
+	if err := h.campaignContextValidator.validateCampaignContext(ctx, in.GetCampaignId(), gamev1.AuthorizationAction_AUTHORIZATION_ACTION_MANAGE); err != nil {
+		return nil, err
+	}
+	draft, err := h.svc.MarkSessionRecapDraftPublished(ctx, in.GetCampaignId(), in.GetSessionId())
+	if err != nil {
+		return nil, transportErrorToStatus(err, transportErrorConfig{Operation: "mark session recap draft published"})
+	}
+	return &aiv1.MarkSessionRecapDraftPublishedResponse{Draft: sessionRecapDraftToProto(draft)}, nil
+}
@@ -0,0 +1,118 @@
+package ai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/service"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/sessionrecap"
+	"github.com/louisbranch/fracturing.space/internal/test/mock/aifakes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newSessionRecapHandlersForTest(t *testing.T, drafts *aifakes.SessionRecapDraftStore, authorizer *fakeCampaignAuthorizer) *SessionRecapHandlers {
+	t.Helper()
+
+	sealer := &aifakes.Sealer{}
+	registry := mustProviderRegistryForTransportTests(t, nil, nil, nil, nil)
+	svc, err := service.NewSessionRecapService(service.SessionRecapServiceConfig{
+		DraftStore:       drafts,
+		AgentStore:       newFakeStore(),
+		ProviderRegistry: registry,
+		AuthMaterialResolver: service.NewAuthMaterialResolver(service.AuthMaterialResolverConfig{
+			CredentialStore: newFakeStore(),
+			Sealer:          sealer,
+			ProviderGrantRuntime: service.NewProviderGrantRuntime(service.ProviderGrantRuntimeConfig{
+				ProviderGrantStore: newFakeStore(),
+				ProviderRegistry:   registry,
+				Sealer:             sealer,
+			}),
+		}),
+		Clock: func() time.Time { return time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC) },
+	})
+	if err != nil {
+		t.Fatalf("NewSessionRecapService: %v", err)
+	}
+	h, err := NewSessionRecapHandlers(SessionRecapHandlersConfig{
+		SessionRecapService: svc,
+		CampaignAuthorizer:  authorizer,
+	})
+	if err != nil {
+		t.Fatalf("NewSessionRecapHandlers: %v", err)
+	}
+	return h
+}
+
+func TestDraftSessionRecapRejectsUserCallers(t *testing.T) {
+	h := newSessionRecapHandlersForTest(t, aifakes.NewSessionRecapDraftStore(), &fakeCampaignAuthorizer{allowed: true})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDHeader, "user-1"))
+
+	_, err := h.DraftSessionRecap(ctx, &aiv1.DraftSessionRecapRequest{
+		CampaignId:     "campaign-1",
+		SessionId:      "session-1",
+		SourceMarkdown: "events",
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("DraftSessionRecap() code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
+
+func TestSessionRecapDraftHandlersRequireManageAccess(t *testing.T) {
+	drafts := aifakes.NewSessionRecapDraftStore()
+	drafts.Drafts[aifakes.SessionRecapDraftKey("campaign-1", "session-1")] = sessionrecap.Draft{
+		CampaignID: "campaign-1",
+		SessionID:  "session-1",
+		Status:     sessionrecap.StatusPending,
+		Markdown:   "draft",
+	}
+	authorizer := &fakeCampaignAuthorizer{allowed: true}
+	h := newSessionRecapHandlersForTest(t, drafts, authorizer)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDHeader, "gm-1"))
+
+	updated, err := h.UpdateSessionRecapDraft(ctx, &aiv1.UpdateSessionRecapDraftRequest{
+		CampaignId: "campaign-1",
+		SessionId:  "session-1",
+		Markdown:   "edited",
+	})
+	if err != nil {
+		t.Fatalf("UpdateSessionRecapDraft() error = %v", err)
+	}
+	if updated.GetDraft().GetMarkdown() != "edited" {
+		t.Fatalf("markdown = %q, want %q", updated.GetDraft().GetMarkdown(), "edited")
+	}
+	if authorizer.lastAction != gamev1.AuthorizationAction_AUTHORIZATION_ACTION_MANAGE {
+		t.Fatalf("action = %v, want manage", authorizer.lastAction)
+	}
+
+	published, err := h.MarkSessionRecapDraftPublished(ctx, &aiv1.MarkSessionRecapDraftPublishedRequest{
+		CampaignId: "campaign-1",
+		SessionId:  "session-1",
+	})
+	if err != nil {
+		t.Fatalf("MarkSessionRecapDraftPublished() error = %v", err)
+	}
+	if published.GetDraft().GetStatus() != aiv1.SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_PUBLISHED || published.GetDraft().GetPublishedAt() == nil {
+		t.Fatalf("published draft = %#v", published.GetDraft())
+	}
+
+	authorizer.allowed = false
+	_, err = h.GetSessionRecapDraft(ctx, &aiv1.GetSessionRecapDraftRequest{CampaignId: "campaign-1", SessionId: "session-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("GetSessionRecapDraft() code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
+
+func TestGetSessionRecapDraftReturnsNotFound(t *testing.T) {
+	h := newSessionRecapHandlersForTest(t, aifakes.NewSessionRecapDraftStore(), &fakeCampaignAuthorizer{allowed: true})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDHeader, "gm-1"))
+
+	_, err := h.GetSessionRecapDraft(ctx, &aiv1.GetSessionRecapDraftRequest{CampaignId: "campaign-1", SessionId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("GetSessionRecapDraft() code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/louisbranch/fracturing.space/internal/platform/config"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
 	"github.com/louisbranch/fracturing.space/internal/platform/serviceaddr"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/openviking"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/cassette"
 	openaiprovider "github.com/louisbranch/fracturing.space/internal/services/ai/provider/openai"
-	"github.com/louisbranch/fracturing.space/internal/services/ai/secret"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/providercatalog"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/aisessiongrant"
 )
 
@@ -21,6 +23,8 @@ import (
 type serverEnv struct {
 	DBPath                   string `env:"FRACTURING_SPACE_AI_DB_PATH"`
 	EncryptionKey            string `env:"FRACTURING_SPACE_AI_ENCRYPTION_KEY"`
+	EncryptionKeys           string `env:"FRACTURING_SPACE_AI_ENCRYPTION_KEYS"`
+	EncryptionActiveKeyID    string `env:"FRACTURING_SPACE_AI_ENCRYPTION_ACTIVE_KEY_ID"`
 	GameAddr                 string `env:"FRACTURING_SPACE_GAME_ADDR"`
 	InternalServiceAllowlist string `env:"FRACTURING_SPACE_AI_INTERNAL_SERVICE_ALLOWLIST" envDefault:"ai,worker,game"`
 
@@ -49,12 +53,17 @@ type serverEnv struct {
 	OpenVikingMaxSections                int           `env:"FRACTURING_SPACE_AI_OPENVIKING_MAX_SECTIONS" envDefault:"2"`
 	OpenVikingMinRelevanceScore          float64       `env:"FRACTURING_SPACE_AI_OPENVIKING_MIN_RELEVANCE_SCORE" envDefault:"0"`
 	OpenVikingResourceSync               time.Duration `env:"FRACTURING_SPACE_AI_OPENVIKING_RESOURCE_SYNC_TIMEOUT" envDefault:"2s"`
+	ProviderCassetteMode                 string        `env:"FRACTURING_SPACE_AI_PROVIDER_CASSETTE_MODE"`
+	ProviderCassettePath                 string        `env:"FRACTURING_SPACE_AI_PROVIDER_CASSETTE_PATH"`
+	ProviderCassetteStrict               bool          `env:"FRACTURING_SPACE_AI_PROVIDER_CASSETTE_STRICT"`
 }
 
 // runtimeConfig is the normalized startup configuration used by the AI runtime.
 type runtimeConfig struct {
 	DBPath                               string
 	EncryptionKey                        string
+	EncryptionKeys                       string
+	EncryptionActiveKeyID                string
 	GameAddr                             string
 	InternalServiceAllowlist             map[string]struct{}
 	OpenAIOAuthConfig                    *openaiprovider.OAuthConfig
@@ -78,6 +87,9 @@ type runtimeConfig struct {
 	OpenVikingMaxSections                int
 	OpenVikingMinRelevanceScore          float64
 	OpenVikingResourceSync               time.Duration
+	ProviderCassetteMode                 cassette.Mode
+	ProviderCassettePath                 string
+	ProviderCassetteStrict               bool
 	SessionGrantConfig                   *aisessiongrant.Config
 }
 
@@ -87,7 +99,7 @@ func (cfg runtimeConfig) Validate() error {
 	if strings.TrimSpace(cfg.DBPath) == "" {
 		return fmt.Errorf("FRACTURING_SPACE_AI_DB_PATH is required")
 	}
-	if _, err := cfg.encryptionKeyBytes(); err != nil {
+	if _, err := cfg.buildKeyring(); err != nil {
 		return err
 	}
 	if cfg.OrchestrationTurnTimeout <= 0 {
@@ -116,31 +128,32 @@ func (cfg runtimeConfig) Validate() error {
 			return fmt.Errorf("FRACTURING_SPACE_AI_OPENVIKING_RESOURCE_SYNC_TIMEOUT must be positive")
 		}
 	}
+	if cfg.ProviderCassetteMode != cassette.ModeOff && cfg.ProviderCassettePath == "" {
+		return fmt.Errorf("FRACTURING_SPACE_AI_PROVIDER_CASSETTE_PATH is required when a provider cassette mode is set")
+	}
 	return nil
 }
 
-func (cfg runtimeConfig) encryptionKeyBytes() ([]byte, error) {
-	encryptionKey := strings.TrimSpace(cfg.EncryptionKey)
-	if encryptionKey == "" {
-		return nil, fmt.Errorf("FRACTURING_SPACE_AI_ENCRYPTION_KEY is required")
+// buildKeyring folds the legacy single key and the keyed list into one
+// keyring so rotation can start without renaming the existing key.
+func (cfg runtimeConfig) buildKeyring() (*secret.Keyring, error) {
+	keyringConfig := secret.KeyringConfig{
+		LegacyKey:   cfg.EncryptionKey,
+		Keys:        cfg.EncryptionKeys,
+		ActiveKeyID: cfg.EncryptionActiveKeyID,
+	}
+	if !keyringConfig.Configured() {
+		return nil, fmt.Errorf("FRACTURING_SPACE_AI_ENCRYPTION_KEY is required unless FRACTURING_SPACE_AI_ENCRYPTION_KEYS is set")
 	}
-	keyBytes, err := decodeBase64Key(encryptionKey)
+	keyring, err := keyringConfig.Build()
 	if err != nil {
-		return nil, fmt.Errorf("decode encryption key: %w", err)
+		return nil, fmt.Errorf("build encryption keyring: %w", err)
 	}
-	return keyBytes, nil
+	return keyring, nil
 }
 
 func (cfg runtimeConfig) buildSealer() (secret.Sealer, error) {
-	keyBytes, err := cfg.encryptionKeyBytes()
-	if err != nil {
-		return nil, err
-	}
-	sealer, err := secret.NewAESGCMSealer(keyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("build secret sealer: %w", err)
-	}
-	return sealer, nil
+	return cfg.buildKeyring()
 }
 
 func loadServerEnv() (serverEnv, error) {
@@ -170,10 +183,16 @@ func loadRuntimeConfigFromEnv() (runtimeConfig, error) {
 	if err != nil {
 		return runtimeConfig{}, fmt.Errorf("load AI session grant config: %w", err)
 	}
+	cassetteMode, err := cassette.ParseMode(srvEnv.ProviderCassetteMode)
+	if err != nil {
+		return runtimeConfig{}, fmt.Errorf("FRACTURING_SPACE_AI_PROVIDER_CASSETTE_MODE: %w", err)
+	}
 
 	cfg := runtimeConfig{
 		DBPath:                               strings.TrimSpace(srvEnv.DBPath),
 		EncryptionKey:                        strings.TrimSpace(srvEnv.EncryptionKey),
+		EncryptionKeys:                       strings.TrimSpace(srvEnv.EncryptionKeys),
+		EncryptionActiveKeyID:                strings.TrimSpace(srvEnv.EncryptionActiveKeyID),
 		GameAddr:                             strings.TrimSpace(srvEnv.GameAddr),
 		InternalServiceAllowlist:             parseInternalServiceAllowlist(srvEnv.InternalServiceAllowlist),
 		OpenAIOAuthConfig:                    openAIOAuthConfig,
@@ -197,6 +216,9 @@ func loadRuntimeConfigFromEnv() (runtimeConfig, error) {
 		OpenVikingMaxSections:                srvEnv.OpenVikingMaxSections,
 		OpenVikingMinRelevanceScore:          srvEnv.OpenVikingMinRelevanceScore,
 		OpenVikingResourceSync:               srvEnv.OpenVikingResourceSync,
+		ProviderCassetteMode:                 cassetteMode,
+		ProviderCassettePath:                 strings.TrimSpace(srvEnv.ProviderCassettePath),
+		ProviderCassetteStrict:               srvEnv.ProviderCassetteStrict,
 		SessionGrantConfig:                   sessionGrantConfig,
 	}
 	if err := cfg.Validate(); err != nil {
@@ -205,6 +227,60 @@ func loadRuntimeConfigFromEnv() (runtimeConfig, error) {
 	return cfg, nil
 }
 
+// providerCassette carries the optional provider cassette so eval lanes can
+// record live tool-loop exchanges or replay them without network access.
+type providerCassette struct {
+	mode   cassette.Mode
+	path   string
+	player *cassette.Player
+}
+
+// buildProviderCassette loads the replay cassette once so every provider
+// bundle and the shutdown replay check share one player.
+func (cfg runtimeConfig) buildProviderCassette() (providerCassette, error) {
+	pc := providerCassette{mode: cfg.ProviderCassetteMode, path: cfg.ProviderCassettePath}
+	if pc.mode != cassette.ModeReplay {
+		return pc, nil
+	}
+	player, err := cassette.LoadPlayer(pc.path, cassette.PlayerConfig{Strict: cfg.ProviderCassetteStrict})
+	if err != nil {
+		return providerCassette{}, fmt.Errorf("build provider cassette player: %w", err)
+	}
+	pc.player = player
+	return pc, nil
+}
+
+// wrap applies the cassette to one provider bundle. Recording wraps the live
+// tool adapter when the provider has one; replay serves both the tool loop and
+// model discovery for the provider the cassette was recorded against.
+func (pc providerCassette) wrap(bundle providercatalog.Bundle) (providercatalog.Bundle, error) {
+	switch pc.mode {
+	case cassette.ModeRecord:
+		if bundle.Tool == nil {
+			return bundle, nil
+		}
+		recorder, err := cassette.NewRecorder(cassette.RecorderConfig{
+			Live:     bundle.Tool,
+			Path:     pc.path,
+			Metadata: cassette.Metadata{Provider: string(bundle.Provider)},
+		})
+		if err != nil {
+			return providercatalog.Bundle{}, fmt.Errorf("build provider cassette recorder: %w", err)
+		}
+		bundle.Tool = recorder
+		return bundle, nil
+	case cassette.ModeReplay:
+		if recorded := pc.player.Provider(); recorded != "" && recorded != string(bundle.Provider) {
+			return bundle, nil
+		}
+		bundle.Tool = pc.player
+		bundle.Model = pc.player
+		return bundle, nil
+	default:
+		return bundle, nil
+	}
+}
+
 func (cfg runtimeConfig) campaignTurnRunnerConfig(dialer orchestration.Dialer) orchestration.RunnerConfig {
 	return orchestration.RunnerConfig{
 		Dialer:             dialer,
@@ -27,6 +27,36 @@ func TestLoadRuntimeConfigFromEnvRejectsInvalidEncryptionKey(t *testing.T) {
 	}
 }
 
+func TestLoadRuntimeConfigFromEnvAcceptsEncryptionKeyring(t *testing.T) {
+	oldKey := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	newKey := base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEY", oldKey)
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEYS", "2026-10:"+newKey)
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_ACTIVE_KEY_ID", "2026-10")
+
+	cfg, err := loadRuntimeConfigFromEnv()
+	if err != nil {
+		t.Fatalf("loadRuntimeConfigFromEnv() error = %v", err)
+	}
+	keyring, err := cfg.buildKeyring()
+	if err != nil {
+		t.Fatalf("buildKeyring() error = %v", err)
+	}
+	if got := keyring.ActiveKeyID(); got != "2026-10" {
+		t.Fatalf("ActiveKeyID() = %q, want %q", got, "2026-10")
+	}
+}
+
+func TestLoadRuntimeConfigFromEnvRequiresActiveKeyForMultipleKeys(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEY", base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")))
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEYS", "next:"+base64.RawStdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210")))
+
+	_, err := loadRuntimeConfigFromEnv()
+	if err == nil || !strings.Contains(err.Error(), "active key id is required") {
+		t.Fatalf("loadRuntimeConfigFromEnv() error = %v", err)
+	}
+}
+
 func TestLoadRuntimeConfigFromEnvRejectsInvalidOrchestrationDuration(t *testing.T) {
 	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEY", base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")))
 	t.Setenv("FRACTURING_SPACE_AI_ORCHESTRATION_TURN_TIMEOUT", "not-a-duration")
@@ -93,3 +123,23 @@ func TestLoadRuntimeConfigFromEnvRejectsInvalidOpenVikingMode(t *testing.T) {
 		t.Fatalf("loadRuntimeConfigFromEnv() error = %v", err)
 	}
 }
+
+func TestLoadRuntimeConfigFromEnvRequiresProviderCassettePath(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEY", base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")))
+	t.Setenv("FRACTURING_SPACE_AI_PROVIDER_CASSETTE_MODE", "replay")
+
+	_, err := loadRuntimeConfigFromEnv()
+	if err == nil || !strings.Contains(err.Error(), "FRACTURING_SPACE_AI_PROVIDER_CASSETTE_PATH is required") {
+		t.Fatalf("loadRuntimeConfigFromEnv() error = %v", err)
+	}
+}
+
+func TestLoadRuntimeConfigFromEnvRejectsInvalidProviderCassetteMode(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEY", base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")))
+	t.Setenv("FRACTURING_SPACE_AI_PROVIDER_CASSETTE_MODE", "rewind")
+
+	_, err := loadRuntimeConfigFromEnv()
+	if err == nil || !strings.Contains(err.Error(), "unsupported provider cassette mode") {
+		t.Fatalf("loadRuntimeConfigFromEnv() error = %v", err)
+	}
+}
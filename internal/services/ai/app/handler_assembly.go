@@ -19,9 +19,11 @@ type serviceHandlers struct {
 	campaignOrchestration *aiservice.CampaignOrchestrationHandlers
 	campaignDebug         *aiservice.CampaignDebugHandlers
 	campaignArtifacts     *aiservice.CampaignArtifactHandlers
+	sessionRecaps         *aiservice.SessionRecapHandlers
 	systemReferences      *aiservice.SystemReferenceHandlers
 	providerGrants        *aiservice.ProviderGrantHandlers
 	accessRequests        *aiservice.AccessRequestHandlers
+	accountData           *aiservice.AccountDataHandlers
 }
 
 type workflowDeps struct {
@@ -32,6 +34,7 @@ type workflowDeps struct {
 	accessibleAgentResolver *svcpkg.AccessibleAgentResolver
 	debugUpdateBroker       *svcpkg.CampaignDebugUpdateBroker
 	campaignTurnRunner      orchestration.CampaignTurnRunner
+	playerTurnRunner        orchestration.CampaignTurnRunner
 	campaignLogger          *slog.Logger
 }
 
@@ -39,6 +42,7 @@ type authLifecycleModule struct {
 	credentials    *aiservice.CredentialHandlers
 	providerGrants *aiservice.ProviderGrantHandlers
 	accessRequests *aiservice.AccessRequestHandlers
+	accountData    *aiservice.AccountDataHandlers
 }
 
 type agentRuntimeModule struct {
@@ -50,6 +54,7 @@ type campaignRuntimeModule struct {
 	campaignOrchestration *aiservice.CampaignOrchestrationHandlers
 	campaignDebug         *aiservice.CampaignDebugHandlers
 	campaignArtifacts     *aiservice.CampaignArtifactHandlers
+	sessionRecaps         *aiservice.SessionRecapHandlers
 	systemReferences      *aiservice.SystemReferenceHandlers
 }
 
@@ -76,9 +81,11 @@ func buildHandlers(d runtimeDeps) (serviceHandlers, error) {
 		campaignOrchestration: campaignModule.campaignOrchestration,
 		campaignDebug:         campaignModule.campaignDebug,
 		campaignArtifacts:     campaignModule.campaignArtifacts,
+		sessionRecaps:         campaignModule.sessionRecaps,
 		systemReferences:      campaignModule.systemReferences,
 		providerGrants:        authModule.providerGrants,
 		accessRequests:        authModule.accessRequests,
+		accountData:           authModule.accountData,
 	}, nil
 }
 
@@ -98,6 +105,7 @@ func buildWorkflowDeps(d runtimeDeps) workflowDeps {
 		accessibleAgentResolver: svcpkg.NewAccessibleAgentResolver(d.store, d.store),
 		debugUpdateBroker:       svcpkg.NewCampaignDebugUpdateBroker(),
 		campaignTurnRunner:      buildCampaignTurnRunner(d),
+		playerTurnRunner:        buildCampaignPlayerTurnRunner(d),
 		campaignLogger:          slog.Default().With("service", "ai", "component", "campaign_debug"),
 	}
 }
@@ -152,10 +160,29 @@ func buildAuthLifecycleModule(w workflowDeps) (authLifecycleModule, error) {
 		return authLifecycleModule{}, fmt.Errorf("access request handlers: %w", err)
 	}
 
+	accountDataService, err := svcpkg.NewAccountDataService(svcpkg.AccountDataServiceConfig{
+		CredentialStore:    w.runtime.store,
+		AgentStore:         w.runtime.store,
+		ProviderGrantStore: w.runtime.store,
+		AccessRequestStore: w.runtime.store,
+		UserDataStore:      w.runtime.store,
+	})
+	if err != nil {
+		return authLifecycleModule{}, fmt.Errorf("account data service: %w", err)
+	}
+	accountDataHandlers, err := aiservice.NewAccountDataHandlers(aiservice.AccountDataHandlersConfig{
+		AccountDataService: accountDataService,
+		Authorizer:         w.runtime.gameBridge,
+	})
+	if err != nil {
+		return authLifecycleModule{}, fmt.Errorf("account data handlers: %w", err)
+	}
+
 	return authLifecycleModule{
 		credentials:    credentialHandlers,
 		providerGrants: providerGrantHandlers,
 		accessRequests: accessRequestHandlers,
+		accountData:    accountDataHandlers,
 	}, nil
 }
 
@@ -225,6 +252,7 @@ func buildCampaignRuntimeModule(w workflowDeps) (campaignRuntimeModule, error) {
 		CampaignAuthStateReader: w.runtime.gameBridge,
 		ProviderRegistry:        w.runtime.providerRegistry,
 		CampaignTurnRunner:      w.campaignTurnRunner,
+		PlayerTurnRunner:        w.playerTurnRunner,
 		TurnMemorySync: func(ctx context.Context, input svcpkg.TurnMemorySyncInput) error {
 			if w.runtime.openVikingSessionSync == nil {
 				return nil
@@ -269,10 +297,29 @@ func buildCampaignRuntimeModule(w workflowDeps) (campaignRuntimeModule, error) {
 		return campaignRuntimeModule{}, fmt.Errorf("campaign debug handlers: %w", err)
 	}
 
+	sessionRecapService, err := svcpkg.NewSessionRecapService(svcpkg.SessionRecapServiceConfig{
+		DraftStore:              w.runtime.store,
+		AgentStore:              w.runtime.store,
+		CampaignAuthStateReader: w.runtime.gameBridge,
+		ProviderRegistry:        w.runtime.providerRegistry,
+		AuthMaterialResolver:    w.authMaterialResolver,
+	})
+	if err != nil {
+		return campaignRuntimeModule{}, fmt.Errorf("session recap service: %w", err)
+	}
+	sessionRecapHandlers, err := aiservice.NewSessionRecapHandlers(aiservice.SessionRecapHandlersConfig{
+		SessionRecapService: sessionRecapService,
+		CampaignAuthorizer:  w.runtime.gameBridge,
+	})
+	if err != nil {
+		return campaignRuntimeModule{}, fmt.Errorf("session recap handlers: %w", err)
+	}
+
 	return campaignRuntimeModule{
 		campaignOrchestration: campaignOrchestrationHandlers,
 		campaignDebug:         campaignDebugHandlers,
 		campaignArtifacts:     campaignArtifactHandlers,
+		sessionRecaps:         sessionRecapHandlers,
 		systemReferences:      w.runtime.systemReferenceHandlers,
 	}, nil
 }
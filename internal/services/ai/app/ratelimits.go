@@ -0,0 +1,18 @@
+package server
+
+import (
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+)
+
+// rateLimits are the AI throttling defaults for user-initiated provider
+// spend. Worker-driven campaign turns are paced by session flow instead and
+// stay unthrottled so a busy table never stalls its GM.
+var rateLimits = ratelimit.Rules{
+	aiv1.InvocationService_InvokeAgent_FullMethodName:             {Key: ratelimit.KeyUser, Limit: 20, Period: time.Minute, Burst: 5},
+	aiv1.SessionRecapService_DraftSessionRecap_FullMethodName:     {Key: ratelimit.KeyCampaign, Limit: 10, Period: time.Hour, Burst: 3},
+	aiv1.AgentService_ListProviderModels_FullMethodName:           {Key: ratelimit.KeyUser, Limit: 30, Period: time.Minute},
+	aiv1.ProviderGrantService_StartProviderConnect_FullMethodName: {Key: ratelimit.KeyUser, Limit: 10, Period: time.Minute},
+}
@@ -50,6 +50,12 @@ func serviceRegistrations(h serviceHandlers) []grpcServiceRegistration {
 				aiv1.RegisterCampaignArtifactServiceServer(server, h.campaignArtifacts)
 			},
 		},
+		{
+			healthName: "ai.v1.SessionRecapService",
+			register: func(server *grpc.Server) {
+				aiv1.RegisterSessionRecapServiceServer(server, h.sessionRecaps)
+			},
+		},
 		{
 			healthName: "ai.v1.SystemReferenceService",
 			register: func(server *grpc.Server) {
@@ -68,6 +74,12 @@ func serviceRegistrations(h serviceHandlers) []grpcServiceRegistration {
 				aiv1.RegisterAccessRequestServiceServer(server, h.accessRequests)
 			},
 		},
+		{
+			healthName: "ai.v1.AccountDataService",
+			register: func(server *grpc.Server) {
+				aiv1.RegisterAccountDataServiceServer(server, h.accountData)
+			},
+		},
 	}
 }
 
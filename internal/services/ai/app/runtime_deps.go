@@ -10,6 +10,7 @@ import (
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
 	"github.com/louisbranch/fracturing.space/internal/platform/serviceaddr"
 	aiservice "github.com/louisbranch/fracturing.space/internal/services/ai/api/grpc/ai"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext"
@@ -18,13 +19,13 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/ai/gamebridge"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/openviking"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/cassette"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/gametools"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
 	anthropicprovider "github.com/louisbranch/fracturing.space/internal/services/ai/provider/anthropic"
 	openaiprovider "github.com/louisbranch/fracturing.space/internal/services/ai/provider/openai"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/providercatalog"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/provideroauth"
-	"github.com/louisbranch/fracturing.space/internal/services/ai/secret"
 	aisqlite "github.com/louisbranch/fracturing.space/internal/services/ai/storage/sqlite"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
 	"google.golang.org/grpc"
@@ -45,6 +46,7 @@ type runtimeDeps struct {
 	gameMc                  *platformgrpc.ManagedConn
 	openVikingAugmenter     orchestration.PromptAugmenter
 	openVikingSessionSync   *openviking.SessionSync
+	cassettePlayer          *cassette.Player
 }
 
 func buildRuntimeDeps(ctx context.Context, cfg runtimeConfig, logger *slog.Logger, deps serverDependencies) (runtimeDeps, error) {
@@ -72,20 +74,32 @@ func buildRuntimeDeps(ctx context.Context, cfg runtimeConfig, logger *slog.Logge
 	anthropicAdapter := anthropicprovider.NewAdapter(anthropicprovider.Config{
 		BaseURL: cfg.AnthropicBaseURL,
 	})
-	providerRegistry, err := providercatalog.New(
-		providercatalog.Bundle{
+	providerCassette, err := cfg.buildProviderCassette()
+	if err != nil {
+		_ = store.Close()
+		return runtimeDeps{}, err
+	}
+	bundles := []providercatalog.Bundle{
+		{
 			Provider:   provider.OpenAI,
 			OAuth:      openAIOAuthAdapter,
 			Invocation: openAIAdapter,
 			Model:      openAIAdapter,
 			Tool:       openAIAdapter,
 		},
-		providercatalog.Bundle{
+		{
 			Provider:   provider.Anthropic,
 			Invocation: anthropicAdapter,
 			Model:      anthropicAdapter,
 		},
-	)
+	}
+	for i, bundle := range bundles {
+		if bundles[i], err = providerCassette.wrap(bundle); err != nil {
+			_ = store.Close()
+			return runtimeDeps{}, err
+		}
+	}
+	providerRegistry, err := providercatalog.New(bundles...)
 	if err != nil {
 		_ = store.Close()
 		return runtimeDeps{}, fmt.Errorf("build provider registry: %w", err)
@@ -155,6 +169,7 @@ func buildRuntimeDeps(ctx context.Context, cfg runtimeConfig, logger *slog.Logge
 		gameMc:                  gameMc,
 		openVikingAugmenter:     openVikingAugmenter,
 		openVikingSessionSync:   openVikingSessionSync,
+		cassettePlayer:          providerCassette.player,
 	}, nil
 }
 
@@ -227,8 +242,32 @@ func buildCampaignTurnRunner(d runtimeDeps) orchestration.CampaignTurnRunner {
 		return nil
 	}
 
+	promptBuilder := buildPromptBuilder(d.instructionLoader, d.openVikingAugmenter, openviking.IntegrationMode(d.cfg.OpenVikingMode))
+	runnerCfg := d.cfg.campaignTurnRunnerConfig(buildGameToolsDialer(d))
+	runnerCfg.PromptBuilder = promptBuilder
+	runnerCfg.ToolPolicy = orchestration.NewStaticToolPolicy(gametools.ProductionToolNames())
+	return orchestration.NewRunner(runnerCfg)
+}
+
+// buildCampaignPlayerTurnRunner builds the runner for AI-controlled player
+// participants. It shares the game tool dialer with the GM runner but only
+// exposes the player tool profile and a player-safe prompt.
+func buildCampaignPlayerTurnRunner(d runtimeDeps) orchestration.CampaignTurnRunner {
+	if d.gameMc == nil {
+		return nil
+	}
+
+	runnerCfg := d.cfg.campaignTurnRunnerConfig(buildGameToolsDialer(d))
+	runnerCfg.PromptBuilder = buildPlayerPromptBuilder()
+	runnerCfg.TurnPolicy = orchestration.NewPlayerTurnPolicy()
+	runnerCfg.CommitToolName = orchestration.PlayerCommitToolName
+	runnerCfg.ToolPolicy = orchestration.NewStaticToolPolicy(gametools.PlayerToolNames())
+	return orchestration.NewRunner(runnerCfg)
+}
+
+func buildGameToolsDialer(d runtimeDeps) orchestration.Dialer {
 	gameConn := d.gameMc.Conn()
-	dialer := gametools.NewDirectDialer(gametools.Clients{
+	return gametools.NewDirectDialer(gametools.Clients{
 		Interaction: gamev1.NewInteractionServiceClient(gameConn),
 		CampaignAI:  gamev1.NewCampaignAIOrchestrationServiceClient(gameConn),
 		Scene:       gamev1.NewSceneServiceClient(gameConn),
@@ -241,9 +280,4 @@ func buildCampaignTurnRunner(d runtimeDeps) orchestration.CampaignTurnRunner {
 		Artifact:    d.campaignArtifactManager,
 		Reference:   d.referenceCorpus,
 	})
-	promptBuilder := buildPromptBuilder(d.instructionLoader, d.openVikingAugmenter, openviking.IntegrationMode(d.cfg.OpenVikingMode))
-	runnerCfg := d.cfg.campaignTurnRunnerConfig(dialer)
-	runnerCfg.PromptBuilder = promptBuilder
-	runnerCfg.ToolPolicy = orchestration.NewStaticToolPolicy(gametools.ProductionToolNames())
-	return orchestration.NewRunner(runnerCfg)
 }
@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -12,10 +11,12 @@ import (
 	"sync"
 
 	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext/instructionset"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/openviking"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/cassette"
 	orchdaggerheart "github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/daggerheart"
 	aisqlite "github.com/louisbranch/fracturing.space/internal/services/ai/storage/sqlite"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -60,8 +61,11 @@ type Server struct {
 	health     *health.Server
 	store      *aisqlite.Store
 	gameMc     *platformgrpc.ManagedConn
+	limiter    *ratelimit.Limiter
 	logger     *slog.Logger
-	closeOnce  sync.Once
+	// cassettePlayer is set only in provider cassette replay mode.
+	cassettePlayer *cassette.Player
+	closeOnce      sync.Once
 }
 
 // New creates a configured AI server using one startup context for dependency
@@ -88,10 +92,22 @@ func newServerWithRuntimeConfig(ctx context.Context, addr string, cfg runtimeCon
 		return nil, fmt.Errorf("listen on %s: %w", addr, err)
 	}
 
+	limiter, err := ratelimit.NewFromEnv(rateLimits)
+	if err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("configure rate limits: %w", err)
+	}
+
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.ChainUnaryInterceptor(serviceIdentityValidationUnaryInterceptor(cfg.InternalServiceAllowlist)),
-		grpc.ChainStreamInterceptor(serviceIdentityValidationStreamInterceptor(cfg.InternalServiceAllowlist)),
+		grpc.ChainUnaryInterceptor(
+			serviceIdentityValidationUnaryInterceptor(cfg.InternalServiceAllowlist),
+			limiter.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			serviceIdentityValidationStreamInterceptor(cfg.InternalServiceAllowlist),
+			limiter.StreamServerInterceptor(),
+		),
 	)
 
 	logger := slog.Default().With("service", "ai")
@@ -99,12 +115,14 @@ func newServerWithRuntimeConfig(ctx context.Context, addr string, cfg runtimeCon
 	runtimeDeps, err := buildRuntimeDeps(ctx, cfg, logger, deps)
 	if err != nil {
 		_ = listener.Close()
+		_ = limiter.Close()
 		return nil, err
 	}
 
 	handlers, err := buildHandlers(runtimeDeps)
 	if err != nil {
 		_ = listener.Close()
+		_ = limiter.Close()
 		runtimeDeps.close(logger)
 		return nil, fmt.Errorf("build handlers: %w", err)
 	}
@@ -118,7 +136,10 @@ func newServerWithRuntimeConfig(ctx context.Context, addr string, cfg runtimeCon
 		health:     healthServer,
 		store:      runtimeDeps.store,
 		gameMc:     runtimeDeps.gameMc,
+		limiter:    limiter,
 		logger:     logger,
+
+		cassettePlayer: runtimeDeps.cassettePlayer,
 	}, nil
 }
 
@@ -174,7 +195,7 @@ func (s *Server) Serve(ctx context.Context) error {
 		s.grpcServer.GracefulStop()
 		err := <-serveErr
 		if err == nil || errors.Is(err, grpc.ErrServerStopped) {
-			return nil
+			return s.checkCassetteReplay()
 		}
 		return fmt.Errorf("serve gRPC: %w", err)
 	case err := <-serveErr:
@@ -188,6 +209,18 @@ func (s *Server) Serve(ctx context.Context) error {
 	}
 }
 
+// checkCassetteReplay surfaces lenient replay drift and fails shutdown when the
+// live tool loop ended before the recorded exchanges were all replayed.
+func (s *Server) checkCassetteReplay() error {
+	if s.cassettePlayer == nil {
+		return nil
+	}
+	for _, drift := range s.cassettePlayer.Drift() {
+		s.logger.Warn("provider cassette drift", "error", drift)
+	}
+	return s.cassettePlayer.Done()
+}
+
 // Close releases server resources.
 func (s *Server) Close() {
 	if s == nil {
@@ -212,6 +245,9 @@ func (s *Server) Close() {
 			}
 		}
 		closeManagedConn(s.gameMc, "game", s.logger)
+		if err := s.limiter.Close(); err != nil {
+			s.logger.Warn("close rate limiter", "error", err)
+		}
 	})
 }
 
@@ -228,20 +264,6 @@ func openAIStore(path string) (*aisqlite.Store, error) {
 	return store, nil
 }
 
-// decodeBase64Key accepts both raw and padded base64 encodings to reduce
-// operational friction across secret managers while preserving exact key bytes.
-func decodeBase64Key(value string) ([]byte, error) {
-	key, rawErr := base64.RawStdEncoding.DecodeString(value)
-	if rawErr == nil {
-		return key, nil
-	}
-	key, stdErr := base64.StdEncoding.DecodeString(value)
-	if stdErr == nil {
-		return key, nil
-	}
-	return nil, rawErr
-}
-
 // buildPromptBuilder loads instruction files and creates a configured prompt
 // builder. Missing instruction content degrades explicitly to inline renderer
 // defaults while preserving the full context-source registry.
@@ -302,6 +324,17 @@ func buildPromptContextSources(policy promptContextPolicy) *orchestration.Contex
 	return reg
 }
 
+// buildPlayerPromptBuilder assembles AI player prompts from player-visible
+// context only; GM instruction files and story artifacts are never loaded.
+func buildPlayerPromptBuilder() orchestration.PromptBuilder {
+	return orchestration.NewPromptBuilder(orchestration.PromptBuilderConfig{
+		Collector: orchestration.NewPlayerContextSourceRegistry(),
+		Renderer: orchestration.NewBriefPromptRenderer(orchestration.BriefPromptRendererConfig{
+			Policy: orchestration.PlayerPromptRenderPolicy(),
+		}),
+	})
+}
+
 func buildPromptRenderer(loader *instructionset.Loader) orchestration.PromptRenderer {
 	policy := orchestration.DefaultPromptRenderPolicy()
 	policy.Instructions = loadPromptInstructions(loader)
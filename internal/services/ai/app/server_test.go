@@ -15,9 +15,11 @@ import (
 
 	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext/instructionset"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/openviking"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/cassette"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
@@ -451,6 +453,74 @@ func TestBuildRuntimeDepsRegistersAnthropicWithoutToolRuntime(t *testing.T) {
 	}
 }
 
+func TestBuildRuntimeDepsReplaysCassetteForRecordedProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "anthropic.json")
+	if err := cassette.Save(path, &cassette.Cassette{
+		Version:  cassette.FormatVersion,
+		Metadata: cassette.Metadata{Provider: string(provider.Anthropic)},
+		Turns:    []cassette.Turn{{Exchanges: []cassette.Exchange{{Input: cassette.Input{Model: "claude-test", Prompt: "go"}}}}},
+	}); err != nil {
+		t.Fatalf("save cassette: %v", err)
+	}
+	cfg := testRuntimeConfig(t)
+	cfg.ProviderCassetteMode = cassette.ModeReplay
+	cfg.ProviderCassettePath = path
+	logger := newDiscardLogger()
+	deps, err := buildRuntimeDeps(context.Background(), cfg, logger, defaultServerDependencies())
+	if err != nil {
+		t.Fatalf("buildRuntimeDeps() error = %v", err)
+	}
+	t.Cleanup(func() {
+		deps.close(logger)
+	})
+
+	tool, ok := deps.providerRegistry.ToolAdapter(provider.Anthropic)
+	if !ok || tool != orchestration.Provider(deps.cassettePlayer) {
+		t.Fatalf("anthropic tool adapter = %T, want cassette player", tool)
+	}
+	models, ok := deps.providerRegistry.ModelAdapter(provider.Anthropic)
+	if !ok {
+		t.Fatal("expected anthropic model adapter")
+	}
+	listed, err := models.ListModels(context.Background(), provider.ListModelsInput{})
+	if err != nil || len(listed) != 1 || listed[0].ID != "claude-test" {
+		t.Fatalf("ListModels() = %v, %v", listed, err)
+	}
+	if tool, _ := deps.providerRegistry.ToolAdapter(provider.OpenAI); tool == orchestration.Provider(deps.cassettePlayer) {
+		t.Fatal("did not expect openai tool adapter to replay an anthropic cassette")
+	}
+}
+
+func TestServeFailsWhenCassetteReplayIsIncomplete(t *testing.T) {
+	player, err := cassette.NewPlayer(&cassette.Cassette{
+		Version: cassette.FormatVersion,
+		Turns:   []cassette.Turn{{Exchanges: []cassette.Exchange{{Input: cassette.Input{Prompt: "go"}}}}},
+	}, cassette.PlayerConfig{})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	limiter, err := ratelimit.NewFromEnv(rateLimits)
+	if err != nil {
+		t.Fatalf("rate limiter: %v", err)
+	}
+	srv := &Server{
+		listener:       listener,
+		grpcServer:     grpc.NewServer(),
+		limiter:        limiter,
+		logger:         newDiscardLogger(),
+		cassettePlayer: player,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := srv.Serve(ctx); err == nil || !strings.Contains(err.Error(), "not fully replayed") {
+		t.Fatalf("Serve() error = %v, want unreplayed cassette error", err)
+	}
+}
+
 func TestRegisterServicesSetsHealthForAllRegistrations(t *testing.T) {
 	logger := newDiscardLogger()
 	deps, err := buildRuntimeDeps(context.Background(), testRuntimeConfig(t), logger, defaultServerDependencies())
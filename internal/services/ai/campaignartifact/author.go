@@ -0,0 +1,41 @@
+package campaignartifact
+
+import (
+	"context"
+	"strings"
+)
+
+type authorContextKey struct{}
+
+// WithAuthor attributes artifact writes made with ctx to author.
+func WithAuthor(ctx context.Context, author Author) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, authorContextKey{}, author.Normalize())
+}
+
+// AuthorFromContext returns the write author carried by ctx, defaulting to
+// the system author when none was attached.
+func AuthorFromContext(ctx context.Context) Author {
+	if ctx == nil {
+		return Author{Kind: AuthorKindSystem}
+	}
+	author, ok := ctx.Value(authorContextKey{}).(Author)
+	if !ok {
+		return Author{Kind: AuthorKindSystem}
+	}
+	return author
+}
+
+// Normalize trims the author ID and folds unknown kinds to system.
+func (a Author) Normalize() Author {
+	a.ID = strings.TrimSpace(a.ID)
+	switch a.Kind {
+	case AuthorKindAITurn, AuthorKindUser:
+	default:
+		a.Kind = AuthorKindSystem
+		a.ID = ""
+	}
+	return a
+}
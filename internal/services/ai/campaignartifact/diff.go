@@ -0,0 +1,195 @@
+package campaignartifact
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffOp classifies one line in a revision diff.
+type DiffOp string
+
+const (
+	// DiffOpEqual marks a line present in both revisions.
+	DiffOpEqual DiffOp = "equal"
+	// DiffOpInsert marks a line only present in the newer revision.
+	DiffOpInsert DiffOp = "insert"
+	// DiffOpDelete marks a line only present in the older revision.
+	DiffOpDelete DiffOp = "delete"
+)
+
+// diffContextLines is the number of unchanged lines kept around each hunk.
+const diffContextLines = 3
+
+// DiffLine is one line of a line-oriented revision diff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// Diff compares two revisions of the same artifact path.
+type Diff struct {
+	From  Revision
+	To    Revision
+	Lines []DiffLine
+}
+
+// Changed reports whether the two revisions differ.
+func (d Diff) Changed() bool {
+	for _, line := range d.Lines {
+		if line.Op != DiffOpEqual {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffRevisions builds a full line diff from one revision to another.
+func DiffRevisions(from Revision, to Revision) Diff {
+	return Diff{From: from, To: to, Lines: diffLines(splitLines(from.Content), splitLines(to.Content))}
+}
+
+// Unified renders the diff in unified format with three lines of context.
+func (d Diff) Unified() string {
+	if !d.Changed() {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s@%d\n", d.From.Path, d.From.Number)
+	fmt.Fprintf(&b, "+++ %s@%d\n", d.To.Path, d.To.Number)
+
+	// fromLine/toLine are 1-based positions of each DiffLine in either side.
+	fromLine := make([]int, len(d.Lines))
+	toLine := make([]int, len(d.Lines))
+	nextFrom, nextTo := 1, 1
+	for i, line := range d.Lines {
+		fromLine[i], toLine[i] = nextFrom, nextTo
+		if line.Op != DiffOpInsert {
+			nextFrom++
+		}
+		if line.Op != DiffOpDelete {
+			nextTo++
+		}
+	}
+
+	for start := 0; start < len(d.Lines); {
+		if d.Lines[start].Op == DiffOpEqual {
+			start++
+			continue
+		}
+		hunkStart := max(start-diffContextLines, 0)
+		// changeEnd is one past the last change merged into this hunk; changes
+		// separated by more than twice the context width start a new hunk.
+		changeEnd := start
+		for i := start; i < len(d.Lines); i++ {
+			if d.Lines[i].Op != DiffOpEqual {
+				changeEnd = i + 1
+				continue
+			}
+			if i-changeEnd >= 2*diffContextLines {
+				break
+			}
+		}
+		hunkEnd := min(changeEnd+diffContextLines, len(d.Lines))
+
+		fromCount, toCount := 0, 0
+		for _, line := range d.Lines[hunkStart:hunkEnd] {
+			if line.Op != DiffOpInsert {
+				fromCount++
+			}
+			if line.Op != DiffOpDelete {
+				toCount++
+			}
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(fromLine[hunkStart], fromCount), hunkRange(toLine[hunkStart], toCount))
+		for _, line := range d.Lines[hunkStart:hunkEnd] {
+			switch line.Op {
+			case DiffOpInsert:
+				b.WriteByte('+')
+			case DiffOpDelete:
+				b.WriteByte('-')
+			default:
+				b.WriteByte(' ')
+			}
+			b.WriteString(line.Text)
+			b.WriteByte('\n')
+		}
+		start = hunkEnd
+	}
+	return b.String()
+}
+
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
+
+// diffLines computes a longest-common-subsequence line diff. Artifacts are
+// short markdown documents, so the quadratic table stays small.
+func diffLines(from []string, to []string) []DiffLine {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+	a := from[prefix : len(from)-suffix]
+	b := to[prefix : len(to)-suffix]
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]DiffLine, 0, len(from)+len(b))
+	for _, text := range from[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffOpEqual, Text: text})
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, DiffLine{Op: DiffOpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, DiffLine{Op: DiffOpDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Op: DiffOpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, DiffLine{Op: DiffOpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, DiffLine{Op: DiffOpInsert, Text: b[j]})
+	}
+	for _, text := range from[len(from)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffOpEqual, Text: text})
+	}
+	return lines
+}
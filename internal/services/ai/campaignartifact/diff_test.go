@@ -0,0 +1,71 @@
+package campaignartifact
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDiffRevisionsProducesUnifiedHunks(t *testing.T) {
+	from := Revision{Path: "memory.md", Number: 1, Content: "# Memory\n- harbor\n- bell\n- fog\n"}
+	to := Revision{Path: "memory.md", Number: 2, Content: "# Memory\n- harbor\n- lantern\n- fog\n- tide\n"}
+
+	diff := DiffRevisions(from, to)
+	if !diff.Changed() {
+		t.Fatal("expected diff to report changes")
+	}
+	want := strings.Join([]string{
+		"--- memory.md@1",
+		"+++ memory.md@2",
+		"@@ -1,4 +1,5 @@",
+		" # Memory",
+		" - harbor",
+		"-- bell",
+		"+- lantern",
+		" - fog",
+		"+- tide",
+		"",
+	}, "\n")
+	if got := diff.Unified(); got != want {
+		t.Fatalf("unified diff =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDiffRevisionsSplitsDistantHunks(t *testing.T) {
+	lines := make([]string, 20)
+	for i := range lines {
+		lines[i] = string(rune('a' + i))
+	}
+	changed := append([]string(nil), lines...)
+	changed[1] = "B"
+	changed[18] = "S"
+
+	diff := DiffRevisions(
+		Revision{Path: "story.md", Number: 3, Content: strings.Join(lines, "\n")},
+		Revision{Path: "story.md", Number: 4, Content: strings.Join(changed, "\n")},
+	)
+	if got := strings.Count(diff.Unified(), "@@ -"); got != 2 {
+		t.Fatalf("hunk count = %d, want 2:\n%s", got, diff.Unified())
+	}
+}
+
+func TestDiffRevisionsIdenticalContent(t *testing.T) {
+	diff := DiffRevisions(Revision{Content: "same"}, Revision{Content: "same"})
+	if diff.Changed() || diff.Unified() != "" {
+		t.Fatalf("identical revisions should not diff: %#v", diff)
+	}
+}
+
+func TestAuthorFromContextDefaultsToSystem(t *testing.T) {
+	if got := AuthorFromContext(context.Background()); got.Kind != AuthorKindSystem || got.ID != "" {
+		t.Fatalf("default author = %#v", got)
+	}
+	ctx := WithAuthor(context.Background(), Author{Kind: AuthorKindAITurn, ID: " turn-1 "})
+	if got := AuthorFromContext(ctx); got.Kind != AuthorKindAITurn || got.ID != "turn-1" {
+		t.Fatalf("ai author = %#v", got)
+	}
+	ctx = WithAuthor(context.Background(), Author{Kind: "robot", ID: "x"})
+	if got := AuthorFromContext(ctx); got.Kind != AuthorKindSystem || got.ID != "" {
+		t.Fatalf("unknown author kind = %#v, want system", got)
+	}
+}
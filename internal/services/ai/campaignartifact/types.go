@@ -15,9 +15,49 @@ type Artifact struct {
 	UpdatedAt  time.Time
 }
 
+// AuthorKind identifies which actor produced one artifact revision.
+type AuthorKind string
+
+const (
+	// AuthorKindSystem marks writes made by built-in seeding and upgrades.
+	AuthorKindSystem AuthorKind = "system"
+	// AuthorKindAITurn marks writes made by the AI GM during one campaign turn.
+	AuthorKindAITurn AuthorKind = "ai_turn"
+	// AuthorKindUser marks writes made directly by a signed-in user.
+	AuthorKindUser AuthorKind = "user"
+)
+
+// Author records who produced one artifact revision. ID is the AI turn ID for
+// AuthorKindAITurn, the user ID for AuthorKindUser, and empty for system writes.
+type Author struct {
+	Kind AuthorKind
+	ID   string
+}
+
+// Revision stores one immutable artifact body captured at write time.
+// Numbers start at 1 and increase per campaign artifact path.
+type Revision struct {
+	CampaignID string
+	Path       string
+	Number     int
+	Content    string
+	Author     Author
+	SizeBytes  int
+	CreatedAt  time.Time
+}
+
 // Store persists campaign-scoped GM working artifacts.
 type Store interface {
 	PutCampaignArtifact(ctx context.Context, record Artifact) error
 	GetCampaignArtifact(ctx context.Context, campaignID string, path string) (Artifact, error)
 	ListCampaignArtifacts(ctx context.Context, campaignID string) ([]Artifact, error)
 }
+
+// RevisionStore persists artifact writes together with their revision history.
+type RevisionStore interface {
+	// PutCampaignArtifactRevision stores the artifact snapshot and appends the
+	// next revision for its path in one write.
+	PutCampaignArtifactRevision(ctx context.Context, record Artifact, author Author) (Revision, error)
+	ListCampaignArtifactRevisions(ctx context.Context, campaignID string, path string) ([]Revision, error)
+	GetCampaignArtifactRevision(ctx context.Context, campaignID string, path string, number int) (Revision, error)
+}
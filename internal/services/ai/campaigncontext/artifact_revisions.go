@@ -0,0 +1,82 @@
+package campaigncontext
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+// ListRevisions returns the write history of one artifact, newest first.
+func (m *Manager) ListRevisions(ctx context.Context, campaignID string, path string) ([]campaignartifact.Revision, error) {
+	campaignID, normalizedPath, err := m.revisionScope(campaignID, path)
+	if err != nil {
+		return nil, err
+	}
+	return m.store.ListCampaignArtifactRevisions(ctx, campaignID, normalizedPath)
+}
+
+// GetRevision returns one recorded artifact revision.
+func (m *Manager) GetRevision(ctx context.Context, campaignID string, path string, number int) (campaignartifact.Revision, error) {
+	campaignID, normalizedPath, err := m.revisionScope(campaignID, path)
+	if err != nil {
+		return campaignartifact.Revision{}, err
+	}
+	if number < 1 {
+		return campaignartifact.Revision{}, fmt.Errorf("revision must be positive")
+	}
+	return m.store.GetCampaignArtifactRevision(ctx, campaignID, normalizedPath, number)
+}
+
+// DiffRevisions compares two revisions of one artifact. A zero toNumber
+// compares against the latest revision.
+func (m *Manager) DiffRevisions(ctx context.Context, campaignID string, path string, fromNumber int, toNumber int) (campaignartifact.Diff, error) {
+	from, err := m.GetRevision(ctx, campaignID, path, fromNumber)
+	if err != nil {
+		return campaignartifact.Diff{}, err
+	}
+	var to campaignartifact.Revision
+	if toNumber == 0 {
+		revisions, err := m.ListRevisions(ctx, campaignID, path)
+		if err != nil {
+			return campaignartifact.Diff{}, err
+		}
+		if len(revisions) == 0 {
+			return campaignartifact.Diff{}, storage.ErrNotFound
+		}
+		to = revisions[0]
+	} else {
+		to, err = m.GetRevision(ctx, campaignID, path, toNumber)
+		if err != nil {
+			return campaignartifact.Diff{}, err
+		}
+	}
+	return campaignartifact.DiffRevisions(from, to), nil
+}
+
+// RestoreRevision rolls one mutable artifact back to a recorded revision. The
+// rollback is itself appended as a new revision so history stays linear.
+func (m *Manager) RestoreRevision(ctx context.Context, campaignID string, path string, number int) (campaignartifact.Artifact, error) {
+	revision, err := m.GetRevision(ctx, campaignID, path, number)
+	if err != nil {
+		return campaignartifact.Artifact{}, err
+	}
+	return m.UpsertArtifact(ctx, revision.CampaignID, revision.Path, revision.Content)
+}
+
+func (m *Manager) revisionScope(campaignID string, path string) (string, string, error) {
+	if m == nil || m.store == nil {
+		return "", "", fmt.Errorf("campaign artifact store is not configured")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return "", "", fmt.Errorf("campaign id is required")
+	}
+	normalizedPath, err := NormalizeArtifactPath(path)
+	if err != nil {
+		return "", "", err
+	}
+	return campaignID, normalizedPath, nil
+}
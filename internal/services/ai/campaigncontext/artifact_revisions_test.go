@@ -0,0 +1,112 @@
+package campaigncontext
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+	"github.com/louisbranch/fracturing.space/internal/test/mock/aifakes"
+)
+
+func TestManagerUpsertArtifactRecordsAuthoredRevisions(t *testing.T) {
+	store := aifakes.NewCampaignArtifactStore()
+	now := time.Date(2026, 3, 14, 1, 30, 0, 0, time.UTC)
+	manager := NewManager(ManagerConfig{Store: store, Clock: func() time.Time { return now }})
+
+	aiCtx := campaignartifact.WithAuthor(context.Background(), campaignartifact.Author{Kind: campaignartifact.AuthorKindAITurn, ID: "turn-1"})
+	if _, err := manager.UpsertArtifact(aiCtx, "campaign-1", MemoryArtifactPath, "first"); err != nil {
+		t.Fatalf("UpsertArtifact(ai) error = %v", err)
+	}
+	userCtx := campaignartifact.WithAuthor(context.Background(), campaignartifact.Author{Kind: campaignartifact.AuthorKindUser, ID: "user-1"})
+	if _, err := manager.UpsertArtifact(userCtx, "campaign-1", MemoryArtifactPath, "second"); err != nil {
+		t.Fatalf("UpsertArtifact(user) error = %v", err)
+	}
+
+	revisions, err := manager.ListRevisions(context.Background(), "campaign-1", " ./memory.md ")
+	if err != nil {
+		t.Fatalf("ListRevisions() error = %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("revision count = %d, want 2", len(revisions))
+	}
+	if revisions[0].Author != (campaignartifact.Author{Kind: campaignartifact.AuthorKindUser, ID: "user-1"}) {
+		t.Fatalf("latest author = %+v", revisions[0].Author)
+	}
+	if revisions[1].Author != (campaignartifact.Author{Kind: campaignartifact.AuthorKindAITurn, ID: "turn-1"}) {
+		t.Fatalf("first author = %+v", revisions[1].Author)
+	}
+}
+
+func TestManagerEnsureDefaultArtifactsRecordsSystemRevisions(t *testing.T) {
+	store := aifakes.NewCampaignArtifactStore()
+	manager := NewManager(ManagerConfig{Store: store})
+
+	ctx := campaignartifact.WithAuthor(context.Background(), campaignartifact.Author{Kind: campaignartifact.AuthorKindUser, ID: "user-1"})
+	if _, err := manager.EnsureDefaultArtifacts(ctx, "campaign-1", "Story"); err != nil {
+		t.Fatalf("EnsureDefaultArtifacts() error = %v", err)
+	}
+	revisions, err := manager.ListRevisions(context.Background(), "campaign-1", StoryArtifactPath)
+	if err != nil {
+		t.Fatalf("ListRevisions() error = %v", err)
+	}
+	if len(revisions) != 1 || revisions[0].Author.Kind != campaignartifact.AuthorKindSystem {
+		t.Fatalf("story revisions = %+v, want one system revision", revisions)
+	}
+}
+
+func TestManagerRestoreRevisionAppendsRollback(t *testing.T) {
+	store := aifakes.NewCampaignArtifactStore()
+	manager := NewManager(ManagerConfig{Store: store})
+	ctx := context.Background()
+	for _, content := range []string{"good memory", "corrupted"} {
+		if _, err := manager.UpsertArtifact(ctx, "campaign-1", MemoryArtifactPath, content); err != nil {
+			t.Fatalf("UpsertArtifact(%q) error = %v", content, err)
+		}
+	}
+
+	restoreCtx := campaignartifact.WithAuthor(ctx, campaignartifact.Author{Kind: campaignartifact.AuthorKindUser, ID: "gm-1"})
+	restored, err := manager.RestoreRevision(restoreCtx, "campaign-1", MemoryArtifactPath, 1)
+	if err != nil {
+		t.Fatalf("RestoreRevision() error = %v", err)
+	}
+	if restored.Content != "good memory" {
+		t.Fatalf("restored content = %q, want good memory", restored.Content)
+	}
+	revisions, err := manager.ListRevisions(ctx, "campaign-1", MemoryArtifactPath)
+	if err != nil {
+		t.Fatalf("ListRevisions() error = %v", err)
+	}
+	if len(revisions) != 3 || revisions[0].Number != 3 || revisions[0].Author.ID != "gm-1" {
+		t.Fatalf("revisions after restore = %+v", revisions)
+	}
+
+	diff, err := manager.DiffRevisions(ctx, "campaign-1", MemoryArtifactPath, 2, 0)
+	if err != nil {
+		t.Fatalf("DiffRevisions() error = %v", err)
+	}
+	if diff.To.Number != 3 || !diff.Changed() {
+		t.Fatalf("diff against latest = %+v", diff)
+	}
+}
+
+func TestManagerRestoreRevisionRejectsReadOnlyAndMissingRevisions(t *testing.T) {
+	store := aifakes.NewCampaignArtifactStore()
+	manager := NewManager(ManagerConfig{Store: store})
+	ctx := context.Background()
+	if _, err := manager.EnsureDefaultArtifacts(ctx, "campaign-1", ""); err != nil {
+		t.Fatalf("EnsureDefaultArtifacts() error = %v", err)
+	}
+
+	if _, err := manager.RestoreRevision(ctx, "campaign-1", SkillsArtifactPath, 1); err == nil {
+		t.Fatal("expected skills.md restore to be rejected")
+	}
+	if _, err := manager.RestoreRevision(ctx, "campaign-1", MemoryArtifactPath, 7); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("RestoreRevision(missing) error = %v, want storage.ErrNotFound", err)
+	}
+	if _, err := manager.GetRevision(ctx, "campaign-1", MemoryArtifactPath, 0); err == nil {
+		t.Fatal("expected non-positive revision to be rejected")
+	}
+}
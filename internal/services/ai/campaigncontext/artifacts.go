@@ -29,11 +29,12 @@ const (
 
 var workingArtifactSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
 
-// ArtifactStore persists campaign-scoped GM artifacts.
+// ArtifactStore persists campaign-scoped GM artifacts and their revision
+// history.
 type ArtifactStore interface {
-	PutCampaignArtifact(ctx context.Context, record campaignartifact.Artifact) error
 	GetCampaignArtifact(ctx context.Context, campaignID string, path string) (campaignartifact.Artifact, error)
 	ListCampaignArtifacts(ctx context.Context, campaignID string) ([]campaignartifact.Artifact, error)
+	campaignartifact.RevisionStore
 }
 
 // SkillsDocumentLoader provides the composed skills document used for default
@@ -85,7 +86,9 @@ func (m *Manager) EnsureDefaultArtifacts(ctx context.Context, campaignID string,
 	if campaignID == "" {
 		return nil, fmt.Errorf("campaign id is required")
 	}
-	if err := ensureArtifactUpToDate(ctx, m.store, m.clock, campaignID, campaignartifact.Artifact{
+	// Seeding and built-in upgrades are system writes regardless of caller.
+	ctx = campaignartifact.WithAuthor(ctx, campaignartifact.Author{Kind: campaignartifact.AuthorKindSystem})
+	if err := m.ensureArtifactUpToDate(ctx, campaignID, campaignartifact.Artifact{
 		CampaignID: campaignID,
 		Path:       SkillsArtifactPath,
 		Content:    m.resolveSkillsContent(),
@@ -93,7 +96,7 @@ func (m *Manager) EnsureDefaultArtifacts(ctx context.Context, campaignID string,
 	}); err != nil {
 		return nil, err
 	}
-	if err := ensureArtifactUpToDate(ctx, m.store, m.clock, campaignID, campaignartifact.Artifact{
+	if err := m.ensureArtifactUpToDate(ctx, campaignID, campaignartifact.Artifact{
 		CampaignID: campaignID,
 		Path:       MemoryArtifactPath,
 		Content:    "",
@@ -103,7 +106,7 @@ func (m *Manager) EnsureDefaultArtifacts(ctx context.Context, campaignID string,
 	}
 	storySeed = strings.TrimSpace(storySeed)
 	if storySeed != "" {
-		if err := ensureArtifactIfMissing(ctx, m.store, m.clock, campaignID, campaignartifact.Artifact{
+		if err := m.ensureArtifactIfMissing(ctx, campaignID, campaignartifact.Artifact{
 			CampaignID: campaignID,
 			Path:       StoryArtifactPath,
 			Content:    storySeed,
@@ -139,7 +142,8 @@ func (m *Manager) GetArtifact(ctx context.Context, campaignID string, path strin
 	return m.store.GetCampaignArtifact(ctx, strings.TrimSpace(campaignID), normalizedPath)
 }
 
-// UpsertArtifact validates policy and replaces one mutable artifact body.
+// UpsertArtifact validates policy and replaces one mutable artifact body. The
+// write is recorded as a new revision attributed to the author carried by ctx.
 func (m *Manager) UpsertArtifact(ctx context.Context, campaignID string, path string, content string) (campaignartifact.Artifact, error) {
 	if m == nil || m.store == nil {
 		return campaignartifact.Artifact{}, fmt.Errorf("campaign artifact store is not configured")
@@ -170,7 +174,7 @@ func (m *Manager) UpsertArtifact(ctx context.Context, campaignID string, path st
 	default:
 		return campaignartifact.Artifact{}, err
 	}
-	if err := m.store.PutCampaignArtifact(ctx, record); err != nil {
+	if _, err := m.put(ctx, record); err != nil {
 		return campaignartifact.Artifact{}, err
 	}
 	return m.store.GetCampaignArtifact(ctx, campaignID, normalizedPath)
@@ -211,8 +215,13 @@ func normalizeWritableArtifactPath(path string) (string, error) {
 	return normalizedPath, nil
 }
 
-func ensureArtifactUpToDate(ctx context.Context, store ArtifactStore, clock func() time.Time, campaignID string, record campaignartifact.Artifact) error {
-	existing, err := store.GetCampaignArtifact(ctx, campaignID, record.Path)
+// put stores one artifact write as a new revision authored by ctx.
+func (m *Manager) put(ctx context.Context, record campaignartifact.Artifact) (campaignartifact.Revision, error) {
+	return m.store.PutCampaignArtifactRevision(ctx, record, campaignartifact.AuthorFromContext(ctx))
+}
+
+func (m *Manager) ensureArtifactUpToDate(ctx context.Context, campaignID string, record campaignartifact.Artifact) error {
+	existing, err := m.store.GetCampaignArtifact(ctx, campaignID, record.Path)
 	switch {
 	case err == nil:
 		if existing.ReadOnly == record.ReadOnly && existing.Content == record.Content {
@@ -220,30 +229,33 @@ func ensureArtifactUpToDate(ctx context.Context, store ArtifactStore, clock func
 		}
 		existing.Content = record.Content
 		existing.ReadOnly = record.ReadOnly
-		existing.UpdatedAt = clock().UTC()
-		return store.PutCampaignArtifact(ctx, existing)
+		existing.UpdatedAt = m.clock().UTC()
+		_, err = m.put(ctx, existing)
+		return err
 	case err != storage.ErrNotFound:
 		return err
 	}
 
-	now := clock().UTC()
+	now := m.clock().UTC()
 	record.CreatedAt = now
 	record.UpdatedAt = now
-	return store.PutCampaignArtifact(ctx, record)
+	_, err = m.put(ctx, record)
+	return err
 }
 
-func ensureArtifactIfMissing(ctx context.Context, store ArtifactStore, clock func() time.Time, campaignID string, record campaignartifact.Artifact) error {
-	_, err := store.GetCampaignArtifact(ctx, campaignID, record.Path)
+func (m *Manager) ensureArtifactIfMissing(ctx context.Context, campaignID string, record campaignartifact.Artifact) error {
+	_, err := m.store.GetCampaignArtifact(ctx, campaignID, record.Path)
 	if err == nil {
 		return nil
 	}
 	if err != storage.ErrNotFound {
 		return err
 	}
-	now := clock().UTC()
+	now := m.clock().UTC()
 	record.CreatedAt = now
 	record.UpdatedAt = now
-	return store.PutCampaignArtifact(ctx, record)
+	_, err = m.put(ctx, record)
+	return err
 }
 
 // resolveSkillsContent returns skills content from the instruction loader if
@@ -169,7 +169,8 @@ func TestManagerEnsureDefaultArtifactsUsesConfiguredSkillsLoader(t *testing.T) {
 
 func TestEnsureArtifactIfMissingPropagatesStoreErrors(t *testing.T) {
 	store := &artifactStoreStub{getErr: context.DeadlineExceeded}
-	err := ensureArtifactUpToDate(context.Background(), store, time.Now, "campaign-1", campaignartifact.Artifact{Path: StoryArtifactPath})
+	manager := NewManager(ManagerConfig{Store: store})
+	err := manager.ensureArtifactUpToDate(context.Background(), "campaign-1", campaignartifact.Artifact{Path: StoryArtifactPath})
 	if err != context.DeadlineExceeded {
 		t.Fatalf("ensureArtifactUpToDate() error = %v, want %v", err, context.DeadlineExceeded)
 	}
@@ -221,8 +222,16 @@ func (s stubSkillsLoader) LoadSkills(string) (string, error) {
 	return s.content, s.err
 }
 
-func (s *artifactStoreStub) PutCampaignArtifact(context.Context, campaignartifact.Artifact) error {
-	return nil
+func (s *artifactStoreStub) PutCampaignArtifactRevision(context.Context, campaignartifact.Artifact, campaignartifact.Author) (campaignartifact.Revision, error) {
+	return campaignartifact.Revision{}, nil
+}
+
+func (s *artifactStoreStub) ListCampaignArtifactRevisions(context.Context, string, string) ([]campaignartifact.Revision, error) {
+	return nil, nil
+}
+
+func (s *artifactStoreStub) GetCampaignArtifactRevision(context.Context, string, string, int) (campaignartifact.Revision, error) {
+	return campaignartifact.Revision{}, s.getErr
 }
 
 func (s *artifactStoreStub) GetCampaignArtifact(context.Context, string, string) (campaignartifact.Artifact, error) {
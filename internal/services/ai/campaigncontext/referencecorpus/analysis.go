@@ -0,0 +1,186 @@
+package referencecorpus
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	localeEnglish    = "en-US"
+	localePortuguese = "pt-BR"
+)
+
+// token is one analyzed word from a document or query. Pos counts every word,
+// including stop words, so phrase matching keeps the original spacing.
+type token struct {
+	term  string
+	pos   int
+	start int
+	end   int
+}
+
+var stopWords = map[string]map[string]struct{}{
+	localeEnglish:    wordSet("a", "an", "and", "are", "as", "at", "be", "by", "for", "from", "in", "is", "it", "its", "of", "on", "or", "that", "the", "this", "to", "with"),
+	localePortuguese: wordSet("a", "ao", "aos", "as", "com", "da", "das", "de", "do", "dos", "e", "em", "na", "nas", "no", "nos", "o", "os", "ou", "para", "pela", "pelo", "por", "que", "se", "um", "uma"),
+}
+
+func wordSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+	return set
+}
+
+// normalizeLocale maps index locales onto the analyzers this package ships.
+func normalizeLocale(locale string) string {
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(locale)), "pt") {
+		return localePortuguese
+	}
+	return localeEnglish
+}
+
+// analyze tokenizes text and stems every non-stop word for one locale.
+func analyze(text, locale string) []token {
+	stops := stopWords[locale]
+	words := splitWords(text)
+	tokens := words[:0]
+	for _, word := range words {
+		if _, ok := stops[word.term]; ok {
+			continue
+		}
+		word.term = stem(word.term, locale)
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// splitWords breaks text into lower-cased, accent-folded words with byte
+// offsets into the original text.
+func splitWords(text string) []token {
+	var words []token
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		words = append(words, token{term: foldWord(text[start:end]), pos: len(words), start: start, end: end})
+		start = -1
+	}
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+	}
+	flush(len(text))
+	return words
+}
+
+// foldWord lower-cases a word and strips diacritics so "ação" and "acao"
+// index the same way.
+func foldWord(word string) string {
+	word = strings.ToLower(word)
+	for i := 0; i < len(word); i++ {
+		if word[i] >= utf8.RuneSelf {
+			folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), word)
+			if err != nil {
+				return word
+			}
+			return folded
+		}
+	}
+	return word
+}
+
+// stem applies the light suffix stripper for one locale. The stemmers trade
+// linguistic precision for predictable, dependency-free behavior: they only
+// need to conflate the inflections players actually type.
+func stem(word, locale string) string {
+	if locale == localePortuguese {
+		return stemPortuguese(word)
+	}
+	return stemEnglish(word)
+}
+
+func stemEnglish(word string) string {
+	if len(word) <= 3 || !isASCIIWord(word) {
+		return word
+	}
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		word = word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "sses"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		word = word[:len(word)-1]
+	}
+	for _, suffix := range []string{"ingly", "edly", "ing", "ed", "ly"} {
+		if strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= 3 {
+			word = undoubleConsonant(word[:len(word)-len(suffix)])
+			break
+		}
+	}
+	if strings.HasSuffix(word, "e") && len(word) > 3 {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+func stemPortuguese(word string) string {
+	if len(word) <= 3 {
+		return word
+	}
+	word = stripPortuguesePlural(word)
+	for _, suffix := range []string{"mente", "ando", "endo", "indo", "ar", "er", "ir"} {
+		if strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= 3 {
+			word = word[:len(word)-len(suffix)]
+			break
+		}
+	}
+	if last := word[len(word)-1]; (last == 'a' || last == 'e' || last == 'o') && len(word) > 3 {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+func stripPortuguesePlural(word string) string {
+	for _, rule := range [][2]string{{"oes", "ao"}, {"aes", "ao"}, {"ais", "al"}, {"eis", "el"}, {"ois", "ol"}, {"ns", "m"}, {"res", "r"}} {
+		if strings.HasSuffix(word, rule[0]) && len(word) > len(rule[0])+1 {
+			return word[:len(word)-len(rule[0])] + rule[1]
+		}
+	}
+	if strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+func undoubleConsonant(word string) string {
+	n := len(word)
+	if n < 2 || word[n-1] != word[n-2] {
+		return word
+	}
+	switch word[n-1] {
+	case 'a', 'e', 'i', 'o', 'u', 'l', 's', 'z':
+		return word
+	}
+	return word[:n-1]
+}
+
+func isASCIIWord(word string) bool {
+	for i := 0; i < len(word); i++ {
+		if word[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
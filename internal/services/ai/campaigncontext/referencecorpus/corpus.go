@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 	"sync"
 )
@@ -44,7 +43,9 @@ type indexEntry struct {
 	Kind    string   `json:"kind"`
 	Path    string   `json:"path"`
 	Aliases []string `json:"aliases"`
-	absPath string   `json:"-"`
+	// Locale selects the stemmer for this document; blank means en-US.
+	Locale  string `json:"locale,omitempty"`
+	absPath string `json:"-"`
 }
 
 // Corpus serves a read-only filesystem-backed system corpus.
@@ -53,6 +54,10 @@ type Corpus struct {
 	loadErr error
 	once    sync.Once
 	entries []indexEntry
+
+	indexOnce sync.Once
+	indexErr  error
+	index     *searchIndex
 }
 
 // New builds a read-only reference corpus rooted at one local directory.
@@ -80,69 +85,19 @@ func (c *Corpus) Search(ctx context.Context, system, query string, maxResults in
 		maxResults = maxSearchResults
 	}
 
-	queryLower := strings.ToLower(query)
-	queryTerms := strings.Fields(queryLower)
-	type scoredResult struct {
-		score  int
-		result SearchResult
-	}
-	scored := make([]scoredResult, 0, len(entries))
-	seen := make(map[string]struct{}, len(entries))
-	for _, entry := range entries {
-		if err := ctx.Err(); err != nil {
-			return nil, err
-		}
-		score := metadataScore(entry, queryLower, queryTerms)
-		if score == 0 {
-			continue
-		}
-		scored = append(scored, scoredResult{
-			score: score,
-			result: SearchResult{
-				System:     supportedSystem,
-				DocumentID: entry.ID,
-				Title:      entry.Title,
-				Kind:       entry.Kind,
-				Path:       entry.Path,
-				Aliases:    append([]string(nil), entry.Aliases...),
-				Snippet:    metadataSnippet(entry),
-			},
-		})
-		seen[entry.ID] = struct{}{}
-	}
-	sort.SliceStable(scored, func(i, j int) bool {
-		if scored[i].score != scored[j].score {
-			return scored[i].score > scored[j].score
-		}
-		return scored[i].result.Title < scored[j].result.Title
-	})
-
-	results := make([]SearchResult, 0, maxResults)
-	for _, item := range scored {
-		if len(results) == maxResults {
-			return results, nil
-		}
-		results = append(results, item.result)
+	index, err := c.searchIndex(entries)
+	if err != nil {
+		return nil, err
 	}
-	if len(results) == maxResults {
-		return results, nil
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	for _, entry := range entries {
-		if err := ctx.Err(); err != nil {
-			return nil, err
-		}
-		if _, ok := seen[entry.ID]; ok {
-			continue
-		}
-		content, err := c.readEntryContent(entry)
-		if err != nil {
-			return nil, err
-		}
-		contentLower := strings.ToLower(content)
-		if !strings.Contains(contentLower, queryLower) && !containsAllTerms(contentLower, queryTerms) {
-			continue
-		}
+	parsed := parseQuery(query)
+	ranked := index.search(parsed)
+	results := make([]SearchResult, 0, min(maxResults, len(ranked)))
+	for _, hit := range ranked[:min(maxResults, len(ranked))] {
+		entry := index.docs[hit.doc].entry
 		results = append(results, SearchResult{
 			System:     supportedSystem,
 			DocumentID: entry.ID,
@@ -150,11 +105,8 @@ func (c *Corpus) Search(ctx context.Context, system, query string, maxResults in
 			Kind:       entry.Kind,
 			Path:       entry.Path,
 			Aliases:    append([]string(nil), entry.Aliases...),
-			Snippet:    contentSnippet(content, queryLower),
+			Snippet:    index.snippet(hit.doc, parsed),
 		})
-		if len(results) == maxResults {
-			break
-		}
 	}
 	return results, nil
 }
@@ -228,6 +180,25 @@ func (c *Corpus) indexEntries() ([]indexEntry, error) {
 	return append([]indexEntry(nil), c.entries...), nil
 }
 
+// searchIndex builds the full-text index over every document once. Search
+// fails while any indexed document is unreadable so missing rules are not
+// silently dropped from results.
+func (c *Corpus) searchIndex(entries []indexEntry) (*searchIndex, error) {
+	c.indexOnce.Do(func() {
+		contents := make([]string, len(entries))
+		for i, entry := range entries {
+			content, err := c.readEntryContent(entry)
+			if err != nil {
+				c.indexErr = err
+				return
+			}
+			contents[i] = content
+		}
+		c.index = buildSearchIndex(entries, contents)
+	})
+	return c.index, c.indexErr
+}
+
 func (c *Corpus) readEntryContent(entry indexEntry) (string, error) {
 	if entry.absPath != "" {
 		data, err := os.ReadFile(entry.absPath)
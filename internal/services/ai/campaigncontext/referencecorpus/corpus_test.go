@@ -25,8 +25,8 @@ func TestCorpusSearchAndRead(t *testing.T) {
 	if len(results) == 0 || results[0].DocumentID != "moves" {
 		t.Fatalf("Search() results = %+v, want moves first", results)
 	}
-	if !strings.Contains(results[0].Snippet, "aliases: gm moves") {
-		t.Fatalf("metadata snippet = %q, want alias snippet", results[0].Snippet)
+	if !strings.Contains(results[0].Snippet, "Escalate fear when the table stalls.") {
+		t.Fatalf("snippet = %q, want matching body passage", results[0].Snippet)
 	}
 
 	results, err = corpus.Search(context.Background(), supportedSystem, "action tracker", 1)
@@ -109,12 +109,12 @@ func TestCorpusIncludesRepoPlaybooks(t *testing.T) {
 	}
 }
 
-func writeFixture(t *testing.T, root, contents string) {
+func writeFixture(t testing.TB, root, contents string) {
 	t.Helper()
 	writeFile(t, root, "index.json", contents)
 }
 
-func writeFile(t *testing.T, root, path, contents string) {
+func writeFile(t testing.TB, root, path, contents string) {
 	t.Helper()
 	fullPath := filepath.Join(root, path)
 	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
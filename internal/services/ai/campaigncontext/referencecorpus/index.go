@@ -0,0 +1,366 @@
+package referencecorpus
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// bm25K1 and bm25B are the standard Okapi BM25 saturation and length
+	// normalization parameters.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+	// metadataFieldWeight counts a title, alias, id, or kind occurrence as
+	// this many body occurrences (a BM25F-style field boost).
+	metadataFieldWeight = 3
+	// fieldPositionGap separates metadata values and the body so a phrase
+	// never matches across a field boundary.
+	fieldPositionGap = 1 << 16
+
+	snippetWindowTokens = 28
+	snippetMaxBytes     = 280
+)
+
+// searchIndex is an in-memory inverted index over metadata and document
+// bodies, built once per corpus load.
+type searchIndex struct {
+	docs      []indexedDocument
+	postings  map[string][]posting
+	avgLength float64
+}
+
+type indexedDocument struct {
+	entry   indexEntry
+	content string
+	// body holds analyzed body tokens for passage selection.
+	body   []token
+	length float64
+}
+
+// posting records one term's weighted frequency and positions in one
+// document.
+type posting struct {
+	doc       int
+	freq      float64
+	positions []int
+}
+
+// queryTerm is one analyzed query word. Variants hold the stems produced by
+// every supported locale so one query matches documents in any language.
+type queryTerm struct {
+	variants []string
+	offset   int
+	// stopWord marks words that some locale drops at index time; phrases
+	// skip them because they have no positions in those documents.
+	stopWord bool
+}
+
+type parsedQuery struct {
+	terms   []queryTerm
+	phrases [][]queryTerm
+}
+
+type scoredDocument struct {
+	doc   int
+	score float64
+}
+
+func buildSearchIndex(entries []indexEntry, contents []string) *searchIndex {
+	index := &searchIndex{
+		docs:     make([]indexedDocument, len(entries)),
+		postings: make(map[string][]posting),
+	}
+	var totalLength float64
+	for docID, entry := range entries {
+		locale := normalizeLocale(entry.Locale)
+		metadata := analyzeMetadata(entry, locale)
+		body := analyze(contents[docID], locale)
+		document := indexedDocument{
+			entry:   entry,
+			content: contents[docID],
+			body:    body,
+			length:  float64(metadataFieldWeight*len(metadata) + len(body)),
+		}
+		index.docs[docID] = document
+		totalLength += document.length
+
+		termPostings := make(map[string]*posting)
+		add := func(tok token, weight float64, offset int) {
+			p := termPostings[tok.term]
+			if p == nil {
+				p = &posting{doc: docID}
+				termPostings[tok.term] = p
+			}
+			p.freq += weight
+			p.positions = append(p.positions, tok.pos+offset)
+		}
+		for _, tok := range metadata {
+			add(tok, metadataFieldWeight, 0)
+		}
+		bodyOffset := (len(entry.Aliases) + 4) * fieldPositionGap
+		for _, tok := range body {
+			add(tok, 1, bodyOffset)
+		}
+		for term, p := range termPostings {
+			index.postings[term] = append(index.postings[term], *p)
+		}
+	}
+	index.avgLength = 1
+	if len(entries) > 0 && totalLength > 0 {
+		index.avgLength = totalLength / float64(len(entries))
+	}
+	return index
+}
+
+// analyzeMetadata tokenizes the searchable metadata fields, spacing each
+// value apart so phrases stay within one field. Document ids are usually
+// slugs, so their separators split into words like any other text.
+func analyzeMetadata(entry indexEntry, locale string) []token {
+	values := append([]string{entry.Title, entry.ID, entry.Kind}, entry.Aliases...)
+	var tokens []token
+	for i, value := range values {
+		for _, tok := range analyze(value, locale) {
+			tok.pos += i * fieldPositionGap
+			tokens = append(tokens, tok)
+		}
+	}
+	return tokens
+}
+
+// parseQuery splits a query into ranked terms and double-quoted phrases. Terms
+// inside a phrase still contribute to the ranking score.
+func parseQuery(query string) parsedQuery {
+	var parsed parsedQuery
+	segments := strings.Split(query, `"`)
+	for i, segment := range segments {
+		terms := analyzeQuery(segment)
+		parsed.terms = append(parsed.terms, terms...)
+		// Odd segments sit between quotes; an unterminated quote is treated
+		// as plain text.
+		if i%2 == 1 && i < len(segments)-1 {
+			if phrase := phraseTerms(terms); len(phrase) > 1 {
+				parsed.phrases = append(parsed.phrases, phrase)
+			}
+		}
+	}
+	return parsed
+}
+
+func phraseTerms(terms []queryTerm) []queryTerm {
+	phrase := make([]queryTerm, 0, len(terms))
+	for _, term := range terms {
+		if !term.stopWord {
+			phrase = append(phrase, term)
+		}
+	}
+	return phrase
+}
+
+func analyzeQuery(text string) []queryTerm {
+	words := splitWords(text)
+	terms := make([]queryTerm, 0, len(words))
+	for _, word := range words {
+		var variants []string
+		stopWord := false
+		for _, locale := range []string{localeEnglish, localePortuguese} {
+			if _, ok := stopWords[locale][word.term]; ok {
+				stopWord = true
+				continue
+			}
+			variant := stem(word.term, locale)
+			if !containsString(variants, variant) {
+				variants = append(variants, variant)
+			}
+		}
+		if len(variants) == 0 {
+			continue
+		}
+		terms = append(terms, queryTerm{variants: variants, offset: word.pos, stopWord: stopWord})
+	}
+	return terms
+}
+
+// search ranks documents with BM25 over the weighted metadata and body fields.
+// A query term's score is the best score among its locale variants.
+func (idx *searchIndex) search(query parsedQuery) []scoredDocument {
+	if idx == nil || len(idx.docs) == 0 || len(query.terms) == 0 {
+		return nil
+	}
+	scores := make(map[int]float64)
+	for _, term := range query.terms {
+		best := make(map[int]float64)
+		for _, variant := range term.variants {
+			postings := idx.postings[variant]
+			if len(postings) == 0 {
+				continue
+			}
+			idf := idx.idf(len(postings))
+			for _, p := range postings {
+				norm := bm25K1 * (1 - bm25B + bm25B*idx.docs[p.doc].length/idx.avgLength)
+				score := idf * p.freq * (bm25K1 + 1) / (p.freq + norm)
+				if score > best[p.doc] {
+					best[p.doc] = score
+				}
+			}
+		}
+		for doc, score := range best {
+			scores[doc] += score
+		}
+	}
+
+	results := make([]scoredDocument, 0, len(scores))
+	for doc, score := range scores {
+		if !idx.matchesPhrases(doc, query.phrases) {
+			continue
+		}
+		results = append(results, scoredDocument{doc: doc, score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].score != results[j].score {
+			return results[i].score > results[j].score
+		}
+		return idx.docs[results[i].doc].entry.Title < idx.docs[results[j].doc].entry.Title
+	})
+	return results
+}
+
+func (idx *searchIndex) idf(documentFrequency int) float64 {
+	n := float64(len(idx.docs))
+	df := float64(documentFrequency)
+	return math.Log(1 + (n-df+0.5)/(df+0.5))
+}
+
+// matchesPhrases reports whether every phrase occurs in one document with its
+// words at their original relative offsets.
+func (idx *searchIndex) matchesPhrases(doc int, phrases [][]queryTerm) bool {
+	for _, phrase := range phrases {
+		if !idx.matchesPhrase(doc, phrase) {
+			return false
+		}
+	}
+	return true
+}
+
+func (idx *searchIndex) matchesPhrase(doc int, phrase []queryTerm) bool {
+	positionSets := make([]map[int]struct{}, len(phrase))
+	for i, term := range phrase {
+		positionSets[i] = idx.termPositions(doc, term)
+		if len(positionSets[i]) == 0 {
+			return false
+		}
+	}
+	base := phrase[0].offset
+	for start := range positionSets[0] {
+		matched := true
+		for i := 1; i < len(phrase); i++ {
+			if _, ok := positionSets[i][start+phrase[i].offset-base]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (idx *searchIndex) termPositions(doc int, term queryTerm) map[int]struct{} {
+	positions := make(map[int]struct{})
+	for _, variant := range term.variants {
+		postings := idx.postings[variant]
+		i := sort.Search(len(postings), func(i int) bool { return postings[i].doc >= doc })
+		if i < len(postings) && postings[i].doc == doc {
+			for _, position := range postings[i].positions {
+				positions[position] = struct{}{}
+			}
+		}
+	}
+	return positions
+}
+
+// snippet returns the body passage with the most matched query weight,
+// falling back to metadata when the body never mentions the query.
+func (idx *searchIndex) snippet(doc int, query parsedQuery) string {
+	document := idx.docs[doc]
+	weights := make(map[string]float64)
+	for _, term := range query.terms {
+		for _, variant := range term.variants {
+			if postings := idx.postings[variant]; len(postings) > 0 {
+				weights[variant] = max(weights[variant], idx.idf(len(postings)))
+			}
+		}
+	}
+
+	bestStart, bestEnd, bestScore := 0, 0, 0.0
+	for start := range document.body {
+		if _, ok := weights[document.body[start].term]; !ok {
+			continue
+		}
+		end := start
+		seen := make(map[string]struct{})
+		score := 0.0
+		for i := start; i < len(document.body) && document.body[i].pos-document.body[start].pos < snippetWindowTokens; i++ {
+			term := document.body[i].term
+			if weight, ok := weights[term]; ok {
+				if _, dup := seen[term]; !dup {
+					seen[term] = struct{}{}
+					score += weight
+				}
+				end = i
+			}
+		}
+		if score > bestScore {
+			bestStart, bestEnd, bestScore = start, end, score
+		}
+	}
+	if bestScore == 0 {
+		return metadataSnippet(document.entry)
+	}
+	return passageText(document.content, document.body, bestStart, bestEnd)
+}
+
+// passageText centers the matched token span in a snippet of at most
+// snippetMaxBytes bytes, cut on word boundaries with whitespace collapsed.
+func passageText(content string, body []token, first, last int) string {
+	start, end := body[first].start, body[last].end
+	lo, hi := first-1, last+1
+	for grew := true; grew; {
+		grew = false
+		if lo >= 0 && end-body[lo].start <= snippetMaxBytes {
+			start = body[lo].start
+			lo--
+			grew = true
+		}
+		if hi < len(body) && body[hi].end-start <= snippetMaxBytes {
+			end = body[hi].end
+			hi++
+			grew = true
+		}
+	}
+	// Keep punctuation attached to the last word, such as a closing period.
+	if next := strings.IndexFunc(content[end:], unicode.IsSpace); next >= 0 {
+		end += next
+	} else {
+		end = len(content)
+	}
+	snippet := strings.Join(strings.Fields(content[start:end]), " ")
+	if lo >= 0 {
+		snippet = "..." + snippet
+	}
+	if hi < len(body) {
+		snippet += "..."
+	}
+	return snippet
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package referencecorpus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+)
+
+// benchmarkCorpusDocuments approximates the Daggerheart SRD: classes,
+// subclasses, ancestries, communities, domain cards, equipment, adversaries,
+// environments, and rules chapters.
+const (
+	benchmarkCorpusDocuments = 900
+	benchmarkDocumentWords   = 320
+)
+
+var benchmarkQueries = []string{
+	"spotlight",
+	"spend hope to help an ally",
+	"armor slots damage threshold",
+	`"long rest"`,
+	"fear countdown consequence",
+	"rolar ação com esperança",
+}
+
+// BenchmarkCorpusSearch measures ranked search over a full-size corpus. Set
+// FRACTURING_SPACE_AI_DAGGERHEART_REFERENCE_ROOT to benchmark the real
+// Daggerheart corpus instead of the synthetic one.
+//
+//	go test ./internal/services/ai/campaigncontext/referencecorpus -run '^$' -bench CorpusSearch
+func BenchmarkCorpusSearch(b *testing.B) {
+	root := strings.TrimSpace(os.Getenv("FRACTURING_SPACE_AI_DAGGERHEART_REFERENCE_ROOT"))
+	if root == "" {
+		root = writeBenchmarkCorpus(b)
+	}
+	corpus := New(root)
+	ctx := context.Background()
+	if _, err := corpus.Search(ctx, supportedSystem, "warm up", 1); err != nil {
+		b.Fatalf("Search(warm up) error = %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := corpus.Search(ctx, supportedSystem, benchmarkQueries[i%len(benchmarkQueries)], maxSearchResults); err != nil {
+			b.Fatalf("Search() error = %v", err)
+		}
+	}
+}
+
+func writeBenchmarkCorpus(b *testing.B) string {
+	b.Helper()
+	vocabulary := strings.Fields(`action adversary ally armor attack bonus card character class countdown
+		damage domain downtime duality environment evasion experience fear feature gm hope
+		hit level long rest major minor move proficiency range reaction roll severe short
+		slot spend spotlight stress subclass target threshold trait weapon harmony tag team
+		ação esperança medo rolar personagem dano armadura descanso`)
+	rng := rand.New(rand.NewSource(31))
+	entries := make([]indexEntry, 0, benchmarkCorpusDocuments)
+	root := b.TempDir()
+	for i := 0; i < benchmarkCorpusDocuments; i++ {
+		words := make([]string, benchmarkDocumentWords)
+		for j := range words {
+			words[j] = vocabulary[rng.Intn(len(vocabulary))]
+		}
+		path := fmt.Sprintf("docs/doc-%03d.md", i)
+		entries = append(entries, indexEntry{
+			ID:      fmt.Sprintf("doc-%03d", i),
+			Title:   strings.Join(words[:3], " "),
+			Kind:    "rule",
+			Path:    path,
+			Aliases: []string{words[3] + " " + words[4]},
+		})
+		writeFile(b, root, path, strings.Join(words, " ")+".")
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		b.Fatalf("marshal index: %v", err)
+	}
+	writeFixture(b, root, string(data))
+	return root
+}
@@ -0,0 +1,125 @@
+package referencecorpus
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCorpusSearchRanksBodyOnlyMatchesWithStemming(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, `[
+  {"id":"downtime","title":"Downtime","kind":"rule","path":"downtime.md"},
+  {"id":"armor","title":"Armor","kind":"rule","path":"armor.md"},
+  {"id":"repouso","title":"Descanso","kind":"rule","path":"repouso.md","locale":"pt-BR"}
+]`)
+	writeFile(t, root, "downtime.md", "# Downtime\nDuring a long rest each character may repair damaged armor slots.")
+	writeFile(t, root, "armor.md", "# Armor\nArmor has a score and slots. Mark a slot to reduce damage by one threshold. Marked slots stay marked.")
+	writeFile(t, root, "repouso.md", "# Descanso\nDurante um descanso longo, os personagens recuperam as ações e marcações de armadura.")
+	corpus := New(root)
+
+	results, err := corpus.Search(context.Background(), supportedSystem, "repairing armor", 3)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) == 0 || results[0].DocumentID != "downtime" {
+		t.Fatalf("Search(repairing armor) = %+v, want downtime first via body stem", results)
+	}
+
+	results, err = corpus.Search(context.Background(), supportedSystem, "marking slots", 3)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) == 0 || results[0].DocumentID != "armor" {
+		t.Fatalf("Search(marking slots) = %+v, want armor first", results)
+	}
+
+	results, err = corpus.Search(context.Background(), supportedSystem, "recuperar acao", 3)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 1 || results[0].DocumentID != "repouso" {
+		t.Fatalf("Search(recuperar acao) = %+v, want pt-BR document", results)
+	}
+}
+
+func TestCorpusSearchPhraseQueries(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, `[
+  {"id":"hope","title":"Hope","kind":"rule","path":"hope.md"},
+  {"id":"fear","title":"Fear","kind":"rule","path":"fear.md"}
+]`)
+	writeFile(t, root, "hope.md", "Players spend a Hope to help an ally.")
+	writeFile(t, root, "fear.md", "The GM may spend Fear; players keep their Hope.")
+	corpus := New(root)
+
+	results, err := corpus.Search(context.Background(), supportedSystem, `"spend a hope"`, 5)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 1 || results[0].DocumentID != "hope" {
+		t.Fatalf("Search(phrase) = %+v, want only hope", results)
+	}
+
+	results, err = corpus.Search(context.Background(), supportedSystem, "spend hope", 5)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if resultIndex(results, "hope") < 0 || resultIndex(results, "fear") < 0 {
+		t.Fatalf("Search(terms) = %+v, want both documents", results)
+	}
+}
+
+func TestCorpusSearchSnippetCentersBestPassage(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, `[{"id":"long","title":"Long Rules","kind":"rule","path":"long.md"}]`)
+	filler := strings.Repeat("Adventurers travel between towns and talk with strangers. ", 40)
+	writeFile(t, root, "long.md", filler+"When a countdown reaches zero the triggered consequence happens immediately. "+filler)
+	corpus := New(root)
+
+	results, err := corpus.Search(context.Background(), supportedSystem, "countdown consequence", maxSearchResults)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	i := resultIndex(results, "long")
+	if i < 0 {
+		t.Fatalf("Search() = %+v, want long document", results)
+	}
+	snippet := results[i].Snippet
+	if !strings.Contains(snippet, "countdown reaches zero the triggered consequence") {
+		t.Fatalf("snippet = %q, want countdown passage", snippet)
+	}
+	if !strings.HasPrefix(snippet, "...") || !strings.HasSuffix(snippet, "...") || len(snippet) > snippetMaxBytes+64 {
+		t.Fatalf("snippet = %q, want bounded passage with ellipses", snippet)
+	}
+}
+
+func TestStemmersConflateCommonInflections(t *testing.T) {
+	for _, tc := range []struct {
+		locale string
+		words  []string
+	}{
+		{localeEnglish, []string{"roll", "rolls", "rolled", "rolling"}},
+		{localeEnglish, []string{"move", "moves", "moved", "moving"}},
+		{localeEnglish, []string{"ability", "abilities"}},
+		{localePortuguese, []string{"ação", "ações", "acao"}},
+		{localePortuguese, []string{"rolar", "rolando", "rola"}},
+		{localePortuguese, []string{"personagem", "personagens"}},
+	} {
+		want := stem(foldWord(tc.words[0]), tc.locale)
+		for _, word := range tc.words[1:] {
+			if got := stem(foldWord(word), tc.locale); got != want {
+				t.Fatalf("stem(%q, %s) = %q, want %q", word, tc.locale, got, want)
+			}
+		}
+	}
+}
+
+func resultIndex(results []SearchResult, documentID string) int {
+	for i, result := range results {
+		if result.DocumentID == documentID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,305 @@
+package cassette
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+)
+
+// FormatVersion identifies the on-disk cassette schema written by Recorder.
+const FormatVersion = 1
+
+// Mode controls whether a process records or replays provider exchanges.
+type Mode string
+
+const (
+	// ModeOff leaves the live provider untouched.
+	ModeOff Mode = ""
+	// ModeRecord forwards every exchange to the live provider and persists it.
+	ModeRecord Mode = "record"
+	// ModeReplay serves recorded exchanges without calling any provider.
+	ModeReplay Mode = "replay"
+)
+
+// ParseMode validates one configured cassette mode.
+func ParseMode(raw string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(raw))); mode {
+	case ModeOff, "off":
+		return ModeOff, nil
+	case ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return ModeOff, fmt.Errorf("unsupported provider cassette mode %q", raw)
+	}
+}
+
+// Metadata records the provenance of one cassette without affecting replay.
+type Metadata struct {
+	Provider        string `json:"provider,omitempty"`
+	Model           string `json:"model,omitempty"`
+	ReasoningEffort string `json:"reasoning_effort,omitempty"`
+	Scenario        string `json:"scenario,omitempty"`
+	RecordedAtUTC   string `json:"recorded_at_utc,omitempty"`
+}
+
+// Cassette is the reviewable on-disk form of recorded provider turns.
+type Cassette struct {
+	Version  int      `json:"version"`
+	Metadata Metadata `json:"metadata"`
+	Turns    []Turn   `json:"turns"`
+}
+
+// Turn groups the provider exchanges of one campaign-turn tool loop.
+type Turn struct {
+	Exchanges []Exchange `json:"exchanges"`
+}
+
+// Exchange pairs one provider input with the output or error it produced.
+type Exchange struct {
+	Input  Input  `json:"input"`
+	Output Output `json:"output"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Input is the persisted subset of orchestration.ProviderInput. Auth tokens
+// are never written, and instructions are stored as a digest to keep
+// cassettes small while still detecting instruction drift.
+type Input struct {
+	Model              string       `json:"model,omitempty"`
+	ReasoningEffort    string       `json:"reasoning_effort,omitempty"`
+	InstructionsDigest string       `json:"instructions_digest,omitempty"`
+	Prompt             string       `json:"prompt,omitempty"`
+	FollowUpPrompt     string       `json:"follow_up_prompt,omitempty"`
+	ConversationID     string       `json:"conversation_id,omitempty"`
+	ToolNames          []string     `json:"tool_names,omitempty"`
+	Results            []ToolResult `json:"results,omitempty"`
+}
+
+// ToolResult is the persisted form of one orchestration.ProviderToolResult.
+type ToolResult struct {
+	CallID  string `json:"call_id"`
+	Output  string `json:"output"`
+	IsError bool   `json:"is_error,omitempty"`
+}
+
+// Output is the persisted form of one orchestration.ProviderOutput.
+type Output struct {
+	ConversationID string     `json:"conversation_id,omitempty"`
+	OutputText     string     `json:"output_text,omitempty"`
+	ToolCalls      []ToolCall `json:"tool_calls,omitempty"`
+	Usage          Usage      `json:"usage"`
+}
+
+// ToolCall is the persisted form of one orchestration.ProviderToolCall.
+type ToolCall struct {
+	CallID    string `json:"call_id"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// Usage is the persisted form of provider token accounting.
+type Usage struct {
+	InputTokens     int32 `json:"input_tokens,omitempty"`
+	OutputTokens    int32 `json:"output_tokens,omitempty"`
+	ReasoningTokens int32 `json:"reasoning_tokens,omitempty"`
+	TotalTokens     int32 `json:"total_tokens,omitempty"`
+}
+
+// Load reads one cassette from disk and rejects unknown schema versions.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("parse cassette %s: %w", path, err)
+	}
+	if cassette.Version != FormatVersion {
+		return nil, fmt.Errorf("cassette %s has version %d, want %d", path, cassette.Version, FormatVersion)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette atomically so an interrupted recording never
+// leaves a truncated file behind.
+func Save(path string, cassette *Cassette) error {
+	if cassette == nil {
+		return fmt.Errorf("cassette is required")
+	}
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal cassette: %w", err)
+	}
+	data = append(data, '\n')
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create cassette dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".cassette-*.json")
+	if err != nil {
+		return fmt.Errorf("create cassette temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("close cassette: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("publish cassette: %w", err)
+	}
+	return nil
+}
+
+// ToolNames returns every tool name requested across the cassette in call
+// order, which eval harnesses use for coverage assertions.
+func (c *Cassette) ToolNames() []string {
+	if c == nil {
+		return nil
+	}
+	var names []string
+	for _, turn := range c.Turns {
+		for _, exchange := range turn.Exchanges {
+			for _, call := range exchange.Output.ToolCalls {
+				names = append(names, call.Name)
+			}
+		}
+	}
+	return names
+}
+
+// Models returns the distinct models requested across the cassette in first
+// use order, which replay serves in place of provider model discovery.
+func (c *Cassette) Models() []string {
+	if c == nil {
+		return nil
+	}
+	var models []string
+	for _, turn := range c.Turns {
+		for _, exchange := range turn.Exchanges {
+			model := strings.TrimSpace(exchange.Input.Model)
+			if model != "" && !slices.Contains(models, model) {
+				models = append(models, model)
+			}
+		}
+	}
+	return models
+}
+
+// ExchangeCount reports the number of recorded exchanges across all turns.
+func (c *Cassette) ExchangeCount() int {
+	if c == nil {
+		return 0
+	}
+	count := 0
+	for _, turn := range c.Turns {
+		count += len(turn.Exchanges)
+	}
+	return count
+}
+
+// startsTurn reports whether one provider input opens a new tool loop rather
+// than continuing an existing conversation.
+func startsTurn(input orchestration.ProviderInput) bool {
+	return strings.TrimSpace(input.ConversationID) == "" &&
+		strings.TrimSpace(input.FollowUpPrompt) == "" &&
+		len(input.Results) == 0
+}
+
+func captureInput(input orchestration.ProviderInput) Input {
+	captured := Input{
+		Model:              strings.TrimSpace(input.Model),
+		ReasoningEffort:    strings.TrimSpace(input.ReasoningEffort),
+		InstructionsDigest: digest(input.Instructions),
+		Prompt:             input.Prompt,
+		FollowUpPrompt:     input.FollowUpPrompt,
+		ConversationID:     input.ConversationID,
+	}
+	if len(input.Tools) > 0 {
+		captured.ToolNames = make([]string, 0, len(input.Tools))
+		for _, tool := range input.Tools {
+			captured.ToolNames = append(captured.ToolNames, tool.Name)
+		}
+	}
+	if len(input.Results) > 0 {
+		captured.Results = make([]ToolResult, 0, len(input.Results))
+		for _, result := range input.Results {
+			captured.Results = append(captured.Results, ToolResult{
+				CallID:  result.CallID,
+				Output:  result.Output,
+				IsError: result.IsError,
+			})
+		}
+	}
+	return captured
+}
+
+func captureOutput(output orchestration.ProviderOutput) Output {
+	captured := Output{
+		ConversationID: output.ConversationID,
+		OutputText:     output.OutputText,
+		Usage: Usage{
+			InputTokens:     output.Usage.InputTokens,
+			OutputTokens:    output.Usage.OutputTokens,
+			ReasoningTokens: output.Usage.ReasoningTokens,
+			TotalTokens:     output.Usage.TotalTokens,
+		},
+	}
+	if len(output.ToolCalls) > 0 {
+		captured.ToolCalls = make([]ToolCall, 0, len(output.ToolCalls))
+		for _, call := range output.ToolCalls {
+			captured.ToolCalls = append(captured.ToolCalls, ToolCall{
+				CallID:    call.CallID,
+				Name:      call.Name,
+				Arguments: call.Arguments,
+			})
+		}
+	}
+	return captured
+}
+
+func (o Output) providerOutput(rewrite func(string) string) orchestration.ProviderOutput {
+	output := orchestration.ProviderOutput{
+		ConversationID: o.ConversationID,
+		OutputText:     rewrite(o.OutputText),
+		Usage: provider.Usage{
+			InputTokens:     o.Usage.InputTokens,
+			OutputTokens:    o.Usage.OutputTokens,
+			ReasoningTokens: o.Usage.ReasoningTokens,
+			TotalTokens:     o.Usage.TotalTokens,
+		},
+	}
+	if len(o.ToolCalls) > 0 {
+		output.ToolCalls = make([]orchestration.ProviderToolCall, 0, len(o.ToolCalls))
+		for _, call := range o.ToolCalls {
+			output.ToolCalls = append(output.ToolCalls, orchestration.ProviderToolCall{
+				CallID:    call.CallID,
+				Name:      call.Name,
+				Arguments: rewrite(call.Arguments),
+			})
+		}
+	}
+	return output
+}
+
+func digest(value string) string {
+	if value == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
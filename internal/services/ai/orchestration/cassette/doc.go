@@ -0,0 +1,11 @@
+// Package cassette records and replays orchestration provider exchanges.
+//
+// A cassette captures every ProviderInput/ProviderOutput pair of one or more
+// campaign turns at the orchestration.Provider seam, so evaluation lanes can
+// re-run the real tool loop and game RPCs without a network-backed model.
+//
+// Recording wraps a live provider and flushes the cassette to disk after every
+// exchange. Replay serves recorded outputs in order, rewrites run-specific
+// identifiers learned from the live inputs into the recorded tool calls, and
+// reports structural drift as a MismatchError with per-field diagnostics.
+package cassette
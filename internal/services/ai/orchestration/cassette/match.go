@@ -0,0 +1,275 @@
+package cassette
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// idPattern matches platform identifiers (26-char lowercase base32), which
+// are the run-specific values that differ between recording and replay.
+var idPattern = regexp.MustCompile(`\b[a-z2-7]{26}\b`)
+
+// contextNoisePattern matches run-specific text masked out of ID contexts.
+var contextNoisePattern = regexp.MustCompile(`\b[a-z2-7]{26}\b|[0-9]`)
+
+// idContextBefore and idContextAfter bound the text compared around one ID.
+// The trailing window is wider because records usually name themselves after
+// their identifier.
+const (
+	idContextBefore = 48
+	idContextAfter  = 96
+)
+
+// diagnosticContext bounds how much surrounding text a content mismatch shows.
+const diagnosticContext = 48
+
+// Mismatch describes one input field that diverged from the recording.
+type Mismatch struct {
+	Field string
+	Want  string
+	Got   string
+}
+
+// MismatchError reports that replay diverged from the recorded exchange.
+type MismatchError struct {
+	Turn       int
+	Step       int
+	Mismatches []Mismatch
+}
+
+// Error renders every diverging field so a failing CI run is diagnosable
+// without re-running the lane locally.
+func (e *MismatchError) Error() string {
+	if e == nil {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "provider cassette mismatch at turn %d step %d", e.Turn+1, e.Step+1)
+	for _, mismatch := range e.Mismatches {
+		fmt.Fprintf(&b, "\n  %s: want %q, got %q", mismatch.Field, mismatch.Want, mismatch.Got)
+	}
+	return b.String()
+}
+
+// idMap tracks recorded identifiers rebound to their live equivalents.
+type idMap map[string]string
+
+// learn binds recorded identifiers to live ones. Each occurrence is matched by
+// its surrounding text first, so lists ordered by run-specific IDs (such as
+// scene indexes) still bind correctly; occurrences whose context is ambiguous
+// fall back to positional alignment, which is only trusted when both sides
+// expose the same number of identifiers.
+func (m idMap) learn(recorded, live string) {
+	recordedIDs := idOccurrences(recorded)
+	liveIDs := idOccurrences(live)
+	if len(recordedIDs) == 0 || len(liveIDs) == 0 {
+		return
+	}
+	liveByContext := make(map[string][]string)
+	for _, occurrence := range liveIDs {
+		ids := liveByContext[occurrence.context]
+		if !slices.Contains(ids, occurrence.id) {
+			liveByContext[occurrence.context] = append(ids, occurrence.id)
+		}
+	}
+	for _, occurrence := range recordedIDs {
+		if _, bound := m[occurrence.id]; bound {
+			continue
+		}
+		if ids := liveByContext[occurrence.context]; len(ids) == 1 {
+			m[occurrence.id] = ids[0]
+		}
+	}
+	if len(recordedIDs) != len(liveIDs) {
+		return
+	}
+	for i, occurrence := range recordedIDs {
+		if _, bound := m[occurrence.id]; bound || occurrence.context != liveIDs[i].context {
+			continue
+		}
+		m[occurrence.id] = liveIDs[i].id
+	}
+}
+
+// idOccurrence is one identifier plus the masked text around it.
+type idOccurrence struct {
+	id      string
+	context string
+}
+
+// idOccurrences lists identifiers in text order with their surrounding
+// context. Identifiers and digits are masked in place first so the context
+// ignores other run-specific values such as neighbouring IDs and timestamps,
+// and each window stops at the enclosing JSON object or list so neighbouring
+// records do not make the context depend on list order.
+func idOccurrences(text string) []idOccurrence {
+	locations := idPattern.FindAllStringIndex(text, -1)
+	if len(locations) == 0 {
+		return nil
+	}
+	masked := contextNoisePattern.ReplaceAllStringFunc(text, func(noise string) string {
+		if len(noise) == 1 {
+			return "0"
+		}
+		return strings.Repeat("*", len(noise))
+	})
+	occurrences := make([]idOccurrence, 0, len(locations))
+	for _, loc := range locations {
+		before := masked[max(0, loc[0]-idContextBefore):loc[0]]
+		if start := strings.LastIndexAny(before, "{["); start >= 0 {
+			before = before[start+1:]
+		}
+		after := masked[loc[1]:min(len(masked), loc[1]+idContextAfter)]
+		if end := strings.IndexAny(after, "}]"); end >= 0 {
+			after = after[:end]
+		}
+		occurrences = append(occurrences, idOccurrence{
+			id:      text[loc[0]:loc[1]],
+			context: before + "\x00" + after,
+		})
+	}
+	return occurrences
+}
+
+// rewrite replaces bound recorded identifiers with their live values.
+func (m idMap) rewrite(value string) string {
+	if len(m) == 0 || value == "" {
+		return value
+	}
+	return idPattern.ReplaceAllStringFunc(value, func(id string) string {
+		if live, ok := m[id]; ok {
+			return live
+		}
+		return id
+	})
+}
+
+// compareStructure reports divergences that change tool-loop control flow.
+// These always fail replay because the recorded outputs would no longer be
+// valid responses to the live input.
+func compareStructure(recorded Input, live Input) []Mismatch {
+	var mismatches []Mismatch
+	if recorded.Model != live.Model {
+		mismatches = append(mismatches, Mismatch{Field: "model", Want: recorded.Model, Got: live.Model})
+	}
+	if (recorded.ConversationID == "") != (live.ConversationID == "") {
+		mismatches = append(mismatches, Mismatch{
+			Field: "conversation_id",
+			Want:  presence(recorded.ConversationID),
+			Got:   presence(live.ConversationID),
+		})
+	}
+	if (recorded.FollowUpPrompt == "") != (live.FollowUpPrompt == "") {
+		mismatches = append(mismatches, Mismatch{
+			Field: "follow_up_prompt",
+			Want:  presence(recorded.FollowUpPrompt),
+			Got:   presence(live.FollowUpPrompt),
+		})
+	}
+	if missing, extra := setDiff(recorded.ToolNames, live.ToolNames); len(missing) > 0 || len(extra) > 0 {
+		mismatches = append(mismatches, Mismatch{
+			Field: "tool_names",
+			Want:  "missing " + strings.Join(missing, ","),
+			Got:   "extra " + strings.Join(extra, ","),
+		})
+	}
+	if len(recorded.Results) != len(live.Results) {
+		mismatches = append(mismatches, Mismatch{
+			Field: "results",
+			Want:  fmt.Sprintf("%d results", len(recorded.Results)),
+			Got:   fmt.Sprintf("%d results", len(live.Results)),
+		})
+		return mismatches
+	}
+	for i := range recorded.Results {
+		want, got := recorded.Results[i], live.Results[i]
+		if want.CallID != got.CallID {
+			mismatches = append(mismatches, Mismatch{Field: fmt.Sprintf("results[%d].call_id", i), Want: want.CallID, Got: got.CallID})
+		}
+		if want.IsError != got.IsError {
+			mismatches = append(mismatches, Mismatch{
+				Field: fmt.Sprintf("results[%d].is_error", i),
+				Want:  fmt.Sprint(want.IsError),
+				Got:   fmt.Sprint(got.IsError),
+			})
+		}
+	}
+	return mismatches
+}
+
+// compareContent reports text drift after rebinding recorded identifiers.
+// Content drift is fatal only in strict replay; otherwise it is surfaced as
+// diagnostics because game RPC outputs legitimately vary (timestamps, dice).
+func compareContent(recorded Input, live Input, ids idMap) []Mismatch {
+	var mismatches []Mismatch
+	appendText := func(field, want, got string) {
+		want = ids.rewrite(want)
+		if want == got {
+			return
+		}
+		wantExcerpt, gotExcerpt := excerptDifference(want, got)
+		mismatches = append(mismatches, Mismatch{Field: field, Want: wantExcerpt, Got: gotExcerpt})
+	}
+	if recorded.InstructionsDigest != live.InstructionsDigest {
+		mismatches = append(mismatches, Mismatch{
+			Field: "instructions_digest",
+			Want:  recorded.InstructionsDigest,
+			Got:   live.InstructionsDigest,
+		})
+	}
+	appendText("prompt", recorded.Prompt, live.Prompt)
+	appendText("follow_up_prompt", recorded.FollowUpPrompt, live.FollowUpPrompt)
+	for i := range min(len(recorded.Results), len(live.Results)) {
+		appendText(fmt.Sprintf("results[%d].output", i), recorded.Results[i].Output, live.Results[i].Output)
+	}
+	return mismatches
+}
+
+// excerptDifference trims two diverging strings to a window around their
+// first differing byte so diagnostics stay readable for large prompts.
+func excerptDifference(want, got string) (string, string) {
+	prefix := 0
+	for prefix < len(want) && prefix < len(got) && want[prefix] == got[prefix] {
+		prefix++
+	}
+	start := max(prefix-diagnosticContext, 0)
+	return excerpt(want, start), excerpt(got, start)
+}
+
+func excerpt(value string, start int) string {
+	if start >= len(value) {
+		return ""
+	}
+	end := min(start+2*diagnosticContext, len(value))
+	out := value[start:end]
+	if start > 0 {
+		out = "…" + out
+	}
+	if end < len(value) {
+		out += "…"
+	}
+	return out
+}
+
+func presence(value string) string {
+	if value == "" {
+		return "absent"
+	}
+	return "present"
+}
+
+func setDiff(want, got []string) (missing, extra []string) {
+	for _, name := range want {
+		if !slices.Contains(got, name) {
+			missing = append(missing, name)
+		}
+	}
+	for _, name := range got {
+		if !slices.Contains(want, name) {
+			extra = append(extra, name)
+		}
+	}
+	return missing, extra
+}
@@ -0,0 +1,177 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+)
+
+// ErrExhausted indicates replay requested more exchanges than were recorded.
+var ErrExhausted = errors.New("provider cassette exhausted")
+
+// PlayerConfig tunes replay strictness.
+type PlayerConfig struct {
+	// Strict fails replay on prompt, instruction, or tool-output drift in
+	// addition to structural divergence.
+	Strict bool
+}
+
+// Player implements orchestration.Provider by serving recorded outputs in
+// order without any network access.
+type Player struct {
+	cassette *Cassette
+	strict   bool
+
+	mu                 sync.Mutex
+	nextTurn           int
+	stepByTurn         map[int]int
+	turnByConversation map[string]int
+	lastTurn           int
+	ids                idMap
+	drift              []*MismatchError
+}
+
+// NewPlayer builds a replaying provider from one loaded cassette.
+func NewPlayer(cassette *Cassette, cfg PlayerConfig) (*Player, error) {
+	if cassette == nil {
+		return nil, fmt.Errorf("cassette is required")
+	}
+	return &Player{
+		cassette:           cassette,
+		strict:             cfg.Strict,
+		stepByTurn:         make(map[int]int),
+		turnByConversation: make(map[string]int),
+		lastTurn:           -1,
+		ids:                make(idMap),
+	}, nil
+}
+
+// LoadPlayer reads a cassette from disk and builds a replaying provider.
+func LoadPlayer(path string, cfg PlayerConfig) (*Player, error) {
+	cassette, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewPlayer(cassette, cfg)
+}
+
+// Run serves the next recorded output for the turn this input belongs to.
+func (p *Player) Run(ctx context.Context, input orchestration.ProviderInput) (orchestration.ProviderOutput, error) {
+	if err := ctx.Err(); err != nil {
+		return orchestration.ProviderOutput{}, err
+	}
+	live := captureInput(input)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	turn, err := p.turnFor(input)
+	if err != nil {
+		return orchestration.ProviderOutput{}, err
+	}
+	step := p.stepByTurn[turn]
+	exchanges := p.cassette.Turns[turn].Exchanges
+	if step >= len(exchanges) {
+		return orchestration.ProviderOutput{}, fmt.Errorf("%w: turn %d has %d recorded steps", ErrExhausted, turn+1, len(exchanges))
+	}
+	recorded := exchanges[step]
+
+	if mismatches := compareStructure(recorded.Input, live); len(mismatches) > 0 {
+		return orchestration.ProviderOutput{}, &MismatchError{Turn: turn, Step: step, Mismatches: mismatches}
+	}
+	p.learn(recorded.Input, live)
+	if drift := compareContent(recorded.Input, live, p.ids); len(drift) > 0 {
+		mismatch := &MismatchError{Turn: turn, Step: step, Mismatches: drift}
+		if p.strict {
+			return orchestration.ProviderOutput{}, mismatch
+		}
+		p.drift = append(p.drift, mismatch)
+	}
+
+	p.stepByTurn[turn] = step + 1
+	p.lastTurn = turn
+	if recorded.Error != "" {
+		return orchestration.ProviderOutput{}, fmt.Errorf("recorded provider error: %s", recorded.Error)
+	}
+	output := recorded.Output.providerOutput(p.ids.rewrite)
+	if conversationID := strings.TrimSpace(output.ConversationID); conversationID != "" {
+		p.turnByConversation[conversationID] = turn
+	}
+	return output, nil
+}
+
+// ListModels serves the models the cassette was recorded against so replay
+// agent setup validates models without provider network access.
+func (p *Player) ListModels(ctx context.Context, _ provider.ListModelsInput) ([]provider.Model, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	recorded := p.cassette.Models()
+	models := make([]provider.Model, 0, len(recorded))
+	for _, id := range recorded {
+		models = append(models, provider.Model{ID: id})
+	}
+	return models, nil
+}
+
+// Provider returns the provider the cassette was recorded against.
+func (p *Player) Provider() string {
+	return strings.TrimSpace(p.cassette.Metadata.Provider)
+}
+
+// Drift returns non-fatal content divergences observed during lenient replay.
+func (p *Player) Drift() []*MismatchError {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*MismatchError(nil), p.drift...)
+}
+
+// Done reports an error when recorded exchanges were never replayed, which
+// means the live tool loop ended earlier than the recording.
+func (p *Player) Done() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var unplayed []string
+	for turn, recorded := range p.cassette.Turns {
+		if played := p.stepByTurn[turn]; played < len(recorded.Exchanges) {
+			unplayed = append(unplayed, fmt.Sprintf("turn %d replayed %d of %d steps", turn+1, played, len(recorded.Exchanges)))
+		}
+	}
+	if len(unplayed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("provider cassette not fully replayed: %s", strings.Join(unplayed, "; "))
+}
+
+// turnFor mirrors Recorder.turnFor so replay routes steps exactly as they
+// were captured. Callers must hold p.mu.
+func (p *Player) turnFor(input orchestration.ProviderInput) (int, error) {
+	if !startsTurn(input) {
+		if turn, ok := p.turnByConversation[strings.TrimSpace(input.ConversationID)]; ok {
+			return turn, nil
+		}
+		if p.lastTurn >= 0 {
+			return p.lastTurn, nil
+		}
+	}
+	if p.nextTurn >= len(p.cassette.Turns) {
+		return 0, fmt.Errorf("%w: %d recorded turns", ErrExhausted, len(p.cassette.Turns))
+	}
+	turn := p.nextTurn
+	p.nextTurn++
+	return turn, nil
+}
+
+// learn binds recorded identifiers to live ones from every text field the
+// live run produced. Callers must hold p.mu.
+func (p *Player) learn(recorded Input, live Input) {
+	p.ids.learn(recorded.Prompt, live.Prompt)
+	p.ids.learn(recorded.FollowUpPrompt, live.FollowUpPrompt)
+	for i := range min(len(recorded.Results), len(live.Results)) {
+		p.ids.learn(recorded.Results[i].Output, live.Results[i].Output)
+	}
+}
@@ -0,0 +1,226 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+)
+
+const (
+	recordedSceneID = "aaaaaaaaaaaaaaaaaaaaaaaaaa"
+	liveSceneID     = "bbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func replayFixture() *Cassette {
+	return &Cassette{
+		Version: FormatVersion,
+		Turns: []Turn{{Exchanges: []Exchange{
+			{
+				Input: Input{
+					Model:     "gpt-test",
+					Prompt:    "Active scene: " + recordedSceneID,
+					ToolNames: []string{"scene_read", "interaction_record_scene_gm_interaction"},
+				},
+				Output: Output{
+					ConversationID: "conv-1",
+					ToolCalls: []ToolCall{{
+						CallID:    "call-1",
+						Name:      "scene_read",
+						Arguments: `{"scene_id":"` + recordedSceneID + `"}`,
+					}},
+				},
+			},
+			{
+				Input: Input{
+					Model:          "gpt-test",
+					Prompt:         "Active scene: " + recordedSceneID,
+					ConversationID: "conv-1",
+					ToolNames:      []string{"scene_read", "interaction_record_scene_gm_interaction"},
+					Results:        []ToolResult{{CallID: "call-1", Output: `{"name":"Harbor"}`}},
+				},
+				Output: Output{ConversationID: "conv-1", OutputText: "The harbor waits."},
+			},
+		}}},
+	}
+}
+
+func liveTools() []orchestration.Tool {
+	return []orchestration.Tool{{Name: "interaction_record_scene_gm_interaction"}, {Name: "scene_read"}}
+}
+
+func TestPlayerReplaysAndRebindsRecordedIDs(t *testing.T) {
+	player, err := NewPlayer(replayFixture(), PlayerConfig{Strict: true})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	ctx := context.Background()
+
+	first, err := player.Run(ctx, orchestration.ProviderInput{
+		Model:  "gpt-test",
+		Prompt: "Active scene: " + liveSceneID,
+		Tools:  liveTools(),
+	})
+	if err != nil {
+		t.Fatalf("first step: %v", err)
+	}
+	if got := first.ToolCalls[0].Arguments; got != `{"scene_id":"`+liveSceneID+`"}` {
+		t.Fatalf("rebound arguments = %s", got)
+	}
+
+	second, err := player.Run(ctx, orchestration.ProviderInput{
+		Model:          "gpt-test",
+		Prompt:         "Active scene: " + liveSceneID,
+		ConversationID: first.ConversationID,
+		Tools:          liveTools(),
+		Results:        []orchestration.ProviderToolResult{{CallID: "call-1", Output: `{"name":"Harbor"}`}},
+	})
+	if err != nil {
+		t.Fatalf("second step: %v", err)
+	}
+	if second.OutputText != "The harbor waits." {
+		t.Fatalf("output text = %q", second.OutputText)
+	}
+	if err := player.Done(); err != nil {
+		t.Fatalf("done: %v", err)
+	}
+}
+
+func TestPlayerReportsStructuralMismatch(t *testing.T) {
+	player, err := NewPlayer(replayFixture(), PlayerConfig{})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	_, err = player.Run(context.Background(), orchestration.ProviderInput{
+		Model:  "gpt-other",
+		Prompt: "Active scene: " + liveSceneID,
+		Tools:  []orchestration.Tool{{Name: "scene_read"}},
+	})
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("error = %v, want MismatchError", err)
+	}
+	fields := make([]string, 0, len(mismatch.Mismatches))
+	for _, m := range mismatch.Mismatches {
+		fields = append(fields, m.Field)
+	}
+	if got := strings.Join(fields, ","); got != "model,tool_names" {
+		t.Fatalf("mismatch fields = %s, want model,tool_names", got)
+	}
+	if !strings.Contains(err.Error(), "turn 1 step 1") {
+		t.Fatalf("error message missing position: %v", err)
+	}
+}
+
+func TestPlayerLenientReplayRecordsContentDrift(t *testing.T) {
+	player, err := NewPlayer(replayFixture(), PlayerConfig{})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	if _, err := player.Run(context.Background(), orchestration.ProviderInput{
+		Model:  "gpt-test",
+		Prompt: "Active scene: " + liveSceneID + " (dusk)",
+		Tools:  liveTools(),
+	}); err != nil {
+		t.Fatalf("lenient replay should tolerate prompt drift: %v", err)
+	}
+	drift := player.Drift()
+	if len(drift) != 1 || drift[0].Mismatches[0].Field != "prompt" {
+		t.Fatalf("drift = %#v, want one prompt mismatch", drift)
+	}
+	if err := player.Done(); err == nil {
+		t.Fatal("expected unplayed step to be reported")
+	}
+}
+
+func TestPlayerStrictReplayFailsOnContentDrift(t *testing.T) {
+	player, err := NewPlayer(replayFixture(), PlayerConfig{Strict: true})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	_, err = player.Run(context.Background(), orchestration.ProviderInput{
+		Model:  "gpt-test",
+		Prompt: "A different prompt entirely",
+		Tools:  liveTools(),
+	})
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) || mismatch.Mismatches[0].Field != "prompt" {
+		t.Fatalf("error = %v, want prompt mismatch", err)
+	}
+}
+
+func TestPlayerExhaustedCassette(t *testing.T) {
+	player, err := NewPlayer(&Cassette{Version: FormatVersion}, PlayerConfig{})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	if _, err := player.Run(context.Background(), orchestration.ProviderInput{Prompt: "go"}); !errors.Is(err, ErrExhausted) {
+		t.Fatalf("error = %v, want ErrExhausted", err)
+	}
+}
+
+func TestPlayerReplaysRecordedProviderError(t *testing.T) {
+	player, err := NewPlayer(&Cassette{
+		Version: FormatVersion,
+		Turns:   []Turn{{Exchanges: []Exchange{{Input: Input{Prompt: "go"}, Error: "rate limited"}}}},
+	}, PlayerConfig{})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	if _, err := player.Run(context.Background(), orchestration.ProviderInput{Prompt: "go"}); err == nil || !strings.Contains(err.Error(), "rate limited") {
+		t.Fatalf("error = %v, want recorded provider error", err)
+	}
+}
+
+func TestPlayerListsRecordedModels(t *testing.T) {
+	player, err := NewPlayer(replayFixture(), PlayerConfig{})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	models, err := player.ListModels(context.Background(), provider.ListModelsInput{})
+	if err != nil {
+		t.Fatalf("list models: %v", err)
+	}
+	if len(models) != 1 || models[0].ID != "gpt-test" {
+		t.Fatalf("models = %v, want [gpt-test]", models)
+	}
+}
+
+func TestPlayerRebindsReorderedIDsByContext(t *testing.T) {
+	const (
+		recordedNorth = "cccccccccccccccccccccccccc"
+		recordedSouth = "dddddddddddddddddddddddddd"
+		liveNorth     = "zzzzzzzzzzzzzzzzzzzzzzzzzz"
+		liveSouth     = "eeeeeeeeeeeeeeeeeeeeeeeeee"
+	)
+	scenes := func(first, firstName, second, secondName string) string {
+		return `{"scene_id":"` + first + `","name":"` + firstName + `"},{"scene_id":"` + second + `","name":"` + secondName + `"}`
+	}
+	player, err := NewPlayer(&Cassette{
+		Version: FormatVersion,
+		Turns: []Turn{{Exchanges: []Exchange{{
+			Input: Input{Prompt: scenes(recordedNorth, "North Gate", recordedSouth, "South Tunnel")},
+			Output: Output{ToolCalls: []ToolCall{{
+				CallID:    "call-1",
+				Name:      "interaction_activate_scene",
+				Arguments: `{"scene_id":"` + recordedSouth + `"}`,
+			}}},
+		}}}},
+	}, PlayerConfig{})
+	if err != nil {
+		t.Fatalf("new player: %v", err)
+	}
+	// Live IDs sort the other way, so the scene list comes back reordered.
+	output, err := player.Run(context.Background(), orchestration.ProviderInput{
+		Prompt: scenes(liveSouth, "South Tunnel", liveNorth, "North Gate"),
+	})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if got := output.ToolCalls[0].Arguments; !strings.Contains(got, liveSouth) {
+		t.Fatalf("arguments = %s, want live south scene %s", got, liveSouth)
+	}
+}
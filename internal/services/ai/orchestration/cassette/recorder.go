@@ -0,0 +1,115 @@
+package cassette
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+)
+
+// Recorder forwards provider steps to a live provider and captures every
+// exchange into a cassette persisted at path.
+type Recorder struct {
+	live orchestration.Provider
+	path string
+	now  func() time.Time
+
+	mu       sync.Mutex
+	cassette Cassette
+	// turnByConversation routes follow-up steps to the turn that produced the
+	// provider conversation they continue.
+	turnByConversation map[string]int
+	lastTurn           int
+}
+
+// RecorderConfig declares the live provider and destination for one recording.
+type RecorderConfig struct {
+	Live     orchestration.Provider
+	Path     string
+	Metadata Metadata
+	// Now overrides the recording timestamp clock; defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewRecorder builds a recording provider around one live provider.
+func NewRecorder(cfg RecorderConfig) (*Recorder, error) {
+	if cfg.Live == nil {
+		return nil, fmt.Errorf("live provider is required")
+	}
+	path := strings.TrimSpace(cfg.Path)
+	if path == "" {
+		return nil, fmt.Errorf("cassette path is required")
+	}
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
+	metadata := cfg.Metadata
+	metadata.RecordedAtUTC = now().UTC().Format(time.RFC3339)
+	return &Recorder{
+		live:               cfg.Live,
+		path:               path,
+		now:                now,
+		cassette:           Cassette{Version: FormatVersion, Metadata: metadata},
+		turnByConversation: make(map[string]int),
+		lastTurn:           -1,
+	}, nil
+}
+
+// Run forwards one provider step and persists the resulting exchange. Failed
+// live steps are recorded too so replay reproduces provider errors.
+func (r *Recorder) Run(ctx context.Context, input orchestration.ProviderInput) (orchestration.ProviderOutput, error) {
+	output, runErr := r.live.Run(ctx, input)
+
+	exchange := Exchange{Input: captureInput(input), Output: captureOutput(output)}
+	if runErr != nil {
+		exchange.Output = Output{}
+		exchange.Error = runErr.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	turn := r.turnFor(input)
+	r.cassette.Turns[turn].Exchanges = append(r.cassette.Turns[turn].Exchanges, exchange)
+	if conversationID := strings.TrimSpace(output.ConversationID); runErr == nil && conversationID != "" {
+		r.turnByConversation[conversationID] = turn
+	}
+	r.lastTurn = turn
+	if err := Save(r.path, &r.cassette); err != nil {
+		if runErr != nil {
+			return output, runErr
+		}
+		return orchestration.ProviderOutput{}, fmt.Errorf("record provider cassette: %w", err)
+	}
+	return output, runErr
+}
+
+// Cassette returns a snapshot of everything recorded so far.
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snapshot := r.cassette
+	snapshot.Turns = make([]Turn, len(r.cassette.Turns))
+	for i, turn := range r.cassette.Turns {
+		snapshot.Turns[i] = Turn{Exchanges: append([]Exchange(nil), turn.Exchanges...)}
+	}
+	return snapshot
+}
+
+// turnFor resolves which recorded turn one input belongs to, opening a new
+// turn for fresh tool loops. Callers must hold r.mu.
+func (r *Recorder) turnFor(input orchestration.ProviderInput) int {
+	if !startsTurn(input) {
+		if turn, ok := r.turnByConversation[strings.TrimSpace(input.ConversationID)]; ok {
+			return turn
+		}
+		if r.lastTurn >= 0 {
+			return r.lastTurn
+		}
+	}
+	r.cassette.Turns = append(r.cassette.Turns, Turn{})
+	return len(r.cassette.Turns) - 1
+}
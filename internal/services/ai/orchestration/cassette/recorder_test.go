@@ -0,0 +1,161 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+)
+
+type scriptedProvider struct {
+	outputs []orchestration.ProviderOutput
+	errs    []error
+	inputs  []orchestration.ProviderInput
+}
+
+func (p *scriptedProvider) Run(_ context.Context, input orchestration.ProviderInput) (orchestration.ProviderOutput, error) {
+	step := len(p.inputs)
+	p.inputs = append(p.inputs, input)
+	var err error
+	if step < len(p.errs) {
+		err = p.errs[step]
+	}
+	if step >= len(p.outputs) {
+		return orchestration.ProviderOutput{}, err
+	}
+	return p.outputs[step], err
+}
+
+func TestNewRecorderRequiresLiveProviderAndPath(t *testing.T) {
+	if _, err := NewRecorder(RecorderConfig{Path: "x.json"}); err == nil {
+		t.Fatal("expected missing live provider to fail")
+	}
+	if _, err := NewRecorder(RecorderConfig{Live: &scriptedProvider{}, Path: "  "}); err == nil {
+		t.Fatal("expected missing path to fail")
+	}
+}
+
+func TestRecorderPersistsTurnsAfterEveryExchange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "turn.json")
+	live := &scriptedProvider{outputs: []orchestration.ProviderOutput{
+		{
+			ConversationID: "conv-1",
+			ToolCalls:      []orchestration.ProviderToolCall{{CallID: "call-1", Name: "scene_list", Arguments: `{}`}},
+			Usage:          provider.Usage{InputTokens: 10, TotalTokens: 12},
+		},
+		{ConversationID: "conv-1", OutputText: "The harbor bell tolls."},
+		{ConversationID: "conv-2", OutputText: "A second turn."},
+	}}
+	recorder, err := NewRecorder(RecorderConfig{
+		Live:     live,
+		Path:     path,
+		Metadata: Metadata{Provider: "openai", Scenario: "bootstrap"},
+		Now:      func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) },
+	})
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := recorder.Run(ctx, orchestration.ProviderInput{
+		Model:        "gpt-test",
+		Prompt:       "Open the scene.",
+		Instructions: "be a gm",
+		AuthToken:    "secret-token",
+		Tools:        []orchestration.Tool{{Name: "scene_list"}},
+	}); err != nil {
+		t.Fatalf("first step: %v", err)
+	}
+	persisted, err := Load(path)
+	if err != nil {
+		t.Fatalf("load after first step: %v", err)
+	}
+	if got := persisted.ExchangeCount(); got != 1 {
+		t.Fatalf("exchange count after first step = %d, want 1", got)
+	}
+
+	if _, err := recorder.Run(ctx, orchestration.ProviderInput{
+		Model:          "gpt-test",
+		Prompt:         "Open the scene.",
+		ConversationID: "conv-1",
+		Results:        []orchestration.ProviderToolResult{{CallID: "call-1", Output: `{"scenes":[]}`}},
+	}); err != nil {
+		t.Fatalf("second step: %v", err)
+	}
+	if _, err := recorder.Run(ctx, orchestration.ProviderInput{Model: "gpt-test", Prompt: "Next turn."}); err != nil {
+		t.Fatalf("third step: %v", err)
+	}
+
+	persisted, err = Load(path)
+	if err != nil {
+		t.Fatalf("load cassette: %v", err)
+	}
+	if persisted.Metadata.RecordedAtUTC != "2026-03-01T12:00:00Z" {
+		t.Fatalf("recorded_at = %q", persisted.Metadata.RecordedAtUTC)
+	}
+	if len(persisted.Turns) != 2 {
+		t.Fatalf("turns = %d, want 2", len(persisted.Turns))
+	}
+	if got := len(persisted.Turns[0].Exchanges); got != 2 {
+		t.Fatalf("turn 1 exchanges = %d, want 2", got)
+	}
+	first := persisted.Turns[0].Exchanges[0]
+	if first.Input.InstructionsDigest == "" || first.Input.InstructionsDigest == "be a gm" {
+		t.Fatalf("instructions digest = %q, want sha256 digest", first.Input.InstructionsDigest)
+	}
+	if first.Output.Usage.TotalTokens != 12 {
+		t.Fatalf("usage total = %d, want 12", first.Output.Usage.TotalTokens)
+	}
+	if got := persisted.ToolNames(); len(got) != 1 || got[0] != "scene_list" {
+		t.Fatalf("tool names = %v, want [scene_list]", got)
+	}
+}
+
+func TestRecorderCapturesProviderErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "turn.json")
+	live := &scriptedProvider{errs: []error{errors.New("rate limited")}}
+	recorder, err := NewRecorder(RecorderConfig{Live: live, Path: path})
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	if _, err := recorder.Run(context.Background(), orchestration.ProviderInput{Prompt: "go"}); err == nil || err.Error() != "rate limited" {
+		t.Fatalf("run error = %v, want live error", err)
+	}
+	got := recorder.Cassette()
+	if got.Turns[0].Exchanges[0].Error != "rate limited" {
+		t.Fatalf("recorded error = %q", got.Turns[0].Exchanges[0].Error)
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    Mode
+		wantErr bool
+	}{
+		{raw: "", want: ModeOff},
+		{raw: "off", want: ModeOff},
+		{raw: " Record ", want: ModeRecord},
+		{raw: "replay", want: ModeReplay},
+		{raw: "rewind", wantErr: true},
+	}
+	for _, tc := range tests {
+		got, err := ParseMode(tc.raw)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("ParseMode(%q) expected error", tc.raw)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseMode(%q): %v", tc.raw, err)
+		}
+		if got != tc.want {
+			t.Fatalf("ParseMode(%q) = %q, want %q", tc.raw, got, tc.want)
+		}
+	}
+}
@@ -172,7 +172,8 @@ type countdownAdvanceSummary struct {
 	Reason          string `json:"reason,omitempty"`
 }
 
-// ActionRollResolve runs the authoritative Daggerheart action-roll workflow in one tool call.
+// ActionRollResolve runs the authoritative Daggerheart action-roll workflow in
+// one tool call; the roll and its outcome commit together.
 func ActionRollResolve(runtime Runtime, ctx context.Context, argsJSON []byte) (orchestration.ToolResult, error) {
 	var input actionRollResolveInput
 	if err := json.Unmarshal(argsJSON, &input); err != nil {
@@ -208,41 +209,36 @@ func ActionRollResolve(runtime Runtime, ctx context.Context, argsJSON []byte) (o
 		}
 	}
 
-	rollResp, err := runtime.DaggerheartClient().SessionActionRoll(callCtx, &pb.SessionActionRollRequest{
-		CampaignId:             campaignID,
-		SessionId:              sessionID,
-		SceneId:                sceneID,
-		CharacterId:            characterID,
-		Trait:                  trait,
-		RollKind:               pb.RollKind_ROLL_KIND_ACTION,
-		Difficulty:             int32(input.Difficulty),
-		Modifiers:              actionRollModifiersToProto(input.Modifiers),
-		HopeSpends:             actionRollHopeSpendsToProto(input.HopeSpends),
-		Advantage:              int32(input.Advantage),
-		Disadvantage:           int32(input.Disadvantage),
-		Underwater:             input.Underwater,
-		BreathSceneCountdownId: strings.TrimSpace(input.BreathSceneCountdownID),
-		Rng:                    rngRequestToProto(input.Rng),
-		ReplaceHopeWithArmor:   input.ReplaceHopeWithArmor,
-		Context:                actionRollContextToProto(input.Context),
+	flowResp, err := runtime.DaggerheartClient().SessionActionRollFlow(callCtx, &pb.SessionActionRollFlowRequest{
+		Roll: &pb.SessionActionRollRequest{
+			CampaignId:             campaignID,
+			SessionId:              sessionID,
+			SceneId:                sceneID,
+			CharacterId:            characterID,
+			Trait:                  trait,
+			RollKind:               pb.RollKind_ROLL_KIND_ACTION,
+			Difficulty:             int32(input.Difficulty),
+			Modifiers:              actionRollModifiersToProto(input.Modifiers),
+			HopeSpends:             actionRollHopeSpendsToProto(input.HopeSpends),
+			Advantage:              int32(input.Advantage),
+			Disadvantage:           int32(input.Disadvantage),
+			Underwater:             input.Underwater,
+			BreathSceneCountdownId: strings.TrimSpace(input.BreathSceneCountdownID),
+			Rng:                    rngRequestToProto(input.Rng),
+			ReplaceHopeWithArmor:   input.ReplaceHopeWithArmor,
+			Context:                actionRollContextToProto(input.Context),
+		},
+		Targets:      compactStrings(input.Targets),
+		SwapHopeFear: input.SwapHopeFear,
 	})
 	if err != nil {
-		return orchestration.ToolResult{}, fmt.Errorf("session action roll failed: %w", err)
+		return orchestration.ToolResult{}, fmt.Errorf("session action roll flow failed: %w", err)
 	}
+	rollResp := flowResp.GetActionRoll()
 	if rollResp == nil {
 		return orchestration.ToolResult{}, fmt.Errorf("session action roll response is missing")
 	}
-
-	outcomeResp, err := runtime.DaggerheartClient().ApplyRollOutcome(callCtx, &pb.ApplyRollOutcomeRequest{
-		SessionId:    sessionID,
-		RollSeq:      rollResp.GetRollSeq(),
-		Targets:      compactStrings(input.Targets),
-		SceneId:      sceneID,
-		SwapHopeFear: input.SwapHopeFear,
-	})
-	if err != nil {
-		return orchestration.ToolResult{}, fmt.Errorf("apply roll outcome failed: %w", err)
-	}
+	outcomeResp := flowResp.GetRollOutcome()
 	if outcomeResp == nil {
 		return orchestration.ToolResult{}, fmt.Errorf("apply roll outcome response is missing")
 	}
@@ -0,0 +1,123 @@
+package daggerhearttools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+)
+
+type incomingDamagePreviewResult struct {
+	CharacterID    string                       `json:"character_id"`
+	Preview        *commandPreviewSummary       `json:"preview,omitempty"`
+	ChoiceRequired *combatChoiceRequiredSummary `json:"choice_required,omitempty"`
+}
+
+type commandPreviewSummary struct {
+	Events       []previewEventSummary       `json:"events"`
+	StateChanges []previewStateChangeSummary `json:"state_changes"`
+}
+
+type previewEventSummary struct {
+	Type       string          `json:"type"`
+	EntityType string          `json:"entity_type,omitempty"`
+	EntityID   string          `json:"entity_id,omitempty"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+type previewStateChangeSummary struct {
+	Path   string          `json:"path"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// IncomingDamagePreview dry-runs incoming Daggerheart damage against one
+// character and returns the would-be events and state changes without
+// applying anything.
+func IncomingDamagePreview(runtime Runtime, ctx context.Context, argsJSON []byte) (orchestration.ToolResult, error) {
+	var input incomingDamageResolveInput
+	if err := json.Unmarshal(argsJSON, &input); err != nil {
+		return orchestration.ToolResult{}, fmt.Errorf("unmarshal args: %w", err)
+	}
+	campaignID := runtime.ResolveCampaignID("")
+	if campaignID == "" {
+		return orchestration.ToolResult{}, fmt.Errorf("campaign_id is required")
+	}
+	characterID := strings.TrimSpace(input.CharacterID)
+	if characterID == "" {
+		return orchestration.ToolResult{}, fmt.Errorf("character_id is required")
+	}
+	if input.Damage == nil {
+		return orchestration.ToolResult{}, fmt.Errorf("damage is required")
+	}
+	callCtx, cancel := runtime.CallContext(ctx)
+	defer cancel()
+	sceneID := strings.TrimSpace(input.SceneID)
+	if sceneID == "" {
+		var err error
+		sceneID, err = runtime.ResolveSceneID(callCtx, campaignID, "")
+		if err != nil {
+			return orchestration.ToolResult{}, err
+		}
+	}
+	resp, err := runtime.DaggerheartClient().ApplyDamage(callCtx, &pb.DaggerheartApplyDamageRequest{
+		CampaignId:              campaignID,
+		CharacterId:             characterID,
+		SceneId:                 sceneID,
+		Damage:                  incomingDamageSpecToProto(input.Damage),
+		RollSeq:                 input.RollSeq,
+		RequireDamageRoll:       boolDefaultTrue(input.RequireDamageRoll),
+		MitigationDecision:      damageMitigationDecisionToProto(input.MitigationDecision),
+		RequireMitigationChoice: true,
+		Preview:                 true,
+	})
+	if err != nil {
+		return orchestration.ToolResult{}, fmt.Errorf("preview damage failed: %w", err)
+	}
+	if resp == nil {
+		return orchestration.ToolResult{}, fmt.Errorf("preview damage response is missing")
+	}
+	return toolResultJSON(incomingDamagePreviewResult{
+		CharacterID:    resp.GetCharacterId(),
+		Preview:        commandPreviewSummaryFromProto(resp.GetPreview()),
+		ChoiceRequired: choiceRequiredSummaryFromProto(resp.GetChoiceRequired(), nil),
+	})
+}
+
+func commandPreviewSummaryFromProto(preview *pb.DaggerheartCommandPreview) *commandPreviewSummary {
+	if preview == nil {
+		return nil
+	}
+	summary := &commandPreviewSummary{
+		Events:       make([]previewEventSummary, 0, len(preview.GetEvents())),
+		StateChanges: make([]previewStateChangeSummary, 0, len(preview.GetStateChanges())),
+	}
+	for _, evt := range preview.GetEvents() {
+		summary.Events = append(summary.Events, previewEventSummary{
+			Type:       evt.GetType(),
+			EntityType: evt.GetEntityType(),
+			EntityID:   evt.GetEntityId(),
+			Payload:    validJSONOrNil(evt.GetPayloadJson()),
+		})
+	}
+	for _, change := range preview.GetStateChanges() {
+		summary.StateChanges = append(summary.StateChanges, previewStateChangeSummary{
+			Path:   change.GetPath(),
+			Before: validJSONOrNil(change.GetBeforeJson()),
+			After:  validJSONOrNil(change.GetAfterJson()),
+		})
+	}
+	return summary
+}
+
+// validJSONOrNil guards the embedded raw JSON so a malformed payload cannot
+// break encoding of the whole tool result.
+func validJSONOrNil(data []byte) json.RawMessage {
+	if len(data) == 0 || !json.Valid(data) {
+		return nil
+	}
+	return data
+}
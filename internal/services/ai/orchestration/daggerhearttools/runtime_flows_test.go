@@ -48,8 +48,7 @@ func (r daggerheartRuntimeTest) ResolveSceneID(ctx context.Context, campaignID,
 
 type daggerheartRuntimeClientStub struct {
 	pb.DaggerheartServiceClient
-	sessionActionRollFunc            func(context.Context, *pb.SessionActionRollRequest, ...grpc.CallOption) (*pb.SessionActionRollResponse, error)
-	applyRollOutcomeFunc             func(context.Context, *pb.ApplyRollOutcomeRequest, ...grpc.CallOption) (*pb.ApplyRollOutcomeResponse, error)
+	sessionActionRollFlowFunc        func(context.Context, *pb.SessionActionRollFlowRequest, ...grpc.CallOption) (*pb.SessionActionRollFlowResponse, error)
 	applyGmMoveFunc                  func(context.Context, *pb.DaggerheartApplyGmMoveRequest, ...grpc.CallOption) (*pb.DaggerheartApplyGmMoveResponse, error)
 	createAdversaryFunc              func(context.Context, *pb.DaggerheartCreateAdversaryRequest, ...grpc.CallOption) (*pb.DaggerheartCreateAdversaryResponse, error)
 	createSceneCountdownFunc         func(context.Context, *pb.DaggerheartCreateSceneCountdownRequest, ...grpc.CallOption) (*pb.DaggerheartCreateSceneCountdownResponse, error)
@@ -62,11 +61,8 @@ type daggerheartRuntimeClientStub struct {
 	sessionReactionFlowFunc          func(context.Context, *pb.SessionReactionFlowRequest, ...grpc.CallOption) (*pb.SessionReactionFlowResponse, error)
 }
 
-func (s *daggerheartRuntimeClientStub) SessionActionRoll(ctx context.Context, req *pb.SessionActionRollRequest, opts ...grpc.CallOption) (*pb.SessionActionRollResponse, error) {
-	return s.sessionActionRollFunc(ctx, req, opts...)
-}
-func (s *daggerheartRuntimeClientStub) ApplyRollOutcome(ctx context.Context, req *pb.ApplyRollOutcomeRequest, opts ...grpc.CallOption) (*pb.ApplyRollOutcomeResponse, error) {
-	return s.applyRollOutcomeFunc(ctx, req, opts...)
+func (s *daggerheartRuntimeClientStub) SessionActionRollFlow(ctx context.Context, req *pb.SessionActionRollFlowRequest, opts ...grpc.CallOption) (*pb.SessionActionRollFlowResponse, error) {
+	return s.sessionActionRollFlowFunc(ctx, req, opts...)
 }
 func (s *daggerheartRuntimeClientStub) ApplyGmMove(ctx context.Context, req *pb.DaggerheartApplyGmMoveRequest, opts ...grpc.CallOption) (*pb.DaggerheartApplyGmMoveResponse, error) {
 	return s.applyGmMoveFunc(ctx, req, opts...)
@@ -155,20 +151,18 @@ func TestActionRollResolve(t *testing.T) {
 	t.Parallel()
 
 	stub := &daggerheartRuntimeClientStub{
-		sessionActionRollFunc: func(_ context.Context, req *pb.SessionActionRollRequest, _ ...grpc.CallOption) (*pb.SessionActionRollResponse, error) {
-			if req.GetCampaignId() != "camp-1" || req.GetSessionId() != "sess-1" || req.GetSceneId() != "scene-1" {
-				t.Fatalf("request scope = %#v", req)
+		sessionActionRollFlowFunc: func(_ context.Context, req *pb.SessionActionRollFlowRequest, _ ...grpc.CallOption) (*pb.SessionActionRollFlowResponse, error) {
+			roll := req.GetRoll()
+			if roll.GetCampaignId() != "camp-1" || roll.GetSessionId() != "sess-1" || roll.GetSceneId() != "scene-1" {
+				t.Fatalf("request scope = %#v", roll)
 			}
-			if req.GetCharacterId() != "char-1" || req.GetTrait() != "Agility" || len(req.GetModifiers()) != 1 {
-				t.Fatalf("request = %#v", req)
+			if roll.GetCharacterId() != "char-1" || roll.GetTrait() != "Agility" || len(roll.GetModifiers()) != 1 {
+				t.Fatalf("request = %#v", roll)
 			}
-			return sampleActionRoll(), nil
-		},
-		applyRollOutcomeFunc: func(_ context.Context, req *pb.ApplyRollOutcomeRequest, _ ...grpc.CallOption) (*pb.ApplyRollOutcomeResponse, error) {
-			if req.GetRollSeq() != 11 || len(req.GetTargets()) != 1 || req.GetTargets()[0] != "target-1" || !req.GetSwapHopeFear() {
+			if len(req.GetTargets()) != 1 || req.GetTargets()[0] != "target-1" || !req.GetSwapHopeFear() {
 				t.Fatalf("apply outcome request = %#v", req)
 			}
-			return sampleRollOutcome(), nil
+			return &pb.SessionActionRollFlowResponse{ActionRoll: sampleActionRoll(), RollOutcome: sampleRollOutcome()}, nil
 		},
 	}
 
@@ -12,6 +12,11 @@ type toolExecutor func(*DirectSession, context.Context, []byte) (orchestration.T
 type productionToolDefinition struct {
 	Tool    orchestration.Tool
 	Execute toolExecutor
+	// playerOnly keeps participant-scoped player writes out of the GM profile.
+	playerOnly bool
+	// internalOnly marks tools backed by game RPCs that only accept the AI
+	// service identity, so user-scoped clients cannot call them.
+	internalOnly bool
 }
 
 type productionToolRegistry struct {
@@ -62,12 +67,51 @@ func (r productionToolRegistry) lookup(name string) (productionToolDefinition, b
 	return definition, ok
 }
 
-// ProductionToolNames returns the concrete production tool profile owned by
-// the direct game-tools bridge.
+// ProductionToolNames returns the concrete production GM tool profile owned
+// by the direct game-tools bridge.
 func ProductionToolNames() []string {
 	names := make([]string, 0, len(defaultRegistry.definitions))
 	for _, definition := range defaultRegistry.definitions {
+		if definition.playerOnly {
+			continue
+		}
 		names = append(names, definition.Tool.Name)
 	}
 	return names
 }
+
+// UserGMToolNames returns the GM tool profile for user-scoped clients such as
+// the external MCP server: the production GM profile minus internal-only
+// tools.
+func UserGMToolNames() []string {
+	names := make([]string, 0, len(defaultRegistry.definitions))
+	for _, definition := range defaultRegistry.definitions {
+		if definition.playerOnly || definition.internalOnly {
+			continue
+		}
+		names = append(names, definition.Tool.Name)
+	}
+	return names
+}
+
+// playerProfileToolNames lists the tools an AI-controlled player may use: read
+// its own view, check its character, roll dice, and submit or yield its slot.
+var playerProfileToolNames = []string{
+	"interaction_state_read",
+	"character_sheet_read",
+	"interaction_submit_scene_player_action",
+	"interaction_yield_scene_player_phase",
+	"duality_action_roll",
+	"roll_dice",
+	"duality_outcome",
+	"duality_explain",
+	"duality_probability",
+	"system_reference_search",
+	"system_reference_read",
+}
+
+// PlayerToolNames returns the restricted production tool profile for
+// AI-controlled player participants.
+func PlayerToolNames() []string {
+	return append([]string(nil), playerProfileToolNames...)
+}
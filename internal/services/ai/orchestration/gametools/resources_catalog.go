@@ -0,0 +1,58 @@
+package gametools
+
+import "strings"
+
+// ResourceTemplate describes one readable resource URI family. Placeholders
+// use RFC 6570 level-1 syntax ({campaign_id}) so MCP clients can expand them.
+type ResourceTemplate struct {
+	URITemplate string
+	Name        string
+	Description string
+	// MIMEType is the content type ReadResource returns for this family.
+	MIMEType string
+}
+
+// resourceMIMEJSON is the content type of every resource: readers return
+// indented JSON, including artifact records.
+const resourceMIMEJSON = "application/json"
+
+// resourceTemplates mirrors the URI families readResource dispatches, including
+// the Daggerheart URIs delegated to daggerhearttools.
+var resourceTemplates = []ResourceTemplate{
+	{"context://current", "current_context", "Fixed campaign, session, and participant authority for this connection", resourceMIMEJSON},
+	{"campaign://{campaign_id}", "campaign", "Campaign theme and high-level metadata", resourceMIMEJSON},
+	{"campaign://{campaign_id}/participants", "campaign_participants", "Table participants and GM/player authority", resourceMIMEJSON},
+	{"campaign://{campaign_id}/characters", "campaign_characters", "Campaign cast index and identities", resourceMIMEJSON},
+	{"campaign://{campaign_id}/characters/{character_id}/sheet", "character_sheet", "Full character sheet with Daggerheart state", resourceMIMEJSON},
+	{"campaign://{campaign_id}/sessions", "campaign_sessions", "Session list and current session status", resourceMIMEJSON},
+	{"campaign://{campaign_id}/sessions/{session_id}/scenes", "session_scenes", "Scene list and continuity for one session", resourceMIMEJSON},
+	{"campaign://{campaign_id}/sessions/{session_id}/recap", "session_recap", "Recorded recap for one session", resourceMIMEJSON},
+	{"campaign://{campaign_id}/interaction", "interaction_state", "Current phase, active scene, and player-review status", resourceMIMEJSON},
+	{"campaign://{campaign_id}/artifacts/{path}", "campaign_artifact", "Campaign GM working artifact such as story.md or memory.md", resourceMIMEJSON},
+	{"daggerheart://rules/version", "daggerheart_rules_version", "Daggerheart rules version and dice semantics", resourceMIMEJSON},
+	{"daggerheart://campaign/{campaign_id}/snapshot", "daggerheart_snapshot", "Daggerheart campaign state snapshot", resourceMIMEJSON},
+	{"daggerheart://campaign/{campaign_id}/sessions/{session_id}/combat_board", "daggerheart_combat_board", "Daggerheart combat board for one session", resourceMIMEJSON},
+	{"daggerheart://campaign/{campaign_id}/campaign_countdowns", "daggerheart_campaign_countdowns", "Campaign-scoped Daggerheart countdowns", resourceMIMEJSON},
+}
+
+// ResourceTemplates returns the readable resource URI families.
+func ResourceTemplates() []ResourceTemplate {
+	return append([]ResourceTemplate(nil), resourceTemplates...)
+}
+
+// ResourceCampaignID returns the campaign a resource URI addresses. ok is
+// false for URIs that are not campaign-scoped, such as context://current or
+// daggerheart://rules/version.
+func ResourceCampaignID(uri string) (campaignID string, ok bool) {
+	var rest string
+	switch {
+	case strings.HasPrefix(uri, "daggerheart://campaign/"):
+		rest = strings.TrimPrefix(uri, "daggerheart://campaign/")
+	case strings.HasPrefix(uri, "campaign://"):
+		rest = strings.TrimPrefix(uri, "campaign://")
+	default:
+		return "", false
+	}
+	campaignID, _, _ = strings.Cut(rest, "/")
+	return campaignID, true
+}
@@ -0,0 +1,63 @@
+package gametools
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestResourceTemplatesAreUniqueAndDispatchable(t *testing.T) {
+	sess := NewDirectSession(Clients{}, SessionContext{})
+	seenURIs := make(map[string]struct{})
+	seenNames := make(map[string]struct{})
+	for _, template := range ResourceTemplates() {
+		if _, ok := seenURIs[template.URITemplate]; ok {
+			t.Fatalf("duplicate resource template %q", template.URITemplate)
+		}
+		seenURIs[template.URITemplate] = struct{}{}
+		if _, ok := seenNames[template.Name]; ok {
+			t.Fatalf("duplicate resource name %q", template.Name)
+		}
+		seenNames[template.Name] = struct{}{}
+		if template.Description == "" || template.MIMEType == "" {
+			t.Fatalf("resource template %q is missing description or mime type", template.URITemplate)
+		}
+
+		uri := strings.NewReplacer(
+			"{campaign_id}", "camp-1",
+			"{session_id}", "sess-1",
+			"{character_id}", "char-1",
+			"{path}", "memory.md",
+		).Replace(template.URITemplate)
+		err := func() (err error) {
+			// Clients are nil, so recognized URIs panic or fail on the missing
+			// client; only an "unknown resource" error means no dispatch.
+			defer func() { _ = recover() }()
+			_, err = sess.ReadResource(context.Background(), uri)
+			return err
+		}()
+		if err != nil && strings.Contains(err.Error(), "unknown resource URI") {
+			t.Fatalf("resource template %q is not dispatchable", template.URITemplate)
+		}
+	}
+}
+
+func TestResourceCampaignID(t *testing.T) {
+	cases := []struct {
+		uri        string
+		campaignID string
+		ok         bool
+	}{
+		{"campaign://camp-1", "camp-1", true},
+		{"campaign://camp-1/artifacts/memory.md", "camp-1", true},
+		{"daggerheart://campaign/camp-2/snapshot", "camp-2", true},
+		{"daggerheart://rules/version", "", false},
+		{"context://current", "", false},
+	}
+	for _, tc := range cases {
+		campaignID, ok := ResourceCampaignID(tc.uri)
+		if campaignID != tc.campaignID || ok != tc.ok {
+			t.Fatalf("ResourceCampaignID(%q) = %q, %v; want %q, %v", tc.uri, campaignID, ok, tc.campaignID, tc.ok)
+		}
+	}
+}
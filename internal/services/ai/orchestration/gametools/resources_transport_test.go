@@ -54,6 +54,10 @@ func (stub *campaignClientStub) RestoreCampaign(context.Context, *statev1.Restor
 	return nil, nil
 }
 
+func (stub *campaignClientStub) SetCampaignModerationLock(context.Context, *statev1.SetCampaignModerationLockRequest, ...grpc.CallOption) (*statev1.SetCampaignModerationLockResponse, error) {
+	return nil, nil
+}
+
 func (stub *campaignClientStub) SetCampaignCover(context.Context, *statev1.SetCampaignCoverRequest, ...grpc.CallOption) (*statev1.SetCampaignCoverResponse, error) {
 	return nil, nil
 }
@@ -95,6 +99,14 @@ func (stub *participantClientStub) GetParticipant(context.Context, *statev1.GetP
 	return nil, nil
 }
 
+func (stub *participantClientStub) ListUserParticipants(context.Context, *statev1.ListUserParticipantsRequest, ...grpc.CallOption) (*statev1.ListUserParticipantsResponse, error) {
+	return nil, nil
+}
+
+func (stub *participantClientStub) AnonymizeUserParticipants(context.Context, *statev1.AnonymizeUserParticipantsRequest, ...grpc.CallOption) (*statev1.AnonymizeUserParticipantsResponse, error) {
+	return nil, nil
+}
+
 func (stub *participantClientStub) BindParticipant(context.Context, *statev1.BindParticipantRequest, ...grpc.CallOption) (*statev1.BindParticipantResponse, error) {
 	return nil, nil
 }
@@ -177,6 +189,10 @@ func (stub *sessionClientStub) GetSessionRecap(context.Context, *statev1.GetSess
 	return stub.recapResp, stub.recapErr
 }
 
+func (stub *sessionClientStub) RecordSessionRecap(context.Context, *statev1.RecordSessionRecapRequest, ...grpc.CallOption) (*statev1.RecordSessionRecapResponse, error) {
+	return nil, nil
+}
+
 func (stub *sessionClientStub) EndSession(context.Context, *statev1.EndSessionRequest, ...grpc.CallOption) (*statev1.EndSessionResponse, error) {
 	return nil, nil
 }
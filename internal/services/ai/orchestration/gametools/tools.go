@@ -9,6 +9,7 @@ func newProductionToolDefinitions() []productionToolDefinition {
 	definitions = append(definitions, artifactToolDefinitions()...)
 	definitions = append(definitions, sceneToolDefinitions()...)
 	definitions = append(definitions, interactionToolDefinitions()...)
+	definitions = append(definitions, playerToolDefinitions()...)
 	definitions = append(definitions, daggerheartToolDefinitions()...)
 	definitions = append(definitions, diceUtilityToolDefinitions()...)
 	definitions = append(definitions, referenceToolDefinitions()...)
@@ -244,6 +244,34 @@ func daggerheartToolDefinitions() []productionToolDefinition {
 			},
 			Execute: wrapDaggerheartExecutor(daggerhearttools.IncomingDamageResolve),
 		},
+		{
+			Tool: orchestration.Tool{
+				Name:        "daggerheart_incoming_damage_preview",
+				Description: "Previews incoming Daggerheart damage to one character without applying it, returning the would-be events and resulting state changes such as HP, Stress, and armor",
+				InputSchema: schemaObject(map[string]schemaProperty{
+					"character_id":        {Type: "string", Description: "target character identifier"},
+					"scene_id":            {Type: "string", Description: "optional explicit scene identifier; defaults to the active scene"},
+					"roll_seq":            {Type: "integer", Description: "optional recorded damage roll sequence"},
+					"require_damage_roll": {Type: "boolean", Description: "whether damage application should require the recorded damage roll; defaults to true"},
+					"damage": {Type: "object", Description: "incoming damage details", Properties: map[string]schemaProperty{
+						"amount":               {Type: "integer", Description: "incoming damage amount"},
+						"damage_type":          {Type: "string", Description: "damage type: physical, magic, or mixed"},
+						"resist_physical":      {Type: "boolean", Description: "whether the target resists physical damage"},
+						"resist_magic":         {Type: "boolean", Description: "whether the target resists magic damage"},
+						"immune_physical":      {Type: "boolean", Description: "whether the target is immune to physical damage"},
+						"immune_magic":         {Type: "boolean", Description: "whether the target is immune to magic damage"},
+						"direct":               {Type: "boolean", Description: "whether the damage bypasses armor mitigation"},
+						"massive_damage":       {Type: "boolean", Description: "whether the damage may use the massive-damage rule"},
+						"source":               {Type: "string", Description: "short source label"},
+						"source_character_ids": {Type: "array", Description: "optional source character or adversary identifiers", Items: &schemaProperty{Type: "string"}},
+					}},
+					"mitigation_decision": {Type: "object", Description: "optional explicit mitigation decision to preview", Properties: map[string]schemaProperty{
+						"base_armor": {Type: "string", Description: "optional base armor decision: SPEND or DECLINE"},
+					}},
+				}),
+			},
+			Execute: wrapDaggerheartExecutor(daggerhearttools.IncomingDamagePreview),
+		},
 		{
 			Tool: orchestration.Tool{
 				Name:        "daggerheart_adversary_attack_flow_resolve",
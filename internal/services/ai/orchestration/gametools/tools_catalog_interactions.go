@@ -162,7 +162,8 @@ func interactionToolDefinitions() []productionToolDefinition {
 					"epilogue":     {Type: "string", Description: "required when end_campaign is true; otherwise leave empty"},
 				}),
 			},
-			Execute: (*DirectSession).interactionConcludeSession,
+			Execute:      (*DirectSession).interactionConcludeSession,
+			internalOnly: true,
 		},
 	}
 }
@@ -0,0 +1,35 @@
+package gametools
+
+import "github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+
+// playerToolDefinitions are the interaction writes available to AI-controlled
+// player participants. They act as the session's own participant, so they are
+// kept out of the GM profile.
+func playerToolDefinitions() []productionToolDefinition {
+	return []productionToolDefinition{
+		{
+			Tool: orchestration.Tool{
+				Name:        "interaction_submit_scene_player_action",
+				Description: "Submits or replaces your character's action for the open player phase; describe only what your own character attempts, says, or commits to",
+				InputSchema: schemaObject(map[string]schemaProperty{
+					"scene_id":      {Type: "string", Description: "scene identifier (defaults to active scene)"},
+					"summary_text":  {Type: "string", Description: "first-person or third-person description of your character's action"},
+					"character_ids": {Type: "array", Description: "your acting character identifiers", Items: &schemaProperty{Type: "string"}},
+				}),
+			},
+			Execute:    (*DirectSession).interactionSubmitScenePlayerAction,
+			playerOnly: true,
+		},
+		{
+			Tool: orchestration.Tool{
+				Name:        "interaction_yield_scene_player_phase",
+				Description: "Marks your slot as ready so the GM can review the player phase; yield after submitting an action, or without one to pass",
+				InputSchema: schemaObject(map[string]schemaProperty{
+					"scene_id": {Type: "string", Description: "scene identifier (defaults to active scene)"},
+				}),
+			},
+			Execute:    (*DirectSession).interactionYieldScenePlayerPhase,
+			playerOnly: true,
+		},
+	}
+}
@@ -22,6 +22,8 @@ type interactionTransportClientStub struct {
 	postSessionOOCFunc           func(context.Context, *statev1.PostSessionOOCRequest, ...grpc.CallOption) (*statev1.PostSessionOOCResponse, error)
 	markOOCReadyToResumeFunc     func(context.Context, *statev1.MarkOOCReadyToResumeRequest, ...grpc.CallOption) (*statev1.MarkOOCReadyToResumeResponse, error)
 	clearOOCReadyToResumeFunc    func(context.Context, *statev1.ClearOOCReadyToResumeRequest, ...grpc.CallOption) (*statev1.ClearOOCReadyToResumeResponse, error)
+	submitScenePlayerActionFunc  func(context.Context, *statev1.SubmitScenePlayerActionRequest, ...grpc.CallOption) (*statev1.SubmitScenePlayerActionResponse, error)
+	yieldScenePlayerPhaseFunc    func(context.Context, *statev1.YieldScenePlayerPhaseRequest, ...grpc.CallOption) (*statev1.YieldScenePlayerPhaseResponse, error)
 }
 
 type campaignAITransportClientStub struct {
@@ -50,11 +52,17 @@ func (s *interactionTransportClientStub) OpenScenePlayerPhase(ctx context.Contex
 	return &statev1.OpenScenePlayerPhaseResponse{}, nil
 }
 
-func (*interactionTransportClientStub) SubmitScenePlayerAction(context.Context, *statev1.SubmitScenePlayerActionRequest, ...grpc.CallOption) (*statev1.SubmitScenePlayerActionResponse, error) {
+func (s *interactionTransportClientStub) SubmitScenePlayerAction(ctx context.Context, req *statev1.SubmitScenePlayerActionRequest, opts ...grpc.CallOption) (*statev1.SubmitScenePlayerActionResponse, error) {
+	if s.submitScenePlayerActionFunc != nil {
+		return s.submitScenePlayerActionFunc(ctx, req, opts...)
+	}
 	return nil, nil
 }
 
-func (*interactionTransportClientStub) YieldScenePlayerPhase(context.Context, *statev1.YieldScenePlayerPhaseRequest, ...grpc.CallOption) (*statev1.YieldScenePlayerPhaseResponse, error) {
+func (s *interactionTransportClientStub) YieldScenePlayerPhase(ctx context.Context, req *statev1.YieldScenePlayerPhaseRequest, opts ...grpc.CallOption) (*statev1.YieldScenePlayerPhaseResponse, error) {
+	if s.yieldScenePlayerPhaseFunc != nil {
+		return s.yieldScenePlayerPhaseFunc(ctx, req, opts...)
+	}
 	return nil, nil
 }
 
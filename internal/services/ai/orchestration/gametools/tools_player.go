@@ -0,0 +1,121 @@
+package gametools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+)
+
+type interactionSubmitScenePlayerActionInput struct {
+	CampaignID   string   `json:"campaign_id,omitempty"`
+	SceneID      string   `json:"scene_id,omitempty"`
+	SummaryText  string   `json:"summary_text"`
+	CharacterIDs []string `json:"character_ids,omitempty"`
+}
+
+type interactionYieldScenePlayerPhaseInput struct {
+	CampaignID string `json:"campaign_id,omitempty"`
+	SceneID    string `json:"scene_id,omitempty"`
+}
+
+// playerActionResult is the player-scoped view returned to AI players after a
+// write. It reports only the caller's own slot so other participants' drafts
+// never leak into the model context.
+type playerActionResult struct {
+	SceneID     string                       `json:"scene_id,omitempty"`
+	PhaseID     string                       `json:"phase_id,omitempty"`
+	PhaseStatus string                       `json:"phase_status,omitempty"`
+	Slot        *interactionPlayerSlotResult `json:"slot,omitempty"`
+	Yielded     bool                         `json:"yielded"`
+}
+
+func (s *DirectSession) interactionSubmitScenePlayerAction(ctx context.Context, argsJSON []byte) (orchestration.ToolResult, error) {
+	var input interactionSubmitScenePlayerActionInput
+	if err := json.Unmarshal(argsJSON, &input); err != nil {
+		return orchestration.ToolResult{}, fmt.Errorf("unmarshal args: %w", err)
+	}
+	campaignID := s.resolveCampaignID(input.CampaignID)
+	if campaignID == "" {
+		return orchestration.ToolResult{}, fmt.Errorf("campaign_id is required")
+	}
+	if strings.TrimSpace(input.SummaryText) == "" {
+		return orchestration.ToolResult{}, fmt.Errorf("summary_text is required")
+	}
+	callCtx, cancel := outgoingContext(ctx, s.sc)
+	defer cancel()
+	sceneID, err := s.resolveSceneID(callCtx, campaignID, input.SceneID)
+	if err != nil {
+		return orchestration.ToolResult{}, err
+	}
+	resp, err := s.clients.Interaction.SubmitScenePlayerAction(callCtx, &statev1.SubmitScenePlayerActionRequest{
+		CampaignId:   campaignID,
+		SceneId:      sceneID,
+		SummaryText:  input.SummaryText,
+		CharacterIds: input.CharacterIDs,
+	})
+	if err != nil {
+		return orchestration.ToolResult{}, fmt.Errorf("submit scene player action failed: %w", err)
+	}
+	if resp == nil {
+		return orchestration.ToolResult{}, fmt.Errorf("submit scene player action response is missing")
+	}
+	return toolResultJSON(playerActionResultFromProto(resp.GetState(), s.sc.ParticipantID))
+}
+
+func (s *DirectSession) interactionYieldScenePlayerPhase(ctx context.Context, argsJSON []byte) (orchestration.ToolResult, error) {
+	var input interactionYieldScenePlayerPhaseInput
+	if len(argsJSON) > 0 {
+		if err := json.Unmarshal(argsJSON, &input); err != nil {
+			return orchestration.ToolResult{}, fmt.Errorf("unmarshal args: %w", err)
+		}
+	}
+	campaignID := s.resolveCampaignID(input.CampaignID)
+	if campaignID == "" {
+		return orchestration.ToolResult{}, fmt.Errorf("campaign_id is required")
+	}
+	callCtx, cancel := outgoingContext(ctx, s.sc)
+	defer cancel()
+	sceneID, err := s.resolveSceneID(callCtx, campaignID, input.SceneID)
+	if err != nil {
+		return orchestration.ToolResult{}, err
+	}
+	resp, err := s.clients.Interaction.YieldScenePlayerPhase(callCtx, &statev1.YieldScenePlayerPhaseRequest{
+		CampaignId: campaignID,
+		SceneId:    sceneID,
+	})
+	if err != nil {
+		return orchestration.ToolResult{}, fmt.Errorf("yield scene player phase failed: %w", err)
+	}
+	if resp == nil {
+		return orchestration.ToolResult{}, fmt.Errorf("yield scene player phase response is missing")
+	}
+	result := playerActionResultFromProto(resp.GetState(), s.sc.ParticipantID)
+	// A yield that closes the phase hands it to GM review, which also means
+	// this participant is done.
+	result.Yielded = result.Yielded || result.PhaseStatus != "PLAYERS"
+	return toolResultJSON(result)
+}
+
+func playerActionResultFromProto(state *statev1.InteractionState, participantID string) playerActionResult {
+	full := interactionStateFromProto(state)
+	result := playerActionResult{
+		SceneID:     full.ActiveScene.SceneID,
+		PhaseID:     full.PlayerPhase.PhaseID,
+		PhaseStatus: full.PlayerPhase.Status,
+	}
+	participantID = strings.TrimSpace(participantID)
+	for i := range full.PlayerPhase.Slots {
+		slot := full.PlayerPhase.Slots[i]
+		if strings.TrimSpace(slot.ParticipantID) != participantID {
+			continue
+		}
+		result.Slot = &slot
+		result.Yielded = slot.Yielded
+		break
+	}
+	return result
+}
@@ -0,0 +1,93 @@
+package gametools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"google.golang.org/grpc"
+)
+
+func samplePlayerPhaseState(status statev1.ScenePhaseStatus, yielded bool) *statev1.InteractionState {
+	state := sampleInteractionState("scene-1")
+	state.PlayerPhase = &statev1.ScenePlayerPhase{
+		PhaseId: "phase-1",
+		Status:  status,
+		Slots: []*statev1.ScenePlayerSlot{
+			{ParticipantId: "human", SummaryText: "I search the wagon.", Yielded: true},
+			{ParticipantId: "ai-player", SummaryText: "I keep watch.", Yielded: yielded},
+		},
+	}
+	return state
+}
+
+func newPlayerTransportSession(stub *interactionTransportClientStub) *DirectSession {
+	return NewDirectSession(Clients{Interaction: stub}, SessionContext{
+		CampaignID:    "camp-ctx",
+		SessionID:     "sess-ctx",
+		ParticipantID: "ai-player",
+	})
+}
+
+func decodePlayerActionResult(t *testing.T, output string) playerActionResult {
+	t.Helper()
+	var decoded playerActionResult
+	if err := json.Unmarshal([]byte(output), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	return decoded
+}
+
+func TestInteractionSubmitScenePlayerActionReturnsOnlyOwnSlot(t *testing.T) {
+	t.Parallel()
+
+	stub := &interactionTransportClientStub{
+		getInteractionStateFunc: func(context.Context, *statev1.GetInteractionStateRequest, ...grpc.CallOption) (*statev1.GetInteractionStateResponse, error) {
+			return &statev1.GetInteractionStateResponse{State: sampleInteractionState("scene-1")}, nil
+		},
+		submitScenePlayerActionFunc: func(_ context.Context, req *statev1.SubmitScenePlayerActionRequest, _ ...grpc.CallOption) (*statev1.SubmitScenePlayerActionResponse, error) {
+			if req.GetCampaignId() != "camp-ctx" || req.GetSceneId() != "scene-1" || req.GetSummaryText() != "I keep watch." {
+				t.Fatalf("request = %#v", req)
+			}
+			return &statev1.SubmitScenePlayerActionResponse{State: samplePlayerPhaseState(statev1.ScenePhaseStatus_SCENE_PHASE_STATUS_PLAYERS, false)}, nil
+		},
+	}
+
+	result, err := newPlayerTransportSession(stub).interactionSubmitScenePlayerAction(context.Background(), []byte(`{"summary_text":"I keep watch."}`))
+	if err != nil {
+		t.Fatalf("interactionSubmitScenePlayerAction() error = %v", err)
+	}
+	decoded := decodePlayerActionResult(t, result.Output)
+	if decoded.Slot == nil || decoded.Slot.ParticipantID != "ai-player" || decoded.Yielded {
+		t.Fatalf("result = %#v", decoded)
+	}
+
+	if _, err := newPlayerTransportSession(stub).interactionSubmitScenePlayerAction(context.Background(), []byte(`{"summary_text":"  "}`)); err == nil {
+		t.Fatal("expected error for empty summary")
+	}
+}
+
+func TestInteractionYieldScenePlayerPhaseReportsCompletionOnReview(t *testing.T) {
+	t.Parallel()
+
+	stub := &interactionTransportClientStub{
+		getInteractionStateFunc: func(context.Context, *statev1.GetInteractionStateRequest, ...grpc.CallOption) (*statev1.GetInteractionStateResponse, error) {
+			return &statev1.GetInteractionStateResponse{State: sampleInteractionState("scene-1")}, nil
+		},
+		yieldScenePlayerPhaseFunc: func(_ context.Context, req *statev1.YieldScenePlayerPhaseRequest, _ ...grpc.CallOption) (*statev1.YieldScenePlayerPhaseResponse, error) {
+			if req.GetSceneId() != "scene-1" {
+				t.Fatalf("scene id = %q, want scene-1", req.GetSceneId())
+			}
+			return &statev1.YieldScenePlayerPhaseResponse{State: samplePlayerPhaseState(statev1.ScenePhaseStatus_SCENE_PHASE_STATUS_GM_REVIEW, false)}, nil
+		},
+	}
+
+	result, err := newPlayerTransportSession(stub).interactionYieldScenePlayerPhase(context.Background(), []byte(`{"scene_id":"scene-1"}`))
+	if err != nil {
+		t.Fatalf("interactionYieldScenePlayerPhase() error = %v", err)
+	}
+	if decoded := decodePlayerActionResult(t, result.Output); !decoded.Yielded || decoded.PhaseStatus != "GM_REVIEW" {
+		t.Fatalf("result = %#v", decoded)
+	}
+}
@@ -18,6 +18,7 @@ func TestProductionToolRegistryMatchesSessionCatalog(t *testing.T) {
 	}
 
 	names := make([]string, 0, len(tools))
+	gmNames := make([]string, 0, len(tools))
 	seen := make(map[string]struct{}, len(tools))
 	for _, tool := range tools {
 		if tool.Name == "" {
@@ -28,13 +29,55 @@ func TestProductionToolRegistryMatchesSessionCatalog(t *testing.T) {
 		}
 		seen[tool.Name] = struct{}{}
 		names = append(names, tool.Name)
-		if _, ok := defaultRegistry.lookup(tool.Name); !ok {
+		definition, ok := defaultRegistry.lookup(tool.Name)
+		if !ok {
 			t.Fatalf("tool %q is listed but not dispatchable", tool.Name)
 		}
+		if !definition.playerOnly {
+			gmNames = append(gmNames, tool.Name)
+		}
 	}
 
-	if !reflect.DeepEqual(names, ProductionToolNames()) {
-		t.Fatalf("catalog names = %#v, want %#v", names, ProductionToolNames())
+	if !reflect.DeepEqual(gmNames, ProductionToolNames()) {
+		t.Fatalf("catalog gm names = %#v, want %#v", gmNames, ProductionToolNames())
+	}
+	for _, name := range PlayerToolNames() {
+		if _, ok := seen[name]; !ok {
+			t.Fatalf("player tool %q is not in the session catalog", name)
+		}
+	}
+}
+
+func TestPlayerToolProfileStaysOutOfGMProfile(t *testing.T) {
+	gm := make(map[string]struct{})
+	for _, name := range ProductionToolNames() {
+		gm[name] = struct{}{}
+	}
+	for _, name := range []string{"interaction_submit_scene_player_action", "interaction_yield_scene_player_phase"} {
+		if _, ok := gm[name]; ok {
+			t.Fatalf("gm profile includes player tool %q", name)
+		}
+	}
+	for _, name := range PlayerToolNames() {
+		if strings.HasPrefix(name, "scene_") || strings.HasPrefix(name, "campaign_") || strings.HasPrefix(name, "daggerheart_") {
+			t.Fatalf("player profile includes gm-only tool %q", name)
+		}
+	}
+}
+
+func TestUserGMToolProfileExcludesInternalOnlyTools(t *testing.T) {
+	user := make(map[string]struct{})
+	for _, name := range UserGMToolNames() {
+		user[name] = struct{}{}
+	}
+	if _, ok := user["interaction_conclude_session"]; ok {
+		t.Fatal("user gm profile includes internal-only interaction_conclude_session")
+	}
+	for _, name := range ProductionToolNames() {
+		definition, _ := defaultRegistry.lookup(name)
+		if _, ok := user[name]; !ok && !definition.internalOnly {
+			t.Fatalf("user gm profile is missing %q", name)
+		}
 	}
 }
 
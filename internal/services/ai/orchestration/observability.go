@@ -1,13 +1,34 @@
 package orchestration
 
 import (
+	"context"
+	"time"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	platformotel "github.com/louisbranch/fracturing.space/internal/platform/otel"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const tracerName = "github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
 
+var (
+	turnDuration = platformotel.Instrument(otel.Meter(tracerName).Float64Histogram(
+		"fracturing.ai.turn.duration",
+		metric.WithDescription("Wall time of one orchestrated AI turn, including tool calls."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.5, 1, 2.5, 5, 10, 20, 30, 60, 90, 120, 180),
+	))
+	turnTokens = platformotel.Instrument(otel.Meter(tracerName).Int64Counter(
+		"fracturing.ai.turn.tokens",
+		metric.WithDescription("Provider tokens consumed by orchestrated AI turns."),
+		metric.WithUnit("{token}"),
+	))
+)
+
 func orchestrationTracer() trace.Tracer {
 	return otel.Tracer(tracerName)
 }
@@ -19,3 +40,22 @@ func recordSpanError(span trace.Span, err error) {
 	span.RecordError(err)
 	span.SetStatus(codes.Error, err.Error())
 }
+
+// recordTurnMetrics records one runner turn. Failed turns carry their
+// orchestration error code so dashboards can split timeouts from step limits.
+func recordTurnMetrics(ctx context.Context, elapsed time.Duration, result Result, err error) {
+	outcome := "ok"
+	if err != nil {
+		outcome = string(apperrors.GetCode(err))
+	}
+	turnDuration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attribute.String("outcome", outcome)))
+	for direction, tokens := range map[string]int32{
+		"input":     result.Usage.InputTokens,
+		"output":    result.Usage.OutputTokens,
+		"reasoning": result.Usage.ReasoningTokens,
+	} {
+		if tokens > 0 {
+			turnTokens.Add(ctx, int64(tokens), metric.WithAttributes(attribute.String("direction", direction)))
+		}
+	}
+}
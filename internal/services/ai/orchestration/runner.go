@@ -130,6 +130,13 @@ func NewRunner(cfg RunnerConfig) CampaignTurnRunner {
 
 // Run executes one provider turn.
 func (r *runner) Run(ctx context.Context, input Input) (Result, error) {
+	started := time.Now()
+	result, err := r.run(ctx, input)
+	recordTurnMetrics(ctx, time.Since(started), result, err)
+	return result, err
+}
+
+func (r *runner) run(ctx context.Context, input Input) (Result, error) {
 	ctx, span := orchestrationTracer().Start(ctx, "ai.orchestration.run")
 	defer span.End()
 	if r == nil || r.dialer == nil {
@@ -0,0 +1,174 @@
+package orchestration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PlayerCommitToolName is the tool that records an AI player's action in the
+// open player phase. Player runners pass it as RunnerConfig.CommitToolName.
+const PlayerCommitToolName = "interaction_submit_scene_player_action"
+
+const playerYieldToolName = "interaction_yield_scene_player_phase"
+
+// NewPlayerTurnPolicy returns the turn policy for AI-controlled player
+// characters. A player turn is complete once the participant has yielded its
+// slot in the open player phase.
+func NewPlayerTurnPolicy() TurnPolicy {
+	return playerTurnPolicy{}
+}
+
+type playerTurnPolicy struct{}
+
+func (playerTurnPolicy) Controller(commitToolName string) TurnController {
+	commitToolName = strings.TrimSpace(commitToolName)
+	if commitToolName == "" || commitToolName == DefaultCommitToolName {
+		commitToolName = PlayerCommitToolName
+	}
+	return &playerTurnController{commitToolName: commitToolName}
+}
+
+type playerTurnController struct {
+	commitToolName string
+	submitted      bool
+	yielded        bool
+}
+
+func (c *playerTurnController) ObserveSuccessfulTool(name string, output string) {
+	switch strings.TrimSpace(name) {
+	case c.commitToolName:
+		c.submitted = true
+	case playerYieldToolName:
+		c.submitted = true
+		c.yielded = true
+		// The yield result also counts a phase that already moved on to GM
+		// review as yielded, so trust it when present.
+		var result struct {
+			Yielded *bool `json:"yielded"`
+		}
+		if err := json.Unmarshal([]byte(output), &result); err == nil && result.Yielded != nil {
+			c.yielded = *result.Yielded
+		}
+	}
+}
+
+func (c *playerTurnController) HasCommittedOrResolvedInteraction() bool {
+	return c.submitted
+}
+
+func (c *playerTurnController) ReadyForCompletion() bool {
+	return c.yielded
+}
+
+func (c *playerTurnController) PlayerHandoffRegressed() bool {
+	return false
+}
+
+func (c *playerTurnController) BuildCommitReminder(text string) string {
+	var b strings.Builder
+	b.WriteString("You returned text without recording your character's action in the player phase.\n")
+	b.WriteString("Use " + c.commitToolName + " to record what your character does, says, or attempts, then " + playerYieldToolName + " to hand the phase back.\n")
+	b.WriteString("Describe only your own character's intent; the GM decides outcomes and NPC responses.\n")
+	if text != "" {
+		b.WriteString("Use this draft as the action summary unless you need a small correction:\n")
+		b.WriteString(text)
+	}
+	return b.String()
+}
+
+func (c *playerTurnController) BuildTurnCompletionReminder(text string) string {
+	var b strings.Builder
+	b.WriteString("You recorded an action, but your slot in the player phase is not yielded yet.\n")
+	b.WriteString("Call " + playerYieldToolName + " once your action is final so the GM can resolve the phase.\n")
+	if text != "" {
+		b.WriteString("Keep this final text unless you need a small correction:\n")
+		b.WriteString(text)
+	}
+	return b.String()
+}
+
+func (c *playerTurnController) BuildPlayerPhaseStartReminder(text string) string {
+	return c.BuildTurnCompletionReminder(text)
+}
+
+// PlayerContextSources returns the context sources for AI player turns. They
+// cover only what a player at the table can see: no story notes, GM memory, or
+// GM phase guidance.
+func PlayerContextSources() []ContextSource {
+	return []ContextSource{
+		ContextSourceFunc(campaignContextSource),
+		ContextSourceFunc(participantsContextSource),
+		ContextSourceFunc(charactersContextSource),
+		ContextSourceFunc(scenesContextSource),
+		ContextSourceFunc(currentContextSource),
+		ContextSourceFunc(playerInteractionStateContextSource),
+		ContextSourceFunc(playerRoleContextSource),
+	}
+}
+
+// NewPlayerContextSourceRegistry builds the collector used by AI player
+// prompt builders.
+func NewPlayerContextSourceRegistry() *ContextSourceRegistry {
+	reg := NewContextSourceRegistry()
+	reg.RegisterAll(PlayerContextSources()...)
+	return reg
+}
+
+// PlayerPromptRenderPolicy returns the rendering policy for AI player turns.
+// It replaces the GM interaction contract so the model never sees GM tool
+// guidance.
+func PlayerPromptRenderPolicy() PromptRenderPolicy {
+	return PromptRenderPolicy{
+		Instructions: PromptInstructions{
+			InteractionContract: playerInteractionContractText(),
+		},
+		ClosingInstruction: "Return a short in-character summary of your action once it is submitted and your slot is yielded.",
+	}
+}
+
+func playerInteractionContractText() string {
+	return strings.Join([]string{
+		"You are an AI player at this table, not the GM. You control only the characters listed in the player role section.",
+		"Act only on what your character knows from the fiction so far; never reveal or guess at GM-only information.",
+		"Describe what your character does, says, chooses, or attempts. Never narrate outcomes, NPC reactions, or other players' characters.",
+		"Use " + PlayerCommitToolName + " to record your action in the open player phase, then " + playerYieldToolName + " when it is final.",
+		"Use the dice and rules tools only to understand your options; the GM adjudicates and rolls for outcomes.",
+		"Keep actions brief and leave room for the human players at the table.",
+	}, "\n")
+}
+
+func playerInteractionStateContextSource(ctx context.Context, sess Session, input PromptInput) (BriefContribution, error) {
+	interaction, err := sess.ReadResource(ctx, fmt.Sprintf("campaign://%s/interaction", input.CampaignID))
+	if err != nil {
+		return BriefContribution{}, fmt.Errorf("read interaction state: %w", err)
+	}
+	snapshot, err := decodeInteractionStateSnapshot(interaction)
+	if err != nil {
+		return BriefContribution{}, fmt.Errorf("decode interaction state: %w", err)
+	}
+	return BriefContribution{
+		Sections: []BriefSection{{
+			ID:       "interaction_state",
+			Priority: 200,
+			Label:    "Current interaction state",
+			Content:  interaction,
+		}},
+		InteractionState: &snapshot,
+	}, nil
+}
+
+func playerRoleContextSource(_ context.Context, _ Session, input PromptInput) (BriefContribution, error) {
+	var b strings.Builder
+	b.WriteString("You are participant " + input.ParticipantID + ".")
+	b.WriteString("\nYour characters are the ones whose controller is your participant ID.")
+	b.WriteString("\nIf your slot in the current player phase is already filled, revise it only when the GM requested revisions.")
+	return SectionContribution(BriefSection{
+		ID:       "player_role",
+		Priority: 100,
+		Label:    "Player role",
+		Content:  b.String(),
+		Required: true,
+	}), nil
+}
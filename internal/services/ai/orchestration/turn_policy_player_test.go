@@ -0,0 +1,120 @@
+package orchestration
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunnerCompletesPlayerTurnAfterSubmitAndYield(t *testing.T) {
+	sess := &fakeSession{
+		tools: []Tool{
+			{Name: PlayerCommitToolName},
+			{Name: playerYieldToolName},
+		},
+		resources: baseSessionResources("p-ai", "scene-1"),
+		results: map[string]ToolResult{
+			PlayerCommitToolName: {Output: `{"scene_id":"scene-1","phase_id":"phase-1","phase_status":"PLAYERS","slot":{"participant_id":"p-ai","summary_text":"I follow."},"yielded":false}`},
+			playerYieldToolName:  {Output: `{"scene_id":"scene-1","phase_id":"phase-1","phase_status":"GM_REVIEW","yielded":true}`},
+		},
+	}
+	provider := &fakeProvider{
+		steps: []ProviderOutput{
+			{ConversationID: "resp-1", OutputText: "I follow the scout."},
+			{
+				ConversationID: "resp-2",
+				ToolCalls: []ProviderToolCall{{
+					CallID:    "call-1",
+					Name:      PlayerCommitToolName,
+					Arguments: `{"summary_text":"I follow the scout."}`,
+				}},
+			},
+			{ConversationID: "resp-3", OutputText: "I follow the scout."},
+			{
+				ConversationID: "resp-4",
+				ToolCalls: []ProviderToolCall{{
+					CallID:    "call-2",
+					Name:      playerYieldToolName,
+					Arguments: `{}`,
+				}},
+			},
+			{ConversationID: "resp-5", OutputText: "I follow the scout."},
+		},
+	}
+
+	runner := NewRunner(RunnerConfig{
+		Dialer:         &fakeDialer{sess: sess},
+		PromptBuilder:  &fakePromptBuilder{prompt: "act"},
+		TurnPolicy:     NewPlayerTurnPolicy(),
+		CommitToolName: PlayerCommitToolName,
+		MaxSteps:       6,
+	})
+	res, err := runner.Run(context.Background(), Input{
+		CampaignID:    "camp-1",
+		SessionID:     "sess-1",
+		ParticipantID: "p-ai",
+		Model:         "gpt-4.1-mini",
+		AuthToken:     "sk-1",
+		Provider:      provider,
+	})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if res.OutputText != "I follow the scout." {
+		t.Fatalf("output = %q", res.OutputText)
+	}
+	if !reflect.DeepEqual(sess.calls, []string{PlayerCommitToolName, playerYieldToolName}) {
+		t.Fatalf("tool calls = %#v", sess.calls)
+	}
+	if !strings.Contains(provider.calls[1].FollowUpPrompt, PlayerCommitToolName) {
+		t.Fatalf("commit reminder = %q", provider.calls[1].FollowUpPrompt)
+	}
+	if !strings.Contains(provider.calls[3].FollowUpPrompt, playerYieldToolName) {
+		t.Fatalf("completion reminder = %q", provider.calls[3].FollowUpPrompt)
+	}
+}
+
+func TestPlayerTurnControllerTrustsYieldResult(t *testing.T) {
+	controller := NewPlayerTurnPolicy().Controller("")
+	controller.ObserveSuccessfulTool(playerYieldToolName, `{"phase_status":"PLAYERS","yielded":false}`)
+	if !controller.HasCommittedOrResolvedInteraction() {
+		t.Fatal("expected yield to count as a committed player action")
+	}
+	if controller.ReadyForCompletion() {
+		t.Fatal("expected unyielded slot to keep the turn open")
+	}
+	controller.ObserveSuccessfulTool(playerYieldToolName, `{"phase_status":"PLAYERS","yielded":true}`)
+	if !controller.ReadyForCompletion() {
+		t.Fatal("expected yielded slot to complete the turn")
+	}
+}
+
+func TestPlayerPromptExcludesGMContext(t *testing.T) {
+	builder := NewPromptBuilder(PromptBuilderConfig{
+		Collector: NewPlayerContextSourceRegistry(),
+		Renderer:  NewBriefPromptRenderer(BriefPromptRendererConfig{Policy: PlayerPromptRenderPolicy()}),
+	})
+	sess := &fakeSession{resources: baseSessionResources("p-ai", "scene-1")}
+	sess.resources["campaign://camp-1/artifacts/story.md"] = "The duke is the traitor."
+	sess.resources["campaign://camp-1/artifacts/memory.md"] = "GM secret notes"
+
+	prompt, err := builder.Build(context.Background(), sess, PromptInput{
+		CampaignID:    "camp-1",
+		SessionID:     "sess-1",
+		ParticipantID: "p-ai",
+	})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	for _, want := range []string{"You are an AI player at this table", "You are participant p-ai.", PlayerCommitToolName} {
+		if !strings.Contains(prompt, want) {
+			t.Fatalf("prompt missing %q:\n%s", want, prompt)
+		}
+	}
+	for _, leaked := range []string{"The duke is the traitor.", "GM secret notes", "interaction_record_scene_gm_interaction"} {
+		if strings.Contains(prompt, leaked) {
+			t.Fatalf("prompt leaked %q:\n%s", leaked, prompt)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/providergrant"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+// accountDataPageSize walks owner listings in the largest page stores accept.
+const accountDataPageSize = 50
+
+// AccountDataService gathers and erases one user's AI records for account
+// export and deletion. Exports describe records by metadata only: secrets,
+// token ciphertexts, and agent auth material never leave the service.
+type AccountDataService struct {
+	credentialStore    storage.CredentialStore
+	agentStore         storage.AgentStore
+	providerGrantStore storage.ProviderGrantStore
+	accessRequestStore storage.AccessRequestStore
+	userDataStore      storage.UserDataStore
+}
+
+// AccountDataServiceConfig declares dependencies for the account-data service.
+type AccountDataServiceConfig struct {
+	CredentialStore    storage.CredentialStore
+	AgentStore         storage.AgentStore
+	ProviderGrantStore storage.ProviderGrantStore
+	AccessRequestStore storage.AccessRequestStore
+	UserDataStore      storage.UserDataStore
+}
+
+// NewAccountDataService builds an account-data service from explicit deps.
+func NewAccountDataService(cfg AccountDataServiceConfig) (*AccountDataService, error) {
+	if cfg.CredentialStore == nil {
+		return nil, fmt.Errorf("ai: NewAccountDataService: credential store is required")
+	}
+	if cfg.AgentStore == nil {
+		return nil, fmt.Errorf("ai: NewAccountDataService: agent store is required")
+	}
+	if cfg.ProviderGrantStore == nil {
+		return nil, fmt.Errorf("ai: NewAccountDataService: provider grant store is required")
+	}
+	if cfg.AccessRequestStore == nil {
+		return nil, fmt.Errorf("ai: NewAccountDataService: access request store is required")
+	}
+	if cfg.UserDataStore == nil {
+		return nil, fmt.Errorf("ai: NewAccountDataService: user data store is required")
+	}
+	return &AccountDataService{
+		credentialStore:    cfg.CredentialStore,
+		agentStore:         cfg.AgentStore,
+		providerGrantStore: cfg.ProviderGrantStore,
+		accessRequestStore: cfg.AccessRequestStore,
+		userDataStore:      cfg.UserDataStore,
+	}, nil
+}
+
+// AccountDataExport is the AI section of an account export archive.
+type AccountDataExport struct {
+	Credentials    []ExportedCredential    `json:"credentials"`
+	Agents         []ExportedAgent         `json:"agents"`
+	ProviderGrants []ExportedProviderGrant `json:"provider_grants"`
+	AccessRequests []ExportedAccessRequest `json:"access_requests"`
+}
+
+// ExportedCredential describes one stored credential without its secret.
+type ExportedCredential struct {
+	ID        string     `json:"id"`
+	Provider  string     `json:"provider"`
+	Label     string     `json:"label"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// ExportedAgent describes one owned agent profile.
+type ExportedAgent struct {
+	ID           string    `json:"id"`
+	Label        string    `json:"label"`
+	Instructions string    `json:"instructions"`
+	Provider     string    `json:"provider"`
+	Model        string    `json:"model"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ExportedProviderGrant describes one provider grant without its token.
+type ExportedProviderGrant struct {
+	ID            string     `json:"id"`
+	Provider      string     `json:"provider"`
+	GrantedScopes []string   `json:"granted_scopes"`
+	Status        string     `json:"status"`
+	CreatedAt     time.Time  `json:"created_at"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+}
+
+// ExportedAccessRequest describes one access request the user filed.
+type ExportedAccessRequest struct {
+	ID          string    `json:"id"`
+	AgentID     string    `json:"agent_id"`
+	Scope       string    `json:"scope"`
+	RequestNote string    `json:"request_note"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Export gathers the user's AI records.
+func (s *AccountDataService) Export(ctx context.Context, userID string) (AccountDataExport, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return AccountDataExport{}, Errorf(ErrKindInvalidArgument, "user id is required")
+	}
+	export := AccountDataExport{
+		Credentials:    []ExportedCredential{},
+		Agents:         []ExportedAgent{},
+		ProviderGrants: []ExportedProviderGrant{},
+		AccessRequests: []ExportedAccessRequest{},
+	}
+
+	for pageToken := ""; ; {
+		page, err := s.credentialStore.ListCredentialsByOwner(ctx, userID, accountDataPageSize, pageToken)
+		if err != nil {
+			return AccountDataExport{}, Wrapf(ErrKindInternal, err, "list credentials")
+		}
+		for _, record := range page.Credentials {
+			export.Credentials = append(export.Credentials, ExportedCredential{
+				ID:        record.ID,
+				Provider:  string(record.Provider),
+				Label:     record.Label,
+				Status:    string(record.Status),
+				CreatedAt: record.CreatedAt,
+				RevokedAt: record.RevokedAt,
+			})
+		}
+		if pageToken = page.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	for pageToken := ""; ; {
+		page, err := s.agentStore.ListAgentsByOwner(ctx, userID, accountDataPageSize, pageToken)
+		if err != nil {
+			return AccountDataExport{}, Wrapf(ErrKindInternal, err, "list agents")
+		}
+		for _, record := range page.Agents {
+			export.Agents = append(export.Agents, ExportedAgent{
+				ID:           record.ID,
+				Label:        record.Label,
+				Instructions: record.Instructions,
+				Provider:     string(record.Provider),
+				Model:        record.Model,
+				Status:       string(record.Status),
+				CreatedAt:    record.CreatedAt,
+			})
+		}
+		if pageToken = page.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	for pageToken := ""; ; {
+		page, err := s.providerGrantStore.ListProviderGrantsByOwner(ctx, userID, accountDataPageSize, pageToken, providergrant.Filter{})
+		if err != nil {
+			return AccountDataExport{}, Wrapf(ErrKindInternal, err, "list provider grants")
+		}
+		for _, record := range page.ProviderGrants {
+			export.ProviderGrants = append(export.ProviderGrants, ExportedProviderGrant{
+				ID:            record.ID,
+				Provider:      string(record.Provider),
+				GrantedScopes: record.GrantedScopes,
+				Status:        string(record.Status),
+				CreatedAt:     record.CreatedAt,
+				RevokedAt:     record.RevokedAt,
+			})
+		}
+		if pageToken = page.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	for pageToken := ""; ; {
+		page, err := s.accessRequestStore.ListAccessRequestsByRequester(ctx, userID, accountDataPageSize, pageToken)
+		if err != nil {
+			return AccountDataExport{}, Wrapf(ErrKindInternal, err, "list access requests")
+		}
+		for _, record := range page.AccessRequests {
+			export.AccessRequests = append(export.AccessRequests, ExportedAccessRequest{
+				ID:          record.ID,
+				AgentID:     record.AgentID,
+				Scope:       string(record.Scope),
+				RequestNote: record.RequestNote,
+				Status:      string(record.Status),
+				CreatedAt:   record.CreatedAt,
+			})
+		}
+		if pageToken = page.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	return export, nil
+}
+
+// Delete erases every AI record the user owns or requested. Repeated calls
+// succeed and report zero removed records.
+func (s *AccountDataService) Delete(ctx context.Context, userID string) (int, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return 0, Errorf(ErrKindInvalidArgument, "user id is required")
+	}
+	deleted, err := s.userDataStore.DeleteUserData(ctx, userID)
+	if err != nil {
+		return 0, Wrapf(ErrKindInternal, err, "delete user data")
+	}
+	return deleted, nil
+}
@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/agent"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/debugtrace"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/gamebridge"
@@ -25,6 +27,7 @@ type CampaignOrchestrationService struct {
 	campaignAuthStateReader CampaignAuthStateReader
 	providerRegistry        *providercatalog.Registry
 	campaignTurnRunner      orchestration.CampaignTurnRunner
+	playerTurnRunner        orchestration.CampaignTurnRunner
 	turnMemorySync          func(context.Context, TurnMemorySyncInput) error
 	debugTraceStore         debugtrace.Store
 	debugUpdateBroker       *CampaignDebugUpdateBroker
@@ -43,14 +46,17 @@ type CampaignOrchestrationServiceConfig struct {
 	CampaignAuthStateReader CampaignAuthStateReader
 	ProviderRegistry        *providercatalog.Registry
 	CampaignTurnRunner      orchestration.CampaignTurnRunner
-	TurnMemorySync          func(context.Context, TurnMemorySyncInput) error
-	DebugTraceStore         debugtrace.Store
-	DebugUpdateBroker       *CampaignDebugUpdateBroker
-	SessionGrantConfig      *aisessiongrant.Config
-	AuthMaterialResolver    *AuthMaterialResolver
-	Clock                   Clock
-	IDGenerator             IDGenerator
-	Logger                  *slog.Logger
+	// PlayerTurnRunner runs turns for AI-controlled player participants with
+	// the player tool profile and prompt.
+	PlayerTurnRunner     orchestration.CampaignTurnRunner
+	TurnMemorySync       func(context.Context, TurnMemorySyncInput) error
+	DebugTraceStore      debugtrace.Store
+	DebugUpdateBroker    *CampaignDebugUpdateBroker
+	SessionGrantConfig   *aisessiongrant.Config
+	AuthMaterialResolver *AuthMaterialResolver
+	Clock                Clock
+	IDGenerator          IDGenerator
+	Logger               *slog.Logger
 }
 
 // NewCampaignOrchestrationService builds a campaign orchestration service from
@@ -78,6 +84,7 @@ func NewCampaignOrchestrationService(cfg CampaignOrchestrationServiceConfig) (*C
 		campaignAuthStateReader: cfg.CampaignAuthStateReader,
 		providerRegistry:        cfg.ProviderRegistry,
 		campaignTurnRunner:      cfg.CampaignTurnRunner,
+		playerTurnRunner:        cfg.PlayerTurnRunner,
 		turnMemorySync:          cfg.TurnMemorySync,
 		debugTraceStore:         cfg.DebugTraceStore,
 		debugUpdateBroker:       cfg.DebugUpdateBroker,
@@ -134,60 +141,17 @@ func (s *CampaignOrchestrationService) RunCampaignTurn(ctx context.Context, inpu
 		return RunCampaignTurnResult{}, Errorf(ErrKindInvalidArgument, "session_grant is required")
 	}
 
-	claims, err := aisessiongrant.Validate(*s.sessionGrantConfig, input.SessionGrant)
+	rt, err := s.loadTurnRuntime(ctx, input.SessionGrant, staleGrant)
 	if err != nil {
-		switch {
-		case errors.Is(err, aisessiongrant.ErrExpired):
-			return RunCampaignTurnResult{}, Errorf(ErrKindPermissionDenied, "session grant is expired")
-		case errors.Is(err, aisessiongrant.ErrInvalid):
-			return RunCampaignTurnResult{}, Errorf(ErrKindPermissionDenied, "session grant is invalid")
-		default:
-			return RunCampaignTurnResult{}, Wrapf(ErrKindInternal, err, "validate session grant")
-		}
-	}
-
-	state, err := s.campaignAuthStateReader.CampaignAuthState(ctx, claims.CampaignID)
-	if err != nil {
-		if errors.Is(err, gamebridge.ErrCampaignAuthStateUnavailable) {
-			return RunCampaignTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign ai auth state client is unavailable")
-		}
-		return RunCampaignTurnResult{}, Wrapf(ErrKindInternal, err, "get campaign ai auth state")
-	}
-	if staleGrant(claims, state) {
-		return RunCampaignTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign ai session grant is stale")
-	}
-
-	agentID := strings.TrimSpace(state.GetAiAgentId())
-	if agentID == "" {
-		return RunCampaignTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign ai runtime is unavailable")
-	}
-
-	agentRecord, err := s.agentStore.GetAgent(ctx, agentID)
-	if err != nil {
-		if errors.Is(err, storage.ErrNotFound) {
-			return RunCampaignTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign ai runtime is unavailable")
-		}
-		return RunCampaignTurnResult{}, Wrapf(ErrKindInternal, err, "get campaign ai runtime")
-	}
-	if !agentRecord.Status.IsActive() {
-		return RunCampaignTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign ai runtime is inactive")
+		return RunCampaignTurnResult{}, err
 	}
+	claims, state, agentRecord := rt.claims, rt.state, rt.agent
 	if s.campaignArtifactManager != nil {
 		if _, err := s.campaignArtifactManager.EnsureDefaultArtifacts(ctx, claims.CampaignID, ""); err != nil {
 			return RunCampaignTurnResult{}, Wrapf(ErrKindInternal, err, "ensure campaign artifacts")
 		}
 	}
 
-	adapter, ok := s.providerRegistry.ToolAdapter(agentRecord.Provider)
-	if !ok || adapter == nil {
-		return RunCampaignTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign ai provider adapter is unavailable")
-	}
-
-	token, err := s.authMaterialResolver.ResolveAgentInvokeToken(ctx, agentRecord.OwnerUserID, agentRecord)
-	if err != nil {
-		return RunCampaignTurnResult{}, err
-	}
-
 	now := s.clock().UTC()
 	traceRecorder := newCampaignDebugTraceRecorder(ctx, s.debugTraceStore, s.clock, s.debugUpdateBroker, s.idGenerator, s.logger, debugtrace.Turn{
 		CampaignID:    claims.CampaignID,
@@ -200,6 +164,13 @@ func (s *CampaignOrchestrationService) RunCampaignTurn(ctx context.Context, inpu
 		UpdatedAt:     now,
 	})
 
+	// Artifact writes made by tools during this turn are attributed to it.
+	turnID := traceRecorder.TurnID()
+	if turnID == "" {
+		turnID = strings.TrimSpace(input.TurnToken)
+	}
+	ctx = campaignartifact.WithAuthor(ctx, campaignartifact.Author{Kind: campaignartifact.AuthorKindAITurn, ID: turnID})
+
 	result, err := s.campaignTurnRunner.Run(ctx, orchestration.Input{
 		CampaignID:      claims.CampaignID,
 		SessionID:       claims.SessionID,
@@ -208,8 +179,8 @@ func (s *CampaignOrchestrationService) RunCampaignTurn(ctx context.Context, inpu
 		Model:           agentRecord.Model,
 		ReasoningEffort: input.ReasoningEffort,
 		Instructions:    agentRecord.Instructions,
-		AuthToken:       token,
-		Provider:        adapter,
+		AuthToken:       rt.token,
+		Provider:        rt.adapter,
 		TraceRecorder:   traceRecorder,
 	})
 	if traceRecorder != nil {
@@ -244,6 +215,74 @@ func (s *CampaignOrchestrationService) RunCampaignTurn(ctx context.Context, inpu
 	}, nil
 }
 
+// campaignTurnRuntime is the validated grant plus the resolved agent runtime
+// shared by GM and player turns.
+type campaignTurnRuntime struct {
+	claims  aisessiongrant.Claims
+	state   *gamev1.GetCampaignAIAuthStateResponse
+	agent   agent.Agent
+	adapter orchestration.Provider
+	token   string
+}
+
+// loadTurnRuntime validates one session grant against the current campaign
+// auth state and resolves the bound agent, provider adapter, and invoke token.
+func (s *CampaignOrchestrationService) loadTurnRuntime(
+	ctx context.Context,
+	sessionGrant string,
+	stale func(aisessiongrant.Claims, *gamev1.GetCampaignAIAuthStateResponse) bool,
+) (campaignTurnRuntime, error) {
+	claims, err := aisessiongrant.Validate(*s.sessionGrantConfig, sessionGrant)
+	if err != nil {
+		switch {
+		case errors.Is(err, aisessiongrant.ErrExpired):
+			return campaignTurnRuntime{}, Errorf(ErrKindPermissionDenied, "session grant is expired")
+		case errors.Is(err, aisessiongrant.ErrInvalid):
+			return campaignTurnRuntime{}, Errorf(ErrKindPermissionDenied, "session grant is invalid")
+		default:
+			return campaignTurnRuntime{}, Wrapf(ErrKindInternal, err, "validate session grant")
+		}
+	}
+
+	state, err := s.campaignAuthStateReader.CampaignAuthState(ctx, claims.CampaignID)
+	if err != nil {
+		if errors.Is(err, gamebridge.ErrCampaignAuthStateUnavailable) {
+			return campaignTurnRuntime{}, Errorf(ErrKindFailedPrecondition, "campaign ai auth state client is unavailable")
+		}
+		return campaignTurnRuntime{}, Wrapf(ErrKindInternal, err, "get campaign ai auth state")
+	}
+	if stale(claims, state) {
+		return campaignTurnRuntime{}, Errorf(ErrKindFailedPrecondition, "campaign ai session grant is stale")
+	}
+
+	agentID := strings.TrimSpace(state.GetAiAgentId())
+	if agentID == "" {
+		return campaignTurnRuntime{}, Errorf(ErrKindFailedPrecondition, "campaign ai runtime is unavailable")
+	}
+
+	agentRecord, err := s.agentStore.GetAgent(ctx, agentID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return campaignTurnRuntime{}, Errorf(ErrKindFailedPrecondition, "campaign ai runtime is unavailable")
+		}
+		return campaignTurnRuntime{}, Wrapf(ErrKindInternal, err, "get campaign ai runtime")
+	}
+	if !agentRecord.Status.IsActive() {
+		return campaignTurnRuntime{}, Errorf(ErrKindFailedPrecondition, "campaign ai runtime is inactive")
+	}
+
+	adapter, ok := s.providerRegistry.ToolAdapter(agentRecord.Provider)
+	if !ok || adapter == nil {
+		return campaignTurnRuntime{}, Errorf(ErrKindFailedPrecondition, "campaign ai provider adapter is unavailable")
+	}
+
+	token, err := s.authMaterialResolver.ResolveAgentInvokeToken(ctx, agentRecord.OwnerUserID, agentRecord)
+	if err != nil {
+		return campaignTurnRuntime{}, err
+	}
+	return campaignTurnRuntime{claims: claims, state: state, agent: agentRecord, adapter: adapter, token: token}, nil
+}
+
 // staleGrant checks whether the session grant claims are stale relative to
 // the current campaign auth state.
 func staleGrant(claims aisessiongrant.Claims, state *gamev1.GetCampaignAIAuthStateResponse) bool {
@@ -8,6 +8,7 @@ import (
 
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/agent"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/credential"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/debugtrace"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
@@ -17,13 +18,15 @@ import (
 )
 
 type campaignTurnRunnerStub struct {
-	lastInput orchestration.Input
-	result    orchestration.Result
-	err       error
+	lastInput  orchestration.Input
+	lastAuthor campaignartifact.Author
+	result     orchestration.Result
+	err        error
 }
 
-func (s *campaignTurnRunnerStub) Run(_ context.Context, input orchestration.Input) (orchestration.Result, error) {
+func (s *campaignTurnRunnerStub) Run(ctx context.Context, input orchestration.Input) (orchestration.Result, error) {
 	s.lastInput = input
+	s.lastAuthor = campaignartifact.AuthorFromContext(ctx)
 	if s.err != nil {
 		return orchestration.Result{}, s.err
 	}
@@ -190,6 +193,9 @@ func TestCampaignOrchestrationServiceRunCampaignTurn(t *testing.T) {
 	if runner.lastInput.AuthToken != "token-1" || runner.lastInput.Input != "Start the scene." || runner.lastInput.TraceRecorder == nil {
 		t.Fatalf("runner input = %#v", runner.lastInput)
 	}
+	if runner.lastAuthor != (campaignartifact.Author{Kind: campaignartifact.AuthorKindAITurn, ID: "turn-1"}) {
+		t.Fatalf("artifact author = %#v, want ai turn turn-1", runner.lastAuthor)
+	}
 	if turn := traceStore.turns["turn-1"]; turn.Status != debugtrace.StatusSucceeded || turn.TurnToken != "turn-token-1" || turn.Provider != provider.OpenAI {
 		t.Fatalf("trace turn = %#v", turn)
 	}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/debugtrace"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/aisessiongrant"
+)
+
+// RunCampaignPlayerTurnInput is the domain input for one AI player turn.
+type RunCampaignPlayerTurnInput struct {
+	SessionGrant    string
+	ReasoningEffort string
+	TurnToken       string
+}
+
+// RunCampaignPlayerTurnResult is the domain result of one AI player turn.
+type RunCampaignPlayerTurnResult struct {
+	OutputText string
+	Provider   provider.Provider
+	Model      string
+	Usage      provider.Usage
+}
+
+// RunCampaignPlayerTurn validates a game-issued player session grant and runs
+// one turn for the AI-controlled player participant it names.
+//
+// Player turns use the campaign's bound agent but never write GM memory or
+// artifacts: the player runner only exposes player-safe tools.
+func (s *CampaignOrchestrationService) RunCampaignPlayerTurn(ctx context.Context, input RunCampaignPlayerTurnInput) (RunCampaignPlayerTurnResult, error) {
+	if s.playerTurnRunner == nil {
+		return RunCampaignPlayerTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign player turn runner is unavailable")
+	}
+	if s.sessionGrantConfig == nil {
+		return RunCampaignPlayerTurnResult{}, Errorf(ErrKindFailedPrecondition, "ai session grant validation is unavailable")
+	}
+	if s.campaignAuthStateReader == nil {
+		return RunCampaignPlayerTurnResult{}, Errorf(ErrKindFailedPrecondition, "campaign ai auth state client is unavailable")
+	}
+	if input.SessionGrant == "" {
+		return RunCampaignPlayerTurnResult{}, Errorf(ErrKindInvalidArgument, "session_grant is required")
+	}
+
+	rt, err := s.loadTurnRuntime(ctx, input.SessionGrant, stalePlayerGrant)
+	if err != nil {
+		return RunCampaignPlayerTurnResult{}, err
+	}
+	claims, agentRecord := rt.claims, rt.agent
+	participantID := strings.TrimSpace(claims.ParticipantID)
+
+	now := s.clock().UTC()
+	traceRecorder := newCampaignDebugTraceRecorder(ctx, s.debugTraceStore, s.clock, s.debugUpdateBroker, s.idGenerator, s.logger, debugtrace.Turn{
+		CampaignID:    claims.CampaignID,
+		SessionID:     claims.SessionID,
+		TurnToken:     strings.TrimSpace(input.TurnToken),
+		ParticipantID: participantID,
+		Provider:      agentRecord.Provider,
+		Model:         agentRecord.Model,
+		StartedAt:     now,
+		UpdatedAt:     now,
+	})
+
+	result, err := s.playerTurnRunner.Run(ctx, orchestration.Input{
+		CampaignID:      claims.CampaignID,
+		SessionID:       claims.SessionID,
+		ParticipantID:   participantID,
+		Model:           agentRecord.Model,
+		ReasoningEffort: input.ReasoningEffort,
+		AuthToken:       rt.token,
+		Provider:        rt.adapter,
+		TraceRecorder:   traceRecorder,
+	})
+	if traceRecorder != nil {
+		traceRecorder.Finish(ctx, err)
+	}
+	if err != nil {
+		// Return the raw orchestration error for the transport layer to map.
+		return RunCampaignPlayerTurnResult{}, err
+	}
+	if result.OutputText == "" {
+		return RunCampaignPlayerTurnResult{}, orchestration.ErrEmptyOutput
+	}
+	return RunCampaignPlayerTurnResult{
+		OutputText: result.OutputText,
+		Provider:   agentRecord.Provider,
+		Model:      agentRecord.Model,
+		Usage:      result.Usage,
+	}, nil
+}
+
+// stalePlayerGrant mirrors staleGrant for player grants, which name an AI
+// player participant instead of the campaign's GM seat.
+func stalePlayerGrant(claims aisessiongrant.Claims, state *gamev1.GetCampaignAIAuthStateResponse) bool {
+	if state == nil {
+		return true
+	}
+	participantID := strings.TrimSpace(claims.ParticipantID)
+	if participantID == "" || participantID == strings.TrimSpace(state.GetParticipantId()) {
+		return true
+	}
+	if strings.TrimSpace(state.GetCampaignId()) != strings.TrimSpace(claims.CampaignID) {
+		return true
+	}
+	if strings.TrimSpace(state.GetActiveSessionId()) != strings.TrimSpace(claims.SessionID) {
+		return true
+	}
+	return state.GetAuthEpoch() != claims.AuthEpoch
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/agent"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/credential"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/debugtrace"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/aisessiongrant"
+	"github.com/louisbranch/fracturing.space/internal/test/mock/aifakes"
+)
+
+func TestCampaignOrchestrationServiceRunCampaignPlayerTurn(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1711111111, 0).UTC()
+	grantConfig := aisessiongrant.Config{
+		Issuer:   "fracturing-space-game",
+		Audience: "fracturing-space-ai",
+		HMACKey:  []byte("12345678901234567890123456789012"),
+		TTL:      5 * time.Minute,
+		Now:      func() time.Time { return now },
+	}
+	issue := func(participantID string) string {
+		t.Helper()
+		grant, _, err := aisessiongrant.Issue(grantConfig, aisessiongrant.IssueInput{
+			GrantID:       "grant-" + participantID,
+			CampaignID:    "campaign-1",
+			SessionID:     "session-1",
+			ParticipantID: participantID,
+			AuthEpoch:     7,
+		})
+		if err != nil {
+			t.Fatalf("Issue session grant: %v", err)
+		}
+		return grant
+	}
+
+	credentialStore := aifakes.NewCredentialStore()
+	credentialStore.Credentials["cred-1"] = credential.Credential{
+		ID:               "cred-1",
+		OwnerUserID:      "user-1",
+		Provider:         provider.OpenAI,
+		Label:            "primary",
+		SecretCiphertext: "enc:token-1",
+		Status:           credential.StatusActive,
+	}
+	agentStore := aifakes.NewAgentStore()
+	agentStore.Agents["agent-1"] = agent.Agent{
+		ID:            "agent-1",
+		OwnerUserID:   "user-1",
+		Label:         "gm-runtime",
+		Instructions:  "Run the session.",
+		Provider:      provider.OpenAI,
+		Model:         "gpt-5.4",
+		AuthReference: agent.CredentialAuthReference("cred-1"),
+		Status:        agent.StatusActive,
+	}
+	gmRunner := &campaignTurnRunnerStub{result: orchestration.Result{OutputText: "gm"}}
+	playerRunner := &campaignTurnRunnerStub{result: orchestration.Result{
+		OutputText: "I follow the scout.",
+		Usage:      provider.Usage{TotalTokens: 9},
+	}}
+	traceStore := newDebugTraceStoreStub()
+	syncCalls := 0
+	svc, err := NewCampaignOrchestrationService(CampaignOrchestrationServiceConfig{
+		AgentStore:              agentStore,
+		CampaignAuthStateReader: &campaignAuthStateReaderStub{authState: matchingCampaignAIAuthState()},
+		ProviderRegistry:        mustProviderRegistryForTests(t, nil, nil, nil, map[provider.Provider]orchestration.Provider{provider.OpenAI: providerAdapterStub{}}),
+		CampaignTurnRunner:      gmRunner,
+		PlayerTurnRunner:        playerRunner,
+		TurnMemorySync: func(context.Context, TurnMemorySyncInput) error {
+			syncCalls++
+			return nil
+		},
+		DebugTraceStore:    traceStore,
+		SessionGrantConfig: &grantConfig,
+		AuthMaterialResolver: NewAuthMaterialResolver(AuthMaterialResolverConfig{
+			CredentialStore: credentialStore,
+			Sealer:          &aifakes.Sealer{},
+		}),
+		Clock:       func() time.Time { return now },
+		IDGenerator: func() (string, error) { return "turn-1", nil },
+	})
+	if err != nil {
+		t.Fatalf("NewCampaignOrchestrationService: %v", err)
+	}
+
+	result, err := svc.RunCampaignPlayerTurn(context.Background(), RunCampaignPlayerTurnInput{
+		SessionGrant: issue("companion-1"),
+		TurnToken:    "turn-token-1",
+	})
+	if err != nil {
+		t.Fatalf("RunCampaignPlayerTurn: %v", err)
+	}
+	if result.OutputText != "I follow the scout." || result.Model != "gpt-5.4" || result.Usage.TotalTokens != 9 {
+		t.Fatalf("result = %#v", result)
+	}
+	if playerRunner.lastInput.ParticipantID != "companion-1" || playerRunner.lastInput.AuthToken != "token-1" {
+		t.Fatalf("player runner input = %#v", playerRunner.lastInput)
+	}
+	if playerRunner.lastInput.Instructions != "" {
+		t.Fatalf("player runner instructions = %q, want GM instructions withheld", playerRunner.lastInput.Instructions)
+	}
+	if playerRunner.lastAuthor.Kind == campaignartifact.AuthorKindAITurn {
+		t.Fatalf("artifact author = %#v, want no ai turn author for player turns", playerRunner.lastAuthor)
+	}
+	if gmRunner.lastInput.CampaignID != "" {
+		t.Fatalf("gm runner should not run, got %#v", gmRunner.lastInput)
+	}
+	if syncCalls != 0 {
+		t.Fatalf("sync calls = %d, want 0 for player turns", syncCalls)
+	}
+	if turn := traceStore.turns["turn-1"]; turn.Status != debugtrace.StatusSucceeded || turn.ParticipantID != "companion-1" {
+		t.Fatalf("trace turn = %#v", turn)
+	}
+
+	if _, err := svc.RunCampaignPlayerTurn(context.Background(), RunCampaignPlayerTurnInput{
+		SessionGrant: issue("participant-1"),
+	}); ErrorKindOf(err) != ErrKindFailedPrecondition {
+		t.Fatalf("gm grant error = %v, want failed precondition", err)
+	}
+}
+
+func TestStalePlayerGrant(t *testing.T) {
+	t.Parallel()
+
+	claims := aisessiongrant.Claims{
+		CampaignID:    "campaign-1",
+		SessionID:     "session-1",
+		ParticipantID: "companion-1",
+		AuthEpoch:     7,
+	}
+	tests := []struct {
+		name   string
+		claims aisessiongrant.Claims
+		state  *gamev1.GetCampaignAIAuthStateResponse
+		want   bool
+	}{
+		{name: "nil state", claims: claims, want: true},
+		{name: "missing participant", claims: aisessiongrant.Claims{CampaignID: "campaign-1", SessionID: "session-1", AuthEpoch: 7}, state: matchingCampaignAIAuthState(), want: true},
+		{name: "gm participant", claims: aisessiongrant.Claims{CampaignID: "campaign-1", SessionID: "session-1", ParticipantID: "participant-1", AuthEpoch: 7}, state: matchingCampaignAIAuthState(), want: true},
+		{name: "session mismatch", claims: claims, state: &gamev1.GetCampaignAIAuthStateResponse{CampaignId: "campaign-1", ActiveSessionId: "session-2", ParticipantId: "participant-1", AuthEpoch: 7}, want: true},
+		{name: "epoch mismatch", claims: claims, state: &gamev1.GetCampaignAIAuthStateResponse{CampaignId: "campaign-1", ActiveSessionId: "session-1", ParticipantId: "participant-1", AuthEpoch: 8}, want: true},
+		{name: "matching state", claims: claims, state: matchingCampaignAIAuthState(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stalePlayerGrant(tt.claims, tt.state); got != tt.want {
+				t.Fatalf("stalePlayerGrant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
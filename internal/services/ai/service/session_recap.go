@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/gamebridge"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/providercatalog"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/sessionrecap"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+// sessionRecapInstructions frames the drafting call. The agent's own
+// instructions are appended so the recap keeps the campaign's GM voice.
+const sessionRecapInstructions = `You are drafting a recap of one tabletop roleplaying session for its players.
+Write concise Markdown: a short title line, a few paragraphs retelling what happened in story order, and a bullet list of open threads.
+Use character names, never participant or account identifiers. Only describe what the source material shows; do not invent outcomes.
+Reply with the recap Markdown only.`
+
+// SessionRecapService drafts session recaps with a campaign's bound agent and
+// keeps them pending until the GM publishes them.
+type SessionRecapService struct {
+	draftStore              sessionrecap.Store
+	agentStore              storage.AgentStore
+	campaignAuthStateReader CampaignAuthStateReader
+	providerRegistry        *providercatalog.Registry
+	authMaterialResolver    *AuthMaterialResolver
+	clock                   Clock
+}
+
+// SessionRecapServiceConfig declares dependencies for the session recap service.
+type SessionRecapServiceConfig struct {
+	DraftStore              sessionrecap.Store
+	AgentStore              storage.AgentStore
+	CampaignAuthStateReader CampaignAuthStateReader
+	ProviderRegistry        *providercatalog.Registry
+	AuthMaterialResolver    *AuthMaterialResolver
+	Clock                   Clock
+}
+
+// NewSessionRecapService builds a session recap service from explicit deps.
+func NewSessionRecapService(cfg SessionRecapServiceConfig) (*SessionRecapService, error) {
+	if cfg.DraftStore == nil {
+		return nil, fmt.Errorf("ai: NewSessionRecapService: draft store is required")
+	}
+	if cfg.AgentStore == nil {
+		return nil, fmt.Errorf("ai: NewSessionRecapService: agent store is required")
+	}
+	if err := RequireAuthMaterialResolver(cfg.AuthMaterialResolver, "NewSessionRecapService"); err != nil {
+		return nil, err
+	}
+	if err := RequireProviderRegistry(cfg.ProviderRegistry, "NewSessionRecapService"); err != nil {
+		return nil, err
+	}
+	return &SessionRecapService{
+		draftStore:              cfg.DraftStore,
+		agentStore:              cfg.AgentStore,
+		campaignAuthStateReader: cfg.CampaignAuthStateReader,
+		providerRegistry:        cfg.ProviderRegistry,
+		authMaterialResolver:    cfg.AuthMaterialResolver,
+		clock:                   withDefaultClock(cfg.Clock),
+	}, nil
+}
+
+// DraftSessionRecapInput is the domain input for drafting one session recap.
+type DraftSessionRecapInput struct {
+	CampaignID     string
+	SessionID      string
+	SourceMarkdown string
+}
+
+// DraftSessionRecap asks the campaign's bound agent to draft a recap from the
+// collected session sources and stores it as pending.
+//
+// Drafting is idempotent per session: an existing draft is returned unchanged
+// so retried jobs never overwrite GM edits or re-bill the provider.
+func (s *SessionRecapService) DraftSessionRecap(ctx context.Context, input DraftSessionRecapInput) (sessionrecap.Draft, error) {
+	campaignID := strings.TrimSpace(input.CampaignID)
+	sessionID := strings.TrimSpace(input.SessionID)
+	if campaignID == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindInvalidArgument, "campaign_id is required")
+	}
+	if sessionID == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindInvalidArgument, "session_id is required")
+	}
+	source := strings.TrimSpace(input.SourceMarkdown)
+	if source == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindInvalidArgument, "source_markdown is required")
+	}
+
+	existing, err := s.draftStore.GetSessionRecapDraft(ctx, campaignID, sessionID)
+	switch {
+	case err == nil:
+		return existing, nil
+	case !errors.Is(err, storage.ErrNotFound):
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "get session recap draft")
+	}
+
+	if s.campaignAuthStateReader == nil {
+		return sessionrecap.Draft{}, Errorf(ErrKindFailedPrecondition, "campaign ai auth state client is unavailable")
+	}
+	state, err := s.campaignAuthStateReader.CampaignAuthState(ctx, campaignID)
+	if err != nil {
+		if errors.Is(err, gamebridge.ErrCampaignAuthStateUnavailable) {
+			return sessionrecap.Draft{}, Errorf(ErrKindFailedPrecondition, "campaign ai auth state client is unavailable")
+		}
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "get campaign ai auth state")
+	}
+	agentID := strings.TrimSpace(state.GetAiAgentId())
+	if agentID == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindFailedPrecondition, "campaign has no bound ai agent")
+	}
+	agentRecord, err := s.agentStore.GetAgent(ctx, agentID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return sessionrecap.Draft{}, Errorf(ErrKindFailedPrecondition, "campaign ai agent is unavailable")
+		}
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "get campaign ai agent")
+	}
+	if !agentRecord.Status.IsActive() {
+		return sessionrecap.Draft{}, Errorf(ErrKindFailedPrecondition, "campaign ai agent is inactive")
+	}
+	adapter, ok := s.providerRegistry.InvocationAdapter(agentRecord.Provider)
+	if !ok || adapter == nil {
+		return sessionrecap.Draft{}, Errorf(ErrKindFailedPrecondition, "provider invocation adapter is unavailable")
+	}
+	token, err := s.authMaterialResolver.ResolveAgentInvokeToken(ctx, agentRecord.OwnerUserID, agentRecord)
+	if err != nil {
+		return sessionrecap.Draft{}, err
+	}
+
+	instructions := sessionRecapInstructions
+	if extra := strings.TrimSpace(agentRecord.Instructions); extra != "" {
+		instructions += "\n\n" + extra
+	}
+	result, err := adapter.Invoke(ctx, provider.InvokeInput{
+		Model:        agentRecord.Model,
+		Input:        source,
+		Instructions: instructions,
+		AuthToken:    token,
+	})
+	if err != nil {
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "invoke provider")
+	}
+	markdown := strings.TrimSpace(result.OutputText)
+	if markdown == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindInternal, "provider returned empty output")
+	}
+
+	now := s.clock().UTC()
+	draft := sessionrecap.Draft{
+		CampaignID: campaignID,
+		SessionID:  sessionID,
+		Status:     sessionrecap.StatusPending,
+		Markdown:   markdown,
+		AgentID:    agentRecord.ID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.draftStore.PutSessionRecapDraft(ctx, draft); err != nil {
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "put session recap draft")
+	}
+	return draft, nil
+}
+
+// GetSessionRecapDraft returns the recap draft for one session.
+func (s *SessionRecapService) GetSessionRecapDraft(ctx context.Context, campaignID string, sessionID string) (sessionrecap.Draft, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	sessionID = strings.TrimSpace(sessionID)
+	if campaignID == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindInvalidArgument, "campaign_id is required")
+	}
+	if sessionID == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindInvalidArgument, "session_id is required")
+	}
+	draft, err := s.draftStore.GetSessionRecapDraft(ctx, campaignID, sessionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return sessionrecap.Draft{}, Errorf(ErrKindNotFound, "session recap draft not found")
+		}
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "get session recap draft")
+	}
+	return draft, nil
+}
+
+// UpdateSessionRecapDraft replaces the markdown of a pending draft.
+func (s *SessionRecapService) UpdateSessionRecapDraft(ctx context.Context, campaignID string, sessionID string, markdown string) (sessionrecap.Draft, error) {
+	markdown = strings.TrimSpace(markdown)
+	if markdown == "" {
+		return sessionrecap.Draft{}, Errorf(ErrKindInvalidArgument, "markdown is required")
+	}
+	draft, err := s.GetSessionRecapDraft(ctx, campaignID, sessionID)
+	if err != nil {
+		return sessionrecap.Draft{}, err
+	}
+	if draft.Status != sessionrecap.StatusPending {
+		return sessionrecap.Draft{}, Errorf(ErrKindFailedPrecondition, "session recap draft is already published")
+	}
+	draft.Markdown = markdown
+	draft.UpdatedAt = s.clock().UTC()
+	if err := s.draftStore.PutSessionRecapDraft(ctx, draft); err != nil {
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "put session recap draft")
+	}
+	return draft, nil
+}
+
+// MarkSessionRecapDraftPublished closes a draft once its markdown has been
+// recorded on the session. Marking an already published draft is a no-op.
+func (s *SessionRecapService) MarkSessionRecapDraftPublished(ctx context.Context, campaignID string, sessionID string) (sessionrecap.Draft, error) {
+	draft, err := s.GetSessionRecapDraft(ctx, campaignID, sessionID)
+	if err != nil {
+		return sessionrecap.Draft{}, err
+	}
+	if draft.Status == sessionrecap.StatusPublished {
+		return draft, nil
+	}
+	now := s.clock().UTC()
+	draft.Status = sessionrecap.StatusPublished
+	draft.UpdatedAt = now
+	draft.PublishedAt = &now
+	if err := s.draftStore.PutSessionRecapDraft(ctx, draft); err != nil {
+		return sessionrecap.Draft{}, Wrapf(ErrKindInternal, err, "put session recap draft")
+	}
+	return draft, nil
+}
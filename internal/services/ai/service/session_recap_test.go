@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/agent"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/credential"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/sessionrecap"
+	"github.com/louisbranch/fracturing.space/internal/test/mock/aifakes"
+)
+
+func newSessionRecapServiceForTest(t *testing.T, adapter *fakeInvocationAdapter, now time.Time) (*SessionRecapService, *aifakes.SessionRecapDraftStore) {
+	t.Helper()
+
+	agentStore := aifakes.NewAgentStore()
+	agentStore.Agents["agent-1"] = agent.Agent{
+		ID:            "agent-1",
+		OwnerUserID:   "user-1",
+		Provider:      provider.OpenAI,
+		Model:         "gpt-4o-mini",
+		Instructions:  "Keep it grim.",
+		AuthReference: agent.CredentialAuthReference("cred-1"),
+		Status:        agent.StatusActive,
+	}
+	credentialStore := aifakes.NewCredentialStore()
+	credentialStore.Credentials["cred-1"] = credential.Credential{
+		ID:               "cred-1",
+		OwnerUserID:      "user-1",
+		Provider:         provider.OpenAI,
+		SecretCiphertext: "enc:sk-1",
+		Status:           credential.StatusActive,
+	}
+	sealer := &aifakes.Sealer{}
+	registry := mustProviderRegistryForTests(t, nil, map[provider.Provider]provider.InvocationAdapter{provider.OpenAI: adapter}, nil, nil)
+	resolver := NewAuthMaterialResolver(AuthMaterialResolverConfig{
+		CredentialStore: credentialStore,
+		Sealer:          sealer,
+		ProviderGrantRuntime: NewProviderGrantRuntime(ProviderGrantRuntimeConfig{
+			ProviderGrantStore: providerGrantStoreForConfig(nil),
+			ProviderRegistry:   registry,
+			Sealer:             sealer,
+		}),
+	})
+	drafts := aifakes.NewSessionRecapDraftStore()
+	svc, err := NewSessionRecapService(SessionRecapServiceConfig{
+		DraftStore: drafts,
+		AgentStore: agentStore,
+		CampaignAuthStateReader: &campaignAuthStateReaderStub{authState: &gamev1.GetCampaignAIAuthStateResponse{
+			CampaignId: "camp-1",
+			AiAgentId:  "agent-1",
+		}},
+		ProviderRegistry:     registry,
+		AuthMaterialResolver: resolver,
+		Clock:                func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("NewSessionRecapService() error = %v", err)
+	}
+	return svc, drafts
+}
+
+func TestDraftSessionRecapInvokesBoundAgentOnce(t *testing.T) {
+	now := time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC)
+	adapter := &fakeInvocationAdapter{invokeResult: provider.InvokeResult{OutputText: "  # The Bridge\n\nThey crossed it.  "}}
+	svc, drafts := newSessionRecapServiceForTest(t, adapter, now)
+
+	draft, err := svc.DraftSessionRecap(context.Background(), DraftSessionRecapInput{
+		CampaignID:     "camp-1",
+		SessionID:      "sess-1",
+		SourceMarkdown: "## Events\n- scene ended",
+	})
+	if err != nil {
+		t.Fatalf("DraftSessionRecap() error = %v", err)
+	}
+	if draft.Status != sessionrecap.StatusPending || draft.Markdown != "# The Bridge\n\nThey crossed it." || draft.AgentID != "agent-1" {
+		t.Fatalf("draft = %+v", draft)
+	}
+	if adapter.lastInput.AuthToken != "sk-1" || adapter.lastInput.Input != "## Events\n- scene ended" {
+		t.Fatalf("invoke input = %+v", adapter.lastInput)
+	}
+	if !strings.HasSuffix(adapter.lastInput.Instructions, "Keep it grim.") {
+		t.Fatalf("instructions = %q, want agent instructions appended", adapter.lastInput.Instructions)
+	}
+
+	adapter.invokeResult.OutputText = "replacement"
+	again, err := svc.DraftSessionRecap(context.Background(), DraftSessionRecapInput{
+		CampaignID:     "camp-1",
+		SessionID:      "sess-1",
+		SourceMarkdown: "## Events",
+	})
+	if err != nil {
+		t.Fatalf("DraftSessionRecap(retry) error = %v", err)
+	}
+	if again.Markdown != draft.Markdown || len(drafts.Drafts) != 1 {
+		t.Fatalf("retry draft = %+v, want existing draft kept", again)
+	}
+}
+
+func TestDraftSessionRecapRequiresBoundAgent(t *testing.T) {
+	now := time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC)
+	svc, _ := newSessionRecapServiceForTest(t, &fakeInvocationAdapter{}, now)
+	svc.campaignAuthStateReader = &campaignAuthStateReaderStub{authState: &gamev1.GetCampaignAIAuthStateResponse{CampaignId: "camp-1"}}
+
+	_, err := svc.DraftSessionRecap(context.Background(), DraftSessionRecapInput{
+		CampaignID:     "camp-1",
+		SessionID:      "sess-1",
+		SourceMarkdown: "## Events",
+	})
+	if got := ErrorKindOf(err); got != ErrKindFailedPrecondition {
+		t.Fatalf("ErrorKindOf(err) = %v, want %v", got, ErrKindFailedPrecondition)
+	}
+}
+
+func TestSessionRecapDraftEditAndPublish(t *testing.T) {
+	now := time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC)
+	svc, drafts := newSessionRecapServiceForTest(t, &fakeInvocationAdapter{}, now)
+	drafts.Drafts[aifakes.SessionRecapDraftKey("camp-1", "sess-1")] = sessionrecap.Draft{
+		CampaignID: "camp-1",
+		SessionID:  "sess-1",
+		Status:     sessionrecap.StatusPending,
+		Markdown:   "draft",
+		CreatedAt:  now.Add(-time.Hour),
+		UpdatedAt:  now.Add(-time.Hour),
+	}
+
+	updated, err := svc.UpdateSessionRecapDraft(context.Background(), "camp-1", "sess-1", " edited ")
+	if err != nil {
+		t.Fatalf("UpdateSessionRecapDraft() error = %v", err)
+	}
+	if updated.Markdown != "edited" || !updated.UpdatedAt.Equal(now) {
+		t.Fatalf("updated = %+v", updated)
+	}
+
+	published, err := svc.MarkSessionRecapDraftPublished(context.Background(), "camp-1", "sess-1")
+	if err != nil {
+		t.Fatalf("MarkSessionRecapDraftPublished() error = %v", err)
+	}
+	if published.Status != sessionrecap.StatusPublished || published.PublishedAt == nil {
+		t.Fatalf("published = %+v", published)
+	}
+
+	_, err = svc.UpdateSessionRecapDraft(context.Background(), "camp-1", "sess-1", "late edit")
+	if got := ErrorKindOf(err); got != ErrKindFailedPrecondition {
+		t.Fatalf("ErrorKindOf(err) = %v, want %v", got, ErrKindFailedPrecondition)
+	}
+
+	_, err = svc.GetSessionRecapDraft(context.Background(), "camp-1", "missing")
+	if got := ErrorKindOf(err); got != ErrKindNotFound {
+		t.Fatalf("ErrorKindOf(err) = %v, want %v", got, ErrKindNotFound)
+	}
+}
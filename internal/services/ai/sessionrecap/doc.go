@@ -0,0 +1,3 @@
+// Package sessionrecap owns AI-drafted session recap vocabulary and the
+// storage seam for drafts awaiting GM review.
+package sessionrecap
@@ -0,0 +1,35 @@
+package sessionrecap
+
+import (
+	"context"
+	"time"
+)
+
+// Status is the review state of one recap draft.
+type Status string
+
+const (
+	// StatusPending marks a draft the GM has not yet published.
+	StatusPending Status = "pending"
+	// StatusPublished marks a draft whose markdown was recorded on the
+	// session. Published drafts are immutable.
+	StatusPublished Status = "published"
+)
+
+// Draft is one AI-drafted recap for a campaign session.
+type Draft struct {
+	CampaignID  string
+	SessionID   string
+	Status      Status
+	Markdown    string
+	AgentID     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt *time.Time
+}
+
+// Store persists recap drafts keyed by campaign and session.
+type Store interface {
+	PutSessionRecapDraft(ctx context.Context, draft Draft) error
+	GetSessionRecapDraft(ctx context.Context, campaignID string, sessionID string) (Draft, error)
+}
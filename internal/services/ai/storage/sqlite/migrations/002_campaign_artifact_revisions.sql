@@ -0,0 +1,21 @@
+-- Immutable per-write history for campaign artifacts.
+
+CREATE TABLE ai_campaign_artifact_revisions (
+	campaign_id TEXT NOT NULL,
+	path TEXT NOT NULL,
+	revision INTEGER NOT NULL,
+	content TEXT NOT NULL,
+	author_kind TEXT NOT NULL,
+	author_id TEXT NOT NULL DEFAULT '',
+	size_bytes INTEGER NOT NULL,
+	created_at INTEGER NOT NULL,
+	PRIMARY KEY (campaign_id, path, revision)
+);
+
+-- Seed revision 1 from existing artifacts so pre-upgrade content stays in
+-- history and remains restorable after the first post-upgrade write.
+INSERT INTO ai_campaign_artifact_revisions (
+	campaign_id, path, revision, content, author_kind, author_id, size_bytes, created_at
+)
+SELECT campaign_id, path, 1, content, 'system', '', length(CAST(content AS BLOB)), updated_at
+FROM ai_campaign_artifacts;
@@ -0,0 +1,13 @@
+-- AI-drafted session recaps awaiting GM review.
+
+CREATE TABLE ai_session_recap_drafts (
+	campaign_id TEXT NOT NULL,
+	session_id TEXT NOT NULL,
+	status TEXT NOT NULL,
+	markdown TEXT NOT NULL,
+	agent_id TEXT NOT NULL DEFAULT '',
+	created_at INTEGER NOT NULL,
+	updated_at INTEGER NOT NULL,
+	published_at INTEGER,
+	PRIMARY KEY (campaign_id, session_id)
+);
@@ -0,0 +1,188 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+// PutCampaignArtifactRevision persists one artifact snapshot and appends the
+// next revision for its path in the same transaction.
+func (s *Store) PutCampaignArtifactRevision(ctx context.Context, record campaignartifact.Artifact, author campaignartifact.Author) (campaignartifact.Revision, error) {
+	if err := ctx.Err(); err != nil {
+		return campaignartifact.Revision{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return campaignartifact.Revision{}, fmt.Errorf("storage is not configured")
+	}
+	if record.CampaignID == "" {
+		return campaignartifact.Revision{}, fmt.Errorf("campaign id is required")
+	}
+	if record.Path == "" {
+		return campaignartifact.Revision{}, fmt.Errorf("artifact path is required")
+	}
+	author = author.Normalize()
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return campaignartifact.Revision{}, fmt.Errorf("begin campaign artifact revision tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO ai_campaign_artifacts (
+	campaign_id, path, content, read_only, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, path) DO UPDATE SET
+	content = excluded.content,
+	read_only = excluded.read_only,
+	updated_at = excluded.updated_at
+`,
+		record.CampaignID,
+		record.Path,
+		record.Content,
+		record.ReadOnly,
+		sqliteutil.ToMillis(record.CreatedAt),
+		sqliteutil.ToMillis(record.UpdatedAt),
+	); err != nil {
+		return campaignartifact.Revision{}, fmt.Errorf("put campaign artifact: %w", err)
+	}
+
+	var latest int
+	if err := tx.QueryRowContext(ctx, `
+SELECT COALESCE(MAX(revision), 0)
+FROM ai_campaign_artifact_revisions
+WHERE campaign_id = ? AND path = ?
+`, record.CampaignID, record.Path).Scan(&latest); err != nil {
+		return campaignartifact.Revision{}, fmt.Errorf("read latest campaign artifact revision: %w", err)
+	}
+
+	revision := campaignartifact.Revision{
+		CampaignID: record.CampaignID,
+		Path:       record.Path,
+		Number:     latest + 1,
+		Content:    record.Content,
+		Author:     author,
+		SizeBytes:  len(record.Content),
+		CreatedAt:  record.UpdatedAt,
+	}
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO ai_campaign_artifact_revisions (
+	campaign_id, path, revision, content, author_kind, author_id, size_bytes, created_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`,
+		revision.CampaignID,
+		revision.Path,
+		revision.Number,
+		revision.Content,
+		string(revision.Author.Kind),
+		revision.Author.ID,
+		revision.SizeBytes,
+		sqliteutil.ToMillis(revision.CreatedAt),
+	); err != nil {
+		return campaignartifact.Revision{}, fmt.Errorf("append campaign artifact revision: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return campaignartifact.Revision{}, fmt.Errorf("commit campaign artifact revision: %w", err)
+	}
+	return revision, nil
+}
+
+// ListCampaignArtifactRevisions returns every revision of one artifact path,
+// newest first.
+func (s *Store) ListCampaignArtifactRevisions(ctx context.Context, campaignID string, path string) ([]campaignartifact.Revision, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+	if campaignID == "" {
+		return nil, fmt.Errorf("campaign id is required")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("artifact path is required")
+	}
+
+	rows, err := s.sqlDB.QueryContext(ctx, `
+SELECT campaign_id, path, revision, content, author_kind, author_id, size_bytes, created_at
+FROM ai_campaign_artifact_revisions
+WHERE campaign_id = ? AND path = ?
+ORDER BY revision DESC
+`, campaignID, path)
+	if err != nil {
+		return nil, fmt.Errorf("list campaign artifact revisions: %w", err)
+	}
+	defer rows.Close()
+
+	records := make([]campaignartifact.Revision, 0, 8)
+	for rows.Next() {
+		record, err := scanCampaignArtifactRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan campaign artifact revision row: %w", err)
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate campaign artifact revision rows: %w", err)
+	}
+	return records, nil
+}
+
+// GetCampaignArtifactRevision fetches one artifact revision by number.
+func (s *Store) GetCampaignArtifactRevision(ctx context.Context, campaignID string, path string, number int) (campaignartifact.Revision, error) {
+	if err := ctx.Err(); err != nil {
+		return campaignartifact.Revision{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return campaignartifact.Revision{}, fmt.Errorf("storage is not configured")
+	}
+	if campaignID == "" {
+		return campaignartifact.Revision{}, fmt.Errorf("campaign id is required")
+	}
+	if path == "" {
+		return campaignartifact.Revision{}, fmt.Errorf("artifact path is required")
+	}
+
+	row := s.sqlDB.QueryRowContext(ctx, `
+SELECT campaign_id, path, revision, content, author_kind, author_id, size_bytes, created_at
+FROM ai_campaign_artifact_revisions
+WHERE campaign_id = ? AND path = ? AND revision = ?
+`, campaignID, path, number)
+	record, err := scanCampaignArtifactRevision(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return campaignartifact.Revision{}, storage.ErrNotFound
+		}
+		return campaignartifact.Revision{}, fmt.Errorf("get campaign artifact revision: %w", err)
+	}
+	return record, nil
+}
+
+func scanCampaignArtifactRevision(row scanner) (campaignartifact.Revision, error) {
+	var (
+		record     campaignartifact.Revision
+		authorKind string
+		createdAt  int64
+	)
+	if err := row.Scan(
+		&record.CampaignID,
+		&record.Path,
+		&record.Number,
+		&record.Content,
+		&authorKind,
+		&record.Author.ID,
+		&record.SizeBytes,
+		&createdAt,
+	); err != nil {
+		return campaignartifact.Revision{}, err
+	}
+	record.Author.Kind = campaignartifact.AuthorKind(authorKind)
+	record.CreatedAt = sqliteutil.FromMillis(createdAt)
+	return record, nil
+}
@@ -3,11 +3,17 @@ package sqlite
 import (
 	"context"
 	"errors"
+	"io/fs"
+	"path/filepath"
 	"testing"
+	"testing/fstest"
 	"time"
 
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteconn"
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqlitemigrate"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
 	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage/sqlite/migrations"
 )
 
 func TestCampaignArtifactRoundTripAndList(t *testing.T) {
@@ -64,3 +70,124 @@ func TestGetCampaignArtifactReturnsNotFound(t *testing.T) {
 		t.Fatalf("GetCampaignArtifact() error = %v, want storage.ErrNotFound", err)
 	}
 }
+
+func TestCampaignArtifactRevisionsAppendInOrder(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 16, 2, 0, 0, 0, time.UTC)
+	record := campaignartifact.Artifact{
+		CampaignID: "campaign-1",
+		Path:       "memory.md",
+		Content:    "first",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	first, err := store.PutCampaignArtifactRevision(ctx, record, campaignartifact.Author{Kind: campaignartifact.AuthorKindAITurn, ID: "turn-1"})
+	if err != nil {
+		t.Fatalf("PutCampaignArtifactRevision(first) error = %v", err)
+	}
+	record.Content = "second body"
+	record.UpdatedAt = now.Add(time.Minute)
+	second, err := store.PutCampaignArtifactRevision(ctx, record, campaignartifact.Author{Kind: campaignartifact.AuthorKindUser, ID: "user-1"})
+	if err != nil {
+		t.Fatalf("PutCampaignArtifactRevision(second) error = %v", err)
+	}
+	if first.Number != 1 || second.Number != 2 {
+		t.Fatalf("revision numbers = %d, %d, want 1, 2", first.Number, second.Number)
+	}
+
+	current, err := store.GetCampaignArtifact(ctx, "campaign-1", "memory.md")
+	if err != nil {
+		t.Fatalf("GetCampaignArtifact() error = %v", err)
+	}
+	if current.Content != "second body" {
+		t.Fatalf("current content = %q, want second body", current.Content)
+	}
+
+	items, err := store.ListCampaignArtifactRevisions(ctx, "campaign-1", "memory.md")
+	if err != nil {
+		t.Fatalf("ListCampaignArtifactRevisions() error = %v", err)
+	}
+	if len(items) != 2 || items[0].Number != 2 || items[1].Number != 1 {
+		t.Fatalf("revisions = %+v, want newest first", items)
+	}
+	if items[0].Author.Kind != campaignartifact.AuthorKindUser || items[0].Author.ID != "user-1" || items[0].SizeBytes != len("second body") {
+		t.Fatalf("latest revision = %+v", items[0])
+	}
+	if !items[0].CreatedAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("latest created_at = %v", items[0].CreatedAt)
+	}
+
+	got, err := store.GetCampaignArtifactRevision(ctx, "campaign-1", "memory.md", 1)
+	if err != nil {
+		t.Fatalf("GetCampaignArtifactRevision() error = %v", err)
+	}
+	if got.Content != "first" || got.Author.ID != "turn-1" {
+		t.Fatalf("first revision = %+v", got)
+	}
+	if _, err := store.GetCampaignArtifactRevision(ctx, "campaign-1", "memory.md", 9); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("GetCampaignArtifactRevision(missing) error = %v, want storage.ErrNotFound", err)
+	}
+}
+
+func TestCampaignArtifactRevisionsMigrationSeedsExistingArtifacts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ai.db")
+	sqlDB, err := sqliteconn.Open(path)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	baseline, err := fs.ReadFile(migrations.FS, "001_ai.sql")
+	if err != nil {
+		t.Fatalf("read baseline migration: %v", err)
+	}
+	preRevisions := fstest.MapFS{"001_ai.sql": &fstest.MapFile{Data: baseline}}
+	if err := sqlitemigrate.ApplyMigrations(sqlDB, preRevisions, "", time.Now); err != nil {
+		t.Fatalf("apply baseline migration: %v", err)
+	}
+	updated := time.Date(2026, 2, 16, 2, 0, 0, 0, time.UTC)
+	if _, err := sqlDB.Exec(`
+INSERT INTO ai_campaign_artifacts (campaign_id, path, content, read_only, created_at, updated_at)
+VALUES ('campaign-1', 'memory.md', 'pre-upgrade notes', 0, ?, ?)
+`, updated.Add(-time.Hour).UnixMilli(), updated.UnixMilli()); err != nil {
+		t.Fatalf("insert pre-upgrade artifact: %v", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatalf("close sqlite: %v", err)
+	}
+
+	store, err := Open(path)
+	if err != nil {
+		t.Fatalf("open upgraded store: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := store.Close(); err != nil {
+			t.Fatalf("close store: %v", err)
+		}
+	})
+	ctx := context.Background()
+	seeded, err := store.GetCampaignArtifactRevision(ctx, "campaign-1", "memory.md", 1)
+	if err != nil {
+		t.Fatalf("GetCampaignArtifactRevision(seeded) error = %v", err)
+	}
+	if seeded.Content != "pre-upgrade notes" || seeded.Author.Kind != campaignartifact.AuthorKindSystem || seeded.SizeBytes != len("pre-upgrade notes") {
+		t.Fatalf("seeded revision = %+v", seeded)
+	}
+	if !seeded.CreatedAt.Equal(updated) {
+		t.Fatalf("seeded created_at = %v, want %v", seeded.CreatedAt, updated)
+	}
+
+	next, err := store.PutCampaignArtifactRevision(ctx, campaignartifact.Artifact{
+		CampaignID: "campaign-1",
+		Path:       "memory.md",
+		Content:    "post-upgrade notes",
+		CreatedAt:  updated,
+		UpdatedAt:  updated.Add(time.Minute),
+	}, campaignartifact.Author{Kind: campaignartifact.AuthorKindAITurn, ID: "turn-1"})
+	if err != nil {
+		t.Fatalf("PutCampaignArtifactRevision() error = %v", err)
+	}
+	if next.Number != 2 {
+		t.Fatalf("first post-upgrade revision = %d, want 2", next.Number)
+	}
+}
@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+// ListCredentialCiphertexts returns credential secret ciphertexts ordered by
+// credential ID, starting after afterID.
+func (s *Store) ListCredentialCiphertexts(ctx context.Context, afterID string, limit int) ([]storage.SealedValue, error) {
+	return s.listCiphertexts(ctx, `
+SELECT id, secret_ciphertext
+FROM ai_credentials
+WHERE id > ?
+ORDER BY id
+LIMIT ?
+`, "credential", afterID, limit)
+}
+
+// ReplaceCredentialCiphertext swaps one credential's secret ciphertext when it
+// still matches current. UpdatedAt is left alone because the sealed plaintext
+// is unchanged.
+func (s *Store) ReplaceCredentialCiphertext(ctx context.Context, credentialID string, current string, replacement string) error {
+	return s.replaceCiphertext(ctx, `
+UPDATE ai_credentials
+SET secret_ciphertext = ?
+WHERE id = ? AND secret_ciphertext = ?
+`, "credential", credentialID, current, replacement)
+}
+
+// ListProviderGrantCiphertexts returns provider grant token ciphertexts
+// ordered by grant ID, starting after afterID.
+func (s *Store) ListProviderGrantCiphertexts(ctx context.Context, afterID string, limit int) ([]storage.SealedValue, error) {
+	return s.listCiphertexts(ctx, `
+SELECT id, token_ciphertext
+FROM ai_provider_grants
+WHERE id > ?
+ORDER BY id
+LIMIT ?
+`, "provider grant", afterID, limit)
+}
+
+// ReplaceProviderGrantCiphertext swaps one provider grant's token ciphertext
+// when it still matches current.
+func (s *Store) ReplaceProviderGrantCiphertext(ctx context.Context, providerGrantID string, current string, replacement string) error {
+	return s.replaceCiphertext(ctx, `
+UPDATE ai_provider_grants
+SET token_ciphertext = ?
+WHERE id = ? AND token_ciphertext = ?
+`, "provider grant", providerGrantID, current, replacement)
+}
+
+func (s *Store) listCiphertexts(ctx context.Context, query string, label string, afterID string, limit int) ([]storage.SealedValue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	db, err := requireStoreDB(s)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than zero")
+	}
+
+	rows, err := db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list %s ciphertexts: %w", label, err)
+	}
+	defer rows.Close()
+
+	values := make([]storage.SealedValue, 0, limit)
+	for rows.Next() {
+		var value storage.SealedValue
+		if err := rows.Scan(&value.ID, &value.Ciphertext); err != nil {
+			return nil, fmt.Errorf("scan %s ciphertext: %w", label, err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s ciphertexts: %w", label, err)
+	}
+	return values, nil
+}
+
+func (s *Store) replaceCiphertext(ctx context.Context, query string, label string, id string, current string, replacement string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	db, err := requireStoreDB(s)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return fmt.Errorf("%s id is required", label)
+	}
+	if replacement == "" {
+		return fmt.Errorf("replacement ciphertext is required")
+	}
+
+	res, err := db.ExecContext(ctx, query, replacement, id, current)
+	if err != nil {
+		return fmt.Errorf("replace %s ciphertext: %w", label, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("replace %s ciphertext rows affected: %w", label, err)
+	}
+	if affected == 0 {
+		return storage.ErrConflict
+	}
+	return nil
+}
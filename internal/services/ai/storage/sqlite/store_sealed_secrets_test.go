@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/credential"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/providergrant"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+func TestCredentialCiphertextsPageAndReplace(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 15, 22, 45, 0, 0, time.UTC)
+
+	for _, c := range []credential.Credential{
+		{ID: "cred-1", OwnerUserID: "user-1", Provider: provider.OpenAI, Label: "A", SecretCiphertext: "enc:1", Status: credential.StatusActive, CreatedAt: now, UpdatedAt: now},
+		{ID: "cred-2", OwnerUserID: "user-2", Provider: provider.OpenAI, Label: "B", SecretCiphertext: "enc:2", Status: credential.StatusActive, CreatedAt: now, UpdatedAt: now},
+		{ID: "cred-3", OwnerUserID: "user-2", Provider: provider.OpenAI, Label: "C", SecretCiphertext: "enc:3", Status: credential.StatusRevoked, CreatedAt: now, UpdatedAt: now, RevokedAt: ptrTime(now)},
+	} {
+		if err := store.PutCredential(ctx, c); err != nil {
+			t.Fatalf("put credential %s: %v", c.ID, err)
+		}
+	}
+
+	first, err := store.ListCredentialCiphertexts(ctx, "", 2)
+	if err != nil {
+		t.Fatalf("list first page: %v", err)
+	}
+	if len(first) != 2 || first[0].ID != "cred-1" || first[1].Ciphertext != "enc:2" {
+		t.Fatalf("first page = %+v", first)
+	}
+	second, err := store.ListCredentialCiphertexts(ctx, first[1].ID, 2)
+	if err != nil {
+		t.Fatalf("list second page: %v", err)
+	}
+	if len(second) != 1 || second[0].ID != "cred-3" {
+		t.Fatalf("second page = %+v", second)
+	}
+
+	if err := store.ReplaceCredentialCiphertext(ctx, "cred-1", "enc:1", "v1:next:1"); err != nil {
+		t.Fatalf("replace ciphertext: %v", err)
+	}
+	got, err := store.GetCredential(ctx, "cred-1")
+	if err != nil {
+		t.Fatalf("get credential: %v", err)
+	}
+	if got.SecretCiphertext != "v1:next:1" || !got.UpdatedAt.Equal(now) {
+		t.Fatalf("credential after replace = %+v", got)
+	}
+	if err := store.ReplaceCredentialCiphertext(ctx, "cred-1", "enc:1", "v1:next:stale"); !errors.Is(err, storage.ErrConflict) {
+		t.Fatalf("stale replace error = %v, want %v", err, storage.ErrConflict)
+	}
+}
+
+func TestProviderGrantCiphertextsPageAndReplace(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 15, 22, 45, 0, 0, time.UTC)
+
+	for _, grant := range []providergrant.ProviderGrant{
+		{ID: "grant-1", OwnerUserID: "user-1", Provider: provider.OpenAI, TokenCiphertext: "enc:1", Status: providergrant.StatusActive, CreatedAt: now, UpdatedAt: now},
+		{ID: "grant-2", OwnerUserID: "user-2", Provider: provider.OpenAI, TokenCiphertext: "enc:2", Status: providergrant.StatusActive, CreatedAt: now, UpdatedAt: now},
+	} {
+		if err := store.PutProviderGrant(ctx, grant); err != nil {
+			t.Fatalf("put provider grant %s: %v", grant.ID, err)
+		}
+	}
+
+	values, err := store.ListProviderGrantCiphertexts(ctx, "grant-1", 10)
+	if err != nil {
+		t.Fatalf("list ciphertexts: %v", err)
+	}
+	if len(values) != 1 || values[0].ID != "grant-2" || values[0].Ciphertext != "enc:2" {
+		t.Fatalf("values = %+v", values)
+	}
+
+	if err := store.ReplaceProviderGrantCiphertext(ctx, "grant-2", "enc:2", "v1:next:2"); err != nil {
+		t.Fatalf("replace ciphertext: %v", err)
+	}
+	got, err := store.GetProviderGrant(ctx, "grant-2")
+	if err != nil {
+		t.Fatalf("get provider grant: %v", err)
+	}
+	if got.TokenCiphertext != "v1:next:2" {
+		t.Fatalf("TokenCiphertext = %q, want %q", got.TokenCiphertext, "v1:next:2")
+	}
+	if err := store.ReplaceProviderGrantCiphertext(ctx, "missing", "enc:x", "v1:next:x"); !errors.Is(err, storage.ErrConflict) {
+		t.Fatalf("missing replace error = %v, want %v", err, storage.ErrConflict)
+	}
+	if _, err := store.ListProviderGrantCiphertexts(ctx, "", 0); err == nil {
+		t.Fatal("expected error for zero limit")
+	}
+}
@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/sessionrecap"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+// PutSessionRecapDraft inserts or replaces one session recap draft.
+func (s *Store) PutSessionRecapDraft(ctx context.Context, draft sessionrecap.Draft) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if draft.CampaignID == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	if draft.SessionID == "" {
+		return fmt.Errorf("session id is required")
+	}
+
+	_, err := s.sqlDB.ExecContext(ctx, `
+INSERT INTO ai_session_recap_drafts (
+	campaign_id, session_id, status, markdown, agent_id, created_at, updated_at, published_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, session_id) DO UPDATE SET
+	status = excluded.status,
+	markdown = excluded.markdown,
+	agent_id = excluded.agent_id,
+	updated_at = excluded.updated_at,
+	published_at = excluded.published_at
+`,
+		draft.CampaignID,
+		draft.SessionID,
+		string(draft.Status),
+		draft.Markdown,
+		draft.AgentID,
+		sqliteutil.ToMillis(draft.CreatedAt),
+		sqliteutil.ToMillis(draft.UpdatedAt),
+		sqliteutil.ToNullMillis(draft.PublishedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("put session recap draft: %w", err)
+	}
+	return nil
+}
+
+// GetSessionRecapDraft fetches the recap draft for one campaign session.
+func (s *Store) GetSessionRecapDraft(ctx context.Context, campaignID string, sessionID string) (sessionrecap.Draft, error) {
+	if err := ctx.Err(); err != nil {
+		return sessionrecap.Draft{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return sessionrecap.Draft{}, fmt.Errorf("storage is not configured")
+	}
+	if campaignID == "" {
+		return sessionrecap.Draft{}, fmt.Errorf("campaign id is required")
+	}
+	if sessionID == "" {
+		return sessionrecap.Draft{}, fmt.Errorf("session id is required")
+	}
+
+	row := s.sqlDB.QueryRowContext(ctx, `
+SELECT campaign_id, session_id, status, markdown, agent_id, created_at, updated_at, published_at
+FROM ai_session_recap_drafts
+WHERE campaign_id = ? AND session_id = ?
+`, campaignID, sessionID)
+
+	var (
+		draft       sessionrecap.Draft
+		status      string
+		createdAt   int64
+		updatedAt   int64
+		publishedAt sql.NullInt64
+	)
+	if err := row.Scan(
+		&draft.CampaignID,
+		&draft.SessionID,
+		&status,
+		&draft.Markdown,
+		&draft.AgentID,
+		&createdAt,
+		&updatedAt,
+		&publishedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sessionrecap.Draft{}, storage.ErrNotFound
+		}
+		return sessionrecap.Draft{}, fmt.Errorf("get session recap draft: %w", err)
+	}
+	draft.Status = sessionrecap.Status(status)
+	draft.CreatedAt = sqliteutil.FromMillis(createdAt)
+	draft.UpdatedAt = sqliteutil.FromMillis(updatedAt)
+	draft.PublishedAt = sqliteutil.FromNullMillis(publishedAt)
+	return draft, nil
+}
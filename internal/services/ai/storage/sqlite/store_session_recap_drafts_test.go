@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/sessionrecap"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+func TestSessionRecapDraftRoundTripAndPublish(t *testing.T) {
+	store := openTempStore(t)
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	draft := sessionrecap.Draft{
+		CampaignID: "campaign-1",
+		SessionID:  "session-1",
+		Status:     sessionrecap.StatusPending,
+		Markdown:   "The party crossed the bridge.",
+		AgentID:    "agent-1",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := store.PutSessionRecapDraft(context.Background(), draft); err != nil {
+		t.Fatalf("PutSessionRecapDraft() error = %v", err)
+	}
+
+	got, err := store.GetSessionRecapDraft(context.Background(), "campaign-1", "session-1")
+	if err != nil {
+		t.Fatalf("GetSessionRecapDraft() error = %v", err)
+	}
+	if got.Status != sessionrecap.StatusPending || got.Markdown != draft.Markdown || got.AgentID != "agent-1" || got.PublishedAt != nil {
+		t.Fatalf("unexpected draft: %+v", got)
+	}
+
+	published := got
+	published.Status = sessionrecap.StatusPublished
+	published.Markdown = "The party crossed the bridge at dawn."
+	published.UpdatedAt = now.Add(time.Hour)
+	published.PublishedAt = ptrTime(now.Add(time.Hour))
+	if err := store.PutSessionRecapDraft(context.Background(), published); err != nil {
+		t.Fatalf("PutSessionRecapDraft(published) error = %v", err)
+	}
+	got, err = store.GetSessionRecapDraft(context.Background(), "campaign-1", "session-1")
+	if err != nil {
+		t.Fatalf("GetSessionRecapDraft() error = %v", err)
+	}
+	if got.Status != sessionrecap.StatusPublished || got.PublishedAt == nil || !got.CreatedAt.Equal(now) {
+		t.Fatalf("unexpected published draft: %+v", got)
+	}
+}
+
+func TestGetSessionRecapDraftReturnsNotFound(t *testing.T) {
+	store := openTempStore(t)
+
+	_, err := store.GetSessionRecapDraft(context.Background(), "campaign-1", "missing")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("GetSessionRecapDraft() error = %v, want %v", err, storage.ErrNotFound)
+	}
+}
@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+var _ storage.UserDataStore = (*Store)(nil)
+
+// DeleteUserData removes every AI record owned or requested by one user in a
+// single transaction. Campaign-scoped artifacts and audit events stay because
+// they carry only opaque IDs.
+func (s *Store) DeleteUserData(ctx context.Context, userID string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return 0, fmt.Errorf("storage is not configured")
+	}
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return 0, fmt.Errorf("user id is required")
+	}
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin delete user data: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{`DELETE FROM ai_access_requests WHERE requester_user_id = ? OR owner_user_id = ?`, []any{userID, userID}},
+		{`DELETE FROM ai_agents WHERE owner_user_id = ?`, []any{userID}},
+		{`DELETE FROM ai_credentials WHERE owner_user_id = ?`, []any{userID}},
+		{`DELETE FROM ai_provider_grants WHERE owner_user_id = ?`, []any{userID}},
+		{`DELETE FROM ai_provider_connect_sessions WHERE owner_user_id = ?`, []any{userID}},
+	}
+	deleted := 0
+	for _, statement := range statements {
+		res, err := tx.ExecContext(ctx, statement.query, statement.args...)
+		if err != nil {
+			return 0, fmt.Errorf("delete user data: %w", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("delete user data rows affected: %w", err)
+		}
+		deleted += int(affected)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit delete user data: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/accessrequest"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/agent"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/credential"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+func TestDeleteUserDataRemovesOwnedAndRequestedRecords(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	for _, c := range []credential.Credential{
+		{ID: "cred-1", OwnerUserID: "user-1", Provider: provider.OpenAI, Label: "Main", SecretCiphertext: "enc:1", Status: credential.StatusActive, CreatedAt: now, UpdatedAt: now},
+		{ID: "cred-2", OwnerUserID: "user-2", Provider: provider.OpenAI, Label: "Main", SecretCiphertext: "enc:2", Status: credential.StatusActive, CreatedAt: now, UpdatedAt: now},
+	} {
+		if err := store.PutCredential(ctx, c); err != nil {
+			t.Fatalf("put credential: %v", err)
+		}
+	}
+	if err := store.PutAgent(ctx, agent.Agent{
+		ID:            "agent-1",
+		OwnerUserID:   "user-1",
+		Label:         "narrator",
+		Provider:      provider.OpenAI,
+		Model:         "gpt-4o-mini",
+		AuthReference: agent.CredentialAuthReference("cred-1"),
+		Status:        agent.StatusActive,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}); err != nil {
+		t.Fatalf("put agent: %v", err)
+	}
+	if err := store.PutAccessRequest(ctx, accessrequest.AccessRequest{
+		ID:              "request-1",
+		RequesterUserID: "user-2",
+		OwnerUserID:     "user-1",
+		AgentID:         "agent-1",
+		Scope:           accessrequest.ScopeInvoke,
+		Status:          accessrequest.StatusPending,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}); err != nil {
+		t.Fatalf("put access request: %v", err)
+	}
+
+	deleted, err := store.DeleteUserData(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("delete user data: %v", err)
+	}
+	if deleted != 3 {
+		t.Fatalf("deleted = %d, want 3", deleted)
+	}
+	if _, err := store.GetCredential(ctx, "cred-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("get deleted credential err = %v, want not found", err)
+	}
+	if _, err := store.GetAccessRequest(ctx, "request-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("get deleted access request err = %v, want not found", err)
+	}
+	if _, err := store.GetCredential(ctx, "cred-2"); err != nil {
+		t.Fatalf("get other user's credential: %v", err)
+	}
+}
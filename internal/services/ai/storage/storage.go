@@ -64,3 +64,29 @@ type AccessRequestStore interface {
 	// request. Same CAS pattern as ReviewAccessRequest.
 	RevokeAccessRequest(ctx context.Context, revoked accessrequest.AccessRequest) error
 }
+
+// SealedValue identifies one stored ciphertext by the ID of the row that owns
+// it.
+type SealedValue struct {
+	ID         string
+	Ciphertext string
+}
+
+// SealedSecretStore exposes stored ciphertexts to key-rotation tooling.
+// Listing pages by row ID so an interrupted re-seal can resume after the last
+// processed row. Replacement is a compare-and-swap against the ciphertext the
+// caller read and returns ErrConflict when the row changed in between.
+type SealedSecretStore interface {
+	ListCredentialCiphertexts(ctx context.Context, afterID string, limit int) ([]SealedValue, error)
+	ReplaceCredentialCiphertext(ctx context.Context, credentialID string, current string, replacement string) error
+	ListProviderGrantCiphertexts(ctx context.Context, afterID string, limit int) ([]SealedValue, error)
+	ReplaceProviderGrantCiphertext(ctx context.Context, providerGrantID string, current string, replacement string) error
+}
+
+// UserDataStore erases the AI records a closed account owns or requested.
+type UserDataStore interface {
+	// DeleteUserData removes credentials, agents, provider grants, connect
+	// sessions, and access requests tied to the user, returning the number of
+	// rows removed.
+	DeleteUserData(ctx context.Context, userID string) (int, error)
+}
@@ -0,0 +1,376 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+	authusername "github.com/louisbranch/fracturing.space/internal/services/auth/username"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// AccountExportRequestedEventType asks the worker to assemble one export.
+	AccountExportRequestedEventType = "auth.account_export_requested"
+	// AccountDeletedEventType asks the worker to erase a closed account's
+	// personal data in every other service.
+	AccountDeletedEventType = "auth.account_deleted"
+
+	accountExportTTL = 7 * 24 * time.Hour
+	// accountExportPendingTTL bounds how long a pending export blocks new
+	// requests; past it the worker is assumed to have dropped the job.
+	accountExportPendingTTL = 24 * time.Hour
+	accountExportListLimit  = 10
+	accountExportFailureMax = 500
+)
+
+// RequestAccountExport queues one archive job unless another is still pending.
+func (s *AccountService) RequestAccountExport(ctx context.Context, in *authv1.RequestAccountExportRequest) (*authv1.RequestAccountExportResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Request account export request is required.")
+	}
+	if s.userStore == nil || s.exportStore == nil {
+		return nil, status.Error(codes.Internal, "Account export store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	found, err := s.userStore.GetUser(ctx, userID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if found.DeletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Account is closed.")
+	}
+
+	// Auth has no sweeper loop, so expired archives are pruned on the write
+	// path that creates new ones.
+	if err := s.exportStore.DeleteExpiredAccountExports(ctx, s.now()); err != nil {
+		return nil, status.Errorf(codes.Internal, "Delete expired account exports: %v", err)
+	}
+	existing, err := s.exportStore.ListAccountExports(ctx, userID, accountExportListLimit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "List account exports: %v", err)
+	}
+	now := s.now()
+	for _, export := range existing {
+		if export.Status == storage.AccountExportStatusPending && now.Sub(export.CreatedAt) < accountExportPendingTTL {
+			return nil, status.Error(codes.FailedPrecondition, "An account export is already in progress.")
+		}
+	}
+
+	exportID, err := s.newID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Generate export ID: %v", err)
+	}
+	export := storage.AccountExport{
+		ID:        exportID,
+		UserID:    userID,
+		Status:    storage.AccountExportStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	event, err := s.accountOutboxEvent(
+		AccountExportRequestedEventType,
+		"account_export_requested:export:"+exportID+":v1",
+		map[string]string{"export_id": exportID, "user_id": userID},
+		now,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.exportStore.PutAccountExportWithIntegrationOutboxEvent(ctx, export, event); err != nil {
+		return nil, status.Errorf(codes.Internal, "Put account export: %v", err)
+	}
+	return &authv1.RequestAccountExportResponse{Export: accountExportToProto(export)}, nil
+}
+
+// ListAccountExports returns the user's recent exports, newest first.
+func (s *AccountService) ListAccountExports(ctx context.Context, in *authv1.ListAccountExportsRequest) (*authv1.ListAccountExportsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "List account exports request is required.")
+	}
+	if s.exportStore == nil {
+		return nil, status.Error(codes.Internal, "Account export store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	exports, err := s.exportStore.ListAccountExports(ctx, userID, accountExportListLimit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "List account exports: %v", err)
+	}
+	now := s.now()
+	resp := &authv1.ListAccountExportsResponse{Exports: make([]*authv1.AccountExport, 0, len(exports))}
+	for _, export := range exports {
+		if accountExportExpired(export, now) {
+			continue
+		}
+		resp.Exports = append(resp.Exports, accountExportToProto(export))
+	}
+	return resp, nil
+}
+
+// GetAccountExportArchive returns a ready archive to the user who requested it.
+func (s *AccountService) GetAccountExportArchive(ctx context.Context, in *authv1.GetAccountExportArchiveRequest) (*authv1.GetAccountExportArchiveResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Get account export archive request is required.")
+	}
+	if s.exportStore == nil {
+		return nil, status.Error(codes.Internal, "Account export store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	exportID := strings.TrimSpace(in.GetExportId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	if exportID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Export ID is required.")
+	}
+	export, err := s.exportStore.GetAccountExport(ctx, exportID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	// Other users' exports are reported as missing rather than forbidden so
+	// export IDs cannot be probed.
+	if export.UserID != userID || accountExportExpired(export, s.now()) {
+		return nil, status.Error(codes.NotFound, "Account export not found.")
+	}
+	if export.Status != storage.AccountExportStatusReady {
+		return nil, status.Error(codes.FailedPrecondition, "Account export is not ready.")
+	}
+	return &authv1.GetAccountExportArchiveResponse{
+		Export:   accountExportToProto(export),
+		Filename: accountExportFilename(export),
+		Archive:  export.Archive,
+	}, nil
+}
+
+// ExportUserData returns auth-owned records for the export job. Credential
+// material is never included; passkeys are listed by ID and timestamps only.
+func (s *AccountService) ExportUserData(ctx context.Context, in *authv1.ExportUserDataRequest) (*authv1.ExportUserDataResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Export user data request is required.")
+	}
+	if s.userStore == nil {
+		return nil, status.Error(codes.Internal, "Account store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	found, err := s.userStore.GetUser(ctx, userID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+
+	type exportedPasskey struct {
+		CredentialID string     `json:"credential_id"`
+		CreatedAt    time.Time  `json:"created_at"`
+		LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	}
+	records := struct {
+		User struct {
+			ID        string    `json:"id"`
+			Username  string    `json:"username"`
+			Locale    string    `json:"locale"`
+			CreatedAt time.Time `json:"created_at"`
+			UpdatedAt time.Time `json:"updated_at"`
+		} `json:"user"`
+		Passkeys []exportedPasskey `json:"passkeys"`
+	}{Passkeys: []exportedPasskey{}}
+	records.User.ID = found.ID
+	records.User.Username = found.Username
+	records.User.Locale = found.Locale.String()
+	records.User.CreatedAt = found.CreatedAt
+	records.User.UpdatedAt = found.UpdatedAt
+
+	if s.passkeyStore != nil {
+		credentials, err := s.passkeyStore.ListPasskeyCredentials(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "List passkeys: %v", err)
+		}
+		for _, credential := range credentials {
+			records.Passkeys = append(records.Passkeys, exportedPasskey{
+				CredentialID: credential.CredentialID,
+				CreatedAt:    credential.CreatedAt,
+				LastUsedAt:   credential.LastUsedAt,
+			})
+		}
+	}
+
+	payload, err := json.Marshal(records)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Marshal auth records: %v", err)
+	}
+	return &authv1.ExportUserDataResponse{RecordsJson: string(payload)}, nil
+}
+
+// CompleteAccountExport stores the worker's archive, or its failure, for a
+// pending export. Repeated completions are no-ops.
+func (s *AccountService) CompleteAccountExport(ctx context.Context, in *authv1.CompleteAccountExportRequest) (*authv1.CompleteAccountExportResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Complete account export request is required.")
+	}
+	if s.exportStore == nil {
+		return nil, status.Error(codes.Internal, "Account export store is not configured.")
+	}
+	exportID := strings.TrimSpace(in.GetExportId())
+	if exportID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Export ID is required.")
+	}
+	failure := strings.TrimSpace(in.GetFailure())
+	if failure == "" && len(in.GetArchive()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Archive or failure is required.")
+	}
+
+	now := s.now()
+	if failure != "" {
+		if len(failure) > accountExportFailureMax {
+			failure = failure[:accountExportFailureMax]
+		}
+		err := s.exportStore.FailAccountExport(ctx, exportID, failure, now)
+		if err != nil {
+			return nil, handleDomainError(err)
+		}
+	} else {
+		err := s.exportStore.CompleteAccountExport(ctx, exportID, in.GetArchive(), now, now.Add(accountExportTTL))
+		if err != nil {
+			return nil, handleDomainError(err)
+		}
+	}
+
+	export, err := s.exportStore.GetAccountExport(ctx, exportID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	return &authv1.CompleteAccountExportResponse{Export: accountExportToProto(export)}, nil
+}
+
+// DeleteAccount closes the account after the user repeats their username.
+//
+// Credentials, sessions, OAuth grants, and archives are dropped in the same
+// write as the tombstone; every other service erases its records when the
+// worker consumes the account-deleted outbox event.
+func (s *AccountService) DeleteAccount(ctx context.Context, in *authv1.DeleteAccountRequest) (*authv1.DeleteAccountResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Delete account request is required.")
+	}
+	if s.userStore == nil || s.deletionStore == nil {
+		return nil, status.Error(codes.Internal, "Account deletion store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	found, err := s.userStore.GetUser(ctx, userID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if found.DeletedAt != nil {
+		return &authv1.DeleteAccountResponse{DeletedAt: timestamppb.New(*found.DeletedAt)}, nil
+	}
+	confirm, err := authusername.Canonicalize(in.GetConfirmUsername())
+	if err != nil || confirm != found.Username {
+		return nil, status.Error(codes.InvalidArgument, "Confirmation does not match the account username.")
+	}
+
+	now := s.now()
+	event, err := s.accountOutboxEvent(
+		AccountDeletedEventType,
+		"account_deleted:user:"+userID+":v1",
+		map[string]string{"user_id": userID, "deleted_at": now.Format(time.RFC3339Nano)},
+		now,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.deletionStore.DeleteAccountWithIntegrationOutboxEvent(ctx, user.Tombstone(found, now), now, event); err != nil {
+		return nil, status.Errorf(codes.Internal, "Delete account: %v", err)
+	}
+	return &authv1.DeleteAccountResponse{DeletedAt: timestamppb.New(now)}, nil
+}
+
+func (s *AccountService) now() time.Time {
+	if s != nil && s.clock != nil {
+		return s.clock().UTC()
+	}
+	return time.Now().UTC()
+}
+
+func (s *AccountService) newID() (string, error) {
+	if s != nil && s.idGenerator != nil {
+		return s.idGenerator()
+	}
+	return id.NewID()
+}
+
+func (s *AccountService) accountOutboxEvent(eventType, dedupeKey string, payload map[string]string, now time.Time) (storage.IntegrationOutboxEvent, error) {
+	eventID, err := s.newID()
+	if err != nil {
+		return storage.IntegrationOutboxEvent{}, fmt.Errorf("Generate %s event ID: %w", eventType, err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return storage.IntegrationOutboxEvent{}, fmt.Errorf("Marshal %s payload: %w", eventType, err)
+	}
+	return storage.IntegrationOutboxEvent{
+		ID:            eventID,
+		EventType:     eventType,
+		PayloadJSON:   string(payloadJSON),
+		DedupeKey:     dedupeKey,
+		Status:        storage.IntegrationOutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func accountExportExpired(export storage.AccountExport, now time.Time) bool {
+	return export.ExpiresAt != nil && !export.ExpiresAt.After(now)
+}
+
+func accountExportFilename(export storage.AccountExport) string {
+	return "fracturing-space-export-" + export.CreatedAt.UTC().Format("20060102") + "-" + export.ID + ".zip"
+}
+
+func accountExportToProto(export storage.AccountExport) *authv1.AccountExport {
+	proto := &authv1.AccountExport{
+		Id:               export.ID,
+		UserId:           export.UserID,
+		Status:           accountExportStatusToProto(export.Status),
+		ArchiveSizeBytes: export.ArchiveSize,
+		LastError:        export.LastError,
+		CreatedAt:        timestamppb.New(export.CreatedAt),
+	}
+	if export.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*export.CompletedAt)
+	}
+	if export.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*export.ExpiresAt)
+	}
+	return proto
+}
+
+func accountExportStatusToProto(value string) authv1.AccountExportStatus {
+	switch value {
+	case storage.AccountExportStatusPending:
+		return authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_PENDING
+	case storage.AccountExportStatusReady:
+		return authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_READY
+	case storage.AccountExportStatusFailed:
+		return authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_FAILED
+	default:
+		return authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_UNSPECIFIED
+	}
+}
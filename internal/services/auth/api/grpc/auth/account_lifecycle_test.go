@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	authsqlite "github.com/louisbranch/fracturing.space/internal/services/auth/storage/sqlite"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestAccountLifecycleService(t *testing.T, now time.Time) (*AccountService, *authsqlite.Store) {
+	t.Helper()
+	store := openTempAuthStore(t)
+	if err := store.PutUser(context.Background(), user.User{
+		ID:        "user-1",
+		Username:  "alice",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("put user: %v", err)
+	}
+	svc := NewAccountService(store)
+	svc.clock = func() time.Time { return now }
+	next := 0
+	svc.idGenerator = func() (string, error) {
+		next++
+		return fmt.Sprintf("id-%d", next), nil
+	}
+	return svc, store
+}
+
+func TestAccountExportRequestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, _ := newTestAccountLifecycleService(t, now)
+
+	requested, err := svc.RequestAccountExport(ctx, &authv1.RequestAccountExportRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("request export: %v", err)
+	}
+	exportID := requested.GetExport().GetId()
+	if got := requested.GetExport().GetStatus(); got != authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_PENDING {
+		t.Fatalf("status = %v, want pending", got)
+	}
+
+	_, err = svc.RequestAccountExport(ctx, &authv1.RequestAccountExportRequest{UserId: "user-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("second request code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	_, err = svc.GetAccountExportArchive(ctx, &authv1.GetAccountExportArchiveRequest{UserId: "user-1", ExportId: exportID})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("pending archive code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	if _, err := svc.CompleteAccountExport(ctx, &authv1.CompleteAccountExportRequest{ExportId: exportID, Archive: []byte("zip")}); err != nil {
+		t.Fatalf("complete export: %v", err)
+	}
+
+	_, err = svc.GetAccountExportArchive(ctx, &authv1.GetAccountExportArchiveRequest{UserId: "user-2", ExportId: exportID})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("foreign archive code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+	archive, err := svc.GetAccountExportArchive(ctx, &authv1.GetAccountExportArchiveRequest{UserId: "user-1", ExportId: exportID})
+	if err != nil {
+		t.Fatalf("get archive: %v", err)
+	}
+	if string(archive.GetArchive()) != "zip" || archive.GetFilename() == "" {
+		t.Fatalf("archive = %q filename = %q", archive.GetArchive(), archive.GetFilename())
+	}
+
+	listed, err := svc.ListAccountExports(ctx, &authv1.ListAccountExportsRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("list exports: %v", err)
+	}
+	if len(listed.GetExports()) != 1 || listed.GetExports()[0].GetArchiveSizeBytes() != 3 {
+		t.Fatalf("listed exports = %+v", listed.GetExports())
+	}
+}
+
+func TestCompleteAccountExportRequiresArchiveOrFailure(t *testing.T) {
+	svc, _ := newTestAccountLifecycleService(t, time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	_, err := svc.CompleteAccountExport(context.Background(), &authv1.CompleteAccountExportRequest{ExportId: "export-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestExportUserDataOmitsCredentialMaterial(t *testing.T) {
+	svc, _ := newTestAccountLifecycleService(t, time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	resp, err := svc.ExportUserData(context.Background(), &authv1.ExportUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("export user data: %v", err)
+	}
+	if got := resp.GetRecordsJson(); !strings.Contains(got, `"username":"alice"`) || !strings.Contains(got, `"passkeys":[]`) {
+		t.Fatalf("records = %s", got)
+	}
+}
+
+func TestDeleteAccountRequiresUsernameConfirmation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, store := newTestAccountLifecycleService(t, now)
+
+	_, err := svc.DeleteAccount(ctx, &authv1.DeleteAccountRequest{UserId: "user-1", ConfirmUsername: "bob"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("mismatched confirm code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	resp, err := svc.DeleteAccount(ctx, &authv1.DeleteAccountRequest{UserId: "user-1", ConfirmUsername: "Alice"})
+	if err != nil {
+		t.Fatalf("delete account: %v", err)
+	}
+	if !resp.GetDeletedAt().AsTime().Equal(now) {
+		t.Fatalf("deleted at = %v, want %v", resp.GetDeletedAt().AsTime(), now)
+	}
+	got, err := store.GetUser(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	if got.DeletedAt == nil || got.Username == "alice" {
+		t.Fatalf("user after deletion = %+v", got)
+	}
+
+	// Retries after the username changed must stay successful.
+	if _, err := svc.DeleteAccount(ctx, &authv1.DeleteAccountRequest{UserId: "user-1"}); err != nil {
+		t.Fatalf("repeat delete account: %v", err)
+	}
+	_, err = svc.RequestAccountExport(ctx, &authv1.RequestAccountExportRequest{UserId: "user-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("export after deletion code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	platformi18n "github.com/louisbranch/fracturing.space/internal/platform/i18n"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
 	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
 	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
 	"google.golang.org/grpc/codes"
@@ -17,16 +18,32 @@ import (
 // AccountService exposes account profile operations separate from identity auth APIs.
 type AccountService struct {
 	authv1.UnimplementedAccountServiceServer
-	userStore storage.UserStore
-	clock     func() time.Time
+	userStore     storage.UserStore
+	passkeyStore  storage.PasskeyStore
+	exportStore   storage.AccountExportStore
+	deletionStore storage.AccountDeletionStore
+	clock         func() time.Time
+	idGenerator   func() (string, error)
 }
 
-// NewAccountService creates the account profile service.
+// NewAccountService creates the account profile service. Export and deletion
+// support is enabled when the user store also implements those stores.
 func NewAccountService(userStore storage.UserStore) *AccountService {
-	return &AccountService{
-		userStore: userStore,
-		clock:     time.Now,
+	service := &AccountService{
+		userStore:   userStore,
+		clock:       time.Now,
+		idGenerator: id.NewID,
 	}
+	if typed, ok := userStore.(storage.PasskeyStore); ok {
+		service.passkeyStore = typed
+	}
+	if typed, ok := userStore.(storage.AccountExportStore); ok {
+		service.exportStore = typed
+	}
+	if typed, ok := userStore.(storage.AccountDeletionStore); ok {
+		service.deletionStore = typed
+	}
+	return service
 }
 
 // GetProfile returns account profile metadata for a specific user ID.
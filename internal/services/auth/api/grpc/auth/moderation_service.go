@@ -0,0 +1,385 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpc/pagination"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// UserSuspendedEventType tells the worker to notify a suspended user.
+	UserSuspendedEventType = "auth.user_suspended"
+	// UserUnsuspendedEventType tells the worker to notify a reinstated user.
+	UserUnsuspendedEventType = "auth.user_unsuspended"
+
+	moderationActionUserSuspend   = "user.suspend"
+	moderationActionUserUnsuspend = "user.unsuspend"
+
+	defaultListModerationActionsPageSize = 25
+	maxListModerationActionsPageSize     = 100
+	maxModerationReasonLength            = 500
+	maxModerationActionLength            = 64
+)
+
+// ModerationService exposes operator suspensions and the moderation audit log.
+//
+// Admin is the only caller; it passes the operator's user ID so every row in
+// the audit log names who acted.
+type ModerationService struct {
+	authv1.UnimplementedModerationServiceServer
+	userStore       storage.UserStore
+	moderationStore storage.ModerationStore
+	clock           func() time.Time
+	idGenerator     func() (string, error)
+}
+
+// NewModerationService creates the moderation service. Moderation writes are
+// enabled when the user store also implements storage.ModerationStore.
+func NewModerationService(userStore storage.UserStore) *ModerationService {
+	service := &ModerationService{
+		userStore:   userStore,
+		clock:       time.Now,
+		idGenerator: id.NewID,
+	}
+	if typed, ok := userStore.(storage.ModerationStore); ok {
+		service.moderationStore = typed
+	}
+	return service
+}
+
+// SuspendUser blocks sign-in for a user, revoking their web sessions and
+// OAuth grants. Suspending an already suspended user replaces the reason and
+// expiry.
+func (s *ModerationService) SuspendUser(ctx context.Context, in *authv1.SuspendUserRequest) (*authv1.SuspendUserResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Suspend user request is required.")
+	}
+	if s.userStore == nil || s.moderationStore == nil {
+		return nil, status.Error(codes.Internal, "Moderation store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	actorID := strings.TrimSpace(in.GetActorUserId())
+	reason := strings.TrimSpace(in.GetReason())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	if actorID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Actor user ID is required.")
+	}
+	if actorID == userID {
+		return nil, status.Error(codes.InvalidArgument, "Operators cannot suspend their own account.")
+	}
+	if err := validateModerationReason(reason, true); err != nil {
+		return nil, err
+	}
+	now := s.now()
+	var expiresAt *time.Time
+	if ts := in.GetExpiresAt(); ts != nil {
+		value := ts.AsTime().UTC()
+		if !value.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "Suspension expiry must be in the future.")
+		}
+		expiresAt = &value
+	}
+
+	found, err := s.userStore.GetUser(ctx, userID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if found.DeletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Account is closed.")
+	}
+
+	actionID, err := s.newID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Generate moderation action ID: %v", err)
+	}
+	action := storage.ModerationAction{
+		ID:          actionID,
+		Action:      moderationActionUserSuspend,
+		TargetKind:  storage.ModerationTargetUser,
+		TargetID:    userID,
+		ActorUserID: actorID,
+		Reason:      reason,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now,
+	}
+	payload := map[string]string{"user_id": userID, "action_id": actionID, "reason": reason}
+	if expiresAt != nil {
+		payload["expires_at"] = expiresAt.Format(time.RFC3339Nano)
+	}
+	event, err := s.moderationOutboxEvent(UserSuspendedEventType, "user_suspended:action:"+actionID+":v1", payload, now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	suspension := storage.UserSuspension{
+		UserID:      userID,
+		Reason:      reason,
+		ActorUserID: actorID,
+		SuspendedAt: now,
+		ExpiresAt:   expiresAt,
+	}
+	if err := s.moderationStore.SuspendUserWithIntegrationOutboxEvent(ctx, suspension, action, event); err != nil {
+		return nil, status.Errorf(codes.Internal, "Suspend user: %v", err)
+	}
+
+	found.SuspendedAt = &now
+	found.SuspendedUntil = expiresAt
+	found.SuspensionReason = reason
+	return &authv1.SuspendUserResponse{User: userToProto(found)}, nil
+}
+
+// UnsuspendUser lifts a suspension before it expires.
+func (s *ModerationService) UnsuspendUser(ctx context.Context, in *authv1.UnsuspendUserRequest) (*authv1.UnsuspendUserResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Unsuspend user request is required.")
+	}
+	if s.userStore == nil || s.moderationStore == nil {
+		return nil, status.Error(codes.Internal, "Moderation store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	actorID := strings.TrimSpace(in.GetActorUserId())
+	reason := strings.TrimSpace(in.GetReason())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	if actorID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Actor user ID is required.")
+	}
+	if err := validateModerationReason(reason, false); err != nil {
+		return nil, err
+	}
+	found, err := s.userStore.GetUser(ctx, userID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if found.SuspendedAt == nil {
+		return nil, status.Error(codes.FailedPrecondition, "User is not suspended.")
+	}
+
+	now := s.now()
+	actionID, err := s.newID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Generate moderation action ID: %v", err)
+	}
+	action := storage.ModerationAction{
+		ID:          actionID,
+		Action:      moderationActionUserUnsuspend,
+		TargetKind:  storage.ModerationTargetUser,
+		TargetID:    userID,
+		ActorUserID: actorID,
+		Reason:      reason,
+		CreatedAt:   now,
+	}
+	event, err := s.moderationOutboxEvent(
+		UserUnsuspendedEventType,
+		"user_unsuspended:action:"+actionID+":v1",
+		map[string]string{"user_id": userID, "action_id": actionID},
+		now,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.moderationStore.UnsuspendUserWithIntegrationOutboxEvent(ctx, userID, action, event); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "User is not suspended.")
+		}
+		return nil, status.Errorf(codes.Internal, "Unsuspend user: %v", err)
+	}
+
+	found.SuspendedAt = nil
+	found.SuspendedUntil = nil
+	found.SuspensionReason = ""
+	return &authv1.UnsuspendUserResponse{User: userToProto(found)}, nil
+}
+
+// RecordModerationAction appends an audit row for moderation applied by
+// another service, such as a game campaign lock.
+func (s *ModerationService) RecordModerationAction(ctx context.Context, in *authv1.RecordModerationActionRequest) (*authv1.RecordModerationActionResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Record moderation action request is required.")
+	}
+	if s.moderationStore == nil {
+		return nil, status.Error(codes.Internal, "Moderation store is not configured.")
+	}
+	actionName := strings.TrimSpace(in.GetAction())
+	targetID := strings.TrimSpace(in.GetTargetId())
+	actorID := strings.TrimSpace(in.GetActorUserId())
+	reason := strings.TrimSpace(in.GetReason())
+	if actionName == "" || len(actionName) > maxModerationActionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Action is required and must be at most %d characters.", maxModerationActionLength)
+	}
+	targetKind, ok := moderationTargetKindFromProto(in.GetTargetKind())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Target kind is required.")
+	}
+	if targetID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Target ID is required.")
+	}
+	if actorID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Actor user ID is required.")
+	}
+	if err := validateModerationReason(reason, false); err != nil {
+		return nil, err
+	}
+
+	actionID, err := s.newID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Generate moderation action ID: %v", err)
+	}
+	action := storage.ModerationAction{
+		ID:          actionID,
+		Action:      actionName,
+		TargetKind:  targetKind,
+		TargetID:    targetID,
+		ActorUserID: actorID,
+		Reason:      reason,
+		CreatedAt:   s.now(),
+	}
+	if err := s.moderationStore.PutModerationAction(ctx, action); err != nil {
+		return nil, status.Errorf(codes.Internal, "Record moderation action: %v", err)
+	}
+	return &authv1.RecordModerationActionResponse{Action: moderationActionToProto(action)}, nil
+}
+
+// ListModerationActions returns the audit log newest first.
+func (s *ModerationService) ListModerationActions(ctx context.Context, in *authv1.ListModerationActionsRequest) (*authv1.ListModerationActionsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "List moderation actions request is required.")
+	}
+	if s.moderationStore == nil {
+		return nil, status.Error(codes.Internal, "Moderation store is not configured.")
+	}
+	targetKind := ""
+	if in.GetTargetKind() != authv1.ModerationTargetKind_MODERATION_TARGET_KIND_UNSPECIFIED {
+		kind, ok := moderationTargetKindFromProto(in.GetTargetKind())
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "Target kind is invalid.")
+		}
+		targetKind = kind
+	}
+	pageSize := pagination.ClampPageSize(in.GetPageSize(), pagination.PageSizeConfig{
+		Default: defaultListModerationActionsPageSize,
+		Max:     maxListModerationActionsPageSize,
+	})
+	page, err := s.moderationStore.ListModerationActions(ctx, pageSize, in.GetPageToken(), targetKind, in.GetTargetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "List moderation actions: %v", err)
+	}
+	resp := &authv1.ListModerationActionsResponse{
+		Actions:       make([]*authv1.ModerationAction, 0, len(page.Actions)),
+		NextPageToken: page.NextPageToken,
+	}
+	for _, action := range page.Actions {
+		resp.Actions = append(resp.Actions, moderationActionToProto(action))
+	}
+	return resp, nil
+}
+
+func (s *ModerationService) now() time.Time {
+	if s != nil && s.clock != nil {
+		return s.clock().UTC()
+	}
+	return time.Now().UTC()
+}
+
+func (s *ModerationService) newID() (string, error) {
+	if s != nil && s.idGenerator != nil {
+		return s.idGenerator()
+	}
+	return id.NewID()
+}
+
+func (s *ModerationService) moderationOutboxEvent(eventType, dedupeKey string, payload map[string]string, now time.Time) (storage.IntegrationOutboxEvent, error) {
+	eventID, err := s.newID()
+	if err != nil {
+		return storage.IntegrationOutboxEvent{}, fmt.Errorf("Generate %s event ID: %w", eventType, err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return storage.IntegrationOutboxEvent{}, fmt.Errorf("Marshal %s payload: %w", eventType, err)
+	}
+	return storage.IntegrationOutboxEvent{
+		ID:            eventID,
+		EventType:     eventType,
+		PayloadJSON:   string(payloadJSON),
+		DedupeKey:     dedupeKey,
+		Status:        storage.IntegrationOutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// rejectSuspendedUser is the sign-in gate shared by session, passkey, and
+// recovery flows.
+func rejectSuspendedUser(u user.User, now time.Time) error {
+	if u.IsSuspended(now) {
+		return status.Error(codes.PermissionDenied, "Account is suspended.")
+	}
+	return nil
+}
+
+func validateModerationReason(reason string, required bool) error {
+	if required && reason == "" {
+		return status.Error(codes.InvalidArgument, "Reason is required.")
+	}
+	if len(reason) > maxModerationReasonLength {
+		return status.Errorf(codes.InvalidArgument, "Reason must be at most %d characters.", maxModerationReasonLength)
+	}
+	return nil
+}
+
+func moderationTargetKindFromProto(kind authv1.ModerationTargetKind) (string, bool) {
+	switch kind {
+	case authv1.ModerationTargetKind_MODERATION_TARGET_KIND_USER:
+		return storage.ModerationTargetUser, true
+	case authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN:
+		return storage.ModerationTargetCampaign, true
+	case authv1.ModerationTargetKind_MODERATION_TARGET_KIND_DISCOVERY_ENTRY:
+		return storage.ModerationTargetDiscoveryEntry, true
+	default:
+		return "", false
+	}
+}
+
+func moderationTargetKindToProto(kind string) authv1.ModerationTargetKind {
+	switch kind {
+	case storage.ModerationTargetUser:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_USER
+	case storage.ModerationTargetCampaign:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN
+	case storage.ModerationTargetDiscoveryEntry:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_DISCOVERY_ENTRY
+	default:
+		return authv1.ModerationTargetKind_MODERATION_TARGET_KIND_UNSPECIFIED
+	}
+}
+
+func moderationActionToProto(action storage.ModerationAction) *authv1.ModerationAction {
+	proto := &authv1.ModerationAction{
+		Id:          action.ID,
+		Action:      action.Action,
+		TargetKind:  moderationTargetKindToProto(action.TargetKind),
+		TargetId:    action.TargetID,
+		ActorUserId: action.ActorUserID,
+		Reason:      action.Reason,
+		CreatedAt:   timestamppb.New(action.CreatedAt),
+	}
+	if action.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*action.ExpiresAt)
+	}
+	return proto
+}
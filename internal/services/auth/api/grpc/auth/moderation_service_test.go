@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	authsqlite "github.com/louisbranch/fracturing.space/internal/services/auth/storage/sqlite"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestModerationService(t *testing.T, now time.Time) (*ModerationService, *authsqlite.Store) {
+	t.Helper()
+	store := openTempAuthStore(t)
+	if err := store.PutUser(context.Background(), user.User{
+		ID:        "user-1",
+		Username:  "alice",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("put user: %v", err)
+	}
+	svc := NewModerationService(store)
+	svc.clock = func() time.Time { return now }
+	next := 0
+	svc.idGenerator = func() (string, error) {
+		next++
+		return fmt.Sprintf("id-%d", next), nil
+	}
+	return svc, store
+}
+
+func TestSuspendUserBlocksWebSessionsUntilLifted(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, store := newTestModerationService(t, now)
+	authSvc := NewAuthService(store, store, nil)
+	authSvc.clock = func() time.Time { return now }
+
+	created, err := authSvc.CreateWebSession(ctx, &authv1.CreateWebSessionRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("create web session: %v", err)
+	}
+
+	resp, err := svc.SuspendUser(ctx, &authv1.SuspendUserRequest{
+		UserId:      "user-1",
+		Reason:      "spam",
+		ExpiresAt:   timestamppb.New(now.Add(24 * time.Hour)),
+		ActorUserId: "op-1",
+	})
+	if err != nil {
+		t.Fatalf("suspend user: %v", err)
+	}
+	if resp.GetUser().GetSuspendedAt() == nil || resp.GetUser().GetSuspensionReason() != "spam" {
+		t.Fatalf("suspended user = %+v", resp.GetUser())
+	}
+	if _, err := store.GetIntegrationOutboxEvent(ctx, "id-2"); err != nil {
+		t.Fatalf("get suspension outbox event: %v", err)
+	}
+
+	_, err = authSvc.GetWebSession(ctx, &authv1.GetWebSessionRequest{SessionId: created.GetSession().GetId()})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("revoked session code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+	_, err = authSvc.CreateWebSession(ctx, &authv1.CreateWebSessionRequest{UserId: "user-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("create while suspended code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+
+	if _, err := svc.UnsuspendUser(ctx, &authv1.UnsuspendUserRequest{UserId: "user-1", ActorUserId: "op-1"}); err != nil {
+		t.Fatalf("unsuspend user: %v", err)
+	}
+	if _, err := authSvc.CreateWebSession(ctx, &authv1.CreateWebSessionRequest{UserId: "user-1"}); err != nil {
+		t.Fatalf("create after unsuspend: %v", err)
+	}
+	_, err = svc.UnsuspendUser(ctx, &authv1.UnsuspendUserRequest{UserId: "user-1", ActorUserId: "op-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("second unsuspend code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	listed, err := svc.ListModerationActions(ctx, &authv1.ListModerationActionsRequest{
+		TargetKind: authv1.ModerationTargetKind_MODERATION_TARGET_KIND_USER,
+		TargetId:   "user-1",
+	})
+	if err != nil {
+		t.Fatalf("list moderation actions: %v", err)
+	}
+	if len(listed.GetActions()) != 2 {
+		t.Fatalf("moderation actions = %d, want 2", len(listed.GetActions()))
+	}
+}
+
+func TestSuspendUserValidation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, _ := newTestModerationService(t, now)
+
+	tests := []struct {
+		name string
+		req  *authv1.SuspendUserRequest
+		code codes.Code
+	}{
+		{name: "nil request", req: nil, code: codes.InvalidArgument},
+		{name: "missing reason", req: &authv1.SuspendUserRequest{UserId: "user-1", ActorUserId: "op-1"}, code: codes.InvalidArgument},
+		{name: "missing actor", req: &authv1.SuspendUserRequest{UserId: "user-1", Reason: "spam"}, code: codes.InvalidArgument},
+		{name: "self suspension", req: &authv1.SuspendUserRequest{UserId: "user-1", Reason: "spam", ActorUserId: "user-1"}, code: codes.InvalidArgument},
+		{name: "past expiry", req: &authv1.SuspendUserRequest{UserId: "user-1", Reason: "spam", ActorUserId: "op-1", ExpiresAt: timestamppb.New(now.Add(-time.Hour))}, code: codes.InvalidArgument},
+		{name: "unknown user", req: &authv1.SuspendUserRequest{UserId: "user-9", Reason: "spam", ActorUserId: "op-1"}, code: codes.NotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.SuspendUser(ctx, tc.req)
+			if status.Code(err) != tc.code {
+				t.Fatalf("code = %v, want %v (%v)", status.Code(err), tc.code, err)
+			}
+		})
+	}
+}
+
+func TestExpiredSuspensionAllowsSignIn(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, store := newTestModerationService(t, now)
+	if _, err := svc.SuspendUser(ctx, &authv1.SuspendUserRequest{
+		UserId:      "user-1",
+		Reason:      "cool off",
+		ExpiresAt:   timestamppb.New(now.Add(time.Hour)),
+		ActorUserId: "op-1",
+	}); err != nil {
+		t.Fatalf("suspend user: %v", err)
+	}
+	authSvc := NewAuthService(store, store, nil)
+	authSvc.clock = func() time.Time { return now.Add(2 * time.Hour) }
+	if _, err := authSvc.CreateWebSession(ctx, &authv1.CreateWebSessionRequest{UserId: "user-1"}); err != nil {
+		t.Fatalf("create after expiry: %v", err)
+	}
+}
+
+func TestRecordModerationActionRequiresTarget(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, _ := newTestModerationService(t, now)
+
+	_, err := svc.RecordModerationAction(ctx, &authv1.RecordModerationActionRequest{Action: "campaign.lock", TargetId: "camp-1", ActorUserId: "op-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("missing kind code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+	resp, err := svc.RecordModerationAction(ctx, &authv1.RecordModerationActionRequest{
+		Action:      "campaign.lock",
+		TargetKind:  authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN,
+		TargetId:    "camp-1",
+		ActorUserId: "op-1",
+		Reason:      "abuse report",
+	})
+	if err != nil {
+		t.Fatalf("record moderation action: %v", err)
+	}
+	if resp.GetAction().GetTargetKind() != authv1.ModerationTargetKind_MODERATION_TARGET_KIND_CAMPAIGN {
+		t.Fatalf("target kind = %v", resp.GetAction().GetTargetKind())
+	}
+}
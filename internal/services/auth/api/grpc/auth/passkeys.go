@@ -258,6 +258,9 @@ func (s *AuthService) FinishPasskeyLogin(ctx context.Context, in *authv1.FinishP
 	if err != nil {
 		return nil, handleDomainError(err)
 	}
+	if err := rejectSuspendedUser(baseUser, s.clock().UTC()); err != nil {
+		return nil, err
+	}
 	passkeyUser, err := s.loadPasskeyUser(ctx, baseUser)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Load passkey user: %v", err)
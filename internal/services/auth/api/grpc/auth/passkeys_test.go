@@ -29,6 +29,7 @@ type fakePasskeyStore struct {
 	putErr        error
 	getErr        error
 	listErr       error
+	deleteErr     error
 }
 
 func newFakePasskeyStore() *fakePasskeyStore {
@@ -95,6 +96,35 @@ func (s *fakePasskeyStore) DeletePasskeyCredentialsByUserExcept(_ context.Contex
 	return nil
 }
 
+func (s *fakePasskeyStore) DeleteUserPasskeyCredential(_ context.Context, userID string, credentialID string) (bool, error) {
+	credential, ok := s.credentials[credentialID]
+	if !ok || credential.UserID != userID {
+		return false, storage.ErrNotFound
+	}
+	owned := 0
+	for _, candidate := range s.credentials {
+		if candidate.UserID == userID {
+			owned++
+		}
+	}
+	if owned <= 1 {
+		return false, nil
+	}
+	delete(s.credentials, credentialID)
+	return true, nil
+}
+
+func (s *fakePasskeyStore) RenamePasskeyCredential(_ context.Context, userID string, credentialID string, label string, updatedAt time.Time) error {
+	credential, ok := s.credentials[credentialID]
+	if !ok || credential.UserID != userID {
+		return storage.ErrNotFound
+	}
+	credential.Label = label
+	credential.UpdatedAt = updatedAt
+	s.credentials[credentialID] = credential
+	return nil
+}
+
 func (s *fakePasskeyStore) PutPasskeySession(_ context.Context, session storage.PasskeySession) error {
 	if s.putErr != nil {
 		return s.putErr
@@ -115,6 +145,9 @@ func (s *fakePasskeyStore) GetPasskeySession(_ context.Context, id string) (stor
 }
 
 func (s *fakePasskeyStore) DeletePasskeySession(_ context.Context, id string) error {
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
 	delete(s.sessions, id)
 	return nil
 }
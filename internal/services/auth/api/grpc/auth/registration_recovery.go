@@ -17,7 +17,6 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // BeginAccountRegistration reserves a username and starts the first passkey ceremony.
@@ -283,6 +282,9 @@ func (s *AuthService) BeginAccountRecovery(ctx context.Context, in *authv1.Begin
 	if !recoverycode.Verify(in.GetRecoveryCode(), baseUser.RecoveryCodeHash) {
 		return nil, status.Error(codes.InvalidArgument, "Recovery code is invalid.")
 	}
+	if err := rejectSuspendedUser(baseUser, now); err != nil {
+		return nil, err
+	}
 
 	recoverySessionID, err := s.idGenerator()
 	if err != nil {
@@ -434,15 +436,7 @@ func (s *AuthService) ListPasskeys(ctx context.Context, in *authv1.ListPasskeysR
 	}
 	response := &authv1.ListPasskeysResponse{Passkeys: make([]*authv1.PasskeyCredentialSummary, 0, len(rows))}
 	for _, row := range rows {
-		item := &authv1.PasskeyCredentialSummary{
-			CredentialId: row.CredentialID,
-			CreatedAt:    timestamppb.New(row.CreatedAt),
-			UpdatedAt:    timestamppb.New(row.UpdatedAt),
-		}
-		if row.LastUsedAt != nil {
-			item.LastUsedAt = timestamppb.New(*row.LastUsedAt)
-		}
-		response.Passkeys = append(response.Passkeys, item)
+		response.Passkeys = append(response.Passkeys, passkeyCredentialToProto(row))
 	}
 	return response, nil
 }
@@ -502,6 +496,9 @@ func (s *AuthService) loadRecoverySessionUser(ctx context.Context, recoverySessi
 	if baseUser.RecoveryReservedSessionID != recoverySessionID {
 		return storage.RecoverySession{}, user.User{}, status.Error(codes.FailedPrecondition, "Recovery session is no longer active.")
 	}
+	if err := rejectSuspendedUser(baseUser, s.clock().UTC()); err != nil {
+		return storage.RecoverySession{}, user.User{}, err
+	}
 	return recoverySession, baseUser, nil
 }
 
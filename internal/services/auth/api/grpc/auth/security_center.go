@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/passkey"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxPasskeyLabelLength bounds user-chosen passkey names.
+const maxPasskeyLabelLength = 64
+
+// ListWebSessions returns the user's active web sessions, most recently seen first.
+func (s *AuthService) ListWebSessions(ctx context.Context, in *authv1.ListWebSessionsRequest) (*authv1.ListWebSessionsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "List web sessions request is required.")
+	}
+	if s.webSessionStore == nil {
+		return nil, status.Error(codes.Internal, "Web session store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	sessions, err := s.webSessionStore.ListActiveWebSessionsByUser(ctx, userID, s.clock().UTC())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "List web sessions: %v", err)
+	}
+	response := &authv1.ListWebSessionsResponse{Sessions: make([]*authv1.WebSession, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, webSessionToProto(session))
+	}
+	return response, nil
+}
+
+// RevokeUserWebSession revokes one web session after checking the user owns it.
+func (s *AuthService) RevokeUserWebSession(ctx context.Context, in *authv1.RevokeUserWebSessionRequest) (*authv1.RevokeUserWebSessionResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Revoke user web session request is required.")
+	}
+	if s.webSessionStore == nil {
+		return nil, status.Error(codes.Internal, "Web session store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Session ID is required.")
+	}
+	session, err := s.webSessionStore.GetWebSession(ctx, sessionID)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "Web session not found.")
+		}
+		return nil, status.Errorf(codes.Internal, "Get web session: %v", err)
+	}
+	// Foreign sessions report not found so IDs cannot be probed.
+	if session.UserID != userID {
+		return nil, status.Error(codes.NotFound, "Web session not found.")
+	}
+	if session.RevokedAt != nil {
+		return &authv1.RevokeUserWebSessionResponse{}, nil
+	}
+	if err := s.webSessionStore.RevokeWebSession(ctx, sessionID, s.clock().UTC()); err != nil {
+		return nil, status.Errorf(codes.Internal, "Revoke web session: %v", err)
+	}
+	return &authv1.RevokeUserWebSessionResponse{}, nil
+}
+
+// RevokeOtherWebSessions signs the user out everywhere except the kept session.
+func (s *AuthService) RevokeOtherWebSessions(ctx context.Context, in *authv1.RevokeOtherWebSessionsRequest) (*authv1.RevokeOtherWebSessionsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Revoke other web sessions request is required.")
+	}
+	if s.webSessionStore == nil {
+		return nil, status.Error(codes.Internal, "Web session store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	keepID := strings.TrimSpace(in.GetKeepSessionId())
+	if keepID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Keep session ID is required.")
+	}
+	revoked, err := s.webSessionStore.RevokeOtherWebSessionsByUser(ctx, userID, keepID, s.clock().UTC())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Revoke other web sessions: %v", err)
+	}
+	return &authv1.RevokeOtherWebSessionsResponse{RevokedCount: int32(revoked)}, nil
+}
+
+// RenamePasskey sets the display label of one of the user's passkeys.
+func (s *AuthService) RenamePasskey(ctx context.Context, in *authv1.RenamePasskeyRequest) (*authv1.RenamePasskeyResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Rename passkey request is required.")
+	}
+	if s.passkeyStore == nil {
+		return nil, status.Error(codes.Internal, "Passkey store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	credentialID := strings.TrimSpace(in.GetCredentialId())
+	if credentialID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Credential ID is required.")
+	}
+	label := strings.TrimSpace(in.GetLabel())
+	if utf8.RuneCountInString(label) > maxPasskeyLabelLength {
+		return nil, status.Error(codes.InvalidArgument, "Passkey label is too long.")
+	}
+	if err := s.passkeyStore.RenamePasskeyCredential(ctx, userID, credentialID, label, s.clock().UTC()); err != nil {
+		if err == storage.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "Passkey not found.")
+		}
+		return nil, status.Errorf(codes.Internal, "Rename passkey: %v", err)
+	}
+	renamed, err := s.passkeyStore.GetPasskeyCredential(ctx, credentialID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Get passkey: %v", err)
+	}
+	return &authv1.RenamePasskeyResponse{Passkey: passkeyCredentialToProto(renamed)}, nil
+}
+
+// DeletePasskey removes one of the user's passkeys. The last passkey is kept
+// so the account always retains a sign-in method besides its recovery code.
+func (s *AuthService) DeletePasskey(ctx context.Context, in *authv1.DeletePasskeyRequest) (*authv1.DeletePasskeyResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Delete passkey request is required.")
+	}
+	if s.passkeyStore == nil {
+		return nil, status.Error(codes.Internal, "Passkey store is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	credentialID := strings.TrimSpace(in.GetCredentialId())
+	if credentialID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Credential ID is required.")
+	}
+	deleted, err := s.passkeyStore.DeleteUserPasskeyCredential(ctx, userID, credentialID)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "Passkey not found.")
+		}
+		return nil, status.Errorf(codes.Internal, "Delete passkey: %v", err)
+	}
+	if !deleted {
+		return nil, status.Error(codes.FailedPrecondition, "The last passkey cannot be deleted.")
+	}
+	return &authv1.DeletePasskeyResponse{}, nil
+}
+
+// BeginRecoveryCodeRotation starts the passkey assertion that must succeed
+// before a signed-in user receives a new recovery code.
+func (s *AuthService) BeginRecoveryCodeRotation(ctx context.Context, in *authv1.BeginRecoveryCodeRotationRequest) (*authv1.BeginRecoveryCodeRotationResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Begin recovery code rotation request is required.")
+	}
+	if s.store == nil {
+		return nil, status.Error(codes.Internal, "User store is not configured.")
+	}
+	if s.passkeyStore == nil {
+		return nil, status.Error(codes.Internal, "Passkey store is not configured.")
+	}
+	if s.passkeyInitErr != nil || s.passkeyWebAuthn == nil {
+		return nil, status.Error(codes.Internal, "Passkey configuration is not available.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	baseUser, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if err := requireRotatableAccount(baseUser, s.clock().UTC()); err != nil {
+		return nil, err
+	}
+	passkeyUser, err := s.loadPasskeyUser(ctx, baseUser)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Load passkey user: %v", err)
+	}
+	if len(passkeyUser.credentials) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "A passkey is required to rotate recovery codes.")
+	}
+	assertion, session, err := s.passkeyWebAuthn.BeginLogin(passkeyUser)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Begin passkey reauthentication: %v", err)
+	}
+	sessionID, err := s.newPasskeySessionID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Create passkey session: %v", err)
+	}
+	if err := s.storePasskeySession(ctx, sessionID, passkey.SessionKindReauthentication, baseUser.ID, session); err != nil {
+		return nil, status.Errorf(codes.Internal, "Store passkey session: %v", err)
+	}
+	optionsJSON, err := json.Marshal(assertion)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Encode reauthentication options: %v", err)
+	}
+	return &authv1.BeginRecoveryCodeRotationResponse{
+		SessionId:                    sessionID,
+		CredentialRequestOptionsJson: optionsJSON,
+	}, nil
+}
+
+// FinishRecoveryCodeRotation verifies the passkey assertion and replaces the
+// user's recovery code. The plaintext code is returned exactly once.
+func (s *AuthService) FinishRecoveryCodeRotation(ctx context.Context, in *authv1.FinishRecoveryCodeRotationRequest) (*authv1.FinishRecoveryCodeRotationResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Finish recovery code rotation request is required.")
+	}
+	if s.store == nil {
+		return nil, status.Error(codes.Internal, "User store is not configured.")
+	}
+	if s.passkeyStore == nil {
+		return nil, status.Error(codes.Internal, "Passkey store is not configured.")
+	}
+	if s.passkeyInitErr != nil || s.passkeyWebAuthn == nil {
+		return nil, status.Error(codes.Internal, "Passkey configuration is not available.")
+	}
+	if s.passkeyParser == nil {
+		return nil, status.Error(codes.Internal, "Passkey parser is not configured.")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required.")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Session ID is required.")
+	}
+	if len(in.GetCredentialResponseJson()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Credential response JSON is required.")
+	}
+
+	session, err := s.loadPasskeySession(ctx, sessionID, passkey.SessionKindReauthentication)
+	if err != nil {
+		return nil, err
+	}
+	// A ceremony started for another account must not rotate this one.
+	if session.UserID != userID {
+		return nil, status.Error(codes.PermissionDenied, "Passkey session does not belong to this user.")
+	}
+	parsed, err := s.passkeyParser.ParseCredentialRequestResponseBytes(in.GetCredentialResponseJson())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Parse credential response: %v", err)
+	}
+	baseUser, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	// The account may have been closed or suspended since the ceremony began.
+	if err := requireRotatableAccount(baseUser, s.clock().UTC()); err != nil {
+		return nil, err
+	}
+	passkeyUser, err := s.loadPasskeyUser(ctx, baseUser)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Load passkey user: %v", err)
+	}
+	validatedCredential, err := s.passkeyWebAuthn.ValidateLogin(passkeyUser, session.Data, parsed)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "Validate passkey reauthentication: %v", err)
+	}
+	if err := s.storePasskeyCredential(ctx, baseUser.ID, *validatedCredential, true); err != nil {
+		return nil, status.Errorf(codes.Internal, "Store passkey credential: %v", err)
+	}
+	// The session must be consumed before a code is issued, so one assertion
+	// cannot rotate twice.
+	if err := s.passkeyStore.DeletePasskeySession(ctx, sessionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "Delete passkey session: %v", err)
+	}
+
+	recoveryCode, recoveryHash, err := s.generateRecoveryCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Generate recovery code: %v", err)
+	}
+	now := s.clock().UTC()
+	baseUser.RecoveryCodeHash = recoveryHash
+	baseUser.RecoveryCodeUpdatedAt = now
+	baseUser.UpdatedAt = now
+	if err := s.store.PutUser(ctx, baseUser); err != nil {
+		return nil, status.Errorf(codes.Internal, "Rotate recovery code: %v", err)
+	}
+	return &authv1.FinishRecoveryCodeRotationResponse{RecoveryCode: recoveryCode}, nil
+}
+
+// requireRotatableAccount rejects recovery code rotation for closed or
+// suspended accounts.
+func requireRotatableAccount(u user.User, now time.Time) error {
+	if u.DeletedAt != nil {
+		return status.Error(codes.FailedPrecondition, "Account is closed.")
+	}
+	return rejectSuspendedUser(u, now)
+}
+
+func passkeyCredentialToProto(record storage.PasskeyCredential) *authv1.PasskeyCredentialSummary {
+	out := &authv1.PasskeyCredentialSummary{
+		CredentialId: record.CredentialID,
+		CreatedAt:    timestamppb.New(record.CreatedAt),
+		UpdatedAt:    timestamppb.New(record.UpdatedAt),
+		Label:        record.Label,
+	}
+	if record.LastUsedAt != nil {
+		out.LastUsedAt = timestamppb.New(*record.LastUsedAt)
+	}
+	return out
+}
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+	"github.com/louisbranch/fracturing.space/internal/test/grpcassert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetWebSession_TouchesClientMetadataAtInterval(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	userStore := newFakeUserStore()
+	userStore.users["u1"] = user.User{ID: "u1", Username: "alice", CreatedAt: now, UpdatedAt: now}
+	sessionStore := newFakeWebSessionStore()
+	recent := now.Add(-time.Minute)
+	sessionStore.sessions["s1"] = storage.WebSession{ID: "s1", UserID: "u1", CreatedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour), UserAgent: "Firefox", ClientIP: "10.0.0.1", LastSeenAt: &recent}
+	svc := newTestAuthServiceWithSessions(userStore, sessionStore)
+
+	// Same device inside the interval keeps the stored timestamp.
+	resp, err := svc.GetWebSession(context.Background(), &authv1.GetWebSessionRequest{SessionId: "s1", UserAgent: "Firefox", ClientIp: "10.0.0.1"})
+	if err != nil {
+		t.Fatalf("get web session: %v", err)
+	}
+	if !resp.GetSession().GetLastSeenAt().AsTime().Equal(recent) {
+		t.Fatalf("last seen = %v, want %v", resp.GetSession().GetLastSeenAt().AsTime(), recent)
+	}
+
+	// A new address is recorded immediately.
+	resp, err = svc.GetWebSession(context.Background(), &authv1.GetWebSessionRequest{SessionId: "s1", UserAgent: "Firefox", ClientIp: "10.0.0.9"})
+	if err != nil {
+		t.Fatalf("get web session: %v", err)
+	}
+	if resp.GetSession().GetClientIp() != "10.0.0.9" || !resp.GetSession().GetLastSeenAt().AsTime().Equal(now) {
+		t.Fatalf("session = %+v", resp.GetSession())
+	}
+	if stored := sessionStore.sessions["s1"]; stored.ClientIP != "10.0.0.9" {
+		t.Fatalf("stored session = %+v", stored)
+	}
+}
+
+func TestListAndRevokeUserWebSessions(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	sessionStore := newFakeWebSessionStore()
+	for _, session := range []storage.WebSession{
+		{ID: "s1", UserID: "u1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+		{ID: "s2", UserID: "u1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+		{ID: "s3", UserID: "u1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+		{ID: "foreign", UserID: "u2", CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+	} {
+		sessionStore.sessions[session.ID] = session
+	}
+	svc := newTestAuthServiceWithSessions(newFakeUserStore(), sessionStore)
+	ctx := context.Background()
+
+	listed, err := svc.ListWebSessions(ctx, &authv1.ListWebSessionsRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("list web sessions: %v", err)
+	}
+	if len(listed.GetSessions()) != 3 {
+		t.Fatalf("sessions = %d, want 3", len(listed.GetSessions()))
+	}
+
+	_, err = svc.RevokeUserWebSession(ctx, &authv1.RevokeUserWebSessionRequest{UserId: "u1", SessionId: "foreign"})
+	grpcassert.StatusCode(t, err, codes.NotFound)
+	if sessionStore.sessions["foreign"].RevokedAt != nil {
+		t.Fatal("expected foreign session untouched")
+	}
+
+	if _, err := svc.RevokeUserWebSession(ctx, &authv1.RevokeUserWebSessionRequest{UserId: "u1", SessionId: "s2"}); err != nil {
+		t.Fatalf("revoke user web session: %v", err)
+	}
+	if sessionStore.sessions["s2"].RevokedAt == nil {
+		t.Fatal("expected s2 revoked")
+	}
+
+	_, err = svc.RevokeOtherWebSessions(ctx, &authv1.RevokeOtherWebSessionsRequest{UserId: "u1"})
+	grpcassert.StatusCode(t, err, codes.InvalidArgument)
+	others, err := svc.RevokeOtherWebSessions(ctx, &authv1.RevokeOtherWebSessionsRequest{UserId: "u1", KeepSessionId: "s1"})
+	if err != nil {
+		t.Fatalf("revoke other web sessions: %v", err)
+	}
+	if others.GetRevokedCount() != 1 || sessionStore.sessions["s1"].RevokedAt != nil || sessionStore.sessions["s3"].RevokedAt == nil {
+		t.Fatalf("revoked count = %d sessions = %+v", others.GetRevokedCount(), sessionStore.sessions)
+	}
+}
+
+func TestRenameAndDeletePasskey(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	passkeyStore := newFakePasskeyStore()
+	passkeyStore.credentials["cred-1"] = storage.PasskeyCredential{CredentialID: "cred-1", UserID: "u1", CreatedAt: now}
+	passkeyStore.credentials["cred-2"] = storage.PasskeyCredential{CredentialID: "cred-2", UserID: "u1", CreatedAt: now}
+	passkeyStore.credentials["cred-3"] = storage.PasskeyCredential{CredentialID: "cred-3", UserID: "u2", CreatedAt: now}
+	svc := NewAuthService(newFakeUserStore(), passkeyStore, nil)
+	svc.clock = func() time.Time { return now }
+	ctx := context.Background()
+
+	renamed, err := svc.RenamePasskey(ctx, &authv1.RenamePasskeyRequest{UserId: "u1", CredentialId: "cred-1", Label: "  Laptop  "})
+	if err != nil {
+		t.Fatalf("rename passkey: %v", err)
+	}
+	if renamed.GetPasskey().GetLabel() != "Laptop" {
+		t.Fatalf("label = %q, want Laptop", renamed.GetPasskey().GetLabel())
+	}
+	_, err = svc.RenamePasskey(ctx, &authv1.RenamePasskeyRequest{UserId: "u1", CredentialId: "cred-3", Label: "Mine"})
+	grpcassert.StatusCode(t, err, codes.NotFound)
+
+	_, err = svc.DeletePasskey(ctx, &authv1.DeletePasskeyRequest{UserId: "u1", CredentialId: "cred-3"})
+	grpcassert.StatusCode(t, err, codes.NotFound)
+	if _, err := svc.DeletePasskey(ctx, &authv1.DeletePasskeyRequest{UserId: "u1", CredentialId: "cred-2"}); err != nil {
+		t.Fatalf("delete passkey: %v", err)
+	}
+	_, err = svc.DeletePasskey(ctx, &authv1.DeletePasskeyRequest{UserId: "u1", CredentialId: "cred-1"})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+	if _, ok := passkeyStore.credentials["cred-1"]; !ok {
+		t.Fatal("expected last passkey retained")
+	}
+}
+
+func TestRecoveryCodeRotationRequiresOwnReauthentication(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	userStore := newFakeUserStore()
+	userStore.users["u1"] = user.User{ID: "u1", Username: "alice", RecoveryCodeHash: "old-hash", CreatedAt: now, UpdatedAt: now}
+	userStore.users["u2"] = user.User{ID: "u2", Username: "bob", RecoveryCodeHash: "bob-hash", CreatedAt: now, UpdatedAt: now}
+	passkeyStore := newFakePasskeyStore()
+	credential := webauthn.Credential{ID: []byte("cred-rotate")}
+	payload, err := json.Marshal(credential)
+	if err != nil {
+		t.Fatalf("marshal credential: %v", err)
+	}
+	passkeyStore.credentials[encodeCredentialID(credential.ID)] = storage.PasskeyCredential{
+		CredentialID:   encodeCredentialID(credential.ID),
+		UserID:         "u1",
+		CredentialJSON: string(payload),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	svc := NewAuthService(userStore, passkeyStore, nil)
+	svc.clock = func() time.Time { return now }
+	svc.passkeyWebAuthn = &fakePasskeyProvider{credential: &credential}
+	svc.passkeyInitErr = nil
+	svc.passkeyParser = &fakePasskeyParser{}
+	ctx := context.Background()
+
+	_, err = svc.BeginRecoveryCodeRotation(ctx, &authv1.BeginRecoveryCodeRotationRequest{UserId: "u2"})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+
+	begun, err := svc.BeginRecoveryCodeRotation(ctx, &authv1.BeginRecoveryCodeRotationRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("begin recovery code rotation: %v", err)
+	}
+	_, err = svc.FinishRecoveryCodeRotation(ctx, &authv1.FinishRecoveryCodeRotationRequest{UserId: "u2", SessionId: begun.GetSessionId(), CredentialResponseJson: []byte("{}")})
+	grpcassert.StatusCode(t, err, codes.PermissionDenied)
+	if userStore.users["u2"].RecoveryCodeHash != "bob-hash" {
+		t.Fatal("expected foreign recovery code unchanged")
+	}
+
+	finished, err := svc.FinishRecoveryCodeRotation(ctx, &authv1.FinishRecoveryCodeRotationRequest{UserId: "u1", SessionId: begun.GetSessionId(), CredentialResponseJson: []byte("{}")})
+	if err != nil {
+		t.Fatalf("finish recovery code rotation: %v", err)
+	}
+	rotated := userStore.users["u1"]
+	if finished.GetRecoveryCode() == "" || rotated.RecoveryCodeHash == "old-hash" || !rotated.RecoveryCodeUpdatedAt.Equal(now) {
+		t.Fatalf("code = %q user = %+v", finished.GetRecoveryCode(), rotated)
+	}
+	if _, ok := passkeyStore.sessions[begun.GetSessionId()]; ok {
+		t.Fatal("expected reauthentication session consumed")
+	}
+}
+
+func TestFinishRecoveryCodeRotationRechecksAccountAndConsumesSession(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	credential := webauthn.Credential{ID: []byte("cred-rotate")}
+	payload, err := json.Marshal(credential)
+	if err != nil {
+		t.Fatalf("marshal credential: %v", err)
+	}
+	setup := func(t *testing.T) (*AuthService, *fakeUserStore, *fakePasskeyStore, string) {
+		t.Helper()
+		userStore := newFakeUserStore()
+		userStore.users["u1"] = user.User{ID: "u1", Username: "alice", RecoveryCodeHash: "old-hash", CreatedAt: now, UpdatedAt: now}
+		passkeyStore := newFakePasskeyStore()
+		passkeyStore.credentials[encodeCredentialID(credential.ID)] = storage.PasskeyCredential{
+			CredentialID:   encodeCredentialID(credential.ID),
+			UserID:         "u1",
+			CredentialJSON: string(payload),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		svc := NewAuthService(userStore, passkeyStore, nil)
+		svc.clock = func() time.Time { return now }
+		svc.passkeyWebAuthn = &fakePasskeyProvider{credential: &credential}
+		svc.passkeyInitErr = nil
+		svc.passkeyParser = &fakePasskeyParser{}
+		begun, err := svc.BeginRecoveryCodeRotation(context.Background(), &authv1.BeginRecoveryCodeRotationRequest{UserId: "u1"})
+		if err != nil {
+			t.Fatalf("begin recovery code rotation: %v", err)
+		}
+		return svc, userStore, passkeyStore, begun.GetSessionId()
+	}
+	finish := func(svc *AuthService, sessionID string) error {
+		_, err := svc.FinishRecoveryCodeRotation(context.Background(), &authv1.FinishRecoveryCodeRotationRequest{UserId: "u1", SessionId: sessionID, CredentialResponseJson: []byte("{}")})
+		return err
+	}
+
+	t.Run("closed after begin", func(t *testing.T) {
+		svc, userStore, _, sessionID := setup(t)
+		closed := userStore.users["u1"]
+		closed.DeletedAt = &now
+		userStore.users["u1"] = closed
+		grpcassert.StatusCode(t, finish(svc, sessionID), codes.FailedPrecondition)
+		if userStore.users["u1"].RecoveryCodeHash != "old-hash" {
+			t.Fatal("expected recovery code unchanged")
+		}
+	})
+
+	t.Run("suspended after begin", func(t *testing.T) {
+		svc, userStore, _, sessionID := setup(t)
+		suspended := userStore.users["u1"]
+		suspended.SuspendedAt = &now
+		userStore.users["u1"] = suspended
+		grpcassert.StatusCode(t, finish(svc, sessionID), codes.PermissionDenied)
+		if userStore.users["u1"].RecoveryCodeHash != "old-hash" {
+			t.Fatal("expected recovery code unchanged")
+		}
+	})
+
+	t.Run("session delete fails", func(t *testing.T) {
+		svc, userStore, passkeyStore, sessionID := setup(t)
+		passkeyStore.deleteErr = errors.New("boom")
+		grpcassert.StatusCode(t, finish(svc, sessionID), codes.Internal)
+		if userStore.users["u1"].RecoveryCodeHash != "old-hash" {
+			t.Fatal("expected recovery code unchanged")
+		}
+	})
+}
@@ -232,13 +232,24 @@ func (s *AuthService) ListUsers(ctx context.Context, in *authv1.ListUsersRequest
 }
 
 func userToProto(u user.User) *authv1.User {
-	return &authv1.User{
+	proto := &authv1.User{
 		Id:        u.ID,
 		Username:  u.Username,
 		Locale:    u.Locale,
 		CreatedAt: timestamppb.New(u.CreatedAt),
 		UpdatedAt: timestamppb.New(u.UpdatedAt),
 	}
+	if u.DeletedAt != nil {
+		proto.DeletedAt = timestamppb.New(*u.DeletedAt)
+	}
+	if u.SuspendedAt != nil {
+		proto.SuspendedAt = timestamppb.New(*u.SuspendedAt)
+		proto.SuspensionReason = u.SuspensionReason
+	}
+	if u.SuspendedUntil != nil {
+		proto.SuspendedUntil = timestamppb.New(*u.SuspendedUntil)
+	}
+	return proto
 }
 
 func normalizeUsernameCandidate(input string) (string, bool) {
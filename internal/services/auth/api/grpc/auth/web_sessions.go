@@ -12,6 +12,14 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// webSessionTouchInterval bounds how often request traffic rewrites a
+	// session's last-seen metadata.
+	webSessionTouchInterval      = 5 * time.Minute
+	maxWebSessionUserAgentLength = 512
+	maxWebSessionClientIPLength  = 64
+)
+
 // CreateWebSession creates a durable authenticated web session for a user.
 func (s *AuthService) CreateWebSession(ctx context.Context, in *authv1.CreateWebSessionRequest) (*authv1.CreateWebSessionResponse, error) {
 	if in == nil {
@@ -31,14 +39,28 @@ func (s *AuthService) CreateWebSession(ctx context.Context, in *authv1.CreateWeb
 	if err != nil {
 		return nil, handleDomainError(err)
 	}
+	if found.DeletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Account is closed.")
+	}
+	if err := rejectSuspendedUser(found, s.clock().UTC()); err != nil {
+		return nil, err
+	}
 	ttl := time.Duration(in.GetTtlSeconds()) * time.Second
 	if ttl <= 0 {
 		ttl = defaultWebSessionTTL
 	}
-	session, err := s.createWebSession(ctx, userID, ttl)
+	session, err := s.buildWebSession(userID, ttl)
 	if err != nil {
 		return nil, err
 	}
+	session.UserAgent, session.ClientIP = normalizeWebSessionClient(in.GetUserAgent(), in.GetClientIp())
+	if session.UserAgent != "" || session.ClientIP != "" {
+		seenAt := session.CreatedAt
+		session.LastSeenAt = &seenAt
+	}
+	if err := s.webSessionStore.PutWebSession(ctx, session); err != nil {
+		return nil, status.Errorf(codes.Internal, "Put web session: %v", err)
+	}
 	return &authv1.CreateWebSessionResponse{Session: webSessionToProto(session), User: userToProto(found)}, nil
 }
 
@@ -72,6 +94,12 @@ func (s *AuthService) GetWebSession(ctx context.Context, in *authv1.GetWebSessio
 	if err != nil {
 		return nil, handleDomainError(err)
 	}
+	// Suspension revokes existing sessions, but the lookup still checks so a
+	// session minted concurrently with the suspension cannot outlive it.
+	if err := rejectSuspendedUser(found, now); err != nil {
+		return nil, err
+	}
+	session = s.touchWebSession(ctx, session, now, in.GetUserAgent(), in.GetClientIp())
 	return &authv1.GetWebSessionResponse{Session: webSessionToProto(session), User: userToProto(found)}, nil
 }
 
@@ -106,9 +134,50 @@ func webSessionToProto(session storage.WebSession) *authv1.WebSession {
 	if session.RevokedAt != nil {
 		out.RevokedAt = timestamppb.New(*session.RevokedAt)
 	}
+	out.UserAgent = session.UserAgent
+	out.ClientIp = session.ClientIP
+	if session.LastSeenAt != nil {
+		out.LastSeenAt = timestamppb.New(*session.LastSeenAt)
+	}
 	return out
 }
 
+// touchWebSession refreshes last-seen metadata when the caller reports client
+// details. Writes are coalesced to webSessionTouchInterval unless the device
+// changed, and failures are ignored because session resolution must not depend
+// on bookkeeping.
+func (s *AuthService) touchWebSession(ctx context.Context, session storage.WebSession, now time.Time, userAgent string, clientIP string) storage.WebSession {
+	userAgent, clientIP = normalizeWebSessionClient(userAgent, clientIP)
+	if userAgent == "" && clientIP == "" {
+		return session
+	}
+	unchanged := userAgent == session.UserAgent && clientIP == session.ClientIP
+	if unchanged && session.LastSeenAt != nil && now.Sub(*session.LastSeenAt) < webSessionTouchInterval {
+		return session
+	}
+	if err := s.webSessionStore.TouchWebSession(ctx, session.ID, now, userAgent, clientIP); err != nil {
+		return session
+	}
+	session.UserAgent = userAgent
+	session.ClientIP = clientIP
+	session.LastSeenAt = &now
+	return session
+}
+
+// normalizeWebSessionClient trims and bounds caller-reported client metadata
+// so oversized headers cannot bloat session rows.
+func normalizeWebSessionClient(userAgent string, clientIP string) (string, string) {
+	userAgent = strings.TrimSpace(userAgent)
+	if len(userAgent) > maxWebSessionUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:maxWebSessionUserAgentLength], "")
+	}
+	clientIP = strings.TrimSpace(clientIP)
+	if len(clientIP) > maxWebSessionClientIPLength {
+		clientIP = ""
+	}
+	return userAgent, clientIP
+}
+
 func (s *AuthService) createWebSession(ctx context.Context, userID string, ttl time.Duration) (storage.WebSession, error) {
 	if s == nil || s.webSessionStore == nil {
 		return storage.WebSession{}, status.Error(codes.Internal, "Web session store is not configured.")
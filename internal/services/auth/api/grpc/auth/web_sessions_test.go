@@ -67,6 +67,40 @@ func (s *fakeWebSessionStore) RevokeWebSessionsByUser(_ context.Context, userID
 	return nil
 }
 
+func (s *fakeWebSessionStore) RevokeOtherWebSessionsByUser(_ context.Context, userID string, keepID string, revokedAt time.Time) (int, error) {
+	revoked := 0
+	for id, sess := range s.sessions {
+		if sess.UserID == userID && id != keepID && sess.RevokedAt == nil {
+			sess.RevokedAt = &revokedAt
+			s.sessions[id] = sess
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+func (s *fakeWebSessionStore) ListActiveWebSessionsByUser(_ context.Context, userID string, now time.Time) ([]storage.WebSession, error) {
+	sessions := []storage.WebSession{}
+	for _, sess := range s.sessions {
+		if sess.UserID == userID && sess.RevokedAt == nil && sess.ExpiresAt.After(now) {
+			sessions = append(sessions, sess)
+		}
+	}
+	return sessions, nil
+}
+
+func (s *fakeWebSessionStore) TouchWebSession(_ context.Context, id string, seenAt time.Time, userAgent string, clientIP string) error {
+	sess, ok := s.sessions[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	sess.LastSeenAt = &seenAt
+	sess.UserAgent = userAgent
+	sess.ClientIP = clientIP
+	s.sessions[id] = sess
+	return nil
+}
+
 func (s *fakeWebSessionStore) DeleteExpiredWebSessions(_ context.Context, _ time.Time) error {
 	return nil
 }
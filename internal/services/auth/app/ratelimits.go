@@ -0,0 +1,24 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+)
+
+// rateLimits are the auth throttling defaults. Login and recovery ceremonies
+// arrive through the web service, which forwards each browser's address in
+// gRPC metadata, so those rules budget per browser rather than per web
+// replica. Join grants are budgeted per user. The OAuth token endpoint is
+// budgeted per client IP, which is the X-Forwarded-For address when
+// FRACTURING_SPACE_AUTH_TRUST_FORWARDED_FOR is set behind the edge proxy.
+// Account exports are heavy fan-out jobs, so each user gets a handful per day.
+var rateLimits = ratelimit.Rules{
+	authv1.AuthService_BeginPasskeyLogin_FullMethodName:       {Key: ratelimit.KeyIP, Limit: 600, Period: time.Minute},
+	authv1.AuthService_BeginAccountRecovery_FullMethodName:    {Key: ratelimit.KeyIP, Limit: 120, Period: time.Minute},
+	authv1.AuthService_IssueJoinGrant_FullMethodName:          {Key: ratelimit.KeyUser, Limit: 30, Period: time.Minute, Burst: 10},
+	authv1.AccountService_RequestAccountExport_FullMethodName: {Key: ratelimit.KeyUser, Limit: 5, Period: 24 * time.Hour, Burst: 2},
+	ratelimit.HTTPRule(http.MethodPost, "/token"):             {Key: ratelimit.KeyIP, Limit: 60, Period: time.Minute},
+}
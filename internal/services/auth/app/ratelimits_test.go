@@ -0,0 +1,45 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+)
+
+func TestTokenRateLimitBucketsForwardedClientsSeparately(t *testing.T) {
+	t.Parallel()
+
+	rules := ratelimit.Rules{}
+	for name, policy := range rateLimits {
+		rules[name] = policy
+	}
+	tokenRule := ratelimit.HTTPRule(http.MethodPost, "/token")
+	policy := rules[tokenRule]
+	policy.Limit, policy.Burst = 1, 0
+	rules[tokenRule] = policy
+
+	limiter := ratelimit.New(ratelimit.NewMemoryStore(), rules)
+	handler := newHTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), limiter, true)
+	serve := func(forwarded string) int {
+		req := httptest.NewRequest(http.MethodPost, "/token", nil)
+		req.RemoteAddr = "172.18.0.2:443"
+		req.Header.Set("X-Forwarded-For", forwarded)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	if code := serve("203.0.113.5"); code != http.StatusNoContent {
+		t.Fatalf("client A = %d, want %d", code, http.StatusNoContent)
+	}
+	if code := serve("203.0.113.6"); code != http.StatusNoContent {
+		t.Fatalf("client B behind same proxy = %d, want own bucket", code)
+	}
+	if code := serve("203.0.113.5"); code != http.StatusTooManyRequests {
+		t.Fatalf("client A second = %d, want %d", code, http.StatusTooManyRequests)
+	}
+}
@@ -15,9 +15,11 @@ import (
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/config"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	authservice "github.com/louisbranch/fracturing.space/internal/services/auth/api/grpc/auth"
 	"github.com/louisbranch/fracturing.space/internal/services/auth/oauth"
 	authsqlite "github.com/louisbranch/fracturing.space/internal/services/auth/storage/sqlite"
+	sharedhttpx "github.com/louisbranch/fracturing.space/internal/services/shared/httpx"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
@@ -27,6 +29,9 @@ import (
 // authServerEnv captures env-driven auth startup settings.
 type authServerEnv struct {
 	DBPath string `env:"FRACTURING_SPACE_AUTH_DB_PATH"`
+	// TrustForwardedFor keys IP rate limits by X-Forwarded-For. Enable only
+	// behind a proxy that overwrites the header.
+	TrustForwardedFor bool `env:"FRACTURING_SPACE_AUTH_TRUST_FORWARDED_FOR" envDefault:"false"`
 }
 
 type runtimeDeps struct {
@@ -67,6 +72,7 @@ type Server struct {
 	httpServer   *http.Server
 	oauthStore   *oauth.Store
 	oauthServer  *oauth.Server
+	limiter      *ratelimit.Limiter
 	closeOnce    sync.Once
 	logf         func(format string, args ...any)
 }
@@ -115,6 +121,12 @@ func newWithDeps(port int, httpAddr string, deps runtimeDeps) (*Server, error) {
 	if oauthConfig.Issuer == "" {
 		oauthConfig.Issuer = defaultOAuthIssuer(httpAddr)
 	}
+	limiter, err := ratelimit.NewFromEnv(rateLimits)
+	if err != nil {
+		_ = listener.Close()
+		_ = store.Close()
+		return nil, fmt.Errorf("Configure rate limits: %w", err)
+	}
 	var httpListener net.Listener
 	var httpServer *http.Server
 	var oauthServer *oauth.Server
@@ -123,6 +135,7 @@ func newWithDeps(port int, httpAddr string, deps runtimeDeps) (*Server, error) {
 		if err != nil {
 			_ = listener.Close()
 			_ = store.Close()
+			_ = limiter.Close()
 			return nil, fmt.Errorf("Listen on HTTP address %s: %w", httpAddr, err)
 		}
 		mux := http.NewServeMux()
@@ -131,26 +144,32 @@ func newWithDeps(port int, httpAddr string, deps runtimeDeps) (*Server, error) {
 			_ = httpListener.Close()
 			_ = listener.Close()
 			_ = store.Close()
+			_ = limiter.Close()
 			return nil, fmt.Errorf("Register OAuth routes: %w", err)
 		}
-		httpServer = &http.Server{Handler: mux}
+		httpServer = &http.Server{Handler: newHTTPHandler(mux, limiter, srvEnv.TrustForwardedFor)}
 	}
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(limiter.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(limiter.StreamServerInterceptor()),
 	)
 	authService := authservice.NewAuthService(store, store, oauthStore)
 	statisticsService := authservice.NewStatisticsService(store)
 	accountService := authservice.NewAccountService(store)
+	moderationService := authservice.NewModerationService(store)
 	healthServer := health.NewServer()
 	authv1.RegisterAuthServiceServer(grpcServer, authService)
 	authv1.RegisterStatisticsServiceServer(grpcServer, statisticsService)
 	authv1.RegisterAccountServiceServer(grpcServer, accountService)
+	authv1.RegisterModerationServiceServer(grpcServer, moderationService)
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 	healthServer.SetServingStatus("auth.v1.AuthService", grpc_health_v1.HealthCheckResponse_SERVING)
 	healthServer.SetServingStatus("auth.v1.StatisticsService", grpc_health_v1.HealthCheckResponse_SERVING)
 	healthServer.SetServingStatus("auth.v1.AccountService", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("auth.v1.ModerationService", grpc_health_v1.HealthCheckResponse_SERVING)
 
 	return &Server{
 		listener:     listener,
@@ -161,10 +180,18 @@ func newWithDeps(port int, httpAddr string, deps runtimeDeps) (*Server, error) {
 		httpServer:   httpServer,
 		oauthStore:   oauthStore,
 		oauthServer:  oauthServer,
+		limiter:      limiter,
 		logf:         deps.logf,
 	}, nil
 }
 
+// newHTTPHandler wraps the OAuth routes with rate limiting. Behind the edge
+// proxy every caller shares the proxy address, so IP rules must read the
+// forwarded client when the proxy is trusted.
+func newHTTPHandler(mux http.Handler, limiter *ratelimit.Limiter, trustForwardedFor bool) http.Handler {
+	return sharedhttpx.Chain(mux, limiter.Middleware(ratelimit.HTTPOptions{TrustForwardedFor: trustForwardedFor}))
+}
+
 // Addr returns the gRPC listener address for the auth server.
 func (s *Server) Addr() string {
 	if s == nil || s.listener == nil {
@@ -317,6 +344,9 @@ func (s *Server) Close() {
 				logf("close auth store: %v", err)
 			}
 		}
+		if err := s.limiter.Close(); err != nil {
+			logf("close auth rate limiter: %v", err)
+		}
 	})
 }
 
@@ -328,6 +328,16 @@ func (s *Server) handleIntrospect(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusOK, introspectResponse{Active: false})
 		return
 	}
+	// Suspension deletes issued tokens; this check covers tokens minted while
+	// the suspension transaction was in flight. A failed lookup cannot prove
+	// the account is in good standing, so the token is reported inactive.
+	if s.userStore != nil {
+		account, err := s.userStore.GetUser(r.Context(), entry.UserID)
+		if err != nil || account.IsSuspended(s.clock().UTC()) {
+			writeJSON(w, http.StatusOK, introspectResponse{Active: false})
+			return
+		}
+	}
 
 	writeJSON(w, http.StatusOK, introspectResponse{
 		Active:   true,
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
 	authsqlite "github.com/louisbranch/fracturing.space/internal/services/auth/storage/sqlite"
 	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
 )
@@ -682,6 +683,10 @@ func TestHandleIntrospect(t *testing.T) {
 
 	t.Run("valid token returns active", func(t *testing.T) {
 		server, oauthStore := testServer(t)
+		now := time.Now().UTC()
+		if err := server.userStore.PutUser(context.Background(), user.User{ID: "user-1", Username: "alice", CreatedAt: now, UpdatedAt: now}); err != nil {
+			t.Fatalf("put user: %v", err)
+		}
 		token, err := oauthStore.CreateAccessToken("test-client", "user-1", "openid", time.Hour)
 		if err != nil {
 			t.Fatalf("create token: %v", err)
@@ -709,6 +714,62 @@ func TestHandleIntrospect(t *testing.T) {
 			t.Errorf("expected user_id %q, got %q", "user-1", resp.UserID)
 		}
 	})
+
+	t.Run("suspended user token returns inactive", func(t *testing.T) {
+		server, oauthStore := testServer(t)
+		now := time.Now().UTC()
+		if err := server.userStore.PutUser(context.Background(), user.User{ID: "user-1", Username: "alice", CreatedAt: now, UpdatedAt: now}); err != nil {
+			t.Fatalf("put user: %v", err)
+		}
+		moderation := server.userStore.(storage.ModerationStore)
+		if err := moderation.SuspendUserWithIntegrationOutboxEvent(context.Background(),
+			storage.UserSuspension{UserID: "user-1", Reason: "spam", SuspendedAt: now},
+			storage.ModerationAction{ID: "act-1", Action: "user.suspend", TargetKind: storage.ModerationTargetUser, TargetID: "user-1", CreatedAt: now},
+			storage.IntegrationOutboxEvent{ID: "evt-1", EventType: "auth.user_suspended", PayloadJSON: "{}", DedupeKey: "act-1", Status: storage.IntegrationOutboxStatusPending, CreatedAt: now},
+		); err != nil {
+			t.Fatalf("suspend user: %v", err)
+		}
+		// Minted after the suspension committed, so only introspection can stop it.
+		token, err := oauthStore.CreateAccessToken("test-client", "user-1", "openid", time.Hour)
+		if err != nil {
+			t.Fatalf("create token: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/introspect", nil)
+		req.Header.Set("X-Resource-Secret", "test-resource-secret")
+		req.Header.Set("Authorization", "Bearer "+token.Token)
+		w := httptest.NewRecorder()
+		server.handleIntrospect(w, req)
+		var resp introspectResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if resp.Active {
+			t.Error("expected suspended user token to be inactive")
+		}
+	})
+
+	t.Run("user lookup failure returns inactive", func(t *testing.T) {
+		server, oauthStore := testServer(t)
+		// No user record exists, so the suspension check cannot pass.
+		token, err := oauthStore.CreateAccessToken("test-client", "user-1", "openid", time.Hour)
+		if err != nil {
+			t.Fatalf("create token: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/introspect", nil)
+		req.Header.Set("X-Resource-Secret", "test-resource-secret")
+		req.Header.Set("Authorization", "Bearer "+token.Token)
+		w := httptest.NewRecorder()
+		server.handleIntrospect(w, req)
+		var resp introspectResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if resp.Active {
+			t.Error("expected token to be inactive when the user lookup fails")
+		}
+	})
 }
 
 func TestHelperFunctions(t *testing.T) {
@@ -3,6 +3,7 @@
 package oauth
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	authsqlite "github.com/louisbranch/fracturing.space/internal/services/auth/storage/sqlite"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
 )
 
 // TestOAuthAuthorizationCodeFlow exercises the authorize → consent → token → introspect
@@ -78,6 +80,10 @@ func TestOAuthAuthorizationCodeFlow(t *testing.T) {
 
 	// Step 2: Simulate authentication by setting the user ID on the pending authorization.
 	userID := "integration-user-1"
+	now := time.Now().UTC()
+	if err := store.PutUser(context.Background(), user.User{ID: userID, Username: "integration", CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatalf("put user: %v", err)
+	}
 	if err := oauthStore.UpdatePendingAuthorizationUserID(pendingID, userID); err != nil {
 		t.Fatalf("set pending user id: %v", err)
 	}
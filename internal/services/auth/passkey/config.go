@@ -14,6 +14,9 @@ type SessionKind string
 const (
 	SessionKindRegistration SessionKind = "registration"
 	SessionKindLogin        SessionKind = "login"
+	// SessionKindReauthentication proves a signed-in user still holds a
+	// passkey before a sensitive account change.
+	SessionKindReauthentication SessionKind = "reauthentication"
 )
 
 // Config controls WebAuthn relying party settings.
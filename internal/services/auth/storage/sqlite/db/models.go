@@ -8,6 +8,24 @@ import (
 	"database/sql"
 )
 
+type AccountDeletion struct {
+	UserID    string `json:"user_id"`
+	DeletedAt int64  `json:"deleted_at"`
+}
+
+type AccountExport struct {
+	ID          string        `json:"id"`
+	UserID      string        `json:"user_id"`
+	Status      string        `json:"status"`
+	Archive     []byte        `json:"archive"`
+	ArchiveSize int64         `json:"archive_size"`
+	LastError   string        `json:"last_error"`
+	CreatedAt   int64         `json:"created_at"`
+	UpdatedAt   int64         `json:"updated_at"`
+	CompletedAt sql.NullInt64 `json:"completed_at"`
+	ExpiresAt   sql.NullInt64 `json:"expires_at"`
+}
+
 type AuthIntegrationOutbox struct {
 	ID             string        `json:"id"`
 	EventType      string        `json:"event_type"`
@@ -65,6 +83,7 @@ type Passkey struct {
 	CreatedAt      int64         `json:"created_at"`
 	UpdatedAt      int64         `json:"updated_at"`
 	LastUsedAt     sql.NullInt64 `json:"last_used_at"`
+	Label          string        `json:"label"`
 }
 
 type PasskeySession struct {
@@ -78,6 +78,25 @@ func (q *Queries) DeletePasskeysByUserExcept(ctx context.Context, arg DeletePass
 	return err
 }
 
+const deleteUserPasskeyUnlessLast = `-- name: DeleteUserPasskeyUnlessLast :execrows
+DELETE FROM passkeys
+WHERE credential_id = ?1 AND user_id = ?2
+  AND (SELECT COUNT(*) FROM passkeys WHERE user_id = ?2) > 1
+`
+
+type DeleteUserPasskeyUnlessLastParams struct {
+	CredentialID string `json:"credential_id"`
+	UserID       string `json:"user_id"`
+}
+
+func (q *Queries) DeleteUserPasskeyUnlessLast(ctx context.Context, arg DeleteUserPasskeyUnlessLastParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserPasskeyUnlessLast, arg.CredentialID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteRecoverySession = `-- name: DeleteRecoverySession :exec
 DELETE FROM recovery_sessions WHERE id = ?
 `
@@ -97,7 +116,7 @@ func (q *Queries) DeleteRegistrationSession(ctx context.Context, id string) erro
 }
 
 const getPasskey = `-- name: GetPasskey :one
-SELECT credential_id, user_id, credential_json, created_at, updated_at, last_used_at FROM passkeys WHERE credential_id = ?
+SELECT credential_id, user_id, credential_json, created_at, updated_at, last_used_at, label FROM passkeys WHERE credential_id = ?
 `
 
 func (q *Queries) GetPasskey(ctx context.Context, credentialID string) (Passkey, error) {
@@ -110,6 +129,7 @@ func (q *Queries) GetPasskey(ctx context.Context, credentialID string) (Passkey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LastUsedAt,
+		&i.Label,
 	)
 	return i, err
 }
@@ -192,7 +212,7 @@ func (q *Queries) GetRegistrationSessionByUsername(ctx context.Context, username
 }
 
 const listPasskeysByUser = `-- name: ListPasskeysByUser :many
-SELECT credential_id, user_id, credential_json, created_at, updated_at, last_used_at FROM passkeys WHERE user_id = ? ORDER BY credential_id
+SELECT credential_id, user_id, credential_json, created_at, updated_at, last_used_at, label FROM passkeys WHERE user_id = ? ORDER BY credential_id
 `
 
 func (q *Queries) ListPasskeysByUser(ctx context.Context, userID string) ([]Passkey, error) {
@@ -211,6 +231,7 @@ func (q *Queries) ListPasskeysByUser(ctx context.Context, userID string) ([]Pass
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LastUsedAt,
+			&i.Label,
 		); err != nil {
 			return nil, err
 		}
@@ -257,6 +278,30 @@ func (q *Queries) PutPasskey(ctx context.Context, arg PutPasskeyParams) error {
 	return err
 }
 
+const renamePasskey = `-- name: RenamePasskey :execrows
+UPDATE passkeys SET label = ?, updated_at = ? WHERE credential_id = ? AND user_id = ?
+`
+
+type RenamePasskeyParams struct {
+	Label        string `json:"label"`
+	UpdatedAt    int64  `json:"updated_at"`
+	CredentialID string `json:"credential_id"`
+	UserID       string `json:"user_id"`
+}
+
+func (q *Queries) RenamePasskey(ctx context.Context, arg RenamePasskeyParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, renamePasskey,
+		arg.Label,
+		arg.UpdatedAt,
+		arg.CredentialID,
+		arg.UserID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const putPasskeySession = `-- name: PutPasskeySession :exec
 INSERT INTO passkey_sessions (
     id, kind, user_id, session_json, expires_at
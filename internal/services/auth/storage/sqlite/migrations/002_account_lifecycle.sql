@@ -0,0 +1,23 @@
+-- Account data exports and closed-account tombstones.
+
+CREATE TABLE account_exports (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    archive BLOB,
+    archive_size INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    completed_at INTEGER,
+    expires_at INTEGER
+);
+CREATE INDEX account_exports_user_created_idx
+ON account_exports(user_id, created_at DESC, id);
+CREATE INDEX account_exports_expires_idx
+ON account_exports(expires_at)
+WHERE expires_at IS NOT NULL;
+CREATE TABLE account_deletions (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    deleted_at INTEGER NOT NULL
+);
@@ -0,0 +1,9 @@
+-- Session device metadata and user-chosen passkey labels for the account
+-- security center.
+
+ALTER TABLE web_sessions ADD COLUMN user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE web_sessions ADD COLUMN client_ip TEXT NOT NULL DEFAULT '';
+ALTER TABLE web_sessions ADD COLUMN last_seen_at INTEGER;
+CREATE INDEX web_sessions_user_idx
+ON web_sessions(user_id, revoked_at, expires_at);
+ALTER TABLE passkeys ADD COLUMN label TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,23 @@
+-- Operator user suspensions and the shared moderation audit log.
+
+CREATE TABLE user_suspensions (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    actor_user_id TEXT NOT NULL DEFAULT '',
+    suspended_at INTEGER NOT NULL,
+    expires_at INTEGER
+);
+CREATE TABLE moderation_actions (
+    id TEXT PRIMARY KEY,
+    action TEXT NOT NULL,
+    target_kind TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    actor_user_id TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at INTEGER,
+    created_at INTEGER NOT NULL
+);
+CREATE INDEX moderation_actions_created_idx
+ON moderation_actions(created_at DESC, id DESC);
+CREATE INDEX moderation_actions_target_idx
+ON moderation_actions(target_kind, target_id, created_at DESC);
@@ -26,6 +26,9 @@ func TestMigrationsEmbedded(t *testing.T) {
 
 	expected := []string{
 		"001_auth.sql",
+		"002_account_lifecycle.sql",
+		"003_security_center.sql",
+		"004_moderation.sql",
 	}
 	if len(files) != len(expected) {
 		t.Fatalf("migration file count = %d, want %d (%v)", len(files), len(expected), expected)
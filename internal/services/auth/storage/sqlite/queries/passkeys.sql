@@ -23,6 +23,14 @@ DELETE FROM passkeys WHERE user_id = ?;
 -- name: DeletePasskeysByUserExcept :exec
 DELETE FROM passkeys WHERE user_id = ? AND credential_id <> ?;
 
+-- name: DeleteUserPasskeyUnlessLast :execrows
+DELETE FROM passkeys
+WHERE credential_id = ?1 AND user_id = ?2
+  AND (SELECT COUNT(*) FROM passkeys WHERE user_id = ?2) > 1;
+
+-- name: RenamePasskey :execrows
+UPDATE passkeys SET label = ?, updated_at = ? WHERE credential_id = ? AND user_id = ?;
+
 -- name: PutPasskeySession :exec
 INSERT INTO passkey_sessions (
     id, kind, user_id, session_json, expires_at
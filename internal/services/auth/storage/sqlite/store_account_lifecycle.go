@@ -0,0 +1,365 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+)
+
+const accountExportColumns = `id, user_id, status, archive_size, last_error, created_at, updated_at, completed_at, expires_at`
+
+// PutAccountExportWithIntegrationOutboxEvent records a pending export and the
+// worker event that assembles it in one write unit.
+func (s *Store) PutAccountExportWithIntegrationOutboxEvent(ctx context.Context, export storage.AccountExport, event storage.IntegrationOutboxEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	export.ID = strings.TrimSpace(export.ID)
+	export.UserID = strings.TrimSpace(export.UserID)
+	if export.ID == "" {
+		return fmt.Errorf("Export ID is required.")
+	}
+	if export.UserID == "" {
+		return fmt.Errorf("User ID is required.")
+	}
+	if export.CreatedAt.IsZero() {
+		return fmt.Errorf("Created at is required.")
+	}
+	if export.UpdatedAt.IsZero() {
+		export.UpdatedAt = export.CreatedAt
+	}
+	if strings.TrimSpace(export.Status) == "" {
+		export.Status = storage.AccountExportStatusPending
+	}
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Start transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO account_exports (id, user_id, status, archive_size, last_error, created_at, updated_at)
+VALUES (?, ?, ?, 0, '', ?, ?)`,
+		export.ID,
+		export.UserID,
+		export.Status,
+		sqliteutil.ToMillis(export.CreatedAt.UTC()),
+		sqliteutil.ToMillis(export.UpdatedAt.UTC()),
+	); err != nil {
+		return fmt.Errorf("Put account export: %w", err)
+	}
+	if err := enqueueIntegrationOutboxEvent(ctx, tx, event); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Commit account export + outbox: %w", err)
+	}
+	return nil
+}
+
+// GetAccountExport fetches one export, including its archive bytes.
+func (s *Store) GetAccountExport(ctx context.Context, id string) (storage.AccountExport, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.AccountExport{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.AccountExport{}, fmt.Errorf("Storage is not configured.")
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return storage.AccountExport{}, fmt.Errorf("Export ID is required.")
+	}
+
+	row := s.sqlDB.QueryRowContext(ctx, `SELECT `+accountExportColumns+`, archive FROM account_exports WHERE id = ?`, id)
+	var archive []byte
+	export, err := scanAccountExport(row.Scan, &archive)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.AccountExport{}, storage.ErrNotFound
+		}
+		return storage.AccountExport{}, fmt.Errorf("Get account export: %w", err)
+	}
+	export.Archive = archive
+	return export, nil
+}
+
+// ListAccountExports returns a user's most recent exports without archives.
+func (s *Store) ListAccountExports(ctx context.Context, userID string, limit int) ([]storage.AccountExport, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("Storage is not configured.")
+	}
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("User ID is required.")
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("Limit must be greater than zero.")
+	}
+
+	rows, err := s.sqlDB.QueryContext(ctx, `
+SELECT `+accountExportColumns+`
+FROM account_exports
+WHERE user_id = ?
+ORDER BY created_at DESC, id
+LIMIT ?`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("List account exports: %w", err)
+	}
+	defer rows.Close()
+
+	exports := make([]storage.AccountExport, 0, limit)
+	for rows.Next() {
+		export, err := scanAccountExport(rows.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("Scan account export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Iterate account exports: %w", err)
+	}
+	return exports, nil
+}
+
+// CompleteAccountExport stores the finished archive for a pending export.
+func (s *Store) CompleteAccountExport(ctx context.Context, id string, archive []byte, completedAt time.Time, expiresAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return fmt.Errorf("Export ID is required.")
+	}
+	if len(archive) == 0 {
+		return fmt.Errorf("Archive is required.")
+	}
+	completed := sqliteutil.ToMillis(completedAt.UTC())
+	return s.finishAccountExport(ctx, id, `
+UPDATE account_exports
+SET status = ?, archive = ?, archive_size = ?, last_error = '', updated_at = ?, completed_at = ?, expires_at = ?
+WHERE id = ? AND status = ?`,
+		storage.AccountExportStatusReady,
+		archive,
+		int64(len(archive)),
+		completed,
+		completed,
+		sqliteutil.ToMillis(expiresAt.UTC()),
+		id,
+		storage.AccountExportStatusPending,
+	)
+}
+
+// FailAccountExport records why a pending export could not be assembled.
+func (s *Store) FailAccountExport(ctx context.Context, id string, lastError string, failedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return fmt.Errorf("Export ID is required.")
+	}
+	failed := sqliteutil.ToMillis(failedAt.UTC())
+	return s.finishAccountExport(ctx, id, `
+UPDATE account_exports
+SET status = ?, last_error = ?, updated_at = ?, completed_at = ?
+WHERE id = ? AND status = ?`,
+		storage.AccountExportStatusFailed,
+		strings.TrimSpace(lastError),
+		failed,
+		failed,
+		id,
+		storage.AccountExportStatusPending,
+	)
+}
+
+// finishAccountExport applies a pending-only transition and reports a missing
+// export as not found. Already-finished exports are left untouched so worker
+// retries stay idempotent.
+func (s *Store) finishAccountExport(ctx context.Context, id string, query string, args ...any) error {
+	res, err := s.sqlDB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("Finish account export: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Finish account export rows affected: %w", err)
+	}
+	if affected > 0 {
+		return nil
+	}
+	var exists int
+	if err := s.sqlDB.QueryRowContext(ctx, `SELECT 1 FROM account_exports WHERE id = ?`, id).Scan(&exists); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ErrNotFound
+		}
+		return fmt.Errorf("Check account export: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredAccountExports removes archives past their download window.
+func (s *Store) DeleteExpiredAccountExports(ctx context.Context, now time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+	_, err := s.sqlDB.ExecContext(ctx, `DELETE FROM account_exports WHERE expires_at IS NOT NULL AND expires_at <= ?`, sqliteutil.ToMillis(now.UTC()))
+	if err != nil {
+		return fmt.Errorf("Delete expired account exports: %w", err)
+	}
+	return nil
+}
+
+// DeleteAccountWithIntegrationOutboxEvent closes an account atomically.
+func (s *Store) DeleteAccountWithIntegrationOutboxEvent(ctx context.Context, tombstone user.User, deletedAt time.Time, event storage.IntegrationOutboxEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	if deletedAt.IsZero() {
+		return fmt.Errorf("Deleted at is required.")
+	}
+	params, err := normalizeUserPutParams(tombstone)
+	if err != nil {
+		return err
+	}
+	userID := params.ID
+	deleted := sqliteutil.ToMillis(deletedAt.UTC())
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Start transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := s.q.WithTx(tx).PutUser(ctx, params); err != nil {
+		return fmt.Errorf("Put user: %w", err)
+	}
+	statements := []struct {
+		label string
+		query string
+		args  []any
+	}{
+		{"record deletion", `INSERT INTO account_deletions (user_id, deleted_at) VALUES (?, ?) ON CONFLICT(user_id) DO NOTHING`, []any{userID, deleted}},
+		{"delete passkeys", `DELETE FROM passkeys WHERE user_id = ?`, []any{userID}},
+		{"delete passkey sessions", `DELETE FROM passkey_sessions WHERE user_id = ?`, []any{userID}},
+		{"delete recovery sessions", `DELETE FROM recovery_sessions WHERE user_id = ?`, []any{userID}},
+		{"delete registration sessions", `DELETE FROM registration_sessions WHERE user_id = ?`, []any{userID}},
+		{"revoke web sessions", `UPDATE web_sessions SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL`, []any{deleted, userID}},
+		{"delete oauth access tokens", `DELETE FROM oauth_access_tokens WHERE user_id = ?`, []any{userID}},
+		{"delete oauth authorization codes", `DELETE FROM oauth_authorization_codes WHERE user_id = ?`, []any{userID}},
+		{"delete oauth pending authorizations", `DELETE FROM oauth_pending_authorizations WHERE user_id = ?`, []any{userID}},
+		{"delete account exports", `DELETE FROM account_exports WHERE user_id = ?`, []any{userID}},
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return fmt.Errorf("Delete account: %s: %w", stmt.label, err)
+		}
+	}
+	if err := enqueueIntegrationOutboxEvent(ctx, tx, event); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Commit account deletion: %w", err)
+	}
+	return nil
+}
+
+// applyAccountDeletions fills DeletedAt for any closed accounts in users.
+func (s *Store) applyAccountDeletions(ctx context.Context, users []user.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+	placeholders := make([]string, len(users))
+	args := make([]any, len(users))
+	index := make(map[string]int, len(users))
+	for i, u := range users {
+		placeholders[i] = "?"
+		args[i] = u.ID
+		index[u.ID] = i
+	}
+	rows, err := s.sqlDB.QueryContext(ctx, `SELECT user_id, deleted_at FROM account_deletions WHERE user_id IN (`+strings.Join(placeholders, ", ")+`)`, args...)
+	if err != nil {
+		return fmt.Errorf("Load account deletions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userID string
+		var deletedAt int64
+		if err := rows.Scan(&userID, &deletedAt); err != nil {
+			return fmt.Errorf("Scan account deletion: %w", err)
+		}
+		if i, ok := index[userID]; ok {
+			value := sqliteutil.FromMillis(deletedAt)
+			users[i].DeletedAt = &value
+		}
+	}
+	return rows.Err()
+}
+
+func scanAccountExport(scan func(dest ...any) error, extra ...any) (storage.AccountExport, error) {
+	var export storage.AccountExport
+	var createdAt int64
+	var updatedAt int64
+	var completedAt sql.NullInt64
+	var expiresAt sql.NullInt64
+	dest := []any{
+		&export.ID,
+		&export.UserID,
+		&export.Status,
+		&export.ArchiveSize,
+		&export.LastError,
+		&createdAt,
+		&updatedAt,
+		&completedAt,
+		&expiresAt,
+	}
+	if err := scan(append(dest, extra...)...); err != nil {
+		return storage.AccountExport{}, err
+	}
+	export.CreatedAt = sqliteutil.FromMillis(createdAt)
+	export.UpdatedAt = sqliteutil.FromMillis(updatedAt)
+	if completedAt.Valid {
+		value := sqliteutil.FromMillis(completedAt.Int64)
+		export.CompletedAt = &value
+	}
+	if expiresAt.Valid {
+		value := sqliteutil.FromMillis(expiresAt.Int64)
+		export.ExpiresAt = &value
+	}
+	return export, nil
+}
+
+var _ storage.AccountExportStore = (*Store)(nil)
+var _ storage.AccountDeletionStore = (*Store)(nil)
@@ -0,0 +1,171 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+)
+
+func TestAccountExportLifecycle(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	putTestUser(t, store, "user-1", "alice", now)
+
+	export := storage.AccountExport{ID: "export-1", UserID: "user-1", CreatedAt: now}
+	event := storage.IntegrationOutboxEvent{
+		ID:          "event-1",
+		EventType:   "auth.account_export_requested",
+		PayloadJSON: `{"export_id":"export-1"}`,
+		DedupeKey:   "account_export_requested:export:export-1:v1",
+		Status:      storage.IntegrationOutboxStatusPending,
+		CreatedAt:   now,
+	}
+	if err := store.PutAccountExportWithIntegrationOutboxEvent(ctx, export, event); err != nil {
+		t.Fatalf("put account export: %v", err)
+	}
+	if _, err := store.GetIntegrationOutboxEvent(ctx, "event-1"); err != nil {
+		t.Fatalf("get export outbox event: %v", err)
+	}
+
+	got, err := store.GetAccountExport(ctx, "export-1")
+	if err != nil {
+		t.Fatalf("get account export: %v", err)
+	}
+	if got.Status != storage.AccountExportStatusPending || got.UserID != "user-1" {
+		t.Fatalf("pending export = %+v", got)
+	}
+
+	expiresAt := now.Add(7 * 24 * time.Hour)
+	if err := store.CompleteAccountExport(ctx, "export-1", []byte("zip"), now.Add(time.Minute), expiresAt); err != nil {
+		t.Fatalf("complete account export: %v", err)
+	}
+	// A late failure from a retried job must not clobber the ready archive.
+	if err := store.FailAccountExport(ctx, "export-1", "late", now.Add(2*time.Minute)); err != nil {
+		t.Fatalf("fail finished export: %v", err)
+	}
+	got, err = store.GetAccountExport(ctx, "export-1")
+	if err != nil {
+		t.Fatalf("get ready export: %v", err)
+	}
+	if got.Status != storage.AccountExportStatusReady || string(got.Archive) != "zip" || got.ArchiveSize != 3 {
+		t.Fatalf("ready export = %+v", got)
+	}
+	if got.ExpiresAt == nil || !got.ExpiresAt.Equal(expiresAt) {
+		t.Fatalf("expires at = %v, want %v", got.ExpiresAt, expiresAt)
+	}
+
+	listed, err := store.ListAccountExports(ctx, "user-1", 5)
+	if err != nil {
+		t.Fatalf("list account exports: %v", err)
+	}
+	if len(listed) != 1 || listed[0].Archive != nil {
+		t.Fatalf("listed exports = %+v, want one without archive bytes", listed)
+	}
+
+	if err := store.CompleteAccountExport(ctx, "missing", []byte("zip"), now, expiresAt); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("complete missing export err = %v, want not found", err)
+	}
+
+	if err := store.DeleteExpiredAccountExports(ctx, expiresAt); err != nil {
+		t.Fatalf("delete expired exports: %v", err)
+	}
+	if _, err := store.GetAccountExport(ctx, "export-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("get expired export err = %v, want not found", err)
+	}
+}
+
+func TestDeleteAccountWithIntegrationOutboxEventClosesAccount(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	putTestUser(t, store, "user-1", "alice", now)
+	u, err := store.GetUser(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+
+	if err := store.PutPasskeyCredential(ctx, storage.PasskeyCredential{
+		CredentialID:   "cred-1",
+		UserID:         "user-1",
+		CredentialJSON: "{}",
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}); err != nil {
+		t.Fatalf("put passkey: %v", err)
+	}
+	if err := store.PutWebSession(ctx, storage.WebSession{
+		ID:        "ws-1",
+		UserID:    "user-1",
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}); err != nil {
+		t.Fatalf("put web session: %v", err)
+	}
+	if err := store.PutAccountExportWithIntegrationOutboxEvent(ctx, storage.AccountExport{ID: "export-1", UserID: "user-1", CreatedAt: now}, storage.IntegrationOutboxEvent{
+		ID:        "event-export",
+		EventType: "auth.account_export_requested",
+		DedupeKey: "export",
+		Status:    storage.IntegrationOutboxStatusPending,
+		CreatedAt: now,
+	}); err != nil {
+		t.Fatalf("put export: %v", err)
+	}
+
+	deletedAt := now.Add(time.Hour)
+	if err := store.DeleteAccountWithIntegrationOutboxEvent(ctx, user.Tombstone(u, deletedAt), deletedAt, storage.IntegrationOutboxEvent{
+		ID:        "event-delete",
+		EventType: "auth.account_deleted",
+		DedupeKey: "account_deleted:user:user-1:v1",
+		Status:    storage.IntegrationOutboxStatusPending,
+		CreatedAt: deletedAt,
+	}); err != nil {
+		t.Fatalf("delete account: %v", err)
+	}
+
+	got, err := store.GetUser(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("get deleted user: %v", err)
+	}
+	if got.DeletedAt == nil || !got.DeletedAt.Equal(deletedAt) {
+		t.Fatalf("deleted at = %v, want %v", got.DeletedAt, deletedAt)
+	}
+	if got.Username == "alice" {
+		t.Fatalf("tombstone user = %+v", got)
+	}
+	if _, err := store.GetUserByUsername(ctx, "alice"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("original username lookup err = %v, want not found", err)
+	}
+	page, err := store.ListUsers(ctx, 10, "")
+	if err != nil {
+		t.Fatalf("list users: %v", err)
+	}
+	if len(page.Users) != 1 || page.Users[0].DeletedAt == nil {
+		t.Fatalf("listed users = %+v, want deleted marker", page.Users)
+	}
+
+	credentials, err := store.ListPasskeyCredentials(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("list passkeys: %v", err)
+	}
+	if len(credentials) != 0 {
+		t.Fatalf("passkeys = %d, want 0", len(credentials))
+	}
+	session, err := store.GetWebSession(ctx, "ws-1")
+	if err != nil {
+		t.Fatalf("get web session: %v", err)
+	}
+	if session.RevokedAt == nil {
+		t.Fatal("expected web session to be revoked")
+	}
+	if _, err := store.GetAccountExport(ctx, "export-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("export after deletion err = %v, want not found", err)
+	}
+	if _, err := store.GetIntegrationOutboxEvent(ctx, "event-delete"); err != nil {
+		t.Fatalf("get deletion outbox event: %v", err)
+	}
+}
@@ -0,0 +1,341 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/auth/user"
+)
+
+const moderationActionColumns = `id, action, target_kind, target_id, actor_user_id, reason, expires_at, created_at`
+
+// SuspendUserWithIntegrationOutboxEvent records a suspension, cuts off the
+// user's live credentials, and appends the audit row in one write unit.
+func (s *Store) SuspendUserWithIntegrationOutboxEvent(ctx context.Context, suspension storage.UserSuspension, action storage.ModerationAction, event storage.IntegrationOutboxEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	suspension.UserID = strings.TrimSpace(suspension.UserID)
+	if suspension.UserID == "" {
+		return fmt.Errorf("User ID is required.")
+	}
+	if strings.TrimSpace(suspension.Reason) == "" {
+		return fmt.Errorf("Reason is required.")
+	}
+	if suspension.SuspendedAt.IsZero() {
+		return fmt.Errorf("Suspended at is required.")
+	}
+	suspended := sqliteutil.ToMillis(suspension.SuspendedAt.UTC())
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Start transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	userID := suspension.UserID
+	statements := []struct {
+		label string
+		query string
+		args  []any
+	}{
+		{"record suspension", `
+INSERT INTO user_suspensions (user_id, reason, actor_user_id, suspended_at, expires_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(user_id) DO UPDATE SET
+    reason = excluded.reason,
+    actor_user_id = excluded.actor_user_id,
+    suspended_at = excluded.suspended_at,
+    expires_at = excluded.expires_at`,
+			[]any{userID, strings.TrimSpace(suspension.Reason), strings.TrimSpace(suspension.ActorUserID), suspended, nullableMillis(suspension.ExpiresAt)}},
+		{"revoke web sessions", `UPDATE web_sessions SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL`, []any{suspended, userID}},
+		{"delete oauth access tokens", `DELETE FROM oauth_access_tokens WHERE user_id = ?`, []any{userID}},
+		{"delete oauth authorization codes", `DELETE FROM oauth_authorization_codes WHERE user_id = ?`, []any{userID}},
+		{"delete oauth pending authorizations", `DELETE FROM oauth_pending_authorizations WHERE user_id = ?`, []any{userID}},
+		{"delete passkey sessions", `DELETE FROM passkey_sessions WHERE user_id = ?`, []any{userID}},
+		{"delete recovery sessions", `DELETE FROM recovery_sessions WHERE user_id = ?`, []any{userID}},
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return fmt.Errorf("Suspend user: %s: %w", stmt.label, err)
+		}
+	}
+	if err := insertModerationAction(ctx, tx, action); err != nil {
+		return err
+	}
+	if err := enqueueIntegrationOutboxEvent(ctx, tx, event); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Commit user suspension: %w", err)
+	}
+	return nil
+}
+
+// UnsuspendUserWithIntegrationOutboxEvent lifts a suspension and appends the
+// audit row in one write unit. Lifting a missing suspension reports not found.
+func (s *Store) UnsuspendUserWithIntegrationOutboxEvent(ctx context.Context, userID string, action storage.ModerationAction, event storage.IntegrationOutboxEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return fmt.Errorf("User ID is required.")
+	}
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Start transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM user_suspensions WHERE user_id = ?`, userID)
+	if err != nil {
+		return fmt.Errorf("Unsuspend user: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Unsuspend user rows affected: %w", err)
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	if err := insertModerationAction(ctx, tx, action); err != nil {
+		return err
+	}
+	if err := enqueueIntegrationOutboxEvent(ctx, tx, event); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Commit user unsuspension: %w", err)
+	}
+	return nil
+}
+
+// PutModerationAction appends an audit row for moderation applied elsewhere,
+// such as game campaign locks and discovery visibility changes.
+func (s *Store) PutModerationAction(ctx context.Context, action storage.ModerationAction) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	return insertModerationAction(ctx, s.sqlDB, action)
+}
+
+// ListModerationActions returns audit rows newest first, optionally narrowed
+// to one target kind or one target.
+func (s *Store) ListModerationActions(ctx context.Context, pageSize int, pageToken string, targetKind string, targetID string) (storage.ModerationActionPage, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.ModerationActionPage{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.ModerationActionPage{}, fmt.Errorf("Storage is not configured.")
+	}
+	if pageSize <= 0 {
+		return storage.ModerationActionPage{}, fmt.Errorf("Page size must be greater than zero.")
+	}
+
+	where := []string{"1 = 1"}
+	args := []any{}
+	if targetKind = strings.TrimSpace(targetKind); targetKind != "" {
+		where = append(where, "target_kind = ?")
+		args = append(args, targetKind)
+	}
+	if targetID = strings.TrimSpace(targetID); targetID != "" {
+		where = append(where, "target_id = ?")
+		args = append(args, targetID)
+	}
+	if pageToken = strings.TrimSpace(pageToken); pageToken != "" {
+		createdAt, id, err := parseModerationActionPageToken(pageToken)
+		if err != nil {
+			return storage.ModerationActionPage{}, err
+		}
+		where = append(where, "(created_at < ? OR (created_at = ? AND id < ?))")
+		args = append(args, createdAt, createdAt, id)
+	}
+	args = append(args, pageSize+1)
+
+	rows, err := s.sqlDB.QueryContext(ctx, `
+SELECT `+moderationActionColumns+`
+FROM moderation_actions
+WHERE `+strings.Join(where, " AND ")+`
+ORDER BY created_at DESC, id DESC
+LIMIT ?`, args...)
+	if err != nil {
+		return storage.ModerationActionPage{}, fmt.Errorf("List moderation actions: %w", err)
+	}
+	defer rows.Close()
+
+	page := storage.ModerationActionPage{Actions: make([]storage.ModerationAction, 0, pageSize)}
+	for rows.Next() {
+		action, err := scanModerationAction(rows.Scan)
+		if err != nil {
+			return storage.ModerationActionPage{}, fmt.Errorf("Scan moderation action: %w", err)
+		}
+		if len(page.Actions) == pageSize {
+			last := page.Actions[pageSize-1]
+			page.NextPageToken = moderationActionPageToken(last)
+			break
+		}
+		page.Actions = append(page.Actions, action)
+	}
+	if err := rows.Err(); err != nil {
+		return storage.ModerationActionPage{}, fmt.Errorf("Iterate moderation actions: %w", err)
+	}
+	return page, nil
+}
+
+// applyAccountStatus fills deletion and suspension state for users.
+func (s *Store) applyAccountStatus(ctx context.Context, users []user.User) error {
+	if err := s.applyAccountDeletions(ctx, users); err != nil {
+		return err
+	}
+	return s.applyUserSuspensions(ctx, users)
+}
+
+// applyUserSuspensions fills suspension fields for any suspended users.
+func (s *Store) applyUserSuspensions(ctx context.Context, users []user.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+	placeholders := make([]string, len(users))
+	args := make([]any, len(users))
+	index := make(map[string]int, len(users))
+	for i, u := range users {
+		placeholders[i] = "?"
+		args[i] = u.ID
+		index[u.ID] = i
+	}
+	rows, err := s.sqlDB.QueryContext(ctx, `SELECT user_id, reason, suspended_at, expires_at FROM user_suspensions WHERE user_id IN (`+strings.Join(placeholders, ", ")+`)`, args...)
+	if err != nil {
+		return fmt.Errorf("Load user suspensions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userID string
+		var reason string
+		var suspendedAt int64
+		var expiresAt sql.NullInt64
+		if err := rows.Scan(&userID, &reason, &suspendedAt, &expiresAt); err != nil {
+			return fmt.Errorf("Scan user suspension: %w", err)
+		}
+		i, ok := index[userID]
+		if !ok {
+			continue
+		}
+		suspended := sqliteutil.FromMillis(suspendedAt)
+		users[i].SuspendedAt = &suspended
+		users[i].SuspensionReason = reason
+		if expiresAt.Valid {
+			value := sqliteutil.FromMillis(expiresAt.Int64)
+			users[i].SuspendedUntil = &value
+		}
+	}
+	return rows.Err()
+}
+
+type moderationExecer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func insertModerationAction(ctx context.Context, exec moderationExecer, action storage.ModerationAction) error {
+	action.ID = strings.TrimSpace(action.ID)
+	if action.ID == "" {
+		return fmt.Errorf("Moderation action ID is required.")
+	}
+	if strings.TrimSpace(action.Action) == "" {
+		return fmt.Errorf("Moderation action is required.")
+	}
+	if strings.TrimSpace(action.TargetKind) == "" {
+		return fmt.Errorf("Moderation target kind is required.")
+	}
+	if strings.TrimSpace(action.TargetID) == "" {
+		return fmt.Errorf("Moderation target ID is required.")
+	}
+	if action.CreatedAt.IsZero() {
+		return fmt.Errorf("Created at is required.")
+	}
+	if _, err := exec.ExecContext(ctx, `
+INSERT INTO moderation_actions (`+moderationActionColumns+`)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		action.ID,
+		strings.TrimSpace(action.Action),
+		strings.TrimSpace(action.TargetKind),
+		strings.TrimSpace(action.TargetID),
+		strings.TrimSpace(action.ActorUserID),
+		strings.TrimSpace(action.Reason),
+		nullableMillis(action.ExpiresAt),
+		sqliteutil.ToMillis(action.CreatedAt.UTC()),
+	); err != nil {
+		return fmt.Errorf("Put moderation action: %w", err)
+	}
+	return nil
+}
+
+func scanModerationAction(scan func(dest ...any) error) (storage.ModerationAction, error) {
+	var action storage.ModerationAction
+	var expiresAt sql.NullInt64
+	var createdAt int64
+	if err := scan(
+		&action.ID,
+		&action.Action,
+		&action.TargetKind,
+		&action.TargetID,
+		&action.ActorUserID,
+		&action.Reason,
+		&expiresAt,
+		&createdAt,
+	); err != nil {
+		return storage.ModerationAction{}, err
+	}
+	action.CreatedAt = sqliteutil.FromMillis(createdAt)
+	if expiresAt.Valid {
+		value := sqliteutil.FromMillis(expiresAt.Int64)
+		action.ExpiresAt = &value
+	}
+	return action, nil
+}
+
+// moderationActionPageToken encodes the (created_at, id) keyset cursor.
+func moderationActionPageToken(action storage.ModerationAction) string {
+	return strconv.FormatInt(sqliteutil.ToMillis(action.CreatedAt.UTC()), 10) + ":" + action.ID
+}
+
+func parseModerationActionPageToken(token string) (int64, string, error) {
+	millis, id, ok := strings.Cut(token, ":")
+	if !ok || id == "" {
+		return 0, "", fmt.Errorf("Page token is invalid.")
+	}
+	createdAt, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("Page token is invalid.")
+	}
+	return createdAt, id, nil
+}
+
+func nullableMillis(value *time.Time) any {
+	if value == nil || value.IsZero() {
+		return nil
+	}
+	return sqliteutil.ToMillis(value.UTC())
+}
+
+var _ storage.ModerationStore = (*Store)(nil)
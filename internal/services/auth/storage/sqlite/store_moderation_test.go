@@ -0,0 +1,110 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/auth/storage"
+)
+
+func TestUserSuspensionLifecycle(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	putTestUser(t, store, "user-1", "alice", now)
+	if err := store.PutWebSession(ctx, storage.WebSession{ID: "ws-1", UserID: "user-1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("put web session: %v", err)
+	}
+
+	expiresAt := now.Add(72 * time.Hour)
+	suspension := storage.UserSuspension{UserID: "user-1", Reason: "spam", ActorUserID: "op-1", SuspendedAt: now, ExpiresAt: &expiresAt}
+	action := storage.ModerationAction{ID: "act-1", Action: "user.suspend", TargetKind: storage.ModerationTargetUser, TargetID: "user-1", ActorUserID: "op-1", Reason: "spam", ExpiresAt: &expiresAt, CreatedAt: now}
+	event := storage.IntegrationOutboxEvent{ID: "event-1", EventType: "auth.user_suspended", PayloadJSON: `{"user_id":"user-1"}`, DedupeKey: "user_suspended:act-1", Status: storage.IntegrationOutboxStatusPending, CreatedAt: now}
+	if err := store.SuspendUserWithIntegrationOutboxEvent(ctx, suspension, action, event); err != nil {
+		t.Fatalf("suspend user: %v", err)
+	}
+
+	got, err := store.GetUser(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	if got.SuspendedAt == nil || got.SuspensionReason != "spam" || got.SuspendedUntil == nil || !got.SuspendedUntil.Equal(expiresAt) {
+		t.Fatalf("suspended user = %+v", got)
+	}
+	if !got.IsSuspended(now.Add(time.Hour)) || got.IsSuspended(expiresAt) {
+		t.Fatal("expected suspension to hold until expiry")
+	}
+	session, err := store.GetWebSession(ctx, "ws-1")
+	if err != nil {
+		t.Fatalf("get web session: %v", err)
+	}
+	if session.RevokedAt == nil {
+		t.Fatal("expected suspension to revoke web sessions")
+	}
+	if _, err := store.GetIntegrationOutboxEvent(ctx, "event-1"); err != nil {
+		t.Fatalf("get suspension outbox event: %v", err)
+	}
+
+	lift := storage.ModerationAction{ID: "act-2", Action: "user.unsuspend", TargetKind: storage.ModerationTargetUser, TargetID: "user-1", ActorUserID: "op-1", CreatedAt: now.Add(time.Minute)}
+	liftEvent := storage.IntegrationOutboxEvent{ID: "event-2", EventType: "auth.user_unsuspended", PayloadJSON: `{"user_id":"user-1"}`, DedupeKey: "user_unsuspended:act-2", Status: storage.IntegrationOutboxStatusPending, CreatedAt: now}
+	if err := store.UnsuspendUserWithIntegrationOutboxEvent(ctx, "user-1", lift, liftEvent); err != nil {
+		t.Fatalf("unsuspend user: %v", err)
+	}
+	got, err = store.GetUser(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("get user after unsuspend: %v", err)
+	}
+	if got.SuspendedAt != nil {
+		t.Fatalf("expected suspension cleared, got %+v", got)
+	}
+	lift.ID = "act-3"
+	liftEvent.ID = "event-3"
+	liftEvent.DedupeKey = "user_unsuspended:act-3"
+	if err := store.UnsuspendUserWithIntegrationOutboxEvent(ctx, "user-1", lift, liftEvent); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("unsuspend unsuspended user err = %v, want not found", err)
+	}
+}
+
+func TestListModerationActionsPagesNewestFirst(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i, id := range []string{"act-1", "act-2", "act-3"} {
+		kind := storage.ModerationTargetCampaign
+		if id == "act-2" {
+			kind = storage.ModerationTargetDiscoveryEntry
+		}
+		action := storage.ModerationAction{ID: id, Action: "lock", TargetKind: kind, TargetID: "camp-1", CreatedAt: now.Add(time.Duration(i) * time.Minute)}
+		if err := store.PutModerationAction(ctx, action); err != nil {
+			t.Fatalf("put moderation action %s: %v", id, err)
+		}
+	}
+
+	page, err := store.ListModerationActions(ctx, 2, "", "", "")
+	if err != nil {
+		t.Fatalf("list first page: %v", err)
+	}
+	if len(page.Actions) != 2 || page.Actions[0].ID != "act-3" || page.Actions[1].ID != "act-2" || page.NextPageToken == "" {
+		t.Fatalf("first page = %+v", page)
+	}
+	page, err = store.ListModerationActions(ctx, 2, page.NextPageToken, "", "")
+	if err != nil {
+		t.Fatalf("list second page: %v", err)
+	}
+	if len(page.Actions) != 1 || page.Actions[0].ID != "act-1" || page.NextPageToken != "" {
+		t.Fatalf("second page = %+v", page)
+	}
+
+	page, err = store.ListModerationActions(ctx, 10, "", storage.ModerationTargetCampaign, "camp-1")
+	if err != nil {
+		t.Fatalf("list filtered: %v", err)
+	}
+	if len(page.Actions) != 2 {
+		t.Fatalf("filtered actions = %+v", page.Actions)
+	}
+	if _, err := store.ListModerationActions(ctx, 10, "bogus", "", ""); err == nil {
+		t.Fatal("expected invalid page token error")
+	}
+}
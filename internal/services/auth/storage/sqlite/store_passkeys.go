@@ -151,6 +151,68 @@ func (s *Store) DeletePasskeyCredentialsByUserExcept(ctx context.Context, userID
 	})
 }
 
+// DeleteUserPasskeyCredential removes one passkey owned by the user unless it
+// is the user's only passkey. It returns storage.ErrNotFound when the user does
+// not own the credential and false when the guard kept the last passkey.
+func (s *Store) DeleteUserPasskeyCredential(ctx context.Context, userID string, credentialID string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return false, fmt.Errorf("Storage is not configured.")
+	}
+	if strings.TrimSpace(userID) == "" || strings.TrimSpace(credentialID) == "" {
+		return false, fmt.Errorf("User ID and credential ID are required.")
+	}
+	deleted, err := s.q.DeleteUserPasskeyUnlessLast(ctx, db.DeleteUserPasskeyUnlessLastParams{
+		CredentialID: credentialID,
+		UserID:       userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("Delete user passkey: %w", err)
+	}
+	if deleted > 0 {
+		return true, nil
+	}
+	existing, err := s.GetPasskeyCredential(ctx, credentialID)
+	if err != nil {
+		return false, err
+	}
+	if existing.UserID != userID {
+		return false, storage.ErrNotFound
+	}
+	return false, nil
+}
+
+// RenamePasskeyCredential sets the display label of a passkey owned by the user.
+func (s *Store) RenamePasskeyCredential(ctx context.Context, userID string, credentialID string, label string, updatedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	if strings.TrimSpace(userID) == "" || strings.TrimSpace(credentialID) == "" {
+		return fmt.Errorf("User ID and credential ID are required.")
+	}
+	if updatedAt.IsZero() {
+		updatedAt = time.Now().UTC()
+	}
+	affected, err := s.q.RenamePasskey(ctx, db.RenamePasskeyParams{
+		Label:        label,
+		UpdatedAt:    sqliteutil.ToMillis(updatedAt),
+		CredentialID: credentialID,
+		UserID:       userID,
+	})
+	if err != nil {
+		return fmt.Errorf("Rename passkey: %w", err)
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
 // PutPasskeySession stores a WebAuthn session.
 func (s *Store) PutPasskeySession(ctx context.Context, session storage.PasskeySession) error {
 	if err := ctx.Err(); err != nil {
@@ -416,6 +478,7 @@ func dbPasskeyToDomain(row db.Passkey) storage.PasskeyCredential {
 		CreatedAt:      sqliteutil.FromMillis(row.CreatedAt),
 		UpdatedAt:      sqliteutil.FromMillis(row.UpdatedAt),
 		LastUsedAt:     lastUsed,
+		Label:          row.Label,
 	}
 }
 
@@ -789,6 +789,57 @@ func TestDeleteExpiredRecoverySessions(t *testing.T) {
 	}
 }
 
+func TestRenameAndDeleteUserPasskeyKeepsLastCredential(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 12, 12, 0, 0, 0, time.UTC)
+
+	putTestUser(t, store, "user-1", "alpha", now)
+	putTestUser(t, store, "user-2", "beta", now)
+	for _, credential := range []storage.PasskeyCredential{
+		{CredentialID: "cred-1", UserID: "user-1", CredentialJSON: "{}", CreatedAt: now},
+		{CredentialID: "cred-2", UserID: "user-1", CredentialJSON: "{}", CreatedAt: now},
+		{CredentialID: "cred-3", UserID: "user-2", CredentialJSON: "{}", CreatedAt: now},
+	} {
+		if err := store.PutPasskeyCredential(ctx, credential); err != nil {
+			t.Fatalf("put passkey %s: %v", credential.CredentialID, err)
+		}
+	}
+
+	if err := store.RenamePasskeyCredential(ctx, "user-1", "cred-1", "Laptop", now.Add(time.Minute)); err != nil {
+		t.Fatalf("rename passkey: %v", err)
+	}
+	if err := store.RenamePasskeyCredential(ctx, "user-1", "cred-3", "Stolen", now); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("rename foreign passkey err = %v, want not found", err)
+	}
+	// Login refreshes rewrite the credential row but must keep the label.
+	if err := store.PutPasskeyCredential(ctx, storage.PasskeyCredential{CredentialID: "cred-1", UserID: "user-1", CredentialJSON: "{}", CreatedAt: now, UpdatedAt: now.Add(2 * time.Minute)}); err != nil {
+		t.Fatalf("refresh passkey: %v", err)
+	}
+	renamed, err := store.GetPasskeyCredential(ctx, "cred-1")
+	if err != nil {
+		t.Fatalf("get renamed passkey: %v", err)
+	}
+	if renamed.Label != "Laptop" {
+		t.Fatalf("label = %q, want Laptop", renamed.Label)
+	}
+
+	if _, err := store.DeleteUserPasskeyCredential(ctx, "user-1", "cred-3"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("delete foreign passkey err = %v, want not found", err)
+	}
+	deleted, err := store.DeleteUserPasskeyCredential(ctx, "user-1", "cred-2")
+	if err != nil || !deleted {
+		t.Fatalf("delete passkey = %v, %v; want deleted", deleted, err)
+	}
+	deleted, err = store.DeleteUserPasskeyCredential(ctx, "user-1", "cred-1")
+	if err != nil || deleted {
+		t.Fatalf("delete last passkey = %v, %v; want kept", deleted, err)
+	}
+	if _, err := store.GetPasskeyCredential(ctx, "cred-1"); err != nil {
+		t.Fatalf("expected last passkey retained, got %v", err)
+	}
+}
+
 func TestWebSessionRoundTrip(t *testing.T) {
 	store := openTempStore(t)
 	now := time.Date(2026, 2, 23, 15, 0, 0, 0, time.UTC)
@@ -840,6 +891,66 @@ func TestWebSessionRoundTrip(t *testing.T) {
 	}
 }
 
+func TestWebSessionSecurityCenterQueries(t *testing.T) {
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 23, 15, 0, 0, 0, time.UTC)
+
+	putTestUser(t, store, "user-1", "primary", now)
+	putTestUser(t, store, "user-2", "secondary", now)
+
+	for _, session := range []storage.WebSession{
+		{ID: "ws-1", UserID: "user-1", CreatedAt: now, ExpiresAt: now.Add(time.Hour), UserAgent: "Firefox", ClientIP: "10.0.0.1"},
+		{ID: "ws-2", UserID: "user-1", CreatedAt: now.Add(time.Minute), ExpiresAt: now.Add(time.Hour)},
+		{ID: "ws-expired", UserID: "user-1", CreatedAt: now, ExpiresAt: now.Add(time.Second)},
+		{ID: "ws-other", UserID: "user-2", CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+	} {
+		if err := store.PutWebSession(ctx, session); err != nil {
+			t.Fatalf("put web session %s: %v", session.ID, err)
+		}
+	}
+
+	if err := store.TouchWebSession(ctx, "ws-1", now.Add(5*time.Minute), "Safari", "10.0.0.2"); err != nil {
+		t.Fatalf("touch web session: %v", err)
+	}
+	if err := store.TouchWebSession(ctx, "missing", now, "", ""); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("touch missing session err = %v, want not found", err)
+	}
+
+	active, err := store.ListActiveWebSessionsByUser(ctx, "user-1", now.Add(2*time.Minute))
+	if err != nil {
+		t.Fatalf("list active web sessions: %v", err)
+	}
+	if len(active) != 2 || active[0].ID != "ws-1" || active[1].ID != "ws-2" {
+		t.Fatalf("active sessions = %+v", active)
+	}
+	if active[0].UserAgent != "Safari" || active[0].ClientIP != "10.0.0.2" || active[0].LastSeenAt == nil || !active[0].LastSeenAt.Equal(now.Add(5*time.Minute)) {
+		t.Fatalf("touched session = %+v", active[0])
+	}
+
+	revoked, err := store.RevokeOtherWebSessionsByUser(ctx, "user-1", "ws-1", now.Add(3*time.Minute))
+	if err != nil {
+		t.Fatalf("revoke other web sessions: %v", err)
+	}
+	if revoked != 1 {
+		t.Fatalf("revoked = %d, want 1", revoked)
+	}
+	active, err = store.ListActiveWebSessionsByUser(ctx, "user-1", now.Add(3*time.Minute))
+	if err != nil {
+		t.Fatalf("list active web sessions after revoke: %v", err)
+	}
+	if len(active) != 1 || active[0].ID != "ws-1" {
+		t.Fatalf("active sessions after revoke = %+v", active)
+	}
+	other, err := store.GetWebSession(ctx, "ws-other")
+	if err != nil {
+		t.Fatalf("get other user session: %v", err)
+	}
+	if other.RevokedAt != nil {
+		t.Fatalf("expected other user's session untouched")
+	}
+}
+
 func TestPutWebSessionDefaultsCreatedAtAndUpsertsRevocation(t *testing.T) {
 	store := openTempStore(t)
 	now := time.Date(2026, 2, 23, 15, 0, 0, 0, time.UTC)
@@ -135,7 +135,11 @@ func (s *Store) GetUser(ctx context.Context, userID string) (user.User, error) {
 		return user.User{}, fmt.Errorf("Get user: %w", err)
 	}
 
-	return dbUserToDomain(row), nil
+	users := []user.User{dbUserToDomain(row)}
+	if err := s.applyAccountStatus(ctx, users); err != nil {
+		return user.User{}, err
+	}
+	return users[0], nil
 }
 
 // GetUserByUsername fetches a user record by username.
@@ -158,7 +162,11 @@ func (s *Store) GetUserByUsername(ctx context.Context, username string) (user.Us
 		}
 		return user.User{}, fmt.Errorf("Get user by username: %w", err)
 	}
-	return dbUserToDomain(row), nil
+	users := []user.User{dbUserToDomain(row)}
+	if err := s.applyAccountStatus(ctx, users); err != nil {
+		return user.User{}, err
+	}
+	return users[0], nil
 }
 
 // ListUsers returns a page of user records.
@@ -205,6 +213,9 @@ func (s *Store) ListUsers(ctx context.Context, pageSize int, pageToken string) (
 		}
 	}
 
+	if err := s.applyAccountStatus(ctx, page.Users); err != nil {
+		return storage.UserPage{}, err
+	}
 	return page, nil
 }
 
@@ -44,19 +44,19 @@ func putWebSessionWithExecutor(ctx context.Context, exec execContexter, session
 	if err != nil {
 		return err
 	}
-	var revokedAt sql.NullInt64
-	if normalized.RevokedAt != nil {
-		revokedAt = sql.NullInt64{Int64: sqliteutil.ToMillis(normalized.RevokedAt.UTC()), Valid: true}
-	}
 	_, err = exec.ExecContext(ctx, `
-INSERT INTO web_sessions (id, user_id, created_at, expires_at, revoked_at)
-VALUES (?, ?, ?, ?, ?)
+INSERT INTO web_sessions (id, user_id, created_at, expires_at, revoked_at, user_agent, client_ip, last_seen_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
   user_id = excluded.user_id,
   created_at = excluded.created_at,
   expires_at = excluded.expires_at,
-  revoked_at = excluded.revoked_at
-`, normalized.ID, normalized.UserID, sqliteutil.ToMillis(normalized.CreatedAt.UTC()), sqliteutil.ToMillis(normalized.ExpiresAt.UTC()), revokedAt)
+  revoked_at = excluded.revoked_at,
+  user_agent = excluded.user_agent,
+  client_ip = excluded.client_ip,
+  last_seen_at = excluded.last_seen_at
+`, normalized.ID, normalized.UserID, sqliteutil.ToMillis(normalized.CreatedAt.UTC()), sqliteutil.ToMillis(normalized.ExpiresAt.UTC()),
+		sqliteutil.ToNullMillis(normalized.RevokedAt), normalized.UserAgent, normalized.ClientIP, sqliteutil.ToNullMillis(normalized.LastSeenAt))
 	if err != nil {
 		return fmt.Errorf("Put web session: %w", err)
 	}
@@ -76,29 +76,93 @@ func (s *Store) GetWebSession(ctx context.Context, id string) (storage.WebSessio
 		return storage.WebSession{}, fmt.Errorf("Session ID is required.")
 	}
 	row := s.sqlDB.QueryRowContext(ctx, `
-SELECT id, user_id, created_at, expires_at, revoked_at
+SELECT `+webSessionColumns+`
 FROM web_sessions
 WHERE id = ?
 `, id)
-	var session storage.WebSession
-	var createdAt int64
-	var expiresAt int64
-	var revokedAt sql.NullInt64
-	if err := row.Scan(&session.ID, &session.UserID, &createdAt, &expiresAt, &revokedAt); err != nil {
+	session, err := scanWebSession(row)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return storage.WebSession{}, storage.ErrNotFound
 		}
 		return storage.WebSession{}, fmt.Errorf("Get web session: %w", err)
 	}
-	session.CreatedAt = sqliteutil.FromMillis(createdAt)
-	session.ExpiresAt = sqliteutil.FromMillis(expiresAt)
-	if revokedAt.Valid {
-		value := sqliteutil.FromMillis(revokedAt.Int64)
-		session.RevokedAt = &value
-	}
 	return session, nil
 }
 
+// ListActiveWebSessionsByUser returns the user's unrevoked, unexpired sessions,
+// most recently seen first.
+func (s *Store) ListActiveWebSessionsByUser(ctx context.Context, userID string, now time.Time) ([]storage.WebSession, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("Context is required.")
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("Storage is not configured.")
+	}
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("User ID is required.")
+	}
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+	rows, err := s.sqlDB.QueryContext(ctx, `
+SELECT `+webSessionColumns+`
+FROM web_sessions
+WHERE user_id = ? AND revoked_at IS NULL AND expires_at > ?
+ORDER BY COALESCE(last_seen_at, created_at) DESC, id
+`, userID, sqliteutil.ToMillis(now.UTC()))
+	if err != nil {
+		return nil, fmt.Errorf("List web sessions: %w", err)
+	}
+	defer rows.Close()
+	sessions := []storage.WebSession{}
+	for rows.Next() {
+		session, err := scanWebSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Scan web session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("List web sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// TouchWebSession records the latest request metadata observed for a session.
+func (s *Store) TouchWebSession(ctx context.Context, id string, seenAt time.Time, userAgent string, clientIP string) error {
+	if ctx == nil {
+		return fmt.Errorf("Context is required.")
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("Storage is not configured.")
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return fmt.Errorf("Session ID is required.")
+	}
+	if seenAt.IsZero() {
+		seenAt = time.Now().UTC()
+	}
+	res, err := s.sqlDB.ExecContext(ctx, `
+UPDATE web_sessions
+SET last_seen_at = ?, user_agent = ?, client_ip = ?
+WHERE id = ?
+`, sqliteutil.ToMillis(seenAt.UTC()), userAgent, clientIP, id)
+	if err != nil {
+		return fmt.Errorf("Touch web session: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("Touch web session rows affected: %w", err)
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
 // RevokeWebSession marks a durable session as revoked.
 func (s *Store) RevokeWebSession(ctx context.Context, id string, revokedAt time.Time) error {
 	if ctx == nil {
@@ -150,6 +214,37 @@ func (s *Store) RevokeWebSessionsByUser(ctx context.Context, userID string, revo
 	return nil
 }
 
+// RevokeOtherWebSessionsByUser revokes every active session of the user except
+// keepID and returns how many sessions were revoked.
+func (s *Store) RevokeOtherWebSessionsByUser(ctx context.Context, userID string, keepID string, revokedAt time.Time) (int, error) {
+	if ctx == nil {
+		return 0, fmt.Errorf("Context is required.")
+	}
+	if s == nil || s.sqlDB == nil {
+		return 0, fmt.Errorf("Storage is not configured.")
+	}
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return 0, fmt.Errorf("User ID is required.")
+	}
+	if revokedAt.IsZero() {
+		revokedAt = time.Now().UTC()
+	}
+	res, err := s.sqlDB.ExecContext(ctx, `
+UPDATE web_sessions
+SET revoked_at = ?
+WHERE user_id = ? AND id <> ? AND revoked_at IS NULL AND expires_at > ?
+`, sqliteutil.ToMillis(revokedAt.UTC()), userID, strings.TrimSpace(keepID), sqliteutil.ToMillis(revokedAt.UTC()))
+	if err != nil {
+		return 0, fmt.Errorf("Revoke other web sessions: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("Revoke other web sessions rows affected: %w", err)
+	}
+	return int(affected), nil
+}
+
 // DeleteExpiredWebSessions removes expired durable web sessions.
 func (s *Store) DeleteExpiredWebSessions(ctx context.Context, now time.Time) error {
 	if ctx == nil {
@@ -167,3 +262,25 @@ func (s *Store) DeleteExpiredWebSessions(ctx context.Context, now time.Time) err
 	}
 	return nil
 }
+
+const webSessionColumns = `id, user_id, created_at, expires_at, revoked_at, user_agent, client_ip, last_seen_at`
+
+type webSessionScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebSession(row webSessionScanner) (storage.WebSession, error) {
+	var session storage.WebSession
+	var createdAt int64
+	var expiresAt int64
+	var revokedAt sql.NullInt64
+	var lastSeenAt sql.NullInt64
+	if err := row.Scan(&session.ID, &session.UserID, &createdAt, &expiresAt, &revokedAt, &session.UserAgent, &session.ClientIP, &lastSeenAt); err != nil {
+		return storage.WebSession{}, err
+	}
+	session.CreatedAt = sqliteutil.FromMillis(createdAt)
+	session.ExpiresAt = sqliteutil.FromMillis(expiresAt)
+	session.RevokedAt = sqliteutil.FromNullMillis(revokedAt)
+	session.LastSeenAt = sqliteutil.FromNullMillis(lastSeenAt)
+	return session, nil
+}
@@ -55,6 +55,8 @@ type PasskeyCredential struct {
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 	LastUsedAt     *time.Time
+	// Label is the user-chosen display name; empty until renamed.
+	Label string
 }
 
 // PasskeySession stores pending WebAuthn challenge state for registration or login.
@@ -73,6 +75,10 @@ type WebSession struct {
 	CreatedAt time.Time
 	ExpiresAt time.Time
 	RevokedAt *time.Time
+	// UserAgent and ClientIP describe the device that last used the session.
+	UserAgent  string
+	ClientIP   string
+	LastSeenAt *time.Time
 }
 
 // PasskeyStore persists WebAuthn credential and challenge state.
@@ -83,6 +89,10 @@ type PasskeyStore interface {
 	DeletePasskeyCredential(ctx context.Context, credentialID string) error
 	DeletePasskeyCredentialsByUser(ctx context.Context, userID string) error
 	DeletePasskeyCredentialsByUserExcept(ctx context.Context, userID string, credentialID string) error
+	// DeleteUserPasskeyCredential removes one of the user's passkeys unless it
+	// is their only one, reporting whether a row was deleted.
+	DeleteUserPasskeyCredential(ctx context.Context, userID string, credentialID string) (bool, error)
+	RenamePasskeyCredential(ctx context.Context, userID string, credentialID string, label string, updatedAt time.Time) error
 	PutPasskeySession(ctx context.Context, session PasskeySession) error
 	GetPasskeySession(ctx context.Context, id string) (PasskeySession, error)
 	DeletePasskeySession(ctx context.Context, id string) error
@@ -104,6 +114,11 @@ type WebSessionStore interface {
 	GetWebSession(ctx context.Context, id string) (WebSession, error)
 	RevokeWebSession(ctx context.Context, id string, revokedAt time.Time) error
 	RevokeWebSessionsByUser(ctx context.Context, userID string, revokedAt time.Time) error
+	// RevokeOtherWebSessionsByUser revokes the user's active sessions except
+	// keepID and returns how many were revoked.
+	RevokeOtherWebSessionsByUser(ctx context.Context, userID string, keepID string, revokedAt time.Time) (int, error)
+	ListActiveWebSessionsByUser(ctx context.Context, userID string, now time.Time) ([]WebSession, error)
+	TouchWebSession(ctx context.Context, id string, seenAt time.Time, userAgent string, clientIP string) error
 	DeleteExpiredWebSessions(ctx context.Context, now time.Time) error
 }
 
@@ -174,3 +189,94 @@ type UserOutboxTransactionalStore interface {
 type UserSignupTransactionalStore interface {
 	PutUserPasskeyWithIntegrationOutboxEvent(ctx context.Context, u user.User, credential PasskeyCredential, session WebSession, event IntegrationOutboxEvent) error
 }
+
+const (
+	// AccountExportStatusPending waits for the worker to assemble the archive.
+	AccountExportStatusPending = "pending"
+	// AccountExportStatusReady holds a downloadable archive.
+	AccountExportStatusReady = "ready"
+	// AccountExportStatusFailed stopped on a permanent gather failure.
+	AccountExportStatusFailed = "failed"
+)
+
+// AccountExport tracks one user-requested data archive from request to download.
+type AccountExport struct {
+	ID          string
+	UserID      string
+	Status      string
+	Archive     []byte
+	ArchiveSize int64
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+}
+
+// AccountExportStore persists export jobs and their finished archives.
+//
+// List results omit archive bytes; only GetAccountExport loads them.
+type AccountExportStore interface {
+	PutAccountExportWithIntegrationOutboxEvent(ctx context.Context, export AccountExport, event IntegrationOutboxEvent) error
+	GetAccountExport(ctx context.Context, id string) (AccountExport, error)
+	ListAccountExports(ctx context.Context, userID string, limit int) ([]AccountExport, error)
+	CompleteAccountExport(ctx context.Context, id string, archive []byte, completedAt time.Time, expiresAt time.Time) error
+	FailAccountExport(ctx context.Context, id string, lastError string, failedAt time.Time) error
+	DeleteExpiredAccountExports(ctx context.Context, now time.Time) error
+}
+
+// AccountDeletionStore closes accounts in one write unit.
+//
+// DeleteAccountWithIntegrationOutboxEvent stores the tombstoned user, records
+// the deletion, drops passkeys, pending ceremonies, OAuth grants, and export
+// archives, revokes web sessions, and enqueues the downstream cleanup event.
+type AccountDeletionStore interface {
+	DeleteAccountWithIntegrationOutboxEvent(ctx context.Context, tombstone user.User, deletedAt time.Time, event IntegrationOutboxEvent) error
+}
+
+// Moderation target kinds recorded in the audit log.
+const (
+	ModerationTargetUser           = "user"
+	ModerationTargetCampaign       = "campaign"
+	ModerationTargetDiscoveryEntry = "discovery_entry"
+)
+
+// UserSuspension is an operator-imposed sign-in block on one account.
+type UserSuspension struct {
+	UserID      string
+	Reason      string
+	ActorUserID string
+	SuspendedAt time.Time
+	// ExpiresAt ends a timed suspension; nil means it lasts until lifted.
+	ExpiresAt *time.Time
+}
+
+// ModerationAction is one append-only audit log row for operator moderation.
+type ModerationAction struct {
+	ID          string
+	Action      string
+	TargetKind  string
+	TargetID    string
+	ActorUserID string
+	Reason      string
+	ExpiresAt   *time.Time
+	CreatedAt   time.Time
+}
+
+// ModerationActionPage is a newest-first cursor page of audit log rows.
+type ModerationActionPage struct {
+	Actions       []ModerationAction
+	NextPageToken string
+}
+
+// ModerationStore persists suspensions and the moderation audit log.
+//
+// Suspending a user revokes web sessions and drops OAuth grants in the same
+// write unit as the audit row and the notification outbox event, so a
+// committed suspension never leaves a usable credential behind.
+type ModerationStore interface {
+	SuspendUserWithIntegrationOutboxEvent(ctx context.Context, suspension UserSuspension, action ModerationAction, event IntegrationOutboxEvent) error
+	UnsuspendUserWithIntegrationOutboxEvent(ctx context.Context, userID string, action ModerationAction, event IntegrationOutboxEvent) error
+	PutModerationAction(ctx context.Context, action ModerationAction) error
+	ListModerationActions(ctx context.Context, pageSize int, pageToken string, targetKind string, targetID string) (ModerationActionPage, error)
+}
@@ -30,6 +30,21 @@ type User struct {
 	RecoveryCodeUpdatedAt     time.Time
 	CreatedAt                 time.Time
 	UpdatedAt                 time.Time
+	// DeletedAt is set once the account is closed.
+	DeletedAt *time.Time
+	// SuspendedAt is set while an operator suspension is recorded.
+	SuspendedAt *time.Time
+	// SuspendedUntil ends a timed suspension; nil means indefinite.
+	SuspendedUntil   *time.Time
+	SuspensionReason string
+}
+
+// IsSuspended reports whether an operator suspension blocks sign-in at now.
+func (u User) IsSuspended(now time.Time) bool {
+	if u.SuspendedAt == nil {
+		return false
+	}
+	return u.SuspendedUntil == nil || now.Before(*u.SuspendedUntil)
 }
 
 // CreateUserInput describes the metadata needed to create a user.
@@ -89,3 +104,25 @@ func NormalizeCreateUserInput(input CreateUserInput) (CreateUserInput, error) {
 	input.Locale = platformi18n.NormalizeLocale(input.Locale)
 	return input, nil
 }
+
+// tombstoneUsernamePrefix marks usernames released by account deletion.
+const tombstoneUsernamePrefix = "deleted-"
+
+// Tombstone returns the closed form of an account: the username is replaced by
+// an ID-derived placeholder so the original name can be claimed again, and
+// recovery state is cleared so the account can never be recovered.
+func Tombstone(u User, deletedAt time.Time) User {
+	suffix := u.ID
+	if max := 32 - len(tombstoneUsernamePrefix); len(suffix) > max {
+		suffix = suffix[:max]
+	}
+	deletedAt = deletedAt.UTC()
+	u.Username = tombstoneUsernamePrefix + suffix
+	u.RecoveryCodeHash = ""
+	u.RecoveryReservedSessionID = ""
+	u.RecoveryReservedUntil = nil
+	u.RecoveryCodeUpdatedAt = deletedAt
+	u.UpdatedAt = deletedAt
+	u.DeletedAt = &deletedAt
+	return u
+}
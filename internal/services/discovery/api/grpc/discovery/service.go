@@ -9,6 +9,7 @@ import (
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/grpc/pagination"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/catalog"
 	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,21 +19,35 @@ import (
 const (
 	defaultListDiscoveryEntriesPageSize = 10
 	maxListDiscoveryEntriesPageSize     = 50
+	maxHiddenReasonLength               = 500
 )
 
 // Service exposes discovery.v1 gRPC operations.
 type Service struct {
 	discoveryv1.UnimplementedDiscoveryServiceServer
-	store storage.DiscoveryEntryStore
-	clock func() time.Time
+	store           storage.DiscoveryEntryStore
+	moderationStore storage.DiscoveryEntryModerationStore
+	listingStore    storage.CampaignListingStore
+	pregens         pregenLookup
+	clock           func() time.Time
 }
 
 // NewService creates a discovery service backed by discovery entry storage.
+// Moderation and campaign listings are enabled when the store also implements
+// storage.DiscoveryEntryModerationStore and storage.CampaignListingStore.
 func NewService(store storage.DiscoveryEntryStore) *Service {
-	return &Service{
-		store: store,
-		clock: time.Now,
+	service := &Service{
+		store:   store,
+		pregens: catalog.BuiltinPregen,
+		clock:   time.Now,
 	}
+	if typed, ok := store.(storage.DiscoveryEntryModerationStore); ok {
+		service.moderationStore = typed
+	}
+	if typed, ok := store.(storage.CampaignListingStore); ok {
+		service.listingStore = typed
+	}
+	return service
 }
 
 // CreateDiscoveryEntry creates one discovery entry record.
@@ -138,9 +153,46 @@ func (s *Service) GetDiscoveryEntry(ctx context.Context, in *discoveryv1.GetDisc
 		}
 		return nil, status.Errorf(codes.Internal, "get discovery entry: %v", err)
 	}
+	if record.Hidden && !in.GetIncludeHidden() {
+		return nil, status.Error(codes.NotFound, "discovery entry not found")
+	}
 	return &discoveryv1.GetDiscoveryEntryResponse{Entry: discoveryEntryToProto(record)}, nil
 }
 
+// SetDiscoveryEntryHidden hides or restores one entry in public discovery.
+func (s *Service) SetDiscoveryEntryHidden(ctx context.Context, in *discoveryv1.SetDiscoveryEntryHiddenRequest) (*discoveryv1.SetDiscoveryEntryHiddenResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "set discovery entry hidden request is required")
+	}
+	if s == nil || s.moderationStore == nil {
+		return nil, status.Error(codes.Internal, "discovery moderation store is not configured")
+	}
+	entryID := strings.TrimSpace(in.GetEntryId())
+	reason := strings.TrimSpace(in.GetReason())
+	if entryID == "" {
+		return nil, status.Error(codes.InvalidArgument, "entry id is required")
+	}
+	if in.GetHidden() && reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required to hide an entry")
+	}
+	if len(reason) > maxHiddenReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxHiddenReasonLength)
+	}
+
+	now := time.Now().UTC()
+	if s.clock != nil {
+		now = s.clock().UTC()
+	}
+	record, err := s.moderationStore.SetDiscoveryEntryHidden(ctx, entryID, in.GetHidden(), reason, now)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "discovery entry not found")
+		}
+		return nil, status.Errorf(codes.Internal, "set discovery entry hidden: %v", err)
+	}
+	return &discoveryv1.SetDiscoveryEntryHiddenResponse{Entry: discoveryEntryToProto(record)}, nil
+}
+
 // ListDiscoveryEntries returns a page of discovery entry records.
 func (s *Service) ListDiscoveryEntries(ctx context.Context, in *discoveryv1.ListDiscoveryEntriesRequest) (*discoveryv1.ListDiscoveryEntriesResponse, error) {
 	if in == nil {
@@ -192,6 +244,12 @@ func discoveryEntryToProto(entry storage.DiscoveryEntry) *discoveryv1.DiscoveryE
 		PreviewPlaystyleLabel:      entry.PreviewPlaystyleLabel,
 		PreviewCharacterName:       entry.PreviewCharacterName,
 		PreviewCharacterSummary:    entry.PreviewCharacterSummary,
+		Hidden:                     entry.Hidden,
+		HiddenReason:               entry.HiddenReason,
+		ScheduleLabel:              entry.ScheduleLabel,
+		Tone:                       entry.Tone,
+		SafetyNotes:                entry.SafetyNotes,
+		OpenSeats:                  int32(entry.OpenSeats),
 		CreatedAt:                  timestamppb.New(entry.CreatedAt),
 		UpdatedAt:                  timestamppb.New(entry.UpdatedAt),
 	}
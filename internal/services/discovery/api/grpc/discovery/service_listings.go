@@ -0,0 +1,137 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	campaignListingEntryPrefix    = "listing:"
+	maxCampaignListingOpenSeats   = 12
+	maxCampaignListingTitleLength = 120
+	maxCampaignListingTextLength  = 2000
+	maxCampaignListingLabelLength = 200
+)
+
+// CampaignListingEntryID returns the discovery entry ID that holds a
+// campaign's open-table listing.
+func CampaignListingEntryID(campaignID string) string {
+	return campaignListingEntryPrefix + strings.TrimSpace(campaignID)
+}
+
+// PublishCampaignListing creates or updates one campaign's open-table listing.
+func (s *Service) PublishCampaignListing(ctx context.Context, in *discoveryv1.PublishCampaignListingRequest) (*discoveryv1.PublishCampaignListingResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "publish campaign listing request is required")
+	}
+	if s == nil || s.listingStore == nil {
+		return nil, status.Error(codes.Internal, "campaign listing store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	title := strings.TrimSpace(in.GetTitle())
+	description := strings.TrimSpace(in.GetDescription())
+	scheduleLabel := strings.TrimSpace(in.GetScheduleLabel())
+	tone := strings.TrimSpace(in.GetTone())
+	safetyNotes := strings.TrimSpace(in.GetSafetyNotes())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if description == "" {
+		return nil, status.Error(codes.InvalidArgument, "description is required")
+	}
+	if in.GetOpenSeats() < 1 || in.GetOpenSeats() > maxCampaignListingOpenSeats {
+		return nil, status.Errorf(codes.InvalidArgument, "open seats must be between 1 and %d", maxCampaignListingOpenSeats)
+	}
+	if utf8.RuneCountInString(title) > maxCampaignListingTitleLength {
+		return nil, status.Errorf(codes.InvalidArgument, "title must be at most %d characters", maxCampaignListingTitleLength)
+	}
+	if utf8.RuneCountInString(scheduleLabel) > maxCampaignListingLabelLength {
+		return nil, status.Errorf(codes.InvalidArgument, "schedule must be at most %d characters", maxCampaignListingLabelLength)
+	}
+	for _, text := range []string{description, tone, safetyNotes} {
+		if utf8.RuneCountInString(text) > maxCampaignListingTextLength {
+			return nil, status.Errorf(codes.InvalidArgument, "listing text fields must be at most %d characters", maxCampaignListingTextLength)
+		}
+	}
+
+	now := time.Now().UTC()
+	if s.clock != nil {
+		now = s.clock().UTC()
+	}
+	record, err := s.listingStore.UpsertCampaignListing(ctx, storage.DiscoveryEntry{
+		EntryID:       CampaignListingEntryID(campaignID),
+		Kind:          discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE,
+		SourceID:      campaignID,
+		Title:         title,
+		Description:   description,
+		System:        in.GetSystem(),
+		GmMode:        in.GetGmMode(),
+		ScheduleLabel: scheduleLabel,
+		Tone:          tone,
+		SafetyNotes:   safetyNotes,
+		OpenSeats:     int(in.GetOpenSeats()),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "publish campaign listing: %v", err)
+	}
+	return &discoveryv1.PublishCampaignListingResponse{Entry: discoveryEntryToProto(record)}, nil
+}
+
+// GetCampaignListing returns one campaign's visible open-table listing.
+func (s *Service) GetCampaignListing(ctx context.Context, in *discoveryv1.GetCampaignListingRequest) (*discoveryv1.GetCampaignListingResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "get campaign listing request is required")
+	}
+	if s == nil || s.store == nil {
+		return nil, status.Error(codes.Internal, "discovery entry store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	record, err := s.store.GetDiscoveryEntry(ctx, CampaignListingEntryID(campaignID))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "campaign listing not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get campaign listing: %v", err)
+	}
+	if record.Hidden || record.Kind != discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE {
+		return nil, status.Error(codes.NotFound, "campaign listing not found")
+	}
+	return &discoveryv1.GetCampaignListingResponse{Entry: discoveryEntryToProto(record)}, nil
+}
+
+// CloseCampaignListing removes one campaign's open-table listing.
+func (s *Service) CloseCampaignListing(ctx context.Context, in *discoveryv1.CloseCampaignListingRequest) (*discoveryv1.CloseCampaignListingResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "close campaign listing request is required")
+	}
+	if s == nil || s.listingStore == nil {
+		return nil, status.Error(codes.Internal, "campaign listing store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	if err := s.listingStore.DeleteDiscoveryEntry(ctx, CampaignListingEntryID(campaignID)); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "campaign listing not found")
+		}
+		return nil, status.Errorf(codes.Internal, "close campaign listing: %v", err)
+	}
+	return &discoveryv1.CloseCampaignListingResponse{}, nil
+}
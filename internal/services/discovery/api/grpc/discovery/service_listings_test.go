@@ -0,0 +1,94 @@
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPublishCampaignListing_RoundTripsThroughOpenTableKind(t *testing.T) {
+	store := newFakeStore()
+	svc := NewService(store)
+
+	_, err := svc.PublishCampaignListing(context.Background(), &discoveryv1.PublishCampaignListingRequest{
+		CampaignId: "camp-1", Title: "Sunfall", Description: "Haunted valley", OpenSeats: 0,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("zero seats code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	resp, err := svc.PublishCampaignListing(context.Background(), &discoveryv1.PublishCampaignListingRequest{
+		CampaignId:    " camp-1 ",
+		Title:         "Sunfall",
+		Description:   "Haunted valley",
+		ScheduleLabel: " Fridays ",
+		Tone:          "Grim",
+		SafetyNotes:   "X-card",
+		OpenSeats:     2,
+	})
+	if err != nil {
+		t.Fatalf("publish listing: %v", err)
+	}
+	entry := resp.GetEntry()
+	if entry.GetEntryId() != "listing:camp-1" || entry.GetSourceId() != "camp-1" || entry.GetKind() != discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE {
+		t.Fatalf("published entry = %+v", entry)
+	}
+	if entry.GetScheduleLabel() != "Fridays" || entry.GetOpenSeats() != 2 {
+		t.Fatalf("published listing details = %+v", entry)
+	}
+
+	got, err := svc.GetCampaignListing(context.Background(), &discoveryv1.GetCampaignListingRequest{CampaignId: "camp-1"})
+	if err != nil {
+		t.Fatalf("get listing: %v", err)
+	}
+	if got.GetEntry().GetSafetyNotes() != "X-card" {
+		t.Fatalf("listing = %+v", got.GetEntry())
+	}
+
+	if _, err := svc.CloseCampaignListing(context.Background(), &discoveryv1.CloseCampaignListingRequest{CampaignId: "camp-1"}); err != nil {
+		t.Fatalf("close listing: %v", err)
+	}
+	_, err = svc.GetCampaignListing(context.Background(), &discoveryv1.GetCampaignListingRequest{CampaignId: "camp-1"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("closed listing code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestGetCampaignListing_HidesModeratedListings(t *testing.T) {
+	store := newFakeStore()
+	store.records["listing:camp-1"] = storage.DiscoveryEntry{
+		EntryID:   "listing:camp-1",
+		Kind:      discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE,
+		SourceID:  "camp-1",
+		OpenSeats: 1,
+		Hidden:    true,
+	}
+	svc := NewService(store)
+
+	_, err := svc.GetCampaignListing(context.Background(), &discoveryv1.GetCampaignListingRequest{CampaignId: "camp-1"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("hidden listing code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
+func (f *fakeStore) UpsertCampaignListing(_ context.Context, entry storage.DiscoveryEntry) (storage.DiscoveryEntry, error) {
+	if current, ok := f.records[entry.EntryID]; ok {
+		entry.CreatedAt = current.CreatedAt
+		entry.Hidden = current.Hidden
+		entry.HiddenReason = current.HiddenReason
+	}
+	f.records[entry.EntryID] = entry
+	return entry, nil
+}
+
+func (f *fakeStore) DeleteDiscoveryEntry(_ context.Context, entryID string) error {
+	if _, ok := f.records[entryID]; !ok {
+		return storage.ErrNotFound
+	}
+	delete(f.records, entryID)
+	return nil
+}
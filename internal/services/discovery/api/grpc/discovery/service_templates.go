@@ -0,0 +1,60 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/catalog"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pregenLookup resolves the build behind a CHARACTER_TEMPLATE entry.
+type pregenLookup func(entryID string) (catalog.PregenDefinition, bool, error)
+
+// GetCharacterTemplate returns one pregenerated character and the creation
+// workflow that builds it. The stored entry gates visibility so moderation can
+// hide a template; the build comes from the embedded catalog.
+func (s *Service) GetCharacterTemplate(ctx context.Context, in *discoveryv1.GetCharacterTemplateRequest) (*discoveryv1.GetCharacterTemplateResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "get character template request is required")
+	}
+	if s == nil || s.store == nil || s.pregens == nil {
+		return nil, status.Error(codes.Internal, "discovery entry store is not configured")
+	}
+	entryID := strings.TrimSpace(in.GetEntryId())
+	if entryID == "" {
+		return nil, status.Error(codes.InvalidArgument, "entry id is required")
+	}
+
+	record, err := s.store.GetDiscoveryEntry(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "character template not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get character template: %v", err)
+	}
+	if record.Hidden || record.Kind != discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE {
+		return nil, status.Error(codes.NotFound, "character template not found")
+	}
+	pregen, ok, err := s.pregens(entryID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load character template: %v", err)
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "character template not found")
+	}
+
+	return &discoveryv1.GetCharacterTemplateResponse{Template: &discoveryv1.CharacterTemplate{
+		Entry:    discoveryEntryToProto(record),
+		Name:     pregen.Character.Name,
+		Pronouns: pregen.Character.Pronouns,
+		Summary:  pregen.Character.Summary,
+		SystemWorkflow: &discoveryv1.CharacterTemplate_Daggerheart{
+			Daggerheart: pregen.Character.DaggerheartWorkflow(),
+		},
+	}}, nil
+}
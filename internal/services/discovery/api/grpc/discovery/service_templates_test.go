@@ -0,0 +1,64 @@
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/catalog"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetCharacterTemplate_ReturnsBuiltinWorkflow(t *testing.T) {
+	pregens, err := catalog.BuiltinPregens()
+	if err != nil {
+		t.Fatalf("BuiltinPregens: %v", err)
+	}
+	pregen := pregens[0]
+	store := newFakeStore()
+	store.records[pregen.Entry.EntryID] = pregen.Entry
+	svc := NewService(store)
+
+	resp, err := svc.GetCharacterTemplate(context.Background(), &discoveryv1.GetCharacterTemplateRequest{EntryId: " " + pregen.Entry.EntryID + " "})
+	if err != nil {
+		t.Fatalf("GetCharacterTemplate: %v", err)
+	}
+	template := resp.GetTemplate()
+	if template.GetName() != pregen.Character.Name || template.GetEntry().GetKind() != discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE {
+		t.Fatalf("template = %+v", template)
+	}
+	if got := template.GetDaggerheart().GetClassSubclassInput().GetClassId(); got != pregen.Character.ClassID {
+		t.Fatalf("workflow class = %q, want %q", got, pregen.Character.ClassID)
+	}
+}
+
+func TestGetCharacterTemplate_NotFound(t *testing.T) {
+	store := newFakeStore()
+	store.records["pregen:hidden"] = storage.DiscoveryEntry{
+		EntryID: "pregen:hidden",
+		Kind:    discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE,
+		Hidden:  true,
+	}
+	store.records["starter:one"] = storage.DiscoveryEntry{
+		EntryID: "starter:one",
+		Kind:    discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CAMPAIGN_STARTER,
+	}
+	store.records["pregen:unknown"] = storage.DiscoveryEntry{
+		EntryID: "pregen:unknown",
+		Kind:    discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE,
+	}
+	svc := NewService(store)
+
+	for _, entryID := range []string{"missing", "pregen:hidden", "starter:one", "pregen:unknown"} {
+		_, err := svc.GetCharacterTemplate(context.Background(), &discoveryv1.GetCharacterTemplateRequest{EntryId: entryID})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("GetCharacterTemplate(%q) code = %v, want %v", entryID, status.Code(err), codes.NotFound)
+		}
+	}
+	_, err := svc.GetCharacterTemplate(context.Background(), &discoveryv1.GetCharacterTemplateRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("empty entry id code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
@@ -153,6 +153,41 @@ func TestCreateDiscoveryEntry_TrimsCampaignTheme(t *testing.T) {
 	}
 }
 
+func TestSetDiscoveryEntryHidden_HidesFromPublicLookup(t *testing.T) {
+	store := newFakeStore()
+	store.records["starter:camp-1"] = storage.DiscoveryEntry{EntryID: "starter:camp-1", Title: "Sunfall"}
+	svc := NewService(store)
+
+	_, err := svc.SetDiscoveryEntryHidden(context.Background(), &discoveryv1.SetDiscoveryEntryHiddenRequest{EntryId: "starter:camp-1", Hidden: true})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("missing reason code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+	resp, err := svc.SetDiscoveryEntryHidden(context.Background(), &discoveryv1.SetDiscoveryEntryHiddenRequest{EntryId: "starter:camp-1", Hidden: true, Reason: "reported"})
+	if err != nil {
+		t.Fatalf("hide entry: %v", err)
+	}
+	if !resp.GetEntry().GetHidden() || resp.GetEntry().GetHiddenReason() != "reported" {
+		t.Fatalf("hidden entry = %+v", resp.GetEntry())
+	}
+
+	_, err = svc.GetDiscoveryEntry(context.Background(), &discoveryv1.GetDiscoveryEntryRequest{EntryId: "starter:camp-1"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("public get code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+	got, err := svc.GetDiscoveryEntry(context.Background(), &discoveryv1.GetDiscoveryEntryRequest{EntryId: "starter:camp-1", IncludeHidden: true})
+	if err != nil {
+		t.Fatalf("operator get: %v", err)
+	}
+	if !got.GetEntry().GetHidden() {
+		t.Fatalf("operator entry = %+v", got.GetEntry())
+	}
+
+	_, err = svc.SetDiscoveryEntryHidden(context.Background(), &discoveryv1.SetDiscoveryEntryHiddenRequest{EntryId: "missing", Hidden: true, Reason: "x"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("missing entry code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
 type fakeStore struct {
 	records map[string]storage.DiscoveryEntry
 }
@@ -177,6 +212,21 @@ func (f *fakeStore) GetDiscoveryEntry(_ context.Context, entryID string) (storag
 	return entry, nil
 }
 
+func (f *fakeStore) SetDiscoveryEntryHidden(_ context.Context, entryID string, hidden bool, reason string, updatedAt time.Time) (storage.DiscoveryEntry, error) {
+	entry, ok := f.records[entryID]
+	if !ok {
+		return storage.DiscoveryEntry{}, storage.ErrNotFound
+	}
+	entry.Hidden = hidden
+	entry.HiddenReason = ""
+	if hidden {
+		entry.HiddenReason = reason
+	}
+	entry.UpdatedAt = updatedAt
+	f.records[entryID] = entry
+	return entry, nil
+}
+
 func (f *fakeStore) ListDiscoveryEntries(
 	_ context.Context,
 	pageSize int,
@@ -185,6 +235,9 @@ func (f *fakeStore) ListDiscoveryEntries(
 ) (storage.DiscoveryEntryPage, error) {
 	ids := make([]string, 0, len(f.records))
 	for id := range f.records {
+		if f.records[id].Hidden {
+			continue
+		}
 		if kind != discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_UNSPECIFIED && f.records[id].Kind != kind {
 			continue
 		}
@@ -295,13 +295,21 @@ func openDiscoveryStore(path string) (*discoverysqlite.Store, error) {
 	return store, nil
 }
 
-// bootstrapBuiltinCatalog inserts embedded discovery entries into the store if
-// they do not already exist. Already-existing entries are silently skipped.
+// bootstrapBuiltinCatalog inserts embedded starter and pregen discovery entries
+// into the store if they do not already exist. Already-existing entries are
+// silently skipped.
 func bootstrapBuiltinCatalog(store *discoverysqlite.Store) error {
 	entries, err := catalog.BuiltinEntries()
 	if err != nil {
 		return fmt.Errorf("load builtin entries: %w", err)
 	}
+	pregens, err := catalog.BuiltinPregens()
+	if err != nil {
+		return fmt.Errorf("load builtin pregens: %w", err)
+	}
+	for _, pregen := range pregens {
+		entries = append(entries, pregen.Entry)
+	}
 	ctx := context.Background()
 	for _, entry := range entries {
 		if err := store.UpsertBuiltinDiscoveryEntry(ctx, entry); err != nil {
@@ -8,7 +8,6 @@ import (
 
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
-	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
 	"github.com/louisbranch/fracturing.space/internal/services/discovery/catalog"
 	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
@@ -117,52 +116,11 @@ func createStarterTemplateCampaign(
 		return "", archiveOnFailure(fmt.Errorf("create character returned empty character id"))
 	}
 
-	workflow := &daggerheartv1.DaggerheartCreationWorkflowInput{
-		ClassSubclassInput: &daggerheartv1.DaggerheartCreationStepClassSubclassInput{
-			ClassId:    starter.Character.ClassID,
-			SubclassId: starter.Character.SubclassID,
-		},
-		HeritageInput: &daggerheartv1.DaggerheartCreationStepHeritageInput{
-			Heritage: &daggerheartv1.DaggerheartCreationStepHeritageSelectionInput{
-				FirstFeatureAncestryId:  starter.Character.AncestryID,
-				SecondFeatureAncestryId: starter.Character.AncestryID,
-				CommunityId:             starter.Character.CommunityID,
-			},
-		},
-		TraitsInput: &daggerheartv1.DaggerheartCreationStepTraitsInput{
-			Agility:   starter.Character.Traits.Agility,
-			Strength:  starter.Character.Traits.Strength,
-			Finesse:   starter.Character.Traits.Finesse,
-			Instinct:  starter.Character.Traits.Instinct,
-			Presence:  starter.Character.Traits.Presence,
-			Knowledge: starter.Character.Traits.Knowledge,
-		},
-		DetailsInput: &daggerheartv1.DaggerheartCreationStepDetailsInput{
-			Description: starter.Character.Description,
-		},
-		EquipmentInput: &daggerheartv1.DaggerheartCreationStepEquipmentInput{
-			WeaponIds:    append([]string(nil), starter.Character.WeaponIDs...),
-			ArmorId:      starter.Character.ArmorID,
-			PotionItemId: starter.Character.PotionItemID,
-		},
-		BackgroundInput: &daggerheartv1.DaggerheartCreationStepBackgroundInput{
-			Background: starter.Character.Background,
-		},
-		ExperiencesInput: &daggerheartv1.DaggerheartCreationStepExperiencesInput{
-			Experiences: toDaggerheartExperiences(starter.Character.Experiences),
-		},
-		DomainCardsInput: &daggerheartv1.DaggerheartCreationStepDomainCardsInput{
-			DomainCardIds: append([]string(nil), starter.Character.DomainCardIDs...),
-		},
-		ConnectionsInput: &daggerheartv1.DaggerheartCreationStepConnectionsInput{
-			Connections: starter.Character.Connections,
-		},
-	}
 	_, err = characterClient.ApplyCharacterCreationWorkflow(participantCtx, &gamev1.ApplyCharacterCreationWorkflowRequest{
 		CampaignId:  campaignID,
 		CharacterId: characterID,
 		SystemWorkflow: &gamev1.ApplyCharacterCreationWorkflowRequest_Daggerheart{
-			Daggerheart: workflow,
+			Daggerheart: starter.Character.DaggerheartWorkflow(),
 		},
 	})
 	if err != nil {
@@ -197,21 +155,6 @@ func isRetryableStarterReconciliationError(err error) bool {
 	}
 }
 
-func toDaggerheartExperiences(src []catalog.StarterExperienceDefinition) []*daggerheartv1.DaggerheartExperience {
-	out := make([]*daggerheartv1.DaggerheartExperience, 0, len(src))
-	for _, experience := range src {
-		name := strings.TrimSpace(experience.Name)
-		if name == "" {
-			continue
-		}
-		out = append(out, &daggerheartv1.DaggerheartExperience{
-			Name:     name,
-			Modifier: experience.Modifier,
-		})
-	}
-	return out
-}
-
 func starterThemePrompt(entry storage.DiscoveryEntry) string {
 	if theme := strings.TrimSpace(entry.CampaignTheme); theme != "" {
 		return theme
@@ -148,6 +148,73 @@ func TestBuiltinStarters_AreWorkflowValid(t *testing.T) {
 	}
 }
 
+func TestBuiltinPregens_CharacterTemplateShape(t *testing.T) {
+	pregens, err := BuiltinPregens()
+	if err != nil {
+		t.Fatalf("BuiltinPregens: %v", err)
+	}
+	if len(pregens) == 0 {
+		t.Fatal("BuiltinPregens returned no pregens")
+	}
+	seen := map[string]struct{}{}
+	for i, pregen := range pregens {
+		if pregen.Entry.Kind != discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE {
+			t.Fatalf("pregens[%d].kind = %v, want CHARACTER_TEMPLATE", i, pregen.Entry.Kind)
+		}
+		if pregen.Entry.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+			t.Fatalf("pregens[%d].system = %v, want DAGGERHEART", i, pregen.Entry.System)
+		}
+		if pregen.Entry.PreviewCharacterName != pregen.Character.Name || pregen.Entry.Description == "" {
+			t.Fatalf("pregens[%d] missing preview data", i)
+		}
+		if _, ok := seen[pregen.Entry.EntryID]; ok {
+			t.Fatalf("pregens[%d] duplicates entry id %q", i, pregen.Entry.EntryID)
+		}
+		seen[pregen.Entry.EntryID] = struct{}{}
+	}
+	if _, ok, err := BuiltinPregen(pregens[0].Entry.EntryID); err != nil || !ok {
+		t.Fatalf("BuiltinPregen(%q) = (%v, %v)", pregens[0].Entry.EntryID, ok, err)
+	}
+}
+
+func TestBuiltinPregens_AreWorkflowValid(t *testing.T) {
+	store := openImportedDaggerheartContentStore(t)
+	provider := daggerheartcreation.CreationWorkflowProvider{}
+
+	pregens, err := BuiltinPregens()
+	if err != nil {
+		t.Fatalf("BuiltinPregens: %v", err)
+	}
+	for _, pregen := range pregens {
+		pregen := pregen
+		t.Run(pregen.Entry.EntryID, func(t *testing.T) {
+			deps := &starterWorkflowDeps{content: store}
+			_, progress, err := provider.ApplyWorkflow(
+				context.Background(),
+				deps,
+				characterworkflow.CampaignContext{
+					ID:     "pregen-contract-campaign",
+					System: bridge.SystemIDDaggerheart,
+					Status: campaign.StatusActive,
+				},
+				&statev1.ApplyCharacterCreationWorkflowRequest{
+					CampaignId:  "pregen-contract-campaign",
+					CharacterId: "pregen-contract-character",
+					SystemWorkflow: &statev1.ApplyCharacterCreationWorkflowRequest_Daggerheart{
+						Daggerheart: pregen.Character.DaggerheartWorkflow(),
+					},
+				},
+			)
+			if err != nil {
+				t.Fatalf("ApplyWorkflow(%q) error = %v", pregen.Entry.EntryID, err)
+			}
+			if !progress.Ready {
+				t.Fatalf("ApplyWorkflow(%q) ready = false, unmet = %v", pregen.Entry.EntryID, progress.UnmetReasons)
+			}
+		})
+	}
+}
+
 type starterWorkflowDeps struct {
 	content      contentstore.DaggerheartContentReadStore
 	replaceCalls int
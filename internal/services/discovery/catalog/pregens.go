@@ -0,0 +1,145 @@
+package catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
+)
+
+//go:embed data/pregens.v1.json
+var pregensJSON []byte
+
+var (
+	pregenLoadOnce sync.Once
+	cachedPregens  []PregenDefinition
+	pregenLoadErr  error
+)
+
+// PregenDefinition is one curated pregenerated character published as a
+// CHARACTER_TEMPLATE discovery entry.
+type PregenDefinition struct {
+	Entry     storage.DiscoveryEntry
+	Character StarterCharacterDefinition
+}
+
+// pregenJSON mirrors the JSON schema in pregens.v1.json.
+type pregenJSON struct {
+	EntryID               string               `json:"entry_id"`
+	Title                 string               `json:"title"`
+	Description           string               `json:"description"`
+	DifficultyTier        string               `json:"difficulty_tier"`
+	ExpectedDurationLabel string               `json:"expected_duration_label"`
+	System                string               `json:"system"`
+	Level                 int                  `json:"level"`
+	Tags                  []string             `json:"tags"`
+	PreviewPlaystyleLabel string               `json:"preview_playstyle_label"`
+	Character             starterCharacterJSON `json:"character"`
+}
+
+// BuiltinPregens returns the canonical builtin pregenerated characters.
+func BuiltinPregens() ([]PregenDefinition, error) {
+	pregenLoadOnce.Do(func() {
+		cachedPregens, pregenLoadErr = loadPregenDefinitions()
+	})
+	if pregenLoadErr != nil {
+		return nil, pregenLoadErr
+	}
+	return copyPregens(cachedPregens), nil
+}
+
+// BuiltinPregen returns one builtin pregenerated character by discovery entry
+// ID.
+func BuiltinPregen(entryID string) (PregenDefinition, bool, error) {
+	pregens, err := BuiltinPregens()
+	if err != nil {
+		return PregenDefinition{}, false, err
+	}
+	entryID = strings.TrimSpace(entryID)
+	for _, pregen := range pregens {
+		if pregen.Entry.EntryID == entryID {
+			return pregen, true, nil
+		}
+	}
+	return PregenDefinition{}, false, nil
+}
+
+func loadPregenDefinitions() ([]PregenDefinition, error) {
+	var entries []pregenJSON
+	if err := json.Unmarshal(pregensJSON, &entries); err != nil {
+		return nil, fmt.Errorf("decode pregens JSON: %w", err)
+	}
+	out := make([]PregenDefinition, 0, len(entries))
+	for i, raw := range entries {
+		pregen, err := convertPregen(raw)
+		if err != nil {
+			return nil, fmt.Errorf("pregen [%d] %q: %w", i, raw.EntryID, err)
+		}
+		out = append(out, pregen)
+	}
+	return out, nil
+}
+
+func convertPregen(raw pregenJSON) (PregenDefinition, error) {
+	entryID := strings.TrimSpace(raw.EntryID)
+	if entryID == "" {
+		return PregenDefinition{}, fmt.Errorf("entry_id is required")
+	}
+	difficultyTier, err := parseDifficultyTier(raw.DifficultyTier)
+	if err != nil {
+		return PregenDefinition{}, err
+	}
+	system, err := parseGameSystem(raw.System)
+	if err != nil {
+		return PregenDefinition{}, err
+	}
+	character, err := convertStarterCharacter(raw.Character)
+	if err != nil {
+		return PregenDefinition{}, err
+	}
+	title := strings.TrimSpace(raw.Title)
+	if title == "" {
+		title = character.Name
+	}
+	description := strings.TrimSpace(raw.Description)
+	if description == "" {
+		description = character.Summary
+	}
+
+	return PregenDefinition{
+		Entry: storage.DiscoveryEntry{
+			EntryID:                    entryID,
+			Kind:                       discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE,
+			Title:                      title,
+			Description:                description,
+			RecommendedParticipantsMin: 1,
+			RecommendedParticipantsMax: 1,
+			DifficultyTier:             difficultyTier,
+			ExpectedDurationLabel:      strings.TrimSpace(raw.ExpectedDurationLabel),
+			System:                     system,
+			Level:                      raw.Level,
+			CharacterCount:             1,
+			Tags:                       trimValues(raw.Tags),
+			PreviewPlaystyleLabel:      strings.TrimSpace(raw.PreviewPlaystyleLabel),
+			PreviewCharacterName:       character.Name,
+			PreviewCharacterSummary:    character.Summary,
+		},
+		Character: character,
+	}, nil
+}
+
+func copyPregens(src []PregenDefinition) []PregenDefinition {
+	out := make([]PregenDefinition, len(src))
+	for i, pregen := range src {
+		out[i] = pregen
+		out[i].Entry.Tags = append([]string(nil), pregen.Entry.Tags...)
+		out[i].Character.WeaponIDs = append([]string(nil), pregen.Character.WeaponIDs...)
+		out[i].Character.DomainCardIDs = append([]string(nil), pregen.Character.DomainCardIDs...)
+		out[i].Character.Experiences = append([]StarterExperienceDefinition(nil), pregen.Character.Experiences...)
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package catalog
+
+import (
+	"strings"
+
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+)
+
+// DaggerheartWorkflow returns the complete Daggerheart creation workflow that
+// builds this character through ApplyCharacterCreationWorkflow.
+func (c StarterCharacterDefinition) DaggerheartWorkflow() *daggerheartv1.DaggerheartCreationWorkflowInput {
+	return &daggerheartv1.DaggerheartCreationWorkflowInput{
+		ClassSubclassInput: &daggerheartv1.DaggerheartCreationStepClassSubclassInput{
+			ClassId:    c.ClassID,
+			SubclassId: c.SubclassID,
+		},
+		HeritageInput: &daggerheartv1.DaggerheartCreationStepHeritageInput{
+			Heritage: &daggerheartv1.DaggerheartCreationStepHeritageSelectionInput{
+				FirstFeatureAncestryId:  c.AncestryID,
+				SecondFeatureAncestryId: c.AncestryID,
+				CommunityId:             c.CommunityID,
+			},
+		},
+		TraitsInput: &daggerheartv1.DaggerheartCreationStepTraitsInput{
+			Agility:   c.Traits.Agility,
+			Strength:  c.Traits.Strength,
+			Finesse:   c.Traits.Finesse,
+			Instinct:  c.Traits.Instinct,
+			Presence:  c.Traits.Presence,
+			Knowledge: c.Traits.Knowledge,
+		},
+		DetailsInput: &daggerheartv1.DaggerheartCreationStepDetailsInput{
+			Description: c.Description,
+		},
+		EquipmentInput: &daggerheartv1.DaggerheartCreationStepEquipmentInput{
+			WeaponIds:    append([]string(nil), c.WeaponIDs...),
+			ArmorId:      c.ArmorID,
+			PotionItemId: c.PotionItemID,
+		},
+		BackgroundInput: &daggerheartv1.DaggerheartCreationStepBackgroundInput{
+			Background: c.Background,
+		},
+		ExperiencesInput: &daggerheartv1.DaggerheartCreationStepExperiencesInput{
+			Experiences: daggerheartExperiences(c.Experiences),
+		},
+		DomainCardsInput: &daggerheartv1.DaggerheartCreationStepDomainCardsInput{
+			DomainCardIds: append([]string(nil), c.DomainCardIDs...),
+		},
+		ConnectionsInput: &daggerheartv1.DaggerheartCreationStepConnectionsInput{
+			Connections: c.Connections,
+		},
+	}
+}
+
+func daggerheartExperiences(src []StarterExperienceDefinition) []*daggerheartv1.DaggerheartExperience {
+	out := make([]*daggerheartv1.DaggerheartExperience, 0, len(src))
+	for _, experience := range src {
+		name := strings.TrimSpace(experience.Name)
+		if name == "" {
+			continue
+		}
+		out = append(out, &daggerheartv1.DaggerheartExperience{
+			Name:     name,
+			Modifier: experience.Modifier,
+		})
+	}
+	return out
+}
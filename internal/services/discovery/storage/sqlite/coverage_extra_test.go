@@ -276,6 +276,12 @@ func TestUpdateGetListAndScanBranches(t *testing.T) {
 		" Guardian ",
 		" Mira ",
 		" Summary ",
+		int64(0),
+		"",
+		"",
+		"",
+		"",
+		0,
 		time.Date(2026, time.March, 28, 21, 0, 0, 0, time.UTC).UnixMilli(),
 		time.Date(2026, time.March, 28, 22, 0, 0, 0, time.UTC).UnixMilli(),
 	}})
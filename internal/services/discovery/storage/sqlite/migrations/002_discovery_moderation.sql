@@ -0,0 +1,4 @@
+-- Operator moderation can hide entries from public discovery.
+
+ALTER TABLE discovery_entries ADD COLUMN hidden INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE discovery_entries ADD COLUMN hidden_reason TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- Open-table listings advertise open seats in live campaigns.
+
+ALTER TABLE discovery_entries ADD COLUMN schedule_label TEXT NOT NULL DEFAULT '';
+ALTER TABLE discovery_entries ADD COLUMN tone TEXT NOT NULL DEFAULT '';
+ALTER TABLE discovery_entries ADD COLUMN safety_notes TEXT NOT NULL DEFAULT '';
+ALTER TABLE discovery_entries ADD COLUMN open_seats INTEGER NOT NULL DEFAULT 0;
@@ -278,6 +278,53 @@ func (s *Store) UpdateDiscoveryEntrySourceID(ctx context.Context, entryID string
 	return nil
 }
 
+// SetDiscoveryEntryHidden hides or restores one entry and returns the result.
+// Restoring clears the recorded reason.
+func (s *Store) SetDiscoveryEntryHidden(ctx context.Context, entryID string, hidden bool, reason string, updatedAt time.Time) (storage.DiscoveryEntry, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.DiscoveryEntry{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.DiscoveryEntry{}, fmt.Errorf("storage is not configured")
+	}
+	entryID = strings.TrimSpace(entryID)
+	if entryID == "" {
+		return storage.DiscoveryEntry{}, fmt.Errorf("entry id is required")
+	}
+	reason = strings.TrimSpace(reason)
+	hiddenValue := 0
+	if hidden {
+		hiddenValue = 1
+	} else {
+		reason = ""
+	}
+	if updatedAt.IsZero() {
+		updatedAt = time.Now().UTC()
+	}
+
+	result, err := s.sqlDB.ExecContext(
+		ctx,
+		`UPDATE discovery_entries
+		    SET hidden = ?, hidden_reason = ?, updated_at = ?
+		  WHERE entry_id = ?`,
+		hiddenValue,
+		reason,
+		sqliteutil.ToMillis(updatedAt.UTC()),
+		entryID,
+	)
+	if err != nil {
+		return storage.DiscoveryEntry{}, fmt.Errorf("set discovery entry hidden: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return storage.DiscoveryEntry{}, fmt.Errorf("set discovery entry hidden rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return storage.DiscoveryEntry{}, storage.ErrNotFound
+	}
+	return s.GetDiscoveryEntry(ctx, entryID)
+}
+
 // GetDiscoveryEntry returns one discovery entry by entry ID.
 func (s *Store) GetDiscoveryEntry(ctx context.Context, entryID string) (storage.DiscoveryEntry, error) {
 	if err := ctx.Err(); err != nil {
@@ -298,6 +345,7 @@ func (s *Store) GetDiscoveryEntry(ctx context.Context, entryID string) (storage.
 		        difficulty_tier, expected_duration_label, system,
 		        gm_mode, intent, level, character_count, storyline, tags,
 		        preview_hook, preview_playstyle_label, preview_character_name, preview_character_summary,
+		        hidden, hidden_reason, schedule_label, tone, safety_notes, open_seats,
 		        created_at, updated_at
 		   FROM discovery_entries
 		  WHERE entry_id = ?`,
@@ -341,6 +389,7 @@ func (s *Store) ListDiscoveryEntries(
 		        difficulty_tier, expected_duration_label, system,
 		        gm_mode, intent, level, character_count, storyline, tags,
 		        preview_hook, preview_playstyle_label, preview_character_name, preview_character_summary,
+		        hidden, hidden_reason, schedule_label, tone, safety_notes, open_seats,
 		        created_at, updated_at`
 
 	var (
@@ -353,6 +402,7 @@ func (s *Store) ListDiscoveryEntries(
 			ctx,
 			`SELECT `+selectCols+`
 			   FROM discovery_entries
+			  WHERE hidden = 0
 			  ORDER BY entry_id ASC
 			  LIMIT ?`,
 			pageSize+1,
@@ -362,7 +412,7 @@ func (s *Store) ListDiscoveryEntries(
 			ctx,
 			`SELECT `+selectCols+`
 			   FROM discovery_entries
-			  WHERE entry_id > ?
+			  WHERE hidden = 0 AND entry_id > ?
 			  ORDER BY entry_id ASC
 			  LIMIT ?`,
 			pageToken,
@@ -373,7 +423,7 @@ func (s *Store) ListDiscoveryEntries(
 			ctx,
 			`SELECT `+selectCols+`
 			   FROM discovery_entries
-			  WHERE kind = ?
+			  WHERE hidden = 0 AND kind = ?
 			  ORDER BY entry_id ASC
 			  LIMIT ?`,
 			int32(kind),
@@ -384,7 +434,7 @@ func (s *Store) ListDiscoveryEntries(
 			ctx,
 			`SELECT `+selectCols+`
 			   FROM discovery_entries
-			  WHERE kind = ? AND entry_id > ?
+			  WHERE hidden = 0 AND kind = ? AND entry_id > ?
 			  ORDER BY entry_id ASC
 			  LIMIT ?`,
 			int32(kind),
@@ -431,6 +481,7 @@ func scanEntry(scanner rowScanner) (storage.DiscoveryEntry, error) {
 	var previewPlaystyleLabel string
 	var previewCharacterName string
 	var previewCharacterSummary string
+	var hidden int64
 	var createdAt int64
 	var updatedAt int64
 	if err := scanner.Scan(
@@ -455,6 +506,12 @@ func scanEntry(scanner rowScanner) (storage.DiscoveryEntry, error) {
 		&previewPlaystyleLabel,
 		&previewCharacterName,
 		&previewCharacterSummary,
+		&hidden,
+		&entry.HiddenReason,
+		&entry.ScheduleLabel,
+		&entry.Tone,
+		&entry.SafetyNotes,
+		&entry.OpenSeats,
 		&createdAt,
 		&updatedAt,
 	); err != nil {
@@ -471,6 +528,7 @@ func scanEntry(scanner rowScanner) (storage.DiscoveryEntry, error) {
 	entry.PreviewPlaystyleLabel = strings.TrimSpace(previewPlaystyleLabel)
 	entry.PreviewCharacterName = strings.TrimSpace(previewCharacterName)
 	entry.PreviewCharacterSummary = strings.TrimSpace(previewCharacterSummary)
+	entry.Hidden = hidden != 0
 	entry.CreatedAt = sqliteutil.FromMillis(createdAt)
 	entry.UpdatedAt = sqliteutil.FromMillis(updatedAt)
 	return entry, nil
@@ -569,3 +627,5 @@ func isDiscoveryEntryUniqueViolation(err error) bool {
 }
 
 var _ storage.DiscoveryEntryStore = (*Store)(nil)
+var _ storage.DiscoveryEntryModerationStore = (*Store)(nil)
+var _ storage.CampaignListingStore = (*Store)(nil)
@@ -0,0 +1,127 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
+)
+
+// UpsertCampaignListing inserts or replaces one open-table listing. The
+// original creation time and any moderation hide survive republishing so a GM
+// cannot lift a takedown by editing the listing.
+func (s *Store) UpsertCampaignListing(ctx context.Context, entry storage.DiscoveryEntry) (storage.DiscoveryEntry, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.DiscoveryEntry{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.DiscoveryEntry{}, fmt.Errorf("storage is not configured")
+	}
+	entry.EntryID = strings.TrimSpace(entry.EntryID)
+	entry.SourceID = strings.TrimSpace(entry.SourceID)
+	entry.Title = strings.TrimSpace(entry.Title)
+	entry.Description = strings.TrimSpace(entry.Description)
+	entry.ScheduleLabel = strings.TrimSpace(entry.ScheduleLabel)
+	entry.Tone = strings.TrimSpace(entry.Tone)
+	entry.SafetyNotes = strings.TrimSpace(entry.SafetyNotes)
+	if entry.EntryID == "" || entry.SourceID == "" {
+		return storage.DiscoveryEntry{}, fmt.Errorf("entry id and source id are required")
+	}
+	if entry.Kind != discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE {
+		return storage.DiscoveryEntry{}, fmt.Errorf("campaign listings must be open-table entries")
+	}
+	if entry.OpenSeats <= 0 {
+		return storage.DiscoveryEntry{}, fmt.Errorf("open seats must be greater than zero")
+	}
+	if entry.UpdatedAt.IsZero() {
+		entry.UpdatedAt = time.Now().UTC()
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = entry.UpdatedAt
+	}
+
+	_, err := s.sqlDB.ExecContext(
+		ctx,
+		`INSERT INTO discovery_entries (
+		   entry_id,
+		   kind,
+		   source_id,
+		   title,
+		   description,
+		   recommended_participants_min,
+		   recommended_participants_max,
+		   difficulty_tier,
+		   expected_duration_label,
+		   system,
+		   gm_mode,
+		   schedule_label,
+		   tone,
+		   safety_notes,
+		   open_seats,
+		   created_at,
+		   updated_at
+		 ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, '', ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(entry_id) DO UPDATE SET
+		   title = excluded.title,
+		   description = excluded.description,
+		   recommended_participants_min = excluded.recommended_participants_min,
+		   recommended_participants_max = excluded.recommended_participants_max,
+		   system = excluded.system,
+		   gm_mode = excluded.gm_mode,
+		   schedule_label = excluded.schedule_label,
+		   tone = excluded.tone,
+		   safety_notes = excluded.safety_notes,
+		   open_seats = excluded.open_seats,
+		   updated_at = excluded.updated_at`,
+		entry.EntryID,
+		int32(entry.Kind),
+		entry.SourceID,
+		entry.Title,
+		entry.Description,
+		entry.OpenSeats,
+		entry.OpenSeats,
+		int32(discoveryv1.DiscoveryDifficultyTier_DISCOVERY_DIFFICULTY_TIER_UNSPECIFIED),
+		int32(entry.System),
+		int32(entry.GmMode),
+		entry.ScheduleLabel,
+		entry.Tone,
+		entry.SafetyNotes,
+		entry.OpenSeats,
+		sqliteutil.ToMillis(entry.CreatedAt.UTC()),
+		sqliteutil.ToMillis(entry.UpdatedAt.UTC()),
+	)
+	if err != nil {
+		return storage.DiscoveryEntry{}, fmt.Errorf("upsert campaign listing: %w", err)
+	}
+	return s.GetDiscoveryEntry(ctx, entry.EntryID)
+}
+
+// DeleteDiscoveryEntry removes one discovery entry.
+func (s *Store) DeleteDiscoveryEntry(ctx context.Context, entryID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	entryID = strings.TrimSpace(entryID)
+	if entryID == "" {
+		return fmt.Errorf("entry id is required")
+	}
+	result, err := s.sqlDB.ExecContext(ctx, `DELETE FROM discovery_entries WHERE entry_id = ?`, entryID)
+	if err != nil {
+		return fmt.Errorf("delete discovery entry: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete discovery entry rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/discovery/storage"
+)
+
+func TestUpsertCampaignListingPreservesCreationAndModeration(t *testing.T) {
+	t.Parallel()
+
+	store := openTempStore(t)
+	ctx := context.Background()
+	created := time.Date(2026, time.March, 6, 13, 0, 0, 0, time.UTC)
+	listing := storage.DiscoveryEntry{
+		EntryID:       "listing:camp-1",
+		Kind:          discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE,
+		SourceID:      "camp-1",
+		Title:         "Sunfall",
+		Description:   "A haunted valley campaign",
+		System:        commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+		ScheduleLabel: "Fridays 19:00 UTC",
+		Tone:          "Grim but hopeful",
+		SafetyNotes:   "X-card, no gore",
+		OpenSeats:     2,
+		CreatedAt:     created,
+		UpdatedAt:     created,
+	}
+	got, err := store.UpsertCampaignListing(ctx, listing)
+	if err != nil {
+		t.Fatalf("upsert campaign listing: %v", err)
+	}
+	if got.OpenSeats != 2 || got.ScheduleLabel != "Fridays 19:00 UTC" || got.SafetyNotes != "X-card, no gore" {
+		t.Fatalf("listing = %+v", got)
+	}
+	if _, err := store.SetDiscoveryEntryHidden(ctx, listing.EntryID, true, "spam", created); err != nil {
+		t.Fatalf("hide listing: %v", err)
+	}
+
+	listing.OpenSeats = 1
+	listing.CreatedAt = time.Time{}
+	listing.UpdatedAt = created.Add(time.Hour)
+	got, err = store.UpsertCampaignListing(ctx, listing)
+	if err != nil {
+		t.Fatalf("republish campaign listing: %v", err)
+	}
+	if got.OpenSeats != 1 || !got.CreatedAt.Equal(created) {
+		t.Fatalf("republished listing = %+v", got)
+	}
+	if !got.Hidden {
+		t.Fatal("republishing must not lift a moderation hide")
+	}
+}
+
+func TestDeleteDiscoveryEntryReportsMissingEntries(t *testing.T) {
+	t.Parallel()
+
+	store := openTempStore(t)
+	ctx := context.Background()
+	if _, err := store.UpsertCampaignListing(ctx, storage.DiscoveryEntry{
+		EntryID:     "listing:camp-1",
+		Kind:        discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE,
+		SourceID:    "camp-1",
+		Title:       "Sunfall",
+		Description: "A haunted valley campaign",
+		OpenSeats:   1,
+	}); err != nil {
+		t.Fatalf("upsert campaign listing: %v", err)
+	}
+	if err := store.DeleteDiscoveryEntry(ctx, "listing:camp-1"); err != nil {
+		t.Fatalf("delete discovery entry: %v", err)
+	}
+	if err := store.DeleteDiscoveryEntry(ctx, "listing:camp-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("second delete error = %v, want ErrNotFound", err)
+	}
+}
@@ -256,6 +256,55 @@ func TestUpdateDiscoveryEntrySourceIDUpdatesSourceID(t *testing.T) {
 	}
 }
 
+func TestSetDiscoveryEntryHiddenRemovesEntryFromListing(t *testing.T) {
+	t.Parallel()
+
+	store := openTempStore(t)
+	now := time.Date(2026, time.March, 6, 16, 0, 0, 0, time.UTC)
+	entry := storage.DiscoveryEntry{
+		EntryID:                    "starter:camp-1",
+		Kind:                       discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CAMPAIGN_STARTER,
+		Title:                      "Sunfall",
+		Description:                "A haunted valley campaign",
+		RecommendedParticipantsMin: 1,
+		RecommendedParticipantsMax: 1,
+		DifficultyTier:             discoveryv1.DiscoveryDifficultyTier_DISCOVERY_DIFFICULTY_TIER_BEGINNER,
+		ExpectedDurationLabel:      "1 session",
+		System:                     commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+		CreatedAt:                  now,
+		UpdatedAt:                  now,
+	}
+	if err := store.CreateDiscoveryEntry(context.Background(), entry); err != nil {
+		t.Fatalf("create discovery entry: %v", err)
+	}
+
+	hidden, err := store.SetDiscoveryEntryHidden(context.Background(), entry.EntryID, true, "reported", now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("hide discovery entry: %v", err)
+	}
+	if !hidden.Hidden || hidden.HiddenReason != "reported" {
+		t.Fatalf("hidden entry = %#v", hidden)
+	}
+	page, err := store.ListDiscoveryEntries(context.Background(), 10, "", discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_UNSPECIFIED)
+	if err != nil {
+		t.Fatalf("list discovery entries: %v", err)
+	}
+	if len(page.Entries) != 0 {
+		t.Fatalf("listed entries = %d, want 0", len(page.Entries))
+	}
+
+	restored, err := store.SetDiscoveryEntryHidden(context.Background(), entry.EntryID, false, "ignored", now.Add(2*time.Minute))
+	if err != nil {
+		t.Fatalf("restore discovery entry: %v", err)
+	}
+	if restored.Hidden || restored.HiddenReason != "" {
+		t.Fatalf("restored entry = %#v", restored)
+	}
+	if _, err := store.SetDiscoveryEntryHidden(context.Background(), "missing", true, "x", now); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("hide missing error = %v, want %v", err, storage.ErrNotFound)
+	}
+}
+
 func openTempStore(t *testing.T) *Store {
 	t.Helper()
 
@@ -40,8 +40,18 @@ type DiscoveryEntry struct {
 	PreviewPlaystyleLabel      string
 	PreviewCharacterName       string
 	PreviewCharacterSummary    string
-	CreatedAt                  time.Time
-	UpdatedAt                  time.Time
+	// Hidden removes the entry from public listing and lookup until an
+	// operator restores it.
+	Hidden       bool
+	HiddenReason string
+	// Open-table listing details. Only DISCOVERY_ENTRY_KIND_OPEN_TABLE
+	// entries set these.
+	ScheduleLabel string
+	Tone          string
+	SafetyNotes   string
+	OpenSeats     int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
 // DiscoveryEntryPage stores one page of discovery records.
@@ -51,8 +61,22 @@ type DiscoveryEntryPage struct {
 }
 
 // DiscoveryEntryStore persists discovery entry records.
+//
+// ListDiscoveryEntries omits hidden entries; GetDiscoveryEntry returns them so
+// callers decide whether hidden entries are visible.
 type DiscoveryEntryStore interface {
 	CreateDiscoveryEntry(ctx context.Context, entry DiscoveryEntry) error
 	GetDiscoveryEntry(ctx context.Context, entryID string) (DiscoveryEntry, error)
 	ListDiscoveryEntries(ctx context.Context, pageSize int, pageToken string, kind discoveryv1.DiscoveryEntryKind) (DiscoveryEntryPage, error)
 }
+
+// DiscoveryEntryModerationStore applies operator visibility changes.
+type DiscoveryEntryModerationStore interface {
+	SetDiscoveryEntryHidden(ctx context.Context, entryID string, hidden bool, reason string, updatedAt time.Time) (DiscoveryEntry, error)
+}
+
+// CampaignListingStore persists open-table listings, one per campaign.
+type CampaignListingStore interface {
+	UpsertCampaignListing(ctx context.Context, entry DiscoveryEntry) (DiscoveryEntry, error)
+	DeleteDiscoveryEntry(ctx context.Context, entryID string) error
+}
@@ -7,15 +7,18 @@ import (
 )
 
 // CampaignAIOrchestrationDeps declares the explicit collaborators used by the
-// internal AI GM turn orchestration surface.
+// internal AI GM and AI player turn orchestration surface.
 type CampaignAIOrchestrationDeps struct {
 	Campaign           storage.CampaignStore
 	Participant        storage.ParticipantStore
+	Character          storage.CharacterStore
+	Event              storage.EventHistoryStore
 	Session            storage.SessionStore
 	SessionRecap       storage.SessionRecapStore
 	SessionInteraction storage.SessionInteractionStore
 	Scene              storage.SceneStore
 	SceneInteraction   storage.SceneInteractionStore
+	SceneGMInteraction storage.SceneGMInteractionStore
 	Write              WritePath
 	Applier            projection.Applier
 }
@@ -36,11 +39,14 @@ func newCampaignAIOrchestrationApplicationWithDependencies(
 	return interactiontransport.NewAIOrchestrationApplication(interactiontransport.Deps{
 		Campaign:           deps.Campaign,
 		Participant:        deps.Participant,
+		Character:          deps.Character,
+		Event:              deps.Event,
 		Session:            deps.Session,
 		SessionRecap:       deps.SessionRecap,
 		SessionInteraction: deps.SessionInteraction,
 		Scene:              deps.Scene,
 		SceneInteraction:   deps.SceneInteraction,
+		SceneGMInteraction: deps.SceneGMInteraction,
 		Write:              deps.Write,
 		Applier:            deps.Applier,
 	}, idGenerator)
@@ -12,7 +12,7 @@ import (
 )
 
 // CampaignAIOrchestrationService implements internal automation lifecycle
-// writes for AI-owned GM turns.
+// writes for AI-owned GM turns and scheduling reads for AI player turns.
 type CampaignAIOrchestrationService struct {
 	campaignv1.UnimplementedCampaignAIOrchestrationServiceServer
 	app interactiontransport.AIOrchestrationApplication
@@ -121,6 +121,44 @@ func (s *CampaignAIOrchestrationService) CompleteAIGMTurn(ctx context.Context, i
 	return &campaignv1.CompleteAIGMTurnResponse{AiTurn: turn}, nil
 }
 
+func (s *CampaignAIOrchestrationService) ListDueAIPlayerTurns(ctx context.Context, in *campaignv1.ListDueAIPlayerTurnsRequest) (*campaignv1.ListDueAIPlayerTurnsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list due ai player turns request is required")
+	}
+	campaignID, err := validate.RequiredID(in.GetCampaignId(), "campaign id")
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := validate.RequiredID(in.GetSessionId(), "session id")
+	if err != nil {
+		return nil, err
+	}
+	sceneID, err := validate.RequiredID(in.GetSceneId(), "scene id")
+	if err != nil {
+		return nil, err
+	}
+	phaseID, err := validate.RequiredID(in.GetPhaseId(), "phase id")
+	if err != nil {
+		return nil, err
+	}
+	return s.app.ListDueAIPlayerTurns(ctx, campaignID, sessionID, sceneID, phaseID)
+}
+
+func (s *CampaignAIOrchestrationService) GetSessionRecapSources(ctx context.Context, in *campaignv1.GetSessionRecapSourcesRequest) (*campaignv1.GetSessionRecapSourcesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "get session recap sources request is required")
+	}
+	campaignID, err := validate.RequiredID(in.GetCampaignId(), "campaign id")
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := validate.RequiredID(in.GetSessionId(), "session id")
+	if err != nil {
+		return nil, err
+	}
+	return s.app.GetSessionRecapSources(ctx, campaignID, sessionID)
+}
+
 func (s *CampaignAIOrchestrationService) ConcludeSession(ctx context.Context, in *campaignv1.ConcludeSessionRequest) (*campaignv1.ConcludeSessionResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "conclude session request is required")
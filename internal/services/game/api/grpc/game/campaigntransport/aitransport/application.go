@@ -65,6 +65,7 @@ func (a application) IssueCampaignAISessionGrant(
 	ctx context.Context,
 	campaignID string,
 	sessionID string,
+	playerParticipantID string,
 ) (*campaignv1.IssueCampaignAISessionGrantResponse, error) {
 	if a.stores.Campaign == nil {
 		return nil, status.Error(codes.Internal, "campaign store is not configured")
@@ -77,7 +78,9 @@ func (a application) IssueCampaignAISessionGrant(
 	if err != nil {
 		return nil, err
 	}
-	if campaignRecord.GmMode != campaign.GmModeAI && campaignRecord.GmMode != campaign.GmModeHybrid {
+	// AI players may fill seats at any table, so only GM grants depend on the
+	// campaign GM mode.
+	if playerParticipantID == "" && campaignRecord.GmMode != campaign.GmModeAI && campaignRecord.GmMode != campaign.GmModeHybrid {
 		return nil, status.Error(codes.FailedPrecondition, "campaign gm mode does not support ai orchestration")
 	}
 	boundAgentID := strings.TrimSpace(campaignRecord.AIAgentID)
@@ -95,12 +98,19 @@ func (a application) IssueCampaignAISessionGrant(
 	if strings.TrimSpace(activeSession.ID) != sessionID {
 		return nil, status.Error(codes.FailedPrecondition, "requested session does not match active campaign session")
 	}
-	participantID, err := a.campaignAIParticipantID(ctx, campaignID, sessionID)
-	if err != nil {
-		return nil, err
-	}
-	if participantID == "" {
-		return nil, status.Error(codes.FailedPrecondition, "campaign ai gm participant is unavailable")
+	participantID := playerParticipantID
+	if participantID != "" {
+		if err := a.requireAIPlayerParticipant(ctx, campaignID, participantID); err != nil {
+			return nil, err
+		}
+	} else {
+		participantID, err = a.campaignAIParticipantID(ctx, campaignID, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		if participantID == "" {
+			return nil, status.Error(codes.FailedPrecondition, "campaign ai gm participant is unavailable")
+		}
 	}
 
 	grantID, err := a.idGenerator()
@@ -236,3 +246,22 @@ func (a application) campaignAIParticipantID(ctx context.Context, campaignID, se
 	}
 	return pid, nil
 }
+
+// requireAIPlayerParticipant checks that a player grant targets an
+// AI-controlled player seat rather than a human or the GM.
+func (a application) requireAIPlayerParticipant(ctx context.Context, campaignID, participantID string) error {
+	if a.stores.Participant == nil {
+		return status.Error(codes.Internal, "participant store is not configured")
+	}
+	record, err := a.stores.Participant.GetParticipant(ctx, campaignID, participantID)
+	if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get ai player participant"); lookupErr != nil {
+		return lookupErr
+	}
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, "ai player participant was not found")
+	}
+	if record.Role != participant.RolePlayer || record.Controller != participant.ControllerAI {
+		return status.Error(codes.FailedPrecondition, "participant is not an ai-controlled player")
+	}
+	return nil
+}
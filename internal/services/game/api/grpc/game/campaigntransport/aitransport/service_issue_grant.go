@@ -2,6 +2,7 @@ package aitransport
 
 import (
 	"context"
+	"strings"
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
@@ -23,5 +24,5 @@ func (s *Service) IssueCampaignAISessionGrant(ctx context.Context, in *campaignv
 	if err != nil {
 		return nil, err
 	}
-	return s.app.IssueCampaignAISessionGrant(ctx, campaignID, sessionID)
+	return s.app.IssueCampaignAISessionGrant(ctx, campaignID, sessionID, strings.TrimSpace(in.GetParticipantId()))
 }
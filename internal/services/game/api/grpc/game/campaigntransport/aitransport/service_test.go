@@ -239,6 +239,54 @@ func TestIssueCampaignAISessionGrantSuccess(t *testing.T) {
 	}
 }
 
+func TestIssueCampaignAISessionGrantForAIPlayer(t *testing.T) {
+	now := time.Date(2026, 3, 2, 7, 0, 0, 0, time.UTC)
+	campaignStore := gametest.NewFakeCampaignStore()
+	sessionStore := gametest.NewFakeSessionStore()
+	participantStore := gametest.NewFakeParticipantStore()
+	campaignStore.Campaigns["camp-1"] = storage.CampaignRecord{ID: "camp-1", GmMode: campaign.GmModeHuman, AIAgentID: "agent-1", AIAuthEpoch: 4}
+	sessionStore.Sessions["camp-1"] = map[string]storage.SessionRecord{
+		"session-1": {ID: "session-1", CampaignID: "camp-1", Status: session.StatusActive, StartedAt: now},
+	}
+	sessionStore.ActiveSession["camp-1"] = "session-1"
+	participantStore.Participants["camp-1"] = map[string]storage.ParticipantRecord{
+		"ai-player": {ID: "ai-player", CampaignID: "camp-1", Role: participant.RolePlayer, Controller: participant.ControllerAI},
+		"human":     {ID: "human", CampaignID: "camp-1", Role: participant.RolePlayer, Controller: participant.ControllerHuman},
+	}
+
+	svc := newServiceForTest(Deps{
+		Campaign:           campaignStore,
+		Session:            sessionStore,
+		Participant:        participantStore,
+		SessionInteraction: gametest.NewFakeSessionInteractionStore(),
+	}, now)
+
+	for _, participantID := range []string{"human", "missing"} {
+		_, err := svc.IssueCampaignAISessionGrant(context.Background(), &statev1.IssueCampaignAISessionGrantRequest{
+			CampaignId:    "camp-1",
+			SessionId:     "session-1",
+			ParticipantId: participantID,
+		})
+		assertStatusCode(t, err, codes.FailedPrecondition)
+	}
+
+	resp, err := svc.IssueCampaignAISessionGrant(context.Background(), &statev1.IssueCampaignAISessionGrantRequest{
+		CampaignId:    "camp-1",
+		SessionId:     "session-1",
+		ParticipantId: "ai-player",
+	})
+	if err != nil {
+		t.Fatalf("issue ai player grant: %v", err)
+	}
+	claims, err := aisessiongrant.Validate(campaignAIGrantConfig(now), resp.GetGrant().GetToken())
+	if err != nil {
+		t.Fatalf("validate issued token: %v", err)
+	}
+	if claims.ParticipantID != "ai-player" || claims.AuthEpoch != 4 {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+}
+
 func TestGetCampaignAIBindingUsage(t *testing.T) {
 	now := time.Date(2026, 3, 2, 7, 0, 0, 0, time.UTC)
 	svc := newServiceForTest(Deps{}, now)
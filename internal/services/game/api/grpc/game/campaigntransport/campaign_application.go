@@ -25,6 +25,7 @@ type Deps struct {
 	Character          storage.CharacterStore
 	Session            storage.SessionStore
 	SessionInteraction storage.SessionInteractionStore
+	Event              storage.EventHistoryStore
 	SystemStores       systemmanifest.ProjectionStores
 	SystemMetadata     *bridge.MetadataRegistry
 	SystemModules      *module.Registry
@@ -52,6 +53,7 @@ type campaignApplicationStores struct {
 	Campaign    storage.CampaignStore
 	Participant storage.ParticipantStore
 	Session     storage.SessionStore
+	Event       storage.EventHistoryStore
 	Social      social.ProfileClient
 }
 
@@ -76,6 +78,7 @@ func newCampaignApplicationWithDependencies(
 			Campaign:    deps.Campaign,
 			Participant: deps.Participant,
 			Session:     deps.Session,
+			Event:       deps.Event,
 			Social:      deps.Social,
 		},
 		write:       deps.Write,
@@ -0,0 +1,83 @@
+package campaigntransport
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/handler"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/commandbuild"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	domainauthz "github.com/louisbranch/fracturing.space/internal/services/game/domain/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/commandids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetCampaignModerationLock locks or unlocks a campaign on behalf of an
+// operator. Only admin-override callers may moderate; campaign participants,
+// including owners, cannot lift an operator lock.
+func (c campaignApplication) SetCampaignModerationLock(ctx context.Context, campaignID string, locked bool, reason string) (storage.CampaignRecord, error) {
+	if _, overrideRequested := authz.AdminOverrideFromContext(ctx); !overrideRequested {
+		return storage.CampaignRecord{}, status.Error(codes.PermissionDenied, "campaign moderation requires admin override")
+	}
+	campaignRecord, err := c.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return storage.CampaignRecord{}, err
+	}
+	if err := authz.RequirePolicy(ctx, c.auth, domainauthz.CapabilityManageCampaign(), campaignRecord); err != nil {
+		return storage.CampaignRecord{}, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	var (
+		cmdType     command.Type
+		payloadJSON []byte
+	)
+	if locked {
+		if reason == "" {
+			return storage.CampaignRecord{}, status.Error(codes.InvalidArgument, "moderation reason is required")
+		}
+		cmdType = commandids.CampaignModerationLock
+		payloadJSON, err = json.Marshal(campaign.ModerationLockPayload{Reason: reason})
+	} else {
+		cmdType = commandids.CampaignModerationUnlock
+		payloadJSON, err = json.Marshal(campaign.ModerationUnlockPayload{Reason: reason})
+	}
+	if err != nil {
+		return storage.CampaignRecord{}, grpcerror.Internal("encode payload", err)
+	}
+
+	actorID := strings.TrimSpace(grpcmeta.UserIDFromContext(ctx))
+	_, err = handler.ExecuteAndApplyDomainCommand(
+		ctx,
+		c.write,
+		c.applier,
+		commandbuild.Core(commandbuild.CoreInput{
+			CampaignID:   campaignID,
+			Type:         cmdType,
+			ActorType:    command.ActorTypeSystem,
+			ActorID:      actorID,
+			RequestID:    grpcmeta.RequestIDFromContext(ctx),
+			InvocationID: grpcmeta.InvocationIDFromContext(ctx),
+			EntityType:   "campaign",
+			EntityID:     campaignID,
+			PayloadJSON:  payloadJSON,
+		}),
+		domainwrite.Options{},
+	)
+	if err != nil {
+		return storage.CampaignRecord{}, err
+	}
+	updated, err := c.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return storage.CampaignRecord{}, grpcerror.Internal("load campaign", err)
+	}
+	return updated, nil
+}
@@ -35,6 +35,21 @@ func (c campaignApplication) GetCampaign(ctx context.Context, campaignID string)
 	return record, nil
 }
 
+// HeadSeq returns the campaign's latest journal seq for optimistic
+// concurrency. It reports zero when no event history store is wired, or under
+// outbox apply, where the journal head may be newer than the projected state
+// and the expected-seq guard is refused.
+func (c campaignApplication) HeadSeq(ctx context.Context, campaignID string) (uint64, error) {
+	if c.stores.Event == nil || c.write.Runtime.ProjectionsTrailJournal() {
+		return 0, nil
+	}
+	seq, err := c.stores.Event.GetLatestEventSeq(ctx, campaignID)
+	if err != nil {
+		return 0, grpcerror.Internal("get latest event seq", err)
+	}
+	return seq, nil
+}
+
 func (c campaignApplication) ListCampaigns(ctx context.Context, in *campaignv1.ListCampaignsRequest) (campaignListPage, error) {
 	pageSize := pagination.ClampPageSize(in.GetPageSize(), pagination.PageSizeConfig{
 		Default: defaultListCampaignsPageSize,
@@ -0,0 +1,76 @@
+package campaigntransport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/runtimekit"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetCampaignModerationLock_RequiresAdminOverride(t *testing.T) {
+	ts := newTestDeps().withSession()
+	ts.Participant = ownerParticipantStore("c1")
+	ts.Campaign.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+
+	svc := NewCampaignService(ts.build())
+	_, err := svc.SetCampaignModerationLock(requestctx.WithParticipantID(context.Background(), "owner-1"), &statev1.SetCampaignModerationLockRequest{
+		CampaignId: "c1",
+		Locked:     true,
+		Reason:     "spam",
+	})
+	assertStatusCode(t, err, codes.PermissionDenied)
+}
+
+func TestSetCampaignModerationLock_RequiresReasonWhenLocking(t *testing.T) {
+	ts := newTestDeps().withSession()
+	ts.Campaign.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+
+	svc := NewCampaignService(ts.build())
+	_, err := svc.SetCampaignModerationLock(requestctx.WithAdminOverride(context.Background(), "moderation"), &statev1.SetCampaignModerationLockRequest{
+		CampaignId: "c1",
+		Locked:     true,
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSetCampaignModerationLock_LocksCampaign(t *testing.T) {
+	ts := newTestDeps().withSession()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	ts.Campaign.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	domain := &fakeDomainEngine{store: ts.Event, result: engine.Result{
+		Decision: command.Accept(event.Event{
+			CampaignID:  "c1",
+			Type:        campaign.EventTypeModerationLocked,
+			Timestamp:   now,
+			ActorType:   event.ActorTypeSystem,
+			EntityType:  "campaign",
+			EntityID:    "c1",
+			PayloadJSON: []byte(`{"reason":"spam"}`),
+		}),
+	}}
+
+	svc := newTestCampaignService(ts.withDomain(domain).build(), runtimekit.FixedClock(now), nil)
+	resp, err := svc.SetCampaignModerationLock(requestctx.WithAdminOverride(context.Background(), "moderation"), &statev1.SetCampaignModerationLockRequest{
+		CampaignId: "c1",
+		Locked:     true,
+		Reason:     "spam",
+	})
+	if err != nil {
+		t.Fatalf("SetCampaignModerationLock returned error: %v", err)
+	}
+	if !resp.GetCampaign().GetModerationLocked() || resp.GetCampaign().GetModerationReason() != "spam" {
+		t.Fatalf("campaign = %+v, want moderation lock with reason spam", resp.GetCampaign())
+	}
+	if domain.lastCommand.Type != campaign.CommandTypeModerationLock {
+		t.Fatalf("command type = %s, want %s", domain.lastCommand.Type, campaign.CommandTypeModerationLock)
+	}
+}
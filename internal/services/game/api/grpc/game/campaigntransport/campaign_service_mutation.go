@@ -175,3 +175,24 @@ func (s *CampaignService) ClearCampaignAIBinding(ctx context.Context, in *campai
 	}
 	return &campaignv1.ClearCampaignAIBindingResponse{Campaign: CampaignToProto(updated)}, nil
 }
+
+// SetCampaignModerationLock locks or unlocks a campaign for operator moderation.
+func (s *CampaignService) SetCampaignModerationLock(ctx context.Context, in *campaignv1.SetCampaignModerationLockRequest) (*campaignv1.SetCampaignModerationLockResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "set campaign moderation lock request is required")
+	}
+
+	campaignID, err := validate.RequiredID(in.GetCampaignId(), "campaign id")
+	if err != nil {
+		return nil, err
+	}
+	if err := validate.MaxLength(in.GetReason(), "reason", validate.MaxPromptLen); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.app.SetCampaignModerationLock(ctx, campaignID, in.GetLocked(), in.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.SetCampaignModerationLockResponse{Campaign: CampaignToProto(updated)}, nil
+}
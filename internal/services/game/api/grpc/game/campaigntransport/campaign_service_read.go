@@ -22,6 +22,13 @@ func (s *CampaignService) GetCampaign(ctx context.Context, in *campaignv1.GetCam
 		return nil, err
 	}
 
+	// The head comes from the journal, not the projection. Inline apply keeps
+	// them in step, but under outbox apply the head can be ahead of the record
+	// returned here, so callers must not treat it as the record's version.
+	headSeq, err := s.app.HeadSeq(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
 	c, err := s.app.GetCampaign(ctx, campaignID)
 	if err != nil {
 		return nil, err
@@ -29,5 +36,6 @@ func (s *CampaignService) GetCampaign(ctx context.Context, in *campaignv1.GetCam
 
 	return &campaignv1.GetCampaignResponse{
 		Campaign: CampaignToProto(c),
+		HeadSeq:  headSeq,
 	}, nil
 }
@@ -10,6 +10,7 @@ import (
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	systems "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
@@ -365,6 +366,8 @@ func TestGetCampaign_Success(t *testing.T) {
 		"participant-1": gametest.MemberUserParticipantRecord("c1", "participant-1", "user-1", ""),
 	}
 
+	ts.Event.NextSeq["c1"] = 8
+
 	svc := NewCampaignService(ts.build())
 
 	resp, err := svc.GetCampaign(requestctx.WithParticipantID(context.Background(), "participant-1"), &statev1.GetCampaignRequest{CampaignId: "c1"})
@@ -374,6 +377,9 @@ func TestGetCampaign_Success(t *testing.T) {
 	if resp.Campaign == nil {
 		t.Fatal("GetCampaign response has nil campaign")
 	}
+	if resp.GetHeadSeq() != 7 {
+		t.Errorf("HeadSeq = %d, want 7", resp.GetHeadSeq())
+	}
 	if resp.Campaign.Id != "c1" {
 		t.Errorf("Campaign ID = %q, want %q", resp.Campaign.Id, "c1")
 	}
@@ -382,6 +388,28 @@ func TestGetCampaign_Success(t *testing.T) {
 	}
 }
 
+func TestGetCampaign_OmitsHeadSeqUnderOutboxApply(t *testing.T) {
+	ts := newTestDeps()
+	ts.Campaign.Campaigns["c1"] = gametest.TestCampaignRecordWithStatusAndCreatedAt(campaign.StatusDraft, time.Now().UTC())
+	ts.Participant.Participants["c1"] = map[string]storage.ParticipantRecord{
+		"participant-1": gametest.MemberUserParticipantRecord("c1", "participant-1", "user-1", ""),
+	}
+	ts.Event.NextSeq["c1"] = 8
+
+	deps := ts.build()
+	deps.Write.Runtime = domainwrite.NewRuntime()
+	deps.Write.Runtime.SetInlineApplyEnabled(false)
+	svc := NewCampaignService(deps)
+
+	resp, err := svc.GetCampaign(requestctx.WithParticipantID(context.Background(), "participant-1"), &statev1.GetCampaignRequest{CampaignId: "c1"})
+	if err != nil {
+		t.Fatalf("GetCampaign returned error: %v", err)
+	}
+	if resp.GetHeadSeq() != 0 {
+		t.Fatalf("HeadSeq = %d, want 0 while projections trail the journal", resp.GetHeadSeq())
+	}
+}
+
 func TestGetCampaign_SuccessByUserIDFallback(t *testing.T) {
 	ts := newTestDeps()
 	now := time.Now().UTC()
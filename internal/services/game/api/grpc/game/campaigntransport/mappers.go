@@ -34,6 +34,8 @@ func CampaignToProto(c storage.CampaignRecord) *campaignv1.Campaign {
 		CompletedAt:      handler.TimestampOrNil(c.CompletedAt),
 		ArchivedAt:       handler.TimestampOrNil(c.ArchivedAt),
 		LatestSessionAt:  handler.TimestampOrNil(c.LatestSessionAt),
+		ModerationLocked: c.ModerationLocked,
+		ModerationReason: c.ModerationReason,
 	}
 }
 
@@ -125,6 +125,7 @@ func (b *testDepsBuilder) build() Deps {
 	d := Deps{
 		Campaign:    b.Campaign,
 		Participant: b.Participant,
+		Event:       b.Event,
 	}
 	if b.Session != nil {
 		d.Session = b.Session
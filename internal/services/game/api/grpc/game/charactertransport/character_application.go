@@ -5,6 +5,7 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/contentstore"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
 	"github.com/louisbranch/fracturing.space/internal/services/game/projection"
@@ -21,6 +22,8 @@ type Deps struct {
 	DaggerheartContent contentstore.DaggerheartContentReadStore
 	Write              domainwrite.WritePath
 	Applier            projection.Applier
+	// History serves as_of character sheet reads; nil disables them.
+	History *historyread.Reader
 }
 
 // characterApplication coordinates character transport use-cases across focused
@@ -30,6 +33,7 @@ type characterApplication struct {
 	stores      characterApplicationStores
 	write       domainwrite.WritePath
 	applier     projection.Applier
+	history     *historyread.Reader
 	clock       func() time.Time
 	idGenerator func() (string, error)
 }
@@ -58,6 +62,7 @@ func newCharacterApplicationFromDeps(
 		},
 		write:       deps.Write,
 		applier:     deps.Applier,
+		history:     deps.History,
 		clock:       clock,
 		idGenerator: idGenerator,
 	}
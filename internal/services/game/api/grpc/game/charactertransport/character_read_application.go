@@ -9,6 +9,7 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/platform/grpc/pagination"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	bridge "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
@@ -29,6 +30,8 @@ type characterSheetState struct {
 	character storage.CharacterRecord
 	profile   projectionstore.DaggerheartCharacterProfile
 	state     projectionstore.DaggerheartCharacterState
+	// asOfSeq is the replayed event seq for point-in-time reads.
+	asOfSeq uint64
 }
 
 func (c characterApplication) ListCharacters(ctx context.Context, campaignID, pageToken string, pageSize int32) (characterListPage, error) {
@@ -75,23 +78,47 @@ func (c characterApplication) ListCharacterProfiles(ctx context.Context, campaig
 	}, systemID, nil
 }
 
-func (c characterApplication) GetCharacterSheet(ctx context.Context, campaignID, characterID string) (characterSheetState, error) {
+// GetCharacterSheet authorizes against the current campaign and then reads the
+// sheet from the live projection or a historical replay selected by point.
+func (c characterApplication) GetCharacterSheet(ctx context.Context, campaignID, characterID string, point historyread.Point) (characterSheetState, error) {
 	campaignRecord, err := c.loadReadableCampaign(ctx, campaignID)
 	if err != nil {
 		return characterSheetState{}, err
 	}
 
-	ch, err := c.stores.Character.GetCharacter(ctx, campaignRecord.ID, characterID)
+	if point.IsCurrent() {
+		return readCharacterSheet(ctx, campaignRecord.ID, characterID, c.stores.Character, c.stores.Daggerheart)
+	}
+	var sheet characterSheetState
+	err = c.history.Read(ctx, campaignRecord.ID, point, func(view historyread.View) error {
+		state, err := readCharacterSheet(ctx, campaignRecord.ID, characterID, view.Stores, view.System.Daggerheart)
+		if err != nil {
+			return err
+		}
+		state.asOfSeq = view.Seq
+		sheet = state
+		return nil
+	})
+	return sheet, err
+}
+
+func readCharacterSheet(
+	ctx context.Context,
+	campaignID, characterID string,
+	characters storage.CharacterStore,
+	daggerheart projectionstore.Store,
+) (characterSheetState, error) {
+	ch, err := characters.GetCharacter(ctx, campaignID, characterID)
 	if err != nil {
 		return characterSheetState{}, err
 	}
 
-	dhProfile, err := c.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignRecord.ID, characterID)
+	dhProfile, err := daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
 	if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get daggerheart profile"); lookupErr != nil {
 		return characterSheetState{}, lookupErr
 	}
 
-	dhState, err := c.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignRecord.ID, characterID)
+	dhState, err := daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
 	if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get daggerheart state"); lookupErr != nil {
 		return characterSheetState{}, lookupErr
 	}
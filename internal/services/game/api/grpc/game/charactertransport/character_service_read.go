@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
 	bridge "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems"
 	"google.golang.org/grpc/codes"
@@ -73,7 +74,8 @@ func (s *Service) ListCharacterProfiles(ctx context.Context, in *campaignv1.List
 	return response, nil
 }
 
-// GetCharacterSheet returns a character sheet (character, profile, and state).
+// GetCharacterSheet returns a character sheet (character, profile, and state),
+// optionally as of an earlier event seq or the end of an ended session.
 func (s *Service) GetCharacterSheet(ctx context.Context, in *campaignv1.GetCharacterSheetRequest) (*campaignv1.GetCharacterSheetResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "get character sheet request is required")
@@ -89,7 +91,12 @@ func (s *Service) GetCharacterSheet(ctx context.Context, in *campaignv1.GetChara
 		return nil, err
 	}
 
-	sheet, err := s.app.GetCharacterSheet(ctx, campaignID, characterID)
+	point, err := historyread.NewPoint(in.GetAsOfSeq(), in.GetAsOfSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, err := s.app.GetCharacterSheet(ctx, campaignID, characterID, point)
 	if err != nil {
 		return nil, err
 	}
@@ -98,5 +105,6 @@ func (s *Service) GetCharacterSheet(ctx context.Context, in *campaignv1.GetChara
 		Character: CharacterToProto(sheet.character),
 		Profile:   DaggerheartSheetProfileToProto(ctx, campaignID, characterID, sheet.profile, s.app.stores.DaggerheartContent),
 		State:     DaggerheartStateToProto(campaignID, characterID, sheet.state),
+		AsOfSeq:   sheet.asOfSeq,
 	}, nil
 }
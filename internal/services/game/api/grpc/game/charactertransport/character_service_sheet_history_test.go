@@ -0,0 +1,120 @@
+package charactertransport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/character"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	daggerheartpayload "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/payload"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/coreprojection"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetCharacterSheet_AsOfSeqReplaysHistoricalState(t *testing.T) {
+	ts := newTestStores().withCharacter()
+	ts.Campaign.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	ts.Character.Characters["c1"] = map[string]storage.CharacterRecord{
+		"ch1": {ID: "ch1", CampaignID: "c1", Name: "Hero", Kind: character.KindPC, CreatedAt: time.Now().UTC()},
+	}
+	ts.Daggerheart.States["c1"] = map[string]projectionstore.DaggerheartCharacterState{
+		"ch1": {CampaignID: "c1", CharacterID: "ch1", Hp: 2},
+	}
+	ts.Content = workflowContentStore{}
+	ts.Event.Events["c1"] = []event.Event{
+		sheetHistoryEvent(t, 1, campaign.EventTypeCreated, "campaign", "c1", campaign.CreatePayload{
+			Name:       "Test Campaign",
+			GameSystem: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
+			GmMode:     "GM_MODE_HUMAN",
+		}),
+		sheetHistoryEvent(t, 2, character.EventTypeCreated, "character", "ch1", character.CreatePayload{
+			CharacterID: "ch1",
+			Name:        "Hero",
+			Kind:        "PC",
+		}),
+		sheetHPPatchedEvent(t, 3, "ch1", 6),
+		sheetHPPatchedEvent(t, 4, "ch1", 2),
+	}
+	ts.Event.NextSeq["c1"] = 5
+
+	deps := ts.build()
+	deps.History = historyread.NewReader(historyread.Config{
+		Events: ts.Event,
+		OpenScratch: func() (historyread.Scratch, error) {
+			store, err := coreprojection.OpenMemory()
+			if err != nil {
+				return nil, err
+			}
+			return store, nil
+		},
+	})
+	svc := NewService(deps)
+
+	resp, err := svc.GetCharacterSheet(requestctx.WithAdminOverride(context.Background(), "sheet-test"), &statev1.GetCharacterSheetRequest{
+		CampaignId:  "c1",
+		CharacterId: "ch1",
+		AsOfSeq:     3,
+	})
+	if err != nil {
+		t.Fatalf("GetCharacterSheet returned error: %v", err)
+	}
+	if got := resp.GetState().GetDaggerheart().GetHp(); got != 6 {
+		t.Fatalf("historical hp = %d, want 6", got)
+	}
+	if resp.GetAsOfSeq() != 3 {
+		t.Fatalf("AsOfSeq = %d, want 3", resp.GetAsOfSeq())
+	}
+
+	_, err = svc.GetCharacterSheet(requestctx.WithAdminOverride(context.Background(), "sheet-test"), &statev1.GetCharacterSheetRequest{
+		CampaignId:  "c1",
+		CharacterId: "ch1",
+		AsOfSeq:     1,
+	})
+	assertStatusCode(t, err, codes.NotFound)
+}
+
+func TestGetCharacterSheet_AsOfRequiresHistoryReader(t *testing.T) {
+	ts := newTestStores().withCharacter()
+	ts.Campaign.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+
+	svc := NewService(ts.build())
+	_, err := svc.GetCharacterSheet(requestctx.WithAdminOverride(context.Background(), "sheet-test"), &statev1.GetCharacterSheetRequest{
+		CampaignId:    "c1",
+		CharacterId:   "ch1",
+		AsOfSessionId: "s1",
+	})
+	assertStatusCode(t, err, codes.Unimplemented)
+}
+
+func sheetHistoryEvent(t *testing.T, seq uint64, eventType event.Type, entityType, entityID string, payload any) event.Event {
+	t.Helper()
+	return event.Event{
+		CampaignID:  "c1",
+		Seq:         seq,
+		Timestamp:   time.Date(2026, 1, 10, 12, int(seq), 0, 0, time.UTC),
+		Type:        eventType,
+		EntityType:  entityType,
+		EntityID:    entityID,
+		PayloadJSON: mustJSON(t, payload),
+	}
+}
+
+func sheetHPPatchedEvent(t *testing.T, seq uint64, characterID string, hp int) event.Event {
+	t.Helper()
+	evt := sheetHistoryEvent(t, seq, event.Type("sys.daggerheart.character_state_patched"), "character", characterID,
+		daggerheartpayload.CharacterStatePatchedPayload{CharacterID: ids.CharacterID(characterID), HP: &hp})
+	evt.SystemID = daggerheart.SystemID
+	evt.SystemVersion = daggerheart.SystemVersion
+	return evt
+}
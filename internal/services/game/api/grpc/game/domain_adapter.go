@@ -71,3 +71,16 @@ func (a JournalAdapter) BatchAppend(ctx context.Context, events []event.Event) (
 	}
 	return ba.BatchAppendEvents(ctx, events)
 }
+
+// BatchAppendAtSeq appends a decision batch only while the campaign head
+// still equals expectedSeq, implementing engine.ConditionalEventJournal.
+func (a JournalAdapter) BatchAppendAtSeq(ctx context.Context, expectedSeq uint64, events []event.Event) ([]event.Event, error) {
+	if a.store == nil {
+		return nil, errJournalEventStoreRequired
+	}
+	ca, ok := a.store.(storage.ConditionalBatchEventAppender)
+	if !ok {
+		return nil, fmt.Errorf("conditional batch append not supported by underlying store")
+	}
+	return ca.BatchAppendEventsAtSeq(ctx, expectedSeq, events)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
 )
@@ -47,6 +48,20 @@ func TestJournalAdapterBatchAppend_RejectsNonBatchStore(t *testing.T) {
 	}
 }
 
+func TestJournalAdapterBatchAppendAtSeq_RejectsMovedHead(t *testing.T) {
+	store := gametest.NewFakeBatchEventStore()
+	adapter := JournalAdapter{store: store}
+	events := []event.Event{{CampaignID: "camp-1", Type: event.Type("a")}}
+
+	if _, err := adapter.BatchAppendAtSeq(context.Background(), 0, events); err != nil {
+		t.Fatalf("append at empty head: %v", err)
+	}
+	_, err := adapter.BatchAppendAtSeq(context.Background(), 0, events)
+	if !apperrors.IsCode(err, apperrors.CodeExpectedSeqMismatch) {
+		t.Fatalf("stale append error = %v, want %s", err, apperrors.CodeExpectedSeqMismatch)
+	}
+}
+
 // nonBatchEventStore implements storage.EventStore but not batchAppender.
 type nonBatchEventStore struct {
 	gametest.FakeEventStore
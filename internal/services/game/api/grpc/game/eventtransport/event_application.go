@@ -12,6 +12,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/commandbuild"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/core/filter"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/action"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/commandids"
@@ -38,6 +39,8 @@ type Deps struct {
 	Character   storage.CharacterStore
 	Session     storage.SessionStore
 	Write       domainwrite.WritePath
+	// EventRegistry supplies the payload fields list filters may reference.
+	EventRegistry *event.Registry
 }
 
 // eventHistoryStore narrows the event transport read-side dependency to the
@@ -48,10 +51,11 @@ type eventHistoryStore interface {
 }
 
 type eventApplication struct {
-	auth   authz.PolicyDeps
-	stores eventApplicationStores
-	write  domainwrite.WritePath
-	clock  func() time.Time
+	auth          authz.PolicyDeps
+	stores        eventApplicationStores
+	write         domainwrite.WritePath
+	clock         func() time.Time
+	payloadFields []filter.PayloadField
 }
 
 type eventApplicationStores struct {
@@ -79,8 +83,9 @@ func newEventApplicationWithDependencies(deps Deps, clock func() time.Time) even
 			Character:   deps.Character,
 			Session:     deps.Session,
 		},
-		write: deps.Write,
-		clock: clock,
+		write:         deps.Write,
+		clock:         clock,
+		payloadFields: deps.EventRegistry.PayloadFilterFields(),
 	}
 	if app.clock == nil {
 		app.clock = time.Now
@@ -27,7 +27,7 @@ func TestNormalizeListEventsRequestTokenCursorFields(t *testing.T) {
 		Filter:     "type = \"session.started\"",
 		AfterSeq:   7,
 		PageToken:  token,
-	})
+	}, nil)
 	if err != nil {
 		t.Fatalf("normalize list events request: %v", err)
 	}
@@ -62,7 +62,7 @@ func TestNormalizeListEventsRequestRejectsTokenWithoutSeqValue(t *testing.T) {
 	_, err = normalizeListEventsRequest(&campaignv1.ListEventsRequest{
 		CampaignId: "camp-1",
 		PageToken:  token,
-	})
+	}, nil)
 	if err == nil {
 		t.Fatal("expected invalid page token error")
 	}
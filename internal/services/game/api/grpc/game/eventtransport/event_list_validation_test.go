@@ -11,6 +11,7 @@ import (
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/grpc/pagination"
+	"github.com/louisbranch/fracturing.space/internal/services/game/core/filter"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
 	"google.golang.org/grpc/codes"
 )
@@ -26,7 +27,7 @@ func TestNormalizeListEventsRequestDefaultsAndScope(t *testing.T) {
 		CampaignId: " c1 ",
 		Filter:     " type = \"session.started\" ",
 		AfterSeq:   7,
-	})
+	}, nil)
 	if err != nil {
 		t.Fatalf("normalize list events request: %v", err)
 	}
@@ -44,6 +45,21 @@ func TestNormalizeListEventsRequestDefaultsAndScope(t *testing.T) {
 	}
 }
 
+func TestNormalizeListEventsRequestPayloadFilterRequiresDeclaredField(t *testing.T) {
+	in := &campaignv1.ListEventsRequest{CampaignId: "c1", Filter: "payload.marks >= 2"}
+
+	_, err := normalizeListEventsRequest(in, nil)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	fields := []filter.PayloadField{{Name: "marks", Path: "$.marks", Type: filter.FieldTypeInt}}
+	if _, err := normalizeListEventsRequest(in, fields); err != nil {
+		t.Fatalf("normalize with declared payload field: %v", err)
+	}
+	if _, err := normalizeListTimelineEntriesRequest(&campaignv1.ListTimelineEntriesRequest{CampaignId: "c1", Filter: "payload.marks >= 2"}, fields); err != nil {
+		t.Fatalf("normalize timeline with declared payload field: %v", err)
+	}
+}
+
 func TestListEvents_MissingCampaignId(t *testing.T) {
 	eventStore := gametest.NewFakeEventStore()
 	svc := NewService(Deps{Event: eventStore})
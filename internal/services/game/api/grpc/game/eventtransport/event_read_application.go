@@ -32,7 +32,7 @@ type normalizedTimelineRequest struct {
 
 // ListEvents loads paginated event history behind the event application seam.
 func (a eventApplication) ListEvents(ctx context.Context, in *campaignv1.ListEventsRequest) (*campaignv1.ListEventsResponse, error) {
-	normalized, err := normalizeListEventsRequest(in)
+	normalized, err := normalizeListEventsRequest(in, a.payloadFields)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +173,7 @@ func (a eventApplication) SubscribeCampaignUpdates(
 
 // ListTimelineEntries loads the timeline view behind the event application seam.
 func (a eventApplication) ListTimelineEntries(ctx context.Context, in *campaignv1.ListTimelineEntriesRequest) (*campaignv1.ListTimelineEntriesResponse, error) {
-	normalized, err := normalizeListTimelineEntriesRequest(in)
+	normalized, err := normalizeListTimelineEntriesRequest(in, a.payloadFields)
 	if err != nil {
 		return nil, err
 	}
@@ -242,7 +242,7 @@ func (a eventApplication) ListTimelineEntries(ctx context.Context, in *campaignv
 	return response, nil
 }
 
-func normalizeListEventsRequest(in *campaignv1.ListEventsRequest) (normalizedListEventsRequest, error) {
+func normalizeListEventsRequest(in *campaignv1.ListEventsRequest, payloadFields []filter.PayloadField) (normalizedListEventsRequest, error) {
 	if in == nil {
 		return normalizedListEventsRequest{}, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -271,7 +271,7 @@ func normalizeListEventsRequest(in *campaignv1.ListEventsRequest) (normalizedLis
 	paginationScope := filterStr + "|after_seq=" + strconv.FormatUint(afterSeq, 10)
 
 	if filterStr != "" {
-		if _, err := filter.ParseEventFilter(filterStr); err != nil {
+		if _, err := filter.ParseEventFilter(filterStr, payloadFields...); err != nil {
 			return normalizedListEventsRequest{}, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
 	}
@@ -315,7 +315,7 @@ func normalizeListEventsRequest(in *campaignv1.ListEventsRequest) (normalizedLis
 	}, nil
 }
 
-func normalizeListTimelineEntriesRequest(in *campaignv1.ListTimelineEntriesRequest) (normalizedTimelineRequest, error) {
+func normalizeListTimelineEntriesRequest(in *campaignv1.ListTimelineEntriesRequest, payloadFields []filter.PayloadField) (normalizedTimelineRequest, error) {
 	if in == nil {
 		return normalizedTimelineRequest{}, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -341,7 +341,7 @@ func normalizeListTimelineEntriesRequest(in *campaignv1.ListTimelineEntriesReque
 
 	filterStr := strings.TrimSpace(in.GetFilter())
 	if filterStr != "" {
-		if _, err := filter.ParseEventFilter(filterStr); err != nil {
+		if _, err := filter.ParseEventFilter(filterStr, payloadFields...); err != nil {
 			return normalizedTimelineRequest{}, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
 	}
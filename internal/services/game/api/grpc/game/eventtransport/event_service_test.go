@@ -2,11 +2,13 @@ package eventtransport
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/eventredaction"
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
@@ -214,3 +216,41 @@ func TestListEvents_DESC_Pagination(t *testing.T) {
 		t.Errorf("back DESC: expected seqs [5,4], got [%d,%d]", respBack.Events[0].Seq, respBack.Events[1].Seq)
 	}
 }
+
+func TestListEvents_RedactsSeatsReleasedByAccountDeletion(t *testing.T) {
+	eventStore := gametest.NewFakeEventStore()
+	authzCtx := requestctx.WithAdminOverride(context.Background(), "events-test")
+	now := time.Now().UTC()
+
+	eventStore.Events["c1"] = []event.Event{
+		{CampaignID: "c1", Seq: 1, Type: event.Type("participant.joined"), Timestamp: now, EntityType: "participant", EntityID: "p1", PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","name":"Ada","pronouns":"she/her","role":"player"}`)},
+		{CampaignID: "c1", Seq: 2, Type: event.Type("participant.joined"), Timestamp: now, EntityType: "participant", EntityID: "p2", PayloadJSON: []byte(`{"participant_id":"p2","user_id":"user-2","name":"Bo"}`)},
+		{CampaignID: "c1", Seq: 3, Type: event.Type("participant.updated"), Timestamp: now, EntityType: "participant", EntityID: "p1", PayloadJSON: []byte(`{"participant_id":"p1","fields":{"name":"Ada L."}}`)},
+		{CampaignID: "c1", Seq: 4, Type: event.Type("participant.unbound"), Timestamp: now, EntityType: "participant", EntityID: "p1", PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","reason":"account_deleted"}`)},
+		{CampaignID: "c1", Seq: 5, Type: event.Type("participant.unbound"), Timestamp: now, EntityType: "participant", EntityID: "p2", PayloadJSON: []byte(`{"participant_id":"p2","user_id":"user-2","reason":"left"}`)},
+	}
+
+	svc := NewService(Deps{Event: eventredaction.NewStore(eventStore)})
+	resp, err := svc.ListEvents(authzCtx, &campaignv1.ListEventsRequest{CampaignId: "c1"})
+	if err != nil {
+		t.Fatalf("list events: %v", err)
+	}
+	if len(resp.Events) != 5 {
+		t.Fatalf("events = %d, want 5", len(resp.Events))
+	}
+	for _, seq := range []int{0, 2, 3} {
+		payload := string(resp.Events[seq].PayloadJson)
+		if strings.Contains(payload, "user-1") || strings.Contains(payload, "Ada") || strings.Contains(payload, "she/her") {
+			t.Fatalf("event %d payload leaks identity: %s", resp.Events[seq].Seq, payload)
+		}
+	}
+	if !strings.Contains(string(resp.Events[0].PayloadJson), `"role":"player"`) {
+		t.Fatalf("redaction dropped non-identity fields: %s", resp.Events[0].PayloadJson)
+	}
+	if resp.Events[0].Hash != eventStore.Events["c1"][0].Hash {
+		t.Fatal("redaction must not alter the stored hash")
+	}
+	if payload := string(resp.Events[1].PayloadJson); !strings.Contains(payload, "Bo") {
+		t.Fatalf("unrelated seat was redacted: %s", payload)
+	}
+}
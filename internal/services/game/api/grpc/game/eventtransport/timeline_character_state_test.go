@@ -161,3 +161,51 @@ func TestListTimelineEntries_CharacterStateChanges_WithBefore(t *testing.T) {
 	assertField("Armor", "= 2")
 	assertField("Life State", "= dying")
 }
+
+func TestListTimelineEntries_CorrectionLinksCorrectedEvent(t *testing.T) {
+	characterStore := gametest.NewFakeCharacterStore()
+	eventStore := gametest.NewFakeEventStore()
+	characterStore.Characters["c1"] = map[string]storage.CharacterRecord{
+		"ch1": {ID: "ch1", CampaignID: "c1", Name: "Frodo", Kind: character.KindPC},
+	}
+
+	hp := 6
+	payloadJSON, err := json.Marshal(daggerheartpayload.CharacterStatePatchedPayload{
+		CharacterID: "ch1",
+		Source:      "gm.correction",
+		HP:          &hp,
+	})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	eventStore.Events["c1"] = []event.Event{{
+		CampaignID:  "c1",
+		Seq:         5,
+		Type:        event.Type("sys.daggerheart.character_state_patched"),
+		EntityType:  "character",
+		EntityID:    "ch1",
+		Timestamp:   time.Now().UTC(),
+		CausationID: event.CorrectionCausationID(3),
+		PayloadJSON: payloadJSON,
+	}}
+
+	svc := NewService(Deps{Event: eventStore, Character: characterStore})
+	resp, err := svc.ListTimelineEntries(requestctx.WithAdminOverride(context.Background(), "timeline-test"), &campaignv1.ListTimelineEntriesRequest{
+		CampaignId: "c1",
+		OrderBy:    "seq",
+	})
+	if err != nil {
+		t.Fatalf("list timeline entries: %v", err)
+	}
+	if len(resp.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(resp.Entries))
+	}
+	entry := resp.Entries[0]
+	if entry.GetCorrectsSeq() != 3 {
+		t.Fatalf("corrects seq = %d, want 3", entry.GetCorrectsSeq())
+	}
+	fields := entry.GetProjection().GetFields()
+	if len(fields) < 2 || fields[0].GetLabel() != "Corrects" || fields[0].GetValue() != "#3" {
+		t.Fatalf("fields = %v, want Corrects #3 first", fields)
+	}
+}
@@ -19,6 +19,13 @@ func timelineEntryFromEvent(ctx context.Context, resolver *timelineProjectionRes
 		return nil, err
 	}
 	changeFields := timelineChangeFields(evt)
+	correctsSeq, corrects := event.CorrectedSeq(evt.CausationID)
+	if corrects {
+		changeFields = append([]*campaignv1.ProjectionField{{
+			Label: "Corrects",
+			Value: "#" + strconv.FormatUint(correctsSeq, 10),
+		}}, changeFields...)
+	}
 	if len(changeFields) > 0 {
 		if projection == nil {
 			projection = &campaignv1.ProjectionDisplay{}
@@ -32,6 +39,7 @@ func timelineEntryFromEvent(ctx context.Context, resolver *timelineProjectionRes
 		IconId:           iconID,
 		Projection:       projection,
 		EventPayloadJson: string(evt.PayloadJSON),
+		CorrectsSeq:      correctsSeq,
 	}, nil
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/eventredaction"
 
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
@@ -150,3 +151,41 @@ func TestListTimelineEntries_ProjectionDisplayByDomain(t *testing.T) {
 	assertEntry(resp.Entries[2], commonv1.IconId_ICON_ID_CHARACTER, "Frodo")
 	assertEntry(resp.Entries[3], commonv1.IconId_ICON_ID_SESSION, "Session 1")
 }
+
+func TestListTimelineEntries_RedactsSeatsReleasedByAccountDeletion(t *testing.T) {
+	participantStore := gametest.NewFakeParticipantStore()
+	eventStore := gametest.NewFakeEventStore()
+	now := time.Now().UTC()
+
+	participantStore.Participants["c1"] = map[string]storage.ParticipantRecord{
+		"p1": {ID: "p1", CampaignID: "c1", Name: participant.AnonymizedName, Role: participant.RolePlayer},
+	}
+	eventStore.Events["c1"] = []event.Event{
+		{CampaignID: "c1", Seq: 1, Type: event.Type("participant.joined"), Timestamp: now, EntityType: "participant", EntityID: "p1", PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","name":"Ada","role":"player"}`)},
+		{CampaignID: "c1", Seq: 2, Type: event.Type("participant.unbound"), Timestamp: now, EntityType: "participant", EntityID: "p1", PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","reason":"account_deleted"}`)},
+	}
+
+	svc := NewService(Deps{
+		Event:       eventredaction.NewStore(eventStore),
+		Participant: participantStore,
+	})
+	resp, err := svc.ListTimelineEntries(requestctx.WithAdminOverride(context.Background(), "timeline-test"), &campaignv1.ListTimelineEntriesRequest{
+		CampaignId: "c1",
+		OrderBy:    "seq",
+	})
+	if err != nil {
+		t.Fatalf("list timeline entries: %v", err)
+	}
+	if len(resp.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(resp.Entries))
+	}
+	for _, entry := range resp.Entries {
+		payload := entry.GetEventPayloadJson()
+		if strings.Contains(payload, "user-1") || strings.Contains(payload, "Ada") {
+			t.Fatalf("timeline entry %d leaks identity: %s", entry.GetSeq(), payload)
+		}
+	}
+	if !strings.Contains(resp.Entries[0].GetEventPayloadJson(), `"role":"player"`) {
+		t.Fatalf("redaction dropped non-identity fields: %s", resp.Entries[0].GetEventPayloadJson())
+	}
+}
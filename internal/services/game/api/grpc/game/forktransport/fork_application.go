@@ -6,6 +6,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/handler/social"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/journalimport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/projection"
@@ -26,12 +27,14 @@ type Deps struct {
 	Applier       projection.Applier
 	EventRegistry *event.Registry
 	Importer      journalimport.Importer
+	History       *historyread.Reader
 }
 
 type forkApplication struct {
 	auth        authz.PolicyDeps
 	stores      forkApplicationStores
 	eventReplay forkEventReplay
+	history     *historyread.Reader
 	write       domainwrite.WritePath
 	applier     projection.Applier
 	clock       func() time.Time
@@ -64,6 +67,7 @@ func newForkApplicationWithDependencies(
 			Event:        deps.Event,
 			Social:       deps.Social,
 		},
+		history:     deps.History,
 		write:       deps.Write,
 		applier:     deps.Applier,
 		clock:       clock,
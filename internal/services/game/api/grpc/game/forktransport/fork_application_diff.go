@@ -0,0 +1,212 @@
+package forktransport
+
+import (
+	"context"
+
+	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const diffListPageSize = 200
+
+// statePoint is one side of a state comparison before its seq is resolved.
+type statePoint struct {
+	campaignID string
+	point      historyread.Point
+}
+
+// stateDiffResult carries the resolved seqs alongside the computed diff.
+type stateDiffResult struct {
+	fromSeq uint64
+	toSeq   uint64
+	diff    *campaignv1.CampaignStateDiff
+}
+
+// stateCapture is the comparable slice of one replayed campaign state.
+type stateCapture struct {
+	campaign    storage.CampaignRecord
+	snapshot    *projectionstore.DaggerheartSnapshot
+	characters  []characterCapture
+	scenes      []storage.SceneRecord
+	countdowns  []projectionstore.DaggerheartCountdown
+	adversaries []projectionstore.DaggerheartAdversary
+}
+
+type characterCapture struct {
+	record storage.CharacterRecord
+	state  *projectionstore.DaggerheartCharacterState
+}
+
+// DiffCampaignState reconstructs both points through the history reader and
+// compares them. Both campaigns must be readable by the caller and, when
+// different, share the same lineage origin so entity IDs line up.
+func (a forkApplication) DiffCampaignState(ctx context.Context, from, to statePoint) (stateDiffResult, error) {
+	for _, campaignID := range []string{from.campaignID, to.campaignID} {
+		campaignRecord, err := a.stores.Campaign.Get(ctx, campaignID)
+		if err != nil {
+			return stateDiffResult{}, grpcerror.EnsureStatus(err)
+		}
+		if err := authz.RequireReadPolicy(ctx, a.auth, campaignRecord); err != nil {
+			return stateDiffResult{}, err
+		}
+	}
+	if from.campaignID != to.campaignID {
+		fromOrigin, err := a.lineageOrigin(ctx, from.campaignID)
+		if err != nil {
+			return stateDiffResult{}, err
+		}
+		toOrigin, err := a.lineageOrigin(ctx, to.campaignID)
+		if err != nil {
+			return stateDiffResult{}, err
+		}
+		if fromOrigin != toOrigin {
+			return stateDiffResult{}, status.Error(codes.FailedPrecondition, "campaigns do not share lineage")
+		}
+	}
+
+	fromSeq, before, err := a.captureState(ctx, from)
+	if err != nil {
+		return stateDiffResult{}, err
+	}
+	toSeq, after, err := a.captureState(ctx, to)
+	if err != nil {
+		return stateDiffResult{}, err
+	}
+	return stateDiffResult{
+		fromSeq: fromSeq,
+		toSeq:   toSeq,
+		diff:    diffStateCaptures(before, after),
+	}, nil
+}
+
+// lineageOrigin returns the root campaign of the fork chain.
+func (a forkApplication) lineageOrigin(ctx context.Context, campaignID string) (string, error) {
+	metadata, err := a.stores.CampaignFork.GetCampaignForkMetadata(ctx, campaignID)
+	if err != nil {
+		if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get fork metadata"); lookupErr != nil {
+			return "", lookupErr
+		}
+	}
+	if metadata.OriginCampaignID == "" {
+		return campaignID, nil
+	}
+	return metadata.OriginCampaignID, nil
+}
+
+// captureState replays one point and copies out the entities the diff
+// compares. A current point is pinned to the latest event so both sides go
+// through the same replay path.
+func (a forkApplication) captureState(ctx context.Context, side statePoint) (uint64, stateCapture, error) {
+	point := side.point
+	if point.IsCurrent() {
+		latestSeq, err := a.stores.Event.GetLatestEventSeq(ctx, side.campaignID)
+		if err != nil {
+			return 0, stateCapture{}, grpcerror.Internal("get latest event seq", err)
+		}
+		if latestSeq == 0 {
+			return 0, stateCapture{}, status.Error(codes.FailedPrecondition, "campaign has no events")
+		}
+		point = historyread.Point{Seq: latestSeq}
+	}
+
+	var (
+		seq     uint64
+		capture stateCapture
+	)
+	err := a.history.Read(ctx, side.campaignID, point, func(view historyread.View) error {
+		captured, err := readStateCapture(ctx, side.campaignID, view)
+		if err != nil {
+			return err
+		}
+		seq, capture = view.Seq, captured
+		return nil
+	})
+	return seq, capture, err
+}
+
+func readStateCapture(ctx context.Context, campaignID string, view historyread.View) (stateCapture, error) {
+	var capture stateCapture
+	campaignRecord, err := view.Stores.Get(ctx, campaignID)
+	if err != nil {
+		return stateCapture{}, grpcerror.Internal("get historical campaign", err)
+	}
+	capture.campaign = campaignRecord
+
+	for pageToken := ""; ; {
+		page, err := view.Stores.ListCharacters(ctx, campaignID, diffListPageSize, pageToken)
+		if err != nil {
+			return stateCapture{}, grpcerror.Internal("list historical characters", err)
+		}
+		for _, record := range page.Characters {
+			capture.characters = append(capture.characters, characterCapture{record: record})
+		}
+		if pageToken = page.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	for pageToken := ""; ; {
+		page, err := view.Stores.ListSessions(ctx, campaignID, diffListPageSize, pageToken)
+		if err != nil {
+			return stateCapture{}, grpcerror.Internal("list historical sessions", err)
+		}
+		for _, sess := range page.Sessions {
+			scenes, err := listSessionScenes(ctx, view.Stores, campaignID, sess.ID)
+			if err != nil {
+				return stateCapture{}, err
+			}
+			capture.scenes = append(capture.scenes, scenes...)
+		}
+		if pageToken = page.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	daggerheart := view.System.Daggerheart
+	if daggerheart == nil {
+		return capture, nil
+	}
+	snapshot, err := daggerheart.GetDaggerheartSnapshot(ctx, campaignID)
+	if err == nil {
+		capture.snapshot = &snapshot
+	} else if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get historical daggerheart snapshot"); lookupErr != nil {
+		return stateCapture{}, lookupErr
+	}
+	for i := range capture.characters {
+		state, err := daggerheart.GetDaggerheartCharacterState(ctx, campaignID, capture.characters[i].record.ID)
+		if err != nil {
+			if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get historical character state"); lookupErr != nil {
+				return stateCapture{}, lookupErr
+			}
+			continue
+		}
+		capture.characters[i].state = &state
+	}
+	if capture.countdowns, err = daggerheart.ListDaggerheartCountdowns(ctx, campaignID); err != nil {
+		return stateCapture{}, grpcerror.Internal("list historical countdowns", err)
+	}
+	if capture.adversaries, err = daggerheart.ListDaggerheartAdversaries(ctx, campaignID, ""); err != nil {
+		return stateCapture{}, grpcerror.Internal("list historical adversaries", err)
+	}
+	return capture, nil
+}
+
+func listSessionScenes(ctx context.Context, scenes storage.SceneReader, campaignID, sessionID string) ([]storage.SceneRecord, error) {
+	var records []storage.SceneRecord
+	for pageToken := ""; ; {
+		page, err := scenes.ListScenes(ctx, campaignID, sessionID, diffListPageSize, pageToken)
+		if err != nil {
+			return nil, grpcerror.Internal("list historical scenes", err)
+		}
+		records = append(records, page.Scenes...)
+		if pageToken = page.NextPageToken; pageToken == "" {
+			return records, nil
+		}
+	}
+}
@@ -81,7 +81,8 @@ func shouldCopyForkEvent(evt event.Event, copyParticipants bool) (bool, error) {
 	switch evt.Type {
 	case campaign.EventTypeCreated, campaign.EventTypeForked:
 		return false, nil
-	case campaign.EventTypeAIBound, campaign.EventTypeAIUnbound:
+	case campaign.EventTypeAIBound, campaign.EventTypeAIUnbound,
+		campaign.EventTypeModerationLocked, campaign.EventTypeModerationUnlocked:
 		return false, nil
 	case participant.EventTypeJoined, participant.EventTypeUpdated, participant.EventTypeLeft:
 		return copyParticipants, nil
@@ -0,0 +1,55 @@
+package forktransport
+
+import (
+	"context"
+	"strings"
+
+	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DiffCampaignState compares reconstructed state at two points.
+func (s *Service) DiffCampaignState(ctx context.Context, in *campaignv1.DiffCampaignStateRequest) (*campaignv1.DiffCampaignStateResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "diff campaign state request is required")
+	}
+	from, err := statePointFromProto(in.GetFrom(), "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := statePointFromProto(in.GetTo(), "to")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.app.DiffCampaignState(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.DiffCampaignStateResponse{
+		From: &campaignv1.StatePoint{CampaignId: from.campaignID, EventSeq: result.fromSeq, SessionId: from.point.SessionID},
+		To:   &campaignv1.StatePoint{CampaignId: to.campaignID, EventSeq: result.toSeq, SessionId: to.point.SessionID},
+		Diff: result.diff,
+	}, nil
+}
+
+func statePointFromProto(pb *campaignv1.StatePoint, side string) (statePoint, error) {
+	if pb == nil {
+		return statePoint{}, status.Errorf(codes.InvalidArgument, "%s point is required", side)
+	}
+	campaignID, err := validate.RequiredID(pb.GetCampaignId(), side+" campaign id")
+	if err != nil {
+		return statePoint{}, err
+	}
+	sessionID := strings.TrimSpace(pb.GetSessionId())
+	if pb.GetEventSeq() > 0 && sessionID != "" {
+		return statePoint{}, status.Errorf(codes.InvalidArgument, "%s event_seq and session_id are mutually exclusive", side)
+	}
+	return statePoint{
+		campaignID: campaignID,
+		point:      historyread.Point{Seq: pb.GetEventSeq(), SessionID: sessionID},
+	}, nil
+}
@@ -0,0 +1,222 @@
+package forktransport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	daggerheartpayload "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/payload"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/coreprojection"
+	"google.golang.org/grpc/codes"
+)
+
+func newDiffServiceForTest(t *testing.T) (*Service, *gametest.FakeCampaignForkStore) {
+	t.Helper()
+
+	campaignStore := gametest.NewFakeCampaignStore()
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	campaignStore.Campaigns["c2"] = gametest.ActiveCampaignRecord("c2")
+
+	events := gametest.NewFakeEventStore()
+	events.Events["c1"] = []event.Event{
+		diffCampaignCreatedEvent("c1", 1),
+		diffGMFearChangedEvent("c1", 2, 2),
+		diffGMFearChangedEvent("c1", 3, 5),
+	}
+	events.NextSeq["c1"] = 4
+	events.Events["c2"] = []event.Event{
+		diffCampaignCreatedEvent("c2", 1),
+		diffGMFearChangedEvent("c2", 2, 2),
+		diffGMFearChangedEvent("c2", 3, 4),
+	}
+	events.NextSeq["c2"] = 4
+
+	forkStore := gametest.NewFakeCampaignForkStore()
+	svc := newServiceForTest(Deps{
+		Campaign:     campaignStore,
+		CampaignFork: forkStore,
+		Event:        events,
+		History: historyread.NewReader(historyread.Config{
+			Events: events,
+			OpenScratch: func() (historyread.Scratch, error) {
+				store, err := coreprojection.OpenMemory()
+				if err != nil {
+					return nil, err
+				}
+				return store, nil
+			},
+		}),
+	}, nil, nil)
+	return svc, forkStore
+}
+
+func TestDiffCampaignState_ComparesPointsWithinCampaign(t *testing.T) {
+	svc, _ := newDiffServiceForTest(t)
+	ctx := requestctx.WithAdminOverride(context.Background(), "fork-test")
+
+	resp, err := svc.DiffCampaignState(ctx, &statev1.DiffCampaignStateRequest{
+		From: &statev1.StatePoint{CampaignId: "c1", EventSeq: 2},
+		To:   &statev1.StatePoint{CampaignId: "c1"},
+	})
+	if err != nil {
+		t.Fatalf("DiffCampaignState returned error: %v", err)
+	}
+	if resp.GetFrom().GetEventSeq() != 2 || resp.GetTo().GetEventSeq() != 3 {
+		t.Fatalf("resolved seqs = %d..%d, want 2..3", resp.GetFrom().GetEventSeq(), resp.GetTo().GetEventSeq())
+	}
+	resources := resp.GetDiff().GetCampaignResources()
+	if len(resources) != 1 || resources[0].GetResource() != "gm_fear" || resources[0].GetBefore() != 2 || resources[0].GetAfter() != 5 {
+		t.Fatalf("campaign resources = %v, want gm_fear 2 -> 5", resources)
+	}
+}
+
+func TestDiffCampaignState_ComparesForksSharingLineage(t *testing.T) {
+	svc, forkStore := newDiffServiceForTest(t)
+	forkStore.Metadata["c2"] = storage.ForkMetadata{ParentCampaignID: "c1", OriginCampaignID: "c1", ForkEventSeq: 2}
+	ctx := requestctx.WithAdminOverride(context.Background(), "fork-test")
+
+	resp, err := svc.DiffCampaignState(ctx, &statev1.DiffCampaignStateRequest{
+		From: &statev1.StatePoint{CampaignId: "c1"},
+		To:   &statev1.StatePoint{CampaignId: "c2"},
+	})
+	if err != nil {
+		t.Fatalf("DiffCampaignState returned error: %v", err)
+	}
+	resources := resp.GetDiff().GetCampaignResources()
+	if len(resources) != 1 || resources[0].GetBefore() != 5 || resources[0].GetAfter() != 4 {
+		t.Fatalf("campaign resources = %v, want gm_fear 5 -> 4", resources)
+	}
+}
+
+func TestDiffCampaignState_RejectsUnrelatedCampaigns(t *testing.T) {
+	svc, _ := newDiffServiceForTest(t)
+	ctx := requestctx.WithAdminOverride(context.Background(), "fork-test")
+
+	_, err := svc.DiffCampaignState(ctx, &statev1.DiffCampaignStateRequest{
+		From: &statev1.StatePoint{CampaignId: "c1"},
+		To:   &statev1.StatePoint{CampaignId: "c2"},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestDiffCampaignState_ValidatesPoints(t *testing.T) {
+	svc := newServiceForTest(Deps{}, nil, nil)
+	tests := []*statev1.DiffCampaignStateRequest{
+		nil,
+		{From: &statev1.StatePoint{CampaignId: "c1"}},
+		{From: &statev1.StatePoint{CampaignId: "c1"}, To: &statev1.StatePoint{}},
+		{From: &statev1.StatePoint{CampaignId: "c1", EventSeq: 2, SessionId: "s1"}, To: &statev1.StatePoint{CampaignId: "c1"}},
+	}
+	for _, req := range tests {
+		_, err := svc.DiffCampaignState(context.Background(), req)
+		assertStatusCode(t, err, codes.InvalidArgument)
+	}
+}
+
+func TestDiffStateCaptures_ReportsEntityChanges(t *testing.T) {
+	before := stateCapture{
+		characters: []characterCapture{
+			{
+				record: storage.CharacterRecord{ID: "char-1", Name: "Aria"},
+				state: &projectionstore.DaggerheartCharacterState{Hp: 6, Hope: 2, Conditions: []projectionstore.DaggerheartConditionState{
+					{Code: "vulnerable", Label: "Vulnerable"},
+				}},
+			},
+			{record: storage.CharacterRecord{ID: "char-2", Name: "Bren"}},
+			{record: storage.CharacterRecord{ID: "char-3", Name: "Cato"}},
+		},
+		countdowns: []projectionstore.DaggerheartCountdown{{CountdownID: "cd-1", Name: "Ritual", RemainingValue: 4}},
+	}
+	after := stateCapture{
+		characters: []characterCapture{
+			{
+				record: storage.CharacterRecord{ID: "char-1", Name: "Aria"},
+				state: &projectionstore.DaggerheartCharacterState{Hp: 3, Hope: 2, Conditions: []projectionstore.DaggerheartConditionState{
+					{Code: "restrained", Label: "Restrained"},
+				}},
+			},
+			{record: storage.CharacterRecord{ID: "char-3", Name: "Cato"}},
+			{record: storage.CharacterRecord{ID: "char-4", Name: "Dree"}},
+		},
+		countdowns:  []projectionstore.DaggerheartCountdown{{CountdownID: "cd-1", Name: "Ritual", RemainingValue: 1}},
+		adversaries: []projectionstore.DaggerheartAdversary{{AdversaryID: "adv-1", Name: "Bandit", HP: 5}},
+	}
+
+	diff := diffStateCaptures(before, after)
+
+	characters := diff.GetCharacters()
+	if len(characters) != 3 {
+		t.Fatalf("character diffs = %d, want 3 (unchanged char-3 omitted)", len(characters))
+	}
+	aria := characters[0]
+	if aria.GetEntityId() != "char-1" || aria.GetChange() != statev1.StateDiffChange_STATE_DIFF_CHANGE_MODIFIED {
+		t.Fatalf("first character diff = %v, want modified char-1", aria)
+	}
+	if len(aria.GetResources()) != 1 || aria.GetResources()[0].GetResource() != "hp" || aria.GetResources()[0].GetAfter() != 3 {
+		t.Fatalf("char-1 resources = %v, want hp 6 -> 3", aria.GetResources())
+	}
+	if len(aria.GetConditionsAdded()) != 1 || aria.GetConditionsAdded()[0] != "Restrained" ||
+		len(aria.GetConditionsRemoved()) != 1 || aria.GetConditionsRemoved()[0] != "Vulnerable" {
+		t.Fatalf("char-1 conditions = +%v -%v", aria.GetConditionsAdded(), aria.GetConditionsRemoved())
+	}
+	if characters[1].GetEntityId() != "char-2" || characters[1].GetChange() != statev1.StateDiffChange_STATE_DIFF_CHANGE_REMOVED {
+		t.Fatalf("second character diff = %v, want removed char-2", characters[1])
+	}
+	if characters[2].GetEntityId() != "char-4" || characters[2].GetChange() != statev1.StateDiffChange_STATE_DIFF_CHANGE_ADDED {
+		t.Fatalf("third character diff = %v, want added char-4", characters[2])
+	}
+
+	if countdowns := diff.GetCountdowns(); len(countdowns) != 1 || countdowns[0].GetResources()[0].GetAfter() != 1 {
+		t.Fatalf("countdown diffs = %v, want remaining 4 -> 1", countdowns)
+	}
+	if adversaries := diff.GetAdversaries(); len(adversaries) != 1 || adversaries[0].GetChange() != statev1.StateDiffChange_STATE_DIFF_CHANGE_ADDED {
+		t.Fatalf("adversary diffs = %v, want added adv-1", adversaries)
+	}
+	if len(diff.GetScenes()) != 0 || len(diff.GetCampaignFields()) != 0 {
+		t.Fatalf("unexpected scene or campaign diffs: %v", diff)
+	}
+}
+
+func diffCampaignCreatedEvent(campaignID string, seq uint64) event.Event {
+	data, _ := json.Marshal(campaign.CreatePayload{
+		Name:       "Test Campaign",
+		GameSystem: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
+		GmMode:     "GM_MODE_HUMAN",
+	})
+	return event.Event{
+		CampaignID:  ids.CampaignID(campaignID),
+		Seq:         seq,
+		Timestamp:   time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC),
+		Type:        campaign.EventTypeCreated,
+		EntityType:  "campaign",
+		EntityID:    campaignID,
+		PayloadJSON: data,
+	}
+}
+
+func diffGMFearChangedEvent(campaignID string, seq uint64, gmFear int) event.Event {
+	data, _ := json.Marshal(daggerheartpayload.GMFearChangedPayload{Value: gmFear})
+	return event.Event{
+		CampaignID:    ids.CampaignID(campaignID),
+		Seq:           seq,
+		Timestamp:     time.Date(2026, 1, 10, 12, int(seq), 0, 0, time.UTC),
+		Type:          event.Type("sys.daggerheart.gm_fear_changed"),
+		SystemID:      daggerheart.SystemID,
+		SystemVersion: daggerheart.SystemVersion,
+		EntityType:    "campaign",
+		EntityID:      campaignID,
+		PayloadJSON:   data,
+	}
+}
@@ -29,6 +29,9 @@ func (a forkApplication) loadForkSourceState(ctx context.Context, sourceCampaign
 	if err != nil {
 		return forkSourceState{}, grpcerror.EnsureStatus(err)
 	}
+	if sourceCampaign.ModerationLocked {
+		return forkSourceState{}, status.Error(codes.FailedPrecondition, "campaign is locked by moderation")
+	}
 	if sourceCampaign.AccessPolicy == campaign.AccessPolicyPublic {
 		if !copyParticipants {
 			return forkSourceState{}, status.Error(codes.FailedPrecondition, "public campaign forks must copy participants")
@@ -0,0 +1,283 @@
+package forktransport
+
+import (
+	"slices"
+	"strings"
+
+	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// diffEntity flattens one entity into ordered fields, resources, and
+// condition labels so every entity kind shares a single comparison.
+type diffEntity struct {
+	id         string
+	name       string
+	fields     []diffValue
+	resources  []diffResource
+	conditions []string
+}
+
+type diffValue struct {
+	name  string
+	value string
+}
+
+type diffResource struct {
+	name  string
+	value int
+}
+
+// diffStateCaptures compares two captured states. Entities are matched by ID,
+// which forks preserve from their parent journal.
+func diffStateCaptures(before, after stateCapture) *campaignv1.CampaignStateDiff {
+	beforeCampaign, afterCampaign := campaignDiffEntity(before), campaignDiffEntity(after)
+	campaignFields, campaignResources, _, _ := diffEntityValues(&beforeCampaign, &afterCampaign)
+	return &campaignv1.CampaignStateDiff{
+		CampaignFields:    campaignFields,
+		CampaignResources: campaignResources,
+		Characters:        diffEntities(mapEntities(before.characters, characterDiffEntity), mapEntities(after.characters, characterDiffEntity)),
+		Scenes:            diffEntities(mapEntities(before.scenes, sceneDiffEntity), mapEntities(after.scenes, sceneDiffEntity)),
+		Countdowns:        diffEntities(mapEntities(before.countdowns, countdownDiffEntity), mapEntities(after.countdowns, countdownDiffEntity)),
+		Adversaries:       diffEntities(mapEntities(before.adversaries, adversaryDiffEntity), mapEntities(after.adversaries, adversaryDiffEntity)),
+	}
+}
+
+func mapEntities[T any](items []T, convert func(T) diffEntity) map[string]diffEntity {
+	entities := make(map[string]diffEntity, len(items))
+	for _, item := range items {
+		entity := convert(item)
+		entities[entity.id] = entity
+	}
+	return entities
+}
+
+// diffEntities returns one entry per added, removed, or modified entity in
+// stable ID order.
+func diffEntities(before, after map[string]diffEntity) []*campaignv1.EntityStateDiff {
+	ids := make([]string, 0, len(before)+len(after))
+	for id := range before {
+		ids = append(ids, id)
+	}
+	for id := range after {
+		if _, ok := before[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	var diffs []*campaignv1.EntityStateDiff
+	for _, id := range ids {
+		beforeEntity, hadBefore := before[id]
+		afterEntity, hasAfter := after[id]
+		var (
+			change campaignv1.StateDiffChange
+			name   string
+			b, a   *diffEntity
+		)
+		switch {
+		case !hadBefore:
+			change, name, a = campaignv1.StateDiffChange_STATE_DIFF_CHANGE_ADDED, afterEntity.name, &afterEntity
+		case !hasAfter:
+			change, name, b = campaignv1.StateDiffChange_STATE_DIFF_CHANGE_REMOVED, beforeEntity.name, &beforeEntity
+		default:
+			change, name, b, a = campaignv1.StateDiffChange_STATE_DIFF_CHANGE_MODIFIED, afterEntity.name, &beforeEntity, &afterEntity
+		}
+		fields, resources, added, removed := diffEntityValues(b, a)
+		if change == campaignv1.StateDiffChange_STATE_DIFF_CHANGE_MODIFIED &&
+			len(fields) == 0 && len(resources) == 0 && len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+		diffs = append(diffs, &campaignv1.EntityStateDiff{
+			EntityId:          id,
+			Name:              name,
+			Change:            change,
+			Fields:            fields,
+			Resources:         resources,
+			ConditionsAdded:   added,
+			ConditionsRemoved: removed,
+		})
+	}
+	return diffs
+}
+
+// diffEntityValues compares two flattened entities. A nil side stands for an
+// absent entity, so every value on the other side is reported.
+func diffEntityValues(before, after *diffEntity) ([]*campaignv1.FieldDiff, []*campaignv1.ResourceDiff, []string, []string) {
+	var empty diffEntity
+	if before == nil {
+		before = &empty
+	}
+	if after == nil {
+		after = &empty
+	}
+
+	var fields []*campaignv1.FieldDiff
+	for _, name := range unionNames(before.fields, after.fields, func(v diffValue) string { return v.name }) {
+		b, a := lookupValue(before.fields, name), lookupValue(after.fields, name)
+		if b != a {
+			fields = append(fields, &campaignv1.FieldDiff{Field: name, Before: b, After: a})
+		}
+	}
+	var resources []*campaignv1.ResourceDiff
+	for _, name := range unionNames(before.resources, after.resources, func(r diffResource) string { return r.name }) {
+		b, a := lookupResource(before.resources, name), lookupResource(after.resources, name)
+		if b != a {
+			resources = append(resources, &campaignv1.ResourceDiff{Resource: name, Before: int32(b), After: int32(a)})
+		}
+	}
+	var added, removed []string
+	for _, label := range after.conditions {
+		if !slices.Contains(before.conditions, label) {
+			added = append(added, label)
+		}
+	}
+	for _, label := range before.conditions {
+		if !slices.Contains(after.conditions, label) {
+			removed = append(removed, label)
+		}
+	}
+	return fields, resources, added, removed
+}
+
+// unionNames keeps declaration order from both sides so output follows the
+// converter's field order.
+func unionNames[T any](before, after []T, name func(T) string) []string {
+	var names []string
+	for _, items := range [][]T{before, after} {
+		for _, item := range items {
+			if n := name(item); !slices.Contains(names, n) {
+				names = append(names, n)
+			}
+		}
+	}
+	return names
+}
+
+func lookupValue(values []diffValue, name string) string {
+	for _, v := range values {
+		if v.name == name {
+			return v.value
+		}
+	}
+	return ""
+}
+
+func lookupResource(resources []diffResource, name string) int {
+	for _, r := range resources {
+		if r.name == name {
+			return r.value
+		}
+	}
+	return 0
+}
+
+func campaignDiffEntity(capture stateCapture) diffEntity {
+	entity := diffEntity{
+		id:     capture.campaign.ID,
+		fields: []diffValue{{name: "status", value: string(capture.campaign.Status)}},
+	}
+	if capture.snapshot != nil {
+		entity.resources = []diffResource{
+			{name: "gm_fear", value: capture.snapshot.GMFear},
+			{name: "consecutive_short_rests", value: capture.snapshot.ConsecutiveShortRests},
+		}
+	}
+	return entity
+}
+
+func characterDiffEntity(c characterCapture) diffEntity {
+	entity := diffEntity{
+		id:   c.record.ID,
+		name: c.record.Name,
+		fields: []diffValue{
+			{name: "name", value: c.record.Name},
+			{name: "kind", value: string(c.record.Kind)},
+			{name: "owner_participant_id", value: c.record.OwnerParticipantID},
+		},
+	}
+	if c.state == nil {
+		return entity
+	}
+	entity.fields = append(entity.fields, diffValue{name: "life_state", value: c.state.LifeState})
+	entity.resources = []diffResource{
+		{name: "hp", value: c.state.Hp},
+		{name: "hope", value: c.state.Hope},
+		{name: "hope_max", value: c.state.HopeMax},
+		{name: "stress", value: c.state.Stress},
+		{name: "armor", value: c.state.Armor},
+	}
+	entity.conditions = conditionLabels(c.state.Conditions)
+	return entity
+}
+
+func sceneDiffEntity(scene storage.SceneRecord) diffEntity {
+	status := "open"
+	if !scene.Open {
+		status = "ended"
+	}
+	return diffEntity{
+		id:   scene.SceneID,
+		name: scene.Name,
+		fields: []diffValue{
+			{name: "name", value: scene.Name},
+			{name: "description", value: scene.Description},
+			{name: "session_id", value: scene.SessionID},
+			{name: "status", value: status},
+		},
+	}
+}
+
+func countdownDiffEntity(countdown projectionstore.DaggerheartCountdown) diffEntity {
+	return diffEntity{
+		id:   countdown.CountdownID,
+		name: countdown.Name,
+		fields: []diffValue{
+			{name: "name", value: countdown.Name},
+			{name: "tone", value: countdown.Tone},
+			{name: "status", value: countdown.Status},
+			{name: "scene_id", value: countdown.SceneID},
+		},
+		resources: []diffResource{
+			{name: "remaining", value: countdown.RemainingValue},
+			{name: "starting", value: countdown.StartingValue},
+		},
+	}
+}
+
+func adversaryDiffEntity(adversary projectionstore.DaggerheartAdversary) diffEntity {
+	return diffEntity{
+		id:   adversary.AdversaryID,
+		name: adversary.Name,
+		fields: []diffValue{
+			{name: "name", value: adversary.Name},
+			{name: "scene_id", value: adversary.SceneID},
+		},
+		resources: []diffResource{
+			{name: "hp", value: adversary.HP},
+			{name: "stress", value: adversary.Stress},
+			{name: "armor", value: adversary.Armor},
+		},
+		conditions: conditionLabels(adversary.Conditions),
+	}
+}
+
+// conditionLabels prefers the display label and falls back to stable codes.
+func conditionLabels(conditions []projectionstore.DaggerheartConditionState) []string {
+	labels := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		label := strings.TrimSpace(condition.Label)
+		if label == "" {
+			label = strings.TrimSpace(condition.Code)
+		}
+		if label == "" {
+			label = strings.TrimSpace(condition.Standard)
+		}
+		if label == "" {
+			label = condition.ID
+		}
+		labels = append(labels, label)
+	}
+	return labels
+}
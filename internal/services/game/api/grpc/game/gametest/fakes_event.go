@@ -8,9 +8,10 @@ import (
 )
 
 var (
-	_ storage.EventHistoryStore  = (*FakeEventStore)(nil)
-	_ storage.EventStore         = (*FakeEventStore)(nil)
-	_ storage.BatchEventAppender = (*FakeBatchEventStore)(nil)
+	_ storage.EventHistoryStore             = (*FakeEventStore)(nil)
+	_ storage.EventStore                    = (*FakeEventStore)(nil)
+	_ storage.BatchEventAppender            = (*FakeBatchEventStore)(nil)
+	_ storage.ConditionalBatchEventAppender = (*FakeBatchEventStore)(nil)
 )
 
 // FakeEventStore is a test double for storage.EventStore.
@@ -74,6 +75,22 @@ func (s *FakeBatchEventStore) BatchAppendEvents(ctx context.Context, events []ev
 	return stored, nil
 }
 
+// BatchAppendEventsAtSeq appends only when the fake campaign head matches
+// expectedSeq, mirroring the conditional sqlite journal.
+func (s *FakeBatchEventStore) BatchAppendEventsAtSeq(ctx context.Context, expectedSeq uint64, events []event.Event) ([]event.Event, error) {
+	if len(events) > 0 {
+		campaignID := string(events[0].CampaignID)
+		var headSeq uint64
+		if next := s.NextSeq[campaignID]; next > 0 {
+			headSeq = next - 1
+		}
+		if headSeq != expectedSeq {
+			return nil, storage.NewExpectedSeqMismatchError(campaignID, expectedSeq, headSeq)
+		}
+	}
+	return s.BatchAppendEvents(ctx, events)
+}
+
 func (s *FakeEventStore) GetEventByHash(_ context.Context, hash string) (event.Event, error) {
 	if s.GetErr != nil {
 		return event.Event{}, s.GetErr
@@ -36,6 +36,29 @@ func ExecuteAndApplyDomainCommand(
 	return result, nil
 }
 
+// ExecuteAndApplyDomainCommandBatch executes domain commands all-or-nothing
+// and applies the resulting events inline when enabled.
+func ExecuteAndApplyDomainCommandBatch(
+	ctx context.Context,
+	deps DomainWriteDeps,
+	applier projection.Applier,
+	cmds []command.Command,
+	options domainwrite.Options,
+) (engine.BatchResult, error) {
+	result, err := domainwrite.TransportExecuteBatchAndApply(
+		ctx,
+		deps,
+		applier,
+		cmds,
+		options,
+		domainwrite.NormalizeDomainWriteOptionsConfig{},
+	)
+	if err != nil {
+		return result, grpcerror.EnsureStatus(err)
+	}
+	return result, nil
+}
+
 // ExecuteWithoutInlineApply executes a domain command without inline event
 // application.
 func ExecuteWithoutInlineApply(
@@ -0,0 +1,136 @@
+package interactiontransport
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/scene"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// aiPlayerTurnSource marks AI player turn tokens so they never collide with
+// AI GM turn tokens built from session event types.
+const aiPlayerTurnSource = "ai_player_turn"
+
+// ListDueAIPlayerTurns returns the AI-controlled player participants that
+// should act now in one player phase, or a waiting reason when none are due.
+//
+// AI players never pre-empt humans: a turn is only due once every human acting
+// participant has yielded, and only while the AI participant's own slot is not
+// yielded. Each AI player therefore acts at most once per phase unless the GM
+// requests revisions from it.
+func (a AIOrchestrationApplication) ListDueAIPlayerTurns(
+	ctx context.Context,
+	campaignID, sessionID, sceneID, phaseID string,
+) (*campaignv1.ListDueAIPlayerTurnsResponse, error) {
+	waiting := func(reason string) (*campaignv1.ListDueAIPlayerTurnsResponse, error) {
+		return &campaignv1.ListDueAIPlayerTurnsResponse{WaitingReason: reason}, nil
+	}
+
+	campaignRecord, err := a.interaction.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(campaignRecord.AIAgentID) == "" {
+		return waiting("campaign ai binding is required")
+	}
+	activeSession, sessionInteraction, err := a.interaction.loadActiveSessionInteraction(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if activeSession == nil || strings.TrimSpace(activeSession.ID) != strings.TrimSpace(sessionID) {
+		return waiting("session is not active")
+	}
+	if sessionInteraction.OOCPaused || sessionInteraction.OOCResolutionPending {
+		return waiting("session is paused for out-of-character discussion")
+	}
+	if strings.TrimSpace(sessionInteraction.ActiveSceneID) != strings.TrimSpace(sceneID) {
+		return waiting("scene is not active")
+	}
+	if a.interaction.stores.SceneInteraction == nil {
+		return waiting("active scene interaction state is unavailable")
+	}
+	sceneInteraction, err := a.interaction.stores.SceneInteraction.GetSceneInteraction(ctx, campaignID, sceneID)
+	if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "load ai player scene interaction"); lookupErr != nil {
+		return nil, lookupErr
+	}
+	if err != nil {
+		return waiting("scene player phase is not open")
+	}
+	if !sceneInteraction.PhaseOpen || sceneInteraction.PhaseStatus != scene.PlayerPhaseStatusPlayers {
+		return waiting("scene player phase is not open")
+	}
+	if strings.TrimSpace(sceneInteraction.PhaseID) != strings.TrimSpace(phaseID) {
+		return waiting("player phase has changed")
+	}
+
+	due := make([]*campaignv1.AIPlayerTurn, 0, len(sceneInteraction.ActingParticipantIDs))
+	for _, participantID := range sceneInteraction.ActingParticipantIDs {
+		record, err := a.interaction.stores.Participant.GetParticipant(ctx, campaignID, participantID)
+		if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "load acting participant"); lookupErr != nil {
+			return nil, lookupErr
+		}
+		if err != nil {
+			continue
+		}
+		slot := aiPlayerPhaseSlot(sceneInteraction, participantID)
+		if record.Controller != participant.ControllerAI {
+			if !slot.Yielded {
+				return waiting("waiting for human participants to yield")
+			}
+			continue
+		}
+		if record.Role != participant.RolePlayer || slot.Yielded {
+			continue
+		}
+		due = append(due, &campaignv1.AIPlayerTurn{
+			ParticipantId: participantID,
+			CharacterIds:  aiPlayerActingCharacterIDs(ctx, a.interaction, campaignID, sessionInteraction, participantID, sceneInteraction.ActingCharacterIDs),
+			TurnToken:     aiTurnToken(activeSession.ID, participantID, aiPlayerTurnSource, sceneID, sceneInteraction.PhaseID),
+		})
+	}
+	if len(due) == 0 {
+		return waiting("no ai player turns are due")
+	}
+	return &campaignv1.ListDueAIPlayerTurnsResponse{Turns: due}, nil
+}
+
+func aiPlayerPhaseSlot(interaction storage.SceneInteraction, participantID string) storage.ScenePlayerSlot {
+	for _, slot := range interaction.Slots {
+		if strings.TrimSpace(slot.ParticipantID) == strings.TrimSpace(participantID) {
+			return slot
+		}
+	}
+	return storage.ScenePlayerSlot{ParticipantID: participantID}
+}
+
+// aiPlayerActingCharacterIDs narrows the phase's acting characters to those
+// the AI participant controls this session so its brief only covers its own
+// characters.
+func aiPlayerActingCharacterIDs(
+	ctx context.Context,
+	app interactionApplication,
+	campaignID string,
+	sessionInteraction storage.SessionInteraction,
+	participantID string,
+	actingCharacterIDs []string,
+) []string {
+	owned := make([]string, 0, len(actingCharacterIDs))
+	if app.stores.Character == nil {
+		return owned
+	}
+	for _, characterID := range actingCharacterIDs {
+		record, err := app.stores.Character.GetCharacter(ctx, campaignID, characterID)
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(effectiveSessionCharacterController(sessionInteraction, record)) == strings.TrimSpace(participantID) && !slices.Contains(owned, characterID) {
+			owned = append(owned, characterID)
+		}
+	}
+	return owned
+}
@@ -0,0 +1,156 @@
+package interactiontransport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/runtimekit"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/scene"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+func newAIPlayerTurnsTestApp(t *testing.T, sceneInteraction storage.SceneInteraction) (AIOrchestrationApplication, *concludeSceneInteractionStore) {
+	t.Helper()
+
+	campaignStore := gametest.NewFakeCampaignStore()
+	campaignStore.Campaigns["camp-1"] = storage.CampaignRecord{ID: "camp-1", Status: campaign.StatusActive, GmMode: campaign.GmModeHuman, AIAgentID: "agent-1"}
+	sessionStore := gametest.NewFakeSessionStore()
+	sessionStore.Sessions["camp-1"] = map[string]storage.SessionRecord{
+		"sess-1": {ID: "sess-1", CampaignID: "camp-1", Status: session.StatusActive},
+	}
+	sessionStore.ActiveSession["camp-1"] = "sess-1"
+	sessionInteractionStore := gametest.NewFakeSessionInteractionStore()
+	sessionInteractionStore.Values = map[string]storage.SessionInteraction{
+		"camp-1:sess-1": {CampaignID: "camp-1", SessionID: "sess-1", ActiveSceneID: "scene-1"},
+	}
+	participantStore := gametest.NewFakeParticipantStore()
+	participantStore.Participants["camp-1"] = map[string]storage.ParticipantRecord{
+		"human":     {ID: "human", CampaignID: "camp-1", Role: participant.RolePlayer, Controller: participant.ControllerHuman},
+		"companion": {ID: "companion", CampaignID: "camp-1", Role: participant.RolePlayer, Controller: participant.ControllerAI},
+	}
+	characterStore := gametest.NewFakeCharacterStore()
+	characterStore.Characters["camp-1"] = map[string]storage.CharacterRecord{
+		"char-human":     {ID: "char-human", CampaignID: "camp-1", OwnerParticipantID: "human"},
+		"char-companion": {ID: "char-companion", CampaignID: "camp-1", OwnerParticipantID: "companion"},
+	}
+	sceneInteractionStore := &concludeSceneInteractionStore{values: map[string]storage.SceneInteraction{
+		"camp-1:scene-1": sceneInteraction,
+	}}
+
+	app := NewAIOrchestrationApplication(Deps{
+		Campaign:           campaignStore,
+		Participant:        participantStore,
+		Character:          characterStore,
+		Session:            sessionStore,
+		SessionInteraction: sessionInteractionStore,
+		SceneInteraction:   sceneInteractionStore,
+	}, runtimekit.FixedIDGenerator("unused"))
+	return app, sceneInteractionStore
+}
+
+func TestListDueAIPlayerTurnsWaitsForHumansBeforeAIPlayers(t *testing.T) {
+	t.Parallel()
+
+	phase := storage.SceneInteraction{
+		CampaignID:           "camp-1",
+		SceneID:              "scene-1",
+		SessionID:            "sess-1",
+		PhaseOpen:            true,
+		PhaseID:              "phase-1",
+		PhaseStatus:          scene.PlayerPhaseStatusPlayers,
+		ActingCharacterIDs:   []string{"char-human", "char-companion"},
+		ActingParticipantIDs: []string{"human", "companion"},
+		Slots: []storage.ScenePlayerSlot{
+			{ParticipantID: "human", SummaryText: "I scout ahead."},
+		},
+	}
+	app, store := newAIPlayerTurnsTestApp(t, phase)
+
+	resp, err := app.ListDueAIPlayerTurns(context.Background(), "camp-1", "sess-1", "scene-1", "phase-1")
+	if err != nil {
+		t.Fatalf("ListDueAIPlayerTurns() error = %v", err)
+	}
+	if len(resp.GetTurns()) != 0 || resp.GetWaitingReason() != "waiting for human participants to yield" {
+		t.Fatalf("response = %+v, want waiting for humans", resp)
+	}
+
+	phase.Slots[0].Yielded = true
+	store.values["camp-1:scene-1"] = phase
+	resp, err = app.ListDueAIPlayerTurns(context.Background(), "camp-1", "sess-1", "scene-1", "phase-1")
+	if err != nil {
+		t.Fatalf("ListDueAIPlayerTurns() error = %v", err)
+	}
+	if len(resp.GetTurns()) != 1 {
+		t.Fatalf("turns = %+v, want one due turn", resp.GetTurns())
+	}
+	turn := resp.GetTurns()[0]
+	if turn.GetParticipantId() != "companion" {
+		t.Fatalf("participant id = %q, want companion", turn.GetParticipantId())
+	}
+	if got := turn.GetCharacterIds(); len(got) != 1 || got[0] != "char-companion" {
+		t.Fatalf("character ids = %v, want [char-companion]", got)
+	}
+	if turn.GetTurnToken() != "sess-1|companion|ai_player_turn|scene-1|phase-1" {
+		t.Fatalf("turn token = %q", turn.GetTurnToken())
+	}
+
+	phase.Slots = append(phase.Slots, storage.ScenePlayerSlot{ParticipantID: "companion", SummaryText: "I follow.", Yielded: true})
+	store.values["camp-1:scene-1"] = phase
+	resp, err = app.ListDueAIPlayerTurns(context.Background(), "camp-1", "sess-1", "scene-1", "phase-1")
+	if err != nil {
+		t.Fatalf("ListDueAIPlayerTurns() error = %v", err)
+	}
+	if len(resp.GetTurns()) != 0 {
+		t.Fatalf("turns = %+v, want none after the ai player yielded", resp.GetTurns())
+	}
+}
+
+func TestListDueAIPlayerTurnsIgnoresStalePhases(t *testing.T) {
+	t.Parallel()
+
+	app, _ := newAIPlayerTurnsTestApp(t, storage.SceneInteraction{
+		CampaignID:           "camp-1",
+		SceneID:              "scene-1",
+		SessionID:            "sess-1",
+		PhaseOpen:            true,
+		PhaseID:              "phase-2",
+		PhaseStatus:          scene.PlayerPhaseStatusPlayers,
+		ActingCharacterIDs:   []string{"char-companion"},
+		ActingParticipantIDs: []string{"companion"},
+	})
+
+	tests := []struct {
+		name      string
+		sessionID string
+		sceneID   string
+		phaseID   string
+		want      string
+	}{
+		{name: "old phase", sessionID: "sess-1", sceneID: "scene-1", phaseID: "phase-1", want: "player phase has changed"},
+		{name: "other scene", sessionID: "sess-1", sceneID: "scene-9", phaseID: "phase-2", want: "scene is not active"},
+		{name: "other session", sessionID: "sess-9", sceneID: "scene-1", phaseID: "phase-2", want: "session is not active"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.ListDueAIPlayerTurns(context.Background(), "camp-1", tc.sessionID, tc.sceneID, tc.phaseID)
+			if err != nil {
+				t.Fatalf("ListDueAIPlayerTurns() error = %v", err)
+			}
+			if len(resp.GetTurns()) != 0 || resp.GetWaitingReason() != tc.want {
+				t.Fatalf("response = %+v, want waiting reason %q", resp, tc.want)
+			}
+		})
+	}
+
+	resp, err := app.ListDueAIPlayerTurns(context.Background(), "camp-1", "sess-1", "scene-1", "phase-2")
+	if err != nil {
+		t.Fatalf("ListDueAIPlayerTurns() error = %v", err)
+	}
+	if len(resp.GetTurns()) != 1 {
+		t.Fatalf("turns = %+v, want the ai-only phase to be due immediately", resp.GetTurns())
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/participanttransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
@@ -37,6 +38,8 @@ type Deps struct {
 	SceneGMInteraction storage.SceneGMInteractionStore
 	Write              domainwrite.WritePath
 	Applier            projection.Applier
+	// History serves as_of interaction reads; nil disables them.
+	History *historyread.Reader
 }
 
 // interactionApplication coordinates the scene-phase interaction service over
@@ -46,6 +49,7 @@ type interactionApplication struct {
 	stores      interactionApplicationStores
 	write       domainwrite.WritePath
 	applier     projection.Applier
+	history     *historyread.Reader
 	idGenerator func() (string, error)
 }
 
@@ -84,6 +88,7 @@ func newInteractionApplicationWithDependencies(
 		},
 		write:       deps.Write,
 		applier:     deps.Applier,
+		history:     deps.History,
 		idGenerator: idGenerator,
 	}
 }
@@ -93,7 +98,69 @@ func (a interactionApplication) GetInteractionState(ctx context.Context, campaig
 	if err != nil {
 		return nil, err
 	}
+	return a.buildInteractionState(ctx, campaignRecord, actor)
+}
 
+// GetInteractionStateAt authorizes the viewer against current projections and
+// then builds interaction state from a historical replay selected by point. It
+// returns the replayed event seq, or zero for current-state reads.
+func (a interactionApplication) GetInteractionStateAt(ctx context.Context, campaignID string, point historyread.Point) (*campaignv1.InteractionState, uint64, error) {
+	if point.IsCurrent() {
+		state, err := a.GetInteractionState(ctx, campaignID)
+		return state, 0, err
+	}
+	campaignRecord, actor, err := a.loadViewerCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, 0, err
+	}
+	var (
+		state *campaignv1.InteractionState
+		seq   uint64
+	)
+	err = a.history.Read(ctx, campaignID, point, func(view historyread.View) error {
+		historical, err := a.withHistoricalStores(view).buildInteractionState(ctx, campaignRecord, actor)
+		if err != nil {
+			return err
+		}
+		state, seq = historical, view.Seq
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return state, seq, nil
+}
+
+// withHistoricalStores swaps session and scene reads onto a historical view.
+// Campaign and participant stores stay current because they back viewer
+// authorization, and the journal fallback is dropped so later events cannot
+// leak into the replayed state.
+func (a interactionApplication) withHistoricalStores(view historyread.View) interactionApplication {
+	a.stores.Character = view.Stores
+	a.stores.Event = nil
+	a.stores.Session = view.Stores
+	a.stores.SessionRecap = view.Stores
+	a.stores.SessionInteraction = view.Stores
+	a.stores.Scene = view.Stores
+	a.stores.SceneCharacter = view.Stores
+	a.stores.SceneInteraction = view.Stores
+	a.stores.SceneGMInteraction = view.Stores
+	return a
+}
+
+func (a interactionApplication) buildInteractionState(ctx context.Context, campaignRecord storage.CampaignRecord, actor storage.ParticipantRecord) (*campaignv1.InteractionState, error) {
+	campaignID := campaignRecord.ID
+	// Historical builds drop the journal store, so they report no head seq.
+	// Neither does outbox apply, where the journal head may be ahead of the
+	// projections this state is built from.
+	var headSeq uint64
+	if a.stores.Event != nil && !a.write.Runtime.ProjectionsTrailJournal() {
+		seq, err := a.stores.Event.GetLatestEventSeq(ctx, campaignID)
+		if err != nil {
+			return nil, grpcerror.Internal("get latest event seq", err)
+		}
+		headSeq = seq
+	}
 	state := &campaignv1.InteractionState{
 		CampaignId:   campaignRecord.ID,
 		CampaignName: campaignRecord.Name,
@@ -110,6 +177,7 @@ func (a interactionApplication) GetInteractionState(ctx context.Context, campaig
 		AiTurn: &campaignv1.AITurnState{
 			Status: campaignv1.AITurnStatus_AI_TURN_STATUS_IDLE,
 		},
+		HeadSeq: headSeq,
 	}
 
 	activeSession, sessionInteraction, err := a.loadActiveSessionInteraction(ctx, campaignID)
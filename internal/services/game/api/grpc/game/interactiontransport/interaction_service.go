@@ -5,6 +5,7 @@ import (
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,11 +32,15 @@ func (s *InteractionService) GetInteractionState(ctx context.Context, in *campai
 	if err != nil {
 		return nil, err
 	}
-	state, err := s.app.GetInteractionState(ctx, campaignID)
+	point, err := historyread.NewPoint(in.GetAsOfSeq(), in.GetAsOfSessionId())
 	if err != nil {
 		return nil, err
 	}
-	return &campaignv1.GetInteractionStateResponse{State: state}, nil
+	state, asOfSeq, err := s.app.GetInteractionStateAt(ctx, campaignID, point)
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.GetInteractionStateResponse{State: state, AsOfSeq: asOfSeq}, nil
 }
 
 func (s *InteractionService) ActivateScene(ctx context.Context, in *campaignv1.ActivateSceneRequest) (*campaignv1.ActivateSceneResponse, error) {
@@ -0,0 +1,127 @@
+package interactiontransport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/coreprojection"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNewInteractionServiceGetInteractionStateAsOfReplaysHistoricalSession(t *testing.T) {
+	t.Parallel()
+
+	h := newInteractionServiceHarness()
+	h.sessions.Sessions["c1"]["sess-0"] = storage.SessionRecord{
+		ID:         "sess-0",
+		CampaignID: "c1",
+		Name:       "Session Zero",
+		Status:     session.StatusEnded,
+	}
+	h.events.Events["c1"] = []event.Event{
+		historyEvent(t, 1, "", campaign.EventTypeCreated, "campaign", "c1", campaign.CreatePayload{
+			Name:       "Test Campaign",
+			GameSystem: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
+			GmMode:     "GM_MODE_HUMAN",
+		}),
+		historyEvent(t, 2, "sess-0", session.EventTypeStarted, "session", "sess-0", session.StartPayload{
+			SessionID:   "sess-0",
+			SessionName: "Session Zero",
+		}),
+		historyEvent(t, 3, "sess-0", session.EventTypeEnded, "session", "sess-0", session.EndPayload{
+			SessionID: "sess-0",
+		}),
+	}
+	h.events.NextSeq["c1"] = 4
+
+	deps := h.deps()
+	deps.History = historyread.NewReader(historyread.Config{
+		Events:   h.events,
+		Sessions: h.sessions,
+		OpenScratch: func() (historyread.Scratch, error) {
+			store, err := coreprojection.OpenMemory()
+			if err != nil {
+				return nil, err
+			}
+			return store, nil
+		},
+	})
+	svc := NewInteractionService(deps)
+	ctx := requestctx.WithParticipantID(context.Background(), "player-1")
+
+	resp, err := svc.GetInteractionState(ctx, &gamev1.GetInteractionStateRequest{CampaignId: "c1", AsOfSeq: 2})
+	if err != nil {
+		t.Fatalf("GetInteractionState error = %v", err)
+	}
+	if resp.GetAsOfSeq() != 2 {
+		t.Fatalf("AsOfSeq = %d, want 2", resp.GetAsOfSeq())
+	}
+	if resp.GetState().GetViewer().GetParticipantId() != "player-1" {
+		t.Fatalf("viewer participant = %q", resp.GetState().GetViewer().GetParticipantId())
+	}
+	if got := resp.GetState().GetActiveSession(); got.GetSessionId() != "sess-0" || got.GetName() != "Session Zero" {
+		t.Fatalf("active session = %#v, want historical sess-0", got)
+	}
+
+	resp, err = svc.GetInteractionState(ctx, &gamev1.GetInteractionStateRequest{CampaignId: "c1", AsOfSessionId: "sess-0"})
+	if err != nil {
+		t.Fatalf("GetInteractionState as of session error = %v", err)
+	}
+	if resp.GetAsOfSeq() != 3 || resp.GetState().GetActiveSession() != nil {
+		t.Fatalf("as of session end = seq %d session %#v, want seq 3 and no active session", resp.GetAsOfSeq(), resp.GetState().GetActiveSession())
+	}
+	if resp.GetState().GetHeadSeq() != 0 {
+		t.Fatalf("historical HeadSeq = %d, want 0", resp.GetState().GetHeadSeq())
+	}
+
+	resp, err = svc.GetInteractionState(ctx, &gamev1.GetInteractionStateRequest{CampaignId: "c1"})
+	if err != nil {
+		t.Fatalf("GetInteractionState current error = %v", err)
+	}
+	if resp.GetState().GetHeadSeq() != 3 {
+		t.Fatalf("current HeadSeq = %d, want 3", resp.GetState().GetHeadSeq())
+	}
+}
+
+func TestNewInteractionServiceGetInteractionStateAsOfRequiresAuthorizedViewer(t *testing.T) {
+	t.Parallel()
+
+	h := newInteractionServiceHarness()
+	_, err := h.service().GetInteractionState(context.Background(), &gamev1.GetInteractionStateRequest{CampaignId: "c1", AsOfSeq: 1})
+	assertStatusCode(t, err, codes.PermissionDenied)
+
+	_, err = h.service().GetInteractionState(
+		requestctx.WithParticipantID(context.Background(), "player-1"),
+		&gamev1.GetInteractionStateRequest{CampaignId: "c1", AsOfSeq: 1},
+	)
+	assertStatusCode(t, err, codes.Unimplemented)
+}
+
+func historyEvent(t *testing.T, seq uint64, sessionID string, eventType event.Type, entityType, entityID string, payload any) event.Event {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	return event.Event{
+		CampaignID:  "c1",
+		SessionID:   ids.SessionID(sessionID),
+		Seq:         seq,
+		Timestamp:   time.Date(2026, 3, 12, 9, int(seq), 0, 0, time.UTC),
+		Type:        eventType,
+		EntityType:  entityType,
+		EntityID:    entityID,
+		PayloadJSON: data,
+	}
+}
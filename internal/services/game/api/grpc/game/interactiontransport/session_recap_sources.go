@@ -0,0 +1,121 @@
+package interactiontransport
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/handler"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sessionRecapSourceEventLimit bounds how much journal history one recap
+// draft can pull; long sessions are truncated to their earliest events.
+const sessionRecapSourceEventLimit = 500
+
+// GetSessionRecapSources returns the session journal, GM interactions, and
+// participant names used to draft a recap for one running or ended session.
+func (a AIOrchestrationApplication) GetSessionRecapSources(ctx context.Context, campaignID, sessionID string) (*campaignv1.GetSessionRecapSourcesResponse, error) {
+	stores := a.interaction.stores
+	if stores.Event == nil || stores.Scene == nil || stores.SceneGMInteraction == nil {
+		return nil, grpcerror.Internal("load session recap sources", errors.New("session recap source stores are not configured"))
+	}
+	campaignRecord, err := stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	sessionRecord, err := stores.Session.GetSession(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &campaignv1.GetSessionRecapSourcesResponse{
+		CampaignName: campaignRecord.Name,
+		SessionName:  sessionRecord.Name,
+		AiAgentBound: strings.TrimSpace(campaignRecord.AIAgentID) != "",
+	}
+
+	if stores.SessionRecap != nil {
+		recap, err := stores.SessionRecap.GetSessionRecap(ctx, campaignID, sessionID)
+		if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "load session recap"); lookupErr != nil {
+			return nil, lookupErr
+		}
+		resp.RecapRecorded = err == nil && strings.TrimSpace(recap.Markdown) != ""
+	}
+
+	events, err := stores.Event.ListEventsBySession(ctx, campaignID, sessionID, 0, sessionRecapSourceEventLimit+1)
+	if err != nil {
+		return nil, grpcerror.Internal("list session events", err)
+	}
+	if len(events) > sessionRecapSourceEventLimit {
+		events = events[:sessionRecapSourceEventLimit]
+		resp.EventsTruncated = true
+	}
+	resp.Events = make([]*campaignv1.SessionRecapSourceEvent, 0, len(events))
+	for _, evt := range events {
+		resp.Events = append(resp.Events, &campaignv1.SessionRecapSourceEvent{
+			Seq:         evt.Seq,
+			Type:        string(evt.Type),
+			OccurredAt:  timestamppb.New(evt.Timestamp),
+			ActorType:   string(evt.ActorType),
+			ActorId:     evt.ActorID,
+			PayloadJson: string(evt.PayloadJSON),
+		})
+	}
+
+	interactions, err := a.listSessionGMInteractions(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	resp.GmInteractions = make([]*campaignv1.GMInteraction, 0, len(interactions))
+	for _, interaction := range interactions {
+		if converted := sceneGMInteractionToProto(interaction); converted != nil {
+			resp.GmInteractions = append(resp.GmInteractions, converted)
+		}
+	}
+
+	participants, err := stores.Participant.ListParticipantsByCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, grpcerror.Internal("list campaign participants", err)
+	}
+	resp.Participants = make([]*campaignv1.SessionRecapSourceParticipant, 0, len(participants))
+	for _, record := range participants {
+		resp.Participants = append(resp.Participants, &campaignv1.SessionRecapSourceParticipant{
+			ParticipantId: record.ID,
+			Name:          record.Name,
+		})
+	}
+	return resp, nil
+}
+
+// listSessionGMInteractions collects GM interactions across every scene of a
+// session, oldest first.
+func (a AIOrchestrationApplication) listSessionGMInteractions(ctx context.Context, campaignID, sessionID string) ([]storage.SceneGMInteraction, error) {
+	stores := a.interaction.stores
+	var interactions []storage.SceneGMInteraction
+	pageToken := ""
+	for {
+		page, err := stores.Scene.ListScenes(ctx, campaignID, sessionID, handler.PageLarge, pageToken)
+		if err != nil {
+			return nil, grpcerror.Internal("list session scenes", err)
+		}
+		for _, sceneRecord := range page.Scenes {
+			sceneInteractions, err := stores.SceneGMInteraction.ListSceneGMInteractions(ctx, campaignID, sceneRecord.SceneID)
+			if err != nil {
+				return nil, grpcerror.Internal("list scene gm interactions", err)
+			}
+			interactions = append(interactions, sceneInteractions...)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+	sort.SliceStable(interactions, func(i, j int) bool {
+		return interactions[i].CreatedAt.Before(interactions[j].CreatedAt)
+	})
+	return interactions, nil
+}
@@ -0,0 +1,90 @@
+package interactiontransport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/runtimekit"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+func TestGetSessionRecapSourcesCollectsEndedSession(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 12, 20, 0, 0, 0, time.UTC)
+	campaignStore := gametest.NewFakeCampaignStore()
+	campaignStore.Campaigns["camp-1"] = storage.CampaignRecord{ID: "camp-1", Name: "Ashes", Status: campaign.StatusActive, AIAgentID: "agent-1"}
+	sessionStore := gametest.NewFakeSessionStore()
+	sessionStore.Sessions["camp-1"] = map[string]storage.SessionRecord{
+		"sess-1": {ID: "sess-1", CampaignID: "camp-1", Name: "Session 3", Status: session.StatusEnded},
+	}
+	participantStore := gametest.NewFakeParticipantStore()
+	participantStore.Participants["camp-1"] = map[string]storage.ParticipantRecord{
+		"gm": {ID: "gm", CampaignID: "camp-1", Name: "Guide"},
+	}
+	eventStore := gametest.NewFakeEventStore()
+	for _, evtType := range []event.Type{session.EventTypeStarted, session.EventTypeEnded} {
+		if _, err := eventStore.AppendEvent(context.Background(), event.Event{
+			CampaignID:  ids.CampaignID("camp-1"),
+			SessionID:   ids.SessionID("sess-1"),
+			Type:        evtType,
+			Timestamp:   now,
+			ActorType:   event.ActorTypeParticipant,
+			ActorID:     "gm",
+			PayloadJSON: []byte(`{"session_id":"sess-1"}`),
+		}); err != nil {
+			t.Fatalf("append event: %v", err)
+		}
+	}
+	sceneStore := &concludeSceneStore{scenes: map[string]storage.SceneRecord{
+		"camp-1:scene-1": {CampaignID: "camp-1", SceneID: "scene-1", SessionID: "sess-1"},
+		"camp-1:scene-2": {CampaignID: "camp-1", SceneID: "scene-2", SessionID: "sess-1"},
+	}}
+	gmStore := &concludeSceneGMInteractionStore{values: map[string][]storage.SceneGMInteraction{
+		"camp-1:scene-1": {{CampaignID: "camp-1", SceneID: "scene-1", InteractionID: "late", Title: "The gate falls", CreatedAt: now.Add(time.Minute)}},
+		"camp-1:scene-2": {{CampaignID: "camp-1", SceneID: "scene-2", InteractionID: "early", Title: "Arrival", CreatedAt: now}},
+	}}
+	recapStore := gametest.NewFakeSessionRecapStore()
+
+	app := NewAIOrchestrationApplication(Deps{
+		Campaign:           campaignStore,
+		Participant:        participantStore,
+		Event:              eventStore,
+		Session:            sessionStore,
+		SessionRecap:       recapStore,
+		Scene:              sceneStore,
+		SceneGMInteraction: gmStore,
+	}, runtimekit.FixedIDGenerator("unused"))
+
+	resp, err := app.GetSessionRecapSources(context.Background(), "camp-1", "sess-1")
+	if err != nil {
+		t.Fatalf("GetSessionRecapSources() error = %v", err)
+	}
+	if resp.GetCampaignName() != "Ashes" || resp.GetSessionName() != "Session 3" || resp.GetRecapRecorded() || !resp.GetAiAgentBound() {
+		t.Fatalf("response header = %+v", resp)
+	}
+	if len(resp.GetEvents()) != 2 || resp.GetEvents()[1].GetType() != string(session.EventTypeEnded) {
+		t.Fatalf("events = %+v", resp.GetEvents())
+	}
+	if len(resp.GetGmInteractions()) != 2 || resp.GetGmInteractions()[0].GetInteractionId() != "early" {
+		t.Fatalf("gm interactions = %+v, want oldest first", resp.GetGmInteractions())
+	}
+	if len(resp.GetParticipants()) != 1 || resp.GetParticipants()[0].GetName() != "Guide" {
+		t.Fatalf("participants = %+v", resp.GetParticipants())
+	}
+
+	recapStore.Recaps["camp-1:sess-1"] = storage.SessionRecap{CampaignID: "camp-1", SessionID: "sess-1", Markdown: "Done."}
+	resp, err = app.GetSessionRecapSources(context.Background(), "camp-1", "sess-1")
+	if err != nil {
+		t.Fatalf("GetSessionRecapSources() error = %v", err)
+	}
+	if !resp.GetRecapRecorded() {
+		t.Fatal("expected recap_recorded once markdown is stored")
+	}
+}
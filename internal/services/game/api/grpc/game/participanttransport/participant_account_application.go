@@ -0,0 +1,122 @@
+package participanttransport
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/handler"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/commandbuild"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/commandids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// ListUserParticipants returns every participant seat bound to the user
+// across all campaigns.
+func (c participantApplication) ListUserParticipants(ctx context.Context, userID string) ([]storage.ParticipantRecord, error) {
+	campaignIDs, err := c.stores.Participant.ListCampaignIDsByUser(ctx, userID)
+	if err != nil {
+		return nil, grpcerror.Internal("list user campaigns", err)
+	}
+	records := []storage.ParticipantRecord{}
+	for _, campaignID := range campaignIDs {
+		participants, err := c.stores.Participant.ListParticipantsByCampaign(ctx, campaignID)
+		if err != nil {
+			return nil, grpcerror.Internal("list campaign participants", err)
+		}
+		for _, record := range participants {
+			if strings.TrimSpace(record.UserID) == userID {
+				records = append(records, record)
+			}
+		}
+	}
+	return records, nil
+}
+
+// AnonymizeUserParticipants detaches a deleted account from every seat it
+// held. Each seat is renamed with profile fields cleared, then unbound with
+// the account-deleted reason. Both steps append new events, so the journal
+// hash chain is untouched; earlier identity is hidden on read instead.
+// Campaign status checks are skipped because archived campaigns must be
+// anonymized too. Commands always carry the system actor, which is what lets
+// them through a campaign's moderation lock.
+func (c participantApplication) AnonymizeUserParticipants(ctx context.Context, userID string) ([]storage.ParticipantRecord, error) {
+	seats, err := c.ListUserParticipants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	actorID, actorType := "", command.ActorTypeSystem
+	anonymized := make([]storage.ParticipantRecord, 0, len(seats))
+	for _, seat := range seats {
+		updatePayload, err := json.Marshal(participant.UpdatePayload{
+			ParticipantID: ids.ParticipantID(seat.ID),
+			Fields: map[string]string{
+				"name":          participant.AnonymizedName,
+				"pronouns":      "",
+				"avatar_set_id": "",
+			},
+		})
+		if err != nil {
+			return nil, grpcerror.Internal("encode payload", err)
+		}
+		if err := c.executeAccountCommand(ctx, seat, commandids.ParticipantUpdate, actorID, actorType, updatePayload, "apply participant anonymize event"); err != nil {
+			return nil, err
+		}
+
+		unbindPayload, err := json.Marshal(participant.UnbindPayload{
+			ParticipantID: ids.ParticipantID(seat.ID),
+			UserID:        ids.UserID(userID),
+			Reason:        participant.UnbindReasonAccountDeleted,
+		})
+		if err != nil {
+			return nil, grpcerror.Internal("encode payload", err)
+		}
+		if err := c.executeAccountCommand(ctx, seat, commandids.ParticipantUnbind, actorID, actorType, unbindPayload, "apply participant unbind event"); err != nil {
+			return nil, err
+		}
+
+		updated, err := c.stores.Participant.GetParticipant(ctx, seat.CampaignID, seat.ID)
+		if err != nil {
+			return nil, grpcerror.Internal("load participant", err)
+		}
+		anonymized = append(anonymized, updated)
+	}
+	return anonymized, nil
+}
+
+func (c participantApplication) executeAccountCommand(
+	ctx context.Context,
+	seat storage.ParticipantRecord,
+	cmdType command.Type,
+	actorID string,
+	actorType command.ActorType,
+	payloadJSON []byte,
+	applyMessage string,
+) error {
+	_, err := handler.ExecuteAndApplyDomainCommand(
+		ctx,
+		c.write,
+		c.applier,
+		commandbuild.Core(commandbuild.CoreInput{
+			CampaignID:   seat.CampaignID,
+			Type:         cmdType,
+			ActorType:    actorType,
+			ActorID:      actorID,
+			RequestID:    grpcmeta.RequestIDFromContext(ctx),
+			InvocationID: grpcmeta.InvocationIDFromContext(ctx),
+			EntityType:   "participant",
+			EntityID:     seat.ID,
+			PayloadJSON:  payloadJSON,
+		}),
+		domainwrite.Options{
+			ApplyErr: handler.ApplyErrorWithCodePreserve(applyMessage),
+		},
+	)
+	return err
+}
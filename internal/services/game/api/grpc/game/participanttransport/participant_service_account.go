@@ -0,0 +1,56 @@
+package participanttransport
+
+import (
+	"context"
+
+	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListUserParticipants lists every seat bound to a user across campaigns.
+// Internal-only: account export uses it to locate the user's game records.
+func (s *Service) ListUserParticipants(ctx context.Context, in *campaignv1.ListUserParticipantsRequest) (*campaignv1.ListUserParticipantsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list user participants request is required")
+	}
+	userID, err := validate.RequiredID(in.GetUserId(), "user id")
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.app.ListUserParticipants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.ListUserParticipantsResponse{Participants: participantsToProto(records)}, nil
+}
+
+// AnonymizeUserParticipants detaches a deleted account from all of its seats.
+// Internal-only: the account deletion job is the sole caller. Repeated calls
+// succeed once no seats remain bound.
+func (s *Service) AnonymizeUserParticipants(ctx context.Context, in *campaignv1.AnonymizeUserParticipantsRequest) (*campaignv1.AnonymizeUserParticipantsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "anonymize user participants request is required")
+	}
+	userID, err := validate.RequiredID(in.GetUserId(), "user id")
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.app.AnonymizeUserParticipants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.AnonymizeUserParticipantsResponse{Participants: participantsToProto(records)}, nil
+}
+
+func participantsToProto(records []storage.ParticipantRecord) []*campaignv1.Participant {
+	out := make([]*campaignv1.Participant, 0, len(records))
+	for _, record := range records {
+		out = append(out, ParticipantToProto(record))
+	}
+	return out
+}
@@ -0,0 +1,167 @@
+package participanttransport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/projection"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListUserParticipants_ReturnsBoundSeatsAcrossCampaigns(t *testing.T) {
+	participantStore := gametest.NewFakeParticipantStore()
+	participantStore.Participants["c1"] = map[string]storage.ParticipantRecord{
+		"p1": {ID: "p1", CampaignID: "c1", UserID: "user-1", Name: "Ada", Role: participant.RolePlayer},
+		"p2": {ID: "p2", CampaignID: "c1", UserID: "user-2", Name: "Bo", Role: participant.RolePlayer},
+	}
+	participantStore.Participants["c2"] = map[string]storage.ParticipantRecord{
+		"p9": {ID: "p9", CampaignID: "c2", UserID: "user-1", Name: "Ada", Role: participant.RoleGM},
+	}
+	svc := NewService(Deps{Participant: participantStore})
+
+	resp, err := svc.ListUserParticipants(context.Background(), &statev1.ListUserParticipantsRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("ListUserParticipants returned error: %v", err)
+	}
+	if got := len(resp.GetParticipants()); got != 2 {
+		t.Fatalf("participants = %d, want 2", got)
+	}
+	for _, p := range resp.GetParticipants() {
+		if p.GetUserId() != "user-1" {
+			t.Fatalf("participant %s user = %q, want user-1", p.GetId(), p.GetUserId())
+		}
+	}
+}
+
+func TestListUserParticipants_RequiresUserID(t *testing.T) {
+	svc := NewService(Deps{Participant: gametest.NewFakeParticipantStore()})
+	_, err := svc.ListUserParticipants(context.Background(), &statev1.ListUserParticipantsRequest{})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestAnonymizeUserParticipants_RenamesThenUnbindsSeat(t *testing.T) {
+	campaignStore := gametest.NewFakeCampaignStore()
+	participantStore := gametest.NewFakeParticipantStore()
+	eventStore := gametest.NewFakeEventStore()
+
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecordWithParticipantCount("c1", 1)
+	participantStore.Participants["c1"] = map[string]storage.ParticipantRecord{
+		"p1": {ID: "p1", CampaignID: "c1", UserID: "user-1", Name: "Ada", Pronouns: "she/her", Role: participant.RolePlayer},
+	}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	domain := &fakeDomainEngine{store: eventStore, resultsByType: map[command.Type]engine.Result{
+		command.Type("participant.update"): {
+			Decision: command.Accept(event.Event{
+				CampaignID:  "c1",
+				Type:        event.Type("participant.updated"),
+				Timestamp:   now,
+				ActorType:   event.ActorTypeSystem,
+				EntityType:  "participant",
+				EntityID:    "p1",
+				PayloadJSON: []byte(`{"participant_id":"p1","fields":{"name":"Former participant","pronouns":""}}`),
+			}),
+		},
+		command.Type("participant.unbind"): {
+			Decision: command.Accept(event.Event{
+				CampaignID:  "c1",
+				Type:        event.Type("participant.unbound"),
+				Timestamp:   now,
+				ActorType:   event.ActorTypeSystem,
+				EntityType:  "participant",
+				EntityID:    "p1",
+				PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","reason":"account_deleted"}`),
+			}),
+		},
+	}}
+
+	svc := NewService(Deps{
+		Auth:        authz.PolicyDeps{Participant: participantStore},
+		Campaign:    campaignStore,
+		Participant: participantStore,
+		Write:       domainwrite.WritePath{Executor: domain, Runtime: testRuntime},
+		Applier:     projection.Applier{Campaign: campaignStore, Participant: participantStore},
+	})
+	resp, err := svc.AnonymizeUserParticipants(context.Background(), &statev1.AnonymizeUserParticipantsRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("AnonymizeUserParticipants returned error: %v", err)
+	}
+	if len(domain.commands) != 2 || domain.commands[0].Type != "participant.update" || domain.commands[1].Type != "participant.unbind" {
+		t.Fatalf("commands = %+v, want update then unbind", domain.commands)
+	}
+	if got := resp.GetParticipants(); len(got) != 1 || got[0].GetUserId() != "" || got[0].GetName() != participant.AnonymizedName {
+		t.Fatalf("participants = %+v", got)
+	}
+
+	// Once every seat is released a retry has nothing left to do.
+	domain.commands = nil
+	resp, err = svc.AnonymizeUserParticipants(context.Background(), &statev1.AnonymizeUserParticipantsRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("repeat AnonymizeUserParticipants returned error: %v", err)
+	}
+	if len(resp.GetParticipants()) != 0 || len(domain.commands) != 0 {
+		t.Fatalf("repeat participants = %d commands = %d, want none", len(resp.GetParticipants()), len(domain.commands))
+	}
+}
+
+func TestAnonymizeUserParticipants_IssuesSystemCommandsForLockedCampaign(t *testing.T) {
+	campaignStore := gametest.NewFakeCampaignStore()
+	participantStore := gametest.NewFakeParticipantStore()
+	eventStore := gametest.NewFakeEventStore()
+
+	locked := gametest.ActiveCampaignRecordWithParticipantCount("c1", 1)
+	locked.ModerationLocked = true
+	campaignStore.Campaigns["c1"] = locked
+	participantStore.Participants["c1"] = map[string]storage.ParticipantRecord{
+		"p1": {ID: "p1", CampaignID: "c1", UserID: "user-1", Name: "Ada", Role: participant.RolePlayer},
+	}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	domain := &fakeDomainEngine{store: eventStore, resultsByType: map[command.Type]engine.Result{
+		command.Type("participant.update"): {Decision: command.Accept(event.Event{
+			CampaignID: "c1", Type: event.Type("participant.updated"), Timestamp: now, ActorType: event.ActorTypeSystem,
+			EntityType: "participant", EntityID: "p1",
+			PayloadJSON: []byte(`{"participant_id":"p1","fields":{"name":"Former participant"}}`),
+		})},
+		command.Type("participant.unbind"): {Decision: command.Accept(event.Event{
+			CampaignID: "c1", Type: event.Type("participant.unbound"), Timestamp: now, ActorType: event.ActorTypeSystem,
+			EntityType: "participant", EntityID: "p1",
+			PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","reason":"account_deleted"}`),
+		})},
+	}}
+	svc := NewService(Deps{
+		Auth:        authz.PolicyDeps{Participant: participantStore},
+		Campaign:    campaignStore,
+		Participant: participantStore,
+		Write:       domainwrite.WritePath{Executor: domain, Runtime: testRuntime},
+		Applier:     projection.Applier{Campaign: campaignStore, Participant: participantStore},
+	})
+
+	// A participant identity on the worker call must not change the actor the
+	// moderation lock checks.
+	ctx := requestctx.WithParticipantID(context.Background(), "p1")
+	if _, err := svc.AnonymizeUserParticipants(ctx, &statev1.AnonymizeUserParticipantsRequest{UserId: "user-1"}); err != nil {
+		t.Fatalf("AnonymizeUserParticipants returned error: %v", err)
+	}
+	if len(domain.commands) != 2 {
+		t.Fatalf("commands = %d, want 2", len(domain.commands))
+	}
+	for _, cmd := range domain.commands {
+		if cmd.ActorType != command.ActorTypeSystem {
+			t.Fatalf("%s actor type = %s, want system", cmd.Type, cmd.ActorType)
+		}
+		if decision, blocked := engine.RejectModerationLockedCommand(campaign.State{ModerationLocked: true}, cmd); blocked {
+			t.Fatalf("%s blocked by moderation lock: %v", cmd.Type, decision.Rejections)
+		}
+	}
+}
@@ -39,29 +39,47 @@ func newSessionCommandExecutor(write domainwrite.WritePath, applier projection.A
 }
 
 func (e sessionCommandExecutor) Execute(ctx context.Context, input sessionCommandExecutionInput) error {
+	cmd, err := buildSessionCommand(ctx, input)
+	if err != nil {
+		return err
+	}
+	_, err = handler.ExecuteAndApplyDomainCommand(ctx, e.write, e.applier, cmd, input.Options)
+	return err
+}
+
+// ExecuteBatch runs several session commands all-or-nothing so multi-command
+// flows never leave a partially applied session in the journal. Per-input
+// Options are ignored in favor of the batch-wide options.
+func (e sessionCommandExecutor) ExecuteBatch(ctx context.Context, options domainwrite.Options, inputs ...sessionCommandExecutionInput) error {
+	cmds := make([]command.Command, 0, len(inputs))
+	for _, input := range inputs {
+		cmd, err := buildSessionCommand(ctx, input)
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, cmd)
+	}
+	_, err := handler.ExecuteAndApplyDomainCommandBatch(ctx, e.write, e.applier, cmds, options)
+	return err
+}
+
+func buildSessionCommand(ctx context.Context, input sessionCommandExecutionInput) (command.Command, error) {
 	payloadJSON, err := json.Marshal(input.Payload)
 	if err != nil {
-		return grpcerror.Internal("encode payload", err)
+		return command.Command{}, grpcerror.Internal("encode payload", err)
 	}
 
 	actorID, actorType := handler.ResolveCommandActor(ctx)
-	_, err = handler.ExecuteAndApplyDomainCommand(
-		ctx,
-		e.write,
-		e.applier,
-		commandbuild.Core(commandbuild.CoreInput{
-			CampaignID:   input.CampaignID,
-			Type:         input.CommandType,
-			ActorType:    actorType,
-			ActorID:      actorID,
-			SessionID:    input.SessionID,
-			RequestID:    grpcmeta.RequestIDFromContext(ctx),
-			InvocationID: grpcmeta.InvocationIDFromContext(ctx),
-			EntityType:   "session",
-			EntityID:     input.SessionID,
-			PayloadJSON:  payloadJSON,
-		}),
-		input.Options,
-	)
-	return err
+	return commandbuild.Core(commandbuild.CoreInput{
+		CampaignID:   input.CampaignID,
+		Type:         input.CommandType,
+		ActorType:    actorType,
+		ActorID:      actorID,
+		SessionID:    input.SessionID,
+		RequestID:    grpcmeta.RequestIDFromContext(ctx),
+		InvocationID: grpcmeta.InvocationIDFromContext(ctx),
+		EntityType:   "session",
+		EntityID:     input.SessionID,
+		PayloadJSON:  payloadJSON,
+	}), nil
 }
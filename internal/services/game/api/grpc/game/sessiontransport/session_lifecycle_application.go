@@ -60,35 +60,34 @@ func (a sessionApplication) StartSession(ctx context.Context, campaignID string,
 		return storage.SessionRecord{}, err
 	}
 
-	payload := session.StartPayload{
-		SessionID:            ids.SessionID(sessionID),
-		SessionName:          sessionName,
-		CharacterControllers: characterControllers,
-	}
-	if err := a.commands.Execute(ctx, sessionCommandExecutionInput{
-		CommandType: commandids.SessionStart,
-		CampaignID:  campaignID,
-		SessionID:   sessionID,
-		Payload:     payload,
-		Options:     domainwrite.RequireEvents("session.start did not emit an event"),
-	}); err != nil {
-		return storage.SessionRecord{}, err
-	}
-
 	defaultAuthority, err := defaultGMAuthorityParticipant(c, participants)
 	if err != nil {
 		return storage.SessionRecord{}, grpcerror.Internal("resolve default gm authority", err)
 	}
-	if err := a.commands.Execute(ctx, sessionCommandExecutionInput{
-		CommandType: command.Type(commandids.SessionGMAuthoritySet),
-		CampaignID:  campaignID,
-		SessionID:   sessionID,
-		Payload: session.GMAuthoritySetPayload{
-			SessionID:     ids.SessionID(sessionID),
-			ParticipantID: ids.ParticipantID(defaultAuthority.ID),
+	// Start and default GM authority land in one journal batch so a failed
+	// authority assignment never leaves an active session without a GM.
+	if err := a.commands.ExecuteBatch(ctx,
+		domainwrite.RequireEvents("session start did not emit an event"),
+		sessionCommandExecutionInput{
+			CommandType: commandids.SessionStart,
+			CampaignID:  campaignID,
+			SessionID:   sessionID,
+			Payload: session.StartPayload{
+				SessionID:            ids.SessionID(sessionID),
+				SessionName:          sessionName,
+				CharacterControllers: characterControllers,
+			},
 		},
-		Options: domainwrite.RequireEvents("session.gm_authority.set did not emit an event"),
-	}); err != nil {
+		sessionCommandExecutionInput{
+			CommandType: command.Type(commandids.SessionGMAuthoritySet),
+			CampaignID:  campaignID,
+			SessionID:   sessionID,
+			Payload: session.GMAuthoritySetPayload{
+				SessionID:     ids.SessionID(sessionID),
+				ParticipantID: ids.ParticipantID(defaultAuthority.ID),
+			},
+		},
+	); err != nil {
 		return storage.SessionRecord{}, err
 	}
 
@@ -228,6 +227,45 @@ func (a sessionApplication) EndSession(ctx context.Context, campaignID string, i
 	return updated, nil
 }
 
+// RecordSessionRecap records GM-reviewed recap markdown for one running or
+// ended session and returns the stored projection.
+func (a sessionApplication) RecordSessionRecap(ctx context.Context, campaignID, sessionID string, in *campaignv1.RecordSessionRecapRequest) (storage.SessionRecap, error) {
+	markdown := strings.TrimSpace(in.GetMarkdown())
+	if markdown == "" {
+		return storage.SessionRecap{}, status.Error(codes.InvalidArgument, "markdown is required")
+	}
+
+	c, err := a.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return storage.SessionRecap{}, err
+	}
+	if err := authz.RequirePolicy(ctx, a.auth, domainauthz.CapabilityManageSessions(), c); err != nil {
+		return storage.SessionRecap{}, err
+	}
+	if _, err := a.stores.Session.GetSession(ctx, campaignID, sessionID); err != nil {
+		return storage.SessionRecap{}, err
+	}
+
+	if err := a.commands.Execute(ctx, sessionCommandExecutionInput{
+		CommandType: commandids.SessionRecapRecord,
+		CampaignID:  campaignID,
+		SessionID:   sessionID,
+		Payload: session.RecapRecordedPayload{
+			SessionID: ids.SessionID(sessionID),
+			Markdown:  markdown,
+		},
+		Options: domainwrite.RequireEvents("session.recap.record did not emit an event"),
+	}); err != nil {
+		return storage.SessionRecap{}, err
+	}
+
+	recap, err := a.stores.SessionRecap.GetSessionRecap(ctx, campaignID, sessionID)
+	if err != nil {
+		return storage.SessionRecap{}, grpcerror.Internal("load session recap", err)
+	}
+	return recap, nil
+}
+
 // resolveSessionStartName ensures session.start commands always persist a usable name.
 func (a sessionApplication) resolveSessionStartName(ctx context.Context, campaignRecord storage.CampaignRecord, campaignID, rawName string) (string, error) {
 	name := strings.TrimSpace(rawName)
@@ -85,6 +85,28 @@ func (s *SessionService) GetSessionRecap(ctx context.Context, in *campaignv1.Get
 	}, nil
 }
 
+// RecordSessionRecap records or replaces the recap markdown for one session.
+func (s *SessionService) RecordSessionRecap(ctx context.Context, in *campaignv1.RecordSessionRecapRequest) (*campaignv1.RecordSessionRecapResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "record session recap request is required")
+	}
+	campaignID, err := validate.RequiredID(in.GetCampaignId(), "campaign id")
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := validate.RequiredID(in.GetSessionId(), "session id")
+	if err != nil {
+		return nil, err
+	}
+	recap, err := newSessionApplication(s).RecordSessionRecap(ctx, campaignID, sessionID, in)
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.RecordSessionRecapResponse{
+		Recap: SessionRecapToProto(recap),
+	}, nil
+}
+
 // EndSession ends a session by campaign ID and session ID.
 func (s *SessionService) EndSession(ctx context.Context, in *campaignv1.EndSessionRequest) (*campaignv1.EndSessionResponse, error) {
 	if in == nil {
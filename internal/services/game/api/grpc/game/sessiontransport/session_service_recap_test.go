@@ -0,0 +1,104 @@
+package sessiontransport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/runtimekit"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRecordSessionRecap_ValidatesRequest(t *testing.T) {
+	svc := NewSessionService(Deps{})
+	_, err := svc.RecordSessionRecap(context.Background(), nil)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.RecordSessionRecap(context.Background(), &statev1.RecordSessionRecapRequest{SessionId: "s1", Markdown: "recap"})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.RecordSessionRecap(context.Background(), &statev1.RecordSessionRecapRequest{CampaignId: "c1", SessionId: "s1", Markdown: "  "})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestRecordSessionRecap_DeniesMemberAccess(t *testing.T) {
+	campaignStore := gametest.NewFakeCampaignStore()
+	sessionStore := gametest.NewFakeSessionStore()
+	participantStore := gametest.NewFakeParticipantStore()
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	participantStore.Participants["c1"] = map[string]storage.ParticipantRecord{
+		"member-1": {ID: "member-1", CampaignID: "c1", CampaignAccess: participant.CampaignAccessMember},
+	}
+
+	svc := NewSessionService(Deps{Campaign: campaignStore, Session: sessionStore, Participant: participantStore})
+	_, err := svc.RecordSessionRecap(requestctx.WithParticipantID(context.Background(), "member-1"), &statev1.RecordSessionRecapRequest{
+		CampaignId: "c1",
+		SessionId:  "s1",
+		Markdown:   "recap",
+	})
+	assertStatusCode(t, err, codes.PermissionDenied)
+}
+
+func TestRecordSessionRecap_RecordsEndedSession(t *testing.T) {
+	campaignStore := gametest.NewFakeCampaignStore()
+	sessionStore := gametest.NewFakeSessionStore()
+	recapStore := gametest.NewFakeSessionRecapStore()
+	eventStore := gametest.NewFakeEventStore()
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	endedAt := now.Add(-time.Hour)
+
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	sessionStore.Sessions["c1"] = map[string]storage.SessionRecord{
+		"s1": {ID: "s1", CampaignID: "c1", Status: session.StatusEnded, StartedAt: now.Add(-2 * time.Hour), EndedAt: &endedAt},
+	}
+	domain := &fakeDomainEngine{store: eventStore, result: engine.Result{
+		Decision: command.Accept(event.Event{
+			CampaignID:  "c1",
+			Type:        session.EventTypeRecapRecorded,
+			Timestamp:   now,
+			ActorType:   event.ActorTypeParticipant,
+			ActorID:     "manager-1",
+			SessionID:   "s1",
+			EntityType:  "session",
+			EntityID:    "s1",
+			PayloadJSON: []byte(`{"session_id":"s1","markdown":"The party escaped."}`),
+		}),
+	}}
+
+	svc := newTestSessionService(
+		Deps{
+			Campaign:     campaignStore,
+			Session:      sessionStore,
+			SessionRecap: recapStore,
+			Participant:  sessionManagerParticipantStore("c1"),
+			Write:        domainwrite.WritePath{Executor: domain, Runtime: testRuntime},
+		},
+		runtimekit.FixedClock(now),
+		nil,
+	)
+
+	resp, err := svc.RecordSessionRecap(requestctx.WithParticipantID(context.Background(), "manager-1"), &statev1.RecordSessionRecapRequest{
+		CampaignId: "c1",
+		SessionId:  "s1",
+		Markdown:   "  The party escaped.  ",
+	})
+	if err != nil {
+		t.Fatalf("RecordSessionRecap returned error: %v", err)
+	}
+	if domain.lastCommand.Type != command.Type("session.recap.record") {
+		t.Fatalf("command type = %s, want session.recap.record", domain.lastCommand.Type)
+	}
+	if got := resp.GetRecap().GetMarkdown(); got != "The party escaped." {
+		t.Fatalf("recap markdown = %q", got)
+	}
+}
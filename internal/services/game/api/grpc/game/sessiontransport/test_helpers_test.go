@@ -57,6 +57,7 @@ func testApplier(deps Deps) projection.Applier {
 	return projection.Applier{
 		Campaign:           deps.Campaign,
 		Session:            deps.Session,
+		SessionRecap:       deps.SessionRecap,
 		SessionGate:        deps.SessionGate,
 		SessionSpotlight:   deps.SessionSpotlight,
 		SessionInteraction: deps.SessionInteraction,
@@ -118,6 +119,24 @@ func (f *fakeDomainEngine) Execute(ctx context.Context, cmd command.Command) (en
 	return result, nil
 }
 
+// ExecuteBatch runs each command through Execute; the fake journal has no
+// transaction to roll back, so atomicity is covered by engine tests.
+func (f *fakeDomainEngine) ExecuteBatch(ctx context.Context, cmds []command.Command) (engine.BatchResult, error) {
+	result := engine.BatchResult{}
+	for _, cmd := range cmds {
+		single, err := f.Execute(ctx, cmd)
+		if err != nil {
+			return engine.BatchResult{}, err
+		}
+		result.Decisions = append(result.Decisions, single.Decision)
+		result.State = single.State
+		if len(single.Decision.Rejections) > 0 {
+			break
+		}
+	}
+	return result, nil
+}
+
 func testWritePath(executor domainwrite.Executor) domainwrite.WritePath {
 	return domainwrite.WritePath{Executor: executor, Runtime: testRuntime}
 }
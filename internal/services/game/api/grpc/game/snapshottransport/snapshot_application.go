@@ -3,6 +3,7 @@ package snapshottransport
 import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
 	"github.com/louisbranch/fracturing.space/internal/services/game/projection"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
@@ -16,6 +17,11 @@ type Deps struct {
 	Daggerheart projectionstore.Store
 	Write       domainwrite.WritePath
 	Applier     projection.Applier
+	// History serves as_of snapshot reads and event corrections; nil disables
+	// them.
+	History *historyread.Reader
+	// Event resolves corrected events and the journal head.
+	Event storage.EventReadStore
 }
 
 // snapshotApplication coordinates snapshot transport use-cases across focused
@@ -26,6 +32,8 @@ type snapshotApplication struct {
 	stores  snapshotApplicationStores
 	write   domainwrite.WritePath
 	applier projection.Applier
+	history *historyread.Reader
+	events  storage.EventReadStore
 }
 
 type snapshotApplicationStores struct {
@@ -51,5 +59,7 @@ func newSnapshotApplicationWithDependencies(deps Deps) snapshotApplication {
 		},
 		write:   deps.Write,
 		applier: deps.Applier,
+		history: deps.History,
+		events:  deps.Event,
 	}
 }
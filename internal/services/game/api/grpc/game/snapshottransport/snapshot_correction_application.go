@@ -0,0 +1,310 @@
+package snapshottransport
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/handler"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/commandbuild"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	domainauthz "github.com/louisbranch/fracturing.space/internal/services/game/domain/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/commandids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	daggerheart "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	daggerheartpayload "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/payload"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	daggerheartstate "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// correctionSeqWindow bounds how far behind the journal head a correctable
+	// event may be. Older mistakes need an explicit state patch instead.
+	correctionSeqWindow = 100
+
+	// correctionSource tags compensating payloads written by CorrectEvent.
+	correctionSource = "gm.correction"
+)
+
+// correctionTarget is the state slice one compensating command restores.
+type correctionTarget struct {
+	commandType command.Type
+	entityType  string
+	entityID    string
+	payload     any
+}
+
+// CorrectEvent restores the Daggerheart state changed by the event at seq.
+//
+// The pre-event, post-event, and current states all come from point-in-time
+// replays, the current one at the journal head observed here, so a lagging
+// projection cannot make a stale value look current. Each field the event
+// changed must still hold the value the event wrote; otherwise a later event
+// already moved it and the correction is refused rather than silently
+// overwriting newer play.
+//
+// The correction is written as an ordinary GM Fear set or character state
+// patch rather than a dedicated correction event type: those events already
+// carry the exact state replacement, so replay, projections, and every
+// existing reader handle a correction without new fold logic. The causation ID
+// naming the corrected seq, the correction source, and the GM's reason are
+// what mark it as compensating. The commands must land on the journal head
+// observed here.
+func (a snapshotApplication) CorrectEvent(ctx context.Context, campaignID string, seq uint64, reason string) (snapshotReadState, []uint64, error) {
+	c, err := a.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return snapshotReadState{}, nil, err
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpCampaignMutate); err != nil {
+		return snapshotReadState{}, nil, err
+	}
+	if err := authz.RequirePolicy(ctx, a.auth, domainauthz.CapabilityManageSessions(), c); err != nil {
+		return snapshotReadState{}, nil, err
+	}
+	if a.events == nil {
+		return snapshotReadState{}, nil, status.Error(codes.Internal, "event store is not configured")
+	}
+
+	latestSeq, err := a.events.GetLatestEventSeq(ctx, campaignID)
+	if err != nil {
+		return snapshotReadState{}, nil, grpcerror.Internal("get latest event seq", err)
+	}
+	if seq > latestSeq {
+		return snapshotReadState{}, nil, status.Errorf(codes.NotFound, "event %d not found", seq)
+	}
+	if latestSeq-seq >= correctionSeqWindow {
+		return snapshotReadState{}, nil, status.Errorf(codes.FailedPrecondition, "event %d is outside the correction window of %d events", seq, correctionSeqWindow)
+	}
+	target, err := a.events.GetEventBySeq(ctx, campaignID, seq)
+	if err != nil {
+		return snapshotReadState{}, nil, grpcerror.LookupErrorContext(ctx, err, "get event", "event not found")
+	}
+
+	var correction correctionTarget
+	switch {
+	case target.Type == daggerheartpayload.EventTypeGMFearChanged:
+		correction, err = a.gmFearCorrection(ctx, campaignID, seq, latestSeq, reason)
+	case target.SystemID == daggerheart.SystemID && target.EntityType == "character":
+		correction, err = a.characterStateCorrection(ctx, campaignID, seq, latestSeq, target.EntityID, reason)
+	default:
+		return snapshotReadState{}, nil, status.Errorf(codes.FailedPrecondition, "event type %s cannot be corrected", target.Type)
+	}
+	if err != nil {
+		return snapshotReadState{}, nil, err
+	}
+
+	result, err := a.executeCorrection(domainwrite.WithExpectedSeq(ctx, latestSeq), campaignID, seq, correction)
+	if err != nil {
+		return snapshotReadState{}, nil, err
+	}
+	correctionSeqs := make([]uint64, 0, len(result.Decision.Events))
+	for _, evt := range result.Decision.Events {
+		correctionSeqs = append(correctionSeqs, evt.Seq)
+	}
+	readState, err := readSnapshotState(ctx, campaignID, a.stores.Character, a.stores.Daggerheart)
+	if err != nil {
+		return snapshotReadState{}, nil, err
+	}
+	return readState, correctionSeqs, nil
+}
+
+// gmFearCorrection sets GM Fear back to its value before seq.
+func (a snapshotApplication) gmFearCorrection(ctx context.Context, campaignID string, seq, latestSeq uint64, reason string) (correctionTarget, error) {
+	var before, after, current int
+	if err := a.readHistoricalSnapshot(ctx, campaignID, seq-1, &before); err != nil {
+		return correctionTarget{}, err
+	}
+	if err := a.readHistoricalSnapshot(ctx, campaignID, seq, &after); err != nil {
+		return correctionTarget{}, err
+	}
+	if err := a.readHistoricalSnapshot(ctx, campaignID, latestSeq, &current); err != nil {
+		return correctionTarget{}, err
+	}
+	if before == after {
+		return correctionTarget{}, status.Error(codes.FailedPrecondition, "event changed no correctable state")
+	}
+	if current != after {
+		return correctionTarget{}, status.Error(codes.FailedPrecondition, "gm fear changed after the corrected event")
+	}
+	return correctionTarget{
+		commandType: commandids.DaggerheartGMFearSet,
+		entityType:  "campaign",
+		entityID:    campaignID,
+		payload:     daggerheartpayload.GMFearSetPayload{After: &before, Reason: correctionReason(reason)},
+	}, nil
+}
+
+// correctionReason returns the GM's reason, falling back to the correction
+// source so every compensating payload says why it was written.
+func correctionReason(reason string) string {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return correctionSource
+	}
+	return reason
+}
+
+// characterStateCorrection patches every character resource the event at seq
+// changed back to its pre-event value.
+func (a snapshotApplication) characterStateCorrection(ctx context.Context, campaignID string, seq, latestSeq uint64, characterID, reason string) (correctionTarget, error) {
+	var before, after, current projectionstore.DaggerheartCharacterState
+	if err := a.readHistoricalCharacterState(ctx, campaignID, seq-1, characterID, &before); err != nil {
+		return correctionTarget{}, err
+	}
+	if err := a.readHistoricalCharacterState(ctx, campaignID, seq, characterID, &after); err != nil {
+		return correctionTarget{}, err
+	}
+	if err := a.readHistoricalCharacterState(ctx, campaignID, latestSeq, characterID, &current); err != nil {
+		return correctionTarget{}, err
+	}
+	payload, err := characterCorrectionPayload(characterID, reason, before, after, current)
+	if err != nil {
+		return correctionTarget{}, err
+	}
+	return correctionTarget{
+		commandType: commandids.DaggerheartCharacterStatePatch,
+		entityType:  "character",
+		entityID:    characterID,
+		payload:     payload,
+	}, nil
+}
+
+// characterCorrectionPayload builds a patch from current back to before for
+// each field that differs between before and after.
+func characterCorrectionPayload(characterID, reason string, before, after, current projectionstore.DaggerheartCharacterState) (daggerheartpayload.CharacterStatePatchPayload, error) {
+	payload := daggerheartpayload.CharacterStatePatchPayload{
+		CharacterID: ids.CharacterID(characterID),
+		Source:      correctionSource,
+		Reason:      correctionReason(reason),
+	}
+	fields := []struct {
+		name                  string
+		before, after, actual int
+		patchBefore           **int
+		patchAfter            **int
+	}{
+		{"hp", before.Hp, after.Hp, current.Hp, &payload.HPBefore, &payload.HPAfter},
+		{"hope", before.Hope, after.Hope, current.Hope, &payload.HopeBefore, &payload.HopeAfter},
+		{"hope_max", before.HopeMax, after.HopeMax, current.HopeMax, &payload.HopeMaxBefore, &payload.HopeMaxAfter},
+		{"stress", before.Stress, after.Stress, current.Stress, &payload.StressBefore, &payload.StressAfter},
+		{"armor", before.Armor, after.Armor, current.Armor, &payload.ArmorBefore, &payload.ArmorAfter},
+	}
+	changed := false
+	for _, field := range fields {
+		if field.before == field.after {
+			continue
+		}
+		if field.actual != field.after {
+			return daggerheartpayload.CharacterStatePatchPayload{}, status.Errorf(codes.FailedPrecondition, "character %s changed after the corrected event", field.name)
+		}
+		actual, restored := field.actual, field.before
+		*field.patchBefore = &actual
+		*field.patchAfter = &restored
+		changed = true
+	}
+	lifeStateBefore := correctionLifeState(before.LifeState)
+	lifeStateAfter := correctionLifeState(after.LifeState)
+	if lifeStateBefore != lifeStateAfter {
+		lifeStateCurrent := correctionLifeState(current.LifeState)
+		if lifeStateCurrent != lifeStateAfter {
+			return daggerheartpayload.CharacterStatePatchPayload{}, status.Error(codes.FailedPrecondition, "character life_state changed after the corrected event")
+		}
+		payload.LifeStateBefore = &lifeStateCurrent
+		payload.LifeStateAfter = &lifeStateBefore
+		changed = true
+	}
+	if !changed {
+		return daggerheartpayload.CharacterStatePatchPayload{}, status.Error(codes.FailedPrecondition, "event changed no correctable character state")
+	}
+	return payload, nil
+}
+
+// correctionLifeState treats an unset projection life state as alive, matching
+// how state patches describe it.
+func correctionLifeState(value string) string {
+	if value == "" {
+		return daggerheartstate.LifeStateAlive
+	}
+	return value
+}
+
+func (a snapshotApplication) readHistoricalSnapshot(ctx context.Context, campaignID string, seq uint64, gmFear *int) error {
+	*gmFear = daggerheartstate.GMFearDefault
+	if seq == 0 {
+		return nil
+	}
+	return a.history.Read(ctx, campaignID, historyread.Point{Seq: seq}, func(view historyread.View) error {
+		snapshot, err := view.System.Daggerheart.GetDaggerheartSnapshot(ctx, campaignID)
+		if err != nil {
+			return grpcerror.OptionalLookupErrorContext(ctx, err, "get historical daggerheart snapshot")
+		}
+		*gmFear = snapshot.GMFear
+		return nil
+	})
+}
+
+func (a snapshotApplication) readHistoricalCharacterState(ctx context.Context, campaignID string, seq uint64, characterID string, state *projectionstore.DaggerheartCharacterState) error {
+	if seq == 0 {
+		return status.Error(codes.FailedPrecondition, "character state did not exist before the corrected event")
+	}
+	return a.history.Read(ctx, campaignID, historyread.Point{Seq: seq}, func(view historyread.View) error {
+		loaded, err := view.System.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+		if err != nil {
+			if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get historical daggerheart character state"); lookupErr != nil {
+				return lookupErr
+			}
+			return status.Errorf(codes.FailedPrecondition, "character state does not exist at event %d", seq)
+		}
+		*state = loaded
+		return nil
+	})
+}
+
+func (a snapshotApplication) executeCorrection(ctx context.Context, campaignID string, correctedSeq uint64, correction correctionTarget) (engine.Result, error) {
+	payloadJSON, err := json.Marshal(correction.payload)
+	if err != nil {
+		return engine.Result{}, grpcerror.Internal("encode payload", err)
+	}
+	actorID := strings.TrimSpace(grpcmeta.ParticipantIDFromContext(ctx))
+	actorType := command.ActorTypeSystem
+	if actorID != "" {
+		actorType = command.ActorTypeGM
+	}
+	return handler.ExecuteAndApplyDomainCommand(
+		ctx,
+		a.write,
+		a.applier,
+		commandbuild.System(commandbuild.SystemInput{
+			CoreInput: commandbuild.CoreInput{
+				CampaignID:   campaignID,
+				Type:         correction.commandType,
+				ActorType:    actorType,
+				ActorID:      actorID,
+				SessionID:    grpcmeta.SessionIDFromContext(ctx),
+				RequestID:    grpcmeta.RequestIDFromContext(ctx),
+				InvocationID: grpcmeta.InvocationIDFromContext(ctx),
+				CausationID:  event.CorrectionCausationID(correctedSeq),
+				EntityType:   correction.entityType,
+				EntityID:     correction.entityID,
+				PayloadJSON:  payloadJSON,
+			},
+			SystemID:      daggerheart.SystemID,
+			SystemVersion: daggerheart.SystemVersion,
+		}),
+		domainwrite.Options{
+			RequireEvents:   true,
+			MissingEventMsg: "event correction did not emit an event",
+			ApplyErr:        handler.ApplyErrorWithCodePreserve("apply event"),
+		},
+	)
+}
@@ -5,16 +5,22 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 )
 
 type snapshotReadState struct {
 	systemState     projectionstore.DaggerheartSnapshot
 	characterStates []projectionstore.DaggerheartCharacterState
+	// asOfSeq is the replayed event seq for point-in-time reads.
+	asOfSeq uint64
 }
 
-func (a snapshotApplication) GetSnapshot(ctx context.Context, campaignID string) (snapshotReadState, error) {
+// GetSnapshot authorizes against the current campaign and then reads either
+// the live projection or a historical replay selected by point.
+func (a snapshotApplication) GetSnapshot(ctx context.Context, campaignID string, point historyread.Point) (snapshotReadState, error) {
 	campaignRecord, err := a.stores.Campaign.Get(ctx, campaignID)
 	if err != nil {
 		return snapshotReadState{}, err
@@ -26,7 +32,29 @@ func (a snapshotApplication) GetSnapshot(ctx context.Context, campaignID string)
 		return snapshotReadState{}, err
 	}
 
-	systemState, err := a.stores.Daggerheart.GetDaggerheartSnapshot(ctx, campaignID)
+	if point.IsCurrent() {
+		return readSnapshotState(ctx, campaignID, a.stores.Character, a.stores.Daggerheart)
+	}
+	var readState snapshotReadState
+	err = a.history.Read(ctx, campaignID, point, func(view historyread.View) error {
+		state, err := readSnapshotState(ctx, campaignID, view.Stores, view.System.Daggerheart)
+		if err != nil {
+			return err
+		}
+		state.asOfSeq = view.Seq
+		readState = state
+		return nil
+	})
+	return readState, err
+}
+
+func readSnapshotState(
+	ctx context.Context,
+	campaignID string,
+	characters storage.CharacterStore,
+	daggerheart projectionstore.Store,
+) (snapshotReadState, error) {
+	systemState, err := daggerheart.GetDaggerheartSnapshot(ctx, campaignID)
 	if err != nil {
 		if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "get daggerheart snapshot"); lookupErr != nil {
 			return snapshotReadState{}, lookupErr
@@ -34,14 +62,14 @@ func (a snapshotApplication) GetSnapshot(ctx context.Context, campaignID string)
 		systemState = projectionstore.DaggerheartSnapshot{CampaignID: campaignID}
 	}
 
-	charPage, err := a.stores.Character.ListCharacters(ctx, campaignID, 100, "")
+	charPage, err := characters.ListCharacters(ctx, campaignID, 100, "")
 	if err != nil {
 		return snapshotReadState{}, grpcerror.Internal("list characters", err)
 	}
 
 	characterStates := make([]projectionstore.DaggerheartCharacterState, 0, len(charPage.Characters))
 	for _, record := range charPage.Characters {
-		state, err := a.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, record.ID)
+		state, err := daggerheart.GetDaggerheartCharacterState(ctx, campaignID, record.ID)
 		if err != nil {
 			if grpcerror.OptionalLookupErrorContext(ctx, err, "get daggerheart character state") == nil {
 				continue
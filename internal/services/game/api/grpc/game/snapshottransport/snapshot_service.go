@@ -7,6 +7,7 @@ import (
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/charactertransport"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -24,7 +25,8 @@ func NewService(deps Deps) *Service {
 	}
 }
 
-// GetSnapshot returns the snapshot projection for a campaign.
+// GetSnapshot returns the snapshot projection for a campaign, optionally as of
+// an earlier event seq or the end of an ended session.
 func (s *Service) GetSnapshot(ctx context.Context, in *campaignv1.GetSnapshotRequest) (*campaignv1.GetSnapshotResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "get snapshot request is required")
@@ -35,28 +37,37 @@ func (s *Service) GetSnapshot(ctx context.Context, in *campaignv1.GetSnapshotReq
 		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
 	}
 
-	readState, err := s.app.GetSnapshot(ctx, campaignID)
+	point, err := historyread.NewPoint(in.GetAsOfSeq(), in.GetAsOfSessionId())
 	if err != nil {
 		return nil, err
 	}
 
+	readState, err := s.app.GetSnapshot(ctx, campaignID, point)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaignv1.GetSnapshotResponse{
+		Snapshot: snapshotToProto(campaignID, readState),
+		AsOfSeq:  readState.asOfSeq,
+	}, nil
+}
+
+func snapshotToProto(campaignID string, readState snapshotReadState) *campaignv1.Snapshot {
 	characterStates := make([]*campaignv1.CharacterState, 0, len(readState.characterStates))
 	for _, state := range readState.characterStates {
 		characterStates = append(characterStates, charactertransport.DaggerheartStateToProto(campaignID, state.CharacterID, state))
 	}
-
-	return &campaignv1.GetSnapshotResponse{
-		Snapshot: &campaignv1.Snapshot{
-			CampaignId:      campaignID,
-			CharacterStates: characterStates,
-			SystemSnapshot: &campaignv1.Snapshot_Daggerheart{
-				Daggerheart: &daggerheartv1.DaggerheartSnapshot{
-					GmFear:                int32(readState.systemState.GMFear),
-					ConsecutiveShortRests: int32(readState.systemState.ConsecutiveShortRests),
-				},
+	return &campaignv1.Snapshot{
+		CampaignId:      campaignID,
+		CharacterStates: characterStates,
+		SystemSnapshot: &campaignv1.Snapshot_Daggerheart{
+			Daggerheart: &daggerheartv1.DaggerheartSnapshot{
+				GmFear:                int32(readState.systemState.GMFear),
+				ConsecutiveShortRests: int32(readState.systemState.ConsecutiveShortRests),
 			},
 		},
-	}, nil
+	}
 }
 
 // PatchCharacterState patches a character's state (system-specific state like HP, Hope, Stress).
@@ -108,3 +119,29 @@ func (s *Service) UpdateSnapshotState(ctx context.Context, in *campaignv1.Update
 		},
 	}, nil
 }
+
+// CorrectEvent emits compensating events that restore the state changed by a
+// recent event and returns the resulting snapshot.
+func (s *Service) CorrectEvent(ctx context.Context, in *campaignv1.CorrectEventRequest) (*campaignv1.CorrectEventResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "correct event request is required")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	if in.GetSeq() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "seq is required")
+	}
+
+	readState, correctionSeqs, err := s.app.CorrectEvent(ctx, campaignID, in.GetSeq(), in.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaignv1.CorrectEventResponse{
+		Snapshot:       snapshotToProto(campaignID, readState),
+		CorrectionSeqs: correctionSeqs,
+	}, nil
+}
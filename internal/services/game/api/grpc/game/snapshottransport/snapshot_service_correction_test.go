@@ -0,0 +1,210 @@
+package snapshottransport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	daggerhearttestkit "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/testkit"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	daggerheartpayload "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/payload"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"google.golang.org/grpc/codes"
+)
+
+func correctionTestEvents() *gametest.FakeEventStore {
+	events := gametest.NewFakeEventStore()
+	events.Events["c1"] = []event.Event{
+		campaignCreatedEvent("c1", 1),
+		gmFearChangedEvent("c1", 2, 2),
+		gmFearChangedEvent("c1", 3, 5),
+	}
+	events.NextSeq["c1"] = 4
+	return events
+}
+
+func correctionTestService(events *gametest.FakeEventStore, dhStore *daggerhearttestkit.FakeDaggerheartStore, domain *fakeDomainEngine) *Service {
+	campaignStore := gametest.NewFakeCampaignStore()
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	return NewService(Deps{
+		Campaign:    campaignStore,
+		Character:   gametest.NewFakeCharacterStore(),
+		Daggerheart: dhStore,
+		Write:       domainwrite.WritePath{Executor: domain, Runtime: testRuntime},
+		Applier:     testApplier(dhStore),
+		Event:       events,
+		History: historyread.NewReader(historyread.Config{
+			Events:      events,
+			OpenScratch: openMemoryScratch,
+		}),
+	})
+}
+
+func TestCorrectEvent_RestoresGMFearWithCausationLink(t *testing.T) {
+	events := correctionTestEvents()
+	dhStore := daggerhearttestkit.NewFakeDaggerheartStore()
+	dhStore.Snapshots["c1"] = projectionstore.DaggerheartSnapshot{CampaignID: "c1", GMFear: 5}
+	payloadJSON, err := json.Marshal(daggerheartpayload.GMFearChangedPayload{Value: 2, Reason: "misread dice"})
+	if err != nil {
+		t.Fatalf("encode payload: %v", err)
+	}
+	domain := &fakeDomainEngine{store: events, result: engine.Result{
+		Decision: command.Accept(event.Event{
+			CampaignID:    "c1",
+			Type:          daggerheartpayload.EventTypeGMFearChanged,
+			Timestamp:     time.Date(2026, 1, 10, 13, 0, 0, 0, time.UTC),
+			ActorType:     event.ActorTypeSystem,
+			EntityType:    "campaign",
+			EntityID:      "c1",
+			SystemID:      daggerheart.SystemID,
+			SystemVersion: daggerheart.SystemVersion,
+			CausationID:   event.CorrectionCausationID(3),
+			PayloadJSON:   payloadJSON,
+		}),
+	}}
+	svc := correctionTestService(events, dhStore, domain)
+
+	resp, err := svc.CorrectEvent(requestctx.WithAdminOverride(context.Background(), "correction-test"), &statev1.CorrectEventRequest{
+		CampaignId: "c1",
+		Seq:        3,
+		Reason:     "misread dice",
+	})
+	if err != nil {
+		t.Fatalf("CorrectEvent returned error: %v", err)
+	}
+	if got := resp.GetSnapshot().GetDaggerheart().GetGmFear(); got != 2 {
+		t.Fatalf("GmFear = %d, want 2", got)
+	}
+	if len(resp.GetCorrectionSeqs()) != 1 || resp.GetCorrectionSeqs()[0] != 4 {
+		t.Fatalf("correction seqs = %v, want [4]", resp.GetCorrectionSeqs())
+	}
+
+	cmd := domain.lastCommand
+	if cmd.Type != command.Type("sys.daggerheart.gm_fear.set") {
+		t.Fatalf("command type = %s, want gm_fear.set", cmd.Type)
+	}
+	if cmd.CausationID != event.CorrectionCausationID(3) {
+		t.Fatalf("causation id = %q, want correction link to seq 3", cmd.CausationID)
+	}
+	if cmd.ExpectedSeq != 3 {
+		t.Fatalf("expected seq = %d, want journal head 3", cmd.ExpectedSeq)
+	}
+	var payload daggerheartpayload.GMFearSetPayload
+	if err := json.Unmarshal(cmd.PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.After == nil || *payload.After != 2 || payload.Reason != "misread dice" {
+		t.Fatalf("payload = %+v, want after 2 with reason", payload)
+	}
+}
+
+func TestCorrectEvent_RejectsSupersededState(t *testing.T) {
+	dhStore := daggerhearttestkit.NewFakeDaggerheartStore()
+	dhStore.Snapshots["c1"] = projectionstore.DaggerheartSnapshot{CampaignID: "c1", GMFear: 5}
+	domain := &fakeDomainEngine{}
+	svc := correctionTestService(correctionTestEvents(), dhStore, domain)
+
+	// Seq 2 set fear to 2, but seq 3 has since moved it to 5.
+	_, err := svc.CorrectEvent(requestctx.WithAdminOverride(context.Background(), "correction-test"), &statev1.CorrectEventRequest{CampaignId: "c1", Seq: 2})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+	if domain.calls != 0 {
+		t.Fatalf("domain calls = %d, want none", domain.calls)
+	}
+}
+
+func TestCorrectEvent_ReadsCurrentStateFromJournalNotProjection(t *testing.T) {
+	dhStore := daggerhearttestkit.NewFakeDaggerheartStore()
+	// A lagging projection still shows the fear seq 2 wrote, but seq 3 has
+	// already moved it in the journal.
+	dhStore.Snapshots["c1"] = projectionstore.DaggerheartSnapshot{CampaignID: "c1", GMFear: 2}
+	domain := &fakeDomainEngine{}
+	svc := correctionTestService(correctionTestEvents(), dhStore, domain)
+
+	_, err := svc.CorrectEvent(requestctx.WithAdminOverride(context.Background(), "correction-test"), &statev1.CorrectEventRequest{CampaignId: "c1", Seq: 2})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+	if domain.calls != 0 {
+		t.Fatalf("domain calls = %d, want none", domain.calls)
+	}
+}
+
+func TestCorrectEvent_RejectsUnsupportedEventsAndOldSeqs(t *testing.T) {
+	events := correctionTestEvents()
+	svc := correctionTestService(events, daggerhearttestkit.NewFakeDaggerheartStore(), &fakeDomainEngine{})
+	ctx := requestctx.WithAdminOverride(context.Background(), "correction-test")
+
+	_, err := svc.CorrectEvent(ctx, &statev1.CorrectEventRequest{CampaignId: "c1", Seq: 1})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	_, err = svc.CorrectEvent(ctx, &statev1.CorrectEventRequest{CampaignId: "c1", Seq: 9})
+	assertStatusCode(t, err, codes.NotFound)
+
+	_, err = svc.CorrectEvent(ctx, &statev1.CorrectEventRequest{CampaignId: "c1"})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	events.NextSeq["c1"] = 4 + correctionSeqWindow
+	_, err = svc.CorrectEvent(ctx, &statev1.CorrectEventRequest{CampaignId: "c1", Seq: 3})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestCorrectEvent_RequiresManageSessionsPolicy(t *testing.T) {
+	campaignStore := gametest.NewFakeCampaignStore()
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	svc := NewService(Deps{
+		Auth:        authz.PolicyDeps{Participant: gametest.NewFakeParticipantStore()},
+		Campaign:    campaignStore,
+		Daggerheart: daggerhearttestkit.NewFakeDaggerheartStore(),
+		Event:       correctionTestEvents(),
+	})
+
+	_, err := svc.CorrectEvent(context.Background(), &statev1.CorrectEventRequest{CampaignId: "c1", Seq: 3})
+	assertStatusCode(t, err, codes.PermissionDenied)
+}
+
+func TestCharacterCorrectionPayload_RestoresOnlyChangedFields(t *testing.T) {
+	before := projectionstore.DaggerheartCharacterState{Hp: 6, Hope: 2, Stress: 1, Armor: 2}
+	after := projectionstore.DaggerheartCharacterState{Hp: 3, Hope: 2, Stress: 2, Armor: 2, LifeState: "unconscious"}
+	current := projectionstore.DaggerheartCharacterState{Hp: 3, Hope: 4, Stress: 2, Armor: 2, LifeState: "unconscious"}
+
+	payload, err := characterCorrectionPayload("ch1", "misread dice", before, after, current)
+	if err != nil {
+		t.Fatalf("characterCorrectionPayload: %v", err)
+	}
+	if payload.CharacterID != ids.CharacterID("ch1") || payload.Source != correctionSource || payload.Reason != "misread dice" {
+		t.Fatalf("payload = %+v, want ch1 correction with reason", payload)
+	}
+	if payload.HPBefore == nil || *payload.HPBefore != 3 || payload.HPAfter == nil || *payload.HPAfter != 6 {
+		t.Fatalf("hp = %v -> %v, want 3 -> 6", payload.HPBefore, payload.HPAfter)
+	}
+	if payload.StressAfter == nil || *payload.StressAfter != 1 {
+		t.Fatalf("stress after = %v, want 1", payload.StressAfter)
+	}
+	if payload.LifeStateAfter == nil || *payload.LifeStateAfter != "alive" {
+		t.Fatalf("life state after = %v, want alive", payload.LifeStateAfter)
+	}
+	if payload.HopeAfter != nil || payload.ArmorAfter != nil {
+		t.Fatalf("payload = %+v, want untouched hope and armor", payload)
+	}
+
+	if payload, err := characterCorrectionPayload("ch1", "", before, after, current); err != nil || payload.Reason != correctionSource {
+		t.Fatalf("payload reason = %q, %v, want correction source fallback", payload.Reason, err)
+	}
+
+	current.Hp = 1
+	if _, err := characterCorrectionPayload("ch1", "misread dice", before, after, current); err == nil {
+		t.Fatal("expected superseded hp to be rejected")
+	}
+	if _, err := characterCorrectionPayload("ch1", "misread dice", before, before, before); err == nil {
+		t.Fatal("expected no-op correction to be rejected")
+	}
+}
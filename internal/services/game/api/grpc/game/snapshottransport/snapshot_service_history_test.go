@@ -0,0 +1,116 @@
+package snapshottransport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
+	daggerhearttestkit "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/testkit"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	daggerheartpayload "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/payload"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/coreprojection"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetSnapshot_AsOfSeqReplaysHistoricalState(t *testing.T) {
+	campaignStore := gametest.NewFakeCampaignStore()
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	dhStore := daggerhearttestkit.NewFakeDaggerheartStore()
+	dhStore.Snapshots["c1"] = projectionstore.DaggerheartSnapshot{CampaignID: "c1", GMFear: 5}
+
+	events := gametest.NewFakeEventStore()
+	events.Events["c1"] = []event.Event{
+		campaignCreatedEvent("c1", 1),
+		gmFearChangedEvent("c1", 2, 2),
+		gmFearChangedEvent("c1", 3, 5),
+	}
+	events.NextSeq["c1"] = 4
+
+	svc := NewService(Deps{
+		Campaign:    campaignStore,
+		Daggerheart: dhStore,
+		Character:   gametest.NewFakeCharacterStore(),
+		History: historyread.NewReader(historyread.Config{
+			Events:      events,
+			OpenScratch: openMemoryScratch,
+		}),
+	})
+
+	resp, err := svc.GetSnapshot(requestctx.WithAdminOverride(context.Background(), "snapshot-test"), &statev1.GetSnapshotRequest{CampaignId: "c1", AsOfSeq: 2})
+	if err != nil {
+		t.Fatalf("GetSnapshot returned error: %v", err)
+	}
+	if got := resp.GetSnapshot().GetDaggerheart().GetGmFear(); got != 2 {
+		t.Fatalf("GmFear = %d, want 2", got)
+	}
+	if resp.GetAsOfSeq() != 2 {
+		t.Fatalf("AsOfSeq = %d, want 2", resp.GetAsOfSeq())
+	}
+}
+
+func TestGetSnapshot_AsOfRejectsInvalidPoints(t *testing.T) {
+	campaignStore := gametest.NewFakeCampaignStore()
+	campaignStore.Campaigns["c1"] = gametest.ActiveCampaignRecord("c1")
+	svc := NewService(Deps{
+		Campaign:    campaignStore,
+		Daggerheart: daggerhearttestkit.NewFakeDaggerheartStore(),
+		Character:   gametest.NewFakeCharacterStore(),
+	})
+	ctx := requestctx.WithAdminOverride(context.Background(), "snapshot-test")
+
+	_, err := svc.GetSnapshot(ctx, &statev1.GetSnapshotRequest{CampaignId: "c1", AsOfSeq: 1, AsOfSessionId: "s1"})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.GetSnapshot(ctx, &statev1.GetSnapshotRequest{CampaignId: "c1", AsOfSeq: 1})
+	assertStatusCode(t, err, codes.Unimplemented)
+}
+
+func openMemoryScratch() (historyread.Scratch, error) {
+	store, err := coreprojection.OpenMemory()
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func campaignCreatedEvent(campaignID string, seq uint64) event.Event {
+	data, _ := json.Marshal(campaign.CreatePayload{
+		Name:       "Test Campaign",
+		GameSystem: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
+		GmMode:     "GM_MODE_HUMAN",
+	})
+	return event.Event{
+		CampaignID:  ids.CampaignID(campaignID),
+		Seq:         seq,
+		Timestamp:   time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC),
+		Type:        campaign.EventTypeCreated,
+		EntityType:  "campaign",
+		EntityID:    campaignID,
+		PayloadJSON: data,
+	}
+}
+
+func gmFearChangedEvent(campaignID string, seq uint64, gmFear int) event.Event {
+	data, _ := json.Marshal(daggerheartpayload.GMFearChangedPayload{Value: gmFear})
+	return event.Event{
+		CampaignID:    ids.CampaignID(campaignID),
+		Seq:           seq,
+		Timestamp:     time.Date(2026, 1, 10, 12, int(seq), 0, 0, time.UTC),
+		Type:          event.Type("sys.daggerheart.gm_fear_changed"),
+		SystemID:      daggerheart.SystemID,
+		SystemVersion: daggerheart.SystemVersion,
+		EntityType:    "campaign",
+		EntityID:      campaignID,
+		PayloadJSON:   data,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/handler"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/eventredaction"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/historyread"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/contentstore"
 	systemmanifest "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/manifest"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
@@ -98,3 +100,27 @@ type StoresRuntimeConfig struct {
 func NewWriteRuntime() *domainwrite.Runtime {
 	return domainwrite.NewRuntime()
 }
+
+// HistoryReader serves point-in-time snapshot, sheet, and interaction reads.
+type HistoryReader = historyread.Reader
+
+// HistoryReaderConfig wires the journal and scratch projection factory used
+// by point-in-time reads.
+type HistoryReaderConfig = historyread.Config
+
+// HistoryScratch is the disposable projection store a historical replay fills.
+type HistoryScratch = historyread.Scratch
+
+// NewHistoryReader creates the shared point-in-time reader for service startup
+// without leaking the internal historyread package to callers outside the gRPC
+// package tree.
+func NewHistoryReader(config HistoryReaderConfig) *HistoryReader {
+	return historyread.NewReader(config)
+}
+
+// NewRedactedEventStore wraps the journal for transport reads so every read
+// path hides the identity of users who closed their account. The domain
+// engine keeps the unwrapped store: replaying commands needs the raw events.
+func NewRedactedEventStore(events storage.EventStore) storage.EventStore {
+	return eventredaction.NewStore(events)
+}
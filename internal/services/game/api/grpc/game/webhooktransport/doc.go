@@ -0,0 +1,5 @@
+// Package webhooktransport implements the gRPC transport layer for
+// campaign-owned outgoing webhooks. It owns the WebhookService handler, the
+// owner-only registration application, and the worker-facing delivery target
+// and delivery log RPCs.
+package webhooktransport
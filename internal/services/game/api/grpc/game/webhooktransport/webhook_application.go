@@ -0,0 +1,476 @@
+package webhooktransport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	domainauthz "github.com/louisbranch/fracturing.space/internal/services/game/domain/authz"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxWebhooksPerCampaign bounds append-time fan-out work per event.
+	maxWebhooksPerCampaign = 10
+	// maxWebhookEventTypes bounds one endpoint's filter list.
+	maxWebhookEventTypes = 50
+	maxWebhookURLLength  = 2048
+	// maxWebhookDescriptionLength keeps descriptions to a short label.
+	maxWebhookDescriptionLength = 200
+
+	defaultWebhookDeliveriesPageSize = 50
+	maxWebhookDeliveriesPageSize     = 200
+
+	// webhookSecretPrefix makes leaked secrets easy to recognize in logs
+	// and secret scanners.
+	webhookSecretPrefix = "whsec_"
+)
+
+// Deps holds the collaborators of the webhook transport.
+type Deps struct {
+	Auth     authz.PolicyDeps
+	Campaign storage.CampaignStore
+	Webhook  storage.WebhookStore
+	// Outbox queues test deliveries next to the append-time fan-out rows.
+	Outbox storage.IntegrationOutboxWriter
+	// EventRegistry validates event type filters against registered types.
+	EventRegistry *event.Registry
+	// Events re-reads delivered events through the redacting journal view;
+	// outbox rows hold the payload as it was at append time.
+	Events storage.EventLookupStore
+	// Sealer encrypts signing secrets before they are stored. Without it new
+	// webhooks cannot be created.
+	Sealer secret.Sealer
+	// AllowLoopbackHTTP accepts plain-http URLs on loopback hosts so local
+	// receivers work during development. Off by default.
+	AllowLoopbackHTTP bool
+}
+
+type webhookApplication struct {
+	auth     authz.PolicyDeps
+	campaign storage.CampaignStore
+	webhook  storage.WebhookStore
+	outbox   storage.IntegrationOutboxWriter
+	registry *event.Registry
+	events   storage.EventLookupStore
+	sealer   secret.Sealer
+	loopback bool
+	clock    func() time.Time
+	newID    func() (string, error)
+	secret   func() (string, error)
+}
+
+func newWebhookApplication(deps Deps) webhookApplication {
+	return webhookApplication{
+		auth:     deps.Auth,
+		campaign: deps.Campaign,
+		webhook:  deps.Webhook,
+		outbox:   deps.Outbox,
+		registry: deps.EventRegistry,
+		events:   deps.Events,
+		sealer:   deps.Sealer,
+		loopback: deps.AllowLoopbackHTTP,
+		clock:    time.Now,
+		newID:    id.NewID,
+		secret:   newSigningSecret,
+	}
+}
+
+func (a webhookApplication) CreateWebhook(ctx context.Context, campaignID, rawURL string, rawEventTypes []string, description string) (storage.WebhookEndpoint, error) {
+	if err := a.requireConfigured(); err != nil {
+		return storage.WebhookEndpoint{}, err
+	}
+	if a.sealer == nil {
+		return storage.WebhookEndpoint{}, status.Error(codes.FailedPrecondition, "webhook secret sealing is not configured")
+	}
+	if err := a.requireCampaignOwner(ctx, campaignID); err != nil {
+		return storage.WebhookEndpoint{}, err
+	}
+	endpointURL, err := normalizeWebhookURL(rawURL, a.loopback)
+	if err != nil {
+		return storage.WebhookEndpoint{}, err
+	}
+	eventTypes, err := a.normalizeEventTypes(rawEventTypes)
+	if err != nil {
+		return storage.WebhookEndpoint{}, err
+	}
+	description = strings.TrimSpace(description)
+	if len(description) > maxWebhookDescriptionLength {
+		return storage.WebhookEndpoint{}, status.Errorf(codes.InvalidArgument, "description must be at most %d characters", maxWebhookDescriptionLength)
+	}
+	existing, err := a.webhook.ListWebhookEndpoints(ctx, campaignID)
+	if err != nil {
+		return storage.WebhookEndpoint{}, grpcerror.Internal("list webhooks", err)
+	}
+	if len(existing) >= maxWebhooksPerCampaign {
+		return storage.WebhookEndpoint{}, status.Errorf(codes.FailedPrecondition, "campaign already has %d webhooks", maxWebhooksPerCampaign)
+	}
+
+	webhookID, err := a.newID()
+	if err != nil {
+		return storage.WebhookEndpoint{}, grpcerror.Internal("generate webhook id", err)
+	}
+	signingSecret, err := a.secret()
+	if err != nil {
+		return storage.WebhookEndpoint{}, grpcerror.Internal("generate webhook signing secret", err)
+	}
+	sealedSecret, err := a.sealer.Seal(signingSecret)
+	if err != nil {
+		return storage.WebhookEndpoint{}, grpcerror.Internal("seal webhook signing secret", err)
+	}
+	now := a.clock().UTC()
+	endpoint := storage.WebhookEndpoint{
+		ID:            webhookID,
+		CampaignID:    campaignID,
+		URL:           endpointURL,
+		EventTypes:    eventTypes,
+		Description:   description,
+		SigningSecret: sealedSecret,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := a.webhook.PutWebhookEndpoint(ctx, endpoint); err != nil {
+		return storage.WebhookEndpoint{}, grpcerror.Internal("put webhook", err)
+	}
+	// The owner sees the plaintext secret exactly once, in this response.
+	endpoint.SigningSecret = signingSecret
+	return endpoint, nil
+}
+
+func (a webhookApplication) ListWebhooks(ctx context.Context, campaignID string) ([]storage.WebhookEndpoint, error) {
+	if err := a.requireConfigured(); err != nil {
+		return nil, err
+	}
+	if err := a.requireCampaignOwner(ctx, campaignID); err != nil {
+		return nil, err
+	}
+	endpoints, err := a.webhook.ListWebhookEndpoints(ctx, campaignID)
+	if err != nil {
+		return nil, grpcerror.Internal("list webhooks", err)
+	}
+	return endpoints, nil
+}
+
+func (a webhookApplication) DeleteWebhook(ctx context.Context, campaignID, webhookID string) error {
+	if err := a.requireConfigured(); err != nil {
+		return err
+	}
+	if err := a.requireCampaignOwner(ctx, campaignID); err != nil {
+		return err
+	}
+	if err := a.webhook.DeleteWebhookEndpoint(ctx, campaignID, webhookID); err != nil {
+		return grpcerror.LookupErrorContext(ctx, err, "delete webhook", "webhook not found")
+	}
+	return nil
+}
+
+func (a webhookApplication) ListWebhookDeliveries(ctx context.Context, campaignID, webhookID string, pageSize int) ([]storage.WebhookDelivery, error) {
+	if err := a.requireConfigured(); err != nil {
+		return nil, err
+	}
+	if err := a.requireCampaignOwner(ctx, campaignID); err != nil {
+		return nil, err
+	}
+	if _, err := a.campaignWebhook(ctx, campaignID, webhookID); err != nil {
+		return nil, err
+	}
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultWebhookDeliveriesPageSize
+	case pageSize > maxWebhookDeliveriesPageSize:
+		pageSize = maxWebhookDeliveriesPageSize
+	}
+	deliveries, err := a.webhook.ListWebhookDeliveries(ctx, webhookID, pageSize)
+	if err != nil {
+		return nil, grpcerror.Internal("list webhook deliveries", err)
+	}
+	return deliveries, nil
+}
+
+// SendTestWebhookEvent queues a synthetic event for one endpoint. It skips
+// the journal on purpose: a test must not become campaign history.
+func (a webhookApplication) SendTestWebhookEvent(ctx context.Context, campaignID, webhookID string) (string, error) {
+	if err := a.requireConfigured(); err != nil {
+		return "", err
+	}
+	if a.outbox == nil {
+		return "", status.Error(codes.Internal, "integration outbox is not configured")
+	}
+	if err := a.requireCampaignOwner(ctx, campaignID); err != nil {
+		return "", err
+	}
+	endpoint, err := a.campaignWebhook(ctx, campaignID, webhookID)
+	if err != nil {
+		return "", err
+	}
+	deliveryID, err := a.newID()
+	if err != nil {
+		return "", grpcerror.Internal("generate delivery id", err)
+	}
+	now := a.clock().UTC()
+	testPayload, err := json.Marshal(map[string]string{
+		"webhook_id": endpoint.ID,
+		"message":    "Test event from Fracturing.Space.",
+	})
+	if err != nil {
+		return "", grpcerror.Internal("encode test payload", err)
+	}
+	payloadJSON, err := json.Marshal(gameintegration.WebhookDeliveryOutboxPayload{
+		WebhookID: endpoint.ID,
+		Event: gameintegration.WebhookEvent{
+			CampaignID: campaignID,
+			Type:       gameintegration.WebhookTestEventType,
+			Timestamp:  now,
+			Payload:    testPayload,
+			Test:       true,
+		},
+	})
+	if err != nil {
+		return "", grpcerror.Internal("encode test delivery", err)
+	}
+	if err := a.outbox.EnqueueIntegrationOutboxEvent(ctx, storage.IntegrationOutboxEvent{
+		ID:            deliveryID,
+		EventType:     gameintegration.WebhookDeliveryRequestedOutboxEventType,
+		PayloadJSON:   string(payloadJSON),
+		DedupeKey:     gameintegration.WebhookTestDeliveryDedupeKey(endpoint.ID, deliveryID),
+		Status:        storage.IntegrationOutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}); err != nil {
+		return "", grpcerror.Internal("queue test delivery", err)
+	}
+	return deliveryID, nil
+}
+
+// GetWebhookDeliveryTarget serves the worker, which is authenticated by the
+// internal service identity interceptor rather than campaign policy. A nonzero
+// eventSeq also returns that event's current payload so the delivered body
+// reflects redactions made after the delivery was queued.
+func (a webhookApplication) GetWebhookDeliveryTarget(ctx context.Context, webhookID string, eventSeq uint64) (storage.WebhookEndpoint, []byte, error) {
+	if err := a.requireConfigured(); err != nil {
+		return storage.WebhookEndpoint{}, nil, err
+	}
+	endpoint, err := a.webhook.GetWebhookEndpoint(ctx, webhookID)
+	if err != nil {
+		return storage.WebhookEndpoint{}, nil, grpcerror.LookupErrorContext(ctx, err, "get webhook", "webhook not found")
+	}
+	endpoint.SigningSecret, err = a.openSigningSecret(endpoint.SigningSecret)
+	if err != nil {
+		return storage.WebhookEndpoint{}, nil, err
+	}
+	if eventSeq == 0 {
+		return endpoint, nil, nil
+	}
+	if a.events == nil {
+		return storage.WebhookEndpoint{}, nil, status.Error(codes.Internal, "event store is not configured")
+	}
+	evt, err := a.events.GetEventBySeq(ctx, endpoint.CampaignID, eventSeq)
+	if err != nil {
+		return storage.WebhookEndpoint{}, nil, grpcerror.Internal("load webhook event", err)
+	}
+	return endpoint, evt.PayloadJSON, nil
+}
+
+// RecordWebhookDelivery appends one worker-reported attempt to the log.
+func (a webhookApplication) RecordWebhookDelivery(ctx context.Context, delivery storage.WebhookDelivery) error {
+	if err := a.requireConfigured(); err != nil {
+		return err
+	}
+	deliveryID, err := a.newID()
+	if err != nil {
+		return grpcerror.Internal("generate delivery log id", err)
+	}
+	delivery.ID = deliveryID
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = a.clock().UTC()
+	}
+	if err := a.webhook.RecordWebhookDelivery(ctx, delivery); err != nil {
+		return grpcerror.Internal("record webhook delivery", err)
+	}
+	return nil
+}
+
+func (a webhookApplication) requireConfigured() error {
+	if a.webhook == nil {
+		return status.Error(codes.Internal, "webhook store is not configured")
+	}
+	return nil
+}
+
+// openSigningSecret decrypts a stored signing secret. Secrets are sealed
+// before they are stored, so a value that does not open is an error.
+func (a webhookApplication) openSigningSecret(stored string) (string, error) {
+	if a.sealer == nil {
+		return "", status.Error(codes.Internal, "webhook secret sealer is not configured")
+	}
+	signingSecret, err := a.sealer.Open(stored)
+	if err != nil {
+		return "", grpcerror.Internal("open webhook signing secret", err)
+	}
+	return signingSecret, nil
+}
+
+// requireCampaignOwner restricts webhook management to campaign owners:
+// endpoints receive the full event stream, including GM-only detail.
+func (a webhookApplication) requireCampaignOwner(ctx context.Context, campaignID string) error {
+	if a.campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	campaignRecord, err := a.campaign.Get(ctx, campaignID)
+	if err != nil {
+		return grpcerror.LookupErrorContext(ctx, err, "load campaign", "campaign not found")
+	}
+	actor, err := authz.RequirePolicyActor(ctx, a.auth, domainauthz.CapabilityManageCampaign(), campaignRecord)
+	if err != nil {
+		return err
+	}
+	if _, override := authz.AdminOverrideFromContext(ctx); override {
+		return nil
+	}
+	if actor.CampaignAccess != participant.CampaignAccessOwner {
+		return status.Error(codes.PermissionDenied, "owner permission is required")
+	}
+	return nil
+}
+
+func (a webhookApplication) campaignWebhook(ctx context.Context, campaignID, webhookID string) (storage.WebhookEndpoint, error) {
+	endpoint, err := a.webhook.GetWebhookEndpoint(ctx, webhookID)
+	if err != nil {
+		return storage.WebhookEndpoint{}, grpcerror.LookupErrorContext(ctx, err, "get webhook", "webhook not found")
+	}
+	if endpoint.CampaignID != campaignID {
+		return storage.WebhookEndpoint{}, status.Error(codes.NotFound, "webhook not found")
+	}
+	return endpoint, nil
+}
+
+// normalizeEventTypes trims, dedupes, and sorts filters, rejecting types and
+// namespaces that no registered event could ever match.
+func (a webhookApplication) normalizeEventTypes(raw []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(raw))
+	eventTypes := make([]string, 0, len(raw))
+	for _, value := range raw {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		if !a.eventTypeFilterKnown(value) {
+			return nil, status.Errorf(codes.InvalidArgument, "event type %q does not match any registered event", value)
+		}
+		seen[value] = struct{}{}
+		eventTypes = append(eventTypes, value)
+	}
+	if len(eventTypes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one event type is required")
+	}
+	if len(eventTypes) > maxWebhookEventTypes {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d event types are allowed", maxWebhookEventTypes)
+	}
+	sort.Strings(eventTypes)
+	return eventTypes, nil
+}
+
+func (a webhookApplication) eventTypeFilterKnown(filter string) bool {
+	if a.registry == nil {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(filter, "*"); ok {
+		if !strings.HasSuffix(prefix, ".") || prefix == "." {
+			return false
+		}
+		for _, def := range a.registry.ListDefinitions() {
+			if gameintegration.WebhookSubscribes([]string{filter}, string(def.Type)) {
+				return true
+			}
+		}
+		return false
+	}
+	_, ok := a.registry.Definition(event.Type(filter))
+	return ok
+}
+
+// normalizeWebhookURL requires HTTPS and rejects hosts that are literal
+// internal addresses. allowLoopback admits loopback hosts, over plain HTTP
+// too, so local receivers work during development. Hostnames that resolve to
+// internal addresses are refused by the worker when it dials.
+func normalizeWebhookURL(raw string, allowLoopback bool) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", status.Error(codes.InvalidArgument, "url is required")
+	}
+	if len(raw) > maxWebhookURLLength {
+		return "", status.Errorf(codes.InvalidArgument, "url must be at most %d characters", maxWebhookURLLength)
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" || parsed.Hostname() == "" {
+		return "", status.Error(codes.InvalidArgument, "url must be absolute")
+	}
+	if parsed.User != nil {
+		return "", status.Error(codes.InvalidArgument, "url must not embed credentials")
+	}
+	if parsed.Fragment != "" {
+		return "", status.Error(codes.InvalidArgument, "url must not include a fragment")
+	}
+	loopback := isLoopbackHost(parsed.Hostname())
+	if loopback && !allowLoopback {
+		return "", status.Error(codes.InvalidArgument, "url must not target a loopback host")
+	}
+	if isInternalIPHost(parsed.Hostname()) {
+		return "", status.Error(codes.InvalidArgument, "url must not target a private or reserved address")
+	}
+	switch parsed.Scheme {
+	case "https":
+	case "http":
+		if !loopback {
+			return "", status.Error(codes.InvalidArgument, "url must use https")
+		}
+	default:
+		return "", status.Error(codes.InvalidArgument, "url must use https")
+	}
+	return parsed.String(), nil
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// isInternalIPHost reports whether host is a literal non-loopback address
+// webhook deliveries must not reach. The worker re-checks resolved addresses
+// against the same list at dial time.
+func isInternalIPHost(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	return err == nil && gameintegration.IsBlockedWebhookAddr(addr, true)
+}
+
+func newSigningSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,244 @@
+package webhooktransport
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Service implements the game.v1.WebhookService gRPC API.
+type Service struct {
+	campaignv1.UnimplementedWebhookServiceServer
+	app webhookApplication
+}
+
+// NewService creates a webhook Service with the provided dependencies.
+func NewService(deps Deps) *Service {
+	return &Service{app: newWebhookApplication(deps)}
+}
+
+func (s *Service) CreateWebhook(ctx context.Context, in *campaignv1.CreateWebhookRequest) (*campaignv1.CreateWebhookResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "create webhook request is required")
+	}
+	campaignID, err := validate.RequiredID(in.GetCampaignId(), "campaign id")
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := s.app.CreateWebhook(ctx, campaignID, in.GetUrl(), in.GetEventTypes(), in.GetDescription())
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.CreateWebhookResponse{
+		Webhook:       webhookToProto(endpoint),
+		SigningSecret: endpoint.SigningSecret,
+	}, nil
+}
+
+func (s *Service) ListWebhooks(ctx context.Context, in *campaignv1.ListWebhooksRequest) (*campaignv1.ListWebhooksResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list webhooks request is required")
+	}
+	campaignID, err := validate.RequiredID(in.GetCampaignId(), "campaign id")
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := s.app.ListWebhooks(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &campaignv1.ListWebhooksResponse{Webhooks: make([]*campaignv1.Webhook, 0, len(endpoints))}
+	for _, endpoint := range endpoints {
+		resp.Webhooks = append(resp.Webhooks, webhookToProto(endpoint))
+	}
+	return resp, nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, in *campaignv1.DeleteWebhookRequest) (*campaignv1.DeleteWebhookResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "delete webhook request is required")
+	}
+	campaignID, webhookID, err := requiredCampaignWebhookIDs(in.GetCampaignId(), in.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.DeleteWebhook(ctx, campaignID, webhookID); err != nil {
+		return nil, err
+	}
+	return &campaignv1.DeleteWebhookResponse{}, nil
+}
+
+func (s *Service) ListWebhookDeliveries(ctx context.Context, in *campaignv1.ListWebhookDeliveriesRequest) (*campaignv1.ListWebhookDeliveriesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list webhook deliveries request is required")
+	}
+	campaignID, webhookID, err := requiredCampaignWebhookIDs(in.GetCampaignId(), in.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := s.app.ListWebhookDeliveries(ctx, campaignID, webhookID, int(in.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &campaignv1.ListWebhookDeliveriesResponse{Deliveries: make([]*campaignv1.WebhookDelivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, webhookDeliveryToProto(delivery))
+	}
+	return resp, nil
+}
+
+func (s *Service) SendTestWebhookEvent(ctx context.Context, in *campaignv1.SendTestWebhookEventRequest) (*campaignv1.SendTestWebhookEventResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "send test webhook event request is required")
+	}
+	campaignID, webhookID, err := requiredCampaignWebhookIDs(in.GetCampaignId(), in.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+	deliveryID, err := s.app.SendTestWebhookEvent(ctx, campaignID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.SendTestWebhookEventResponse{DeliveryId: deliveryID}, nil
+}
+
+func (s *Service) GetWebhookDeliveryTarget(ctx context.Context, in *campaignv1.GetWebhookDeliveryTargetRequest) (*campaignv1.GetWebhookDeliveryTargetResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "get webhook delivery target request is required")
+	}
+	webhookID, err := validate.RequiredID(in.GetWebhookId(), "webhook id")
+	if err != nil {
+		return nil, err
+	}
+	endpoint, payload, err := s.app.GetWebhookDeliveryTarget(ctx, webhookID, in.GetEventSeq())
+	if err != nil {
+		return nil, err
+	}
+	return &campaignv1.GetWebhookDeliveryTargetResponse{
+		WebhookId:        endpoint.ID,
+		CampaignId:       endpoint.CampaignID,
+		Url:              endpoint.URL,
+		SigningSecret:    endpoint.SigningSecret,
+		EventPayloadJson: string(payload),
+	}, nil
+}
+
+func (s *Service) RecordWebhookDelivery(ctx context.Context, in *campaignv1.RecordWebhookDeliveryRequest) (*campaignv1.RecordWebhookDeliveryResponse, error) {
+	if in == nil || in.GetDelivery() == nil {
+		return nil, status.Error(codes.InvalidArgument, "webhook delivery is required")
+	}
+	delivery, err := webhookDeliveryFromProto(in.GetDelivery())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.RecordWebhookDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return &campaignv1.RecordWebhookDeliveryResponse{}, nil
+}
+
+func requiredCampaignWebhookIDs(rawCampaignID, rawWebhookID string) (string, string, error) {
+	campaignID, err := validate.RequiredID(rawCampaignID, "campaign id")
+	if err != nil {
+		return "", "", err
+	}
+	webhookID, err := validate.RequiredID(rawWebhookID, "webhook id")
+	if err != nil {
+		return "", "", err
+	}
+	return campaignID, webhookID, nil
+}
+
+func webhookToProto(endpoint storage.WebhookEndpoint) *campaignv1.Webhook {
+	return &campaignv1.Webhook{
+		Id:          endpoint.ID,
+		CampaignId:  endpoint.CampaignID,
+		Url:         endpoint.URL,
+		EventTypes:  append([]string(nil), endpoint.EventTypes...),
+		Description: endpoint.Description,
+		CreatedAt:   timestamppb.New(endpoint.CreatedAt),
+		UpdatedAt:   timestamppb.New(endpoint.UpdatedAt),
+	}
+}
+
+func webhookDeliveryToProto(delivery storage.WebhookDelivery) *campaignv1.WebhookDelivery {
+	return &campaignv1.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.WebhookID,
+		CampaignId:     delivery.CampaignID,
+		DeliveryId:     delivery.OutboxEventID,
+		EventType:      delivery.EventType,
+		EventSeq:       delivery.EventSeq,
+		Attempt:        int32(delivery.Attempt),
+		Status:         webhookDeliveryStatusToProto(delivery.Status),
+		ResponseStatus: int32(delivery.ResponseStatus),
+		Error:          delivery.Error,
+		DurationMs:     delivery.Duration.Milliseconds(),
+		Test:           delivery.Test,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+}
+
+func webhookDeliveryFromProto(pb *campaignv1.WebhookDelivery) (storage.WebhookDelivery, error) {
+	webhookID, err := validate.RequiredID(pb.GetWebhookId(), "webhook id")
+	if err != nil {
+		return storage.WebhookDelivery{}, err
+	}
+	deliveryStatus, ok := webhookDeliveryStatusFromProto(pb.GetStatus())
+	if !ok {
+		return storage.WebhookDelivery{}, status.Error(codes.InvalidArgument, "delivery status is required")
+	}
+	if pb.GetAttempt() <= 0 {
+		return storage.WebhookDelivery{}, status.Error(codes.InvalidArgument, "delivery attempt must be positive")
+	}
+	delivery := storage.WebhookDelivery{
+		WebhookID:      webhookID,
+		CampaignID:     strings.TrimSpace(pb.GetCampaignId()),
+		OutboxEventID:  strings.TrimSpace(pb.GetDeliveryId()),
+		EventType:      strings.TrimSpace(pb.GetEventType()),
+		EventSeq:       pb.GetEventSeq(),
+		Attempt:        int(pb.GetAttempt()),
+		Status:         deliveryStatus,
+		ResponseStatus: int(pb.GetResponseStatus()),
+		Error:          pb.GetError(),
+		Duration:       time.Duration(pb.GetDurationMs()) * time.Millisecond,
+		Test:           pb.GetTest(),
+	}
+	if ts := pb.GetCreatedAt(); ts != nil && ts.IsValid() {
+		delivery.CreatedAt = ts.AsTime().UTC()
+	}
+	return delivery, nil
+}
+
+func webhookDeliveryStatusToProto(value storage.WebhookDeliveryStatus) campaignv1.WebhookDeliveryStatus {
+	switch value {
+	case storage.WebhookDeliveryStatusSucceeded:
+		return campaignv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case storage.WebhookDeliveryStatusFailed:
+		return campaignv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	case storage.WebhookDeliveryStatusDead:
+		return campaignv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		return campaignv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+func webhookDeliveryStatusFromProto(value campaignv1.WebhookDeliveryStatus) (storage.WebhookDeliveryStatus, bool) {
+	switch value {
+	case campaignv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return storage.WebhookDeliveryStatusSucceeded, true
+	case campaignv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED:
+		return storage.WebhookDeliveryStatusFailed, true
+	case campaignv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:
+		return storage.WebhookDeliveryStatusDead, true
+	default:
+		return "", false
+	}
+}
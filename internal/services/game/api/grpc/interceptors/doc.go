@@ -15,7 +15,12 @@
 //  4. session_lock — blocks mutators during active sessions.
 //     Cheap gate check; rejects before handler execution.
 //
-//  5. error_conversion — converts domain errors to gRPC status codes.
+//  5. expected_seq — arms the optional optimistic concurrency precondition
+//     from the request's expected_seq field or metadata for the first
+//     command the handler executes that appends events.
+//     Unary only; streaming RPCs do not append commands.
+//
+//  6. error_conversion — converts domain errors to gRPC status codes.
 //     Innermost so it translates handler errors before telemetry observes them.
 //
 // Verify ordering in app/bootstrap_transport.go where interceptors are chained.
@@ -0,0 +1,59 @@
+package interceptors
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// expectedSeqRequest is implemented by mutating request messages that
+// declare an expected_seq field.
+type expectedSeqRequest interface {
+	GetExpectedSeq() uint64
+}
+
+// ExpectedSeqUnaryInterceptor arms the optional optimistic concurrency
+// precondition carried in a request's expected_seq field or in
+// x-fracturing-space-expected-seq metadata. The first domain command the
+// handler executes that appends events is appended only if the campaign
+// journal head still equals the supplied seq. The header serves callers that
+// cannot set the field, such as the HTTP gateway; when both are sent they must
+// agree.
+//
+// The guard compares against the journal head, which only matches the state a
+// client rendered while projections apply inline. When supported is false
+// (outbox apply mode) a precondition is refused with FailedPrecondition rather
+// than honoured against state the caller may not have seen.
+func ExpectedSeqUnaryInterceptor(supported bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var fieldSeq uint64
+		if guarded, ok := req.(expectedSeqRequest); ok {
+			fieldSeq = guarded.GetExpectedSeq()
+		}
+		raw := strings.TrimSpace(grpcmeta.ExpectedSeqFromContext(ctx))
+		if raw == "" && fieldSeq == 0 {
+			return handler(ctx, req)
+		}
+		if !supported {
+			return nil, status.Error(codes.FailedPrecondition, "expected_seq is unsupported while projections apply through the outbox")
+		}
+		seq := fieldSeq
+		if raw != "" {
+			headerSeq, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil || headerSeq == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "%s must be a positive integer", grpcmeta.ExpectedSeqHeader)
+			}
+			if fieldSeq != 0 && fieldSeq != headerSeq {
+				return nil, status.Errorf(codes.InvalidArgument, "expected_seq %d disagrees with %s %d", fieldSeq, grpcmeta.ExpectedSeqHeader, headerSeq)
+			}
+			seq = headerSeq
+		}
+		return handler(domainwrite.WithExpectedSeq(ctx, seq), req)
+	}
+}
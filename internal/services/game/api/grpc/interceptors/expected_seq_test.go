@@ -0,0 +1,101 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestExpectedSeqUnaryInterceptor_PassesThroughWithoutHeader(t *testing.T) {
+	interceptor := ExpectedSeqUnaryInterceptor(true)
+	called := false
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		called = true
+		return nil, nil
+	})
+	if err != nil || !called {
+		t.Fatalf("handler called = %v, err = %v; want called without error", called, err)
+	}
+}
+
+func TestExpectedSeqUnaryInterceptor_RejectsMalformedHeader(t *testing.T) {
+	interceptor := ExpectedSeqUnaryInterceptor(true)
+	// Zero is never a valid head: campaign creation appends seq 1, so a guard
+	// against an empty journal is neither expressible nor needed.
+	for _, raw := range []string{"abc", "0", "-1"} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcmeta.ExpectedSeqHeader, raw))
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+			t.Fatalf("handler should not run for %q", raw)
+			return nil, nil
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected seq %q error = %v, want InvalidArgument", raw, err)
+		}
+	}
+}
+
+func TestExpectedSeqUnaryInterceptor_RejectsHeaderWhenUnsupported(t *testing.T) {
+	interceptor := ExpectedSeqUnaryInterceptor(false)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcmeta.ExpectedSeqHeader, "7"))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		t.Fatal("handler should not run when expected seq is unsupported")
+		return nil, nil
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("error = %v, want FailedPrecondition", err)
+	}
+
+	called := false
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		called = true
+		return nil, nil
+	}); err != nil || !called {
+		t.Fatalf("handler called = %v, err = %v; want unguarded writes to pass", called, err)
+	}
+}
+
+func TestExpectedSeqUnaryInterceptor_ArmsRequestField(t *testing.T) {
+	interceptor := ExpectedSeqUnaryInterceptor(true)
+	req := &gamev1.UpdateCampaignRequest{CampaignId: "camp-1", ExpectedSeq: 7}
+	executor := &seqRecordingExecutor{}
+	_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+		return domainwrite.ExecuteAndApply(ctx, executor, nil, command.Command{CampaignID: "camp-1", Type: "campaign.update"}, domainwrite.Options{})
+	})
+	if err != nil || executor.expectedSeq != 7 {
+		t.Fatalf("expected seq = %d, err = %v; want the field armed", executor.expectedSeq, err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcmeta.ExpectedSeqHeader, "8"))
+	if _, err := interceptor(ctx, req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		t.Fatal("handler should not run when field and header disagree")
+		return nil, nil
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("error = %v, want InvalidArgument", err)
+	}
+
+	if _, err := ExpectedSeqUnaryInterceptor(false)(context.Background(), req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		t.Fatal("handler should not run when expected seq is unsupported")
+		return nil, nil
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("error = %v, want FailedPrecondition", err)
+	}
+}
+
+// seqRecordingExecutor records the precondition stamped on the command it runs.
+type seqRecordingExecutor struct {
+	expectedSeq uint64
+}
+
+func (e *seqRecordingExecutor) Execute(_ context.Context, cmd command.Command) (engine.Result, error) {
+	e.expectedSeq = cmd.ExpectedSeq
+	return engine.Result{}, nil
+}
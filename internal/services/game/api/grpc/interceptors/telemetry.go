@@ -183,6 +183,7 @@ func classifyMethodKind(fullMethod string) string {
 	if strings.HasPrefix(method, "Get") ||
 		strings.HasPrefix(method, "List") ||
 		strings.HasPrefix(method, "Subscribe") ||
+		strings.HasPrefix(method, "Diff") ||
 		method == "Can" ||
 		method == "BatchCan" {
 		return "read"
@@ -88,6 +88,7 @@ func TestClassifyMethodKind(t *testing.T) {
 		"/game.v1.EventService/SubscribeCampaignUpdates",
 		// ForkService
 		"/game.v1.ForkService/GetLineage",
+		"/game.v1.ForkService/DiffCampaignState",
 		"/game.v1.ForkService/ListForks",
 		// InteractionService
 		"/game.v1.InteractionService/GetInteractionState",
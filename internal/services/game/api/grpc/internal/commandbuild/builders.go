@@ -16,6 +16,7 @@ type CoreInput struct {
 	RequestID     string
 	InvocationID  string
 	CorrelationID string
+	CausationID   string
 	EntityType    string
 	EntityID      string
 	PayloadJSON   []byte
@@ -33,6 +34,7 @@ func Core(in CoreInput) command.Command {
 		RequestID:     in.RequestID,
 		InvocationID:  in.InvocationID,
 		CorrelationID: in.CorrelationID,
+		CausationID:   in.CausationID,
 		EntityType:    in.EntityType,
 		EntityID:      in.EntityID,
 		PayloadJSON:   in.PayloadJSON,
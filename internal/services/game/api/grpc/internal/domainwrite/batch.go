@@ -0,0 +1,174 @@
+package domainwrite
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+)
+
+// BatchExecutor executes several domain commands all-or-nothing against one
+// loaded state. The production engine handler satisfies it.
+type BatchExecutor interface {
+	ExecuteBatch(context.Context, []command.Command) (engine.BatchResult, error)
+}
+
+// ExecuteBatchAndApply executes commands as one atomic batch, handles the first
+// rejection, and applies every emitted event in journal order.
+//
+// Options apply to the batch as a whole: RequireEvents is checked per command
+// and the request ExpectedSeq, when armed, guards the first command and
+// therefore the whole batch. Under a [WithPreview] context the batch is
+// dry-run and nothing is applied; under a [WithStagedBatch] context it joins
+// the staged batch and is applied when that batch commits.
+func ExecuteBatchAndApply(
+	ctx context.Context,
+	executor Executor,
+	applier EventApplier,
+	cmds []command.Command,
+	options Options,
+) (engine.BatchResult, error) {
+	options = normalizeOptions(options)
+
+	batchExecutor, ok := executor.(BatchExecutor)
+	if executor == nil || !ok {
+		return engine.BatchResult{}, errors.New("domain engine does not support command batches")
+	}
+
+	cmds = append([]command.Command(nil), cmds...)
+	recorder := previewRecorderFromContext(ctx)
+	staged := stagedBatchUnlessPreview(ctx, recorder)
+	var claimed uint64
+	if recorder != nil || staged != nil {
+		for i := range cmds {
+			cmds[i].ExpectedSeq = 0
+		}
+	} else if len(cmds) > 0 && cmds[0].ExpectedSeq == 0 {
+		claimed = claimExpectedSeq(ctx)
+		cmds[0].ExpectedSeq = claimed
+	}
+	result, err := executeOrPreviewBatch(ctx, batchExecutor, recorder, staged, executor, applier, options, cmds)
+	if err == nil && !batchAppended(result) {
+		rearmExpectedSeq(ctx, claimed)
+	}
+	if err != nil {
+		if apperrors.IsCode(err, apperrors.CodeExpectedSeqMismatch) {
+			return engine.BatchResult{}, apperrors.HandleError(err, grpcmeta.LocaleFromContext(ctx))
+		}
+		return engine.BatchResult{}, options.ExecuteErr(err)
+	}
+	for i, decision := range result.Decisions {
+		if len(decision.Rejections) == 0 {
+			continue
+		}
+		rejection := decision.Rejections[0]
+		if options.OnRejection != nil {
+			options.OnRejection(ctx, OnRejectionInfo{
+				CampaignID:  string(cmds[i].CampaignID),
+				CommandType: cmds[i].Type,
+				Code:        rejection.Code,
+				Message:     rejection.Message,
+			})
+		}
+		return engine.BatchResult{}, options.RejectErr(rejection.Code, rejection.Message)
+	}
+	if options.RequireEvents {
+		for _, decision := range result.Decisions {
+			if len(decision.Events) == 0 {
+				return engine.BatchResult{}, errors.New(options.MissingEventMsg)
+			}
+		}
+	}
+	if options.InlineApplyEnabled && recorder == nil && staged == nil {
+		for _, decision := range result.Decisions {
+			for _, evt := range decision.Events {
+				if options.ShouldApply != nil && !options.ShouldApply(evt) {
+					continue
+				}
+				if err := applier.Apply(ctx, evt); err != nil {
+					return engine.BatchResult{}, options.ApplyErr(err)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// executeOrPreviewBatch executes cmds atomically, dry-runs them through
+// recorder when the request is in preview mode, or stages them into the
+// request's staged batch.
+func executeOrPreviewBatch(
+	ctx context.Context,
+	batchExecutor BatchExecutor,
+	recorder *PreviewRecorder,
+	staged *StagedBatch,
+	executor Executor,
+	applier EventApplier,
+	options Options,
+	cmds []command.Command,
+) (engine.BatchResult, error) {
+	if staged != nil {
+		decisions, err := staged.stage(ctx, executor, applier, options, cmds)
+		if err != nil {
+			return engine.BatchResult{}, err
+		}
+		return engine.BatchResult{Decisions: decisions}, nil
+	}
+	if recorder == nil {
+		return batchExecutor.ExecuteBatch(ctx, cmds)
+	}
+	decisions, err := recorder.record(ctx, executor, cmds)
+	if err != nil {
+		return engine.BatchResult{}, err
+	}
+	return engine.BatchResult{Decisions: decisions}, nil
+}
+
+// ExecuteBatchAndApply executes a command batch using runtime apply
+// configuration.
+func (r *Runtime) ExecuteBatchAndApply(
+	ctx context.Context,
+	executor Executor,
+	applier EventApplier,
+	cmds []command.Command,
+	options Options,
+) (engine.BatchResult, error) {
+	options.InlineApplyEnabled = r.InlineApplyEnabled()
+	if options.ShouldApply == nil {
+		options.ShouldApply = r.ShouldApply()
+	}
+	return ExecuteBatchAndApply(ctx, executor, applier, cmds, options)
+}
+
+// TransportExecuteBatchAndApply is the batch counterpart of
+// [TransportExecuteAndApply]: it normalizes transport options, wires audit
+// callbacks, and executes commands all-or-nothing.
+func TransportExecuteBatchAndApply(
+	ctx context.Context,
+	deps Deps,
+	applier EventApplier,
+	cmds []command.Command,
+	options Options,
+	normalizeConfig NormalizeDomainWriteOptionsConfig,
+) (engine.BatchResult, error) {
+	NormalizeDomainWriteOptions(ctx, &options, normalizeConfig)
+	setDefaultOnRejection(&options, deps)
+	runtime := deps.DomainWriteRuntime()
+	if runtime == nil {
+		runtime = NewRuntime()
+	}
+	return runtime.ExecuteBatchAndApply(ctx, deps.DomainExecutor(), applier, cmds, options)
+}
+
+// batchAppended reports whether any command in the batch emitted events.
+func batchAppended(result engine.BatchResult) bool {
+	for _, decision := range result.Decisions {
+		if len(decision.Events) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package domainwrite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+)
+
+type recordingBatchExecutor struct {
+	recordingExecutor
+	batches [][]command.Command
+	result  engine.BatchResult
+}
+
+func (r *recordingBatchExecutor) ExecuteBatch(_ context.Context, cmds []command.Command) (engine.BatchResult, error) {
+	r.batches = append(r.batches, cmds)
+	return r.result, r.err
+}
+
+type recordingApplier struct {
+	applied []event.Type
+}
+
+func (r *recordingApplier) Apply(_ context.Context, evt event.Event) error {
+	r.applied = append(r.applied, evt.Type)
+	return nil
+}
+
+func TestExecuteBatchAndApply_AppliesEventsInBatchOrder(t *testing.T) {
+	executor := &recordingBatchExecutor{result: engine.BatchResult{Decisions: []command.Decision{
+		command.Accept(event.Event{Type: "session.started"}),
+		command.Accept(event.Event{Type: "session.gm_authority_set"}),
+	}}}
+	applier := &recordingApplier{}
+	ctx := WithExpectedSeq(context.Background(), 5)
+	cmds := []command.Command{
+		{CampaignID: "camp-1", Type: "session.start"},
+		{CampaignID: "camp-1", Type: "session.gm_authority.set"},
+	}
+
+	if _, err := ExecuteBatchAndApply(ctx, executor, applier, cmds, Options{InlineApplyEnabled: true}); err != nil {
+		t.Fatalf("execute batch: %v", err)
+	}
+	if len(executor.batches) != 1 || executor.batches[0][0].ExpectedSeq != 5 || executor.batches[0][1].ExpectedSeq != 0 {
+		t.Fatalf("batches = %+v, want one batch guarded on its first command", executor.batches)
+	}
+	if cmds[0].ExpectedSeq != 0 {
+		t.Fatal("caller command slice was mutated")
+	}
+	if len(applier.applied) != 2 || applier.applied[0] != "session.started" || applier.applied[1] != "session.gm_authority_set" {
+		t.Fatalf("applied = %v, want batch order", applier.applied)
+	}
+}
+
+func TestExecuteBatchAndApply_ReportsRejectedCommand(t *testing.T) {
+	executor := &recordingBatchExecutor{result: engine.BatchResult{Decisions: []command.Decision{
+		command.Accept(event.Event{Type: "session.started"}),
+		command.Reject(command.Rejection{Code: "GM_REQUIRED", Message: "gm required"}),
+	}}}
+	applier := &recordingApplier{}
+	var rejected OnRejectionInfo
+
+	_, err := ExecuteBatchAndApply(context.Background(), executor, applier, []command.Command{
+		{CampaignID: "camp-1", Type: "session.start"},
+		{CampaignID: "camp-1", Type: "session.gm_authority.set"},
+	}, Options{
+		InlineApplyEnabled: true,
+		OnRejection:        func(_ context.Context, info OnRejectionInfo) { rejected = info },
+	})
+	if err == nil || err.Error() != "gm required" {
+		t.Fatalf("error = %v, want rejection message", err)
+	}
+	if rejected.CommandType != "session.gm_authority.set" || rejected.Code != "GM_REQUIRED" {
+		t.Fatalf("rejection info = %+v, want second command", rejected)
+	}
+	if len(applier.applied) != 0 {
+		t.Fatalf("applied = %v, want nothing for rejected batch", applier.applied)
+	}
+}
+
+func TestExecuteBatchAndApply_RequiresBatchExecutor(t *testing.T) {
+	_, err := ExecuteBatchAndApply(context.Background(), &recordingExecutor{}, nilEventApplier{}, []command.Command{
+		{CampaignID: "camp-1", Type: "session.start"},
+	}, Options{})
+	if err == nil {
+		t.Fatal("expected error for executor without batch support")
+	}
+}
+
+func TestExecuteBatchAndApply_WrapsExecuteErrors(t *testing.T) {
+	executor := &recordingBatchExecutor{}
+	executor.err = errors.New("journal down")
+
+	_, err := ExecuteBatchAndApply(context.Background(), executor, nilEventApplier{}, []command.Command{
+		{CampaignID: "camp-1", Type: "session.start"},
+	}, Options{ExecuteErrMessage: "start session"})
+	if err == nil || err.Error() != "start session: journal down" {
+		t.Fatalf("error = %v, want wrapped execute error", err)
+	}
+}
@@ -11,6 +11,15 @@
 // error callbacks, wire audit rejection telemetry, and apply runtime
 // configuration.
 //
+// WithPreview arms a request-scoped dry-run mode: the same helpers decide
+// commands through the engine preview pipeline and record the would-be events
+// and state diff without appending or applying anything.
+//
+// WithStagedBatch arms a request-scoped staged mode for multi-step flows: the
+// helpers decide commands the same way but defer them, and StagedBatch.Commit
+// appends every staged command in one journal transaction before applying
+// their events. StagedEventStore lets later flow steps read staged events.
+//
 // WritePath bundles the executor, runtime, and optional audit store into
 // a single dependency struct that satisfies the Deps interface. Transport
 // packages embed or accept WritePath instead of carrying three separate fields.
@@ -0,0 +1,50 @@
+package domainwrite
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type expectedSeqContextKey struct{}
+
+// expectedSeqClaim holds one request-scoped precondition. Only the first
+// command or batch that appends events under the request consumes it: later
+// writes in the same RPC run against a head the request itself just advanced.
+// A write that appends nothing hands the claim back.
+type expectedSeqClaim struct {
+	seq     uint64
+	claimed atomic.Bool
+}
+
+// WithExpectedSeq arms an optimistic concurrency precondition for the first
+// domain command under ctx that appends events. Zero leaves ctx unchanged.
+func WithExpectedSeq(ctx context.Context, seq uint64) context.Context {
+	if seq == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, expectedSeqContextKey{}, &expectedSeqClaim{seq: seq})
+}
+
+// claimExpectedSeq returns the armed precondition once, or zero when none is
+// armed or it was already consumed.
+func claimExpectedSeq(ctx context.Context) uint64 {
+	claim, ok := ctx.Value(expectedSeqContextKey{}).(*expectedSeqClaim)
+	if !ok || claim == nil {
+		return 0
+	}
+	if !claim.claimed.CompareAndSwap(false, true) {
+		return 0
+	}
+	return claim.seq
+}
+
+// rearmExpectedSeq hands a claimed precondition back when the write that took
+// it appended nothing, so the request's next write is still guarded.
+func rearmExpectedSeq(ctx context.Context, seq uint64) {
+	if seq == 0 {
+		return
+	}
+	if claim, ok := ctx.Value(expectedSeqContextKey{}).(*expectedSeqClaim); ok && claim != nil {
+		claim.claimed.Store(false)
+	}
+}
@@ -0,0 +1,91 @@
+package domainwrite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordingExecutor records executed commands. Each command emits the next
+// entry of emits as its event count; commands past the end emit one event.
+type recordingExecutor struct {
+	commands []command.Command
+	emits    []int
+	err      error
+}
+
+func (r *recordingExecutor) Execute(_ context.Context, cmd command.Command) (engine.Result, error) {
+	r.commands = append(r.commands, cmd)
+	count := 1
+	if i := len(r.commands) - 1; i < len(r.emits) {
+		count = r.emits[i]
+	}
+	var decision command.Decision
+	for range count {
+		decision.Events = append(decision.Events, event.Event{CampaignID: cmd.CampaignID, Type: event.Type("session.started")})
+	}
+	return engine.Result{Decision: decision}, r.err
+}
+
+func TestExecuteAndApply_ExpectedSeqAppliesToFirstCommandOnly(t *testing.T) {
+	executor := &recordingExecutor{}
+	ctx := WithExpectedSeq(context.Background(), 7)
+	cmd := command.Command{CampaignID: "camp-1", Type: command.Type("session.start")}
+
+	for range 2 {
+		if _, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, cmd, Options{}); err != nil {
+			t.Fatalf("execute: %v", err)
+		}
+	}
+	if len(executor.commands) != 2 {
+		t.Fatalf("executed commands = %d, want 2", len(executor.commands))
+	}
+	if executor.commands[0].ExpectedSeq != 7 || executor.commands[1].ExpectedSeq != 0 {
+		t.Fatalf("expected seqs = [%d %d], want [7 0]", executor.commands[0].ExpectedSeq, executor.commands[1].ExpectedSeq)
+	}
+}
+
+func TestExecuteAndApply_ExpectedSeqSurvivesCommandsThatAppendNothing(t *testing.T) {
+	executor := &recordingExecutor{emits: []int{0, 1, 1}}
+	ctx := WithExpectedSeq(context.Background(), 7)
+	cmd := command.Command{CampaignID: "camp-1", Type: command.Type("session.start")}
+
+	for range 3 {
+		if _, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, cmd, Options{}); err != nil {
+			t.Fatalf("execute: %v", err)
+		}
+	}
+	got := []uint64{executor.commands[0].ExpectedSeq, executor.commands[1].ExpectedSeq, executor.commands[2].ExpectedSeq}
+	if got[0] != 7 || got[1] != 7 || got[2] != 0 {
+		t.Fatalf("expected seqs = %v, want [7 7 0]", got)
+	}
+}
+
+func TestExecuteAndApply_ExpectedSeqMismatchReturnsFailedPrecondition(t *testing.T) {
+	executor := &recordingExecutor{err: storage.NewExpectedSeqMismatchError("camp-1", 7, 9)}
+	ctx := WithExpectedSeq(context.Background(), 7)
+
+	_, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: command.Type("session.start")}, Options{
+		ExecuteErr: func(err error) error { return status.Error(codes.Internal, err.Error()) },
+	})
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		t.Fatalf("error = %v, want FailedPrecondition", err)
+	}
+	var currentSeq string
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			currentSeq = info.GetMetadata()["CurrentSeq"]
+		}
+	}
+	if currentSeq != "9" {
+		t.Fatalf("CurrentSeq detail = %q, want 9", currentSeq)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"sync/atomic"
 
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
@@ -140,6 +142,13 @@ func (r *Runtime) InlineApplyEnabled() bool {
 	return r.inlineApplyEnabled.Load()
 }
 
+// ProjectionsTrailJournal reports whether projections are applied out of band
+// (outbox apply), so the journal head may be ahead of projected reads. A nil
+// runtime reports false.
+func (r *Runtime) ProjectionsTrailJournal() bool {
+	return r != nil && !r.InlineApplyEnabled()
+}
+
 // ExecuteAndApply executes a command using runtime apply configuration.
 func (r *Runtime) ExecuteAndApply(
 	ctx context.Context,
@@ -174,6 +183,9 @@ func (nilEventApplier) Apply(context.Context, event.Event) error {
 }
 
 // ExecuteAndApply executes the command, handles rejections, and applies events.
+// Under a [WithPreview] context it dry-runs the command instead and applies
+// nothing; under a [WithStagedBatch] context it stages the command for the
+// batch commit.
 func ExecuteAndApply(
 	ctx context.Context,
 	executor Executor,
@@ -187,8 +199,27 @@ func ExecuteAndApply(
 		return engine.Result{}, errors.New("domain engine is not configured")
 	}
 
-	result, err := executor.Execute(ctx, cmd)
+	recorder := previewRecorderFromContext(ctx)
+	staged := stagedBatchUnlessPreview(ctx, recorder)
+	var claimed uint64
+	if recorder != nil || staged != nil {
+		// Previews never append, and staged commands are guarded as a whole
+		// when the batch commits.
+		cmd.ExpectedSeq = 0
+	} else if cmd.ExpectedSeq == 0 {
+		claimed = claimExpectedSeq(ctx)
+		cmd.ExpectedSeq = claimed
+	}
+	result, err := executeOrPreview(ctx, executor, recorder, staged, applier, options, cmd)
+	if err == nil && len(result.Decision.Events) == 0 {
+		rearmExpectedSeq(ctx, claimed)
+	}
 	if err != nil {
+		// A stale precondition is a caller-facing conflict, not an execution
+		// failure, so it bypasses ExecuteErr and keeps its structured code.
+		if apperrors.IsCode(err, apperrors.CodeExpectedSeqMismatch) {
+			return engine.Result{}, apperrors.HandleError(err, grpcmeta.LocaleFromContext(ctx))
+		}
 		return engine.Result{}, options.ExecuteErr(err)
 	}
 	if len(result.Decision.Rejections) > 0 {
@@ -213,7 +244,7 @@ func ExecuteAndApply(
 	// journal is authoritative and projections are eventually consistent. The
 	// outbox-only mode has a wider consistency window (seconds) but identical
 	// semantics.
-	if options.InlineApplyEnabled {
+	if options.InlineApplyEnabled && recorder == nil && staged == nil {
 		for _, evt := range result.Decision.Events {
 			if options.ShouldApply != nil && !options.ShouldApply(evt) {
 				continue
@@ -301,3 +332,31 @@ func NormalizeErrorHandlers(options ErrorHandlerOptions) (
 
 	return executeErr, applyErr, rejectErr
 }
+
+// executeOrPreview executes cmd, or dry-runs it through recorder when the
+// request is in preview mode.
+func executeOrPreview(
+	ctx context.Context,
+	executor Executor,
+	recorder *PreviewRecorder,
+	staged *StagedBatch,
+	applier EventApplier,
+	options Options,
+	cmd command.Command,
+) (engine.Result, error) {
+	if staged != nil {
+		decisions, err := staged.stage(ctx, executor, applier, options, []command.Command{cmd})
+		if err != nil {
+			return engine.Result{}, err
+		}
+		return engine.Result{Decision: decisions[0]}, nil
+	}
+	if recorder == nil {
+		return executor.Execute(ctx, cmd)
+	}
+	decisions, err := recorder.record(ctx, executor, []command.Command{cmd})
+	if err != nil {
+		return engine.Result{}, err
+	}
+	return engine.Result{Decision: decisions[0]}, nil
+}
@@ -0,0 +1,84 @@
+package domainwrite
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+)
+
+// PreviewExecutor dry-runs domain commands without appending. The production
+// engine handler satisfies it.
+type PreviewExecutor interface {
+	PreviewBatch(context.Context, []command.Command) (engine.Preview, error)
+}
+
+type previewContextKey struct{}
+
+// PreviewRecorder accumulates the commands a transport handler would execute
+// under a preview context and the dry-run result for all of them.
+//
+// Each command is previewed together with every command recorded before it, so
+// a multi-step workflow observes its own earlier steps through engine state
+// exactly as sequential execution would. Projections are not updated, so
+// workflow steps that branch on projection reads still see pre-preview data.
+type PreviewRecorder struct {
+	mu      sync.Mutex
+	cmds    []command.Command
+	preview engine.Preview
+}
+
+// WithPreview switches every ExecuteAndApply and ExecuteBatchAndApply call
+// under ctx into dry-run mode: commands are decided but never appended or
+// applied. Read the accumulated result from the returned recorder.
+func WithPreview(ctx context.Context) (context.Context, *PreviewRecorder) {
+	recorder := &PreviewRecorder{}
+	return context.WithValue(ctx, previewContextKey{}, recorder), recorder
+}
+
+// Result returns the dry-run outcome for every accepted command recorded so far.
+func (r *PreviewRecorder) Result() engine.Preview {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.preview
+}
+
+// InPreview reports whether writes under ctx are dry runs. Handlers use it to
+// tolerate projection rows a preview never applied.
+func InPreview(ctx context.Context) bool {
+	return previewRecorderFromContext(ctx) != nil
+}
+
+func previewRecorderFromContext(ctx context.Context) *PreviewRecorder {
+	recorder, _ := ctx.Value(previewContextKey{}).(*PreviewRecorder)
+	return recorder
+}
+
+// record previews cmds after the commands already recorded and returns the
+// decisions for cmds alone. Rejected commands are not kept, so the recorder
+// only ever describes a batch the engine would accept.
+func (r *PreviewRecorder) record(ctx context.Context, executor Executor, cmds []command.Command) ([]command.Decision, error) {
+	previewer, ok := executor.(PreviewExecutor)
+	if executor == nil || !ok {
+		return nil, errors.New("domain engine does not support command previews")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	candidate := append(append([]command.Command(nil), r.cmds...), cmds...)
+	preview, err := previewer.PreviewBatch(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+	if len(preview.Decisions) <= len(r.cmds) {
+		return nil, errors.New("command preview stopped before the requested commands")
+	}
+	decisions := preview.Decisions[len(r.cmds):]
+	if !preview.Rejected() {
+		r.cmds = candidate
+		r.preview = preview
+	}
+	return decisions, nil
+}
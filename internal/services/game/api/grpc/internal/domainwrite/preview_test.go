@@ -0,0 +1,106 @@
+package domainwrite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+)
+
+// recordingPreviewExecutor accepts every command with one event named after
+// its type, rejecting the types listed in reject.
+type recordingPreviewExecutor struct {
+	recordingBatchExecutor
+	previews [][]command.Command
+	reject   map[command.Type]bool
+}
+
+func (r *recordingPreviewExecutor) PreviewBatch(_ context.Context, cmds []command.Command) (engine.Preview, error) {
+	r.previews = append(r.previews, cmds)
+	preview := engine.Preview{}
+	for _, cmd := range cmds {
+		if r.reject[cmd.Type] {
+			preview.Decisions = append(preview.Decisions, command.Reject(command.Rejection{Code: "NOPE", Message: "nope"}))
+			return preview, nil
+		}
+		preview.Decisions = append(preview.Decisions, command.Accept(event.Event{Type: event.Type(cmd.Type)}))
+	}
+	preview.Changes = []engine.StateChange{{Path: "Count", After: []byte("1")}}
+	return preview, nil
+}
+
+func TestExecuteAndApply_PreviewAccumulatesCommandsWithoutApplying(t *testing.T) {
+	executor := &recordingPreviewExecutor{}
+	applier := &recordingApplier{}
+	ctx := WithExpectedSeq(context.Background(), 4)
+	ctx, recorder := WithPreview(ctx)
+	options := Options{InlineApplyEnabled: true, RequireEvents: true, MissingEventMsg: "missing"}
+
+	for _, commandType := range []command.Type{"sys.first", "sys.second"} {
+		result, err := ExecuteAndApply(ctx, executor, applier, command.Command{CampaignID: "camp-1", Type: commandType}, options)
+		if err != nil {
+			t.Fatalf("preview %s: %v", commandType, err)
+		}
+		if len(result.Decision.Events) != 1 || result.Decision.Events[0].Type != event.Type(commandType) {
+			t.Fatalf("decision = %+v, want only the %s event", result.Decision, commandType)
+		}
+	}
+	if len(executor.commands) != 0 || len(applier.applied) != 0 {
+		t.Fatalf("executed = %d, applied = %v, want no writes", len(executor.commands), applier.applied)
+	}
+	if len(executor.previews) != 2 || len(executor.previews[1]) != 2 || executor.previews[1][0].ExpectedSeq != 0 {
+		t.Fatalf("previews = %+v, want accumulated batches without expected seq", executor.previews)
+	}
+	if got := recorder.Result(); len(got.Decisions) != 2 || len(got.Changes) != 1 {
+		t.Fatalf("recorded preview = %+v, want both decisions and changes", got)
+	}
+	if claimExpectedSeq(ctx) != 4 {
+		t.Fatal("preview consumed the expected seq precondition")
+	}
+}
+
+func TestExecuteAndApply_PreviewRejectionIsNotRecorded(t *testing.T) {
+	executor := &recordingPreviewExecutor{reject: map[command.Type]bool{"sys.bad": true}}
+	ctx, recorder := WithPreview(context.Background())
+
+	if _, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: "sys.good"}, Options{}); err != nil {
+		t.Fatalf("preview good: %v", err)
+	}
+	_, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: "sys.bad"}, Options{})
+	if err == nil || err.Error() != "nope" {
+		t.Fatalf("error = %v, want rejection", err)
+	}
+	if got := recorder.Result(); len(got.Decisions) != 1 {
+		t.Fatalf("recorded decisions = %d, want only the accepted command", len(got.Decisions))
+	}
+}
+
+func TestExecuteAndApply_PreviewRequiresPreviewExecutor(t *testing.T) {
+	ctx, _ := WithPreview(context.Background())
+	_, err := ExecuteAndApply(ctx, &recordingExecutor{}, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: "sys.first"}, Options{})
+	if err == nil {
+		t.Fatal("expected error for executor without preview support")
+	}
+}
+
+func TestExecuteBatchAndApply_PreviewReturnsBatchDecisions(t *testing.T) {
+	executor := &recordingPreviewExecutor{}
+	applier := &recordingApplier{}
+	ctx, recorder := WithPreview(context.Background())
+
+	result, err := ExecuteBatchAndApply(ctx, executor, applier, []command.Command{
+		{CampaignID: "camp-1", Type: "session.start"},
+		{CampaignID: "camp-1", Type: "session.gm_authority.set"},
+	}, Options{InlineApplyEnabled: true})
+	if err != nil {
+		t.Fatalf("preview batch: %v", err)
+	}
+	if len(executor.batches) != 0 || len(result.Decisions) != 2 || len(applier.applied) != 0 {
+		t.Fatalf("decisions = %d, applied = %v, want 2 decisions and no applies", len(result.Decisions), applier.applied)
+	}
+	if len(recorder.Result().Decisions) != 2 {
+		t.Fatalf("recorded decisions = %d, want 2", len(recorder.Result().Decisions))
+	}
+}
@@ -0,0 +1,241 @@
+package domainwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+type stagedBatchContextKey struct{}
+
+// StagedBatch collects the commands a multi-step transport flow executes and
+// commits them as one all-or-nothing engine batch.
+//
+// Under [WithStagedBatch] every ExecuteAndApply and ExecuteBatchAndApply call
+// is decided through the engine preview pipeline together with the commands
+// staged before it, so a rejection surfaces at the step that caused it and
+// later steps observe earlier ones through engine state. Nothing is appended
+// or applied until [StagedBatch.Commit].
+//
+// Staged events carry provisional seqs that continue from the journal head the
+// batch was opened at. Commit guards the append on that head, so the
+// provisional seqs are exactly the seqs the journal assigns. Stores wrapped
+// with [NewStagedEventStore] serve staged events to later steps; projection
+// stores layer their own overlays through [StagedBatch.Overlay]. Other
+// projection reads would see pre-batch data, so once a step stages a projected
+// event no overlay folds, every later step fails with
+// [ErrStagedProjectionNotOverlaid] instead of deciding on stale reads.
+type StagedBatch struct {
+	campaignID string
+	headSeq    uint64
+	overlaid   func(event.Type) bool
+	recorder   PreviewRecorder
+
+	mu       sync.Mutex
+	steps    []stagedStep
+	events   []event.Event
+	overlays map[any]any
+	// unreadable is the first staged projected event type no overlay folds.
+	unreadable event.Type
+}
+
+// ErrStagedProjectionNotOverlaid reports a staged step that would read a
+// projection an earlier step changed but no staged overlay serves.
+var ErrStagedProjectionNotOverlaid = errors.New("staged step follows a projected event no staged overlay serves")
+
+// stagedStep keeps the apply policy of one staged command for Commit.
+type stagedStep struct {
+	applier EventApplier
+	options Options
+}
+
+// WithStagedBatch switches every write under the returned context into staged
+// mode for campaignID. headSeq is the journal head the caller read before
+// staging began; Commit fails with EXPECTED_SEQ_MISMATCH if it has moved.
+// overlaid reports the event types the caller's staged read overlays fold; nil
+// means none do.
+func WithStagedBatch(ctx context.Context, campaignID string, headSeq uint64, overlaid func(event.Type) bool) (context.Context, *StagedBatch) {
+	batch := &StagedBatch{campaignID: strings.TrimSpace(campaignID), headSeq: headSeq, overlaid: overlaid}
+	return context.WithValue(ctx, stagedBatchContextKey{}, batch), batch
+}
+
+// StagedBatchFromContext returns the batch armed on ctx, or nil.
+func StagedBatchFromContext(ctx context.Context) *StagedBatch {
+	batch, _ := ctx.Value(stagedBatchContextKey{}).(*StagedBatch)
+	return batch
+}
+
+// stagedBatchUnlessPreview returns the staged batch armed on ctx. Preview mode
+// wins when both are armed: a dry run must never stage commits.
+func stagedBatchUnlessPreview(ctx context.Context, recorder *PreviewRecorder) *StagedBatch {
+	if recorder != nil {
+		return nil
+	}
+	return StagedBatchFromContext(ctx)
+}
+
+// withoutStagedBatch masks any staged batch so writes under the returned
+// context reach the live stores.
+func withoutStagedBatch(ctx context.Context) context.Context {
+	if StagedBatchFromContext(ctx) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, stagedBatchContextKey{}, (*StagedBatch)(nil))
+}
+
+// CampaignID returns the campaign the batch writes to.
+func (b *StagedBatch) CampaignID() string {
+	return b.campaignID
+}
+
+// HeadSeq returns the journal head the batch was opened at.
+func (b *StagedBatch) HeadSeq() uint64 {
+	return b.headSeq
+}
+
+// Events returns the staged events in journal order with their provisional
+// seqs.
+func (b *StagedBatch) Events() []event.Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]event.Event(nil), b.events...)
+}
+
+// Overlay returns the value stored under key, creating it with open on first
+// use. Stores use it to keep per-batch read overlays alive exactly as long as
+// the batch.
+func (b *StagedBatch) Overlay(key any, open func() any) any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.overlays == nil {
+		b.overlays = make(map[any]any)
+	}
+	value, ok := b.overlays[key]
+	if !ok {
+		value = open()
+		b.overlays[key] = value
+	}
+	return value
+}
+
+// stage decides cmds after the commands already staged. Accepted commands are
+// kept with the apply policy Commit will use for them; a rejection keeps
+// nothing so the caller can surface it. Staging after a projected event no
+// overlay folds fails before deciding anything.
+func (b *StagedBatch) stage(ctx context.Context, executor Executor, applier EventApplier, options Options, cmds []command.Command) ([]command.Decision, error) {
+	for _, cmd := range cmds {
+		if strings.TrimSpace(string(cmd.CampaignID)) != b.campaignID {
+			return nil, engine.ErrBatchCampaignMismatch
+		}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.unreadable != "" {
+		return nil, fmt.Errorf("%w: %s", ErrStagedProjectionNotOverlaid, b.unreadable)
+	}
+
+	decisions, err := b.recorder.record(ctx, executor, cmds)
+	if err != nil {
+		return nil, err
+	}
+	if batchDecisionsRejected(decisions) {
+		return decisions, nil
+	}
+	for i := range decisions {
+		decisions[i].Events = append([]event.Event(nil), decisions[i].Events...)
+		for j := range decisions[i].Events {
+			decisions[i].Events[j].Seq = b.headSeq + uint64(len(b.events)) + 1
+			b.events = append(b.events, decisions[i].Events[j])
+			b.noteUnreadable(options, decisions[i].Events[j])
+		}
+		b.steps = append(b.steps, stagedStep{applier: applier, options: options})
+	}
+	return decisions, nil
+}
+
+// Commit executes every staged command as one atomic batch guarded on the
+// head the batch was opened at, then applies the events with each command's
+// own apply policy. A request-level expected seq that disagrees with that head
+// fails the commit the same way a moved head does. Committing an empty batch
+// is a no-op.
+func (b *StagedBatch) Commit(ctx context.Context, executor Executor) (engine.BatchResult, error) {
+	ctx = withoutStagedBatch(ctx)
+	b.mu.Lock()
+	cmds := append([]command.Command(nil), b.recorder.cmds...)
+	steps := append([]stagedStep(nil), b.steps...)
+	b.mu.Unlock()
+	if len(cmds) == 0 {
+		return engine.BatchResult{}, nil
+	}
+
+	batchExecutor, ok := executor.(BatchExecutor)
+	if executor == nil || !ok {
+		return engine.BatchResult{}, errors.New("domain engine does not support command batches")
+	}
+	expected := claimExpectedSeq(ctx)
+	if expected != 0 && expected != b.headSeq {
+		return engine.BatchResult{}, apperrors.HandleError(
+			storage.NewExpectedSeqMismatchError(b.campaignID, expected, b.headSeq),
+			grpcmeta.LocaleFromContext(ctx),
+		)
+	}
+	cmds[0].ExpectedSeq = b.headSeq
+
+	result, err := batchExecutor.ExecuteBatch(ctx, cmds)
+	if err == nil && !batchAppended(result) {
+		rearmExpectedSeq(ctx, expected)
+	}
+	if err != nil {
+		if apperrors.IsCode(err, apperrors.CodeExpectedSeqMismatch) {
+			return engine.BatchResult{}, apperrors.HandleError(err, grpcmeta.LocaleFromContext(ctx))
+		}
+		return engine.BatchResult{}, steps[0].options.ExecuteErr(err)
+	}
+	for i, decision := range result.Decisions {
+		if len(decision.Rejections) == 0 {
+			continue
+		}
+		rejection := decision.Rejections[0]
+		return engine.BatchResult{}, steps[i].options.RejectErr(rejection.Code, rejection.Message)
+	}
+	for i, decision := range result.Decisions {
+		options := steps[i].options
+		if !options.InlineApplyEnabled {
+			continue
+		}
+		for _, evt := range decision.Events {
+			if options.ShouldApply != nil && !options.ShouldApply(evt) {
+				continue
+			}
+			if err := steps[i].applier.Apply(ctx, evt); err != nil {
+				return engine.BatchResult{}, options.ApplyErr(err)
+			}
+		}
+	}
+	return result, nil
+}
+
+// noteUnreadable records evt when it will be projected on commit but no
+// staged overlay serves its change to later steps.
+func (b *StagedBatch) noteUnreadable(options Options, evt event.Event) {
+	if b.unreadable != "" || options.ShouldApply == nil || !options.ShouldApply(evt) {
+		return
+	}
+	if b.overlaid != nil && b.overlaid(evt.Type) {
+		return
+	}
+	b.unreadable = evt.Type
+}
+
+func batchDecisionsRejected(decisions []command.Decision) bool {
+	return len(decisions) > 0 && len(decisions[len(decisions)-1].Rejections) > 0
+}
@@ -0,0 +1,138 @@
+package domainwrite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// StagedEventStore layers the events of the request's [StagedBatch] on top of
+// the journal, so later steps of a staged flow read the rolls and outcomes
+// earlier steps staged. Without a staged batch for the campaign on the context
+// every call passes straight through.
+//
+// Staged events are only matched by the exact-field filters of
+// [storage.EventQueryFilter]; an Expression filter sees the journal alone.
+type StagedEventStore struct {
+	storage.EventStore
+}
+
+// NewStagedEventStore wraps events with staged-batch reads.
+func NewStagedEventStore(events storage.EventStore) StagedEventStore {
+	return StagedEventStore{EventStore: events}
+}
+
+// GetLatestEventSeq returns the provisional head when events are staged.
+func (s StagedEventStore) GetLatestEventSeq(ctx context.Context, campaignID string) (uint64, error) {
+	if staged := stagedEvents(ctx, campaignID); len(staged) > 0 {
+		return staged[len(staged)-1].Seq, nil
+	}
+	return s.EventStore.GetLatestEventSeq(ctx, campaignID)
+}
+
+// GetEventBySeq returns a staged event when seq is past the journal head.
+func (s StagedEventStore) GetEventBySeq(ctx context.Context, campaignID string, seq uint64) (event.Event, error) {
+	for _, evt := range stagedEvents(ctx, campaignID) {
+		if evt.Seq == seq {
+			return evt, nil
+		}
+	}
+	return s.EventStore.GetEventBySeq(ctx, campaignID, seq)
+}
+
+// ListEvents appends staged events after the journal page.
+func (s StagedEventStore) ListEvents(ctx context.Context, campaignID string, afterSeq uint64, limit int) ([]event.Event, error) {
+	events, err := s.EventStore.ListEvents(ctx, campaignID, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, evt := range stagedEvents(ctx, campaignID) {
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+		if evt.Seq > afterSeq {
+			events = append(events, evt)
+		}
+	}
+	return events, nil
+}
+
+// ListEventsPage merges matching staged events into the journal page in the
+// requested order.
+func (s StagedEventStore) ListEventsPage(ctx context.Context, req storage.ListEventsPageRequest) (storage.ListEventsPageResult, error) {
+	result, err := s.EventStore.ListEventsPage(ctx, req)
+	if err != nil {
+		return storage.ListEventsPageResult{}, err
+	}
+	staged := stagedEvents(ctx, req.CampaignID)
+	if len(staged) == 0 || strings.TrimSpace(req.Filter.Expression) != "" {
+		return result, nil
+	}
+	matched := make([]event.Event, 0, len(staged))
+	for _, evt := range staged {
+		if stagedEventInPage(evt, req) {
+			matched = append(matched, evt)
+		}
+	}
+	if len(matched) == 0 {
+		return result, nil
+	}
+	result.TotalCount += len(matched)
+	if req.Descending {
+		reversed := make([]event.Event, 0, len(matched)+len(result.Events))
+		for i := len(matched) - 1; i >= 0; i-- {
+			reversed = append(reversed, matched[i])
+		}
+		result.Events = append(reversed, result.Events...)
+	} else {
+		result.Events = append(result.Events, matched...)
+	}
+	if req.PageSize > 0 && len(result.Events) > req.PageSize {
+		result.Events = result.Events[:req.PageSize]
+		result.HasNextPage = true
+	}
+	return result, nil
+}
+
+func stagedEvents(ctx context.Context, campaignID string) []event.Event {
+	batch := StagedBatchFromContext(ctx)
+	if batch == nil || batch.CampaignID() != strings.TrimSpace(campaignID) {
+		return nil
+	}
+	return batch.Events()
+}
+
+func stagedEventInPage(evt event.Event, req storage.ListEventsPageRequest) bool {
+	if evt.Seq <= req.AfterSeq {
+		return false
+	}
+	if req.CursorSeq > 0 {
+		if req.CursorDir == "bwd" && evt.Seq >= req.CursorSeq {
+			return false
+		}
+		if req.CursorDir != "bwd" && evt.Seq <= req.CursorSeq {
+			return false
+		}
+	}
+	filter := req.Filter
+	for _, check := range []struct{ want, got string }{
+		{filter.EventType, string(evt.Type)},
+		{filter.SessionID, evt.SessionID.String()},
+		{filter.SceneID, evt.SceneID.String()},
+		{filter.RequestID, evt.RequestID},
+		{filter.InvocationID, evt.InvocationID},
+		{filter.ActorType, string(evt.ActorType)},
+		{filter.ActorID, evt.ActorID},
+		{filter.SystemID, evt.SystemID},
+		{filter.SystemVersion, evt.SystemVersion},
+		{filter.EntityType, evt.EntityType},
+		{filter.EntityID, evt.EntityID},
+	} {
+		if check.want != "" && check.want != check.got {
+			return false
+		}
+	}
+	return true
+}
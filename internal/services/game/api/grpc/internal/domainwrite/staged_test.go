@@ -0,0 +1,206 @@
+package domainwrite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stagedJournal is an event store holding only a journal head.
+type stagedJournal struct {
+	storage.EventStore
+	head   uint64
+	events map[uint64]event.Event
+}
+
+func (j stagedJournal) GetLatestEventSeq(context.Context, string) (uint64, error) {
+	return j.head, nil
+}
+
+func (j stagedJournal) GetEventBySeq(_ context.Context, _ string, seq uint64) (event.Event, error) {
+	evt, ok := j.events[seq]
+	if !ok {
+		return event.Event{}, storage.ErrNotFound
+	}
+	return evt, nil
+}
+
+func (j stagedJournal) ListEventsPage(context.Context, storage.ListEventsPageRequest) (storage.ListEventsPageResult, error) {
+	return storage.ListEventsPageResult{}, nil
+}
+
+func TestStagedBatch_StagesWritesUntilCommit(t *testing.T) {
+	executor := &recordingPreviewExecutor{}
+	executor.result = engine.BatchResult{Decisions: []command.Decision{
+		command.Accept(event.Event{Type: "sys.first"}),
+		command.Accept(event.Event{Type: "sys.second"}),
+	}}
+	applier := &recordingApplier{}
+	ctx, batch := WithStagedBatch(context.Background(), "camp-1", 10, nil)
+	options := Options{InlineApplyEnabled: true, RequireEvents: true, MissingEventMsg: "missing"}
+
+	for _, commandType := range []command.Type{"sys.first", "sys.second"} {
+		if _, err := ExecuteAndApply(ctx, executor, applier, command.Command{CampaignID: "camp-1", Type: commandType}, options); err != nil {
+			t.Fatalf("stage %s: %v", commandType, err)
+		}
+	}
+	if len(executor.batches) != 0 || len(applier.applied) != 0 {
+		t.Fatalf("batches = %d, applied = %v, want nothing before commit", len(executor.batches), applier.applied)
+	}
+	events := batch.Events()
+	if len(events) != 2 || events[0].Seq != 11 || events[1].Seq != 12 {
+		t.Fatalf("staged events = %+v, want provisional seqs 11 and 12", events)
+	}
+
+	if _, err := batch.Commit(ctx, executor); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if len(executor.batches) != 1 || len(executor.batches[0]) != 2 || executor.batches[0][0].ExpectedSeq != 10 {
+		t.Fatalf("batches = %+v, want one batch guarded on the opening head", executor.batches)
+	}
+	if len(applier.applied) != 2 || applier.applied[0] != "sys.first" || applier.applied[1] != "sys.second" {
+		t.Fatalf("applied = %v, want both events after commit", applier.applied)
+	}
+}
+
+func TestStagedBatch_MidBatchRejectionAppendsNothing(t *testing.T) {
+	executor := &recordingPreviewExecutor{reject: map[command.Type]bool{"sys.bad": true}}
+	applier := &recordingApplier{}
+	ctx, batch := WithStagedBatch(context.Background(), "camp-1", 3, nil)
+	options := Options{InlineApplyEnabled: true}
+
+	if _, err := ExecuteAndApply(ctx, executor, applier, command.Command{CampaignID: "camp-1", Type: "sys.good"}, options); err != nil {
+		t.Fatalf("stage good: %v", err)
+	}
+	_, err := ExecuteBatchAndApply(ctx, executor, applier, []command.Command{
+		{CampaignID: "camp-1", Type: "sys.also_good"},
+		{CampaignID: "camp-1", Type: "sys.bad"},
+	}, options)
+	if err == nil || err.Error() != "nope" {
+		t.Fatalf("error = %v, want rejection", err)
+	}
+	if len(executor.commands) != 0 || len(executor.batches) != 0 || len(applier.applied) != 0 {
+		t.Fatalf("executed = %d, batches = %d, applied = %v, want nothing appended", len(executor.commands), len(executor.batches), applier.applied)
+	}
+	if events := batch.Events(); len(events) != 1 || events[0].Type != "sys.good" {
+		t.Fatalf("staged events = %+v, want only the accepted step", events)
+	}
+}
+
+func TestStagedBatch_CommitRejectsMismatchedExpectedSeq(t *testing.T) {
+	executor := &recordingPreviewExecutor{}
+	ctx := WithExpectedSeq(context.Background(), 4)
+	ctx, batch := WithStagedBatch(ctx, "camp-1", 5, nil)
+
+	if _, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: "sys.first"}, Options{}); err != nil {
+		t.Fatalf("stage: %v", err)
+	}
+	_, err := batch.Commit(ctx, executor)
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.FailedPrecondition {
+		t.Fatalf("error = %v, want FailedPrecondition", err)
+	}
+	if len(executor.batches) != 0 {
+		t.Fatalf("batches = %d, want none", len(executor.batches))
+	}
+}
+
+func TestStagedBatch_RejectsStepsAfterProjectionWithoutOverlay(t *testing.T) {
+	executor := &recordingPreviewExecutor{}
+	overlaid := func(eventType event.Type) bool { return eventType == "sys.overlaid" }
+	ctx, batch := WithStagedBatch(context.Background(), "camp-1", 0, overlaid)
+	options := Options{
+		ShouldApply: func(evt event.Event) bool { return evt.Type != "sys.audit" },
+		ExecuteErr:  func(err error) error { return err },
+	}
+	stage := func(commandType command.Type) error {
+		_, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: commandType}, options)
+		return err
+	}
+
+	// Overlaid and unprojected events leave later reads accurate.
+	for _, commandType := range []command.Type{"sys.overlaid", "sys.audit", "sys.unread"} {
+		if err := stage(commandType); err != nil {
+			t.Fatalf("stage %s: %v", commandType, err)
+		}
+	}
+	// sys.unread changed a projection no overlay serves, so a later step
+	// would decide on pre-batch data.
+	if err := stage("sys.next"); !errors.Is(err, ErrStagedProjectionNotOverlaid) {
+		t.Fatalf("error = %v, want %v", err, ErrStagedProjectionNotOverlaid)
+	}
+	if events := batch.Events(); len(events) != 3 {
+		t.Fatalf("staged events = %+v, want the three accepted steps", events)
+	}
+}
+
+func TestStagedBatch_RejectsOtherCampaign(t *testing.T) {
+	executor := &recordingPreviewExecutor{}
+	ctx, batch := WithStagedBatch(context.Background(), "camp-1", 0, nil)
+
+	if _, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-2", Type: "sys.first"}, Options{}); err == nil {
+		t.Fatal("expected error for command outside the batch campaign")
+	}
+	if len(batch.Events()) != 0 {
+		t.Fatal("staged a command for another campaign")
+	}
+}
+
+func TestStagedBatch_PreviewWins(t *testing.T) {
+	executor := &recordingPreviewExecutor{}
+	ctx, batch := WithStagedBatch(context.Background(), "camp-1", 0, nil)
+	ctx, recorder := WithPreview(ctx)
+
+	if _, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: "sys.first"}, Options{}); err != nil {
+		t.Fatalf("preview: %v", err)
+	}
+	if len(batch.Events()) != 0 || len(recorder.Result().Decisions) != 1 {
+		t.Fatal("preview context staged a commit")
+	}
+}
+
+func TestStagedEventStore_ServesStagedEvents(t *testing.T) {
+	journal := stagedJournal{head: 7, events: map[uint64]event.Event{7: {Seq: 7, Type: "sys.old"}}}
+	store := NewStagedEventStore(journal)
+	ctx, batch := WithStagedBatch(context.Background(), "camp-1", 7, nil)
+	executor := &recordingPreviewExecutor{}
+	for _, commandType := range []command.Type{"sys.roll", "sys.outcome"} {
+		if _, err := ExecuteAndApply(ctx, executor, nilEventApplier{}, command.Command{CampaignID: "camp-1", Type: commandType}, Options{}); err != nil {
+			t.Fatalf("stage %s: %v", commandType, err)
+		}
+	}
+
+	if head, _ := store.GetLatestEventSeq(ctx, "camp-1"); head != 9 {
+		t.Fatalf("head = %d, want provisional head 9", head)
+	}
+	if head, _ := store.GetLatestEventSeq(context.Background(), "camp-1"); head != 7 {
+		t.Fatalf("head without batch = %d, want journal head 7", head)
+	}
+	if evt, err := store.GetEventBySeq(ctx, "camp-1", 8); err != nil || evt.Type != "sys.roll" {
+		t.Fatalf("event 8 = %+v, %v, want staged roll", evt, err)
+	}
+	if evt, err := store.GetEventBySeq(ctx, "camp-1", 7); err != nil || evt.Type != "sys.old" {
+		t.Fatalf("event 7 = %+v, %v, want journal event", evt, err)
+	}
+	page, err := store.ListEventsPage(ctx, storage.ListEventsPageRequest{
+		CampaignID: "camp-1",
+		Descending: true,
+		PageSize:   10,
+		Filter:     storage.EventQueryFilter{EventType: "sys.outcome"},
+	})
+	if err != nil || len(page.Events) != 1 || page.Events[0].Seq != 9 {
+		t.Fatalf("page = %+v, %v, want the staged outcome", page, err)
+	}
+	if head, _ := store.GetLatestEventSeq(ctx, "camp-2"); head != 7 {
+		t.Fatalf("other campaign head = %d, want journal head", head)
+	}
+	if len(batch.Events()) != 2 {
+		t.Fatal("reads changed the staged events")
+	}
+}
@@ -0,0 +1,8 @@
+// Package eventredaction hides the personal identity of users who closed
+// their account from every transport read of the campaign journal.
+//
+// Journal events are immutable and hash-chained, so identity is masked on
+// read rather than rewritten in storage. Wrapping the event store once at
+// startup keeps list, timeline, subscription, fork, and historical replay
+// reads from each needing to remember the redaction step.
+package eventredaction
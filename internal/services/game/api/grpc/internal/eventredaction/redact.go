@@ -0,0 +1,122 @@
+package eventredaction
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// seatScanPageSize bounds each page read while collecting deleted seats.
+const seatScanPageSize = 200
+
+// redactedParticipantKeys lists payload keys that carry a participant's
+// personal identity. Participant update events nest them under "fields".
+var redactedParticipantKeys = map[string]string{
+	"user_id":         "",
+	"prior_user_id":   "",
+	"name":            participant.AnonymizedName,
+	"pronouns":        "",
+	"avatar_set_id":   "",
+	"avatar_asset_id": "",
+}
+
+// deletedSeats maps a participant ID to the seq of the latest unbind caused
+// by account deletion. Events after that seq belong to a later occupant.
+type deletedSeats map[string]uint64
+
+// loadDeletedSeats returns the seats in a campaign whose user closed their
+// account.
+func loadDeletedSeats(ctx context.Context, events storage.EventHistoryStore, campaignID string) (deletedSeats, error) {
+	seats := deletedSeats{}
+	var cursor uint64
+	for {
+		page, err := events.ListEventsPage(ctx, storage.ListEventsPageRequest{
+			CampaignID: campaignID,
+			PageSize:   seatScanPageSize,
+			CursorSeq:  cursor,
+			CursorDir:  "fwd",
+			Filter:     storage.EventQueryFilter{EventType: string(participant.EventTypeUnbound)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, evt := range page.Events {
+			var payload participant.UnbindPayload
+			if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+				continue
+			}
+			if strings.TrimSpace(payload.Reason) == participant.UnbindReasonAccountDeleted {
+				seats[strings.TrimSpace(evt.EntityID)] = evt.Seq
+			}
+			cursor = evt.Seq
+		}
+		if !page.HasNextPage || len(page.Events) == 0 {
+			return seats, nil
+		}
+	}
+}
+
+// DeletionWatermark returns the seq of the latest unbind caused by account
+// deletion in a campaign, or zero when there is none. State built from
+// redacted reads is stale once the watermark moves past the value it was
+// built under.
+func DeletionWatermark(ctx context.Context, events storage.EventHistoryStore, campaignID string) (uint64, error) {
+	seats, err := loadDeletedSeats(ctx, events, campaignID)
+	if err != nil {
+		return 0, err
+	}
+	var watermark uint64
+	for _, seq := range seats {
+		watermark = max(watermark, seq)
+	}
+	return watermark, nil
+}
+
+// hasParticipantEvents reports whether any event could need redaction, so
+// reads without participant events skip the seat scan.
+func hasParticipantEvents(events []event.Event) bool {
+	for _, evt := range events {
+		if evt.EntityType == "participant" {
+			return true
+		}
+	}
+	return false
+}
+
+// redactEvent masks identity fields in participant events that belong to a
+// seat released by account deletion.
+func redactEvent(evt event.Event, seats deletedSeats) event.Event {
+	if len(seats) == 0 || evt.EntityType != "participant" {
+		return evt
+	}
+	unboundSeq, ok := seats[strings.TrimSpace(evt.EntityID)]
+	if !ok || evt.Seq > unboundSeq {
+		return evt
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return evt
+	}
+	redactIdentityFields(payload)
+	if fields, ok := payload["fields"].(map[string]any); ok {
+		redactIdentityFields(fields)
+	}
+	redacted, err := json.Marshal(payload)
+	if err != nil {
+		return evt
+	}
+	evt.PayloadJSON = redacted
+	return evt
+}
+
+func redactIdentityFields(values map[string]any) {
+	for key, replacement := range redactedParticipantKeys {
+		if _, ok := values[key]; ok {
+			values[key] = replacement
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package eventredaction
+
+import (
+	"context"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+var (
+	_ storage.EventStore         = Store{}
+	_ storage.BatchEventAppender = batchStore{}
+)
+
+// Store wraps the journal so every read returns deleted-account identity
+// masked. Appends and head lookups pass straight through.
+type Store struct {
+	storage.EventStore
+}
+
+// batchStore keeps journal imports atomic when the wrapped journal appends
+// batches; embedding the interface alone would hide that capability.
+type batchStore struct {
+	Store
+	storage.BatchEventAppender
+}
+
+// NewStore wraps events with deleted-account redaction. The result still
+// satisfies storage.BatchEventAppender when events does.
+func NewStore(events storage.EventStore) storage.EventStore {
+	store := Store{EventStore: events}
+	if batch, ok := events.(storage.BatchEventAppender); ok {
+		return batchStore{Store: store, BatchEventAppender: batch}
+	}
+	return store
+}
+
+// GetEventByHash returns the event with identity redacted when needed.
+func (s Store) GetEventByHash(ctx context.Context, hash string) (event.Event, error) {
+	evt, err := s.EventStore.GetEventByHash(ctx, hash)
+	if err != nil {
+		return event.Event{}, err
+	}
+	return s.redactOne(ctx, string(evt.CampaignID), evt)
+}
+
+// GetEventBySeq returns the event with identity redacted when needed.
+func (s Store) GetEventBySeq(ctx context.Context, campaignID string, seq uint64) (event.Event, error) {
+	evt, err := s.EventStore.GetEventBySeq(ctx, campaignID, seq)
+	if err != nil {
+		return event.Event{}, err
+	}
+	return s.redactOne(ctx, campaignID, evt)
+}
+
+// ListEvents returns a feed page with identity redacted when needed.
+func (s Store) ListEvents(ctx context.Context, campaignID string, afterSeq uint64, limit int) ([]event.Event, error) {
+	events, err := s.EventStore.ListEvents(ctx, campaignID, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	return s.redactAll(ctx, campaignID, events)
+}
+
+// ListEventsBySession returns a session feed page with identity redacted
+// when needed.
+func (s Store) ListEventsBySession(ctx context.Context, campaignID, sessionID string, afterSeq uint64, limit int) ([]event.Event, error) {
+	events, err := s.EventStore.ListEventsBySession(ctx, campaignID, sessionID, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	return s.redactAll(ctx, campaignID, events)
+}
+
+// ListEventsPage returns a filtered page with identity redacted when needed.
+func (s Store) ListEventsPage(ctx context.Context, req storage.ListEventsPageRequest) (storage.ListEventsPageResult, error) {
+	result, err := s.EventStore.ListEventsPage(ctx, req)
+	if err != nil {
+		return storage.ListEventsPageResult{}, err
+	}
+	result.Events, err = s.redactAll(ctx, req.CampaignID, result.Events)
+	if err != nil {
+		return storage.ListEventsPageResult{}, err
+	}
+	return result, nil
+}
+
+func (s Store) redactOne(ctx context.Context, campaignID string, evt event.Event) (event.Event, error) {
+	events, err := s.redactAll(ctx, campaignID, []event.Event{evt})
+	if err != nil {
+		return event.Event{}, err
+	}
+	return events[0], nil
+}
+
+func (s Store) redactAll(ctx context.Context, campaignID string, events []event.Event) ([]event.Event, error) {
+	if !hasParticipantEvents(events) {
+		return events, nil
+	}
+	seats, err := loadDeletedSeats(ctx, s.EventStore, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if len(seats) == 0 {
+		return events, nil
+	}
+	redacted := make([]event.Event, len(events))
+	for i, evt := range events {
+		redacted[i] = redactEvent(evt, seats)
+	}
+	return redacted, nil
+}
@@ -0,0 +1,100 @@
+package eventredaction
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+func seedDeletedSeat(store *gametest.FakeEventStore) {
+	events := []event.Event{
+		{CampaignID: "c1", Seq: 1, Type: event.Type("participant.joined"), EntityType: "participant", EntityID: "p1", Hash: "h1", PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","name":"Ada"}`)},
+		{CampaignID: "c1", Seq: 2, Type: event.Type("participant.unbound"), EntityType: "participant", EntityID: "p1", Hash: "h2", PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","reason":"account_deleted"}`)},
+		{CampaignID: "c1", Seq: 3, Type: event.Type("participant.bound"), EntityType: "participant", EntityID: "p1", Hash: "h3", PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-2"}`)},
+		{CampaignID: "c1", Seq: 4, Type: event.Type("scene.created"), EntityType: "scene", EntityID: "sc1", Hash: "h4", PayloadJSON: []byte(`{"name":"Ada's tavern"}`)},
+	}
+	store.Events["c1"] = events
+	store.NextSeq["c1"] = uint64(len(events) + 1)
+	for _, evt := range events {
+		store.ByHash[evt.Hash] = evt
+	}
+}
+
+func TestStoreRedactsEveryReadPath(t *testing.T) {
+	ctx := context.Background()
+	inner := gametest.NewFakeEventStore()
+	seedDeletedSeat(inner)
+	store := NewStore(inner)
+
+	byHash, err := store.GetEventByHash(ctx, "h1")
+	if err != nil {
+		t.Fatalf("get by hash: %v", err)
+	}
+	bySeq, err := store.GetEventBySeq(ctx, "c1", 2)
+	if err != nil {
+		t.Fatalf("get by seq: %v", err)
+	}
+	listed, err := store.ListEvents(ctx, "c1", 0, 10)
+	if err != nil {
+		t.Fatalf("list events: %v", err)
+	}
+	page, err := store.ListEventsPage(ctx, storage.ListEventsPageRequest{CampaignID: "c1", PageSize: 10})
+	if err != nil {
+		t.Fatalf("list events page: %v", err)
+	}
+
+	for name, evt := range map[string]event.Event{
+		"by hash":   byHash,
+		"by seq":    bySeq,
+		"list":      listed[0],
+		"list page": page.Events[1],
+	} {
+		if strings.Contains(string(evt.PayloadJSON), "user-1") || strings.Contains(string(evt.PayloadJSON), "Ada") {
+			t.Fatalf("%s leaks identity: %s", name, evt.PayloadJSON)
+		}
+	}
+	if string(inner.Events["c1"][0].PayloadJSON) != `{"participant_id":"p1","user_id":"user-1","name":"Ada"}` {
+		t.Fatal("redaction must not mutate stored events")
+	}
+}
+
+func TestStoreKeepsLaterOccupantsAndOtherEntities(t *testing.T) {
+	inner := gametest.NewFakeEventStore()
+	seedDeletedSeat(inner)
+
+	listed, err := NewStore(inner).ListEvents(context.Background(), "c1", 0, 10)
+	if err != nil {
+		t.Fatalf("list events: %v", err)
+	}
+	if !strings.Contains(string(listed[2].PayloadJSON), "user-2") {
+		t.Fatalf("later occupant was redacted: %s", listed[2].PayloadJSON)
+	}
+	if !strings.Contains(string(listed[3].PayloadJSON), "Ada's tavern") {
+		t.Fatalf("non-participant event was redacted: %s", listed[3].PayloadJSON)
+	}
+}
+
+func TestStoreSurfacesSeatScanErrors(t *testing.T) {
+	inner := gametest.NewFakeEventStore()
+	seedDeletedSeat(inner)
+	store := NewStore(inner)
+	inner.ListErr = errors.New("boom")
+
+	if _, err := store.GetEventByHash(context.Background(), "h1"); err == nil {
+		t.Fatal("expected seat scan error")
+	}
+}
+
+func TestStoreKeepsBatchAppendCapability(t *testing.T) {
+	if _, ok := NewStore(gametest.NewFakeBatchEventStore()).(storage.BatchEventAppender); !ok {
+		t.Fatal("batch-capable journal lost BatchAppendEvents when wrapped")
+	}
+	if _, ok := NewStore(gametest.NewFakeEventStore()).(storage.BatchEventAppender); ok {
+		t.Fatal("single-append journal must not advertise BatchAppendEvents")
+	}
+}
@@ -0,0 +1,7 @@
+// Package historyread serves point-in-time campaign reads by replaying the
+// event journal into ephemeral projection stores.
+//
+// Read handlers keep authorizing against current projections and swap only
+// their state stores for the historical view, so a caller can never gain
+// access by asking about a moment when they were still a participant.
+package historyread
@@ -0,0 +1,85 @@
+package historyread
+
+import (
+	"context"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const sessionEventPageSize = 200
+
+// Point selects the journal position a read should observe. The zero value
+// means the current projection.
+type Point struct {
+	// Seq is the last campaign event sequence included in the view.
+	Seq uint64
+	// SessionID resolves to the last event recorded for an ended session.
+	SessionID string
+}
+
+// NewPoint normalizes request fields into a Point, rejecting requests that
+// set both selectors.
+func NewPoint(seq uint64, sessionID string) (Point, error) {
+	sessionID = strings.TrimSpace(sessionID)
+	if seq > 0 && sessionID != "" {
+		return Point{}, status.Error(codes.InvalidArgument, "as_of_seq and as_of_session_id are mutually exclusive")
+	}
+	return Point{Seq: seq, SessionID: sessionID}, nil
+}
+
+// IsCurrent reports whether the point asks for the live projection.
+func (p Point) IsCurrent() bool {
+	return p.Seq == 0 && strings.TrimSpace(p.SessionID) == ""
+}
+
+// resolve turns a point into a concrete campaign event sequence.
+func (r *Reader) resolve(ctx context.Context, campaignID string, point Point) (uint64, error) {
+	if point.SessionID != "" {
+		return r.resolveSessionEnd(ctx, campaignID, point.SessionID)
+	}
+	latestSeq, err := r.events.GetLatestEventSeq(ctx, campaignID)
+	if err != nil {
+		return 0, grpcerror.Internal("get latest event seq", err)
+	}
+	if point.Seq > latestSeq {
+		return 0, status.Error(codes.FailedPrecondition, "as_of_seq is beyond current campaign state")
+	}
+	return point.Seq, nil
+}
+
+// resolveSessionEnd returns the last event sequence recorded for an ended
+// session so the view reflects the table exactly as the session closed.
+func (r *Reader) resolveSessionEnd(ctx context.Context, campaignID, sessionID string) (uint64, error) {
+	if r.sessions == nil {
+		return 0, status.Error(codes.Internal, "session store is not configured")
+	}
+	sess, err := r.sessions.GetSession(ctx, campaignID, sessionID)
+	if err != nil {
+		return 0, grpcerror.LookupErrorContext(ctx, err, "get session", "session not found")
+	}
+	if sess.Status != session.StatusEnded {
+		return 0, status.Error(codes.FailedPrecondition, "session has not ended")
+	}
+
+	lastSeq := uint64(0)
+	for {
+		events, err := r.events.ListEventsBySession(ctx, campaignID, sessionID, lastSeq, sessionEventPageSize)
+		if err != nil {
+			return 0, grpcerror.Internal("list session events", err)
+		}
+		for _, evt := range events {
+			lastSeq = evt.Seq
+		}
+		if len(events) < sessionEventPageSize {
+			break
+		}
+	}
+	if lastSeq == 0 {
+		return 0, status.Error(codes.FailedPrecondition, "session has no recorded events")
+	}
+	return lastSeq, nil
+}
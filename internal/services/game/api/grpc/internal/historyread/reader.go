@@ -0,0 +1,245 @@
+package historyread
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/eventredaction"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	systemmanifest "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/manifest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/observability/audit"
+	"github.com/louisbranch/fracturing.space/internal/services/game/projection"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultCacheSize bounds how many replayed campaign states stay resident.
+const DefaultCacheSize = 8
+
+// Scratch is an empty, disposable projection store that a replay fills in.
+type Scratch interface {
+	projection.StoreBundle
+	ProjectionStores() systemmanifest.ProjectionStores
+	Close() error
+}
+
+// Config wires the journal, current session projection, and scratch factory.
+type Config struct {
+	// Events is the authoritative journal replayed into scratch stores.
+	Events storage.EventHistoryStore
+	// Sessions resolves session points against the current projection.
+	Sessions storage.SessionReader
+	// Registry drives event intent filtering during replay.
+	Registry *event.Registry
+	// OpenScratch creates one empty scratch store per replayed state.
+	OpenScratch func() (Scratch, error)
+	// CacheSize bounds resident historical states; zero uses DefaultCacheSize.
+	CacheSize int
+}
+
+// View exposes one historical projection state to a read handler.
+type View struct {
+	// Seq is the last event sequence folded into the view.
+	Seq uint64
+	// Stores holds core projections as of Seq.
+	Stores projection.StoreBundle
+	// System holds system-owned projections as of Seq.
+	System systemmanifest.ProjectionStores
+}
+
+// Reader resolves points and serves cached historical projection states.
+type Reader struct {
+	events      storage.EventHistoryStore
+	sessions    storage.SessionReader
+	registry    *event.Registry
+	openScratch func() (Scratch, error)
+	capacity    int
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	order   *list.List
+}
+
+// cacheKey identifies one replayed state. redactedThrough is the campaign's
+// account-deletion watermark when the state was built, so a deletion appended
+// later misses the cache instead of serving identity the replay did not mask.
+type cacheKey struct {
+	campaignID      string
+	seq             uint64
+	redactedThrough uint64
+}
+
+// cacheEntry is shared by concurrent readers of the same state. The builder
+// closes ready once scratch or err is set; refs keeps an evicted entry open
+// until its last reader finishes.
+type cacheEntry struct {
+	key     cacheKey
+	ready   chan struct{}
+	scratch Scratch
+	err     error
+	refs    int
+	evicted bool
+}
+
+// NewReader builds a historical reader, or nil when the journal or scratch
+// factory is missing so callers can degrade to current-only reads.
+func NewReader(config Config) *Reader {
+	if config.Events == nil || config.OpenScratch == nil {
+		return nil
+	}
+	capacity := config.CacheSize
+	if capacity <= 0 {
+		capacity = DefaultCacheSize
+	}
+	return &Reader{
+		events:      config.Events,
+		sessions:    config.Sessions,
+		registry:    config.Registry,
+		openScratch: config.OpenScratch,
+		capacity:    capacity,
+		entries:     make(map[cacheKey]*list.Element),
+		order:       list.New(),
+	}
+}
+
+// Read resolves point for campaignID and calls fn with the matching
+// historical view. The view is only valid for the duration of fn.
+func (r *Reader) Read(ctx context.Context, campaignID string, point Point, fn func(View) error) error {
+	if r == nil {
+		return status.Error(codes.Unimplemented, "point-in-time reads are not configured")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	if point.IsCurrent() {
+		return status.Error(codes.InvalidArgument, "as_of_seq or as_of_session_id is required")
+	}
+	seq, err := r.resolve(ctx, campaignID, point)
+	if err != nil {
+		return err
+	}
+
+	redactedThrough, err := eventredaction.DeletionWatermark(ctx, r.events, campaignID)
+	if err != nil {
+		return grpcerror.Internal("load redaction watermark", err)
+	}
+
+	entry, err := r.acquire(ctx, cacheKey{campaignID: campaignID, seq: seq, redactedThrough: redactedThrough})
+	if err != nil {
+		return err
+	}
+	defer r.release(entry)
+	return fn(View{
+		Seq:    seq,
+		Stores: entry.scratch,
+		System: entry.scratch.ProjectionStores(),
+	})
+}
+
+// acquire returns a ready cache entry for key, building it when absent.
+// Concurrent requests for the same key share a single replay.
+func (r *Reader) acquire(ctx context.Context, key cacheKey) (*cacheEntry, error) {
+	r.mu.Lock()
+	if elem, ok := r.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.refs++
+		r.order.MoveToFront(elem)
+		r.mu.Unlock()
+
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			r.release(entry)
+			return nil, ctx.Err()
+		}
+		if entry.err != nil {
+			r.release(entry)
+			return nil, entry.err
+		}
+		return entry, nil
+	}
+
+	entry := &cacheEntry{key: key, ready: make(chan struct{}), refs: 1}
+	r.entries[key] = r.order.PushFront(entry)
+	r.evictLocked()
+	r.mu.Unlock()
+
+	// Build with a detached context so one caller cancelling does not fail
+	// other readers waiting on the same replay.
+	scratch, err := r.build(context.WithoutCancel(ctx), key)
+	r.mu.Lock()
+	entry.scratch, entry.err = scratch, err
+	if err != nil {
+		r.dropLocked(entry)
+	}
+	close(entry.ready)
+	r.mu.Unlock()
+	if err != nil {
+		r.release(entry)
+		return nil, err
+	}
+	return entry, nil
+}
+
+// release drops one reference and closes the scratch store once an evicted
+// entry has no readers left.
+func (r *Reader) release(entry *cacheEntry) {
+	r.mu.Lock()
+	entry.refs--
+	closeNow := entry.refs == 0 && entry.evicted && entry.scratch != nil
+	r.mu.Unlock()
+	if closeNow {
+		_ = entry.scratch.Close()
+	}
+}
+
+// evictLocked trims the least recently used entries beyond capacity.
+func (r *Reader) evictLocked() {
+	for r.order.Len() > r.capacity {
+		oldest := r.order.Back()
+		entry := oldest.Value.(*cacheEntry)
+		r.dropLocked(entry)
+		if entry.refs == 0 && entry.scratch != nil {
+			_ = entry.scratch.Close()
+		}
+	}
+}
+
+// dropLocked removes an entry from the cache and marks it for closing.
+func (r *Reader) dropLocked(entry *cacheEntry) {
+	if elem, ok := r.entries[entry.key]; ok && elem.Value == entry {
+		r.order.Remove(elem)
+		delete(r.entries, entry.key)
+	}
+	entry.evicted = true
+}
+
+// build replays the journal up to key.seq into a fresh scratch store.
+func (r *Reader) build(ctx context.Context, key cacheKey) (Scratch, error) {
+	scratch, err := r.openScratch()
+	if err != nil {
+		return nil, grpcerror.Internal("open historical projection", err)
+	}
+	applier, err := projection.NewApplierFromBundle(projection.BundleApplierConfig{
+		StoreBundle:  scratch,
+		SystemStores: scratch.ProjectionStores(),
+		Events:       r.registry,
+		AuditPolicy:  audit.DisabledPolicy(),
+	})
+	if err != nil {
+		_ = scratch.Close()
+		return nil, grpcerror.Internal("build historical projection applier", err)
+	}
+	if _, err := projection.ReplayCampaignWith(ctx, r.events, applier, key.campaignID, projection.ReplayOptions{
+		UntilSeq: key.seq,
+	}); err != nil {
+		_ = scratch.Close()
+		return nil, grpcerror.Internal("replay historical projection", err)
+	}
+	return scratch, nil
+}
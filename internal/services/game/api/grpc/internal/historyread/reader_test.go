@@ -0,0 +1,262 @@
+package historyread
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/eventredaction"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/coreprojection"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// countingScratch records how often scratch stores are opened and closed.
+type countingScratch struct {
+	*coreprojection.Store
+	closed *int
+}
+
+func (s countingScratch) Close() error {
+	*s.closed++
+	return s.Store.Close()
+}
+
+type scratchCounter struct {
+	opened int
+	closed int
+}
+
+func (c *scratchCounter) open() (Scratch, error) {
+	store, err := coreprojection.OpenMemory()
+	if err != nil {
+		return nil, err
+	}
+	c.opened++
+	return countingScratch{Store: store, closed: &c.closed}, nil
+}
+
+func newTestReader(t *testing.T, cacheSize int) (*Reader, *scratchCounter, *gametest.FakeSessionStore) {
+	t.Helper()
+
+	events := gametest.NewFakeEventStore()
+	events.Events["camp-1"] = []event.Event{
+		campaignCreatedEvent("camp-1", 1),
+		participantJoinedEvent("camp-1", "part-1", "sess-1", 2),
+		participantJoinedEvent("camp-1", "part-2", "", 3),
+	}
+	events.NextSeq["camp-1"] = 4
+
+	sessions := gametest.NewFakeSessionStore()
+	sessions.Sessions["camp-1"] = map[string]storage.SessionRecord{
+		"sess-1": {ID: "sess-1", CampaignID: "camp-1", Status: session.StatusEnded},
+		"sess-2": {ID: "sess-2", CampaignID: "camp-1", Status: session.StatusActive},
+	}
+
+	counter := &scratchCounter{}
+	reader := NewReader(Config{
+		Events:      events,
+		Sessions:    sessions,
+		OpenScratch: counter.open,
+		CacheSize:   cacheSize,
+	})
+	return reader, counter, sessions
+}
+
+func readParticipantCount(t *testing.T, reader *Reader, point Point) (uint64, int) {
+	t.Helper()
+
+	var seq uint64
+	var count int
+	err := reader.Read(context.Background(), "camp-1", point, func(view View) error {
+		record, err := view.Stores.Get(context.Background(), "camp-1")
+		if err != nil {
+			return err
+		}
+		seq = view.Seq
+		count = record.ParticipantCount
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Read(%+v) error = %v", point, err)
+	}
+	return seq, count
+}
+
+func TestReadReplaysUntilRequestedSeq(t *testing.T) {
+	reader, _, _ := newTestReader(t, 0)
+
+	seq, count := readParticipantCount(t, reader, Point{Seq: 2})
+	if seq != 2 || count != 1 {
+		t.Fatalf("seq = %d count = %d, want 2 and 1", seq, count)
+	}
+	seq, count = readParticipantCount(t, reader, Point{Seq: 3})
+	if seq != 3 || count != 2 {
+		t.Fatalf("seq = %d count = %d, want 3 and 2", seq, count)
+	}
+}
+
+func TestReadResolvesEndedSessionToLastSessionEvent(t *testing.T) {
+	reader, _, _ := newTestReader(t, 0)
+
+	seq, count := readParticipantCount(t, reader, Point{SessionID: "sess-1"})
+	if seq != 2 || count != 1 {
+		t.Fatalf("seq = %d count = %d, want 2 and 1", seq, count)
+	}
+}
+
+func TestReadRejectsUnresolvablePoints(t *testing.T) {
+	reader, _, _ := newTestReader(t, 0)
+
+	tests := []struct {
+		name  string
+		point Point
+		code  codes.Code
+	}{
+		{name: "current", point: Point{}, code: codes.InvalidArgument},
+		{name: "beyond head", point: Point{Seq: 9}, code: codes.FailedPrecondition},
+		{name: "active session", point: Point{SessionID: "sess-2"}, code: codes.FailedPrecondition},
+		{name: "unknown session", point: Point{SessionID: "sess-9"}, code: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := reader.Read(context.Background(), "camp-1", tt.point, func(View) error { return nil })
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %v, want %v (err = %v)", got, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestReadCachesAndEvictsReplayedStates(t *testing.T) {
+	reader, counter, _ := newTestReader(t, 1)
+
+	readParticipantCount(t, reader, Point{Seq: 2})
+	readParticipantCount(t, reader, Point{Seq: 2})
+	if counter.opened != 1 || counter.closed != 0 {
+		t.Fatalf("opened = %d closed = %d, want one cached scratch", counter.opened, counter.closed)
+	}
+	readParticipantCount(t, reader, Point{Seq: 3})
+	if counter.opened != 2 || counter.closed != 1 {
+		t.Fatalf("opened = %d closed = %d, want first scratch evicted", counter.opened, counter.closed)
+	}
+}
+
+func TestReadRebuildsCachedStateAfterAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+	events := gametest.NewFakeEventStore()
+	events.Events["camp-1"] = []event.Event{
+		campaignCreatedEvent("camp-1", 1),
+		participantJoinedEvent("camp-1", "part-1", "", 2),
+	}
+	events.NextSeq["camp-1"] = 3
+	counter := &scratchCounter{}
+	reader := NewReader(Config{
+		Events:      eventredaction.NewStore(events),
+		Sessions:    gametest.NewFakeSessionStore(),
+		OpenScratch: counter.open,
+	})
+
+	readName := func() string {
+		t.Helper()
+		var name string
+		err := reader.Read(ctx, "camp-1", Point{Seq: 2}, func(view View) error {
+			record, err := view.Stores.GetParticipant(ctx, "camp-1", "part-1")
+			name = record.Name
+			return err
+		})
+		if err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+		return name
+	}
+
+	if got := readName(); got != "Player part-1" {
+		t.Fatalf("name before deletion = %q", got)
+	}
+	unbound, _ := json.Marshal(participant.UnbindPayload{ParticipantID: "part-1", Reason: participant.UnbindReasonAccountDeleted})
+	events.Events["camp-1"] = append(events.Events["camp-1"], event.Event{
+		CampaignID:  "camp-1",
+		Seq:         3,
+		Timestamp:   time.Date(2026, 1, 10, 10, 3, 0, 0, time.UTC),
+		Type:        participant.EventTypeUnbound,
+		EntityType:  "participant",
+		EntityID:    "part-1",
+		PayloadJSON: unbound,
+	})
+	events.NextSeq["camp-1"] = 4
+
+	if got := readName(); got != participant.AnonymizedName {
+		t.Fatalf("name after deletion = %q, want %q", got, participant.AnonymizedName)
+	}
+	if counter.opened != 2 {
+		t.Fatalf("opened = %d, want the cached state rebuilt", counter.opened)
+	}
+}
+
+func TestNilReaderReportsUnimplemented(t *testing.T) {
+	var reader *Reader
+	err := reader.Read(context.Background(), "camp-1", Point{Seq: 1}, func(View) error { return nil })
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("code = %v, want Unimplemented", status.Code(err))
+	}
+	if NewReader(Config{}) != nil {
+		t.Fatal("NewReader without journal should return nil")
+	}
+}
+
+func TestNewPointRejectsBothSelectors(t *testing.T) {
+	if _, err := NewPoint(3, "sess-1"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", status.Code(err))
+	}
+	point, err := NewPoint(0, "  ")
+	if err != nil || !point.IsCurrent() {
+		t.Fatalf("NewPoint(0, blank) = %+v, %v; want current point", point, err)
+	}
+}
+
+func campaignCreatedEvent(campaignID string, seq uint64) event.Event {
+	data, _ := json.Marshal(campaign.CreatePayload{
+		Name:       "Test Campaign",
+		GameSystem: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
+		GmMode:     "GM_MODE_HUMAN",
+	})
+	return event.Event{
+		CampaignID:  ids.CampaignID(campaignID),
+		Seq:         seq,
+		Timestamp:   time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC),
+		Type:        campaign.EventTypeCreated,
+		EntityType:  "campaign",
+		EntityID:    campaignID,
+		PayloadJSON: data,
+	}
+}
+
+func participantJoinedEvent(campaignID, participantID, sessionID string, seq uint64) event.Event {
+	data, _ := json.Marshal(participant.JoinPayload{
+		ParticipantID:  ids.ParticipantID(participantID),
+		Name:           "Player " + participantID,
+		Role:           "PLAYER",
+		Controller:     "CONTROLLER_HUMAN",
+		CampaignAccess: "MEMBER",
+	})
+	return event.Event{
+		CampaignID:  ids.CampaignID(campaignID),
+		SessionID:   ids.SessionID(sessionID),
+		Seq:         seq,
+		Timestamp:   time.Date(2026, 1, 10, 10, int(seq), 0, 0, time.UTC),
+		Type:        participant.EventTypeJoined,
+		EntityType:  "participant",
+		EntityID:    participantID,
+		PayloadJSON: data,
+	}
+}
@@ -458,3 +458,63 @@ func TestApplyRest_RejectsSceneCountdownForCampaignCountdownFields(t *testing.T)
 	})
 	grpcassert.StatusCode(t, err, codes.InvalidArgument)
 }
+
+func TestApplyRest_PreviewReturnsWouldBeEventsWithoutAppending(t *testing.T) {
+	svc := newActionTestService()
+	eventStore := svc.stores.Event.(*fakeEventStore)
+
+	payloadJSON, err := json.Marshal(daggerheartpayload.RestTakenPayload{RestType: "short", ShortRests: 1})
+	if err != nil {
+		t.Fatalf("encode rest payload: %v", err)
+	}
+	domain := &fakeDomainEngine{
+		store: eventStore,
+		resultsByType: map[command.Type]engine.Result{
+			command.Type("sys.daggerheart.rest.take"): {
+				Decision: command.Accept(event.Event{
+					CampaignID:    "camp-1",
+					Type:          event.Type("sys.daggerheart.rest_taken"),
+					Timestamp:     testTimestamp,
+					ActorType:     event.ActorTypeSystem,
+					SessionID:     "sess-1",
+					EntityType:    "session",
+					EntityID:      "camp-1",
+					SystemID:      daggerheart.SystemID,
+					SystemVersion: daggerheart.SystemVersion,
+					PayloadJSON:   payloadJSON,
+				}),
+			},
+		},
+		previewChanges: []engine.StateChange{{
+			Path:   "Systems.daggerheart@1.0.0.CharacterStates.char-1.Stress",
+			Before: []byte("3"),
+			After:  []byte("1"),
+		}},
+	}
+	svc.stores.Write.Executor = domain
+
+	resp, err := svc.ApplyRest(contextWithSessionID("sess-1"), &pb.DaggerheartApplyRestRequest{
+		CampaignId: "camp-1",
+		Preview:    true,
+		Rest: &pb.DaggerheartRestRequest{
+			RestType:     pb.DaggerheartRestType_DAGGERHEART_REST_TYPE_SHORT,
+			Participants: []*pb.DaggerheartRestParticipant{{CharacterId: "char-1"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyRest preview returned error: %v", err)
+	}
+	if domain.calls != 0 || len(eventStore.Events["camp-1"]) != 0 {
+		t.Fatalf("execute calls = %d, stored events = %d, want no writes", domain.calls, len(eventStore.Events["camp-1"]))
+	}
+	if resp.GetSnapshot() != nil {
+		t.Fatal("preview response should not carry an applied snapshot")
+	}
+	preview := resp.GetPreview()
+	if len(preview.GetEvents()) != 1 || preview.GetEvents()[0].GetType() != "sys.daggerheart.rest_taken" {
+		t.Fatalf("preview events = %v, want rest_taken", preview.GetEvents())
+	}
+	if len(preview.GetStateChanges()) != 1 || string(preview.GetStateChanges()[0].GetAfterJson()) != "1" {
+		t.Fatalf("preview state changes = %v, want stress change", preview.GetStateChanges())
+	}
+}
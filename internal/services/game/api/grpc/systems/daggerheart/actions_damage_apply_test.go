@@ -156,6 +156,60 @@ func TestApplyDamage_Success(t *testing.T) {
 	}
 }
 
+func TestApplyDamage_PreviewLeavesStateUnchanged(t *testing.T) {
+	svc := newActionTestService()
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+
+	profile := dhStore.Profiles["camp-1:char-1"]
+	state := dhStore.States["camp-1:char-1"]
+	damage := &pb.DaggerheartDamageRequest{
+		Amount:     3,
+		DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+	}
+	payloadJSON := mustDamageAppliedPayloadJSON(t, "char-1", damage, profile, state)
+
+	domain := &fakeDomainEngine{store: eventStore, resultsByType: map[command.Type]engine.Result{
+		command.Type("sys.daggerheart.damage.apply"): {
+			Decision: command.Accept(event.Event{
+				CampaignID:    "camp-1",
+				Type:          event.Type("sys.daggerheart.damage_applied"),
+				Timestamp:     testTimestamp,
+				ActorType:     event.ActorTypeSystem,
+				SessionID:     "sess-1",
+				EntityType:    "character",
+				EntityID:      "char-1",
+				SystemID:      daggerheart.SystemID,
+				SystemVersion: daggerheart.SystemVersion,
+				PayloadJSON:   payloadJSON,
+			}),
+		},
+	}}
+	svc.stores.Write.Executor = domain
+	resp, err := svc.ApplyDamage(contextWithSessionID("sess-1"), &pb.DaggerheartApplyDamageRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		Damage:      damage,
+		Preview:     true,
+	})
+	if err != nil {
+		t.Fatalf("ApplyDamage preview returned error: %v", err)
+	}
+	if domain.calls != 0 || len(domain.previewBatches) != 1 {
+		t.Fatalf("execute calls = %d, preview batches = %d, want preview only", domain.calls, len(domain.previewBatches))
+	}
+	if got := dhStore.States["camp-1:char-1"].Hp; got != state.Hp {
+		t.Fatalf("hp = %d, want unchanged %d after preview", got, state.Hp)
+	}
+	if resp.GetState() != nil {
+		t.Fatal("preview response should not carry applied state")
+	}
+	events := resp.GetPreview().GetEvents()
+	if len(events) != 1 || events[0].GetType() != "sys.daggerheart.damage_applied" || events[0].GetEntityId() != "char-1" {
+		t.Fatalf("preview events = %v, want damage_applied for char-1", events)
+	}
+}
+
 func TestApplyDamage_UsesDomainEngine(t *testing.T) {
 	svc := newActionTestService()
 	eventStore := svc.stores.Event.(*fakeEventStore)
@@ -7,6 +7,8 @@ import (
 
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/gameplaystores"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/workflowtransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/action"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
@@ -216,3 +218,130 @@ func TestSessionAttackFlow_Success(t *testing.T) {
 		t.Fatal("expected attack outcome result")
 	}
 }
+
+// newStagedAttackFlowService wires the attack flow stores the way startup does,
+// so the flow runs as one staged batch.
+func newStagedAttackFlowService(t *testing.T, outcome command.Decision) (*DaggerheartService, *fakeEventStore, *fakeDomainEngine) {
+	t.Helper()
+	svc := newActionTestService()
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	rollJSON, err := json.Marshal(action.RollResolvePayload{
+		RequestID: "req-attack-1",
+		RollSeq:   1,
+		Results:   map[string]any{"d20": 8},
+		Outcome:   pb.Outcome_FAILURE_WITH_FEAR.String(),
+		SystemData: map[string]any{
+			workflowtransport.KeyCharacterID: "char-1",
+			workflowtransport.KeyRollKind:    pb.RollKind_ROLL_KIND_ACTION.String(),
+			workflowtransport.KeyHopeFear:    false,
+			"gm_move":                        false,
+		},
+	})
+	if err != nil {
+		t.Fatalf("encode roll payload: %v", err)
+	}
+	domain := &fakeDomainEngine{store: eventStore, resultsByType: map[command.Type]engine.Result{
+		command.Type("action.roll.resolve"): {
+			Decision: command.Accept(event.Event{
+				CampaignID:  "camp-1",
+				Type:        event.Type("action.roll_resolved"),
+				Timestamp:   testTimestamp,
+				ActorType:   event.ActorTypeSystem,
+				SessionID:   "sess-1",
+				RequestID:   "req-attack-1",
+				EntityType:  "roll",
+				EntityID:    "req-attack-1",
+				PayloadJSON: rollJSON,
+			}),
+		},
+		command.Type("action.outcome.apply"): {Decision: outcome},
+	}}
+	svc.stores.Write.Executor = domain
+	svc.stores.Event = domainwrite.NewStagedEventStore(eventStore)
+	svc.stores.Daggerheart = gameplaystores.NewStagedStore(svc.stores.Daggerheart)
+	svc.stores.SessionGate = gameplaystores.NewStagedSessionGateStore(svc.stores.SessionGate)
+	svc.stores.SessionSpotlight = gameplaystores.NewStagedSessionSpotlightStore(svc.stores.SessionSpotlight)
+	return svc, eventStore, domain
+}
+
+func stagedAttackFlowRequest() *pb.SessionAttackFlowRequest {
+	return &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Difficulty:  10,
+		TargetId:    "char-2",
+		Damage: &pb.DaggerheartAttackDamageSpec{
+			DamageType:         pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+			SourceCharacterIds: []string{"char-1"},
+		},
+		AttackProfile: &pb.SessionAttackFlowRequest_StandardAttack{
+			StandardAttack: &pb.SessionStandardAttackProfile{
+				Trait:       "agility",
+				AttackRange: pb.DaggerheartAttackRange_DAGGERHEART_ATTACK_RANGE_MELEE,
+				DamageDice:  []*pb.DiceSpec{{Sides: 6, Count: 1}},
+			},
+		},
+	}
+}
+
+func TestSessionAttackFlow_CommitsStepsAsOneBatch(t *testing.T) {
+	outcomeJSON, err := json.Marshal(action.OutcomeApplyPayload{
+		RequestID:      "req-attack-1",
+		RollSeq:        1,
+		Targets:        []string{"char-2"},
+		AppliedChanges: []action.OutcomeAppliedChange{},
+	})
+	if err != nil {
+		t.Fatalf("encode outcome payload: %v", err)
+	}
+	svc, eventStore, domain := newStagedAttackFlowService(t, command.Accept(event.Event{
+		CampaignID:  "camp-1",
+		Type:        event.Type("action.outcome_applied"),
+		Timestamp:   testTimestamp,
+		ActorType:   event.ActorTypeSystem,
+		SessionID:   "sess-1",
+		RequestID:   "req-attack-1",
+		EntityID:    "req-attack-1",
+		EntityType:  "outcome",
+		PayloadJSON: outcomeJSON,
+	}))
+
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-attack-1")
+	resp, err := svc.SessionAttackFlow(ctx, stagedAttackFlowRequest())
+	if err != nil {
+		t.Fatalf("SessionAttackFlow returned error: %v", err)
+	}
+	if resp.GetActionRoll().GetRollSeq() != 1 || resp.GetAttackOutcome().GetResult() == nil {
+		t.Fatalf("response = %+v, want roll 1 with an attack outcome", resp)
+	}
+	if domain.calls != 0 || len(domain.batches) != 1 {
+		t.Fatalf("executes = %d, batches = %d, want one batch", domain.calls, len(domain.batches))
+	}
+	if got := len(eventStore.Events["camp-1"]); got != len(domain.batches[0]) || got < 2 {
+		t.Fatalf("journal events = %d, want every staged command committed", got)
+	}
+	if domain.batches[0][0].ExpectedSeq != 0 {
+		t.Fatalf("batch guard = %d, want the opening head 0", domain.batches[0][0].ExpectedSeq)
+	}
+}
+
+func TestSessionAttackFlow_MidBatchRejectionAppendsNothing(t *testing.T) {
+	svc, eventStore, domain := newStagedAttackFlowService(t, command.Reject(command.Rejection{
+		Code:    "OUTCOME_REJECTED",
+		Message: "outcome rejected",
+	}))
+
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-attack-1")
+	_, err := svc.SessionAttackFlow(ctx, stagedAttackFlowRequest())
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+	if len(domain.previewBatches) < 2 {
+		t.Fatalf("previews = %d, want the roll staged before the outcome was rejected", len(domain.previewBatches))
+	}
+	if domain.calls != 0 || len(domain.batches) != 0 {
+		t.Fatalf("executes = %d, batches = %d, want no commit", domain.calls, len(domain.batches))
+	}
+	if got := len(eventStore.Events["camp-1"]); got != 0 {
+		t.Fatalf("journal events = %d, want none after a mid-batch rejection", got)
+	}
+}
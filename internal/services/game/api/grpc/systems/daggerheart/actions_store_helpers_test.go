@@ -33,6 +33,10 @@ type fakeDomainEngine struct {
 	calls         int
 	lastCommand   command.Command
 	commands      []command.Command
+
+	previewBatches [][]command.Command
+	previewChanges []engine.StateChange
+	batches        [][]command.Command
 }
 
 func (f *fakeDomainEngine) Execute(ctx context.Context, cmd command.Command) (engine.Result, error) {
@@ -64,6 +68,52 @@ func (f *fakeDomainEngine) Execute(ctx context.Context, cmd command.Command) (en
 	return result, nil
 }
 
+// ExecuteBatch executes cmds in order with the configured results and appends
+// their events only when every command is accepted.
+func (f *fakeDomainEngine) ExecuteBatch(ctx context.Context, cmds []command.Command) (engine.BatchResult, error) {
+	f.batches = append(f.batches, cmds)
+	preview, err := f.PreviewBatch(ctx, cmds)
+	if err != nil || preview.Rejected() {
+		return engine.BatchResult{Decisions: preview.Decisions}, err
+	}
+	f.previewBatches = f.previewBatches[:len(f.previewBatches)-1]
+	result := engine.BatchResult{Decisions: preview.Decisions}
+	if f.store == nil {
+		return result, nil
+	}
+	for i, decision := range result.Decisions {
+		stored := make([]event.Event, 0, len(decision.Events))
+		for _, evt := range decision.Events {
+			storedEvent, err := f.store.AppendEvent(ctx, evt)
+			if err != nil {
+				return engine.BatchResult{}, err
+			}
+			stored = append(stored, storedEvent)
+		}
+		result.Decisions[i].Events = stored
+	}
+	return result, nil
+}
+
+// PreviewBatch dry-runs cmds with the configured results and never touches
+// the event store, so tests can assert preview requests leave no writes.
+func (f *fakeDomainEngine) PreviewBatch(_ context.Context, cmds []command.Command) (engine.Preview, error) {
+	f.previewBatches = append(f.previewBatches, cmds)
+	preview := engine.Preview{}
+	for _, cmd := range cmds {
+		result := f.result
+		if selected, ok := f.resultsByType[cmd.Type]; ok {
+			result = selected
+		}
+		preview.Decisions = append(preview.Decisions, result.Decision)
+		if len(result.Decision.Rejections) > 0 {
+			return preview, nil
+		}
+	}
+	preview.Changes = f.previewChanges
+	return preview, nil
+}
+
 type fakeCharacterStore = gamefakes.CharacterStore
 
 func newFakeCharacterStore() *fakeCharacterStore {
@@ -0,0 +1,519 @@
+package gameplaystores
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// StagedStore serves Daggerheart projection reads for a staged write batch
+// from an overlay that folds the batch's staged events over the live
+// projection, so later steps of an atomic flow see the hope, armor, and
+// adversary changes earlier steps staged. Without a staged batch on the
+// context every call goes to the wrapped store.
+type StagedStore struct {
+	GameplayStore
+}
+
+// NewStagedStore wraps store with staged-batch overlays.
+func NewStagedStore(store GameplayStore) StagedStore {
+	return StagedStore{GameplayStore: store}
+}
+
+// StagedReads reports whether the event, Daggerheart, session gate, and
+// session spotlight stores serve the staged events of a
+// [domainwrite.StagedBatch], which multi-step flows need before they can run
+// as one batch.
+func (s Stores) StagedReads() bool {
+	_, events := s.Event.(domainwrite.StagedEventStore)
+	_, daggerheart := s.Daggerheart.(StagedStore)
+	_, gates := s.SessionGate.(StagedSessionGateStore)
+	_, spotlights := s.SessionSpotlight.(StagedSessionSpotlightStore)
+	return events && daggerheart && gates && spotlights
+}
+
+func stagedEventStore(events storage.EventStore) storage.EventStore {
+	if events == nil {
+		return nil
+	}
+	return domainwrite.NewStagedEventStore(events)
+}
+
+func stagedGameplayStore(store GameplayStore) GameplayStore {
+	if store == nil {
+		return nil
+	}
+	return NewStagedStore(store)
+}
+
+// stagedOverlayKey identifies the Daggerheart overlay among a batch's overlays.
+type stagedOverlayKey struct{}
+
+// view returns the store reads and writes under ctx should use.
+func (s StagedStore) view(ctx context.Context) (projectionstore.Store, error) {
+	batch := domainwrite.StagedBatchFromContext(ctx)
+	if batch == nil {
+		return s.GameplayStore, nil
+	}
+	overlay := batch.Overlay(stagedOverlayKey{}, func() any {
+		return newStagedOverlay(s.GameplayStore)
+	}).(*stagedOverlay)
+	if err := overlay.catchUp(ctx, batch.Events()); err != nil {
+		return nil, err
+	}
+	return overlay, nil
+}
+
+func (s StagedStore) PutDaggerheartCharacterProfile(ctx context.Context, profile projectionstore.DaggerheartCharacterProfile) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutDaggerheartCharacterProfile(ctx, profile)
+}
+
+func (s StagedStore) GetDaggerheartCharacterProfile(ctx context.Context, campaignID, characterID string) (projectionstore.DaggerheartCharacterProfile, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return projectionstore.DaggerheartCharacterProfile{}, err
+	}
+	return store.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+}
+
+func (s StagedStore) ListDaggerheartCharacterProfiles(ctx context.Context, campaignID string, pageSize int, pageToken string) (projectionstore.DaggerheartCharacterProfilePage, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return projectionstore.DaggerheartCharacterProfilePage{}, err
+	}
+	return store.ListDaggerheartCharacterProfiles(ctx, campaignID, pageSize, pageToken)
+}
+
+func (s StagedStore) DeleteDaggerheartCharacterProfile(ctx context.Context, campaignID, characterID string) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.DeleteDaggerheartCharacterProfile(ctx, campaignID, characterID)
+}
+
+func (s StagedStore) PutDaggerheartCharacterState(ctx context.Context, state projectionstore.DaggerheartCharacterState) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutDaggerheartCharacterState(ctx, state)
+}
+
+func (s StagedStore) GetDaggerheartCharacterState(ctx context.Context, campaignID, characterID string) (projectionstore.DaggerheartCharacterState, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return projectionstore.DaggerheartCharacterState{}, err
+	}
+	return store.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+}
+
+func (s StagedStore) PutDaggerheartSnapshot(ctx context.Context, snap projectionstore.DaggerheartSnapshot) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutDaggerheartSnapshot(ctx, snap)
+}
+
+func (s StagedStore) GetDaggerheartSnapshot(ctx context.Context, campaignID string) (projectionstore.DaggerheartSnapshot, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return projectionstore.DaggerheartSnapshot{}, err
+	}
+	return store.GetDaggerheartSnapshot(ctx, campaignID)
+}
+
+func (s StagedStore) PutDaggerheartCountdown(ctx context.Context, countdown projectionstore.DaggerheartCountdown) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutDaggerheartCountdown(ctx, countdown)
+}
+
+func (s StagedStore) GetDaggerheartCountdown(ctx context.Context, campaignID, countdownID string) (projectionstore.DaggerheartCountdown, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return projectionstore.DaggerheartCountdown{}, err
+	}
+	return store.GetDaggerheartCountdown(ctx, campaignID, countdownID)
+}
+
+func (s StagedStore) ListDaggerheartCountdowns(ctx context.Context, campaignID string) ([]projectionstore.DaggerheartCountdown, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return store.ListDaggerheartCountdowns(ctx, campaignID)
+}
+
+func (s StagedStore) DeleteDaggerheartCountdown(ctx context.Context, campaignID, countdownID string) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.DeleteDaggerheartCountdown(ctx, campaignID, countdownID)
+}
+
+func (s StagedStore) PutDaggerheartAdversary(ctx context.Context, adversary projectionstore.DaggerheartAdversary) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutDaggerheartAdversary(ctx, adversary)
+}
+
+func (s StagedStore) GetDaggerheartAdversary(ctx context.Context, campaignID, adversaryID string) (projectionstore.DaggerheartAdversary, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return projectionstore.DaggerheartAdversary{}, err
+	}
+	return store.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
+}
+
+func (s StagedStore) ListDaggerheartAdversaries(ctx context.Context, campaignID, sessionID string) ([]projectionstore.DaggerheartAdversary, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return store.ListDaggerheartAdversaries(ctx, campaignID, sessionID)
+}
+
+func (s StagedStore) DeleteDaggerheartAdversary(ctx context.Context, campaignID, adversaryID string) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.DeleteDaggerheartAdversary(ctx, campaignID, adversaryID)
+}
+
+func (s StagedStore) PutDaggerheartEnvironmentEntity(ctx context.Context, environmentEntity projectionstore.DaggerheartEnvironmentEntity) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutDaggerheartEnvironmentEntity(ctx, environmentEntity)
+}
+
+func (s StagedStore) GetDaggerheartEnvironmentEntity(ctx context.Context, campaignID, environmentEntityID string) (projectionstore.DaggerheartEnvironmentEntity, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return projectionstore.DaggerheartEnvironmentEntity{}, err
+	}
+	return store.GetDaggerheartEnvironmentEntity(ctx, campaignID, environmentEntityID)
+}
+
+func (s StagedStore) ListDaggerheartEnvironmentEntities(ctx context.Context, campaignID, sessionID, sceneID string) ([]projectionstore.DaggerheartEnvironmentEntity, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return store.ListDaggerheartEnvironmentEntities(ctx, campaignID, sessionID, sceneID)
+}
+
+func (s StagedStore) DeleteDaggerheartEnvironmentEntity(ctx context.Context, campaignID, environmentEntityID string) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.DeleteDaggerheartEnvironmentEntity(ctx, campaignID, environmentEntityID)
+}
+
+// stagedOverlay is a copy-on-write Daggerheart projection for one staged
+// batch. Rows written while folding staged events shadow the live rows; a nil
+// row marks a staged delete. Reads of untouched rows fall through to base.
+type stagedOverlay struct {
+	base projectionstore.Store
+
+	// applyMu serializes catch-up; mu guards the rows the adapter writes.
+	applyMu sync.Mutex
+	applied int
+	handled map[event.Type]bool
+
+	mu           sync.Mutex
+	profiles     map[string]*projectionstore.DaggerheartCharacterProfile
+	states       map[string]*projectionstore.DaggerheartCharacterState
+	snapshots    map[string]*projectionstore.DaggerheartSnapshot
+	countdowns   map[string]*projectionstore.DaggerheartCountdown
+	adversaries  map[string]*projectionstore.DaggerheartAdversary
+	environments map[string]*projectionstore.DaggerheartEnvironmentEntity
+}
+
+func newStagedOverlay(base projectionstore.Store) *stagedOverlay {
+	return &stagedOverlay{
+		base:         base,
+		profiles:     make(map[string]*projectionstore.DaggerheartCharacterProfile),
+		states:       make(map[string]*projectionstore.DaggerheartCharacterState),
+		snapshots:    make(map[string]*projectionstore.DaggerheartSnapshot),
+		countdowns:   make(map[string]*projectionstore.DaggerheartCountdown),
+		adversaries:  make(map[string]*projectionstore.DaggerheartAdversary),
+		environments: make(map[string]*projectionstore.DaggerheartEnvironmentEntity),
+	}
+}
+
+// catchUp folds the staged events the overlay has not seen yet through the
+// Daggerheart projection adapter. Core events are left to core projections.
+func (o *stagedOverlay) catchUp(ctx context.Context, events []event.Event) error {
+	o.applyMu.Lock()
+	defer o.applyMu.Unlock()
+	if o.applied >= len(events) {
+		return nil
+	}
+	adapter := daggerheart.NewAdapter(o)
+	if o.handled == nil {
+		o.handled = o.handledTypes()
+	}
+	for _, evt := range events[o.applied:] {
+		if o.handled[evt.Type] {
+			if err := adapter.Apply(ctx, evt); err != nil {
+				return err
+			}
+		}
+		o.applied++
+	}
+	return nil
+}
+
+// handledTypes returns the event types the Daggerheart projection adapter
+// folds.
+func (o *stagedOverlay) handledTypes() map[event.Type]bool {
+	handled := make(map[event.Type]bool)
+	for _, eventType := range daggerheart.NewAdapter(o).HandledTypes() {
+		handled[eventType] = true
+	}
+	return handled
+}
+
+func overlayKey(campaignID, id string) string {
+	return campaignID + "/" + id
+}
+
+// lookupStaged returns the staged row for key, reporting whether the overlay
+// holds one. A held nil row is a staged delete.
+func lookupStaged[T any](mu *sync.Mutex, rows map[string]*T, key string) (*T, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	row, ok := rows[key]
+	if !ok || row == nil {
+		return nil, ok
+	}
+	copied := *row
+	return &copied, true
+}
+
+func putStaged[T any](mu *sync.Mutex, rows map[string]*T, key string, row *T) {
+	mu.Lock()
+	defer mu.Unlock()
+	rows[key] = row
+}
+
+// mergeStaged replaces or drops live rows shadowed by the overlay, then
+// appends rows only the overlay holds in key order.
+func mergeStaged[T any](mu *sync.Mutex, rows map[string]*T, live []T, keyOf func(T) string, include func(T) bool) []T {
+	mu.Lock()
+	defer mu.Unlock()
+	merged := make([]T, 0, len(live))
+	seen := make(map[string]bool, len(live))
+	for _, row := range live {
+		key := keyOf(row)
+		seen[key] = true
+		staged, ok := rows[key]
+		switch {
+		case !ok:
+			merged = append(merged, row)
+		case staged != nil && include(*staged):
+			merged = append(merged, *staged)
+		}
+	}
+	added := make([]string, 0)
+	for key, staged := range rows {
+		if !seen[key] && staged != nil && include(*staged) {
+			added = append(added, key)
+		}
+	}
+	sort.Strings(added)
+	for _, key := range added {
+		merged = append(merged, *rows[key])
+	}
+	return merged
+}
+
+func (o *stagedOverlay) PutDaggerheartCharacterProfile(_ context.Context, profile projectionstore.DaggerheartCharacterProfile) error {
+	putStaged(&o.mu, o.profiles, overlayKey(profile.CampaignID, profile.CharacterID), &profile)
+	return nil
+}
+
+func (o *stagedOverlay) GetDaggerheartCharacterProfile(ctx context.Context, campaignID, characterID string) (projectionstore.DaggerheartCharacterProfile, error) {
+	if row, ok := lookupStaged(&o.mu, o.profiles, overlayKey(campaignID, characterID)); ok {
+		if row == nil {
+			return projectionstore.DaggerheartCharacterProfile{}, storage.ErrNotFound
+		}
+		return *row, nil
+	}
+	return o.base.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+}
+
+// ListDaggerheartCharacterProfiles shadows rows within each live page and adds
+// overlay-only profiles to the last page.
+func (o *stagedOverlay) ListDaggerheartCharacterProfiles(ctx context.Context, campaignID string, pageSize int, pageToken string) (projectionstore.DaggerheartCharacterProfilePage, error) {
+	page, err := o.base.ListDaggerheartCharacterProfiles(ctx, campaignID, pageSize, pageToken)
+	if err != nil {
+		return projectionstore.DaggerheartCharacterProfilePage{}, err
+	}
+	lastPage := page.NextPageToken == ""
+	keyOf := func(profile projectionstore.DaggerheartCharacterProfile) string {
+		return overlayKey(profile.CampaignID, profile.CharacterID)
+	}
+	seen := make(map[string]bool, len(page.Profiles))
+	for _, profile := range page.Profiles {
+		seen[keyOf(profile)] = true
+	}
+	page.Profiles = mergeStaged(&o.mu, o.profiles, page.Profiles, keyOf, func(profile projectionstore.DaggerheartCharacterProfile) bool {
+		return profile.CampaignID == campaignID && (lastPage || seen[keyOf(profile)])
+	})
+	return page, nil
+}
+
+func (o *stagedOverlay) DeleteDaggerheartCharacterProfile(_ context.Context, campaignID, characterID string) error {
+	putStaged[projectionstore.DaggerheartCharacterProfile](&o.mu, o.profiles, overlayKey(campaignID, characterID), nil)
+	return nil
+}
+
+func (o *stagedOverlay) PutDaggerheartCharacterState(_ context.Context, state projectionstore.DaggerheartCharacterState) error {
+	putStaged(&o.mu, o.states, overlayKey(state.CampaignID, state.CharacterID), &state)
+	return nil
+}
+
+func (o *stagedOverlay) GetDaggerheartCharacterState(ctx context.Context, campaignID, characterID string) (projectionstore.DaggerheartCharacterState, error) {
+	if row, ok := lookupStaged(&o.mu, o.states, overlayKey(campaignID, characterID)); ok && row != nil {
+		return *row, nil
+	}
+	return o.base.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+}
+
+func (o *stagedOverlay) PutDaggerheartSnapshot(_ context.Context, snap projectionstore.DaggerheartSnapshot) error {
+	putStaged(&o.mu, o.snapshots, snap.CampaignID, &snap)
+	return nil
+}
+
+func (o *stagedOverlay) GetDaggerheartSnapshot(ctx context.Context, campaignID string) (projectionstore.DaggerheartSnapshot, error) {
+	if row, ok := lookupStaged(&o.mu, o.snapshots, campaignID); ok && row != nil {
+		return *row, nil
+	}
+	return o.base.GetDaggerheartSnapshot(ctx, campaignID)
+}
+
+func (o *stagedOverlay) PutDaggerheartCountdown(_ context.Context, countdown projectionstore.DaggerheartCountdown) error {
+	putStaged(&o.mu, o.countdowns, overlayKey(countdown.CampaignID, countdown.CountdownID), &countdown)
+	return nil
+}
+
+func (o *stagedOverlay) GetDaggerheartCountdown(ctx context.Context, campaignID, countdownID string) (projectionstore.DaggerheartCountdown, error) {
+	if row, ok := lookupStaged(&o.mu, o.countdowns, overlayKey(campaignID, countdownID)); ok {
+		if row == nil {
+			return projectionstore.DaggerheartCountdown{}, storage.ErrNotFound
+		}
+		return *row, nil
+	}
+	return o.base.GetDaggerheartCountdown(ctx, campaignID, countdownID)
+}
+
+func (o *stagedOverlay) ListDaggerheartCountdowns(ctx context.Context, campaignID string) ([]projectionstore.DaggerheartCountdown, error) {
+	live, err := o.base.ListDaggerheartCountdowns(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	return mergeStaged(&o.mu, o.countdowns, live,
+		func(countdown projectionstore.DaggerheartCountdown) string {
+			return overlayKey(countdown.CampaignID, countdown.CountdownID)
+		},
+		func(countdown projectionstore.DaggerheartCountdown) bool {
+			return countdown.CampaignID == campaignID
+		}), nil
+}
+
+func (o *stagedOverlay) DeleteDaggerheartCountdown(_ context.Context, campaignID, countdownID string) error {
+	putStaged[projectionstore.DaggerheartCountdown](&o.mu, o.countdowns, overlayKey(campaignID, countdownID), nil)
+	return nil
+}
+
+func (o *stagedOverlay) PutDaggerheartAdversary(_ context.Context, adversary projectionstore.DaggerheartAdversary) error {
+	putStaged(&o.mu, o.adversaries, overlayKey(adversary.CampaignID, adversary.AdversaryID), &adversary)
+	return nil
+}
+
+func (o *stagedOverlay) GetDaggerheartAdversary(ctx context.Context, campaignID, adversaryID string) (projectionstore.DaggerheartAdversary, error) {
+	if row, ok := lookupStaged(&o.mu, o.adversaries, overlayKey(campaignID, adversaryID)); ok {
+		if row == nil {
+			return projectionstore.DaggerheartAdversary{}, storage.ErrNotFound
+		}
+		return *row, nil
+	}
+	return o.base.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
+}
+
+func (o *stagedOverlay) ListDaggerheartAdversaries(ctx context.Context, campaignID, sessionID string) ([]projectionstore.DaggerheartAdversary, error) {
+	live, err := o.base.ListDaggerheartAdversaries(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return mergeStaged(&o.mu, o.adversaries, live,
+		func(adversary projectionstore.DaggerheartAdversary) string {
+			return overlayKey(adversary.CampaignID, adversary.AdversaryID)
+		},
+		func(adversary projectionstore.DaggerheartAdversary) bool {
+			return adversary.CampaignID == campaignID && (sessionID == "" || adversary.SessionID == sessionID)
+		}), nil
+}
+
+func (o *stagedOverlay) DeleteDaggerheartAdversary(_ context.Context, campaignID, adversaryID string) error {
+	putStaged[projectionstore.DaggerheartAdversary](&o.mu, o.adversaries, overlayKey(campaignID, adversaryID), nil)
+	return nil
+}
+
+func (o *stagedOverlay) PutDaggerheartEnvironmentEntity(_ context.Context, environmentEntity projectionstore.DaggerheartEnvironmentEntity) error {
+	putStaged(&o.mu, o.environments, overlayKey(environmentEntity.CampaignID, environmentEntity.EnvironmentEntityID), &environmentEntity)
+	return nil
+}
+
+func (o *stagedOverlay) GetDaggerheartEnvironmentEntity(ctx context.Context, campaignID, environmentEntityID string) (projectionstore.DaggerheartEnvironmentEntity, error) {
+	if row, ok := lookupStaged(&o.mu, o.environments, overlayKey(campaignID, environmentEntityID)); ok {
+		if row == nil {
+			return projectionstore.DaggerheartEnvironmentEntity{}, storage.ErrNotFound
+		}
+		return *row, nil
+	}
+	return o.base.GetDaggerheartEnvironmentEntity(ctx, campaignID, environmentEntityID)
+}
+
+func (o *stagedOverlay) ListDaggerheartEnvironmentEntities(ctx context.Context, campaignID, sessionID, sceneID string) ([]projectionstore.DaggerheartEnvironmentEntity, error) {
+	live, err := o.base.ListDaggerheartEnvironmentEntities(ctx, campaignID, sessionID, sceneID)
+	if err != nil {
+		return nil, err
+	}
+	return mergeStaged(&o.mu, o.environments, live,
+		func(entity projectionstore.DaggerheartEnvironmentEntity) string {
+			return overlayKey(entity.CampaignID, entity.EnvironmentEntityID)
+		},
+		func(entity projectionstore.DaggerheartEnvironmentEntity) bool {
+			return entity.CampaignID == campaignID &&
+				entity.SessionID == sessionID &&
+				(sceneID == "" || entity.SceneID == sceneID)
+		}), nil
+}
+
+func (o *stagedOverlay) DeleteDaggerheartEnvironmentEntity(_ context.Context, campaignID, environmentEntityID string) error {
+	putStaged[projectionstore.DaggerheartEnvironmentEntity](&o.mu, o.environments, overlayKey(campaignID, environmentEntityID), nil)
+	return nil
+}
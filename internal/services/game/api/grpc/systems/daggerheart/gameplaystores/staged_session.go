@@ -0,0 +1,283 @@
+package gameplaystores
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/projection"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// stagedSessionGateTypes and stagedSessionSpotlightTypes are the core events
+// the staged session overlays fold. Staged flows open GM-consequence gates and
+// set the spotlight, then check both before the next step.
+var (
+	stagedSessionGateTypes = map[event.Type]bool{
+		session.EventTypeGateOpened:           true,
+		session.EventTypeGateResponseRecorded: true,
+		session.EventTypeGateResolved:         true,
+		session.EventTypeGateAbandoned:        true,
+	}
+	stagedSessionSpotlightTypes = map[event.Type]bool{
+		session.EventTypeSpotlightSet:     true,
+		session.EventTypeSpotlightCleared: true,
+	}
+)
+
+// StagedOverlayCovers reports whether a staged read overlay folds events of
+// eventType, so steps after it in a staged batch read its projected change.
+func StagedOverlayCovers(eventType event.Type) bool {
+	return stagedSessionGateTypes[eventType] ||
+		stagedSessionSpotlightTypes[eventType] ||
+		daggerheartHandledTypes()[eventType]
+}
+
+// daggerheartHandledTypes lists the events the Daggerheart overlay folds.
+var daggerheartHandledTypes = sync.OnceValue(func() map[event.Type]bool {
+	return newStagedOverlay(nil).handledTypes()
+})
+
+// StagedSessionGateStore serves session gate reads for a staged write batch
+// from an overlay that folds the batch's staged gate events over the live
+// projection, so a step sees a gate an earlier step opened. Without a staged
+// batch on the context every call goes to the wrapped store.
+type StagedSessionGateStore struct {
+	storage.SessionGateStore
+}
+
+// NewStagedSessionGateStore wraps store with staged-batch overlays.
+func NewStagedSessionGateStore(store storage.SessionGateStore) StagedSessionGateStore {
+	return StagedSessionGateStore{SessionGateStore: store}
+}
+
+// StagedSessionSpotlightStore serves session spotlight reads for a staged
+// write batch the same way [StagedSessionGateStore] serves gates.
+type StagedSessionSpotlightStore struct {
+	storage.SessionSpotlightStore
+}
+
+// NewStagedSessionSpotlightStore wraps store with staged-batch overlays.
+func NewStagedSessionSpotlightStore(store storage.SessionSpotlightStore) StagedSessionSpotlightStore {
+	return StagedSessionSpotlightStore{SessionSpotlightStore: store}
+}
+
+func stagedSessionGateStore(store storage.SessionGateStore) storage.SessionGateStore {
+	if store == nil {
+		return nil
+	}
+	return NewStagedSessionGateStore(store)
+}
+
+func stagedSessionSpotlightStore(store storage.SessionSpotlightStore) storage.SessionSpotlightStore {
+	if store == nil {
+		return nil
+	}
+	return NewStagedSessionSpotlightStore(store)
+}
+
+// stagedSessionGateOverlayKey and stagedSessionSpotlightOverlayKey identify
+// the core session overlays among a batch's overlays.
+type (
+	stagedSessionGateOverlayKey      struct{}
+	stagedSessionSpotlightOverlayKey struct{}
+)
+
+// view returns the store reads and writes under ctx should use.
+func (s StagedSessionGateStore) view(ctx context.Context) (storage.SessionGateStore, error) {
+	batch := domainwrite.StagedBatchFromContext(ctx)
+	if batch == nil {
+		return s.SessionGateStore, nil
+	}
+	overlay := batch.Overlay(stagedSessionGateOverlayKey{}, func() any {
+		gates := &stagedSessionGateOverlay{base: s.SessionGateStore, rows: make(map[string]*storage.SessionGate)}
+		gates.fold = stagedCoreFold{types: stagedSessionGateTypes, applier: projection.Applier{SessionGate: gates}}
+		return gates
+	}).(*stagedSessionGateOverlay)
+	if err := overlay.fold.catchUp(ctx, batch.Events()); err != nil {
+		return nil, err
+	}
+	return overlay, nil
+}
+
+func (s StagedSessionGateStore) GetSessionGate(ctx context.Context, campaignID, sessionID, gateID string) (storage.SessionGate, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return storage.SessionGate{}, err
+	}
+	return store.GetSessionGate(ctx, campaignID, sessionID, gateID)
+}
+
+func (s StagedSessionGateStore) GetOpenSessionGate(ctx context.Context, campaignID, sessionID string) (storage.SessionGate, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return storage.SessionGate{}, err
+	}
+	return store.GetOpenSessionGate(ctx, campaignID, sessionID)
+}
+
+func (s StagedSessionGateStore) PutSessionGate(ctx context.Context, gate storage.SessionGate) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutSessionGate(ctx, gate)
+}
+
+// view returns the store reads and writes under ctx should use.
+func (s StagedSessionSpotlightStore) view(ctx context.Context) (storage.SessionSpotlightStore, error) {
+	batch := domainwrite.StagedBatchFromContext(ctx)
+	if batch == nil {
+		return s.SessionSpotlightStore, nil
+	}
+	overlay := batch.Overlay(stagedSessionSpotlightOverlayKey{}, func() any {
+		spotlights := &stagedSessionSpotlightOverlay{base: s.SessionSpotlightStore, rows: make(map[string]*storage.SessionSpotlight)}
+		spotlights.fold = stagedCoreFold{types: stagedSessionSpotlightTypes, applier: projection.Applier{SessionSpotlight: spotlights}}
+		return spotlights
+	}).(*stagedSessionSpotlightOverlay)
+	if err := overlay.fold.catchUp(ctx, batch.Events()); err != nil {
+		return nil, err
+	}
+	return overlay, nil
+}
+
+func (s StagedSessionSpotlightStore) GetSessionSpotlight(ctx context.Context, campaignID, sessionID string) (storage.SessionSpotlight, error) {
+	store, err := s.view(ctx)
+	if err != nil {
+		return storage.SessionSpotlight{}, err
+	}
+	return store.GetSessionSpotlight(ctx, campaignID, sessionID)
+}
+
+func (s StagedSessionSpotlightStore) PutSessionSpotlight(ctx context.Context, spotlight storage.SessionSpotlight) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.PutSessionSpotlight(ctx, spotlight)
+}
+
+func (s StagedSessionSpotlightStore) ClearSessionSpotlight(ctx context.Context, campaignID, sessionID string) error {
+	store, err := s.view(ctx)
+	if err != nil {
+		return err
+	}
+	return store.ClearSessionSpotlight(ctx, campaignID, sessionID)
+}
+
+// stagedCoreFold folds the staged events of the given types through the core
+// projection handlers into one overlay. The applier carries only the overlay
+// store, so no watermark is saved.
+type stagedCoreFold struct {
+	types   map[event.Type]bool
+	applier projection.Applier
+
+	mu      sync.Mutex
+	applied int
+}
+
+// catchUp folds the staged events the overlay has not seen yet.
+func (f *stagedCoreFold) catchUp(ctx context.Context, events []event.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, evt := range events[min(f.applied, len(events)):] {
+		if f.types[evt.Type] {
+			if err := f.applier.Apply(ctx, evt); err != nil {
+				return err
+			}
+		}
+		f.applied++
+	}
+	return nil
+}
+
+// stagedSessionGateOverlay is a copy-on-write session gate projection for one
+// staged batch. Gates the batch touched shadow the live rows.
+type stagedSessionGateOverlay struct {
+	base storage.SessionGateStore
+	fold stagedCoreFold
+
+	mu   sync.Mutex
+	rows map[string]*storage.SessionGate
+}
+
+func sessionGateOverlayKey(campaignID, sessionID, gateID string) string {
+	return overlayKey(overlayKey(campaignID, sessionID), gateID)
+}
+
+func (o *stagedSessionGateOverlay) GetSessionGate(ctx context.Context, campaignID, sessionID, gateID string) (storage.SessionGate, error) {
+	if row, ok := lookupStaged(&o.mu, o.rows, sessionGateOverlayKey(campaignID, sessionID, gateID)); ok && row != nil {
+		return *row, nil
+	}
+	return o.base.GetSessionGate(ctx, campaignID, sessionID, gateID)
+}
+
+// GetOpenSessionGate prefers a gate the batch opened. A live open gate the
+// batch resolved or abandoned no longer counts as open.
+func (o *stagedSessionGateOverlay) GetOpenSessionGate(ctx context.Context, campaignID, sessionID string) (storage.SessionGate, error) {
+	if gate, ok := o.stagedOpenGate(campaignID, sessionID); ok {
+		return gate, nil
+	}
+	gate, err := o.base.GetOpenSessionGate(ctx, campaignID, sessionID)
+	if err != nil {
+		return storage.SessionGate{}, err
+	}
+	if _, shadowed := lookupStaged(&o.mu, o.rows, sessionGateOverlayKey(campaignID, sessionID, gate.GateID)); shadowed {
+		return storage.SessionGate{}, storage.ErrNotFound
+	}
+	return gate, nil
+}
+
+func (o *stagedSessionGateOverlay) stagedOpenGate(campaignID, sessionID string) (storage.SessionGate, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	keys := make([]string, 0, len(o.rows))
+	for key, row := range o.rows {
+		if row != nil && row.CampaignID == campaignID && row.SessionID == sessionID && row.Status == session.GateStatusOpen {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return storage.SessionGate{}, false
+	}
+	sort.Strings(keys)
+	return *o.rows[keys[0]], true
+}
+
+func (o *stagedSessionGateOverlay) PutSessionGate(_ context.Context, gate storage.SessionGate) error {
+	putStaged(&o.mu, o.rows, sessionGateOverlayKey(gate.CampaignID, gate.SessionID, gate.GateID), &gate)
+	return nil
+}
+
+// stagedSessionSpotlightOverlay is a copy-on-write session spotlight
+// projection for one staged batch; a nil row marks a staged clear.
+type stagedSessionSpotlightOverlay struct {
+	base storage.SessionSpotlightStore
+	fold stagedCoreFold
+
+	mu   sync.Mutex
+	rows map[string]*storage.SessionSpotlight
+}
+
+func (o *stagedSessionSpotlightOverlay) GetSessionSpotlight(ctx context.Context, campaignID, sessionID string) (storage.SessionSpotlight, error) {
+	if row, ok := lookupStaged(&o.mu, o.rows, overlayKey(campaignID, sessionID)); ok {
+		if row == nil {
+			return storage.SessionSpotlight{}, storage.ErrNotFound
+		}
+		return *row, nil
+	}
+	return o.base.GetSessionSpotlight(ctx, campaignID, sessionID)
+}
+
+func (o *stagedSessionSpotlightOverlay) PutSessionSpotlight(_ context.Context, spotlight storage.SessionSpotlight) error {
+	putStaged(&o.mu, o.rows, overlayKey(spotlight.CampaignID, spotlight.SessionID), &spotlight)
+	return nil
+}
+
+func (o *stagedSessionSpotlightOverlay) ClearSessionSpotlight(_ context.Context, campaignID, sessionID string) error {
+	putStaged[storage.SessionSpotlight](&o.mu, o.rows, overlayKey(campaignID, sessionID), nil)
+	return nil
+}
@@ -0,0 +1,171 @@
+package gameplaystores
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/gametest"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+	daggerheartpayload "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/payload"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/test/mock/gamefakes"
+)
+
+// stagingEngine previews every command as one pre-built event keyed by the
+// command type.
+type stagingEngine struct {
+	fakeDomainEngine
+	events map[command.Type]event.Event
+}
+
+func (e *stagingEngine) PreviewBatch(_ context.Context, cmds []command.Command) (engine.Preview, error) {
+	preview := engine.Preview{}
+	for _, cmd := range cmds {
+		preview.Decisions = append(preview.Decisions, command.Accept(e.events[cmd.Type]))
+	}
+	return preview, nil
+}
+
+func stagedEvent(t *testing.T, eventType event.Type, entityType, entityID string, payload any) event.Event {
+	t.Helper()
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("encode payload: %v", err)
+	}
+	return event.Event{
+		CampaignID:  "camp-1",
+		Type:        eventType,
+		SystemID:    "daggerheart",
+		EntityType:  entityType,
+		EntityID:    entityID,
+		PayloadJSON: payloadJSON,
+	}
+}
+
+func TestStagedStore_ServesStagedProjectionChanges(t *testing.T) {
+	base := gamefakes.NewDaggerheartStore()
+	base.Snapshots["camp-1"] = projectionstore.DaggerheartSnapshot{CampaignID: "camp-1", GMFear: 1}
+	base.Adversaries["camp-1:adv-1"] = projectionstore.DaggerheartAdversary{CampaignID: "camp-1", AdversaryID: "adv-1", SessionID: "sess-1"}
+	base.Adversaries["camp-1:adv-2"] = projectionstore.DaggerheartAdversary{CampaignID: "camp-1", AdversaryID: "adv-2", SessionID: "sess-1"}
+	store := NewStagedStore(base)
+
+	executor := &stagingEngine{events: map[command.Type]event.Event{
+		"sys.daggerheart.gm_fear.set": stagedEvent(t, daggerheartpayload.EventTypeGMFearChanged, "campaign", "camp-1",
+			daggerheartpayload.GMFearChangedPayload{Value: 4}),
+		"sys.daggerheart.adversary.delete": stagedEvent(t, daggerheartpayload.EventTypeAdversaryDeleted, "adversary", "adv-1",
+			daggerheartpayload.AdversaryDeletedPayload{AdversaryID: "adv-1"}),
+	}}
+	ctx, _ := domainwrite.WithStagedBatch(context.Background(), "camp-1", 0, nil)
+	for _, commandType := range []command.Type{"sys.daggerheart.gm_fear.set", "sys.daggerheart.adversary.delete"} {
+		if _, err := domainwrite.ExecuteAndApply(ctx, executor, nil, command.Command{CampaignID: "camp-1", Type: commandType}, domainwrite.Options{}); err != nil {
+			t.Fatalf("stage %s: %v", commandType, err)
+		}
+	}
+
+	snapshot, err := store.GetDaggerheartSnapshot(ctx, "camp-1")
+	if err != nil || snapshot.GMFear != 4 {
+		t.Fatalf("staged snapshot = %+v, %v, want gm fear 4", snapshot, err)
+	}
+	if _, err := store.GetDaggerheartAdversary(ctx, "camp-1", "adv-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("staged deleted adversary error = %v, want not found", err)
+	}
+	adversaries, err := store.ListDaggerheartAdversaries(ctx, "camp-1", "sess-1")
+	if err != nil || len(adversaries) != 1 || adversaries[0].AdversaryID != "adv-2" {
+		t.Fatalf("staged adversaries = %+v, %v, want only adv-2", adversaries, err)
+	}
+
+	if snapshot, _ := store.GetDaggerheartSnapshot(context.Background(), "camp-1"); snapshot.GMFear != 1 {
+		t.Fatalf("live snapshot gm fear = %d, want 1", snapshot.GMFear)
+	}
+	if _, ok := base.Adversaries["camp-1:adv-1"]; !ok {
+		t.Fatal("staging deleted the live adversary")
+	}
+}
+
+func TestStagedSessionStores_ServeGateAndSpotlightToLaterSteps(t *testing.T) {
+	gates := gametest.NewFakeSessionGateStore()
+	spotlights := gametest.NewFakeSessionSpotlightStore()
+	gateStore := NewStagedSessionGateStore(gates)
+	spotlightStore := NewStagedSessionSpotlightStore(spotlights)
+
+	coreEvent := func(eventType event.Type, entityType, entityID string, payload any) event.Event {
+		evt := stagedEvent(t, eventType, entityType, entityID, payload)
+		evt.SystemID = ""
+		evt.SessionID = "sess-1"
+		evt.Timestamp = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		return evt
+	}
+	executor := &stagingEngine{events: map[command.Type]event.Event{
+		"session.gate_open": coreEvent(session.EventTypeGateOpened, "session_gate", "gate-1",
+			session.GateOpenedPayload{GateID: "gate-1", GateType: "gm_consequence", Reason: "gm_consequence"}),
+		"session.spotlight_set": coreEvent(session.EventTypeSpotlightSet, "session", "sess-1",
+			session.SpotlightSetPayload{SpotlightType: string(session.SpotlightTypeGM)}),
+		"session.gate_resolve": coreEvent(session.EventTypeGateResolved, "session_gate", "gate-1",
+			session.GateResolvedPayload{GateID: "gate-1", Decision: "done"}),
+	}}
+	ctx, _ := domainwrite.WithStagedBatch(context.Background(), "camp-1", 0, StagedOverlayCovers)
+	stage := func(commandType command.Type) {
+		t.Helper()
+		if _, err := domainwrite.ExecuteAndApply(ctx, executor, nil, command.Command{CampaignID: "camp-1", Type: commandType}, domainwrite.Options{}); err != nil {
+			t.Fatalf("stage %s: %v", commandType, err)
+		}
+	}
+
+	// Step 1 opens a gate and takes the spotlight; step 2 must see both, the
+	// way a GM consequence checks for an open gate before opening another.
+	stage("session.gate_open")
+	stage("session.spotlight_set")
+	gate, err := gateStore.GetOpenSessionGate(ctx, "camp-1", "sess-1")
+	if err != nil || gate.GateID != "gate-1" || gate.Status != session.GateStatusOpen {
+		t.Fatalf("staged open gate = %+v, %v, want open gate-1", gate, err)
+	}
+	spotlight, err := spotlightStore.GetSessionSpotlight(ctx, "camp-1", "sess-1")
+	if err != nil || spotlight.SpotlightType != session.SpotlightTypeGM {
+		t.Fatalf("staged spotlight = %+v, %v, want gm", spotlight, err)
+	}
+
+	stage("session.gate_resolve")
+	if _, err := gateStore.GetOpenSessionGate(ctx, "camp-1", "sess-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("staged open gate after resolve error = %v, want not found", err)
+	}
+
+	if _, err := gateStore.GetOpenSessionGate(context.Background(), "camp-1", "sess-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("live open gate error = %v, want not found", err)
+	}
+	if len(gates.Gates) != 0 || len(spotlights.Spotlights) != 0 {
+		t.Fatalf("staging wrote live rows: gates %v, spotlights %v", gates.Gates, spotlights.Spotlights)
+	}
+}
+
+func TestStagedSessionGateStore_HidesLiveGateResolvedInBatch(t *testing.T) {
+	gates := gametest.NewFakeSessionGateStore()
+	gates.Gates["camp-1:sess-1:gate-1"] = storage.SessionGate{
+		CampaignID: "camp-1", SessionID: "sess-1", GateID: "gate-1", GateType: "gm_consequence", Status: session.GateStatusOpen,
+	}
+	store := NewStagedSessionGateStore(gates)
+
+	resolved := stagedEvent(t, session.EventTypeGateResolved, "session_gate", "gate-1", session.GateResolvedPayload{GateID: "gate-1"})
+	resolved.SystemID = ""
+	resolved.SessionID = "sess-1"
+	resolved.Timestamp = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	executor := &stagingEngine{events: map[command.Type]event.Event{"session.gate_resolve": resolved}}
+	ctx, _ := domainwrite.WithStagedBatch(context.Background(), "camp-1", 0, StagedOverlayCovers)
+	if _, err := domainwrite.ExecuteAndApply(ctx, executor, nil, command.Command{CampaignID: "camp-1", Type: "session.gate_resolve"}, domainwrite.Options{}); err != nil {
+		t.Fatalf("stage resolve: %v", err)
+	}
+
+	if _, err := store.GetOpenSessionGate(ctx, "camp-1", "sess-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("staged open gate error = %v, want not found", err)
+	}
+	if gate, err := store.GetOpenSessionGate(context.Background(), "camp-1", "sess-1"); err != nil || gate.GateID != "gate-1" {
+		t.Fatalf("live open gate = %+v, %v, want gate-1", gate, err)
+	}
+}
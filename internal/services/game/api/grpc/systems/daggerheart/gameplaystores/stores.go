@@ -74,17 +74,19 @@ type FromProjectionConfig struct {
 }
 
 // NewFromProjection constructs Stores from a projection-oriented bundle plus
-// runtime dependencies. This keeps startup wiring concise and explicit.
+// runtime dependencies. This keeps startup wiring concise and explicit. The
+// event, Daggerheart, session gate, and session spotlight stores are wrapped
+// for staged-batch reads.
 func NewFromProjection(config FromProjectionConfig) Stores {
 	return Stores{
 		Campaign:         config.ProjectionStore,
 		Character:        config.ProjectionStore,
 		Session:          config.ProjectionStore,
-		SessionGate:      config.ProjectionStore,
-		SessionSpotlight: config.ProjectionStore,
-		Daggerheart:      config.DaggerheartStore,
+		SessionGate:      stagedSessionGateStore(config.ProjectionStore),
+		SessionSpotlight: stagedSessionSpotlightStore(config.ProjectionStore),
+		Daggerheart:      stagedGameplayStore(config.DaggerheartStore),
 		Content:          config.ContentStore,
-		Event:            config.EventStore,
+		Event:            stagedEventStore(config.EventStore),
 		Watermarks:       config.ProjectionStore,
 		Events:           config.Events,
 		Write: domainwrite.WritePath{
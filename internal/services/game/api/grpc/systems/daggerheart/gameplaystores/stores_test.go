@@ -105,8 +105,11 @@ func TestNewFromProjection(t *testing.T) {
 	if stores.Campaign == nil || stores.Character == nil || stores.Daggerheart == nil {
 		t.Fatal("expected projection-backed stores to be populated")
 	}
-	if stores.Daggerheart != daggerheartStore {
-		t.Fatal("expected Daggerheart store to come from explicit system stores")
+	if staged, ok := stores.Daggerheart.(StagedStore); !ok || staged.GameplayStore != daggerheartStore {
+		t.Fatal("expected Daggerheart store to wrap the explicit system store for staged reads")
+	}
+	if !stores.StagedReads() {
+		t.Fatal("expected projection-backed stores to serve staged reads")
 	}
 	if stores.Event == nil || stores.Write.Runtime == nil || stores.Events == nil {
 		t.Fatal("expected runtime stores to be propagated")
@@ -9,6 +9,7 @@ import (
 
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
@@ -194,9 +195,16 @@ func (h *Handler) ApplyRest(ctx context.Context, in *pb.DaggerheartApplyRestRequ
 	if err := h.applyRestStressConditionChanges(ctx, campaignID, sessionID, profilesByCharacterID, statesByCharacterID, result.Payload); err != nil {
 		return RestResult{}, err
 	}
+	// A committed rest always projects the snapshot row. Previews apply
+	// nothing, so only they may find a campaign without one here.
 	updatedSnap, err := h.deps.Daggerheart.GetDaggerheartSnapshot(ctx, campaignID)
 	if err != nil {
-		return RestResult{}, grpcerror.Internal("load daggerheart snapshot", err)
+		if !domainwrite.InPreview(ctx) {
+			return RestResult{}, grpcerror.Internal("load daggerheart snapshot", err)
+		}
+		if lookupErr := grpcerror.OptionalLookupErrorContext(ctx, err, "load daggerheart snapshot"); lookupErr != nil {
+			return RestResult{}, lookupErr
+		}
 	}
 	affectedCharacterIDs := affectedRestCharacterIDs(result.Payload)
 	entries := make([]CharacterStateEntry, 0, len(affectedCharacterIDs))
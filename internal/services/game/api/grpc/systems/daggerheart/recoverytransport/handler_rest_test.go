@@ -5,7 +5,11 @@ import (
 	"testing"
 
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/test/grpcassert"
+	"google.golang.org/grpc/codes"
 )
 
 func TestHandlerApplyRestSuccess(t *testing.T) {
@@ -46,3 +50,39 @@ func TestHandlerApplyRestSuccess(t *testing.T) {
 		t.Fatalf("character states = %d, want 1", len(result.CharacterStates))
 	}
 }
+
+func newSnapshotlessRestHandler() *Handler {
+	return newTestHandler(Dependencies{
+		Daggerheart: &testDaggerheartStore{
+			snapshotErr: storage.ErrNotFound,
+			profiles: map[string]projectionstore.DaggerheartCharacterProfile{
+				"char-1": {CampaignID: "camp-1", CharacterID: "char-1", HpMax: 5, StressMax: 3},
+			},
+			states: map[string]projectionstore.DaggerheartCharacterState{
+				"char-1": {CampaignID: "camp-1", CharacterID: "char-1", Hp: 3, Hope: 2, HopeMax: 2, Stress: 0},
+			},
+		},
+	})
+}
+
+func snapshotlessRestRequest() *pb.DaggerheartApplyRestRequest {
+	return &pb.DaggerheartApplyRestRequest{
+		CampaignId: "camp-1",
+		Rest: &pb.DaggerheartRestRequest{
+			RestType:     pb.DaggerheartRestType_DAGGERHEART_REST_TYPE_SHORT,
+			Participants: []*pb.DaggerheartRestParticipant{{CharacterId: "char-1"}},
+		},
+	}
+}
+
+func TestHandlerApplyRestMissingSnapshotAfterCommitIsInternal(t *testing.T) {
+	_, err := newSnapshotlessRestHandler().ApplyRest(testContext(), snapshotlessRestRequest())
+	grpcassert.StatusCode(t, err, codes.Internal)
+}
+
+func TestHandlerApplyRestPreviewToleratesMissingSnapshot(t *testing.T) {
+	ctx, _ := domainwrite.WithPreview(testContext())
+	if _, err := newSnapshotlessRestHandler().ApplyRest(ctx, snapshotlessRestRequest()); err != nil {
+		t.Fatalf("ApplyRest preview returned error: %v", err)
+	}
+}
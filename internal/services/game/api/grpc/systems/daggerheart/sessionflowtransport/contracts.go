@@ -32,6 +32,10 @@ type Dependencies struct {
 	ExecuteAdversaryUpdate      func(context.Context, AdversaryUpdateInput) error
 	AdjustGMFear                func(context.Context, GMFearAdjustInput) error
 	SeedFunc                    func() (int64, error)
+
+	// RunBatch runs a flow so every write it makes commits as one atomic batch
+	// for the campaign. Nil runs flows write by write.
+	RunBatch func(ctx context.Context, campaignID string, run func(context.Context) error) error
 }
 
 type CharacterStatePatchInput struct {
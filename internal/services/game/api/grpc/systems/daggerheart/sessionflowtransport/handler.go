@@ -26,6 +26,25 @@ func NewHandler(deps Dependencies) *Handler {
 	return &Handler{deps: deps}
 }
 
+// runFlowBatch runs flow under the configured batch runner. Requests without a
+// campaign ID go straight to flow, which rejects them before any write.
+func runFlowBatch[T any](h *Handler, ctx context.Context, campaignID string, flow func(context.Context) (T, error)) (T, error) {
+	if h.deps.RunBatch == nil || strings.TrimSpace(campaignID) == "" {
+		return flow(ctx)
+	}
+	var resp T
+	err := h.deps.RunBatch(ctx, strings.TrimSpace(campaignID), func(ctx context.Context) error {
+		var err error
+		resp, err = flow(ctx)
+		return err
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return resp, nil
+}
+
 func (h *Handler) requireAttackFlowDeps() error {
 	switch {
 	case h.deps.SessionActionRoll == nil:
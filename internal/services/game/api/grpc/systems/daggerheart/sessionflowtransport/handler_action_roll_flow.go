@@ -0,0 +1,61 @@
+package sessionflowtransport
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/validate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/workflowtransport"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SessionActionRollFlow records an action roll and applies its mandatory
+// outcome as one atomic write batch.
+func (h *Handler) SessionActionRollFlow(ctx context.Context, in *pb.SessionActionRollFlowRequest) (*pb.SessionActionRollFlowResponse, error) {
+	return runFlowBatch(h, ctx, in.GetRoll().GetCampaignId(), func(ctx context.Context) (*pb.SessionActionRollFlowResponse, error) {
+		return h.sessionActionRollFlow(ctx, in)
+	})
+}
+
+func (h *Handler) sessionActionRollFlow(ctx context.Context, in *pb.SessionActionRollFlowRequest) (*pb.SessionActionRollFlowResponse, error) {
+	if in == nil || in.GetRoll() == nil {
+		return nil, status.Error(codes.InvalidArgument, "session action roll flow request is required")
+	}
+	if h.deps.SessionActionRoll == nil || h.deps.ApplyRollOutcome == nil {
+		return nil, status.Error(codes.Internal, "session workflow dependencies are not configured")
+	}
+
+	campaignID, err := validate.RequiredID(in.GetRoll().GetCampaignId(), "campaign id")
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := validate.RequiredID(in.GetRoll().GetSessionId(), "session id")
+	if err != nil {
+		return nil, err
+	}
+	sceneID := strings.TrimSpace(in.GetRoll().GetSceneId())
+
+	rollResp, err := h.deps.SessionActionRoll(ctx, in.GetRoll())
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWithMeta := workflowtransport.WithCampaignSessionMetadata(ctx, campaignID, sessionID)
+	rollOutcome, err := h.deps.ApplyRollOutcome(ctxWithMeta, &pb.ApplyRollOutcomeRequest{
+		SessionId:    sessionID,
+		SceneId:      sceneID,
+		RollSeq:      rollResp.GetRollSeq(),
+		Targets:      workflowtransport.NormalizeTargets(in.GetTargets()),
+		SwapHopeFear: in.GetSwapHopeFear(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SessionActionRollFlowResponse{
+		ActionRoll:  rollResp,
+		RollOutcome: rollOutcome,
+	}, nil
+}
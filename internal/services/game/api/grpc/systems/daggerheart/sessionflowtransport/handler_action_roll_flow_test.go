@@ -0,0 +1,75 @@
+package sessionflowtransport
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+)
+
+type batchContextKey struct{}
+
+func TestHandlerSessionActionRollFlowRunsRollAndOutcomeInOneBatch(t *testing.T) {
+	var batches []string
+	var outcomeReq *pb.ApplyRollOutcomeRequest
+	handler := NewHandler(Dependencies{
+		RunBatch: func(ctx context.Context, campaignID string, run func(context.Context) error) error {
+			batches = append(batches, campaignID)
+			return run(context.WithValue(ctx, batchContextKey{}, campaignID))
+		},
+		SessionActionRoll: func(ctx context.Context, _ *pb.SessionActionRollRequest) (*pb.SessionActionRollResponse, error) {
+			if ctx.Value(batchContextKey{}) == nil {
+				t.Fatal("action roll ran outside the batch")
+			}
+			return &pb.SessionActionRollResponse{RollSeq: 9}, nil
+		},
+		ApplyRollOutcome: func(ctx context.Context, in *pb.ApplyRollOutcomeRequest) (*pb.ApplyRollOutcomeResponse, error) {
+			if ctx.Value(batchContextKey{}) == nil {
+				t.Fatal("roll outcome ran outside the batch")
+			}
+			outcomeReq = in
+			return &pb.ApplyRollOutcomeResponse{RollSeq: in.GetRollSeq()}, nil
+		},
+	})
+
+	resp, err := handler.SessionActionRollFlow(context.Background(), &pb.SessionActionRollFlowRequest{
+		Roll:         &pb.SessionActionRollRequest{CampaignId: " camp-1 ", SessionId: "sess-1", SceneId: "scene-1", CharacterId: "char-1", Trait: "agility"},
+		Targets:      []string{"char-2", " char-2 "},
+		SwapHopeFear: true,
+	})
+	if err != nil {
+		t.Fatalf("SessionActionRollFlow returned error: %v", err)
+	}
+	if len(batches) != 1 || batches[0] != "camp-1" {
+		t.Fatalf("batches = %v, want one batch for camp-1", batches)
+	}
+	if outcomeReq.GetRollSeq() != 9 || outcomeReq.GetSceneId() != "scene-1" || !outcomeReq.GetSwapHopeFear() || len(outcomeReq.GetTargets()) != 1 {
+		t.Fatalf("outcome request = %+v, want roll 9 forwarded with normalized targets", outcomeReq)
+	}
+	if resp.GetActionRoll().GetRollSeq() != 9 || resp.GetRollOutcome().GetRollSeq() != 9 {
+		t.Fatalf("response = %+v, want roll and outcome", resp)
+	}
+}
+
+func TestHandlerSessionActionRollFlowReturnsOutcomeFailure(t *testing.T) {
+	outcomeErr := errors.New("outcome failed")
+	handler := NewHandler(Dependencies{
+		RunBatch: func(ctx context.Context, _ string, run func(context.Context) error) error {
+			return run(ctx)
+		},
+		SessionActionRoll: func(context.Context, *pb.SessionActionRollRequest) (*pb.SessionActionRollResponse, error) {
+			return &pb.SessionActionRollResponse{RollSeq: 1}, nil
+		},
+		ApplyRollOutcome: func(context.Context, *pb.ApplyRollOutcomeRequest) (*pb.ApplyRollOutcomeResponse, error) {
+			return nil, outcomeErr
+		},
+	})
+
+	_, err := handler.SessionActionRollFlow(context.Background(), &pb.SessionActionRollFlowRequest{
+		Roll: &pb.SessionActionRollRequest{CampaignId: "camp-1", SessionId: "sess-1"},
+	})
+	if !errors.Is(err, outcomeErr) {
+		t.Fatalf("error = %v, want outcome failure", err)
+	}
+}
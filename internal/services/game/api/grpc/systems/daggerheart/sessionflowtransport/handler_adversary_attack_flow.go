@@ -16,7 +16,15 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// SessionAdversaryAttackFlow runs the adversary attack workflow as one atomic
+// write batch.
 func (h *Handler) SessionAdversaryAttackFlow(ctx context.Context, in *pb.SessionAdversaryAttackFlowRequest) (*pb.SessionAdversaryAttackFlowResponse, error) {
+	return runFlowBatch(h, ctx, in.GetCampaignId(), func(ctx context.Context) (*pb.SessionAdversaryAttackFlowResponse, error) {
+		return h.sessionAdversaryAttackFlow(ctx, in)
+	})
+}
+
+func (h *Handler) sessionAdversaryAttackFlow(ctx context.Context, in *pb.SessionAdversaryAttackFlowRequest) (*pb.SessionAdversaryAttackFlowResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "session adversary attack flow request is required")
 	}
@@ -16,8 +16,15 @@ import (
 )
 
 // SessionAttackFlow runs the attack workflow by composing a roll, outcome, and
-// optional damage application.
+// optional damage application. Every write lands in one atomic batch, so a
+// step that fails part-way leaves the journal untouched.
 func (h *Handler) SessionAttackFlow(ctx context.Context, in *pb.SessionAttackFlowRequest) (*pb.SessionAttackFlowResponse, error) {
+	return runFlowBatch(h, ctx, in.GetCampaignId(), func(ctx context.Context) (*pb.SessionAttackFlowResponse, error) {
+		return h.sessionAttackFlow(ctx, in)
+	})
+}
+
+func (h *Handler) sessionAttackFlow(ctx context.Context, in *pb.SessionAttackFlowRequest) (*pb.SessionAttackFlowResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "session attack flow request is required")
 	}
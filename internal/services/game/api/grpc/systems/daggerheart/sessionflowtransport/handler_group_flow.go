@@ -11,7 +11,15 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// SessionGroupActionFlow runs the group action workflow as one atomic write
+// batch.
 func (h *Handler) SessionGroupActionFlow(ctx context.Context, in *pb.SessionGroupActionFlowRequest) (*pb.SessionGroupActionFlowResponse, error) {
+	return runFlowBatch(h, ctx, in.GetCampaignId(), func(ctx context.Context) (*pb.SessionGroupActionFlowResponse, error) {
+		return h.sessionGroupActionFlow(ctx, in)
+	})
+}
+
+func (h *Handler) sessionGroupActionFlow(ctx context.Context, in *pb.SessionGroupActionFlowRequest) (*pb.SessionGroupActionFlowResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "session group action flow request is required")
 	}
@@ -134,8 +142,15 @@ func (h *Handler) SessionGroupActionFlow(ctx context.Context, in *pb.SessionGrou
 }
 
 // SessionTagTeamFlow runs the tag-team orchestration by resolving both action
-// rolls and then applying the chosen result to the combined targets.
+// rolls and then applying the chosen result to the combined targets, as one
+// atomic write batch.
 func (h *Handler) SessionTagTeamFlow(ctx context.Context, in *pb.SessionTagTeamFlowRequest) (*pb.SessionTagTeamFlowResponse, error) {
+	return runFlowBatch(h, ctx, in.GetCampaignId(), func(ctx context.Context) (*pb.SessionTagTeamFlowResponse, error) {
+		return h.sessionTagTeamFlow(ctx, in)
+	})
+}
+
+func (h *Handler) sessionTagTeamFlow(ctx context.Context, in *pb.SessionTagTeamFlowRequest) (*pb.SessionTagTeamFlowResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "session tag team flow request is required")
 	}
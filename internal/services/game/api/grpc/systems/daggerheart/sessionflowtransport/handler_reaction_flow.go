@@ -12,7 +12,14 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// SessionReactionFlow runs the reaction workflow as one atomic write batch.
 func (h *Handler) SessionReactionFlow(ctx context.Context, in *pb.SessionReactionFlowRequest) (*pb.SessionReactionFlowResponse, error) {
+	return runFlowBatch(h, ctx, in.GetCampaignId(), func(ctx context.Context) (*pb.SessionReactionFlowResponse, error) {
+		return h.sessionReactionFlow(ctx, in)
+	})
+}
+
+func (h *Handler) sessionReactionFlow(ctx context.Context, in *pb.SessionReactionFlowRequest) (*pb.SessionReactionFlowResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "session reaction flow request is required")
 	}
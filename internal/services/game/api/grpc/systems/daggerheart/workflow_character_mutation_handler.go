@@ -5,6 +5,7 @@ import (
 
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/charactermutationtransport"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/workflowwrite"
 )
 
 func (s *DaggerheartService) characterMutationHandler() *charactermutationtransport.Handler {
@@ -17,7 +18,15 @@ func (s *DaggerheartService) characterMutationHandler() *charactermutationtransp
 }
 
 func (s *DaggerheartService) ApplyLevelUp(ctx context.Context, in *pb.DaggerheartApplyLevelUpRequest) (*pb.DaggerheartApplyLevelUpResponse, error) {
-	return s.characterMutationHandler().ApplyLevelUp(ctx, in)
+	ctx, preview := workflowwrite.WithPreview(ctx, in.GetPreview())
+	resp, err := s.characterMutationHandler().ApplyLevelUp(ctx, in)
+	if err != nil || preview == nil {
+		return resp, err
+	}
+	return &pb.DaggerheartApplyLevelUpResponse{
+		CharacterId: resp.GetCharacterId(),
+		Preview:     commandPreviewToProto(preview()),
+	}, nil
 }
 
 func (s *DaggerheartService) ApplyClassFeature(ctx context.Context, in *pb.DaggerheartApplyClassFeatureRequest) (*pb.DaggerheartApplyClassFeatureResponse, error) {
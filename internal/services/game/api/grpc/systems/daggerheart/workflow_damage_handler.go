@@ -7,6 +7,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/adversarytransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/damagetransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/statetransport"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/workflowwrite"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/projectionstore"
 )
 
@@ -26,10 +27,18 @@ func (s *DaggerheartService) damageHandler() *damagetransport.Handler {
 }
 
 func (s *DaggerheartService) ApplyDamage(ctx context.Context, in *pb.DaggerheartApplyDamageRequest) (*pb.DaggerheartApplyDamageResponse, error) {
+	ctx, preview := workflowwrite.WithPreview(ctx, in.GetPreview())
 	result, err := s.damageHandler().ApplyDamage(ctx, in)
 	if err != nil {
 		return nil, err
 	}
+	if preview != nil {
+		return &pb.DaggerheartApplyDamageResponse{
+			CharacterId:    result.CharacterID,
+			ChoiceRequired: result.Choice,
+			Preview:        commandPreviewToProto(preview()),
+		}, nil
+	}
 	return &pb.DaggerheartApplyDamageResponse{
 		CharacterId:    result.CharacterID,
 		State:          statetransport.CharacterStateToProto(result.State),
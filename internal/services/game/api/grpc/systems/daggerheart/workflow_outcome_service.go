@@ -6,10 +6,20 @@ import (
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/outcometransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/workfloweffects"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/workflowwrite"
 )
 
 func (s *DaggerheartService) ApplyRollOutcome(ctx context.Context, in *pb.ApplyRollOutcomeRequest) (*pb.ApplyRollOutcomeResponse, error) {
-	return s.outcomeHandler().ApplyRollOutcome(ctx, in)
+	ctx, preview := workflowwrite.WithPreview(ctx, in.GetPreview())
+	resp, err := s.outcomeHandler().ApplyRollOutcome(ctx, in)
+	if err != nil || preview == nil {
+		return resp, err
+	}
+	return &pb.ApplyRollOutcomeResponse{
+		RollSeq:              resp.GetRollSeq(),
+		RequiresComplication: resp.GetRequiresComplication(),
+		Preview:              commandPreviewToProto(preview()),
+	}, nil
 }
 
 func (s *DaggerheartService) ApplyAttackOutcome(ctx context.Context, in *pb.DaggerheartApplyAttackOutcomeRequest) (*pb.DaggerheartApplyAttackOutcomeResponse, error) {
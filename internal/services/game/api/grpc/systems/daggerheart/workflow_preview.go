@@ -0,0 +1,33 @@
+package daggerheart
+
+import (
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+)
+
+// commandPreviewToProto maps an engine dry-run result to the shared preview
+// message returned by preview-capable Daggerheart RPCs.
+func commandPreviewToProto(preview engine.Preview) *pb.DaggerheartCommandPreview {
+	out := &pb.DaggerheartCommandPreview{
+		Events:       make([]*pb.DaggerheartPreviewEvent, 0),
+		StateChanges: make([]*pb.DaggerheartPreviewStateChange, 0, len(preview.Changes)),
+	}
+	for _, decision := range preview.Decisions {
+		for _, evt := range decision.Events {
+			out.Events = append(out.Events, &pb.DaggerheartPreviewEvent{
+				Type:        string(evt.Type),
+				EntityType:  evt.EntityType,
+				EntityId:    evt.EntityID,
+				PayloadJson: evt.PayloadJSON,
+			})
+		}
+	}
+	for _, change := range preview.Changes {
+		out.StateChanges = append(out.StateChanges, &pb.DaggerheartPreviewStateChange{
+			Path:       change.Path,
+			BeforeJson: change.Before,
+			AfterJson:  change.After,
+		})
+	}
+	return out
+}
@@ -70,10 +70,14 @@ func (s *DaggerheartService) recoveryHandler() *recoverytransport.Handler {
 }
 
 func (s *DaggerheartService) ApplyRest(ctx context.Context, in *pb.DaggerheartApplyRestRequest) (*pb.DaggerheartApplyRestResponse, error) {
+	ctx, preview := workflowwrite.WithPreview(ctx, in.GetPreview())
 	result, err := s.recoveryHandler().ApplyRest(ctx, in)
 	if err != nil {
 		return nil, err
 	}
+	if preview != nil {
+		return &pb.DaggerheartApplyRestResponse{Preview: commandPreviewToProto(preview())}, nil
+	}
 	entries := make([]*pb.DaggerheartCharacterStateEntry, 0, len(result.CharacterStates))
 	for _, entry := range result.CharacterStates {
 		entries = append(entries, &pb.DaggerheartCharacterStateEntry{
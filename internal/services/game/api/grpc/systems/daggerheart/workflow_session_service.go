@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/grpcerror"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/gameplaystores"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/sessionflowtransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/workflowruntime"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/commandids"
@@ -75,9 +78,38 @@ func (s *DaggerheartService) sessionFlowHandler() *sessionflowtransport.Handler
 		ExecuteAdversaryUpdate:     s.executeSessionFlowAdversaryUpdate,
 		AdjustGMFear:               s.executeSessionFlowGMFearAdjust,
 		SeedFunc:                   s.seedFunc,
+		RunBatch:                   s.sessionFlowBatchRunner(),
 	})
 }
 
+// sessionFlowBatchRunner returns the runner that commits each session flow as
+// one atomic batch, or nil when the stores cannot serve the staged reads later
+// flow steps depend on.
+func (s *DaggerheartService) sessionFlowBatchRunner() func(context.Context, string, func(context.Context) error) error {
+	if !s.stores.StagedReads() {
+		return nil
+	}
+	return s.runSessionFlowBatch
+}
+
+// runSessionFlowBatch stages every write run makes against the current journal
+// head and commits them together. A failing step returns before the commit, so
+// nothing is appended.
+func (s *DaggerheartService) runSessionFlowBatch(ctx context.Context, campaignID string, run func(context.Context) error) error {
+	headSeq, err := s.stores.Event.GetLatestEventSeq(ctx, campaignID)
+	if err != nil {
+		return grpcerror.Internal("load latest event seq", err)
+	}
+	stagedCtx, batch := domainwrite.WithStagedBatch(ctx, campaignID, headSeq, gameplaystores.StagedOverlayCovers)
+	if err := run(stagedCtx); err != nil {
+		return err
+	}
+	if _, err := batch.Commit(ctx, s.stores.Write.Executor); err != nil {
+		return grpcerror.EnsureStatus(err)
+	}
+	return nil
+}
+
 func (s *DaggerheartService) executeSessionFlowCharacterStatePatch(ctx context.Context, in sessionflowtransport.CharacterStatePatchInput) error {
 	payloadJSON, err := json.Marshal(daggerheartpayload.CharacterStatePatchPayload{
 		CharacterID:                         ids.CharacterID(in.CharacterID),
@@ -225,6 +257,16 @@ func (s *DaggerheartService) SessionDamageRoll(ctx context.Context, in *pb.Sessi
 	return s.sessionRollHandler().SessionDamageRoll(ctx, in)
 }
 
+func (s *DaggerheartService) SessionActionRollFlow(ctx context.Context, in *pb.SessionActionRollFlowRequest) (*pb.SessionActionRollFlowResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "session action roll flow request is required")
+	}
+	if err := s.requireSessionFlowStores(); err != nil {
+		return nil, err
+	}
+	return s.sessionFlowHandler().SessionActionRollFlow(ctx, in)
+}
+
 func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.SessionAttackFlowRequest) (*pb.SessionAttackFlowResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "session attack flow request is required")
@@ -0,0 +1,19 @@
+package workflowwrite
+
+import (
+	"context"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/internal/domainwrite"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+)
+
+// WithPreview arms dry-run mode for every workflow write under the returned
+// context when enabled. The returned func reads the accumulated preview; it is
+// nil when preview is disabled so callers can branch on it.
+func WithPreview(ctx context.Context, enabled bool) (context.Context, func() engine.Preview) {
+	if !enabled {
+		return ctx, nil
+	}
+	ctx, recorder := domainwrite.WithPreview(ctx)
+	return ctx, recorder.Result
+}
@@ -418,6 +418,10 @@ func (b *serverBootstrap) NewWithAddr(ctx context.Context, addr string) (server
 		return nil, err
 	}
 	attachDependencyClients(&domainState.contentStores, deps)
+	webhookSealer, err := srvEnv.webhookSealer()
+	if err != nil {
+		return nil, err
+	}
 	registration := buildRegistrationAssemblies(registrationAssemblySources{
 		bundle:          bundle,
 		projectionStore: bundle.projections,
@@ -428,6 +432,8 @@ func (b *serverBootstrap) NewWithAddr(ctx context.Context, addr string) (server
 		aiAgentClient:   newAIAgentServiceClient(deps.ai.Conn()),
 		systemRegistry:  systemState.systemRegistry,
 		systemModules:   registries.Systems,
+		webhookLoopback: srvEnv.WebhookAllowLoopbackHTTP,
+		webhookSealer:   webhookSealer,
 	})
 
 	transportState, err := b.bootstrapTransportPhase(
@@ -3,6 +3,8 @@ package app
 import (
 	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
+	gamegrpc "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/module"
 	bridge "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems"
 )
@@ -28,11 +30,26 @@ type registrationAssemblySources struct {
 	aiAgentClient   aiv1.AgentServiceClient
 	systemRegistry  *bridge.MetadataRegistry
 	systemModules   *module.Registry
+	// webhookLoopback admits plain-http loopback webhook URLs in development.
+	webhookLoopback bool
+	// webhookSealer seals webhook signing secrets; nil disables registration.
+	webhookSealer secret.Sealer
 }
 
 // buildRegistrationAssemblies assembles the transport registration families
 // from the narrowed domain state plus startup-owned dependency/system context.
 func buildRegistrationAssemblies(sources registrationAssemblySources) registrationAssemblies {
+	// Transport reads share one redacting journal view so no read path can
+	// surface a deleted account's identity.
+	eventReads := gamegrpc.NewRedactedEventStore(sources.domainState.infrastructureStores.Event)
+	// One reader backs every point-in-time surface so replayed states are
+	// cached once per process.
+	historyReader := gamegrpc.NewHistoryReader(gamegrpc.HistoryReaderConfig{
+		Events:      eventReads,
+		Sessions:    sources.domainState.projectionStores.Session,
+		Registry:    sources.domainState.applier.Events,
+		OpenScratch: openHistoryScratch,
+	})
 	return registrationAssemblies{
 		daggerheart: daggerheartRegistrationDeps{
 			projectionStore: sources.projectionStore,
@@ -52,15 +69,18 @@ func buildRegistrationAssemblies(sources registrationAssemblySources) registrati
 			sessionInteraction: sources.domainState.projectionStores.SessionInteraction,
 			sceneStore:         sources.domainState.projectionStores.Scene,
 			sceneInteraction:   sources.domainState.projectionStores.SceneInteraction,
+			sceneGMInteraction: sources.domainState.projectionStores.SceneGMInteraction,
 			systemStores:       sources.domainState.systemStores,
 			systemMetadata:     sources.systemRegistry,
 			systemModules:      sources.systemModules,
 			claimIndexStore:    sources.domainState.projectionStores.ClaimIndex,
-			eventHistoryStore:  sources.domainState.infrastructureStores.Event,
+			eventHistoryStore:  eventReads,
+			eventReadStore:     eventReads,
 			contentStore:       sources.domainState.contentStores.DaggerheartContent,
 			socialClient:       sources.domainState.contentStores.Social,
 			writePath:          sources.domainState.runtimeStores.Write,
 			applier:            sources.domainState.applier,
+			historyReader:      historyReader,
 			authClient:         sources.authClient,
 			aiAgentClient:      sources.aiAgentClient,
 		},
@@ -79,12 +99,13 @@ func buildRegistrationAssemblies(sources registrationAssemblySources) registrati
 			sceneInteraction:   sources.domainState.projectionStores.SceneInteraction,
 			sceneGMInteraction: sources.domainState.projectionStores.SceneGMInteraction,
 			campaignForkStore:  sources.domainState.projectionStores.CampaignFork,
-			eventHistoryStore:  sources.domainState.infrastructureStores.Event,
-			eventJournal:       sources.domainState.infrastructureStores.Event,
+			eventHistoryStore:  eventReads,
+			eventJournal:       eventReads,
 			eventRegistry:      sources.domainState.applier.Events,
 			socialClient:       sources.domainState.contentStores.Social,
 			writePath:          sources.domainState.runtimeStores.Write,
 			applier:            sources.domainState.applier,
+			historyReader:      historyReader,
 		},
 		infrastructure: infrastructureRegistrationDeps{
 			campaignStore:    sources.domainState.projectionStores.Campaign,
@@ -92,8 +113,12 @@ func buildRegistrationAssemblies(sources registrationAssemblySources) registrati
 			characterStore:   sources.domainState.projectionStores.Character,
 			auditStore:       sources.domainState.infrastructureStores.Audit,
 			statisticsStore:  sources.domainState.infrastructureStores.Statistics,
+			eventReadStore:   eventReads,
 			bundle:           sources.bundle,
 			systemRegistry:   sources.systemRegistry,
+			eventRegistry:    sources.domainState.applier.Events,
+			webhookLoopback:  sources.webhookLoopback,
+			webhookSealer:    sources.webhookSealer,
 		},
 	}
 }
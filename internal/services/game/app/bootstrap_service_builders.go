@@ -8,6 +8,7 @@ import (
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
 	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
 	gamegrpc "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authorizationtransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/authz"
@@ -21,6 +22,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/scenetransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/sessiontransport"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/snapshottransport"
+	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game/webhooktransport"
 	daggerheartservice "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart/gameplaystores"
 	"github.com/louisbranch/fracturing.space/internal/services/game/core/random"
@@ -58,15 +60,18 @@ type campaignRegistrationDeps struct {
 	sessionInteraction storage.SessionInteractionStore
 	sceneStore         storage.SceneStore
 	sceneInteraction   storage.SceneInteractionStore
+	sceneGMInteraction storage.SceneGMInteractionStore
 	systemStores       gamegrpc.SystemStores
 	systemMetadata     *bridge.MetadataRegistry
 	systemModules      *module.Registry
 	claimIndexStore    storage.ClaimIndexStore
 	eventHistoryStore  storage.EventHistoryStore
+	eventReadStore     storage.EventReadStore
 	contentStore       contentstore.DaggerheartContentReadStore
 	socialClient       socialv1.SocialServiceClient
 	writePath          gamegrpc.WritePath
 	applier            projection.Applier
+	historyReader      *gamegrpc.HistoryReader
 	authClient         authv1.AuthServiceClient
 	aiAgentClient      aiv1.AgentServiceClient
 }
@@ -104,6 +109,7 @@ type sessionRegistrationDeps struct {
 	socialClient       socialv1.SocialServiceClient
 	writePath          gamegrpc.WritePath
 	applier            projection.Applier
+	historyReader      *gamegrpc.HistoryReader
 }
 
 // policyDeps derives shared authorization collaborators from the session
@@ -124,8 +130,12 @@ type infrastructureRegistrationDeps struct {
 	characterStore   storage.CharacterStore
 	auditStore       storage.AuditEventStore
 	statisticsStore  storage.StatisticsStore
+	eventReadStore   storage.EventReadStore
 	bundle           *storageBundle
 	systemRegistry   *bridge.MetadataRegistry
+	eventRegistry    *event.Registry
+	webhookLoopback  bool
+	webhookSealer    secret.Sealer
 }
 
 // buildDaggerheartServiceDescriptors wires the Daggerheart transport family
@@ -188,6 +198,7 @@ func buildCampaignServiceDescriptors(
 		Character:          deps.characterStore,
 		Session:            deps.sessionStore,
 		SessionInteraction: deps.sessionInteraction,
+		Event:              deps.eventHistoryStore,
 		SystemStores:       deps.systemStores,
 		SystemMetadata:     deps.systemMetadata,
 		SystemModules:      deps.systemModules,
@@ -207,11 +218,14 @@ func buildCampaignServiceDescriptors(
 	campaignAIOrchestrationService := gamegrpc.NewCampaignAIOrchestrationService(gamegrpc.CampaignAIOrchestrationDeps{
 		Campaign:           deps.campaignStore,
 		Participant:        deps.participantStore,
+		Character:          deps.characterStore,
+		Event:              deps.eventHistoryStore,
 		Session:            deps.sessionStore,
 		SessionRecap:       deps.sessionRecap,
 		SessionInteraction: deps.sessionInteraction,
 		Scene:              deps.sceneStore,
 		SceneInteraction:   deps.sceneInteraction,
+		SceneGMInteraction: deps.sceneGMInteraction,
 		Write:              deps.writePath,
 		Applier:            deps.applier,
 	})
@@ -236,6 +250,7 @@ func buildCampaignServiceDescriptors(
 		DaggerheartContent: deps.contentStore,
 		Write:              deps.writePath,
 		Applier:            deps.applier,
+		History:            deps.historyReader,
 	})
 	snapshotService := snapshottransport.NewService(snapshottransport.Deps{
 		Auth:        policy,
@@ -244,6 +259,8 @@ func buildCampaignServiceDescriptors(
 		Daggerheart: deps.systemStores.Daggerheart,
 		Write:       deps.writePath,
 		Applier:     deps.applier,
+		History:     deps.historyReader,
+		Event:       deps.eventReadStore,
 	})
 
 	return []grpcServiceDescriptor{
@@ -331,15 +348,17 @@ func buildSessionServiceDescriptors(deps sessionRegistrationDeps) []grpcServiceD
 		Social:        deps.socialClient,
 		Write:         deps.writePath,
 		Applier:       deps.applier,
+		History:       deps.historyReader,
 	})
 	eventService := eventtransport.NewService(eventtransport.Deps{
-		Auth:        policy,
-		Event:       deps.eventHistoryStore,
-		Campaign:    deps.campaignStore,
-		Participant: deps.participantStore,
-		Character:   deps.characterStore,
-		Session:     deps.sessionStore,
-		Write:       deps.writePath,
+		Auth:          policy,
+		Event:         deps.eventHistoryStore,
+		Campaign:      deps.campaignStore,
+		Participant:   deps.participantStore,
+		Character:     deps.characterStore,
+		Session:       deps.sessionStore,
+		Write:         deps.writePath,
+		EventRegistry: deps.eventRegistry,
 	})
 	interactionService := interactiontransport.NewInteractionService(interactiontransport.Deps{
 		Auth:               policy,
@@ -355,6 +374,7 @@ func buildSessionServiceDescriptors(deps sessionRegistrationDeps) []grpcServiceD
 		SceneGMInteraction: deps.sceneGMInteraction,
 		Write:              deps.writePath,
 		Applier:            deps.applier,
+		History:            deps.historyReader,
 	})
 
 	return []grpcServiceDescriptor{
@@ -394,7 +414,23 @@ func buildSessionServiceDescriptors(deps sessionRegistrationDeps) []grpcServiceD
 // buildInfrastructureServiceDescriptors wires the operational services that do
 // not belong to a gameplay capability family.
 func buildInfrastructureServiceDescriptors(deps infrastructureRegistrationDeps) []grpcServiceDescriptor {
-	integrationService := gamegrpc.NewIntegrationService(deps.bundle.events.IntegrationOutboxStore())
+	integrationOutbox := deps.bundle.events.IntegrationOutboxStore()
+	integrationService := gamegrpc.NewIntegrationService(integrationOutbox)
+	webhookService := webhooktransport.NewService(webhooktransport.Deps{
+		Auth: authz.PolicyDeps{
+			Participant: deps.participantStore,
+			Character:   deps.characterStore,
+			Audit:       deps.auditStore,
+		},
+		Campaign:      deps.campaignStore,
+		Webhook:       deps.bundle.events.WebhookStore(),
+		Outbox:        integrationOutbox,
+		EventRegistry: deps.eventRegistry,
+		Events:        deps.eventReadStore,
+
+		AllowLoopbackHTTP: deps.webhookLoopback,
+		Sealer:            deps.webhookSealer,
+	})
 	statisticsService := gamegrpc.NewStatisticsService(deps.statisticsStore)
 	systemService := gamegrpc.NewSystemService(deps.systemRegistry)
 	authorizationService := authorizationtransport.NewService(authorizationtransport.Deps{
@@ -411,6 +447,12 @@ func buildInfrastructureServiceDescriptors(deps infrastructureRegistrationDeps)
 				statev1.RegisterIntegrationServiceServer(server, integrationService)
 			},
 		},
+		{
+			healthService: "game.v1.WebhookService",
+			register: func(server *grpc.Server) {
+				statev1.RegisterWebhookServiceServer(server, webhookService)
+			},
+		},
 		{
 			healthService: "game.v1.StatisticsService",
 			register: func(server *grpc.Server) {
@@ -142,7 +142,11 @@ func newDefaultGRPCServer(bundle *storageBundle, srvEnv serverEnv) *grpc.Server
 			"/game.v1.CampaignAIOrchestrationService/",
 			campaignv1.EventService_AppendEvent_FullMethodName,
 			"/game.v1.IntegrationService/",
+			campaignv1.WebhookService_GetWebhookDeliveryTarget_FullMethodName,
+			campaignv1.WebhookService_RecordWebhookDelivery_FullMethodName,
 			campaignv1.ParticipantService_BindParticipant_FullMethodName,
+			campaignv1.ParticipantService_ListUserParticipants_FullMethodName,
+			campaignv1.ParticipantService_AnonymizeUserParticipants_FullMethodName,
 		},
 		AllowedServiceIDs: parseInternalServiceAllowlist(srvEnv.InternalServiceAllowlist),
 	}
@@ -153,6 +157,7 @@ func newDefaultGRPCServer(bundle *storageBundle, srvEnv serverEnv) *grpc.Server
 			interceptors.InternalServiceIdentityUnaryInterceptor(internalIdentity),
 			interceptors.AuditInterceptor(audit.EnabledPolicy(bundle.events)),
 			interceptors.SessionLockInterceptor(bundle.projections),
+			interceptors.ExpectedSeqUnaryInterceptor(expectedSeqSupported(srvEnv)),
 			interceptors.ErrorConversionUnaryInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 
+	gamegrpc "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/aggregate"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
@@ -116,6 +117,16 @@ func openProjectionStore(path string) (projectionBackend, systemmanifest.Project
 	return store, store.ProjectionStores(), nil
 }
 
+// openHistoryScratch opens an empty in-memory projection store for one
+// point-in-time replay.
+func openHistoryScratch() (gamegrpc.HistoryScratch, error) {
+	store, err := sqlitecoreprojection.OpenMemory()
+	if err != nil {
+		return nil, fmt.Errorf("open history scratch store: %w", err)
+	}
+	return store, nil
+}
+
 // openContentStore opens the content reference database.
 func openContentStore(path string) (contentBackend, error) {
 	if err := ensureDir(path); err != nil {
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/louisbranch/fracturing.space/internal/platform/config"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
 	"github.com/louisbranch/fracturing.space/internal/platform/serviceaddr"
 )
 
@@ -24,6 +26,14 @@ type serverEnv struct {
 	ProjectionApplyOutboxWorkerEnabled       bool          `env:"FRACTURING_SPACE_GAME_PROJECTION_APPLY_OUTBOX_WORKER_ENABLED" envDefault:"false"`
 	InternalServiceAllowlist                 string        `env:"FRACTURING_SPACE_GAME_INTERNAL_SERVICE_ALLOWLIST" envDefault:"ai,invite,worker"`
 	StartupTimeout                           time.Duration `env:"FRACTURING_SPACE_GAME_STARTUP_TIMEOUT"            envDefault:"60s"`
+	// WebhookAllowLoopbackHTTP accepts plain-http loopback webhook URLs for
+	// local receivers. Development only; production must leave it off.
+	WebhookAllowLoopbackHTTP bool `env:"FRACTURING_SPACE_GAME_WEBHOOK_ALLOW_LOOPBACK_HTTP" envDefault:"false"`
+	// EncryptionKey, EncryptionKeys, and EncryptionActiveKeyID configure the
+	// keyring that seals webhook signing secrets at rest.
+	EncryptionKey         string `env:"FRACTURING_SPACE_GAME_ENCRYPTION_KEY"`
+	EncryptionKeys        string `env:"FRACTURING_SPACE_GAME_ENCRYPTION_KEYS"`
+	EncryptionActiveKeyID string `env:"FRACTURING_SPACE_GAME_ENCRYPTION_ACTIVE_KEY_ID"`
 }
 
 const (
@@ -58,9 +68,31 @@ func loadServerEnv() (serverEnv, error) {
 	if err := validateProjectionApplyOutboxConfig(cfg); err != nil {
 		return serverEnv{}, fmt.Errorf("validate projection apply config: %w", err)
 	}
+	if _, err := cfg.webhookSealer(); err != nil {
+		return serverEnv{}, err
+	}
 	return cfg, nil
 }
 
+// webhookSealer builds the keyring that seals webhook signing secrets. It
+// returns nil when no key is configured so webhook registration fails closed
+// instead of storing plaintext.
+func (cfg serverEnv) webhookSealer() (secret.Sealer, error) {
+	keyringConfig := secret.KeyringConfig{
+		LegacyKey:   strings.TrimSpace(cfg.EncryptionKey),
+		Keys:        strings.TrimSpace(cfg.EncryptionKeys),
+		ActiveKeyID: strings.TrimSpace(cfg.EncryptionActiveKeyID),
+	}
+	if !keyringConfig.Configured() {
+		return nil, nil
+	}
+	keyring, err := keyringConfig.Build()
+	if err != nil {
+		return nil, fmt.Errorf("build game encryption keyring: %w", err)
+	}
+	return keyring, nil
+}
+
 // validateProjectionApplyOutboxConfig checks that the projection apply outbox
 // env flags form a valid combination. This is the single validation pass;
 // resolveProjectionApplyOutboxModes reuses the same rules to return the
@@ -70,6 +102,14 @@ func validateProjectionApplyOutboxConfig(srvEnv serverEnv) error {
 	return err
 }
 
+// expectedSeqSupported reports whether the expected-seq write guard can be
+// honoured. The guard compares against the journal head, which only matches
+// projected read state while events apply inline.
+func expectedSeqSupported(srvEnv serverEnv) bool {
+	_, _, mode, err := resolveProjectionApplyOutboxModes(srvEnv)
+	return err == nil && mode != projectionApplyModeOutboxApplyOnly
+}
+
 func resolveProjectionApplyOutboxModes(srvEnv serverEnv) (bool, bool, string, error) {
 	if !srvEnv.ProjectionApplyOutboxEnabled {
 		if srvEnv.ProjectionApplyOutboxWorkerEnabled {
@@ -446,6 +446,47 @@ func TestLoadServerEnvDomainEnabledDefaults(t *testing.T) {
 	}
 }
 
+func TestLoadServerEnvWebhookSealerOptional(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_GAME_ENCRYPTION_KEY", "")
+	t.Setenv("FRACTURING_SPACE_GAME_ENCRYPTION_KEYS", "")
+
+	cfg, err := loadServerEnv()
+	if err != nil {
+		t.Fatalf("load server env: %v", err)
+	}
+	sealer, err := cfg.webhookSealer()
+	if err != nil {
+		t.Fatalf("webhook sealer: %v", err)
+	}
+	if sealer != nil {
+		t.Fatalf("sealer = %v, want nil without keys", sealer)
+	}
+}
+
+func TestLoadServerEnvWebhookSealerFromKey(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_GAME_ENCRYPTION_KEY", "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+
+	cfg, err := loadServerEnv()
+	if err != nil {
+		t.Fatalf("load server env: %v", err)
+	}
+	sealer, err := cfg.webhookSealer()
+	if err != nil {
+		t.Fatalf("webhook sealer: %v", err)
+	}
+	if sealer == nil {
+		t.Fatal("expected sealer when key is configured")
+	}
+}
+
+func TestLoadServerEnvRejectsInvalidEncryptionKey(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_GAME_ENCRYPTION_KEY", "not-base64")
+
+	if _, err := loadServerEnv(); err == nil {
+		t.Fatal("expected error for invalid encryption key")
+	}
+}
+
 func TestLoadServerEnvAuthAddrDefaultsToServiceDNS(t *testing.T) {
 	t.Setenv("FRACTURING_SPACE_AUTH_ADDR", "")
 
@@ -585,6 +626,18 @@ func TestResolveProjectionApplyOutboxModes_OutboxApplyOnly(t *testing.T) {
 	}
 }
 
+func TestExpectedSeqSupportedOnlyWithInlineApply(t *testing.T) {
+	if !expectedSeqSupported(serverEnv{}) {
+		t.Fatal("expected seq should be supported in inline apply mode")
+	}
+	if !expectedSeqSupported(serverEnv{ProjectionApplyOutboxEnabled: true, ProjectionApplyOutboxShadowWorkerEnabled: true}) {
+		t.Fatal("expected seq should be supported in shadow mode, which still applies inline")
+	}
+	if expectedSeqSupported(serverEnv{ProjectionApplyOutboxEnabled: true, ProjectionApplyOutboxWorkerEnabled: true}) {
+		t.Fatal("expected seq should be unsupported in outbox apply mode")
+	}
+}
+
 func TestResolveProjectionApplyOutboxModes_ShadowOnly(t *testing.T) {
 	applyWorker, shadowWorker, mode, err := resolveProjectionApplyOutboxModes(serverEnv{
 		ProjectionApplyOutboxEnabled:             true,
@@ -8,6 +8,7 @@ import (
 
 	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	sqliteprojectionapplyoutbox "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/projectionapplyoutbox"
 )
 
 func (s *Server) Addr() string {
@@ -97,7 +98,16 @@ func (s *Server) startProjectionWorker(ctx context.Context, kind projectionWorke
 		return func() {}
 	}
 
-	return startCancelableLoop(ctx, func(workerCtx context.Context) {
+	stopObservingBacklog := func() {}
+	if kind == projectionApplyWorkerKind {
+		unregister, err := sqliteprojectionapplyoutbox.ObserveBacklog(processor)
+		if err != nil {
+			slog.Warn("observe projection apply outbox backlog", "error", err)
+		}
+		stopObservingBacklog = unregister
+	}
+
+	stopLoop := startCancelableLoop(ctx, func(workerCtx context.Context) {
 		switch kind {
 		case projectionApplyWorkerKind:
 			runProjectionApplyOutboxWorker(
@@ -120,6 +130,7 @@ func (s *Server) startProjectionWorker(ctx context.Context, kind projectionWorke
 			)
 		}
 	})
+	return composeRuntimeStops(stopLoop, stopObservingBacklog)
 }
 
 // runProjectionApplyOutboxShadowWorker drains projection outbox shadow entries.
@@ -70,7 +70,8 @@ type eventBackend interface {
 	storage.EventIntegrityVerifier
 	Close() error
 	ProjectionApplyOutboxStore() storage.ProjectionApplyOutboxStore
-	IntegrationOutboxStore() storage.IntegrationOutboxWorkerStore
+	IntegrationOutboxStore() storage.IntegrationOutboxStore
+	WebhookStore() storage.WebhookStore
 }
 
 type projectionBackend interface {
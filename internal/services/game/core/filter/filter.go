@@ -10,10 +10,28 @@ import (
 	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 )
 
+// FunctionIn is the custom membership function, e.g.
+// `in(payload.severity, "major", "severe")`.
+const FunctionIn = "in"
+
+// maxInValues bounds the candidate list accepted by FunctionIn. The checker
+// resolves overloads by exact arity, so each list length is declared.
+const maxInValues = 20
+
+// presenceWildcard is the AIP-160 value for key presence, e.g. `payload.reason:*`.
+const presenceWildcard = "*"
+
 // EventDeclarations returns the field declarations for event filtering.
-func EventDeclarations() (*filtering.Declarations, error) {
-	return filtering.NewDeclarations(
+// Payload fields are declared under the payload prefix.
+func EventDeclarations(payload ...PayloadField) (*filtering.Declarations, error) {
+	fields, err := payloadFieldsByName(payload)
+	if err != nil {
+		return nil, err
+	}
+	opts := []filtering.DeclarationOption{
 		filtering.DeclareStandardFunctions(),
+		filtering.DeclareFunction(FunctionIn, inOverloads()...),
+		filtering.DeclareFunction(filtering.FunctionHas, presenceOverloads()...),
 		filtering.DeclareIdent("session_id", filtering.TypeString),
 		filtering.DeclareIdent("scene_id", filtering.TypeString),
 		filtering.DeclareIdent("type", filtering.TypeString),
@@ -24,7 +42,50 @@ func EventDeclarations() (*filtering.Declarations, error) {
 		filtering.DeclareIdent("entity_type", filtering.TypeString),
 		filtering.DeclareIdent("entity_id", filtering.TypeString),
 		filtering.DeclareIdent("ts", filtering.TypeTimestamp),
-	)
+		filtering.DeclareIdent("true", filtering.TypeBool),
+		filtering.DeclareIdent("false", filtering.TypeBool),
+	}
+	for name, field := range fields {
+		fieldType, _ := field.Type.filterType()
+		opts = append(opts, filtering.DeclareIdent(name, fieldType))
+	}
+	return filtering.NewDeclarations(opts...)
+}
+
+// presenceOverloads extends the standard `:` overloads so non-string payload
+// fields accept the presence check, e.g. `payload.marks:*`.
+func presenceOverloads() []*expr.Decl_FunctionDecl_Overload {
+	return []*expr.Decl_FunctionDecl_Overload{
+		filtering.NewFunctionOverload(filtering.FunctionHas+"_int_string", filtering.TypeBool, filtering.TypeInt, filtering.TypeString),
+		filtering.NewFunctionOverload(filtering.FunctionHas+"_float_string", filtering.TypeBool, filtering.TypeFloat, filtering.TypeString),
+		filtering.NewFunctionOverload(filtering.FunctionHas+"_bool_string", filtering.TypeBool, filtering.TypeBool, filtering.TypeString),
+	}
+}
+
+// inOverloads declares FunctionIn for every supported scalar type and list
+// length.
+func inOverloads() []*expr.Decl_FunctionDecl_Overload {
+	scalars := []struct {
+		name string
+		t    *expr.Type
+	}{
+		{name: "string", t: filtering.TypeString},
+		{name: "int", t: filtering.TypeInt},
+		{name: "float", t: filtering.TypeFloat},
+	}
+	overloads := make([]*expr.Decl_FunctionDecl_Overload, 0, len(scalars)*maxInValues)
+	for _, scalar := range scalars {
+		for n := 1; n <= maxInValues; n++ {
+			params := make([]*expr.Type, n+1)
+			for i := range params {
+				params[i] = scalar.t
+			}
+			overloads = append(overloads, filtering.NewFunctionOverload(
+				fmt.Sprintf("%s_%s_%d", FunctionIn, scalar.name, n), filtering.TypeBool, params...,
+			))
+		}
+	}
+	return overloads
 }
 
 // SQLCondition represents a SQL WHERE clause fragment with parameters.
@@ -50,13 +111,14 @@ var fieldMapping = map[string]string{
 }
 
 // ParseEventFilter parses an AIP-160 filter expression and returns a SQL condition.
-// Returns an empty condition for an empty filter string.
-func ParseEventFilter(filterStr string) (SQLCondition, error) {
+// Returns an empty condition for an empty filter string. Payload fields must be
+// declared to be filterable; callers usually pass the event registry's fields.
+func ParseEventFilter(filterStr string, payload ...PayloadField) (SQLCondition, error) {
 	if strings.TrimSpace(filterStr) == "" {
 		return SQLCondition{}, nil
 	}
 
-	decls, err := EventDeclarations()
+	decls, err := EventDeclarations(payload...)
 	if err != nil {
 		return SQLCondition{}, fmt.Errorf("create declarations: %w", err)
 	}
@@ -66,58 +128,74 @@ func ParseEventFilter(filterStr string) (SQLCondition, error) {
 		return SQLCondition{}, fmt.Errorf("parse filter: %w", err)
 	}
 
-	return translateExpr(filter.CheckedExpr.Expr)
+	fields, err := payloadFieldsByName(payload)
+	if err != nil {
+		return SQLCondition{}, err
+	}
+	return translator{payload: fields}.translateExpr(filter.CheckedExpr.Expr)
+}
+
+// translator turns a checked filter expression into SQL, resolving payload
+// fields to json_extract expressions.
+type translator struct {
+	payload map[string]PayloadField
 }
 
 // translateExpr translates a CEL expression to a SQL condition.
-func translateExpr(e *expr.Expr) (SQLCondition, error) {
+func (t translator) translateExpr(e *expr.Expr) (SQLCondition, error) {
 	if e == nil {
 		return SQLCondition{}, nil
 	}
 
 	switch kind := e.ExprKind.(type) {
 	case *expr.Expr_CallExpr:
-		return translateCall(kind.CallExpr)
+		return t.translateCall(kind.CallExpr)
 	default:
 		return SQLCondition{}, fmt.Errorf("unsupported expression type: %T", kind)
 	}
 }
 
 // translateCall translates a CEL function call to a SQL condition.
-func translateCall(call *expr.Expr_Call) (SQLCondition, error) {
+func (t translator) translateCall(call *expr.Expr_Call) (SQLCondition, error) {
 	switch call.Function {
 	case "_&&_", "AND":
-		return translateAnd(call.Args)
+		return t.translateAnd(call.Args)
 	case "_||_", "OR":
-		return translateOr(call.Args)
+		return t.translateOr(call.Args)
+	case "NOT":
+		return t.translateNot(call.Args)
 	case "_==_", "=":
-		return translateEquals(call.Args)
+		return t.translateComparison(call.Args, "=")
 	case "_!=_", "!=":
-		return translateNotEquals(call.Args)
+		return t.translateComparison(call.Args, "!=")
 	case "_<_", "<":
-		return translateLessThan(call.Args)
+		return t.translateComparison(call.Args, "<")
 	case "_<=_", "<=":
-		return translateLessEquals(call.Args)
+		return t.translateComparison(call.Args, "<=")
 	case "_>_", ">":
-		return translateGreaterThan(call.Args)
+		return t.translateComparison(call.Args, ">")
 	case "_>=_", ">=":
-		return translateGreaterEquals(call.Args)
+		return t.translateComparison(call.Args, ">=")
+	case filtering.FunctionHas:
+		return t.translateHas(call.Args)
+	case FunctionIn:
+		return t.translateIn(call.Args)
 	default:
 		return SQLCondition{}, fmt.Errorf("unsupported function: %s", call.Function)
 	}
 }
 
-func translateAnd(args []*expr.Expr) (SQLCondition, error) {
+func (t translator) translateAnd(args []*expr.Expr) (SQLCondition, error) {
 	if len(args) != 2 {
 		return SQLCondition{}, fmt.Errorf("AND requires 2 arguments")
 	}
 
-	left, err := translateExpr(args[0])
+	left, err := t.translateExpr(args[0])
 	if err != nil {
 		return SQLCondition{}, err
 	}
 
-	right, err := translateExpr(args[1])
+	right, err := t.translateExpr(args[1])
 	if err != nil {
 		return SQLCondition{}, err
 	}
@@ -128,17 +206,17 @@ func translateAnd(args []*expr.Expr) (SQLCondition, error) {
 	}, nil
 }
 
-func translateOr(args []*expr.Expr) (SQLCondition, error) {
+func (t translator) translateOr(args []*expr.Expr) (SQLCondition, error) {
 	if len(args) != 2 {
 		return SQLCondition{}, fmt.Errorf("OR requires 2 arguments")
 	}
 
-	left, err := translateExpr(args[0])
+	left, err := t.translateExpr(args[0])
 	if err != nil {
 		return SQLCondition{}, err
 	}
 
-	right, err := translateExpr(args[1])
+	right, err := t.translateExpr(args[1])
 	if err != nil {
 		return SQLCondition{}, err
 	}
@@ -149,54 +227,171 @@ func translateOr(args []*expr.Expr) (SQLCondition, error) {
 	}, nil
 }
 
-func translateEquals(args []*expr.Expr) (SQLCondition, error) {
-	return translateComparison(args, "=")
-}
+// translateNot negates a condition. Rows where the inner condition is NULL
+// (typically payload fields absent from other event types) count as not
+// matching, so the negation includes them.
+func (t translator) translateNot(args []*expr.Expr) (SQLCondition, error) {
+	if len(args) != 1 {
+		return SQLCondition{}, fmt.Errorf("NOT requires 1 argument")
+	}
 
-func translateNotEquals(args []*expr.Expr) (SQLCondition, error) {
-	return translateComparison(args, "!=")
-}
+	inner, err := t.translateExpr(args[0])
+	if err != nil {
+		return SQLCondition{}, err
+	}
 
-func translateLessThan(args []*expr.Expr) (SQLCondition, error) {
-	return translateComparison(args, "<")
+	return SQLCondition{
+		Clause: fmt.Sprintf("NOT COALESCE(%s, 0)", inner.Clause),
+		Params: inner.Params,
+	}, nil
 }
 
-func translateLessEquals(args []*expr.Expr) (SQLCondition, error) {
-	return translateComparison(args, "<=")
-}
+func (t translator) translateComparison(args []*expr.Expr, op string) (SQLCondition, error) {
+	if len(args) != 2 {
+		return SQLCondition{}, fmt.Errorf("comparison requires 2 arguments")
+	}
 
-func translateGreaterThan(args []*expr.Expr) (SQLCondition, error) {
-	return translateComparison(args, ">")
-}
+	col, err := t.resolveColumn(args[0])
+	if err != nil {
+		return SQLCondition{}, err
+	}
+	if col.fieldType == FieldTypeStringList {
+		return SQLCondition{}, fmt.Errorf("list field %s only supports ':'", col.name)
+	}
+
+	value, err := extractValue(args[1])
+	if err != nil {
+		return SQLCondition{}, err
+	}
 
-func translateGreaterEquals(args []*expr.Expr) (SQLCondition, error) {
-	return translateComparison(args, ">=")
+	return col.scope(SQLCondition{
+		Clause: fmt.Sprintf("%s %s ?", col.sql, op),
+		Params: []any{value},
+	}), nil
 }
 
-func translateComparison(args []*expr.Expr, op string) (SQLCondition, error) {
+// translateHas handles the AIP-160 `:` operator: key presence for `*`, element
+// membership for list fields, and equality for scalars.
+func (t translator) translateHas(args []*expr.Expr) (SQLCondition, error) {
 	if len(args) != 2 {
-		return SQLCondition{}, fmt.Errorf("comparison requires 2 arguments")
+		return SQLCondition{}, fmt.Errorf("':' requires 2 arguments")
 	}
 
-	field, err := extractFieldName(args[0])
+	col, err := t.resolveColumn(args[0])
+	if err != nil {
+		return SQLCondition{}, err
+	}
+
+	value, err := extractValue(args[1])
 	if err != nil {
 		return SQLCondition{}, err
 	}
+	if value == presenceWildcard {
+		if col.payload {
+			return col.scope(SQLCondition{Clause: col.sql + " IS NOT NULL"}), nil
+		}
+		return SQLCondition{Clause: col.sql + " != ''"}, nil
+	}
+	switch col.fieldType {
+	case FieldTypeString:
+		return col.scope(SQLCondition{
+			Clause: col.sql + " = ?",
+			Params: []any{value},
+		}), nil
+	case FieldTypeStringList:
+		return col.scope(SQLCondition{
+			Clause: fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s, '%s') WHERE json_each.value = ?)", payloadColumnExpr, col.path),
+			Params: []any{value},
+		}), nil
+	default:
+		return SQLCondition{}, fmt.Errorf("field %s only supports ':*'", col.name)
+	}
+}
 
-	column, ok := fieldMapping[field]
-	if !ok {
-		return SQLCondition{}, fmt.Errorf("unknown field: %s", field)
+// translateIn handles `in(field, v1, v2, ...)`.
+func (t translator) translateIn(args []*expr.Expr) (SQLCondition, error) {
+	if len(args) < 2 {
+		return SQLCondition{}, fmt.Errorf("%s requires a field and at least 1 value", FunctionIn)
 	}
 
-	value, err := extractValue(args[1])
+	col, err := t.resolveColumn(args[0])
 	if err != nil {
 		return SQLCondition{}, err
 	}
+	if col.fieldType == FieldTypeStringList {
+		return SQLCondition{}, fmt.Errorf("list field %s only supports ':'", col.name)
+	}
+
+	placeholders := make([]string, 0, len(args)-1)
+	params := make([]any, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		value, err := extractValue(arg)
+		if err != nil {
+			return SQLCondition{}, err
+		}
+		placeholders = append(placeholders, "?")
+		params = append(params, value)
+	}
 
+	return col.scope(SQLCondition{
+		Clause: fmt.Sprintf("%s IN (%s)", col.sql, strings.Join(placeholders, ", ")),
+		Params: params,
+	}), nil
+}
+
+// column is a resolved filter field. Envelope columns are strings apart from
+// ts, which only supports comparisons.
+type column struct {
+	name      string
+	sql       string
+	path      string
+	payload   bool
+	fieldType FieldType
+	// eventTypes restricts payload clauses to the declaring event types.
+	eventTypes []string
+}
+
+// scope ANDs a payload clause with the event types that declare the field, so
+// a key shared by an unrelated payload never matches. Envelope clauses and
+// unscoped fields pass through.
+func (c column) scope(cond SQLCondition) SQLCondition {
+	if !c.payload || len(c.eventTypes) == 0 {
+		return cond
+	}
+	placeholders := make([]string, 0, len(c.eventTypes))
+	params := make([]any, 0, len(c.eventTypes)+len(cond.Params))
+	for _, eventType := range c.eventTypes {
+		placeholders = append(placeholders, "?")
+		params = append(params, eventType)
+	}
 	return SQLCondition{
-		Clause: fmt.Sprintf("%s %s ?", column, op),
-		Params: []any{value},
-	}, nil
+		Clause: fmt.Sprintf("(%s IN (%s) AND %s)", fieldMapping["type"], strings.Join(placeholders, ", "), cond.Clause),
+		Params: append(params, cond.Params...),
+	}
+}
+
+// resolveColumn maps a field expression to its envelope column or payload
+// JSON extraction. Payload columns carry their declaring event types, which
+// every clause built on them is scoped to.
+func (t translator) resolveColumn(e *expr.Expr) (column, error) {
+	field, err := extractFieldName(e)
+	if err != nil {
+		return column{}, err
+	}
+	if name, ok := fieldMapping[field]; ok {
+		return column{name: field, sql: name, fieldType: FieldTypeString}, nil
+	}
+	if payloadField, ok := t.payload[field]; ok {
+		return column{
+			name:       field,
+			sql:        payloadField.SQLExpr(),
+			path:       payloadField.Path,
+			payload:    true,
+			fieldType:  payloadField.Type,
+			eventTypes: payloadField.EventTypes,
+		}, nil
+	}
+	return column{}, fmt.Errorf("unknown field: %s", field)
 }
 
 func extractFieldName(e *expr.Expr) (string, error) {
@@ -204,12 +399,10 @@ func extractFieldName(e *expr.Expr) (string, error) {
 		return "", fmt.Errorf("nil expression")
 	}
 
-	switch kind := e.ExprKind.(type) {
-	case *expr.Expr_IdentExpr:
-		return kind.IdentExpr.Name, nil
-	default:
-		return "", fmt.Errorf("expected identifier, got %T", kind)
+	if name, ok := qualifiedName(e); ok {
+		return name, nil
 	}
+	return "", fmt.Errorf("expected identifier, got %T", e.ExprKind)
 }
 
 func extractValue(e *expr.Expr) (any, error) {
@@ -220,6 +413,16 @@ func extractValue(e *expr.Expr) (any, error) {
 	switch kind := e.ExprKind.(type) {
 	case *expr.Expr_ConstExpr:
 		return extractConstValue(kind.ConstExpr)
+	case *expr.Expr_IdentExpr:
+		// true and false are declared as bool identifiers; AIP-160 has no
+		// boolean literal.
+		switch kind.IdentExpr.Name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("expected constant or timestamp, got identifier %s", kind.IdentExpr.Name)
 	case *expr.Expr_CallExpr:
 		// Handle timestamp("...") function calls
 		if kind.CallExpr.Function == "timestamp" && len(kind.CallExpr.Args) == 1 {
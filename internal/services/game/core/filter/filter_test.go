@@ -237,7 +237,7 @@ func TestParseEventFilter_SystemVersionField(t *testing.T) {
 // --- Internal function tests for uncovered branches ---
 
 func TestTranslateExpr_Nil(t *testing.T) {
-	cond, err := translateExpr(nil)
+	cond, err := translator{}.translateExpr(nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -248,28 +248,28 @@ func TestTranslateExpr_Nil(t *testing.T) {
 
 func TestTranslateExpr_UnsupportedType(t *testing.T) {
 	e := &expr.Expr{ExprKind: &expr.Expr_IdentExpr{IdentExpr: &expr.Expr_Ident{Name: "x"}}}
-	_, err := translateExpr(e)
+	_, err := translator{}.translateExpr(e)
 	if err == nil {
 		t.Fatal("expected error for unsupported expression type")
 	}
 }
 
 func TestTranslateAnd_WrongArgCount(t *testing.T) {
-	_, err := translateAnd(nil)
+	_, err := translator{}.translateAnd(nil)
 	if err == nil {
 		t.Fatal("expected error for AND with 0 arguments")
 	}
 }
 
 func TestTranslateOr_WrongArgCount(t *testing.T) {
-	_, err := translateOr(nil)
+	_, err := translator{}.translateOr(nil)
 	if err == nil {
 		t.Fatal("expected error for OR with 0 arguments")
 	}
 }
 
 func TestTranslateComparison_WrongArgCount(t *testing.T) {
-	_, err := translateComparison(nil, "=")
+	_, err := translator{}.translateComparison(nil, "=")
 	if err == nil {
 		t.Fatal("expected error for comparison with 0 arguments")
 	}
@@ -364,7 +364,7 @@ func TestExtractTimestampValue_NonStringConst(t *testing.T) {
 
 func TestTranslateCall_UnsupportedFunction(t *testing.T) {
 	call := &expr.Expr_Call{Function: "unsupported_func"}
-	_, err := translateCall(call)
+	_, err := translator{}.translateCall(call)
 	if err == nil {
 		t.Fatal("expected error for unsupported function")
 	}
@@ -379,7 +379,7 @@ func TestTranslateAnd_RightSideError(t *testing.T) {
 		},
 	}}}
 	bad := &expr.Expr{ExprKind: &expr.Expr_IdentExpr{IdentExpr: &expr.Expr_Ident{Name: "x"}}}
-	_, err := translateAnd([]*expr.Expr{good, bad})
+	_, err := translator{}.translateAnd([]*expr.Expr{good, bad})
 	if err == nil {
 		t.Fatal("expected error for right-side error in AND")
 	}
@@ -394,7 +394,7 @@ func TestTranslateAnd_LeftSideError(t *testing.T) {
 			{ExprKind: &expr.Expr_ConstExpr{ConstExpr: &expr.Constant{ConstantKind: &expr.Constant_StringValue{StringValue: "a"}}}},
 		},
 	}}}
-	_, err := translateAnd([]*expr.Expr{bad, good})
+	_, err := translator{}.translateAnd([]*expr.Expr{bad, good})
 	if err == nil {
 		t.Fatal("expected error for left-side error in AND")
 	}
@@ -409,7 +409,7 @@ func TestTranslateOr_RightSideError(t *testing.T) {
 		},
 	}}}
 	bad := &expr.Expr{ExprKind: &expr.Expr_IdentExpr{IdentExpr: &expr.Expr_Ident{Name: "x"}}}
-	_, err := translateOr([]*expr.Expr{good, bad})
+	_, err := translator{}.translateOr([]*expr.Expr{good, bad})
 	if err == nil {
 		t.Fatal("expected error for right-side error in OR")
 	}
@@ -420,7 +420,7 @@ func TestTranslateComparison_ExtractValueError(t *testing.T) {
 		{ExprKind: &expr.Expr_IdentExpr{IdentExpr: &expr.Expr_Ident{Name: "type"}}},
 		{ExprKind: &expr.Expr_IdentExpr{IdentExpr: &expr.Expr_Ident{Name: "not_a_value"}}},
 	}
-	_, err := translateComparison(args, "=")
+	_, err := translator{}.translateComparison(args, "=")
 	if err == nil {
 		t.Fatal("expected error for extractValue failure")
 	}
@@ -435,7 +435,7 @@ func TestTranslateOr_LeftSideError(t *testing.T) {
 			{ExprKind: &expr.Expr_ConstExpr{ConstExpr: &expr.Constant{ConstantKind: &expr.Constant_StringValue{StringValue: "a"}}}},
 		},
 	}}}
-	_, err := translateOr([]*expr.Expr{bad, good})
+	_, err := translator{}.translateOr([]*expr.Expr{bad, good})
 	if err == nil {
 		t.Fatal("expected error for left-side error in OR")
 	}
@@ -460,8 +460,180 @@ func TestTranslateComparison_ExtractFieldError(t *testing.T) {
 		{ExprKind: &expr.Expr_ConstExpr{ConstExpr: &expr.Constant{ConstantKind: &expr.Constant_StringValue{StringValue: "oops"}}}},
 		{ExprKind: &expr.Expr_ConstExpr{ConstExpr: &expr.Constant{ConstantKind: &expr.Constant_StringValue{StringValue: "val"}}}},
 	}
-	_, err := translateComparison(args, "=")
+	_, err := translator{}.translateComparison(args, "=")
 	if err == nil {
 		t.Fatal("expected error for extractFieldName failure")
 	}
 }
+
+var testPayloadFields = []PayloadField{
+	{Name: "marks", Path: "$.marks", Type: FieldTypeInt},
+	{Name: "severity", Path: "$.severity", Type: FieldTypeString},
+	{Name: "mitigated", Path: "$.mitigated", Type: FieldTypeBool},
+	{Name: "source_character_ids", Path: "$.source_character_ids", Type: FieldTypeStringList},
+}
+
+func TestParseEventFilter_PayloadComparison(t *testing.T) {
+	cond, err := ParseEventFilter(`type = "sys.daggerheart.damage_applied" AND payload.marks >= 2`, testPayloadFields...)
+	if err != nil {
+		t.Fatalf("parse filter: %v", err)
+	}
+	if cond.Clause != "(event_type = ? AND json_extract(CAST(payload_json AS TEXT), '$.marks') >= ?)" {
+		t.Fatalf("Clause = %q", cond.Clause)
+	}
+	if !reflect.DeepEqual(cond.Params, []any{"sys.daggerheart.damage_applied", int64(2)}) {
+		t.Fatalf("Params = %v", cond.Params)
+	}
+
+	cond, err = ParseEventFilter(`payload.mitigated = true`, testPayloadFields...)
+	if err != nil {
+		t.Fatalf("parse bool filter: %v", err)
+	}
+	if !reflect.DeepEqual(cond.Params, []any{true}) {
+		t.Fatalf("Params = %v", cond.Params)
+	}
+}
+
+func TestParseEventFilter_PayloadScopedToDeclaringTypes(t *testing.T) {
+	marks := PayloadField{Name: "marks", Path: "$.marks", Type: FieldTypeInt, EventTypes: []string{"sys.daggerheart.damage_applied"}}
+	tags := PayloadField{Name: "tags", Path: "$.tags", Type: FieldTypeStringList, EventTypes: []string{"sys.a", "sys.b"}}
+
+	cond, err := ParseEventFilter(`payload.marks >= 2`, marks, tags)
+	if err != nil {
+		t.Fatalf("parse filter: %v", err)
+	}
+	if cond.Clause != "(event_type IN (?) AND json_extract(CAST(payload_json AS TEXT), '$.marks') >= ?)" {
+		t.Fatalf("Clause = %q", cond.Clause)
+	}
+	if !reflect.DeepEqual(cond.Params, []any{"sys.daggerheart.damage_applied", int64(2)}) {
+		t.Fatalf("Params = %v", cond.Params)
+	}
+
+	cond, err = ParseEventFilter(`payload.tags:"x" AND type = "sys.b"`, marks, tags)
+	if err != nil {
+		t.Fatalf("parse list filter: %v", err)
+	}
+	if !reflect.DeepEqual(cond.Params, []any{"sys.a", "sys.b", "x", "sys.b"}) {
+		t.Fatalf("Params = %v, want declaring types before the value", cond.Params)
+	}
+
+	cond, err = ParseEventFilter(`in(payload.marks, 1, 2)`, marks)
+	if err != nil {
+		t.Fatalf("parse in filter: %v", err)
+	}
+	if cond.Clause != "(event_type IN (?) AND json_extract(CAST(payload_json AS TEXT), '$.marks') IN (?, ?))" {
+		t.Fatalf("Clause = %q", cond.Clause)
+	}
+}
+
+func TestParseEventFilter_PayloadFieldsMustBeDeclared(t *testing.T) {
+	if _, err := ParseEventFilter(`payload.marks >= 2`); err == nil {
+		t.Fatal("expected undeclared payload field to be rejected")
+	}
+	if _, err := ParseEventFilter(`payload.marks >= "two"`, testPayloadFields...); err == nil {
+		t.Fatal("expected mistyped payload literal to be rejected")
+	}
+	if _, err := ParseEventFilter(`payload.source_character_ids = "ch1"`, testPayloadFields...); err == nil {
+		t.Fatal("expected list comparison to be rejected")
+	}
+	if _, err := ParseEventFilter(`payload.marks:"2"`, testPayloadFields...); err == nil {
+		t.Fatal("expected non-presence ':' on an int field to be rejected")
+	}
+}
+
+func TestParseEventFilter_Has(t *testing.T) {
+	tests := []struct {
+		filter string
+		clause string
+		params []any
+	}{
+		{
+			filter: `payload.source_character_ids:"ch1"`,
+			clause: "EXISTS (SELECT 1 FROM json_each(CAST(payload_json AS TEXT), '$.source_character_ids') WHERE json_each.value = ?)",
+			params: []any{"ch1"},
+		},
+		{
+			filter: `payload.severity:*`,
+			clause: "json_extract(CAST(payload_json AS TEXT), '$.severity') IS NOT NULL",
+		},
+		{
+			filter: `payload.marks:*`,
+			clause: "json_extract(CAST(payload_json AS TEXT), '$.marks') IS NOT NULL",
+		},
+		{
+			filter: `scene_id:*`,
+			clause: "scene_id != ''",
+		},
+		{
+			filter: `payload.severity:"major"`,
+			clause: "json_extract(CAST(payload_json AS TEXT), '$.severity') = ?",
+			params: []any{"major"},
+		},
+	}
+	for _, tt := range tests {
+		cond, err := ParseEventFilter(tt.filter, testPayloadFields...)
+		if err != nil {
+			t.Fatalf("parse %q: %v", tt.filter, err)
+		}
+		if cond.Clause != tt.clause {
+			t.Fatalf("%q Clause = %q, want %q", tt.filter, cond.Clause, tt.clause)
+		}
+		if !reflect.DeepEqual(cond.Params, tt.params) {
+			t.Fatalf("%q Params = %v, want %v", tt.filter, cond.Params, tt.params)
+		}
+	}
+}
+
+func TestParseEventFilter_In(t *testing.T) {
+	cond, err := ParseEventFilter(`in(payload.severity, "major", "severe")`, testPayloadFields...)
+	if err != nil {
+		t.Fatalf("parse filter: %v", err)
+	}
+	if cond.Clause != "json_extract(CAST(payload_json AS TEXT), '$.severity') IN (?, ?)" {
+		t.Fatalf("Clause = %q", cond.Clause)
+	}
+	if !reflect.DeepEqual(cond.Params, []any{"major", "severe"}) {
+		t.Fatalf("Params = %v", cond.Params)
+	}
+
+	cond, err = ParseEventFilter(`in(type, "a")`)
+	if err != nil {
+		t.Fatalf("parse envelope in: %v", err)
+	}
+	if cond.Clause != "event_type IN (?)" {
+		t.Fatalf("Clause = %q", cond.Clause)
+	}
+
+	if _, err := ParseEventFilter(`in(payload.marks, "two")`, testPayloadFields...); err == nil {
+		t.Fatal("expected mixed in() types to be rejected")
+	}
+}
+
+func TestParseEventFilter_Negation(t *testing.T) {
+	for _, filter := range []string{`NOT payload.mitigated = true`, `-payload.mitigated = true`} {
+		cond, err := ParseEventFilter(filter, testPayloadFields...)
+		if err != nil {
+			t.Fatalf("parse %q: %v", filter, err)
+		}
+		if cond.Clause != "NOT COALESCE(json_extract(CAST(payload_json AS TEXT), '$.mitigated') = ?, 0)" {
+			t.Fatalf("%q Clause = %q", filter, cond.Clause)
+		}
+	}
+}
+
+func TestEventDeclarations_RejectsInvalidPayloadFields(t *testing.T) {
+	invalid := [][]PayloadField{
+		{{Name: "Marks", Path: "$.marks", Type: FieldTypeInt}},
+		{{Name: "marks", Path: "$.marks'); DROP TABLE events; --", Type: FieldTypeInt}},
+		{{Name: "marks", Path: "$.marks", Type: "decimal"}},
+		{{Name: "marks", Path: "$.marks", Type: FieldTypeInt}, {Name: "marks", Path: "$.hp", Type: FieldTypeInt}},
+	}
+	for _, fields := range invalid {
+		if _, err := EventDeclarations(fields...); err == nil {
+			t.Fatalf("expected %+v to be rejected", fields)
+		}
+	}
+	if _, err := EventDeclarations(testPayloadFields[0], testPayloadFields[0]); err != nil {
+		t.Fatalf("identical redeclaration should merge: %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+
+	"go.einride.tech/aip/filtering"
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// PayloadFieldPrefix namespaces payload fields in filter expressions, e.g.
+// `payload.marks >= 2`.
+const PayloadFieldPrefix = "payload."
+
+// payloadColumnExpr reads payload JSON as text so SQLite does not mistake the
+// BLOB column for JSONB. Index expressions must repeat it verbatim for the
+// query planner to use them.
+const payloadColumnExpr = "CAST(payload_json AS TEXT)"
+
+// FieldType identifies the JSON value type of a payload filter field.
+type FieldType string
+
+const (
+	// FieldTypeString declares a JSON string value.
+	FieldTypeString FieldType = "string"
+	// FieldTypeInt declares a JSON integer value.
+	FieldTypeInt FieldType = "int"
+	// FieldTypeFloat declares a JSON number value.
+	FieldTypeFloat FieldType = "float"
+	// FieldTypeBool declares a JSON boolean value.
+	FieldTypeBool FieldType = "bool"
+	// FieldTypeStringList declares a JSON array of strings; only `:` (has)
+	// applies to it.
+	FieldTypeStringList FieldType = "string_list"
+)
+
+// PayloadField declares one filterable value inside event payload JSON.
+//
+// Event definitions own these declarations so the filter surface follows the
+// payload contract of each event type rather than a hand-maintained list.
+type PayloadField struct {
+	// Name is the filter identifier without the payload prefix.
+	Name string
+	// Path is the SQLite JSON path of the value, e.g. "$.hp_after".
+	Path string
+	// Type is the JSON value type used for type-checking filter literals.
+	Type FieldType
+	// EventTypes lists the event types whose payloads declare the field. Clauses
+	// on the field only match those types, so an unrelated payload that happens
+	// to use the same key never matches. Empty leaves the field unscoped.
+	EventTypes []string
+}
+
+var (
+	payloadFieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	payloadFieldPathPattern = regexp.MustCompile(`^\$(\.[a-z][a-z0-9_]*)+$`)
+)
+
+// Validate checks the field name, JSON path, and type. Paths are embedded in
+// SQL text (so expression indexes can match), which is why they are limited
+// to plain dotted object keys.
+func (f PayloadField) Validate() error {
+	if !payloadFieldNamePattern.MatchString(f.Name) {
+		return fmt.Errorf("payload field name %q must be lower snake case", f.Name)
+	}
+	if !payloadFieldPathPattern.MatchString(f.Path) {
+		return fmt.Errorf("payload field %s path %q must be a dotted JSON object path", f.Name, f.Path)
+	}
+	if _, ok := f.Type.filterType(); !ok {
+		return fmt.Errorf("payload field %s type %q is invalid", f.Name, f.Type)
+	}
+	return nil
+}
+
+// SQLExpr returns the SQLite expression that extracts the field value.
+func (f PayloadField) SQLExpr() string {
+	return fmt.Sprintf("json_extract(%s, '%s')", payloadColumnExpr, f.Path)
+}
+
+func (t FieldType) filterType() (*expr.Type, bool) {
+	switch t {
+	case FieldTypeString:
+		return filtering.TypeString, true
+	case FieldTypeInt:
+		return filtering.TypeInt, true
+	case FieldTypeFloat:
+		return filtering.TypeFloat, true
+	case FieldTypeBool:
+		return filtering.TypeBool, true
+	case FieldTypeStringList:
+		return filtering.TypeList(filtering.TypeString), true
+	default:
+		return nil, false
+	}
+}
+
+// payloadFieldsByName indexes fields by their filter identifier.
+func payloadFieldsByName(fields []PayloadField) (map[string]PayloadField, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	byName := make(map[string]PayloadField, len(fields))
+	for _, field := range fields {
+		if err := field.Validate(); err != nil {
+			return nil, err
+		}
+		name := PayloadFieldPrefix + field.Name
+		if existing, ok := byName[name]; ok {
+			if existing.Path != field.Path || existing.Type != field.Type {
+				return nil, fmt.Errorf("payload field %s declared twice with different paths or types", field.Name)
+			}
+			field.EventTypes = append(append([]string(nil), existing.EventTypes...), field.EventTypes...)
+		}
+		byName[name] = field
+	}
+	return byName, nil
+}
+
+// qualifiedName flattens a select chain such as payload.marks into its
+// dotted identifier.
+func qualifiedName(e *expr.Expr) (string, bool) {
+	switch kind := e.GetExprKind().(type) {
+	case *expr.Expr_IdentExpr:
+		return kind.IdentExpr.GetName(), true
+	case *expr.Expr_SelectExpr:
+		operand, ok := qualifiedName(kind.SelectExpr.GetOperand())
+		if !ok {
+			return "", false
+		}
+		return operand + "." + kind.SelectExpr.GetField(), true
+	default:
+		return "", false
+	}
+}
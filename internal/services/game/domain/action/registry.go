@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	corefilter "github.com/louisbranch/fracturing.space/internal/services/game/core/filter"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
 )
@@ -71,6 +72,10 @@ func RegisterEvents(registry *event.Registry) error {
 			Addressing:      event.AddressingPolicyEntityTarget,
 			ValidatePayload: validateRollResolvePayload,
 			Intent:          event.IntentReplayOnly,
+			FilterFields: []corefilter.PayloadField{
+				{Name: "outcome", Path: "$.outcome", Type: corefilter.FieldTypeString},
+				{Name: "roll_seq", Path: "$.roll_seq", Type: corefilter.FieldTypeInt},
+			},
 		},
 		{
 			Type:            EventTypeOutcomeApplied,
@@ -28,6 +28,8 @@ func TestCampaignContractTypeLists(t *testing.T) {
 		EventTypeAIUnbound,
 		EventTypeAIAuthRotated,
 		EventTypeForked,
+		EventTypeModerationLocked,
+		EventTypeModerationUnlocked,
 	}
 	if !testcontracts.EqualSlices(emittable, wantEmittable) {
 		t.Fatalf("EmittableEventTypes() = %v, want %v", emittable, wantEmittable)
@@ -45,6 +47,8 @@ func TestCampaignContractTypeLists(t *testing.T) {
 		CommandTypeEnd,
 		CommandTypeArchive,
 		CommandTypeRestore,
+		CommandTypeModerationLock,
+		CommandTypeModerationUnlock,
 	}
 	if !testcontracts.EqualSlices(commands, wantCommands) {
 		t.Fatalf("DeciderHandledCommands() = %v, want %v", commands, wantCommands)
@@ -19,12 +19,16 @@ const (
 	CommandTypeEnd                    command.Type = "campaign.end"
 	CommandTypeArchive                command.Type = "campaign.archive"
 	CommandTypeRestore                command.Type = "campaign.restore"
+	CommandTypeModerationLock         command.Type = "campaign.moderation_lock"
+	CommandTypeModerationUnlock       command.Type = "campaign.moderation_unlock"
 	EventTypeCreated                  event.Type   = "campaign.created"
 	EventTypeUpdated                  event.Type   = "campaign.updated"
 	EventTypeAIBound                  event.Type   = "campaign.ai_bound"
 	EventTypeAIUnbound                event.Type   = "campaign.ai_unbound"
 	EventTypeAIAuthRotated            event.Type   = "campaign.ai_auth_rotated"
 	EventTypeForked                   event.Type   = "campaign.forked"
+	EventTypeModerationLocked         event.Type   = "campaign.moderation_locked"
+	EventTypeModerationUnlocked       event.Type   = "campaign.moderation_unlocked"
 
 	rejectionCodeCampaignAlreadyExists      = "CAMPAIGN_ALREADY_EXISTS"
 	rejectionCodeCampaignNotCreated         = "CAMPAIGN_NOT_CREATED"
@@ -39,6 +43,8 @@ const (
 	rejectionCodeCampaignCoverAssetInvalid  = "CAMPAIGN_COVER_ASSET_INVALID"
 	rejectionCodeCampaignCoverSetInvalid    = "CAMPAIGN_COVER_SET_INVALID"
 	rejectionCodeCampaignAIAgentIDRequired  = "CAMPAIGN_AI_AGENT_ID_REQUIRED"
+	rejectionCodeCampaignModerationReason   = "CAMPAIGN_MODERATION_REASON_REQUIRED"
+	rejectionCodeCampaignModerationState    = "CAMPAIGN_MODERATION_STATE_UNCHANGED"
 	// Used by campaignbootstrap workflow, not by campaign.Decide directly.
 	rejectionCodeCampaignParticipantsEmpty    = "CAMPAIGN_PARTICIPANTS_REQUIRED"
 	rejectionCodeCampaignParticipantDuplicate = "CAMPAIGN_PARTICIPANT_DUPLICATE"
@@ -63,6 +69,8 @@ func RejectionCodes() []string {
 		rejectionCodeCampaignCoverAssetInvalid,
 		rejectionCodeCampaignCoverSetInvalid,
 		rejectionCodeCampaignAIAgentIDRequired,
+		rejectionCodeCampaignModerationReason,
+		rejectionCodeCampaignModerationState,
 		rejectionCodeCampaignParticipantsEmpty,
 		rejectionCodeCampaignParticipantDuplicate,
 	}
@@ -89,6 +97,10 @@ func Decide(state State, cmd command.Command, now func() time.Time) command.Deci
 		return decideFork(state, cmd, now)
 	case CommandTypeEnd, CommandTypeArchive, CommandTypeRestore:
 		return decideLifecycleStatus(state, cmd, now)
+	case CommandTypeModerationLock:
+		return decideModerationLock(state, cmd, now)
+	case CommandTypeModerationUnlock:
+		return decideModerationUnlock(state, cmd, now)
 	default:
 		return command.Reject(command.Rejection{
 			Code:    rejectionCodeCommandTypeUnsupported,
@@ -0,0 +1,72 @@
+package campaign
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+)
+
+// decideModerationLock records an operator takedown. A locked campaign rejects
+// every other write until an operator lifts the lock, so the reason is
+// mandatory for the audit trail and for the notice shown to participants.
+func decideModerationLock(state State, cmd command.Command, now func() time.Time) command.Decision {
+	if !state.Created {
+		return command.Reject(command.Rejection{
+			Code:    rejectionCodeCampaignNotCreated,
+			Message: "campaign does not exist",
+		})
+	}
+	var payload ModerationLockPayload
+	if err := json.Unmarshal(cmd.PayloadJSON, &payload); err != nil {
+		return command.Reject(command.Rejection{
+			Code:    rejectionCodePayloadDecodeFailed,
+			Message: commandDecodeMessage(cmd, err),
+		})
+	}
+	reason := strings.TrimSpace(payload.Reason)
+	if reason == "" {
+		return command.Reject(command.Rejection{
+			Code:    rejectionCodeCampaignModerationReason,
+			Message: "moderation reason is required",
+		})
+	}
+	if state.ModerationLocked {
+		return command.Reject(command.Rejection{
+			Code:    rejectionCodeCampaignModerationState,
+			Message: "campaign is already locked by moderation",
+		})
+	}
+
+	payloadJSON, _ := json.Marshal(ModerationLockPayload{Reason: reason})
+	evt := command.NewEvent(cmd, EventTypeModerationLocked, "campaign", string(cmd.CampaignID), payloadJSON, command.RequireNowFunc(now)().UTC())
+	return command.Accept(evt)
+}
+
+// decideModerationUnlock lifts an operator takedown.
+func decideModerationUnlock(state State, cmd command.Command, now func() time.Time) command.Decision {
+	if !state.Created {
+		return command.Reject(command.Rejection{
+			Code:    rejectionCodeCampaignNotCreated,
+			Message: "campaign does not exist",
+		})
+	}
+	var payload ModerationUnlockPayload
+	if err := json.Unmarshal(cmd.PayloadJSON, &payload); err != nil {
+		return command.Reject(command.Rejection{
+			Code:    rejectionCodePayloadDecodeFailed,
+			Message: commandDecodeMessage(cmd, err),
+		})
+	}
+	if !state.ModerationLocked {
+		return command.Reject(command.Rejection{
+			Code:    rejectionCodeCampaignModerationState,
+			Message: "campaign is not locked by moderation",
+		})
+	}
+
+	payloadJSON, _ := json.Marshal(ModerationUnlockPayload{Reason: strings.TrimSpace(payload.Reason)})
+	evt := command.NewEvent(cmd, EventTypeModerationUnlocked, "campaign", string(cmd.CampaignID), payloadJSON, command.RequireNowFunc(now)().UTC())
+	return command.Accept(evt)
+}
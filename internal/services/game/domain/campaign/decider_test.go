@@ -915,3 +915,78 @@ func TestDecide_MalformedCreatePayloadRejected(t *testing.T) {
 type updatePayload struct {
 	Fields map[string]string `json:"fields"`
 }
+
+func TestDecideCampaignModerationLock_EmitsLockedEvent(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	decision := Decide(State{Created: true}, command.Command{
+		CampaignID:  "camp-1",
+		Type:        command.Type("campaign.moderation_lock"),
+		ActorType:   command.ActorTypeSystem,
+		PayloadJSON: []byte(`{"reason":"  harassment report  "}`),
+	}, func() time.Time { return now })
+	if len(decision.Rejections) != 0 {
+		t.Fatalf("expected no rejections, got %v", decision.Rejections)
+	}
+	if len(decision.Events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(decision.Events))
+	}
+	evt := decision.Events[0]
+	if evt.Type != event.Type("campaign.moderation_locked") {
+		t.Fatalf("event type = %s, want %s", evt.Type, "campaign.moderation_locked")
+	}
+	var payload ModerationLockPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if payload.Reason != "harassment report" {
+		t.Fatalf("payload reason = %q, want %q", payload.Reason, "harassment report")
+	}
+}
+
+func TestDecideCampaignModerationLock_Rejections(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   State
+		payload string
+		want    string
+	}{
+		{name: "not created", state: State{}, payload: `{"reason":"spam"}`, want: rejectionCodeCampaignNotCreated},
+		{name: "missing reason", state: State{Created: true}, payload: `{"reason":"  "}`, want: rejectionCodeCampaignModerationReason},
+		{name: "already locked", state: State{Created: true, ModerationLocked: true}, payload: `{"reason":"spam"}`, want: rejectionCodeCampaignModerationState},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := Decide(tt.state, command.Command{
+				CampaignID:  "camp-1",
+				Type:        CommandTypeModerationLock,
+				ActorType:   command.ActorTypeSystem,
+				PayloadJSON: []byte(tt.payload),
+			}, time.Now)
+			if len(decision.Rejections) != 1 || decision.Rejections[0].Code != tt.want {
+				t.Fatalf("rejections = %v, want code %s", decision.Rejections, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecideCampaignModerationUnlock(t *testing.T) {
+	decision := Decide(State{Created: true}, command.Command{
+		CampaignID:  "camp-1",
+		Type:        CommandTypeModerationUnlock,
+		ActorType:   command.ActorTypeSystem,
+		PayloadJSON: []byte(`{}`),
+	}, time.Now)
+	if len(decision.Rejections) != 1 || decision.Rejections[0].Code != rejectionCodeCampaignModerationState {
+		t.Fatalf("rejections = %v, want code %s", decision.Rejections, rejectionCodeCampaignModerationState)
+	}
+
+	decision = Decide(State{Created: true, ModerationLocked: true}, command.Command{
+		CampaignID:  "camp-1",
+		Type:        CommandTypeModerationUnlock,
+		ActorType:   command.ActorTypeSystem,
+		PayloadJSON: []byte(`{"reason":"appeal upheld"}`),
+	}, time.Now)
+	if len(decision.Events) != 1 || decision.Events[0].Type != EventTypeModerationUnlocked {
+		t.Fatalf("events = %v, want one %s", decision.Events, EventTypeModerationUnlocked)
+	}
+}
@@ -22,6 +22,8 @@ func newFoldRouter() *fold.CoreFoldRouter[State] {
 	r.Handle(EventTypeAIUnbound, foldAIUnbound)
 	r.Handle(EventTypeAIAuthRotated, foldAIAuthRotated)
 	r.Handle(EventTypeForked, foldForked)
+	r.Handle(EventTypeModerationLocked, foldModerationLocked)
+	r.Handle(EventTypeModerationUnlocked, foldModerationUnlocked)
 	return r
 }
 
@@ -106,3 +108,19 @@ func foldAIAuthRotated(state State, evt event.Event) (State, error) {
 func foldForked(state State, _ event.Event) (State, error) {
 	return state, nil
 }
+
+func foldModerationLocked(state State, evt event.Event) (State, error) {
+	var payload ModerationLockPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return state, fmt.Errorf("campaign fold %s: %w", evt.Type, err)
+	}
+	state.ModerationLocked = true
+	state.ModerationReason = strings.TrimSpace(payload.Reason)
+	return state, nil
+}
+
+func foldModerationUnlocked(state State, _ event.Event) (State, error) {
+	state.ModerationLocked = false
+	state.ModerationReason = ""
+	return state, nil
+}
@@ -201,3 +201,26 @@ func TestFoldCampaignForked_NoStateMutation(t *testing.T) {
 		t.Fatalf("fork event mutated state: got %#v, want %#v", updated, initial)
 	}
 }
+
+func TestFoldCampaignModerationLockRoundTrip(t *testing.T) {
+	locked, err := Fold(State{Created: true}, event.Event{
+		Type:        EventTypeModerationLocked,
+		PayloadJSON: []byte(`{"reason":"spam"}`),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !locked.ModerationLocked || locked.ModerationReason != "spam" {
+		t.Fatalf("locked state = %+v, want locked with reason spam", locked)
+	}
+	unlocked, err := Fold(locked, event.Event{
+		Type:        EventTypeModerationUnlocked,
+		PayloadJSON: []byte(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unlocked.ModerationLocked || unlocked.ModerationReason != "" {
+		t.Fatalf("unlocked state = %+v, want cleared moderation lock", unlocked)
+	}
+}
@@ -63,3 +63,15 @@ type ForkPayload struct {
 	OriginCampaignID ids.CampaignID `json:"origin_campaign_id"`
 	CopyParticipants bool           `json:"copy_participants"`
 }
+
+// ModerationLockPayload captures the payload for campaign.moderation_lock
+// commands and campaign.moderation_locked events.
+type ModerationLockPayload struct {
+	Reason string `json:"reason"`
+}
+
+// ModerationUnlockPayload captures the payload for campaign.moderation_unlock
+// commands and campaign.moderation_unlocked events.
+type ModerationUnlockPayload struct {
+	Reason string `json:"reason,omitempty"`
+}
@@ -100,6 +100,22 @@ var campaignCommandContracts = []commandContract{
 			ActiveSession:   command.BlockedDuringActiveSession(),
 		},
 	},
+	{
+		definition: command.Definition{
+			Type:            CommandTypeModerationLock,
+			Owner:           command.OwnerCore,
+			ValidatePayload: validateModerationLockPayload,
+			ActiveSession:   command.AllowedDuringActiveSession(),
+		},
+	},
+	{
+		definition: command.Definition{
+			Type:            CommandTypeModerationUnlock,
+			Owner:           command.OwnerCore,
+			ValidatePayload: validateModerationUnlockPayload,
+			ActiveSession:   command.AllowedDuringActiveSession(),
+		},
+	},
 }
 
 var campaignEventContracts = []eventProjectionContract{
@@ -163,6 +179,26 @@ var campaignEventContracts = []eventProjectionContract{
 		emittable:  true,
 		projection: true,
 	},
+	{
+		definition: event.Definition{
+			Type:            EventTypeModerationLocked,
+			Owner:           event.OwnerCore,
+			Addressing:      event.AddressingPolicyEntityTarget,
+			ValidatePayload: validateModerationLockPayload,
+		},
+		emittable:  true,
+		projection: true,
+	},
+	{
+		definition: event.Definition{
+			Type:            EventTypeModerationUnlocked,
+			Owner:           event.OwnerCore,
+			Addressing:      event.AddressingPolicyEntityTarget,
+			ValidatePayload: validateModerationUnlockPayload,
+		},
+		emittable:  true,
+		projection: true,
+	},
 }
 
 // RegisterCommands registers campaign commands with the shared registry.
@@ -286,6 +322,27 @@ func validateAIAuthRotatePayload(raw json.RawMessage) error {
 	return nil
 }
 
+// validateModerationLockPayload ensures lock payloads include a reason string.
+func validateModerationLockPayload(raw json.RawMessage) error {
+	var payload ModerationLockPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return err
+	}
+	if strings.TrimSpace(payload.Reason) == "" {
+		return errors.New("reason is required")
+	}
+	return nil
+}
+
+// validateModerationUnlockPayload ensures unlock payload shape is accepted.
+func validateModerationUnlockPayload(raw json.RawMessage) error {
+	var payload ModerationUnlockPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return err
+	}
+	return nil
+}
+
 // validateForkPayload ensures fork payloads include required identifiers.
 func validateForkPayload(raw json.RawMessage) error {
 	var payload ForkPayload
@@ -27,4 +27,8 @@ type State struct {
 	AIAgentID string
 	// AIAuthEpoch stores the current AI authorization epoch for session grants.
 	AIAuthEpoch uint64
+	// ModerationLocked blocks every campaign write except the operator unlock.
+	ModerationLocked bool
+	// ModerationReason stores the operator-supplied reason for the active lock.
+	ModerationReason string
 }
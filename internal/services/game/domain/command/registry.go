@@ -169,6 +169,11 @@ type Command struct {
 
 	// --- Payload: the command-specific data (canonical JSON) ---
 	PayloadJSON []byte
+
+	// --- Concurrency: optional optimistic precondition on the journal head ---
+	// ExpectedSeq, when non-zero, is the campaign seq the caller last observed.
+	// The engine appends only if the journal head still matches it.
+	ExpectedSeq uint64
 }
 
 // Definition registers metadata for a command type.
@@ -14,6 +14,8 @@ const (
 	CampaignEnd                    command.Type = "campaign.end"
 	CampaignArchive                command.Type = "campaign.archive"
 	CampaignRestore                command.Type = "campaign.restore"
+	CampaignModerationLock         command.Type = "campaign.moderation_lock"
+	CampaignModerationUnlock       command.Type = "campaign.moderation_unlock"
 	ActionRollResolve              command.Type = "action.roll.resolve"
 	ActionOutcomeApply             command.Type = "action.outcome.apply"
 	ActionOutcomeReject            command.Type = "action.outcome.reject"
@@ -167,6 +167,8 @@ func staticCoreCommandRoutes() map[command.Type]coreCommandRoute {
 		campaign.CommandTypeEnd:                      campaignRoute,
 		campaign.CommandTypeArchive:                  campaignRoute,
 		campaign.CommandTypeRestore:                  campaignRoute,
+		campaign.CommandTypeModerationLock:           campaignRoute,
+		campaign.CommandTypeModerationUnlock:         campaignRoute,
 		action.CommandTypeRollResolve:                actionRoute,
 		action.CommandTypeOutcomeApply:               actionRoute,
 		action.CommandTypeOutcomeReject:              actionRoute,
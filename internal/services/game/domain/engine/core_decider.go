@@ -32,8 +32,8 @@ func NewCoreDecider(systems *module.Registry, definitions []command.Definition)
 	}, nil
 }
 
-// Decide routes system envelopes to the system dispatcher and all remaining
-// commands through the core router.
+// Decide applies the moderation lock, then routes system envelopes to the
+// system dispatcher and all remaining commands through the core router.
 func (d CoreDecider) Decide(state any, cmd command.Command, now func() time.Time) command.Decision {
 	current, err := requireAggregateState(state)
 	if err != nil {
@@ -42,6 +42,9 @@ func (d CoreDecider) Decide(state any, cmd command.Command, now func() time.Time
 			Message: fmt.Sprintf("core decider aggregate state assertion: %v", err),
 		})
 	}
+	if decision, blocked := RejectModerationLockedCommand(current.Campaign, cmd); blocked {
+		return decision
+	}
 	if isSystemCommand(cmd) {
 		return d.systemCommands.Decide(current, cmd, now)
 	}
@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/aggregate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+)
+
+func TestCoreDeciderDecide_BlocksCoreAndSystemCommandsWhenModerationLocked(t *testing.T) {
+	decider := CoreDecider{
+		coreCommands: coreCommandRouter{
+			routes: map[command.Type]coreCommandRoute{
+				campaign.CommandTypeUpdate: campaignRoute,
+			},
+		},
+	}
+	state := aggregate.State{Campaign: campaign.State{Created: true, ModerationLocked: true}}
+	for _, cmd := range []command.Command{
+		{Type: campaign.CommandTypeUpdate},
+		{Type: command.Type("sys.daggerheart.gm_fear.set"), SystemID: "daggerheart", SystemVersion: "v1"},
+	} {
+		decision := decider.Decide(state, cmd, time.Now)
+		if len(decision.Rejections) != 1 {
+			t.Fatalf("%s rejections = %d, want 1", cmd.Type, len(decision.Rejections))
+		}
+		if decision.Rejections[0].Code != RejectionCodeCampaignModerationLocked {
+			t.Fatalf("%s rejection code = %s, want %s", cmd.Type, decision.Rejections[0].Code, RejectionCodeCampaignModerationLocked)
+		}
+	}
+}
+
+func TestCoreDeciderDecide_AllowsModerationUnlockWhenLocked(t *testing.T) {
+	decider := CoreDecider{
+		coreCommands: coreCommandRouter{
+			routes: map[command.Type]coreCommandRoute{
+				campaign.CommandTypeModerationUnlock: campaignRoute,
+			},
+		},
+	}
+	decision := decider.Decide(
+		aggregate.State{Campaign: campaign.State{Created: true, ModerationLocked: true}},
+		command.Command{CampaignID: "camp-1", Type: campaign.CommandTypeModerationUnlock, PayloadJSON: []byte(`{}`)},
+		time.Now,
+	)
+	if len(decision.Rejections) != 0 {
+		t.Fatalf("rejections = %v, want none", decision.Rejections)
+	}
+	if len(decision.Events) != 1 || decision.Events[0].Type != campaign.EventTypeModerationUnlocked {
+		t.Fatalf("events = %v, want one %s", decision.Events, campaign.EventTypeModerationUnlocked)
+	}
+}
+
+func TestCoreDeciderDecide_AllowsSystemSeatAnonymizationWhenLocked(t *testing.T) {
+	decider, err := NewCoreDecider(nil, allCoreCommandDefinitions())
+	if err != nil {
+		t.Fatalf("NewCoreDecider returned error: %v", err)
+	}
+	state := aggregate.State{
+		Campaign: campaign.State{Created: true, ModerationLocked: true},
+		Participants: map[ids.ParticipantID]participant.State{
+			"p1": {Joined: true, ParticipantID: "p1", UserID: "user-1", Name: "Ada", Role: participant.RolePlayer},
+		},
+	}
+	updateCmd := command.Command{
+		CampaignID:  "camp-1",
+		Type:        participant.CommandTypeUpdate,
+		ActorType:   command.ActorTypeSystem,
+		EntityType:  "participant",
+		EntityID:    "p1",
+		PayloadJSON: []byte(`{"participant_id":"p1","fields":{"name":"` + participant.AnonymizedName + `","pronouns":""}}`),
+	}
+	unbindCmd := command.Command{
+		CampaignID:  "camp-1",
+		Type:        participant.CommandTypeUnbind,
+		ActorType:   command.ActorTypeSystem,
+		EntityType:  "participant",
+		EntityID:    "p1",
+		PayloadJSON: []byte(`{"participant_id":"p1","user_id":"user-1","reason":"` + participant.UnbindReasonAccountDeleted + `"}`),
+	}
+	for _, cmd := range []command.Command{updateCmd, unbindCmd} {
+		decision := decider.Decide(state, cmd, time.Now)
+		if len(decision.Rejections) != 0 {
+			t.Fatalf("%s rejections = %v, want none", cmd.Type, decision.Rejections)
+		}
+		if len(decision.Events) != 1 {
+			t.Fatalf("%s events = %d, want 1", cmd.Type, len(decision.Events))
+		}
+	}
+
+	// Participants still cannot edit seats while the campaign is locked.
+	updateCmd.ActorType = command.ActorTypeParticipant
+	updateCmd.ActorID = "p1"
+	decision := decider.Decide(state, updateCmd, time.Now)
+	if len(decision.Rejections) != 1 || decision.Rejections[0].Code != RejectionCodeCampaignModerationLocked {
+		t.Fatalf("participant update rejections = %v, want moderation lock", decision.Rejections)
+	}
+}
@@ -41,6 +41,9 @@ var (
 	// ErrPostPersistCheckpointFailed indicates checkpoint persistence failed
 	// after journal append succeeded.
 	ErrPostPersistCheckpointFailed = errors.New("post-persist checkpoint save failed")
+	// ErrConditionalJournalRequired indicates a command carried an
+	// ExpectedSeq precondition but the journal cannot append conditionally.
+	ErrConditionalJournalRequired = errors.New("event journal does not support expected seq preconditions")
 	// ErrStateFactoryRequired indicates a missing state factory.
 	ErrStateFactoryRequired = errors.New("state factory is required")
 	// ErrStateRequired indicates a nil state was provided where state is required.
@@ -79,6 +82,14 @@ type EventJournal interface {
 	BatchAppend(ctx context.Context, events []event.Event) ([]event.Event, error)
 }
 
+// ConditionalEventJournal is the optional EventJournal extension used for
+// commands that carry an ExpectedSeq precondition. BatchAppendAtSeq must check
+// the campaign head and append in the same transaction so concurrent writers
+// cannot slip between the check and the write.
+type ConditionalEventJournal interface {
+	BatchAppendAtSeq(ctx context.Context, expectedSeq uint64, events []event.Event) ([]event.Event, error)
+}
+
 // Folder folds events into aggregate state.
 //
 // The folder is intentionally shared between request-time execution and replay
@@ -106,10 +117,13 @@ type Decider interface {
 //  2. enforce optional session gate policy,
 //  3. execute deciders over replay-derived state,
 //  4. validate events against event registry,
-//  5. append events to the journal,
+//  5. append events to the journal, honoring any ExpectedSeq precondition,
 //  6. apply events to in-memory state,
 //  7. checkpoint and snapshot state for fast future replays.
 //
+// [Handler.ExecuteBatch] runs the same pipeline for several commands against
+// one loaded state and appends all of their events in a single journal batch.
+//
 // Handler is safe for concurrent use after construction assuming its
 // dependencies are thread-safe.
 //
@@ -178,14 +192,26 @@ type Result struct {
 
 // Execute validates, gates, loads state, decides, persists, and folds a single
 // domain command through the full engine pipeline.
-func (h Handler) Execute(ctx context.Context, cmd command.Command) (Result, error) {
+func (h Handler) Execute(ctx context.Context, cmd command.Command) (result Result, err error) {
+	started := time.Now()
+	defer func() { recordCommand(ctx, cmd, started, result.Decision, err) }()
+
 	validated, state, decision, err := h.prepareExecution(ctx, cmd)
 	if err != nil {
 		return Result{}, err
 	}
-	lastSeq := lastDecisionSeq(decision.Events)
+	if err := h.saveReplayCursor(ctx, string(validated.CampaignID), lastDecisionSeq(decision.Events), state); err != nil {
+		return Result{}, err
+	}
+	return Result{Decision: decision, State: state}, nil
+}
+
+// saveReplayCursor snapshots and checkpoints state after a successful append so
+// later loads can resume from lastSeq. A zero lastSeq means nothing was
+// persisted and is a no-op.
+func (h Handler) saveReplayCursor(ctx context.Context, campaignID string, lastSeq uint64, state any) error {
 	if lastSeq == 0 {
-		return Result{Decision: decision, State: state}, nil
+		return nil
 	}
 
 	// Capture a single post-persist timestamp for both snapshot and checkpoint
@@ -193,10 +219,10 @@ func (h Handler) Execute(ctx context.Context, cmd command.Command) (Result, erro
 	postPersistTime := h.nowFunc()().UTC()
 
 	if h.Snapshots != nil {
-		if err := h.Snapshots.SaveState(ctx, string(validated.CampaignID), lastSeq, state); err != nil {
-			return Result{}, newPostPersistError(
+		if err := h.Snapshots.SaveState(ctx, campaignID, lastSeq, state); err != nil {
+			return newPostPersistError(
 				PostPersistStageSnapshot,
-				string(validated.CampaignID),
+				campaignID,
 				lastSeq,
 				fmt.Errorf("%w: %w", ErrPostPersistSnapshotFailed, err),
 			)
@@ -204,19 +230,19 @@ func (h Handler) Execute(ctx context.Context, cmd command.Command) (Result, erro
 	}
 	if h.Checkpoints != nil {
 		if err := h.Checkpoints.Save(ctx, replay.Checkpoint{
-			CampaignID: string(validated.CampaignID),
+			CampaignID: campaignID,
 			LastSeq:    lastSeq,
 			UpdatedAt:  postPersistTime,
 		}); err != nil {
-			return Result{}, newPostPersistError(
+			return newPostPersistError(
 				PostPersistStageCheckpoint,
-				string(validated.CampaignID),
+				campaignID,
 				lastSeq,
 				fmt.Errorf("%w: %w", ErrPostPersistCheckpointFailed, err),
 			)
 		}
 	}
-	return Result{Decision: decision, State: state}, nil
+	return nil
 }
 
 // prepareExecution runs the full command pipeline: validate → gate → load → decide → append → fold.
@@ -269,7 +295,7 @@ func (h Handler) prepareExecution(ctx context.Context, cmd command.Command) (com
 		return command.Command{}, nil, command.Decision{}, err
 	}
 
-	decision, err = h.appendDecisionEvents(ctx, decision)
+	decision, err = h.appendDecisionEvents(ctx, validated, decision)
 	if err != nil {
 		return command.Command{}, nil, command.Decision{}, err
 	}
@@ -408,21 +434,36 @@ func (h Handler) validateDecisionEvents(decision command.Decision) (command.Deci
 	return decision, nil
 }
 
-func (h Handler) appendDecisionEvents(ctx context.Context, decision command.Decision) (command.Decision, error) {
+func (h Handler) appendDecisionEvents(ctx context.Context, cmd command.Command, decision command.Decision) (command.Decision, error) {
 	// Required in production via NewHandler; nil here supports test-path flexibility.
 	if h.Journal == nil || len(decision.Events) == 0 {
 		return decision, nil
 	}
-	stored, err := h.Journal.BatchAppend(ctx, decision.Events)
+	stored, err := h.batchAppend(ctx, cmd, decision.Events)
 	if err != nil {
 		return command.Decision{}, err
 	}
 	// decision.Events carry journal-assigned sequences from BatchAppend at
 	// this point.
 	decision.Events = stored
+	recordEventsAppended(ctx, decision)
 	return decision, nil
 }
 
+// batchAppend routes commands with an ExpectedSeq precondition through the
+// conditional journal; a journal without that capability fails closed rather
+// than silently dropping the caller's concurrency guard.
+func (h Handler) batchAppend(ctx context.Context, cmd command.Command, events []event.Event) ([]event.Event, error) {
+	if cmd.ExpectedSeq == 0 {
+		return h.Journal.BatchAppend(ctx, events)
+	}
+	conditional, ok := h.Journal.(ConditionalEventJournal)
+	if !ok {
+		return nil, ErrConditionalJournalRequired
+	}
+	return conditional.BatchAppendAtSeq(ctx, cmd.ExpectedSeq, events)
+}
+
 func (h Handler) applyDecisionEvents(state any, decision command.Decision) (any, error) {
 	// Required in production via NewHandler; nil here supports test-path flexibility.
 	if h.Folder == nil || len(decision.Events) == 0 {
@@ -0,0 +1,232 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/aggregate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/scene"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
+)
+
+var (
+	// ErrBatchEmpty indicates ExecuteBatch was called without commands.
+	ErrBatchEmpty = errors.New("command batch is empty")
+	// ErrBatchCampaignMismatch indicates a batch spans more than one campaign.
+	ErrBatchCampaignMismatch = errors.New("command batch must target a single campaign")
+	// ErrBatchExpectedSeqNotFirst indicates a batch carries an ExpectedSeq on a
+	// command other than the first; the precondition guards the whole batch.
+	ErrBatchExpectedSeqNotFirst = errors.New("command batch expected seq must be set on the first command only")
+)
+
+// BatchResult captures the outcome of an atomic command batch.
+//
+// Decisions are index-aligned with the submitted commands up to the first
+// rejection. When any decision carries rejections nothing was appended, the
+// accepted decisions before it hold unsequenced events, and State is nil.
+type BatchResult struct {
+	Decisions []command.Decision
+	State     any
+}
+
+// ExecuteBatch decides several commands for one campaign against a single
+// loaded state and persists them all-or-nothing.
+//
+// Each command is gated and decided against the state produced by folding the
+// events of the commands before it, so later commands observe earlier ones
+// exactly as they would after sequential execution. All accepted events are
+// then appended in one journal batch, which assigns one contiguous seq range.
+// A rejection, validation failure, or append error leaves the journal
+// untouched. An ExpectedSeq on the first command guards the whole batch.
+func (h Handler) ExecuteBatch(ctx context.Context, cmds []command.Command) (result BatchResult, err error) {
+	started := time.Now()
+	defer func() {
+		for i, cmd := range cmds {
+			var decision command.Decision
+			if i < len(result.Decisions) {
+				decision = result.Decisions[i]
+			}
+			recordCommand(ctx, cmd, started, decision, err)
+		}
+	}()
+
+	validated, err := h.validateBatch(cmds)
+	if err != nil {
+		return BatchResult{}, err
+	}
+	state, err := h.loadState(ctx, validated[0])
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	decisions, pending, state, err := h.decideBatch(ctx, validated, state)
+	if err != nil {
+		return BatchResult{}, err
+	}
+	if batchRejected(decisions) {
+		return BatchResult{Decisions: decisions}, nil
+	}
+
+	if h.Journal != nil && len(pending) > 0 {
+		stored, err := h.batchAppend(ctx, validated[0], pending)
+		if err != nil {
+			return BatchResult{}, err
+		}
+		offset := 0
+		for i := range decisions {
+			count := len(decisions[i].Events)
+			decisions[i].Events = stored[offset : offset+count]
+			offset += count
+			recordEventsAppended(ctx, decisions[i])
+		}
+	}
+
+	if err := h.saveReplayCursor(ctx, string(validated[0].CampaignID), lastDecisionSeq(decisions[len(decisions)-1].Events), state); err != nil {
+		return BatchResult{}, err
+	}
+	return BatchResult{Decisions: decisions, State: state}, nil
+}
+
+// validateBatch checks registry intent for every command and the batch-level
+// invariants before any state is loaded.
+func (h Handler) validateBatch(cmds []command.Command) ([]command.Command, error) {
+	if len(cmds) == 0 {
+		return nil, ErrBatchEmpty
+	}
+	validated := make([]command.Command, 0, len(cmds))
+	for i, cmd := range cmds {
+		vetted, err := h.validateCommand(cmd)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			if vetted.CampaignID != validated[0].CampaignID {
+				return nil, ErrBatchCampaignMismatch
+			}
+			if vetted.ExpectedSeq != 0 {
+				return nil, ErrBatchExpectedSeqNotFirst
+			}
+		}
+		validated = append(validated, vetted)
+	}
+	return validated, nil
+}
+
+// decideBatch gates, decides, and folds each command in order against the
+// batch working state. It stops at the first rejection, returning the decisions
+// so far; callers detect that case with batchRejected.
+func (h Handler) decideBatch(ctx context.Context, cmds []command.Command, state any) ([]command.Decision, []event.Event, any, error) {
+	decisions := make([]command.Decision, 0, len(cmds))
+	pending := make([]event.Event, 0, len(cmds))
+	for _, cmd := range cmds {
+		decision, err := h.decideInBatch(ctx, state, cmd)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		decisions = append(decisions, decision)
+		if len(decision.Rejections) > 0 {
+			return decisions, nil, nil, nil
+		}
+		// Folding before append is safe because nothing is persisted yet: a
+		// fold failure aborts the batch instead of becoming a post-persist error.
+		state, err = h.foldPending(state, decision.Events)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pending = append(pending, decision.Events...)
+	}
+	return decisions, pending, state, nil
+}
+
+func batchRejected(decisions []command.Decision) bool {
+	return len(decisions) > 0 && len(decisions[len(decisions)-1].Rejections) > 0
+}
+
+// decideInBatch gates and decides one command against the batch working
+// state. Gate checks read that state rather than the journal so they see the
+// session and scene changes decided earlier in the batch.
+func (h Handler) decideInBatch(ctx context.Context, state any, cmd command.Command) (command.Decision, error) {
+	gated := h
+	gated.GateStateLoader = batchGateStateLoader{state: state, fallback: h.GateStateLoader}
+	gated.SceneGateStateLoader = batchSceneGateStateLoader{state: state, fallback: h.SceneGateStateLoader}
+
+	if decision, shortCircuit, err := gated.evaluateSessionGate(ctx, cmd); err != nil || shortCircuit {
+		return decision, err
+	}
+	if decision, shortCircuit, err := gated.evaluateSceneGate(ctx, cmd); err != nil || shortCircuit {
+		return decision, err
+	}
+	decision, err := h.decide(state, cmd)
+	if err != nil {
+		return command.Decision{}, err
+	}
+	return h.validateDecisionEvents(decision)
+}
+
+// foldPending folds not-yet-persisted events into the batch working state.
+func (h Handler) foldPending(state any, events []event.Event) (any, error) {
+	// Required in production via NewHandler; nil here supports test-path flexibility.
+	if h.Folder == nil {
+		return state, nil
+	}
+	for _, evt := range events {
+		next, err := h.Folder.Fold(state, evt)
+		if err != nil {
+			return nil, err
+		}
+		state = next
+	}
+	return state, nil
+}
+
+// batchGateStateLoader serves session gate checks from the batch working
+// state, falling back to the configured loader for non-aggregate states.
+type batchGateStateLoader struct {
+	state    any
+	fallback GateStateLoader
+}
+
+func (l batchGateStateLoader) LoadSession(ctx context.Context, campaignID, sessionID string) (session.State, error) {
+	if state, ok := aggregateFromState(l.state); ok {
+		return state.Session, nil
+	}
+	if l.fallback == nil {
+		return session.State{}, ErrGateStateLoaderRequired
+	}
+	return l.fallback.LoadSession(ctx, campaignID, sessionID)
+}
+
+// batchSceneGateStateLoader serves scene gate checks from the batch working
+// state, falling back to the configured loader for non-aggregate states.
+type batchSceneGateStateLoader struct {
+	state    any
+	fallback SceneGateStateLoader
+}
+
+func (l batchSceneGateStateLoader) LoadScene(ctx context.Context, campaignID, sceneID string) (scene.State, error) {
+	if state, ok := aggregateFromState(l.state); ok {
+		return state.Scenes[ids.SceneID(sceneID)], nil
+	}
+	if l.fallback == nil {
+		return scene.State{}, ErrSceneGateStateLoaderRequired
+	}
+	return l.fallback.LoadScene(ctx, campaignID, sceneID)
+}
+
+func aggregateFromState(state any) (aggregate.State, bool) {
+	switch typed := state.(type) {
+	case aggregate.State:
+		return typed, true
+	case *aggregate.State:
+		if typed == nil {
+			return aggregate.State{}, false
+		}
+		return *typed, true
+	default:
+		return aggregate.State{}, false
+	}
+}
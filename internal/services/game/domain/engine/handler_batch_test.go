@@ -0,0 +1,207 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/aggregate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+)
+
+type commandTypeDecider map[command.Type]command.Decision
+
+func (d commandTypeDecider) Decide(_ any, cmd command.Command, _ func() time.Time) command.Decision {
+	return d[cmd.Type]
+}
+
+type failingBatchJournal struct {
+	err error
+}
+
+func (f failingBatchJournal) BatchAppend(context.Context, []event.Event) ([]event.Event, error) {
+	return nil, f.err
+}
+
+func newBatchTestRegistry(t *testing.T, defs ...command.Definition) *command.Registry {
+	t.Helper()
+	registry := command.NewRegistry()
+	for _, def := range defs {
+		if def.Owner == "" {
+			def.Owner = command.OwnerCore
+		}
+		if err := registry.Register(def); err != nil {
+			t.Fatalf("register command %s: %v", def.Type, err)
+		}
+	}
+	return registry
+}
+
+func batchTestEvent(eventType string, payload string) event.Event {
+	return event.Event{
+		CampaignID:  "camp-1",
+		Type:        event.Type(eventType),
+		Timestamp:   time.Unix(0, 0).UTC(),
+		ActorType:   event.ActorTypeSystem,
+		PayloadJSON: []byte(payload),
+	}
+}
+
+func TestExecuteBatch_AppendsAllDecisionsInOneContiguousBatch(t *testing.T) {
+	registry := newBatchTestRegistry(t,
+		command.Definition{Type: command.Type("action.first")},
+		command.Definition{Type: command.Type("action.second")},
+	)
+	journal := &batchTrackingJournal{}
+	checkpoints := &fakeCheckpointStore{}
+	handler := Handler{
+		Commands:    registry,
+		Journal:     journal,
+		Checkpoints: checkpoints,
+		Decider: commandTypeDecider{
+			command.Type("action.first"): command.Accept(
+				batchTestEvent("action.tested", `{"a":1}`),
+				batchTestEvent("action.tested", `{"a":2}`),
+			),
+			command.Type("action.second"): command.Accept(batchTestEvent("action.tested", `{"a":3}`)),
+		},
+	}
+
+	result, err := handler.ExecuteBatch(context.Background(), []command.Command{
+		{CampaignID: "camp-1", Type: command.Type("action.first"), ActorType: command.ActorTypeSystem},
+		{CampaignID: "camp-1", Type: command.Type("action.second"), ActorType: command.ActorTypeSystem},
+	})
+	if err != nil {
+		t.Fatalf("execute batch: %v", err)
+	}
+	if journal.batchAppendCalls != 1 {
+		t.Fatalf("batch append calls = %d, want 1", journal.batchAppendCalls)
+	}
+	if len(result.Decisions) != 2 || len(result.Decisions[0].Events) != 2 || len(result.Decisions[1].Events) != 1 {
+		t.Fatalf("decisions = %+v, want events split [2 1]", result.Decisions)
+	}
+	if got := []uint64{result.Decisions[0].Events[0].Seq, result.Decisions[0].Events[1].Seq, result.Decisions[1].Events[0].Seq}; got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("event seqs = %v, want [1 2 3]", got)
+	}
+	if checkpoints.calls != 1 || checkpoints.last.LastSeq != 3 {
+		t.Fatalf("checkpoint = %+v after %d saves, want last seq 3 after 1 save", checkpoints.last, checkpoints.calls)
+	}
+}
+
+func TestExecuteBatch_GatesLaterCommandsAgainstFoldedState(t *testing.T) {
+	registry := newBatchTestRegistry(t,
+		command.Definition{Type: command.Type("gate.open")},
+		command.Definition{
+			Type: command.Type("action.gated"),
+			Gate: command.GatePolicy{Scope: command.GateScopeSession},
+		},
+	)
+	journal := &batchTrackingJournal{}
+	handler := Handler{
+		Commands:        registry,
+		Journal:         journal,
+		GateStateLoader: fakeGateLoader{},
+		StateLoader:     &trackingStateLoader{},
+		Folder:          &aggregate.Folder{},
+		Decider: commandTypeDecider{
+			command.Type("gate.open"):    command.Accept(batchTestEvent("session.gate_opened", `{"gate_id":"gate-1","gate_type":"gm_consequence"}`)),
+			command.Type("action.gated"): command.Accept(batchTestEvent("action.tested", `{}`)),
+		},
+	}
+
+	result, err := handler.ExecuteBatch(context.Background(), []command.Command{
+		{CampaignID: "camp-1", Type: command.Type("gate.open"), ActorType: command.ActorTypeSystem},
+		{CampaignID: "camp-1", Type: command.Type("action.gated"), ActorType: command.ActorTypeSystem, SessionID: "sess-1"},
+	})
+	if err != nil {
+		t.Fatalf("execute batch: %v", err)
+	}
+	if len(result.Decisions) != 2 || len(result.Decisions[1].Rejections) != 1 {
+		t.Fatalf("decisions = %+v, want second command rejected by gate", result.Decisions)
+	}
+	if journal.batchAppendCalls != 0 {
+		t.Fatalf("batch append calls = %d, want 0 for rejected batch", journal.batchAppendCalls)
+	}
+	if result.State != nil {
+		t.Fatalf("state = %#v, want nil for rejected batch", result.State)
+	}
+}
+
+func TestExecuteBatch_JournalFailureLeavesNoCheckpoint(t *testing.T) {
+	registry := newBatchTestRegistry(t, command.Definition{Type: command.Type("action.test")})
+	checkpoints := &fakeCheckpointStore{}
+	appendErr := errors.New("append failed")
+	handler := Handler{
+		Commands:    registry,
+		Journal:     failingBatchJournal{err: appendErr},
+		Checkpoints: checkpoints,
+		Decider:     fixedDecider{decision: command.Accept(batchTestEvent("action.tested", `{}`))},
+	}
+
+	_, err := handler.ExecuteBatch(context.Background(), []command.Command{
+		{CampaignID: "camp-1", Type: command.Type("action.test"), ActorType: command.ActorTypeSystem},
+		{CampaignID: "camp-1", Type: command.Type("action.test"), ActorType: command.ActorTypeSystem},
+	})
+	if !errors.Is(err, appendErr) {
+		t.Fatalf("error = %v, want %v", err, appendErr)
+	}
+	if checkpoints.calls != 0 {
+		t.Fatalf("checkpoint saves = %d, want 0", checkpoints.calls)
+	}
+}
+
+func TestExecuteBatch_ValidatesBatchShape(t *testing.T) {
+	registry := newBatchTestRegistry(t, command.Definition{Type: command.Type("action.test")})
+	handler := Handler{
+		Commands: registry,
+		Journal:  &batchTrackingJournal{},
+		Decider:  fixedDecider{decision: command.Accept(batchTestEvent("action.tested", `{}`))},
+	}
+	base := command.Command{CampaignID: "camp-1", Type: command.Type("action.test"), ActorType: command.ActorTypeSystem}
+	otherCampaign := base
+	otherCampaign.CampaignID = "camp-2"
+	laterExpectedSeq := base
+	laterExpectedSeq.ExpectedSeq = 4
+
+	tests := []struct {
+		name string
+		cmds []command.Command
+		want error
+	}{
+		{name: "empty", want: ErrBatchEmpty},
+		{name: "mixed campaigns", cmds: []command.Command{base, otherCampaign}, want: ErrBatchCampaignMismatch},
+		{name: "expected seq after first", cmds: []command.Command{base, laterExpectedSeq}, want: ErrBatchExpectedSeqNotFirst},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := handler.ExecuteBatch(context.Background(), tt.cmds); !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteBatch_ExpectedSeqGuardsWholeBatch(t *testing.T) {
+	registry := newBatchTestRegistry(t, command.Definition{Type: command.Type("action.test")})
+	journal := &conditionalJournal{batchTrackingJournal: batchTrackingJournal{nextSeq: 9}}
+	handler := Handler{
+		Commands: registry,
+		Journal:  journal,
+		Decider:  fixedDecider{decision: command.Accept(batchTestEvent("action.tested", `{}`))},
+	}
+	first := command.Command{CampaignID: "camp-1", Type: command.Type("action.test"), ActorType: command.ActorTypeSystem, ExpectedSeq: 9}
+	second := first
+	second.ExpectedSeq = 0
+
+	if _, err := handler.ExecuteBatch(context.Background(), []command.Command{first, second}); err != nil {
+		t.Fatalf("execute batch: %v", err)
+	}
+	if len(journal.expectedSeqs) != 1 || journal.expectedSeqs[0] != 9 {
+		t.Fatalf("conditional appends = %v, want [9]", journal.expectedSeqs)
+	}
+	if journal.batchAppendCalls != 1 || journal.nextSeq != 11 {
+		t.Fatalf("batch appends = %d, head = %d, want 1 append ending at 11", journal.batchAppendCalls, journal.nextSeq)
+	}
+}
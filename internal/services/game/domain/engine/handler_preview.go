@@ -0,0 +1,188 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+)
+
+// Preview captures the outcome of a dry-run command batch.
+//
+// Decisions follow the BatchResult contract: they are index-aligned with the
+// submitted commands up to the first rejection. Events are never sequenced
+// because nothing is appended. Changes is empty when the batch was rejected.
+type Preview struct {
+	Decisions []command.Decision
+	Changes   []StateChange
+}
+
+// Rejected reports whether the previewed batch stopped at a rejection.
+func (p Preview) Rejected() bool {
+	return batchRejected(p.Decisions)
+}
+
+// StateChange describes one leaf value that differs between the replayed state
+// before a preview and the throwaway state after folding its events.
+//
+// Path joins field names and map keys with dots, for example
+// "Systems.daggerheart@1.0.0.CharacterStates.char-1.HP". Before or After is
+// nil when the leaf does not exist on that side.
+type StateChange struct {
+	Path   string
+	Before json.RawMessage
+	After  json.RawMessage
+}
+
+// Preview runs one command through the dry-run pipeline. See PreviewBatch.
+func (h Handler) Preview(ctx context.Context, cmd command.Command) (Preview, error) {
+	return h.PreviewBatch(ctx, []command.Command{cmd})
+}
+
+// PreviewBatch runs validation, gate checks, and the decider for each command
+// exactly as ExecuteBatch would, folds the resulting events into a throwaway
+// copy of the loaded state, and returns the would-be events plus a leaf-level
+// diff of that state. The journal, checkpoints, and snapshots are never
+// touched, and ExpectedSeq is not enforced because nothing is appended.
+func (h Handler) PreviewBatch(ctx context.Context, cmds []command.Command) (Preview, error) {
+	validated, err := h.validateBatch(cmds)
+	if err != nil {
+		return Preview{}, err
+	}
+	state, err := h.loadState(ctx, validated[0])
+	if err != nil {
+		return Preview{}, err
+	}
+	// Capture the before document first: folds may update nested maps in
+	// place, and the loader hands out a per-call copy we are free to discard.
+	before, err := stateDocument(state)
+	if err != nil {
+		return Preview{}, err
+	}
+
+	decisions, _, state, err := h.decideBatch(ctx, validated, state)
+	if err != nil {
+		return Preview{}, err
+	}
+	if batchRejected(decisions) {
+		return Preview{Decisions: decisions}, nil
+	}
+	after, err := stateDocument(state)
+	if err != nil {
+		return Preview{}, err
+	}
+	changes, err := diffStateDocuments(before, after)
+	if err != nil {
+		return Preview{}, err
+	}
+	return Preview{Decisions: decisions, Changes: changes}, nil
+}
+
+// stateDocument renders state as a generic JSON document for diffing. Aggregate
+// system states are keyed by "id@version" because module keys are structs.
+func stateDocument(state any) (any, error) {
+	if typed, ok := aggregateFromState(state); ok {
+		systems := make(map[string]any, len(typed.Systems))
+		for key, value := range typed.Systems {
+			systems[key.ID+"@"+key.Version] = value
+		}
+		typed.Systems = nil
+		data, err := json.Marshal(typed)
+		if err != nil {
+			return nil, fmt.Errorf("encode preview state: %w", err)
+		}
+		var document map[string]any
+		if err := json.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("decode preview state: %w", err)
+		}
+		systemsDocument, err := stateDocument(systems)
+		if err != nil {
+			return nil, err
+		}
+		document["Systems"] = systemsDocument
+		return document, nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("encode preview state: %w", err)
+	}
+	var document any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("decode preview state: %w", err)
+	}
+	return document, nil
+}
+
+// diffStateDocuments flattens both documents to leaf paths and reports every
+// leaf that was added, removed, or changed, sorted by path. Arrays are leaves.
+func diffStateDocuments(before, after any) ([]StateChange, error) {
+	beforeLeaves := map[string]any{}
+	afterLeaves := map[string]any{}
+	flattenDocument("", before, beforeLeaves)
+	flattenDocument("", after, afterLeaves)
+
+	paths := make([]string, 0, len(afterLeaves))
+	for path := range afterLeaves {
+		paths = append(paths, path)
+	}
+	for path := range beforeLeaves {
+		if _, ok := afterLeaves[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := make([]StateChange, 0)
+	for _, path := range paths {
+		beforeJSON, err := leafJSON(beforeLeaves, path)
+		if err != nil {
+			return nil, err
+		}
+		afterJSON, err := leafJSON(afterLeaves, path)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(beforeJSON, afterJSON) {
+			continue
+		}
+		changes = append(changes, StateChange{Path: path, Before: beforeJSON, After: afterJSON})
+	}
+	return changes, nil
+}
+
+// flattenDocument records leaf values by path. Nulls and empty objects are
+// treated as absent so nil-versus-initialized maps never show up as changes.
+func flattenDocument(prefix string, value any, leaves map[string]any) {
+	if value == nil {
+		return
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		if prefix != "" {
+			leaves[prefix] = value
+		}
+		return
+	}
+	for key, child := range object {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		flattenDocument(path, child, leaves)
+	}
+}
+
+func leafJSON(leaves map[string]any, path string) (json.RawMessage, error) {
+	value, ok := leaves[path]
+	if !ok {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("encode preview state %s: %w", path, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/aggregate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+)
+
+func TestPreviewBatch_ReturnsEventsAndStateDiffWithoutAppending(t *testing.T) {
+	registry := newBatchTestRegistry(t, command.Definition{Type: command.Type("gate.open")})
+	journal := &batchTrackingJournal{}
+	checkpoints := &fakeCheckpointStore{}
+	handler := Handler{
+		Commands:    registry,
+		Journal:     journal,
+		Checkpoints: checkpoints,
+		StateLoader: &trackingStateLoader{},
+		Folder:      &aggregate.Folder{},
+		Decider: commandTypeDecider{
+			command.Type("gate.open"): command.Accept(batchTestEvent("session.gate_opened", `{"gate_id":"gate-1","gate_type":"gm_consequence"}`)),
+		},
+	}
+
+	preview, err := handler.Preview(context.Background(), command.Command{
+		CampaignID: "camp-1", Type: command.Type("gate.open"), ActorType: command.ActorTypeSystem,
+	})
+	if err != nil {
+		t.Fatalf("preview: %v", err)
+	}
+	if journal.appendCalls != 0 || journal.batchAppendCalls != 0 || checkpoints.calls != 0 {
+		t.Fatalf("journal appends = %d/%d, checkpoints = %d, want no writes", journal.appendCalls, journal.batchAppendCalls, checkpoints.calls)
+	}
+	if preview.Rejected() || len(preview.Decisions) != 1 || len(preview.Decisions[0].Events) != 1 {
+		t.Fatalf("decisions = %+v, want one accepted decision", preview.Decisions)
+	}
+	if preview.Decisions[0].Events[0].Seq != 0 {
+		t.Fatalf("event seq = %d, want unsequenced event", preview.Decisions[0].Events[0].Seq)
+	}
+	changes := map[string]string{}
+	for _, change := range preview.Changes {
+		changes[change.Path] = string(change.Before) + "->" + string(change.After)
+	}
+	if got := changes["Session.GateOpen"]; got != "false->true" {
+		t.Fatalf("Session.GateOpen change = %q, want false->true (changes %v)", got, changes)
+	}
+	if got := changes["Session.GateID"]; got != `""->"gate-1"` {
+		t.Fatalf("Session.GateID change = %q, want gate-1 (changes %v)", got, changes)
+	}
+}
+
+func TestPreviewBatch_RejectionReturnsDecisionsWithoutChanges(t *testing.T) {
+	registry := newBatchTestRegistry(t,
+		command.Definition{Type: command.Type("action.first")},
+		command.Definition{Type: command.Type("action.second")},
+	)
+	handler := Handler{
+		Commands:    registry,
+		Journal:     &batchTrackingJournal{},
+		StateLoader: &trackingStateLoader{},
+		Folder:      &aggregate.Folder{},
+		Decider: commandTypeDecider{
+			command.Type("action.first"):  command.Accept(batchTestEvent("session.gate_opened", `{"gate_id":"gate-1","gate_type":"gm_consequence"}`)),
+			command.Type("action.second"): command.Reject(command.Rejection{Code: "NOPE", Message: "nope"}),
+		},
+	}
+
+	preview, err := handler.PreviewBatch(context.Background(), []command.Command{
+		{CampaignID: "camp-1", Type: command.Type("action.first"), ActorType: command.ActorTypeSystem},
+		{CampaignID: "camp-1", Type: command.Type("action.second"), ActorType: command.ActorTypeSystem},
+	})
+	if err != nil {
+		t.Fatalf("preview: %v", err)
+	}
+	if !preview.Rejected() || len(preview.Decisions) != 2 {
+		t.Fatalf("decisions = %+v, want second command rejected", preview.Decisions)
+	}
+	if len(preview.Changes) != 0 {
+		t.Fatalf("changes = %+v, want none for rejected preview", preview.Changes)
+	}
+}
+
+func TestDiffStateDocuments_TreatsNullAndEmptyObjectsAsAbsent(t *testing.T) {
+	changes, err := diffStateDocuments(
+		map[string]any{"Characters": nil, "HP": float64(6), "Armor": float64(2)},
+		map[string]any{"Characters": map[string]any{}, "HP": float64(3), "Stress": float64(1), "Armor": float64(2)},
+	)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	if len(changes) != 2 || changes[0].Path != "HP" || changes[1].Path != "Stress" {
+		t.Fatalf("changes = %+v, want HP and Stress only", changes)
+	}
+	if changes[1].Before != nil || string(changes[1].After) != "1" {
+		t.Fatalf("stress change = %+v, want added leaf", changes[1])
+	}
+}
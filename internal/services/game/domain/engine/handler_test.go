@@ -828,6 +828,20 @@ func (j *batchTrackingJournal) BatchAppend(_ context.Context, events []event.Eve
 	return stored, nil
 }
 
+// conditionalJournal records the expected seq passed to conditional appends.
+type conditionalJournal struct {
+	batchTrackingJournal
+	expectedSeqs []uint64
+}
+
+func (j *conditionalJournal) BatchAppendAtSeq(ctx context.Context, expectedSeq uint64, events []event.Event) ([]event.Event, error) {
+	j.expectedSeqs = append(j.expectedSeqs, expectedSeq)
+	if expectedSeq != j.nextSeq {
+		return nil, fmt.Errorf("head seq is %d, expected %d", j.nextSeq, expectedSeq)
+	}
+	return j.BatchAppend(ctx, events)
+}
+
 type failingJournal struct{}
 
 func (failingJournal) Append(_ context.Context, _ event.Event) (event.Event, error) {
@@ -973,3 +987,95 @@ func TestExecute_BatchAppendsAllEventsAtOnce(t *testing.T) {
 		t.Fatalf("event seqs = [%d, %d], want [1, 2]", result.Decision.Events[0].Seq, result.Decision.Events[1].Seq)
 	}
 }
+
+func TestExecute_ExpectedSeqUsesConditionalJournal(t *testing.T) {
+	cmdRegistry := command.NewRegistry()
+	if err := cmdRegistry.Register(command.Definition{
+		Type:  command.Type("action.test"),
+		Owner: command.OwnerCore,
+	}); err != nil {
+		t.Fatalf("register command: %v", err)
+	}
+	eventRegistry := event.NewRegistry()
+	if err := eventRegistry.Register(event.Definition{
+		Type:  event.Type("action.tested"),
+		Owner: event.OwnerCore,
+	}); err != nil {
+		t.Fatalf("register event: %v", err)
+	}
+
+	journal := &conditionalJournal{batchTrackingJournal: batchTrackingJournal{nextSeq: 4}}
+	handler := Handler{
+		Commands: cmdRegistry,
+		Events:   eventRegistry,
+		Journal:  journal,
+		Decider: fixedDecider{decision: command.Decision{Events: []event.Event{
+			{CampaignID: "camp-1", Type: event.Type("action.tested"), Timestamp: time.Unix(0, 0).UTC(), ActorType: event.ActorTypeSystem, PayloadJSON: []byte(`{"a":1}`)},
+		}}},
+	}
+	cmd := command.Command{
+		CampaignID:  "camp-1",
+		Type:        command.Type("action.test"),
+		ActorType:   command.ActorTypeSystem,
+		ExpectedSeq: 3,
+	}
+
+	if _, err := handler.Execute(context.Background(), cmd); err == nil {
+		t.Fatal("expected stale expected seq to fail")
+	}
+	if journal.batchAppendCalls != 0 {
+		t.Fatalf("batch append calls after stale seq = %d, want 0", journal.batchAppendCalls)
+	}
+
+	cmd.ExpectedSeq = 4
+	result, err := handler.Execute(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if len(journal.expectedSeqs) != 2 || journal.expectedSeqs[1] != 4 {
+		t.Fatalf("expected seqs = %v, want [3 4]", journal.expectedSeqs)
+	}
+	if result.Decision.Events[0].Seq != 5 {
+		t.Fatalf("event seq = %d, want 5", result.Decision.Events[0].Seq)
+	}
+}
+
+func TestExecute_ExpectedSeqRequiresConditionalJournal(t *testing.T) {
+	cmdRegistry := command.NewRegistry()
+	if err := cmdRegistry.Register(command.Definition{
+		Type:  command.Type("action.test"),
+		Owner: command.OwnerCore,
+	}); err != nil {
+		t.Fatalf("register command: %v", err)
+	}
+	eventRegistry := event.NewRegistry()
+	if err := eventRegistry.Register(event.Definition{
+		Type:  event.Type("action.tested"),
+		Owner: event.OwnerCore,
+	}); err != nil {
+		t.Fatalf("register event: %v", err)
+	}
+
+	journal := &batchTrackingJournal{}
+	handler := Handler{
+		Commands: cmdRegistry,
+		Events:   eventRegistry,
+		Journal:  journal,
+		Decider: fixedDecider{decision: command.Decision{Events: []event.Event{
+			{CampaignID: "camp-1", Type: event.Type("action.tested"), Timestamp: time.Unix(0, 0).UTC(), ActorType: event.ActorTypeSystem, PayloadJSON: []byte(`{"a":1}`)},
+		}}},
+	}
+
+	_, err := handler.Execute(context.Background(), command.Command{
+		CampaignID:  "camp-1",
+		Type:        command.Type("action.test"),
+		ActorType:   command.ActorTypeSystem,
+		ExpectedSeq: 1,
+	})
+	if !errors.Is(err, ErrConditionalJournalRequired) {
+		t.Fatalf("error = %v, want ErrConditionalJournalRequired", err)
+	}
+	if journal.batchAppendCalls != 0 {
+		t.Fatalf("batch append calls = %d, want 0", journal.batchAppendCalls)
+	}
+}
@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	platformotel "github.com/louisbranch/fracturing.space/internal/platform/otel"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+
+// Command outcomes recorded on the command duration histogram.
+const (
+	commandOutcomeAccepted = "accepted"
+	commandOutcomeRejected = "rejected"
+	commandOutcomeError    = "error"
+)
+
+var (
+	commandDuration = platformotel.Instrument(otel.Meter(meterName).Float64Histogram(
+		"fracturing.game.command.duration",
+		metric.WithDescription("Time to validate, decide, persist, and fold one domain command."),
+		metric.WithUnit("s"),
+	))
+	eventsAppended = platformotel.Instrument(otel.Meter(meterName).Int64Counter(
+		"fracturing.game.events.appended",
+		metric.WithDescription("Domain events appended to the event journal."),
+		metric.WithUnit("{event}"),
+	))
+)
+
+// recordCommand records one Execute call. Command types come from the
+// registry, so the attribute set stays bounded.
+func recordCommand(ctx context.Context, cmd command.Command, started time.Time, decision command.Decision, err error) {
+	outcome := commandOutcomeAccepted
+	switch {
+	case err != nil:
+		outcome = commandOutcomeError
+	case len(decision.Rejections) > 0:
+		outcome = commandOutcomeRejected
+	}
+	commandDuration.Record(ctx, time.Since(started).Seconds(), metric.WithAttributes(
+		attribute.String("command.type", string(cmd.Type)),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// recordEventsAppended counts events persisted by one journal batch.
+func recordEventsAppended(ctx context.Context, decision command.Decision) {
+	for _, evt := range decision.Events {
+		eventsAppended.Add(ctx, 1, metric.WithAttributes(attribute.String("event.type", string(evt.Type))))
+	}
+}
@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// testMetricReader installs one SDK meter provider per test binary: the global
+// provider only delegates package-level instruments to the first one set.
+var testMetricReader = sync.OnceValue(func() *sdkmetric.ManualReader {
+	reader := sdkmetric.NewManualReader(sdkmetric.WithTemporalitySelector(func(sdkmetric.InstrumentKind) metricdata.Temporality {
+		return metricdata.DeltaTemporality
+	}))
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	return reader
+})
+
+func TestExecute_RecordsCommandAndAppendMetrics(t *testing.T) {
+	reader := testMetricReader()
+	// Drain measurements recorded by earlier tests.
+	if err := reader.Collect(context.Background(), &metricdata.ResourceMetrics{}); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+
+	cmdRegistry := command.NewRegistry()
+	if err := cmdRegistry.Register(command.Definition{
+		Type:  command.Type("action.test"),
+		Owner: command.OwnerCore,
+	}); err != nil {
+		t.Fatalf("register command: %v", err)
+	}
+	eventRegistry := event.NewRegistry()
+	if err := eventRegistry.Register(event.Definition{
+		Type:  event.Type("action.tested"),
+		Owner: event.OwnerCore,
+	}); err != nil {
+		t.Fatalf("register event: %v", err)
+	}
+	handler := Handler{
+		Commands: cmdRegistry,
+		Events:   eventRegistry,
+		Journal:  &fakeJournal{},
+		Decider: fixedDecider{decision: command.Accept(event.Event{
+			CampaignID:  "camp-1",
+			Type:        event.Type("action.tested"),
+			Timestamp:   time.Unix(0, 0).UTC(),
+			ActorType:   event.ActorTypeSystem,
+			PayloadJSON: []byte(`{"ok":true}`),
+		})},
+	}
+	if _, err := handler.Execute(context.Background(), command.Command{
+		CampaignID: "camp-1",
+		Type:       command.Type("action.test"),
+		ActorType:  command.ActorTypeSystem,
+	}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	metrics := map[string]metricdata.Aggregation{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	histogram, ok := metrics["fracturing.game.command.duration"].(metricdata.Histogram[float64])
+	if !ok || len(histogram.DataPoints) != 1 || histogram.DataPoints[0].Count != 1 {
+		t.Fatalf("command duration = %+v, want one recorded command", metrics["fracturing.game.command.duration"])
+	}
+	if outcome, _ := histogram.DataPoints[0].Attributes.Value(attribute.Key("outcome")); outcome.AsString() != commandOutcomeAccepted {
+		t.Fatalf("command outcome = %q, want %q", outcome.AsString(), commandOutcomeAccepted)
+	}
+	appended, ok := metrics["fracturing.game.events.appended"].(metricdata.Sum[int64])
+	if !ok || len(appended.DataPoints) != 1 || appended.DataPoints[0].Value != 1 {
+		t.Fatalf("events appended = %+v, want one event", metrics["fracturing.game.events.appended"])
+	}
+}
@@ -0,0 +1,35 @@
+package engine
+
+import (
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/command"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/participant"
+)
+
+const (
+	// RejectionCodeCampaignModerationLocked identifies operator takedown rejections.
+	RejectionCodeCampaignModerationLocked = "CAMPAIGN_MODERATION_LOCKED"
+)
+
+// RejectModerationLockedCommand returns a rejection when an operator has
+// locked the campaign. The moderation commands themselves pass through so
+// operators can always lift (or re-assert) the lock, and so do system-issued
+// seat anonymization commands: account deletion must release seats in locked
+// campaigns too.
+func RejectModerationLockedCommand(state campaign.State, cmd command.Command) (command.Decision, bool) {
+	if !state.ModerationLocked {
+		return command.Decision{}, false
+	}
+	switch cmd.Type {
+	case campaign.CommandTypeModerationLock, campaign.CommandTypeModerationUnlock:
+		return command.Decision{}, false
+	case participant.CommandTypeUpdate, participant.CommandTypeUnbind:
+		if cmd.ActorType == command.ActorTypeSystem {
+			return command.Decision{}, false
+		}
+	}
+	return command.Reject(command.Rejection{
+		Code:    RejectionCodeCampaignModerationLocked,
+		Message: "campaign is locked by moderation",
+	}), true
+}
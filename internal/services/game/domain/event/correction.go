@@ -0,0 +1,29 @@
+package event
+
+import (
+	"strconv"
+	"strings"
+)
+
+// correctionCausationPrefix marks causation IDs written by GM corrections.
+const correctionCausationPrefix = "correction:"
+
+// CorrectionCausationID links a compensating event to the earlier event at seq
+// in the same campaign journal.
+func CorrectionCausationID(seq uint64) string {
+	return correctionCausationPrefix + strconv.FormatUint(seq, 10)
+}
+
+// CorrectedSeq returns the seq of the event a compensating event corrects, or
+// false when causationID was not written by a correction.
+func CorrectedSeq(causationID string) (uint64, bool) {
+	value, ok := strings.CutPrefix(strings.TrimSpace(causationID), correctionCausationPrefix)
+	if !ok {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || seq == 0 {
+		return 0, false
+	}
+	return seq, true
+}
@@ -0,0 +1,19 @@
+package event
+
+import "testing"
+
+func TestCorrectionCausationIDRoundTrip(t *testing.T) {
+	id := CorrectionCausationID(42)
+	seq, ok := CorrectedSeq(id)
+	if !ok || seq != 42 {
+		t.Fatalf("CorrectedSeq(%q) = %d, %v, want 42, true", id, seq, ok)
+	}
+}
+
+func TestCorrectedSeqRejectsOtherCausationIDs(t *testing.T) {
+	for _, id := range []string{"", "req-1", "correction:", "correction:0", "correction:abc"} {
+		if seq, ok := CorrectedSeq(id); ok {
+			t.Fatalf("CorrectedSeq(%q) = %d, want not a correction", id, seq)
+		}
+	}
+}
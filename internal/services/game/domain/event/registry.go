@@ -10,6 +10,7 @@ import (
 	"time"
 
 	coreencoding "github.com/louisbranch/fracturing.space/internal/services/game/core/encoding"
+	corefilter "github.com/louisbranch/fracturing.space/internal/services/game/core/filter"
 	"github.com/louisbranch/fracturing.space/internal/services/game/core/naming"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
 )
@@ -133,6 +134,9 @@ type Definition struct {
 	Addressing      AddressingPolicy
 	ValidatePayload PayloadValidator
 	Intent          Intent
+	// FilterFields declares payload values that event list filters may address
+	// as payload.<name>. Event types sharing a name must agree on path and type.
+	FilterFields []corefilter.PayloadField
 }
 
 // Intent declares what the runtime should do when the event is replayed.
@@ -218,10 +222,31 @@ func (r *Registry) Register(def Definition) error {
 	if _, exists := r.definitions[def.Type]; exists {
 		return fmt.Errorf("event type already registered: %s", def.Type)
 	}
+	if err := r.validateFilterFields(def); err != nil {
+		return err
+	}
 	r.definitions[def.Type] = def
 	return nil
 }
 
+// validateFilterFields rejects malformed payload filter fields and names that
+// collide with a different declaration on another event type.
+func (r *Registry) validateFilterFields(def Definition) error {
+	for _, field := range def.FilterFields {
+		if err := field.Validate(); err != nil {
+			return fmt.Errorf("event type %s: %w", def.Type, err)
+		}
+		for _, existing := range r.definitions {
+			for _, other := range existing.FilterFields {
+				if other.Name == field.Name && (other.Path != field.Path || other.Type != field.Type) {
+					return fmt.Errorf("event type %s: payload filter field %s conflicts with %s", def.Type, field.Name, existing.Type)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateForAppend validates and normalizes an event prior to storage append.
 //
 // It enforces ownership boundaries (core/system), canonical payload shape, and
@@ -523,3 +548,33 @@ func (r *Registry) ListDefinitions() []Definition {
 	})
 	return definitions
 }
+
+// PayloadFilterFields returns the payload filter fields declared across all
+// registered event types, deduplicated and sorted by name. Each field lists
+// the event types that declare it so filters only match those payloads.
+func (r *Registry) PayloadFilterFields() []corefilter.PayloadField {
+	if r == nil || len(r.definitions) == 0 {
+		return nil
+	}
+	byName := make(map[string]corefilter.PayloadField)
+	for _, definition := range r.definitions {
+		for _, field := range definition.FilterFields {
+			merged := byName[field.Name]
+			merged.Name, merged.Path, merged.Type = field.Name, field.Path, field.Type
+			merged.EventTypes = append(merged.EventTypes, string(definition.Type))
+			byName[field.Name] = merged
+		}
+	}
+	if len(byName) == 0 {
+		return nil
+	}
+	fields := make([]corefilter.PayloadField, 0, len(byName))
+	for _, field := range byName {
+		sort.Strings(field.EventTypes)
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+	return fields
+}
@@ -1,6 +1,10 @@
 package event
 
-import "testing"
+import (
+	"testing"
+
+	corefilter "github.com/louisbranch/fracturing.space/internal/services/game/core/filter"
+)
 
 func TestRegistryListDefinitions(t *testing.T) {
 	t.Run("nil registry", func(t *testing.T) {
@@ -35,3 +39,38 @@ func TestRegistryListDefinitions(t *testing.T) {
 		}
 	})
 }
+
+func TestRegistryPayloadFilterFields(t *testing.T) {
+	marks := corefilter.PayloadField{Name: "marks", Path: "$.marks", Type: corefilter.FieldTypeInt}
+	registry := NewRegistry()
+	if err := registry.Register(Definition{Type: Type("beta.happened"), Owner: OwnerCore, FilterFields: []corefilter.PayloadField{
+		marks,
+		{Name: "severity", Path: "$.severity", Type: corefilter.FieldTypeString},
+	}}); err != nil {
+		t.Fatalf("register beta: %v", err)
+	}
+	if err := registry.Register(Definition{Type: Type("alpha.happened"), Owner: OwnerCore, FilterFields: []corefilter.PayloadField{marks}}); err != nil {
+		t.Fatalf("register alpha with shared field: %v", err)
+	}
+
+	fields := registry.PayloadFilterFields()
+	if len(fields) != 2 || fields[0].Name != "marks" || fields[1].Name != "severity" {
+		t.Fatalf("fields = %+v, want deduplicated [marks severity]", fields)
+	}
+	if got := fields[0].EventTypes; len(got) != 2 || got[0] != "alpha.happened" || got[1] != "beta.happened" {
+		t.Fatalf("marks event types = %v, want [alpha.happened beta.happened]", got)
+	}
+
+	conflicting := Definition{Type: Type("gamma.happened"), Owner: OwnerCore, FilterFields: []corefilter.PayloadField{
+		{Name: "marks", Path: "$.hp_marks", Type: corefilter.FieldTypeInt},
+	}}
+	if err := registry.Register(conflicting); err == nil {
+		t.Fatal("expected conflicting payload field declaration to be rejected")
+	}
+	invalid := Definition{Type: Type("delta.happened"), Owner: OwnerCore, FilterFields: []corefilter.PayloadField{
+		{Name: "marks", Path: "marks", Type: corefilter.FieldTypeInt},
+	}}
+	if err := registry.Register(invalid); err == nil {
+		t.Fatal("expected invalid payload field path to be rejected")
+	}
+}
@@ -33,6 +33,16 @@ type BindPayload struct {
 	UserID        ids.UserID        `json:"user_id"`
 }
 
+const (
+	// UnbindReasonAccountDeleted marks participant.unbound events written when
+	// the bound user closed their account. Event reads use it to redact the
+	// identity carried by that seat's earlier events.
+	UnbindReasonAccountDeleted = "account_deleted"
+	// AnonymizedName replaces the display name of seats whose user closed
+	// their account.
+	AnonymizedName = "Former participant"
+)
+
 // UnbindPayload captures the payload for participant.unbind commands and participant.unbound events.
 type UnbindPayload struct {
 	ParticipantID ids.ParticipantID `json:"participant_id"`
@@ -10,8 +10,10 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
 )
 
+// decideRecapRecord accepts recaps while a session runs and after it ends, so
+// a GM can publish a reviewed recap once play has concluded.
 func decideRecapRecord(state State, cmd command.Command, now func() time.Time) command.Decision {
-	if !state.Started {
+	if !state.Started && !state.Ended {
 		return command.Reject(command.Rejection{
 			Code:    rejectionCodeSessionNotStarted,
 			Message: "session not started",
@@ -90,3 +90,26 @@ func mustMarshalSessionJSON(t *testing.T, value any) []byte {
 	}
 	return data
 }
+
+func TestDecideRecapRecord_AllowsEndedSessions(t *testing.T) {
+	t.Parallel()
+
+	now := func() time.Time { return time.Date(2026, 3, 27, 13, 0, 0, 0, time.UTC) }
+	cmd := command.Command{
+		Type:        CommandTypeRecapRecord,
+		PayloadJSON: mustMarshalSessionJSON(t, RecapRecordedPayload{SessionID: ids.SessionID("sess-1"), Markdown: " The party escaped. "}),
+	}
+
+	decision := decideRecapRecord(State{Ended: true, SessionID: ids.SessionID("sess-1")}, cmd, now)
+	if len(decision.Rejections) != 0 {
+		t.Fatalf("unexpected rejections: %+v", decision.Rejections)
+	}
+	if len(decision.Events) != 1 || decision.Events[0].Type != EventTypeRecapRecorded {
+		t.Fatalf("events = %#v", decision.Events)
+	}
+
+	decision = decideRecapRecord(State{}, cmd, now)
+	if len(decision.Rejections) != 1 || decision.Rejections[0].Code != rejectionCodeSessionNotStarted {
+		t.Fatalf("rejections = %+v, want session not started", decision.Rejections)
+	}
+}
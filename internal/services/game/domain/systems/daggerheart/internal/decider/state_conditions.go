@@ -173,6 +173,7 @@ func decideCharacterStatePatch(snapshotState daggerheartstate.SnapshotState, has
 			return payload.CharacterStatePatchedPayload{
 				CharacterID:   p.CharacterID,
 				Source:        normalize.String(p.Source),
+				Reason:        normalize.String(p.Reason),
 				HP:            p.HPAfter,
 				Hope:          p.HopeAfter,
 				HopeMax:       p.HopeMaxAfter,
@@ -3,6 +3,7 @@ package daggerheart
 import (
 	"errors"
 
+	corefilter "github.com/louisbranch/fracturing.space/internal/services/game/core/filter"
 	daggerheartdecider "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/internal/decider"
 	daggerheartpayload "github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart/payload"
 
@@ -85,9 +86,25 @@ var daggerheartCommandDefinitions = []command.Definition{
 	{Type: daggerheartdecider.CommandTypeStatModifierChange, Owner: command.OwnerSystem, ValidatePayload: validator.ValidateStatModifierChangePayload},
 }
 
+// damageFilterFields exposes damage payload values to event list filters, e.g.
+// `payload.marks >= 2` or `payload.source_character_ids:"char-1"`.
+var damageFilterFields = []corefilter.PayloadField{
+	{Name: "hp_after", Path: "$.hp_after", Type: corefilter.FieldTypeInt},
+	{Name: "armor_spent", Path: "$.armor_spent", Type: corefilter.FieldTypeInt},
+	{Name: "marks", Path: "$.marks", Type: corefilter.FieldTypeInt},
+	{Name: "severity", Path: "$.severity", Type: corefilter.FieldTypeString},
+	{Name: "damage_type", Path: "$.damage_type", Type: corefilter.FieldTypeString},
+	{Name: "roll_seq", Path: "$.roll_seq", Type: corefilter.FieldTypeInt},
+	{Name: "mitigated", Path: "$.mitigated", Type: corefilter.FieldTypeBool},
+	{Name: "massive_damage", Path: "$.massive_damage", Type: corefilter.FieldTypeBool},
+	{Name: "source_character_ids", Path: "$.source_character_ids", Type: corefilter.FieldTypeStringList},
+}
+
 var daggerheartEventDefinitions = []event.Definition{
 	{Type: daggerheartpayload.EventTypeGMMoveApplied, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateGMMoveAppliedPayload, Intent: event.IntentAuditOnly},
-	{Type: daggerheartpayload.EventTypeGMFearChanged, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateGMFearChangedPayload, Intent: event.IntentProjectionAndReplay},
+	{Type: daggerheartpayload.EventTypeGMFearChanged, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateGMFearChangedPayload, Intent: event.IntentProjectionAndReplay, FilterFields: []corefilter.PayloadField{
+		{Name: "fear_after", Path: "$.after", Type: corefilter.FieldTypeInt},
+	}},
 	{Type: daggerheartpayload.EventTypeCharacterProfileReplaced, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateCharacterProfileReplacedPayload, Intent: event.IntentProjectionAndReplay},
 	{Type: daggerheartpayload.EventTypeCharacterProfileDeleted, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateCharacterProfileDeletedPayload, Intent: event.IntentProjectionAndReplay},
 	{Type: daggerheartpayload.EventTypeCharacterStatePatched, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateCharacterStatePatchedPayload, Intent: event.IntentProjectionAndReplay},
@@ -106,8 +123,8 @@ var daggerheartEventDefinitions = []event.Definition{
 	{Type: daggerheartpayload.EventTypeCampaignCountdownAdvanced, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateCampaignCountdownAdvancedPayload, Intent: event.IntentProjectionAndReplay},
 	{Type: daggerheartpayload.EventTypeCampaignCountdownTriggerResolved, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateCampaignCountdownTriggerResolvedPayload, Intent: event.IntentProjectionAndReplay},
 	{Type: daggerheartpayload.EventTypeCampaignCountdownDeleted, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateCampaignCountdownDeletedPayload, Intent: event.IntentProjectionAndReplay},
-	{Type: daggerheartpayload.EventTypeDamageApplied, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateDamageAppliedPayload, Intent: event.IntentProjectionAndReplay},
-	{Type: daggerheartpayload.EventTypeAdversaryDamageApplied, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateAdversaryDamageAppliedPayload, Intent: event.IntentProjectionAndReplay},
+	{Type: daggerheartpayload.EventTypeDamageApplied, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateDamageAppliedPayload, Intent: event.IntentProjectionAndReplay, FilterFields: damageFilterFields},
+	{Type: daggerheartpayload.EventTypeAdversaryDamageApplied, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateAdversaryDamageAppliedPayload, Intent: event.IntentProjectionAndReplay, FilterFields: damageFilterFields},
 	{Type: daggerheartpayload.EventTypeDowntimeMoveApplied, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateDowntimeMoveAppliedPayload, Intent: event.IntentProjectionAndReplay},
 	{Type: daggerheartpayload.EventTypeCharacterTemporaryArmorApplied, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateCharacterTemporaryArmorAppliedPayload, Intent: event.IntentProjectionAndReplay},
 	{Type: daggerheartpayload.EventTypeAdversaryConditionChanged, Owner: event.OwnerSystem, ValidatePayload: validator.ValidateAdversaryConditionChangedPayload, Intent: event.IntentProjectionAndReplay},
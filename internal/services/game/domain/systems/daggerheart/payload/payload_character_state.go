@@ -11,9 +11,11 @@ import (
 // Source is an optional discriminator indicating what triggered the patch
 // (e.g. "hope.spend", "stress.spend"), enabling journal queries to distinguish
 // spend events from generic GM adjustments without introducing separate event types.
+// Reason is optional free text explaining the patch, such as a GM correction note.
 type CharacterStatePatchPayload struct {
 	CharacterID                         ids.CharacterID                          `json:"character_id"`
 	Source                              string                                   `json:"source,omitempty"`
+	Reason                              string                                   `json:"reason,omitempty"`
 	MutationSource                      *daggerheartstate.MutationSource         `json:"mutation_source,omitempty"`
 	HPBefore                            *int                                     `json:"hp_before,omitempty"`
 	HPAfter                             *int                                     `json:"hp_after,omitempty"`
@@ -39,6 +41,7 @@ type CharacterStatePatchPayload struct {
 type CharacterStatePatchedPayload struct {
 	CharacterID                   ids.CharacterID                          `json:"character_id"`
 	Source                        string                                   `json:"source,omitempty"`
+	Reason                        string                                   `json:"reason,omitempty"`
 	HP                            *int                                     `json:"hp_after,omitempty"`
 	Hope                          *int                                     `json:"hope_after,omitempty"`
 	HopeMax                       *int                                     `json:"hope_max_after,omitempty"`
@@ -19,3 +19,15 @@ func TestAIGMTurnRequestedDedupeKey(t *testing.T) {
 		t.Fatalf("different campaign should change dedupe key: %q", other)
 	}
 }
+
+func TestAIPlayerTurnRequestedDedupeKey(t *testing.T) {
+	t.Parallel()
+
+	key := AIPlayerTurnRequestedDedupeKey("camp-1", 42)
+	if key == AIGMTurnRequestedDedupeKey("camp-1", 42) {
+		t.Fatalf("ai player dedupe key must not collide with ai gm dedupe key: %q", key)
+	}
+	if other := AIPlayerTurnRequestedDedupeKey("camp-1", 43); other == key {
+		t.Fatalf("different source event seq should change dedupe key: %q", other)
+	}
+}
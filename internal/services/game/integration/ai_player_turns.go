@@ -0,0 +1,23 @@
+package integration
+
+import "fmt"
+
+const AIPlayerTurnRequestedOutboxEventType = "game.ai_player_turn.requested.v1"
+
+// AIPlayerTurnRequestedOutboxPayload is the durable worker-facing request
+// emitted whenever a scene player phase changes in a way that may leave an
+// AI-controlled player participant due to act. The worker asks the game which
+// AI players are actually due, so the request itself is only a wake-up hint.
+type AIPlayerTurnRequestedOutboxPayload struct {
+	CampaignID      string `json:"campaign_id"`
+	SessionID       string `json:"session_id"`
+	SceneID         string `json:"scene_id"`
+	PhaseID         string `json:"phase_id"`
+	SourceEventType string `json:"source_event_type,omitempty"`
+}
+
+// AIPlayerTurnRequestedDedupeKey returns the stable dedupe key for one AI
+// player turn request sourced from a persisted journal event.
+func AIPlayerTurnRequestedDedupeKey(campaignID string, sourceEventSeq uint64) string {
+	return fmt.Sprintf("ai-player-turn:%s:%d", campaignID, sourceEventSeq)
+}
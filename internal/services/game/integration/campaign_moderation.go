@@ -0,0 +1,20 @@
+package integration
+
+import "fmt"
+
+// CampaignModerationChangedOutboxEventType announces that an operator locked
+// or unlocked a campaign so its participants can be notified.
+const CampaignModerationChangedOutboxEventType = "game.campaign_moderation.changed.v1"
+
+// CampaignModerationOutboxPayload describes one campaign moderation change.
+type CampaignModerationOutboxPayload struct {
+	CampaignID string `json:"campaign_id"`
+	Locked     bool   `json:"locked"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// CampaignModerationChangedDedupeKey returns the stable dedupe key for one
+// moderation change sourced from a persisted journal event.
+func CampaignModerationChangedDedupeKey(campaignID string, sourceEventSeq uint64) string {
+	return fmt.Sprintf("campaign-moderation-changed:%s:%d", campaignID, sourceEventSeq)
+}
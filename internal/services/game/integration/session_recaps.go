@@ -0,0 +1,32 @@
+package integration
+
+import "fmt"
+
+const (
+	// SessionRecapDraftRequestedOutboxEventType asks the worker to draft a
+	// recap for a session that just ended. Campaigns without a bound AI agent
+	// skip the request, so the draft stays optional.
+	SessionRecapDraftRequestedOutboxEventType = "game.session_recap_draft.requested.v1"
+	// SessionRecapPublishedOutboxEventType announces that recap markdown was
+	// recorded for one session so players can be notified.
+	SessionRecapPublishedOutboxEventType = "game.session_recap.published.v1"
+)
+
+// SessionRecapOutboxPayload identifies the session targeted by a session
+// recap outbox event.
+type SessionRecapOutboxPayload struct {
+	CampaignID string `json:"campaign_id"`
+	SessionID  string `json:"session_id"`
+}
+
+// SessionRecapDraftRequestedDedupeKey returns the stable dedupe key for the
+// draft request of one ended session.
+func SessionRecapDraftRequestedDedupeKey(campaignID, sessionID string) string {
+	return fmt.Sprintf("session-recap-draft:%s:%s", campaignID, sessionID)
+}
+
+// SessionRecapPublishedDedupeKey returns the stable dedupe key for one
+// recorded recap sourced from a persisted journal event.
+func SessionRecapPublishedDedupeKey(campaignID string, sourceEventSeq uint64) string {
+	return fmt.Sprintf("session-recap-published:%s:%d", campaignID, sourceEventSeq)
+}
@@ -0,0 +1,59 @@
+package integration
+
+import "net/netip"
+
+// webhookBlockedPrefixes lists the non-loopback address ranges webhook
+// deliveries must never reach: private and shared address space, special-use
+// and reserved blocks, and IPv6 translation prefixes that can embed an
+// internal IPv4 address. The game checks literal registration URLs against it
+// and the worker checks every resolved address at dial time, so both sides
+// agree on one list.
+var webhookBlockedPrefixes = []netip.Prefix{
+	// IPv4.
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("10.0.0.0/8"),      // private
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT shared space
+	netip.MustParsePrefix("169.254.0.0/16"),  // link-local, cloud metadata
+	netip.MustParsePrefix("172.16.0.0/12"),   // private
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("192.88.99.0/24"),  // 6to4 relay anycast
+	netip.MustParsePrefix("192.168.0.0/16"),  // private
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("224.0.0.0/4"),     // multicast
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, limited broadcast
+
+	// IPv6. IPv4-mapped addresses are unmapped and checked as IPv4.
+	netip.MustParsePrefix("::/96"),          // unspecified, IPv4-compatible
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64 well-known prefix
+	netip.MustParsePrefix("64:ff9b:1::/48"), // NAT64 local-use prefix
+	netip.MustParsePrefix("100::/64"),       // discard-only
+	netip.MustParsePrefix("2001::/23"),      // IETF protocol assignments, Teredo
+	netip.MustParsePrefix("2001:db8::/32"),  // documentation
+	netip.MustParsePrefix("2002::/16"),      // 6to4
+	netip.MustParsePrefix("fc00::/7"),       // unique local
+	netip.MustParsePrefix("fe80::/10"),      // link-local
+	netip.MustParsePrefix("fec0::/10"),      // deprecated site-local
+	netip.MustParsePrefix("ff00::/8"),       // multicast
+}
+
+// IsBlockedWebhookAddr reports whether webhook deliveries must not reach addr.
+// Loopback addresses are blocked unless allowLoopback admits local
+// development receivers.
+func IsBlockedWebhookAddr(addr netip.Addr, allowLoopback bool) bool {
+	if !addr.IsValid() {
+		return true
+	}
+	addr = addr.WithZone("").Unmap()
+	if addr.IsLoopback() {
+		return !allowLoopback
+	}
+	for _, prefix := range webhookBlockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
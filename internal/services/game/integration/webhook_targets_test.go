@@ -0,0 +1,58 @@
+package integration
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIsBlockedWebhookAddr(t *testing.T) {
+	for _, raw := range []string{
+		"0.1.2.3",
+		"10.1.2.3",
+		"100.64.0.1",
+		"100.127.255.254",
+		"169.254.169.254",
+		"172.31.0.1",
+		"192.0.0.8",
+		"192.0.2.10",
+		"192.168.1.1",
+		"198.18.0.1",
+		"198.19.255.254",
+		"203.0.113.5",
+		"224.0.0.1",
+		"240.0.0.1",
+		"255.255.255.255",
+		"::",
+		"::ffff:10.0.0.1",
+		"::ffff:100.64.0.1",
+		"64:ff9b::a00:1",
+		"64:ff9b:1::1",
+		"2001::1",
+		"2001:db8::1",
+		"2002:a00:1::1",
+		"fd00::1",
+		"fe80::1%eth0",
+		"ff02::1",
+	} {
+		if !IsBlockedWebhookAddr(netip.MustParseAddr(raw), false) {
+			t.Errorf("%s not blocked", raw)
+		}
+	}
+	for _, raw := range []string{"93.184.216.34", "100.128.0.1", "198.20.0.1", "2606:4700::1111", "::ffff:93.184.216.34"} {
+		if IsBlockedWebhookAddr(netip.MustParseAddr(raw), false) {
+			t.Errorf("public address %s blocked", raw)
+		}
+	}
+	for _, raw := range []string{"127.0.0.1", "::1", "::ffff:127.0.0.1"} {
+		addr := netip.MustParseAddr(raw)
+		if !IsBlockedWebhookAddr(addr, false) {
+			t.Errorf("loopback %s not blocked", raw)
+		}
+		if IsBlockedWebhookAddr(addr, true) {
+			t.Errorf("loopback %s blocked with allowLoopback", raw)
+		}
+	}
+	if !IsBlockedWebhookAddr(netip.Addr{}, true) {
+		t.Error("invalid address not blocked")
+	}
+}
@@ -0,0 +1,150 @@
+package integration
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// WebhookDeliveryRequestedOutboxEventType asks the worker to POST one
+	// campaign event to one registered webhook endpoint.
+	WebhookDeliveryRequestedOutboxEventType = "game.webhook_delivery.requested.v1"
+	// WebhookTestEventType is the event type of the synthetic event sent by
+	// the "send test event" action. It never appears in the journal.
+	WebhookTestEventType = "webhook.test"
+)
+
+// Webhook request headers. Receivers verify SignatureHeader before trusting
+// the body and may use DeliveryHeader to drop retried duplicates.
+const (
+	WebhookSignatureHeader = "X-Fracturing-Signature"
+	WebhookEventHeader     = "X-Fracturing-Event"
+	WebhookDeliveryHeader  = "X-Fracturing-Delivery"
+)
+
+// webhookSignatureVersion tags the signing scheme so it can change without
+// breaking receivers that check the version.
+const webhookSignatureVersion = "v1"
+
+// WebhookEvent is the JSON body delivered to webhook endpoints. It mirrors the
+// journal envelope so receivers can reason about ordering with Seq.
+type WebhookEvent struct {
+	CampaignID string          `json:"campaign_id"`
+	Seq        uint64          `json:"seq,omitempty"`
+	Type       string          `json:"type"`
+	Timestamp  time.Time       `json:"timestamp"`
+	SessionID  string          `json:"session_id,omitempty"`
+	SceneID    string          `json:"scene_id,omitempty"`
+	ActorType  string          `json:"actor_type,omitempty"`
+	ActorID    string          `json:"actor_id,omitempty"`
+	EntityType string          `json:"entity_type,omitempty"`
+	EntityID   string          `json:"entity_id,omitempty"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	Test       bool            `json:"test,omitempty"`
+}
+
+// WebhookDeliveryOutboxPayload carries one event for one endpoint, captured
+// at append time. The worker replaces the payload with the one
+// GetWebhookDeliveryTarget returns for the event's seq, so identity redacted
+// after the delivery was queued is not sent; the envelope fields are delivered
+// as captured.
+type WebhookDeliveryOutboxPayload struct {
+	WebhookID string       `json:"webhook_id"`
+	Event     WebhookEvent `json:"event"`
+}
+
+// WebhookDeliveryDedupeKey returns the stable dedupe key for delivering one
+// persisted journal event to one endpoint.
+func WebhookDeliveryDedupeKey(webhookID, campaignID string, sourceEventSeq uint64) string {
+	return fmt.Sprintf("webhook-delivery:%s:%s:%d", webhookID, campaignID, sourceEventSeq)
+}
+
+// WebhookTestDeliveryDedupeKey returns the dedupe key for one test delivery;
+// every request gets its own key so repeated tests are all sent.
+func WebhookTestDeliveryDedupeKey(webhookID, requestID string) string {
+	return fmt.Sprintf("webhook-test:%s:%s", webhookID, requestID)
+}
+
+// WebhookSubscribes reports whether an endpoint's event type filters select
+// eventType. Filters are exact types or a namespace prefix ending in ".*",
+// e.g. "session.*" or "sys.daggerheart.*".
+func WebhookSubscribes(filters []string, eventType string) bool {
+	eventType = strings.TrimSpace(eventType)
+	if eventType == "" {
+		return false
+	}
+	for _, filter := range filters {
+		filter = strings.TrimSpace(filter)
+		if prefix, ok := strings.CutSuffix(filter, "*"); ok {
+			if strings.HasSuffix(prefix, ".") && strings.HasPrefix(eventType, prefix) {
+				return true
+			}
+			continue
+		}
+		if filter == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// SignWebhookPayload returns the signature header value for body:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">". Binding the
+// timestamp into the MAC lets receivers reject replayed requests.
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + unix + "," + webhookSignatureVersion + "=" + webhookMAC(secret, unix, body)
+}
+
+// VerifyWebhookSignature checks a signature header produced by
+// SignWebhookPayload. A non-positive tolerance skips the timestamp check.
+func VerifyWebhookSignature(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var unix, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			unix = value
+		case webhookSignatureVersion:
+			signature = value
+		}
+	}
+	if unix == "" || signature == "" {
+		return errors.New("webhook signature header is malformed")
+	}
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil {
+		return fmt.Errorf("webhook signature timestamp: %w", err)
+	}
+	if tolerance > 0 {
+		skew := now.Sub(time.Unix(seconds, 0))
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > tolerance {
+			return errors.New("webhook signature timestamp is outside the tolerance window")
+		}
+	}
+	if !hmac.Equal([]byte(signature), []byte(webhookMAC(secret, unix, body))) {
+		return errors.New("webhook signature does not match")
+	}
+	return nil
+}
+
+func webhookMAC(secret, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebhookSubscribes(t *testing.T) {
+	t.Parallel()
+
+	filters := []string{"session.started", "sys.daggerheart.*"}
+	for eventType, want := range map[string]bool{
+		"session.started":                true,
+		"session.ended":                  false,
+		"sys.daggerheart.damage_applied": true,
+		"sys.daggerheartish":             false,
+		"":                               false,
+	} {
+		if got := WebhookSubscribes(filters, eventType); got != want {
+			t.Fatalf("WebhookSubscribes(%q) = %v, want %v", eventType, got, want)
+		}
+	}
+	if WebhookSubscribes([]string{"*"}, "session.started") {
+		t.Fatal("bare wildcard must not subscribe to every event")
+	}
+}
+
+func TestWebhookDeliveryDedupeKey(t *testing.T) {
+	t.Parallel()
+
+	key := WebhookDeliveryDedupeKey("wh-1", "camp-1", 42)
+	if other := WebhookDeliveryDedupeKey("wh-2", "camp-1", 42); other == key {
+		t.Fatalf("different endpoint should change dedupe key: %q", other)
+	}
+	if other := WebhookDeliveryDedupeKey("wh-1", "camp-1", 43); other == key {
+		t.Fatalf("different source event seq should change dedupe key: %q", other)
+	}
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	body := []byte(`{"type":"session.started"}`)
+	header := SignWebhookPayload("whsec_test", now, body)
+
+	if err := VerifyWebhookSignature("whsec_test", header, body, now.Add(time.Minute), 5*time.Minute); err != nil {
+		t.Fatalf("verify signature: %v", err)
+	}
+	if err := VerifyWebhookSignature("whsec_other", header, body, now, 0); err == nil {
+		t.Fatal("expected wrong secret to fail")
+	}
+	if err := VerifyWebhookSignature("whsec_test", header, []byte(`{}`), now, 0); err == nil {
+		t.Fatal("expected tampered body to fail")
+	}
+	if err := VerifyWebhookSignature("whsec_test", header, body, now.Add(time.Hour), 5*time.Minute); err == nil {
+		t.Fatal("expected stale timestamp to fail")
+	}
+	if err := VerifyWebhookSignature("whsec_test", "garbage", body, now, 0); err == nil {
+		t.Fatal("expected malformed header to fail")
+	}
+}
@@ -165,6 +165,36 @@ func (a Applier) applyCampaignAIUnbound(ctx context.Context, evt event.Event, _
 	return a.Campaign.Put(ctx, current)
 }
 
+func (a Applier) applyCampaignModerationLocked(ctx context.Context, evt event.Event, payload campaign.ModerationLockPayload) error {
+	current, err := a.Campaign.Get(ctx, string(evt.CampaignID))
+	if err != nil {
+		return err
+	}
+	current.ModerationLocked = true
+	current.ModerationReason = strings.TrimSpace(payload.Reason)
+	updatedAt, err := ensureTimestamp(evt.Timestamp)
+	if err != nil {
+		return err
+	}
+	current.UpdatedAt = updatedAt
+	return a.Campaign.Put(ctx, current)
+}
+
+func (a Applier) applyCampaignModerationUnlocked(ctx context.Context, evt event.Event, _ campaign.ModerationUnlockPayload) error {
+	current, err := a.Campaign.Get(ctx, string(evt.CampaignID))
+	if err != nil {
+		return err
+	}
+	current.ModerationLocked = false
+	current.ModerationReason = ""
+	updatedAt, err := ensureTimestamp(evt.Timestamp)
+	if err != nil {
+		return err
+	}
+	current.UpdatedAt = updatedAt
+	return a.Campaign.Put(ctx, current)
+}
+
 func (a Applier) applyCampaignAIAuthRotated(ctx context.Context, evt event.Event, payload campaign.AIAuthRotatePayload) error {
 	current, err := a.Campaign.Get(ctx, string(evt.CampaignID))
 	if err != nil {
@@ -8,5 +8,7 @@ func registerCampaignProjectionHandlers(r *CoreRouter) {
 	HandleProjection(r, campaign.EventTypeAIBound, requirements(needsStores(storeCampaign), needsEnvelope(fieldCampaignID)), Applier.applyCampaignAIBound)
 	HandleProjection(r, campaign.EventTypeAIUnbound, requirements(needsStores(storeCampaign), needsEnvelope(fieldCampaignID)), Applier.applyCampaignAIUnbound)
 	HandleProjection(r, campaign.EventTypeAIAuthRotated, requirements(needsStores(storeCampaign), needsEnvelope(fieldCampaignID)), Applier.applyCampaignAIAuthRotated)
+	HandleProjection(r, campaign.EventTypeModerationLocked, requirements(needsStores(storeCampaign), needsEnvelope(fieldCampaignID)), Applier.applyCampaignModerationLocked)
+	HandleProjection(r, campaign.EventTypeModerationUnlocked, requirements(needsStores(storeCampaign), needsEnvelope(fieldCampaignID)), Applier.applyCampaignModerationUnlocked)
 	HandleProjection(r, campaign.EventTypeForked, requirements(needsStores(storeCampaignFork), needsEnvelope(fieldCampaignID)), Applier.applyCampaignForked)
 }
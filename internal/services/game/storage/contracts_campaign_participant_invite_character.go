@@ -27,6 +27,8 @@ type CampaignRecord struct {
 	CoverSetID       string
 	AIAgentID        string
 	AIAuthEpoch      uint64
+	ModerationLocked bool
+	ModerationReason string
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	CompletedAt      *time.Time
@@ -18,6 +18,14 @@ type BatchEventAppender interface {
 	BatchAppendEvents(ctx context.Context, events []event.Event) ([]event.Event, error)
 }
 
+// ConditionalBatchEventAppender appends a decision batch only while the
+// campaign journal head still equals expectedSeq. The head check and the
+// append share one transaction; a moved head returns the error built by
+// NewExpectedSeqMismatchError.
+type ConditionalBatchEventAppender interface {
+	BatchAppendEventsAtSeq(ctx context.Context, expectedSeq uint64, events []event.Event) ([]event.Event, error)
+}
+
 // EventLookupStore resolves specific journal events by stable identifiers.
 type EventLookupStore interface {
 	// GetEventByHash retrieves an event by its content hash.
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookDeliveryStatus classifies one recorded webhook delivery attempt.
+type WebhookDeliveryStatus = string
+
+const (
+	// WebhookDeliveryStatusSucceeded means the endpoint answered with 2xx.
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusFailed means the attempt failed and will be retried.
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+	// WebhookDeliveryStatusDead means the delivery was dead-lettered: the
+	// endpoint rejected it permanently or retries ran out.
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "dead"
+)
+
+// WebhookEndpoint is one campaign-owned outgoing webhook registration.
+type WebhookEndpoint struct {
+	ID          string
+	CampaignID  string
+	URL         string
+	EventTypes  []string
+	Description string
+	// SigningSecret is the HMAC key shared with the receiver. Stores hold it
+	// sealed; the transport returns the plaintext only to the owner at
+	// creation and to the delivering worker.
+	SigningSecret string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// WebhookDelivery is one delivery-log row: a single POST attempt.
+type WebhookDelivery struct {
+	ID             string
+	WebhookID      string
+	CampaignID     string
+	OutboxEventID  string
+	EventType      string
+	EventSeq       uint64
+	Attempt        int
+	Status         WebhookDeliveryStatus
+	ResponseStatus int
+	Error          string
+	Duration       time.Duration
+	Test           bool
+	CreatedAt      time.Time
+}
+
+// WebhookStore persists campaign webhook endpoints and their delivery log.
+type WebhookStore interface {
+	// PutWebhookEndpoint inserts a new endpoint.
+	PutWebhookEndpoint(ctx context.Context, endpoint WebhookEndpoint) error
+	// GetWebhookEndpoint returns ErrNotFound when the endpoint is missing.
+	GetWebhookEndpoint(ctx context.Context, webhookID string) (WebhookEndpoint, error)
+	// ListWebhookEndpoints returns a campaign's endpoints oldest first.
+	ListWebhookEndpoints(ctx context.Context, campaignID string) ([]WebhookEndpoint, error)
+	// DeleteWebhookEndpoint removes an endpoint and its delivery log. It
+	// returns ErrNotFound when the endpoint does not belong to the campaign.
+	DeleteWebhookEndpoint(ctx context.Context, campaignID, webhookID string) error
+	// RecordWebhookDelivery appends one delivery-log row.
+	RecordWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error
+	// ListWebhookDeliveries returns an endpoint's most recent attempts first.
+	ListWebhookDeliveries(ctx context.Context, webhookID string, limit int) ([]WebhookDelivery, error)
+}
+
+// WebhookSecretStore exposes sealed signing secrets to key-rotation tooling.
+// Listing pages by webhook ID so an interrupted re-seal can resume after the
+// last processed row. Replacement is a compare-and-swap against the value the
+// caller read and returns ErrConflict when the row changed in between.
+type WebhookSecretStore interface {
+	ListWebhookSecretCiphertexts(ctx context.Context, afterID string, limit int) ([]WebhookSealedSecret, error)
+	ReplaceWebhookSecretCiphertext(ctx context.Context, webhookID string, current string, replacement string) error
+}
+
+// WebhookSealedSecret identifies one stored signing secret by its webhook ID.
+type WebhookSealedSecret struct {
+	ID         string
+	Ciphertext string
+}
@@ -16,4 +16,5 @@
 // Common error types:
 // - ErrNotFound: requested record is missing
 // - ErrActiveSessionExists: conflict starting a new session
+// - NewExpectedSeqMismatchError: conditional append found a moved journal head
 package storage
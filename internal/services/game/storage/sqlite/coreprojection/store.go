@@ -54,6 +54,16 @@ func OpenProjections(path string) (*Store, error) {
 	return store, nil
 }
 
+// OpenMemory opens an empty projection store held entirely in memory. It backs
+// short-lived replays such as point-in-time reads and is discarded on Close.
+func OpenMemory() (*Store, error) {
+	sqlDB, err := sqliteconn.OpenMemory()
+	if err != nil {
+		return nil, err
+	}
+	return bootStore(sqlDB, migrations.ProjectionsFS, "projections")
+}
+
 // Close closes the underlying SQLite database.
 //
 // Close is intentionally nil-safe so callers can defer it in all startup paths.
@@ -75,7 +85,12 @@ func openStore(path string, migrationFS fs.FS, migrationRoot string) (*Store, er
 	if err != nil {
 		return nil, err
 	}
+	return bootStore(sqlDB, migrationFS, migrationRoot)
+}
 
+// bootStore applies embedded migrations to an open database handle and wraps
+// it in a Store.
+func bootStore(sqlDB *sql.DB, migrationFS fs.FS, migrationRoot string) (*Store, error) {
 	store := &Store{
 		sqlDB: sqlDB,
 		q:     db.New(sqlDB),
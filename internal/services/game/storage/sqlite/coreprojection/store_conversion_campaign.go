@@ -29,6 +29,8 @@ type campaignRowData struct {
 	CoverSetID       string
 	AIAgentID        string
 	AIAuthEpoch      int64
+	ModerationLocked int64
+	ModerationReason string
 	CreatedAt        int64
 	UpdatedAt        int64
 	CompletedAt      sql.NullInt64
@@ -56,6 +58,8 @@ func campaignRowDataToDomain(row campaignRowData) (storage.CampaignRecord, error
 		CoverSetID:       row.CoverSetID,
 		AIAgentID:        row.AIAgentID,
 		AIAuthEpoch:      uint64(row.AIAuthEpoch),
+		ModerationLocked: row.ModerationLocked != 0,
+		ModerationReason: row.ModerationReason,
 		CreatedAt:        sqliteutil.FromMillis(row.CreatedAt),
 		UpdatedAt:        sqliteutil.FromMillis(row.UpdatedAt),
 	}
@@ -84,6 +88,8 @@ func dbGetCampaignRowToDomain(row db.GetCampaignRow) (storage.CampaignRecord, er
 		CoverSetID:       row.CoverSetID,
 		AIAgentID:        row.AiAgentID,
 		AIAuthEpoch:      row.AiAuthEpoch,
+		ModerationLocked: row.ModerationLocked,
+		ModerationReason: row.ModerationReason,
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        row.UpdatedAt,
 		CompletedAt:      row.CompletedAt,
@@ -109,6 +115,8 @@ func dbListCampaignsRowToDomain(row db.ListCampaignsRow) (storage.CampaignRecord
 		CoverSetID:       row.CoverSetID,
 		AIAgentID:        row.AiAgentID,
 		AIAuthEpoch:      row.AiAuthEpoch,
+		ModerationLocked: row.ModerationLocked,
+		ModerationReason: row.ModerationReason,
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        row.UpdatedAt,
 		CompletedAt:      row.CompletedAt,
@@ -134,6 +142,8 @@ func dbListAllCampaignsRowToDomain(row db.ListAllCampaignsRow) (storage.Campaign
 		CoverSetID:       row.CoverSetID,
 		AIAgentID:        row.AiAgentID,
 		AIAuthEpoch:      row.AiAuthEpoch,
+		ModerationLocked: row.ModerationLocked,
+		ModerationReason: row.ModerationReason,
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        row.UpdatedAt,
 		CompletedAt:      row.CompletedAt,
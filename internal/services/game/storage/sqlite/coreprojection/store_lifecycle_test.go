@@ -51,6 +51,38 @@ func TestOpenAlias(t *testing.T) {
 	}
 }
 
+func TestOpenMemoryIsolatesStores(t *testing.T) {
+	first, err := OpenMemory()
+	if err != nil {
+		t.Fatalf("OpenMemory: %v", err)
+	}
+	defer first.Close()
+	second, err := OpenMemory()
+	if err != nil {
+		t.Fatalf("OpenMemory second: %v", err)
+	}
+	defer second.Close()
+
+	if err := first.Put(context.Background(), storage.CampaignRecord{
+		ID:        "camp-memory",
+		Name:      "Test",
+		Locale:    "en-US",
+		System:    bridge.SystemIDDaggerheart,
+		Status:    campaign.StatusActive,
+		GmMode:    campaign.GmModeHuman,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}); err != nil {
+		t.Fatalf("put campaign via OpenMemory: %v", err)
+	}
+	if _, err := first.Get(context.Background(), "camp-memory"); err != nil {
+		t.Fatalf("get campaign from first store: %v", err)
+	}
+	if _, err := second.Get(context.Background(), "camp-memory"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("get campaign from second store error = %v, want not found", err)
+	}
+}
+
 func TestPutParticipantDuplicateUser(t *testing.T) {
 	store := openTestStore(t)
 	now := time.Date(2026, 2, 3, 10, 0, 0, 0, time.UTC)
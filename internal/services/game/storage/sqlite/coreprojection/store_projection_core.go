@@ -43,6 +43,8 @@ func (s *Store) Put(ctx context.Context, c storage.CampaignRecord) error {
 		CoverSetID:       c.CoverSetID,
 		AiAgentID:        c.AIAgentID,
 		AiAuthEpoch:      int64(c.AIAuthEpoch),
+		ModerationLocked: boolToInt(c.ModerationLocked),
+		ModerationReason: c.ModerationReason,
 		CreatedAt:        sqliteutil.ToMillis(c.CreatedAt),
 		UpdatedAt:        sqliteutil.ToMillis(c.UpdatedAt),
 		CompletedAt:      completedAt,
@@ -16,7 +16,7 @@ SELECT
 	(SELECT COUNT(*) FROM participants p WHERE p.campaign_id = c.id) AS participant_count,
 	(SELECT COUNT(*) FROM characters ch WHERE ch.campaign_id = c.id) AS character_count,
 	(SELECT MAX(COALESCE(s.ended_at, s.started_at)) FROM sessions s WHERE s.campaign_id = c.id) AS latest_session_at,
-	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
+	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.moderation_locked, c.moderation_reason, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
 	c.created_at, c.updated_at, c.completed_at, c.archived_at
 FROM campaigns c WHERE c.id = ?
 `
@@ -38,6 +38,8 @@ type GetCampaignRow struct {
 	CoverSetID       string         `json:"cover_set_id"`
 	AiAgentID        string         `json:"ai_agent_id"`
 	AiAuthEpoch      int64          `json:"ai_auth_epoch"`
+	ModerationLocked int64          `json:"moderation_locked"`
+	ModerationReason string         `json:"moderation_reason"`
 	ParentCampaignID sql.NullString `json:"parent_campaign_id"`
 	ForkEventSeq     sql.NullInt64  `json:"fork_event_seq"`
 	OriginCampaignID sql.NullString `json:"origin_campaign_id"`
@@ -67,6 +69,8 @@ func (q *Queries) GetCampaign(ctx context.Context, id string) (GetCampaignRow, e
 		&i.CoverSetID,
 		&i.AiAgentID,
 		&i.AiAuthEpoch,
+		&i.ModerationLocked,
+		&i.ModerationReason,
 		&i.ParentCampaignID,
 		&i.ForkEventSeq,
 		&i.OriginCampaignID,
@@ -84,7 +88,7 @@ SELECT
 	(SELECT COUNT(*) FROM participants p WHERE p.campaign_id = c.id) AS participant_count,
 	(SELECT COUNT(*) FROM characters ch WHERE ch.campaign_id = c.id) AS character_count,
 	(SELECT MAX(COALESCE(s.ended_at, s.started_at)) FROM sessions s WHERE s.campaign_id = c.id) AS latest_session_at,
-	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
+	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.moderation_locked, c.moderation_reason, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
 	c.created_at, c.updated_at, c.completed_at, c.archived_at
 FROM campaigns c
 ORDER BY c.id
@@ -108,6 +112,8 @@ type ListAllCampaignsRow struct {
 	CoverSetID       string         `json:"cover_set_id"`
 	AiAgentID        string         `json:"ai_agent_id"`
 	AiAuthEpoch      int64          `json:"ai_auth_epoch"`
+	ModerationLocked int64          `json:"moderation_locked"`
+	ModerationReason string         `json:"moderation_reason"`
 	ParentCampaignID sql.NullString `json:"parent_campaign_id"`
 	ForkEventSeq     sql.NullInt64  `json:"fork_event_seq"`
 	OriginCampaignID sql.NullString `json:"origin_campaign_id"`
@@ -143,6 +149,8 @@ func (q *Queries) ListAllCampaigns(ctx context.Context, limit int64) ([]ListAllC
 			&i.CoverSetID,
 			&i.AiAgentID,
 			&i.AiAuthEpoch,
+			&i.ModerationLocked,
+			&i.ModerationReason,
 			&i.ParentCampaignID,
 			&i.ForkEventSeq,
 			&i.OriginCampaignID,
@@ -201,7 +209,7 @@ SELECT
 	(SELECT COUNT(*) FROM participants p WHERE p.campaign_id = c.id) AS participant_count,
 	(SELECT COUNT(*) FROM characters ch WHERE ch.campaign_id = c.id) AS character_count,
 	(SELECT MAX(COALESCE(s.ended_at, s.started_at)) FROM sessions s WHERE s.campaign_id = c.id) AS latest_session_at,
-	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
+	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.moderation_locked, c.moderation_reason, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
 	c.created_at, c.updated_at, c.completed_at, c.archived_at
 FROM campaigns c
 WHERE c.id > ?
@@ -231,6 +239,8 @@ type ListCampaignsRow struct {
 	CoverSetID       string         `json:"cover_set_id"`
 	AiAgentID        string         `json:"ai_agent_id"`
 	AiAuthEpoch      int64          `json:"ai_auth_epoch"`
+	ModerationLocked int64          `json:"moderation_locked"`
+	ModerationReason string         `json:"moderation_reason"`
 	ParentCampaignID sql.NullString `json:"parent_campaign_id"`
 	ForkEventSeq     sql.NullInt64  `json:"fork_event_seq"`
 	OriginCampaignID sql.NullString `json:"origin_campaign_id"`
@@ -266,6 +276,8 @@ func (q *Queries) ListCampaigns(ctx context.Context, arg ListCampaignsParams) ([
 			&i.CoverSetID,
 			&i.AiAgentID,
 			&i.AiAuthEpoch,
+			&i.ModerationLocked,
+			&i.ModerationReason,
 			&i.ParentCampaignID,
 			&i.ForkEventSeq,
 			&i.OriginCampaignID,
@@ -291,8 +303,8 @@ const putCampaign = `-- name: PutCampaign :exec
 INSERT INTO campaigns (
 	id, name, locale, game_system, status, gm_mode, intent, access_policy,
 	participant_count, character_count, theme_prompt, cover_asset_id, cover_set_id, ai_agent_id, ai_auth_epoch,
-	created_at, updated_at, completed_at, archived_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	moderation_locked, moderation_reason, created_at, updated_at, completed_at, archived_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
 	name = excluded.name,
 	locale = excluded.locale,
@@ -308,6 +320,8 @@ ON CONFLICT(id) DO UPDATE SET
     cover_set_id = excluded.cover_set_id,
     ai_agent_id = excluded.ai_agent_id,
     ai_auth_epoch = excluded.ai_auth_epoch,
+    moderation_locked = excluded.moderation_locked,
+    moderation_reason = excluded.moderation_reason,
     updated_at = excluded.updated_at,
     completed_at = excluded.completed_at,
     archived_at = excluded.archived_at
@@ -329,6 +343,8 @@ type PutCampaignParams struct {
 	CoverSetID       string        `json:"cover_set_id"`
 	AiAgentID        string        `json:"ai_agent_id"`
 	AiAuthEpoch      int64         `json:"ai_auth_epoch"`
+	ModerationLocked int64         `json:"moderation_locked"`
+	ModerationReason string        `json:"moderation_reason"`
 	CreatedAt        int64         `json:"created_at"`
 	UpdatedAt        int64         `json:"updated_at"`
 	CompletedAt      sql.NullInt64 `json:"completed_at"`
@@ -352,6 +368,8 @@ func (q *Queries) PutCampaign(ctx context.Context, arg PutCampaignParams) error
 		arg.CoverSetID,
 		arg.AiAgentID,
 		arg.AiAuthEpoch,
+		arg.ModerationLocked,
+		arg.ModerationReason,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.CompletedAt,
@@ -47,6 +47,8 @@ type Campaign struct {
 	CoverSetID       string         `json:"cover_set_id"`
 	AiAgentID        string         `json:"ai_agent_id"`
 	AiAuthEpoch      int64          `json:"ai_auth_epoch"`
+	ModerationLocked int64          `json:"moderation_locked"`
+	ModerationReason string         `json:"moderation_reason"`
 }
 
 type CampaignActiveSession struct {
@@ -17,7 +17,9 @@ type listEventsPageSQLPlan struct {
 	countParams      []any
 }
 
-func buildListEventsPageSQLPlan(req storage.ListEventsPageRequest) (listEventsPageSQLPlan, error) {
+// buildListEventsPageSQLPlan composes the page query. payloadFields are the
+// registry-declared payload filter fields the filter expression may reference.
+func buildListEventsPageSQLPlan(req storage.ListEventsPageRequest, payloadFields []corefilter.PayloadField) (listEventsPageSQLPlan, error) {
 	whereClause := "campaign_id = ?"
 	params := []any{req.CampaignID}
 	if req.AfterSeq > 0 {
@@ -55,7 +57,7 @@ func buildListEventsPageSQLPlan(req storage.ListEventsPageRequest) (listEventsPa
 		countParams = append(countParams, req.AfterSeq)
 	}
 
-	filterClause, filterParams, err := compileEventQueryFilter(req.Filter)
+	filterClause, filterParams, err := compileEventQueryFilter(req.Filter, payloadFields)
 	if err != nil {
 		return listEventsPageSQLPlan{}, err
 	}
@@ -77,14 +79,14 @@ func buildListEventsPageSQLPlan(req storage.ListEventsPageRequest) (listEventsPa
 	}, nil
 }
 
-func compileEventQueryFilter(filter storage.EventQueryFilter) (string, []any, error) {
+func compileEventQueryFilter(filter storage.EventQueryFilter, payloadFields []corefilter.PayloadField) (string, []any, error) {
 	var (
 		clauses []string
 		params  []any
 	)
 
 	if expression := strings.TrimSpace(filter.Expression); expression != "" {
-		cond, err := corefilter.ParseEventFilter(expression)
+		cond, err := corefilter.ParseEventFilter(expression, payloadFields...)
 		if err != nil {
 			return "", nil, fmt.Errorf("parse event filter expression: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage/integrity"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/db"
 	sqliteintegrationoutbox "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/integrationoutbox"
@@ -168,6 +169,18 @@ func (s *Store) AppendEvent(ctx context.Context, evt event.Event) (event.Event,
 // A constraint violation rolls back the entire transaction. Callers must
 // implement their own deduplication or treat partial-commit retries as fatal.
 func (s *Store) BatchAppendEvents(ctx context.Context, events []event.Event) ([]event.Event, error) {
+	return s.batchAppendEvents(ctx, events, nil)
+}
+
+// BatchAppendEventsAtSeq is BatchAppendEvents guarded by an optimistic
+// concurrency check: the campaign's latest stored seq must equal expectedSeq
+// inside the append transaction, otherwise nothing is written and the
+// mismatch error carries the current head.
+func (s *Store) BatchAppendEventsAtSeq(ctx context.Context, expectedSeq uint64, events []event.Event) ([]event.Event, error) {
+	return s.batchAppendEvents(ctx, events, &expectedSeq)
+}
+
+func (s *Store) batchAppendEvents(ctx context.Context, events []event.Event, expectedSeq *uint64) ([]event.Event, error) {
 	if len(events) == 0 {
 		return nil, nil
 	}
@@ -221,6 +234,11 @@ func (s *Store) BatchAppendEvents(ctx context.Context, events []event.Event) ([]
 	if err != nil {
 		return nil, fmt.Errorf("get event seq: %w", err)
 	}
+	if expectedSeq != nil {
+		if headSeq := uint64(baseSeq) - 1; headSeq != *expectedSeq {
+			return nil, storage.NewExpectedSeqMismatchError(campaignID, *expectedSeq, headSeq)
+		}
+	}
 
 	// Load previous chain hash for linking the first event in the batch.
 	prevChainHash := ""
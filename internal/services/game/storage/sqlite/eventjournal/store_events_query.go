@@ -161,7 +161,7 @@ func (s *Store) ListEventsPage(ctx context.Context, req storage.ListEventsPageRe
 		req.PageSize = 200
 	}
 
-	plan, err := buildListEventsPageSQLPlan(req)
+	plan, err := buildListEventsPageSQLPlan(req, s.eventRegistry.PayloadFilterFields())
 	if err != nil {
 		return storage.ListEventsPageResult{}, fmt.Errorf("build list events query plan: %w", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
@@ -391,7 +392,7 @@ func TestBuildListEventsPageSQLPlanBuildsExpectedClauses(t *testing.T) {
 		Filter: storage.EventQueryFilter{
 			SessionID: "sess-1",
 		},
-	})
+	}, nil)
 	if err != nil {
 		t.Fatalf("build list events page plan: %v", err)
 	}
@@ -427,7 +428,7 @@ func TestBuildListEventsPageSQLPlanFilterExpression(t *testing.T) {
 			Expression: `session_id = "sess-1"`,
 			EventType:  "session.started",
 		},
-	})
+	}, nil)
 	if err != nil {
 		t.Fatalf("build list events page plan: %v", err)
 	}
@@ -449,12 +450,59 @@ func TestBuildListEventsPageSQLPlanInvalidFilterExpression(t *testing.T) {
 		Filter: storage.EventQueryFilter{
 			Expression: "invalid filter syntax ===",
 		},
-	})
+	}, nil)
 	if err == nil {
 		t.Fatal("expected invalid filter expression error")
 	}
 }
 
+func TestListEventsPagePayloadFilter(t *testing.T) {
+	store := openTestEventsStore(t)
+	campaignID := "camp-payload-filter"
+
+	for i, fear := range []int{1, 4, 6} {
+		evt := testEvent(campaignID, event.Type("sys.daggerheart.gm_fear_changed"), "")
+		evt.Timestamp = time.Date(2026, 2, 3, 12, i, 0, 0, time.UTC)
+		evt.SystemID = daggerheart.SystemID
+		evt.SystemVersion = daggerheart.SystemVersion
+		evt.PayloadJSON = []byte(fmt.Sprintf(`{"after":%d}`, fear))
+		if _, err := store.AppendEvent(context.Background(), evt); err != nil {
+			t.Fatalf("append fear event %d: %v", i+1, err)
+		}
+	}
+	if _, err := store.AppendEvent(context.Background(), testEvent(campaignID, event.Type("campaign.created"), "")); err != nil {
+		t.Fatalf("append campaign event: %v", err)
+	}
+
+	tests := []struct {
+		filter string
+		want   []uint64
+	}{
+		{filter: `payload.fear_after > 3`, want: []uint64{2, 3}},
+		{filter: `in(payload.fear_after, 1, 6)`, want: []uint64{1, 3}},
+		{filter: `payload.fear_after:*`, want: []uint64{1, 2, 3}},
+		// Negation includes events that do not carry the payload field.
+		{filter: `NOT payload.fear_after > 3`, want: []uint64{1, 4}},
+	}
+	for _, tt := range tests {
+		result, err := store.ListEventsPage(context.Background(), storage.ListEventsPageRequest{
+			CampaignID: campaignID,
+			PageSize:   10,
+			Filter:     storage.EventQueryFilter{Expression: tt.filter},
+		})
+		if err != nil {
+			t.Fatalf("list events with %q: %v", tt.filter, err)
+		}
+		got := make([]uint64, 0, len(result.Events))
+		for _, evt := range result.Events {
+			got = append(got, evt.Seq)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) || result.TotalCount != len(tt.want) {
+			t.Fatalf("%q seqs = %v (total %d), want %v", tt.filter, got, result.TotalCount, tt.want)
+		}
+	}
+}
+
 func TestVerifyEventIntegrity(t *testing.T) {
 	store := openTestEventsStore(t)
 	campaignID := "camp-verify"
@@ -661,6 +709,37 @@ func TestBatchAppendEvents_EmptySlice(t *testing.T) {
 	}
 }
 
+func TestBatchAppendEventsAtSeq_RejectsMovedHead(t *testing.T) {
+	store := openTestEventsStore(t)
+	campaignID := "camp-batch-expected"
+
+	if _, err := store.AppendEvent(context.Background(), testEvent(campaignID, event.Type("campaign.created"), "")); err != nil {
+		t.Fatalf("append first: %v", err)
+	}
+
+	next := testEvent(campaignID, event.Type("campaign.created"), "")
+	next.Timestamp = next.Timestamp.Add(time.Minute)
+
+	_, err := store.BatchAppendEventsAtSeq(context.Background(), 2, []event.Event{next})
+	if !apperrors.IsCode(err, apperrors.CodeExpectedSeqMismatch) {
+		t.Fatalf("stale expected seq error = %v, want %s", err, apperrors.CodeExpectedSeqMismatch)
+	}
+	if got := apperrors.GetMetadata(err)["CurrentSeq"]; got != "1" {
+		t.Fatalf("current seq metadata = %q, want 1", got)
+	}
+	if latest, err := store.GetLatestEventSeq(context.Background(), campaignID); err != nil || latest != 1 {
+		t.Fatalf("latest seq after rejected append = %d, %v; want 1", latest, err)
+	}
+
+	stored, err := store.BatchAppendEventsAtSeq(context.Background(), 1, []event.Event{next})
+	if err != nil {
+		t.Fatalf("batch append at matching seq: %v", err)
+	}
+	if len(stored) != 1 || stored[0].Seq != 2 {
+		t.Fatalf("stored = %v, want one event at seq 2", stored)
+	}
+}
+
 func TestAppendEventFieldRoundTrip(t *testing.T) {
 	store := openTestEventsStore(t)
 
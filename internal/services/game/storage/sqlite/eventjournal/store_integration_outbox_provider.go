@@ -5,6 +5,7 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	sqliteintegrationoutbox "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/integrationoutbox"
+	sqlitewebhooks "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/webhooks"
 )
 
 // DB exposes the underlying SQLite handle for sibling backend packages that
@@ -18,9 +19,18 @@ func (s *Store) DB() *sql.DB {
 
 // IntegrationOutboxStore binds the integration-outbox backend to this event
 // store's SQLite database so worker-facing delivery persistence is explicit.
-func (s *Store) IntegrationOutboxStore() storage.IntegrationOutboxWorkerStore {
+func (s *Store) IntegrationOutboxStore() storage.IntegrationOutboxStore {
 	if s == nil {
 		return nil
 	}
 	return sqliteintegrationoutbox.Bind(s.sqlDB)
 }
+
+// WebhookStore binds the campaign webhook backend to this event store's
+// SQLite database, where append-time webhook fan-out reads registrations.
+func (s *Store) WebhookStore() storage.WebhookStore {
+	if s == nil {
+		return nil
+	}
+	return sqlitewebhooks.Bind(s.sqlDB)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/scene"
@@ -104,6 +105,64 @@ func TestIntegrationOutboxEventsForEventBuildsAIGMTurnRequests(t *testing.T) {
 	}
 }
 
+func TestIntegrationOutboxEventsForEventBuildsAIPlayerTurnRequests(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 12, 20, 0, 0, 0, time.UTC)
+	events := []event.Event{
+		{
+			Type: scene.EventTypePlayerPhaseStarted,
+			PayloadJSON: mustJSON(t, scene.PlayerPhaseStartedPayload{
+				SceneID: ids.SceneID("scene-1"),
+				PhaseID: "phase-1",
+			}),
+		},
+		{
+			Type: scene.EventTypePlayerPhaseYielded,
+			PayloadJSON: mustJSON(t, scene.PlayerPhaseYieldedPayload{
+				SceneID:       ids.SceneID("scene-1"),
+				PhaseID:       "phase-1",
+				ParticipantID: ids.ParticipantID("human"),
+			}),
+		},
+		{
+			Type: scene.EventTypePlayerPhaseRevisionsRequested,
+			PayloadJSON: mustJSON(t, scene.PlayerPhaseRevisionsRequestedPayload{
+				SceneID: ids.SceneID("scene-1"),
+				PhaseID: "phase-1",
+			}),
+		},
+	}
+	for i, evt := range events {
+		evt.CampaignID = ids.CampaignID("camp-1")
+		evt.SessionID = ids.SessionID("sess-1")
+		evt.Seq = uint64(30 + i)
+		evt.Timestamp = now
+
+		outboxEvents, err := integrationOutboxEventsForEvent(evt)
+		if err != nil {
+			t.Fatalf("%s: integrationOutboxEventsForEvent error = %v", evt.Type, err)
+		}
+		if len(outboxEvents) != 1 {
+			t.Fatalf("%s: outbox events = %d, want 1", evt.Type, len(outboxEvents))
+		}
+		outboxEvent := outboxEvents[0]
+		if outboxEvent.EventType != gameintegration.AIPlayerTurnRequestedOutboxEventType {
+			t.Fatalf("%s: event type = %q", evt.Type, outboxEvent.EventType)
+		}
+		if outboxEvent.DedupeKey != gameintegration.AIPlayerTurnRequestedDedupeKey("camp-1", evt.Seq) {
+			t.Fatalf("%s: dedupe key = %q", evt.Type, outboxEvent.DedupeKey)
+		}
+		var payload gameintegration.AIPlayerTurnRequestedOutboxPayload
+		if err := json.Unmarshal([]byte(outboxEvent.PayloadJSON), &payload); err != nil {
+			t.Fatalf("unmarshal payload: %v", err)
+		}
+		if payload.CampaignID != "camp-1" || payload.SessionID != "sess-1" || payload.SceneID != "scene-1" || payload.PhaseID != "phase-1" || payload.SourceEventType != string(evt.Type) {
+			t.Fatalf("%s: payload = %#v", evt.Type, payload)
+		}
+	}
+}
+
 func TestIntegrationOutboxEventsForEventSkipsAIRequestWithoutCampaignOrSession(t *testing.T) {
 	t.Parallel()
 
@@ -163,3 +222,103 @@ func mustJSON(t *testing.T, v any) []byte {
 	}
 	return data
 }
+
+func TestIntegrationOutboxEventsForEventBuildsSessionRecapEvents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 12, 21, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		evt       event.Event
+		eventType string
+		dedupeKey string
+	}{
+		{
+			name: "session ended requests draft",
+			evt: event.Event{
+				Type:        session.EventTypeEnded,
+				PayloadJSON: mustJSON(t, session.EndPayload{SessionID: ids.SessionID("sess-1")}),
+			},
+			eventType: gameintegration.SessionRecapDraftRequestedOutboxEventType,
+			dedupeKey: gameintegration.SessionRecapDraftRequestedDedupeKey("camp-1", "sess-1"),
+		},
+		{
+			name: "recap recorded publishes",
+			evt: event.Event{
+				Type:        session.EventTypeRecapRecorded,
+				PayloadJSON: mustJSON(t, session.RecapRecordedPayload{SessionID: ids.SessionID("sess-1"), Markdown: "Recap"}),
+			},
+			eventType: gameintegration.SessionRecapPublishedOutboxEventType,
+			dedupeKey: gameintegration.SessionRecapPublishedDedupeKey("camp-1", 42),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evt := tt.evt
+			evt.CampaignID = ids.CampaignID("camp-1")
+			evt.SessionID = ids.SessionID("sess-1")
+			evt.Seq = 42
+			evt.Timestamp = now
+
+			outboxEvents, err := integrationOutboxEventsForEvent(evt)
+			if err != nil {
+				t.Fatalf("integrationOutboxEventsForEvent error = %v", err)
+			}
+			if len(outboxEvents) != 1 {
+				t.Fatalf("outbox events = %d, want 1", len(outboxEvents))
+			}
+			if outboxEvents[0].EventType != tt.eventType || outboxEvents[0].DedupeKey != tt.dedupeKey {
+				t.Fatalf("outbox event = %#v", outboxEvents[0])
+			}
+			var payload gameintegration.SessionRecapOutboxPayload
+			if err := json.Unmarshal([]byte(outboxEvents[0].PayloadJSON), &payload); err != nil {
+				t.Fatalf("unmarshal payload: %v", err)
+			}
+			if payload.CampaignID != "camp-1" || payload.SessionID != "sess-1" {
+				t.Fatalf("payload = %#v", payload)
+			}
+		})
+	}
+}
+
+func TestIntegrationOutboxEventsForEventBuildsCampaignModerationEvents(t *testing.T) {
+	t.Parallel()
+
+	evt := event.Event{
+		Type:        campaign.EventTypeModerationLocked,
+		CampaignID:  ids.CampaignID("camp-1"),
+		Seq:         7,
+		Timestamp:   time.Date(2026, 3, 12, 21, 0, 0, 0, time.UTC),
+		PayloadJSON: mustJSON(t, campaign.ModerationLockPayload{Reason: "abuse report"}),
+	}
+	outboxEvents, err := integrationOutboxEventsForEvent(evt)
+	if err != nil {
+		t.Fatalf("integrationOutboxEventsForEvent error = %v", err)
+	}
+	if len(outboxEvents) != 1 || outboxEvents[0].EventType != gameintegration.CampaignModerationChangedOutboxEventType {
+		t.Fatalf("outbox events = %#v", outboxEvents)
+	}
+	if outboxEvents[0].DedupeKey != gameintegration.CampaignModerationChangedDedupeKey("camp-1", 7) {
+		t.Fatalf("dedupe key = %q", outboxEvents[0].DedupeKey)
+	}
+	var payload gameintegration.CampaignModerationOutboxPayload
+	if err := json.Unmarshal([]byte(outboxEvents[0].PayloadJSON), &payload); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if payload.CampaignID != "camp-1" || !payload.Locked || payload.Reason != "abuse report" {
+		t.Fatalf("payload = %#v", payload)
+	}
+
+	evt.Type = campaign.EventTypeModerationUnlocked
+	evt.PayloadJSON = mustJSON(t, campaign.ModerationUnlockPayload{})
+	outboxEvents, err = integrationOutboxEventsForEvent(evt)
+	if err != nil {
+		t.Fatalf("integrationOutboxEventsForEvent unlock error = %v", err)
+	}
+	if err := json.Unmarshal([]byte(outboxEvents[0].PayloadJSON), &payload); err != nil {
+		t.Fatalf("unmarshal unlock payload: %v", err)
+	}
+	if payload.Locked {
+		t.Fatalf("unlock payload = %#v", payload)
+	}
+}
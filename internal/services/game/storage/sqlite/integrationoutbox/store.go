@@ -11,6 +11,7 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/platform/id"
 	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/scene"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
@@ -53,6 +54,11 @@ func EnqueueForEvent(ctx context.Context, tx *sql.Tx, evt event.Event) error {
 	if err != nil {
 		return err
 	}
+	webhookEvents, err := webhookDeliveryOutboxEventsForEvent(ctx, tx, evt)
+	if err != nil {
+		return err
+	}
+	outboxEvents = append(outboxEvents, webhookEvents...)
 	for _, outboxEvent := range outboxEvents {
 		if err := enqueue(ctx, tx, outboxEvent); err != nil {
 			return err
@@ -133,11 +139,161 @@ func integrationOutboxEventsForEvent(evt event.Event) ([]storage.IntegrationOutb
 		return buildAIGMTurnRequestedOutboxEvent(evt)
 	case scene.EventTypePlayerPhaseReviewStarted:
 		return buildAIGMTurnRequestedOutboxEvent(evt)
+	case scene.EventTypePlayerPhaseStarted, scene.EventTypePlayerPhaseYielded, scene.EventTypePlayerPhaseRevisionsRequested:
+		return buildAIPlayerTurnRequestedOutboxEvent(evt)
+	case session.EventTypeEnded:
+		return buildSessionRecapDraftRequestedOutboxEvent(evt)
+	case session.EventTypeRecapRecorded:
+		return buildSessionRecapPublishedOutboxEvent(evt)
+	case campaign.EventTypeModerationLocked, campaign.EventTypeModerationUnlocked:
+		return buildCampaignModerationChangedOutboxEvent(evt)
 	default:
 		return nil, nil
 	}
 }
 
+// buildAIPlayerTurnRequestedOutboxEvent wakes the worker whenever a player
+// phase opens, a participant yields, or the GM asks for revisions; those are
+// the only transitions that can make an AI-controlled player due to act.
+func buildAIPlayerTurnRequestedOutboxEvent(evt event.Event) ([]storage.IntegrationOutboxEvent, error) {
+	var source struct {
+		SceneID string `json:"scene_id"`
+		PhaseID string `json:"phase_id"`
+	}
+	if err := json.Unmarshal(evt.PayloadJSON, &source); err != nil {
+		return nil, fmt.Errorf("decode %s integration payload: %w", evt.Type, err)
+	}
+	payload := gameintegration.AIPlayerTurnRequestedOutboxPayload{
+		CampaignID:      strings.TrimSpace(string(evt.CampaignID)),
+		SessionID:       strings.TrimSpace(evt.SessionID.String()),
+		SceneID:         strings.TrimSpace(source.SceneID),
+		PhaseID:         strings.TrimSpace(source.PhaseID),
+		SourceEventType: strings.TrimSpace(string(evt.Type)),
+	}
+	if payload.CampaignID == "" || payload.SessionID == "" || payload.SceneID == "" || payload.PhaseID == "" {
+		return nil, nil
+	}
+	if evt.Seq == 0 {
+		return nil, fmt.Errorf("source event sequence is required")
+	}
+	outboxEventID, err := id.NewID()
+	if err != nil {
+		return nil, fmt.Errorf("generate integration outbox event id: %w", err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal ai player turn requested outbox payload: %w", err)
+	}
+	now := evt.Timestamp.UTC()
+	return []storage.IntegrationOutboxEvent{{
+		ID:            outboxEventID,
+		EventType:     gameintegration.AIPlayerTurnRequestedOutboxEventType,
+		PayloadJSON:   string(payloadJSON),
+		DedupeKey:     gameintegration.AIPlayerTurnRequestedDedupeKey(payload.CampaignID, evt.Seq),
+		Status:        storage.IntegrationOutboxStatusPending,
+		AttemptCount:  0,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}}, nil
+}
+
+// buildSessionRecapDraftRequestedOutboxEvent asks the worker for a recap draft
+// once per ended session.
+func buildSessionRecapDraftRequestedOutboxEvent(evt event.Event) ([]storage.IntegrationOutboxEvent, error) {
+	payload := gameintegration.SessionRecapOutboxPayload{
+		CampaignID: strings.TrimSpace(string(evt.CampaignID)),
+		SessionID:  strings.TrimSpace(evt.SessionID.String()),
+	}
+	if payload.CampaignID == "" || payload.SessionID == "" {
+		return nil, nil
+	}
+	return buildSessionRecapOutboxEvent(evt, gameintegration.SessionRecapDraftRequestedOutboxEventType, payload,
+		gameintegration.SessionRecapDraftRequestedDedupeKey(payload.CampaignID, payload.SessionID))
+}
+
+// buildSessionRecapPublishedOutboxEvent announces every recorded recap,
+// whether it came from GM review or the AI conclude-session flow.
+func buildSessionRecapPublishedOutboxEvent(evt event.Event) ([]storage.IntegrationOutboxEvent, error) {
+	payload := gameintegration.SessionRecapOutboxPayload{
+		CampaignID: strings.TrimSpace(string(evt.CampaignID)),
+		SessionID:  strings.TrimSpace(evt.SessionID.String()),
+	}
+	if payload.CampaignID == "" || payload.SessionID == "" {
+		return nil, nil
+	}
+	if evt.Seq == 0 {
+		return nil, fmt.Errorf("source event sequence is required")
+	}
+	return buildSessionRecapOutboxEvent(evt, gameintegration.SessionRecapPublishedOutboxEventType, payload,
+		gameintegration.SessionRecapPublishedDedupeKey(payload.CampaignID, evt.Seq))
+}
+
+func buildSessionRecapOutboxEvent(evt event.Event, eventType string, payload gameintegration.SessionRecapOutboxPayload, dedupeKey string) ([]storage.IntegrationOutboxEvent, error) {
+	outboxEventID, err := id.NewID()
+	if err != nil {
+		return nil, fmt.Errorf("generate integration outbox event id: %w", err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s outbox payload: %w", eventType, err)
+	}
+	now := evt.Timestamp.UTC()
+	return []storage.IntegrationOutboxEvent{{
+		ID:            outboxEventID,
+		EventType:     eventType,
+		PayloadJSON:   string(payloadJSON),
+		DedupeKey:     dedupeKey,
+		Status:        storage.IntegrationOutboxStatusPending,
+		AttemptCount:  0,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}}, nil
+}
+
+// buildCampaignModerationChangedOutboxEvent tells the worker to notify
+// participants when an operator locks or unlocks their campaign.
+func buildCampaignModerationChangedOutboxEvent(evt event.Event) ([]storage.IntegrationOutboxEvent, error) {
+	var source struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal(evt.PayloadJSON, &source); err != nil {
+		return nil, fmt.Errorf("decode %s integration payload: %w", evt.Type, err)
+	}
+	payload := gameintegration.CampaignModerationOutboxPayload{
+		CampaignID: strings.TrimSpace(string(evt.CampaignID)),
+		Locked:     evt.Type == campaign.EventTypeModerationLocked,
+		Reason:     strings.TrimSpace(source.Reason),
+	}
+	if payload.CampaignID == "" {
+		return nil, nil
+	}
+	if evt.Seq == 0 {
+		return nil, fmt.Errorf("source event sequence is required")
+	}
+	outboxEventID, err := id.NewID()
+	if err != nil {
+		return nil, fmt.Errorf("generate integration outbox event id: %w", err)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s outbox payload: %w", gameintegration.CampaignModerationChangedOutboxEventType, err)
+	}
+	now := evt.Timestamp.UTC()
+	return []storage.IntegrationOutboxEvent{{
+		ID:            outboxEventID,
+		EventType:     gameintegration.CampaignModerationChangedOutboxEventType,
+		PayloadJSON:   string(payloadJSON),
+		DedupeKey:     gameintegration.CampaignModerationChangedDedupeKey(payload.CampaignID, evt.Seq),
+		Status:        storage.IntegrationOutboxStatusPending,
+		AttemptCount:  0,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}}, nil
+}
+
 func buildAIGMTurnRequestedOutboxEvent(evt event.Event) ([]storage.IntegrationOutboxEvent, error) {
 	payload := gameintegration.AIGMTurnRequestedOutboxPayload{
 		CampaignID:      strings.TrimSpace(string(evt.CampaignID)),
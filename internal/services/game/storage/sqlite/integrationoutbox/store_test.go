@@ -2,6 +2,7 @@ package integrationoutbox_test
 
 import (
 	"context"
+	"encoding/json"
 	"path/filepath"
 	"testing"
 	"time"
@@ -11,10 +12,12 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/ids"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/session"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage/integrity"
 	sqliteeventjournal "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/eventjournal"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/integrationoutbox"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/webhooks"
 )
 
 func TestIntegrationOutbox_LeaseRetryAndSucceed(t *testing.T) {
@@ -194,3 +197,61 @@ func testKeyring(t *testing.T) *integrity.Keyring {
 	}
 	return keyring
 }
+
+func TestIntegrationOutbox_EnqueueForEventFansOutToSubscribedWebhooks(t *testing.T) {
+	store, root := openTestIntegrationOutboxStore(t)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	webhookStore := webhooks.Bind(root.DB())
+	for _, endpoint := range []storage.WebhookEndpoint{
+		{ID: "wh-sessions", CampaignID: "camp-1", URL: "https://a.example.com", EventTypes: []string{"session.*"}, SigningSecret: "s1"},
+		{ID: "wh-damage", CampaignID: "camp-1", URL: "https://b.example.com", EventTypes: []string{"sys.daggerheart.damage_applied"}, SigningSecret: "s2"},
+		{ID: "wh-other-campaign", CampaignID: "camp-2", URL: "https://c.example.com", EventTypes: []string{"session.*"}, SigningSecret: "s3"},
+	} {
+		if err := webhookStore.PutWebhookEndpoint(context.Background(), endpoint); err != nil {
+			t.Fatalf("put webhook %s: %v", endpoint.ID, err)
+		}
+	}
+	sourceEvent := event.Event{
+		CampaignID:  ids.CampaignID("camp-1"),
+		Seq:         12,
+		SessionID:   ids.SessionID("sess-1"),
+		Type:        session.EventTypeStarted,
+		Timestamp:   now,
+		PayloadJSON: []byte(`{"session_id":"sess-1","session_name":"One"}`),
+	}
+
+	for range 2 {
+		tx, err := root.DB().BeginTx(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("begin tx: %v", err)
+		}
+		if err := integrationoutbox.EnqueueForEvent(context.Background(), tx, sourceEvent); err != nil {
+			_ = tx.Rollback()
+			t.Fatalf("enqueue for event: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatalf("commit tx: %v", err)
+		}
+	}
+
+	leased, err := store.LeaseIntegrationOutboxEvents(context.Background(), "worker-1", 10, now, time.Minute)
+	if err != nil {
+		t.Fatalf("lease integration outbox events: %v", err)
+	}
+	if len(leased) != 1 {
+		t.Fatalf("leased len = %d, want 1 delivery for the subscribed endpoint", len(leased))
+	}
+	if leased[0].EventType != gameintegration.WebhookDeliveryRequestedOutboxEventType {
+		t.Fatalf("event type = %q, want %q", leased[0].EventType, gameintegration.WebhookDeliveryRequestedOutboxEventType)
+	}
+	var payload gameintegration.WebhookDeliveryOutboxPayload
+	if err := json.Unmarshal([]byte(leased[0].PayloadJSON), &payload); err != nil {
+		t.Fatalf("decode delivery payload: %v", err)
+	}
+	if payload.WebhookID != "wh-sessions" || payload.Event.Seq != 12 || payload.Event.Type != string(session.EventTypeStarted) {
+		t.Fatalf("delivery payload = %+v, want wh-sessions for seq 12", payload)
+	}
+	if string(payload.Event.Payload) != `{"session_id":"sess-1","session_name":"One"}` {
+		t.Fatalf("delivered event payload = %s", payload.Event.Payload)
+	}
+}
@@ -0,0 +1,93 @@
+package integrationoutbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/event"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	sqlitewebhooks "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/webhooks"
+)
+
+// webhookDeliveryOutboxEventsForEvent fans one appended event out to every
+// campaign webhook subscribed to its type, one outbox row per endpoint so
+// each endpoint retries and dead-letters independently.
+func webhookDeliveryOutboxEventsForEvent(ctx context.Context, tx *sql.Tx, evt event.Event) ([]storage.IntegrationOutboxEvent, error) {
+	if tx == nil {
+		return nil, nil
+	}
+	webhookIDs, err := sqlitewebhooks.SubscribedWebhookIDs(ctx, tx, string(evt.CampaignID), string(evt.Type))
+	if err != nil {
+		return nil, err
+	}
+	if len(webhookIDs) == 0 {
+		return nil, nil
+	}
+	if evt.Seq == 0 {
+		return nil, fmt.Errorf("source event sequence is required")
+	}
+	body := webhookEventFromJournal(evt)
+	outboxEvents := make([]storage.IntegrationOutboxEvent, 0, len(webhookIDs))
+	for _, webhookID := range webhookIDs {
+		outboxEvent, err := buildWebhookDeliveryOutboxEvent(webhookID, body,
+			gameintegration.WebhookDeliveryDedupeKey(webhookID, body.CampaignID, evt.Seq))
+		if err != nil {
+			return nil, err
+		}
+		outboxEvents = append(outboxEvents, outboxEvent)
+	}
+	return outboxEvents, nil
+}
+
+// webhookEventFromJournal maps a journal event onto the webhook body.
+func webhookEventFromJournal(evt event.Event) gameintegration.WebhookEvent {
+	body := gameintegration.WebhookEvent{
+		CampaignID: strings.TrimSpace(string(evt.CampaignID)),
+		Seq:        evt.Seq,
+		Type:       string(evt.Type),
+		Timestamp:  evt.Timestamp.UTC(),
+		SessionID:  evt.SessionID.String(),
+		SceneID:    evt.SceneID.String(),
+		ActorType:  string(evt.ActorType),
+		ActorID:    evt.ActorID,
+		EntityType: evt.EntityType,
+		EntityID:   evt.EntityID,
+	}
+	if json.Valid(evt.PayloadJSON) {
+		body.Payload = json.RawMessage(evt.PayloadJSON)
+	}
+	return body
+}
+
+// buildWebhookDeliveryOutboxEvent wraps one webhook body for one endpoint as
+// a pending outbox row due at the event timestamp.
+func buildWebhookDeliveryOutboxEvent(webhookID string, body gameintegration.WebhookEvent, dedupeKey string) (storage.IntegrationOutboxEvent, error) {
+	outboxEventID, err := id.NewID()
+	if err != nil {
+		return storage.IntegrationOutboxEvent{}, fmt.Errorf("generate integration outbox event id: %w", err)
+	}
+	payloadJSON, err := json.Marshal(gameintegration.WebhookDeliveryOutboxPayload{
+		WebhookID: webhookID,
+		Event:     body,
+	})
+	if err != nil {
+		return storage.IntegrationOutboxEvent{}, fmt.Errorf("marshal %s outbox payload: %w", gameintegration.WebhookDeliveryRequestedOutboxEventType, err)
+	}
+	now := body.Timestamp.UTC()
+	return storage.IntegrationOutboxEvent{
+		ID:            outboxEventID,
+		EventType:     gameintegration.WebhookDeliveryRequestedOutboxEventType,
+		PayloadJSON:   string(payloadJSON),
+		DedupeKey:     dedupeKey,
+		Status:        storage.IntegrationOutboxStatusPending,
+		AttemptCount:  0,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
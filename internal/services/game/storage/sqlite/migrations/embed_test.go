@@ -21,8 +21,8 @@ func TestEventsMigrationsEmbedded(t *testing.T) {
 	}
 	sort.Strings(files)
 
-	if len(files) != 2 || files[0] != "001_events.sql" || files[1] != "002_projection_apply_campaign_leases.sql" {
-		t.Fatalf("events migrations = %v, want [001_events.sql 002_projection_apply_campaign_leases.sql]", files)
+	if len(files) != 3 || files[0] != "001_events.sql" || files[1] != "002_projection_apply_campaign_leases.sql" || files[2] != "003_campaign_webhooks.sql" {
+		t.Fatalf("events migrations = %v, want [001_events.sql 002_projection_apply_campaign_leases.sql 003_campaign_webhooks.sql]", files)
 	}
 }
 
@@ -41,8 +41,8 @@ func TestProjectionMigrationsEmbedded(t *testing.T) {
 	}
 	sort.Strings(files)
 
-	if len(files) != 1 || files[0] != "001_projections.sql" {
-		t.Fatalf("projection migrations = %v, want [001_projections.sql]", files)
+	if len(files) != 2 || files[0] != "001_projections.sql" || files[1] != "002_campaign_moderation.sql" {
+		t.Fatalf("projection migrations = %v, want [001_projections.sql 002_campaign_moderation.sql]", files)
 	}
 }
 
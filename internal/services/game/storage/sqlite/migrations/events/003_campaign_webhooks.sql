@@ -0,0 +1,30 @@
+CREATE TABLE game_campaign_webhooks (
+    id TEXT PRIMARY KEY,
+    campaign_id TEXT NOT NULL,
+    url TEXT NOT NULL,
+    event_types_json TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    signing_secret TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+CREATE INDEX idx_game_campaign_webhooks_campaign
+    ON game_campaign_webhooks (campaign_id, created_at, id);
+
+CREATE TABLE game_webhook_deliveries (
+    id TEXT PRIMARY KEY,
+    webhook_id TEXT NOT NULL,
+    campaign_id TEXT NOT NULL,
+    outbox_event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    event_seq INTEGER NOT NULL DEFAULT 0,
+    attempt INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    response_status INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+    test INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL
+);
+CREATE INDEX idx_game_webhook_deliveries_webhook
+    ON game_webhook_deliveries (webhook_id, created_at DESC, id);
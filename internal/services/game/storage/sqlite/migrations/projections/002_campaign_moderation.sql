@@ -0,0 +1,3 @@
+-- Operator moderation lock state mirrored from campaign.moderation_* events.
+ALTER TABLE campaigns ADD COLUMN moderation_locked INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE campaigns ADD COLUMN moderation_reason TEXT NOT NULL DEFAULT '';
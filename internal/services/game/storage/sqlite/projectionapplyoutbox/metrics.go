@@ -0,0 +1,78 @@
+package projectionapplyoutbox
+
+import (
+	"context"
+	"time"
+
+	platformotel "github.com/louisbranch/fracturing.space/internal/platform/otel"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/projectionapplyoutbox"
+
+// Row outcomes recorded on the processed-rows counter.
+const (
+	rowOutcomeApplied = "applied"
+	rowOutcomeSkipped = "skipped"
+	rowOutcomeRetried = "retried"
+)
+
+var (
+	projectionLag = platformotel.Instrument(otel.Meter(meterName).Float64Histogram(
+		"fracturing.game.projection.lag",
+		metric.WithDescription("Time between an event's journal timestamp and its projection apply."),
+		metric.WithUnit("s"),
+	))
+	rowsProcessed = platformotel.Instrument(otel.Meter(meterName).Int64Counter(
+		"fracturing.game.projection.outbox.processed",
+		metric.WithDescription("Projection-apply outbox rows processed by outcome."),
+		metric.WithUnit("{row}"),
+	))
+)
+
+func recordRowOutcome(ctx context.Context, outcome string) {
+	rowsProcessed.Add(ctx, 1, metric.WithAttributes(attribute.String("outcome", outcome)))
+}
+
+func recordProjectionLag(ctx context.Context, eventTime time.Time) {
+	if eventTime.IsZero() {
+		return
+	}
+	projectionLag.Record(ctx, max(time.Since(eventTime).Seconds(), 0))
+}
+
+// ObserveBacklog registers a gauge reporting outbox depth by status, read from
+// the inspector on every collection. The returned function unregisters it.
+func ObserveBacklog(inspector storage.ProjectionApplyOutboxInspector) (func(), error) {
+	meter := otel.Meter(meterName)
+	backlog, err := meter.Int64ObservableGauge(
+		"fracturing.game.projection.outbox.backlog",
+		metric.WithDescription("Projection-apply outbox rows by status."),
+		metric.WithUnit("{row}"),
+	)
+	if err != nil {
+		return func() {}, err
+	}
+	registration, err := meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		summary, err := inspector.GetProjectionApplyOutboxSummary(ctx)
+		if err != nil {
+			return err
+		}
+		for status, count := range map[string]int{
+			"pending":    summary.PendingCount,
+			"processing": summary.ProcessingCount,
+			"failed":     summary.FailedCount,
+			"dead":       summary.DeadCount,
+		} {
+			observer.ObserveInt64(backlog, int64(count), metric.WithAttributes(attribute.String("status", status)))
+		}
+		return nil
+	}, backlog)
+	if err != nil {
+		return func() {}, err
+	}
+	return func() { _ = registration.Unregister() }, nil
+}
@@ -243,17 +243,22 @@ func (s *Store) processRow(
 	}
 	storedEvent, loadErr := s.eventLoader.GetEventBySeq(ctx, row.CampaignID, row.Seq)
 	if loadErr != nil {
+		recordRowOutcome(ctx, rowOutcomeRetried)
 		return s.scheduleRetry(ctx, row, now, fmt.Sprintf("load event: %v", loadErr))
 	}
 
 	if !s.shouldApplyEvent(storedEvent) {
+		recordRowOutcome(ctx, rowOutcomeSkipped)
 		return s.completeRow(ctx, row)
 	}
 
 	if applyErr := apply(ctx, storedEvent); applyErr != nil {
+		recordRowOutcome(ctx, rowOutcomeRetried)
 		return s.scheduleRetry(ctx, row, now, fmt.Sprintf("apply projection: %v", applyErr))
 	}
 
+	recordRowOutcome(ctx, rowOutcomeApplied)
+	recordProjectionLag(ctx, storedEvent.Timestamp)
 	return s.completeRow(ctx, row)
 }
 
@@ -4,7 +4,7 @@ SELECT
 	(SELECT COUNT(*) FROM participants p WHERE p.campaign_id = c.id) AS participant_count,
 	(SELECT COUNT(*) FROM characters ch WHERE ch.campaign_id = c.id) AS character_count,
 	(SELECT MAX(COALESCE(s.ended_at, s.started_at)) FROM sessions s WHERE s.campaign_id = c.id) AS latest_session_at,
-	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
+	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.moderation_locked, c.moderation_reason, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
 	c.created_at, c.updated_at, c.completed_at, c.archived_at
 FROM campaigns c WHERE c.id = ?;
 
@@ -12,8 +12,8 @@ FROM campaigns c WHERE c.id = ?;
 INSERT INTO campaigns (
 	id, name, locale, game_system, status, gm_mode, intent, access_policy,
 	participant_count, character_count, theme_prompt, cover_asset_id, cover_set_id, ai_agent_id, ai_auth_epoch,
-	created_at, updated_at, completed_at, archived_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	moderation_locked, moderation_reason, created_at, updated_at, completed_at, archived_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
 	name = excluded.name,
 	locale = excluded.locale,
@@ -29,6 +29,8 @@ ON CONFLICT(id) DO UPDATE SET
     cover_set_id = excluded.cover_set_id,
     ai_agent_id = excluded.ai_agent_id,
     ai_auth_epoch = excluded.ai_auth_epoch,
+    moderation_locked = excluded.moderation_locked,
+    moderation_reason = excluded.moderation_reason,
     updated_at = excluded.updated_at,
     completed_at = excluded.completed_at,
     archived_at = excluded.archived_at;
@@ -39,7 +41,7 @@ SELECT
 	(SELECT COUNT(*) FROM participants p WHERE p.campaign_id = c.id) AS participant_count,
 	(SELECT COUNT(*) FROM characters ch WHERE ch.campaign_id = c.id) AS character_count,
 	(SELECT MAX(COALESCE(s.ended_at, s.started_at)) FROM sessions s WHERE s.campaign_id = c.id) AS latest_session_at,
-	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
+	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.moderation_locked, c.moderation_reason, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
 	c.created_at, c.updated_at, c.completed_at, c.archived_at
 FROM campaigns c
 WHERE c.id > ?
@@ -52,7 +54,7 @@ SELECT
 	(SELECT COUNT(*) FROM participants p WHERE p.campaign_id = c.id) AS participant_count,
 	(SELECT COUNT(*) FROM characters ch WHERE ch.campaign_id = c.id) AS character_count,
 	(SELECT MAX(COALESCE(s.ended_at, s.started_at)) FROM sessions s WHERE s.campaign_id = c.id) AS latest_session_at,
-	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
+	c.theme_prompt, c.cover_asset_id, c.cover_set_id, c.ai_agent_id, c.ai_auth_epoch, c.moderation_locked, c.moderation_reason, c.parent_campaign_id, c.fork_event_seq, c.origin_campaign_id,
 	c.created_at, c.updated_at, c.completed_at, c.archived_at
 FROM campaigns c
 ORDER BY c.id
@@ -0,0 +1,10 @@
+// Package webhooks implements the SQLite backend for campaign-owned outgoing
+// webhook endpoints and their delivery log.
+//
+// Why this package exists:
+//   - Endpoint registrations live on the journal database so event append can
+//     fan subscribed events into the integration outbox inside the same
+//     transaction, without a cross-database read.
+//   - It keeps webhook bookkeeping out of the generic integration-outbox
+//     backend, which stays unaware of any one consumer's tables.
+package webhooks
@@ -0,0 +1,506 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteutil"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// Queryer is the read surface shared by *sql.DB and *sql.Tx.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+type webhookScanner func(dest ...any) error
+
+// Store binds webhook persistence to a SQLite connection.
+type Store struct {
+	sqlDB *sql.DB
+}
+
+var (
+	_ storage.WebhookStore       = (*Store)(nil)
+	_ storage.WebhookSecretStore = (*Store)(nil)
+)
+
+// Bind creates a webhook backend bound to the provided SQLite DB.
+func Bind(sqlDB *sql.DB) *Store {
+	if sqlDB == nil {
+		return nil
+	}
+	return &Store{sqlDB: sqlDB}
+}
+
+// SubscribedWebhookIDs returns the campaign endpoints whose event type
+// filters select eventType. Event append calls it inside its transaction, so
+// an endpoint registered after an event committed never receives it.
+func SubscribedWebhookIDs(ctx context.Context, q Queryer, campaignID, eventType string) ([]string, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" || eventType == gameintegration.WebhookTestEventType {
+		return nil, nil
+	}
+	rows, err := q.QueryContext(ctx, `
+SELECT id, event_types_json
+FROM game_campaign_webhooks
+WHERE campaign_id = ?
+ORDER BY created_at, id
+`, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("select campaign webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var (
+			id             string
+			eventTypesJSON string
+		)
+		if err := rows.Scan(&id, &eventTypesJSON); err != nil {
+			return nil, fmt.Errorf("scan campaign webhook: %w", err)
+		}
+		eventTypes, err := decodeEventTypes(eventTypesJSON)
+		if err != nil {
+			return nil, fmt.Errorf("decode webhook %s event types: %w", id, err)
+		}
+		if gameintegration.WebhookSubscribes(eventTypes, eventType) {
+			ids = append(ids, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate campaign webhooks: %w", err)
+	}
+	return ids, nil
+}
+
+// PutWebhookEndpoint inserts a new endpoint.
+func (s *Store) PutWebhookEndpoint(ctx context.Context, endpoint storage.WebhookEndpoint) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	endpoint.ID = strings.TrimSpace(endpoint.ID)
+	endpoint.CampaignID = strings.TrimSpace(endpoint.CampaignID)
+	if endpoint.ID == "" {
+		return fmt.Errorf("webhook id is required")
+	}
+	if endpoint.CampaignID == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(endpoint.URL) == "" {
+		return fmt.Errorf("webhook url is required")
+	}
+	if endpoint.SigningSecret == "" {
+		return fmt.Errorf("webhook signing secret is required")
+	}
+	eventTypesJSON, err := json.Marshal(endpoint.EventTypes)
+	if err != nil {
+		return fmt.Errorf("encode webhook event types: %w", err)
+	}
+	if endpoint.CreatedAt.IsZero() {
+		endpoint.CreatedAt = time.Now().UTC()
+	}
+	if endpoint.UpdatedAt.IsZero() {
+		endpoint.UpdatedAt = endpoint.CreatedAt
+	}
+	_, err = s.sqlDB.ExecContext(ctx, `
+INSERT INTO game_campaign_webhooks (
+	id,
+	campaign_id,
+	url,
+	event_types_json,
+	description,
+	signing_secret,
+	created_at,
+	updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`,
+		endpoint.ID,
+		endpoint.CampaignID,
+		endpoint.URL,
+		string(eventTypesJSON),
+		endpoint.Description,
+		endpoint.SigningSecret,
+		sqliteutil.ToMillis(endpoint.CreatedAt.UTC()),
+		sqliteutil.ToMillis(endpoint.UpdatedAt.UTC()),
+	)
+	if err != nil {
+		return fmt.Errorf("put webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// GetWebhookEndpoint loads one endpoint by id.
+func (s *Store) GetWebhookEndpoint(ctx context.Context, webhookID string) (storage.WebhookEndpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.WebhookEndpoint{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.WebhookEndpoint{}, fmt.Errorf("storage is not configured")
+	}
+	webhookID = strings.TrimSpace(webhookID)
+	if webhookID == "" {
+		return storage.WebhookEndpoint{}, fmt.Errorf("webhook id is required")
+	}
+	row := s.sqlDB.QueryRowContext(ctx, `
+SELECT id, campaign_id, url, event_types_json, description, signing_secret, created_at, updated_at
+FROM game_campaign_webhooks
+WHERE id = ?
+`, webhookID)
+	endpoint, err := scanWebhookEndpoint(row.Scan)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.WebhookEndpoint{}, storage.ErrNotFound
+		}
+		return storage.WebhookEndpoint{}, fmt.Errorf("get webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+// ListWebhookEndpoints returns a campaign's endpoints oldest first.
+func (s *Store) ListWebhookEndpoints(ctx context.Context, campaignID string) ([]storage.WebhookEndpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return nil, fmt.Errorf("campaign id is required")
+	}
+	rows, err := s.sqlDB.QueryContext(ctx, `
+SELECT id, campaign_id, url, event_types_json, description, signing_secret, created_at, updated_at
+FROM game_campaign_webhooks
+WHERE campaign_id = ?
+ORDER BY created_at, id
+`, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	endpoints := make([]storage.WebhookEndpoint, 0)
+	for rows.Next() {
+		endpoint, err := scanWebhookEndpoint(rows.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("scan webhook endpoint: %w", err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint and its delivery log. Deliveries
+// already queued in the outbox are dropped by the worker once the endpoint
+// lookup fails.
+func (s *Store) DeleteWebhookEndpoint(ctx context.Context, campaignID, webhookID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	webhookID = strings.TrimSpace(webhookID)
+	if campaignID == "" || webhookID == "" {
+		return fmt.Errorf("campaign id and webhook id are required")
+	}
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start delete webhook transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, `
+DELETE FROM game_campaign_webhooks
+WHERE id = ? AND campaign_id = ?
+`, webhookID, campaignID)
+	if err != nil {
+		return fmt.Errorf("delete webhook endpoint: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete webhook rows affected: %w", err)
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM game_webhook_deliveries WHERE webhook_id = ?`, webhookID); err != nil {
+		return fmt.Errorf("delete webhook deliveries: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit delete webhook transaction: %w", err)
+	}
+	return nil
+}
+
+// RecordWebhookDelivery appends one delivery-log row.
+func (s *Store) RecordWebhookDelivery(ctx context.Context, delivery storage.WebhookDelivery) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	delivery.ID = strings.TrimSpace(delivery.ID)
+	delivery.WebhookID = strings.TrimSpace(delivery.WebhookID)
+	if delivery.ID == "" {
+		return fmt.Errorf("delivery id is required")
+	}
+	if delivery.WebhookID == "" {
+		return fmt.Errorf("webhook id is required")
+	}
+	switch delivery.Status {
+	case storage.WebhookDeliveryStatusSucceeded, storage.WebhookDeliveryStatusFailed, storage.WebhookDeliveryStatusDead:
+	default:
+		return fmt.Errorf("delivery status %q is invalid", delivery.Status)
+	}
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now().UTC()
+	}
+	test := 0
+	if delivery.Test {
+		test = 1
+	}
+	_, err := s.sqlDB.ExecContext(ctx, `
+INSERT INTO game_webhook_deliveries (
+	id,
+	webhook_id,
+	campaign_id,
+	outbox_event_id,
+	event_type,
+	event_seq,
+	attempt,
+	status,
+	response_status,
+	error,
+	duration_ms,
+	test,
+	created_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`,
+		delivery.ID,
+		delivery.WebhookID,
+		strings.TrimSpace(delivery.CampaignID),
+		strings.TrimSpace(delivery.OutboxEventID),
+		strings.TrimSpace(delivery.EventType),
+		int64(delivery.EventSeq),
+		delivery.Attempt,
+		delivery.Status,
+		delivery.ResponseStatus,
+		delivery.Error,
+		delivery.Duration.Milliseconds(),
+		test,
+		sqliteutil.ToMillis(delivery.CreatedAt.UTC()),
+	)
+	if err != nil {
+		return fmt.Errorf("record webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries returns an endpoint's most recent attempts first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, webhookID string, limit int) ([]storage.WebhookDelivery, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+	webhookID = strings.TrimSpace(webhookID)
+	if webhookID == "" {
+		return nil, fmt.Errorf("webhook id is required")
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than zero")
+	}
+	rows, err := s.sqlDB.QueryContext(ctx, `
+SELECT
+	id,
+	webhook_id,
+	campaign_id,
+	outbox_event_id,
+	event_type,
+	event_seq,
+	attempt,
+	status,
+	response_status,
+	error,
+	duration_ms,
+	test,
+	created_at
+FROM game_webhook_deliveries
+WHERE webhook_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ?
+`, webhookID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]storage.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		var (
+			delivery    storage.WebhookDelivery
+			eventSeq    int64
+			durationMS  int64
+			test        int
+			createdAtMS int64
+		)
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.CampaignID,
+			&delivery.OutboxEventID,
+			&delivery.EventType,
+			&eventSeq,
+			&delivery.Attempt,
+			&delivery.Status,
+			&delivery.ResponseStatus,
+			&delivery.Error,
+			&durationMS,
+			&test,
+			&createdAtMS,
+		); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		delivery.EventSeq = uint64(eventSeq)
+		delivery.Duration = time.Duration(durationMS) * time.Millisecond
+		delivery.Test = test != 0
+		delivery.CreatedAt = sqliteutil.FromMillis(createdAtMS)
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// ListWebhookSecretCiphertexts returns stored signing secrets ordered by
+// webhook ID, starting after afterID.
+func (s *Store) ListWebhookSecretCiphertexts(ctx context.Context, afterID string, limit int) ([]storage.WebhookSealedSecret, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than zero")
+	}
+
+	rows, err := s.sqlDB.QueryContext(ctx, `
+SELECT id, signing_secret
+FROM game_campaign_webhooks
+WHERE id > ?
+ORDER BY id
+LIMIT ?
+`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook secret ciphertexts: %w", err)
+	}
+	defer rows.Close()
+
+	values := make([]storage.WebhookSealedSecret, 0, limit)
+	for rows.Next() {
+		var value storage.WebhookSealedSecret
+		if err := rows.Scan(&value.ID, &value.Ciphertext); err != nil {
+			return nil, fmt.Errorf("scan webhook secret ciphertext: %w", err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook secret ciphertexts: %w", err)
+	}
+	return values, nil
+}
+
+// ReplaceWebhookSecretCiphertext swaps one endpoint's stored signing secret
+// when it still matches current. UpdatedAt is left alone because the sealed
+// plaintext is unchanged.
+func (s *Store) ReplaceWebhookSecretCiphertext(ctx context.Context, webhookID string, current string, replacement string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(webhookID) == "" {
+		return fmt.Errorf("webhook id is required")
+	}
+	if replacement == "" {
+		return fmt.Errorf("replacement ciphertext is required")
+	}
+
+	res, err := s.sqlDB.ExecContext(ctx, `
+UPDATE game_campaign_webhooks
+SET signing_secret = ?
+WHERE id = ? AND signing_secret = ?
+`, replacement, webhookID, current)
+	if err != nil {
+		return fmt.Errorf("replace webhook secret ciphertext: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("replace webhook secret ciphertext rows affected: %w", err)
+	}
+	if affected == 0 {
+		return storage.ErrConflict
+	}
+	return nil
+}
+
+func scanWebhookEndpoint(scan webhookScanner) (storage.WebhookEndpoint, error) {
+	var (
+		endpoint       storage.WebhookEndpoint
+		eventTypesJSON string
+		createdAtMS    int64
+		updatedAtMS    int64
+	)
+	if err := scan(
+		&endpoint.ID,
+		&endpoint.CampaignID,
+		&endpoint.URL,
+		&eventTypesJSON,
+		&endpoint.Description,
+		&endpoint.SigningSecret,
+		&createdAtMS,
+		&updatedAtMS,
+	); err != nil {
+		return storage.WebhookEndpoint{}, err
+	}
+	eventTypes, err := decodeEventTypes(eventTypesJSON)
+	if err != nil {
+		return storage.WebhookEndpoint{}, err
+	}
+	endpoint.EventTypes = eventTypes
+	endpoint.CreatedAt = sqliteutil.FromMillis(createdAtMS)
+	endpoint.UpdatedAt = sqliteutil.FromMillis(updatedAtMS)
+	return endpoint, nil
+}
+
+func decodeEventTypes(raw string) ([]string, error) {
+	var eventTypes []string
+	if err := json.Unmarshal([]byte(raw), &eventTypes); err != nil {
+		return nil, err
+	}
+	return eventTypes, nil
+}
@@ -0,0 +1,188 @@
+package webhooks_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/engine"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/integrity"
+	sqliteeventjournal "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/eventjournal"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/webhooks"
+)
+
+func TestWebhookStore_EndpointLifecycle(t *testing.T) {
+	store, root := openTestWebhookStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	endpoint := storage.WebhookEndpoint{
+		ID:            "wh-1",
+		CampaignID:    "camp-1",
+		URL:           "https://hooks.example.com/fs",
+		EventTypes:    []string{"session.*", "sys.daggerheart.damage_applied"},
+		Description:   "table bot",
+		SigningSecret: "whsec_test",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := store.PutWebhookEndpoint(ctx, endpoint); err != nil {
+		t.Fatalf("put webhook endpoint: %v", err)
+	}
+
+	got, err := store.GetWebhookEndpoint(ctx, "wh-1")
+	if err != nil {
+		t.Fatalf("get webhook endpoint: %v", err)
+	}
+	if got.URL != endpoint.URL || got.SigningSecret != endpoint.SigningSecret || len(got.EventTypes) != 2 || !got.CreatedAt.Equal(now) {
+		t.Fatalf("endpoint = %+v, want %+v", got, endpoint)
+	}
+	listed, err := store.ListWebhookEndpoints(ctx, "camp-1")
+	if err != nil {
+		t.Fatalf("list webhook endpoints: %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != "wh-1" {
+		t.Fatalf("listed = %+v, want wh-1", listed)
+	}
+
+	for eventType, want := range map[string]int{
+		"session.started":                 1,
+		"sys.daggerheart.damage_applied":  1,
+		"sys.daggerheart.gm_fear_changed": 0,
+		"scene.created":                   0,
+	} {
+		ids, err := webhooks.SubscribedWebhookIDs(ctx, root.DB(), "camp-1", eventType)
+		if err != nil {
+			t.Fatalf("subscribed webhook ids for %s: %v", eventType, err)
+		}
+		if len(ids) != want {
+			t.Fatalf("subscribed ids for %s = %v, want %d", eventType, ids, want)
+		}
+	}
+
+	for attempt, status := range []storage.WebhookDeliveryStatus{storage.WebhookDeliveryStatusFailed, storage.WebhookDeliveryStatusSucceeded} {
+		err := store.RecordWebhookDelivery(ctx, storage.WebhookDelivery{
+			ID:             "attempt-" + string(status),
+			WebhookID:      "wh-1",
+			CampaignID:     "camp-1",
+			OutboxEventID:  "outbox-1",
+			EventType:      "session.started",
+			EventSeq:       7,
+			Attempt:        attempt + 1,
+			Status:         status,
+			ResponseStatus: 500 - attempt*300,
+			Duration:       120 * time.Millisecond,
+			CreatedAt:      now.Add(time.Duration(attempt) * time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("record webhook delivery %d: %v", attempt, err)
+		}
+	}
+	deliveries, err := store.ListWebhookDeliveries(ctx, "wh-1", 10)
+	if err != nil {
+		t.Fatalf("list webhook deliveries: %v", err)
+	}
+	if len(deliveries) != 2 {
+		t.Fatalf("deliveries len = %d, want 2", len(deliveries))
+	}
+	if deliveries[0].Status != storage.WebhookDeliveryStatusSucceeded || deliveries[0].Attempt != 2 || deliveries[0].ResponseStatus != 200 {
+		t.Fatalf("newest delivery = %+v, want succeeded attempt 2", deliveries[0])
+	}
+	if deliveries[1].Duration != 120*time.Millisecond {
+		t.Fatalf("duration = %v, want 120ms", deliveries[1].Duration)
+	}
+
+	if err := store.DeleteWebhookEndpoint(ctx, "camp-2", "wh-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("delete from other campaign err = %v, want not found", err)
+	}
+	if err := store.DeleteWebhookEndpoint(ctx, "camp-1", "wh-1"); err != nil {
+		t.Fatalf("delete webhook endpoint: %v", err)
+	}
+	if _, err := store.GetWebhookEndpoint(ctx, "wh-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("get deleted endpoint err = %v, want not found", err)
+	}
+	deliveries, err = store.ListWebhookDeliveries(ctx, "wh-1", 10)
+	if err != nil {
+		t.Fatalf("list deliveries after delete: %v", err)
+	}
+	if len(deliveries) != 0 {
+		t.Fatalf("deliveries after delete = %d, want 0", len(deliveries))
+	}
+}
+
+func TestWebhookStore_SecretCiphertexts(t *testing.T) {
+	store, _ := openTestWebhookStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	for _, id := range []string{"wh-2", "wh-1", "wh-3"} {
+		err := store.PutWebhookEndpoint(ctx, storage.WebhookEndpoint{
+			ID:            id,
+			CampaignID:    "camp-1",
+			URL:           "https://hooks.example.com/" + id,
+			EventTypes:    []string{"session.*"},
+			SigningSecret: "v1:old:" + id,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			t.Fatalf("put webhook endpoint %s: %v", id, err)
+		}
+	}
+
+	page, err := store.ListWebhookSecretCiphertexts(ctx, "", 2)
+	if err != nil {
+		t.Fatalf("list secret ciphertexts: %v", err)
+	}
+	if len(page) != 2 || page[0].ID != "wh-1" || page[1].ID != "wh-2" || page[0].Ciphertext != "v1:old:wh-1" {
+		t.Fatalf("first page = %+v, want wh-1, wh-2", page)
+	}
+	page, err = store.ListWebhookSecretCiphertexts(ctx, "wh-2", 2)
+	if err != nil {
+		t.Fatalf("list secret ciphertexts after wh-2: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "wh-3" {
+		t.Fatalf("second page = %+v, want wh-3", page)
+	}
+
+	if err := store.ReplaceWebhookSecretCiphertext(ctx, "wh-1", "v1:old:wh-1", "v1:new:wh-1"); err != nil {
+		t.Fatalf("replace secret ciphertext: %v", err)
+	}
+	got, err := store.GetWebhookEndpoint(ctx, "wh-1")
+	if err != nil {
+		t.Fatalf("get webhook endpoint: %v", err)
+	}
+	if got.SigningSecret != "v1:new:wh-1" || !got.UpdatedAt.Equal(now) {
+		t.Fatalf("endpoint = %+v, want replaced secret with unchanged updated_at", got)
+	}
+	if err := store.ReplaceWebhookSecretCiphertext(ctx, "wh-1", "v1:old:wh-1", "v1:other:wh-1"); !errors.Is(err, storage.ErrConflict) {
+		t.Fatalf("stale replace err = %v, want conflict", err)
+	}
+}
+
+func openTestWebhookStore(t *testing.T) (*webhooks.Store, *sqliteeventjournal.Store) {
+	t.Helper()
+	registries, err := engine.BuildRegistries(daggerheart.NewModule())
+	if err != nil {
+		t.Fatalf("build registries: %v", err)
+	}
+	keyring, err := integrity.NewKeyring(
+		map[string][]byte{"test-key-1": []byte("0123456789abcdef0123456789abcdef")},
+		"test-key-1",
+	)
+	if err != nil {
+		t.Fatalf("create test keyring: %v", err)
+	}
+	root, err := sqliteeventjournal.Open(filepath.Join(t.TempDir(), "events.sqlite"), keyring, registries.Events)
+	if err != nil {
+		t.Fatalf("open events store: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := root.Close(); err != nil {
+			t.Fatalf("close events store: %v", err)
+		}
+	})
+	return webhooks.Bind(root.DB()), root
+}
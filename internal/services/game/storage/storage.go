@@ -1,12 +1,37 @@
 package storage
 
-import apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+)
 
 // ErrNotFound indicates a requested persistence record is missing.
 // Callers use this to differentiate between legitimate "no such entity" states
 // and transport or data corruption failures.
 var ErrNotFound = apperrors.New(apperrors.CodeNotFound, "record not found")
 
+// ErrConflict indicates a compare-and-swap write found the record changed
+// since the caller read it.
+var ErrConflict = errors.New("record conflict")
+
 // ErrActiveSessionExists indicates a command tried to start a second active session
 // for the same campaign, which would violate the single-active-session domain rule.
 var ErrActiveSessionExists = apperrors.New(apperrors.CodeActiveSessionExists, "active session already exists for campaign")
+
+// NewExpectedSeqMismatchError reports that a conditional append found the
+// campaign journal head at currentSeq instead of the caller's expectedSeq.
+// The metadata carries both seqs so clients can reload and retry.
+func NewExpectedSeqMismatchError(campaignID string, expectedSeq, currentSeq uint64) error {
+	return apperrors.WithMetadata(
+		apperrors.CodeExpectedSeqMismatch,
+		fmt.Sprintf("campaign %s head seq is %d, expected %d", campaignID, currentSeq, expectedSeq),
+		map[string]string{
+			"CampaignID":  campaignID,
+			"ExpectedSeq": strconv.FormatUint(expectedSeq, 10),
+			"CurrentSeq":  strconv.FormatUint(currentSeq, 10),
+		},
+	)
+}
@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/platform/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+)
+
+// bearerChallenge is sent with 401 responses per RFC 6750.
+const bearerChallenge = `Bearer realm="fracturing.space"`
+
+// APIScope is the OAuth scope a token must carry to call the public API.
+// Tokens minted for the web session or other surfaces lack it.
+const APIScope = "game:api"
+
+// requireBearer authenticates API requests with an OAuth access token from
+// the Authorization header, validated through auth service introspection.
+//
+// Unlike the admin cookie flow there is no login redirect: API clients get a
+// 401 with a bearer challenge and are expected to run the OAuth flow
+// themselves. Tokens issued to a client outside the requirement are invalid
+// here; tokens without the API scope get 403 insufficient_scope.
+func requireBearer(next http.Handler, introspector authctx.Introspector, requirement authctx.TokenRequirement) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			writeUnauthorized(w, "", "bearer access token is required")
+			return
+		}
+		if introspector == nil {
+			writeErrorBody(w, http.StatusServiceUnavailable, apperrors.HTTPError{Code: "UNAVAILABLE", Message: "token validation is not configured"})
+			return
+		}
+		result, err := introspector.Introspect(r.Context(), token)
+		if err != nil {
+			log.Printf("gateway auth introspect error: %v", err)
+			writeErrorBody(w, http.StatusServiceUnavailable, apperrors.HTTPError{Code: "UNAVAILABLE", Message: "token validation is unavailable"})
+			return
+		}
+		userID := strings.TrimSpace(result.UserID)
+		if !result.Active || userID == "" || !requirement.AllowsClient(result) {
+			writeUnauthorized(w, "invalid_token", "access token is invalid or expired")
+			return
+		}
+		if !requirement.AllowsScope(result) {
+			writeInsufficientScope(w, requirement.Scope)
+			return
+		}
+		ctx := requestctx.WithUserID(r.Context(), userID)
+		ctx = context.WithValue(ctx, clientIDKey{}, strings.TrimSpace(result.ClientID))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// clientIDKey carries the introspected OAuth client ID on the request context.
+type clientIDKey struct{}
+
+func clientIDFromContext(ctx context.Context) string {
+	clientID, _ := ctx.Value(clientIDKey{}).(string)
+	return clientID
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func writeUnauthorized(w http.ResponseWriter, oauthError, message string) {
+	challenge := bearerChallenge
+	if oauthError != "" {
+		challenge += `, error="` + oauthError + `"`
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+	writeErrorBody(w, http.StatusUnauthorized, apperrors.HTTPError{Code: "UNAUTHENTICATED", Message: message})
+}
+
+func writeInsufficientScope(w http.ResponseWriter, scope string) {
+	w.Header().Set("WWW-Authenticate", bearerChallenge+`, error="insufficient_scope", scope="`+scope+`"`)
+	writeErrorBody(w, http.StatusForbidden, apperrors.HTTPError{Code: "PERMISSION_DENIED", Message: "access token lacks the " + scope + " scope"})
+}
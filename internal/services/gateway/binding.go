@@ -0,0 +1,226 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// maxRequestBodyBytes bounds JSON request bodies.
+const maxRequestBodyBytes = 1 << 20
+
+var pathWildcardPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// boundRoute is a route resolved against the registered proto descriptors.
+type boundRoute struct {
+	route
+	input      protoreflect.MessageType
+	output     protoreflect.MessageType
+	pathFields []protoreflect.FieldDescriptor
+}
+
+// bindRoutes resolves every route's RPC and path wildcards up front so a typo
+// in the route table fails at startup instead of on the first request.
+func bindRoutes(table []route) ([]boundRoute, error) {
+	bound := make([]boundRoute, 0, len(table))
+	seen := make(map[string]struct{}, len(table))
+	for _, rt := range table {
+		key := rt.method + " " + rt.path
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("duplicate gateway route %s", key)
+		}
+		seen[key] = struct{}{}
+
+		method, err := findMethod(rt.rpc)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %w", key, err)
+		}
+		if method.IsStreamingClient() || method.IsStreamingServer() {
+			return nil, fmt.Errorf("route %s: streaming rpc %s is not supported", key, rt.rpc)
+		}
+		input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+		if err != nil {
+			return nil, fmt.Errorf("route %s: input type: %w", key, err)
+		}
+		output, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+		if err != nil {
+			return nil, fmt.Errorf("route %s: output type: %w", key, err)
+		}
+		b := boundRoute{route: rt, input: input, output: output}
+		for _, match := range pathWildcardPattern.FindAllStringSubmatch(rt.path, -1) {
+			field := input.Descriptor().Fields().ByName(protoreflect.Name(match[1]))
+			if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+				return nil, fmt.Errorf("route %s: path wildcard %q is not a string field of %s", key, match[1], input.Descriptor().FullName())
+			}
+			b.pathFields = append(b.pathFields, field)
+		}
+		bound = append(bound, b)
+	}
+	return bound, nil
+}
+
+// findMethod resolves a gRPC full method name ("/pkg.Service/Method").
+func findMethod(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	trimmed := strings.TrimPrefix(fullMethod, "/")
+	serviceName, methodName, ok := strings.Cut(trimmed, "/")
+	if !ok {
+		return nil, fmt.Errorf("malformed rpc name %q", fullMethod)
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, fmt.Errorf("find service %s: %w", serviceName, err)
+	}
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", serviceName)
+	}
+	method := service.Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return nil, fmt.Errorf("service %s has no method %s", serviceName, methodName)
+	}
+	return method, nil
+}
+
+// decodeRequest builds the RPC request from the HTTP request: JSON body for
+// write routes, query string for read routes, then path wildcards on top.
+func (b boundRoute) decodeRequest(r *http.Request) (proto.Message, error) {
+	msg := b.input.New()
+	if b.hasBody() {
+		body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxRequestBodyBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return nil, fmt.Errorf("request body exceeds %d bytes", maxRequestBodyBytes)
+			}
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		if len(strings.TrimSpace(string(body))) > 0 {
+			if err := protojson.Unmarshal(body, msg.Interface()); err != nil {
+				return nil, fmt.Errorf("decode request body: %w", err)
+			}
+		}
+	} else if err := b.bindQuery(msg, r); err != nil {
+		return nil, err
+	}
+	for _, field := range b.pathFields {
+		msg.Set(field, protoreflect.ValueOfString(r.PathValue(string(field.Name()))))
+	}
+	return msg.Interface(), nil
+}
+
+// bindQuery sets top-level scalar fields from query parameters. Unknown
+// parameters are rejected so typos do not silently return unfiltered data.
+func (b boundRoute) bindQuery(msg protoreflect.Message, r *http.Request) error {
+	fields := msg.Descriptor().Fields()
+	for key, values := range r.URL.Query() {
+		field := fields.ByName(protoreflect.Name(key))
+		if field == nil {
+			field = fields.ByJSONName(key)
+		}
+		if field == nil || b.isPathField(field) || !isQueryBindable(field) {
+			return fmt.Errorf("unknown query parameter %q", key)
+		}
+		if !field.IsList() && len(values) > 1 {
+			return fmt.Errorf("query parameter %q must be set once", key)
+		}
+		for _, raw := range values {
+			value, err := parseScalar(field, raw)
+			if err != nil {
+				return fmt.Errorf("query parameter %q: %w", key, err)
+			}
+			if field.IsList() {
+				msg.Mutable(field).List().Append(value)
+				continue
+			}
+			msg.Set(field, value)
+		}
+	}
+	return nil
+}
+
+func (b boundRoute) isPathField(field protoreflect.FieldDescriptor) bool {
+	for _, pathField := range b.pathFields {
+		if pathField.Number() == field.Number() {
+			return true
+		}
+	}
+	return false
+}
+
+// queryFields lists the request fields a read route accepts as query
+// parameters, in declaration order.
+func (b boundRoute) queryFields() []protoreflect.FieldDescriptor {
+	if b.hasBody() {
+		return nil
+	}
+	fields := b.input.Descriptor().Fields()
+	result := make([]protoreflect.FieldDescriptor, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if b.isPathField(field) || !isQueryBindable(field) {
+			continue
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+func isQueryBindable(field protoreflect.FieldDescriptor) bool {
+	if field.IsMap() {
+		return false
+	}
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+		return false
+	default:
+		return true
+	}
+}
+
+func parseScalar(field protoreflect.FieldDescriptor, raw string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(raw), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(raw)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(raw, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(raw, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(raw, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(raw, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.EnumKind:
+		if value := field.Enum().Values().ByName(protoreflect.Name(raw)); value != nil {
+			return protoreflect.ValueOfEnum(value.Number()), nil
+		}
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || field.Enum().Values().ByNumber(protoreflect.EnumNumber(v)) == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown %s value %q", field.Enum().Name(), raw)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+}
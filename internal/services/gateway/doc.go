@@ -0,0 +1,11 @@
+// Package gateway serves the public HTTP/JSON API over a curated subset of the
+// game gRPC services.
+//
+// Each route in the route table maps one HTTP operation onto one unary RPC.
+// Requests are decoded with protojson (proto field names), authenticated with
+// OAuth bearer tokens through auth-service introspection, and forwarded to
+// game as the token's user. Failures use the platform HTTP error contract from
+// internal/platform/errors. The OpenAPI document is generated from the same
+// route table and proto descriptors, served at /openapi.json, and checked in
+// under api/openapi.
+package gateway
@@ -0,0 +1,146 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	platformi18n "github.com/louisbranch/fracturing.space/internal/platform/i18n"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+	"github.com/louisbranch/fracturing.space/internal/platform/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Public non-API paths.
+const (
+	healthPath  = "/up"
+	openAPIPath = "/openapi.json"
+)
+
+var responseMarshal = protojson.MarshalOptions{UseProtoNames: true}
+
+// NewHandler builds the gateway HTTP handler. conn carries the game RPCs and
+// introspector validates OAuth bearer tokens issued by the auth service.
+// Tokens must carry APIScope; a non-empty allowedClientIDs further limits
+// them to those OAuth clients. limiter throttles API routes per user and
+// client; nil disables throttling.
+func NewHandler(conn grpc.ClientConnInterface, introspector authctx.Introspector, allowedClientIDs []string, limiter *ratelimit.Limiter) (http.Handler, error) {
+	bound, err := bindRoutes(routes)
+	if err != nil {
+		return nil, err
+	}
+	document, err := OpenAPIDocument()
+	if err != nil {
+		return nil, err
+	}
+
+	api := http.NewServeMux()
+	for _, rt := range bound {
+		api.Handle(rt.method+" "+rt.path, rateLimited(limiter, rt, rpcHandler(conn, rt)))
+	}
+	api.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeErrorBody(w, http.StatusNotFound, apperrors.HTTPError{Code: "NOT_FOUND", Message: "no such API route"})
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+healthPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET "+openAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	})
+	mux.Handle("/v1/", requireBearer(api, introspector, authctx.TokenRequirement{Scope: APIScope, ClientIDs: allowedClientIDs}))
+	return mux, nil
+}
+
+// rpcHandler invokes one unary RPC as the authenticated user.
+func rpcHandler(conn grpc.ClientConnInterface, rt boundRoute) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := rt.decodeRequest(r)
+		if err != nil {
+			writeGRPCError(w, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		ctx, err := outgoingContext(r)
+		if err != nil {
+			writeGRPCError(w, err)
+			return
+		}
+		resp := rt.output.New().Interface()
+		if err := conn.Invoke(ctx, rt.rpc, req, resp); err != nil {
+			writeGRPCError(w, err)
+			return
+		}
+		writeProto(w, resp)
+	})
+}
+
+// outgoingContext carries the caller identity, locale, and optional If-Match
+// precondition to game as gRPC metadata.
+func outgoingContext(r *http.Request) (context.Context, error) {
+	ctx := grpcauthctx.WithUserID(r.Context(), requestctx.UserIDFromContext(r.Context()))
+	ctx = metadata.AppendToOutgoingContext(ctx, grpcmeta.LocaleHeader, requestLocale(r))
+	if raw := strings.TrimSpace(r.Header.Get("If-Match")); raw != "" {
+		seq := strings.Trim(strings.TrimPrefix(raw, "W/"), `"`)
+		if _, err := strconv.ParseUint(seq, 10, 64); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "If-Match must be a campaign event sequence number")
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, grpcmeta.ExpectedSeqHeader, seq)
+	}
+	return ctx, nil
+}
+
+// requestLocale picks the best supported locale from Accept-Language.
+func requestLocale(r *http.Request) string {
+	if accept := strings.TrimSpace(r.Header.Get("Accept-Language")); accept != "" {
+		if tags, _, err := language.ParseAcceptLanguage(accept); err == nil {
+			return platformi18n.MatchTags(tags).String()
+		}
+	}
+	return grpcmeta.DefaultLocale
+}
+
+func writeProto(w http.ResponseWriter, msg proto.Message) {
+	body, err := responseMarshal.Marshal(msg)
+	if err != nil {
+		log.Printf("gateway: encode response: %v", err)
+		writeGRPCError(w, status.Error(codes.Internal, "encode response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// writeGRPCError renders a gRPC failure with the shared platform error
+// contract.
+func writeGRPCError(w http.ResponseWriter, err error) {
+	httpStatus, body := apperrors.HTTPErrorFromGRPC(err)
+	writeErrorBody(w, httpStatus, body)
+}
+
+func writeErrorBody(w http.ResponseWriter, httpStatus int, body apperrors.HTTPError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(errorEnvelope{Error: body})
+}
+
+// errorEnvelope wraps error bodies so success and failure payloads never
+// share top-level keys.
+type errorEnvelope struct {
+	Error apperrors.HTTPError `json:"error"`
+}
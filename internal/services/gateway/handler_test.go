@@ -0,0 +1,367 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeIntrospector struct {
+	result authctx.IntrospectionResult
+	err    error
+	token  string
+}
+
+func (f *fakeIntrospector) Introspect(_ context.Context, token string) (authctx.IntrospectionResult, error) {
+	f.token = token
+	return f.result, f.err
+}
+
+// fakeConn records the last invocation and answers with a canned response.
+type fakeConn struct {
+	method string
+	req    proto.Message
+	md     metadata.MD
+	resp   proto.Message
+	err    error
+}
+
+func (f *fakeConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	f.method = method
+	f.req = args.(proto.Message)
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+	if f.err != nil {
+		return f.err
+	}
+	if f.resp != nil {
+		proto.Merge(reply.(proto.Message), f.resp)
+	}
+	return nil
+}
+
+func (f *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streams are not supported")
+}
+
+func activeUser() *fakeIntrospector {
+	return &fakeIntrospector{result: authctx.IntrospectionResult{Active: true, UserID: "user-1", ClientID: "api-client", Scope: "openid " + APIScope}}
+}
+
+func newTestHandler(t *testing.T, conn *fakeConn, introspector authctx.Introspector) http.Handler {
+	t.Helper()
+	handler, err := NewHandler(conn, introspector, []string{"api-client"}, nil)
+	if err != nil {
+		t.Fatalf("NewHandler: %v", err)
+	}
+	return handler
+}
+
+func serve(handler http.Handler, method, target, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) apperrors.HTTPError {
+	t.Helper()
+	var envelope errorEnvelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &envelope); err != nil {
+		t.Fatalf("decode error body %q: %v", rec.Body.String(), err)
+	}
+	return envelope.Error
+}
+
+var bearer = map[string]string{"Authorization": "Bearer token-1"}
+
+func TestRoutesBind(t *testing.T) {
+	if _, err := bindRoutes(routes); err != nil {
+		t.Fatalf("bindRoutes: %v", err)
+	}
+}
+
+func TestBindRoutesRejectsNonStringPathWildcard(t *testing.T) {
+	_, err := bindRoutes([]route{{http.MethodGet, "/v1/campaigns/{page_size}", gamev1.CampaignService_ListCampaigns_FullMethodName, tagCampaigns, ""}})
+	if err == nil {
+		t.Fatal("expected error for int32 path wildcard")
+	}
+}
+
+func TestBindRoutesRejectsUnknownRPC(t *testing.T) {
+	_, err := bindRoutes([]route{{http.MethodGet, "/v1/nope", "/game.v1.CampaignService/Nope", tagCampaigns, ""}})
+	if err == nil {
+		t.Fatal("expected error for unknown rpc")
+	}
+}
+
+func TestAPIRequiresBearerToken(t *testing.T) {
+	handler := newTestHandler(t, &fakeConn{}, activeUser())
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns", "", nil)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", rec.Code)
+	}
+	if got := rec.Header().Get("WWW-Authenticate"); got != bearerChallenge {
+		t.Fatalf("WWW-Authenticate = %q", got)
+	}
+	if got := decodeError(t, rec).Code; got != "UNAUTHENTICATED" {
+		t.Fatalf("code = %q", got)
+	}
+}
+
+func TestAPIRejectsInactiveToken(t *testing.T) {
+	handler := newTestHandler(t, &fakeConn{}, &fakeIntrospector{result: authctx.IntrospectionResult{Active: false}})
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns", "", bearer)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", rec.Code)
+	}
+	if got := rec.Header().Get("WWW-Authenticate"); !strings.Contains(got, `error="invalid_token"`) {
+		t.Fatalf("WWW-Authenticate = %q", got)
+	}
+}
+
+func TestAPIRejectsTokenForOtherClient(t *testing.T) {
+	introspector := activeUser()
+	introspector.result.ClientID = "fracturing-space"
+	conn := &fakeConn{}
+	handler := newTestHandler(t, conn, introspector)
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns", "", bearer)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", rec.Code)
+	}
+	if got := rec.Header().Get("WWW-Authenticate"); !strings.Contains(got, `error="invalid_token"`) {
+		t.Fatalf("WWW-Authenticate = %q", got)
+	}
+	if conn.method != "" {
+		t.Fatalf("forwarded %q for another client's token", conn.method)
+	}
+}
+
+func TestAPIRejectsTokenWithoutAPIScope(t *testing.T) {
+	introspector := activeUser()
+	introspector.result.Scope = "openid profile"
+	conn := &fakeConn{}
+	handler := newTestHandler(t, conn, introspector)
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns", "", bearer)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403", rec.Code)
+	}
+	if got := rec.Header().Get("WWW-Authenticate"); !strings.Contains(got, `error="insufficient_scope"`) || !strings.Contains(got, APIScope) {
+		t.Fatalf("WWW-Authenticate = %q", got)
+	}
+	if conn.method != "" {
+		t.Fatalf("forwarded %q for an unscoped token", conn.method)
+	}
+}
+
+func TestAPIIntrospectionFailureIsUnavailable(t *testing.T) {
+	handler := newTestHandler(t, &fakeConn{}, &fakeIntrospector{err: errors.New("auth down")})
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns", "", bearer)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503", rec.Code)
+	}
+}
+
+func TestPublicEndpointsSkipAuth(t *testing.T) {
+	handler := newTestHandler(t, &fakeConn{}, nil)
+
+	if rec := serve(handler, http.MethodGet, healthPath, "", nil); rec.Code != http.StatusOK {
+		t.Fatalf("health status = %d", rec.Code)
+	}
+	rec := serve(handler, http.MethodGet, openAPIPath, "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("openapi status = %d", rec.Code)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode openapi: %v", err)
+	}
+	if doc["openapi"] != openAPIVersion {
+		t.Fatalf("openapi version = %v", doc["openapi"])
+	}
+}
+
+func TestListBindsQueryAndForwardsIdentity(t *testing.T) {
+	introspector := activeUser()
+	conn := &fakeConn{resp: &gamev1.ListCampaignsResponse{NextPageToken: "next"}}
+	handler := newTestHandler(t, conn, introspector)
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns?page_size=5&pageToken=abc&statuses=ACTIVE&statuses=DRAFT", "", map[string]string{
+		"Authorization":   "Bearer token-1",
+		"Accept-Language": "pt-BR,pt;q=0.9",
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d body = %s", rec.Code, rec.Body.String())
+	}
+	if introspector.token != "token-1" {
+		t.Fatalf("introspected token = %q", introspector.token)
+	}
+	if conn.method != gamev1.CampaignService_ListCampaigns_FullMethodName {
+		t.Fatalf("method = %q", conn.method)
+	}
+	req := conn.req.(*gamev1.ListCampaignsRequest)
+	if req.GetPageSize() != 5 || req.GetPageToken() != "abc" {
+		t.Fatalf("request = %v", req)
+	}
+	if got := req.GetStatuses(); len(got) != 2 || got[0] != gamev1.CampaignStatus_ACTIVE || got[1] != gamev1.CampaignStatus_DRAFT {
+		t.Fatalf("statuses = %v", got)
+	}
+	if got := conn.md.Get(grpcmeta.UserIDHeader); len(got) != 1 || got[0] != "user-1" {
+		t.Fatalf("user metadata = %v", got)
+	}
+	if got := conn.md.Get(grpcmeta.LocaleHeader); len(got) != 1 || got[0] != "pt-BR" {
+		t.Fatalf("locale metadata = %v", got)
+	}
+	if !strings.Contains(rec.Body.String(), `"next_page_token":"next"`) {
+		t.Fatalf("body = %s", rec.Body.String())
+	}
+}
+
+func TestUnknownQueryParameterIsBadRequest(t *testing.T) {
+	conn := &fakeConn{}
+	handler := newTestHandler(t, conn, activeUser())
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns?pagesize=5", "", bearer)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", rec.Code)
+	}
+	if got := decodeError(t, rec).Code; got != "INVALID_ARGUMENT" {
+		t.Fatalf("code = %q", got)
+	}
+	if conn.method != "" {
+		t.Fatalf("rpc should not be invoked, got %q", conn.method)
+	}
+}
+
+func TestPathWildcardOverridesBody(t *testing.T) {
+	conn := &fakeConn{}
+	handler := newTestHandler(t, conn, activeUser())
+
+	rec := serve(handler, http.MethodPatch, "/v1/campaigns/camp-1", `{"campaign_id":"other","name":"Renamed"}`, map[string]string{
+		"Authorization": "Bearer token-1",
+		"If-Match":      `"42"`,
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d body = %s", rec.Code, rec.Body.String())
+	}
+	req := conn.req.(*gamev1.UpdateCampaignRequest)
+	if req.GetCampaignId() != "camp-1" {
+		t.Fatalf("campaign_id = %q", req.GetCampaignId())
+	}
+	if got := conn.md.Get(grpcmeta.ExpectedSeqHeader); len(got) != 1 || got[0] != "42" {
+		t.Fatalf("expected seq metadata = %v", got)
+	}
+}
+
+func TestMalformedIfMatchIsBadRequest(t *testing.T) {
+	handler := newTestHandler(t, &fakeConn{}, activeUser())
+
+	rec := serve(handler, http.MethodPost, "/v1/campaigns/camp-1/end", `{}`, map[string]string{
+		"Authorization": "Bearer token-1",
+		"If-Match":      "*",
+	})
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", rec.Code)
+	}
+}
+
+func TestMalformedBodyIsBadRequest(t *testing.T) {
+	handler := newTestHandler(t, &fakeConn{}, activeUser())
+
+	rec := serve(handler, http.MethodPost, "/v1/campaigns", `{"name":`, bearer)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", rec.Code)
+	}
+}
+
+func TestRPCErrorsUseHTTPErrorContract(t *testing.T) {
+	conn := &fakeConn{err: status.Error(codes.NotFound, "campaign not found")}
+	handler := newTestHandler(t, conn, activeUser())
+
+	rec := serve(handler, http.MethodGet, "/v1/campaigns/missing", "", bearer)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+	body := decodeError(t, rec)
+	if body.Code != "NOT_FOUND" || body.Message != "campaign not found" {
+		t.Fatalf("error = %+v", body)
+	}
+}
+
+func TestUnknownAPIRouteIsJSONNotFound(t *testing.T) {
+	handler := newTestHandler(t, &fakeConn{}, activeUser())
+
+	rec := serve(handler, http.MethodGet, "/v1/nope", "", bearer)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+	if got := decodeError(t, rec).Code; got != "NOT_FOUND" {
+		t.Fatalf("code = %q", got)
+	}
+}
+
+func TestAPIRateLimitsPerUserAndClient(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.NewMemoryStore(), ratelimit.Rules{
+		APIRateLimitRule: {Key: ratelimit.KeyUser, Limit: 1, Period: time.Hour},
+		ratelimit.HTTPRule(http.MethodPost, "/v1/campaigns"): {Key: ratelimit.KeyUser, Limit: 5, Period: time.Hour},
+	})
+	conn := &fakeConn{}
+	introspector := activeUser()
+	handler, err := NewHandler(conn, introspector, nil, limiter)
+	if err != nil {
+		t.Fatalf("NewHandler: %v", err)
+	}
+
+	if rec := serve(handler, http.MethodGet, "/v1/campaigns", "", bearer); rec.Code != http.StatusOK {
+		t.Fatalf("first status = %d, want 200", rec.Code)
+	}
+	conn.method = ""
+	rec := serve(handler, http.MethodGet, "/v1/campaigns/camp-1", "", bearer)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("second status = %d, want 429", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Fatal("Retry-After header is missing")
+	}
+	if got := decodeError(t, rec).Code; got != "RESOURCE_EXHAUSTED" {
+		t.Fatalf("code = %q", got)
+	}
+	if conn.method != "" {
+		t.Fatalf("throttled request reached game: %s", conn.method)
+	}
+
+	if rec := serve(handler, http.MethodPost, "/v1/campaigns", "{}", bearer); rec.Code != http.StatusOK {
+		t.Fatalf("route with its own rule status = %d, want 200", rec.Code)
+	}
+	introspector.result.ClientID = "other-client"
+	if rec := serve(handler, http.MethodGet, "/v1/campaigns", "", bearer); rec.Code != http.StatusOK {
+		t.Fatalf("other client status = %d, want 200", rec.Code)
+	}
+	introspector.result.UserID = "user-2"
+	if rec := serve(handler, http.MethodGet, "/v1/campaigns", "", bearer); rec.Code != http.StatusOK {
+		t.Fatalf("other user status = %d, want 200", rec.Code)
+	}
+}
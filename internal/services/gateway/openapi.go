@@ -0,0 +1,281 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// openAPIVersion is the OpenAPI specification version the document targets.
+const openAPIVersion = "3.0.3"
+
+// OpenAPIDocument renders the gateway's OpenAPI document from the route table
+// and the registered proto descriptors. Field names and scalar encodings
+// follow protojson with proto field names, which is what the gateway emits.
+//
+// The output is deterministic so the checked-in copy can be drift-checked.
+func OpenAPIDocument() ([]byte, error) {
+	bound, err := bindRoutes(routes)
+	if err != nil {
+		return nil, err
+	}
+	gen := &openAPIGenerator{schemas: map[string]any{
+		"Error": errorSchema(),
+	}}
+
+	paths := map[string]map[string]any{}
+	tags := []any{}
+	seenTags := map[string]struct{}{}
+	for _, rt := range bound {
+		if _, ok := seenTags[rt.tag]; !ok {
+			seenTags[rt.tag] = struct{}{}
+			tags = append(tags, map[string]any{"name": rt.tag})
+		}
+		item := paths[rt.path]
+		if item == nil {
+			item = map[string]any{}
+			paths[rt.path] = item
+		}
+		item[strings.ToLower(rt.method)] = gen.operation(rt)
+	}
+
+	doc := map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":       "Fracturing.Space Game API",
+			"version":     "v1",
+			"description": "HTTP/JSON gateway over a curated subset of the game gRPC services. Authenticate with an OAuth access token issued by the auth service. List operations page with page_size and page_token; pass the response's next_page_token to continue.",
+		},
+		"security": []any{map[string]any{"bearerAuth": []any{}}},
+		"tags":     tags,
+		"paths":    paths,
+		"components": map[string]any{
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "OAuth access token issued by the auth service with the game:api scope.",
+				},
+			},
+			"parameters": map[string]any{
+				"IfMatch": map[string]any{
+					"name":        "If-Match",
+					"in":          "header",
+					"required":    false,
+					"description": "Campaign event sequence the caller last observed. The write is rejected with 412 when the campaign has moved on.",
+					"schema":      map[string]any{"type": "string"},
+				},
+			},
+			"responses": map[string]any{
+				"Error": map[string]any{
+					"description": "Error response.",
+					"content":     jsonContent(schemaRef("Error")),
+				},
+			},
+			"schemas": gen.schemas,
+		},
+	}
+	body, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(body, '\n'), nil
+}
+
+type openAPIGenerator struct {
+	schemas map[string]any
+}
+
+func (g *openAPIGenerator) operation(rt boundRoute) map[string]any {
+	parameters := []any{}
+	for _, field := range rt.pathFields {
+		parameters = append(parameters, map[string]any{
+			"name":     string(field.Name()),
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]any{"type": "string"},
+		})
+	}
+	for _, field := range rt.queryFields() {
+		parameters = append(parameters, map[string]any{
+			"name":   string(field.Name()),
+			"in":     "query",
+			"schema": g.fieldSchema(field),
+		})
+	}
+	if rt.method != http.MethodGet && strings.Contains(rt.path, "{campaign_id}") {
+		parameters = append(parameters, map[string]any{"$ref": "#/components/parameters/IfMatch"})
+	}
+
+	op := map[string]any{
+		"operationId": operationID(rt.rpc),
+		"summary":     rt.summary,
+		"tags":        []any{rt.tag},
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "OK",
+				"content":     jsonContent(g.messageRef(rt.output.Descriptor())),
+			},
+			"default": map[string]any{"$ref": "#/components/responses/Error"},
+		},
+	}
+	if len(parameters) > 0 {
+		op["parameters"] = parameters
+	}
+	if rt.hasBody() {
+		op["requestBody"] = map[string]any{
+			"required": false,
+			"content":  jsonContent(g.messageRef(rt.input.Descriptor())),
+		}
+	}
+	return op
+}
+
+// operationID turns "/game.v1.CampaignService/GetCampaign" into
+// "CampaignService_GetCampaign".
+func operationID(fullMethod string) string {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if idx := strings.LastIndex(service, "."); idx >= 0 {
+		service = service[idx+1:]
+	}
+	return service + "_" + method
+}
+
+func (g *openAPIGenerator) fieldSchema(field protoreflect.FieldDescriptor) map[string]any {
+	if field.IsMap() {
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": g.singularSchema(field.MapValue()),
+		}
+	}
+	if field.IsList() {
+		return map[string]any{"type": "array", "items": g.singularSchema(field)}
+	}
+	return g.singularSchema(field)
+}
+
+// singularSchema maps one field value to its protojson encoding.
+func (g *openAPIGenerator) singularSchema(field protoreflect.FieldDescriptor) map[string]any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		return g.enumRef(field.Enum())
+	default:
+		return g.messageRef(field.Message())
+	}
+}
+
+func (g *openAPIGenerator) enumRef(enum protoreflect.EnumDescriptor) map[string]any {
+	name := string(enum.FullName())
+	if _, ok := g.schemas[name]; !ok {
+		values := enum.Values()
+		names := make([]any, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		g.schemas[name] = map[string]any{"type": "string", "enum": names}
+	}
+	return schemaRef(name)
+}
+
+func (g *openAPIGenerator) messageRef(msg protoreflect.MessageDescriptor) map[string]any {
+	if schema, ok := wellKnownSchema(msg.FullName()); ok {
+		return schema
+	}
+	name := string(msg.FullName())
+	if _, ok := g.schemas[name]; ok {
+		return schemaRef(name)
+	}
+	properties := map[string]any{}
+	schema := map[string]any{"type": "object", "properties": properties}
+	// Register before walking fields so recursive messages terminate.
+	g.schemas[name] = schema
+	fields := msg.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		properties[string(field.Name())] = g.fieldSchema(field)
+	}
+	return schemaRef(name)
+}
+
+// wellKnownSchema inlines google.protobuf types using their protojson form.
+func wellKnownSchema(name protoreflect.FullName) (map[string]any, bool) {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}, true
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "example": "1.5s"}, true
+	case "google.protobuf.FieldMask":
+		return map[string]any{"type": "string", "example": "name,status"}, true
+	case "google.protobuf.Struct", "google.protobuf.Any", "google.protobuf.Empty":
+		return map[string]any{"type": "object"}, true
+	case "google.protobuf.Value":
+		return map[string]any{}, true
+	case "google.protobuf.ListValue":
+		return map[string]any{"type": "array", "items": map[string]any{}}, true
+	case "google.protobuf.StringValue":
+		return map[string]any{"type": "string", "nullable": true}, true
+	case "google.protobuf.BoolValue":
+		return map[string]any{"type": "boolean", "nullable": true}, true
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return map[string]any{"type": "integer", "nullable": true}, true
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return map[string]any{"type": "string", "format": "int64", "nullable": true}, true
+	case "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return map[string]any{"type": "number", "nullable": true}, true
+	case "google.protobuf.BytesValue":
+		return map[string]any{"type": "string", "format": "byte", "nullable": true}, true
+	default:
+		return nil, false
+	}
+}
+
+func schemaRef(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+// errorSchema documents the platform HTTP error envelope.
+func errorSchema() map[string]any {
+	return map[string]any{
+		"type":     "object",
+		"required": []any{"error"},
+		"properties": map[string]any{
+			"error": map[string]any{
+				"type":     "object",
+				"required": []any{"code", "message"},
+				"properties": map[string]any{
+					"code":    map[string]any{"type": "string", "description": "Canonical gRPC code name, e.g. NOT_FOUND."},
+					"reason":  map[string]any{"type": "string", "description": "Domain error code when available."},
+					"message": map[string]any{"type": "string", "description": "Localized user-facing message."},
+					"metadata": map[string]any{
+						"type":                 "object",
+						"additionalProperties": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+	}
+}
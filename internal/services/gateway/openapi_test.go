@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestOpenAPIDocumentCoversRoutes(t *testing.T) {
+	body, err := OpenAPIDocument()
+	if err != nil {
+		t.Fatalf("OpenAPIDocument: %v", err)
+	}
+	var doc struct {
+		Paths      map[string]map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("decode document: %v", err)
+	}
+	for _, rt := range routes {
+		if _, ok := doc.Paths[rt.path][strings.ToLower(rt.method)]; !ok {
+			t.Fatalf("document is missing %s %s", rt.method, rt.path)
+		}
+	}
+	for _, name := range []string{"Error", "game.v1.ListCampaignsResponse", "game.v1.CampaignStatus"} {
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Fatalf("document is missing schema %q", name)
+		}
+	}
+}
+
+func TestOpenAPIDocumentMatchesCheckedInCopy(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("resolve caller path")
+	}
+	root := filepath.Clean(filepath.Join(filepath.Dir(filename), "..", "..", ".."))
+	checkedIn, err := os.ReadFile(filepath.Join(root, "api", "openapi", "gateway.json"))
+	if err != nil {
+		t.Fatalf("read api/openapi/gateway.json: %v", err)
+	}
+	generated, err := OpenAPIDocument()
+	if err != nil {
+		t.Fatalf("OpenAPIDocument: %v", err)
+	}
+	if string(checkedIn) != string(generated) {
+		t.Fatal("api/openapi/gateway.json is stale (run: make openapi-generate)")
+	}
+}
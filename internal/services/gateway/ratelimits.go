@@ -0,0 +1,49 @@
+package gateway
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+	"github.com/louisbranch/fracturing.space/internal/platform/requestctx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIRateLimitRule names the budget every API route draws from unless a rule
+// for the route's own "METHOD /path" pattern, such as
+// "POST /v1/campaigns/{campaign_id}/sessions", is configured.
+const APIRateLimitRule = "/v1/"
+
+// RateLimits are the gateway throttling defaults. API calls are budgeted per
+// user and OAuth client, so one integration cannot exhaust a user's other
+// clients or flood game on their behalf.
+var RateLimits = ratelimit.Rules{
+	APIRateLimitRule: {Key: ratelimit.KeyUser, Limit: 600, Period: time.Minute, Burst: 120},
+}
+
+// rateLimited throttles one authenticated API route. It runs after bearer
+// authentication, so buckets are keyed by the introspected user and client.
+// A nil limiter returns next unchanged.
+func rateLimited(limiter *ratelimit.Limiter, rt boundRoute, next http.Handler) http.Handler {
+	if limiter == nil {
+		return next
+	}
+	rule := ratelimit.HTTPRule(rt.method, rt.path)
+	if _, ok := limiter.Policy(rule); !ok {
+		rule = APIRateLimitRule
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		decision := limiter.Allow(r.Context(), rule, ratelimit.Subject{
+			UserID:   requestctx.UserIDFromContext(r.Context()),
+			ClientID: clientIDFromContext(r.Context()),
+			IP:       ratelimit.ClientIP(r, false),
+		})
+		if !decision.Allowed {
+			w.Header().Set("Retry-After", ratelimit.RetryAfterSeconds(decision.RetryAfter))
+			writeGRPCError(w, status.Error(codes.ResourceExhausted, "rate limit exceeded"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,90 @@
+package gateway
+
+import (
+	"net/http"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+)
+
+// route maps one HTTP operation onto one unary game RPC.
+//
+// Path wildcards are named after request fields and always win over the same
+// field in a JSON body. Routes without a body bind remaining scalar request
+// fields from the query string, which is how list pagination (page_size,
+// page_token) is exposed.
+type route struct {
+	method  string
+	path    string
+	rpc     string
+	tag     string
+	summary string
+}
+
+// hasBody reports whether the route decodes the request message from a JSON
+// body instead of the query string.
+func (r route) hasBody() bool {
+	switch r.method {
+	case http.MethodPost, http.MethodPatch, http.MethodPut:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	tagCampaigns   = "Campaigns"
+	tagCharacters  = "Characters"
+	tagSessions    = "Sessions"
+	tagSnapshots   = "Snapshots"
+	tagDaggerheart = "Daggerheart"
+)
+
+// routes is the curated public surface. It deliberately leaves out internal
+// orchestration, AI, moderation, and fork RPCs; add a route here (and
+// regenerate the OpenAPI document) to publish another RPC.
+var routes = []route{
+	// Campaigns.
+	{http.MethodGet, "/v1/campaigns", gamev1.CampaignService_ListCampaigns_FullMethodName, tagCampaigns, "List campaigns the caller belongs to"},
+	{http.MethodPost, "/v1/campaigns", gamev1.CampaignService_CreateCampaign_FullMethodName, tagCampaigns, "Create a campaign"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}", gamev1.CampaignService_GetCampaign_FullMethodName, tagCampaigns, "Get a campaign"},
+	{http.MethodPatch, "/v1/campaigns/{campaign_id}", gamev1.CampaignService_UpdateCampaign_FullMethodName, tagCampaigns, "Update campaign settings"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/session-readiness", gamev1.CampaignService_GetCampaignSessionReadiness_FullMethodName, tagCampaigns, "Check whether a session can start"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/end", gamev1.CampaignService_EndCampaign_FullMethodName, tagCampaigns, "End a campaign"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/archive", gamev1.CampaignService_ArchiveCampaign_FullMethodName, tagCampaigns, "Archive a campaign"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/restore", gamev1.CampaignService_RestoreCampaign_FullMethodName, tagCampaigns, "Restore an archived campaign"},
+
+	// Characters.
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/characters", gamev1.CharacterService_ListCharacters_FullMethodName, tagCharacters, "List campaign characters"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/characters", gamev1.CharacterService_CreateCharacter_FullMethodName, tagCharacters, "Create a character"},
+	{http.MethodPatch, "/v1/campaigns/{campaign_id}/characters/{character_id}", gamev1.CharacterService_UpdateCharacter_FullMethodName, tagCharacters, "Update a character"},
+	{http.MethodDelete, "/v1/campaigns/{campaign_id}/characters/{character_id}", gamev1.CharacterService_DeleteCharacter_FullMethodName, tagCharacters, "Delete a character"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/characters/{character_id}/sheet", gamev1.CharacterService_GetCharacterSheet_FullMethodName, tagCharacters, "Get a character sheet"},
+	{http.MethodPatch, "/v1/campaigns/{campaign_id}/characters/{character_id}/profile", gamev1.CharacterService_PatchCharacterProfile_FullMethodName, tagCharacters, "Patch a character profile"},
+
+	// Sessions.
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/sessions", gamev1.SessionService_ListSessions_FullMethodName, tagSessions, "List campaign sessions"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/sessions", gamev1.SessionService_StartSession_FullMethodName, tagSessions, "Start a session"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/sessions/{session_id}", gamev1.SessionService_GetSession_FullMethodName, tagSessions, "Get a session"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/sessions/{session_id}/end", gamev1.SessionService_EndSession_FullMethodName, tagSessions, "End a session"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/sessions/{session_id}/recap", gamev1.SessionService_GetSessionRecap_FullMethodName, tagSessions, "Get a session recap"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/sessions/{session_id}/spotlight", gamev1.SessionService_GetSessionSpotlight_FullMethodName, tagSessions, "Get the session spotlight"},
+
+	// Snapshots.
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/snapshot", gamev1.SnapshotService_GetSnapshot_FullMethodName, tagSnapshots, "Get the campaign state snapshot"},
+	{http.MethodPatch, "/v1/campaigns/{campaign_id}/characters/{character_id}/state", gamev1.SnapshotService_PatchCharacterState_FullMethodName, tagSnapshots, "Patch character state"},
+
+	// Daggerheart mechanics.
+	{http.MethodGet, "/v1/daggerheart/rules-version", daggerheartv1.DaggerheartService_RulesVersion_FullMethodName, tagDaggerheart, "Get the Daggerheart rules version"},
+	{http.MethodPost, "/v1/daggerheart/dice/roll", daggerheartv1.DaggerheartService_RollDice_FullMethodName, tagDaggerheart, "Roll dice"},
+	{http.MethodPost, "/v1/daggerheart/action-rolls", daggerheartv1.DaggerheartService_ActionRoll_FullMethodName, tagDaggerheart, "Make a stateless action roll"},
+	{http.MethodPost, "/v1/daggerheart/duality/outcome", daggerheartv1.DaggerheartService_DualityOutcome_FullMethodName, tagDaggerheart, "Evaluate a duality outcome"},
+	{http.MethodPost, "/v1/daggerheart/duality/probability", daggerheartv1.DaggerheartService_DualityProbability_FullMethodName, tagDaggerheart, "Compute duality outcome probabilities"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/daggerheart/adversaries", daggerheartv1.DaggerheartService_ListAdversaries_FullMethodName, tagDaggerheart, "List campaign adversaries"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/daggerheart/adversaries/{adversary_id}", daggerheartv1.DaggerheartService_GetAdversary_FullMethodName, tagDaggerheart, "Get an adversary"},
+	{http.MethodGet, "/v1/campaigns/{campaign_id}/daggerheart/countdowns", daggerheartv1.DaggerheartService_ListCampaignCountdowns_FullMethodName, tagDaggerheart, "List campaign countdowns"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/characters/{character_id}/daggerheart/damage", daggerheartv1.DaggerheartService_ApplyDamage_FullMethodName, tagDaggerheart, "Apply damage to a character"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/daggerheart/rest", daggerheartv1.DaggerheartService_ApplyRest_FullMethodName, tagDaggerheart, "Apply a rest"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/characters/{character_id}/daggerheart/conditions", daggerheartv1.DaggerheartService_ApplyConditions_FullMethodName, tagDaggerheart, "Apply character conditions"},
+	{http.MethodPost, "/v1/campaigns/{campaign_id}/sessions/{session_id}/daggerheart/action-rolls", daggerheartv1.DaggerheartService_SessionActionRoll_FullMethodName, tagDaggerheart, "Make a session action roll"},
+}
@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+	platformstatus "github.com/louisbranch/fracturing.space/internal/platform/status"
+	"github.com/louisbranch/fracturing.space/internal/platform/timeouts"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+)
+
+// introspectTimeout bounds one token introspection call.
+const introspectTimeout = 5 * time.Second
+
+// newManagedConn wraps platformgrpc.NewManagedConn for testability.
+var newManagedConn = platformgrpc.NewManagedConn
+
+// Config defines the inputs for the gateway process.
+type Config struct {
+	HTTPAddr string
+	// GameAddr is the game gRPC address every route forwards to.
+	GameAddr string
+	// IntrospectURL and ResourceSecret configure OAuth token introspection
+	// against the auth service.
+	IntrospectURL  string
+	ResourceSecret string
+	// AllowedClientIDs limits accepted tokens to these OAuth clients. Empty
+	// accepts any client whose token carries APIScope.
+	AllowedClientIDs []string
+	// RateLimiter throttles API routes per user and OAuth client. Nil
+	// disables throttling.
+	RateLimiter *ratelimit.Limiter
+	// StatusReporter receives health transitions for dependency capabilities.
+	StatusReporter *platformstatus.Reporter
+}
+
+// Server hosts the HTTP/JSON gateway.
+type Server struct {
+	httpAddr   string
+	gameMc     *platformgrpc.ManagedConn
+	httpServer *http.Server
+}
+
+// NewServer dials game and builds the gateway HTTP server.
+func NewServer(ctx context.Context, cfg Config) (*Server, error) {
+	httpAddr := strings.TrimSpace(cfg.HTTPAddr)
+	if httpAddr == "" {
+		return nil, errors.New("http address is required")
+	}
+	gameAddr := strings.TrimSpace(cfg.GameAddr)
+	if gameAddr == "" {
+		return nil, errors.New("game address is required")
+	}
+	introspectURL := strings.TrimSpace(cfg.IntrospectURL)
+	if introspectURL == "" {
+		return nil, errors.New("auth introspect url is required")
+	}
+
+	mc, err := newManagedConn(ctx, platformgrpc.ManagedConnConfig{
+		Name:             "game",
+		Addr:             gameAddr,
+		Mode:             platformgrpc.ModeOptional,
+		StatusReporter:   cfg.StatusReporter,
+		StatusCapability: "gateway.game.integration",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gateway: managed conn game: %w", err)
+	}
+
+	introspector := authctx.NewHTTPIntrospector(introspectURL, cfg.ResourceSecret, &http.Client{Timeout: introspectTimeout})
+	handler, err := NewHandler(mc.Conn(), introspector, cfg.AllowedClientIDs, cfg.RateLimiter)
+	if err != nil {
+		_ = mc.Close()
+		return nil, fmt.Errorf("gateway: build handler: %w", err)
+	}
+	return &Server{
+		httpAddr: httpAddr,
+		gameMc:   mc,
+		httpServer: &http.Server{
+			Addr:              httpAddr,
+			Handler:           handler,
+			ReadHeaderTimeout: timeouts.ReadHeader,
+		},
+	}, nil
+}
+
+// ListenAndServe runs the HTTP server until the context ends.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	if s == nil {
+		return errors.New("gateway server is nil")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	serveErr := make(chan error, 1)
+	log.Printf("gateway listening on %s", s.httpAddr)
+	go func() {
+		serveErr <- s.httpServer.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), timeouts.Shutdown)
+		err := s.httpServer.Shutdown(shutdownCtx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("shutdown http server: %w", err)
+		}
+		return nil
+	case err := <-serveErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("serve http: %w", err)
+	}
+}
+
+// Close releases the game connection and HTTP server.
+func (s *Server) Close() {
+	if s == nil {
+		return
+	}
+	if s.gameMc != nil {
+		if err := s.gameMc.Close(); err != nil {
+			log.Printf("close gateway game managed conn: %v", err)
+		}
+		s.gameMc = nil
+	}
+	if s.httpServer != nil {
+		_ = s.httpServer.Close()
+	}
+}
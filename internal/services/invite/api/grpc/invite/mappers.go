@@ -88,3 +88,139 @@ func loadCreatorUser(ctx context.Context, game gamev1.ParticipantServiceClient,
 		Username: uResp.GetUser().GetUsername(),
 	}
 }
+
+func inviteLinkToProto(rec storage.InviteLinkRecord) *invitev1.InviteLink {
+	link := &invitev1.InviteLink{
+		Id:                     rec.ID,
+		CampaignId:             rec.CampaignID,
+		CreatedByParticipantId: rec.CreatedByParticipantID,
+		DefaultRole:            linkRoleToProto(rec.Role),
+		MaxUses:                int32(rec.MaxUses),
+		UseCount:               int32(rec.UseCount),
+		RequiresApproval:       rec.RequiresApproval,
+		Status:                 linkStatusToProto(rec.Status),
+		CreatedAt:              timestamppb.New(rec.CreatedAt),
+		UpdatedAt:              timestamppb.New(rec.UpdatedAt),
+	}
+	if rec.ExpiresAt != nil {
+		link.ExpiresAt = timestamppb.New(*rec.ExpiresAt)
+	}
+	return link
+}
+
+func inviteLinkClaimToProto(rec storage.InviteLinkClaimRecord) *invitev1.InviteLinkClaim {
+	return &invitev1.InviteLinkClaim{
+		Id:            rec.ID,
+		LinkId:        rec.LinkID,
+		CampaignId:    rec.CampaignID,
+		UserId:        rec.UserID,
+		ParticipantId: rec.ParticipantID,
+		Status:        claimStatusToProto(rec.Status),
+		CreatedAt:     timestamppb.New(rec.CreatedAt),
+		UpdatedAt:     timestamppb.New(rec.UpdatedAt),
+	}
+}
+
+func linkStatusToProto(s storage.LinkStatus) invitev1.InviteLinkStatus {
+	switch s {
+	case storage.LinkStatusActive:
+		return invitev1.InviteLinkStatus_INVITE_LINK_ACTIVE
+	case storage.LinkStatusRevoked:
+		return invitev1.InviteLinkStatus_INVITE_LINK_REVOKED
+	default:
+		return invitev1.InviteLinkStatus_INVITE_LINK_STATUS_UNSPECIFIED
+	}
+}
+
+func linkRoleToProto(r storage.LinkRole) invitev1.InviteLinkRole {
+	switch r {
+	case storage.LinkRolePlayer:
+		return invitev1.InviteLinkRole_INVITE_LINK_ROLE_PLAYER
+	case storage.LinkRoleGM:
+		return invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM
+	default:
+		return invitev1.InviteLinkRole_INVITE_LINK_ROLE_UNSPECIFIED
+	}
+}
+
+// linkRoleFromProto defaults unspecified roles to player so links created
+// without a role recruit players.
+func linkRoleFromProto(r invitev1.InviteLinkRole) storage.LinkRole {
+	if r == invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM {
+		return storage.LinkRoleGM
+	}
+	return storage.LinkRolePlayer
+}
+
+func linkRoleToGame(r storage.LinkRole) gamev1.ParticipantRole {
+	if r == storage.LinkRoleGM {
+		return gamev1.ParticipantRole_GM
+	}
+	return gamev1.ParticipantRole_PLAYER
+}
+
+func claimStatusToProto(s storage.ClaimStatus) invitev1.InviteLinkClaimStatus {
+	switch s {
+	case storage.ClaimStatusPending:
+		return invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_PENDING_APPROVAL
+	case storage.ClaimStatusJoined:
+		return invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_JOINED
+	case storage.ClaimStatusDeclined:
+		return invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_DECLINED
+	default:
+		return invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_STATUS_UNSPECIFIED
+	}
+}
+
+func participantSummaryToProto(p *gamev1.Participant) *invitev1.InviteParticipantSummary {
+	if p == nil {
+		return nil
+	}
+	return &invitev1.InviteParticipantSummary{
+		Id:     p.GetId(),
+		Name:   p.GetName(),
+		Role:   p.GetRole().String(),
+		UserId: p.GetUserId(),
+	}
+}
+
+func campaignApplicationToProto(rec storage.CampaignApplicationRecord) *invitev1.CampaignApplication {
+	return &invitev1.CampaignApplication{
+		Id:               rec.ID,
+		CampaignId:       rec.CampaignID,
+		ApplicantUserId:  rec.ApplicantUserID,
+		Message:          rec.Message,
+		CharacterConcept: rec.CharacterConcept,
+		Status:           applicationStatusToProto(rec.Status),
+		InviteId:         rec.InviteID,
+		ParticipantId:    rec.ParticipantID,
+		CreatedAt:        timestamppb.New(rec.CreatedAt),
+		UpdatedAt:        timestamppb.New(rec.UpdatedAt),
+	}
+}
+
+func applicationStatusToProto(s storage.ApplicationStatus) invitev1.CampaignApplicationStatus {
+	switch s {
+	case storage.ApplicationStatusPending:
+		return invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_PENDING
+	case storage.ApplicationStatusAccepted:
+		return invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_ACCEPTED
+	case storage.ApplicationStatusDeclined:
+		return invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_DECLINED
+	default:
+		return invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_STATUS_UNSPECIFIED
+	}
+}
+
+func applicationStatusFromProto(s invitev1.CampaignApplicationStatus) storage.ApplicationStatus {
+	switch s {
+	case invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_PENDING:
+		return storage.ApplicationStatusPending
+	case invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_ACCEPTED:
+		return storage.ApplicationStatusAccepted
+	case invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_DECLINED:
+		return storage.ApplicationStatusDeclined
+	default:
+		return ""
+	}
+}
@@ -13,6 +13,9 @@ const (
 	outboxEventCreated  = "invite.invite.created.v1"
 	outboxEventClaimed  = "invite.invite.claimed.v1"
 	outboxEventDeclined = "invite.invite.declined.v1"
+
+	outboxEventApplicationSubmitted = "invite.application.submitted.v1"
+	outboxEventApplicationDeclined  = "invite.application.declined.v1"
 )
 
 // outboxPayload is the durable worker-facing integration payload.
@@ -46,3 +49,35 @@ func enqueueInviteEvent(ctx context.Context, outbox storage.OutboxStore, idGen f
 		log.Printf("invite outbox: enqueue %s: %v", eventType, err)
 	}
 }
+
+// applicationOutboxPayload is the worker-facing payload for campaign
+// application events. Accepted applications surface as invite created events.
+type applicationOutboxPayload struct {
+	ApplicationID    string `json:"application_id"`
+	CampaignID       string `json:"campaign_id"`
+	ApplicantUserID  string `json:"applicant_user_id"`
+	NotificationKind string `json:"notification_kind"`
+}
+
+func enqueueApplicationEvent(ctx context.Context, outbox storage.OutboxStore, idGen func() (string, error), now time.Time, eventType string, app storage.CampaignApplicationRecord) {
+	evtID, err := idGen()
+	if err != nil {
+		log.Printf("invite outbox: generate id: %v", err)
+		return
+	}
+	payload, _ := json.Marshal(applicationOutboxPayload{
+		ApplicationID:    app.ID,
+		CampaignID:       app.CampaignID,
+		ApplicantUserID:  app.ApplicantUserID,
+		NotificationKind: eventType,
+	})
+	if err := outbox.Enqueue(ctx, storage.OutboxEvent{
+		ID:          evtID,
+		EventType:   eventType,
+		PayloadJSON: payload,
+		DedupeKey:   "application:" + app.ID + ":" + eventType,
+		CreatedAt:   now,
+	}); err != nil {
+		log.Printf("invite outbox: enqueue %s: %v", eventType, err)
+	}
+}
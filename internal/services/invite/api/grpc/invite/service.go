@@ -5,6 +5,7 @@ import (
 	"time"
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
@@ -14,23 +15,31 @@ import (
 // Deps holds the explicit dependencies for the invite transport.
 type Deps struct {
 	Store        storage.InviteStore
+	Links        storage.InviteLinkStore
+	Applications storage.CampaignApplicationStore
 	Outbox       storage.OutboxStore
 	Game         gamev1.ParticipantServiceClient
 	GameCampaign gamev1.CampaignServiceClient
 	Auth         authv1.AuthServiceClient
-	IDGenerator  func() (string, error)
-	Clock        func() time.Time
-	Verifier     joingrant.Verifier
+	// Discovery is optional; campaign applications require it to confirm the
+	// campaign has an open-table listing.
+	Discovery   discoveryv1.DiscoveryServiceClient
+	IDGenerator func() (string, error)
+	Clock       func() time.Time
+	Verifier    joingrant.Verifier
 }
 
 // Service implements the invite.v1.InviteServiceServer gRPC API.
 type Service struct {
 	invitev1.UnimplementedInviteServiceServer
 	store        storage.InviteStore
+	links        storage.InviteLinkStore
+	applications storage.CampaignApplicationStore
 	outbox       storage.OutboxStore
 	game         gamev1.ParticipantServiceClient
 	gameCampaign gamev1.CampaignServiceClient
 	auth         authv1.AuthServiceClient
+	discovery    discoveryv1.DiscoveryServiceClient
 	idGenerator  func() (string, error)
 	clock        func() time.Time
 	verifier     joingrant.Verifier
@@ -44,10 +53,13 @@ func NewService(deps Deps) *Service {
 	}
 	return &Service{
 		store:        deps.Store,
+		links:        deps.Links,
+		applications: deps.Applications,
 		outbox:       deps.Outbox,
 		game:         deps.Game,
 		gameCampaign: deps.GameCampaign,
 		auth:         deps.Auth,
+		discovery:    deps.Discovery,
 		idGenerator:  deps.IDGenerator,
 		clock:        clock,
 		verifier:     deps.Verifier,
@@ -0,0 +1,271 @@
+package invite
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxApplicationMessageLength = 2000
+	maxApplicationConceptLength = 1000
+	defaultApplicantSeatName    = "Player"
+)
+
+func (s *Service) SubmitCampaignApplication(ctx context.Context, in *invitev1.SubmitCampaignApplicationRequest) (*invitev1.SubmitCampaignApplicationResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.applications == nil || s.discovery == nil {
+		return nil, status.Error(codes.Unimplemented, "campaign applications are not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	message := strings.TrimSpace(in.GetMessage())
+	if message == "" {
+		return nil, status.Error(codes.InvalidArgument, "message is required")
+	}
+	if utf8.RuneCountInString(message) > maxApplicationMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, "message must be at most %d characters", maxApplicationMessageLength)
+	}
+	concept := strings.TrimSpace(in.GetCharacterConcept())
+	if utf8.RuneCountInString(concept) > maxApplicationConceptLength {
+		return nil, status.Errorf(codes.InvalidArgument, "character_concept must be at most %d characters", maxApplicationConceptLength)
+	}
+	userID := userIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "user identity is required")
+	}
+
+	if _, err := s.discovery.GetCampaignListing(ctx, &discoveryv1.GetCampaignListingRequest{CampaignId: campaignID}); err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return nil, status.Error(codes.FailedPrecondition, "campaign is not accepting applications")
+		}
+		return nil, status.Errorf(codes.Internal, "check campaign listing: %v", err)
+	}
+	if s.game != nil && userHasSeatInCampaign(gameReadContext(ctx), s.game, campaignID, userID) {
+		return nil, status.Error(codes.FailedPrecondition, "user already has a seat in this campaign")
+	}
+
+	applicationID, err := s.idGenerator()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate campaign application id: %v", err)
+	}
+	now := s.clock()
+	app := storage.CampaignApplicationRecord{
+		ID:               applicationID,
+		CampaignID:       campaignID,
+		ApplicantUserID:  userID,
+		Message:          message,
+		CharacterConcept: concept,
+		Status:           storage.ApplicationStatusPending,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := s.applications.PutCampaignApplication(ctx, app); err != nil {
+		if errors.Is(err, storage.ErrApplicationExists) {
+			return nil, status.Error(codes.AlreadyExists, "campaign application already pending")
+		}
+		return nil, status.Errorf(codes.Internal, "store campaign application: %v", err)
+	}
+	if s.outbox != nil {
+		enqueueApplicationEvent(ctx, s.outbox, s.idGenerator, now, outboxEventApplicationSubmitted, app)
+	}
+	return &invitev1.SubmitCampaignApplicationResponse{Application: campaignApplicationToProto(app)}, nil
+}
+
+func (s *Service) ListCampaignApplications(ctx context.Context, in *invitev1.ListCampaignApplicationsRequest) (*invitev1.ListCampaignApplicationsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.applications == nil {
+		return nil, status.Error(codes.Unimplemented, "campaign applications are not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	page, err := s.applications.ListCampaignApplications(ctx, campaignID, "", applicationStatusFromProto(in.GetStatus()), int(in.GetPageSize()), in.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list campaign applications: %v", err)
+	}
+	applications := make([]*invitev1.CampaignApplication, 0, len(page.Applications))
+	for _, app := range page.Applications {
+		applications = append(applications, campaignApplicationToProto(app))
+	}
+	return &invitev1.ListCampaignApplicationsResponse{Applications: applications, NextPageToken: page.NextPageToken}, nil
+}
+
+// AcceptCampaignApplication reserves an unbound player seat for the applicant
+// and addresses a seat invite to them, so joining still goes through the
+// applicant's own invite claim. The application is claimed before the seat is
+// created, so concurrent accepts cannot each create a seat; any failure after
+// the claim removes the seat and returns the application to pending.
+func (s *Service) AcceptCampaignApplication(ctx context.Context, in *invitev1.AcceptCampaignApplicationRequest) (*invitev1.AcceptCampaignApplicationResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	app, err := s.loadPendingApplication(ctx, in.GetCampaignId(), in.GetApplicationId())
+	if err != nil {
+		return nil, err
+	}
+	if s.game == nil {
+		return nil, status.Error(codes.Unavailable, "game participant service is not configured")
+	}
+	if userHasSeatInCampaign(gameReadContext(ctx), s.game, app.CampaignID, app.ApplicantUserID) {
+		return nil, status.Error(codes.FailedPrecondition, "applicant already has a seat in this campaign")
+	}
+
+	now := s.clock()
+	if err := s.applications.ResolveCampaignApplication(ctx, app.ID, storage.ApplicationStatusAccepted, "", "", now); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "campaign application is no longer pending")
+		}
+		return nil, status.Errorf(codes.Internal, "accept campaign application: %v", err)
+	}
+
+	resp, err := s.game.CreateParticipant(grpcauthctx.WithAdminOverride(ctx, "campaign application accept"), &gamev1.CreateParticipantRequest{
+		CampaignId: app.CampaignID,
+		Name:       s.applicantSeatName(ctx, app.ApplicantUserID),
+		Role:       gamev1.ParticipantRole_PLAYER,
+		Controller: gamev1.Controller_CONTROLLER_HUMAN,
+	})
+	if err != nil {
+		// Return the application to pending so the GM can retry.
+		_ = s.applications.ReleaseCampaignApplication(ctx, app.ID, s.clock())
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "create participant: %v", err)
+	}
+	participantID := resp.GetParticipant().GetId()
+
+	inviteID, err := s.idGenerator()
+	if err != nil {
+		s.abandonApplicationSeat(ctx, app, participantID, "")
+		return nil, status.Errorf(codes.Internal, "generate invite id: %v", err)
+	}
+	inv := storage.InviteRecord{
+		ID:                     inviteID,
+		CampaignID:             app.CampaignID,
+		ParticipantID:          participantID,
+		RecipientUserID:        app.ApplicantUserID,
+		Status:                 storage.StatusPending,
+		CreatedByParticipantID: strings.TrimSpace(in.GetCreatedByParticipantId()),
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}
+	if err := s.store.PutInvite(ctx, inv); err != nil {
+		s.abandonApplicationSeat(ctx, app, participantID, "")
+		return nil, status.Errorf(codes.Internal, "store invite: %v", err)
+	}
+	if err := s.applications.CompleteCampaignApplication(ctx, app.ID, inviteID, participantID, now); err != nil {
+		s.abandonApplicationSeat(ctx, app, participantID, inviteID)
+		return nil, status.Errorf(codes.Internal, "record accepted campaign application: %v", err)
+	}
+	if s.outbox != nil {
+		enqueueInviteEvent(ctx, s.outbox, s.idGenerator, now, outboxEventCreated, inv)
+	}
+
+	app.Status = storage.ApplicationStatusAccepted
+	app.InviteID = inviteID
+	app.ParticipantID = participantID
+	app.UpdatedAt = now
+	return &invitev1.AcceptCampaignApplicationResponse{
+		Application: campaignApplicationToProto(app),
+		Invite:      inviteToProto(inv),
+	}, nil
+}
+
+// abandonApplicationSeat undoes a partly applied accept so the GM can retry:
+// it revokes the seat invite when one was stored, removes the reserved seat,
+// and returns the application to pending. Cleanup is best effort; the caller
+// reports the original failure.
+func (s *Service) abandonApplicationSeat(ctx context.Context, app storage.CampaignApplicationRecord, participantID, inviteID string) {
+	if inviteID != "" {
+		_ = s.store.UpdateInviteStatus(ctx, inviteID, storage.StatusRevoked, s.clock())
+	}
+	s.removeSeat(ctx, app.CampaignID, participantID, "campaign application accept")
+	_ = s.applications.ReleaseCampaignApplication(ctx, app.ID, s.clock())
+}
+
+func (s *Service) DeclineCampaignApplication(ctx context.Context, in *invitev1.DeclineCampaignApplicationRequest) (*invitev1.DeclineCampaignApplicationResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	app, err := s.loadPendingApplication(ctx, in.GetCampaignId(), in.GetApplicationId())
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock()
+	if err := s.applications.ResolveCampaignApplication(ctx, app.ID, storage.ApplicationStatusDeclined, "", "", now); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "campaign application is no longer pending")
+		}
+		return nil, status.Errorf(codes.Internal, "decline campaign application: %v", err)
+	}
+	app.Status = storage.ApplicationStatusDeclined
+	app.UpdatedAt = now
+	if s.outbox != nil {
+		enqueueApplicationEvent(ctx, s.outbox, s.idGenerator, now, outboxEventApplicationDeclined, app)
+	}
+	return &invitev1.DeclineCampaignApplicationResponse{Application: campaignApplicationToProto(app)}, nil
+}
+
+// loadPendingApplication validates a GM application decision request and
+// loads the pending application it targets.
+func (s *Service) loadPendingApplication(ctx context.Context, campaignID, applicationID string) (storage.CampaignApplicationRecord, error) {
+	if s.applications == nil {
+		return storage.CampaignApplicationRecord{}, status.Error(codes.Unimplemented, "campaign applications are not configured")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return storage.CampaignApplicationRecord{}, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	applicationID = strings.TrimSpace(applicationID)
+	if applicationID == "" {
+		return storage.CampaignApplicationRecord{}, status.Error(codes.InvalidArgument, "application_id is required")
+	}
+	app, err := s.applications.GetCampaignApplication(ctx, applicationID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.CampaignApplicationRecord{}, status.Error(codes.NotFound, "campaign application not found")
+		}
+		return storage.CampaignApplicationRecord{}, status.Errorf(codes.Internal, "load campaign application: %v", err)
+	}
+	if app.CampaignID != campaignID {
+		return storage.CampaignApplicationRecord{}, status.Error(codes.InvalidArgument, "campaign application campaign does not match")
+	}
+	if app.Status != storage.ApplicationStatusPending {
+		return storage.CampaignApplicationRecord{}, status.Errorf(codes.FailedPrecondition, "campaign application already %s", app.Status)
+	}
+	return app, nil
+}
+
+// applicantSeatName names the reserved seat after the applicant's username so
+// the GM can tell seats apart before the invite is claimed.
+func (s *Service) applicantSeatName(ctx context.Context, userID string) string {
+	if s.auth == nil {
+		return defaultApplicantSeatName
+	}
+	resp, err := s.auth.GetUser(ctx, &authv1.GetUserRequest{UserId: userID})
+	if err != nil {
+		return defaultApplicantSeatName
+	}
+	if username := strings.TrimSpace(resp.GetUser().GetUsername()); username != "" {
+		return username
+	}
+	return defaultApplicantSeatName
+}
@@ -0,0 +1,393 @@
+package invite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+	"github.com/louisbranch/fracturing.space/internal/test/grpcassert"
+	"github.com/louisbranch/fracturing.space/internal/test/mock/invitefakes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeDiscoveryClient struct {
+	discoveryv1.DiscoveryServiceClient
+
+	listingErr error
+}
+
+func (f *fakeDiscoveryClient) GetCampaignListing(_ context.Context, in *discoveryv1.GetCampaignListingRequest, _ ...grpc.CallOption) (*discoveryv1.GetCampaignListingResponse, error) {
+	if f.listingErr != nil {
+		return nil, f.listingErr
+	}
+	return &discoveryv1.GetCampaignListingResponse{Entry: &discoveryv1.DiscoveryEntry{SourceId: in.GetCampaignId(), OpenSeats: 1}}, nil
+}
+
+type fakeAuthClient struct {
+	authv1.AuthServiceClient
+
+	usernames map[string]string
+}
+
+func (f *fakeAuthClient) GetUser(_ context.Context, in *authv1.GetUserRequest, _ ...grpc.CallOption) (*authv1.GetUserResponse, error) {
+	username, ok := f.usernames[in.GetUserId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &authv1.GetUserResponse{User: &authv1.User{Id: in.GetUserId(), Username: username}}, nil
+}
+
+type applicationTestFixture struct {
+	svc          *Service
+	store        *invitefakes.InviteStore
+	applications *invitefakes.CampaignApplicationStore
+	outbox       *invitefakes.OutboxStore
+	discovery    *fakeDiscoveryClient
+	game         *fakeParticipantClient
+}
+
+func newApplicationTestFixture() applicationTestFixture {
+	f := applicationTestFixture{
+		store:        invitefakes.NewInviteStore(),
+		applications: invitefakes.NewCampaignApplicationStore(),
+		outbox:       invitefakes.NewOutboxStore(),
+		discovery:    &fakeDiscoveryClient{},
+		game: &fakeParticipantClient{
+			listResp: &gamev1.ListParticipantsResponse{},
+			createResp: &gamev1.CreateParticipantResponse{
+				Participant: &gamev1.Participant{Id: "part-new", Name: "wanderer"},
+			},
+		},
+	}
+	f.svc = NewService(Deps{
+		Store:        f.store,
+		Applications: f.applications,
+		Outbox:       f.outbox,
+		Game:         f.game,
+		Auth:         &fakeAuthClient{usernames: map[string]string{"user-1": "wanderer"}},
+		Discovery:    f.discovery,
+		IDGenerator:  newIDGenerator(),
+		Clock:        testClock,
+	})
+	return f
+}
+
+func pendingApplication() storage.CampaignApplicationRecord {
+	return storage.CampaignApplicationRecord{
+		ID:              "app-1",
+		CampaignID:      "camp-1",
+		ApplicantUserID: "user-1",
+		Message:         "Fridays work for me",
+		Status:          storage.ApplicationStatusPending,
+		CreatedAt:       testClock(),
+		UpdatedAt:       testClock(),
+	}
+}
+
+func TestSubmitCampaignApplication_Validation(t *testing.T) {
+	t.Parallel()
+	f := newApplicationTestFixture()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *invitev1.SubmitCampaignApplicationRequest
+		code codes.Code
+	}{
+		{name: "nil request", ctx: ctxWithUserID("user-1"), code: codes.InvalidArgument},
+		{name: "missing campaign", ctx: ctxWithUserID("user-1"), req: &invitev1.SubmitCampaignApplicationRequest{Message: "hi"}, code: codes.InvalidArgument},
+		{name: "missing message", ctx: ctxWithUserID("user-1"), req: &invitev1.SubmitCampaignApplicationRequest{CampaignId: "camp-1"}, code: codes.InvalidArgument},
+		{name: "anonymous", ctx: context.Background(), req: &invitev1.SubmitCampaignApplicationRequest{CampaignId: "camp-1", Message: "hi"}, code: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.svc.SubmitCampaignApplication(tt.ctx, tt.req)
+			grpcassert.StatusCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestSubmitCampaignApplication_RequiresOpenListing(t *testing.T) {
+	t.Parallel()
+	f := newApplicationTestFixture()
+	f.discovery.listingErr = status.Error(codes.NotFound, "campaign listing not found")
+
+	_, err := f.svc.SubmitCampaignApplication(ctxWithUserID("user-1"), &invitev1.SubmitCampaignApplicationRequest{
+		CampaignId: "camp-1",
+		Message:    "hi",
+	})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestSubmitCampaignApplication_StoresAndNotifies(t *testing.T) {
+	t.Parallel()
+	f := newApplicationTestFixture()
+	req := &invitev1.SubmitCampaignApplicationRequest{
+		CampaignId:       "camp-1",
+		Message:          " Fridays work for me ",
+		CharacterConcept: "Exiled cartographer",
+	}
+
+	resp, err := f.svc.SubmitCampaignApplication(ctxWithUserID("user-1"), req)
+	if err != nil {
+		t.Fatalf("SubmitCampaignApplication: %v", err)
+	}
+	app := resp.GetApplication()
+	if app.GetApplicantUserId() != "user-1" || app.GetMessage() != "Fridays work for me" ||
+		app.GetStatus() != invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_PENDING {
+		t.Fatalf("application = %+v", app)
+	}
+	if len(f.outbox.Events) != 1 || f.outbox.Events[0].EventType != outboxEventApplicationSubmitted {
+		t.Fatalf("outbox events = %+v", f.outbox.Events)
+	}
+	var payload applicationOutboxPayload
+	if err := json.Unmarshal(f.outbox.Events[0].PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.ApplicationID != app.GetId() || payload.ApplicantUserID != "user-1" {
+		t.Fatalf("payload = %+v", payload)
+	}
+
+	_, err = f.svc.SubmitCampaignApplication(ctxWithUserID("user-1"), req)
+	grpcassert.StatusCode(t, err, codes.AlreadyExists)
+}
+
+func TestSubmitCampaignApplication_RejectsSeatedUser(t *testing.T) {
+	t.Parallel()
+	f := newApplicationTestFixture()
+	f.game.listResp = &gamev1.ListParticipantsResponse{
+		Participants: []*gamev1.Participant{{Id: "part-1", UserId: "user-1"}},
+	}
+
+	_, err := f.svc.SubmitCampaignApplication(ctxWithUserID("user-1"), &invitev1.SubmitCampaignApplicationRequest{
+		CampaignId: "camp-1",
+		Message:    "hi",
+	})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestAcceptCampaignApplication_ReservesSeatAndInvitesApplicant(t *testing.T) {
+	t.Parallel()
+	f := newApplicationTestFixture()
+	f.applications.Applications["app-1"] = pendingApplication()
+
+	resp, err := f.svc.AcceptCampaignApplication(context.Background(), &invitev1.AcceptCampaignApplicationRequest{
+		CampaignId:             "camp-1",
+		ApplicationId:          "app-1",
+		CreatedByParticipantId: "part-gm",
+	})
+	if err != nil {
+		t.Fatalf("AcceptCampaignApplication: %v", err)
+	}
+	if len(f.game.createReqs) != 1 {
+		t.Fatalf("create participant calls = %d, want 1", len(f.game.createReqs))
+	}
+	if got := f.game.createReqs[0]; got.GetUserId() != "" || got.GetName() != "wanderer" || got.GetRole() != gamev1.ParticipantRole_PLAYER {
+		t.Fatalf("create participant request = %+v, want unbound player seat named after applicant", got)
+	}
+	inv := resp.GetInvite()
+	if inv.GetParticipantId() != "part-new" || inv.GetRecipientUserId() != "user-1" || inv.GetCreatedByParticipantId() != "part-gm" {
+		t.Fatalf("invite = %+v", inv)
+	}
+	stored := f.applications.Applications["app-1"]
+	if stored.Status != storage.ApplicationStatusAccepted || stored.InviteID != inv.GetId() || stored.ParticipantID != "part-new" {
+		t.Fatalf("stored application = %+v", stored)
+	}
+	if len(f.outbox.Events) != 1 || f.outbox.Events[0].EventType != outboxEventCreated {
+		t.Fatalf("outbox events = %+v, want invite created", f.outbox.Events)
+	}
+
+	_, err = f.svc.AcceptCampaignApplication(context.Background(), &invitev1.AcceptCampaignApplicationRequest{
+		CampaignId:    "camp-1",
+		ApplicationId: "app-1",
+	})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+}
+
+// barrierParticipantClient holds every seat check until all callers arrive,
+// so concurrent accepts all pass validation before any of them proceeds.
+type barrierParticipantClient struct {
+	*fakeParticipantClient
+
+	mu      sync.Mutex
+	waiting int
+	callers int
+	release chan struct{}
+	creates int
+}
+
+func (c *barrierParticipantClient) ListParticipants(ctx context.Context, in *gamev1.ListParticipantsRequest, opts ...grpc.CallOption) (*gamev1.ListParticipantsResponse, error) {
+	c.mu.Lock()
+	c.waiting++
+	if c.waiting == c.callers {
+		close(c.release)
+	}
+	c.mu.Unlock()
+	<-c.release
+	return c.fakeParticipantClient.ListParticipants(ctx, in, opts...)
+}
+
+func (c *barrierParticipantClient) CreateParticipant(_ context.Context, _ *gamev1.CreateParticipantRequest, _ ...grpc.CallOption) (*gamev1.CreateParticipantResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.creates++
+	return c.createResp, nil
+}
+
+func TestAcceptCampaignApplication_ConcurrentAcceptsCreateOneSeat(t *testing.T) {
+	t.Parallel()
+	const accepts = 8
+	f := newApplicationTestFixture()
+	f.applications.Applications["app-1"] = pendingApplication()
+	game := &barrierParticipantClient{fakeParticipantClient: f.game, callers: accepts, release: make(chan struct{})}
+	f.svc.game = game
+
+	var wg sync.WaitGroup
+	errs := make([]error, accepts)
+	for i := range accepts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = f.svc.AcceptCampaignApplication(context.Background(), &invitev1.AcceptCampaignApplicationRequest{
+				CampaignId:    "camp-1",
+				ApplicationId: "app-1",
+			})
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+	}
+	if succeeded != 1 {
+		t.Fatalf("successful accepts = %d, want 1", succeeded)
+	}
+	if game.creates != 1 {
+		t.Fatalf("create participant calls = %d, want 1", game.creates)
+	}
+	if stored := f.applications.Applications["app-1"]; stored.ParticipantID != "part-new" || stored.InviteID == "" {
+		t.Fatalf("stored application = %+v, want seat and invite recorded", stored)
+	}
+}
+
+func TestAcceptCampaignApplication_SeatFailureReturnsApplicationToPending(t *testing.T) {
+	t.Parallel()
+	f := newApplicationTestFixture()
+	f.applications.Applications["app-1"] = pendingApplication()
+	f.game.createErr = status.Error(codes.Unavailable, "game down")
+
+	_, err := f.svc.AcceptCampaignApplication(context.Background(), &invitev1.AcceptCampaignApplicationRequest{
+		CampaignId:    "camp-1",
+		ApplicationId: "app-1",
+	})
+	grpcassert.StatusCode(t, err, codes.Unavailable)
+	if stored := f.applications.Applications["app-1"]; stored.Status != storage.ApplicationStatusPending {
+		t.Fatalf("stored status = %s, want pending after failed seat", stored.Status)
+	}
+	if len(f.store.Invites) != 0 {
+		t.Fatalf("invites = %d, want none", len(f.store.Invites))
+	}
+
+	f.game.createErr = nil
+	if _, err := f.svc.AcceptCampaignApplication(context.Background(), &invitev1.AcceptCampaignApplicationRequest{
+		CampaignId:    "camp-1",
+		ApplicationId: "app-1",
+	}); err != nil {
+		t.Fatalf("retry AcceptCampaignApplication: %v", err)
+	}
+}
+
+func TestAcceptCampaignApplication_FailureAfterSeatRemovesSeat(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		fail       func(f applicationTestFixture)
+		revokedInv bool
+	}{
+		{
+			name: "store invite",
+			fail: func(f applicationTestFixture) { f.store.PutErr = errors.New("disk full") },
+		},
+		{
+			name:       "record application",
+			fail:       func(f applicationTestFixture) { f.applications.CompleteErr = errors.New("disk full") },
+			revokedInv: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := newApplicationTestFixture()
+			f.applications.Applications["app-1"] = pendingApplication()
+			tt.fail(f)
+
+			_, err := f.svc.AcceptCampaignApplication(context.Background(), &invitev1.AcceptCampaignApplicationRequest{
+				CampaignId:    "camp-1",
+				ApplicationId: "app-1",
+			})
+			grpcassert.StatusCode(t, err, codes.Internal)
+			if len(f.game.deleteReqs) != 1 || f.game.deleteReqs[0].GetParticipantId() != "part-new" || f.game.deleteReqs[0].GetCampaignId() != "camp-1" {
+				t.Fatalf("delete participant requests = %+v, want the created seat", f.game.deleteReqs)
+			}
+			if stored := f.applications.Applications["app-1"]; stored.Status != storage.ApplicationStatusPending {
+				t.Fatalf("stored status = %s, want pending after failed accept", stored.Status)
+			}
+			for _, inv := range f.store.Invites {
+				if !tt.revokedInv || inv.Status != storage.StatusRevoked {
+					t.Fatalf("invite = %+v, want none left pending", inv)
+				}
+			}
+			if len(f.outbox.Events) != 0 {
+				t.Fatalf("outbox events = %+v, want none", f.outbox.Events)
+			}
+		})
+	}
+}
+
+func TestDeclineCampaignApplication_NotifiesApplicant(t *testing.T) {
+	t.Parallel()
+	f := newApplicationTestFixture()
+	f.applications.Applications["app-1"] = pendingApplication()
+
+	_, err := f.svc.DeclineCampaignApplication(context.Background(), &invitev1.DeclineCampaignApplicationRequest{
+		CampaignId:    "camp-2",
+		ApplicationId: "app-1",
+	})
+	grpcassert.StatusCode(t, err, codes.InvalidArgument)
+
+	resp, err := f.svc.DeclineCampaignApplication(context.Background(), &invitev1.DeclineCampaignApplicationRequest{
+		CampaignId:    "camp-1",
+		ApplicationId: "app-1",
+	})
+	if err != nil {
+		t.Fatalf("DeclineCampaignApplication: %v", err)
+	}
+	if resp.GetApplication().GetStatus() != invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_DECLINED {
+		t.Fatalf("application = %+v", resp.GetApplication())
+	}
+	if len(f.outbox.Events) != 1 || f.outbox.Events[0].EventType != outboxEventApplicationDeclined {
+		t.Fatalf("outbox events = %+v", f.outbox.Events)
+	}
+}
+
+func TestCampaignApplications_NotConfigured(t *testing.T) {
+	t.Parallel()
+	svc := newTestService(invitefakes.NewInviteStore(), nil)
+	_, err := svc.SubmitCampaignApplication(ctxWithUserID("user-1"), &invitev1.SubmitCampaignApplicationRequest{CampaignId: "camp-1", Message: "hi"})
+	grpcassert.StatusCode(t, err, codes.Unimplemented)
+	_, err = svc.ListCampaignApplications(context.Background(), &invitev1.ListCampaignApplicationsRequest{CampaignId: "camp-1"})
+	grpcassert.StatusCode(t, err, codes.Unimplemented)
+}
@@ -0,0 +1,283 @@
+package invite
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/joingrant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClaimInviteLink reserves a link use for the caller. A link that needs GM
+// approval leaves the claim pending; otherwise the claim is reserved already
+// joined, so no approval can race it, and the seat is created and recorded.
+// Any failure after the reservation removes the seat and returns the use.
+func (s *Service) ClaimInviteLink(ctx context.Context, in *invitev1.ClaimInviteLinkRequest) (*invitev1.ClaimInviteLinkResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.links == nil {
+		return nil, status.Error(codes.Unimplemented, "invite links are not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	linkID := strings.TrimSpace(in.GetLinkId())
+	if linkID == "" {
+		return nil, status.Error(codes.InvalidArgument, "link_id is required")
+	}
+	joinGrantToken := strings.TrimSpace(in.GetJoinGrant())
+	if joinGrantToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "join_grant is required")
+	}
+	userID := userIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "user identity is required")
+	}
+
+	link, err := s.loadInviteLink(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if link.CampaignID != campaignID {
+		return nil, status.Error(codes.InvalidArgument, "invite link campaign does not match")
+	}
+	now := s.clock()
+	if link.Status != storage.LinkStatusActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "invite link already %s", link.Status)
+	}
+	if link.Expired(now) {
+		return nil, status.Error(codes.FailedPrecondition, "invite link expired")
+	}
+
+	// Join grants for links carry the link ID in the invite_id claim.
+	if s.verifier != nil {
+		if _, err := s.verifier.Validate(joinGrantToken, joingrant.Expectation{
+			CampaignID: campaignID,
+			InviteID:   linkID,
+			UserID:     userID,
+		}); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "invalid join grant: %v", err)
+		}
+	}
+
+	if s.game != nil && userHasSeatInCampaign(gameReadContext(ctx), s.game, campaignID, userID) {
+		return nil, status.Error(codes.FailedPrecondition, "user already has a seat in this campaign")
+	}
+
+	claimID, err := s.idGenerator()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate invite link claim id: %v", err)
+	}
+	claim := storage.InviteLinkClaimRecord{
+		ID:         claimID,
+		LinkID:     linkID,
+		CampaignID: campaignID,
+		UserID:     userID,
+		Status:     storage.ClaimStatusJoined,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if link.RequiresApproval {
+		claim.Status = storage.ClaimStatusPending
+	}
+	if err := s.links.ReserveInviteLinkClaim(ctx, claim); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrLinkUnavailable):
+			return nil, status.Error(codes.FailedPrecondition, "invite link has no remaining uses")
+		case errors.Is(err, storage.ErrClaimExists):
+			return nil, status.Error(codes.AlreadyExists, "invite link already claimed")
+		default:
+			return nil, status.Errorf(codes.Internal, "reserve invite link claim: %v", err)
+		}
+	}
+	if link.RequiresApproval {
+		return &invitev1.ClaimInviteLinkResponse{Claim: inviteLinkClaimToProto(claim)}, nil
+	}
+
+	participant, err := s.createLinkSeat(ctx, campaignID, userID, link.Role)
+	if err != nil {
+		// Return the reserved use so a failed seat does not burn the link.
+		_ = s.links.DeleteInviteLinkClaim(ctx, claimID, s.clock())
+		return nil, err
+	}
+	claim, err = s.completeLinkClaim(ctx, claim, participant.GetId())
+	if err != nil {
+		s.removeSeat(ctx, campaignID, participant.GetId(), "invite link claim")
+		_ = s.links.DeleteInviteLinkClaim(ctx, claimID, s.clock())
+		return nil, err
+	}
+	return &invitev1.ClaimInviteLinkResponse{
+		Claim:       inviteLinkClaimToProto(claim),
+		Participant: participantSummaryToProto(participant),
+	}, nil
+}
+
+func (s *Service) ListInviteLinkClaims(ctx context.Context, in *invitev1.ListInviteLinkClaimsRequest) (*invitev1.ListInviteLinkClaimsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.links == nil {
+		return nil, status.Error(codes.Unimplemented, "invite links are not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	page, err := s.links.ListInviteLinkClaims(ctx, campaignID, strings.TrimSpace(in.GetLinkId()), "", int(in.GetPageSize()), in.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list invite link claims: %v", err)
+	}
+	claims := make([]*invitev1.InviteLinkClaim, 0, len(page.Claims))
+	for _, claim := range page.Claims {
+		claims = append(claims, inviteLinkClaimToProto(claim))
+	}
+	return &invitev1.ListInviteLinkClaimsResponse{Claims: claims, NextPageToken: page.NextPageToken}, nil
+}
+
+// ApproveInviteLinkClaim creates the seat for a pending claim. The claim is
+// marked joined before the seat is created, so concurrent approvals cannot
+// each create a seat; any failure after that removes the seat and returns the
+// claim to pending.
+func (s *Service) ApproveInviteLinkClaim(ctx context.Context, in *invitev1.ApproveInviteLinkClaimRequest) (*invitev1.ApproveInviteLinkClaimResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	claim, err := s.loadPendingLinkClaim(ctx, in.GetCampaignId(), in.GetClaimId())
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.loadInviteLink(ctx, claim.LinkID)
+	if err != nil {
+		return nil, err
+	}
+	if s.game != nil && userHasSeatInCampaign(gameReadContext(ctx), s.game, claim.CampaignID, claim.UserID) {
+		return nil, status.Error(codes.FailedPrecondition, "user already has a seat in this campaign")
+	}
+	if s.game == nil {
+		return nil, status.Error(codes.Unavailable, "game participant service is not configured")
+	}
+	if err := s.links.AcceptInviteLinkClaim(ctx, claim.ID, s.clock()); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "invite link claim is no longer pending")
+		}
+		return nil, status.Errorf(codes.Internal, "approve invite link claim: %v", err)
+	}
+	participant, err := s.createLinkSeat(ctx, claim.CampaignID, claim.UserID, link.Role)
+	if err != nil {
+		// Return the claim to pending so the GM can retry.
+		_ = s.links.ReleaseInviteLinkClaim(ctx, claim.ID, s.clock())
+		return nil, err
+	}
+	joined, err := s.completeLinkClaim(ctx, claim, participant.GetId())
+	if err != nil {
+		s.removeSeat(ctx, claim.CampaignID, participant.GetId(), "invite link claim")
+		_ = s.links.ReleaseInviteLinkClaim(ctx, claim.ID, s.clock())
+		return nil, err
+	}
+	return &invitev1.ApproveInviteLinkClaimResponse{
+		Claim:       inviteLinkClaimToProto(joined),
+		Participant: participantSummaryToProto(participant),
+	}, nil
+}
+
+func (s *Service) DeclineInviteLinkClaim(ctx context.Context, in *invitev1.DeclineInviteLinkClaimRequest) (*invitev1.DeclineInviteLinkClaimResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	claim, err := s.loadPendingLinkClaim(ctx, in.GetCampaignId(), in.GetClaimId())
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock()
+	if err := s.links.DeclineInviteLinkClaim(ctx, claim.ID, now); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "invite link claim is no longer pending")
+		}
+		return nil, status.Errorf(codes.Internal, "decline invite link claim: %v", err)
+	}
+	claim.Status = storage.ClaimStatusDeclined
+	claim.UpdatedAt = now
+	return &invitev1.DeclineInviteLinkClaimResponse{Claim: inviteLinkClaimToProto(claim)}, nil
+}
+
+// loadPendingLinkClaim validates a GM claim decision request and loads the
+// pending claim it targets.
+func (s *Service) loadPendingLinkClaim(ctx context.Context, campaignID, claimID string) (storage.InviteLinkClaimRecord, error) {
+	if s.links == nil {
+		return storage.InviteLinkClaimRecord{}, status.Error(codes.Unimplemented, "invite links are not configured")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return storage.InviteLinkClaimRecord{}, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	claimID = strings.TrimSpace(claimID)
+	if claimID == "" {
+		return storage.InviteLinkClaimRecord{}, status.Error(codes.InvalidArgument, "claim_id is required")
+	}
+	claim, err := s.links.GetInviteLinkClaim(ctx, claimID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.InviteLinkClaimRecord{}, status.Error(codes.NotFound, "invite link claim not found")
+		}
+		return storage.InviteLinkClaimRecord{}, status.Errorf(codes.Internal, "load invite link claim: %v", err)
+	}
+	if claim.CampaignID != campaignID {
+		return storage.InviteLinkClaimRecord{}, status.Error(codes.InvalidArgument, "invite link claim campaign does not match")
+	}
+	if claim.Status != storage.ClaimStatusPending {
+		return storage.InviteLinkClaimRecord{}, status.Errorf(codes.FailedPrecondition, "invite link claim already %s", claim.Status)
+	}
+	return claim, nil
+}
+
+// createLinkSeat creates a participant seat bound to the claimant. The invite
+// service has already authorized the claim, so the game write runs under an
+// admin override; the game resolves the seat name from the user's profile.
+func (s *Service) createLinkSeat(ctx context.Context, campaignID, userID string, role storage.LinkRole) (*gamev1.Participant, error) {
+	if s.game == nil {
+		return nil, status.Error(codes.Unavailable, "game participant service is not configured")
+	}
+	resp, err := s.game.CreateParticipant(grpcauthctx.WithAdminOverride(ctx, "invite link claim"), &gamev1.CreateParticipantRequest{
+		CampaignId: campaignID,
+		UserId:     userID,
+		Role:       linkRoleToGame(role),
+		Controller: gamev1.Controller_CONTROLLER_HUMAN,
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "create participant: %v", err)
+	}
+	return resp.GetParticipant(), nil
+}
+
+// removeSeat deletes a seat created for a join that could not be recorded.
+// Cleanup is best effort; the caller reports the original failure.
+func (s *Service) removeSeat(ctx context.Context, campaignID, participantID, reason string) {
+	_, _ = s.game.DeleteParticipant(grpcauthctx.WithAdminOverride(ctx, reason), &gamev1.DeleteParticipantRequest{
+		CampaignId:    campaignID,
+		ParticipantId: participantID,
+		Reason:        reason + " failed",
+	})
+}
+
+// completeLinkClaim records the seat created for a joined claim.
+func (s *Service) completeLinkClaim(ctx context.Context, claim storage.InviteLinkClaimRecord, participantID string) (storage.InviteLinkClaimRecord, error) {
+	now := s.clock()
+	if err := s.links.CompleteInviteLinkClaim(ctx, claim.ID, participantID, now); err != nil {
+		return storage.InviteLinkClaimRecord{}, status.Errorf(codes.Internal, "complete invite link claim: %v", err)
+	}
+	claim.Status = storage.ClaimStatusJoined
+	claim.ParticipantID = participantID
+	claim.UpdatedAt = now
+	return claim, nil
+}
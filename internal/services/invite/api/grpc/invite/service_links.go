@@ -0,0 +1,170 @@
+package invite
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) CreateInviteLink(ctx context.Context, in *invitev1.CreateInviteLinkRequest) (*invitev1.CreateInviteLinkResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.links == nil {
+		return nil, status.Error(codes.Unimplemented, "invite links are not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	if in.GetMaxUses() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_uses must not be negative")
+	}
+	now := s.clock()
+	rec := storage.InviteLinkRecord{
+		CampaignID:             campaignID,
+		CreatedByParticipantID: strings.TrimSpace(in.GetCreatedByParticipantId()),
+		Role:                   linkRoleFromProto(in.GetDefaultRole()),
+		MaxUses:                int(in.GetMaxUses()),
+		RequiresApproval:       in.GetRequiresApproval(),
+		Status:                 storage.LinkStatusActive,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}
+	if in.GetExpiresAt() != nil {
+		expiresAt := in.GetExpiresAt().AsTime()
+		if !expiresAt.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		rec.ExpiresAt = &expiresAt
+	}
+
+	if s.gameCampaign != nil {
+		if _, err := s.gameCampaign.GetCampaign(gameReadContext(ctx), &gamev1.GetCampaignRequest{CampaignId: campaignID}); err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "campaign not found")
+			}
+			return nil, status.Errorf(codes.Internal, "check campaign: %v", err)
+		}
+	}
+
+	linkID, err := s.idGenerator()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate invite link id: %v", err)
+	}
+	rec.ID = linkID
+	if err := s.links.PutInviteLink(ctx, rec); err != nil {
+		return nil, status.Errorf(codes.Internal, "store invite link: %v", err)
+	}
+	return &invitev1.CreateInviteLinkResponse{Link: inviteLinkToProto(rec)}, nil
+}
+
+func (s *Service) GetPublicInviteLink(ctx context.Context, in *invitev1.GetPublicInviteLinkRequest) (*invitev1.GetPublicInviteLinkResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.links == nil {
+		return nil, status.Error(codes.Unimplemented, "invite links are not configured")
+	}
+	linkID := strings.TrimSpace(in.GetLinkId())
+	if linkID == "" {
+		return nil, status.Error(codes.InvalidArgument, "link_id is required")
+	}
+	link, err := s.loadInviteLink(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &invitev1.GetPublicInviteLinkResponse{Link: inviteLinkToProto(link)}
+	gameCtx := gameReadContext(ctx)
+	if s.gameCampaign != nil {
+		campaignResp, err := s.gameCampaign.GetCampaign(gameCtx, &gamev1.GetCampaignRequest{CampaignId: link.CampaignID})
+		if err == nil && campaignResp.GetCampaign() != nil {
+			c := campaignResp.GetCampaign()
+			resp.Campaign = &invitev1.InviteCampaignSummary{
+				Id:     c.GetId(),
+				Name:   c.GetName(),
+				Status: c.GetStatus().String(),
+			}
+		}
+	}
+	if s.auth != nil && link.CreatedByParticipantID != "" {
+		resp.CreatedByUser = loadCreatorUser(gameCtx, s.game, s.auth, link.CampaignID, link.CreatedByParticipantID)
+	}
+	return resp, nil
+}
+
+func (s *Service) ListInviteLinks(ctx context.Context, in *invitev1.ListInviteLinksRequest) (*invitev1.ListInviteLinksResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.links == nil {
+		return nil, status.Error(codes.Unimplemented, "invite links are not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	page, err := s.links.ListInviteLinks(ctx, campaignID, int(in.GetPageSize()), in.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list invite links: %v", err)
+	}
+	links := make([]*invitev1.InviteLink, 0, len(page.Links))
+	for _, link := range page.Links {
+		links = append(links, inviteLinkToProto(link))
+	}
+	return &invitev1.ListInviteLinksResponse{Links: links, NextPageToken: page.NextPageToken}, nil
+}
+
+func (s *Service) RevokeInviteLink(ctx context.Context, in *invitev1.RevokeInviteLinkRequest) (*invitev1.RevokeInviteLinkResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.links == nil {
+		return nil, status.Error(codes.Unimplemented, "invite links are not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+	linkID := strings.TrimSpace(in.GetLinkId())
+	if linkID == "" {
+		return nil, status.Error(codes.InvalidArgument, "link_id is required")
+	}
+	link, err := s.loadInviteLink(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if link.CampaignID != campaignID {
+		return nil, status.Error(codes.InvalidArgument, "invite link campaign does not match")
+	}
+	if link.Status != storage.LinkStatusActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "invite link already %s", link.Status)
+	}
+
+	now := s.clock()
+	if err := s.links.RevokeInviteLink(ctx, linkID, now); err != nil {
+		return nil, status.Errorf(codes.Internal, "revoke invite link: %v", err)
+	}
+	link.Status = storage.LinkStatusRevoked
+	link.UpdatedAt = now
+	return &invitev1.RevokeInviteLinkResponse{Link: inviteLinkToProto(link)}, nil
+}
+
+// loadInviteLink maps link lookup failures to transport statuses.
+func (s *Service) loadInviteLink(ctx context.Context, linkID string) (storage.InviteLinkRecord, error) {
+	link, err := s.links.GetInviteLink(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.InviteLinkRecord{}, status.Error(codes.NotFound, "invite link not found")
+		}
+		return storage.InviteLinkRecord{}, status.Errorf(codes.Internal, "load invite link: %v", err)
+	}
+	return link, nil
+}
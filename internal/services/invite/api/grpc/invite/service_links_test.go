@@ -0,0 +1,576 @@
+package invite
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+	"github.com/louisbranch/fracturing.space/internal/test/grpcassert"
+	"github.com/louisbranch/fracturing.space/internal/test/mock/invitefakes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newLinkTestService(links *invitefakes.InviteLinkStore, game *fakeParticipantClient) *Service {
+	deps := Deps{
+		Store:       invitefakes.NewInviteStore(),
+		Links:       links,
+		IDGenerator: newIDGenerator(),
+		Clock:       testClock,
+	}
+	if game != nil {
+		deps.Game = game
+	}
+	return NewService(deps)
+}
+
+// activeLink returns a seeded unlimited link that joins players directly.
+func activeLink() storage.InviteLinkRecord {
+	return storage.InviteLinkRecord{
+		ID:         "link-1",
+		CampaignID: "camp-1",
+		Role:       storage.LinkRolePlayer,
+		Status:     storage.LinkStatusActive,
+		CreatedAt:  testClock().Add(-time.Hour),
+		UpdatedAt:  testClock().Add(-time.Hour),
+	}
+}
+
+func seatedGame(participantID string) *fakeParticipantClient {
+	return &fakeParticipantClient{
+		listResp: &gamev1.ListParticipantsResponse{},
+		createResp: &gamev1.CreateParticipantResponse{
+			Participant: &gamev1.Participant{Id: participantID, Name: "Newcomer", UserId: "user-1"},
+		},
+	}
+}
+
+func claimLink(t *testing.T, svc *Service, userID string) (*invitev1.ClaimInviteLinkResponse, error) {
+	t.Helper()
+	return svc.ClaimInviteLink(ctxWithUserID(userID), &invitev1.ClaimInviteLinkRequest{
+		CampaignId: "camp-1",
+		LinkId:     "link-1",
+		JoinGrant:  "grant-token",
+	})
+}
+
+func TestCreateInviteLink_Validation(t *testing.T) {
+	t.Parallel()
+	svc := newLinkTestService(invitefakes.NewInviteLinkStore(), nil)
+	past := timestamppb.New(testClock().Add(-time.Minute))
+	tests := []struct {
+		name string
+		req  *invitev1.CreateInviteLinkRequest
+	}{
+		{name: "nil request", req: nil},
+		{name: "missing campaign", req: &invitev1.CreateInviteLinkRequest{}},
+		{name: "negative max uses", req: &invitev1.CreateInviteLinkRequest{CampaignId: "camp-1", MaxUses: -1}},
+		{name: "past expiry", req: &invitev1.CreateInviteLinkRequest{CampaignId: "camp-1", ExpiresAt: past}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateInviteLink(context.Background(), tt.req)
+			grpcassert.StatusCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+func TestCreateInviteLink_NotConfigured(t *testing.T) {
+	t.Parallel()
+	svc := newTestService(invitefakes.NewInviteStore(), nil)
+	_, err := svc.CreateInviteLink(context.Background(), &invitev1.CreateInviteLinkRequest{CampaignId: "camp-1"})
+	grpcassert.StatusCode(t, err, codes.Unimplemented)
+}
+
+func TestCreateInviteLink_HappyPath(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	svc := newLinkTestService(links, nil)
+	expiresAt := testClock().Add(48 * time.Hour)
+
+	resp, err := svc.CreateInviteLink(context.Background(), &invitev1.CreateInviteLinkRequest{
+		CampaignId:             "camp-1",
+		CreatedByParticipantId: "gm-1",
+		DefaultRole:            invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM,
+		MaxUses:                5,
+		ExpiresAt:              timestamppb.New(expiresAt),
+		RequiresApproval:       true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	link := resp.GetLink()
+	if link.GetStatus() != invitev1.InviteLinkStatus_INVITE_LINK_ACTIVE {
+		t.Fatalf("status = %v, want active", link.GetStatus())
+	}
+	if link.GetDefaultRole() != invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM || link.GetMaxUses() != 5 || !link.GetRequiresApproval() {
+		t.Fatalf("link = %+v, want gm role, 5 uses, approval", link)
+	}
+	stored, ok := links.Links[link.GetId()]
+	if !ok {
+		t.Fatalf("link %q not stored", link.GetId())
+	}
+	if stored.ExpiresAt == nil || !stored.ExpiresAt.Equal(expiresAt) {
+		t.Fatalf("stored expires_at = %v, want %v", stored.ExpiresAt, expiresAt)
+	}
+}
+
+func TestCreateInviteLink_DefaultsToPlayerRole(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	svc := newLinkTestService(links, nil)
+
+	resp, err := svc.CreateInviteLink(context.Background(), &invitev1.CreateInviteLinkRequest{CampaignId: "camp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := links.Links[resp.GetLink().GetId()].Role; got != storage.LinkRolePlayer {
+		t.Fatalf("role = %q, want player", got)
+	}
+}
+
+func TestRevokeInviteLink(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	links.Links["link-1"] = activeLink()
+	svc := newLinkTestService(links, nil)
+
+	resp, err := svc.RevokeInviteLink(context.Background(), &invitev1.RevokeInviteLinkRequest{CampaignId: "camp-1", LinkId: "link-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetLink().GetStatus() != invitev1.InviteLinkStatus_INVITE_LINK_REVOKED {
+		t.Fatalf("status = %v, want revoked", resp.GetLink().GetStatus())
+	}
+
+	_, err = svc.RevokeInviteLink(context.Background(), &invitev1.RevokeInviteLinkRequest{CampaignId: "camp-1", LinkId: "link-1"})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestRevokeInviteLink_CampaignMismatch(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	links.Links["link-1"] = activeLink()
+	svc := newLinkTestService(links, nil)
+
+	_, err := svc.RevokeInviteLink(context.Background(), &invitev1.RevokeInviteLinkRequest{CampaignId: "camp-2", LinkId: "link-1"})
+	grpcassert.StatusCode(t, err, codes.InvalidArgument)
+	if links.Links["link-1"].Status != storage.LinkStatusActive {
+		t.Fatal("link was revoked through another campaign")
+	}
+}
+
+func TestGetPublicInviteLink(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	links.Links["link-1"] = activeLink()
+	svc := newLinkTestService(links, nil)
+
+	resp, err := svc.GetPublicInviteLink(context.Background(), &invitev1.GetPublicInviteLinkRequest{LinkId: "link-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetLink().GetCampaignId() != "camp-1" {
+		t.Fatalf("campaign = %q, want camp-1", resp.GetLink().GetCampaignId())
+	}
+
+	_, err = svc.GetPublicInviteLink(context.Background(), &invitev1.GetPublicInviteLinkRequest{LinkId: "missing"})
+	grpcassert.StatusCode(t, err, codes.NotFound)
+}
+
+func TestClaimInviteLink_Validation(t *testing.T) {
+	t.Parallel()
+	svc := newLinkTestService(invitefakes.NewInviteLinkStore(), nil)
+	tests := []struct {
+		name string
+		req  *invitev1.ClaimInviteLinkRequest
+	}{
+		{name: "nil request", req: nil},
+		{name: "missing campaign", req: &invitev1.ClaimInviteLinkRequest{LinkId: "link-1", JoinGrant: "g"}},
+		{name: "missing link", req: &invitev1.ClaimInviteLinkRequest{CampaignId: "camp-1", JoinGrant: "g"}},
+		{name: "missing grant", req: &invitev1.ClaimInviteLinkRequest{CampaignId: "camp-1", LinkId: "link-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ClaimInviteLink(ctxWithUserID("user-1"), tt.req)
+			grpcassert.StatusCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+func TestClaimInviteLink_RequiresUser(t *testing.T) {
+	t.Parallel()
+	svc := newLinkTestService(invitefakes.NewInviteLinkStore(), nil)
+	_, err := svc.ClaimInviteLink(context.Background(), &invitev1.ClaimInviteLinkRequest{CampaignId: "camp-1", LinkId: "link-1", JoinGrant: "g"})
+	grpcassert.StatusCode(t, err, codes.Unauthenticated)
+}
+
+func TestClaimInviteLink_JoinsDirectly(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	links.Links["link-1"] = activeLink()
+	game := seatedGame("part-new")
+	svc := newLinkTestService(links, game)
+
+	resp, err := claimLink(t, svc, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetClaim().GetStatus() != invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_JOINED {
+		t.Fatalf("claim status = %v, want joined", resp.GetClaim().GetStatus())
+	}
+	if resp.GetClaim().GetParticipantId() != "part-new" || resp.GetParticipant().GetId() != "part-new" {
+		t.Fatalf("participant = %q, want part-new", resp.GetClaim().GetParticipantId())
+	}
+	if len(game.createReqs) != 1 {
+		t.Fatalf("create participant calls = %d, want 1", len(game.createReqs))
+	}
+	req := game.createReqs[0]
+	if req.GetUserId() != "user-1" || req.GetRole() != gamev1.ParticipantRole_PLAYER {
+		t.Fatalf("create participant request = %+v, want user-1 as player", req)
+	}
+	if got := links.Links["link-1"].UseCount; got != 1 {
+		t.Fatalf("use count = %d, want 1", got)
+	}
+}
+
+func TestClaimInviteLink_AwaitsApproval(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	link := activeLink()
+	link.RequiresApproval = true
+	links.Links[link.ID] = link
+	game := seatedGame("part-new")
+	svc := newLinkTestService(links, game)
+
+	resp, err := claimLink(t, svc, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetClaim().GetStatus() != invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_PENDING_APPROVAL {
+		t.Fatalf("claim status = %v, want pending approval", resp.GetClaim().GetStatus())
+	}
+	if len(game.createReqs) != 0 {
+		t.Fatal("participant created before GM approval")
+	}
+	if got := links.Links["link-1"].UseCount; got != 1 {
+		t.Fatalf("use count = %d, want pending claim to hold a use", got)
+	}
+}
+
+func TestClaimInviteLink_Rejections(t *testing.T) {
+	t.Parallel()
+	past := testClock().Add(-time.Minute)
+	tests := []struct {
+		name   string
+		mutate func(*storage.InviteLinkRecord)
+		code   codes.Code
+	}{
+		{name: "revoked", mutate: func(l *storage.InviteLinkRecord) { l.Status = storage.LinkStatusRevoked }, code: codes.FailedPrecondition},
+		{name: "expired", mutate: func(l *storage.InviteLinkRecord) { l.ExpiresAt = &past }, code: codes.FailedPrecondition},
+		{name: "exhausted", mutate: func(l *storage.InviteLinkRecord) { l.MaxUses, l.UseCount = 2, 2 }, code: codes.FailedPrecondition},
+		{name: "other campaign", mutate: func(l *storage.InviteLinkRecord) { l.CampaignID = "camp-2" }, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := invitefakes.NewInviteLinkStore()
+			link := activeLink()
+			tt.mutate(&link)
+			links.Links[link.ID] = link
+			game := seatedGame("part-new")
+			svc := newLinkTestService(links, game)
+
+			_, err := claimLink(t, svc, "user-1")
+			grpcassert.StatusCode(t, err, tt.code)
+			if len(game.createReqs) != 0 {
+				t.Fatal("participant created for rejected claim")
+			}
+		})
+	}
+}
+
+func TestClaimInviteLink_VerifierRejects(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	links.Links["link-1"] = activeLink()
+	svc := NewService(Deps{
+		Store:       invitefakes.NewInviteStore(),
+		Links:       links,
+		Game:        seatedGame("part-new"),
+		IDGenerator: newIDGenerator(),
+		Clock:       testClock,
+		Verifier:    &fakeVerifier{err: errors.New("bad grant")},
+	})
+
+	_, err := claimLink(t, svc, "user-1")
+	grpcassert.StatusCode(t, err, codes.PermissionDenied)
+}
+
+func TestClaimInviteLink_UserAlreadySeated(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	links.Links["link-1"] = activeLink()
+	game := seatedGame("part-new")
+	game.listResp = &gamev1.ListParticipantsResponse{
+		Participants: []*gamev1.Participant{{Id: "part-old", UserId: "user-1"}},
+	}
+	svc := newLinkTestService(links, game)
+
+	_, err := claimLink(t, svc, "user-1")
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+	if got := links.Links["link-1"].UseCount; got != 0 {
+		t.Fatalf("use count = %d, want 0", got)
+	}
+}
+
+func TestClaimInviteLink_DuplicateClaim(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	link := activeLink()
+	link.RequiresApproval = true
+	links.Links[link.ID] = link
+	svc := newLinkTestService(links, seatedGame("part-new"))
+
+	if _, err := claimLink(t, svc, "user-1"); err != nil {
+		t.Fatalf("first claim: %v", err)
+	}
+	_, err := claimLink(t, svc, "user-1")
+	grpcassert.StatusCode(t, err, codes.AlreadyExists)
+}
+
+func TestClaimInviteLink_SeatFailureReleasesUse(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	link := activeLink()
+	link.MaxUses = 1
+	links.Links[link.ID] = link
+	game := seatedGame("part-new")
+	game.createErr = status.Error(codes.FailedPrecondition, "campaign is archived")
+	svc := newLinkTestService(links, game)
+
+	_, err := claimLink(t, svc, "user-1")
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+	if got := links.Links["link-1"].UseCount; got != 0 {
+		t.Fatalf("use count = %d, want released use", got)
+	}
+	if len(links.Claims) != 0 {
+		t.Fatalf("claims = %d, want failed claim removed", len(links.Claims))
+	}
+}
+
+func TestClaimInviteLink_CompletionFailureRemovesSeat(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	links.Links["link-1"] = activeLink()
+	links.CompleteErr = errors.New("disk full")
+	game := seatedGame("part-new")
+	svc := newLinkTestService(links, game)
+
+	_, err := claimLink(t, svc, "user-1")
+	grpcassert.StatusCode(t, err, codes.Internal)
+	if len(game.deleteReqs) != 1 || game.deleteReqs[0].GetParticipantId() != "part-new" {
+		t.Fatalf("delete participant requests = %+v, want the created seat", game.deleteReqs)
+	}
+	if len(links.Claims) != 0 {
+		t.Fatalf("claims = %d, want failed claim removed", len(links.Claims))
+	}
+	if got := links.Links["link-1"].UseCount; got != 0 {
+		t.Fatalf("use count = %d, want released use", got)
+	}
+}
+
+func pendingLinkClaim(links *invitefakes.InviteLinkStore) {
+	link := activeLink()
+	link.RequiresApproval = true
+	link.Role = storage.LinkRoleGM
+	link.UseCount = 1
+	links.Links[link.ID] = link
+	links.Claims["claim-1"] = storage.InviteLinkClaimRecord{
+		ID:         "claim-1",
+		LinkID:     link.ID,
+		CampaignID: link.CampaignID,
+		UserID:     "user-1",
+		Status:     storage.ClaimStatusPending,
+		CreatedAt:  testClock(),
+		UpdatedAt:  testClock(),
+	}
+}
+
+func TestApproveInviteLinkClaim(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	pendingLinkClaim(links)
+	game := seatedGame("part-new")
+	svc := newLinkTestService(links, game)
+
+	resp, err := svc.ApproveInviteLinkClaim(context.Background(), &invitev1.ApproveInviteLinkClaimRequest{CampaignId: "camp-1", ClaimId: "claim-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetClaim().GetStatus() != invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_JOINED {
+		t.Fatalf("claim status = %v, want joined", resp.GetClaim().GetStatus())
+	}
+	if len(game.createReqs) != 1 || game.createReqs[0].GetRole() != gamev1.ParticipantRole_GM {
+		t.Fatalf("create participant requests = %+v, want one GM seat", game.createReqs)
+	}
+	if links.Claims["claim-1"].ParticipantID != "part-new" {
+		t.Fatalf("stored participant = %q, want part-new", links.Claims["claim-1"].ParticipantID)
+	}
+
+	_, err = svc.ApproveInviteLinkClaim(context.Background(), &invitev1.ApproveInviteLinkClaimRequest{CampaignId: "camp-1", ClaimId: "claim-1"})
+	grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestApproveInviteLinkClaim_ConcurrentApprovalsCreateOneSeat(t *testing.T) {
+	t.Parallel()
+	const approvals = 8
+	links := invitefakes.NewInviteLinkStore()
+	pendingLinkClaim(links)
+	game := &barrierParticipantClient{fakeParticipantClient: seatedGame("part-new"), callers: approvals, release: make(chan struct{})}
+	svc := newLinkTestService(links, nil)
+	svc.game = game
+
+	var wg sync.WaitGroup
+	errs := make([]error, approvals)
+	for i := range approvals {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = svc.ApproveInviteLinkClaim(context.Background(), &invitev1.ApproveInviteLinkClaimRequest{CampaignId: "camp-1", ClaimId: "claim-1"})
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		grpcassert.StatusCode(t, err, codes.FailedPrecondition)
+	}
+	if succeeded != 1 {
+		t.Fatalf("successful approvals = %d, want 1", succeeded)
+	}
+	if game.creates != 1 {
+		t.Fatalf("create participant calls = %d, want 1", game.creates)
+	}
+}
+
+func TestApproveInviteLinkClaim_FailureReturnsClaimToPending(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		fail    func(links *invitefakes.InviteLinkStore, game *fakeParticipantClient)
+		code    codes.Code
+		deletes int
+	}{
+		{
+			name: "create seat",
+			fail: func(_ *invitefakes.InviteLinkStore, game *fakeParticipantClient) {
+				game.createErr = status.Error(codes.Unavailable, "game down")
+			},
+			code: codes.Unavailable,
+		},
+		{
+			name: "record seat",
+			fail: func(links *invitefakes.InviteLinkStore, _ *fakeParticipantClient) {
+				links.CompleteErr = errors.New("disk full")
+			},
+			code:    codes.Internal,
+			deletes: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			links := invitefakes.NewInviteLinkStore()
+			pendingLinkClaim(links)
+			game := seatedGame("part-new")
+			tt.fail(links, game)
+			svc := newLinkTestService(links, game)
+
+			_, err := svc.ApproveInviteLinkClaim(context.Background(), &invitev1.ApproveInviteLinkClaimRequest{CampaignId: "camp-1", ClaimId: "claim-1"})
+			grpcassert.StatusCode(t, err, tt.code)
+			if len(game.deleteReqs) != tt.deletes {
+				t.Fatalf("delete participant requests = %d, want %d", len(game.deleteReqs), tt.deletes)
+			}
+			if claim := links.Claims["claim-1"]; claim.Status != storage.ClaimStatusPending || claim.ParticipantID != "" {
+				t.Fatalf("claim = %+v, want pending without a seat", claim)
+			}
+			if got := links.Links["link-1"].UseCount; got != 1 {
+				t.Fatalf("use count = %d, want the pending claim's use kept", got)
+			}
+		})
+	}
+}
+
+func TestDeclineInviteLinkClaim(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	pendingLinkClaim(links)
+	svc := newLinkTestService(links, seatedGame("part-new"))
+
+	resp, err := svc.DeclineInviteLinkClaim(context.Background(), &invitev1.DeclineInviteLinkClaimRequest{CampaignId: "camp-1", ClaimId: "claim-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetClaim().GetStatus() != invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_DECLINED {
+		t.Fatalf("claim status = %v, want declined", resp.GetClaim().GetStatus())
+	}
+	if got := links.Links["link-1"].UseCount; got != 0 {
+		t.Fatalf("use count = %d, want released use", got)
+	}
+}
+
+func TestDeclineInviteLinkClaim_CampaignMismatch(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	pendingLinkClaim(links)
+	svc := newLinkTestService(links, nil)
+
+	_, err := svc.DeclineInviteLinkClaim(context.Background(), &invitev1.DeclineInviteLinkClaimRequest{CampaignId: "camp-2", ClaimId: "claim-1"})
+	grpcassert.StatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestListInviteLinkClaims(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	pendingLinkClaim(links)
+	svc := newLinkTestService(links, nil)
+
+	resp, err := svc.ListInviteLinkClaims(context.Background(), &invitev1.ListInviteLinkClaimsRequest{CampaignId: "camp-1", LinkId: "link-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetClaims()) != 1 || resp.GetClaims()[0].GetUserId() != "user-1" {
+		t.Fatalf("claims = %+v, want user-1 claim", resp.GetClaims())
+	}
+
+	_, err = svc.ListInviteLinkClaims(context.Background(), &invitev1.ListInviteLinkClaimsRequest{})
+	grpcassert.StatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestDeleteUserData_RemovesInviteLinkClaims(t *testing.T) {
+	t.Parallel()
+	links := invitefakes.NewInviteLinkStore()
+	pendingLinkClaim(links)
+	svc := newLinkTestService(links, nil)
+
+	resp, err := svc.DeleteUserData(context.Background(), &invitev1.DeleteUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("DeleteUserData: %v", err)
+	}
+	if resp.GetDeletedRecords() != 1 {
+		t.Fatalf("deleted records = %d, want 1", resp.GetDeletedRecords())
+	}
+	if len(links.Claims) != 0 || links.Links["link-1"].UseCount != 0 {
+		t.Fatalf("claims = %d, use count = %d, want claim removed and use released", len(links.Claims), links.Links["link-1"].UseCount)
+	}
+}
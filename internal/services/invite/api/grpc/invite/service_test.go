@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -86,6 +87,12 @@ type fakeParticipantClient struct {
 	bindErr  error
 	listResp *gamev1.ListParticipantsResponse
 	listErr  error
+
+	createResp *gamev1.CreateParticipantResponse
+	createErr  error
+	createReqs []*gamev1.CreateParticipantRequest
+
+	deleteReqs []*gamev1.DeleteParticipantRequest
 }
 
 func (f *fakeParticipantClient) GetParticipant(_ context.Context, _ *gamev1.GetParticipantRequest, _ ...grpc.CallOption) (*gamev1.GetParticipantResponse, error) {
@@ -100,6 +107,16 @@ func (f *fakeParticipantClient) ListParticipants(_ context.Context, _ *gamev1.Li
 	return f.listResp, f.listErr
 }
 
+func (f *fakeParticipantClient) CreateParticipant(_ context.Context, req *gamev1.CreateParticipantRequest, _ ...grpc.CallOption) (*gamev1.CreateParticipantResponse, error) {
+	f.createReqs = append(f.createReqs, req)
+	return f.createResp, f.createErr
+}
+
+func (f *fakeParticipantClient) DeleteParticipant(_ context.Context, req *gamev1.DeleteParticipantRequest, _ ...grpc.CallOption) (*gamev1.DeleteParticipantResponse, error) {
+	f.deleteReqs = append(f.deleteReqs, req)
+	return &gamev1.DeleteParticipantResponse{}, nil
+}
+
 // fakeVerifier implements joingrant.Verifier for testing.
 type fakeVerifier struct {
 	err error
@@ -1130,6 +1147,51 @@ func TestListPendingInvitesForUser_HappyPath(t *testing.T) {
 	}
 }
 
+// ===========================================================================
+// User data tests
+// ===========================================================================
+
+func TestExportUserData_ListsRecipientInvites(t *testing.T) {
+	t.Parallel()
+	store := invitefakes.NewInviteStore()
+	rec := pendingInvite()
+	rec.RecipientUserID = "user-1"
+	seedInvite(store, rec)
+	svc := newTestService(store, nil)
+
+	resp, err := svc.ExportUserData(context.Background(), &invitev1.ExportUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("ExportUserData: %v", err)
+	}
+	if !strings.Contains(resp.GetRecordsJson(), `"id":"inv-1"`) {
+		t.Fatalf("records = %s, want inv-1", resp.GetRecordsJson())
+	}
+}
+
+func TestDeleteUserData_RevokesPendingRecipientInvites(t *testing.T) {
+	t.Parallel()
+	store := invitefakes.NewInviteStore()
+	rec := pendingInvite()
+	rec.RecipientUserID = "user-1"
+	seedInvite(store, rec)
+	svc := newTestService(store, nil)
+
+	resp, err := svc.DeleteUserData(context.Background(), &invitev1.DeleteUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("DeleteUserData: %v", err)
+	}
+	if resp.GetDeletedRecords() != 1 {
+		t.Fatalf("deleted records = %d, want 1", resp.GetDeletedRecords())
+	}
+	got := store.Invites["inv-1"]
+	if got.Status != storage.StatusRevoked || got.RecipientUserID != "" {
+		t.Fatalf("invite = %+v, want revoked without recipient", got)
+	}
+
+	_, err = svc.DeleteUserData(context.Background(), &invitev1.DeleteUserDataRequest{})
+	grpcassert.StatusCode(t, err, codes.InvalidArgument)
+}
+
 // ===========================================================================
 // LeaseIntegrationOutboxEvents tests
 // ===========================================================================
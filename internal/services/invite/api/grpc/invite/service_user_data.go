@@ -0,0 +1,170 @@
+package invite
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const userDataExportPageSize = 50
+
+type exportedInvite struct {
+	ID            string    `json:"id"`
+	CampaignID    string    `json:"campaign_id"`
+	ParticipantID string    `json:"participant_id"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type exportedInviteLinkClaim struct {
+	ID            string    `json:"id"`
+	LinkID        string    `json:"link_id"`
+	CampaignID    string    `json:"campaign_id"`
+	ParticipantID string    `json:"participant_id,omitempty"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type exportedCampaignApplication struct {
+	ID               string    `json:"id"`
+	CampaignID       string    `json:"campaign_id"`
+	Message          string    `json:"message"`
+	CharacterConcept string    `json:"character_concept,omitempty"`
+	Status           string    `json:"status"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// ExportUserData returns every invite addressed to the user, plus the user's
+// invite link claims and campaign applications, as JSON.
+func (s *Service) ExportUserData(ctx context.Context, in *invitev1.ExportUserDataRequest) (*invitev1.ExportUserDataResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	records := struct {
+		Invites          []exportedInvite              `json:"invites"`
+		InviteLinkClaims []exportedInviteLinkClaim     `json:"invite_link_claims"`
+		Applications     []exportedCampaignApplication `json:"campaign_applications"`
+	}{Invites: []exportedInvite{}, InviteLinkClaims: []exportedInviteLinkClaim{}, Applications: []exportedCampaignApplication{}}
+	pageToken := ""
+	for {
+		page, err := s.store.ListInvites(ctx, "", userID, "", userDataExportPageSize, pageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "list invites: %v", err)
+		}
+		for _, inv := range page.Invites {
+			records.Invites = append(records.Invites, exportedInvite{
+				ID:            inv.ID,
+				CampaignID:    inv.CampaignID,
+				ParticipantID: inv.ParticipantID,
+				Status:        string(inv.Status),
+				CreatedAt:     inv.CreatedAt,
+				UpdatedAt:     inv.UpdatedAt,
+			})
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+	if s.links != nil {
+		pageToken = ""
+		for {
+			page, err := s.links.ListInviteLinkClaims(ctx, "", "", userID, userDataExportPageSize, pageToken)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "list invite link claims: %v", err)
+			}
+			for _, claim := range page.Claims {
+				records.InviteLinkClaims = append(records.InviteLinkClaims, exportedInviteLinkClaim{
+					ID:            claim.ID,
+					LinkID:        claim.LinkID,
+					CampaignID:    claim.CampaignID,
+					ParticipantID: claim.ParticipantID,
+					Status:        string(claim.Status),
+					CreatedAt:     claim.CreatedAt,
+					UpdatedAt:     claim.UpdatedAt,
+				})
+			}
+			if page.NextPageToken == "" {
+				break
+			}
+			pageToken = page.NextPageToken
+		}
+	}
+	if s.applications != nil {
+		pageToken = ""
+		for {
+			page, err := s.applications.ListCampaignApplications(ctx, "", userID, "", userDataExportPageSize, pageToken)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "list campaign applications: %v", err)
+			}
+			for _, app := range page.Applications {
+				records.Applications = append(records.Applications, exportedCampaignApplication{
+					ID:               app.ID,
+					CampaignID:       app.CampaignID,
+					Message:          app.Message,
+					CharacterConcept: app.CharacterConcept,
+					Status:           string(app.Status),
+					CreatedAt:        app.CreatedAt,
+					UpdatedAt:        app.UpdatedAt,
+				})
+			}
+			if page.NextPageToken == "" {
+				break
+			}
+			pageToken = page.NextPageToken
+		}
+	}
+
+	payload, err := json.Marshal(records)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal invites: %v", err)
+	}
+	return &invitev1.ExportUserDataResponse{RecordsJson: string(payload)}, nil
+}
+
+// DeleteUserData revokes pending invites addressed to the user and removes the
+// user from every invite record. Invite rows stay so campaign history keeps
+// its seat references; the user's invite link claims and campaign applications
+// are deleted.
+func (s *Service) DeleteUserData(ctx context.Context, in *invitev1.DeleteUserDataRequest) (*invitev1.DeleteUserDataResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	now := s.clock()
+	released, err := s.store.ReleaseRecipientInvites(ctx, userID, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "release recipient invites: %v", err)
+	}
+	if s.links != nil {
+		deleted, err := s.links.DeleteUserInviteLinkClaims(ctx, userID, now)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "delete invite link claims: %v", err)
+		}
+		released += deleted
+	}
+	if s.applications != nil {
+		deleted, err := s.applications.DeleteUserCampaignApplications(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "delete campaign applications: %v", err)
+		}
+		released += deleted
+	}
+	return &invitev1.DeleteUserDataResponse{DeletedRecords: int32(released)}, nil
+}
@@ -70,7 +70,7 @@ func TestNewWithDepsDialFailureClosesListener(t *testing.T) {
 
 	t.Run("game dial failure", func(t *testing.T) {
 		listener := &inviteListenerStub{addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 32011}}
-		_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", runtimeDeps{
+		_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", "", runtimeDeps{
 			loadEnv: func() serverEnv { return serverEnv{DBPath: filepath.Join(baseDir, "unused.db")} },
 			listen: func(string, string) (net.Listener, error) {
 				return listener, nil
@@ -92,7 +92,7 @@ func TestNewWithDepsDialFailureClosesListener(t *testing.T) {
 
 	t.Run("auth dial failure", func(t *testing.T) {
 		listener := &inviteListenerStub{addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 32012}}
-		_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", runtimeDeps{
+		_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", "", runtimeDeps{
 			loadEnv: func() serverEnv { return serverEnv{DBPath: filepath.Join(baseDir, "unused.db")} },
 			listen: func(string, string) (net.Listener, error) {
 				return listener, nil
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"time"
+
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+)
+
+// rateLimits are the invite throttling defaults. Invite creation is budgeted
+// per campaign so one table cannot flood recipients, while claims are
+// budgeted per user to slow invite-ID guessing. Campaign applications are
+// budgeted per user so one account cannot flood GMs with requests.
+var rateLimits = ratelimit.Rules{
+	invitev1.InviteService_CreateInvite_FullMethodName:              {Key: ratelimit.KeyCampaign, Limit: 30, Period: time.Hour, Burst: 10},
+	invitev1.InviteService_ClaimInvite_FullMethodName:               {Key: ratelimit.KeyUser, Limit: 20, Period: time.Minute},
+	invitev1.InviteService_CreateInviteLink_FullMethodName:          {Key: ratelimit.KeyCampaign, Limit: 30, Period: time.Hour, Burst: 10},
+	invitev1.InviteService_ClaimInviteLink_FullMethodName:           {Key: ratelimit.KeyUser, Limit: 20, Period: time.Minute},
+	invitev1.InviteService_SubmitCampaignApplication_FullMethodName: {Key: ratelimit.KeyUser, Limit: 10, Period: time.Hour, Burst: 3},
+}
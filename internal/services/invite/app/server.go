@@ -13,11 +13,13 @@ import (
 	"sync"
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/config"
 	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
 	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	"github.com/louisbranch/fracturing.space/internal/platform/serviceaddr"
 	inviteservice "github.com/louisbranch/fracturing.space/internal/services/invite/api/grpc/invite"
 	invitesqlite "github.com/louisbranch/fracturing.space/internal/services/invite/storage/sqlite"
@@ -34,6 +36,8 @@ type Config struct {
 	Port     int
 	GameAddr string
 	AuthAddr string
+	// DiscoveryAddr is optional; campaign applications stay disabled without it.
+	DiscoveryAddr string
 }
 
 type runtimeDeps struct {
@@ -42,7 +46,9 @@ type runtimeDeps struct {
 	openStore func(string) (*invitesqlite.Store, error)
 	dialGame  func(context.Context, string, func(string, ...any)) (*grpc.ClientConn, error)
 	dialAuth  func(context.Context, string, func(string, ...any)) (*grpc.ClientConn, error)
-	logf      func(format string, args ...any)
+	// dialDiscovery is optional; nil leaves campaign applications disabled.
+	dialDiscovery func(context.Context, string, func(string, ...any)) (*grpc.ClientConn, error)
+	logf          func(format string, args ...any)
 }
 
 var defaultRuntimeDeps = runtimeDeps{
@@ -59,6 +65,9 @@ var defaultRuntimeDeps = runtimeDeps{
 	dialAuth: func(ctx context.Context, addr string, logf func(string, ...any)) (*grpc.ClientConn, error) {
 		return platformgrpc.DialLenient(ctx, addr, logf), nil
 	},
+	dialDiscovery: func(ctx context.Context, addr string, logf func(string, ...any)) (*grpc.ClientConn, error) {
+		return platformgrpc.DialLenient(ctx, addr, logf), nil
+	},
 	logf: log.Printf,
 }
 
@@ -83,13 +92,15 @@ type Server struct {
 	store      *invitesqlite.Store
 	gameConn   *grpc.ClientConn
 	authConn   *grpc.ClientConn
+	discConn   *grpc.ClientConn
+	limiter    *ratelimit.Limiter
 	closeOnce  sync.Once
 	logf       func(format string, args ...any)
 }
 
 // Run creates and serves an invite server until context cancellation.
 func Run(ctx context.Context, cfg Config) error {
-	server, err := NewWithAddr(ctx, fmt.Sprintf(":%d", cfg.Port), cfg.GameAddr, cfg.AuthAddr)
+	server, err := newWithDeps(ctx, fmt.Sprintf(":%d", cfg.Port), cfg.GameAddr, cfg.AuthAddr, cfg.DiscoveryAddr, defaultRuntimeDeps)
 	if err != nil {
 		return err
 	}
@@ -98,12 +109,13 @@ func Run(ctx context.Context, cfg Config) error {
 
 // NewWithAddr creates a ready-to-serve invite server on the given address.
 // Use "127.0.0.1:0" for tests to let the OS pick an ephemeral port; call
-// Addr() after construction to discover the assigned address.
+// Addr() after construction to discover the assigned address. Campaign
+// applications are disabled because no discovery address is given.
 func NewWithAddr(ctx context.Context, addr, gameAddr, authAddr string) (*Server, error) {
-	return newWithDeps(ctx, addr, gameAddr, authAddr, defaultRuntimeDeps)
+	return newWithDeps(ctx, addr, gameAddr, authAddr, "", defaultRuntimeDeps)
 }
 
-func newWithDeps(ctx context.Context, addr, gameAddr, authAddr string, deps runtimeDeps) (*Server, error) {
+func newWithDeps(ctx context.Context, addr, gameAddr, authAddr, discoveryAddr string, deps runtimeDeps) (*Server, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -157,17 +169,59 @@ func newWithDeps(ctx context.Context, addr, gameAddr, authAddr string, deps runt
 		return nil, fmt.Errorf("dial auth service: %w", err)
 	}
 
-	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	var discConn *grpc.ClientConn
+	if strings.TrimSpace(discoveryAddr) != "" && deps.dialDiscovery != nil {
+		discConn, err = deps.dialDiscovery(ctx, discoveryAddr, logf)
+		if err != nil {
+			_ = listener.Close()
+			_ = store.Close()
+			if gameConn != nil {
+				_ = gameConn.Close()
+			}
+			if authConn != nil {
+				_ = authConn.Close()
+			}
+			return nil, fmt.Errorf("dial discovery service: %w", err)
+		}
+	}
+
+	limiter, err := ratelimit.NewFromEnv(rateLimits)
+	if err != nil {
+		_ = listener.Close()
+		_ = store.Close()
+		if gameConn != nil {
+			_ = gameConn.Close()
+		}
+		if authConn != nil {
+			_ = authConn.Close()
+		}
+		if discConn != nil {
+			_ = discConn.Close()
+		}
+		return nil, fmt.Errorf("configure rate limits: %w", err)
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(limiter.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(limiter.StreamServerInterceptor()),
+	)
 
-	svc := inviteservice.NewService(inviteservice.Deps{
+	serviceDeps := inviteservice.Deps{
 		Store:        store,
+		Links:        store,
+		Applications: store,
 		Outbox:       store,
 		Game:         gamev1.NewParticipantServiceClient(gameConn),
 		GameCampaign: gamev1.NewCampaignServiceClient(gameConn),
 		Auth:         authv1.NewAuthServiceClient(authConn),
 		IDGenerator:  id.NewID,
 		Verifier:     joingrant.EnvVerifier{},
-	})
+	}
+	if discConn != nil {
+		serviceDeps.Discovery = discoveryv1.NewDiscoveryServiceClient(discConn)
+	}
+	svc := inviteservice.NewService(serviceDeps)
 
 	healthServer := health.NewServer()
 	invitev1.RegisterInviteServiceServer(grpcServer, svc)
@@ -182,6 +236,8 @@ func newWithDeps(ctx context.Context, addr, gameAddr, authAddr string, deps runt
 		store:      store,
 		gameConn:   gameConn,
 		authConn:   authConn,
+		discConn:   discConn,
+		limiter:    limiter,
 		logf:       deps.logf,
 	}, nil
 }
@@ -251,11 +307,17 @@ func (s *Server) close() {
 		if s.authConn != nil {
 			_ = s.authConn.Close()
 		}
+		if s.discConn != nil {
+			_ = s.discConn.Close()
+		}
 		if s.store != nil {
 			if err := s.store.Close(); err != nil {
 				logf("close invite store: %v", err)
 			}
 		}
+		if err := s.limiter.Close(); err != nil {
+			logf("close invite rate limiter: %v", err)
+		}
 	})
 }
 
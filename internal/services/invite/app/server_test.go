@@ -34,7 +34,7 @@ func TestNewWithDepsRequiresRuntimeHooks(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", tc.deps)
+			_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", "", tc.deps)
 			if err == nil || !strings.Contains(err.Error(), tc.want) {
 				t.Fatalf("newWithDeps error = %v, want %q", err, tc.want)
 			}
@@ -46,7 +46,7 @@ func TestNewWithDepsClosesListenerWhenStoreOpenFails(t *testing.T) {
 	t.Parallel()
 
 	listener := &inviteListenerStub{addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 32001}}
-	_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", runtimeDeps{
+	_, err := newWithDeps(context.Background(), "127.0.0.1:0", "game:8082", "auth:8083", "", runtimeDeps{
 		loadEnv: func() serverEnv { return serverEnv{DBPath: filepath.Join("testdata", "invite.db")} },
 		listen: func(string, string) (net.Listener, error) {
 			return listener, nil
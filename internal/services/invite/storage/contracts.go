@@ -46,6 +46,183 @@ type InviteStore interface {
 	ListPendingInvitesForRecipient(ctx context.Context, userID string, pageSize int, pageToken string) (InvitePage, error)
 	PutInvite(ctx context.Context, inv InviteRecord) error
 	UpdateInviteStatus(ctx context.Context, inviteID string, status Status, updatedAt time.Time) error
+	// ReleaseRecipientInvites revokes pending invites addressed to a user and
+	// clears that user from every invite, returning the number of invites changed.
+	ReleaseRecipientInvites(ctx context.Context, userID string, updatedAt time.Time) (int, error)
+}
+
+// ErrLinkUnavailable is returned when an invite link is revoked or has no
+// remaining uses.
+var ErrLinkUnavailable = errors.New("invite link unavailable")
+
+// ErrClaimExists is returned when a user has already claimed an invite link.
+var ErrClaimExists = errors.New("invite link already claimed")
+
+// LinkStatus represents the reusable invite link lifecycle state.
+type LinkStatus string
+
+const (
+	LinkStatusActive  LinkStatus = "active"
+	LinkStatusRevoked LinkStatus = "revoked"
+)
+
+// LinkRole is the participant role granted through an invite link.
+type LinkRole string
+
+const (
+	LinkRolePlayer LinkRole = "player"
+	LinkRoleGM     LinkRole = "gm"
+)
+
+// ClaimStatus represents one user's progress through an invite link.
+type ClaimStatus string
+
+const (
+	// ClaimStatusPending holds a reserved link use while the claim awaits GM
+	// approval. A joined claim without a participant is having its seat
+	// created; it holds its use like any joined claim.
+	ClaimStatusPending  ClaimStatus = "pending"
+	ClaimStatusJoined   ClaimStatus = "joined"
+	ClaimStatusDeclined ClaimStatus = "declined"
+)
+
+// InviteLinkRecord captures reusable invite link state. MaxUses of zero means
+// unlimited; UseCount counts joined and pending claims.
+type InviteLinkRecord struct {
+	ID                     string
+	CampaignID             string
+	CreatedByParticipantID string
+	Role                   LinkRole
+	MaxUses                int
+	UseCount               int
+	ExpiresAt              *time.Time
+	RequiresApproval       bool
+	Status                 LinkStatus
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+// Expired reports whether the link expiry has passed at now.
+func (l InviteLinkRecord) Expired(now time.Time) bool {
+	return l.ExpiresAt != nil && !now.Before(*l.ExpiresAt)
+}
+
+// Exhausted reports whether every use of a limited link has been taken.
+func (l InviteLinkRecord) Exhausted() bool {
+	return l.MaxUses > 0 && l.UseCount >= l.MaxUses
+}
+
+// InviteLinkClaimRecord captures one user's claim on an invite link.
+type InviteLinkClaimRecord struct {
+	ID            string
+	LinkID        string
+	CampaignID    string
+	UserID        string
+	ParticipantID string
+	Status        ClaimStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// InviteLinkPage describes a page of invite link records.
+type InviteLinkPage struct {
+	Links         []InviteLinkRecord
+	NextPageToken string
+}
+
+// InviteLinkClaimPage describes a page of invite link claim records.
+type InviteLinkClaimPage struct {
+	Claims        []InviteLinkClaimRecord
+	NextPageToken string
+}
+
+// InviteLinkStore owns reusable invite link and claim persistence. Link use
+// counts change only together with claim rows so the limit cannot be
+// overrun by concurrent claims.
+type InviteLinkStore interface {
+	GetInviteLink(ctx context.Context, linkID string) (InviteLinkRecord, error)
+	PutInviteLink(ctx context.Context, link InviteLinkRecord) error
+	ListInviteLinks(ctx context.Context, campaignID string, pageSize int, pageToken string) (InviteLinkPage, error)
+	RevokeInviteLink(ctx context.Context, linkID string, updatedAt time.Time) error
+	// ReserveInviteLinkClaim consumes one link use and stores the claim,
+	// returning ErrLinkUnavailable or ErrClaimExists on conflict.
+	ReserveInviteLinkClaim(ctx context.Context, claim InviteLinkClaimRecord) error
+	GetInviteLinkClaim(ctx context.Context, claimID string) (InviteLinkClaimRecord, error)
+	ListInviteLinkClaims(ctx context.Context, campaignID, linkID, userID string, pageSize int, pageToken string) (InviteLinkClaimPage, error)
+	// AcceptInviteLinkClaim marks a pending claim joined before its seat
+	// exists, returning ErrNotFound when the claim is no longer pending.
+	AcceptInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error
+	// CompleteInviteLinkClaim records the seat of a joined claim that has none.
+	CompleteInviteLinkClaim(ctx context.Context, claimID, participantID string, updatedAt time.Time) error
+	// ReleaseInviteLinkClaim returns a joined claim that has no seat to
+	// pending, keeping its link use.
+	ReleaseInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error
+	// DeclineInviteLinkClaim marks a pending claim declined and releases its
+	// link use.
+	DeclineInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error
+	// DeleteInviteLinkClaim removes a pending claim or a joined claim that has
+	// no seat and releases its link use when seat creation fails.
+	DeleteInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error
+	// DeleteUserInviteLinkClaims removes every claim made by a user, releasing
+	// the uses held by pending claims, and returns the number removed.
+	DeleteUserInviteLinkClaims(ctx context.Context, userID string, updatedAt time.Time) (int, error)
+}
+
+// ErrApplicationExists is returned when a user already has a pending
+// application to the same campaign.
+var ErrApplicationExists = errors.New("campaign application already pending")
+
+// ApplicationStatus represents the review state of a campaign application.
+type ApplicationStatus string
+
+const (
+	ApplicationStatusPending  ApplicationStatus = "pending"
+	ApplicationStatusAccepted ApplicationStatus = "accepted"
+	ApplicationStatusDeclined ApplicationStatus = "declined"
+)
+
+// CampaignApplicationRecord captures one user's request to join a campaign
+// through its open-table listing. InviteID and ParticipantID are set once the
+// application is accepted.
+type CampaignApplicationRecord struct {
+	ID               string
+	CampaignID       string
+	ApplicantUserID  string
+	Message          string
+	CharacterConcept string
+	Status           ApplicationStatus
+	InviteID         string
+	ParticipantID    string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// CampaignApplicationPage describes a page of campaign application records.
+type CampaignApplicationPage struct {
+	Applications  []CampaignApplicationRecord
+	NextPageToken string
+}
+
+// CampaignApplicationStore owns campaign application persistence. A user may
+// hold at most one pending application per campaign.
+type CampaignApplicationStore interface {
+	// PutCampaignApplication stores a new application, returning
+	// ErrApplicationExists when the applicant already has one pending.
+	PutCampaignApplication(ctx context.Context, app CampaignApplicationRecord) error
+	GetCampaignApplication(ctx context.Context, applicationID string) (CampaignApplicationRecord, error)
+	ListCampaignApplications(ctx context.Context, campaignID, applicantUserID string, status ApplicationStatus, pageSize int, pageToken string) (CampaignApplicationPage, error)
+	// ResolveCampaignApplication moves a pending application to accepted or
+	// declined, returning ErrNotFound when it is no longer pending.
+	ResolveCampaignApplication(ctx context.Context, applicationID string, status ApplicationStatus, inviteID, participantID string, updatedAt time.Time) error
+	// CompleteCampaignApplication records the invite and seat of an accepted
+	// application that has none yet, returning ErrNotFound otherwise.
+	CompleteCampaignApplication(ctx context.Context, applicationID, inviteID, participantID string, updatedAt time.Time) error
+	// ReleaseCampaignApplication returns an accepted application that has no
+	// seat yet to pending, so a failed acceptance can be retried.
+	ReleaseCampaignApplication(ctx context.Context, applicationID string, updatedAt time.Time) error
+	// DeleteUserCampaignApplications removes every application made by a user
+	// and returns the number removed.
+	DeleteUserCampaignApplications(ctx context.Context, userID string) (int, error)
 }
 
 // OutboxEvent represents a pending outbox event.
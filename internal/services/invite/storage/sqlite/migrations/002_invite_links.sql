@@ -0,0 +1,29 @@
+-- Reusable campaign invite links and the claims made through them.
+
+CREATE TABLE invite_links (
+    id TEXT PRIMARY KEY,
+    campaign_id TEXT NOT NULL,
+    created_by_participant_id TEXT NOT NULL DEFAULT '',
+    role TEXT NOT NULL DEFAULT 'player',
+    max_uses INTEGER NOT NULL DEFAULT 0,
+    use_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TEXT NOT NULL DEFAULT '',
+    requires_approval INTEGER NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'active',
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL
+);
+CREATE INDEX idx_invite_links_campaign_id ON invite_links(campaign_id);
+CREATE TABLE invite_link_claims (
+    id TEXT PRIMARY KEY,
+    link_id TEXT NOT NULL,
+    campaign_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    participant_id TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    UNIQUE (link_id, user_id)
+);
+CREATE INDEX idx_invite_link_claims_campaign_id ON invite_link_claims(campaign_id);
+CREATE INDEX idx_invite_link_claims_user_id ON invite_link_claims(user_id);
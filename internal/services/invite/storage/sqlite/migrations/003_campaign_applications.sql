@@ -0,0 +1,19 @@
+-- Applications to join campaigns advertised through open-table listings.
+
+CREATE TABLE campaign_applications (
+    id TEXT PRIMARY KEY,
+    campaign_id TEXT NOT NULL,
+    applicant_user_id TEXT NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    character_concept TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending',
+    invite_id TEXT NOT NULL DEFAULT '',
+    participant_id TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL
+);
+CREATE INDEX idx_campaign_applications_campaign_id ON campaign_applications(campaign_id);
+CREATE INDEX idx_campaign_applications_applicant_user_id ON campaign_applications(applicant_user_id);
+CREATE UNIQUE INDEX idx_campaign_applications_pending
+    ON campaign_applications(campaign_id, applicant_user_id)
+    WHERE status = 'pending';
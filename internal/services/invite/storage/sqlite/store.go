@@ -85,6 +85,33 @@ func (s *Store) UpdateInviteStatus(_ context.Context, inviteID string, status st
 	return nil
 }
 
+func (s *Store) ReleaseRecipientInvites(ctx context.Context, userID string, updatedAt time.Time) (int, error) {
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE invites SET status = ?, updated_at = ? WHERE recipient_user_id = ? AND status = ?`,
+		string(storage.StatusRevoked), updatedAt.Format(timeLayout), userID, string(storage.StatusPending),
+	); err != nil {
+		return 0, err
+	}
+	res, err := tx.ExecContext(ctx,
+		`UPDATE invites SET recipient_user_id = '', updated_at = ? WHERE recipient_user_id = ?`,
+		updatedAt.Format(timeLayout), userID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
 func (s *Store) ListInvites(_ context.Context, campaignID, recipientUserID string, status storage.Status, pageSize int, pageToken string) (storage.InvitePage, error) {
 	if pageSize <= 0 || pageSize > 50 {
 		pageSize = 10
@@ -0,0 +1,163 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+)
+
+var _ storage.CampaignApplicationStore = (*Store)(nil)
+
+const campaignApplicationColumns = `id, campaign_id, applicant_user_id, message, character_concept, status, invite_id, participant_id, created_at, updated_at`
+
+func (s *Store) PutCampaignApplication(ctx context.Context, app storage.CampaignApplicationRecord) error {
+	_, err := s.sqlDB.ExecContext(ctx,
+		`INSERT INTO campaign_applications (`+campaignApplicationColumns+`)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		app.ID, app.CampaignID, app.ApplicantUserID, app.Message, app.CharacterConcept,
+		string(app.Status), app.InviteID, app.ParticipantID,
+		app.CreatedAt.Format(timeLayout), app.UpdatedAt.Format(timeLayout),
+	)
+	if isUniqueConstraintError(err) {
+		return storage.ErrApplicationExists
+	}
+	return err
+}
+
+func (s *Store) GetCampaignApplication(ctx context.Context, applicationID string) (storage.CampaignApplicationRecord, error) {
+	row := s.sqlDB.QueryRowContext(ctx,
+		`SELECT `+campaignApplicationColumns+` FROM campaign_applications WHERE id = ?`, applicationID)
+	app, err := scanCampaignApplication(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.CampaignApplicationRecord{}, storage.ErrNotFound
+	}
+	return app, err
+}
+
+func (s *Store) ListCampaignApplications(ctx context.Context, campaignID, applicantUserID string, status storage.ApplicationStatus, pageSize int, pageToken string) (storage.CampaignApplicationPage, error) {
+	if pageSize <= 0 || pageSize > 50 {
+		pageSize = 10
+	}
+	var conditions []string
+	var args []any
+	if campaignID != "" {
+		conditions = append(conditions, "campaign_id = ?")
+		args = append(args, campaignID)
+	}
+	if applicantUserID != "" {
+		conditions = append(conditions, "applicant_user_id = ?")
+		args = append(args, applicantUserID)
+	}
+	if status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, string(status))
+	}
+	if pageToken != "" {
+		conditions = append(conditions, "id > ?")
+		args = append(args, pageToken)
+	}
+	query := `SELECT ` + campaignApplicationColumns + ` FROM campaign_applications`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id ASC LIMIT ?"
+	args = append(args, pageSize+1)
+
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return storage.CampaignApplicationPage{}, err
+	}
+	defer rows.Close()
+
+	var page storage.CampaignApplicationPage
+	for rows.Next() {
+		app, err := scanCampaignApplication(rows)
+		if err != nil {
+			return storage.CampaignApplicationPage{}, err
+		}
+		page.Applications = append(page.Applications, app)
+	}
+	if err := rows.Err(); err != nil {
+		return storage.CampaignApplicationPage{}, err
+	}
+	if len(page.Applications) > pageSize {
+		page.NextPageToken = page.Applications[pageSize-1].ID
+		page.Applications = page.Applications[:pageSize]
+	}
+	return page, nil
+}
+
+func (s *Store) ResolveCampaignApplication(ctx context.Context, applicationID string, status storage.ApplicationStatus, inviteID, participantID string, updatedAt time.Time) error {
+	res, err := s.sqlDB.ExecContext(ctx,
+		`UPDATE campaign_applications SET status = ?, invite_id = ?, participant_id = ?, updated_at = ?
+		 WHERE id = ? AND status = ?`,
+		string(status), inviteID, participantID, updatedAt.Format(timeLayout),
+		applicationID, string(storage.ApplicationStatusPending),
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) CompleteCampaignApplication(ctx context.Context, applicationID, inviteID, participantID string, updatedAt time.Time) error {
+	res, err := s.sqlDB.ExecContext(ctx,
+		`UPDATE campaign_applications SET invite_id = ?, participant_id = ?, updated_at = ?
+		 WHERE id = ? AND status = ? AND participant_id = ''`,
+		inviteID, participantID, updatedAt.Format(timeLayout),
+		applicationID, string(storage.ApplicationStatusAccepted),
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) ReleaseCampaignApplication(ctx context.Context, applicationID string, updatedAt time.Time) error {
+	res, err := s.sqlDB.ExecContext(ctx,
+		`UPDATE campaign_applications SET status = ?, invite_id = '', updated_at = ?
+		 WHERE id = ? AND status = ? AND participant_id = ''`,
+		string(storage.ApplicationStatusPending), updatedAt.Format(timeLayout),
+		applicationID, string(storage.ApplicationStatusAccepted),
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) DeleteUserCampaignApplications(ctx context.Context, userID string) (int, error) {
+	res, err := s.sqlDB.ExecContext(ctx, `DELETE FROM campaign_applications WHERE applicant_user_id = ?`, userID)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+func scanCampaignApplication(row rowScanner) (storage.CampaignApplicationRecord, error) {
+	var app storage.CampaignApplicationRecord
+	var status, createdAt, updatedAt string
+	if err := row.Scan(&app.ID, &app.CampaignID, &app.ApplicantUserID, &app.Message,
+		&app.CharacterConcept, &status, &app.InviteID, &app.ParticipantID,
+		&createdAt, &updatedAt); err != nil {
+		return storage.CampaignApplicationRecord{}, err
+	}
+	app.Status = storage.ApplicationStatus(status)
+	app.CreatedAt, _ = time.Parse(timeLayout, createdAt)
+	app.UpdatedAt, _ = time.Parse(timeLayout, updatedAt)
+	return app, nil
+}
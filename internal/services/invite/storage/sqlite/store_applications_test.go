@@ -0,0 +1,143 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+)
+
+func testApplication(id, userID string) storage.CampaignApplicationRecord {
+	return storage.CampaignApplicationRecord{
+		ID:               id,
+		CampaignID:       "camp-1",
+		ApplicantUserID:  userID,
+		Message:          "I play Fridays",
+		CharacterConcept: "Exiled cartographer",
+		Status:           storage.ApplicationStatusPending,
+		CreatedAt:        testTime,
+		UpdatedAt:        testTime,
+	}
+}
+
+func TestPutCampaignApplicationRejectsSecondPending(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	if err := s.PutCampaignApplication(ctx, testApplication("app-1", "user-1")); err != nil {
+		t.Fatalf("PutCampaignApplication: %v", err)
+	}
+	if err := s.PutCampaignApplication(ctx, testApplication("app-2", "user-1")); !errors.Is(err, storage.ErrApplicationExists) {
+		t.Fatalf("duplicate pending error = %v, want ErrApplicationExists", err)
+	}
+
+	if err := s.ResolveCampaignApplication(ctx, "app-1", storage.ApplicationStatusDeclined, "", "", testTime); err != nil {
+		t.Fatalf("ResolveCampaignApplication: %v", err)
+	}
+	if err := s.PutCampaignApplication(ctx, testApplication("app-2", "user-1")); err != nil {
+		t.Fatalf("reapply after decline: %v", err)
+	}
+}
+
+func TestResolveCampaignApplicationOnlyFromPending(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	if err := s.PutCampaignApplication(ctx, testApplication("app-1", "user-1")); err != nil {
+		t.Fatalf("PutCampaignApplication: %v", err)
+	}
+	if err := s.ResolveCampaignApplication(ctx, "app-1", storage.ApplicationStatusAccepted, "inv-1", "part-1", testTime); err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+	got, err := s.GetCampaignApplication(ctx, "app-1")
+	if err != nil {
+		t.Fatalf("GetCampaignApplication: %v", err)
+	}
+	if got.Status != storage.ApplicationStatusAccepted || got.InviteID != "inv-1" || got.ParticipantID != "part-1" {
+		t.Fatalf("application = %+v", got)
+	}
+	if err := s.ResolveCampaignApplication(ctx, "app-1", storage.ApplicationStatusDeclined, "", "", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("second resolve error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestReleaseAndCompleteReservedCampaignApplication(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	if err := s.PutCampaignApplication(ctx, testApplication("app-1", "user-1")); err != nil {
+		t.Fatalf("PutCampaignApplication: %v", err)
+	}
+	if err := s.ResolveCampaignApplication(ctx, "app-1", storage.ApplicationStatusAccepted, "", "", testTime); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	if err := s.ReleaseCampaignApplication(ctx, "app-1", testTime); err != nil {
+		t.Fatalf("ReleaseCampaignApplication: %v", err)
+	}
+	got, err := s.GetCampaignApplication(ctx, "app-1")
+	if err != nil {
+		t.Fatalf("GetCampaignApplication: %v", err)
+	}
+	if got.Status != storage.ApplicationStatusPending {
+		t.Fatalf("released status = %s, want pending", got.Status)
+	}
+	if err := s.CompleteCampaignApplication(ctx, "app-1", "inv-1", "part-1", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("complete pending error = %v, want ErrNotFound", err)
+	}
+
+	if err := s.ResolveCampaignApplication(ctx, "app-1", storage.ApplicationStatusAccepted, "", "", testTime); err != nil {
+		t.Fatalf("reserve again: %v", err)
+	}
+	if err := s.CompleteCampaignApplication(ctx, "app-1", "inv-1", "part-1", testTime); err != nil {
+		t.Fatalf("CompleteCampaignApplication: %v", err)
+	}
+	got, err = s.GetCampaignApplication(ctx, "app-1")
+	if err != nil {
+		t.Fatalf("GetCampaignApplication: %v", err)
+	}
+	if got.Status != storage.ApplicationStatusAccepted || got.InviteID != "inv-1" || got.ParticipantID != "part-1" {
+		t.Fatalf("application = %+v", got)
+	}
+	// A seated application is final: it can neither be released nor re-completed.
+	if err := s.ReleaseCampaignApplication(ctx, "app-1", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("release seated error = %v, want ErrNotFound", err)
+	}
+	if err := s.CompleteCampaignApplication(ctx, "app-1", "inv-2", "part-2", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("second complete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestListAndDeleteUserCampaignApplications(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	for _, app := range []storage.CampaignApplicationRecord{
+		testApplication("app-1", "user-1"),
+		testApplication("app-2", "user-2"),
+	} {
+		if err := s.PutCampaignApplication(ctx, app); err != nil {
+			t.Fatalf("PutCampaignApplication: %v", err)
+		}
+	}
+	page, err := s.ListCampaignApplications(ctx, "camp-1", "", storage.ApplicationStatusPending, 1, "")
+	if err != nil {
+		t.Fatalf("ListCampaignApplications: %v", err)
+	}
+	if len(page.Applications) != 1 || page.NextPageToken != "app-1" {
+		t.Fatalf("page = %+v", page)
+	}
+
+	n, err := s.DeleteUserCampaignApplications(ctx, "user-1")
+	if err != nil || n != 1 {
+		t.Fatalf("DeleteUserCampaignApplications = %d, %v", n, err)
+	}
+	page, err = s.ListCampaignApplications(ctx, "", "user-1", "", 10, "")
+	if err != nil || len(page.Applications) != 0 {
+		t.Fatalf("remaining = %+v, %v", page, err)
+	}
+}
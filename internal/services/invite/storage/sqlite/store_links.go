@@ -0,0 +1,366 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+	msqlite "modernc.org/sqlite"
+	sqlite3lib "modernc.org/sqlite/lib"
+)
+
+var _ storage.InviteLinkStore = (*Store)(nil)
+
+const inviteLinkColumns = `id, campaign_id, created_by_participant_id, role, max_uses, use_count, expires_at, requires_approval, status, created_at, updated_at`
+
+const inviteLinkClaimColumns = `id, link_id, campaign_id, user_id, participant_id, status, created_at, updated_at`
+
+func (s *Store) GetInviteLink(ctx context.Context, linkID string) (storage.InviteLinkRecord, error) {
+	row := s.sqlDB.QueryRowContext(ctx,
+		`SELECT `+inviteLinkColumns+` FROM invite_links WHERE id = ?`, linkID)
+	link, err := scanInviteLink(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.InviteLinkRecord{}, storage.ErrNotFound
+	}
+	return link, err
+}
+
+func (s *Store) PutInviteLink(ctx context.Context, link storage.InviteLinkRecord) error {
+	expiresAt := ""
+	if link.ExpiresAt != nil {
+		expiresAt = link.ExpiresAt.UTC().Format(timeLayout)
+	}
+	_, err := s.sqlDB.ExecContext(ctx,
+		`INSERT INTO invite_links (`+inviteLinkColumns+`)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		link.ID, link.CampaignID, link.CreatedByParticipantID, string(link.Role),
+		link.MaxUses, link.UseCount, expiresAt, boolToInt(link.RequiresApproval),
+		string(link.Status), link.CreatedAt.Format(timeLayout), link.UpdatedAt.Format(timeLayout),
+	)
+	return err
+}
+
+func (s *Store) ListInviteLinks(ctx context.Context, campaignID string, pageSize int, pageToken string) (storage.InviteLinkPage, error) {
+	if pageSize <= 0 || pageSize > 50 {
+		pageSize = 10
+	}
+	query := `SELECT ` + inviteLinkColumns + ` FROM invite_links WHERE campaign_id = ?`
+	args := []any{campaignID}
+	if pageToken != "" {
+		query += " AND id > ?"
+		args = append(args, pageToken)
+	}
+	query += " ORDER BY id ASC LIMIT ?"
+	args = append(args, pageSize+1)
+
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return storage.InviteLinkPage{}, err
+	}
+	defer rows.Close()
+
+	var page storage.InviteLinkPage
+	for rows.Next() {
+		link, err := scanInviteLink(rows)
+		if err != nil {
+			return storage.InviteLinkPage{}, err
+		}
+		page.Links = append(page.Links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return storage.InviteLinkPage{}, err
+	}
+	if len(page.Links) > pageSize {
+		page.NextPageToken = page.Links[pageSize-1].ID
+		page.Links = page.Links[:pageSize]
+	}
+	return page, nil
+}
+
+func (s *Store) RevokeInviteLink(ctx context.Context, linkID string, updatedAt time.Time) error {
+	res, err := s.sqlDB.ExecContext(ctx,
+		`UPDATE invite_links SET status = ?, updated_at = ? WHERE id = ?`,
+		string(storage.LinkStatusRevoked), updatedAt.Format(timeLayout), linkID,
+	)
+	if err != nil {
+		return err
+	}
+	n, _ := res.RowsAffected()
+	if n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) ReserveInviteLinkClaim(ctx context.Context, claim storage.InviteLinkClaimRecord) error {
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE invite_links SET use_count = use_count + 1, updated_at = ?
+		 WHERE id = ? AND status = ? AND (max_uses = 0 OR use_count < max_uses)`,
+		claim.CreatedAt.Format(timeLayout), claim.LinkID, string(storage.LinkStatusActive),
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return storage.ErrLinkUnavailable
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO invite_link_claims (`+inviteLinkClaimColumns+`)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		claim.ID, claim.LinkID, claim.CampaignID, claim.UserID, claim.ParticipantID,
+		string(claim.Status), claim.CreatedAt.Format(timeLayout), claim.UpdatedAt.Format(timeLayout),
+	); err != nil {
+		if isUniqueConstraintError(err) {
+			return storage.ErrClaimExists
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *Store) GetInviteLinkClaim(ctx context.Context, claimID string) (storage.InviteLinkClaimRecord, error) {
+	row := s.sqlDB.QueryRowContext(ctx,
+		`SELECT `+inviteLinkClaimColumns+` FROM invite_link_claims WHERE id = ?`, claimID)
+	claim, err := scanInviteLinkClaim(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.InviteLinkClaimRecord{}, storage.ErrNotFound
+	}
+	return claim, err
+}
+
+func (s *Store) ListInviteLinkClaims(ctx context.Context, campaignID, linkID, userID string, pageSize int, pageToken string) (storage.InviteLinkClaimPage, error) {
+	if pageSize <= 0 || pageSize > 50 {
+		pageSize = 10
+	}
+	var conditions []string
+	var args []any
+	if campaignID != "" {
+		conditions = append(conditions, "campaign_id = ?")
+		args = append(args, campaignID)
+	}
+	if linkID != "" {
+		conditions = append(conditions, "link_id = ?")
+		args = append(args, linkID)
+	}
+	if userID != "" {
+		conditions = append(conditions, "user_id = ?")
+		args = append(args, userID)
+	}
+	if pageToken != "" {
+		conditions = append(conditions, "id > ?")
+		args = append(args, pageToken)
+	}
+	query := `SELECT ` + inviteLinkClaimColumns + ` FROM invite_link_claims`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id ASC LIMIT ?"
+	args = append(args, pageSize+1)
+
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return storage.InviteLinkClaimPage{}, err
+	}
+	defer rows.Close()
+
+	var page storage.InviteLinkClaimPage
+	for rows.Next() {
+		claim, err := scanInviteLinkClaim(rows)
+		if err != nil {
+			return storage.InviteLinkClaimPage{}, err
+		}
+		page.Claims = append(page.Claims, claim)
+	}
+	if err := rows.Err(); err != nil {
+		return storage.InviteLinkClaimPage{}, err
+	}
+	if len(page.Claims) > pageSize {
+		page.NextPageToken = page.Claims[pageSize-1].ID
+		page.Claims = page.Claims[:pageSize]
+	}
+	return page, nil
+}
+
+func (s *Store) AcceptInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error {
+	return s.updateInviteLinkClaim(ctx,
+		`UPDATE invite_link_claims SET status = ?, updated_at = ?
+		 WHERE id = ? AND status = ?`,
+		string(storage.ClaimStatusJoined), updatedAt.Format(timeLayout),
+		claimID, string(storage.ClaimStatusPending),
+	)
+}
+
+func (s *Store) CompleteInviteLinkClaim(ctx context.Context, claimID, participantID string, updatedAt time.Time) error {
+	return s.updateInviteLinkClaim(ctx,
+		`UPDATE invite_link_claims SET participant_id = ?, updated_at = ?
+		 WHERE id = ? AND status = ? AND participant_id = ''`,
+		participantID, updatedAt.Format(timeLayout),
+		claimID, string(storage.ClaimStatusJoined),
+	)
+}
+
+func (s *Store) ReleaseInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error {
+	return s.updateInviteLinkClaim(ctx,
+		`UPDATE invite_link_claims SET status = ?, updated_at = ?
+		 WHERE id = ? AND status = ? AND participant_id = ''`,
+		string(storage.ClaimStatusPending), updatedAt.Format(timeLayout),
+		claimID, string(storage.ClaimStatusJoined),
+	)
+}
+
+// updateInviteLinkClaim applies a conditional claim update, returning
+// ErrNotFound when no claim matched.
+func (s *Store) updateInviteLinkClaim(ctx context.Context, query string, args ...any) error {
+	res, err := s.sqlDB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) DeclineInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error {
+	return s.releaseInviteLinkUse(ctx, claimID, updatedAt,
+		`UPDATE invite_link_claims SET status = ?, updated_at = ? WHERE id = ? AND status = ?`,
+		string(storage.ClaimStatusDeclined), updatedAt.Format(timeLayout), claimID, string(storage.ClaimStatusPending),
+	)
+}
+
+func (s *Store) DeleteInviteLinkClaim(ctx context.Context, claimID string, updatedAt time.Time) error {
+	return s.releaseInviteLinkUse(ctx, claimID, updatedAt,
+		`DELETE FROM invite_link_claims
+		 WHERE id = ? AND (status = ? OR (status = ? AND participant_id = ''))`,
+		claimID, string(storage.ClaimStatusPending), string(storage.ClaimStatusJoined),
+	)
+}
+
+// releaseInviteLinkUse applies a claim mutation and returns the claim's
+// reserved use to its link in the same transaction.
+func (s *Store) releaseInviteLinkUse(ctx context.Context, claimID string, updatedAt time.Time, mutation string, args ...any) error {
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var linkID string
+	if err := tx.QueryRowContext(ctx,
+		`SELECT link_id FROM invite_link_claims WHERE id = ?`, claimID,
+	).Scan(&linkID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ErrNotFound
+		}
+		return err
+	}
+	res, err := tx.ExecContext(ctx, mutation, args...)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return storage.ErrNotFound
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE invite_links SET use_count = MAX(use_count - 1, 0), updated_at = ? WHERE id = ?`,
+		updatedAt.Format(timeLayout), linkID,
+	); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *Store) DeleteUserInviteLinkClaims(ctx context.Context, userID string, updatedAt time.Time) (int, error) {
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE invite_links SET use_count = MAX(use_count - 1, 0), updated_at = ?
+		 WHERE id IN (SELECT link_id FROM invite_link_claims WHERE user_id = ? AND status = ?)`,
+		updatedAt.Format(timeLayout), userID, string(storage.ClaimStatusPending),
+	); err != nil {
+		return 0, err
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM invite_link_claims WHERE user_id = ?`, userID)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+// rowScanner abstracts *sql.Row and *sql.Rows for link scans.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanInviteLink(row rowScanner) (storage.InviteLinkRecord, error) {
+	var link storage.InviteLinkRecord
+	var role, expiresAt, status, createdAt, updatedAt string
+	var requiresApproval int
+	if err := row.Scan(&link.ID, &link.CampaignID, &link.CreatedByParticipantID, &role,
+		&link.MaxUses, &link.UseCount, &expiresAt, &requiresApproval, &status,
+		&createdAt, &updatedAt); err != nil {
+		return storage.InviteLinkRecord{}, err
+	}
+	link.Role = storage.LinkRole(role)
+	link.Status = storage.LinkStatus(status)
+	link.RequiresApproval = requiresApproval != 0
+	if expiresAt != "" {
+		if parsed, err := time.Parse(timeLayout, expiresAt); err == nil {
+			link.ExpiresAt = &parsed
+		}
+	}
+	link.CreatedAt, _ = time.Parse(timeLayout, createdAt)
+	link.UpdatedAt, _ = time.Parse(timeLayout, updatedAt)
+	return link, nil
+}
+
+func scanInviteLinkClaim(row rowScanner) (storage.InviteLinkClaimRecord, error) {
+	var claim storage.InviteLinkClaimRecord
+	var status, createdAt, updatedAt string
+	if err := row.Scan(&claim.ID, &claim.LinkID, &claim.CampaignID, &claim.UserID,
+		&claim.ParticipantID, &status, &createdAt, &updatedAt); err != nil {
+		return storage.InviteLinkClaimRecord{}, err
+	}
+	claim.Status = storage.ClaimStatus(status)
+	claim.CreatedAt, _ = time.Parse(timeLayout, createdAt)
+	claim.UpdatedAt, _ = time.Parse(timeLayout, updatedAt)
+	return claim, nil
+}
+
+func boolToInt(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func isUniqueConstraintError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var sqliteErr *msqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3lib.SQLITE_CONSTRAINT_PRIMARYKEY, sqlite3lib.SQLITE_CONSTRAINT_UNIQUE:
+			return true
+		}
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "unique constraint failed")
+}
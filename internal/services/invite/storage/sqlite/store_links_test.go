@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
+)
+
+func putTestLink(t *testing.T, s *Store, maxUses int) storage.InviteLinkRecord {
+	t.Helper()
+	expiresAt := testTime.Add(24 * time.Hour)
+	link := storage.InviteLinkRecord{
+		ID:                     "link-1",
+		CampaignID:             "camp-1",
+		CreatedByParticipantID: "part-gm",
+		Role:                   storage.LinkRolePlayer,
+		MaxUses:                maxUses,
+		ExpiresAt:              &expiresAt,
+		RequiresApproval:       true,
+		Status:                 storage.LinkStatusActive,
+		CreatedAt:              testTime,
+		UpdatedAt:              testTime,
+	}
+	if err := s.PutInviteLink(context.Background(), link); err != nil {
+		t.Fatalf("PutInviteLink: %v", err)
+	}
+	return link
+}
+
+func testClaim(id, userID string) storage.InviteLinkClaimRecord {
+	return storage.InviteLinkClaimRecord{
+		ID:         id,
+		LinkID:     "link-1",
+		CampaignID: "camp-1",
+		UserID:     userID,
+		Status:     storage.ClaimStatusPending,
+		CreatedAt:  testTime,
+		UpdatedAt:  testTime,
+	}
+}
+
+func TestPutAndGetInviteLink(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	want := putTestLink(t, s, 3)
+
+	got, err := s.GetInviteLink(context.Background(), "link-1")
+	if err != nil {
+		t.Fatalf("GetInviteLink: %v", err)
+	}
+	if got.MaxUses != 3 || !got.RequiresApproval || got.Role != storage.LinkRolePlayer {
+		t.Fatalf("link = %+v, want max 3, approval, player", got)
+	}
+	if got.ExpiresAt == nil || !got.ExpiresAt.Equal(*want.ExpiresAt) {
+		t.Fatalf("expires_at = %v, want %v", got.ExpiresAt, want.ExpiresAt)
+	}
+
+	if _, err := s.GetInviteLink(context.Background(), "missing"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("GetInviteLink(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestReserveInviteLinkClaim_EnforcesMaxUses(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+	putTestLink(t, s, 1)
+
+	if err := s.ReserveInviteLinkClaim(ctx, testClaim("claim-1", "user-1")); err != nil {
+		t.Fatalf("ReserveInviteLinkClaim: %v", err)
+	}
+	if err := s.ReserveInviteLinkClaim(ctx, testClaim("claim-2", "user-2")); !errors.Is(err, storage.ErrLinkUnavailable) {
+		t.Fatalf("second reserve error = %v, want ErrLinkUnavailable", err)
+	}
+	link, _ := s.GetInviteLink(ctx, "link-1")
+	if link.UseCount != 1 {
+		t.Fatalf("use count = %d, want 1", link.UseCount)
+	}
+}
+
+func TestReserveInviteLinkClaim_DuplicateUserKeepsCount(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+	putTestLink(t, s, 0)
+
+	if err := s.ReserveInviteLinkClaim(ctx, testClaim("claim-1", "user-1")); err != nil {
+		t.Fatalf("ReserveInviteLinkClaim: %v", err)
+	}
+	if err := s.ReserveInviteLinkClaim(ctx, testClaim("claim-2", "user-1")); !errors.Is(err, storage.ErrClaimExists) {
+		t.Fatalf("duplicate reserve error = %v, want ErrClaimExists", err)
+	}
+	link, _ := s.GetInviteLink(ctx, "link-1")
+	if link.UseCount != 1 {
+		t.Fatalf("use count = %d, want 1 after rolled back duplicate", link.UseCount)
+	}
+}
+
+func TestReserveInviteLinkClaim_RevokedLink(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+	putTestLink(t, s, 0)
+	if err := s.RevokeInviteLink(ctx, "link-1", testTime); err != nil {
+		t.Fatalf("RevokeInviteLink: %v", err)
+	}
+	if err := s.ReserveInviteLinkClaim(ctx, testClaim("claim-1", "user-1")); !errors.Is(err, storage.ErrLinkUnavailable) {
+		t.Fatalf("reserve error = %v, want ErrLinkUnavailable", err)
+	}
+}
+
+func TestInviteLinkClaimLifecycle(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+	putTestLink(t, s, 0)
+	for _, claim := range []storage.InviteLinkClaimRecord{
+		testClaim("claim-1", "user-1"),
+		testClaim("claim-2", "user-2"),
+		testClaim("claim-3", "user-3"),
+	} {
+		if err := s.ReserveInviteLinkClaim(ctx, claim); err != nil {
+			t.Fatalf("ReserveInviteLinkClaim(%s): %v", claim.ID, err)
+		}
+	}
+
+	if err := s.CompleteInviteLinkClaim(ctx, "claim-1", "part-1", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("completing pending claim error = %v, want ErrNotFound", err)
+	}
+	if err := s.AcceptInviteLinkClaim(ctx, "claim-1", testTime); err != nil {
+		t.Fatalf("AcceptInviteLinkClaim: %v", err)
+	}
+	if err := s.AcceptInviteLinkClaim(ctx, "claim-1", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("second accept error = %v, want ErrNotFound", err)
+	}
+	if err := s.ReleaseInviteLinkClaim(ctx, "claim-1", testTime); err != nil {
+		t.Fatalf("ReleaseInviteLinkClaim: %v", err)
+	}
+	if err := s.AcceptInviteLinkClaim(ctx, "claim-1", testTime); err != nil {
+		t.Fatalf("AcceptInviteLinkClaim after release: %v", err)
+	}
+	if err := s.CompleteInviteLinkClaim(ctx, "claim-1", "part-1", testTime); err != nil {
+		t.Fatalf("CompleteInviteLinkClaim: %v", err)
+	}
+	if err := s.ReleaseInviteLinkClaim(ctx, "claim-1", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("releasing seated claim error = %v, want ErrNotFound", err)
+	}
+	if err := s.DeleteInviteLinkClaim(ctx, "claim-1", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("deleting seated claim error = %v, want ErrNotFound", err)
+	}
+	if err := s.DeclineInviteLinkClaim(ctx, "claim-2", testTime); err != nil {
+		t.Fatalf("DeclineInviteLinkClaim: %v", err)
+	}
+	if err := s.AcceptInviteLinkClaim(ctx, "claim-3", testTime); err != nil {
+		t.Fatalf("AcceptInviteLinkClaim: %v", err)
+	}
+	if err := s.DeleteInviteLinkClaim(ctx, "claim-3", testTime); err != nil {
+		t.Fatalf("DeleteInviteLinkClaim: %v", err)
+	}
+	if err := s.DeclineInviteLinkClaim(ctx, "claim-1", testTime); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("declining joined claim error = %v, want ErrNotFound", err)
+	}
+
+	joined, err := s.GetInviteLinkClaim(ctx, "claim-1")
+	if err != nil {
+		t.Fatalf("GetInviteLinkClaim: %v", err)
+	}
+	if joined.Status != storage.ClaimStatusJoined || joined.ParticipantID != "part-1" {
+		t.Fatalf("claim = %+v, want joined with part-1", joined)
+	}
+	page, err := s.ListInviteLinkClaims(ctx, "camp-1", "link-1", "", 10, "")
+	if err != nil {
+		t.Fatalf("ListInviteLinkClaims: %v", err)
+	}
+	if len(page.Claims) != 2 {
+		t.Fatalf("claims = %d, want joined and declined", len(page.Claims))
+	}
+	link, _ := s.GetInviteLink(ctx, "link-1")
+	if link.UseCount != 1 {
+		t.Fatalf("use count = %d, want only the joined claim counted", link.UseCount)
+	}
+}
+
+func TestDeleteUserInviteLinkClaims(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+	putTestLink(t, s, 0)
+	if err := s.ReserveInviteLinkClaim(ctx, testClaim("claim-1", "user-1")); err != nil {
+		t.Fatalf("ReserveInviteLinkClaim: %v", err)
+	}
+
+	deleted, err := s.DeleteUserInviteLinkClaims(ctx, "user-1", testTime)
+	if err != nil {
+		t.Fatalf("DeleteUserInviteLinkClaims: %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("deleted = %d, want 1", deleted)
+	}
+	link, _ := s.GetInviteLink(ctx, "link-1")
+	if link.UseCount != 0 {
+		t.Fatalf("use count = %d, want pending use released", link.UseCount)
+	}
+}
@@ -300,6 +300,45 @@ func TestListPendingInvitesForRecipient(t *testing.T) {
 	}
 }
 
+func TestReleaseRecipientInvites(t *testing.T) {
+	t.Parallel()
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	_ = s.PutInvite(ctx, storage.InviteRecord{
+		ID: "inv-r1", CampaignID: "camp-1", RecipientUserID: "user-1",
+		Status: storage.StatusPending, CreatedAt: testTime, UpdatedAt: testTime,
+	})
+	_ = s.PutInvite(ctx, storage.InviteRecord{
+		ID: "inv-r2", CampaignID: "camp-2", RecipientUserID: "user-1",
+		Status: storage.StatusClaimed, CreatedAt: testTime, UpdatedAt: testTime,
+	})
+	_ = s.PutInvite(ctx, storage.InviteRecord{
+		ID: "inv-r3", CampaignID: "camp-3", RecipientUserID: "user-2",
+		Status: storage.StatusPending, CreatedAt: testTime, UpdatedAt: testTime,
+	})
+
+	released, err := s.ReleaseRecipientInvites(ctx, "user-1", testTime.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("ReleaseRecipientInvites: %v", err)
+	}
+	if released != 2 {
+		t.Fatalf("released = %d, want 2", released)
+	}
+	pending, _ := s.GetInvite(ctx, "inv-r1")
+	if pending.Status != storage.StatusRevoked || pending.RecipientUserID != "" {
+		t.Fatalf("pending invite = %+v, want revoked without recipient", pending)
+	}
+	claimed, _ := s.GetInvite(ctx, "inv-r2")
+	if claimed.Status != storage.StatusClaimed || claimed.RecipientUserID != "" {
+		t.Fatalf("claimed invite = %+v, want claimed without recipient", claimed)
+	}
+	other, _ := s.GetInvite(ctx, "inv-r3")
+	if other.RecipientUserID != "user-2" || other.Status != storage.StatusPending {
+		t.Fatalf("other invite = %+v, want untouched", other)
+	}
+}
+
 // --- OutboxStore contract tests ---
 
 func TestEnqueueAndLease(t *testing.T) {
@@ -0,0 +1,96 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/platform/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+)
+
+// bearerChallenge is sent with 401 responses per RFC 6750.
+const bearerChallenge = `Bearer realm="fracturing.space"`
+
+// ToolsScope is the OAuth scope a token must carry to drive MCP tools.
+// Tokens minted for the web session or the public API lack it.
+const ToolsScope = "game:mcp"
+
+// requireBearer authenticates MCP requests with an OAuth access token from
+// the Authorization header, validated through auth service introspection.
+func requireBearer(next http.Handler, introspector authctx.Introspector, requirement authctx.TokenRequirement) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			writeUnauthorized(w, "", "bearer access token is required")
+			return
+		}
+		userID, err := introspectUser(r.Context(), introspector, requirement, token)
+		switch {
+		case errors.Is(err, errInvalidToken):
+			writeUnauthorized(w, "invalid_token", "access token is invalid or expired")
+			return
+		case errors.Is(err, errInsufficientScope):
+			w.Header().Set("WWW-Authenticate", bearerChallenge+`, error="insufficient_scope", scope="`+requirement.Scope+`"`)
+			writeErrorBody(w, http.StatusForbidden, apperrors.HTTPError{Code: "PERMISSION_DENIED", Message: err.Error()})
+			return
+		case err != nil:
+			log.Printf("mcp auth: %v", err)
+			writeErrorBody(w, http.StatusServiceUnavailable, apperrors.HTTPError{Code: "UNAVAILABLE", Message: "token validation is unavailable"})
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(requestctx.WithUserID(r.Context(), userID)))
+	})
+}
+
+// errInvalidToken reports an inactive token, one without a user subject, or
+// one issued to a client outside the requirement.
+var errInvalidToken = errors.New("access token is invalid or expired")
+
+// errInsufficientScope reports an otherwise valid token that was not granted
+// the MCP tools scope.
+var errInsufficientScope = errors.New("access token lacks the " + ToolsScope + " scope")
+
+// introspectUser validates token against requirement and returns its user id.
+// Both transports use it: HTTP per request, stdio once at startup. The client
+// and scope checks run before any campaign scope is resolved, so a token for
+// another surface never reaches game.
+func introspectUser(ctx context.Context, introspector authctx.Introspector, requirement authctx.TokenRequirement, token string) (string, error) {
+	if introspector == nil {
+		return "", errors.New("token validation is not configured")
+	}
+	result, err := introspector.Introspect(ctx, token)
+	if err != nil {
+		return "", fmt.Errorf("introspect token: %w", err)
+	}
+	userID := strings.TrimSpace(result.UserID)
+	if !result.Active || userID == "" || !requirement.AllowsClient(result) {
+		return "", errInvalidToken
+	}
+	if !requirement.AllowsScope(result) {
+		return "", errInsufficientScope
+	}
+	return userID, nil
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func writeUnauthorized(w http.ResponseWriter, oauthError, message string) {
+	challenge := bearerChallenge
+	if oauthError != "" {
+		challenge += `, error="` + oauthError + `"`
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+	writeErrorBody(w, http.StatusUnauthorized, apperrors.HTTPError{Code: "UNAUTHENTICATED", Message: message})
+}
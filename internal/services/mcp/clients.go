@@ -0,0 +1,123 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaignartifact"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/campaigncontext/referencecorpus"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/gametools"
+)
+
+// artifactClient adapts the AI campaign artifact API to
+// gametools.ArtifactManager. Artifacts live in the AI service, so the MCP
+// server reaches them over gRPC as the scoped user instead of opening the
+// AI store directly.
+type artifactClient struct {
+	client aiv1.CampaignArtifactServiceClient
+}
+
+var _ gametools.ArtifactManager = artifactClient{}
+
+func (c artifactClient) ListArtifacts(ctx context.Context, campaignID string) ([]campaignartifact.Artifact, error) {
+	resp, err := c.client.ListCampaignArtifacts(ctx, &aiv1.ListCampaignArtifactsRequest{CampaignId: campaignID})
+	if err != nil {
+		return nil, err
+	}
+	artifacts := make([]campaignartifact.Artifact, 0, len(resp.GetArtifacts()))
+	for _, artifact := range resp.GetArtifacts() {
+		artifacts = append(artifacts, artifactFromProto(artifact))
+	}
+	return artifacts, nil
+}
+
+func (c artifactClient) GetArtifact(ctx context.Context, campaignID string, path string) (campaignartifact.Artifact, error) {
+	resp, err := c.client.GetCampaignArtifact(ctx, &aiv1.GetCampaignArtifactRequest{CampaignId: campaignID, Path: path})
+	if err != nil {
+		return campaignartifact.Artifact{}, err
+	}
+	if resp.GetArtifact() == nil {
+		return campaignartifact.Artifact{}, errors.New("campaign artifact response is missing")
+	}
+	return artifactFromProto(resp.GetArtifact()), nil
+}
+
+func (c artifactClient) UpsertArtifact(ctx context.Context, campaignID string, path string, content string) (campaignartifact.Artifact, error) {
+	resp, err := c.client.UpsertCampaignArtifact(ctx, &aiv1.UpsertCampaignArtifactRequest{CampaignId: campaignID, Path: path, Content: content})
+	if err != nil {
+		return campaignartifact.Artifact{}, err
+	}
+	if resp.GetArtifact() == nil {
+		return campaignartifact.Artifact{}, errors.New("campaign artifact response is missing")
+	}
+	return artifactFromProto(resp.GetArtifact()), nil
+}
+
+func artifactFromProto(artifact *aiv1.CampaignArtifact) campaignartifact.Artifact {
+	record := campaignartifact.Artifact{
+		CampaignID: artifact.GetCampaignId(),
+		Path:       artifact.GetPath(),
+		Content:    artifact.GetContent(),
+		ReadOnly:   artifact.GetReadOnly(),
+	}
+	if artifact.GetCreatedAt() != nil {
+		record.CreatedAt = artifact.GetCreatedAt().AsTime()
+	}
+	if artifact.GetUpdatedAt() != nil {
+		record.UpdatedAt = artifact.GetUpdatedAt().AsTime()
+	}
+	return record
+}
+
+// referenceClient adapts the AI system reference API to
+// gametools.ReferenceCorpus.
+type referenceClient struct {
+	client aiv1.SystemReferenceServiceClient
+}
+
+var _ gametools.ReferenceCorpus = referenceClient{}
+
+func (c referenceClient) Search(ctx context.Context, system, query string, maxResults int) ([]referencecorpus.SearchResult, error) {
+	resp, err := c.client.SearchSystemReference(ctx, &aiv1.SearchSystemReferenceRequest{
+		System:     system,
+		Query:      query,
+		MaxResults: int32(maxResults),
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]referencecorpus.SearchResult, 0, len(resp.GetResults()))
+	for _, result := range resp.GetResults() {
+		results = append(results, referencecorpus.SearchResult{
+			System:     result.GetSystem(),
+			DocumentID: result.GetDocumentId(),
+			Title:      result.GetTitle(),
+			Kind:       result.GetKind(),
+			Path:       result.GetPath(),
+			Aliases:    result.GetAliases(),
+			Snippet:    result.GetSnippet(),
+		})
+	}
+	return results, nil
+}
+
+func (c referenceClient) Read(ctx context.Context, system, documentID string) (referencecorpus.Document, error) {
+	resp, err := c.client.ReadSystemReferenceDocument(ctx, &aiv1.ReadSystemReferenceDocumentRequest{System: system, DocumentId: documentID})
+	if err != nil {
+		return referencecorpus.Document{}, err
+	}
+	doc := resp.GetDocument()
+	if doc == nil {
+		return referencecorpus.Document{}, errors.New("system reference document response is missing")
+	}
+	return referencecorpus.Document{
+		System:     doc.GetSystem(),
+		DocumentID: doc.GetDocumentId(),
+		Title:      doc.GetTitle(),
+		Kind:       doc.GetKind(),
+		Path:       doc.GetPath(),
+		Aliases:    doc.GetAliases(),
+		Content:    doc.GetContent(),
+	}, nil
+}
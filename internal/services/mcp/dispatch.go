@@ -0,0 +1,287 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/gametools"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/mcpbridge"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serverName and serverVersion identify the server in initialize results.
+const (
+	serverName    = "fracturing-space-mcp"
+	serverVersion = "v1"
+)
+
+// resourceMIMEType is the content type of every resource the catalog reads.
+const resourceMIMEType = "application/json"
+
+const serverInstructions = "Game tools for one Fracturing.Space campaign. Tool calls and resource reads act as your participant in the connected campaign; campaign_id arguments default to that campaign and may not name another one. Read context://current first to see the campaign, session, and participant in scope."
+
+// Service opens scoped MCP clients over the game tool catalog.
+type Service struct {
+	dialer   orchestration.Dialer
+	resolver ScopeResolver
+}
+
+// NewService builds a Service from a game tools dialer and a scope resolver.
+func NewService(dialer orchestration.Dialer, resolver ScopeResolver) *Service {
+	return &Service{dialer: dialer, resolver: resolver}
+}
+
+// client is one MCP client bound to a resolved scope: the tool session it
+// calls through and the policy that filters it.
+type client struct {
+	scope   Scope
+	session orchestration.Session
+	policy  orchestration.ToolPolicy
+}
+
+// open resolves the caller's scope in campaignID and dials a tool session
+// bound to it.
+func (s *Service) open(ctx context.Context, userID, campaignID string) (*client, error) {
+	scope, err := s.resolver.ResolveScope(ctx, userID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.dialer.Dial(mcpbridge.WithSessionContext(ctx, mcpbridge.SessionContext{
+		CampaignID:    scope.CampaignID,
+		SessionID:     scope.SessionID,
+		ParticipantID: scope.ParticipantID,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("dial game tools: %w", err)
+	}
+	return &client{scope: scope, session: session, policy: toolPolicy(scope.Role)}, nil
+}
+
+func (c *client) close() {
+	if err := c.session.Close(); err != nil {
+		log.Printf("mcp: close tool session: %v", err)
+	}
+}
+
+// decodeMessage parses one inbound JSON-RPC message. On failure it returns
+// the error response to send back.
+func decodeMessage(body []byte) (rpcMessage, *rpcResponse) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		resp := errorResponse(nil, codeInvalidRequest, "JSON-RPC batches are not supported")
+		return rpcMessage{}, &resp
+	}
+	var msg rpcMessage
+	if err := json.Unmarshal(trimmed, &msg); err != nil {
+		resp := errorResponse(nil, codeParseError, "invalid JSON")
+		return rpcMessage{}, &resp
+	}
+	if msg.JSONRPC != jsonRPCVersion || strings.TrimSpace(msg.Method) == "" {
+		// Responses to server-initiated requests have no method; the server
+		// never sends any, so they are invalid here too.
+		resp := errorResponse(msg.ID, codeInvalidRequest, "invalid JSON-RPC request")
+		return rpcMessage{}, &resp
+	}
+	return msg, nil
+}
+
+// handle runs one request. ok is false for notifications, which get no
+// response.
+func (c *client) handle(ctx context.Context, msg rpcMessage) (resp rpcResponse, ok bool) {
+	if msg.isNotification() {
+		return rpcResponse{}, false
+	}
+	// Game and AI authorize tool calls as the scoped user; the tool session
+	// adds the campaign, session, and participant headers.
+	ctx = grpcauthctx.WithUserID(ctx, c.scope.UserID)
+
+	var (
+		result any
+		rpcErr *rpcError
+	)
+	switch msg.Method {
+	case "initialize":
+		result, rpcErr = c.initialize(msg.Params)
+	case "ping":
+		result = struct{}{}
+	case "tools/list":
+		result, rpcErr = c.listTools(ctx)
+	case "tools/call":
+		result, rpcErr = c.callTool(ctx, msg.Params)
+	case "resources/list":
+		result = c.listResources()
+	case "resources/templates/list":
+		result = listResourceTemplates()
+	case "resources/read":
+		result, rpcErr = c.readResource(ctx, msg.Params)
+	default:
+		rpcErr = &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q is not supported", msg.Method)}
+	}
+	if rpcErr != nil {
+		return errorResponse(msg.ID, rpcErr.Code, rpcErr.Message), true
+	}
+	return rpcResponse{JSONRPC: jsonRPCVersion, ID: msg.ID, Result: result}, true
+}
+
+func (c *client) initialize(raw json.RawMessage) (any, *rpcError) {
+	var params initializeParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+	version := latestProtocolVersion
+	if _, ok := supportedProtocolVersions[params.ProtocolVersion]; ok {
+		version = params.ProtocolVersion
+	}
+	return initializeResult{
+		ProtocolVersion: version,
+		Capabilities:    serverCapabilities{Tools: &struct{}{}, Resources: &struct{}{}},
+		ServerInfo:      implementation{Name: serverName, Version: serverVersion},
+		Instructions:    serverInstructions,
+	}, nil
+}
+
+func (c *client) listTools(ctx context.Context) (any, *rpcError) {
+	tools, err := c.session.ListTools(ctx)
+	if err != nil {
+		log.Printf("mcp: list tools: %v", err)
+		return nil, &rpcError{Code: codeInternalError, Message: "list tools failed"}
+	}
+	result := listToolsResult{Tools: []toolDescriptor{}}
+	for _, tool := range tools {
+		if !c.policy.Allows(tool.Name) {
+			continue
+		}
+		result.Tools = append(result.Tools, toolDescriptor{
+			Name:        tool.Name,
+			Description: tool.Description,
+			InputSchema: tool.InputSchema,
+		})
+	}
+	return result, nil
+}
+
+func (c *client) callTool(ctx context.Context, raw json.RawMessage) (any, *rpcError) {
+	var params callToolParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "tool name is required"}
+	}
+	// Tools outside the policy are reported as unknown so the client cannot
+	// probe for what other roles may call.
+	if !c.policy.Allows(name) {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool %q", name)}
+	}
+	if err := checkToolArguments(c.scope, params.Arguments); err != nil {
+		return toolError(err.Error()), nil
+	}
+	args := params.Arguments
+	if args == nil {
+		args = map[string]any{}
+	}
+	result, err := c.session.CallTool(ctx, name, args)
+	if err != nil {
+		log.Printf("mcp: call tool %s: %v", name, err)
+		return nil, &rpcError{Code: codeInternalError, Message: "tool call failed"}
+	}
+	return callToolResult{
+		Content: []contentBlock{{Type: "text", Text: result.Output}},
+		IsError: result.IsError,
+	}, nil
+}
+
+func toolError(message string) callToolResult {
+	return callToolResult{Content: []contentBlock{{Type: "text", Text: message}}, IsError: true}
+}
+
+// listResources expands every template whose only placeholders are the
+// scoped campaign and session into a concrete URI. Templates that need other
+// ids are left to resources/templates/list.
+func (c *client) listResources() listResourcesResult {
+	result := listResourcesResult{Resources: []resourceDescriptor{}}
+	for _, template := range gametools.ResourceTemplates() {
+		uri := strings.ReplaceAll(template.URITemplate, "{campaign_id}", c.scope.CampaignID)
+		if c.scope.SessionID != "" {
+			uri = strings.ReplaceAll(uri, "{session_id}", c.scope.SessionID)
+		}
+		if strings.Contains(uri, "{") {
+			continue
+		}
+		result.Resources = append(result.Resources, resourceDescriptor{
+			URI:         uri,
+			Name:        template.Name,
+			Description: template.Description,
+			MIMEType:    template.MIMEType,
+		})
+	}
+	return result
+}
+
+func listResourceTemplates() listResourceTemplatesResult {
+	templates := gametools.ResourceTemplates()
+	result := listResourceTemplatesResult{ResourceTemplates: make([]resourceTemplateDescriptor, 0, len(templates))}
+	for _, template := range templates {
+		result.ResourceTemplates = append(result.ResourceTemplates, resourceTemplateDescriptor{
+			URITemplate: template.URITemplate,
+			Name:        template.Name,
+			Description: template.Description,
+			MIMEType:    template.MIMEType,
+		})
+	}
+	return result
+}
+
+func (c *client) readResource(ctx context.Context, raw json.RawMessage) (any, *rpcError) {
+	var params readResourceParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+	uri := strings.TrimSpace(params.URI)
+	if uri == "" {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "resource uri is required"}
+	}
+	if err := checkResourceURI(c.scope, uri); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	text, err := c.session.ReadResource(ctx, uri)
+	if err != nil {
+		return nil, resourceReadError(uri, err)
+	}
+	return readResourceResult{Contents: []resourceContents{{
+		URI:      uri,
+		MIMEType: resourceMIMEType,
+		Text:     text,
+	}}}, nil
+}
+
+// resourceReadError keeps caller mistakes (bad URIs, missing records,
+// denied access) distinct from dependency failures, which are logged and
+// reported without detail.
+func resourceReadError(uri string, err error) *rpcError {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unimplemented:
+		log.Printf("mcp: read resource %s: %v", uri, err)
+		return &rpcError{Code: codeInternalError, Message: "resource read failed"}
+	default:
+		return &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+}
+
+func decodeParams(raw json.RawMessage, dst any) *rpcError {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+	}
+	return nil
+}
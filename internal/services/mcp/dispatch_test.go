@@ -0,0 +1,410 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/mcpbridge"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeSession records tool calls and resource reads.
+type fakeSession struct {
+	tools      []orchestration.Tool
+	result     orchestration.ToolResult
+	resource   string
+	readErr    error
+	calledTool string
+	calledArgs any
+	readURI    string
+	md         metadata.MD
+	closed     bool
+}
+
+func (f *fakeSession) ListTools(context.Context) ([]orchestration.Tool, error) {
+	return f.tools, nil
+}
+
+func (f *fakeSession) CallTool(ctx context.Context, name string, args any) (orchestration.ToolResult, error) {
+	f.calledTool = name
+	f.calledArgs = args
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+	return f.result, nil
+}
+
+func (f *fakeSession) ReadResource(ctx context.Context, uri string) (string, error) {
+	f.readURI = uri
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+	return f.resource, f.readErr
+}
+
+func (f *fakeSession) Close() error {
+	f.closed = true
+	return nil
+}
+
+type fakeDialer struct {
+	session *fakeSession
+	sc      mcpbridge.SessionContext
+}
+
+func (f *fakeDialer) Dial(ctx context.Context) (orchestration.Session, error) {
+	f.sc = mcpbridge.SessionContextFromContext(ctx)
+	return f.session, nil
+}
+
+type fakeResolver struct {
+	scope  Scope
+	err    error
+	userID string
+}
+
+func (f *fakeResolver) ResolveScope(_ context.Context, userID, campaignID string) (Scope, error) {
+	f.userID = userID
+	if f.err != nil {
+		return Scope{}, f.err
+	}
+	scope := f.scope
+	scope.UserID = userID
+	scope.CampaignID = campaignID
+	return scope, nil
+}
+
+func gmScope() Scope {
+	return Scope{SessionID: "sess-1", ParticipantID: "part-1", Role: gamev1.ParticipantRole_GM}
+}
+
+func testTools() []orchestration.Tool {
+	return []orchestration.Tool{
+		{Name: "interaction_state_read", Description: "read"},
+		{Name: "scene_create", Description: "create"},
+		{Name: "interaction_conclude_session", Description: "conclude"},
+	}
+}
+
+func openTestClient(t *testing.T, session *fakeSession, scope Scope) *client {
+	t.Helper()
+	svc := NewService(&fakeDialer{session: session}, &fakeResolver{scope: scope})
+	c, err := svc.open(context.Background(), "user-1", "camp-1")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	return c
+}
+
+// call runs one request and round-trips the response through JSON so tests
+// inspect what a client would see.
+func call(t *testing.T, c *client, method, params string) map[string]any {
+	t.Helper()
+	msg := rpcMessage{JSONRPC: jsonRPCVersion, ID: json.RawMessage(`7`), Method: method}
+	if params != "" {
+		msg.Params = json.RawMessage(params)
+	}
+	resp, ok := c.handle(context.Background(), msg)
+	if !ok {
+		t.Fatalf("%s: no response", method)
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if decoded["id"] != float64(7) {
+		t.Fatalf("%s: id = %v, want 7", method, decoded["id"])
+	}
+	return decoded
+}
+
+func errorCode(resp map[string]any) float64 {
+	rpcErr, _ := resp["error"].(map[string]any)
+	code, _ := rpcErr["code"].(float64)
+	return code
+}
+
+func TestOpenBindsSessionToResolvedScope(t *testing.T) {
+	session := &fakeSession{}
+	dialer := &fakeDialer{session: session}
+	resolver := &fakeResolver{scope: gmScope()}
+	c, err := NewService(dialer, resolver).open(context.Background(), "user-1", "camp-1")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if resolver.userID != "user-1" {
+		t.Fatalf("resolver user = %q", resolver.userID)
+	}
+	want := mcpbridge.SessionContext{CampaignID: "camp-1", SessionID: "sess-1", ParticipantID: "part-1"}
+	if dialer.sc != want {
+		t.Fatalf("session context = %+v, want %+v", dialer.sc, want)
+	}
+	c.close()
+	if !session.closed {
+		t.Fatal("expected session to be closed")
+	}
+}
+
+func TestOpenPropagatesScopeErrors(t *testing.T) {
+	svc := NewService(&fakeDialer{session: &fakeSession{}}, &fakeResolver{err: errNoParticipant})
+	if _, err := svc.open(context.Background(), "user-1", "camp-1"); !errors.Is(err, errNoParticipant) {
+		t.Fatalf("open error = %v, want errNoParticipant", err)
+	}
+}
+
+func TestInitializeNegotiatesProtocolVersion(t *testing.T) {
+	c := openTestClient(t, &fakeSession{}, gmScope())
+	tests := []struct {
+		requested string
+		want      string
+	}{
+		{"2025-03-26", "2025-03-26"},
+		{"1999-01-01", latestProtocolVersion},
+		{"", latestProtocolVersion},
+	}
+	for _, tt := range tests {
+		resp := call(t, c, "initialize", `{"protocolVersion":"`+tt.requested+`"}`)
+		result := resp["result"].(map[string]any)
+		if result["protocolVersion"] != tt.want {
+			t.Fatalf("requested %q: protocolVersion = %v, want %q", tt.requested, result["protocolVersion"], tt.want)
+		}
+		capabilities := result["capabilities"].(map[string]any)
+		if _, ok := capabilities["tools"]; !ok {
+			t.Fatalf("capabilities = %v, want tools", capabilities)
+		}
+	}
+}
+
+func TestHandleIgnoresNotificationsAndRejectsUnknownMethods(t *testing.T) {
+	c := openTestClient(t, &fakeSession{}, gmScope())
+	if _, ok := c.handle(context.Background(), rpcMessage{JSONRPC: jsonRPCVersion, Method: "notifications/initialized"}); ok {
+		t.Fatal("expected no response to a notification")
+	}
+	if code := errorCode(call(t, c, "prompts/list", "")); code != codeMethodNotFound {
+		t.Fatalf("error code = %v, want %d", code, codeMethodNotFound)
+	}
+}
+
+func TestToolsListFiltersByRole(t *testing.T) {
+	tests := []struct {
+		name string
+		role gamev1.ParticipantRole
+		want []string
+	}{
+		{"gm excludes internal-only tools", gamev1.ParticipantRole_GM, []string{"interaction_state_read", "scene_create"}},
+		{"player gets the player profile", gamev1.ParticipantRole_PLAYER, []string{"interaction_state_read"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := gmScope()
+			scope.Role = tt.role
+			c := openTestClient(t, &fakeSession{tools: testTools()}, scope)
+			result := call(t, c, "tools/list", "")["result"].(map[string]any)
+			var got []string
+			for _, tool := range result["tools"].([]any) {
+				got = append(got, tool.(map[string]any)["name"].(string))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("tools = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToolsCallRunsAllowedToolAsScopedUser(t *testing.T) {
+	session := &fakeSession{result: orchestration.ToolResult{Output: `{"ok":true}`}}
+	c := openTestClient(t, session, gmScope())
+	resp := call(t, c, "tools/call", `{"name":"scene_create","arguments":{"campaign_id":"camp-1","name":"Docks"}}`)
+	result := resp["result"].(map[string]any)
+	content := result["content"].([]any)[0].(map[string]any)
+	if content["type"] != "text" || content["text"] != `{"ok":true}` {
+		t.Fatalf("content = %v", content)
+	}
+	if _, ok := result["isError"]; ok {
+		t.Fatalf("result = %v, want no isError", result)
+	}
+	if session.calledTool != "scene_create" {
+		t.Fatalf("called tool = %q", session.calledTool)
+	}
+	if got := session.md.Get(grpcmeta.UserIDHeader); len(got) != 1 || got[0] != "user-1" {
+		t.Fatalf("user id metadata = %v", got)
+	}
+}
+
+func TestToolsCallRejectsToolsOutsidePolicy(t *testing.T) {
+	scope := gmScope()
+	scope.Role = gamev1.ParticipantRole_PLAYER
+	session := &fakeSession{}
+	c := openTestClient(t, session, scope)
+	for _, name := range []string{"scene_create", "interaction_conclude_session", "no_such_tool"} {
+		if code := errorCode(call(t, c, "tools/call", `{"name":"`+name+`"}`)); code != codeInvalidParams {
+			t.Fatalf("%s: error code = %v, want %d", name, code, codeInvalidParams)
+		}
+	}
+	if session.calledTool != "" {
+		t.Fatalf("called tool = %q, want none", session.calledTool)
+	}
+}
+
+func TestToolsCallRejectsOtherCampaigns(t *testing.T) {
+	session := &fakeSession{}
+	c := openTestClient(t, session, gmScope())
+	result := call(t, c, "tools/call", `{"name":"scene_create","arguments":{"campaign_id":"camp-2"}}`)["result"].(map[string]any)
+	if result["isError"] != true {
+		t.Fatalf("result = %v, want isError", result)
+	}
+	if session.calledTool != "" {
+		t.Fatalf("called tool = %q, want none", session.calledTool)
+	}
+}
+
+func TestResourcesListExpandsScopedTemplates(t *testing.T) {
+	tests := []struct {
+		name      string
+		sessionID string
+		wantRecap bool
+	}{
+		{"active session", "sess-1", true},
+		{"no active session", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := gmScope()
+			scope.SessionID = tt.sessionID
+			c := openTestClient(t, &fakeSession{}, scope)
+			result := call(t, c, "resources/list", "")["result"].(map[string]any)
+			uris := map[string]bool{}
+			for _, resource := range result["resources"].([]any) {
+				uri := resource.(map[string]any)["uri"].(string)
+				if strings.Contains(uri, "{") {
+					t.Fatalf("resource %q is not concrete", uri)
+				}
+				uris[uri] = true
+			}
+			if !uris["campaign://camp-1/interaction"] || !uris["context://current"] {
+				t.Fatalf("resources = %v", uris)
+			}
+			if got := uris["campaign://camp-1/sessions/sess-1/recap"]; got != tt.wantRecap {
+				t.Fatalf("recap listed = %v, want %v", got, tt.wantRecap)
+			}
+		})
+	}
+}
+
+func TestResourcesTemplatesList(t *testing.T) {
+	c := openTestClient(t, &fakeSession{}, gmScope())
+	result := call(t, c, "resources/templates/list", "")["result"].(map[string]any)
+	if len(result["resourceTemplates"].([]any)) == 0 {
+		t.Fatal("expected resource templates")
+	}
+}
+
+func TestResourcesReadEnforcesScope(t *testing.T) {
+	session := &fakeSession{resource: `{"id":"camp-1"}`}
+	c := openTestClient(t, session, gmScope())
+
+	result := call(t, c, "resources/read", `{"uri":"campaign://camp-1"}`)["result"].(map[string]any)
+	contents := result["contents"].([]any)[0].(map[string]any)
+	if contents["uri"] != "campaign://camp-1" || contents["text"] != `{"id":"camp-1"}` || contents["mimeType"] != "application/json" {
+		t.Fatalf("contents = %v", contents)
+	}
+	if got := session.md.Get(grpcmeta.UserIDHeader); len(got) != 1 || got[0] != "user-1" {
+		t.Fatalf("user id metadata = %v", got)
+	}
+
+	session.readURI = ""
+	for _, uri := range []string{"campaign://camp-2/participants", "daggerheart://campaign/camp-2/snapshot"} {
+		if code := errorCode(call(t, c, "resources/read", `{"uri":"`+uri+`"}`)); code != codeInvalidParams {
+			t.Fatalf("%s: error code = %v, want %d", uri, code, codeInvalidParams)
+		}
+	}
+	if session.readURI != "" {
+		t.Fatalf("read uri = %q, want none", session.readURI)
+	}
+}
+
+func TestDecodeMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{"request", `{"jsonrpc":"2.0","id":1,"method":"ping"}`, 0},
+		{"notification", `{"jsonrpc":"2.0","method":"notifications/initialized"}`, 0},
+		{"batch", `[{"jsonrpc":"2.0","id":1,"method":"ping"}]`, codeInvalidRequest},
+		{"invalid json", `{"jsonrpc":`, codeParseError},
+		{"wrong version", `{"jsonrpc":"1.0","id":1,"method":"ping"}`, codeInvalidRequest},
+		{"response", `{"jsonrpc":"2.0","id":1,"result":{}}`, codeInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, failure := decodeMessage([]byte(tt.body))
+			switch {
+			case tt.wantCode == 0 && failure != nil:
+				t.Fatalf("unexpected failure %+v", failure.Error)
+			case tt.wantCode != 0 && (failure == nil || failure.Error.Code != tt.wantCode):
+				t.Fatalf("failure = %+v, want code %d", failure, tt.wantCode)
+			}
+		})
+	}
+}
+
+// fakeInteractionClient answers GetInteractionState and records metadata.
+type fakeInteractionClient struct {
+	gamev1.InteractionServiceClient
+	state *gamev1.InteractionState
+	err   error
+	md    metadata.MD
+	req   *gamev1.GetInteractionStateRequest
+}
+
+func (f *fakeInteractionClient) GetInteractionState(ctx context.Context, in *gamev1.GetInteractionStateRequest, _ ...grpc.CallOption) (*gamev1.GetInteractionStateResponse, error) {
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+	f.req = in
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &gamev1.GetInteractionStateResponse{State: f.state}, nil
+}
+
+func TestScopeResolverUsesViewerFromUserIdentity(t *testing.T) {
+	interaction := &fakeInteractionClient{state: &gamev1.InteractionState{
+		Viewer:        &gamev1.InteractionViewer{ParticipantId: "part-1", Role: gamev1.ParticipantRole_PLAYER},
+		ActiveSession: &gamev1.InteractionSession{SessionId: "sess-1"},
+	}}
+	scope, err := NewScopeResolver(interaction).ResolveScope(context.Background(), "user-1", "camp-1")
+	if err != nil {
+		t.Fatalf("ResolveScope: %v", err)
+	}
+	want := Scope{UserID: "user-1", CampaignID: "camp-1", SessionID: "sess-1", ParticipantID: "part-1", Role: gamev1.ParticipantRole_PLAYER}
+	if scope != want {
+		t.Fatalf("scope = %+v, want %+v", scope, want)
+	}
+	if interaction.req.GetCampaignId() != "camp-1" {
+		t.Fatalf("campaign id = %q", interaction.req.GetCampaignId())
+	}
+	if got := interaction.md.Get(grpcmeta.UserIDHeader); len(got) != 1 || got[0] != "user-1" {
+		t.Fatalf("user id metadata = %v", got)
+	}
+	// The participant must come from game, not from the caller.
+	if got := interaction.md.Get(grpcmeta.ParticipantIDHeader); len(got) != 0 {
+		t.Fatalf("participant metadata = %v, want none", got)
+	}
+}
+
+func TestScopeResolverRejectsMissingViewer(t *testing.T) {
+	interaction := &fakeInteractionClient{state: &gamev1.InteractionState{}}
+	if _, err := NewScopeResolver(interaction).ResolveScope(context.Background(), "user-1", "camp-1"); !errors.Is(err, errNoParticipant) {
+		t.Fatalf("error = %v, want errNoParticipant", err)
+	}
+	if _, err := NewScopeResolver(interaction).ResolveScope(context.Background(), "", "camp-1"); err == nil {
+		t.Fatal("expected error for missing user id")
+	}
+}
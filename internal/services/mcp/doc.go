@@ -0,0 +1,17 @@
+// Package mcp serves the AI game tool catalog to external assistants over the
+// Model Context Protocol.
+//
+// The tools and resources are the same ones the internal orchestration
+// runner uses (orchestration/gametools): scenes, interactions, Daggerheart
+// mechanics, dice, artifacts, and reference search. Here they run as an OAuth
+// user instead of the AI service identity. Each client is bound to one
+// campaign; the participant, role, and active session are resolved from the
+// token's user through game, never taken from the client. The participant
+// role picks the tool profile (user-scoped GM or player), and tool arguments
+// or resource URIs naming another campaign are rejected.
+//
+// Two transports share one JSON-RPC dispatcher: streamable HTTP at
+// POST /campaigns/{campaign_id}/mcp, stateless and authenticated per request
+// with a bearer token, and newline-delimited stdio, authenticated once at
+// startup for self-hosted assistants.
+package mcp
@@ -0,0 +1,145 @@
+package mcp
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/platform/requestctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+)
+
+// maxMessageBytes bounds one inbound JSON-RPC message on either transport.
+const maxMessageBytes = 4 << 20
+
+// protocolVersionHeader is the MCP revision header clients send after
+// initialize on the streamable HTTP transport.
+const protocolVersionHeader = "MCP-Protocol-Version"
+
+const healthPath = "/up"
+
+// NewHTTPHandler serves the streamable HTTP transport.
+//
+// Each campaign has its own endpoint, POST /campaigns/{campaign_id}/mcp. The
+// server is stateless: every message is authenticated, scoped, and answered
+// with a single JSON response, so no Mcp-Session-Id is issued and GET streams
+// are not offered. requirement decides which OAuth clients and scopes may call
+// tools. allowedOrigins lists browser origins permitted to call the endpoint;
+// requests without an Origin header (non-browser clients) are always allowed.
+func NewHTTPHandler(svc *Service, introspector authctx.Introspector, requirement authctx.TokenRequirement, allowedOrigins []string) http.Handler {
+	origins := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin = strings.TrimRight(strings.TrimSpace(origin), "/"); origin != "" {
+			origins[origin] = struct{}{}
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+healthPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	endpoint := requireOrigin(origins, requireBearer(messageHandler(svc), introspector, requirement))
+	mux.Handle("POST /campaigns/{campaign_id}/mcp", endpoint)
+	mux.HandleFunc("/campaigns/{campaign_id}/mcp", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorBody(w, http.StatusMethodNotAllowed, apperrors.HTTPError{Code: "UNIMPLEMENTED", Message: "only POST is supported; this server does not offer SSE streams"})
+	})
+	return mux
+}
+
+// requireOrigin rejects browser requests from origins outside the allow list,
+// which guards against DNS rebinding against locally reachable servers.
+func requireOrigin(allowed map[string]struct{}, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := strings.TrimSpace(r.Header.Get("Origin")); origin != "" {
+			if _, ok := allowed[strings.TrimRight(origin, "/")]; !ok {
+				writeErrorBody(w, http.StatusForbidden, apperrors.HTTPError{Code: "PERMISSION_DENIED", Message: "origin is not allowed"})
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// messageHandler answers one JSON-RPC message for the authenticated user.
+func messageHandler(svc *Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if version := strings.TrimSpace(r.Header.Get(protocolVersionHeader)); version != "" {
+			if _, ok := supportedProtocolVersions[version]; !ok {
+				writeRPC(w, http.StatusBadRequest, errorResponse(nil, codeInvalidRequest, "unsupported MCP protocol version "+version))
+				return
+			}
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMessageBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				writeRPC(w, http.StatusRequestEntityTooLarge, errorResponse(nil, codeInvalidRequest, "message is too large"))
+				return
+			}
+			writeRPC(w, http.StatusBadRequest, errorResponse(nil, codeParseError, "read request body"))
+			return
+		}
+		msg, failure := decodeMessage(body)
+		if failure != nil {
+			writeRPC(w, http.StatusBadRequest, *failure)
+			return
+		}
+		if msg.isNotification() {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		userID := requestctx.UserIDFromContext(r.Context())
+		c, err := svc.open(r.Context(), userID, r.PathValue("campaign_id"))
+		if err != nil {
+			writeScopeError(w, err)
+			return
+		}
+		defer c.close()
+
+		resp, _ := c.handle(r.Context(), msg)
+		writeRPC(w, http.StatusOK, resp)
+	})
+}
+
+// writeScopeError reports why the caller could not be bound to the campaign.
+func writeScopeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errNoParticipant) {
+		writeErrorBody(w, http.StatusForbidden, apperrors.HTTPError{Code: "PERMISSION_DENIED", Message: err.Error()})
+		return
+	}
+	httpStatus, body := apperrors.HTTPErrorFromGRPC(err)
+	if httpStatus >= http.StatusInternalServerError {
+		log.Printf("mcp: resolve scope: %v", err)
+	}
+	writeErrorBody(w, httpStatus, body)
+}
+
+func writeRPC(w http.ResponseWriter, httpStatus int, resp rpcResponse) {
+	body, err := json.Marshal(resp)
+	if err != nil {
+		log.Printf("mcp: encode response: %v", err)
+		body, _ = json.Marshal(errorResponse(resp.ID, codeInternalError, "encode response"))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(body)
+}
+
+func writeErrorBody(w http.ResponseWriter, httpStatus int, body apperrors.HTTPError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(errorEnvelope{Error: body})
+}
+
+// errorEnvelope wraps transport-level failures (authentication, origin,
+// scope) in the platform HTTP error contract. Protocol failures use JSON-RPC
+// error responses instead.
+type errorEnvelope struct {
+	Error apperrors.HTTPError `json:"error"`
+}
@@ -0,0 +1,205 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeIntrospector struct {
+	result authctx.IntrospectionResult
+	err    error
+	token  string
+}
+
+func (f *fakeIntrospector) Introspect(_ context.Context, token string) (authctx.IntrospectionResult, error) {
+	f.token = token
+	return f.result, f.err
+}
+
+func activeUser() *fakeIntrospector {
+	return &fakeIntrospector{result: authctx.IntrospectionResult{Active: true, UserID: "user-1", ClientID: "mcp-client", Scope: ToolsScope}}
+}
+
+// otherClientUser returns an active token issued to a client outside
+// testTokens.
+func otherClientUser() *fakeIntrospector {
+	introspector := activeUser()
+	introspector.result.ClientID = "fracturing-space"
+	return introspector
+}
+
+var testTokens = authctx.TokenRequirement{Scope: ToolsScope, ClientIDs: []string{"mcp-client"}}
+
+func newTestHTTPHandler(session *fakeSession, resolver *fakeResolver, introspector authctx.Introspector) http.Handler {
+	svc := NewService(&fakeDialer{session: session}, resolver)
+	return NewHTTPHandler(svc, introspector, testTokens, []string{"https://app.example.com/"})
+}
+
+func post(handler http.Handler, target, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+var bearer = map[string]string{"Authorization": "Bearer tok-1"}
+
+const pingRequest = `{"jsonrpc":"2.0","id":1,"method":"ping"}`
+
+func TestHTTPServesToolsAsScopedCampaign(t *testing.T) {
+	session := &fakeSession{tools: testTools()}
+	resolver := &fakeResolver{scope: gmScope()}
+	introspector := activeUser()
+	handler := newTestHTTPHandler(session, resolver, introspector)
+
+	rec := post(handler, "/campaigns/camp-1/mcp", `{"jsonrpc":"2.0","id":"a","method":"tools/list"}`, bearer)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/json" {
+		t.Fatalf("content type = %q", got)
+	}
+	var resp struct {
+		ID     string `json:"id"`
+		Result struct {
+			Tools []struct {
+				Name string `json:"name"`
+			} `json:"tools"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.ID != "a" || len(resp.Result.Tools) != 2 {
+		t.Fatalf("response = %+v", resp)
+	}
+	if introspector.token != "tok-1" || resolver.userID != "user-1" {
+		t.Fatalf("token = %q, resolver user = %q", introspector.token, resolver.userID)
+	}
+	if !session.closed {
+		t.Fatal("expected per-request session to be closed")
+	}
+}
+
+func TestHTTPAuthentication(t *testing.T) {
+	tests := []struct {
+		name          string
+		introspector  *fakeIntrospector
+		headers       map[string]string
+		wantStatus    int
+		wantChallenge string
+	}{
+		{"missing token", activeUser(), nil, http.StatusUnauthorized, bearerChallenge},
+		{"inactive token", &fakeIntrospector{result: authctx.IntrospectionResult{Active: false}}, bearer, http.StatusUnauthorized, bearerChallenge + `, error="invalid_token"`},
+		{"introspection down", &fakeIntrospector{err: errors.New("boom")}, bearer, http.StatusServiceUnavailable, ""},
+		{"other client", otherClientUser(), bearer, http.StatusUnauthorized, bearerChallenge + `, error="invalid_token"`},
+		{"missing tools scope", &fakeIntrospector{result: authctx.IntrospectionResult{Active: true, UserID: "user-1", ClientID: "mcp-client", Scope: "game:api"}}, bearer, http.StatusForbidden, bearerChallenge + `, error="insufficient_scope", scope="` + ToolsScope + `"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &fakeResolver{scope: gmScope()}
+			handler := newTestHTTPHandler(&fakeSession{}, resolver, tt.introspector)
+			rec := post(handler, "/campaigns/camp-1/mcp", pingRequest, tt.headers)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("WWW-Authenticate"); got != tt.wantChallenge {
+				t.Fatalf("challenge = %q, want %q", got, tt.wantChallenge)
+			}
+			if resolver.userID != "" {
+				t.Fatalf("resolved campaign scope for rejected token as %q", resolver.userID)
+			}
+		})
+	}
+}
+
+func TestHTTPScopeFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"not a participant", errNoParticipant, http.StatusForbidden},
+		{"campaign not found", status.Error(codes.NotFound, "campaign not found"), http.StatusNotFound},
+		{"game unavailable", status.Error(codes.Unavailable, "down"), http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newTestHTTPHandler(&fakeSession{}, &fakeResolver{err: tt.err}, activeUser())
+			rec := post(handler, "/campaigns/camp-1/mcp", pingRequest, bearer)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestHTTPOriginCheck(t *testing.T) {
+	handler := newTestHTTPHandler(&fakeSession{}, &fakeResolver{scope: gmScope()}, activeUser())
+	tests := []struct {
+		origin     string
+		wantStatus int
+	}{
+		{"", http.StatusOK},
+		{"https://app.example.com", http.StatusOK},
+		{"https://evil.example.com", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		headers := map[string]string{"Authorization": "Bearer tok-1"}
+		if tt.origin != "" {
+			headers["Origin"] = tt.origin
+		}
+		if rec := post(handler, "/campaigns/camp-1/mcp", pingRequest, headers); rec.Code != tt.wantStatus {
+			t.Fatalf("origin %q: status = %d, want %d", tt.origin, rec.Code, tt.wantStatus)
+		}
+	}
+}
+
+func TestHTTPProtocolHandling(t *testing.T) {
+	resolver := &fakeResolver{scope: gmScope()}
+	handler := newTestHTTPHandler(&fakeSession{}, resolver, activeUser())
+
+	rec := post(handler, "/campaigns/camp-1/mcp", `{"jsonrpc":"2.0","method":"notifications/initialized"}`, bearer)
+	if rec.Code != http.StatusAccepted || rec.Body.Len() != 0 {
+		t.Fatalf("notification: status = %d, body = %q", rec.Code, rec.Body)
+	}
+	if resolver.userID != "" {
+		t.Fatal("notifications should not resolve scope")
+	}
+
+	rec = post(handler, "/campaigns/camp-1/mcp", `not json`, bearer)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), `"code":-32700`) {
+		t.Fatalf("parse error: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	rec = post(handler, "/campaigns/camp-1/mcp", pingRequest, map[string]string{"Authorization": "Bearer tok-1", protocolVersionHeader: "1999-01-01"})
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("unsupported version: status = %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/campaigns/camp-1/mcp", nil)
+	getRec := httptest.NewRecorder()
+	handler.ServeHTTP(getRec, req)
+	if getRec.Code != http.StatusMethodNotAllowed || getRec.Header().Get("Allow") != http.MethodPost {
+		t.Fatalf("GET: status = %d, allow = %q", getRec.Code, getRec.Header().Get("Allow"))
+	}
+
+	health := httptest.NewRecorder()
+	handler.ServeHTTP(health, httptest.NewRequest(http.MethodGet, healthPath, nil))
+	if health.Code != http.StatusOK {
+		t.Fatalf("health status = %d", health.Code)
+	}
+}
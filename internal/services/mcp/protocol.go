@@ -0,0 +1,145 @@
+package mcp
+
+import "encoding/json"
+
+// latestProtocolVersion is the newest MCP revision the server speaks. It is
+// returned when a client asks for a revision the server does not know.
+const latestProtocolVersion = "2025-06-18"
+
+// supportedProtocolVersions lists MCP revisions whose tool and resource
+// surface is identical for this server.
+var supportedProtocolVersions = map[string]struct{}{
+	"2025-06-18": {},
+	"2025-03-26": {},
+	"2024-11-05": {},
+}
+
+// JSON-RPC 2.0 error codes used by MCP.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+const jsonRPCVersion = "2.0"
+
+// rpcMessage is one inbound JSON-RPC request or notification. Notifications
+// carry no id.
+type rpcMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+func (m rpcMessage) isNotification() bool {
+	return len(m.ID) == 0
+}
+
+// rpcResponse is one outbound JSON-RPC response.
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+func errorResponse(id json.RawMessage, code int, message string) rpcResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return rpcResponse{JSONRPC: jsonRPCVersion, ID: id, Error: &rpcError{Code: code, Message: message}}
+}
+
+type initializeParams struct {
+	ProtocolVersion string `json:"protocolVersion"`
+}
+
+type initializeResult struct {
+	ProtocolVersion string             `json:"protocolVersion"`
+	Capabilities    serverCapabilities `json:"capabilities"`
+	ServerInfo      implementation     `json:"serverInfo"`
+	Instructions    string             `json:"instructions,omitempty"`
+}
+
+type serverCapabilities struct {
+	Tools     *struct{} `json:"tools,omitempty"`
+	Resources *struct{} `json:"resources,omitempty"`
+}
+
+type implementation struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type toolDescriptor struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	InputSchema any    `json:"inputSchema"`
+}
+
+type listToolsResult struct {
+	Tools []toolDescriptor `json:"tools"`
+}
+
+type callToolParams struct {
+	Name      string         `json:"name"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+}
+
+type contentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type callToolResult struct {
+	Content []contentBlock `json:"content"`
+	IsError bool           `json:"isError,omitempty"`
+}
+
+type resourceDescriptor struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MIMEType    string `json:"mimeType,omitempty"`
+}
+
+type listResourcesResult struct {
+	Resources []resourceDescriptor `json:"resources"`
+}
+
+type resourceTemplateDescriptor struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MIMEType    string `json:"mimeType,omitempty"`
+}
+
+type listResourceTemplatesResult struct {
+	ResourceTemplates []resourceTemplateDescriptor `json:"resourceTemplates"`
+}
+
+type readResourceParams struct {
+	URI string `json:"uri"`
+}
+
+type resourceContents struct {
+	URI      string `json:"uri"`
+	MIMEType string `json:"mimeType,omitempty"`
+	Text     string `json:"text"`
+}
+
+type readResourceResult struct {
+	Contents []resourceContents `json:"contents"`
+}
@@ -0,0 +1,111 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/gametools"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+)
+
+// Scope is the campaign authority one MCP client acts under. It is resolved
+// from the authenticated user, never from client-supplied headers.
+type Scope struct {
+	UserID        string
+	CampaignID    string
+	SessionID     string
+	ParticipantID string
+	Role          gamev1.ParticipantRole
+}
+
+// errNoParticipant reports that the caller is authenticated but does not
+// participate in the requested campaign.
+var errNoParticipant = errors.New("caller is not a participant in this campaign")
+
+// ScopeResolver turns an authenticated user and a campaign into a Scope.
+type ScopeResolver interface {
+	ResolveScope(ctx context.Context, userID, campaignID string) (Scope, error)
+}
+
+// interactionScopeResolver resolves scope through game's interaction state.
+//
+// The read is sent with only the user identity, so game picks the viewer by
+// matching the user against campaign participants. The participant id it
+// returns is then safe to forward on later tool calls, where game trusts the
+// participant header as-is.
+type interactionScopeResolver struct {
+	interaction gamev1.InteractionServiceClient
+}
+
+// NewScopeResolver builds the production resolver over game's interaction
+// service.
+func NewScopeResolver(interaction gamev1.InteractionServiceClient) ScopeResolver {
+	return interactionScopeResolver{interaction: interaction}
+}
+
+func (r interactionScopeResolver) ResolveScope(ctx context.Context, userID, campaignID string) (Scope, error) {
+	userID = strings.TrimSpace(userID)
+	campaignID = strings.TrimSpace(campaignID)
+	if userID == "" {
+		return Scope{}, errors.New("user id is required")
+	}
+	if campaignID == "" {
+		return Scope{}, errors.New("campaign id is required")
+	}
+	resp, err := r.interaction.GetInteractionState(grpcauthctx.WithUserID(ctx, userID), &gamev1.GetInteractionStateRequest{CampaignId: campaignID})
+	if err != nil {
+		return Scope{}, err
+	}
+	viewer := resp.GetState().GetViewer()
+	if strings.TrimSpace(viewer.GetParticipantId()) == "" {
+		return Scope{}, errNoParticipant
+	}
+	return Scope{
+		UserID:        userID,
+		CampaignID:    campaignID,
+		SessionID:     resp.GetState().GetActiveSession().GetSessionId(),
+		ParticipantID: viewer.GetParticipantId(),
+		Role:          viewer.GetRole(),
+	}, nil
+}
+
+// toolPolicy picks the tool profile for the scoped participant role. GMs get
+// the user-scoped GM profile; everyone else gets the player profile.
+func toolPolicy(role gamev1.ParticipantRole) orchestration.ToolPolicy {
+	if role == gamev1.ParticipantRole_GM {
+		return orchestration.NewStaticToolPolicy(gametools.UserGMToolNames())
+	}
+	return orchestration.NewStaticToolPolicy(gametools.PlayerToolNames())
+}
+
+// checkToolArguments rejects tool calls that name a campaign other than the
+// scoped one. Tools fill an omitted campaign_id from the scope.
+func checkToolArguments(scope Scope, args map[string]any) error {
+	raw, ok := args["campaign_id"]
+	if !ok || raw == nil {
+		return nil
+	}
+	campaignID, ok := raw.(string)
+	if !ok {
+		return errors.New("campaign_id must be a string")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID != "" && campaignID != scope.CampaignID {
+		return fmt.Errorf("campaign_id %q is outside this connection's campaign scope", campaignID)
+	}
+	return nil
+}
+
+// checkResourceURI rejects resource reads for campaigns other than the
+// scoped one.
+func checkResourceURI(scope Scope, uri string) error {
+	campaignID, ok := gametools.ResourceCampaignID(uri)
+	if ok && campaignID != scope.CampaignID {
+		return fmt.Errorf("resource %q is outside this connection's campaign scope", uri)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
+	platformstatus "github.com/louisbranch/fracturing.space/internal/platform/status"
+	"github.com/louisbranch/fracturing.space/internal/platform/timeouts"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/gametools"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+)
+
+// introspectTimeout bounds one token introspection call.
+const introspectTimeout = 5 * time.Second
+
+// newManagedConn wraps platformgrpc.NewManagedConn for testability.
+var newManagedConn = platformgrpc.NewManagedConn
+
+// Config defines the inputs for the MCP server process.
+type Config struct {
+	// HTTPAddr is the streamable HTTP listen address.
+	HTTPAddr string
+	// GameAddr and AIAddr are the gRPC addresses the tool catalog calls.
+	// Artifacts and reference search live in the AI service.
+	GameAddr string
+	AIAddr   string
+	// IntrospectURL and ResourceSecret configure OAuth token introspection
+	// against the auth service.
+	IntrospectURL  string
+	ResourceSecret string
+	// AllowedClientIDs limits accepted tokens to these OAuth clients. Empty
+	// accepts any client whose token carries ToolsScope.
+	AllowedClientIDs []string
+	// AllowedOrigins lists browser origins allowed on the HTTP transport.
+	AllowedOrigins []string
+	// StatusReporter receives health transitions for dependency capabilities.
+	StatusReporter *platformstatus.Reporter
+}
+
+// Server hosts the MCP tool catalog over stdio or streamable HTTP.
+type Server struct {
+	httpAddr     string
+	gameMc       *platformgrpc.ManagedConn
+	aiMc         *platformgrpc.ManagedConn
+	service      *Service
+	introspector authctx.Introspector
+	tokens       authctx.TokenRequirement
+	httpServer   *http.Server
+}
+
+// NewServer dials game and AI and builds the MCP server.
+func NewServer(ctx context.Context, cfg Config) (*Server, error) {
+	gameAddr := strings.TrimSpace(cfg.GameAddr)
+	if gameAddr == "" {
+		return nil, errors.New("game address is required")
+	}
+	aiAddr := strings.TrimSpace(cfg.AIAddr)
+	if aiAddr == "" {
+		return nil, errors.New("ai address is required")
+	}
+	introspectURL := strings.TrimSpace(cfg.IntrospectURL)
+	if introspectURL == "" {
+		return nil, errors.New("auth introspect url is required")
+	}
+
+	gameMc, err := newManagedConn(ctx, platformgrpc.ManagedConnConfig{
+		Name:             "game",
+		Addr:             gameAddr,
+		Mode:             platformgrpc.ModeOptional,
+		StatusReporter:   cfg.StatusReporter,
+		StatusCapability: "mcp.game.integration",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mcp: managed conn game: %w", err)
+	}
+	aiMc, err := newManagedConn(ctx, platformgrpc.ManagedConnConfig{
+		Name:             "ai",
+		Addr:             aiAddr,
+		Mode:             platformgrpc.ModeOptional,
+		StatusReporter:   cfg.StatusReporter,
+		StatusCapability: "mcp.ai.integration",
+	})
+	if err != nil {
+		_ = gameMc.Close()
+		return nil, fmt.Errorf("mcp: managed conn ai: %w", err)
+	}
+
+	// Game is dialed without a service identity: every call carries the
+	// scoped user so game authorizes it like any other participant request.
+	gameConn := gameMc.Conn()
+	aiConn := aiMc.Conn()
+	dialer := gametools.NewDirectDialer(gametools.Clients{
+		Interaction: gamev1.NewInteractionServiceClient(gameConn),
+		CampaignAI:  gamev1.NewCampaignAIOrchestrationServiceClient(gameConn),
+		Scene:       gamev1.NewSceneServiceClient(gameConn),
+		Campaign:    gamev1.NewCampaignServiceClient(gameConn),
+		Participant: gamev1.NewParticipantServiceClient(gameConn),
+		Character:   gamev1.NewCharacterServiceClient(gameConn),
+		Session:     gamev1.NewSessionServiceClient(gameConn),
+		Snapshot:    gamev1.NewSnapshotServiceClient(gameConn),
+		Daggerheart: daggerheartv1.NewDaggerheartServiceClient(gameConn),
+		Artifact:    artifactClient{client: aiv1.NewCampaignArtifactServiceClient(aiConn)},
+		Reference:   referenceClient{client: aiv1.NewSystemReferenceServiceClient(aiConn)},
+	})
+	service := NewService(dialer, NewScopeResolver(gamev1.NewInteractionServiceClient(gameConn)))
+	introspector := authctx.NewHTTPIntrospector(introspectURL, cfg.ResourceSecret, &http.Client{Timeout: introspectTimeout})
+	tokens := authctx.TokenRequirement{Scope: ToolsScope, ClientIDs: cfg.AllowedClientIDs}
+
+	server := &Server{
+		httpAddr:     strings.TrimSpace(cfg.HTTPAddr),
+		gameMc:       gameMc,
+		aiMc:         aiMc,
+		service:      service,
+		introspector: introspector,
+		tokens:       tokens,
+	}
+	if server.httpAddr != "" {
+		server.httpServer = &http.Server{
+			Addr:              server.httpAddr,
+			Handler:           NewHTTPHandler(service, introspector, tokens, cfg.AllowedOrigins),
+			ReadHeaderTimeout: timeouts.ReadHeader,
+		}
+	}
+	return server, nil
+}
+
+// ListenAndServe runs the streamable HTTP transport until the context ends.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	if s == nil {
+		return errors.New("mcp server is nil")
+	}
+	if s.httpServer == nil {
+		return errors.New("http address is required")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	serveErr := make(chan error, 1)
+	log.Printf("mcp listening on %s", s.httpAddr)
+	go func() {
+		serveErr <- s.httpServer.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), timeouts.Shutdown)
+		err := s.httpServer.Shutdown(shutdownCtx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("shutdown http server: %w", err)
+		}
+		return nil
+	case err := <-serveErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("serve http: %w", err)
+	}
+}
+
+// ServeStdio runs the stdio transport for one access token and campaign.
+func (s *Server) ServeStdio(ctx context.Context, token, campaignID string, in io.Reader, out io.Writer) error {
+	if s == nil {
+		return errors.New("mcp server is nil")
+	}
+	if strings.TrimSpace(token) == "" {
+		return errors.New("access token is required for stdio")
+	}
+	if strings.TrimSpace(campaignID) == "" {
+		return errors.New("campaign id is required for stdio")
+	}
+	return s.service.ServeStdio(ctx, s.introspector, s.tokens, strings.TrimSpace(token), strings.TrimSpace(campaignID), in, out)
+}
+
+// Close releases the game and AI connections and the HTTP server.
+func (s *Server) Close() {
+	if s == nil {
+		return
+	}
+	if s.gameMc != nil {
+		if err := s.gameMc.Close(); err != nil {
+			log.Printf("close mcp game managed conn: %v", err)
+		}
+		s.gameMc = nil
+	}
+	if s.aiMc != nil {
+		if err := s.aiMc.Close(); err != nil {
+			log.Printf("close mcp ai managed conn: %v", err)
+		}
+		s.aiMc = nil
+	}
+	if s.httpServer != nil {
+		_ = s.httpServer.Close()
+	}
+}
@@ -0,0 +1,62 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+)
+
+// ServeStdio runs the stdio transport: newline-delimited JSON-RPC messages on
+// in, responses on out, until in reaches EOF or ctx ends.
+//
+// Unlike HTTP, a stdio process is bound to one token and campaign for its
+// whole life, so authentication and scope resolution happen once up front.
+// The token comes from the launching environment, which makes stdio suitable
+// for assistants the token owner runs themselves.
+func (s *Service) ServeStdio(ctx context.Context, introspector authctx.Introspector, requirement authctx.TokenRequirement, token, campaignID string, in io.Reader, out io.Writer) error {
+	userID, err := introspectUser(ctx, introspector, requirement, token)
+	if err != nil {
+		return err
+	}
+	c, err := s.open(ctx, userID, campaignID)
+	if err != nil {
+		return fmt.Errorf("resolve campaign scope: %w", err)
+	}
+	defer c.close()
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageBytes)
+	encoder := json.NewEncoder(out)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		msg, failure := decodeMessage(line)
+		if failure != nil {
+			if err := encoder.Encode(failure); err != nil {
+				return fmt.Errorf("write response: %w", err)
+			}
+			continue
+		}
+		resp, ok := c.handle(ctx, msg)
+		if !ok {
+			continue
+		}
+		if err := encoder.Encode(resp); err != nil {
+			return fmt.Errorf("write response: %w", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read stdin: %w", err)
+	}
+	return nil
+}
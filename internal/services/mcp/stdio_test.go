@@ -0,0 +1,72 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/shared/authctx"
+)
+
+func TestServeStdioAnswersRequestsInOrder(t *testing.T) {
+	resolver := &fakeResolver{scope: gmScope()}
+	svc := NewService(&fakeDialer{session: &fakeSession{}}, resolver)
+	in := strings.NewReader(strings.Join([]string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		``,
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+		`garbage`,
+	}, "\n"))
+	var out bytes.Buffer
+
+	if err := svc.ServeStdio(context.Background(), activeUser(), testTokens, "tok-1", "camp-1", in, &out); err != nil {
+		t.Fatalf("ServeStdio: %v", err)
+	}
+	if resolver.userID != "user-1" {
+		t.Fatalf("resolver user = %q", resolver.userID)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("responses = %q, want 3 lines", lines)
+	}
+	var responses []rpcResponse
+	for _, line := range lines {
+		var resp rpcResponse
+		if err := json.Unmarshal([]byte(line), &resp); err != nil {
+			t.Fatalf("decode %q: %v", line, err)
+		}
+		responses = append(responses, resp)
+	}
+	if string(responses[0].ID) != "1" || string(responses[1].ID) != "2" {
+		t.Fatalf("ids = %s, %s", responses[0].ID, responses[1].ID)
+	}
+	if responses[2].Error == nil || responses[2].Error.Code != codeParseError {
+		t.Fatalf("last response = %+v, want parse error", responses[2])
+	}
+}
+
+func TestServeStdioRequiresValidToken(t *testing.T) {
+	svc := NewService(&fakeDialer{session: &fakeSession{}}, &fakeResolver{scope: gmScope()})
+	inactive := &fakeIntrospector{result: authctx.IntrospectionResult{Active: false}}
+	err := svc.ServeStdio(context.Background(), inactive, testTokens, "tok-1", "camp-1", strings.NewReader(""), &bytes.Buffer{})
+	if !errors.Is(err, errInvalidToken) {
+		t.Fatalf("error = %v, want errInvalidToken", err)
+	}
+}
+
+func TestServeStdioRejectsTokenForOtherClient(t *testing.T) {
+	resolver := &fakeResolver{scope: gmScope()}
+	svc := NewService(&fakeDialer{session: &fakeSession{}}, resolver)
+	err := svc.ServeStdio(context.Background(), otherClientUser(), testTokens, "tok-1", "camp-1", strings.NewReader(""), &bytes.Buffer{})
+	if !errors.Is(err, errInvalidToken) {
+		t.Fatalf("error = %v, want errInvalidToken", err)
+	}
+	if resolver.userID != "" {
+		t.Fatalf("resolved campaign scope for another client's token as %q", resolver.userID)
+	}
+}
@@ -19,6 +19,7 @@ type domainService interface {
 	GetNotification(ctx context.Context, input domain.GetNotificationInput) (domain.Notification, error)
 	GetUnreadStatus(ctx context.Context, input domain.GetUnreadStatusInput) (domain.UnreadStatus, error)
 	MarkRead(ctx context.Context, input domain.MarkReadInput) (domain.Notification, error)
+	DeleteInbox(ctx context.Context, input domain.DeleteInboxInput) (int, error)
 }
 
 const sourceSystem = "system"
@@ -3,6 +3,7 @@ package notifications
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -202,6 +203,9 @@ type fakeDomainService struct {
 	unreadStatusResult    domain.UnreadStatus
 	unreadStatusErr       error
 	lastUnreadStatusInput domain.GetUnreadStatusInput
+
+	deleteInboxResult    int
+	lastDeleteInboxInput domain.DeleteInboxInput
 }
 
 func (f *fakeDomainService) CreateIntent(_ context.Context, input domain.CreateIntentInput) (domain.Notification, error) {
@@ -244,6 +248,11 @@ func (f *fakeDomainService) GetUnreadStatus(_ context.Context, input domain.GetU
 	return f.unreadStatusResult, nil
 }
 
+func (f *fakeDomainService) DeleteInbox(_ context.Context, input domain.DeleteInboxInput) (int, error) {
+	f.lastDeleteInboxInput = input
+	return f.deleteInboxResult, nil
+}
+
 var _ domainService = (*fakeDomainService)(nil)
 
 func TestMapDomainError(t *testing.T) {
@@ -263,3 +272,44 @@ func TestMapDomainError(t *testing.T) {
 		t.Fatalf("map internal = %v, want %v", got, codes.Internal)
 	}
 }
+
+func TestExportUserDataIncludesInboxPayloads(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	fake := &fakeDomainService{
+		listResult: domain.NotificationPage{Notifications: []domain.Notification{{
+			ID:          "notif-1",
+			MessageType: "campaign.invite",
+			PayloadJSON: `{"invite_id":"inv-1"}`,
+			CreatedAt:   now,
+		}}},
+	}
+	svc := NewService(fake)
+
+	resp, err := svc.ExportUserData(context.Background(), &notificationsv1.ExportUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("export user data: %v", err)
+	}
+	if got, want := resp.GetRecordsJson(), `"payload":{"invite_id":"inv-1"}`; !strings.Contains(got, want) {
+		t.Fatalf("records = %s, want %s", got, want)
+	}
+	if fake.lastListInput.RecipientUserID != "user-1" {
+		t.Fatalf("list recipient = %q, want %q", fake.lastListInput.RecipientUserID, "user-1")
+	}
+}
+
+func TestDeleteUserDataDelegatesToDomain(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeDomainService{deleteInboxResult: 2}
+	svc := NewService(fake)
+
+	resp, err := svc.DeleteUserData(context.Background(), &notificationsv1.DeleteUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("delete user data: %v", err)
+	}
+	if resp.GetDeletedRecords() != 2 || fake.lastDeleteInboxInput.RecipientUserID != "user-1" {
+		t.Fatalf("deleted = %d input = %+v", resp.GetDeletedRecords(), fake.lastDeleteInboxInput)
+	}
+}
@@ -0,0 +1,91 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	notificationsv1 "github.com/louisbranch/fracturing.space/api/gen/go/notifications/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/notifications/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportPageSize walks the inbox in the largest page the domain allows.
+const exportPageSize = 200
+
+type exportedNotification struct {
+	ID          string          `json:"id"`
+	MessageType string          `json:"message_type"`
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+	ReadAt      *time.Time      `json:"read_at,omitempty"`
+}
+
+// ExportUserData returns every notification addressed to the user as JSON.
+func (s *Service) ExportUserData(ctx context.Context, in *notificationsv1.ExportUserDataRequest) (*notificationsv1.ExportUserDataResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "export user data request is required")
+	}
+	if s == nil || s.domain == nil {
+		return nil, status.Error(codes.Internal, "notifications domain service is not configured")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	records := struct {
+		Notifications []exportedNotification `json:"notifications"`
+	}{Notifications: []exportedNotification{}}
+	pageToken := ""
+	for {
+		page, err := s.domain.ListInbox(ctx, domain.ListInboxInput{
+			RecipientUserID: userID,
+			PageSize:        exportPageSize,
+			PageToken:       pageToken,
+		})
+		if err != nil {
+			return nil, mapDomainError(err)
+		}
+		for _, notification := range page.Notifications {
+			payload := json.RawMessage(notification.PayloadJSON)
+			if !json.Valid(payload) {
+				payload = json.RawMessage("{}")
+			}
+			records.Notifications = append(records.Notifications, exportedNotification{
+				ID:          notification.ID,
+				MessageType: notification.MessageType,
+				Payload:     payload,
+				CreatedAt:   notification.CreatedAt,
+				ReadAt:      notification.ReadAt,
+			})
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	encoded, err := json.Marshal(records)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "export user data: %v", err)
+	}
+	return &notificationsv1.ExportUserDataResponse{RecordsJson: string(encoded)}, nil
+}
+
+// DeleteUserData erases the user's inbox and delivery state.
+func (s *Service) DeleteUserData(ctx context.Context, in *notificationsv1.DeleteUserDataRequest) (*notificationsv1.DeleteUserDataResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "delete user data request is required")
+	}
+	if s == nil || s.domain == nil {
+		return nil, status.Error(codes.Internal, "notifications domain service is not configured")
+	}
+	deleted, err := s.domain.DeleteInbox(ctx, domain.DeleteInboxInput{RecipientUserID: in.GetUserId()})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return &notificationsv1.DeleteUserDataResponse{DeletedRecords: int32(deleted)}, nil
+}
@@ -155,6 +155,17 @@ func (a *domainStoreAdapter) MarkNotificationRead(ctx context.Context, recipient
 	return toDomainNotification(record), nil
 }
 
+func (a *domainStoreAdapter) DeleteNotificationsByRecipient(ctx context.Context, recipientUserID string) (int, error) {
+	if a == nil || a.notificationStore == nil {
+		return 0, domain.ErrStoreNotConfigured
+	}
+	deleted, err := a.notificationStore.DeleteNotificationsByRecipient(ctx, recipientUserID)
+	if err != nil {
+		return 0, mapStorageError(err)
+	}
+	return deleted, nil
+}
+
 func toStorageNotification(notification domain.Notification) storage.NotificationRecord {
 	return storage.NotificationRecord{
 		ID:              notification.ID,
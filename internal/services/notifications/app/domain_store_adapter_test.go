@@ -234,3 +234,14 @@ func (s *atomicCapableStore) PutNotificationWithDeliveries(_ context.Context, no
 	}
 	return nil
 }
+
+func (s *atomicCapableStore) DeleteNotificationsByRecipient(_ context.Context, recipientUserID string) (int, error) {
+	deleted := 0
+	for id, notification := range s.notifications {
+		if notification.RecipientUserID == recipientUserID {
+			delete(s.notifications, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -85,6 +85,11 @@ type MarkReadInput struct {
 	NotificationID  string
 }
 
+// DeleteInboxInput identifies the recipient whose inbox is erased.
+type DeleteInboxInput struct {
+	RecipientUserID string
+}
+
 // GetNotificationInput identifies one recipient notification lookup.
 type GetNotificationInput struct {
 	RecipientUserID string
@@ -99,6 +104,7 @@ type Store interface {
 	ListNotificationsByRecipient(ctx context.Context, recipientUserID string, pageSize int, pageToken string) (NotificationPage, error)
 	CountUnreadNotificationsByRecipient(ctx context.Context, recipientUserID string) (int, error)
 	MarkNotificationRead(ctx context.Context, recipientUserID string, notificationID string, readAt time.Time) (Notification, error)
+	DeleteNotificationsByRecipient(ctx context.Context, recipientUserID string) (int, error)
 }
 
 // Service orchestrates recipient inbox lifecycle behavior.
@@ -254,6 +260,18 @@ func (s *Service) MarkRead(ctx context.Context, input MarkReadInput) (Notificati
 	return s.store.MarkNotificationRead(ctx, recipientUserID, notificationID, s.nowUTC())
 }
 
+// DeleteInbox erases every notification addressed to one recipient.
+func (s *Service) DeleteInbox(ctx context.Context, input DeleteInboxInput) (int, error) {
+	if s == nil || s.store == nil {
+		return 0, ErrStoreNotConfigured
+	}
+	recipientUserID := strings.TrimSpace(input.RecipientUserID)
+	if recipientUserID == "" {
+		return 0, ErrRecipientUserIDRequired
+	}
+	return s.store.DeleteNotificationsByRecipient(ctx, recipientUserID)
+}
+
 func (s *Service) nowUTC() time.Time {
 	if s.clock == nil {
 		return time.Now().UTC()
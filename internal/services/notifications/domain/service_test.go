@@ -557,3 +557,56 @@ func (s *fakeStore) MarkNotificationRead(_ context.Context, recipientUserID stri
 func dedupeKeyIndexKey(recipientUserID string, dedupeKey string) string {
 	return strings.TrimSpace(recipientUserID) + "|" + strings.TrimSpace(dedupeKey)
 }
+
+func (s *fakeStore) DeleteNotificationsByRecipient(_ context.Context, recipientUserID string) (int, error) {
+	deleted := 0
+	for id, notification := range s.notifications {
+		if notification.RecipientUserID != recipientUserID {
+			continue
+		}
+		delete(s.notifications, id)
+		delete(s.dedupeIndex, dedupeKeyIndexKey(recipientUserID, notification.DedupeKey))
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (s *concurrentConflictStore) DeleteNotificationsByRecipient(_ context.Context, recipientUserID string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deleted := 0
+	for id, notification := range s.notifications {
+		if notification.RecipientUserID == recipientUserID {
+			delete(s.notifications, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func TestDeleteInboxRemovesOnlyRecipientNotifications(t *testing.T) {
+	store := newFakeStore()
+	svc := NewService(store, nil, nil)
+	for _, recipient := range []string{"user-1", "user-1", "user-2"} {
+		if _, err := svc.CreateIntent(context.Background(), CreateIntentInput{
+			RecipientUserID: recipient,
+			MessageType:     "auth.onboarding.welcome",
+		}); err != nil {
+			t.Fatalf("create intent: %v", err)
+		}
+	}
+
+	deleted, err := svc.DeleteInbox(context.Background(), DeleteInboxInput{RecipientUserID: "user-1"})
+	if err != nil {
+		t.Fatalf("delete inbox: %v", err)
+	}
+	if deleted != 2 {
+		t.Fatalf("deleted = %d, want 2", deleted)
+	}
+	if len(store.notifications) != 1 {
+		t.Fatalf("remaining notifications = %d, want 1", len(store.notifications))
+	}
+	if _, err := svc.DeleteInbox(context.Background(), DeleteInboxInput{}); !errors.Is(err, ErrRecipientUserIDRequired) {
+		t.Fatalf("missing recipient err = %v, want %v", err, ErrRecipientUserIDRequired)
+	}
+}
@@ -196,6 +196,46 @@ WHERE n.recipient_user_id = ?
 	return unreadCount, nil
 }
 
+// DeleteNotificationsByRecipient removes a recipient's notifications and their
+// channel deliveries.
+func (s *Store) DeleteNotificationsByRecipient(ctx context.Context, recipientUserID string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return 0, fmt.Errorf("storage is not configured")
+	}
+	recipientUserID = strings.TrimSpace(recipientUserID)
+	if recipientUserID == "" {
+		return 0, fmt.Errorf("recipient user id is required")
+	}
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin delete notifications: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+DELETE FROM notification_deliveries
+WHERE notification_id IN (SELECT id FROM notifications WHERE recipient_user_id = ?)
+`, recipientUserID); err != nil {
+		return 0, fmt.Errorf("delete notification deliveries: %w", err)
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM notifications WHERE recipient_user_id = ?`, recipientUserID)
+	if err != nil {
+		return 0, fmt.Errorf("delete notifications: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("delete notifications rows: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit delete notifications: %w", err)
+	}
+	return int(deleted), nil
+}
+
 // MarkNotificationRead marks one notification row as read for a recipient.
 func (s *Store) MarkNotificationRead(ctx context.Context, recipientUserID string, notificationID string, readAt time.Time) (storage.NotificationRecord, error) {
 	if err := ctx.Err(); err != nil {
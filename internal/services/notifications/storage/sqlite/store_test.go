@@ -437,6 +437,44 @@ func TestPutNotificationWithDeliveries_RollsBackOnDeliveryConflict(t *testing.T)
 	}
 }
 
+func TestDeleteNotificationsByRecipientRemovesDeliveries(t *testing.T) {
+	t.Parallel()
+
+	store := openTempStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	for _, record := range []storage.NotificationRecord{
+		{ID: "notif-1", RecipientUserID: "user-1", MessageType: "campaign.invite", PayloadJSON: "{}", CreatedAt: now, UpdatedAt: now},
+		{ID: "notif-2", RecipientUserID: "user-2", MessageType: "campaign.invite", PayloadJSON: "{}", CreatedAt: now, UpdatedAt: now},
+	} {
+		if err := store.PutNotificationWithDeliveries(ctx, record, []storage.DeliveryRecord{{
+			NotificationID: record.ID,
+			Channel:        storage.DeliveryChannelEmail,
+			Status:         storage.DeliveryStatusPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}}); err != nil {
+			t.Fatalf("put notification %s: %v", record.ID, err)
+		}
+	}
+
+	deleted, err := store.DeleteNotificationsByRecipient(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("delete notifications: %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("deleted = %d, want 1", deleted)
+	}
+	pending, err := store.ListPendingDeliveries(ctx, storage.DeliveryChannelEmail, 10, now)
+	if err != nil {
+		t.Fatalf("list pending deliveries: %v", err)
+	}
+	if len(pending) != 1 || pending[0].NotificationID != "notif-2" {
+		t.Fatalf("pending deliveries = %+v, want only notif-2", pending)
+	}
+}
+
 func TestDeliveryQueueRetryAndSuccess(t *testing.T) {
 	t.Parallel()
 
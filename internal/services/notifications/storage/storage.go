@@ -77,6 +77,9 @@ type NotificationStore interface {
 	ListNotificationsByRecipient(ctx context.Context, recipientUserID string, pageSize int, pageToken string) (NotificationPage, error)
 	CountUnreadNotificationsByRecipient(ctx context.Context, recipientUserID string) (int, error)
 	MarkNotificationRead(ctx context.Context, recipientUserID string, notificationID string, readAt time.Time) (NotificationRecord, error)
+	// DeleteNotificationsByRecipient removes a recipient's inbox and delivery
+	// state, returning the number of notifications removed.
+	DeleteNotificationsByRecipient(ctx context.Context, recipientUserID string) (int, error)
 }
 
 // DeliveryStore persists channel delivery attempt state.
@@ -0,0 +1,84 @@
+package transcript
+
+import (
+	"context"
+
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	playtranscript "github.com/louisbranch/fracturing.space/internal/services/play/transcript"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportParticipantMessages returns every message authored from the given
+// seats for an account export archive.
+func (s *Service) ExportParticipantMessages(ctx context.Context, in *playv1.ExportParticipantMessagesRequest) (*playv1.ExportParticipantMessagesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "export participant messages request is required")
+	}
+	store, seats, err := s.participantSeats(ctx, in.GetSeats())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &playv1.ExportParticipantMessagesResponse{Messages: []*playv1.ParticipantMessage{}}
+	for _, seat := range seats {
+		messages, err := store.ListParticipantMessages(ctx, seat)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "export participant messages: %v", err)
+		}
+		for _, message := range messages {
+			resp.Messages = append(resp.Messages, &playv1.ParticipantMessage{
+				CampaignId: message.CampaignID,
+				SessionId:  message.SessionID,
+				Message:    messageToProto(message),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// RedactParticipantMessages blanks the author name and body of every message
+// from the given seats during account deletion. Repeated calls succeed.
+func (s *Service) RedactParticipantMessages(ctx context.Context, in *playv1.RedactParticipantMessagesRequest) (*playv1.RedactParticipantMessagesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "redact participant messages request is required")
+	}
+	store, seats, err := s.participantSeats(ctx, in.GetSeats())
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, seat := range seats {
+		redacted, err := store.RedactParticipantMessages(ctx, seat)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "redact participant messages: %v", err)
+		}
+		total += redacted
+	}
+	return &playv1.RedactParticipantMessagesResponse{RedactedMessages: int32(total)}, nil
+}
+
+// participantSeats authorizes the caller, resolves the seat-capable store, and
+// validates the requested seats.
+func (s *Service) participantSeats(ctx context.Context, in []*playv1.ParticipantSeat) (playtranscript.ParticipantMessageStore, []playtranscript.Seat, error) {
+	if err := s.requireInternalCaller(ctx); err != nil {
+		return nil, nil, err
+	}
+	store, ok := s.store.(playtranscript.ParticipantMessageStore)
+	if !ok || store == nil {
+		return nil, nil, status.Error(codes.Internal, "transcript store does not support participant messages")
+	}
+	seats := make([]playtranscript.Seat, 0, len(in))
+	for _, item := range in {
+		seat := playtranscript.Seat{CampaignID: item.GetCampaignId(), ParticipantID: item.GetParticipantId()}.Normalize()
+		if seat.CampaignID == "" {
+			return nil, nil, status.Error(codes.InvalidArgument, "seat campaign id is required")
+		}
+		if seat.ParticipantID == "" {
+			return nil, nil, status.Error(codes.InvalidArgument, "seat participant id is required")
+		}
+		seats = append(seats, seat)
+	}
+	return store, seats, nil
+}
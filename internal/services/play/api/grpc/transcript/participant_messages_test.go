@@ -0,0 +1,102 @@
+package transcript
+
+import (
+	"context"
+	"testing"
+
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	playtranscript "github.com/louisbranch/fracturing.space/internal/services/play/transcript"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeParticipantMessageStore struct {
+	fakeTranscriptStore
+	redactedSeats []playtranscript.Seat
+}
+
+func (f *fakeParticipantMessageStore) ListParticipantMessages(_ context.Context, seat playtranscript.Seat) ([]playtranscript.Message, error) {
+	out := []playtranscript.Message{}
+	for _, message := range f.messages {
+		if message.CampaignID == seat.CampaignID && message.Actor.ParticipantID == seat.ParticipantID {
+			out = append(out, message)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeParticipantMessageStore) RedactParticipantMessages(_ context.Context, seat playtranscript.Seat) (int, error) {
+	f.redactedSeats = append(f.redactedSeats, seat)
+	return 2, nil
+}
+
+func TestExportParticipantMessagesRequiresAllowlistedService(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(&fakeParticipantMessageStore{}, map[string]struct{}{"worker": {}})
+	_, err := svc.ExportParticipantMessages(context.Background(), &playv1.ExportParticipantMessagesRequest{})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
+
+func TestExportParticipantMessagesFiltersBySeat(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeParticipantMessageStore{fakeTranscriptStore: fakeTranscriptStore{messages: []playtranscript.Message{
+		{MessageID: "m1", CampaignID: "c1", SessionID: "s1", Actor: playtranscript.MessageActor{ParticipantID: "p1"}, Body: "mine"},
+		{MessageID: "m2", CampaignID: "c1", SessionID: "s1", Actor: playtranscript.MessageActor{ParticipantID: "p2"}, Body: "theirs"},
+	}}}
+	svc := NewService(store, map[string]struct{}{"worker": {}})
+
+	resp, err := svc.ExportParticipantMessages(workerContext(), &playv1.ExportParticipantMessagesRequest{
+		Seats: []*playv1.ParticipantSeat{{CampaignId: "c1", ParticipantId: " p1 "}},
+	})
+	if err != nil {
+		t.Fatalf("ExportParticipantMessages() error = %v", err)
+	}
+	if len(resp.GetMessages()) != 1 || resp.GetMessages()[0].GetMessage().GetBody() != "mine" {
+		t.Fatalf("messages = %v, want only p1", resp.GetMessages())
+	}
+	if resp.GetMessages()[0].GetSessionId() != "s1" {
+		t.Fatalf("session id = %q, want s1", resp.GetMessages()[0].GetSessionId())
+	}
+}
+
+func TestRedactParticipantMessagesValidatesSeats(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(&fakeParticipantMessageStore{}, map[string]struct{}{"worker": {}})
+	_, err := svc.RedactParticipantMessages(workerContext(), &playv1.RedactParticipantMessagesRequest{
+		Seats: []*playv1.ParticipantSeat{{CampaignId: "c1"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestRedactParticipantMessagesSumsSeats(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeParticipantMessageStore{}
+	svc := NewService(store, map[string]struct{}{"worker": {}})
+	resp, err := svc.RedactParticipantMessages(workerContext(), &playv1.RedactParticipantMessagesRequest{
+		Seats: []*playv1.ParticipantSeat{{CampaignId: "c1", ParticipantId: "p1"}, {CampaignId: "c2", ParticipantId: "p9"}},
+	})
+	if err != nil {
+		t.Fatalf("RedactParticipantMessages() error = %v", err)
+	}
+	if resp.GetRedactedMessages() != 4 || len(store.redactedSeats) != 2 {
+		t.Fatalf("redacted = %d seats = %v", resp.GetRedactedMessages(), store.redactedSeats)
+	}
+}
+
+func TestParticipantMessagesRequireSeatCapableStore(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(&fakeTranscriptStore{}, map[string]struct{}{"worker": {}})
+	_, err := svc.RedactParticipantMessages(workerContext(), &playv1.RedactParticipantMessagesRequest{})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.Internal)
+	}
+}
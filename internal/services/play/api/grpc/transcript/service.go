@@ -0,0 +1,114 @@
+// Package transcript exposes play-owned chat transcripts to internal services
+// over gRPC.
+//
+// Browsers read transcripts through the play HTTP surface. This package serves
+// the narrower internal read used by background jobs, so every call must carry
+// an allowlisted service identity.
+package transcript
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	playtranscript "github.com/louisbranch/fracturing.space/internal/services/play/transcript"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Service implements playv1.TranscriptServiceServer over the transcript store.
+type Service struct {
+	playv1.UnimplementedTranscriptServiceServer
+
+	store   playtranscript.Store
+	allowed map[string]struct{}
+}
+
+// NewService builds the internal transcript reader. allowed holds lowercase
+// service IDs permitted to read transcripts.
+func NewService(store playtranscript.Store, allowed map[string]struct{}) *Service {
+	return &Service{store: store, allowed: allowed}
+}
+
+// ListSessionTranscript returns one page of human chat messages for a session
+// in send order.
+func (s *Service) ListSessionTranscript(ctx context.Context, in *playv1.ListSessionTranscriptRequest) (*playv1.ListSessionTranscriptResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list session transcript request is required")
+	}
+	if err := s.requireInternalCaller(ctx); err != nil {
+		return nil, err
+	}
+	if s.store == nil {
+		return nil, status.Error(codes.Internal, "transcript store is not configured")
+	}
+	scope := playtranscript.Scope{CampaignID: in.GetCampaignId(), SessionID: in.GetSessionId()}.Normalize()
+	if scope.CampaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	if scope.SessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	messages, err := s.store.HistoryAfter(ctx, playtranscript.HistoryAfterQuery{
+		Scope:           scope,
+		AfterSequenceID: in.GetAfterSequenceId(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list session transcript: %v", err)
+	}
+
+	limit := pageSize(in.GetPageSize())
+	hasMore := len(messages) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+	resp := &playv1.ListSessionTranscriptResponse{
+		Messages: make([]*playv1.TranscriptMessage, 0, len(messages)),
+		HasMore:  hasMore,
+	}
+	for _, message := range messages {
+		resp.Messages = append(resp.Messages, messageToProto(message))
+	}
+	return resp, nil
+}
+
+// requireInternalCaller rejects calls without an allowlisted service identity.
+func (s *Service) requireInternalCaller(ctx context.Context) error {
+	serviceID := strings.ToLower(strings.TrimSpace(grpcmeta.ServiceIDFromContext(ctx)))
+	if serviceID == "" {
+		return status.Error(codes.PermissionDenied, "internal service identity is required")
+	}
+	if _, ok := s.allowed[serviceID]; !ok {
+		return status.Error(codes.PermissionDenied, "internal service identity is not allowed")
+	}
+	return nil
+}
+
+func pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return playtranscript.DefaultHistoryLimit
+	case requested > playtranscript.MaxHistoryLimit:
+		return playtranscript.MaxHistoryLimit
+	default:
+		return int(requested)
+	}
+}
+
+func messageToProto(message playtranscript.Message) *playv1.TranscriptMessage {
+	out := &playv1.TranscriptMessage{
+		MessageId:       message.MessageID,
+		SequenceId:      message.SequenceID,
+		ParticipantId:   message.Actor.ParticipantID,
+		ParticipantName: message.Actor.Name,
+		Body:            message.Body,
+	}
+	if sentAt, err := time.Parse(time.RFC3339Nano, message.SentAt); err == nil {
+		out.SentAt = timestamppb.New(sentAt)
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+package transcript
+
+import (
+	"context"
+	"testing"
+
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
+	playtranscript "github.com/louisbranch/fracturing.space/internal/services/play/transcript"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeTranscriptStore struct {
+	playtranscript.Store
+	messages  []playtranscript.Message
+	lastQuery playtranscript.HistoryAfterQuery
+}
+
+func (f *fakeTranscriptStore) HistoryAfter(_ context.Context, query playtranscript.HistoryAfterQuery) ([]playtranscript.Message, error) {
+	f.lastQuery = query
+	return f.messages, nil
+}
+
+func workerContext() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcmeta.ServiceIDHeader, "worker"))
+}
+
+func TestListSessionTranscriptRequiresAllowlistedService(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(&fakeTranscriptStore{}, map[string]struct{}{"worker": {}})
+	req := &playv1.ListSessionTranscriptRequest{CampaignId: "c1", SessionId: "s1"}
+
+	_, err := svc.ListSessionTranscript(context.Background(), req)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("missing identity code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+	other := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcmeta.ServiceIDHeader, "web"))
+	_, err = svc.ListSessionTranscript(other, req)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("unlisted identity code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
+
+func TestListSessionTranscriptValidatesScope(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(&fakeTranscriptStore{}, map[string]struct{}{"worker": {}})
+	_, err := svc.ListSessionTranscript(workerContext(), &playv1.ListSessionTranscriptRequest{CampaignId: "c1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestListSessionTranscriptPagesMessages(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTranscriptStore{messages: []playtranscript.Message{
+		{MessageID: "m1", SequenceID: 4, SentAt: "2026-01-02T03:04:05Z", Actor: playtranscript.MessageActor{ParticipantID: "p1", Name: "Ada"}, Body: "hello"},
+		{MessageID: "m2", SequenceID: 5, Body: "again"},
+		{MessageID: "m3", SequenceID: 6, Body: "bye"},
+	}}
+	svc := NewService(store, map[string]struct{}{"worker": {}})
+
+	resp, err := svc.ListSessionTranscript(workerContext(), &playv1.ListSessionTranscriptRequest{
+		CampaignId:      " c1 ",
+		SessionId:       "s1",
+		AfterSequenceId: 3,
+		PageSize:        2,
+	})
+	if err != nil {
+		t.Fatalf("ListSessionTranscript() error = %v", err)
+	}
+	if store.lastQuery.Scope.CampaignID != "c1" || store.lastQuery.AfterSequenceID != 3 {
+		t.Fatalf("query = %#v", store.lastQuery)
+	}
+	if len(resp.GetMessages()) != 2 || !resp.GetHasMore() {
+		t.Fatalf("messages = %d has_more = %v, want 2 and true", len(resp.GetMessages()), resp.GetHasMore())
+	}
+	first := resp.GetMessages()[0]
+	if first.GetParticipantName() != "Ada" || first.GetSentAt().AsTime().Year() != 2026 {
+		t.Fatalf("first message = %#v", first)
+	}
+}
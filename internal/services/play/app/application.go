@@ -77,6 +77,7 @@ func (a playApplication) bootstrap(ctx context.Context, req playRequest) (playpr
 		Participants:               participants,
 		CharacterInspectionCatalog: catalog,
 		Chat:                       chat,
+		LatestGameSeq:              state.GetHeadSeq(),
 		Realtime: playprotocol.RealtimeConfig{
 			URL:             "/realtime",
 			ProtocolVersion: playprotocol.RealtimeProtocolVersion,
@@ -161,6 +162,9 @@ func (a playApplication) roomSnapshotFromState(ctx context.Context, req playRequ
 	if campaignID == "" {
 		campaignID = strings.TrimSpace(state.GetCampaignId())
 	}
+	// The interaction read reports the journal head it was built from; prefer
+	// it when the realtime stream has not caught up so expected_seq stays fresh.
+	latestGameSeq = max(latestGameSeq, state.GetHeadSeq())
 	chat, err := a.chatCursor(ctx, campaignID, state)
 	if err != nil {
 		return playprotocol.RoomSnapshot{}, err
@@ -13,6 +13,7 @@ import (
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
 	"github.com/louisbranch/fracturing.space/internal/services/play/transcript"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
 	gogrpc "google.golang.org/grpc"
@@ -119,10 +120,11 @@ func (f stubInteractionClient) RetryAIGMTurn(context.Context, *gamev1.RetryAIGMT
 // ---------------------------------------------------------------------------
 
 type recordingInteractionClient struct {
-	state          *gamev1.InteractionState
-	mutationErr    error
-	lastMethod     string
-	lastCampaignID string
+	state           *gamev1.InteractionState
+	mutationErr     error
+	lastMethod      string
+	lastCampaignID  string
+	lastExpectedSeq string
 }
 
 func newRecordingInteractionClient(state *gamev1.InteractionState) *recordingInteractionClient {
@@ -138,8 +140,13 @@ func (f *recordingInteractionClient) GetInteractionState(context.Context, *gamev
 	return &gamev1.GetInteractionStateResponse{State: f.state}, nil
 }
 
-func (f *recordingInteractionClient) ActivateScene(_ context.Context, req *gamev1.ActivateSceneRequest, _ ...gogrpc.CallOption) (*gamev1.ActivateSceneResponse, error) {
+func (f *recordingInteractionClient) ActivateScene(ctx context.Context, req *gamev1.ActivateSceneRequest, _ ...gogrpc.CallOption) (*gamev1.ActivateSceneResponse, error) {
 	f.record("ActivateScene", req.GetCampaignId())
+	if md, ok := gogrpcmetadata.FromOutgoingContext(ctx); ok {
+		if values := md.Get(grpcmeta.ExpectedSeqHeader); len(values) > 0 {
+			f.lastExpectedSeq = values[0]
+		}
+	}
 	return &gamev1.ActivateSceneResponse{State: f.state}, f.mutationErr
 }
 
@@ -4,13 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
 	gogrpc "google.golang.org/grpc"
 	gogrpcstatus "google.golang.org/grpc/status"
 )
 
+// expectedSeqHeader carries the latest game sequence the browser observed so
+// mutations fail instead of silently overwriting concurrent changes.
+const expectedSeqHeader = "X-Expected-Seq"
+
 type interactionStateResponse interface {
 	GetState() *gamev1.InteractionState
 }
@@ -71,6 +77,14 @@ func (s *Server) handleInteractionMutation(
 	if !ok {
 		return
 	}
+	expectedSeq, ok := parseExpectedSeqHeader(r)
+	if !ok {
+		writeJSONError(w, http.StatusBadRequest, "invalid expected sequence")
+		return
+	}
+	if s.ignoreExpectedSeq {
+		expectedSeq = 0
+	}
 	if target != nil {
 		if err := decodeStrictJSON(r, target); err != nil {
 			writeJSONError(w, http.StatusBadRequest, "invalid json body")
@@ -85,7 +99,7 @@ func (s *Server) handleInteractionMutation(
 			)
 		}
 	}
-	state, err := call(req.authContext(r.Context()), req.CampaignID)
+	state, err := call(grpcauthctx.WithExpectedSeq(req.authContext(r.Context()), expectedSeq), req.CampaignID)
 	if err != nil {
 		status := gogrpcstatus.Convert(err)
 		loggerOrDefault(s.logger).Warn("play interaction mutation failed",
@@ -106,3 +120,18 @@ func (s *Server) handleInteractionMutation(
 	writeJSON(w, http.StatusOK, response)
 	s.realtime.broadcastCurrent(req.CampaignID)
 }
+
+// parseExpectedSeqHeader reads the optional optimistic concurrency header.
+// Absent means unconditional; anything other than a positive integer is
+// rejected.
+func parseExpectedSeqHeader(r *http.Request) (uint64, bool) {
+	raw := strings.TrimSpace(r.Header.Get(expectedSeqHeader))
+	if raw == "" {
+		return 0, true
+	}
+	seq, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil || seq == 0 {
+		return 0, false
+	}
+	return seq, true
+}
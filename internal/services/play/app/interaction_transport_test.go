@@ -140,6 +140,110 @@ func TestInteractionMutationRejectsInvalidJSONAndAuthFailures(t *testing.T) {
 	})
 }
 
+func TestInteractionMutationForwardsExpectedSeqHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("forwards positive sequence and reports head", func(t *testing.T) {
+		t.Parallel()
+
+		state := playTestState()
+		state.HeadSeq = 42
+		interaction := newRecordingInteractionClient(state)
+		server := newAuthedPlayServer(interaction, &scriptTranscriptStore{})
+		handler, err := server.newHandler(testPlayLaunchGrantConfig(t))
+		if err != nil {
+			t.Fatalf("newHandler() error = %v", err)
+		}
+		req := httptest.NewRequest(http.MethodPost, "http://play.example.com/api/campaigns/c1/interaction/activate-scene", strings.NewReader(`{}`))
+		req.AddCookie(&http.Cookie{Name: playSessionCookieName, Value: "ps-1"})
+		req.Header.Set(expectedSeqHeader, "41")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d body=%s", rr.Code, http.StatusOK, rr.Body.String())
+		}
+		if interaction.lastExpectedSeq != "41" {
+			t.Fatalf("expected seq metadata = %q, want %q", interaction.lastExpectedSeq, "41")
+		}
+		var payload playprotocol.RoomSnapshot
+		if err := json.Unmarshal(rr.Body.Bytes(), &payload); err != nil {
+			t.Fatalf("decode interaction response: %v", err)
+		}
+		if payload.LatestGameSeq != 42 {
+			t.Fatalf("latest_game_sequence = %d, want %d", payload.LatestGameSeq, 42)
+		}
+	})
+
+	t.Run("drops sequence when configured to ignore it", func(t *testing.T) {
+		t.Parallel()
+
+		interaction := newRecordingInteractionClient(playTestState())
+		server := newAuthedPlayServer(interaction, &scriptTranscriptStore{})
+		server.ignoreExpectedSeq = true
+		handler, err := server.newHandler(testPlayLaunchGrantConfig(t))
+		if err != nil {
+			t.Fatalf("newHandler() error = %v", err)
+		}
+		req := httptest.NewRequest(http.MethodPost, "http://play.example.com/api/campaigns/c1/interaction/activate-scene", strings.NewReader(`{}`))
+		req.AddCookie(&http.Cookie{Name: playSessionCookieName, Value: "ps-1"})
+		req.Header.Set(expectedSeqHeader, "41")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d body=%s", rr.Code, http.StatusOK, rr.Body.String())
+		}
+		if interaction.lastExpectedSeq != "" {
+			t.Fatalf("expected seq metadata = %q, want none", interaction.lastExpectedSeq)
+		}
+	})
+
+	t.Run("rejects malformed sequence", func(t *testing.T) {
+		t.Parallel()
+
+		interaction := newRecordingInteractionClient(playTestState())
+		server := newAuthedPlayServer(interaction, &scriptTranscriptStore{})
+		handler, err := server.newHandler(testPlayLaunchGrantConfig(t))
+		if err != nil {
+			t.Fatalf("newHandler() error = %v", err)
+		}
+		req := httptest.NewRequest(http.MethodPost, "http://play.example.com/api/campaigns/c1/interaction/activate-scene", strings.NewReader(`{}`))
+		req.AddCookie(&http.Cookie{Name: playSessionCookieName, Value: "ps-1"})
+		req.Header.Set(expectedSeqHeader, "0")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assertJSONError(t, rr, http.StatusBadRequest, "invalid expected sequence")
+		if interaction.lastMethod != "" {
+			t.Fatalf("method = %q, want no upstream call", interaction.lastMethod)
+		}
+	})
+
+	t.Run("stale sequence maps to conflict", func(t *testing.T) {
+		t.Parallel()
+
+		interaction := newRecordingInteractionClient(playTestState())
+		interaction.mutationErr = gogrpcstatus.Error(gogrpccodes.FailedPrecondition, "stale")
+		server := newAuthedPlayServer(interaction, &scriptTranscriptStore{})
+		handler, err := server.newHandler(testPlayLaunchGrantConfig(t))
+		if err != nil {
+			t.Fatalf("newHandler() error = %v", err)
+		}
+		req := httptest.NewRequest(http.MethodPost, "http://play.example.com/api/campaigns/c1/interaction/activate-scene", strings.NewReader(`{}`))
+		req.AddCookie(&http.Cookie{Name: playSessionCookieName, Value: "ps-1"})
+		req.Header.Set(expectedSeqHeader, "3")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assertJSONError(t, rr, http.StatusConflict, "action not allowed in current state")
+	})
+}
+
 func TestInteractionMutationResponseKeepsParticipantAndCharacterEnrichment(t *testing.T) {
 	t.Parallel()
 
@@ -116,6 +116,7 @@ func (h *realtimeHub) broadcastCurrent(campaignID string) {
 
 func (h *realtimeHub) handleWSConn(conn *websocket.Conn, userID string) {
 	defer func() { _ = conn.Close() }()
+	defer trackWSConnection(conn.Request().Context())()
 
 	// Reject oversized websocket frames at the transport level before the JSON
 	// decoder allocates memory for the full message body.
@@ -152,6 +153,7 @@ func (h *realtimeHub) handleWSConn(conn *websocket.Conn, userID string) {
 			_ = session.peer.writeError(frame.RequestID, WSErrorResourceExhausted, "rate limit exceeded", nil)
 			return
 		}
+		recordWSFrame(conn.Request().Context(), frame.Type)
 		switch frame.Type {
 		case FrameConnect:
 			h.handleConnect(conn.Request().Context(), session, frame)
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+
+	platformotel "github.com/louisbranch/fracturing.space/internal/platform/otel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/louisbranch/fracturing.space/internal/services/play/app"
+
+var (
+	wsConnections = platformotel.Instrument(otel.Meter(meterName).Int64UpDownCounter(
+		"fracturing.play.websocket.connections",
+		metric.WithDescription("Open realtime websocket connections."),
+		metric.WithUnit("{connection}"),
+	))
+	wsFrames = platformotel.Instrument(otel.Meter(meterName).Int64Counter(
+		"fracturing.play.websocket.frames",
+		metric.WithDescription("Inbound realtime websocket frames by type."),
+		metric.WithUnit("{frame}"),
+	))
+)
+
+// trackWSConnection counts one open connection and returns the matching
+// release for connection teardown.
+func trackWSConnection(ctx context.Context) func() {
+	wsConnections.Add(ctx, 1)
+	return func() { wsConnections.Add(ctx, -1) }
+}
+
+// recordWSFrame counts one decoded frame. Unknown frame types collapse into a
+// single bucket so clients cannot inflate the attribute set.
+func recordWSFrame(ctx context.Context, frameType string) {
+	switch frameType {
+	case FrameConnect, FrameChatSend, FrameTyping, FramePing:
+	default:
+		frameType = "unsupported"
+	}
+	wsFrames.Add(ctx, 1, metric.WithAttributes(attribute.String("frame.type", frameType)))
+}
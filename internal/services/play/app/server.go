@@ -38,6 +38,9 @@ type Config struct {
 	RequestSchemePolicy httpx.SchemePolicy
 	LaunchGrant         playlaunchgrant.Config
 	Logger              *slog.Logger
+	// IgnoreExpectedSeq drops browser X-Expected-Seq headers instead of
+	// forwarding them. Game refuses the guard under outbox apply.
+	IgnoreExpectedSeq bool
 }
 
 // Dependencies defines the runtime collaborators required by the play service.
@@ -127,6 +130,7 @@ type Server struct {
 	deps                Dependencies
 	shellAssets         shellAssets
 	realtime            *realtimeHub
+	ignoreExpectedSeq   bool
 }
 
 // NewServer constructs a play service runtime from injected dependencies.
@@ -154,6 +158,7 @@ func NewServer(cfg Config, deps Dependencies) (*Server, error) {
 		requestSchemePolicy: cfg.RequestSchemePolicy,
 		deps:                deps,
 		shellAssets:         shellAssets,
+		ignoreExpectedSeq:   cfg.IgnoreExpectedSeq,
 	}
 	server.realtime = newRealtimeHub(server)
 
@@ -10,6 +10,7 @@ type Bootstrap struct {
 	Participants               []Participant                  `json:"participants"`
 	CharacterInspectionCatalog map[string]CharacterInspection `json:"character_inspection_catalog"`
 	Chat                       ChatSnapshot                   `json:"chat"`
+	LatestGameSeq              uint64                         `json:"latest_game_sequence,omitempty"`
 	Realtime                   RealtimeConfig                 `json:"realtime"`
 	TransitionSFX              *TransitionSFX                 `json:"transition_sfx,omitempty"`
 }
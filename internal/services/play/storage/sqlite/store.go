@@ -269,6 +269,69 @@ func (s *Store) HistoryBefore(ctx context.Context, query transcript.HistoryBefor
 	return values, nil
 }
 
+// ListParticipantMessages returns every message authored from one seat,
+// ordered by session then sequence.
+func (s *Store) ListParticipantMessages(ctx context.Context, seat transcript.Seat) ([]transcript.Message, error) {
+	if s == nil || s.sqlDB == nil {
+		return nil, errors.New("store is required")
+	}
+	seat = seat.Normalize()
+	if seat.CampaignID == "" || seat.ParticipantID == "" {
+		return nil, errors.New("participant seat requires campaign and participant")
+	}
+	return s.queryHistory(
+		ctx,
+		`SELECT campaign_id, session_id, sequence_id, message_id, sent_at_utc, participant_id, participant_name, body, COALESCE(client_message_id, '')
+		 FROM transcript_messages
+		 WHERE campaign_id = ? AND participant_id = ?
+		 ORDER BY session_id ASC, sequence_id ASC`,
+		seat.CampaignID,
+		seat.ParticipantID,
+	)
+}
+
+// RedactParticipantMessages replaces the author name and body of every
+// message from one seat. Rows keep their sequence so transcript cursors stay
+// valid; already-redacted rows are not counted again.
+func (s *Store) RedactParticipantMessages(ctx context.Context, seat transcript.Seat) (int, error) {
+	if s == nil || s.sqlDB == nil {
+		return 0, errors.New("store is required")
+	}
+	seat = seat.Normalize()
+	if seat.CampaignID == "" || seat.ParticipantID == "" {
+		return 0, errors.New("participant seat requires campaign and participant")
+	}
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin redact participant messages: %w", err)
+	}
+	defer tx.Rollback()
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE transcript_messages
+		 SET participant_name = ?, body = ?
+		 WHERE campaign_id = ? AND participant_id = ?
+		   AND (participant_name <> ? OR body <> ?)`,
+		transcript.RedactedActorName,
+		transcript.RedactedBody,
+		seat.CampaignID,
+		seat.ParticipantID,
+		transcript.RedactedActorName,
+		transcript.RedactedBody,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("redact participant messages: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("redact participant messages rows: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit redact participant messages: %w", err)
+	}
+	return int(affected), nil
+}
+
 func lookupByClientMessageID(ctx context.Context, tx *sql.Tx, scope transcript.Scope, clientMessageID string) (transcript.Message, bool, error) {
 	row := tx.QueryRowContext(
 		ctx,
@@ -224,6 +224,73 @@ func TestStoreBuildMessageUsesFallbackActorFields(t *testing.T) {
 	}
 }
 
+func TestStoreParticipantMessagesExportAndRedact(t *testing.T) {
+	t.Parallel()
+
+	store, err := Open(filepath.Join(t.TempDir(), "play.sqlite"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer store.Close()
+	ctx := context.Background()
+	appends := []transcript.AppendRequest{
+		{Scope: transcript.Scope{CampaignID: "c1", SessionID: "s2"}, Actor: transcript.MessageActor{ParticipantID: "p1", Name: "Ada"}, Body: "later"},
+		{Scope: transcript.Scope{CampaignID: "c1", SessionID: "s1"}, Actor: transcript.MessageActor{ParticipantID: "p1", Name: "Ada"}, Body: "first"},
+		{Scope: transcript.Scope{CampaignID: "c1", SessionID: "s1"}, Actor: transcript.MessageActor{ParticipantID: "p2", Name: "Bo"}, Body: "other"},
+		{Scope: transcript.Scope{CampaignID: "c2", SessionID: "s1"}, Actor: transcript.MessageActor{ParticipantID: "p1", Name: "Ada"}, Body: "elsewhere"},
+	}
+	for _, req := range appends {
+		if _, err := store.AppendMessage(ctx, req); err != nil {
+			t.Fatalf("AppendMessage() error = %v", err)
+		}
+	}
+
+	seat := transcript.Seat{CampaignID: "c1", ParticipantID: "p1"}
+	messages, err := store.ListParticipantMessages(ctx, seat)
+	if err != nil {
+		t.Fatalf("ListParticipantMessages() error = %v", err)
+	}
+	if len(messages) != 2 || messages[0].Body != "first" || messages[1].Body != "later" {
+		t.Fatalf("messages = %#v, want first then later", messages)
+	}
+
+	redacted, err := store.RedactParticipantMessages(ctx, seat)
+	if err != nil {
+		t.Fatalf("RedactParticipantMessages() error = %v", err)
+	}
+	if redacted != 2 {
+		t.Fatalf("redacted = %d, want 2", redacted)
+	}
+	redacted, err = store.RedactParticipantMessages(ctx, seat)
+	if err != nil {
+		t.Fatalf("repeat RedactParticipantMessages() error = %v", err)
+	}
+	if redacted != 0 {
+		t.Fatalf("repeat redacted = %d, want 0", redacted)
+	}
+
+	history, err := store.HistoryAfter(ctx, transcript.HistoryAfterQuery{Scope: transcript.Scope{CampaignID: "c1", SessionID: "s1"}})
+	if err != nil {
+		t.Fatalf("HistoryAfter() error = %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("history = %d messages, want 2", len(history))
+	}
+	if history[0].Actor.Name != transcript.RedactedActorName || history[0].Body != transcript.RedactedBody {
+		t.Fatalf("redacted message = %#v", history[0])
+	}
+	if history[1].Body != "other" {
+		t.Fatalf("other participant message = %#v, want untouched", history[1])
+	}
+	others, err := store.ListParticipantMessages(ctx, transcript.Seat{CampaignID: "c2", ParticipantID: "p1"})
+	if err != nil {
+		t.Fatalf("ListParticipantMessages(c2) error = %v", err)
+	}
+	if len(others) != 1 || others[0].Body != "elsewhere" {
+		t.Fatalf("other campaign messages = %#v, want untouched", others)
+	}
+}
+
 func TestRetryClassifiers(t *testing.T) {
 	t.Parallel()
 
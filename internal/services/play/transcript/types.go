@@ -166,3 +166,32 @@ type Store interface {
 	HistoryAfter(ctx context.Context, query HistoryAfterQuery) ([]Message, error)
 	HistoryBefore(ctx context.Context, query HistoryBeforeQuery) ([]Message, error)
 }
+
+const (
+	// RedactedActorName replaces the author name on messages whose participant
+	// seat belonged to a deleted account.
+	RedactedActorName = "Former participant"
+	// RedactedBody replaces message text removed during account deletion.
+	RedactedBody = "[message removed]"
+)
+
+// Seat identifies one participant seat across every session in a campaign.
+type Seat struct {
+	CampaignID    string
+	ParticipantID string
+}
+
+// Normalize trims transport whitespace from seat identifiers.
+func (s Seat) Normalize() Seat {
+	s.CampaignID = strings.TrimSpace(s.CampaignID)
+	s.ParticipantID = strings.TrimSpace(s.ParticipantID)
+	return s
+}
+
+// ParticipantMessageStore is the optional account-lifecycle extension of
+// Store. It reads and redacts messages by author seat rather than by session
+// scope, so account export and deletion can cover a user's whole history.
+type ParticipantMessageStore interface {
+	ListParticipantMessages(ctx context.Context, seat Seat) ([]Message, error)
+	RedactParticipantMessages(ctx context.Context, seat Seat) (int, error)
+}
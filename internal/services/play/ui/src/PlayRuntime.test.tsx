@@ -37,6 +37,7 @@ vi.mock("./api/mutations", () => ({
   postSessionOOC: vi.fn(),
   markOOCReadyToResume: vi.fn(),
   clearOOCReadyToResume: vi.fn(),
+  setExpectedGameSequence: vi.fn(),
 }));
 
 vi.mock("./api/websocket", async () => {
@@ -111,7 +111,7 @@ function snapshotFromBootstrap(bootstrap: BootstrapResponse): WireRoomSnapshot {
     participants: bootstrap.participants ?? [],
     character_inspection_catalog: bootstrap.character_inspection_catalog ?? {},
     chat: bootstrap.chat,
-    latest_game_sequence: 0,
+    latest_game_sequence: bootstrap.latest_game_sequence ?? 0,
   };
 }
 
@@ -525,6 +525,12 @@ function PlayRuntimeInner({ shellConfig }: { shellConfig: PlayShellConfig }) {
     };
   }, [shellConfig.bootstrapPath]);
 
+  // Optimistic concurrency: mutations carry the head of the rendered snapshot.
+  const latestGameSequence = state.snapshot?.latest_game_sequence ?? 0;
+  useEffect(() => {
+    mutations.setExpectedGameSequence(shellConfig.campaignId, latestGameSequence);
+  }, [shellConfig.campaignId, latestGameSequence]);
+
   // WebSocket — connect after bootstrap
   useEffect(() => {
     if (!state.bootstrap) return;
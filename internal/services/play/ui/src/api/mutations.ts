@@ -16,6 +16,27 @@ export class InteractionRequestError extends Error {
   }
 }
 
+// expectedGameSequence tracks the journal head of the snapshot each campaign
+// view is rendering so mutations fail with 409 instead of acting on stale state.
+const expectedGameSequence = new Map<string, number>();
+
+export function setExpectedGameSequence(campaignId: string, seq: number): void {
+  if (seq > 0) {
+    expectedGameSequence.set(campaignId, seq);
+  } else {
+    expectedGameSequence.delete(campaignId);
+  }
+}
+
+function mutationHeaders(campaignId: string): Record<string, string> {
+  const headers: Record<string, string> = { "Content-Type": "application/json" };
+  const seq = expectedGameSequence.get(campaignId);
+  if (seq) {
+    headers["X-Expected-Seq"] = String(seq);
+  }
+  return headers;
+}
+
 function interactionURL(campaignId: string, action: string): string {
   return `/api/campaigns/${encodeURIComponent(campaignId)}/interaction/${action}`;
 }
@@ -38,7 +59,7 @@ async function postInteraction(
   const resp = await fetch(interactionURL(campaignId, action), {
     method: "POST",
     credentials: "same-origin",
-    headers: { "Content-Type": "application/json" },
+    headers: mutationHeaders(campaignId),
     body: body ? JSON.stringify(body) : undefined,
     signal: AbortSignal.timeout(MUTATION_TIMEOUT_MS),
   });
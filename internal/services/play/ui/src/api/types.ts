@@ -17,6 +17,7 @@ export type BootstrapResponse = {
   participants: WireParticipant[];
   character_inspection_catalog: Record<string, WireCharacterInspection> | null;
   chat: WireChatSnapshot;
+  latest_game_sequence?: number;
   realtime: WireRealtimeConfig;
   transition_sfx?: WireTransitionSFX;
 };
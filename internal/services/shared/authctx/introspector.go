@@ -5,15 +5,48 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 )
 
 // IntrospectionResult mirrors the auth service introspection JSON response.
 type IntrospectionResult struct {
 	Active        bool   `json:"active"`
+	Scope         string `json:"scope"`
+	ClientID      string `json:"client_id"`
 	UserID        string `json:"user_id"`
 	ParticipantID string `json:"participant_id"`
 }
 
+// HasScope reports whether the token's space-delimited scope grants scope.
+func (r IntrospectionResult) HasScope(scope string) bool {
+	scope = strings.TrimSpace(scope)
+	return scope != "" && slices.Contains(strings.Fields(r.Scope), scope)
+}
+
+// TokenRequirement limits which active tokens a resource server accepts, so a
+// token minted for one client or purpose cannot drive another surface.
+type TokenRequirement struct {
+	// Scope must be granted by the token.
+	Scope string
+	// ClientIDs, when non-empty, lists the OAuth clients whose tokens are
+	// accepted.
+	ClientIDs []string
+}
+
+// AllowsClient reports whether the token was issued to an accepted client.
+func (req TokenRequirement) AllowsClient(result IntrospectionResult) bool {
+	if len(req.ClientIDs) == 0 {
+		return true
+	}
+	return slices.Contains(req.ClientIDs, strings.TrimSpace(result.ClientID))
+}
+
+// AllowsScope reports whether the token grants the required scope.
+func (req TokenRequirement) AllowsScope(result IntrospectionResult) bool {
+	return result.HasScope(req.Scope)
+}
+
 // Introspector validates an OAuth access token via introspection.
 type Introspector interface {
 	Introspect(ctx context.Context, token string) (IntrospectionResult, error)
@@ -20,7 +20,7 @@ func TestHTTPIntrospectorActiveToken(t *testing.T) {
 			t.Errorf("X-Resource-Secret = %q, want %q", got, "my-secret")
 		}
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(IntrospectionResult{Active: true, UserID: "user-42"})
+		_, _ = w.Write([]byte(`{"active":true,"user_id":"user-42","client_id":"cli","scope":"openid game:api"}`))
 	}))
 	defer server.Close()
 
@@ -35,6 +35,29 @@ func TestHTTPIntrospectorActiveToken(t *testing.T) {
 	if result.UserID != "user-42" {
 		t.Fatalf("UserID = %q, want %q", result.UserID, "user-42")
 	}
+	if result.ClientID != "cli" || !result.HasScope("game:api") || result.HasScope("game") {
+		t.Fatalf("client/scope = %q/%q", result.ClientID, result.Scope)
+	}
+}
+
+func TestTokenRequirementChecksClientAndScope(t *testing.T) {
+	req := TokenRequirement{Scope: "game:api", ClientIDs: []string{"cli"}}
+	ok := IntrospectionResult{Active: true, ClientID: "cli", Scope: "game:api"}
+	if !req.AllowsClient(ok) || !req.AllowsScope(ok) {
+		t.Fatal("expected matching token to pass")
+	}
+	if req.AllowsClient(IntrospectionResult{ClientID: "other"}) {
+		t.Fatal("expected other client to be rejected")
+	}
+	if req.AllowsScope(IntrospectionResult{Scope: "openid"}) {
+		t.Fatal("expected missing scope to be rejected")
+	}
+	if !(TokenRequirement{Scope: "game:api"}).AllowsClient(IntrospectionResult{ClientID: "any"}) {
+		t.Fatal("expected empty client list to accept any client")
+	}
+	if (TokenRequirement{}).AllowsScope(IntrospectionResult{Scope: "openid"}) {
+		t.Fatal("expected empty required scope to reject")
+	}
 }
 
 func TestHTTPIntrospectorInactiveToken(t *testing.T) {
@@ -2,6 +2,7 @@ package grpcauthctx
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
@@ -83,6 +84,32 @@ func WithServiceID(ctx context.Context, serviceID string) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, grpcmeta.ServiceIDHeader, serviceID)
 }
 
+// WithClientIP returns a context with the end-user address an edge service
+// observed, for downstream per-client rate limits.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	clientIP = strings.TrimSpace(clientIP)
+	if clientIP == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, grpcmeta.ClientIPHeader, clientIP)
+}
+
+// WithExpectedSeq returns a context carrying an optimistic concurrency
+// precondition for the next game write. Zero means the caller has no observed
+// seq and leaves the context unchanged.
+func WithExpectedSeq(ctx context.Context, seq uint64) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if seq == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, grpcmeta.ExpectedSeqHeader, strconv.FormatUint(seq, 10))
+}
+
 // AdminOverrideUnaryClientInterceptor appends ADMIN override metadata to unary calls.
 func AdminOverrideUnaryClientInterceptor(reason string) grpc.UnaryClientInterceptor {
 	reason = strings.TrimSpace(reason)
@@ -172,6 +172,23 @@ func TestWithServiceIDNoopWhenEmpty(t *testing.T) {
 	}
 }
 
+func TestWithExpectedSeqAppendsMetadataWhenPositive(t *testing.T) {
+	ctx := WithExpectedSeq(context.Background(), 42)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		t.Fatalf("expected outgoing metadata context")
+	}
+	values := md.Get(grpcmeta.ExpectedSeqHeader)
+	if len(values) != 1 || values[0] != "42" {
+		t.Fatalf("metadata %s = %v, want [42]", grpcmeta.ExpectedSeqHeader, values)
+	}
+
+	ctx = WithExpectedSeq(context.Background(), 0)
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(grpcmeta.ExpectedSeqHeader)) > 0 {
+		t.Fatalf("expected no %s metadata for zero seq", grpcmeta.ExpectedSeqHeader)
+	}
+}
+
 func TestWithHelpersSupportNilContext(t *testing.T) {
 	ctx := WithUserID(nil, "user-1")
 	if md, ok := metadata.FromOutgoingContext(ctx); !ok || len(md.Get(grpcmeta.UserIDHeader)) != 1 {
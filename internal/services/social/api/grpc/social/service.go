@@ -29,6 +29,7 @@ type contactAndUserProfileStore interface {
 	storage.ContactStore
 	storage.UserProfileStore
 	storage.UserDirectoryStore
+	storage.UserDataStore
 }
 
 // Service exposes social.v1 gRPC operations.
@@ -432,3 +432,22 @@ func (s *fakeContactStore) GetUserProfileByUserID(_ context.Context, userID stri
 	}
 	return profile, nil
 }
+
+func (s *fakeContactStore) DeleteUserData(_ context.Context, userID string) (int, error) {
+	deleted := 0
+	for key, contact := range s.contacts {
+		if contact.OwnerUserID == userID || contact.ContactUserID == userID {
+			delete(s.contacts, key)
+			deleted++
+		}
+	}
+	if _, ok := s.profiles[userID]; ok {
+		delete(s.profiles, userID)
+		deleted++
+	}
+	if _, ok := s.directory[userID]; ok {
+		delete(s.directory, userID)
+		deleted++
+	}
+	return deleted, nil
+}
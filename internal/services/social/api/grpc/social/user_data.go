@@ -0,0 +1,110 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/social/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportedSocialRecords is the social section of an account export archive.
+type exportedSocialRecords struct {
+	Profile  *exportedUserProfile `json:"profile,omitempty"`
+	Contacts []exportedContact    `json:"contacts"`
+}
+
+type exportedUserProfile struct {
+	Name          string    `json:"name"`
+	Pronouns      string    `json:"pronouns"`
+	Bio           string    `json:"bio"`
+	AvatarSetID   string    `json:"avatar_set_id"`
+	AvatarAssetID string    `json:"avatar_asset_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type exportedContact struct {
+	ContactUserID string    `json:"contact_user_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ExportUserData returns the user's profile and owned contacts as JSON.
+func (s *Service) ExportUserData(ctx context.Context, in *socialv1.ExportUserDataRequest) (*socialv1.ExportUserDataResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "export user data request is required")
+	}
+	if s == nil || s.store == nil {
+		return nil, status.Error(codes.Internal, "contact store is not configured")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	records := exportedSocialRecords{Contacts: []exportedContact{}}
+	profile, err := s.store.GetUserProfileByUserID(ctx, userID)
+	switch {
+	case err == nil:
+		records.Profile = &exportedUserProfile{
+			Name:          profile.Name,
+			Pronouns:      profile.Pronouns,
+			Bio:           profile.Bio,
+			AvatarSetID:   profile.AvatarSetID,
+			AvatarAssetID: profile.AvatarAssetID,
+			CreatedAt:     profile.CreatedAt,
+			UpdatedAt:     profile.UpdatedAt,
+		}
+	case !errors.Is(err, storage.ErrNotFound):
+		return nil, status.Errorf(codes.Internal, "export user data: %v", err)
+	}
+
+	pageToken := ""
+	for {
+		page, err := s.store.ListContacts(ctx, userID, maxListContactsPageSize, pageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "export user data: %v", err)
+		}
+		for _, contact := range page.Contacts {
+			records.Contacts = append(records.Contacts, exportedContact{
+				ContactUserID: contact.ContactUserID,
+				CreatedAt:     contact.CreatedAt,
+			})
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	payload, err := json.Marshal(records)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "export user data: %v", err)
+	}
+	return &socialv1.ExportUserDataResponse{RecordsJson: string(payload)}, nil
+}
+
+// DeleteUserData erases the user's profile, directory entry, and contacts.
+// Repeated calls succeed with zero deleted records.
+func (s *Service) DeleteUserData(ctx context.Context, in *socialv1.DeleteUserDataRequest) (*socialv1.DeleteUserDataResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "delete user data request is required")
+	}
+	if s == nil || s.store == nil {
+		return nil, status.Error(codes.Internal, "contact store is not configured")
+	}
+	userID := strings.TrimSpace(in.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	deleted, err := s.store.DeleteUserData(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete user data: %v", err)
+	}
+	return &socialv1.DeleteUserDataResponse{DeletedRecords: int32(deleted)}, nil
+}
@@ -0,0 +1,39 @@
+package social
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/social/storage"
+)
+
+func TestExportAndDeleteUserData(t *testing.T) {
+	store := newFakeContactStore()
+	svc := NewService(store)
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	store.profiles["user-1"] = storage.UserProfile{UserID: "user-1", Name: "Alice", CreatedAt: now, UpdatedAt: now}
+	store.contacts["user-1|user-2"] = storage.Contact{OwnerUserID: "user-1", ContactUserID: "user-2", CreatedAt: now, UpdatedAt: now}
+	store.contacts["user-2|user-1"] = storage.Contact{OwnerUserID: "user-2", ContactUserID: "user-1", CreatedAt: now, UpdatedAt: now}
+
+	exported, err := svc.ExportUserData(context.Background(), &socialv1.ExportUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("export user data: %v", err)
+	}
+	if got := exported.GetRecordsJson(); !strings.Contains(got, `"name":"Alice"`) || !strings.Contains(got, `"contact_user_id":"user-2"`) {
+		t.Fatalf("records = %s", got)
+	}
+
+	deleted, err := svc.DeleteUserData(context.Background(), &socialv1.DeleteUserDataRequest{UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("delete user data: %v", err)
+	}
+	if deleted.GetDeletedRecords() != 3 {
+		t.Fatalf("deleted records = %d, want 3", deleted.GetDeletedRecords())
+	}
+	if len(store.contacts) != 0 || len(store.profiles) != 0 {
+		t.Fatalf("remaining contacts = %d profiles = %d", len(store.contacts), len(store.profiles))
+	}
+}
@@ -0,0 +1,14 @@
+package server
+
+import (
+	"time"
+
+	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+)
+
+// rateLimits are the social throttling defaults. Directory search is the
+// cheapest way to enumerate accounts, so it is budgeted per viewer.
+var rateLimits = ratelimit.Rules{
+	socialv1.SocialService_SearchUsers_FullMethodName: {Key: ratelimit.KeyUser, Limit: 60, Period: time.Minute, Burst: 20},
+}
@@ -14,6 +14,7 @@ import (
 
 	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/config"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	socialservice "github.com/louisbranch/fracturing.space/internal/services/social/api/grpc/social"
 	socialsqlite "github.com/louisbranch/fracturing.space/internal/services/social/storage/sqlite"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -41,6 +42,7 @@ type Server struct {
 	grpcServer *grpc.Server
 	health     *health.Server
 	store      *socialsqlite.Store
+	limiter    *ratelimit.Limiter
 	closeOnce  sync.Once
 }
 
@@ -67,7 +69,18 @@ func NewWithAddrContext(ctx context.Context, addr string) (*Server, error) {
 		return nil, err
 	}
 
-	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	limiter, err := ratelimit.NewFromEnv(rateLimits)
+	if err != nil {
+		_ = listener.Close()
+		_ = store.Close()
+		return nil, fmt.Errorf("configure rate limits: %w", err)
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(limiter.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(limiter.StreamServerInterceptor()),
+	)
 	apiService := socialservice.NewService(store)
 	healthServer := health.NewServer()
 	socialv1.RegisterSocialServiceServer(grpcServer, apiService)
@@ -80,6 +93,7 @@ func NewWithAddrContext(ctx context.Context, addr string) (*Server, error) {
 		grpcServer: grpcServer,
 		health:     healthServer,
 		store:      store,
+		limiter:    limiter,
 	}, nil
 }
 
@@ -165,6 +179,9 @@ func (s *Server) Close() {
 				log.Printf("close social store: %v", err)
 			}
 		}
+		if err := s.limiter.Close(); err != nil {
+			log.Printf("close social rate limiter: %v", err)
+		}
 	})
 }
 
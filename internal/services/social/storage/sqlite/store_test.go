@@ -255,3 +255,51 @@ func TestDirectoryUserSameValueUpdateIsNoOp(t *testing.T) {
 		t.Fatalf("results = %+v, want alice", results)
 	}
 }
+
+func TestDeleteUserDataRemovesBothContactDirections(t *testing.T) {
+	store, err := Open(t.TempDir() + "/social.db")
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	for _, contact := range []storage.Contact{
+		{OwnerUserID: "user-1", ContactUserID: "user-2", CreatedAt: now, UpdatedAt: now},
+		{OwnerUserID: "user-2", ContactUserID: "user-1", CreatedAt: now, UpdatedAt: now},
+		{OwnerUserID: "user-2", ContactUserID: "user-3", CreatedAt: now, UpdatedAt: now},
+	} {
+		if err := store.PutContact(ctx, contact); err != nil {
+			t.Fatalf("put contact: %v", err)
+		}
+	}
+	if err := store.PutUserProfile(ctx, storage.UserProfile{UserID: "user-1", Name: "Alice", CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatalf("put profile: %v", err)
+	}
+	if err := store.PutDirectoryUser(ctx, storage.DirectoryUser{UserID: "user-1", Username: "alice", CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatalf("put directory user: %v", err)
+	}
+
+	deleted, err := store.DeleteUserData(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("delete user data: %v", err)
+	}
+	if deleted != 4 {
+		t.Fatalf("deleted = %d, want 4", deleted)
+	}
+	if _, err := store.GetUserProfileByUserID(ctx, "user-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("profile err = %v, want not found", err)
+	}
+	if _, err := store.GetContact(ctx, "user-2", "user-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("inbound contact err = %v, want not found", err)
+	}
+	if _, err := store.GetContact(ctx, "user-2", "user-3"); err != nil {
+		t.Fatalf("unrelated contact: %v", err)
+	}
+
+	deleted, err = store.DeleteUserData(ctx, "user-1")
+	if err != nil || deleted != 0 {
+		t.Fatalf("repeat delete = %d, %v; want 0, nil", deleted, err)
+	}
+}
@@ -0,0 +1,56 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/social/storage"
+)
+
+// DeleteUserData removes every social row that names one user.
+func (s *Store) DeleteUserData(ctx context.Context, userID string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return 0, fmt.Errorf("storage is not configured")
+	}
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return 0, fmt.Errorf("user id is required")
+	}
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin delete user data: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{`DELETE FROM contacts WHERE owner_user_id = ? OR contact_user_id = ?`, []any{userID, userID}},
+		{`DELETE FROM user_profiles WHERE user_id = ?`, []any{userID}},
+		{`DELETE FROM user_directory WHERE user_id = ?`, []any{userID}},
+	}
+	deleted := 0
+	for _, statement := range statements {
+		result, err := tx.ExecContext(ctx, statement.query, statement.args...)
+		if err != nil {
+			return 0, fmt.Errorf("delete user data: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("delete user data rows: %w", err)
+		}
+		deleted += int(rows)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit delete user data: %w", err)
+	}
+	return deleted, nil
+}
+
+var _ storage.UserDataStore = (*Store)(nil)
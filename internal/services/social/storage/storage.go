@@ -83,3 +83,10 @@ type UserDirectoryStore interface {
 	PutDirectoryUser(ctx context.Context, user DirectoryUser) error
 	SearchUsers(ctx context.Context, viewerUserID string, query SearchUsersQuery, limit int) ([]SearchUser, error)
 }
+
+// UserDataStore erases every social record that names one user.
+type UserDataStore interface {
+	// DeleteUserData removes the user's profile, directory entry, and contacts
+	// in both directions, returning the number of rows removed.
+	DeleteUserData(ctx context.Context, userID string) (int, error)
+}
@@ -0,0 +1,324 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/status/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultHistoryWindow   = 24 * time.Hour
+	defaultHistoryPageSize = 100
+	maxHistoryPageSize     = 500
+
+	defaultUptimeWindow = 7 * 24 * time.Hour
+
+	defaultIncidentWindow   = 30 * 24 * time.Hour
+	defaultIncidentPageSize = 50
+	maxIncidentPageSize     = 200
+
+	defaultResolution = "Resolved."
+)
+
+// RecordTransitions persists every effective-status change not yet recorded.
+// Transitions are committed only after the append succeeds, so a failed write
+// is retried by the next call. The server runtime also calls it on a ticker so
+// staleness-driven changes are recorded without waiting for the next mutation.
+func (s *Service) RecordTransitions(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+	s.recordMu.Lock()
+	defer s.recordMu.Unlock()
+
+	transitions := s.aggregator.PendingTransitions()
+	if len(transitions) == 0 {
+		return nil
+	}
+	if err := s.store.AppendTransitions(ctx, transitions); err != nil {
+		return err
+	}
+	s.aggregator.CommitTransitions(transitions)
+	return nil
+}
+
+// ListStatusHistory returns persisted status transitions over a window.
+func (s *Service) ListStatusHistory(ctx context.Context, req *statusv1.ListStatusHistoryRequest) (*statusv1.ListStatusHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "list status history request is required")
+	}
+	if s.store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "status history is not configured")
+	}
+	start, end, err := s.window(req.GetStartTime(), req.GetEndTime(), defaultHistoryWindow)
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := s.store.ListTransitions(ctx, domain.TransitionQuery{
+		Service:    strings.TrimSpace(req.GetService()),
+		Capability: strings.TrimSpace(req.GetCapability()),
+		Since:      start,
+		Until:      end,
+		Limit:      pageSize(req.GetPageSize(), defaultHistoryPageSize, maxHistoryPageSize),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list status history: %v", err)
+	}
+	resp := &statusv1.ListStatusHistoryResponse{
+		Transitions: make([]*statusv1.StatusTransition, 0, len(transitions)),
+	}
+	for _, t := range transitions {
+		resp.Transitions = append(resp.Transitions, transitionToProto(t))
+	}
+	return resp, nil
+}
+
+// ListCapabilityUptime returns per-capability uptime over a window. The
+// window end is clamped to now so the current status is not projected into
+// the future.
+func (s *Service) ListCapabilityUptime(ctx context.Context, req *statusv1.ListCapabilityUptimeRequest) (*statusv1.ListCapabilityUptimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "list capability uptime request is required")
+	}
+	if s.store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "status history is not configured")
+	}
+	start, end, err := s.window(req.GetStartTime(), req.GetEndTime(), defaultUptimeWindow)
+	if err != nil {
+		return nil, err
+	}
+	service := strings.TrimSpace(req.GetService())
+	capability := strings.TrimSpace(req.GetCapability())
+
+	priors, err := s.store.LatestTransitions(ctx, start)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load prior statuses: %v", err)
+	}
+	transitions, err := s.store.ListTransitions(ctx, domain.TransitionQuery{
+		Service:    service,
+		Capability: capability,
+		Since:      start,
+		Until:      end,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list status history: %v", err)
+	}
+
+	type capabilityKey struct{ service, capability string }
+	priorByKey := make(map[capabilityKey]*domain.Transition)
+	windowByKey := make(map[capabilityKey][]domain.Transition)
+	for i := range priors {
+		p := &priors[i]
+		if (service != "" && p.Service != service) || (capability != "" && p.Capability != capability) {
+			continue
+		}
+		key := capabilityKey{p.Service, p.Capability}
+		priorByKey[key] = p
+		if _, ok := windowByKey[key]; !ok {
+			windowByKey[key] = nil
+		}
+	}
+	for _, t := range transitions {
+		key := capabilityKey{t.Service, t.Capability}
+		windowByKey[key] = append(windowByKey[key], t)
+	}
+
+	uptimes := make([]*statusv1.CapabilityUptime, 0, len(windowByKey))
+	for key, keyed := range windowByKey {
+		uptime := domain.ComputeUptime(key.service, key.capability, priorByKey[key], keyed, start, end)
+		uptimes = append(uptimes, uptimeToProto(uptime))
+	}
+	sort.Slice(uptimes, func(i, j int) bool {
+		if uptimes[i].GetService() != uptimes[j].GetService() {
+			return uptimes[i].GetService() < uptimes[j].GetService()
+		}
+		return uptimes[i].GetCapability() < uptimes[j].GetCapability()
+	})
+	return &statusv1.ListCapabilityUptimeResponse{
+		Capabilities: uptimes,
+		StartTime:    timestamppb.New(start),
+		EndTime:      timestamppb.New(end),
+	}, nil
+}
+
+// ListIncidents returns the incident timeline, newest first.
+func (s *Service) ListIncidents(ctx context.Context, req *statusv1.ListIncidentsRequest) (*statusv1.ListIncidentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "list incidents request is required")
+	}
+	if s.store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "incident timeline is not configured")
+	}
+	since := s.now().Add(-defaultIncidentWindow)
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
+	incidents, err := s.store.ListIncidents(ctx, domain.IncidentQuery{
+		Service: strings.TrimSpace(req.GetService()),
+		Since:   since,
+		Limit:   pageSize(req.GetPageSize(), defaultIncidentPageSize, maxIncidentPageSize),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list incidents: %v", err)
+	}
+	resp := &statusv1.ListIncidentsResponse{
+		Incidents: make([]*statusv1.Incident, 0, len(incidents)),
+	}
+	for _, inc := range incidents {
+		resp.Incidents = append(resp.Incidents, incidentToProto(inc))
+	}
+	return resp, nil
+}
+
+// recordOverrideIncident opens an incident for the first override on a
+// capability and appends an update for each later one.
+func (s *Service) recordOverrideIncident(ctx context.Context, ov domain.Override, title string) (string, error) {
+	open, ok, err := s.store.OpenIncident(ctx, ov.Service, ov.Capability)
+	if err != nil {
+		return "", err
+	}
+	updateID, err := s.idGenerator()
+	if err != nil {
+		return "", fmt.Errorf("generate incident update id: %w", err)
+	}
+	update := domain.IncidentUpdate{
+		ID:        updateID,
+		Status:    ov.Status,
+		Message:   strings.TrimSpace(ov.Detail),
+		CreatedAt: ov.SetAt,
+	}
+	if ok {
+		update.IncidentID = open.ID
+		return open.ID, s.store.AppendIncidentUpdate(ctx, update)
+	}
+
+	incidentID, err := s.idGenerator()
+	if err != nil {
+		return "", fmt.Errorf("generate incident id: %w", err)
+	}
+	update.IncidentID = incidentID
+	return incidentID, s.store.CreateIncident(ctx, domain.Incident{
+		ID:         incidentID,
+		Service:    ov.Service,
+		Capability: ov.Capability,
+		Title:      domain.IncidentTitle(title, ov),
+		State:      domain.IncidentStateOpen,
+		OpenedAt:   ov.SetAt,
+		Updates:    []domain.IncidentUpdate{update},
+	})
+}
+
+// resolveOverrideIncident resolves the open incident for a capability, if
+// any, recording the status the capability returned to.
+func (s *Service) resolveOverrideIncident(ctx context.Context, service, capability, resolution string) (string, error) {
+	open, ok, err := s.store.OpenIncident(ctx, service, capability)
+	if err != nil || !ok {
+		return "", err
+	}
+	updateID, err := s.idGenerator()
+	if err != nil {
+		return "", fmt.Errorf("generate incident update id: %w", err)
+	}
+	message := strings.TrimSpace(resolution)
+	if message == "" {
+		message = defaultResolution
+	}
+	return open.ID, s.store.ResolveIncident(ctx, domain.IncidentUpdate{
+		ID:         updateID,
+		IncidentID: open.ID,
+		Status:     s.aggregator.EffectiveStatus(service, capability),
+		Message:    message,
+		CreatedAt:  s.now(),
+	})
+}
+
+// window resolves an optional [start, end) request window, clamping end to now.
+func (s *Service) window(startTS, endTS *timestamppb.Timestamp, fallback time.Duration) (time.Time, time.Time, error) {
+	now := s.now()
+	end := now
+	if endTS != nil {
+		end = endTS.AsTime()
+		if end.After(now) {
+			end = now
+		}
+	}
+	start := end.Add(-fallback)
+	if startTS != nil {
+		start = startTS.AsTime()
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+	return start, end, nil
+}
+
+func pageSize(requested int32, fallback, max int) int {
+	switch {
+	case requested <= 0:
+		return fallback
+	case int(requested) > max:
+		return max
+	default:
+		return int(requested)
+	}
+}
+
+func transitionToProto(t domain.Transition) *statusv1.StatusTransition {
+	return &statusv1.StatusTransition{
+		Service:    t.Service,
+		Capability: t.Capability,
+		FromStatus: statusv1.CapabilityStatus(t.From),
+		ToStatus:   statusv1.CapabilityStatus(t.To),
+		Detail:     t.Detail,
+		OccurredAt: timestamppb.New(t.At),
+	}
+}
+
+func uptimeToProto(u domain.Uptime) *statusv1.CapabilityUptime {
+	out := &statusv1.CapabilityUptime{
+		Service:            u.Service,
+		Capability:         u.Capability,
+		MeasuredSeconds:    int64(u.Measured().Seconds()),
+		OperationalSeconds: int64(u.Operational.Seconds()),
+		DegradedSeconds:    int64(u.Degraded.Seconds()),
+		UnavailableSeconds: int64(u.Unavailable.Seconds()),
+		MaintenanceSeconds: int64(u.Maintenance.Seconds()),
+		UnknownSeconds:     int64(u.Unknown.Seconds()),
+	}
+	if percent, ok := u.Percent(); ok {
+		out.UptimePercent = &percent
+	}
+	return out
+}
+
+func incidentToProto(inc domain.Incident) *statusv1.Incident {
+	out := &statusv1.Incident{
+		Id:         inc.ID,
+		Service:    inc.Service,
+		Capability: inc.Capability,
+		Title:      inc.Title,
+		State:      statusv1.IncidentState(inc.State),
+		OpenedAt:   timestamppb.New(inc.OpenedAt),
+		Updates:    make([]*statusv1.IncidentUpdate, 0, len(inc.Updates)),
+	}
+	if !inc.ResolvedAt.IsZero() {
+		out.ResolvedAt = timestamppb.New(inc.ResolvedAt)
+	}
+	for _, update := range inc.Updates {
+		out.Updates = append(out.Updates, &statusv1.IncidentUpdate{
+			Id:        update.ID,
+			Status:    statusv1.CapabilityStatus(update.Status),
+			Message:   update.Message,
+			CreatedAt: timestamppb.New(update.CreatedAt),
+		})
+	}
+	return out
+}
@@ -0,0 +1,200 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/status/domain"
+	statussqlite "github.com/louisbranch/fracturing.space/internal/services/status/storage/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newHistoryService(t *testing.T, now *time.Time) *Service {
+	t.Helper()
+	store, err := statussqlite.Open(filepath.Join(t.TempDir(), "status.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	clock := func() time.Time { return *now }
+	return NewService(domain.NewAggregator(time.Hour, clock), store, clock)
+}
+
+func reportCapability(t *testing.T, svc *Service, at time.Time, capStatus statusv1.CapabilityStatus) {
+	t.Helper()
+	if _, err := svc.ReportStatus(context.Background(), &statusv1.ReportStatusRequest{
+		Report: &statusv1.ServiceStatusReport{
+			Service:      "ai",
+			Capabilities: []*statusv1.CapabilityReport{{Name: "ai.orchestration", Status: capStatus}},
+			ReportedAt:   timestamppb.New(at),
+		},
+	}); err != nil {
+		t.Fatalf("ReportStatus: %v", err)
+	}
+}
+
+func TestService_history_and_uptime(t *testing.T) {
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	svc := newHistoryService(t, &now)
+	ctx := context.Background()
+
+	reportCapability(t, svc, now, statusv1.CapabilityStatus_CAPABILITY_STATUS_OPERATIONAL)
+	now = start.Add(6 * time.Hour)
+	reportCapability(t, svc, now, statusv1.CapabilityStatus_CAPABILITY_STATUS_DEGRADED)
+	now = start.Add(8 * time.Hour)
+	reportCapability(t, svc, now, statusv1.CapabilityStatus_CAPABILITY_STATUS_OPERATIONAL)
+	now = start.Add(10 * time.Hour)
+
+	history, err := svc.ListStatusHistory(ctx, &statusv1.ListStatusHistoryRequest{Capability: "ai.orchestration"})
+	if err != nil {
+		t.Fatalf("ListStatusHistory: %v", err)
+	}
+	if len(history.GetTransitions()) != 3 || history.GetTransitions()[1].GetToStatus() != statusv1.CapabilityStatus_CAPABILITY_STATUS_DEGRADED {
+		t.Fatalf("history = %v, want three transitions with the degraded one in the middle", history.GetTransitions())
+	}
+
+	uptime, err := svc.ListCapabilityUptime(ctx, &statusv1.ListCapabilityUptimeRequest{
+		StartTime: timestamppb.New(start),
+	})
+	if err != nil {
+		t.Fatalf("ListCapabilityUptime: %v", err)
+	}
+	if len(uptime.GetCapabilities()) != 1 {
+		t.Fatalf("uptime = %v, want one capability", uptime.GetCapabilities())
+	}
+	got := uptime.GetCapabilities()[0]
+	if got.GetDegradedSeconds() != int64((2*time.Hour).Seconds()) || got.GetUptimePercent() != 80 {
+		t.Fatalf("uptime = %v, want 2h degraded and 80%%", got)
+	}
+
+	if _, err := svc.ListCapabilityUptime(ctx, &statusv1.ListCapabilityUptimeRequest{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(start),
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("inverted window code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestService_override_incident_timeline(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	svc := newHistoryService(t, &now)
+	ctx := context.Background()
+	reportCapability(t, svc, now, statusv1.CapabilityStatus_CAPABILITY_STATUS_OPERATIONAL)
+
+	set, err := svc.SetOverride(ctx, &statusv1.SetOverrideRequest{
+		Service:       "ai",
+		Capability:    "ai.orchestration",
+		Status:        statusv1.CapabilityStatus_CAPABILITY_STATUS_UNAVAILABLE,
+		Reason:        statusv1.OverrideReason_OVERRIDE_REASON_KNOWN_ISSUE,
+		Detail:        "provider returning errors",
+		IncidentTitle: "AI provider outage",
+	})
+	if err != nil || set.GetIncidentId() == "" {
+		t.Fatalf("SetOverride = %v, %v; want incident id", set, err)
+	}
+
+	now = now.Add(30 * time.Minute)
+	update, err := svc.SetOverride(ctx, &statusv1.SetOverrideRequest{
+		Service:    "ai",
+		Capability: "ai.orchestration",
+		Status:     statusv1.CapabilityStatus_CAPABILITY_STATUS_DEGRADED,
+		Detail:     "fallback provider engaged",
+	})
+	if err != nil || update.GetIncidentId() != set.GetIncidentId() {
+		t.Fatalf("second SetOverride = %v, %v; want same incident", update, err)
+	}
+
+	now = now.Add(30 * time.Minute)
+	cleared, err := svc.ClearOverride(ctx, &statusv1.ClearOverrideRequest{Service: "ai", Capability: "ai.orchestration"})
+	if err != nil || cleared.GetIncidentId() != set.GetIncidentId() {
+		t.Fatalf("ClearOverride = %v, %v; want resolved incident", cleared, err)
+	}
+
+	incidents, err := svc.ListIncidents(ctx, &statusv1.ListIncidentsRequest{})
+	if err != nil {
+		t.Fatalf("ListIncidents: %v", err)
+	}
+	if len(incidents.GetIncidents()) != 1 {
+		t.Fatalf("incidents = %v, want one", incidents.GetIncidents())
+	}
+	incident := incidents.GetIncidents()[0]
+	if incident.GetTitle() != "AI provider outage" || incident.GetState() != statusv1.IncidentState_INCIDENT_STATE_RESOLVED {
+		t.Fatalf("incident = %v, want resolved AI provider outage", incident)
+	}
+	updates := incident.GetUpdates()
+	if len(updates) != 3 || updates[2].GetMessage() != defaultResolution || updates[2].GetStatus() != statusv1.CapabilityStatus_CAPABILITY_STATUS_OPERATIONAL {
+		t.Fatalf("updates = %v, want two override updates and an operational resolution", updates)
+	}
+
+	history, err := svc.ListStatusHistory(ctx, &statusv1.ListStatusHistoryRequest{})
+	if err != nil {
+		t.Fatalf("ListStatusHistory: %v", err)
+	}
+	if len(history.GetTransitions()) != 4 {
+		t.Fatalf("history = %v, want operational, unavailable, degraded, operational", history.GetTransitions())
+	}
+}
+
+func TestService_history_requires_store(t *testing.T) {
+	svc := NewService(domain.NewAggregator(0, nil), nil, nil)
+	ctx := context.Background()
+	if _, err := svc.ListStatusHistory(ctx, &statusv1.ListStatusHistoryRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ListStatusHistory code = %v, want FailedPrecondition", status.Code(err))
+	}
+	if _, err := svc.ListCapabilityUptime(ctx, &statusv1.ListCapabilityUptimeRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ListCapabilityUptime code = %v, want FailedPrecondition", status.Code(err))
+	}
+	if _, err := svc.ListIncidents(ctx, &statusv1.ListIncidentsRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ListIncidents code = %v, want FailedPrecondition", status.Code(err))
+	}
+}
+
+// failingTransitionStore fails AppendTransitions while failing is set.
+type failingTransitionStore struct {
+	Store
+	failing  bool
+	appended []domain.Transition
+}
+
+func (s *failingTransitionStore) AppendTransitions(ctx context.Context, transitions []domain.Transition) error {
+	if s.failing {
+		return errors.New("disk full")
+	}
+	s.appended = append(s.appended, transitions...)
+	return nil
+}
+
+func TestService_RecordTransitions_retries_after_failed_append(t *testing.T) {
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+	store := &failingTransitionStore{failing: true}
+	svc := NewService(domain.NewAggregator(time.Hour, clock), store, clock)
+	svc.aggregator.ApplyReport("ai", []domain.CapabilityReport{{Name: "ai.orchestration", Status: domain.StatusDegraded}}, start)
+
+	if err := svc.RecordTransitions(context.Background()); err == nil {
+		t.Fatal("RecordTransitions error = nil, want append failure")
+	}
+
+	store.failing = false
+	now = start.Add(time.Minute)
+	if err := svc.RecordTransitions(context.Background()); err != nil {
+		t.Fatalf("RecordTransitions retry: %v", err)
+	}
+	if len(store.appended) != 1 || store.appended[0].To != domain.StatusDegraded || !store.appended[0].At.Equal(start) {
+		t.Fatalf("appended = %+v, want the degraded transition observed at %v", store.appended, start)
+	}
+	if err := svc.RecordTransitions(context.Background()); err != nil {
+		t.Fatalf("RecordTransitions after commit: %v", err)
+	}
+	if len(store.appended) != 1 {
+		t.Fatalf("appended = %+v, want no duplicate after commit", store.appended)
+	}
+}
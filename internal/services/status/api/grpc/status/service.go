@@ -3,11 +3,14 @@ package status
 
 import (
 	"context"
+	"log"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
 	"github.com/louisbranch/fracturing.space/internal/services/status/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,28 +23,58 @@ type OverrideStore interface {
 	DeleteOverride(ctx context.Context, service, capability string) error
 }
 
+// HistoryStore persists effective-status transitions.
+type HistoryStore interface {
+	AppendTransitions(ctx context.Context, transitions []domain.Transition) error
+	ListTransitions(ctx context.Context, query domain.TransitionQuery) ([]domain.Transition, error)
+	LatestTransitions(ctx context.Context, before time.Time) ([]domain.Transition, error)
+}
+
+// IncidentStore persists operator-authored incidents and their updates.
+type IncidentStore interface {
+	CreateIncident(ctx context.Context, incident domain.Incident) error
+	AppendIncidentUpdate(ctx context.Context, update domain.IncidentUpdate) error
+	ResolveIncident(ctx context.Context, update domain.IncidentUpdate) error
+	OpenIncident(ctx context.Context, service, capability string) (domain.Incident, bool, error)
+	ListIncidents(ctx context.Context, query domain.IncidentQuery) ([]domain.Incident, error)
+}
+
+// Store is the persistence surface of the status service.
+type Store interface {
+	OverrideStore
+	HistoryStore
+	IncidentStore
+}
+
 // Service implements statusv1.StatusServiceServer.
 type Service struct {
 	statusv1.UnimplementedStatusServiceServer
-	aggregator *domain.Aggregator
-	overrides  OverrideStore
-	now        func() time.Time
+	aggregator  *domain.Aggregator
+	store       Store
+	now         func() time.Time
+	idGenerator func() (string, error)
+	// recordMu serializes RecordTransitions so concurrent mutations and the
+	// sweep ticker never append the same pending transition twice.
+	recordMu sync.Mutex
 }
 
-// NewService creates a status service backed by the given aggregator and override store.
-func NewService(aggregator *domain.Aggregator, overrides OverrideStore, now func() time.Time) *Service {
+// NewService creates a status service backed by the given aggregator and
+// store. A nil store keeps the service in-memory only: overrides are not
+// persisted and the history and incident RPCs are unavailable.
+func NewService(aggregator *domain.Aggregator, store Store, now func() time.Time) *Service {
 	if now == nil {
 		now = time.Now
 	}
 	return &Service{
-		aggregator: aggregator,
-		overrides:  overrides,
-		now:        now,
+		aggregator:  aggregator,
+		store:       store,
+		now:         now,
+		idGenerator: id.NewID,
 	}
 }
 
 // ReportStatus accepts a capability health push from a service.
-func (s *Service) ReportStatus(_ context.Context, req *statusv1.ReportStatusRequest) (*statusv1.ReportStatusResponse, error) {
+func (s *Service) ReportStatus(ctx context.Context, req *statusv1.ReportStatusRequest) (*statusv1.ReportStatusResponse, error) {
 	if req == nil || req.Report == nil {
 		return nil, status.Error(codes.InvalidArgument, "report is required")
 	}
@@ -73,6 +106,11 @@ func (s *Service) ReportStatus(_ context.Context, req *statusv1.ReportStatusRequ
 	}
 
 	s.aggregator.ApplyReport(service, caps, reportedAt)
+	// History is advisory to reporters; a write failure must not reject the
+	// report that was already applied.
+	if err := s.RecordTransitions(ctx); err != nil {
+		log.Printf("record status transitions: %v", err)
+	}
 	return &statusv1.ReportStatusResponse{}, nil
 }
 
@@ -114,12 +152,20 @@ func (s *Service) SetOverride(ctx context.Context, req *statusv1.SetOverrideRequ
 
 	s.aggregator.SetOverride(ov)
 
-	if s.overrides != nil {
-		if err := s.overrides.PutOverride(ctx, ov); err != nil {
-			return nil, status.Errorf(codes.Internal, "persist override: %v", err)
-		}
+	if s.store == nil {
+		return &statusv1.SetOverrideResponse{}, nil
 	}
-	return &statusv1.SetOverrideResponse{}, nil
+	if err := s.store.PutOverride(ctx, ov); err != nil {
+		return nil, status.Errorf(codes.Internal, "persist override: %v", err)
+	}
+	incidentID, err := s.recordOverrideIncident(ctx, ov, req.GetIncidentTitle())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "record incident: %v", err)
+	}
+	if err := s.RecordTransitions(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "record status transitions: %v", err)
+	}
+	return &statusv1.SetOverrideResponse{IncidentId: incidentID}, nil
 }
 
 // ClearOverride removes an operator override from a capability.
@@ -138,12 +184,20 @@ func (s *Service) ClearOverride(ctx context.Context, req *statusv1.ClearOverride
 
 	s.aggregator.ClearOverride(service, capability)
 
-	if s.overrides != nil {
-		if err := s.overrides.DeleteOverride(ctx, service, capability); err != nil {
-			return nil, status.Errorf(codes.Internal, "delete override: %v", err)
-		}
+	if s.store == nil {
+		return &statusv1.ClearOverrideResponse{}, nil
+	}
+	if err := s.store.DeleteOverride(ctx, service, capability); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete override: %v", err)
+	}
+	incidentID, err := s.resolveOverrideIncident(ctx, service, capability, req.GetResolution())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "resolve incident: %v", err)
+	}
+	if err := s.RecordTransitions(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "record status transitions: %v", err)
 	}
-	return &statusv1.ClearOverrideResponse{}, nil
+	return &statusv1.ClearOverrideResponse{IncidentId: incidentID}, nil
 }
 
 func serviceSnapshotToProto(ss domain.ServiceSnapshot) *statusv1.ServiceStatus {
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/config"
@@ -42,9 +43,14 @@ type Server struct {
 	grpcServer *grpc.Server
 	health     *health.Server
 	store      *statussqlite.Store
+	service    *statusservice.Service
 	closeOnce  sync.Once
 }
 
+// transitionSweepInterval bounds how long a staleness-driven status change
+// waits before it is written to history.
+const transitionSweepInterval = 10 * time.Second
+
 // New creates a configured status server listening on the provided port.
 func New(port int) (*Server, error) {
 	return NewWithAddr(fmt.Sprintf(":%d", port))
@@ -81,6 +87,13 @@ func NewWithAddrContext(ctx context.Context, addr string) (*Server, error) {
 	if len(overrides) > 0 {
 		log.Printf("restored %d persisted overrides", len(overrides))
 	}
+	latest, err := store.LatestTransitions(ctx, time.Time{})
+	if err != nil {
+		_ = store.Close()
+		_ = listener.Close()
+		return nil, fmt.Errorf("load status history: %w", err)
+	}
+	aggregator.SeedTransitions(latest)
 
 	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	apiService := statusservice.NewService(aggregator, store, nil)
@@ -95,6 +108,7 @@ func NewWithAddrContext(ctx context.Context, addr string) (*Server, error) {
 		grpcServer: grpcServer,
 		health:     healthServer,
 		store:      store,
+		service:    apiService,
 	}, nil
 }
 
@@ -140,6 +154,9 @@ func (s *Server) Serve(ctx context.Context) error {
 	go func() {
 		serveErr <- s.grpcServer.Serve(s.listener)
 	}()
+	sweepCtx, stopSweep := context.WithCancel(ctx)
+	defer stopSweep()
+	go s.sweepTransitions(sweepCtx)
 
 	select {
 	case <-ctx.Done():
@@ -183,6 +200,26 @@ func (s *Server) Close() {
 	})
 }
 
+// sweepTransitions records staleness-driven status changes, which happen as
+// time passes rather than in response to an RPC.
+func (s *Server) sweepTransitions(ctx context.Context) {
+	if s.service == nil {
+		return
+	}
+	ticker := time.NewTicker(transitionSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.service.RecordTransitions(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("record status transitions: %v", err)
+			}
+		}
+	}
+}
+
 func openStore(path string) (*statussqlite.Store, error) {
 	if dir := filepath.Dir(path); dir != "." {
 		if err := os.MkdirAll(dir, 0o755); err != nil {
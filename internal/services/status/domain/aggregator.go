@@ -1,5 +1,6 @@
 // Package domain implements in-memory capability status aggregation with
-// staleness detection and operator override merging.
+// staleness detection and operator override merging, plus the transition,
+// uptime, and incident models persisted alongside it.
 package domain
 
 import (
@@ -18,6 +19,22 @@ const (
 	StatusMaintenance CapabilityStatus = 4
 )
 
+// String returns the lower-case status label used in derived text.
+func (s CapabilityStatus) String() string {
+	switch s {
+	case StatusOperational:
+		return "operational"
+	case StatusDegraded:
+		return "degraded"
+	case StatusUnavailable:
+		return "unavailable"
+	case StatusMaintenance:
+		return "maintenance"
+	default:
+		return "unspecified"
+	}
+}
+
 // OverrideReason classifies why an operator override was applied.
 type OverrideReason int
 
@@ -88,6 +105,12 @@ type Aggregator struct {
 	services           map[string]*serviceState
 	stalenessThreshold time.Duration
 	now                func() time.Time
+	// recorded holds the last effective status committed through
+	// CommitTransitions, keyed by service then capability.
+	recorded map[string]map[string]CapabilityStatus
+	// pending holds transitions handed out but not yet committed, so a retry
+	// after a failed write keeps the time the change was first observed.
+	pending map[string]map[string]Transition
 }
 
 // NewAggregator creates an aggregator with the given staleness threshold.
@@ -103,6 +126,8 @@ func NewAggregator(threshold time.Duration, now func() time.Time) *Aggregator {
 		services:           make(map[string]*serviceState),
 		stalenessThreshold: threshold,
 		now:                now,
+		recorded:           make(map[string]map[string]CapabilityStatus),
+		pending:            make(map[string]map[string]Transition),
 	}
 }
 
@@ -176,6 +201,24 @@ func (a *Aggregator) ClearOverride(service, capability string) {
 	cs.override = nil
 }
 
+// EffectiveStatus returns the resolved status of one capability, or
+// StatusUnspecified when the capability is unknown.
+func (a *Aggregator) EffectiveStatus(service, capability string) CapabilityStatus {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	state, ok := a.services[service]
+	if !ok {
+		return StatusUnspecified
+	}
+	cs, ok := state.capabilities[name(capability)]
+	if !ok {
+		return StatusUnspecified
+	}
+	stale := !state.lastReportAt.IsZero() && a.now().Sub(state.lastReportAt) > a.stalenessThreshold
+	return resolveCapability(cs, stale).EffectiveStatus
+}
+
 // Snapshot returns the current resolved view of all services.
 func (a *Aggregator) Snapshot() []ServiceSnapshot {
 	a.mu.RLock()
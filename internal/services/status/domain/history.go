@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Transition records one change in a capability's effective status.
+type Transition struct {
+	Service    string
+	Capability string
+	From       CapabilityStatus
+	To         CapabilityStatus
+	Detail     string
+	At         time.Time
+}
+
+// TransitionQuery filters persisted transitions. Empty service or capability
+// matches every value. Since and Until are inclusive; zero times leave the
+// window open on that side.
+type TransitionQuery struct {
+	Service    string
+	Capability string
+	Since      time.Time
+	Until      time.Time
+	Limit      int
+}
+
+// SeedTransitions primes the recorded status of each capability from the
+// latest persisted transitions, so a restart does not re-record statuses that
+// were already known before the process stopped.
+func (a *Aggregator) SeedTransitions(latest []Transition) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, t := range latest {
+		a.recordStatus(t.Service, t.Capability, t.To)
+	}
+}
+
+// PendingTransitions returns every capability whose effective status differs
+// from the last committed one. It does not mark anything recorded: callers
+// persist the result and then pass it to CommitTransitions, so a failed write
+// is retried on the next call. Callers invoke it after each mutation and
+// periodically, because staleness changes effective status without any
+// mutation. Capabilities dropped from a report keep their last recorded
+// status until they are reported again.
+func (a *Aggregator) PendingTransitions() []Transition {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	var transitions []Transition
+	for svc, state := range a.services {
+		stale := !state.lastReportAt.IsZero() && now.Sub(state.lastReportAt) > a.stalenessThreshold
+		for capName, cs := range state.capabilities {
+			snap := resolveCapability(cs, stale)
+			if snap.EffectiveStatus == StatusUnspecified {
+				continue
+			}
+			previous := a.recorded[svc][capName]
+			if previous == snap.EffectiveStatus {
+				delete(a.pending[svc], capName)
+				continue
+			}
+			detail := snap.ReportedDetail
+			if snap.Override != nil {
+				detail = snap.Override.Detail
+			}
+			at := now
+			if earlier, ok := a.pending[svc][capName]; ok && earlier.From == previous && earlier.To == snap.EffectiveStatus {
+				at = earlier.At
+			}
+			transition := Transition{
+				Service:    svc,
+				Capability: capName,
+				From:       previous,
+				To:         snap.EffectiveStatus,
+				Detail:     detail,
+				At:         at,
+			}
+			transitions = append(transitions, transition)
+			a.pendingTransition(transition)
+		}
+	}
+	sort.Slice(transitions, func(i, j int) bool {
+		if transitions[i].Service != transitions[j].Service {
+			return transitions[i].Service < transitions[j].Service
+		}
+		return transitions[i].Capability < transitions[j].Capability
+	})
+	return transitions
+}
+
+// CommitTransitions marks transitions returned by PendingTransitions as
+// recorded once they have been persisted.
+func (a *Aggregator) CommitTransitions(transitions []Transition) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, t := range transitions {
+		a.recordStatus(t.Service, t.Capability, t.To)
+		delete(a.pending[t.Service], t.Capability)
+	}
+}
+
+// pendingTransition remembers one uncommitted transition. Callers must hold
+// a.mu.
+func (a *Aggregator) pendingTransition(t Transition) {
+	byCapability, ok := a.pending[t.Service]
+	if !ok {
+		byCapability = make(map[string]Transition)
+		a.pending[t.Service] = byCapability
+	}
+	byCapability[t.Capability] = t
+}
+
+// recordStatus stores one recorded status. Callers must hold a.mu.
+func (a *Aggregator) recordStatus(service, capability string, status CapabilityStatus) {
+	byCapability, ok := a.recorded[service]
+	if !ok {
+		byCapability = make(map[string]CapabilityStatus)
+		a.recorded[service] = byCapability
+	}
+	byCapability[capability] = status
+}
+
+// Uptime summarizes how long one capability spent in each status over a window.
+type Uptime struct {
+	Service     string
+	Capability  string
+	Operational time.Duration
+	Degraded    time.Duration
+	Unavailable time.Duration
+	Maintenance time.Duration
+	Unknown     time.Duration
+}
+
+// Measured returns the time that counts toward the uptime ratio. Maintenance
+// is planned and unknown time has no evidence either way, so both are excluded.
+func (u Uptime) Measured() time.Duration {
+	return u.Operational + u.Degraded + u.Unavailable
+}
+
+// Percent returns operational time as a percentage of measured time, and false
+// when the window holds no measured time.
+func (u Uptime) Percent() (float64, bool) {
+	measured := u.Measured()
+	if measured <= 0 {
+		return 0, false
+	}
+	return 100 * float64(u.Operational) / float64(measured), true
+}
+
+// ComputeUptime folds one capability's transitions into per-status durations
+// over [start, end). prior is the latest transition before start, if any, and
+// sets the status the window opens with; transitions must be ordered by time.
+func ComputeUptime(service, capability string, prior *Transition, transitions []Transition, start, end time.Time) Uptime {
+	uptime := Uptime{Service: service, Capability: capability}
+	if !end.After(start) {
+		return uptime
+	}
+
+	current := StatusUnspecified
+	if prior != nil {
+		current = prior.To
+	}
+	cursor := start
+	for _, t := range transitions {
+		if !t.At.After(cursor) {
+			current = t.To
+			continue
+		}
+		if !t.At.Before(end) {
+			break
+		}
+		uptime.add(current, t.At.Sub(cursor))
+		cursor = t.At
+		current = t.To
+	}
+	uptime.add(current, end.Sub(cursor))
+	return uptime
+}
+
+func (u *Uptime) add(status CapabilityStatus, d time.Duration) {
+	switch status {
+	case StatusOperational:
+		u.Operational += d
+	case StatusDegraded:
+		u.Degraded += d
+	case StatusUnavailable:
+		u.Unavailable += d
+	case StatusMaintenance:
+		u.Maintenance += d
+	default:
+		u.Unknown += d
+	}
+}
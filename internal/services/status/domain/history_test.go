@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// recordTransitions hands out and commits pending transitions, standing in
+// for a history write that always succeeds.
+func recordTransitions(agg *Aggregator) []Transition {
+	got := agg.PendingTransitions()
+	agg.CommitTransitions(got)
+	return got
+}
+
+func TestAggregator_Transitions_records_changes_once(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := start
+	agg := NewAggregator(30*time.Second, func() time.Time { return current })
+
+	agg.ApplyReport("ai", []CapabilityReport{
+		{Name: "ai.orchestration", Status: StatusOperational},
+	}, start)
+	got := recordTransitions(agg)
+	if len(got) != 1 || got[0].From != StatusUnspecified || got[0].To != StatusOperational {
+		t.Fatalf("first transitions = %+v, want unspecified -> operational", got)
+	}
+	if again := recordTransitions(agg); len(again) != 0 {
+		t.Fatalf("repeat transitions = %+v, want none", again)
+	}
+
+	agg.ApplyReport("ai", []CapabilityReport{
+		{Name: "ai.orchestration", Status: StatusDegraded, Detail: "provider slow"},
+	}, start)
+	got = recordTransitions(agg)
+	if len(got) != 1 || got[0].From != StatusOperational || got[0].To != StatusDegraded || got[0].Detail != "provider slow" {
+		t.Fatalf("degraded transitions = %+v, want operational -> degraded with detail", got)
+	}
+}
+
+func TestAggregator_Transitions_staleness_and_overrides(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := start
+	agg := NewAggregator(30*time.Second, func() time.Time { return current })
+
+	agg.ApplyReport("game", []CapabilityReport{{Name: "game.service", Status: StatusOperational}}, start)
+	_ = recordTransitions(agg)
+
+	current = start.Add(31 * time.Second)
+	got := recordTransitions(agg)
+	if len(got) != 1 || got[0].To != StatusUnavailable || !got[0].At.Equal(current) {
+		t.Fatalf("stale transitions = %+v, want operational -> unavailable at %v", got, current)
+	}
+
+	agg.SetOverride(Override{Service: "game", Capability: "game.service", Status: StatusMaintenance, Detail: "upgrade"})
+	got = recordTransitions(agg)
+	if len(got) != 1 || got[0].To != StatusMaintenance || got[0].Detail != "upgrade" {
+		t.Fatalf("override transitions = %+v, want unavailable -> maintenance", got)
+	}
+}
+
+func TestAggregator_PendingTransitions_retries_until_committed(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := start
+	agg := NewAggregator(time.Hour, func() time.Time { return current })
+
+	agg.ApplyReport("ai", []CapabilityReport{{Name: "ai.orchestration", Status: StatusDegraded}}, start)
+	first := agg.PendingTransitions()
+	if len(first) != 1 || first[0].To != StatusDegraded {
+		t.Fatalf("first pending = %+v, want one degraded transition", first)
+	}
+
+	// The write failed, so nothing was committed: the next sweep hands out
+	// the same transition stamped with when it was first observed.
+	current = start.Add(time.Minute)
+	retry := agg.PendingTransitions()
+	if len(retry) != 1 || retry[0].From != StatusUnspecified || retry[0].To != StatusDegraded || !retry[0].At.Equal(start) {
+		t.Fatalf("retried pending = %+v, want unspecified -> degraded at %v", retry, start)
+	}
+
+	agg.CommitTransitions(retry)
+	if again := agg.PendingTransitions(); len(again) != 0 {
+		t.Fatalf("pending after commit = %+v, want none", again)
+	}
+}
+
+func TestAggregator_SeedTransitions_suppresses_known_statuses(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	agg := NewAggregator(30*time.Second, func() time.Time { return now })
+	agg.SeedTransitions([]Transition{
+		{Service: "game", Capability: "game.service", To: StatusOperational},
+		{Service: "ai", Capability: "ai.orchestration", To: StatusOperational},
+	})
+
+	agg.ApplyReport("game", []CapabilityReport{{Name: "game.service", Status: StatusOperational}}, now)
+	agg.ApplyReport("ai", []CapabilityReport{{Name: "ai.orchestration", Status: StatusDegraded}}, now)
+
+	got := recordTransitions(agg)
+	if len(got) != 1 || got[0].Service != "ai" || got[0].From != StatusOperational {
+		t.Fatalf("transitions = %+v, want only ai operational -> degraded", got)
+	}
+}
+
+func TestComputeUptime(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Hour)
+	prior := &Transition{To: StatusOperational, At: start.Add(-time.Hour)}
+	transitions := []Transition{
+		{To: StatusDegraded, At: start.Add(2 * time.Hour)},
+		{To: StatusOperational, At: start.Add(3 * time.Hour)},
+		{To: StatusMaintenance, At: start.Add(6 * time.Hour)},
+		{To: StatusOperational, At: start.Add(8 * time.Hour)},
+	}
+
+	uptime := ComputeUptime("ai", "ai.orchestration", prior, transitions, start, end)
+	if uptime.Operational != 7*time.Hour || uptime.Degraded != time.Hour || uptime.Maintenance != 2*time.Hour {
+		t.Fatalf("uptime = %+v, want 7h operational, 1h degraded, 2h maintenance", uptime)
+	}
+	percent, ok := uptime.Percent()
+	if !ok || percent != 87.5 {
+		t.Fatalf("percent = %v, %v; want 87.5, true", percent, ok)
+	}
+}
+
+func TestComputeUptime_without_prior_counts_unknown(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	uptime := ComputeUptime("ai", "ai.orchestration", nil, []Transition{
+		{To: StatusUnavailable, At: start.Add(time.Hour)},
+	}, start, start.Add(2*time.Hour))
+	if uptime.Unknown != time.Hour || uptime.Unavailable != time.Hour {
+		t.Fatalf("uptime = %+v, want 1h unknown and 1h unavailable", uptime)
+	}
+	if percent, ok := uptime.Percent(); !ok || percent != 0 {
+		t.Fatalf("percent = %v, %v; want 0, true", percent, ok)
+	}
+
+	empty := ComputeUptime("ai", "ai.orchestration", nil, nil, start, start.Add(time.Hour))
+	if _, ok := empty.Percent(); ok {
+		t.Fatal("percent ok = true for a window with no measured time")
+	}
+}
+
+func TestIncidentTitle_fallbacks(t *testing.T) {
+	ov := Override{Capability: "ai.orchestration", Status: StatusDegraded}
+	if got := IncidentTitle("  Provider outage ", ov); got != "Provider outage" {
+		t.Fatalf("title = %q, want explicit title", got)
+	}
+	ov.Detail = "slow responses"
+	if got := IncidentTitle("", ov); got != "slow responses" {
+		t.Fatalf("title = %q, want detail fallback", got)
+	}
+	ov.Detail = ""
+	if got := IncidentTitle("", ov); got != "ai.orchestration degraded" {
+		t.Fatalf("title = %q, want derived title", got)
+	}
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// IncidentState tracks whether an incident is still being worked.
+type IncidentState int
+
+const (
+	IncidentStateUnspecified IncidentState = 0
+	IncidentStateOpen        IncidentState = 1
+	IncidentStateResolved    IncidentState = 2
+)
+
+// Incident is an operator-authored account of one capability problem. An
+// incident opens with the first override on a capability, collects one update
+// per later override, and resolves when the override is cleared.
+type Incident struct {
+	ID         string
+	Service    string
+	Capability string
+	Title      string
+	State      IncidentState
+	OpenedAt   time.Time
+	ResolvedAt time.Time
+	Updates    []IncidentUpdate
+}
+
+// IncidentUpdate is one timeline entry on an incident.
+type IncidentUpdate struct {
+	ID         string
+	IncidentID string
+	Status     CapabilityStatus
+	Message    string
+	CreatedAt  time.Time
+}
+
+// IncidentQuery filters persisted incidents. Empty service matches every
+// service; open incidents always match, and a zero Since matches every
+// incident.
+type IncidentQuery struct {
+	Service string
+	Since   time.Time
+	Limit   int
+}
+
+// IncidentTitle returns the operator-provided title, falling back to a title
+// derived from the override so every incident has a readable headline.
+func IncidentTitle(title string, ov Override) string {
+	if title = strings.TrimSpace(title); title != "" {
+		return title
+	}
+	if detail := strings.TrimSpace(ov.Detail); detail != "" {
+		return detail
+	}
+	return ov.Capability + " " + ov.Status.String()
+}
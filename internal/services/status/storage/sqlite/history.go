@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/status/domain"
+)
+
+// AppendTransitions persists status transitions in one transaction.
+func (s *Store) AppendTransitions(ctx context.Context, transitions []domain.Transition) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(transitions) == 0 {
+		return nil
+	}
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin append transitions: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, t := range transitions {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO status_transitions (service, capability, from_status, to_status, detail, occurred_at)
+			 VALUES (?, ?, ?, ?, ?, ?)`,
+			t.Service, t.Capability, int(t.From), int(t.To), t.Detail, t.At.UTC().UnixMilli(),
+		); err != nil {
+			return fmt.Errorf("append transition: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit append transitions: %w", err)
+	}
+	return nil
+}
+
+// ListTransitions returns transitions matching the query in chronological
+// order. A positive limit keeps the most recent matches.
+func (s *Store) ListTransitions(ctx context.Context, query domain.TransitionQuery) ([]domain.Transition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var (
+		clauses []string
+		args    []any
+	)
+	if query.Service != "" {
+		clauses = append(clauses, "service = ?")
+		args = append(args, query.Service)
+	}
+	if query.Capability != "" {
+		clauses = append(clauses, "capability = ?")
+		args = append(args, query.Capability)
+	}
+	if !query.Since.IsZero() {
+		clauses = append(clauses, "occurred_at >= ?")
+		args = append(args, query.Since.UTC().UnixMilli())
+	}
+	if !query.Until.IsZero() {
+		clauses = append(clauses, "occurred_at <= ?")
+		args = append(args, query.Until.UTC().UnixMilli())
+	}
+	sqlQuery := `SELECT service, capability, from_status, to_status, detail, occurred_at
+		 FROM status_transitions`
+	if len(clauses) > 0 {
+		sqlQuery += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	sqlQuery += " ORDER BY occurred_at DESC, id DESC"
+	if query.Limit > 0 {
+		sqlQuery += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
+
+	transitions, err := s.queryTransitions(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list transitions: %w", err)
+	}
+	slices.Reverse(transitions)
+	return transitions, nil
+}
+
+// LatestTransitions returns the most recent transition per capability that
+// occurred before the given time. A zero time returns the latest overall.
+func (s *Store) LatestTransitions(ctx context.Context, before time.Time) ([]domain.Transition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	bound := int64(1<<63 - 1)
+	if !before.IsZero() {
+		bound = before.UTC().UnixMilli()
+	}
+	// Row ids grow with insertion order, which matches occurrence order
+	// because transitions are appended as they are observed.
+	transitions, err := s.queryTransitions(ctx,
+		`SELECT t.service, t.capability, t.from_status, t.to_status, t.detail, t.occurred_at
+		 FROM status_transitions t
+		 JOIN (
+		   SELECT MAX(id) AS id FROM status_transitions
+		   WHERE occurred_at < ?
+		   GROUP BY service, capability
+		 ) latest ON latest.id = t.id
+		 ORDER BY t.service, t.capability`,
+		bound,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("latest transitions: %w", err)
+	}
+	return transitions, nil
+}
+
+func (s *Store) queryTransitions(ctx context.Context, query string, args ...any) ([]domain.Transition, error) {
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []domain.Transition
+	for rows.Next() {
+		var t domain.Transition
+		var from, to int
+		var occurredAt int64
+		if err := rows.Scan(&t.Service, &t.Capability, &from, &to, &t.Detail, &occurredAt); err != nil {
+			return nil, fmt.Errorf("scan transition: %w", err)
+		}
+		t.From = domain.CapabilityStatus(from)
+		t.To = domain.CapabilityStatus(to)
+		t.At = time.UnixMilli(occurredAt).UTC()
+		transitions = append(transitions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
@@ -0,0 +1,118 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/status/domain"
+)
+
+func TestStore_transition_history(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	if err := store.AppendTransitions(ctx, []domain.Transition{
+		{Service: "ai", Capability: "ai.orchestration", To: domain.StatusOperational, At: base},
+		{Service: "game", Capability: "game.service", To: domain.StatusOperational, At: base},
+		{Service: "ai", Capability: "ai.orchestration", From: domain.StatusOperational, To: domain.StatusDegraded, Detail: "slow", At: base.Add(time.Hour)},
+		{Service: "ai", Capability: "ai.orchestration", From: domain.StatusDegraded, To: domain.StatusOperational, At: base.Add(2 * time.Hour)},
+	}); err != nil {
+		t.Fatalf("AppendTransitions: %v", err)
+	}
+
+	all, err := store.ListTransitions(ctx, domain.TransitionQuery{Service: "ai"})
+	if err != nil {
+		t.Fatalf("ListTransitions: %v", err)
+	}
+	if len(all) != 3 || all[1].Detail != "slow" || !all[0].At.Equal(base) {
+		t.Fatalf("ai transitions = %+v, want three in chronological order", all)
+	}
+
+	recent, err := store.ListTransitions(ctx, domain.TransitionQuery{Service: "ai", Limit: 2})
+	if err != nil {
+		t.Fatalf("ListTransitions limit: %v", err)
+	}
+	if len(recent) != 2 || recent[0].To != domain.StatusDegraded || recent[1].To != domain.StatusOperational {
+		t.Fatalf("limited transitions = %+v, want the two most recent oldest first", recent)
+	}
+
+	window, err := store.ListTransitions(ctx, domain.TransitionQuery{Since: base.Add(time.Hour), Until: base.Add(90 * time.Minute)})
+	if err != nil {
+		t.Fatalf("ListTransitions window: %v", err)
+	}
+	if len(window) != 1 || window[0].To != domain.StatusDegraded {
+		t.Fatalf("window transitions = %+v, want only the degraded transition", window)
+	}
+
+	latest, err := store.LatestTransitions(ctx, base.Add(90*time.Minute))
+	if err != nil {
+		t.Fatalf("LatestTransitions: %v", err)
+	}
+	if len(latest) != 2 || latest[0].Service != "ai" || latest[0].To != domain.StatusDegraded || latest[1].Service != "game" {
+		t.Fatalf("latest transitions = %+v, want ai degraded and game operational", latest)
+	}
+}
+
+func TestStore_incident_lifecycle(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	opened := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	if err := store.CreateIncident(ctx, domain.Incident{
+		ID:         "inc-1",
+		Service:    "ai",
+		Capability: "ai.orchestration",
+		Title:      "Provider outage",
+		State:      domain.IncidentStateOpen,
+		OpenedAt:   opened,
+		Updates: []domain.IncidentUpdate{
+			{ID: "upd-1", Status: domain.StatusUnavailable, Message: "investigating", CreatedAt: opened},
+		},
+	}); err != nil {
+		t.Fatalf("CreateIncident: %v", err)
+	}
+	if err := store.AppendIncidentUpdate(ctx, domain.IncidentUpdate{
+		ID: "upd-2", IncidentID: "inc-1", Status: domain.StatusDegraded, Message: "partial recovery", CreatedAt: opened.Add(time.Hour),
+	}); err != nil {
+		t.Fatalf("AppendIncidentUpdate: %v", err)
+	}
+
+	open, ok, err := store.OpenIncident(ctx, "ai", "ai.orchestration")
+	if err != nil || !ok {
+		t.Fatalf("OpenIncident = %v, %v; want open incident", ok, err)
+	}
+	if open.ID != "inc-1" || len(open.Updates) != 2 || open.Updates[1].Message != "partial recovery" {
+		t.Fatalf("open incident = %+v, want inc-1 with two updates", open)
+	}
+
+	if err := store.ResolveIncident(ctx, domain.IncidentUpdate{
+		ID: "upd-3", IncidentID: "inc-1", Status: domain.StatusOperational, Message: "resolved", CreatedAt: opened.Add(2 * time.Hour),
+	}); err != nil {
+		t.Fatalf("ResolveIncident: %v", err)
+	}
+	if _, ok, err := store.OpenIncident(ctx, "ai", "ai.orchestration"); err != nil || ok {
+		t.Fatalf("OpenIncident after resolve = %v, %v; want none", ok, err)
+	}
+
+	incidents, err := store.ListIncidents(ctx, domain.IncidentQuery{Since: opened.Add(90 * time.Minute)})
+	if err != nil {
+		t.Fatalf("ListIncidents: %v", err)
+	}
+	if len(incidents) != 1 || incidents[0].State != domain.IncidentStateResolved || !incidents[0].ResolvedAt.Equal(opened.Add(2*time.Hour)) || len(incidents[0].Updates) != 3 {
+		t.Fatalf("incidents = %+v, want resolved inc-1 with three updates", incidents)
+	}
+	if later, err := store.ListIncidents(ctx, domain.IncidentQuery{Since: opened.Add(3 * time.Hour)}); err != nil || len(later) != 0 {
+		t.Fatalf("ListIncidents after resolution = %+v, %v; want none", later, err)
+	}
+}
@@ -0,0 +1,226 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/services/status/domain"
+)
+
+// CreateIncident persists a new incident together with its initial updates.
+func (s *Store) CreateIncident(ctx context.Context, incident domain.Incident) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin create incident: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO incidents (id, service, capability, title, state, opened_at, resolved_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		incident.ID, incident.Service, incident.Capability, incident.Title, int(incident.State),
+		incident.OpenedAt.UTC().UnixMilli(), unixMilliOrZero(incident.ResolvedAt),
+	); err != nil {
+		return fmt.Errorf("create incident: %w", err)
+	}
+	for _, update := range incident.Updates {
+		update.IncidentID = incident.ID
+		if err := insertIncidentUpdate(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit create incident: %w", err)
+	}
+	return nil
+}
+
+// AppendIncidentUpdate adds one timeline entry to an existing incident.
+func (s *Store) AppendIncidentUpdate(ctx context.Context, update domain.IncidentUpdate) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return insertIncidentUpdate(ctx, s.sqlDB, update)
+}
+
+// ResolveIncident marks an incident resolved and records the closing update.
+func (s *Store) ResolveIncident(ctx context.Context, update domain.IncidentUpdate) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin resolve incident: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE incidents SET state = ?, resolved_at = ? WHERE id = ?`,
+		int(domain.IncidentStateResolved), update.CreatedAt.UTC().UnixMilli(), update.IncidentID,
+	); err != nil {
+		return fmt.Errorf("resolve incident: %w", err)
+	}
+	if err := insertIncidentUpdate(ctx, tx, update); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit resolve incident: %w", err)
+	}
+	return nil
+}
+
+// OpenIncident returns the open incident for one capability, if any.
+func (s *Store) OpenIncident(ctx context.Context, service, capability string) (domain.Incident, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return domain.Incident{}, false, err
+	}
+	incidents, err := s.queryIncidents(ctx,
+		`SELECT id, service, capability, title, state, opened_at, resolved_at
+		 FROM incidents
+		 WHERE service = ? AND capability = ? AND state = ?
+		 ORDER BY opened_at DESC
+		 LIMIT 1`,
+		service, capability, int(domain.IncidentStateOpen),
+	)
+	if err != nil {
+		return domain.Incident{}, false, fmt.Errorf("get open incident: %w", err)
+	}
+	if len(incidents) == 0 {
+		return domain.Incident{}, false, nil
+	}
+	return incidents[0], true, nil
+}
+
+// ListIncidents returns incidents that are still open or were opened or
+// resolved since the query time, newest first, with their updates attached.
+func (s *Store) ListIncidents(ctx context.Context, query domain.IncidentQuery) ([]domain.Incident, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	since := query.Since.UTC().UnixMilli()
+	if query.Since.IsZero() {
+		since = 0
+	}
+	clauses := []string{"(state = ? OR opened_at >= ? OR resolved_at >= ?)"}
+	args := []any{int(domain.IncidentStateOpen), since, since}
+	if query.Service != "" {
+		clauses = append(clauses, "service = ?")
+		args = append(args, query.Service)
+	}
+	sqlQuery := `SELECT id, service, capability, title, state, opened_at, resolved_at
+		 FROM incidents
+		 WHERE ` + strings.Join(clauses, " AND ") + `
+		 ORDER BY opened_at DESC, id`
+	if query.Limit > 0 {
+		sqlQuery += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
+	incidents, err := s.queryIncidents(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list incidents: %w", err)
+	}
+	return incidents, nil
+}
+
+// queryIncidents loads incident rows and then their updates in one pass.
+func (s *Store) queryIncidents(ctx context.Context, query string, args ...any) ([]domain.Incident, error) {
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var incidents []domain.Incident
+	for rows.Next() {
+		var inc domain.Incident
+		var state int
+		var openedAt, resolvedAt int64
+		if err := rows.Scan(&inc.ID, &inc.Service, &inc.Capability, &inc.Title, &state, &openedAt, &resolvedAt); err != nil {
+			return nil, fmt.Errorf("scan incident: %w", err)
+		}
+		inc.State = domain.IncidentState(state)
+		inc.OpenedAt = time.UnixMilli(openedAt).UTC()
+		if resolvedAt > 0 {
+			inc.ResolvedAt = time.UnixMilli(resolvedAt).UTC()
+		}
+		incidents = append(incidents, inc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if len(incidents) == 0 {
+		return incidents, nil
+	}
+
+	index := make(map[string]int, len(incidents))
+	placeholders := make([]string, len(incidents))
+	ids := make([]any, len(incidents))
+	for i, inc := range incidents {
+		index[inc.ID] = i
+		placeholders[i] = "?"
+		ids[i] = inc.ID
+	}
+	updateRows, err := s.sqlDB.QueryContext(ctx,
+		`SELECT id, incident_id, status, message, created_at
+		 FROM incident_updates
+		 WHERE incident_id IN (`+strings.Join(placeholders, ", ")+`)
+		 ORDER BY created_at, id`,
+		ids...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list incident updates: %w", err)
+	}
+	defer updateRows.Close()
+	for updateRows.Next() {
+		var update domain.IncidentUpdate
+		var status int
+		var createdAt int64
+		if err := updateRows.Scan(&update.ID, &update.IncidentID, &status, &update.Message, &createdAt); err != nil {
+			return nil, fmt.Errorf("scan incident update: %w", err)
+		}
+		update.Status = domain.CapabilityStatus(status)
+		update.CreatedAt = time.UnixMilli(createdAt).UTC()
+		i := index[update.IncidentID]
+		incidents[i].Updates = append(incidents[i].Updates, update)
+	}
+	if err := updateRows.Err(); err != nil {
+		return nil, fmt.Errorf("list incident updates: %w", err)
+	}
+	return incidents, nil
+}
+
+// execer is the statement surface shared by *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func insertIncidentUpdate(ctx context.Context, db execer, update domain.IncidentUpdate) error {
+	if strings.TrimSpace(update.IncidentID) == "" {
+		return errors.New("incident update requires an incident id")
+	}
+	if _, err := db.ExecContext(ctx,
+		`INSERT INTO incident_updates (id, incident_id, status, message, created_at)
+		 VALUES (?, ?, ?, ?, ?)`,
+		update.ID, update.IncidentID, int(update.Status), update.Message, update.CreatedAt.UTC().UnixMilli(),
+	); err != nil {
+		return fmt.Errorf("append incident update: %w", err)
+	}
+	return nil
+}
+
+func unixMilliOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UTC().UnixMilli()
+}
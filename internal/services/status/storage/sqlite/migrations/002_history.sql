@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS status_transitions (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    service     TEXT NOT NULL,
+    capability  TEXT NOT NULL,
+    from_status INTEGER NOT NULL DEFAULT 0,
+    to_status   INTEGER NOT NULL DEFAULT 0,
+    detail      TEXT NOT NULL DEFAULT '',
+    occurred_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_status_transitions_capability
+    ON status_transitions (service, capability, occurred_at);
+
+CREATE INDEX IF NOT EXISTS idx_status_transitions_occurred
+    ON status_transitions (occurred_at);
+
+CREATE TABLE IF NOT EXISTS incidents (
+    id          TEXT PRIMARY KEY,
+    service     TEXT NOT NULL,
+    capability  TEXT NOT NULL,
+    title       TEXT NOT NULL,
+    state       INTEGER NOT NULL DEFAULT 0,
+    opened_at   INTEGER NOT NULL,
+    resolved_at INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_incidents_capability_state
+    ON incidents (service, capability, state);
+
+CREATE INDEX IF NOT EXISTS idx_incidents_opened
+    ON incidents (opened_at);
+
+CREATE TABLE IF NOT EXISTS incident_updates (
+    id          TEXT PRIMARY KEY,
+    incident_id TEXT NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    status      INTEGER NOT NULL DEFAULT 0,
+    message     TEXT NOT NULL DEFAULT '',
+    created_at  INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_incident_updates_incident
+    ON incident_updates (incident_id, created_at);
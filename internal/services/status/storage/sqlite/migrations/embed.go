@@ -2,7 +2,8 @@ package migrations
 
 import "embed"
 
-// FS contains embedded SQLite migrations for status override storage.
+// FS contains embedded SQLite migrations for status override, history, and
+// incident storage.
 //
 //go:embed *.sql
 var FS embed.FS
@@ -1,4 +1,5 @@
-// Package sqlite provides SQLite-backed override persistence for the status service.
+// Package sqlite provides SQLite-backed override, transition history, and
+// incident persistence for the status service.
 package sqlite
 
 import (
@@ -14,12 +15,13 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/status/storage/sqlite/migrations"
 )
 
-// Store persists capability overrides in SQLite.
+// Store persists capability overrides, status transitions, and incidents in
+// SQLite.
 type Store struct {
 	sqlDB *sql.DB
 }
 
-// Open opens a SQLite override store and applies embedded migrations.
+// Open opens a SQLite status store and applies embedded migrations.
 func Open(path string) (*Store, error) {
 	if strings.TrimSpace(path) == "" {
 		return nil, fmt.Errorf("storage path is required")
@@ -12,6 +12,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/profile"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/publicauth"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/settings"
+	"github.com/louisbranch/fracturing.space/internal/services/web/modules/status"
 	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
 	grpc "google.golang.org/grpc"
 )
@@ -64,6 +65,7 @@ func BindGameDependency(bundle *DependencyBundle, conn *grpc.ClientConn) {
 		return
 	}
 	campaigns.BindGameDependency(&bundle.Modules.Campaigns, conn)
+	discovery.BindGameDependency(&bundle.Modules.Discovery, conn)
 	bundle.Modules.DashboardSync.GameEventClient = statev1.NewEventServiceClient(conn)
 }
 
@@ -73,6 +75,7 @@ func BindInviteDependency(bundle *DependencyBundle, conn *grpc.ClientConn) {
 		return
 	}
 	campaigns.BindInviteDependency(&bundle.Modules.Campaigns, conn)
+	discovery.BindInviteDependency(&bundle.Modules.Discovery, conn)
 	invite.BindInviteDependency(&bundle.Modules.Invite, conn)
 }
 
@@ -112,10 +115,12 @@ func BindNotificationsDependency(bundle *DependencyBundle, conn *grpc.ClientConn
 	notifications.BindDependency(&bundle.Modules.Notifications, conn)
 }
 
-// BindStatusDependency wires the status client into the dashboard dependency set.
+// BindStatusDependency wires the status client into the dashboard and public
+// status page dependency sets.
 func BindStatusDependency(bundle *DependencyBundle, conn *grpc.ClientConn) {
 	if bundle == nil || conn == nil {
 		return
 	}
 	dashboard.BindStatusDependency(&bundle.Modules.Dashboard, conn)
+	status.BindDependency(&bundle.Modules.Status, conn)
 }
@@ -41,12 +41,14 @@ func TestDependenciesCallLeafBindFunctions(t *testing.T) {
 			funcName: "BindGameDependency",
 			want: []selectorCall{
 				{recv: "campaigns", name: "BindGameDependency"},
+				{recv: "discovery", name: "BindGameDependency"},
 			},
 		},
 		{
 			funcName: "BindInviteDependency",
 			want: []selectorCall{
 				{recv: "campaigns", name: "BindInviteDependency"},
+				{recv: "discovery", name: "BindInviteDependency"},
 				{recv: "invite", name: "BindInviteDependency"},
 			},
 		},
@@ -81,6 +83,7 @@ func TestDependenciesCallLeafBindFunctions(t *testing.T) {
 			funcName: "BindStatusDependency",
 			want: []selectorCall{
 				{recv: "dashboard", name: "BindStatusDependency"},
+				{recv: "status", name: "BindDependency"},
 			},
 		},
 	} {
@@ -46,6 +46,9 @@ func TestDependencyBindersWireRootBundle(t *testing.T) {
 	if bundle.Modules.Campaigns.CampaignClient == nil {
 		t.Fatal("Modules.Campaigns.CampaignClient = nil, want client")
 	}
+	if bundle.Modules.Discovery.CharacterClient == nil {
+		t.Fatal("Modules.Discovery.CharacterClient = nil, want client")
+	}
 
 	BindInviteDependency(&bundle, conn)
 	if bundle.Modules.Campaigns.InviteClient == nil {
@@ -54,6 +57,9 @@ func TestDependencyBindersWireRootBundle(t *testing.T) {
 	if bundle.Modules.Invite.InviteClient == nil {
 		t.Fatal("Modules.Invite.InviteClient = nil, want client")
 	}
+	if bundle.Modules.Discovery.ApplicationClient == nil {
+		t.Fatal("Modules.Discovery.ApplicationClient = nil, want client")
+	}
 
 	BindAIDependency(&bundle, conn)
 	if bundle.Modules.Settings.AgentClient == nil {
@@ -71,6 +71,7 @@ func TestFeatureModulesFollowTemplate(t *testing.T) {
 		"profile":       archetypeTransportLayered,
 		"publicauth":    archetypeTransportLayered,
 		"settings":      archetypeTransportLayered,
+		"status":        archetypeTransportLayered,
 	}
 	protectedModuleGatewayUnavailableFiles := map[string]string{
 		"campaigns":     filepath.Join("app", "unavailable_gateway.go"),
@@ -36,6 +36,16 @@ func TestSelectedSmallModulesKeepCompositionOwnedAppServices(t *testing.T) {
 			composePath: "discovery/composition.go",
 			composeFunc: "Compose",
 		},
+		{
+			name:        "status",
+			modulePath:  "status/module.go",
+			configField: "Service",
+			configType:  "statusapp.Service",
+			appPackage:  "statusapp",
+			constructor: "NewService",
+			composePath: "status/composition.go",
+			composeFunc: "Compose",
+		},
 		{
 			name:        "profile",
 			modulePath:  "profile/module.go",
@@ -180,6 +190,7 @@ func TestRegistryUsesAreaOwnedCompositionEntrypoints(t *testing.T) {
 	testast.AssertFuncCallsSelector(t, "registry_public.go", "defaultPublicModules", "discovery", "Compose")
 	testast.AssertFuncCallsSelector(t, "registry_public.go", "defaultPublicModules", "profile", "Compose")
 	testast.AssertFuncCallsSelector(t, "registry_public.go", "defaultPublicModules", "invite", "Compose")
+	testast.AssertFuncCallsSelector(t, "registry_public.go", "defaultPublicModules", "status", "Compose")
 	testast.AssertFuncDoesNotCallSelector(t, "registry_public.go", "defaultPublicModules", "publicauthgateway", "NewGRPCGateway")
 	testast.AssertFuncDoesNotCallSelector(t, "registry_public.go", "defaultPublicModules", "profilegateway", "NewGRPCGateway")
 	testast.AssertFuncDoesNotCallSelector(t, "registry_public.go", "defaultPublicModules", "invitegateway", "NewGRPCGateway")
@@ -17,11 +17,15 @@ type CampaignCharacterReadGateway interface {
 // CampaignAutomationReadGateway loads campaign automation reads for the web service.
 type CampaignAutomationReadGateway interface {
 	CampaignAIAgents(context.Context) ([]CampaignAIAgentOption, error)
+	CampaignAIArtifacts(context.Context, string) ([]CampaignAIArtifact, error)
+	CampaignAIArtifactRevisions(context.Context, string, string) ([]CampaignAIArtifactRevision, error)
+	CampaignAIArtifactDiff(context.Context, string, string, int, int) (CampaignAIArtifactDiff, error)
 }
 
 // CampaignAutomationMutationGateway applies campaign-level automation mutations for the web service.
 type CampaignAutomationMutationGateway interface {
 	UpdateCampaignAIBinding(context.Context, string, UpdateCampaignAIBindingInput) error
+	RestoreCampaignAIArtifactRevision(context.Context, string, string, int) error
 }
 
 // CampaignCharacterOwnershipMutationGateway applies character-owner mutations for the web service.
@@ -24,6 +24,36 @@ type CampaignSessionMutationGateway interface {
 	EndSession(context.Context, string, EndSessionInput) error
 }
 
+// CampaignSessionRecapGateway loads and publishes AI-drafted session recaps.
+// SessionRecapDraft reports false when no draft exists for the session.
+type CampaignSessionRecapGateway interface {
+	SessionRecapDraft(context.Context, string, string) (CampaignSessionRecapDraft, bool, error)
+	UpdateSessionRecapDraft(context.Context, string, SessionRecapDraftInput) error
+	PublishSessionRecap(context.Context, string, SessionRecapDraftInput) error
+}
+
+// CampaignInviteLinkGateway loads and mutates shareable invite links and their
+// claim history.
+type CampaignInviteLinkGateway interface {
+	CampaignInviteLinks(context.Context, string) ([]CampaignInviteLink, error)
+	CampaignInviteLinkClaims(context.Context, string) ([]CampaignInviteLinkClaim, error)
+	CreateInviteLink(context.Context, string, CreateInviteLinkInput) error
+	RevokeInviteLink(context.Context, string, RevokeInviteLinkInput) error
+	ApproveInviteLinkClaim(context.Context, string, InviteLinkClaimDecisionInput) error
+	DeclineInviteLinkClaim(context.Context, string, InviteLinkClaimDecisionInput) error
+}
+
+// CampaignRecruitmentGateway loads and mutates a campaign's open-table
+// listing and the applications it attracts.
+type CampaignRecruitmentGateway interface {
+	CampaignListing(context.Context, string) (CampaignListing, error)
+	PublishCampaignListing(context.Context, string, PublishCampaignListingInput) error
+	CloseCampaignListing(context.Context, string) error
+	CampaignApplications(context.Context, string) ([]CampaignApplication, error)
+	AcceptCampaignApplication(context.Context, string, CampaignApplicationDecisionInput) error
+	DeclineCampaignApplication(context.Context, string, CampaignApplicationDecisionInput) error
+}
+
 // CampaignInviteMutationGateway applies invite mutations for the web service.
 type CampaignInviteMutationGateway interface {
 	CreateInvite(context.Context, string, CreateInviteInput) error
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+// CampaignAIArtifactHistory loads AI GM artifact history page state for
+// campaign managers.
+func (s automationReadService) CampaignAIArtifactHistory(
+	ctx context.Context,
+	campaignID string,
+	query CampaignAIArtifactHistoryQuery,
+) (CampaignAIArtifactHistory, error) {
+	return s.campaignAIArtifactHistory(ctx, campaignID, query)
+}
+
+// RestoreCampaignAIArtifactRevision applies this package workflow transition.
+func (s automationMutationService) RestoreCampaignAIArtifactRevision(ctx context.Context, campaignID string, input RestoreCampaignAIArtifactRevisionInput) error {
+	return s.restoreCampaignAIArtifactRevision(ctx, campaignID, input)
+}
+
+// campaignAIArtifactHistory lists artifacts, the selected artifact's
+// revisions, and an optional revision comparison.
+func (s automationReadService) campaignAIArtifactHistory(
+	ctx context.Context,
+	campaignID string,
+	query CampaignAIArtifactHistoryQuery,
+) (CampaignAIArtifactHistory, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return CampaignAIArtifactHistory{}, apperrors.E(apperrors.KindInvalidInput, "campaign id is required")
+	}
+	if err := s.auth.requireManageCampaign(ctx, campaignID); err != nil {
+		return CampaignAIArtifactHistory{}, err
+	}
+
+	history := CampaignAIArtifactHistory{
+		Artifacts: []CampaignAIArtifact{},
+		Revisions: []CampaignAIArtifactRevision{},
+	}
+	artifacts, err := s.read.CampaignAIArtifacts(ctx, campaignID)
+	if err != nil {
+		var appErr apperrors.Error
+		if stderrors.As(err, &appErr) && appErr.Kind == apperrors.KindUnavailable {
+			history.Unavailable = true
+			return history, nil
+		}
+		return CampaignAIArtifactHistory{}, err
+	}
+	history.Artifacts = append(history.Artifacts, artifacts...)
+
+	history.SelectedPath = selectCampaignAIArtifactPath(artifacts, query.Path)
+	if history.SelectedPath == "" {
+		return history, nil
+	}
+
+	revisions, err := s.read.CampaignAIArtifactRevisions(ctx, campaignID, history.SelectedPath)
+	if err != nil {
+		return CampaignAIArtifactHistory{}, err
+	}
+	history.Revisions = append(history.Revisions, revisions...)
+
+	if query.From > 0 {
+		diff, err := s.read.CampaignAIArtifactDiff(ctx, campaignID, history.SelectedPath, query.From, query.To)
+		if err != nil {
+			return CampaignAIArtifactHistory{}, err
+		}
+		history.Diff = &diff
+	}
+	return history, nil
+}
+
+// restoreCampaignAIArtifactRevision applies the manager-only artifact rollback.
+func (s automationMutationService) restoreCampaignAIArtifactRevision(ctx context.Context, campaignID string, input RestoreCampaignAIArtifactRevisionInput) error {
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return apperrors.E(apperrors.KindInvalidInput, "campaign id is required")
+	}
+	input.Path = strings.TrimSpace(input.Path)
+	if input.Path == "" {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.ai_artifact_path_is_required", "artifact path is required")
+	}
+	if input.Revision < 1 {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.ai_artifact_revision_is_required", "artifact revision is required")
+	}
+	if err := s.auth.requireManageCampaign(ctx, campaignID); err != nil {
+		return err
+	}
+	return s.mutation.RestoreCampaignAIArtifactRevision(ctx, campaignID, input.Path, input.Revision)
+}
+
+// selectCampaignAIArtifactPath keeps the requested artifact when it exists and
+// otherwise falls back to the first writable artifact, since read-only
+// artifacts are regenerated by the AI service and rarely need inspection.
+func selectCampaignAIArtifactPath(artifacts []CampaignAIArtifact, requested string) string {
+	requested = strings.TrimSpace(requested)
+	for _, artifact := range artifacts {
+		if requested != "" && artifact.Path == requested {
+			return artifact.Path
+		}
+	}
+	for _, artifact := range artifacts {
+		if !artifact.ReadOnly {
+			return artifact.Path
+		}
+	}
+	if len(artifacts) > 0 {
+		return artifacts[0].Path
+	}
+	return ""
+}
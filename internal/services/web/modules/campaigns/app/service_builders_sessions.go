@@ -13,6 +13,12 @@ type sessionMutationService struct {
 	auth     authorizationSupport
 }
 
+// sessionRecapService keeps GM recap review on one capability seam.
+type sessionRecapService struct {
+	recaps CampaignSessionRecapGateway
+	auth   authorizationSupport
+}
+
 // inviteReadService owns invite reads and search.
 type inviteReadService struct {
 	read CampaignInviteReadGateway
@@ -25,6 +31,18 @@ type inviteMutationService struct {
 	auth     authorizationSupport
 }
 
+// inviteLinkService owns shareable invite links and claim decisions.
+type inviteLinkService struct {
+	links CampaignInviteLinkGateway
+	auth  authorizationSupport
+}
+
+// recruitmentService owns open-table listings and application decisions.
+type recruitmentService struct {
+	recruitment CampaignRecruitmentGateway
+	auth        authorizationSupport
+}
+
 // configurationService owns campaign settings updates without widening into automation or participant editing.
 type configurationService struct {
 	workspace CampaignWorkspaceReadGateway
@@ -56,6 +74,19 @@ func NewSessionMutationService(config SessionMutationServiceConfig, authorizatio
 	}, nil
 }
 
+// NewSessionRecapService constructs the session recap review service surface
+// from explicit gateway seams. Returns an error when required dependencies
+// are absent.
+func NewSessionRecapService(config SessionRecapServiceConfig, authorization AuthorizationGateway) (CampaignSessionRecapService, error) {
+	if config.Recaps == nil || authorization == nil {
+		return nil, errors.New("session recap service: missing required dependencies")
+	}
+	return sessionRecapService{
+		recaps: config.Recaps,
+		auth:   authorizationSupport{gateway: authorization},
+	}, nil
+}
+
 // NewInviteReadService constructs the invite read service surface from
 // explicit gateway seams. Returns an error when required dependencies are
 // absent.
@@ -82,6 +113,32 @@ func NewInviteMutationService(config InviteMutationServiceConfig, authorization
 	}, nil
 }
 
+// NewInviteLinkService constructs the invite-link service surface from
+// explicit gateway seams. Returns an error when required dependencies are
+// absent.
+func NewInviteLinkService(config InviteLinkServiceConfig, authorization AuthorizationGateway) (CampaignInviteLinkService, error) {
+	if config.Links == nil || authorization == nil {
+		return nil, errors.New("invite link service: missing required dependencies")
+	}
+	return inviteLinkService{
+		links: config.Links,
+		auth:  authorizationSupport{gateway: authorization},
+	}, nil
+}
+
+// NewRecruitmentService constructs the open-table recruitment service surface
+// from explicit gateway seams. Returns an error when required dependencies are
+// absent.
+func NewRecruitmentService(config RecruitmentServiceConfig, authorization AuthorizationGateway) (CampaignRecruitmentService, error) {
+	if config.Recruitment == nil || authorization == nil {
+		return nil, errors.New("recruitment service: missing required dependencies")
+	}
+	return recruitmentService{
+		recruitment: config.Recruitment,
+		auth:        authorizationSupport{gateway: authorization},
+	}, nil
+}
+
 // NewConfigurationService constructs the campaign-configuration service
 // surface from explicit gateway seams. Returns an error when required
 // dependencies are absent.
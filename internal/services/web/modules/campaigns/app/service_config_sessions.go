@@ -10,6 +10,11 @@ type SessionMutationServiceConfig struct {
 	Mutation CampaignSessionMutationGateway
 }
 
+// SessionRecapServiceConfig keeps session recap review dependencies explicit.
+type SessionRecapServiceConfig struct {
+	Recaps CampaignSessionRecapGateway
+}
+
 // InviteReadServiceConfig keeps invite read/search dependencies explicit.
 type InviteReadServiceConfig struct {
 	Read CampaignInviteReadGateway
@@ -20,6 +25,17 @@ type InviteMutationServiceConfig struct {
 	Mutation CampaignInviteMutationGateway
 }
 
+// InviteLinkServiceConfig keeps invite-link dependencies explicit.
+type InviteLinkServiceConfig struct {
+	Links CampaignInviteLinkGateway
+}
+
+// RecruitmentServiceConfig keeps open-table listing and application
+// dependencies explicit.
+type RecruitmentServiceConfig struct {
+	Recruitment CampaignRecruitmentGateway
+}
+
 // ConfigurationServiceConfig keeps campaign-configuration dependencies explicit.
 type ConfigurationServiceConfig struct {
 	Workspace CampaignWorkspaceReadGateway
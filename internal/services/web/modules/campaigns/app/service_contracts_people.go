@@ -20,11 +20,13 @@ type CampaignParticipantMutationService interface {
 type CampaignAutomationReadService interface {
 	CampaignAIBindingSummary(context.Context, string, string, string) (CampaignAIBindingSummary, error)
 	CampaignAIBindingSettings(context.Context, string, string) (CampaignAIBindingSettings, error)
+	CampaignAIArtifactHistory(context.Context, string, CampaignAIArtifactHistoryQuery) (CampaignAIArtifactHistory, error)
 }
 
 // CampaignAutomationMutationService exposes campaign-level AI automation mutations.
 type CampaignAutomationMutationService interface {
 	UpdateCampaignAIBinding(context.Context, string, UpdateCampaignAIBindingInput) error
+	RestoreCampaignAIArtifactRevision(context.Context, string, RestoreCampaignAIArtifactRevisionInput) error
 }
 
 // CampaignCharacterReadService exposes character list/entity/editor reads.
@@ -18,12 +18,41 @@ type CampaignSessionMutationService interface {
 	EndSession(context.Context, string, EndSessionInput) error
 }
 
+// CampaignSessionRecapService exposes GM review of AI-drafted session recaps.
+type CampaignSessionRecapService interface {
+	SessionRecapDraft(context.Context, string, string) (CampaignSessionRecapDraft, bool, error)
+	UpdateSessionRecapDraft(context.Context, string, SessionRecapDraftInput) error
+	PublishSessionRecap(context.Context, string, SessionRecapDraftInput) error
+}
+
 // CampaignInviteReadService exposes invite-focused reads and search.
 type CampaignInviteReadService interface {
 	CampaignInvites(context.Context, string) ([]CampaignInvite, error)
 	SearchInviteUsers(context.Context, string, SearchInviteUsersInput) ([]InviteUserSearchResult, error)
 }
 
+// CampaignInviteLinkService exposes invite-link management for invite
+// managers.
+type CampaignInviteLinkService interface {
+	CampaignInviteLinks(context.Context, string) ([]CampaignInviteLink, error)
+	CampaignInviteLinkClaims(context.Context, string) ([]CampaignInviteLinkClaim, error)
+	CreateInviteLink(context.Context, string, CreateInviteLinkInput) error
+	RevokeInviteLink(context.Context, string, RevokeInviteLinkInput) error
+	ApproveInviteLinkClaim(context.Context, string, InviteLinkClaimDecisionInput) error
+	DeclineInviteLinkClaim(context.Context, string, InviteLinkClaimDecisionInput) error
+}
+
+// CampaignRecruitmentService exposes open-table listing and application
+// review for invite managers.
+type CampaignRecruitmentService interface {
+	CampaignListing(context.Context, string) (CampaignListing, error)
+	PublishCampaignListing(context.Context, string, PublishCampaignListingInput) error
+	CloseCampaignListing(context.Context, string) error
+	CampaignApplications(context.Context, string) ([]CampaignApplication, error)
+	AcceptCampaignApplication(context.Context, string, CampaignApplicationDecisionInput) error
+	DeclineCampaignApplication(context.Context, string, CampaignApplicationDecisionInput) error
+}
+
 // CampaignInviteMutationService exposes invite create/revoke mutations.
 type CampaignInviteMutationService interface {
 	CreateInvite(context.Context, string, CreateInviteInput) error
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+const (
+	// inviteLinkMaxUses bounds the usage limit a GM can set on one link.
+	inviteLinkMaxUses = 100
+	// inviteLinkMaxExpiryHours bounds link lifetimes to thirty days.
+	inviteLinkMaxExpiryHours = 30 * 24
+)
+
+// CampaignInviteLinks lists the campaign's shareable links. Links are bearer
+// credentials, so only invite managers may see them.
+func (s inviteLinkService) CampaignInviteLinks(ctx context.Context, campaignID string) ([]CampaignInviteLink, error) {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return nil, err
+	}
+	return s.links.CampaignInviteLinks(ctx, campaignID)
+}
+
+// CampaignInviteLinkClaims lists claim history across the campaign's links.
+func (s inviteLinkService) CampaignInviteLinkClaims(ctx context.Context, campaignID string) ([]CampaignInviteLinkClaim, error) {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return nil, err
+	}
+	return s.links.CampaignInviteLinkClaims(ctx, campaignID)
+}
+
+// CreateInviteLink validates link limits before creating a shareable link.
+func (s inviteLinkService) CreateInviteLink(ctx context.Context, campaignID string, input CreateInviteLinkInput) error {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return err
+	}
+	role := strings.ToLower(strings.TrimSpace(input.Role))
+	switch role {
+	case "":
+		role = InviteLinkRolePlayer
+	case InviteLinkRolePlayer, InviteLinkRoleGM:
+	default:
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.invite_link_role_is_invalid", "invite link role is invalid")
+	}
+	if input.MaxUses < 0 || input.MaxUses > inviteLinkMaxUses {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.invite_link_max_uses_is_invalid", "invite link max uses is invalid")
+	}
+	if input.ExpiresInHours < 0 || input.ExpiresInHours > inviteLinkMaxExpiryHours {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.invite_link_expiry_is_invalid", "invite link expiry is invalid")
+	}
+	input.Role = role
+	return s.links.CreateInviteLink(ctx, campaignID, input)
+}
+
+// RevokeInviteLink stops a link from accepting further claims.
+func (s inviteLinkService) RevokeInviteLink(ctx context.Context, campaignID string, input RevokeInviteLinkInput) error {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return err
+	}
+	linkID := strings.TrimSpace(input.LinkID)
+	if linkID == "" {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.invite_link_id_is_required", "invite link id is required")
+	}
+	return s.links.RevokeInviteLink(ctx, campaignID, RevokeInviteLinkInput{LinkID: linkID})
+}
+
+// ApproveInviteLinkClaim seats a claimant waiting on GM approval.
+func (s inviteLinkService) ApproveInviteLinkClaim(ctx context.Context, campaignID string, input InviteLinkClaimDecisionInput) error {
+	input, err := s.normalizeClaimDecision(ctx, campaignID, input)
+	if err != nil {
+		return err
+	}
+	return s.links.ApproveInviteLinkClaim(ctx, campaignID, input)
+}
+
+// DeclineInviteLinkClaim rejects a claimant waiting on GM approval.
+func (s inviteLinkService) DeclineInviteLinkClaim(ctx context.Context, campaignID string, input InviteLinkClaimDecisionInput) error {
+	input, err := s.normalizeClaimDecision(ctx, campaignID, input)
+	if err != nil {
+		return err
+	}
+	return s.links.DeclineInviteLinkClaim(ctx, campaignID, input)
+}
+
+// normalizeClaimDecision authorizes claim decisions and rejects blank forms.
+func (s inviteLinkService) normalizeClaimDecision(ctx context.Context, campaignID string, input InviteLinkClaimDecisionInput) (InviteLinkClaimDecisionInput, error) {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return InviteLinkClaimDecisionInput{}, err
+	}
+	claimID := strings.TrimSpace(input.ClaimID)
+	if claimID == "" {
+		return InviteLinkClaimDecisionInput{}, apperrors.EK(apperrors.KindInvalidInput, "error.web.message.invite_link_claim_id_is_required", "invite link claim id is required")
+	}
+	return InviteLinkClaimDecisionInput{ClaimID: claimID}, nil
+}
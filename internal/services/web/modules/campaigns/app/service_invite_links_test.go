@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+type inviteLinkGatewayStub struct {
+	createInput  CreateInviteLinkInput
+	createCalls  int
+	revokeInput  RevokeInviteLinkInput
+	approveInput InviteLinkClaimDecisionInput
+}
+
+func (s *inviteLinkGatewayStub) CampaignInviteLinks(context.Context, string) ([]CampaignInviteLink, error) {
+	return []CampaignInviteLink{{ID: "link-1"}}, nil
+}
+
+func (s *inviteLinkGatewayStub) CampaignInviteLinkClaims(context.Context, string) ([]CampaignInviteLinkClaim, error) {
+	return nil, nil
+}
+
+func (s *inviteLinkGatewayStub) CreateInviteLink(_ context.Context, _ string, input CreateInviteLinkInput) error {
+	s.createCalls++
+	s.createInput = input
+	return nil
+}
+
+func (s *inviteLinkGatewayStub) RevokeInviteLink(_ context.Context, _ string, input RevokeInviteLinkInput) error {
+	s.revokeInput = input
+	return nil
+}
+
+func (s *inviteLinkGatewayStub) ApproveInviteLinkClaim(_ context.Context, _ string, input InviteLinkClaimDecisionInput) error {
+	s.approveInput = input
+	return nil
+}
+
+func (s *inviteLinkGatewayStub) DeclineInviteLinkClaim(context.Context, string, InviteLinkClaimDecisionInput) error {
+	return nil
+}
+
+func newInviteLinkTestService(t *testing.T, allowed bool) (CampaignInviteLinkService, *inviteLinkGatewayStub) {
+	t.Helper()
+	links := &inviteLinkGatewayStub{}
+	svc, err := NewInviteLinkService(InviteLinkServiceConfig{Links: links}, fakeAuthzGateway{
+		decision: AuthorizationDecision{Evaluated: true, Allowed: allowed},
+	})
+	if err != nil {
+		t.Fatalf("NewInviteLinkService() error = %v", err)
+	}
+	return svc, links
+}
+
+func TestCreateInviteLinkDefaultsRoleAndValidatesLimits(t *testing.T) {
+	t.Parallel()
+
+	svc, links := newInviteLinkTestService(t, true)
+	if err := svc.CreateInviteLink(context.Background(), "c1", CreateInviteLinkInput{MaxUses: 5, ExpiresInHours: 24}); err != nil {
+		t.Fatalf("CreateInviteLink() error = %v", err)
+	}
+	if links.createInput.Role != InviteLinkRolePlayer {
+		t.Fatalf("role = %q, want %q", links.createInput.Role, InviteLinkRolePlayer)
+	}
+
+	for name, input := range map[string]CreateInviteLinkInput{
+		"role":     {Role: "owner"},
+		"max uses": {MaxUses: inviteLinkMaxUses + 1},
+		"expiry":   {ExpiresInHours: -1},
+	} {
+		err := svc.CreateInviteLink(context.Background(), "c1", input)
+		var appErr apperrors.Error
+		if !errors.As(err, &appErr) || appErr.Kind != apperrors.KindInvalidInput {
+			t.Fatalf("%s: CreateInviteLink() error = %v, want invalid input", name, err)
+		}
+	}
+	if links.createCalls != 1 {
+		t.Fatalf("create calls = %d, want 1", links.createCalls)
+	}
+}
+
+func TestInviteLinkServiceRequiresManageInvitePolicy(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newInviteLinkTestService(t, false)
+	if _, err := svc.CampaignInviteLinks(context.Background(), "c1"); err == nil {
+		t.Fatal("CampaignInviteLinks() error = nil, want forbidden")
+	}
+	if err := svc.RevokeInviteLink(context.Background(), "c1", RevokeInviteLinkInput{LinkID: "link-1"}); err == nil {
+		t.Fatal("RevokeInviteLink() error = nil, want forbidden")
+	}
+}
+
+func TestInviteLinkClaimDecisionTrimsAndRequiresClaimID(t *testing.T) {
+	t.Parallel()
+
+	svc, links := newInviteLinkTestService(t, true)
+	if err := svc.ApproveInviteLinkClaim(context.Background(), "c1", InviteLinkClaimDecisionInput{ClaimID: " claim-1 "}); err != nil {
+		t.Fatalf("ApproveInviteLinkClaim() error = %v", err)
+	}
+	if links.approveInput.ClaimID != "claim-1" {
+		t.Fatalf("claim id = %q, want %q", links.approveInput.ClaimID, "claim-1")
+	}
+	if err := svc.DeclineInviteLinkClaim(context.Background(), "c1", InviteLinkClaimDecisionInput{ClaimID: " "}); err == nil {
+		t.Fatal("DeclineInviteLinkClaim() error = nil, want invalid input")
+	}
+}
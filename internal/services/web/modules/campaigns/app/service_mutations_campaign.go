@@ -69,7 +69,7 @@ func (s configurationService) configurationWorkspace(ctx context.Context, campai
 
 // buildCampaignUpdatePatch maps validated campaign edit form input into a mutation patch.
 func buildCampaignUpdatePatch(input UpdateCampaignInput, current CampaignWorkspace) (UpdateCampaignInput, bool, error) {
-	patch := UpdateCampaignInput{}
+	patch := UpdateCampaignInput{ExpectedSeq: input.ExpectedSeq}
 	changed := false
 
 	if input.Name != nil {
@@ -1179,3 +1179,27 @@ func TestCreateCampaignForwardsInputToGateway(t *testing.T) {
 		t.Fatalf("ThemePrompt = %q, want %q", gateway.lastCreateInput.ThemePrompt, input.ThemePrompt)
 	}
 }
+
+func TestRestoreCampaignAIArtifactRevisionValidatesAndDelegates(t *testing.T) {
+	t.Parallel()
+
+	gateway := &campaignGatewayStub{
+		authorizationDecision: AuthorizationDecision{Evaluated: true, Allowed: true},
+	}
+	svc := newService(gateway)
+
+	err := svc.restoreCampaignAIArtifactRevision(context.Background(), "c1", RestoreCampaignAIArtifactRevisionInput{Path: "memory.md"})
+	if got := apperrors.HTTPStatus(err); got != http.StatusBadRequest {
+		t.Fatalf("HTTPStatus(err) = %d, want %d", got, http.StatusBadRequest)
+	}
+	if len(gateway.calls) != 0 {
+		t.Fatalf("mutation gateway calls = %v, want none", gateway.calls)
+	}
+
+	if err := svc.restoreCampaignAIArtifactRevision(context.Background(), "c1", RestoreCampaignAIArtifactRevisionInput{Path: " memory.md ", Revision: 2}); err != nil {
+		t.Fatalf("restoreCampaignAIArtifactRevision() error = %v", err)
+	}
+	if gateway.lastRestoreAIArtifactPath != "memory.md" || gateway.lastRestoreAIArtifactRevision != 2 {
+		t.Fatalf("restore = (%q, %d), want (memory.md, 2)", gateway.lastRestoreAIArtifactPath, gateway.lastRestoreAIArtifactRevision)
+	}
+}
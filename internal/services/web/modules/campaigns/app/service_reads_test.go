@@ -992,3 +992,63 @@ func TestCampaignCharacterOwnershipResolvesManagerOptions(t *testing.T) {
 		t.Fatalf("ownership.Options[0] = %+v, want selected unassigned option", ownership.Options[0])
 	}
 }
+
+func TestCampaignAIArtifactHistoryDefaultsToWritableArtifactAndLoadsDiff(t *testing.T) {
+	t.Parallel()
+
+	gateway := &campaignGatewayStub{
+		campaignAIArtifacts: []CampaignAIArtifact{
+			{Path: "skills.md", ReadOnly: true},
+			{Path: "memory.md"},
+		},
+		campaignAIArtifactRevisions: []CampaignAIArtifactRevision{{Number: 2}, {Number: 1}},
+		authorizationDecision:       AuthorizationDecision{Evaluated: true, Allowed: true},
+	}
+	svc := newService(gateway)
+
+	history, err := svc.campaignAIArtifactHistory(context.Background(), "c-1", CampaignAIArtifactHistoryQuery{Path: "missing.md", From: 1})
+	if err != nil {
+		t.Fatalf("campaignAIArtifactHistory() error = %v", err)
+	}
+	if history.SelectedPath != "memory.md" {
+		t.Fatalf("SelectedPath = %q, want %q", history.SelectedPath, "memory.md")
+	}
+	if len(history.Revisions) != 2 {
+		t.Fatalf("len(Revisions) = %d, want 2", len(history.Revisions))
+	}
+	if history.Diff == nil || history.Diff.From != 1 || history.Diff.To != 0 {
+		t.Fatalf("Diff = %#v, want from 1 against latest", history.Diff)
+	}
+}
+
+func TestCampaignAIArtifactHistoryRequiresManageAccess(t *testing.T) {
+	t.Parallel()
+
+	gateway := &campaignGatewayStub{
+		authorizationDecision: AuthorizationDecision{Evaluated: true, Allowed: false},
+	}
+	svc := newService(gateway)
+
+	_, err := svc.campaignAIArtifactHistory(context.Background(), "c-1", CampaignAIArtifactHistoryQuery{})
+	if got := apperrors.HTTPStatus(err); got != http.StatusForbidden {
+		t.Fatalf("HTTPStatus(err) = %d, want %d", got, http.StatusForbidden)
+	}
+}
+
+func TestCampaignAIArtifactHistoryMarksUnavailableWithoutFailingPage(t *testing.T) {
+	t.Parallel()
+
+	gateway := &campaignGatewayStub{
+		campaignAIArtifactsErr: apperrors.E(apperrors.KindUnavailable, "ai unavailable"),
+		authorizationDecision:  AuthorizationDecision{Evaluated: true, Allowed: true},
+	}
+	svc := newService(gateway)
+
+	history, err := svc.campaignAIArtifactHistory(context.Background(), "c-1", CampaignAIArtifactHistoryQuery{})
+	if err != nil {
+		t.Fatalf("campaignAIArtifactHistory() error = %v, want nil", err)
+	}
+	if !history.Unavailable {
+		t.Fatalf("history = %#v, want unavailable", history)
+	}
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+const (
+	// campaignListingMaxOpenSeats mirrors the discovery service seat bound.
+	campaignListingMaxOpenSeats = 12
+	// campaignListingMaxTitleLength mirrors the discovery service title bound.
+	campaignListingMaxTitleLength = 120
+)
+
+// CampaignListing loads the campaign's open-table listing. Only invite
+// managers may see or change recruitment state.
+func (s recruitmentService) CampaignListing(ctx context.Context, campaignID string) (CampaignListing, error) {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return CampaignListing{}, err
+	}
+	return s.recruitment.CampaignListing(ctx, campaignID)
+}
+
+// PublishCampaignListing validates listing copy before advertising open seats.
+func (s recruitmentService) PublishCampaignListing(ctx context.Context, campaignID string, input PublishCampaignListingInput) error {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return err
+	}
+	input = PublishCampaignListingInput{
+		Title:       strings.TrimSpace(input.Title),
+		Description: strings.TrimSpace(input.Description),
+		Schedule:    strings.TrimSpace(input.Schedule),
+		Tone:        strings.TrimSpace(input.Tone),
+		SafetyNotes: strings.TrimSpace(input.SafetyNotes),
+		OpenSeats:   input.OpenSeats,
+	}
+	if input.Title == "" || input.Description == "" {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.campaign_listing_title_and_description_are_required", "listing title and description are required")
+	}
+	if utf8.RuneCountInString(input.Title) > campaignListingMaxTitleLength {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.campaign_listing_title_is_too_long", "listing title is too long")
+	}
+	if input.OpenSeats < 1 || input.OpenSeats > campaignListingMaxOpenSeats {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.campaign_listing_open_seats_is_invalid", "listing open seats is invalid")
+	}
+	return s.recruitment.PublishCampaignListing(ctx, campaignID, input)
+}
+
+// CloseCampaignListing stops advertising the campaign's open seats.
+func (s recruitmentService) CloseCampaignListing(ctx context.Context, campaignID string) error {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return err
+	}
+	return s.recruitment.CloseCampaignListing(ctx, campaignID)
+}
+
+// CampaignApplications lists applications submitted to the campaign.
+func (s recruitmentService) CampaignApplications(ctx context.Context, campaignID string) ([]CampaignApplication, error) {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return nil, err
+	}
+	return s.recruitment.CampaignApplications(ctx, campaignID)
+}
+
+// AcceptCampaignApplication reserves a seat and invites the applicant.
+func (s recruitmentService) AcceptCampaignApplication(ctx context.Context, campaignID string, input CampaignApplicationDecisionInput) error {
+	input, err := s.normalizeApplicationDecision(ctx, campaignID, input)
+	if err != nil {
+		return err
+	}
+	return s.recruitment.AcceptCampaignApplication(ctx, campaignID, input)
+}
+
+// DeclineCampaignApplication rejects a pending application.
+func (s recruitmentService) DeclineCampaignApplication(ctx context.Context, campaignID string, input CampaignApplicationDecisionInput) error {
+	input, err := s.normalizeApplicationDecision(ctx, campaignID, input)
+	if err != nil {
+		return err
+	}
+	return s.recruitment.DeclineCampaignApplication(ctx, campaignID, input)
+}
+
+// normalizeApplicationDecision authorizes application decisions and rejects
+// blank forms.
+func (s recruitmentService) normalizeApplicationDecision(ctx context.Context, campaignID string, input CampaignApplicationDecisionInput) (CampaignApplicationDecisionInput, error) {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageInvite); err != nil {
+		return CampaignApplicationDecisionInput{}, err
+	}
+	applicationID := strings.TrimSpace(input.ApplicationID)
+	if applicationID == "" {
+		return CampaignApplicationDecisionInput{}, apperrors.EK(apperrors.KindInvalidInput, "error.web.message.campaign_application_id_is_required", "campaign application id is required")
+	}
+	return CampaignApplicationDecisionInput{ApplicationID: applicationID}, nil
+}
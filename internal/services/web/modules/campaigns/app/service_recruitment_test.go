@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+type recruitmentGatewayStub struct {
+	publishInput PublishCampaignListingInput
+	publishCalls int
+	acceptInput  CampaignApplicationDecisionInput
+}
+
+func (s *recruitmentGatewayStub) CampaignListing(context.Context, string) (CampaignListing, error) {
+	return CampaignListing{Published: true, Title: "Open Table", OpenSeats: 2}, nil
+}
+
+func (s *recruitmentGatewayStub) PublishCampaignListing(_ context.Context, _ string, input PublishCampaignListingInput) error {
+	s.publishCalls++
+	s.publishInput = input
+	return nil
+}
+
+func (s *recruitmentGatewayStub) CloseCampaignListing(context.Context, string) error {
+	return nil
+}
+
+func (s *recruitmentGatewayStub) CampaignApplications(context.Context, string) ([]CampaignApplication, error) {
+	return []CampaignApplication{{ID: "app-1"}}, nil
+}
+
+func (s *recruitmentGatewayStub) AcceptCampaignApplication(_ context.Context, _ string, input CampaignApplicationDecisionInput) error {
+	s.acceptInput = input
+	return nil
+}
+
+func (s *recruitmentGatewayStub) DeclineCampaignApplication(context.Context, string, CampaignApplicationDecisionInput) error {
+	return nil
+}
+
+func newRecruitmentTestService(t *testing.T, allowed bool) (CampaignRecruitmentService, *recruitmentGatewayStub) {
+	t.Helper()
+	recruitment := &recruitmentGatewayStub{}
+	svc, err := NewRecruitmentService(RecruitmentServiceConfig{Recruitment: recruitment}, fakeAuthzGateway{
+		decision: AuthorizationDecision{Evaluated: true, Allowed: allowed},
+	})
+	if err != nil {
+		t.Fatalf("NewRecruitmentService() error = %v", err)
+	}
+	return svc, recruitment
+}
+
+func TestPublishCampaignListingTrimsAndValidatesInput(t *testing.T) {
+	t.Parallel()
+
+	svc, recruitment := newRecruitmentTestService(t, true)
+	if err := svc.PublishCampaignListing(context.Background(), "c1", PublishCampaignListingInput{
+		Title:       " Night Market ",
+		Description: "Heists in a floating city",
+		OpenSeats:   2,
+	}); err != nil {
+		t.Fatalf("PublishCampaignListing() error = %v", err)
+	}
+	if recruitment.publishInput.Title != "Night Market" {
+		t.Fatalf("title = %q, want trimmed", recruitment.publishInput.Title)
+	}
+
+	for name, input := range map[string]PublishCampaignListingInput{
+		"title":      {Description: "d", OpenSeats: 1},
+		"seats low":  {Title: "t", Description: "d"},
+		"seats high": {Title: "t", Description: "d", OpenSeats: campaignListingMaxOpenSeats + 1},
+	} {
+		err := svc.PublishCampaignListing(context.Background(), "c1", input)
+		var appErr apperrors.Error
+		if !errors.As(err, &appErr) || appErr.Kind != apperrors.KindInvalidInput {
+			t.Fatalf("%s: PublishCampaignListing() error = %v, want invalid input", name, err)
+		}
+	}
+	if recruitment.publishCalls != 1 {
+		t.Fatalf("publish calls = %d, want 1", recruitment.publishCalls)
+	}
+}
+
+func TestRecruitmentServiceRequiresManageInvitePolicy(t *testing.T) {
+	t.Parallel()
+
+	svc, _ := newRecruitmentTestService(t, false)
+	if _, err := svc.CampaignApplications(context.Background(), "c1"); err == nil {
+		t.Fatal("CampaignApplications() error = nil, want forbidden")
+	}
+	if err := svc.CloseCampaignListing(context.Background(), "c1"); err == nil {
+		t.Fatal("CloseCampaignListing() error = nil, want forbidden")
+	}
+}
+
+func TestCampaignApplicationDecisionTrimsAndRequiresID(t *testing.T) {
+	t.Parallel()
+
+	svc, recruitment := newRecruitmentTestService(t, true)
+	if err := svc.AcceptCampaignApplication(context.Background(), "c1", CampaignApplicationDecisionInput{ApplicationID: " app-1 "}); err != nil {
+		t.Fatalf("AcceptCampaignApplication() error = %v", err)
+	}
+	if recruitment.acceptInput.ApplicationID != "app-1" {
+		t.Fatalf("application id = %q, want %q", recruitment.acceptInput.ApplicationID, "app-1")
+	}
+	if err := svc.DeclineCampaignApplication(context.Background(), "c1", CampaignApplicationDecisionInput{}); err == nil {
+		t.Fatal("DeclineCampaignApplication() error = nil, want invalid input")
+	}
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+// SessionRecapDraft loads the pending or published recap draft for one
+// session. Only session managers may review drafts.
+func (s sessionRecapService) SessionRecapDraft(ctx context.Context, campaignID, sessionID string) (CampaignSessionRecapDraft, bool, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	sessionID = strings.TrimSpace(sessionID)
+	if campaignID == "" || sessionID == "" {
+		return CampaignSessionRecapDraft{}, false, nil
+	}
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageSession); err != nil {
+		return CampaignSessionRecapDraft{}, false, err
+	}
+	return s.recaps.SessionRecapDraft(ctx, campaignID, sessionID)
+}
+
+// UpdateSessionRecapDraft saves GM edits without publishing the recap.
+func (s sessionRecapService) UpdateSessionRecapDraft(ctx context.Context, campaignID string, input SessionRecapDraftInput) error {
+	input, err := s.normalizeRecapInput(ctx, campaignID, input)
+	if err != nil {
+		return err
+	}
+	return s.recaps.UpdateSessionRecapDraft(ctx, campaignID, input)
+}
+
+// PublishSessionRecap records the approved recap on the session.
+func (s sessionRecapService) PublishSessionRecap(ctx context.Context, campaignID string, input SessionRecapDraftInput) error {
+	input, err := s.normalizeRecapInput(ctx, campaignID, input)
+	if err != nil {
+		return err
+	}
+	return s.recaps.PublishSessionRecap(ctx, campaignID, input)
+}
+
+// normalizeRecapInput authorizes recap edits and rejects blank forms.
+func (s sessionRecapService) normalizeRecapInput(ctx context.Context, campaignID string, input SessionRecapDraftInput) (SessionRecapDraftInput, error) {
+	if err := s.auth.requirePolicy(ctx, campaignID, policyManageSession); err != nil {
+		return SessionRecapDraftInput{}, err
+	}
+	input.SessionID = strings.TrimSpace(input.SessionID)
+	input.Markdown = strings.TrimSpace(input.Markdown)
+	if input.SessionID == "" {
+		return SessionRecapDraftInput{}, apperrors.EK(apperrors.KindInvalidInput, "error.web.message.session_id_is_required", "session id is required")
+	}
+	if input.Markdown == "" {
+		return SessionRecapDraftInput{}, apperrors.EK(apperrors.KindInvalidInput, "error.web.message.session_recap_is_required", "session recap is required")
+	}
+	return input, nil
+}
@@ -44,6 +44,12 @@ type campaignGatewayStub struct {
 	updateCampaignErr                  error
 	lastUpdateCampaignInput            UpdateCampaignInput
 	updateCampaignAIBindingErr         error
+	campaignAIArtifacts                []CampaignAIArtifact
+	campaignAIArtifactsErr             error
+	campaignAIArtifactRevisions        []CampaignAIArtifactRevision
+	campaignAIArtifactDiff             CampaignAIArtifactDiff
+	lastRestoreAIArtifactPath          string
+	lastRestoreAIArtifactRevision      int
 	lastUpdateCampaignAIBindingInput   UpdateCampaignAIBindingInput
 	authorizationDecision              AuthorizationDecision
 	authorizationErr                   error
@@ -127,6 +133,23 @@ func (f *campaignGatewayStub) CampaignAIAgents(context.Context) ([]CampaignAIAge
 	return f.campaignAIAgents, nil
 }
 
+func (f *campaignGatewayStub) CampaignAIArtifacts(context.Context, string) ([]CampaignAIArtifact, error) {
+	if f.campaignAIArtifactsErr != nil {
+		return nil, f.campaignAIArtifactsErr
+	}
+	return f.campaignAIArtifacts, nil
+}
+
+func (f *campaignGatewayStub) CampaignAIArtifactRevisions(context.Context, string, string) ([]CampaignAIArtifactRevision, error) {
+	return f.campaignAIArtifactRevisions, nil
+}
+
+func (f *campaignGatewayStub) CampaignAIArtifactDiff(_ context.Context, _ string, _ string, from int, to int) (CampaignAIArtifactDiff, error) {
+	diff := f.campaignAIArtifactDiff
+	diff.From, diff.To = from, to
+	return diff, nil
+}
+
 func (f *campaignGatewayStub) CampaignParticipants(context.Context, string) ([]CampaignParticipant, error) {
 	if f.campaignParticipantsErr != nil {
 		return nil, f.campaignParticipantsErr
@@ -249,6 +272,13 @@ func (f *campaignGatewayStub) UpdateCampaignAIBinding(_ context.Context, _ strin
 	return f.updateCampaignAIBindingErr
 }
 
+func (f *campaignGatewayStub) RestoreCampaignAIArtifactRevision(_ context.Context, _ string, path string, revision int) error {
+	f.lastRestoreAIArtifactPath = path
+	f.lastRestoreAIArtifactRevision = revision
+	f.calls = append(f.calls, "restore-ai-artifact-revision")
+	return nil
+}
+
 func (f *campaignGatewayStub) StartSession(_ context.Context, _ string, input StartSessionInput) error {
 	f.lastStartSessionInput = input
 	f.calls = append(f.calls, "start")
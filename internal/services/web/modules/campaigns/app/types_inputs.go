@@ -22,6 +22,9 @@ type UpdateCampaignInput struct {
 	Name        *string
 	ThemePrompt *string
 	Locale      *string
+	// ExpectedSeq, when positive, rejects the update if the campaign journal
+	// moved past the head the edit form was rendered from.
+	ExpectedSeq uint64
 }
 
 // CreateCampaignResult stores create-campaign response values.
@@ -46,6 +49,12 @@ type EndSessionInput struct {
 	SessionID string
 }
 
+// SessionRecapDraftInput stores session recap review form values.
+type SessionRecapDraftInput struct {
+	SessionID string
+	Markdown  string
+}
+
 // CreateInviteInput stores create-invite form values.
 type CreateInviteInput struct {
 	ParticipantID     string
@@ -71,6 +80,41 @@ type RevokeInviteInput struct {
 	InviteID string
 }
 
+// CreateInviteLinkInput stores invite-link create form values. Zero MaxUses
+// and ExpiresInHours mean unlimited.
+type CreateInviteLinkInput struct {
+	Role             string
+	MaxUses          int
+	ExpiresInHours   int
+	RequiresApproval bool
+}
+
+// RevokeInviteLinkInput stores invite-link revoke form values.
+type RevokeInviteLinkInput struct {
+	LinkID string
+}
+
+// InviteLinkClaimDecisionInput stores the claim a GM approves or declines.
+type InviteLinkClaimDecisionInput struct {
+	ClaimID string
+}
+
+// PublishCampaignListingInput stores open-table listing form values.
+type PublishCampaignListingInput struct {
+	Title       string
+	Description string
+	Schedule    string
+	Tone        string
+	SafetyNotes string
+	OpenSeats   int
+}
+
+// CampaignApplicationDecisionInput stores the application a GM accepts or
+// declines.
+type CampaignApplicationDecisionInput struct {
+	ApplicationID string
+}
+
 // CreateCharacterInput stores create-character form values.
 type CreateCharacterInput struct {
 	Name     string
@@ -92,6 +136,12 @@ type UpdateCampaignAIBindingInput struct {
 	AIAgentID string
 }
 
+// RestoreCampaignAIArtifactRevisionInput stores AI artifact rollback form values.
+type RestoreCampaignAIArtifactRevisionInput struct {
+	Path     string
+	Revision int
+}
+
 // CreateCharacterResult stores create-character response values.
 type CreateCharacterResult struct {
 	CharacterID string
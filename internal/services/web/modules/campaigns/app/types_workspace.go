@@ -30,6 +30,7 @@ type CampaignWorkspace struct {
 	CharacterCount   string `json:"characterCount"`
 	CoverPreviewURL  string `json:"coverPreviewUrl"`
 	CoverImageURL    string `json:"coverImageUrl"`
+	HeadSeq          uint64 `json:"headSeq"`
 }
 
 // CampaignParticipant stores participant details used by campaign participants pages.
@@ -113,6 +114,52 @@ type CampaignAIBindingSettings struct {
 	Options     []CampaignAIAgentOption `json:"options"`
 }
 
+// CampaignAIArtifact stores one AI GM artifact summary for history views.
+type CampaignAIArtifact struct {
+	Path      string `json:"path"`
+	ReadOnly  bool   `json:"readOnly"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// CampaignAIArtifactRevision stores one recorded AI GM artifact write.
+type CampaignAIArtifactRevision struct {
+	Number     int    `json:"number"`
+	AuthorKind string `json:"authorKind"`
+	AuthorID   string `json:"authorId"`
+	SizeBytes  int    `json:"sizeBytes"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// CampaignAIArtifactDiffLine stores one line of a revision comparison.
+type CampaignAIArtifactDiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// CampaignAIArtifactDiff stores the comparison between two artifact revisions.
+type CampaignAIArtifactDiff struct {
+	From  int                          `json:"from"`
+	To    int                          `json:"to"`
+	Lines []CampaignAIArtifactDiffLine `json:"lines"`
+}
+
+// CampaignAIArtifactHistoryQuery selects the artifact and revisions a GM is
+// inspecting on the history page.
+type CampaignAIArtifactHistoryQuery struct {
+	Path string
+	From int
+	To   int
+}
+
+// CampaignAIArtifactHistory stores dedicated AI artifact history page state.
+type CampaignAIArtifactHistory struct {
+	Unavailable  bool                         `json:"unavailable"`
+	Artifacts    []CampaignAIArtifact         `json:"artifacts"`
+	SelectedPath string                       `json:"selectedPath"`
+	Revisions    []CampaignAIArtifactRevision `json:"revisions"`
+	Diff         *CampaignAIArtifactDiff      `json:"diff,omitempty"`
+}
+
 // CampaignCharacter stores character details used by campaign characters pages.
 type CampaignCharacter struct {
 	ID                 string                               `json:"id"`
@@ -174,6 +221,25 @@ type CampaignSession struct {
 	EndedAt   string `json:"endedAt"`
 }
 
+// CampaignSessionRecapDraft stores the AI-drafted recap a GM reviews before
+// it is recorded on the session.
+type CampaignSessionRecapDraft struct {
+	Status    string `json:"status"`
+	Markdown  string `json:"markdown"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// Published reports whether the draft was already recorded on the session.
+func (d CampaignSessionRecapDraft) Published() bool {
+	return d.Status == SessionRecapDraftStatusPublished
+}
+
+// Session recap draft statuses mirrored from the AI service.
+const (
+	SessionRecapDraftStatusPending   = "pending"
+	SessionRecapDraftStatusPublished = "published"
+)
+
 // CampaignSessionReadinessBlocker stores one session-start readiness blocker.
 type CampaignSessionReadinessBlocker struct {
 	Code     string            `json:"code"`
@@ -198,6 +264,81 @@ type CampaignInvite struct {
 	Status            string `json:"status"`
 }
 
+// CampaignInviteLink stores one shareable invite link and its usage state.
+type CampaignInviteLink struct {
+	ID               string `json:"id"`
+	Role             string `json:"role"`
+	MaxUses          int    `json:"maxUses"`
+	UseCount         int    `json:"useCount"`
+	ExpiresAt        string `json:"expiresAt"`
+	RequiresApproval bool   `json:"requiresApproval"`
+	Status           string `json:"status"`
+	CreatedAt        string `json:"createdAt"`
+}
+
+// CampaignInviteLinkClaim stores one claim made through an invite link.
+type CampaignInviteLinkClaim struct {
+	ID            string `json:"id"`
+	LinkID        string `json:"linkId"`
+	UserID        string `json:"userId"`
+	Username      string `json:"username"`
+	ParticipantID string `json:"participantId"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// Invite link statuses. Expired and exhausted are derived from expiry and
+// usage limits on otherwise active links.
+const (
+	InviteLinkStatusActive    = "active"
+	InviteLinkStatusRevoked   = "revoked"
+	InviteLinkStatusExpired   = "expired"
+	InviteLinkStatusExhausted = "exhausted"
+)
+
+// Invite link claim statuses mirrored from the invite service.
+const (
+	InviteLinkClaimStatusPending  = "pending"
+	InviteLinkClaimStatusJoined   = "joined"
+	InviteLinkClaimStatusDeclined = "declined"
+)
+
+// Invite link seat roles.
+const (
+	InviteLinkRolePlayer = "player"
+	InviteLinkRoleGM     = "gm"
+)
+
+// CampaignListing stores a campaign's open-table listing. Published is false
+// when the campaign is not advertising seats.
+type CampaignListing struct {
+	Published   bool   `json:"published"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Schedule    string `json:"schedule"`
+	Tone        string `json:"tone"`
+	SafetyNotes string `json:"safetyNotes"`
+	OpenSeats   int    `json:"openSeats"`
+}
+
+// CampaignApplication stores one player's application to an open table.
+type CampaignApplication struct {
+	ID               string `json:"id"`
+	ApplicantUserID  string `json:"applicantUserId"`
+	ApplicantName    string `json:"applicantName"`
+	Message          string `json:"message"`
+	CharacterConcept string `json:"characterConcept"`
+	Status           string `json:"status"`
+	CreatedAt        string `json:"createdAt"`
+}
+
+// Campaign application statuses mirrored from the invite service.
+const (
+	CampaignApplicationStatusPending  = "pending"
+	CampaignApplicationStatusAccepted = "accepted"
+	CampaignApplicationStatusDeclined = "declined"
+)
+
 // InviteUserSearchResult stores one invite typeahead option.
 type InviteUserSearchResult struct {
 	UserID        string `json:"user_id"`
@@ -43,6 +43,21 @@ func (unavailableGateway) CampaignAIAgents(context.Context) ([]CampaignAIAgentOp
 	return nil, apperrors.E(apperrors.KindUnavailable, "campaign ai agents are not configured")
 }
 
+// CampaignAIArtifacts centralizes this web behavior in one helper seam.
+func (unavailableGateway) CampaignAIArtifacts(context.Context, string) ([]CampaignAIArtifact, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "campaign ai artifacts are not configured")
+}
+
+// CampaignAIArtifactRevisions centralizes this web behavior in one helper seam.
+func (unavailableGateway) CampaignAIArtifactRevisions(context.Context, string, string) ([]CampaignAIArtifactRevision, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "campaign ai artifacts are not configured")
+}
+
+// CampaignAIArtifactDiff centralizes this web behavior in one helper seam.
+func (unavailableGateway) CampaignAIArtifactDiff(context.Context, string, string, int, int) (CampaignAIArtifactDiff, error) {
+	return CampaignAIArtifactDiff{}, apperrors.E(apperrors.KindUnavailable, "campaign ai artifacts are not configured")
+}
+
 // CampaignParticipants centralizes this web behavior in one helper seam.
 func (unavailableGateway) CampaignParticipants(context.Context, string) ([]CampaignParticipant, error) {
 	return nil, apperrors.E(apperrors.KindUnavailable, "campaigns service is not configured")
@@ -113,6 +128,11 @@ func (unavailableGateway) UpdateCampaignAIBinding(context.Context, string, Updat
 	return apperrors.E(apperrors.KindUnavailable, "campaigns service is not configured")
 }
 
+// RestoreCampaignAIArtifactRevision applies this package workflow transition.
+func (unavailableGateway) RestoreCampaignAIArtifactRevision(context.Context, string, string, int) error {
+	return apperrors.E(apperrors.KindUnavailable, "campaign ai artifacts are not configured")
+}
+
 // StartSession applies this package workflow transition.
 func (unavailableGateway) StartSession(context.Context, string, StartSessionInput) error {
 	return apperrors.E(apperrors.KindUnavailable, "campaigns service is not configured")
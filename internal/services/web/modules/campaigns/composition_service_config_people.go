@@ -115,8 +115,8 @@ func newOverviewGatewayDeps(deps Dependencies) campaigngateway.OverviewGatewayDe
 		Participants:          campaigngateway.ParticipantReadDeps{Participant: deps.ParticipantClient},
 		Workspace:             campaigngateway.WorkspaceReadDeps{Campaign: deps.CampaignClient},
 		Authorization:         campaigngateway.AuthorizationDeps{Client: deps.AuthorizationClient},
-		AutomationRead:        campaigngateway.AutomationReadDeps{Agent: deps.AgentClient},
-		AutomationMutation:    campaigngateway.AutomationMutationDeps{Campaign: deps.CampaignClient},
+		AutomationRead:        campaigngateway.AutomationReadDeps{Agent: deps.AgentClient, Artifact: deps.CampaignArtifactHistoryClient},
+		AutomationMutation:    campaigngateway.AutomationMutationDeps{Campaign: deps.CampaignClient, Artifact: deps.CampaignArtifactHistoryClient},
 		ConfigurationMutation: campaigngateway.ConfigurationMutationDeps{Campaign: deps.CampaignClient},
 	}
 }
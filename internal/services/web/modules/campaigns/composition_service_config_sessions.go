@@ -14,6 +14,7 @@ func newSessionSurfaceConfig(config CompositionConfig) campaignsessions.ServiceC
 		Characters:    newCharacterReadServiceConfig(config),
 		Mutation:      newSessionMutationServiceConfig(config),
 		Participants:  newParticipantReadServiceConfig(config),
+		Recaps:        newSessionRecapServiceConfig(config),
 		Authorization: newPageAuthorizationGateway(config),
 	}
 }
@@ -24,6 +25,8 @@ func newInviteSurfaceConfig(config CompositionConfig) campaigninvites.ServiceCon
 	return campaigninvites.ServiceConfig{
 		Read:            newInviteReadServiceConfig(config),
 		Mutation:        newInviteMutationServiceConfig(config),
+		Links:           newInviteLinkServiceConfig(config),
+		Recruitment:     newRecruitmentServiceConfig(config),
 		ParticipantRead: newParticipantReadServiceConfig(config),
 		Authorization:   campaigngateway.NewAuthorizationGateway(config.Gateway.Invites.Authorization),
 	}
@@ -45,6 +48,14 @@ func newSessionMutationServiceConfig(config CompositionConfig) campaignapp.Sessi
 	}
 }
 
+// newSessionRecapServiceConfig keeps AI recap review composition with the
+// session capability family.
+func newSessionRecapServiceConfig(config CompositionConfig) campaignapp.SessionRecapServiceConfig {
+	return campaignapp.SessionRecapServiceConfig{
+		Recaps: campaigngateway.NewSessionRecapGateway(config.Gateway.Sessions.Recaps),
+	}
+}
+
 // newInviteReadServiceConfig keeps invite read/search wiring local to the
 // invite capability family.
 func newInviteReadServiceConfig(config CompositionConfig) campaignapp.InviteReadServiceConfig {
@@ -61,6 +72,22 @@ func newInviteMutationServiceConfig(config CompositionConfig) campaignapp.Invite
 	}
 }
 
+// newInviteLinkServiceConfig keeps shareable invite-link wiring local to the
+// invite capability family.
+func newInviteLinkServiceConfig(config CompositionConfig) campaignapp.InviteLinkServiceConfig {
+	return campaignapp.InviteLinkServiceConfig{
+		Links: campaigngateway.NewInviteLinkGateway(config.Gateway.Invites.Links),
+	}
+}
+
+// newRecruitmentServiceConfig keeps open-table listing and application wiring
+// local to the invite capability family.
+func newRecruitmentServiceConfig(config CompositionConfig) campaignapp.RecruitmentServiceConfig {
+	return campaignapp.RecruitmentServiceConfig{
+		Recruitment: campaigngateway.NewRecruitmentGateway(config.Gateway.Invites.Recruitment),
+	}
+}
+
 // newConfigurationServiceConfig keeps campaign settings composition distinct
 // from automation and participant wiring.
 func newConfigurationServiceConfig(config CompositionConfig) campaignapp.ConfigurationServiceConfig {
@@ -75,6 +102,10 @@ func newConfigurationServiceConfig(config CompositionConfig) campaignapp.Configu
 func newSessionGatewayDeps(deps Dependencies) campaigngateway.SessionGatewayDeps {
 	return campaigngateway.SessionGatewayDeps{
 		Mutation: campaigngateway.SessionMutationDeps{Session: deps.SessionClient},
+		Recaps: campaigngateway.SessionRecapDeps{
+			Session: deps.SessionRecapClient,
+			Draft:   deps.SessionRecapDraftClient,
+		},
 	}
 }
 
@@ -88,7 +119,14 @@ func newInviteGatewayDeps(deps Dependencies) campaigngateway.InviteGatewayDeps {
 			Social:      deps.SocialClient,
 			Auth:        deps.AuthClient,
 		},
-		Mutation:      campaigngateway.InviteMutationDeps{Invite: deps.InviteClient, Auth: deps.AuthClient},
+		Mutation: campaigngateway.InviteMutationDeps{Invite: deps.InviteClient, Auth: deps.AuthClient},
+		Links:    campaigngateway.InviteLinkDeps{Invite: deps.InviteLinkClient, Auth: deps.AuthClient},
+		Recruitment: campaigngateway.RecruitmentDeps{
+			Listing:      deps.CampaignListingClient,
+			Applications: deps.CampaignApplicationClient,
+			Campaign:     deps.CampaignClient,
+			Auth:         deps.AuthClient,
+		},
 		Participants:  campaigngateway.ParticipantReadDeps{Participant: deps.ParticipantClient},
 		Authorization: campaigngateway.AuthorizationDeps{Client: deps.AuthorizationClient},
 	}
@@ -15,20 +15,26 @@ import (
 
 // Dependencies contains campaign feature clients.
 type Dependencies struct {
-	CampaignClient           CampaignClient
-	DiscoveryClient          campaigngateway.DiscoveryClient
-	AgentClient              campaigngateway.AgentClient
-	CampaignArtifactClient   campaigngateway.CampaignArtifactClient
-	ParticipantClient        ParticipantClient
-	CharacterClient          CharacterClient
-	DaggerheartContentClient campaigngateway.DaggerheartContentClient
-	DaggerheartAssetClient   campaigngateway.DaggerheartAssetClient
-	SessionClient            SessionClient
-	InviteClient             InviteClient
-	SocialClient             campaigngateway.SocialClient
-	AuthClient               campaigngateway.AuthClient
-	AuthorizationClient      campaigngateway.AuthorizationClient
-	ForkClient               campaigngateway.ForkClient
+	CampaignClient                CampaignClient
+	DiscoveryClient               campaigngateway.DiscoveryClient
+	AgentClient                   campaigngateway.AgentClient
+	CampaignArtifactClient        campaigngateway.CampaignArtifactClient
+	CampaignArtifactHistoryClient campaigngateway.CampaignArtifactHistoryClient
+	ParticipantClient             ParticipantClient
+	CharacterClient               CharacterClient
+	DaggerheartContentClient      campaigngateway.DaggerheartContentClient
+	DaggerheartAssetClient        campaigngateway.DaggerheartAssetClient
+	SessionClient                 SessionClient
+	SessionRecapClient            campaigngateway.SessionRecapRecordClient
+	SessionRecapDraftClient       campaigngateway.SessionRecapDraftClient
+	InviteClient                  InviteClient
+	InviteLinkClient              campaigngateway.InviteLinkClient
+	CampaignApplicationClient     campaigngateway.CampaignApplicationClient
+	CampaignListingClient         campaigngateway.CampaignListingClient
+	SocialClient                  campaigngateway.SocialClient
+	AuthClient                    campaigngateway.AuthClient
+	AuthorizationClient           campaigngateway.AuthorizationClient
+	ForkClient                    campaigngateway.ForkClient
 }
 
 // BindAuthDependency wires auth-backed clients into the campaigns dependency set.
@@ -59,6 +65,7 @@ func BindGameDependency(deps *Dependencies, conn *grpc.ClientConn) {
 	deps.DaggerheartContentClient = daggerheartv1.NewDaggerheartContentServiceClient(conn)
 	deps.DaggerheartAssetClient = daggerheartv1.NewDaggerheartAssetServiceClient(conn)
 	deps.SessionClient = statev1.NewSessionServiceClient(conn)
+	deps.SessionRecapClient = statev1.NewSessionServiceClient(conn)
 	deps.AuthorizationClient = statev1.NewAuthorizationServiceClient(conn)
 }
 
@@ -68,6 +75,8 @@ func BindInviteDependency(deps *Dependencies, conn *grpc.ClientConn) {
 		return
 	}
 	deps.InviteClient = invitev1.NewInviteServiceClient(conn)
+	deps.InviteLinkClient = invitev1.NewInviteServiceClient(conn)
+	deps.CampaignApplicationClient = invitev1.NewInviteServiceClient(conn)
 }
 
 // BindDiscoveryDependency wires discovery-backed clients into the campaigns dependency set.
@@ -76,6 +85,7 @@ func BindDiscoveryDependency(deps *Dependencies, conn *grpc.ClientConn) {
 		return
 	}
 	deps.DiscoveryClient = discoveryv1.NewDiscoveryServiceClient(conn)
+	deps.CampaignListingClient = discoveryv1.NewDiscoveryServiceClient(conn)
 }
 
 // BindAIDependency wires AI-backed clients into the campaigns dependency set.
@@ -85,4 +95,6 @@ func BindAIDependency(deps *Dependencies, conn *grpc.ClientConn) {
 	}
 	deps.AgentClient = aiv1.NewAgentServiceClient(conn)
 	deps.CampaignArtifactClient = aiv1.NewCampaignArtifactServiceClient(conn)
+	deps.CampaignArtifactHistoryClient = aiv1.NewCampaignArtifactServiceClient(conn)
+	deps.SessionRecapDraftClient = aiv1.NewSessionRecapServiceClient(conn)
 }
@@ -0,0 +1,166 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+// CampaignAIArtifacts returns the AI GM artifacts stored for one campaign.
+func (g automationReadGateway) CampaignAIArtifacts(ctx context.Context, campaignID string) ([]campaignapp.CampaignAIArtifact, error) {
+	if g.read.Artifact == nil {
+		return nil, campaignAIArtifactClientUnavailable()
+	}
+	resp, err := g.read.Artifact.ListCampaignArtifacts(ctx, &aiv1.ListCampaignArtifactsRequest{CampaignId: strings.TrimSpace(campaignID)})
+	if err != nil {
+		return nil, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_list_ai_artifacts",
+			FallbackMessage: "failed to list AI artifacts",
+		})
+	}
+
+	artifacts := make([]campaignapp.CampaignAIArtifact, 0, len(resp.GetArtifacts()))
+	for _, artifact := range resp.GetArtifacts() {
+		if artifact == nil {
+			continue
+		}
+		path := strings.TrimSpace(artifact.GetPath())
+		if path == "" {
+			continue
+		}
+		artifacts = append(artifacts, campaignapp.CampaignAIArtifact{
+			Path:      path,
+			ReadOnly:  artifact.GetReadOnly(),
+			UpdatedAt: timestampString(artifact.GetUpdatedAt()),
+		})
+	}
+	return artifacts, nil
+}
+
+// CampaignAIArtifactRevisions returns one artifact's revisions newest first.
+func (g automationReadGateway) CampaignAIArtifactRevisions(ctx context.Context, campaignID string, path string) ([]campaignapp.CampaignAIArtifactRevision, error) {
+	if g.read.Artifact == nil {
+		return nil, campaignAIArtifactClientUnavailable()
+	}
+	resp, err := g.read.Artifact.ListCampaignArtifactRevisions(ctx, &aiv1.ListCampaignArtifactRevisionsRequest{
+		CampaignId: strings.TrimSpace(campaignID),
+		Path:       strings.TrimSpace(path),
+	})
+	if err != nil {
+		return nil, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_list_ai_artifact_revisions",
+			FallbackMessage: "failed to list AI artifact revisions",
+		})
+	}
+
+	revisions := make([]campaignapp.CampaignAIArtifactRevision, 0, len(resp.GetRevisions()))
+	for _, revision := range resp.GetRevisions() {
+		if revision == nil {
+			continue
+		}
+		revisions = append(revisions, campaignAIArtifactRevisionFromProto(revision))
+	}
+	return revisions, nil
+}
+
+// CampaignAIArtifactDiff compares two revisions of one artifact.
+func (g automationReadGateway) CampaignAIArtifactDiff(ctx context.Context, campaignID string, path string, from int, to int) (campaignapp.CampaignAIArtifactDiff, error) {
+	if g.read.Artifact == nil {
+		return campaignapp.CampaignAIArtifactDiff{}, campaignAIArtifactClientUnavailable()
+	}
+	resp, err := g.read.Artifact.DiffCampaignArtifactRevisions(ctx, &aiv1.DiffCampaignArtifactRevisionsRequest{
+		CampaignId:   strings.TrimSpace(campaignID),
+		Path:         strings.TrimSpace(path),
+		FromRevision: int32(from),
+		ToRevision:   int32(to),
+	})
+	if err != nil {
+		return campaignapp.CampaignAIArtifactDiff{}, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_diff_ai_artifact_revisions",
+			FallbackMessage: "failed to compare AI artifact revisions",
+		})
+	}
+
+	diff := campaignapp.CampaignAIArtifactDiff{
+		From:  int(resp.GetFrom().GetRevision()),
+		To:    int(resp.GetTo().GetRevision()),
+		Lines: make([]campaignapp.CampaignAIArtifactDiffLine, 0, len(resp.GetLines())),
+	}
+	for _, line := range resp.GetLines() {
+		if line == nil {
+			continue
+		}
+		diff.Lines = append(diff.Lines, campaignapp.CampaignAIArtifactDiffLine{
+			Op:   campaignAIArtifactDiffOp(line.GetOp()),
+			Text: line.GetText(),
+		})
+	}
+	return diff, nil
+}
+
+// RestoreCampaignAIArtifactRevision rolls one artifact back to a recorded revision.
+func (g automationMutationGateway) RestoreCampaignAIArtifactRevision(ctx context.Context, campaignID string, path string, revision int) error {
+	if g.mutation.Artifact == nil {
+		return campaignAIArtifactClientUnavailable()
+	}
+	_, err := g.mutation.Artifact.RestoreCampaignArtifactRevision(ctx, &aiv1.RestoreCampaignArtifactRevisionRequest{
+		CampaignId: strings.TrimSpace(campaignID),
+		Path:       strings.TrimSpace(path),
+		Revision:   int32(revision),
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_restore_ai_artifact_revision",
+			FallbackMessage: "failed to restore AI artifact revision",
+		})
+	}
+	return nil
+}
+
+// campaignAIArtifactClientUnavailable keeps the missing-client error stable
+// across artifact history reads and rollback.
+func campaignAIArtifactClientUnavailable() error {
+	return apperrors.EK(apperrors.KindUnavailable, "error.web.message.ai_artifact_service_client_is_not_configured", "AI artifact service client is not configured")
+}
+
+// campaignAIArtifactRevisionFromProto maps one revision into app state.
+func campaignAIArtifactRevisionFromProto(revision *aiv1.CampaignArtifactRevision) campaignapp.CampaignAIArtifactRevision {
+	return campaignapp.CampaignAIArtifactRevision{
+		Number:     int(revision.GetRevision()),
+		AuthorKind: campaignAIArtifactAuthorKind(revision.GetAuthorKind()),
+		AuthorID:   strings.TrimSpace(revision.GetAuthorId()),
+		SizeBytes:  int(revision.GetSizeBytes()),
+		CreatedAt:  timestampString(revision.GetCreatedAt()),
+	}
+}
+
+// campaignAIArtifactAuthorKind maps proto author kinds to stable view keys.
+func campaignAIArtifactAuthorKind(kind aiv1.CampaignArtifactAuthorKind) string {
+	switch kind {
+	case aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_AI_TURN:
+		return "ai_turn"
+	case aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_USER:
+		return "user"
+	default:
+		return "system"
+	}
+}
+
+// campaignAIArtifactDiffOp maps proto diff operations to stable view keys.
+func campaignAIArtifactDiffOp(op aiv1.CampaignArtifactDiffOp) string {
+	switch op {
+	case aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_INSERT:
+		return "insert"
+	case aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_DELETE:
+		return "delete"
+	default:
+		return "equal"
+	}
+}
@@ -0,0 +1,120 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCampaignAIArtifactHistoryMapsRevisionsAndDiff(t *testing.T) {
+	t.Parallel()
+
+	client := &contractCampaignArtifactHistoryClient{
+		listResp: &aiv1.ListCampaignArtifactsResponse{Artifacts: []*aiv1.CampaignArtifact{
+			nil,
+			{CampaignId: "c1", Path: "memory.md"},
+			{CampaignId: "c1", Path: "skills.md", ReadOnly: true},
+		}},
+		revisionsResp: &aiv1.ListCampaignArtifactRevisionsResponse{Revisions: []*aiv1.CampaignArtifactRevision{
+			{Revision: 2, AuthorKind: aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_AI_TURN, AuthorId: "turn-9", SizeBytes: 14},
+			{Revision: 1, AuthorKind: aiv1.CampaignArtifactAuthorKind_CAMPAIGN_ARTIFACT_AUTHOR_KIND_SYSTEM},
+		}},
+		diffResp: &aiv1.DiffCampaignArtifactRevisionsResponse{
+			From: &aiv1.CampaignArtifactRevision{Revision: 1},
+			To:   &aiv1.CampaignArtifactRevision{Revision: 2},
+			Lines: []*aiv1.CampaignArtifactDiffLine{
+				{Op: aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_DELETE, Text: "old"},
+				{Op: aiv1.CampaignArtifactDiffOp_CAMPAIGN_ARTIFACT_DIFF_OP_INSERT, Text: "new"},
+			},
+		},
+	}
+	read := automationReadGateway{read: AutomationReadDeps{Artifact: client}}
+	mutation := automationMutationGateway{mutation: AutomationMutationDeps{Artifact: client}}
+
+	artifacts, err := read.CampaignAIArtifacts(context.Background(), "c1")
+	if err != nil {
+		t.Fatalf("CampaignAIArtifacts() error = %v", err)
+	}
+	if len(artifacts) != 2 || artifacts[0].Path != "memory.md" || !artifacts[1].ReadOnly {
+		t.Fatalf("artifacts = %#v", artifacts)
+	}
+
+	revisions, err := read.CampaignAIArtifactRevisions(context.Background(), "c1", "memory.md")
+	if err != nil {
+		t.Fatalf("CampaignAIArtifactRevisions() error = %v", err)
+	}
+	if len(revisions) != 2 || revisions[0].AuthorKind != "ai_turn" || revisions[0].AuthorID != "turn-9" || revisions[1].AuthorKind != "system" {
+		t.Fatalf("revisions = %#v", revisions)
+	}
+
+	diff, err := read.CampaignAIArtifactDiff(context.Background(), "c1", "memory.md", 1, 0)
+	if err != nil {
+		t.Fatalf("CampaignAIArtifactDiff() error = %v", err)
+	}
+	if diff.From != 1 || diff.To != 2 || len(diff.Lines) != 2 || diff.Lines[0].Op != "delete" || diff.Lines[1].Op != "insert" {
+		t.Fatalf("diff = %#v", diff)
+	}
+	if client.lastDiffReq.GetFromRevision() != 1 || client.lastDiffReq.GetToRevision() != 0 {
+		t.Fatalf("lastDiffReq = %#v", client.lastDiffReq)
+	}
+
+	if err := mutation.RestoreCampaignAIArtifactRevision(context.Background(), "c1", "memory.md", 1); err != nil {
+		t.Fatalf("RestoreCampaignAIArtifactRevision() error = %v", err)
+	}
+	if client.lastRestoreReq.GetPath() != "memory.md" || client.lastRestoreReq.GetRevision() != 1 {
+		t.Fatalf("lastRestoreReq = %#v", client.lastRestoreReq)
+	}
+
+	client.restoreErr = status.Error(codes.NotFound, "missing")
+	if err := mutation.RestoreCampaignAIArtifactRevision(context.Background(), "c1", "memory.md", 9); apperrors.HTTPStatus(err) != http.StatusNotFound {
+		t.Fatalf("HTTPStatus(err) = %d, want %d", apperrors.HTTPStatus(err), http.StatusNotFound)
+	}
+}
+
+func TestCampaignAIArtifactHistoryUnavailableWithoutClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := automationReadGateway{}.CampaignAIArtifacts(context.Background(), "c1")
+	if got := apperrors.LocalizationKey(err); got != "error.web.message.ai_artifact_service_client_is_not_configured" {
+		t.Fatalf("LocalizationKey(err) = %q", got)
+	}
+	if err := (automationMutationGateway{}).RestoreCampaignAIArtifactRevision(context.Background(), "c1", "memory.md", 1); apperrors.HTTPStatus(err) != http.StatusServiceUnavailable {
+		t.Fatalf("HTTPStatus(err) = %d, want %d", apperrors.HTTPStatus(err), http.StatusServiceUnavailable)
+	}
+}
+
+type contractCampaignArtifactHistoryClient struct {
+	listResp       *aiv1.ListCampaignArtifactsResponse
+	revisionsResp  *aiv1.ListCampaignArtifactRevisionsResponse
+	diffResp       *aiv1.DiffCampaignArtifactRevisionsResponse
+	restoreErr     error
+	lastDiffReq    *aiv1.DiffCampaignArtifactRevisionsRequest
+	lastRestoreReq *aiv1.RestoreCampaignArtifactRevisionRequest
+}
+
+func (c *contractCampaignArtifactHistoryClient) ListCampaignArtifacts(context.Context, *aiv1.ListCampaignArtifactsRequest, ...grpc.CallOption) (*aiv1.ListCampaignArtifactsResponse, error) {
+	return c.listResp, nil
+}
+
+func (c *contractCampaignArtifactHistoryClient) ListCampaignArtifactRevisions(context.Context, *aiv1.ListCampaignArtifactRevisionsRequest, ...grpc.CallOption) (*aiv1.ListCampaignArtifactRevisionsResponse, error) {
+	return c.revisionsResp, nil
+}
+
+func (c *contractCampaignArtifactHistoryClient) DiffCampaignArtifactRevisions(_ context.Context, req *aiv1.DiffCampaignArtifactRevisionsRequest, _ ...grpc.CallOption) (*aiv1.DiffCampaignArtifactRevisionsResponse, error) {
+	c.lastDiffReq = req
+	return c.diffResp, nil
+}
+
+func (c *contractCampaignArtifactHistoryClient) RestoreCampaignArtifactRevision(_ context.Context, req *aiv1.RestoreCampaignArtifactRevisionRequest, _ ...grpc.CallOption) (*aiv1.RestoreCampaignArtifactRevisionResponse, error) {
+	c.lastRestoreReq = req
+	if c.restoreErr != nil {
+		return nil, c.restoreErr
+	}
+	return &aiv1.RestoreCampaignArtifactRevisionResponse{}, nil
+}
@@ -88,6 +88,7 @@ func (g workspaceReadGateway) CampaignWorkspace(ctx context.Context, campaignID
 		CharacterCount:   strconv.FormatInt(int64(campaign.GetCharacterCount()), 10),
 		CoverPreviewURL:  campaignapp.CampaignCoverPreviewImageURL(g.assetBaseURL, resolvedCampaignID, campaign.GetCoverSetId(), campaign.GetCoverAssetId()),
 		CoverImageURL:    campaignapp.CampaignCoverBackgroundImageURL(g.assetBaseURL, resolvedCampaignID, campaign.GetCoverSetId(), campaign.GetCoverAssetId()),
+		HeadSeq:          resp.GetHeadSeq(),
 	}, nil
 }
 
@@ -125,7 +126,7 @@ func (g configurationMutationGateway) UpdateCampaign(ctx context.Context, campai
 		return apperrors.E(apperrors.KindInvalidInput, "campaign id is required")
 	}
 
-	req := &statev1.UpdateCampaignRequest{CampaignId: campaignID}
+	req := &statev1.UpdateCampaignRequest{CampaignId: campaignID, ExpectedSeq: input.ExpectedSeq}
 	if input.Name != nil {
 		req.Name = wrapperspb.String(strings.TrimSpace(*input.Name))
 	}
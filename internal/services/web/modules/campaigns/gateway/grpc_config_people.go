@@ -32,12 +32,14 @@ type CharacterOwnershipMutationDeps struct {
 
 // AutomationReadDeps keeps automation query dependencies explicit.
 type AutomationReadDeps struct {
-	Agent AgentClient
+	Agent    AgentClient
+	Artifact CampaignArtifactHistoryClient
 }
 
 // AutomationMutationDeps keeps automation mutation dependencies explicit.
 type AutomationMutationDeps struct {
 	Campaign CampaignMutationClient
+	Artifact CampaignArtifactHistoryClient
 }
 
 // participantReadGateway maps participant reads and view formatting inputs.
@@ -1,6 +1,10 @@
 package gateway
 
-import campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+import (
+	"time"
+
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+)
 
 // SessionReadDeps keeps session query dependencies explicit.
 type SessionReadDeps struct {
@@ -13,6 +17,12 @@ type SessionMutationDeps struct {
 	Session SessionMutationClient
 }
 
+// SessionRecapDeps keeps session recap review dependencies explicit.
+type SessionRecapDeps struct {
+	Session SessionRecapRecordClient
+	Draft   SessionRecapDraftClient
+}
+
 // InviteReadDeps keeps invite read/search dependencies explicit.
 type InviteReadDeps struct {
 	Invite      InviteReadClient
@@ -27,6 +37,23 @@ type InviteMutationDeps struct {
 	Auth   AuthClient
 }
 
+// InviteLinkDeps keeps invite-link dependencies explicit. Auth resolves
+// claimant usernames for claim history.
+type InviteLinkDeps struct {
+	Invite InviteLinkClient
+	Auth   AuthClient
+}
+
+// RecruitmentDeps keeps open-table recruitment dependencies explicit.
+// Campaign supplies the listed system and GM mode; Auth resolves applicant
+// usernames.
+type RecruitmentDeps struct {
+	Listing      CampaignListingClient
+	Applications CampaignApplicationClient
+	Campaign     CampaignReadClient
+	Auth         AuthClient
+}
+
 // ConfigurationMutationDeps keeps campaign settings mutation dependencies explicit.
 type ConfigurationMutationDeps struct {
 	Campaign CampaignMutationClient
@@ -42,6 +69,11 @@ type sessionMutationGateway struct {
 	mutation SessionMutationDeps
 }
 
+// sessionRecapGateway maps AI recap drafts and game recap recording.
+type sessionRecapGateway struct {
+	recaps SessionRecapDeps
+}
+
 // inviteReadGateway maps invite reads and invite-search side effects from owned deps.
 type inviteReadGateway struct {
 	read InviteReadDeps
@@ -52,6 +84,17 @@ type inviteMutationGateway struct {
 	mutation InviteMutationDeps
 }
 
+// inviteLinkGateway maps shareable invite links and claim decisions.
+type inviteLinkGateway struct {
+	links InviteLinkDeps
+	now   func() time.Time
+}
+
+// recruitmentGateway maps open-table listings and application decisions.
+type recruitmentGateway struct {
+	recruitment RecruitmentDeps
+}
+
 // configurationMutationGateway maps campaign settings mutations only.
 type configurationMutationGateway struct {
 	mutation ConfigurationMutationDeps
@@ -75,6 +118,15 @@ func NewSessionMutationGateway(mutationDeps SessionMutationDeps) campaignapp.Cam
 	return sessionMutationGateway{mutation: mutationDeps}
 }
 
+// NewSessionRecapGateway builds the session recap adapter from explicit
+// dependencies.
+func NewSessionRecapGateway(recapDeps SessionRecapDeps) campaignapp.CampaignSessionRecapGateway {
+	if recapDeps.Session == nil || recapDeps.Draft == nil {
+		return nil
+	}
+	return sessionRecapGateway{recaps: recapDeps}
+}
+
 // NewInviteReadGateway builds the invite read adapter from explicit
 // dependencies.
 func NewInviteReadGateway(readDeps InviteReadDeps) campaignapp.CampaignInviteReadGateway {
@@ -93,6 +145,24 @@ func NewInviteMutationGateway(mutationDeps InviteMutationDeps) campaignapp.Campa
 	return inviteMutationGateway{mutation: mutationDeps}
 }
 
+// NewRecruitmentGateway builds the open-table recruitment adapter from
+// explicit dependencies.
+func NewRecruitmentGateway(recruitmentDeps RecruitmentDeps) campaignapp.CampaignRecruitmentGateway {
+	if recruitmentDeps.Listing == nil || recruitmentDeps.Applications == nil || recruitmentDeps.Campaign == nil || recruitmentDeps.Auth == nil {
+		return nil
+	}
+	return recruitmentGateway{recruitment: recruitmentDeps}
+}
+
+// NewInviteLinkGateway builds the invite-link adapter from explicit
+// dependencies.
+func NewInviteLinkGateway(linkDeps InviteLinkDeps) campaignapp.CampaignInviteLinkGateway {
+	if linkDeps.Invite == nil || linkDeps.Auth == nil {
+		return nil
+	}
+	return inviteLinkGateway{links: linkDeps, now: time.Now}
+}
+
 // NewConfigurationMutationGateway builds the configuration mutation adapter
 // from explicit dependencies.
 func NewConfigurationMutationGateway(mutationDeps ConfigurationMutationDeps) campaignapp.CampaignConfigurationMutationGateway {
@@ -822,6 +822,7 @@ func TestUpdateCampaignMapsInputAndErrors(t *testing.T) {
 		Name:        &name,
 		ThemePrompt: &theme,
 		Locale:      &locale,
+		ExpectedSeq: 12,
 	})
 	if err != nil {
 		t.Fatalf("UpdateCampaign() error = %v", err)
@@ -829,6 +830,9 @@ func TestUpdateCampaignMapsInputAndErrors(t *testing.T) {
 	if client.lastUpdateReq == nil {
 		t.Fatalf("expected UpdateCampaign request")
 	}
+	if got := client.lastUpdateReq.GetExpectedSeq(); got != 12 {
+		t.Fatalf("request expected seq = %d, want 12", got)
+	}
 	if client.lastUpdateReq.GetCampaignId() != "c1" {
 		t.Fatalf("request campaign id = %q, want %q", client.lastUpdateReq.GetCampaignId(), "c1")
 	}
@@ -1414,7 +1418,7 @@ func (c *contractCampaignClient) CreateCampaign(_ context.Context, req *statev1.
 	return &statev1.CreateCampaignResponse{Campaign: &statev1.Campaign{Id: "c-created"}}, nil
 }
 
-func (c *contractCampaignClient) UpdateCampaign(_ context.Context, req *statev1.UpdateCampaignRequest, _ ...grpc.CallOption) (*statev1.UpdateCampaignResponse, error) {
+func (c *contractCampaignClient) UpdateCampaign(ctx context.Context, req *statev1.UpdateCampaignRequest, _ ...grpc.CallOption) (*statev1.UpdateCampaignResponse, error) {
 	c.lastUpdateReq = req
 	if c.updateErr != nil {
 		return nil, c.updateErr
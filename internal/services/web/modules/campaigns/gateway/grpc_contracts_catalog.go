@@ -39,6 +39,15 @@ type AgentClient interface {
 	ListAgents(context.Context, *aiv1.ListAgentsRequest, ...grpc.CallOption) (*aiv1.ListAgentsResponse, error)
 }
 
+// CampaignArtifactHistoryClient exposes AI-owned campaign artifact history
+// reads and rollback for the GM history page.
+type CampaignArtifactHistoryClient interface {
+	ListCampaignArtifacts(context.Context, *aiv1.ListCampaignArtifactsRequest, ...grpc.CallOption) (*aiv1.ListCampaignArtifactsResponse, error)
+	ListCampaignArtifactRevisions(context.Context, *aiv1.ListCampaignArtifactRevisionsRequest, ...grpc.CallOption) (*aiv1.ListCampaignArtifactRevisionsResponse, error)
+	DiffCampaignArtifactRevisions(context.Context, *aiv1.DiffCampaignArtifactRevisionsRequest, ...grpc.CallOption) (*aiv1.DiffCampaignArtifactRevisionsResponse, error)
+	RestoreCampaignArtifactRevision(context.Context, *aiv1.RestoreCampaignArtifactRevisionRequest, ...grpc.CallOption) (*aiv1.RestoreCampaignArtifactRevisionResponse, error)
+}
+
 // CampaignArtifactClient exposes AI-owned campaign artifact seeding for starter launch.
 type CampaignArtifactClient interface {
 	EnsureCampaignArtifacts(context.Context, *aiv1.EnsureCampaignArtifactsRequest, ...grpc.CallOption) (*aiv1.EnsureCampaignArtifactsResponse, error)
@@ -3,7 +3,9 @@ package gateway
 import (
 	"context"
 
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
@@ -21,6 +23,18 @@ type SessionMutationClient interface {
 	EndSession(context.Context, *statev1.EndSessionRequest, ...grpc.CallOption) (*statev1.EndSessionResponse, error)
 }
 
+// SessionRecapRecordClient records approved recaps on game sessions.
+type SessionRecapRecordClient interface {
+	RecordSessionRecap(context.Context, *statev1.RecordSessionRecapRequest, ...grpc.CallOption) (*statev1.RecordSessionRecapResponse, error)
+}
+
+// SessionRecapDraftClient exposes AI-owned session recap drafts.
+type SessionRecapDraftClient interface {
+	GetSessionRecapDraft(context.Context, *aiv1.GetSessionRecapDraftRequest, ...grpc.CallOption) (*aiv1.GetSessionRecapDraftResponse, error)
+	UpdateSessionRecapDraft(context.Context, *aiv1.UpdateSessionRecapDraftRequest, ...grpc.CallOption) (*aiv1.UpdateSessionRecapDraftResponse, error)
+	MarkSessionRecapDraftPublished(context.Context, *aiv1.MarkSessionRecapDraftPublishedRequest, ...grpc.CallOption) (*aiv1.MarkSessionRecapDraftPublishedResponse, error)
+}
+
 // InviteReadClient exposes invite queries for campaign workspace pages.
 type InviteReadClient interface {
 	ListInvites(context.Context, *invitev1.ListInvitesRequest, ...grpc.CallOption) (*invitev1.ListInvitesResponse, error)
@@ -32,6 +46,33 @@ type InviteMutationClient interface {
 	RevokeInvite(context.Context, *invitev1.RevokeInviteRequest, ...grpc.CallOption) (*invitev1.RevokeInviteResponse, error)
 }
 
+// InviteLinkClient exposes shareable invite-link management for campaign
+// workspace pages.
+type InviteLinkClient interface {
+	ListInviteLinks(context.Context, *invitev1.ListInviteLinksRequest, ...grpc.CallOption) (*invitev1.ListInviteLinksResponse, error)
+	ListInviteLinkClaims(context.Context, *invitev1.ListInviteLinkClaimsRequest, ...grpc.CallOption) (*invitev1.ListInviteLinkClaimsResponse, error)
+	CreateInviteLink(context.Context, *invitev1.CreateInviteLinkRequest, ...grpc.CallOption) (*invitev1.CreateInviteLinkResponse, error)
+	RevokeInviteLink(context.Context, *invitev1.RevokeInviteLinkRequest, ...grpc.CallOption) (*invitev1.RevokeInviteLinkResponse, error)
+	ApproveInviteLinkClaim(context.Context, *invitev1.ApproveInviteLinkClaimRequest, ...grpc.CallOption) (*invitev1.ApproveInviteLinkClaimResponse, error)
+	DeclineInviteLinkClaim(context.Context, *invitev1.DeclineInviteLinkClaimRequest, ...grpc.CallOption) (*invitev1.DeclineInviteLinkClaimResponse, error)
+}
+
+// CampaignListingClient exposes open-table listing management from the
+// discovery service.
+type CampaignListingClient interface {
+	GetCampaignListing(context.Context, *discoveryv1.GetCampaignListingRequest, ...grpc.CallOption) (*discoveryv1.GetCampaignListingResponse, error)
+	PublishCampaignListing(context.Context, *discoveryv1.PublishCampaignListingRequest, ...grpc.CallOption) (*discoveryv1.PublishCampaignListingResponse, error)
+	CloseCampaignListing(context.Context, *discoveryv1.CloseCampaignListingRequest, ...grpc.CallOption) (*discoveryv1.CloseCampaignListingResponse, error)
+}
+
+// CampaignApplicationClient exposes application review from the invite
+// service.
+type CampaignApplicationClient interface {
+	ListCampaignApplications(context.Context, *invitev1.ListCampaignApplicationsRequest, ...grpc.CallOption) (*invitev1.ListCampaignApplicationsResponse, error)
+	AcceptCampaignApplication(context.Context, *invitev1.AcceptCampaignApplicationRequest, ...grpc.CallOption) (*invitev1.AcceptCampaignApplicationResponse, error)
+	DeclineCampaignApplication(context.Context, *invitev1.DeclineCampaignApplicationRequest, ...grpc.CallOption) (*invitev1.DeclineCampaignApplicationResponse, error)
+}
+
 // AuthClient resolves auth-owned users from usernames for invite targeting.
 type AuthClient interface {
 	LookupUserByUsername(context.Context, *authv1.LookupUserByUsernameRequest, ...grpc.CallOption) (*authv1.LookupUserByUsernameResponse, error)
@@ -0,0 +1,232 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/grpcpaging"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// inviteLinkPageSize keeps link and claim listing on one paging size.
+const inviteLinkPageSize = 50
+
+// CampaignInviteLinks lists every shareable link for the campaign.
+func (g inviteLinkGateway) CampaignInviteLinks(ctx context.Context, campaignID string) ([]campaignapp.CampaignInviteLink, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return []campaignapp.CampaignInviteLink{}, nil
+	}
+	links, err := grpcpaging.CollectPages[campaignapp.CampaignInviteLink, *invitev1.InviteLink](
+		ctx, inviteLinkPageSize,
+		func(ctx context.Context, pageToken string) ([]*invitev1.InviteLink, string, error) {
+			resp, err := g.links.Invite.ListInviteLinks(ctx, &invitev1.ListInviteLinksRequest{
+				CampaignId: campaignID,
+				PageSize:   inviteLinkPageSize,
+				PageToken:  pageToken,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return resp.GetLinks(), resp.GetNextPageToken(), nil
+		},
+		func(link *invitev1.InviteLink) (campaignapp.CampaignInviteLink, bool) {
+			if link == nil {
+				return campaignapp.CampaignInviteLink{}, false
+			}
+			return campaignapp.CampaignInviteLink{
+				ID:               strings.TrimSpace(link.GetId()),
+				Role:             inviteLinkRole(link.GetDefaultRole()),
+				MaxUses:          int(link.GetMaxUses()),
+				UseCount:         int(link.GetUseCount()),
+				ExpiresAt:        timestampString(link.GetExpiresAt()),
+				RequiresApproval: link.GetRequiresApproval(),
+				Status:           g.inviteLinkStatus(link),
+				CreatedAt:        timestampString(link.GetCreatedAt()),
+			}, true
+		},
+	)
+	if err != nil {
+		return nil, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_load_invite_links",
+			FallbackMessage: "failed to load invite links",
+		})
+	}
+	return links, nil
+}
+
+// CampaignInviteLinkClaims lists claim history across the campaign's links
+// with claimant usernames resolved best-effort.
+func (g inviteLinkGateway) CampaignInviteLinkClaims(ctx context.Context, campaignID string) ([]campaignapp.CampaignInviteLinkClaim, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return []campaignapp.CampaignInviteLinkClaim{}, nil
+	}
+	claims, err := grpcpaging.CollectPages[campaignapp.CampaignInviteLinkClaim, *invitev1.InviteLinkClaim](
+		ctx, inviteLinkPageSize,
+		func(ctx context.Context, pageToken string) ([]*invitev1.InviteLinkClaim, string, error) {
+			resp, err := g.links.Invite.ListInviteLinkClaims(ctx, &invitev1.ListInviteLinkClaimsRequest{
+				CampaignId: campaignID,
+				PageSize:   inviteLinkPageSize,
+				PageToken:  pageToken,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return resp.GetClaims(), resp.GetNextPageToken(), nil
+		},
+		func(claim *invitev1.InviteLinkClaim) (campaignapp.CampaignInviteLinkClaim, bool) {
+			if claim == nil {
+				return campaignapp.CampaignInviteLinkClaim{}, false
+			}
+			return campaignapp.CampaignInviteLinkClaim{
+				ID:            strings.TrimSpace(claim.GetId()),
+				LinkID:        strings.TrimSpace(claim.GetLinkId()),
+				UserID:        strings.TrimSpace(claim.GetUserId()),
+				ParticipantID: strings.TrimSpace(claim.GetParticipantId()),
+				Status:        inviteLinkClaimStatus(claim.GetStatus()),
+				CreatedAt:     timestampString(claim.GetCreatedAt()),
+			}, true
+		},
+	)
+	if err != nil {
+		return nil, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_load_invite_links",
+			FallbackMessage: "failed to load invite links",
+		})
+	}
+	usernames := map[string]string{}
+	for i := range claims {
+		userID := claims[i].UserID
+		if userID == "" {
+			continue
+		}
+		if _, ok := usernames[userID]; !ok {
+			usernames[userID] = g.lookupUsername(ctx, userID)
+		}
+		claims[i].Username = usernames[userID]
+	}
+	return claims, nil
+}
+
+// CreateInviteLink creates one shareable link with optional limits.
+func (g inviteLinkGateway) CreateInviteLink(ctx context.Context, campaignID string, input campaignapp.CreateInviteLinkInput) error {
+	req := &invitev1.CreateInviteLinkRequest{
+		CampaignId:       strings.TrimSpace(campaignID),
+		DefaultRole:      invitev1.InviteLinkRole_INVITE_LINK_ROLE_PLAYER,
+		MaxUses:          int32(input.MaxUses),
+		RequiresApproval: input.RequiresApproval,
+	}
+	if input.Role == campaignapp.InviteLinkRoleGM {
+		req.DefaultRole = invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM
+	}
+	if input.ExpiresInHours > 0 {
+		req.ExpiresAt = timestamppb.New(g.now().Add(time.Duration(input.ExpiresInHours) * time.Hour))
+	}
+	if _, err := g.links.Invite.CreateInviteLink(ctx, req); err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_create_invite_link",
+			FallbackMessage: "failed to create invite link",
+		})
+	}
+	return nil
+}
+
+// RevokeInviteLink stops a link from accepting further claims.
+func (g inviteLinkGateway) RevokeInviteLink(ctx context.Context, campaignID string, input campaignapp.RevokeInviteLinkInput) error {
+	_, err := g.links.Invite.RevokeInviteLink(ctx, &invitev1.RevokeInviteLinkRequest{
+		CampaignId: strings.TrimSpace(campaignID),
+		LinkId:     input.LinkID,
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_revoke_invite_link",
+			FallbackMessage: "failed to revoke invite link",
+		})
+	}
+	return nil
+}
+
+// ApproveInviteLinkClaim seats a pending claimant.
+func (g inviteLinkGateway) ApproveInviteLinkClaim(ctx context.Context, campaignID string, input campaignapp.InviteLinkClaimDecisionInput) error {
+	_, err := g.links.Invite.ApproveInviteLinkClaim(ctx, &invitev1.ApproveInviteLinkClaimRequest{
+		CampaignId: strings.TrimSpace(campaignID),
+		ClaimId:    input.ClaimID,
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_decide_invite_link_claim",
+			FallbackMessage: "failed to decide invite link claim",
+		})
+	}
+	return nil
+}
+
+// DeclineInviteLinkClaim rejects a pending claimant and frees the use.
+func (g inviteLinkGateway) DeclineInviteLinkClaim(ctx context.Context, campaignID string, input campaignapp.InviteLinkClaimDecisionInput) error {
+	_, err := g.links.Invite.DeclineInviteLinkClaim(ctx, &invitev1.DeclineInviteLinkClaimRequest{
+		CampaignId: strings.TrimSpace(campaignID),
+		ClaimId:    input.ClaimID,
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_decide_invite_link_claim",
+			FallbackMessage: "failed to decide invite link claim",
+		})
+	}
+	return nil
+}
+
+// lookupUsername resolves one claimant username, leaving it blank on failure.
+func (g inviteLinkGateway) lookupUsername(ctx context.Context, userID string) string {
+	resp, err := g.links.Auth.GetUser(ctx, &authv1.GetUserRequest{UserId: userID})
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(resp.GetUser().GetUsername())
+}
+
+// inviteLinkStatus folds expiry and usage limits into the displayed status.
+func (g inviteLinkGateway) inviteLinkStatus(link *invitev1.InviteLink) string {
+	if link.GetStatus() == invitev1.InviteLinkStatus_INVITE_LINK_REVOKED {
+		return campaignapp.InviteLinkStatusRevoked
+	}
+	if link.GetExpiresAt() != nil && !link.GetExpiresAt().AsTime().After(g.now()) {
+		return campaignapp.InviteLinkStatusExpired
+	}
+	if link.GetMaxUses() > 0 && link.GetUseCount() >= link.GetMaxUses() {
+		return campaignapp.InviteLinkStatusExhausted
+	}
+	return campaignapp.InviteLinkStatusActive
+}
+
+// inviteLinkRole maps link role enums onto app role values.
+func inviteLinkRole(role invitev1.InviteLinkRole) string {
+	if role == invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM {
+		return campaignapp.InviteLinkRoleGM
+	}
+	return campaignapp.InviteLinkRolePlayer
+}
+
+// inviteLinkClaimStatus maps claim status enums onto app status values.
+func inviteLinkClaimStatus(status invitev1.InviteLinkClaimStatus) string {
+	switch status {
+	case invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_JOINED:
+		return campaignapp.InviteLinkClaimStatusJoined
+	case invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_DECLINED:
+		return campaignapp.InviteLinkClaimStatusDeclined
+	default:
+		return campaignapp.InviteLinkClaimStatusPending
+	}
+}
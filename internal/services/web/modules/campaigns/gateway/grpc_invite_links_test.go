@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type contractInviteLinkClient struct {
+	links      []*invitev1.InviteLink
+	claims     []*invitev1.InviteLinkClaim
+	lastCreate *invitev1.CreateInviteLinkRequest
+}
+
+func (c *contractInviteLinkClient) ListInviteLinks(context.Context, *invitev1.ListInviteLinksRequest, ...grpc.CallOption) (*invitev1.ListInviteLinksResponse, error) {
+	return &invitev1.ListInviteLinksResponse{Links: c.links}, nil
+}
+
+func (c *contractInviteLinkClient) ListInviteLinkClaims(context.Context, *invitev1.ListInviteLinkClaimsRequest, ...grpc.CallOption) (*invitev1.ListInviteLinkClaimsResponse, error) {
+	return &invitev1.ListInviteLinkClaimsResponse{Claims: c.claims}, nil
+}
+
+func (c *contractInviteLinkClient) CreateInviteLink(_ context.Context, in *invitev1.CreateInviteLinkRequest, _ ...grpc.CallOption) (*invitev1.CreateInviteLinkResponse, error) {
+	c.lastCreate = in
+	return &invitev1.CreateInviteLinkResponse{}, nil
+}
+
+func (c *contractInviteLinkClient) RevokeInviteLink(context.Context, *invitev1.RevokeInviteLinkRequest, ...grpc.CallOption) (*invitev1.RevokeInviteLinkResponse, error) {
+	return &invitev1.RevokeInviteLinkResponse{}, nil
+}
+
+func (c *contractInviteLinkClient) ApproveInviteLinkClaim(context.Context, *invitev1.ApproveInviteLinkClaimRequest, ...grpc.CallOption) (*invitev1.ApproveInviteLinkClaimResponse, error) {
+	return &invitev1.ApproveInviteLinkClaimResponse{}, nil
+}
+
+func (c *contractInviteLinkClient) DeclineInviteLinkClaim(context.Context, *invitev1.DeclineInviteLinkClaimRequest, ...grpc.CallOption) (*invitev1.DeclineInviteLinkClaimResponse, error) {
+	return &invitev1.DeclineInviteLinkClaimResponse{}, nil
+}
+
+func TestCampaignInviteLinksFoldsExpiryAndUsageIntoStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	client := &contractInviteLinkClient{links: []*invitev1.InviteLink{
+		{Id: "open", MaxUses: 3, UseCount: 1, ExpiresAt: timestamppb.New(now.Add(time.Hour))},
+		{Id: "expired", ExpiresAt: timestamppb.New(now.Add(-time.Hour))},
+		{Id: "used", MaxUses: 2, UseCount: 2, DefaultRole: invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM},
+		{Id: "revoked", Status: invitev1.InviteLinkStatus_INVITE_LINK_REVOKED},
+	}}
+	gateway := inviteLinkGateway{links: InviteLinkDeps{Invite: client, Auth: &contractAuthClient{}}, now: func() time.Time { return now }}
+
+	links, err := gateway.CampaignInviteLinks(context.Background(), "c1")
+	if err != nil {
+		t.Fatalf("CampaignInviteLinks() error = %v", err)
+	}
+	want := map[string]string{
+		"open":    campaignapp.InviteLinkStatusActive,
+		"expired": campaignapp.InviteLinkStatusExpired,
+		"used":    campaignapp.InviteLinkStatusExhausted,
+		"revoked": campaignapp.InviteLinkStatusRevoked,
+	}
+	if len(links) != len(want) {
+		t.Fatalf("len(links) = %d, want %d", len(links), len(want))
+	}
+	for _, link := range links {
+		if link.Status != want[link.ID] {
+			t.Fatalf("link %q status = %q, want %q", link.ID, link.Status, want[link.ID])
+		}
+	}
+	if links[2].Role != campaignapp.InviteLinkRoleGM {
+		t.Fatalf("link role = %q, want %q", links[2].Role, campaignapp.InviteLinkRoleGM)
+	}
+}
+
+func TestCampaignInviteLinkClaimsResolvesUsernamesOnce(t *testing.T) {
+	t.Parallel()
+
+	auth := &contractAuthClient{getUserRespByID: map[string]*authv1.GetUserResponse{
+		"user-1": {User: &authv1.User{Id: "user-1", Username: "alice"}},
+	}}
+	client := &contractInviteLinkClient{claims: []*invitev1.InviteLinkClaim{
+		{Id: "claim-1", UserId: "user-1", Status: invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_PENDING_APPROVAL},
+		{Id: "claim-2", UserId: "user-1", Status: invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_JOINED},
+	}}
+	gateway := NewInviteLinkGateway(InviteLinkDeps{Invite: client, Auth: auth})
+
+	claims, err := gateway.CampaignInviteLinkClaims(context.Background(), "c1")
+	if err != nil {
+		t.Fatalf("CampaignInviteLinkClaims() error = %v", err)
+	}
+	if len(claims) != 2 || claims[0].Username != "alice" || claims[1].Username != "alice" {
+		t.Fatalf("claims = %#v", claims)
+	}
+	if claims[0].Status != campaignapp.InviteLinkClaimStatusPending || claims[1].Status != campaignapp.InviteLinkClaimStatusJoined {
+		t.Fatalf("claim statuses = %q, %q", claims[0].Status, claims[1].Status)
+	}
+	if got := auth.getUserRequestUserIDs(); len(got) != 1 {
+		t.Fatalf("get user calls = %v, want one lookup", got)
+	}
+}
+
+func TestCreateInviteLinkSetsExpiryFromHours(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	client := &contractInviteLinkClient{}
+	gateway := inviteLinkGateway{links: InviteLinkDeps{Invite: client, Auth: &contractAuthClient{}}, now: func() time.Time { return now }}
+
+	err := gateway.CreateInviteLink(context.Background(), "c1", campaignapp.CreateInviteLinkInput{
+		Role:             campaignapp.InviteLinkRoleGM,
+		MaxUses:          4,
+		ExpiresInHours:   48,
+		RequiresApproval: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateInviteLink() error = %v", err)
+	}
+	req := client.lastCreate
+	if req.GetDefaultRole() != invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM || req.GetMaxUses() != 4 || !req.GetRequiresApproval() {
+		t.Fatalf("create request = %v", req)
+	}
+	if got := req.GetExpiresAt().AsTime(); !got.Equal(now.Add(48 * time.Hour)) {
+		t.Fatalf("expires at = %v, want %v", got, now.Add(48*time.Hour))
+	}
+}
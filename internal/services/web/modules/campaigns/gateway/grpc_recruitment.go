@@ -0,0 +1,222 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/grpcpaging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// campaignApplicationPageSize keeps application listing on one paging size.
+const campaignApplicationPageSize = 50
+
+// CampaignListing loads the campaign's open-table listing, reporting an
+// unpublished listing when discovery has none.
+func (g recruitmentGateway) CampaignListing(ctx context.Context, campaignID string) (campaignapp.CampaignListing, error) {
+	resp, err := g.recruitment.Listing.GetCampaignListing(ctx, &discoveryv1.GetCampaignListingRequest{CampaignId: strings.TrimSpace(campaignID)})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return campaignapp.CampaignListing{}, nil
+		}
+		return campaignapp.CampaignListing{}, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_load_campaign_listing",
+			FallbackMessage: "failed to load campaign listing",
+		})
+	}
+	entry := resp.GetEntry()
+	if entry == nil {
+		return campaignapp.CampaignListing{}, nil
+	}
+	return campaignapp.CampaignListing{
+		Published:   true,
+		Title:       strings.TrimSpace(entry.GetTitle()),
+		Description: strings.TrimSpace(entry.GetDescription()),
+		Schedule:    strings.TrimSpace(entry.GetScheduleLabel()),
+		Tone:        strings.TrimSpace(entry.GetTone()),
+		SafetyNotes: strings.TrimSpace(entry.GetSafetyNotes()),
+		OpenSeats:   int(entry.GetOpenSeats()),
+	}, nil
+}
+
+// PublishCampaignListing advertises open seats, copying the game system and
+// GM mode from the campaign so listings cannot drift from the live table.
+func (g recruitmentGateway) PublishCampaignListing(ctx context.Context, campaignID string, input campaignapp.PublishCampaignListingInput) error {
+	campaignID = strings.TrimSpace(campaignID)
+	campaignResp, err := g.recruitment.Campaign.GetCampaign(ctx, &statev1.GetCampaignRequest{CampaignId: campaignID})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_publish_campaign_listing",
+			FallbackMessage: "failed to publish campaign listing",
+		})
+	}
+	campaign := campaignResp.GetCampaign()
+	switch campaign.GetStatus() {
+	case statev1.CampaignStatus_COMPLETED, statev1.CampaignStatus_ARCHIVED:
+		return apperrors.EK(apperrors.KindConflict, "error.web.message.campaign_listing_requires_live_campaign", "only live campaigns can publish open seats")
+	}
+	_, err = g.recruitment.Listing.PublishCampaignListing(ctx, &discoveryv1.PublishCampaignListingRequest{
+		CampaignId:    campaignID,
+		Title:         input.Title,
+		Description:   input.Description,
+		System:        campaign.GetSystem(),
+		GmMode:        discoveryGmMode(campaign.GetGmMode()),
+		ScheduleLabel: input.Schedule,
+		Tone:          input.Tone,
+		SafetyNotes:   input.SafetyNotes,
+		OpenSeats:     int32(input.OpenSeats),
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_publish_campaign_listing",
+			FallbackMessage: "failed to publish campaign listing",
+		})
+	}
+	return nil
+}
+
+// CloseCampaignListing stops advertising open seats. Closing an already
+// closed listing is a no-op.
+func (g recruitmentGateway) CloseCampaignListing(ctx context.Context, campaignID string) error {
+	_, err := g.recruitment.Listing.CloseCampaignListing(ctx, &discoveryv1.CloseCampaignListingRequest{CampaignId: strings.TrimSpace(campaignID)})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_close_campaign_listing",
+			FallbackMessage: "failed to close campaign listing",
+		})
+	}
+	return nil
+}
+
+// CampaignApplications lists the campaign's applications with applicant
+// usernames resolved best-effort.
+func (g recruitmentGateway) CampaignApplications(ctx context.Context, campaignID string) ([]campaignapp.CampaignApplication, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return []campaignapp.CampaignApplication{}, nil
+	}
+	applications, err := grpcpaging.CollectPages[campaignapp.CampaignApplication, *invitev1.CampaignApplication](
+		ctx, campaignApplicationPageSize,
+		func(ctx context.Context, pageToken string) ([]*invitev1.CampaignApplication, string, error) {
+			resp, err := g.recruitment.Applications.ListCampaignApplications(ctx, &invitev1.ListCampaignApplicationsRequest{
+				CampaignId: campaignID,
+				PageSize:   campaignApplicationPageSize,
+				PageToken:  pageToken,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return resp.GetApplications(), resp.GetNextPageToken(), nil
+		},
+		func(app *invitev1.CampaignApplication) (campaignapp.CampaignApplication, bool) {
+			if app == nil {
+				return campaignapp.CampaignApplication{}, false
+			}
+			return campaignapp.CampaignApplication{
+				ID:               strings.TrimSpace(app.GetId()),
+				ApplicantUserID:  strings.TrimSpace(app.GetApplicantUserId()),
+				Message:          strings.TrimSpace(app.GetMessage()),
+				CharacterConcept: strings.TrimSpace(app.GetCharacterConcept()),
+				Status:           campaignApplicationStatus(app.GetStatus()),
+				CreatedAt:        timestampString(app.GetCreatedAt()),
+			}, true
+		},
+	)
+	if err != nil {
+		return nil, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_load_campaign_applications",
+			FallbackMessage: "failed to load campaign applications",
+		})
+	}
+	usernames := map[string]string{}
+	for i := range applications {
+		userID := applications[i].ApplicantUserID
+		if userID == "" {
+			continue
+		}
+		if _, ok := usernames[userID]; !ok {
+			usernames[userID] = g.lookupUsername(ctx, userID)
+		}
+		applications[i].ApplicantName = usernames[userID]
+	}
+	return applications, nil
+}
+
+// AcceptCampaignApplication reserves a seat and invites the applicant.
+func (g recruitmentGateway) AcceptCampaignApplication(ctx context.Context, campaignID string, input campaignapp.CampaignApplicationDecisionInput) error {
+	_, err := g.recruitment.Applications.AcceptCampaignApplication(ctx, &invitev1.AcceptCampaignApplicationRequest{
+		CampaignId:    strings.TrimSpace(campaignID),
+		ApplicationId: input.ApplicationID,
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_decide_campaign_application",
+			FallbackMessage: "failed to decide campaign application",
+		})
+	}
+	return nil
+}
+
+// DeclineCampaignApplication rejects a pending application.
+func (g recruitmentGateway) DeclineCampaignApplication(ctx context.Context, campaignID string, input campaignapp.CampaignApplicationDecisionInput) error {
+	_, err := g.recruitment.Applications.DeclineCampaignApplication(ctx, &invitev1.DeclineCampaignApplicationRequest{
+		CampaignId:    strings.TrimSpace(campaignID),
+		ApplicationId: input.ApplicationID,
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_decide_campaign_application",
+			FallbackMessage: "failed to decide campaign application",
+		})
+	}
+	return nil
+}
+
+// lookupUsername resolves one applicant username, leaving it blank on failure.
+func (g recruitmentGateway) lookupUsername(ctx context.Context, userID string) string {
+	resp, err := g.recruitment.Auth.GetUser(ctx, &authv1.GetUserRequest{UserId: userID})
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(resp.GetUser().GetUsername())
+}
+
+// discoveryGmMode maps the campaign GM mode onto the discovery listing enum.
+func discoveryGmMode(mode statev1.GmMode) discoveryv1.DiscoveryGmMode {
+	switch mode {
+	case statev1.GmMode_HUMAN:
+		return discoveryv1.DiscoveryGmMode_DISCOVERY_GM_MODE_HUMAN
+	case statev1.GmMode_AI:
+		return discoveryv1.DiscoveryGmMode_DISCOVERY_GM_MODE_AI
+	case statev1.GmMode_HYBRID:
+		return discoveryv1.DiscoveryGmMode_DISCOVERY_GM_MODE_HYBRID
+	default:
+		return discoveryv1.DiscoveryGmMode_DISCOVERY_GM_MODE_UNSPECIFIED
+	}
+}
+
+// campaignApplicationStatus maps application status enums onto app values.
+func campaignApplicationStatus(status invitev1.CampaignApplicationStatus) string {
+	switch status {
+	case invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_ACCEPTED:
+		return campaignapp.CampaignApplicationStatusAccepted
+	case invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_DECLINED:
+		return campaignapp.CampaignApplicationStatusDeclined
+	default:
+		return campaignapp.CampaignApplicationStatusPending
+	}
+}
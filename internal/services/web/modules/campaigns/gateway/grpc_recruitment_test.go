@@ -0,0 +1,149 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type contractListingClient struct {
+	getErr      error
+	lastPublish *discoveryv1.PublishCampaignListingRequest
+	closeErr    error
+}
+
+func (c *contractListingClient) GetCampaignListing(_ context.Context, in *discoveryv1.GetCampaignListingRequest, _ ...grpc.CallOption) (*discoveryv1.GetCampaignListingResponse, error) {
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	return &discoveryv1.GetCampaignListingResponse{Entry: &discoveryv1.DiscoveryEntry{SourceId: in.GetCampaignId(), Title: "Night Market", OpenSeats: 2}}, nil
+}
+
+func (c *contractListingClient) PublishCampaignListing(_ context.Context, in *discoveryv1.PublishCampaignListingRequest, _ ...grpc.CallOption) (*discoveryv1.PublishCampaignListingResponse, error) {
+	c.lastPublish = in
+	return &discoveryv1.PublishCampaignListingResponse{}, nil
+}
+
+func (c *contractListingClient) CloseCampaignListing(context.Context, *discoveryv1.CloseCampaignListingRequest, ...grpc.CallOption) (*discoveryv1.CloseCampaignListingResponse, error) {
+	return &discoveryv1.CloseCampaignListingResponse{}, c.closeErr
+}
+
+type contractApplicationClient struct {
+	applications []*invitev1.CampaignApplication
+}
+
+func (c *contractApplicationClient) ListCampaignApplications(context.Context, *invitev1.ListCampaignApplicationsRequest, ...grpc.CallOption) (*invitev1.ListCampaignApplicationsResponse, error) {
+	return &invitev1.ListCampaignApplicationsResponse{Applications: c.applications}, nil
+}
+
+func (c *contractApplicationClient) AcceptCampaignApplication(context.Context, *invitev1.AcceptCampaignApplicationRequest, ...grpc.CallOption) (*invitev1.AcceptCampaignApplicationResponse, error) {
+	return &invitev1.AcceptCampaignApplicationResponse{}, nil
+}
+
+func (c *contractApplicationClient) DeclineCampaignApplication(context.Context, *invitev1.DeclineCampaignApplicationRequest, ...grpc.CallOption) (*invitev1.DeclineCampaignApplicationResponse, error) {
+	return &invitev1.DeclineCampaignApplicationResponse{}, nil
+}
+
+func newContractRecruitmentGateway(listing *contractListingClient, applications *contractApplicationClient) recruitmentGateway {
+	return recruitmentGateway{recruitment: RecruitmentDeps{
+		Listing:      listing,
+		Applications: applications,
+		Campaign: &contractCampaignClient{getResp: &statev1.GetCampaignResponse{Campaign: &statev1.Campaign{
+			Id:     "c1",
+			System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+			GmMode: statev1.GmMode_HYBRID,
+		}}},
+		Auth: &contractAuthClient{},
+	}}
+}
+
+func TestCampaignListingTreatsMissingListingAsUnpublished(t *testing.T) {
+	t.Parallel()
+
+	gateway := newContractRecruitmentGateway(&contractListingClient{getErr: status.Error(codes.NotFound, "campaign listing not found")}, &contractApplicationClient{})
+	listing, err := gateway.CampaignListing(context.Background(), "c1")
+	if err != nil {
+		t.Fatalf("CampaignListing() error = %v", err)
+	}
+	if listing.Published {
+		t.Fatalf("listing = %+v, want unpublished", listing)
+	}
+}
+
+func TestPublishCampaignListingCopiesCampaignSystemAndGmMode(t *testing.T) {
+	t.Parallel()
+
+	listing := &contractListingClient{}
+	gateway := newContractRecruitmentGateway(listing, &contractApplicationClient{})
+	err := gateway.PublishCampaignListing(context.Background(), "c1", campaignapp.PublishCampaignListingInput{
+		Title:       "Night Market",
+		Description: "Heists",
+		Schedule:    "Fridays",
+		OpenSeats:   2,
+	})
+	if err != nil {
+		t.Fatalf("PublishCampaignListing() error = %v", err)
+	}
+	got := listing.lastPublish
+	if got.GetSystem() != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART ||
+		got.GetGmMode() != discoveryv1.DiscoveryGmMode_DISCOVERY_GM_MODE_HYBRID ||
+		got.GetScheduleLabel() != "Fridays" || got.GetOpenSeats() != 2 {
+		t.Fatalf("publish request = %+v", got)
+	}
+}
+
+func TestPublishCampaignListingRejectsArchivedCampaign(t *testing.T) {
+	t.Parallel()
+
+	listing := &contractListingClient{}
+	gateway := newContractRecruitmentGateway(listing, &contractApplicationClient{})
+	gateway.recruitment.Campaign = &contractCampaignClient{getResp: &statev1.GetCampaignResponse{Campaign: &statev1.Campaign{
+		Id:     "c1",
+		Status: statev1.CampaignStatus_ARCHIVED,
+	}}}
+	err := gateway.PublishCampaignListing(context.Background(), "c1", campaignapp.PublishCampaignListingInput{Title: "Night Market", Description: "Heists", OpenSeats: 2})
+	if got := apperrors.HTTPStatus(err); got != http.StatusConflict {
+		t.Fatalf("PublishCampaignListing() status = %d, want %d", got, http.StatusConflict)
+	}
+	if listing.lastPublish != nil {
+		t.Fatalf("publish request = %+v, want none", listing.lastPublish)
+	}
+}
+
+func TestCloseCampaignListingIgnoresMissingListing(t *testing.T) {
+	t.Parallel()
+
+	gateway := newContractRecruitmentGateway(&contractListingClient{closeErr: status.Error(codes.NotFound, "campaign listing not found")}, &contractApplicationClient{})
+	if err := gateway.CloseCampaignListing(context.Background(), "c1"); err != nil {
+		t.Fatalf("CloseCampaignListing() error = %v", err)
+	}
+}
+
+func TestCampaignApplicationsMapsStatus(t *testing.T) {
+	t.Parallel()
+
+	gateway := newContractRecruitmentGateway(&contractListingClient{}, &contractApplicationClient{applications: []*invitev1.CampaignApplication{
+		{Id: "a1", ApplicantUserId: "u1", Message: "hi", Status: invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_PENDING},
+		{Id: "a2", ApplicantUserId: "u2", Status: invitev1.CampaignApplicationStatus_CAMPAIGN_APPLICATION_DECLINED},
+	}})
+	applications, err := gateway.CampaignApplications(context.Background(), "c1")
+	if err != nil {
+		t.Fatalf("CampaignApplications() error = %v", err)
+	}
+	if len(applications) != 2 {
+		t.Fatalf("len(applications) = %d, want 2", len(applications))
+	}
+	if applications[0].Status != campaignapp.CampaignApplicationStatusPending || applications[1].Status != campaignapp.CampaignApplicationStatusDeclined {
+		t.Fatalf("applications = %+v", applications)
+	}
+}
@@ -0,0 +1,85 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SessionRecapDraft loads the AI recap draft for one session.
+func (g sessionRecapGateway) SessionRecapDraft(ctx context.Context, campaignID, sessionID string) (campaignapp.CampaignSessionRecapDraft, bool, error) {
+	resp, err := g.recaps.Draft.GetSessionRecapDraft(ctx, &aiv1.GetSessionRecapDraftRequest{
+		CampaignId: strings.TrimSpace(campaignID),
+		SessionId:  strings.TrimSpace(sessionID),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return campaignapp.CampaignSessionRecapDraft{}, false, nil
+		}
+		return campaignapp.CampaignSessionRecapDraft{}, false, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_load_session_recap_draft",
+			FallbackMessage: "failed to load session recap draft",
+		})
+	}
+	draft := resp.GetDraft()
+	if draft == nil {
+		return campaignapp.CampaignSessionRecapDraft{}, false, nil
+	}
+	return campaignapp.CampaignSessionRecapDraft{
+		Status:    sessionRecapDraftStatus(draft.GetStatus()),
+		Markdown:  draft.GetMarkdown(),
+		UpdatedAt: timestampString(draft.GetUpdatedAt()),
+	}, true, nil
+}
+
+// UpdateSessionRecapDraft saves GM edits to the pending draft.
+func (g sessionRecapGateway) UpdateSessionRecapDraft(ctx context.Context, campaignID string, input campaignapp.SessionRecapDraftInput) error {
+	_, err := g.recaps.Draft.UpdateSessionRecapDraft(ctx, &aiv1.UpdateSessionRecapDraftRequest{
+		CampaignId: strings.TrimSpace(campaignID),
+		SessionId:  input.SessionID,
+		Markdown:   input.Markdown,
+	})
+	if err != nil {
+		return mapSessionMutationError(err, "error.web.message.failed_to_save_session_recap", "failed to save session recap")
+	}
+	return nil
+}
+
+// PublishSessionRecap saves the final edits, records the recap on the game
+// session, and then marks the AI draft published. Recording first keeps the
+// draft pending, and therefore retryable, if the game write fails.
+func (g sessionRecapGateway) PublishSessionRecap(ctx context.Context, campaignID string, input campaignapp.SessionRecapDraftInput) error {
+	if err := g.UpdateSessionRecapDraft(ctx, campaignID, input); err != nil {
+		return err
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if _, err := g.recaps.Session.RecordSessionRecap(ctx, &statev1.RecordSessionRecapRequest{
+		CampaignId: campaignID,
+		SessionId:  input.SessionID,
+		Markdown:   input.Markdown,
+	}); err != nil {
+		return mapSessionMutationError(err, "error.web.message.failed_to_publish_session_recap", "failed to publish session recap")
+	}
+	if _, err := g.recaps.Draft.MarkSessionRecapDraftPublished(ctx, &aiv1.MarkSessionRecapDraftPublishedRequest{
+		CampaignId: campaignID,
+		SessionId:  input.SessionID,
+	}); err != nil {
+		return mapSessionMutationError(err, "error.web.message.failed_to_publish_session_recap", "failed to publish session recap")
+	}
+	return nil
+}
+
+// sessionRecapDraftStatus maps AI draft statuses onto app status values.
+func sessionRecapDraftStatus(value aiv1.SessionRecapDraftStatus) string {
+	if value == aiv1.SessionRecapDraftStatus_SESSION_RECAP_DRAFT_STATUS_PUBLISHED {
+		return campaignapp.SessionRecapDraftStatusPublished
+	}
+	return campaignapp.SessionRecapDraftStatusPending
+}
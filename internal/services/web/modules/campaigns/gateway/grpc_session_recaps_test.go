@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type sessionRecapCallLog struct {
+	calls []string
+}
+
+type contractSessionRecapDraftClient struct {
+	log    *sessionRecapCallLog
+	getErr error
+	draft  *aiv1.SessionRecapDraft
+}
+
+func (c *contractSessionRecapDraftClient) GetSessionRecapDraft(context.Context, *aiv1.GetSessionRecapDraftRequest, ...grpc.CallOption) (*aiv1.GetSessionRecapDraftResponse, error) {
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	return &aiv1.GetSessionRecapDraftResponse{Draft: c.draft}, nil
+}
+
+func (c *contractSessionRecapDraftClient) UpdateSessionRecapDraft(_ context.Context, in *aiv1.UpdateSessionRecapDraftRequest, _ ...grpc.CallOption) (*aiv1.UpdateSessionRecapDraftResponse, error) {
+	c.log.calls = append(c.log.calls, "update:"+in.GetMarkdown())
+	return &aiv1.UpdateSessionRecapDraftResponse{}, nil
+}
+
+func (c *contractSessionRecapDraftClient) MarkSessionRecapDraftPublished(context.Context, *aiv1.MarkSessionRecapDraftPublishedRequest, ...grpc.CallOption) (*aiv1.MarkSessionRecapDraftPublishedResponse, error) {
+	c.log.calls = append(c.log.calls, "mark-published")
+	return &aiv1.MarkSessionRecapDraftPublishedResponse{}, nil
+}
+
+type contractSessionRecapRecordClient struct {
+	log *sessionRecapCallLog
+}
+
+func (c *contractSessionRecapRecordClient) RecordSessionRecap(_ context.Context, in *statev1.RecordSessionRecapRequest, _ ...grpc.CallOption) (*statev1.RecordSessionRecapResponse, error) {
+	c.log.calls = append(c.log.calls, "record:"+in.GetMarkdown())
+	return &statev1.RecordSessionRecapResponse{}, nil
+}
+
+func TestSessionRecapDraftTreatsNotFoundAsMissing(t *testing.T) {
+	t.Parallel()
+
+	log := &sessionRecapCallLog{}
+	gateway := NewSessionRecapGateway(SessionRecapDeps{
+		Session: &contractSessionRecapRecordClient{log: log},
+		Draft:   &contractSessionRecapDraftClient{log: log, getErr: status.Error(codes.NotFound, "missing")},
+	})
+
+	_, found, err := gateway.SessionRecapDraft(context.Background(), "c1", "s1")
+	if err != nil || found {
+		t.Fatalf("SessionRecapDraft() = found %v, err %v; want missing without error", found, err)
+	}
+}
+
+func TestPublishSessionRecapRecordsBeforeMarkingPublished(t *testing.T) {
+	t.Parallel()
+
+	log := &sessionRecapCallLog{}
+	gateway := NewSessionRecapGateway(SessionRecapDeps{
+		Session: &contractSessionRecapRecordClient{log: log},
+		Draft:   &contractSessionRecapDraftClient{log: log},
+	})
+
+	err := gateway.PublishSessionRecap(context.Background(), "c1", campaignapp.SessionRecapDraftInput{SessionID: "s1", Markdown: "Recap"})
+	if err != nil {
+		t.Fatalf("PublishSessionRecap() error = %v", err)
+	}
+	want := []string{"update:Recap", "record:Recap", "mark-published"}
+	if len(log.calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", log.calls, want)
+	}
+	for i := range want {
+		if log.calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", log.calls, want)
+		}
+	}
+}
@@ -52,12 +52,15 @@ type CharacterGatewayDeps struct {
 // SessionGatewayDeps keeps session lifecycle gateway dependencies explicit.
 type SessionGatewayDeps struct {
 	Mutation SessionMutationDeps
+	Recaps   SessionRecapDeps
 }
 
 // InviteGatewayDeps keeps invite/search gateway dependencies explicit.
 type InviteGatewayDeps struct {
 	Read          InviteReadDeps
 	Mutation      InviteMutationDeps
+	Links         InviteLinkDeps
+	Recruitment   RecruitmentDeps
 	Participants  ParticipantReadDeps
 	Authorization AuthorizationDeps
 }
@@ -328,6 +328,18 @@ func (g GRPCGateway) CampaignAIAgents(ctx context.Context) ([]campaignapp.Campai
 	return g.unavailable().CampaignAIAgents(ctx)
 }
 
+func (g GRPCGateway) CampaignAIArtifacts(ctx context.Context, campaignID string) ([]campaignapp.CampaignAIArtifact, error) {
+	return g.automationRead().CampaignAIArtifacts(ctx, campaignID)
+}
+
+func (g GRPCGateway) CampaignAIArtifactRevisions(ctx context.Context, campaignID string, path string) ([]campaignapp.CampaignAIArtifactRevision, error) {
+	return g.automationRead().CampaignAIArtifactRevisions(ctx, campaignID, path)
+}
+
+func (g GRPCGateway) CampaignAIArtifactDiff(ctx context.Context, campaignID string, path string, from int, to int) (campaignapp.CampaignAIArtifactDiff, error) {
+	return g.automationRead().CampaignAIArtifactDiff(ctx, campaignID, path, from, to)
+}
+
 func (g GRPCGateway) CharacterCreationProgress(ctx context.Context, campaignID string, characterID string) (campaignapp.CampaignCharacterCreationProgress, error) {
 	if gateway := g.creationRead(); gateway != nil {
 		return gateway.CharacterCreationProgress(ctx, campaignID, characterID)
@@ -370,6 +382,10 @@ func (g GRPCGateway) UpdateCampaignAIBinding(ctx context.Context, campaignID str
 	return g.unavailable().UpdateCampaignAIBinding(ctx, campaignID, input)
 }
 
+func (g GRPCGateway) RestoreCampaignAIArtifactRevision(ctx context.Context, campaignID string, path string, revision int) error {
+	return g.automationMutation().RestoreCampaignAIArtifactRevision(ctx, campaignID, path, revision)
+}
+
 func (g GRPCGateway) CreateCharacter(ctx context.Context, campaignID string, input campaignapp.CreateCharacterInput) (campaignapp.CreateCharacterResult, error) {
 	if gateway := g.characterMutation(); gateway != nil {
 		return gateway.CreateCharacter(ctx, campaignID, input)
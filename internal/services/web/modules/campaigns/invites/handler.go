@@ -15,6 +15,8 @@ import (
 type ServiceConfig struct {
 	Read            campaignapp.InviteReadServiceConfig
 	Mutation        campaignapp.InviteMutationServiceConfig
+	Links           campaignapp.InviteLinkServiceConfig
+	Recruitment     campaignapp.RecruitmentServiceConfig
 	ParticipantRead campaignapp.ParticipantReadServiceConfig
 	Authorization   campaignapp.AuthorizationGateway
 }
@@ -25,6 +27,12 @@ type HandlerServices struct {
 	reads            campaignapp.CampaignInviteReadService
 	mutation         campaignapp.CampaignInviteMutationService
 	participantReads campaignapp.CampaignParticipantReadService
+	// links is nil when invite links are not wired; the invites page then
+	// hides shareable links.
+	links campaignapp.CampaignInviteLinkService
+	// recruitment is nil when open-table listings are not wired; the invites
+	// page then hides the listing and application sections.
+	recruitment campaignapp.CampaignRecruitmentService
 }
 
 // NewHandlerServices keeps invite transport dependencies owned by the invite
@@ -42,7 +50,22 @@ func NewHandlerServices(config ServiceConfig) (HandlerServices, error) {
 	if err != nil {
 		return HandlerServices{}, fmt.Errorf("invite participant-reads: %w", err)
 	}
-	return HandlerServices{reads: reads, mutation: mutation, participantReads: participantReads}, nil
+	services := HandlerServices{reads: reads, mutation: mutation, participantReads: participantReads}
+	if config.Links.Links != nil {
+		links, err := campaignapp.NewInviteLinkService(config.Links, config.Authorization)
+		if err != nil {
+			return HandlerServices{}, fmt.Errorf("invite-links: %w", err)
+		}
+		services.links = links
+	}
+	if config.Recruitment.Recruitment != nil {
+		recruitment, err := campaignapp.NewRecruitmentService(config.Recruitment, config.Authorization)
+		if err != nil {
+			return HandlerServices{}, fmt.Errorf("invite-recruitment: %w", err)
+		}
+		services.recruitment = recruitment
+	}
+	return services, nil
 }
 
 // Handler owns invite read, search, and mutation routes.
@@ -71,6 +94,35 @@ func (h Handler) HandleInvites(w http.ResponseWriter, r *http.Request, campaignI
 		return
 	}
 	view := invitesView(page, campaignID, items, r)
+	if h.invites.links != nil && page.CanManageInvites {
+		links, err := h.invites.links.CampaignInviteLinks(ctx, campaignID)
+		if err != nil {
+			h.WriteError(w, r, err)
+			return
+		}
+		claims, err := h.invites.links.CampaignInviteLinkClaims(ctx, campaignID)
+		if err != nil {
+			h.WriteError(w, r, err)
+			return
+		}
+		view.InviteLinks = mapInviteLinksView(links, claims, r)
+		view.InviteLinksEnabled = true
+	}
+	if h.invites.recruitment != nil && page.CanManageInvites {
+		listing, err := h.invites.recruitment.CampaignListing(ctx, campaignID)
+		if err != nil {
+			h.WriteError(w, r, err)
+			return
+		}
+		applications, err := h.invites.recruitment.CampaignApplications(ctx, campaignID)
+		if err != nil {
+			h.WriteError(w, r, err)
+			return
+		}
+		view.Listing = mapCampaignListingView(listing)
+		view.Applications = mapCampaignApplicationsView(applications)
+		view.RecruitmentEnabled = true
+	}
 	h.WriteCampaignDetailPage(w, r, page, campaignID, campaignrender.InvitesFragment(view, page.Loc), invitesBreadcrumbs(page)...)
 }
 
@@ -0,0 +1,95 @@
+package invites
+
+import (
+	"net/http"
+
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+)
+
+// HandleInviteLinkCreate creates a shareable invite link.
+func (h Handler) HandleInviteLinkCreate(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_invite_link_form", redirectURL) {
+		return
+	}
+	links, ok := h.inviteLinksOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := links.CreateInviteLink(ctx, campaignID, parseCreateInviteLinkInput(r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_create_invite_link", redirectURL)
+		return
+	}
+	h.Sync().InviteChanged(ctx, []string{userID}, campaignID)
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_invite_link_created", redirectURL)
+}
+
+// HandleInviteLinkRevoke revokes a shareable invite link.
+func (h Handler) HandleInviteLinkRevoke(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_invite_link_form", redirectURL) {
+		return
+	}
+	links, ok := h.inviteLinksOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := links.RevokeInviteLink(ctx, campaignID, parseRevokeInviteLinkInput(r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_revoke_invite_link", redirectURL)
+		return
+	}
+	h.Sync().InviteChanged(ctx, []string{userID}, campaignID)
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_invite_link_revoked", redirectURL)
+}
+
+// HandleInviteLinkClaimApprove seats a claimant waiting on GM approval.
+func (h Handler) HandleInviteLinkClaimApprove(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_invite_link_form", redirectURL) {
+		return
+	}
+	links, ok := h.inviteLinksOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := links.ApproveInviteLinkClaim(ctx, campaignID, parseInviteLinkClaimDecisionInput(r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_decide_invite_link_claim", redirectURL)
+		return
+	}
+	h.Sync().InviteChanged(ctx, []string{userID}, campaignID)
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_invite_link_claim_approved", redirectURL)
+}
+
+// HandleInviteLinkClaimDecline rejects a claimant waiting on GM approval.
+func (h Handler) HandleInviteLinkClaimDecline(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_invite_link_form", redirectURL) {
+		return
+	}
+	links, ok := h.inviteLinksOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := links.DeclineInviteLinkClaim(ctx, campaignID, parseInviteLinkClaimDecisionInput(r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_decide_invite_link_claim", redirectURL)
+		return
+	}
+	h.Sync().InviteChanged(ctx, []string{userID}, campaignID)
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_invite_link_claim_declined", redirectURL)
+}
+
+// inviteLinksOrWriteError fails closed when invite links are not wired.
+func (h Handler) inviteLinksOrWriteError(w http.ResponseWriter, r *http.Request) (campaignapp.CampaignInviteLinkService, bool) {
+	if h.invites.links == nil {
+		h.WriteError(w, r, apperrors.EK(apperrors.KindUnavailable, "error.web.message.invite_service_client_is_not_configured", "invite service client is not configured"))
+		return nil, false
+	}
+	return h.invites.links, true
+}
@@ -0,0 +1,90 @@
+package invites
+
+import (
+	"net/http"
+
+	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+)
+
+// HandleListingPublish publishes or updates the campaign's open-table listing.
+func (h Handler) HandleListingPublish(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_publish_campaign_listing", redirectURL) {
+		return
+	}
+	recruitment, ok := h.recruitmentOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, _ := h.RequestContextAndUserID(r)
+	if err := recruitment.PublishCampaignListing(ctx, campaignID, parsePublishCampaignListingInput(r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_publish_campaign_listing", redirectURL)
+		return
+	}
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_campaign_listing_published", redirectURL)
+}
+
+// HandleListingClose stops advertising the campaign's open seats.
+func (h Handler) HandleListingClose(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	recruitment, ok := h.recruitmentOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, _ := h.RequestContextAndUserID(r)
+	if err := recruitment.CloseCampaignListing(ctx, campaignID); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_close_campaign_listing", redirectURL)
+		return
+	}
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_campaign_listing_closed", redirectURL)
+}
+
+// HandleApplicationAccept reserves a seat and invites the applicant.
+func (h Handler) HandleApplicationAccept(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_decide_campaign_application", redirectURL) {
+		return
+	}
+	recruitment, ok := h.recruitmentOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := recruitment.AcceptCampaignApplication(ctx, campaignID, parseCampaignApplicationDecisionInput(r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_decide_campaign_application", redirectURL)
+		return
+	}
+	h.Sync().InviteChanged(ctx, []string{userID}, campaignID)
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_campaign_application_accepted", redirectURL)
+}
+
+// HandleApplicationDecline rejects a pending application.
+func (h Handler) HandleApplicationDecline(w http.ResponseWriter, r *http.Request, campaignID string) {
+	redirectURL := routepath.AppCampaignInvites(campaignID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_decide_campaign_application", redirectURL) {
+		return
+	}
+	recruitment, ok := h.recruitmentOrWriteError(w, r)
+	if !ok {
+		return
+	}
+	ctx, _ := h.RequestContextAndUserID(r)
+	if err := recruitment.DeclineCampaignApplication(ctx, campaignID, parseCampaignApplicationDecisionInput(r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_decide_campaign_application", redirectURL)
+		return
+	}
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_campaign_application_declined", redirectURL)
+}
+
+// recruitmentOrWriteError fails closed when open-table recruitment is not
+// wired.
+func (h Handler) recruitmentOrWriteError(w http.ResponseWriter, r *http.Request) (campaignapp.CampaignRecruitmentService, bool) {
+	if h.invites.recruitment == nil {
+		h.WriteError(w, r, apperrors.EK(apperrors.KindUnavailable, "error.web.message.campaign_recruitment_is_not_configured", "campaign recruitment is not configured"))
+		return nil, false
+	}
+	return h.invites.recruitment, true
+}
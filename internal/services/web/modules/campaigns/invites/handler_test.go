@@ -241,3 +241,109 @@ func TestHandleInviteRevokeRedirectsAndNotifiesSync(t *testing.T) {
 		t.Fatalf("sync = %#v", sync)
 	}
 }
+
+type inviteRecruitment struct {
+	listing     campaignapp.CampaignListing
+	items       []campaignapp.CampaignApplication
+	lastPublish campaignapp.PublishCampaignListingInput
+	lastAccept  campaignapp.CampaignApplicationDecisionInput
+	lastDecline campaignapp.CampaignApplicationDecisionInput
+	closed      bool
+}
+
+func (r *inviteRecruitment) CampaignListing(context.Context, string) (campaignapp.CampaignListing, error) {
+	return r.listing, nil
+}
+func (r *inviteRecruitment) PublishCampaignListing(_ context.Context, _ string, input campaignapp.PublishCampaignListingInput) error {
+	r.lastPublish = input
+	return nil
+}
+func (r *inviteRecruitment) CloseCampaignListing(context.Context, string) error {
+	r.closed = true
+	return nil
+}
+func (r *inviteRecruitment) CampaignApplications(context.Context, string) ([]campaignapp.CampaignApplication, error) {
+	return r.items, nil
+}
+func (r *inviteRecruitment) AcceptCampaignApplication(_ context.Context, _ string, input campaignapp.CampaignApplicationDecisionInput) error {
+	r.lastAccept = input
+	return nil
+}
+func (r *inviteRecruitment) DeclineCampaignApplication(_ context.Context, _ string, input campaignapp.CampaignApplicationDecisionInput) error {
+	r.lastDecline = input
+	return nil
+}
+
+func TestHandleInvitesRendersRecruitmentSection(t *testing.T) {
+	t.Parallel()
+
+	h, _, _ := newInviteHandler(t)
+	h.invites.recruitment = &inviteRecruitment{
+		listing: campaignapp.CampaignListing{Published: true, Title: "Friday Frontier", OpenSeats: 2},
+		items: []campaignapp.CampaignApplication{{
+			ID:              "app-1",
+			ApplicantUserID: "user-2",
+			ApplicantName:   "alice",
+			Message:         "Fridays work for me",
+			Status:          campaignapp.CampaignApplicationStatusPending,
+		}},
+	}
+	req := httptest.NewRequest(http.MethodGet, routepath.AppCampaignInvites("camp-1"), nil)
+	rr := httptest.NewRecorder()
+
+	h.HandleInvites(rr, req, "camp-1")
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	for _, marker := range []string{
+		`data-campaign-recruitment="true"`,
+		`data-campaign-listing-published="true"`,
+		`data-campaign-application-id="app-1"`,
+		`data-campaign-application-accept="true"`,
+		"@alice",
+	} {
+		if !strings.Contains(body, marker) {
+			t.Fatalf("body missing %q", marker)
+		}
+	}
+}
+
+func TestHandleApplicationAcceptRedirectsAndNotifiesSync(t *testing.T) {
+	t.Parallel()
+
+	h, _, sync := newInviteHandler(t)
+	recruitment := &inviteRecruitment{}
+	h.invites.recruitment = recruitment
+	req := httptest.NewRequest(http.MethodPost, routepath.AppCampaignApplicationAccept("camp-1"), strings.NewReader("application_id=app-1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+
+	h.HandleApplicationAccept(rr, req, "camp-1")
+
+	if rr.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if recruitment.lastAccept.ApplicationID != "app-1" {
+		t.Fatalf("accept input = %#v", recruitment.lastAccept)
+	}
+	if sync.lastCampaign != "camp-1" {
+		t.Fatalf("sync = %#v", sync)
+	}
+}
+
+func TestHandleListingPublishFailsClosedWithoutRecruitment(t *testing.T) {
+	t.Parallel()
+
+	h, _, _ := newInviteHandler(t)
+	req := httptest.NewRequest(http.MethodPost, routepath.AppCampaignListingPublish("camp-1"), strings.NewReader("title=Friday+Frontier"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+
+	h.HandleListingPublish(rr, req, "camp-1")
+
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusServiceUnavailable)
+	}
+}
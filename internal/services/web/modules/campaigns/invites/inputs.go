@@ -2,6 +2,7 @@ package invites
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
 
 	campaignapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/campaigns/app"
@@ -19,3 +20,64 @@ func parseCreateInviteInput(form url.Values) campaignapp.CreateInviteInput {
 func parseRevokeInviteInput(form url.Values) campaignapp.RevokeInviteInput {
 	return campaignapp.RevokeInviteInput{InviteID: strings.TrimSpace(form.Get("invite_id"))}
 }
+
+// parseCreateInviteLinkInput normalizes the invite-link create form into app
+// input. Blank numeric fields mean unlimited.
+func parseCreateInviteLinkInput(form url.Values) campaignapp.CreateInviteLinkInput {
+	return campaignapp.CreateInviteLinkInput{
+		Role:             strings.TrimSpace(form.Get("role")),
+		MaxUses:          parseInviteLinkLimit(form.Get("max_uses")),
+		ExpiresInHours:   parseInviteLinkLimit(form.Get("expires_in_hours")),
+		RequiresApproval: strings.TrimSpace(form.Get("requires_approval")) != "",
+	}
+}
+
+// parseInviteLinkLimit reads an optional non-negative limit field. Malformed
+// values become -1 so app validation rejects them instead of silently
+// removing the limit.
+func parseInviteLinkLimit(raw string) int {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return -1
+	}
+	return value
+}
+
+// parseRevokeInviteLinkInput normalizes the invite-link revoke form into app
+// input.
+func parseRevokeInviteLinkInput(form url.Values) campaignapp.RevokeInviteLinkInput {
+	return campaignapp.RevokeInviteLinkInput{LinkID: strings.TrimSpace(form.Get("link_id"))}
+}
+
+// parseInviteLinkClaimDecisionInput normalizes claim approve/decline forms
+// into app input.
+func parseInviteLinkClaimDecisionInput(form url.Values) campaignapp.InviteLinkClaimDecisionInput {
+	return campaignapp.InviteLinkClaimDecisionInput{ClaimID: strings.TrimSpace(form.Get("claim_id"))}
+}
+
+// parsePublishCampaignListingInput normalizes the open-table listing form into
+// app input. Malformed seat counts become zero so app validation rejects them.
+func parsePublishCampaignListingInput(form url.Values) campaignapp.PublishCampaignListingInput {
+	openSeats, err := strconv.Atoi(strings.TrimSpace(form.Get("open_seats")))
+	if err != nil {
+		openSeats = 0
+	}
+	return campaignapp.PublishCampaignListingInput{
+		Title:       strings.TrimSpace(form.Get("title")),
+		Description: strings.TrimSpace(form.Get("description")),
+		Schedule:    strings.TrimSpace(form.Get("schedule")),
+		Tone:        strings.TrimSpace(form.Get("tone")),
+		SafetyNotes: strings.TrimSpace(form.Get("safety_notes")),
+		OpenSeats:   openSeats,
+	}
+}
+
+// parseCampaignApplicationDecisionInput normalizes application accept/decline
+// forms into app input.
+func parseCampaignApplicationDecisionInput(form url.Values) campaignapp.CampaignApplicationDecisionInput {
+	return campaignapp.CampaignApplicationDecisionInput{ApplicationID: strings.TrimSpace(form.Get("application_id"))}
+}
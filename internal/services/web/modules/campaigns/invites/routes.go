@@ -16,4 +16,12 @@ func RegisterStableRoutes(mux *http.ServeMux, h Handler) {
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignInviteSearchPattern, h.WithCampaignID(h.HandleInviteSearch))
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignInviteCreatePattern, h.WithCampaignID(h.HandleInviteCreate))
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignInviteRevokePattern, h.WithCampaignID(h.HandleInviteRevoke))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignInviteLinkCreatePattern, h.WithCampaignID(h.HandleInviteLinkCreate))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignInviteLinkRevokePattern, h.WithCampaignID(h.HandleInviteLinkRevoke))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignInviteLinkApprovePattern, h.WithCampaignID(h.HandleInviteLinkClaimApprove))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignInviteLinkDeclinePattern, h.WithCampaignID(h.HandleInviteLinkClaimDecline))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignListingPublishPattern, h.WithCampaignID(h.HandleListingPublish))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignListingClosePattern, h.WithCampaignID(h.HandleListingClose))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignApplicationAcceptPattern, h.WithCampaignID(h.HandleApplicationAccept))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignApplicationDeclinePattern, h.WithCampaignID(h.HandleApplicationDecline))
 }
@@ -79,6 +79,21 @@ func TestInviteViewsInputsAndRoutes(t *testing.T) {
 		t.Fatalf("parseRevokeInviteInput() = %#v", revoke)
 	}
 
+	publish := parsePublishCampaignListingInput(url.Values{"title": {"  Frontier  "}, "open_seats": {" 3 "}})
+	if publish.Title != "Frontier" || publish.OpenSeats != 3 {
+		t.Fatalf("parsePublishCampaignListingInput() = %#v", publish)
+	}
+	if got := parsePublishCampaignListingInput(url.Values{"open_seats": {"many"}}); got.OpenSeats != 0 {
+		t.Fatalf("parsePublishCampaignListingInput(invalid seats) = %#v", got)
+	}
+	applications := mapCampaignApplicationsView([]campaignapp.CampaignApplication{
+		{ID: "app-1", ApplicantUserID: "user-1", ApplicantName: "mira"},
+		{ID: "app-2", ApplicantUserID: "user-2"},
+	})
+	if len(applications) != 2 || applications[0].Applicant != "@mira" || applications[1].Applicant != "user-2" {
+		t.Fatalf("mapCampaignApplicationsView() = %#v", applications)
+	}
+
 	searchReq := httptest.NewRequest(http.MethodPost, routepath.AppCampaignInviteSearch("camp-1"), bytes.NewBufferString(`{"query":"  mira  ","limit":5}`))
 	searchReq.Header.Set("Content-Type", "application/json")
 	input, err := parseInviteSearchInput(searchReq)
@@ -101,6 +116,10 @@ func TestInviteViewsInputsAndRoutes(t *testing.T) {
 		{method: http.MethodPost, path: routepath.AppCampaignInviteSearch("camp-1")},
 		{method: http.MethodPost, path: routepath.AppCampaignInviteCreate("camp-1")},
 		{method: http.MethodPost, path: routepath.AppCampaignInviteRevoke("camp-1")},
+		{method: http.MethodPost, path: routepath.AppCampaignListingPublish("camp-1")},
+		{method: http.MethodPost, path: routepath.AppCampaignListingClose("camp-1")},
+		{method: http.MethodPost, path: routepath.AppCampaignApplicationAccept("camp-1")},
+		{method: http.MethodPost, path: routepath.AppCampaignApplicationDecline("camp-1")},
 	} {
 		req := httptest.NewRequest(tc.method, tc.path, nil)
 		if _, pattern := mux.Handler(req); pattern == "" {
@@ -127,7 +127,50 @@ func mapInvitesView(items []campaignapp.CampaignInvite, r *http.Request) []campa
 
 // absolutePublicInviteURL builds an absolute invite URL when the request host is known.
 func absolutePublicInviteURL(r *http.Request, inviteID string) string {
-	path := routepath.PublicInvite(inviteID)
+	return absolutePublicURL(r, routepath.PublicInvite(inviteID))
+}
+
+// mapInviteLinksView projects invite links into render state, grouping claim
+// history under the link it was made through.
+func mapInviteLinksView(links []campaignapp.CampaignInviteLink, claims []campaignapp.CampaignInviteLinkClaim, r *http.Request) []campaignrender.InviteLinkView {
+	claimsByLink := make(map[string][]campaignrender.InviteLinkClaimView, len(links))
+	for _, claim := range claims {
+		label := claim.Username
+		if label != "" {
+			label = "@" + label
+		} else {
+			label = claim.UserID
+		}
+		claimsByLink[claim.LinkID] = append(claimsByLink[claim.LinkID], campaignrender.InviteLinkClaimView{
+			ID:        claim.ID,
+			Claimant:  label,
+			Status:    claim.Status,
+			CreatedAt: claim.CreatedAt,
+		})
+	}
+	result := make([]campaignrender.InviteLinkView, 0, len(links))
+	for _, link := range links {
+		view := campaignrender.InviteLinkView{
+			ID:               link.ID,
+			Role:             link.Role,
+			MaxUses:          link.MaxUses,
+			UseCount:         link.UseCount,
+			ExpiresAt:        link.ExpiresAt,
+			RequiresApproval: link.RequiresApproval,
+			Status:           link.Status,
+			Claims:           claimsByLink[link.ID],
+		}
+		if link.Status == campaignapp.InviteLinkStatusActive {
+			view.PublicURL = absolutePublicURL(r, routepath.PublicInviteLink(link.ID))
+		}
+		result = append(result, view)
+	}
+	return result
+}
+
+// absolutePublicURL builds an absolute public URL when the request host is
+// known.
+func absolutePublicURL(r *http.Request, path string) string {
 	if r == nil {
 		return path
 	}
@@ -170,3 +213,37 @@ func campaignInviteSeatController(value string) string {
 		return ""
 	}
 }
+
+// mapCampaignListingView projects the open-table listing into form state.
+func mapCampaignListingView(listing campaignapp.CampaignListing) campaignrender.CampaignListingView {
+	return campaignrender.CampaignListingView{
+		Published:   listing.Published,
+		Title:       listing.Title,
+		Description: listing.Description,
+		Schedule:    listing.Schedule,
+		Tone:        listing.Tone,
+		SafetyNotes: listing.SafetyNotes,
+		OpenSeats:   listing.OpenSeats,
+	}
+}
+
+// mapCampaignApplicationsView projects applications into render state,
+// preferring applicant usernames over raw user IDs.
+func mapCampaignApplicationsView(applications []campaignapp.CampaignApplication) []campaignrender.CampaignApplicationView {
+	result := make([]campaignrender.CampaignApplicationView, 0, len(applications))
+	for _, application := range applications {
+		applicant := application.ApplicantUserID
+		if application.ApplicantName != "" {
+			applicant = "@" + application.ApplicantName
+		}
+		result = append(result, campaignrender.CampaignApplicationView{
+			ID:               application.ID,
+			Applicant:        applicant,
+			Message:          application.Message,
+			CharacterConcept: application.CharacterConcept,
+			Status:           application.Status,
+			CreatedAt:        application.CreatedAt,
+		})
+	}
+	return result
+}
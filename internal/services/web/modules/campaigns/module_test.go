@@ -894,6 +894,18 @@ func (f fakeGateway) CampaignAIAgents(context.Context) ([]campaignapp.CampaignAI
 	return f.campaignAIAgents, nil
 }
 
+func (fakeGateway) CampaignAIArtifacts(context.Context, string) ([]campaignapp.CampaignAIArtifact, error) {
+	return nil, nil
+}
+
+func (fakeGateway) CampaignAIArtifactRevisions(context.Context, string, string) ([]campaignapp.CampaignAIArtifactRevision, error) {
+	return nil, nil
+}
+
+func (fakeGateway) CampaignAIArtifactDiff(context.Context, string, string, int, int) (campaignapp.CampaignAIArtifactDiff, error) {
+	return campaignapp.CampaignAIArtifactDiff{}, nil
+}
+
 func (f fakeGateway) CampaignParticipants(context.Context, string) ([]campaignapp.CampaignParticipant, error) {
 	if f.participantsErr != nil {
 		return nil, f.participantsErr
@@ -1007,6 +1019,10 @@ func (f fakeGateway) UpdateCampaignAIBinding(context.Context, string, campaignap
 	return f.updateCampaignAIBindingErr
 }
 
+func (fakeGateway) RestoreCampaignAIArtifactRevision(context.Context, string, string, int) error {
+	return nil
+}
+
 func (fakeGateway) StartSession(context.Context, string, campaignapp.StartSessionInput) error {
 	return nil
 }
@@ -141,6 +141,45 @@ func (h Handler) HandleCampaignAIBinding(w http.ResponseWriter, r *http.Request,
 	h.WriteMutationSuccess(w, r, "web.campaigns.notice_ai_binding_saved", routepath.AppCampaign(campaignID))
 }
 
+// HandleCampaignAIArtifactsPage renders AI GM artifact history for campaign
+// managers.
+func (h Handler) HandleCampaignAIArtifactsPage(w http.ResponseWriter, r *http.Request, campaignID string) {
+	ctx, page, ok := h.LoadCampaignPageOrWriteError(w, r, campaignID)
+	if !ok {
+		return
+	}
+	history, err := h.overview.automationReads.CampaignAIArtifactHistory(ctx, campaignID, parseCampaignAIArtifactHistoryQuery(r.URL.Query()))
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	view := campaignAIArtifactsView(page, campaignID, history)
+	h.WriteCampaignDetailPage(
+		w,
+		r,
+		page,
+		campaignID,
+		campaignrender.CampaignAIArtifactsFragment(view, page.Loc),
+		campaignAIArtifactsBreadcrumbs(page, campaignID)...,
+	)
+}
+
+// HandleCampaignAIArtifactRestore rolls one AI GM artifact back to a recorded
+// revision.
+func (h Handler) HandleCampaignAIArtifactRestore(w http.ResponseWriter, r *http.Request, campaignID string) {
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_ai_artifact_restore_form", routepath.AppCampaignAIArtifacts(campaignID)) {
+		return
+	}
+	input := parseRestoreCampaignAIArtifactRevisionInput(r.Form)
+	historyURL := routepath.AppCampaignAIArtifactHistory(campaignID, input.Path)
+	ctx, _ := h.RequestContextAndUserID(r)
+	if err := h.overview.automationMutate.RestoreCampaignAIArtifactRevision(ctx, campaignID, input); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_restore_ai_artifact_revision", historyURL)
+		return
+	}
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_ai_artifact_restored", historyURL)
+}
+
 // HandleCampaignUpdate updates the campaign metadata from the edit page.
 func (h Handler) HandleCampaignUpdate(w http.ResponseWriter, r *http.Request, campaignID string) {
 	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_campaign_update_form", routepath.AppCampaign(campaignID)) {
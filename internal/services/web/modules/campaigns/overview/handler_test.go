@@ -50,8 +50,10 @@ func (overviewAuth) RequireManageInvites(context.Context, string) error      { r
 func (overviewAuth) RequireMutateCharacters(context.Context, string) error   { return nil }
 
 type overviewAutomationReads struct {
-	summary  campaignapp.CampaignAIBindingSummary
-	settings campaignapp.CampaignAIBindingSettings
+	summary   campaignapp.CampaignAIBindingSummary
+	settings  campaignapp.CampaignAIBindingSettings
+	artifacts campaignapp.CampaignAIArtifactHistory
+	lastQuery *campaignapp.CampaignAIArtifactHistoryQuery
 }
 
 func (r overviewAutomationReads) CampaignAIBindingSummary(context.Context, string, string, string) (campaignapp.CampaignAIBindingSummary, error) {
@@ -60,10 +62,17 @@ func (r overviewAutomationReads) CampaignAIBindingSummary(context.Context, strin
 func (r overviewAutomationReads) CampaignAIBindingSettings(context.Context, string, string) (campaignapp.CampaignAIBindingSettings, error) {
 	return r.settings, nil
 }
+func (r overviewAutomationReads) CampaignAIArtifactHistory(_ context.Context, _ string, query campaignapp.CampaignAIArtifactHistoryQuery) (campaignapp.CampaignAIArtifactHistory, error) {
+	if r.lastQuery != nil {
+		*r.lastQuery = query
+	}
+	return r.artifacts, nil
+}
 
 type overviewAutomationMutation struct {
-	lastCampaignID string
-	lastInput      campaignapp.UpdateCampaignAIBindingInput
+	lastCampaignID   string
+	lastInput        campaignapp.UpdateCampaignAIBindingInput
+	lastRestoreInput campaignapp.RestoreCampaignAIArtifactRevisionInput
 }
 
 func (m *overviewAutomationMutation) UpdateCampaignAIBinding(_ context.Context, campaignID string, input campaignapp.UpdateCampaignAIBindingInput) error {
@@ -72,6 +81,12 @@ func (m *overviewAutomationMutation) UpdateCampaignAIBinding(_ context.Context,
 	return nil
 }
 
+func (m *overviewAutomationMutation) RestoreCampaignAIArtifactRevision(_ context.Context, campaignID string, input campaignapp.RestoreCampaignAIArtifactRevisionInput) error {
+	m.lastCampaignID = campaignID
+	m.lastRestoreInput = input
+	return nil
+}
+
 type overviewConfiguration struct {
 	lastCampaignID string
 	lastInput      campaignapp.UpdateCampaignInput
@@ -119,6 +134,24 @@ func newOverviewHandler(t *testing.T, authErr error) (Handler, *overviewAutomati
 					{ID: "agent-1", Label: "Narrator", Enabled: true, Selected: true},
 				},
 			},
+			artifacts: campaignapp.CampaignAIArtifactHistory{
+				Artifacts: []campaignapp.CampaignAIArtifact{
+					{Path: "memory.md"},
+					{Path: "skills.md", ReadOnly: true},
+				},
+				SelectedPath: "memory.md",
+				Revisions: []campaignapp.CampaignAIArtifactRevision{
+					{Number: 2, AuthorKind: "ai_turn", AuthorID: "turn-7", SizeBytes: 12},
+					{Number: 1, AuthorKind: "system"},
+				},
+				Diff: &campaignapp.CampaignAIArtifactDiff{
+					From: 1,
+					To:   2,
+					Lines: []campaignapp.CampaignAIArtifactDiffLine{
+						{Op: "insert", Text: "The duke lied."},
+					},
+				},
+			},
 		},
 		automationMutate: automationMutation,
 		configuration:    configuration,
@@ -257,3 +290,51 @@ func TestHandleCampaignAIBindingRedirectsAndForwardsInput(t *testing.T) {
 		t.Fatalf("AIAgentID = %q, want %q", automationMutation.lastInput.AIAgentID, "agent-2")
 	}
 }
+
+func TestHandleCampaignAIArtifactsPageRendersHistoryAndDiff(t *testing.T) {
+	t.Parallel()
+
+	h, _, _ := newOverviewHandler(t, nil)
+	req := httptest.NewRequest(http.MethodGet, routepath.AppCampaignAIArtifactDiff("camp-1", "memory.md", 1, 0), nil)
+	rr := httptest.NewRecorder()
+
+	h.HandleCampaignAIArtifactsPage(rr, req, "camp-1")
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	for _, marker := range []string{
+		`data-campaign-ai-artifacts-page="true"`,
+		`data-campaign-ai-artifact-path="memory.md"`,
+		`data-campaign-ai-artifact-revision="#2"`,
+		`data-campaign-ai-artifact-restore="true"`,
+		`data-campaign-ai-artifact-diff-op="insert"`,
+		`The duke lied.`,
+	} {
+		if !strings.Contains(body, marker) {
+			t.Fatalf("body missing ai-artifacts marker %q: %q", marker, body)
+		}
+	}
+}
+
+func TestHandleCampaignAIArtifactRestoreRedirectsToHistory(t *testing.T) {
+	t.Parallel()
+
+	h, automationMutation, _ := newOverviewHandler(t, nil)
+	req := httptest.NewRequest(http.MethodPost, routepath.AppCampaignAIArtifactsRestore("camp-1"), strings.NewReader("path=+memory.md+&revision=1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+
+	h.HandleCampaignAIArtifactRestore(rr, req, "camp-1")
+
+	if rr.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if got, want := rr.Header().Get("Location"), routepath.AppCampaignAIArtifactHistory("camp-1", "memory.md"); got != want {
+		t.Fatalf("Location = %q, want %q", got, want)
+	}
+	if automationMutation.lastRestoreInput != (campaignapp.RestoreCampaignAIArtifactRevisionInput{Path: "memory.md", Revision: 1}) {
+		t.Fatalf("restore input = %#v", automationMutation.lastRestoreInput)
+	}
+}
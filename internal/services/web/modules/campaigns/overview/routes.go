@@ -17,4 +17,6 @@ func RegisterStableRoutes(mux *http.ServeMux, h Handler) {
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignEditPattern, h.WithCampaignID(h.HandleCampaignUpdate))
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppCampaignAIBindingPattern, h.WithCampaignID(h.HandleCampaignAIBindingPage))
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignAIBindingPattern, h.WithCampaignID(h.HandleCampaignAIBinding))
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppCampaignAIArtifactsPattern, h.WithCampaignID(h.HandleCampaignAIArtifactsPage))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignAIArtifactsRestorePattern, h.WithCampaignID(h.HandleCampaignAIArtifactRestore))
 }
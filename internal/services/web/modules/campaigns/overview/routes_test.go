@@ -69,6 +69,17 @@ func TestOverviewViewBuildersAndRoutes(t *testing.T) {
 	if got := parseUpdateCampaignAIBindingInput(url.Values{"ai_agent_id": {"  agent-2  "}}); got.AIAgentID != "agent-2" {
 		t.Fatalf("parseUpdateCampaignAIBindingInput() = %#v", got)
 	}
+	if got := parseCampaignAIArtifactHistoryQuery(url.Values{"path": {" memory.md "}, "from": {"3"}, "to": {"nope"}}); got.Path != "memory.md" || got.From != 3 || got.To != 0 {
+		t.Fatalf("parseCampaignAIArtifactHistoryQuery() = %#v", got)
+	}
+	history := campaignapp.CampaignAIArtifactHistory{
+		Artifacts:    []campaignapp.CampaignAIArtifact{{Path: "memory.md"}, {Path: "skills.md", ReadOnly: true}},
+		SelectedPath: "memory.md",
+		Revisions:    []campaignapp.CampaignAIArtifactRevision{{Number: 2}, {Number: 1}},
+	}
+	if got := mapAIArtifactHistoryView(history); !got.Artifacts[0].Selected || got.Revisions[0].CanRestore || !got.Revisions[1].CanRestore || !got.Revisions[0].Latest {
+		t.Fatalf("mapAIArtifactHistoryView() = %#v", got)
+	}
 
 	mux := http.NewServeMux()
 	RegisterStableRoutes(mux, Handler{})
@@ -81,6 +92,8 @@ func TestOverviewViewBuildersAndRoutes(t *testing.T) {
 		{method: http.MethodGet, path: routepath.AppCampaignEdit("camp-1")},
 		{method: http.MethodPost, path: routepath.AppCampaignEdit("camp-1")},
 		{method: http.MethodGet, path: routepath.AppCampaignAIBinding("camp-1")},
+		{method: http.MethodGet, path: routepath.AppCampaignAIArtifacts("camp-1")},
+		{method: http.MethodPost, path: routepath.AppCampaignAIArtifactsRestore("camp-1")},
 	} {
 		req := httptest.NewRequest(tc.method, tc.path, nil)
 		if _, pattern := mux.Handler(req); pattern == "" {
@@ -2,6 +2,7 @@ package overview
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
 
 	sharedtemplates "github.com/louisbranch/fracturing.space/internal/services/shared/templates"
@@ -18,6 +19,7 @@ func overviewView(page *campaigndetail.PageContext, campaignID string, canEdit b
 	view.CanEditCampaign = canEdit
 	view.AIBindingStatus = string(summary.Status)
 	view.CanManageAIBinding = summary.CanManage
+	view.CanViewAIArtifacts = canEdit && summary.Status == campaignapp.CampaignAIBindingStatusConfigured
 	return view
 }
 
@@ -26,6 +28,7 @@ func campaignEditView(page *campaigndetail.PageContext, campaignID string) campa
 	view := campaignrender.CampaignEditPageView{CampaignDetailBaseView: page.BaseDetailView(campaignID)}
 	view.CanEditCampaign = true
 	view.LocaleValue = campaigndetail.CampaignWorkspaceLocaleFormValue(view.Locale)
+	view.ExpectedSeq = page.Workspace.HeadSeq
 	return view
 }
 
@@ -36,6 +39,13 @@ func campaignAIBindingView(page *campaigndetail.PageContext, campaignID string,
 	return view
 }
 
+// campaignAIArtifactsView builds the AI artifact history page view.
+func campaignAIArtifactsView(page *campaigndetail.PageContext, campaignID string, history campaignapp.CampaignAIArtifactHistory) campaignrender.CampaignAIArtifactsPageView {
+	view := campaignrender.CampaignAIArtifactsPageView{CampaignDetailBaseView: page.BaseDetailView(campaignID)}
+	view.AIArtifacts = mapAIArtifactHistoryView(history)
+	return view
+}
+
 // campaignEditBreadcrumbs returns breadcrumbs for the campaign-edit page.
 func campaignEditBreadcrumbs(page *campaigndetail.PageContext, campaignID string) []sharedtemplates.BreadcrumbItem {
 	return []sharedtemplates.BreadcrumbItem{
@@ -52,6 +62,63 @@ func campaignAIBindingBreadcrumbs(page *campaigndetail.PageContext, campaignID s
 	}
 }
 
+// campaignAIArtifactsBreadcrumbs returns breadcrumbs for the AI artifact history page.
+func campaignAIArtifactsBreadcrumbs(page *campaigndetail.PageContext, campaignID string) []sharedtemplates.BreadcrumbItem {
+	return []sharedtemplates.BreadcrumbItem{
+		{Label: webtemplates.T(page.Loc, "game.campaign.menu.overview"), URL: routepath.AppCampaign(campaignID)},
+		{Label: webtemplates.T(page.Loc, "game.campaign.ai_artifacts.title")},
+	}
+}
+
+// mapAIArtifactHistoryView projects app-layer artifact history into render state.
+func mapAIArtifactHistoryView(history campaignapp.CampaignAIArtifactHistory) campaignrender.AIArtifactHistoryView {
+	artifacts := make([]campaignrender.AIArtifactView, 0, len(history.Artifacts))
+	for _, artifact := range history.Artifacts {
+		artifacts = append(artifacts, campaignrender.AIArtifactView{
+			Path:      artifact.Path,
+			ReadOnly:  artifact.ReadOnly,
+			UpdatedAt: artifact.UpdatedAt,
+			Selected:  artifact.Path == history.SelectedPath,
+		})
+	}
+	selectedReadOnly := false
+	for _, artifact := range artifacts {
+		if artifact.Selected {
+			selectedReadOnly = artifact.ReadOnly
+		}
+	}
+	revisions := make([]campaignrender.AIArtifactRevisionView, 0, len(history.Revisions))
+	for idx, revision := range history.Revisions {
+		revisions = append(revisions, campaignrender.AIArtifactRevisionView{
+			Number:     revision.Number,
+			AuthorKind: revision.AuthorKind,
+			AuthorID:   revision.AuthorID,
+			SizeBytes:  revision.SizeBytes,
+			CreatedAt:  revision.CreatedAt,
+			Latest:     idx == 0,
+			CanRestore: idx > 0 && !selectedReadOnly,
+		})
+	}
+	view := campaignrender.AIArtifactHistoryView{
+		Unavailable:  history.Unavailable,
+		Artifacts:    artifacts,
+		SelectedPath: history.SelectedPath,
+		Revisions:    revisions,
+	}
+	if history.Diff != nil {
+		lines := make([]campaignrender.AIArtifactDiffLineView, 0, len(history.Diff.Lines))
+		for _, line := range history.Diff.Lines {
+			lines = append(lines, campaignrender.AIArtifactDiffLineView{Op: line.Op, Text: line.Text})
+		}
+		view.Diff = &campaignrender.AIArtifactDiffView{
+			From:  history.Diff.From,
+			To:    history.Diff.To,
+			Lines: lines,
+		}
+	}
+	return view
+}
+
 // mapAIBindingSettingsView projects app-layer AI settings into render state.
 func mapAIBindingSettingsView(settings campaignapp.CampaignAIBindingSettings) campaignrender.AIBindingSettingsView {
 	options := make([]campaignrender.AIAgentOptionView, 0, len(settings.Options))
@@ -77,14 +144,44 @@ func parseUpdateCampaignAIBindingInput(form url.Values) campaignapp.UpdateCampai
 	}
 }
 
+// parseCampaignAIArtifactHistoryQuery normalizes artifact history query
+// parameters; malformed revision numbers are ignored.
+func parseCampaignAIArtifactHistoryQuery(query url.Values) campaignapp.CampaignAIArtifactHistoryQuery {
+	return campaignapp.CampaignAIArtifactHistoryQuery{
+		Path: strings.TrimSpace(query.Get("path")),
+		From: parseRevisionNumber(query.Get("from")),
+		To:   parseRevisionNumber(query.Get("to")),
+	}
+}
+
+// parseRestoreCampaignAIArtifactRevisionInput normalizes the artifact rollback form.
+func parseRestoreCampaignAIArtifactRevisionInput(form url.Values) campaignapp.RestoreCampaignAIArtifactRevisionInput {
+	return campaignapp.RestoreCampaignAIArtifactRevisionInput{
+		Path:     strings.TrimSpace(form.Get("path")),
+		Revision: parseRevisionNumber(form.Get("revision")),
+	}
+}
+
+// parseRevisionNumber parses one positive revision number, returning zero for
+// missing or malformed values.
+func parseRevisionNumber(raw string) int {
+	value, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || value < 0 {
+		return 0
+	}
+	return value
+}
+
 // parseUpdateCampaignInput normalizes the campaign edit form into pointer fields.
 func parseUpdateCampaignInput(form url.Values) campaignapp.UpdateCampaignInput {
 	name := strings.TrimSpace(form.Get("name"))
 	themePrompt := strings.TrimSpace(form.Get("theme_prompt"))
 	locale := strings.TrimSpace(form.Get("locale"))
+	expectedSeq, _ := strconv.ParseUint(strings.TrimSpace(form.Get("expected_seq")), 10, 64)
 	return campaignapp.UpdateCampaignInput{
 		Name:        &name,
 		ThemePrompt: &themePrompt,
 		Locale:      &locale,
+		ExpectedSeq: expectedSeq,
 	}
 }
@@ -12,6 +12,7 @@ func TestParseUpdateCampaignInputTrimsWhitespace(t *testing.T) {
 		"name":         {"  Voyage  "},
 		"theme_prompt": {"  storm  "},
 		"locale":       {"  pt-BR  "},
+		"expected_seq": {" 9 "},
 	})
 	if campaign.Name == nil || campaign.ThemePrompt == nil || campaign.Locale == nil {
 		t.Fatalf("campaign patch pointers should be set: %#v", campaign)
@@ -19,4 +20,7 @@ func TestParseUpdateCampaignInputTrimsWhitespace(t *testing.T) {
 	if *campaign.Name != "Voyage" || *campaign.ThemePrompt != "storm" || *campaign.Locale != "pt-BR" {
 		t.Fatalf("campaign input = %#v", campaign)
 	}
+	if campaign.ExpectedSeq != 9 {
+		t.Fatalf("campaign expected seq = %d, want 9", campaign.ExpectedSeq)
+	}
 }
@@ -9,6 +9,35 @@ import (
 type InvitesPageView struct {
 	CampaignDetailBaseView
 	Invites []InviteView
+	// InviteLinksEnabled shows the shareable-link section for invite managers.
+	InviteLinksEnabled bool
+	InviteLinks        []InviteLinkView
+	// RecruitmentEnabled shows the open-table listing and application review
+	// section for invite managers.
+	RecruitmentEnabled bool
+	Listing            CampaignListingView
+	Applications       []CampaignApplicationView
+}
+
+// CampaignListingView carries open-table listing form state.
+type CampaignListingView struct {
+	Published   bool
+	Title       string
+	Description string
+	Schedule    string
+	Tone        string
+	SafetyNotes string
+	OpenSeats   int
+}
+
+// CampaignApplicationView carries one player application for GM review.
+type CampaignApplicationView struct {
+	ID               string
+	Applicant        string
+	Message          string
+	CharacterConcept string
+	Status           string
+	CreatedAt        string
 }
 
 // InviteCreatePageView carries invite-create page state only.
@@ -43,3 +72,24 @@ type InviteSeatOptionView struct {
 	ParticipantID string
 	Label         string
 }
+
+// InviteLinkView carries one shareable invite link and its claim history.
+type InviteLinkView struct {
+	ID               string
+	Role             string
+	MaxUses          int
+	UseCount         int
+	ExpiresAt        string
+	RequiresApproval bool
+	Status           string
+	PublicURL        string
+	Claims           []InviteLinkClaimView
+}
+
+// InviteLinkClaimView carries one claim made through an invite link.
+type InviteLinkClaimView struct {
+	ID        string
+	Claimant  string
+	Status    string
+	CreatedAt string
+}
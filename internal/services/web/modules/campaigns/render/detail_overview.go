@@ -10,11 +10,13 @@ type OverviewPageView struct {
 	CampaignDetailBaseView
 	AIBindingStatus    string
 	CanManageAIBinding bool
+	CanViewAIArtifacts bool
 }
 
 // CampaignEditPageView carries overview-edit page state only.
 type CampaignEditPageView struct {
 	CampaignDetailBaseView
+	ExpectedSeq uint64
 }
 
 // CampaignAIBindingPageView carries dedicated campaign AI-binding page state.
@@ -31,6 +33,54 @@ type AIBindingSettingsView struct {
 	Options     []AIAgentOptionView
 }
 
+// CampaignAIArtifactsPageView carries AI GM artifact history page state.
+type CampaignAIArtifactsPageView struct {
+	CampaignDetailBaseView
+	AIArtifacts AIArtifactHistoryView
+}
+
+// AIArtifactHistoryView keeps AI artifact history state local to
+// overview-owned rendering.
+type AIArtifactHistoryView struct {
+	Unavailable  bool
+	Artifacts    []AIArtifactView
+	SelectedPath string
+	Revisions    []AIArtifactRevisionView
+	Diff         *AIArtifactDiffView
+}
+
+// AIArtifactView carries one selectable AI artifact.
+type AIArtifactView struct {
+	Path      string
+	ReadOnly  bool
+	UpdatedAt string
+	Selected  bool
+}
+
+// AIArtifactRevisionView carries one recorded AI artifact revision row.
+type AIArtifactRevisionView struct {
+	Number     int
+	AuthorKind string
+	AuthorID   string
+	SizeBytes  int
+	CreatedAt  string
+	Latest     bool
+	CanRestore bool
+}
+
+// AIArtifactDiffView carries one revision comparison.
+type AIArtifactDiffView struct {
+	From  int
+	To    int
+	Lines []AIArtifactDiffLineView
+}
+
+// AIArtifactDiffLineView carries one line of a revision comparison.
+type AIArtifactDiffLineView struct {
+	Op   string
+	Text string
+}
+
 // OverviewFragment renders the campaign overview page.
 func OverviewFragment(view OverviewPageView, loc webtemplates.Localizer) templ.Component {
 	return overviewFragment(view, loc)
@@ -45,3 +95,8 @@ func CampaignEditFragment(view CampaignEditPageView, loc webtemplates.Localizer)
 func CampaignAIBindingFragment(view CampaignAIBindingPageView, loc webtemplates.Localizer) templ.Component {
 	return campaignAIBindingFragment(view, loc)
 }
+
+// CampaignAIArtifactsFragment renders the AI GM artifact history page.
+func CampaignAIArtifactsFragment(view CampaignAIArtifactsPageView, loc webtemplates.Localizer) templ.Component {
+	return campaignAIArtifactsFragment(view, loc)
+}
@@ -23,6 +23,16 @@ type SessionDetailPageView struct {
 	CampaignDetailBaseView
 	SessionID string
 	Sessions  []SessionView
+	// RecapDraft is set when an AI-drafted recap exists for a session the
+	// viewer may manage.
+	RecapDraft *SessionRecapDraftView
+}
+
+// SessionRecapDraftView carries the AI recap draft under GM review.
+type SessionRecapDraftView struct {
+	Markdown  string
+	Published bool
+	UpdatedAt string
 }
 
 // SessionsFragment renders the session-list page.
@@ -1,6 +1,7 @@
 package render
 
 import (
+	"strconv"
 	"strings"
 
 	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
@@ -34,3 +35,92 @@ func campaignInviteCanRevoke(status string) bool {
 func campaignInviteCreateReady(view InviteCreatePageView) bool {
 	return !campaignActionsLocked(view.ActionsLocked) && len(view.InviteSeatOptions) > 0
 }
+
+// campaignInviteLinkStatusLabel resolves invite-link availability copy.
+func campaignInviteLinkStatusLabel(loc webtemplates.Localizer, value string) string {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "active":
+		return webtemplates.T(loc, "game.campaign_invites.links.status_active")
+	case "revoked":
+		return webtemplates.T(loc, "game.campaign_invites.value_revoked")
+	case "expired":
+		return webtemplates.T(loc, "game.campaign_invites.links.status_expired")
+	case "exhausted":
+		return webtemplates.T(loc, "game.campaign_invites.links.status_exhausted")
+	default:
+		return webtemplates.T(loc, "game.campaign_invites.value_unspecified")
+	}
+}
+
+// campaignInviteLinkClaimStatusLabel resolves claim-history status copy.
+func campaignInviteLinkClaimStatusLabel(loc webtemplates.Localizer, value string) string {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "pending":
+		return webtemplates.T(loc, "game.campaign_invites.links.claim_pending")
+	case "joined":
+		return webtemplates.T(loc, "game.campaign_invites.links.claim_joined")
+	case "declined":
+		return webtemplates.T(loc, "game.campaign_invites.value_declined")
+	default:
+		return webtemplates.T(loc, "game.campaign_invites.value_unspecified")
+	}
+}
+
+// campaignInviteLinkRoleLabel resolves the seat role a link grants.
+func campaignInviteLinkRoleLabel(loc webtemplates.Localizer, value string) string {
+	if strings.EqualFold(strings.TrimSpace(value), "gm") {
+		return webtemplates.T(loc, "game.campaign_invites.links.role_gm")
+	}
+	return webtemplates.T(loc, "game.campaign_invites.links.role_player")
+}
+
+// campaignInviteLinkUsesLabel renders link usage against its optional limit.
+func campaignInviteLinkUsesLabel(loc webtemplates.Localizer, link InviteLinkView) string {
+	if link.MaxUses <= 0 {
+		return webtemplates.T(loc, "game.campaign_invites.links.uses_unlimited", link.UseCount)
+	}
+	return webtemplates.T(loc, "game.campaign_invites.links.uses_limited", link.UseCount, link.MaxUses)
+}
+
+// campaignInviteLinkExpiryLabel renders link expiry or the no-expiry copy.
+func campaignInviteLinkExpiryLabel(loc webtemplates.Localizer, link InviteLinkView) string {
+	if strings.TrimSpace(link.ExpiresAt) == "" {
+		return webtemplates.T(loc, "game.campaign_invites.links.expires_never")
+	}
+	return link.ExpiresAt
+}
+
+// campaignInviteLinkClaimPending limits approve/decline affordances to
+// pending claims.
+func campaignInviteLinkClaimPending(status string) bool {
+	return strings.EqualFold(strings.TrimSpace(status), "pending")
+}
+
+// campaignApplicationStatusLabel resolves open-table application status copy.
+func campaignApplicationStatusLabel(loc webtemplates.Localizer, value string) string {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "pending":
+		return webtemplates.T(loc, "game.campaign_invites.value_pending")
+	case "accepted":
+		return webtemplates.T(loc, "game.campaign_invites.recruitment.status_accepted")
+	case "declined":
+		return webtemplates.T(loc, "game.campaign_invites.value_declined")
+	default:
+		return webtemplates.T(loc, "game.campaign_invites.value_unspecified")
+	}
+}
+
+// campaignApplicationPending limits accept/decline affordances to pending
+// applications.
+func campaignApplicationPending(status string) bool {
+	return strings.EqualFold(strings.TrimSpace(status), "pending")
+}
+
+// campaignListingOpenSeatsValue pre-fills the open-seat input with a sensible
+// default for unpublished listings.
+func campaignListingOpenSeatsValue(listing CampaignListingView) string {
+	if listing.OpenSeats <= 0 {
+		return "1"
+	}
+	return strconv.Itoa(listing.OpenSeats)
+}
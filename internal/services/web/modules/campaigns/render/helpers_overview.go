@@ -1,6 +1,7 @@
 package render
 
 import (
+	"strconv"
 	"strings"
 
 	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
@@ -108,3 +109,57 @@ func campaignOverviewAIBindingStatus(loc webtemplates.Localizer, value string) s
 func campaignAIBindingCurrentUnbound(settings AIBindingSettingsView) bool {
 	return strings.TrimSpace(settings.CurrentID) == ""
 }
+
+// campaignAIArtifactRevisionLabel formats one revision number for history rows.
+func campaignAIArtifactRevisionLabel(loc webtemplates.Localizer, number int) string {
+	return webtemplates.T(loc, "game.campaign.ai_artifacts.revision_label", number)
+}
+
+// campaignAIArtifactSize formats one revision size in bytes.
+func campaignAIArtifactSize(loc webtemplates.Localizer, sizeBytes int) string {
+	return webtemplates.T(loc, "game.campaign.ai_artifacts.size_bytes", sizeBytes)
+}
+
+// campaignAIArtifactAuthor maps revision author kinds to localized copy.
+func campaignAIArtifactAuthor(loc webtemplates.Localizer, revision AIArtifactRevisionView) string {
+	switch strings.TrimSpace(revision.AuthorKind) {
+	case "ai_turn":
+		if strings.TrimSpace(revision.AuthorID) == "" {
+			return webtemplates.T(loc, "game.campaign.ai_artifacts.author_ai_turn")
+		}
+		return webtemplates.T(loc, "game.campaign.ai_artifacts.author_ai_turn_id", revision.AuthorID)
+	case "user":
+		return webtemplates.T(loc, "game.campaign.ai_artifacts.author_user")
+	default:
+		return webtemplates.T(loc, "game.campaign.ai_artifacts.author_system")
+	}
+}
+
+// campaignAIArtifactDiffLineClass colors one diff line by operation.
+func campaignAIArtifactDiffLineClass(op string) string {
+	switch op {
+	case "insert":
+		return "bg-success/20"
+	case "delete":
+		return "bg-error/20"
+	default:
+		return "opacity-70"
+	}
+}
+
+// campaignAIArtifactDiffLinePrefix returns the unified-diff marker for one line.
+func campaignAIArtifactDiffLinePrefix(op string) string {
+	switch op {
+	case "insert":
+		return "+ "
+	case "delete":
+		return "- "
+	default:
+		return "  "
+	}
+}
+
+// campaignAIArtifactRevisionNumber formats one revision number for form values.
+func campaignAIArtifactRevisionNumber(number int) string {
+	return strconv.Itoa(number)
+}
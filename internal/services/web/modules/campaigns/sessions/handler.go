@@ -22,6 +22,7 @@ type ServiceConfig struct {
 	Characters    campaignapp.CharacterReadServiceConfig
 	Mutation      campaignapp.SessionMutationServiceConfig
 	Participants  campaignapp.ParticipantReadServiceConfig
+	Recaps        campaignapp.SessionRecapServiceConfig
 	Authorization campaignapp.AuthorizationGateway
 }
 
@@ -30,6 +31,9 @@ type HandlerServices struct {
 	characters   campaignapp.CampaignCharacterReadService
 	mutation     campaignapp.CampaignSessionMutationService
 	participants campaignapp.CampaignParticipantReadService
+	// recaps is nil when the AI service is not configured; recap review is
+	// then hidden from session pages.
+	recaps campaignapp.CampaignSessionRecapService
 }
 
 // NewHandlerServices keeps session transport dependencies owned by the session
@@ -47,11 +51,19 @@ func NewHandlerServices(config ServiceConfig) (HandlerServices, error) {
 	if err != nil {
 		return HandlerServices{}, fmt.Errorf("session-participant-reads: %w", err)
 	}
-	return HandlerServices{
+	services := HandlerServices{
 		characters:   characters,
 		mutation:     mutation,
 		participants: participants,
-	}, nil
+	}
+	if config.Recaps.Recaps != nil {
+		recaps, err := campaignapp.NewSessionRecapService(config.Recaps, config.Authorization)
+		if err != nil {
+			return HandlerServices{}, fmt.Errorf("session-recaps: %w", err)
+		}
+		services.recaps = recaps
+	}
+	return services, nil
 }
 
 // Handler owns session detail/lifecycle plus play-launch routes.
@@ -140,7 +152,7 @@ func (h Handler) HandleSessionCreate(w http.ResponseWriter, r *http.Request, cam
 
 // HandleSessionDetail renders the selected session page.
 func (h Handler) HandleSessionDetail(w http.ResponseWriter, r *http.Request, campaignID, sessionID string) {
-	_, page, ok := h.LoadCampaignPageOrWriteError(w, r, campaignID)
+	ctx, page, ok := h.LoadCampaignPageOrWriteError(w, r, campaignID)
 	if !ok {
 		return
 	}
@@ -149,6 +161,16 @@ func (h Handler) HandleSessionDetail(w http.ResponseWriter, r *http.Request, cam
 		return
 	}
 	view := sessionDetailView(page, campaignID, sessionID)
+	if h.sessions.recaps != nil && page.CanManageSession {
+		draft, found, err := h.sessions.recaps.SessionRecapDraft(ctx, campaignID, sessionID)
+		if err != nil {
+			h.WriteError(w, r, err)
+			return
+		}
+		if found {
+			view.RecapDraft = mapSessionRecapDraftView(draft)
+		}
+	}
 	h.WriteCampaignDetailPage(
 		w,
 		r,
@@ -173,6 +195,42 @@ func (h Handler) HandleSessionEnd(w http.ResponseWriter, r *http.Request, campai
 	h.WriteMutationSuccess(w, r, "web.campaigns.notice_session_ended", routepath.AppCampaignSessions(campaignID))
 }
 
+// HandleSessionRecapSave stores GM edits to the session recap draft.
+func (h Handler) HandleSessionRecapSave(w http.ResponseWriter, r *http.Request, campaignID, sessionID string) {
+	redirectURL := routepath.AppCampaignSession(campaignID, sessionID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_session_recap_form", redirectURL) {
+		return
+	}
+	if h.sessions.recaps == nil {
+		h.WriteError(w, r, apperrors.E(apperrors.KindNotFound, "session recap drafts are not available"))
+		return
+	}
+	ctx, _ := h.RequestContextAndUserID(r)
+	if err := h.sessions.recaps.UpdateSessionRecapDraft(ctx, campaignID, parseSessionRecapDraftInput(sessionID, r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_save_session_recap", redirectURL)
+		return
+	}
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_session_recap_saved", redirectURL)
+}
+
+// HandleSessionRecapPublish approves the draft and records it on the session.
+func (h Handler) HandleSessionRecapPublish(w http.ResponseWriter, r *http.Request, campaignID, sessionID string) {
+	redirectURL := routepath.AppCampaignSession(campaignID, sessionID)
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_parse_session_recap_form", redirectURL) {
+		return
+	}
+	if h.sessions.recaps == nil {
+		h.WriteError(w, r, apperrors.E(apperrors.KindNotFound, "session recap drafts are not available"))
+		return
+	}
+	ctx, _ := h.RequestContextAndUserID(r)
+	if err := h.sessions.recaps.PublishSessionRecap(ctx, campaignID, parseSessionRecapDraftInput(sessionID, r.Form)); err != nil {
+		h.WriteMutationError(w, r, err, "error.web.message.failed_to_publish_session_recap", redirectURL)
+		return
+	}
+	h.WriteMutationSuccess(w, r, "web.campaigns.notice_session_recap_published", redirectURL)
+}
+
 // HandleGame redirects the campaign game route into the dedicated play
 // surface.
 func (h Handler) HandleGame(w http.ResponseWriter, r *http.Request, campaignID string) {
@@ -348,3 +348,79 @@ func TestHandleGameWritesHXRedirectForHTMXRequests(t *testing.T) {
 		t.Fatalf("HX-Redirect = %q, want play launch redirect", location)
 	}
 }
+
+type sessionRecaps struct {
+	draft       campaignapp.CampaignSessionRecapDraft
+	found       bool
+	lastSave    campaignapp.SessionRecapDraftInput
+	lastPublish campaignapp.SessionRecapDraftInput
+}
+
+func (s *sessionRecaps) SessionRecapDraft(context.Context, string, string) (campaignapp.CampaignSessionRecapDraft, bool, error) {
+	return s.draft, s.found, nil
+}
+func (s *sessionRecaps) UpdateSessionRecapDraft(_ context.Context, _ string, input campaignapp.SessionRecapDraftInput) error {
+	s.lastSave = input
+	return nil
+}
+func (s *sessionRecaps) PublishSessionRecap(_ context.Context, _ string, input campaignapp.SessionRecapDraftInput) error {
+	s.lastPublish = input
+	return nil
+}
+
+func TestHandleSessionDetailRendersPendingRecapDraft(t *testing.T) {
+	t.Parallel()
+
+	h, _, _ := newSessionsHandler(t)
+	h.sessions.recaps = &sessionRecaps{
+		draft: campaignapp.CampaignSessionRecapDraft{Status: campaignapp.SessionRecapDraftStatusPending, Markdown: "The party crossed the bridge."},
+		found: true,
+	}
+	req := httptest.NewRequest(http.MethodGet, routepath.AppCampaignSession("camp-1", "sess-1"), nil)
+	rr := httptest.NewRecorder()
+
+	h.HandleSessionDetail(rr, req, "camp-1", "sess-1")
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	for _, marker := range []string{
+		`data-campaign-session-recap-form="true"`,
+		`data-campaign-session-recap-publish="true"`,
+		"The party crossed the bridge.",
+	} {
+		if !strings.Contains(body, marker) {
+			t.Fatalf("body missing recap marker %q: %q", marker, body)
+		}
+	}
+}
+
+func TestHandleSessionRecapSaveAndPublishRedirectToSession(t *testing.T) {
+	t.Parallel()
+
+	h, _, _ := newSessionsHandler(t)
+	recaps := &sessionRecaps{}
+	h.sessions.recaps = recaps
+	for name, handle := range map[string]func(http.ResponseWriter, *http.Request, string, string){
+		"save":    h.HandleSessionRecapSave,
+		"publish": h.HandleSessionRecapPublish,
+	} {
+		req := httptest.NewRequest(http.MethodPost, routepath.AppCampaignSessionRecap("camp-1", "sess-1"), strings.NewReader("markdown=+Edited+recap+"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+
+		handle(rr, req, "camp-1", "sess-1")
+
+		if rr.Code != http.StatusFound {
+			t.Fatalf("%s status = %d, want %d", name, rr.Code, http.StatusFound)
+		}
+		if got := rr.Header().Get("Location"); got != routepath.AppCampaignSession("camp-1", "sess-1") {
+			t.Fatalf("%s Location = %q", name, got)
+		}
+	}
+	want := campaignapp.SessionRecapDraftInput{SessionID: "sess-1", Markdown: "Edited recap"}
+	if recaps.lastSave != want || recaps.lastPublish != want {
+		t.Fatalf("recap inputs = save %#v publish %#v, want %#v", recaps.lastSave, recaps.lastPublish, want)
+	}
+}
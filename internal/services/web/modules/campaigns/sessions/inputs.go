@@ -28,6 +28,14 @@ func parseStartSessionInput(form url.Values) campaignapp.StartSessionInput {
 	}
 }
 
+// parseSessionRecapDraftInput normalizes the recap review form into app input.
+func parseSessionRecapDraftInput(sessionID string, form url.Values) campaignapp.SessionRecapDraftInput {
+	return campaignapp.SessionRecapDraftInput{
+		SessionID: strings.TrimSpace(sessionID),
+		Markdown:  strings.TrimSpace(form.Get("markdown")),
+	}
+}
+
 // parseEndSessionInput normalizes the session-end form into app input.
 func parseEndSessionInput(form url.Values) campaignapp.EndSessionInput {
 	return campaignapp.EndSessionInput{SessionID: strings.TrimSpace(form.Get("session_id"))}
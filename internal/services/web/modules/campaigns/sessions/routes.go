@@ -15,6 +15,8 @@ func RegisterStableRoutes(mux *http.ServeMux, h Handler) {
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppCampaignSessionCreatePattern, h.WithCampaignID(h.HandleSessionCreatePage))
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignSessionCreatePattern, h.WithCampaignID(h.HandleSessionCreate))
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppCampaignSessionPattern, h.WithCampaignAndSessionID(h.HandleSessionDetail))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignSessionRecapPattern, h.WithCampaignAndSessionID(h.HandleSessionRecapSave))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignSessionRecapPublishPattern, h.WithCampaignAndSessionID(h.HandleSessionRecapPublish))
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppCampaignSessionEndPattern, h.WithCampaignID(h.HandleSessionEnd))
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppCampaignGamePattern, h.WithCampaignID(h.HandleGame))
 }
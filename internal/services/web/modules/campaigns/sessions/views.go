@@ -55,6 +55,15 @@ func sessionDetailView(page *campaigndetail.PageContext, campaignID, sessionID s
 	return view
 }
 
+// mapSessionRecapDraftView projects the AI recap draft into render state.
+func mapSessionRecapDraftView(draft campaignapp.CampaignSessionRecapDraft) *campaignrender.SessionRecapDraftView {
+	return &campaignrender.SessionRecapDraftView{
+		Markdown:  draft.Markdown,
+		Published: draft.Published(),
+		UpdatedAt: draft.UpdatedAt,
+	}
+}
+
 // sessionDetailBreadcrumbs returns breadcrumbs for the session-detail page.
 func sessionDetailBreadcrumbs(page *campaigndetail.PageContext, campaignID string, view campaignrender.SessionDetailPageView) []sharedtemplates.BreadcrumbItem {
 	return []sharedtemplates.BreadcrumbItem{
@@ -36,6 +36,18 @@ func (f *fakeStatusClient) ClearOverride(context.Context, *statusv1.ClearOverrid
 	return &statusv1.ClearOverrideResponse{}, nil
 }
 
+func (f *fakeStatusClient) ListStatusHistory(context.Context, *statusv1.ListStatusHistoryRequest, ...grpc.CallOption) (*statusv1.ListStatusHistoryResponse, error) {
+	return &statusv1.ListStatusHistoryResponse{}, nil
+}
+
+func (f *fakeStatusClient) ListCapabilityUptime(context.Context, *statusv1.ListCapabilityUptimeRequest, ...grpc.CallOption) (*statusv1.ListCapabilityUptimeResponse, error) {
+	return &statusv1.ListCapabilityUptimeResponse{}, nil
+}
+
+func (f *fakeStatusClient) ListIncidents(context.Context, *statusv1.ListIncidentsRequest, ...grpc.CallOption) (*statusv1.ListIncidentsResponse, error) {
+	return &statusv1.ListIncidentsResponse{}, nil
+}
+
 type fakeUserHubClient struct{}
 
 func (fakeUserHubClient) GetDashboard(context.Context, *userhubv1.GetDashboardRequest, ...grpc.CallOption) (*userhubv1.GetDashboardResponse, error) {
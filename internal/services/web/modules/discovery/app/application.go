@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/userid"
+)
+
+const (
+	maxApplicationMessageLength = 2000
+	maxApplicationConceptLength = 1000
+)
+
+// ApplicationInput carries one player's application to an open table.
+type ApplicationInput struct {
+	Message          string
+	CharacterConcept string
+}
+
+// ApplicationGateway submits campaign applications on behalf of a viewer.
+type ApplicationGateway interface {
+	SubmitApplication(context.Context, string, string, ApplicationInput) error
+}
+
+// ApplicationService exposes the player-side open-table application workflow.
+type ApplicationService interface {
+	SubmitApplication(context.Context, string, string, ApplicationInput) error
+}
+
+// applicationService validates application input before it reaches the
+// invite service.
+type applicationService struct {
+	gateway ApplicationGateway
+}
+
+// NewApplicationService constructs the application service with fail-closed
+// defaults.
+func NewApplicationService(gateway ApplicationGateway) ApplicationService {
+	if gateway == nil {
+		gateway = unavailableApplicationGateway{}
+	}
+	return applicationService{gateway: gateway}
+}
+
+// SubmitApplication applies to one open table for the signed-in viewer.
+func (s applicationService) SubmitApplication(ctx context.Context, viewerUserID string, campaignID string, input ApplicationInput) error {
+	viewerUserID, err := userid.Require(viewerUserID)
+	if err != nil {
+		return err
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return apperrors.E(apperrors.KindNotFound, "open table not found")
+	}
+	input.Message = strings.TrimSpace(input.Message)
+	input.CharacterConcept = strings.TrimSpace(input.CharacterConcept)
+	if input.Message == "" {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.application_message_required", "application message is required")
+	}
+	if utf8.RuneCountInString(input.Message) > maxApplicationMessageLength ||
+		utf8.RuneCountInString(input.CharacterConcept) > maxApplicationConceptLength {
+		return apperrors.EK(apperrors.KindInvalidInput, "error.web.message.application_too_long", "application is too long")
+	}
+	return s.gateway.SubmitApplication(ctx, viewerUserID, campaignID, input)
+}
+
+// unavailableApplicationGateway fails closed when the discovery module lacks
+// invite wiring.
+type unavailableApplicationGateway struct{}
+
+// SubmitApplication rejects applications when no invite transport is
+// configured.
+func (unavailableApplicationGateway) SubmitApplication(context.Context, string, string, ApplicationInput) error {
+	return apperrors.E(apperrors.KindUnavailable, "invite service client is not configured")
+}
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/userid"
+)
+
+// Pregen is the app-layer model for one curated pregenerated character.
+type Pregen struct {
+	EntryID     string
+	Name        string
+	Pronouns    string
+	Summary     string
+	Playstyle   string
+	System      string
+	Difficulty  string
+	Level       int32
+	Tags        []string
+	Description string
+	Background  string
+	Connections string
+	Traits      []PregenTrait
+	Experiences []PregenExperience
+}
+
+// PregenTrait stores one trait score keyed by its lowercase trait name.
+type PregenTrait struct {
+	Name  string
+	Value int32
+}
+
+// PregenExperience stores one starting experience line.
+type PregenExperience struct {
+	Name     string
+	Modifier int32
+}
+
+// PregenCampaign is one viewer campaign that can receive a pregen.
+type PregenCampaign struct {
+	ID   string
+	Name string
+}
+
+// PregenPage stores one pregen preview plus the viewer's eligible campaigns.
+type PregenPage struct {
+	Pregen       Pregen
+	ViewerUserID string
+	Campaigns    []PregenCampaign
+}
+
+// PregenGateway lists eligible campaigns and instantiates pregens on behalf
+// of a viewer.
+type PregenGateway interface {
+	ListPregenCampaigns(context.Context, string) ([]PregenCampaign, error)
+	CreatePregenCharacter(context.Context, string, string, string) (string, error)
+}
+
+// PregenService exposes the pregen preview and "use this pregen" workflow.
+type PregenService interface {
+	LoadPregen(context.Context, string, string) (PregenPage, error)
+	UsePregen(context.Context, string, string, string) (string, error)
+}
+
+// pregenService combines discovery reads with game-backed instantiation.
+type pregenService struct {
+	discovery Gateway
+	game      PregenGateway
+	logger    *slog.Logger
+}
+
+// NewPregenService constructs the pregen service with fail-closed defaults.
+func NewPregenService(discovery Gateway, game PregenGateway, logger *slog.Logger) PregenService {
+	if discovery == nil {
+		discovery = NewUnavailableGateway()
+	}
+	if game == nil {
+		game = unavailablePregenGateway{}
+	}
+	return pregenService{discovery: discovery, game: game, logger: logger}
+}
+
+// LoadPregen resolves one pregen and, for signed-in viewers, the campaigns it
+// can be added to. Campaign lookup is best-effort so the preview still renders.
+func (s pregenService) LoadPregen(ctx context.Context, viewerUserID string, entryID string) (PregenPage, error) {
+	entryID = strings.TrimSpace(entryID)
+	if entryID == "" {
+		return PregenPage{}, apperrors.E(apperrors.KindNotFound, "pregen not found")
+	}
+	pregen, err := s.discovery.GetPregen(ctx, entryID)
+	if err != nil {
+		return PregenPage{}, err
+	}
+	page := PregenPage{Pregen: pregen, ViewerUserID: strings.TrimSpace(viewerUserID)}
+	if page.ViewerUserID == "" {
+		return page, nil
+	}
+	campaigns, err := s.game.ListPregenCampaigns(ctx, page.ViewerUserID)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.Warn("pregen campaigns unavailable", "error", err)
+		}
+		return page, nil
+	}
+	page.Campaigns = campaigns
+	return page, nil
+}
+
+// UsePregen creates the pregen as a new character in the chosen campaign and
+// returns the created character ID.
+func (s pregenService) UsePregen(ctx context.Context, viewerUserID string, entryID string, campaignID string) (string, error) {
+	viewerUserID, err := userid.Require(viewerUserID)
+	if err != nil {
+		return "", err
+	}
+	entryID = strings.TrimSpace(entryID)
+	if entryID == "" {
+		return "", apperrors.E(apperrors.KindNotFound, "pregen not found")
+	}
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return "", apperrors.EK(apperrors.KindInvalidInput, "error.web.message.pregen_campaign_required", "campaign is required")
+	}
+	return s.game.CreatePregenCharacter(ctx, viewerUserID, campaignID, entryID)
+}
+
+// unavailablePregenGateway fails closed when the discovery module lacks game
+// wiring.
+type unavailablePregenGateway struct{}
+
+// ListPregenCampaigns reports that no game transport is configured.
+func (unavailablePregenGateway) ListPregenCampaigns(context.Context, string) ([]PregenCampaign, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "game service client is not configured")
+}
+
+// CreatePregenCharacter rejects pregen use when no game transport is
+// configured.
+func (unavailablePregenGateway) CreatePregenCharacter(context.Context, string, string, string) (string, error) {
+	return "", apperrors.E(apperrors.KindUnavailable, "game service client is not configured")
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"log/slog"
+	"strings"
 
 	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
 )
@@ -21,6 +22,27 @@ type StarterEntry struct {
 	Players     string
 }
 
+// OpenTable is the app-layer model for a live campaign advertising open seats.
+type OpenTable struct {
+	CampaignID  string
+	Title       string
+	Description string
+	System      string
+	GmMode      string
+	Schedule    string
+	Tone        string
+	SafetyNotes string
+	OpenSeats   int32
+}
+
+// OpenTablePage stores one open-table listing plus viewer-specific state for
+// rendering the application form.
+type OpenTablePage struct {
+	Table        OpenTable
+	ViewerUserID string
+	CanApply     bool
+}
+
 // PageStatus describes the public discovery page availability contract.
 type PageStatus string
 
@@ -34,17 +56,24 @@ const (
 // Gateway loads discovery entries from the backing discovery service.
 type Gateway interface {
 	ListStarterEntries(context.Context) ([]StarterEntry, error)
+	ListOpenTables(context.Context) ([]OpenTable, error)
+	GetOpenTable(context.Context, string) (OpenTable, error)
+	ListPregens(context.Context) ([]Pregen, error)
+	GetPregen(context.Context, string) (Pregen, error)
 }
 
 // Page is the explicit discovery-page contract returned to transport.
 type Page struct {
-	Status  PageStatus
-	Entries []StarterEntry
+	Status     PageStatus
+	Entries    []StarterEntry
+	OpenTables []OpenTable
+	Pregens    []Pregen
 }
 
 // Service orchestrates discovery-page loading.
 type Service interface {
 	LoadPage(context.Context) Page
+	LoadOpenTable(context.Context, string, string) (OpenTablePage, error)
 }
 
 // service defines an internal contract used at this web package boundary.
@@ -85,26 +114,94 @@ func NewService(gateway Gateway, logger *slog.Logger) Service {
 // LoadPage returns a discovery page contract. Dependency failures are surfaced
 // as a degraded page instead of being hidden in handlers.
 func (s service) LoadPage(ctx context.Context) Page {
+	openTables := s.loadOpenTables(ctx)
+	pregens := s.loadPregens(ctx)
 	entries, err := s.gateway.ListStarterEntries(ctx)
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Warn("discovery starters unavailable", "error", err)
 		}
-		return Page{Status: PageStatusUnavailable}
+		return Page{Status: PageStatusUnavailable, OpenTables: openTables, Pregens: pregens}
 	}
 	if len(entries) == 0 {
 		if s.logger != nil {
 			s.logger.Warn("discovery starters unavailable", "reason", "zero_entries")
 		}
-		return Page{Status: PageStatusUnavailable}
+		return Page{Status: PageStatusUnavailable, OpenTables: openTables, Pregens: pregens}
 	}
 	return Page{
-		Status:  PageStatusReady,
-		Entries: entries,
+		Status:     PageStatusReady,
+		Entries:    entries,
+		OpenTables: openTables,
+		Pregens:    pregens,
+	}
+}
+
+// loadPregens keeps pregenerated characters optional alongside starters.
+func (s service) loadPregens(ctx context.Context) []Pregen {
+	pregens, err := s.gateway.ListPregens(ctx)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.Warn("discovery pregens unavailable", "error", err)
+		}
+		return nil
 	}
+	return pregens
+}
+
+// loadOpenTables keeps open-table listings optional: the curated starter
+// catalog still renders when listings cannot be loaded.
+func (s service) loadOpenTables(ctx context.Context) []OpenTable {
+	tables, err := s.gateway.ListOpenTables(ctx)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.Warn("discovery open tables unavailable", "error", err)
+		}
+		return nil
+	}
+	return tables
+}
+
+// LoadOpenTable resolves one open-table listing and whether the viewer can
+// apply from the landing page.
+func (s service) LoadOpenTable(ctx context.Context, viewerUserID string, campaignID string) (OpenTablePage, error) {
+	campaignID = strings.TrimSpace(campaignID)
+	if campaignID == "" {
+		return OpenTablePage{}, apperrors.E(apperrors.KindNotFound, "open table not found")
+	}
+	table, err := s.gateway.GetOpenTable(ctx, campaignID)
+	if err != nil {
+		return OpenTablePage{}, err
+	}
+	viewerUserID = strings.TrimSpace(viewerUserID)
+	return OpenTablePage{
+		Table:        table,
+		ViewerUserID: viewerUserID,
+		CanApply:     viewerUserID != "",
+	}, nil
 }
 
 // ListStarterEntries always returns an unavailable error.
 func (unavailableGateway) ListStarterEntries(context.Context) ([]StarterEntry, error) {
 	return nil, apperrors.E(apperrors.KindUnavailable, "discovery service client is not configured")
 }
+
+// ListOpenTables always returns an unavailable error.
+func (unavailableGateway) ListOpenTables(context.Context) ([]OpenTable, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "discovery service client is not configured")
+}
+
+// GetOpenTable always returns an unavailable error.
+func (unavailableGateway) GetOpenTable(context.Context, string) (OpenTable, error) {
+	return OpenTable{}, apperrors.E(apperrors.KindUnavailable, "discovery service client is not configured")
+}
+
+// ListPregens always returns an unavailable error.
+func (unavailableGateway) ListPregens(context.Context) ([]Pregen, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "discovery service client is not configured")
+}
+
+// GetPregen always returns an unavailable error.
+func (unavailableGateway) GetPregen(context.Context, string) (Pregen, error) {
+	return Pregen{}, apperrors.E(apperrors.KindUnavailable, "discovery service client is not configured")
+}
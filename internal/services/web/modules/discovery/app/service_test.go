@@ -7,14 +7,52 @@ import (
 )
 
 type gatewayStub struct {
-	entries []StarterEntry
-	err     error
+	entries  []StarterEntry
+	err      error
+	tables   []OpenTable
+	tableErr error
+	pregens  []Pregen
 }
 
 func (g gatewayStub) ListStarterEntries(context.Context) ([]StarterEntry, error) {
 	return g.entries, g.err
 }
 
+func (g gatewayStub) ListOpenTables(context.Context) ([]OpenTable, error) {
+	return g.tables, g.tableErr
+}
+
+func (g gatewayStub) GetOpenTable(_ context.Context, campaignID string) (OpenTable, error) {
+	if g.tableErr != nil {
+		return OpenTable{}, g.tableErr
+	}
+	return OpenTable{CampaignID: campaignID, Title: "Open Table"}, nil
+}
+
+func (g gatewayStub) ListPregens(context.Context) ([]Pregen, error) {
+	return g.pregens, nil
+}
+
+func (g gatewayStub) GetPregen(_ context.Context, entryID string) (Pregen, error) {
+	return Pregen{EntryID: entryID, Name: "Vex Marrow"}, nil
+}
+
+type pregenGatewayStub struct {
+	campaigns  []PregenCampaign
+	calls      int
+	campaignID string
+}
+
+func (g *pregenGatewayStub) ListPregenCampaigns(context.Context, string) ([]PregenCampaign, error) {
+	return g.campaigns, nil
+}
+
+func (g *pregenGatewayStub) CreatePregenCharacter(_ context.Context, _ string, campaignID string, _ string) (string, error) {
+	g.calls++
+	g.campaignID = campaignID
+	return "char-1", nil
+}
+
 func TestNewServiceWithoutGatewayUsesExplicitDegradedContract(t *testing.T) {
 	t.Parallel()
 
@@ -69,3 +107,142 @@ func TestIsGatewayHealthy(t *testing.T) {
 		t.Fatal("configured gateway should be healthy")
 	}
 }
+
+func TestLoadPageKeepsOpenTablesWhenStartersAreUnavailable(t *testing.T) {
+	t.Parallel()
+
+	page := NewService(gatewayStub{
+		err:    errors.New("boom"),
+		tables: []OpenTable{{CampaignID: "camp-1", Title: "Open Table"}},
+	}, nil).LoadPage(context.Background())
+	if page.Status != PageStatusUnavailable {
+		t.Fatalf("Status = %q, want %q", page.Status, PageStatusUnavailable)
+	}
+	if len(page.OpenTables) != 1 {
+		t.Fatalf("len(OpenTables) = %d, want 1", len(page.OpenTables))
+	}
+}
+
+func TestLoadOpenTableOffersApplyOnlyToSignedInViewers(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(gatewayStub{}, nil)
+	anonymous, err := svc.LoadOpenTable(context.Background(), "", "camp-1")
+	if err != nil {
+		t.Fatalf("LoadOpenTable() error = %v", err)
+	}
+	if anonymous.CanApply {
+		t.Fatal("anonymous viewer should not be able to apply")
+	}
+	signedIn, err := svc.LoadOpenTable(context.Background(), "user-1", "camp-1")
+	if err != nil {
+		t.Fatalf("LoadOpenTable() error = %v", err)
+	}
+	if !signedIn.CanApply || signedIn.Table.CampaignID != "camp-1" {
+		t.Fatalf("page = %+v, want applyable camp-1", signedIn)
+	}
+}
+
+type applicationGatewayStub struct {
+	calls int
+	input ApplicationInput
+}
+
+func (g *applicationGatewayStub) SubmitApplication(_ context.Context, _ string, _ string, input ApplicationInput) error {
+	g.calls++
+	g.input = input
+	return nil
+}
+
+func TestSubmitApplicationValidatesInput(t *testing.T) {
+	t.Parallel()
+
+	gateway := &applicationGatewayStub{}
+	svc := NewApplicationService(gateway)
+	if err := svc.SubmitApplication(context.Background(), "", "camp-1", ApplicationInput{Message: "hi"}); err == nil {
+		t.Fatal("expected error for anonymous viewer")
+	}
+	if err := svc.SubmitApplication(context.Background(), "user-1", "camp-1", ApplicationInput{Message: "  "}); err == nil {
+		t.Fatal("expected error for blank message")
+	}
+	if gateway.calls != 0 {
+		t.Fatalf("gateway calls = %d, want 0", gateway.calls)
+	}
+	if err := svc.SubmitApplication(context.Background(), "user-1", "camp-1", ApplicationInput{Message: " Fridays work "}); err != nil {
+		t.Fatalf("SubmitApplication() error = %v", err)
+	}
+	if gateway.calls != 1 || gateway.input.Message != "Fridays work" {
+		t.Fatalf("gateway calls = %d input = %+v", gateway.calls, gateway.input)
+	}
+}
+
+func TestNewApplicationServiceWithoutGatewayFailsClosed(t *testing.T) {
+	t.Parallel()
+
+	err := NewApplicationService(nil).SubmitApplication(context.Background(), "user-1", "camp-1", ApplicationInput{Message: "hi"})
+	if err == nil {
+		t.Fatal("expected unavailable error")
+	}
+}
+
+func TestLoadPageKeepsPregensWhenStartersAreUnavailable(t *testing.T) {
+	t.Parallel()
+
+	page := NewService(gatewayStub{pregens: []Pregen{{EntryID: "pregen:vex-marrow"}}}, nil).LoadPage(context.Background())
+	if page.Status != PageStatusUnavailable || len(page.Pregens) != 1 {
+		t.Fatalf("page = %+v, want unavailable starters with one pregen", page)
+	}
+}
+
+func TestLoadPregenListsCampaignsOnlyForSignedInViewers(t *testing.T) {
+	t.Parallel()
+
+	game := &pregenGatewayStub{campaigns: []PregenCampaign{{ID: "camp-1", Name: "Starfall"}}}
+	svc := NewPregenService(gatewayStub{}, game, nil)
+
+	anonymous, err := svc.LoadPregen(context.Background(), "", "pregen:vex-marrow")
+	if err != nil {
+		t.Fatalf("LoadPregen() error = %v", err)
+	}
+	if len(anonymous.Campaigns) != 0 {
+		t.Fatalf("anonymous campaigns = %+v, want none", anonymous.Campaigns)
+	}
+	signedIn, err := svc.LoadPregen(context.Background(), "user-1", "pregen:vex-marrow")
+	if err != nil {
+		t.Fatalf("LoadPregen() error = %v", err)
+	}
+	if len(signedIn.Campaigns) != 1 || signedIn.Pregen.Name != "Vex Marrow" {
+		t.Fatalf("page = %+v", signedIn)
+	}
+}
+
+func TestUsePregenValidatesInput(t *testing.T) {
+	t.Parallel()
+
+	game := &pregenGatewayStub{}
+	svc := NewPregenService(gatewayStub{}, game, nil)
+	if _, err := svc.UsePregen(context.Background(), "", "pregen:vex-marrow", "camp-1"); err == nil {
+		t.Fatal("expected error for anonymous viewer")
+	}
+	if _, err := svc.UsePregen(context.Background(), "user-1", "pregen:vex-marrow", "  "); err == nil {
+		t.Fatal("expected error for blank campaign")
+	}
+	if game.calls != 0 {
+		t.Fatalf("gateway calls = %d, want 0", game.calls)
+	}
+	characterID, err := svc.UsePregen(context.Background(), "user-1", "pregen:vex-marrow", " camp-1 ")
+	if err != nil {
+		t.Fatalf("UsePregen() error = %v", err)
+	}
+	if characterID != "char-1" || game.campaignID != "camp-1" {
+		t.Fatalf("UsePregen() = %q campaign = %q", characterID, game.campaignID)
+	}
+}
+
+func TestNewPregenServiceWithoutGatewayFailsClosed(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPregenService(nil, nil, nil).UsePregen(context.Background(), "user-1", "pregen:vex-marrow", "camp-1"); err == nil {
+		t.Fatal("expected unavailable error")
+	}
+}
@@ -6,13 +6,20 @@ import (
 	module "github.com/louisbranch/fracturing.space/internal/services/web/module"
 	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
 	discoverygateway "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/gateway"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/requestmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
 )
 
 // CompositionConfig owns the startup wiring required to construct the
 // production discovery module.
 type CompositionConfig struct {
-	Client discoverygateway.DiscoveryClient
-	Logger *slog.Logger
+	Client            discoverygateway.DiscoveryClient
+	ApplicationClient discoverygateway.ApplicationClient
+	CampaignClient    discoverygateway.CampaignClient
+	CharacterClient   discoverygateway.CharacterClient
+	RequestMeta       requestmeta.SchemePolicy
+	Principal         principal.PrincipalResolver
+	Logger            *slog.Logger
 }
 
 // Compose builds the discovery module from the exact startup dependencies the
@@ -20,6 +27,14 @@ type CompositionConfig struct {
 func Compose(config CompositionConfig) module.Module {
 	gateway := discoverygateway.NewGRPCGateway(config.Client)
 	return New(Config{
-		Service: discoveryapp.NewService(gateway, config.Logger),
+		Service:      discoveryapp.NewService(gateway, config.Logger),
+		Applications: discoveryapp.NewApplicationService(discoverygateway.NewGRPCApplicationGateway(config.ApplicationClient)),
+		Pregens: discoveryapp.NewPregenService(
+			gateway,
+			discoverygateway.NewGRPCPregenGateway(config.Client, config.CampaignClient, config.CharacterClient),
+			config.Logger,
+		),
+		RequestMeta: config.RequestMeta,
+		Principal:   config.Principal,
 	})
 }
@@ -2,6 +2,8 @@ package discovery
 
 import (
 	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	grpc "google.golang.org/grpc"
 
 	discoverygateway "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/gateway"
@@ -9,7 +11,10 @@ import (
 
 // Dependencies contains discovery feature clients.
 type Dependencies struct {
-	DiscoveryClient discoverygateway.DiscoveryClient
+	DiscoveryClient   discoverygateway.DiscoveryClient
+	ApplicationClient discoverygateway.ApplicationClient
+	CampaignClient    discoverygateway.CampaignClient
+	CharacterClient   discoverygateway.CharacterClient
 }
 
 // BindDependency wires discovery-backed clients into the discovery dependency
@@ -20,3 +25,22 @@ func BindDependency(deps *Dependencies, conn *grpc.ClientConn) {
 	}
 	deps.DiscoveryClient = discoveryv1.NewDiscoveryServiceClient(conn)
 }
+
+// BindInviteDependency wires the invite-backed application client into the
+// discovery dependency set.
+func BindInviteDependency(deps *Dependencies, conn *grpc.ClientConn) {
+	if deps == nil || conn == nil {
+		return
+	}
+	deps.ApplicationClient = invitev1.NewInviteServiceClient(conn)
+}
+
+// BindGameDependency wires the game-backed campaign and character clients used
+// to instantiate pregens into the discovery dependency set.
+func BindGameDependency(deps *Dependencies, conn *grpc.ClientConn) {
+	if deps == nil || conn == nil {
+		return
+	}
+	deps.CampaignClient = statev1.NewCampaignServiceClient(conn)
+	deps.CharacterClient = statev1.NewCharacterServiceClient(conn)
+}
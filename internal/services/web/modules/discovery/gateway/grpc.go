@@ -15,6 +15,8 @@ import (
 // DiscoveryClient exposes discovery operations needed by the discovery module.
 type DiscoveryClient interface {
 	ListDiscoveryEntries(ctx context.Context, in *discoveryv1.ListDiscoveryEntriesRequest, opts ...grpc.CallOption) (*discoveryv1.ListDiscoveryEntriesResponse, error)
+	GetCampaignListing(ctx context.Context, in *discoveryv1.GetCampaignListingRequest, opts ...grpc.CallOption) (*discoveryv1.GetCampaignListingResponse, error)
+	GetCharacterTemplate(ctx context.Context, in *discoveryv1.GetCharacterTemplateRequest, opts ...grpc.CallOption) (*discoveryv1.GetCharacterTemplateResponse, error)
 }
 
 // GRPCGateway implements discoveryapp.Gateway backed by the discovery gRPC service.
@@ -58,6 +60,158 @@ func (g GRPCGateway) ListStarterEntries(ctx context.Context) ([]discoveryapp.Sta
 	return results, nil
 }
 
+// ListOpenTables fetches the live campaigns currently advertising open seats.
+func (g GRPCGateway) ListOpenTables(ctx context.Context) ([]discoveryapp.OpenTable, error) {
+	resp, err := g.client.ListDiscoveryEntries(ctx, &discoveryv1.ListDiscoveryEntriesRequest{
+		PageSize: 50,
+		Kind:     discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_OPEN_TABLE,
+	})
+	if err != nil {
+		return nil, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_list_discovery_entries",
+			FallbackMessage: "discovery service is unavailable",
+		})
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	var results []discoveryapp.OpenTable
+	for _, entry := range resp.GetEntries() {
+		if strings.TrimSpace(entry.GetSourceId()) == "" {
+			continue
+		}
+		results = append(results, mapProtoToOpenTable(entry))
+	}
+	return results, nil
+}
+
+// GetOpenTable fetches one campaign's open-table listing.
+func (g GRPCGateway) GetOpenTable(ctx context.Context, campaignID string) (discoveryapp.OpenTable, error) {
+	resp, err := g.client.GetCampaignListing(ctx, &discoveryv1.GetCampaignListingRequest{CampaignId: campaignID})
+	if err != nil {
+		return discoveryapp.OpenTable{}, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_list_discovery_entries",
+			FallbackMessage: "discovery service is unavailable",
+		})
+	}
+	if resp == nil || resp.GetEntry() == nil {
+		return discoveryapp.OpenTable{}, apperrors.E(apperrors.KindNotFound, "open table not found")
+	}
+	return mapProtoToOpenTable(resp.GetEntry()), nil
+}
+
+// ListPregens fetches the curated pregenerated characters.
+func (g GRPCGateway) ListPregens(ctx context.Context) ([]discoveryapp.Pregen, error) {
+	resp, err := g.client.ListDiscoveryEntries(ctx, &discoveryv1.ListDiscoveryEntriesRequest{
+		PageSize: 50,
+		Kind:     discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE,
+	})
+	if err != nil {
+		return nil, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_list_discovery_entries",
+			FallbackMessage: "discovery service is unavailable",
+		})
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	var results []discoveryapp.Pregen
+	for _, entry := range resp.GetEntries() {
+		if strings.TrimSpace(entry.GetEntryId()) == "" {
+			continue
+		}
+		results = append(results, mapProtoEntryToPregen(entry))
+	}
+	return results, nil
+}
+
+// GetPregen fetches one pregenerated character with its full build.
+func (g GRPCGateway) GetPregen(ctx context.Context, entryID string) (discoveryapp.Pregen, error) {
+	resp, err := g.client.GetCharacterTemplate(ctx, &discoveryv1.GetCharacterTemplateRequest{EntryId: entryID})
+	if err != nil {
+		return discoveryapp.Pregen{}, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_list_discovery_entries",
+			FallbackMessage: "discovery service is unavailable",
+		})
+	}
+	if resp == nil || resp.GetTemplate() == nil {
+		return discoveryapp.Pregen{}, apperrors.E(apperrors.KindNotFound, "pregen not found")
+	}
+	return mapProtoToPregen(resp.GetTemplate()), nil
+}
+
+// mapProtoEntryToPregen converts a CHARACTER_TEMPLATE entry to a pregen card.
+func mapProtoEntryToPregen(l *discoveryv1.DiscoveryEntry) discoveryapp.Pregen {
+	return discoveryapp.Pregen{
+		EntryID:    strings.TrimSpace(l.GetEntryId()),
+		Name:       strings.TrimSpace(l.GetPreviewCharacterName()),
+		Summary:    strings.TrimSpace(l.GetPreviewCharacterSummary()),
+		Playstyle:  strings.TrimSpace(l.GetPreviewPlaystyleLabel()),
+		System:     gameSystemLabel(l.GetSystem()),
+		Difficulty: difficultyLabel(l.GetDifficultyTier()),
+		Level:      l.GetLevel(),
+		Tags:       l.GetTags(),
+	}
+}
+
+// mapProtoToPregen converts a full character template to a pregen preview.
+func mapProtoToPregen(template *discoveryv1.CharacterTemplate) discoveryapp.Pregen {
+	pregen := mapProtoEntryToPregen(template.GetEntry())
+	if name := strings.TrimSpace(template.GetName()); name != "" {
+		pregen.Name = name
+	}
+	if summary := strings.TrimSpace(template.GetSummary()); summary != "" {
+		pregen.Summary = summary
+	}
+	pregen.Pronouns = strings.TrimSpace(template.GetPronouns())
+
+	workflow := template.GetDaggerheart()
+	if workflow == nil {
+		return pregen
+	}
+	pregen.Description = strings.TrimSpace(workflow.GetDetailsInput().GetDescription())
+	pregen.Background = strings.TrimSpace(workflow.GetBackgroundInput().GetBackground())
+	pregen.Connections = strings.TrimSpace(workflow.GetConnectionsInput().GetConnections())
+	if traits := workflow.GetTraitsInput(); traits != nil {
+		pregen.Traits = []discoveryapp.PregenTrait{
+			{Name: "agility", Value: traits.GetAgility()},
+			{Name: "strength", Value: traits.GetStrength()},
+			{Name: "finesse", Value: traits.GetFinesse()},
+			{Name: "instinct", Value: traits.GetInstinct()},
+			{Name: "presence", Value: traits.GetPresence()},
+			{Name: "knowledge", Value: traits.GetKnowledge()},
+		}
+	}
+	for _, experience := range workflow.GetExperiencesInput().GetExperiences() {
+		pregen.Experiences = append(pregen.Experiences, discoveryapp.PregenExperience{
+			Name:     strings.TrimSpace(experience.GetName()),
+			Modifier: experience.GetModifier(),
+		})
+	}
+	return pregen
+}
+
+// mapProtoToOpenTable converts a proto DiscoveryEntry to an open-table listing.
+func mapProtoToOpenTable(l *discoveryv1.DiscoveryEntry) discoveryapp.OpenTable {
+	return discoveryapp.OpenTable{
+		CampaignID:  strings.TrimSpace(l.GetSourceId()),
+		Title:       strings.TrimSpace(l.GetTitle()),
+		Description: strings.TrimSpace(l.GetDescription()),
+		System:      gameSystemLabel(l.GetSystem()),
+		GmMode:      gmModeLabel(l.GetGmMode()),
+		Schedule:    strings.TrimSpace(l.GetScheduleLabel()),
+		Tone:        strings.TrimSpace(l.GetTone()),
+		SafetyNotes: strings.TrimSpace(l.GetSafetyNotes()),
+		OpenSeats:   l.GetOpenSeats(),
+	}
+}
+
 // mapProtoToStarterEntry converts a proto DiscoveryEntry to a presentation-ready StarterEntry.
 func mapProtoToStarterEntry(l *discoveryv1.DiscoveryEntry) discoveryapp.StarterEntry {
 	return discoveryapp.StarterEntry{
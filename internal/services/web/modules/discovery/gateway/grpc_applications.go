@@ -0,0 +1,49 @@
+package gateway
+
+import (
+	"context"
+
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+)
+
+// ApplicationClient exposes the invite-service application submission used by
+// open-table landing pages.
+type ApplicationClient interface {
+	SubmitCampaignApplication(ctx context.Context, in *invitev1.SubmitCampaignApplicationRequest, opts ...grpc.CallOption) (*invitev1.SubmitCampaignApplicationResponse, error)
+}
+
+// GRPCApplicationGateway implements discoveryapp.ApplicationGateway backed by
+// the invite gRPC service.
+type GRPCApplicationGateway struct {
+	client ApplicationClient
+}
+
+// NewGRPCApplicationGateway returns the production application gateway, or
+// nil when the invite client is not configured.
+func NewGRPCApplicationGateway(client ApplicationClient) discoveryapp.ApplicationGateway {
+	if client == nil {
+		return nil
+	}
+	return GRPCApplicationGateway{client: client}
+}
+
+// SubmitApplication submits one application as the signed-in viewer.
+func (g GRPCApplicationGateway) SubmitApplication(ctx context.Context, viewerUserID string, campaignID string, input discoveryapp.ApplicationInput) error {
+	_, err := g.client.SubmitCampaignApplication(grpcauthctx.WithUserID(ctx, viewerUserID), &invitev1.SubmitCampaignApplicationRequest{
+		CampaignId:       campaignID,
+		Message:          input.Message,
+		CharacterConcept: input.CharacterConcept,
+	})
+	if err != nil {
+		return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_submit_application",
+			FallbackMessage: "failed to submit application",
+		})
+	}
+	return nil
+}
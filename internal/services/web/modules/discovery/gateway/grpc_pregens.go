@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/pronouns"
+	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/grpcpaging"
+	"google.golang.org/grpc"
+)
+
+// CampaignClient exposes the game campaign reads used to pick a pregen
+// destination.
+type CampaignClient interface {
+	ListCampaigns(ctx context.Context, in *statev1.ListCampaignsRequest, opts ...grpc.CallOption) (*statev1.ListCampaignsResponse, error)
+	GetCampaign(ctx context.Context, in *statev1.GetCampaignRequest, opts ...grpc.CallOption) (*statev1.GetCampaignResponse, error)
+}
+
+// CharacterClient exposes the game character mutations that instantiate a
+// pregen.
+type CharacterClient interface {
+	CreateCharacter(ctx context.Context, in *statev1.CreateCharacterRequest, opts ...grpc.CallOption) (*statev1.CreateCharacterResponse, error)
+	ApplyCharacterCreationWorkflow(ctx context.Context, in *statev1.ApplyCharacterCreationWorkflowRequest, opts ...grpc.CallOption) (*statev1.ApplyCharacterCreationWorkflowResponse, error)
+	DeleteCharacter(ctx context.Context, in *statev1.DeleteCharacterRequest, opts ...grpc.CallOption) (*statev1.DeleteCharacterResponse, error)
+}
+
+// GRPCPregenGateway implements discoveryapp.PregenGateway by replaying a
+// discovery character template through the game creation workflow.
+type GRPCPregenGateway struct {
+	discovery DiscoveryClient
+	campaign  CampaignClient
+	character CharacterClient
+}
+
+// NewGRPCPregenGateway returns the production pregen gateway, or nil when any
+// required client is not configured.
+func NewGRPCPregenGateway(discovery DiscoveryClient, campaign CampaignClient, character CharacterClient) discoveryapp.PregenGateway {
+	if discovery == nil || campaign == nil || character == nil {
+		return nil
+	}
+	return GRPCPregenGateway{discovery: discovery, campaign: campaign, character: character}
+}
+
+// pregenCampaignPageSize matches game's campaign list page cap.
+const pregenCampaignPageSize = 10
+
+// ListPregenCampaigns returns the viewer's live Daggerheart campaigns across
+// every page, so a campaign past the first page can still receive a pregen.
+func (g GRPCPregenGateway) ListPregenCampaigns(ctx context.Context, viewerUserID string) ([]discoveryapp.PregenCampaign, error) {
+	return grpcpaging.CollectPages[discoveryapp.PregenCampaign, *statev1.Campaign](
+		grpcauthctx.WithUserID(ctx, viewerUserID), pregenCampaignPageSize,
+		func(ctx context.Context, pageToken string) ([]*statev1.Campaign, string, error) {
+			resp, err := g.campaign.ListCampaigns(ctx, &statev1.ListCampaignsRequest{
+				PageSize:  pregenCampaignPageSize,
+				PageToken: pageToken,
+				Statuses:  []statev1.CampaignStatus{statev1.CampaignStatus_DRAFT, statev1.CampaignStatus_ACTIVE},
+			})
+			if err != nil {
+				return nil, "", apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+					FallbackKind:    apperrors.KindUnavailable,
+					FallbackKey:     "error.web.message.failed_to_list_campaigns",
+					FallbackMessage: "failed to list campaigns",
+				})
+			}
+			if resp == nil {
+				return nil, "", nil
+			}
+			return resp.GetCampaigns(), resp.GetNextPageToken(), nil
+		},
+		func(campaign *statev1.Campaign) (discoveryapp.PregenCampaign, bool) {
+			if campaign.GetSystem() != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+				return discoveryapp.PregenCampaign{}, false
+			}
+			campaignID := strings.TrimSpace(campaign.GetId())
+			if campaignID == "" {
+				return discoveryapp.PregenCampaign{}, false
+			}
+			return discoveryapp.PregenCampaign{ID: campaignID, Name: strings.TrimSpace(campaign.GetName())}, true
+		},
+	)
+}
+
+// CreatePregenCharacter creates a player character in the campaign and applies
+// the template's full creation workflow. Game validates each step against the
+// campaign's content catalog; a rejected workflow removes the half-built
+// character so the campaign is left unchanged.
+func (g GRPCPregenGateway) CreatePregenCharacter(ctx context.Context, viewerUserID string, campaignID string, entryID string) (string, error) {
+	ctx = grpcauthctx.WithUserID(ctx, viewerUserID)
+	templateResp, err := g.discovery.GetCharacterTemplate(ctx, &discoveryv1.GetCharacterTemplateRequest{EntryId: entryID})
+	if err != nil {
+		return "", mapPregenError(err)
+	}
+	template := templateResp.GetTemplate()
+	workflow := template.GetDaggerheart()
+	if workflow == nil {
+		return "", apperrors.E(apperrors.KindNotFound, "pregen not found")
+	}
+
+	campaignResp, err := g.campaign.GetCampaign(ctx, &statev1.GetCampaignRequest{CampaignId: campaignID})
+	if err != nil {
+		return "", mapPregenError(err)
+	}
+	if campaignResp.GetCampaign().GetSystem() != template.GetEntry().GetSystem() {
+		return "", apperrors.EK(apperrors.KindInvalidInput, "error.web.message.pregen_system_mismatch", "pregen does not match the campaign game system")
+	}
+
+	created, err := g.character.CreateCharacter(ctx, &statev1.CreateCharacterRequest{
+		CampaignId: campaignID,
+		Name:       strings.TrimSpace(template.GetName()),
+		Pronouns:   pronouns.ToProto(strings.TrimSpace(template.GetPronouns())),
+		Kind:       statev1.CharacterKind_PC,
+		Notes:      strings.TrimSpace(template.GetSummary()),
+	})
+	if err != nil {
+		return "", mapPregenError(err)
+	}
+	characterID := strings.TrimSpace(created.GetCharacter().GetId())
+	if characterID == "" {
+		return "", apperrors.EK(apperrors.KindUnknown, "error.web.message.created_character_id_was_empty", "created character id was empty")
+	}
+
+	_, err = g.character.ApplyCharacterCreationWorkflow(ctx, &statev1.ApplyCharacterCreationWorkflowRequest{
+		CampaignId:     campaignID,
+		CharacterId:    characterID,
+		SystemWorkflow: &statev1.ApplyCharacterCreationWorkflowRequest_Daggerheart{Daggerheart: workflow},
+	})
+	if err != nil {
+		_, _ = g.character.DeleteCharacter(ctx, &statev1.DeleteCharacterRequest{CampaignId: campaignID, CharacterId: characterID})
+		return "", mapPregenError(err)
+	}
+	return characterID, nil
+}
+
+// mapPregenError keeps pregen failures on one localized fallback message.
+func mapPregenError(err error) error {
+	return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+		FallbackKind:    apperrors.KindUnknown,
+		FallbackKey:     "error.web.message.failed_to_use_pregen",
+		FallbackMessage: "failed to use pregen",
+	})
+}
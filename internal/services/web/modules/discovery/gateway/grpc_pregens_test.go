@@ -0,0 +1,174 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type pregenCampaignClientStub struct {
+	system    commonv1.GameSystem
+	campaigns []*statev1.Campaign
+	// pages, when set, serves campaigns one page per token: "" then "1", "2"...
+	pages  [][]*statev1.Campaign
+	userID string
+}
+
+func (s *pregenCampaignClientStub) ListCampaigns(ctx context.Context, in *statev1.ListCampaignsRequest, _ ...grpc.CallOption) (*statev1.ListCampaignsResponse, error) {
+	s.userID = grpcauthctx.UserIDFromOutgoingContext(ctx)
+	if len(s.pages) == 0 {
+		return &statev1.ListCampaignsResponse{Campaigns: s.campaigns}, nil
+	}
+	page := 0
+	if token := in.GetPageToken(); token != "" {
+		page = int(token[0] - '0')
+	}
+	resp := &statev1.ListCampaignsResponse{Campaigns: s.pages[page]}
+	if page+1 < len(s.pages) {
+		resp.NextPageToken = string(rune('0' + page + 1))
+	}
+	return resp, nil
+}
+
+func (s *pregenCampaignClientStub) GetCampaign(_ context.Context, in *statev1.GetCampaignRequest, _ ...grpc.CallOption) (*statev1.GetCampaignResponse, error) {
+	return &statev1.GetCampaignResponse{Campaign: &statev1.Campaign{Id: in.GetCampaignId(), System: s.system}}, nil
+}
+
+type pregenCharacterClientStub struct {
+	created     *statev1.CreateCharacterRequest
+	applied     *statev1.ApplyCharacterCreationWorkflowRequest
+	applyErr    error
+	deletedChar string
+}
+
+func (s *pregenCharacterClientStub) CreateCharacter(_ context.Context, in *statev1.CreateCharacterRequest, _ ...grpc.CallOption) (*statev1.CreateCharacterResponse, error) {
+	s.created = in
+	return &statev1.CreateCharacterResponse{Character: &statev1.Character{Id: "char-1"}}, nil
+}
+
+func (s *pregenCharacterClientStub) ApplyCharacterCreationWorkflow(_ context.Context, in *statev1.ApplyCharacterCreationWorkflowRequest, _ ...grpc.CallOption) (*statev1.ApplyCharacterCreationWorkflowResponse, error) {
+	s.applied = in
+	return &statev1.ApplyCharacterCreationWorkflowResponse{}, s.applyErr
+}
+
+func (s *pregenCharacterClientStub) DeleteCharacter(_ context.Context, in *statev1.DeleteCharacterRequest, _ ...grpc.CallOption) (*statev1.DeleteCharacterResponse, error) {
+	s.deletedChar = in.GetCharacterId()
+	return &statev1.DeleteCharacterResponse{}, nil
+}
+
+func TestNewGRPCPregenGatewayRequiresAllClients(t *testing.T) {
+	t.Parallel()
+
+	if NewGRPCPregenGateway(discoveryClientStub{}, nil, &pregenCharacterClientStub{}) != nil {
+		t.Fatal("expected nil gateway without campaign client")
+	}
+}
+
+func TestCreatePregenCharacterReplaysTemplateWorkflow(t *testing.T) {
+	t.Parallel()
+
+	characters := &pregenCharacterClientStub{}
+	gateway := NewGRPCPregenGateway(discoveryClientStub{}, &pregenCampaignClientStub{system: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART}, characters)
+	characterID, err := gateway.CreatePregenCharacter(context.Background(), "user-1", "camp-1", "pregen:vex-marrow")
+	if err != nil {
+		t.Fatalf("CreatePregenCharacter() error = %v", err)
+	}
+	if characterID != "char-1" {
+		t.Fatalf("characterID = %q, want char-1", characterID)
+	}
+	if characters.created.GetName() != "Vex Marrow" || characters.created.GetKind() != statev1.CharacterKind_PC {
+		t.Fatalf("create request = %+v", characters.created)
+	}
+	if characters.applied.GetCharacterId() != "char-1" || characters.applied.GetDaggerheart().GetTraitsInput().GetFinesse() != 2 {
+		t.Fatalf("workflow request = %+v", characters.applied)
+	}
+}
+
+func TestCreatePregenCharacterRemovesCharacterWhenWorkflowFails(t *testing.T) {
+	t.Parallel()
+
+	characters := &pregenCharacterClientStub{applyErr: status.Error(codes.InvalidArgument, "domain card is not available")}
+	gateway := NewGRPCPregenGateway(discoveryClientStub{}, &pregenCampaignClientStub{system: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART}, characters)
+	_, err := gateway.CreatePregenCharacter(context.Background(), "user-1", "camp-1", "pregen:vex-marrow")
+	if got := apperrors.HTTPStatus(err); got != http.StatusBadRequest {
+		t.Fatalf("CreatePregenCharacter() status = %d, want %d", got, http.StatusBadRequest)
+	}
+	if characters.deletedChar != "char-1" {
+		t.Fatalf("deleted character = %q, want char-1", characters.deletedChar)
+	}
+}
+
+func TestCreatePregenCharacterRejectsSystemMismatch(t *testing.T) {
+	t.Parallel()
+
+	characters := &pregenCharacterClientStub{}
+	gateway := NewGRPCPregenGateway(discoveryClientStub{}, &pregenCampaignClientStub{}, characters)
+	_, err := gateway.CreatePregenCharacter(context.Background(), "user-1", "camp-1", "pregen:vex-marrow")
+	if got := apperrors.HTTPStatus(err); got != http.StatusBadRequest {
+		t.Fatalf("CreatePregenCharacter() status = %d, want %d", got, http.StatusBadRequest)
+	}
+	if characters.created != nil {
+		t.Fatalf("create request = %+v, want none", characters.created)
+	}
+}
+
+func TestListPregenCampaignsKeepsDaggerheartCampaigns(t *testing.T) {
+	t.Parallel()
+
+	campaigns := &pregenCampaignClientStub{campaigns: []*statev1.Campaign{
+		{Id: "camp-1", Name: "Starfall", System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART},
+		{Id: "camp-2", Name: "Other"},
+	}}
+	gateway := NewGRPCPregenGateway(discoveryClientStub{}, campaigns, &pregenCharacterClientStub{})
+	got, err := gateway.ListPregenCampaigns(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("ListPregenCampaigns() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "camp-1" {
+		t.Fatalf("campaigns = %+v", got)
+	}
+	if campaigns.userID != "user-1" {
+		t.Fatalf("user id = %q, want user-1", campaigns.userID)
+	}
+}
+
+func TestListPregenCampaignsPagesThroughAllCampaigns(t *testing.T) {
+	t.Parallel()
+
+	campaigns := &pregenCampaignClientStub{pages: [][]*statev1.Campaign{
+		{{Id: "camp-1", System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART}},
+		{{Id: "camp-2"}},
+		{{Id: "camp-3", Name: "Late", System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART}},
+	}}
+	gateway := NewGRPCPregenGateway(discoveryClientStub{}, campaigns, &pregenCharacterClientStub{})
+	got, err := gateway.ListPregenCampaigns(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("ListPregenCampaigns() error = %v", err)
+	}
+	if len(got) != 2 || got[1].ID != "camp-3" || got[1].Name != "Late" {
+		t.Fatalf("campaigns = %+v, want camp-1 and camp-3", got)
+	}
+}
+
+func TestGetPregenMapsTemplateWorkflow(t *testing.T) {
+	t.Parallel()
+
+	pregen, err := NewGRPCGateway(discoveryClientStub{}).GetPregen(context.Background(), "pregen:vex-marrow")
+	if err != nil {
+		t.Fatalf("GetPregen() error = %v", err)
+	}
+	if pregen.Name != "Vex Marrow" || pregen.Background != "Ran messages." || len(pregen.Traits) != 6 || len(pregen.Experiences) != 1 {
+		t.Fatalf("pregen = %+v", pregen)
+	}
+	if pregen.Traits[1].Name != "strength" || pregen.Traits[1].Value != -1 {
+		t.Fatalf("traits = %+v", pregen.Traits)
+	}
+}
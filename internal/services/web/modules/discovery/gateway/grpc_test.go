@@ -6,6 +6,7 @@ import (
 
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	discoveryv1 "github.com/louisbranch/fracturing.space/api/gen/go/discovery/v1"
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
 	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
 	"google.golang.org/grpc"
@@ -19,6 +20,42 @@ type discoveryClientStub struct {
 	err  error
 }
 
+func (s discoveryClientStub) GetCampaignListing(_ context.Context, in *discoveryv1.GetCampaignListingRequest, _ ...grpc.CallOption) (*discoveryv1.GetCampaignListingResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &discoveryv1.GetCampaignListingResponse{Entry: &discoveryv1.DiscoveryEntry{
+		SourceId:      in.GetCampaignId(),
+		Title:         "Open Table",
+		ScheduleLabel: "Fridays",
+		OpenSeats:     2,
+	}}, nil
+}
+
+func (s discoveryClientStub) GetCharacterTemplate(_ context.Context, in *discoveryv1.GetCharacterTemplateRequest, _ ...grpc.CallOption) (*discoveryv1.GetCharacterTemplateResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &discoveryv1.GetCharacterTemplateResponse{Template: &discoveryv1.CharacterTemplate{
+		Entry: &discoveryv1.DiscoveryEntry{
+			EntryId: in.GetEntryId(),
+			Kind:    discoveryv1.DiscoveryEntryKind_DISCOVERY_ENTRY_KIND_CHARACTER_TEMPLATE,
+			System:  commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+			Level:   1,
+		},
+		Name:     "Vex Marrow",
+		Pronouns: "they/them",
+		Summary:  "Trades favors across every rooftop.",
+		SystemWorkflow: &discoveryv1.CharacterTemplate_Daggerheart{Daggerheart: &daggerheartv1.DaggerheartCreationWorkflowInput{
+			TraitsInput: &daggerheartv1.DaggerheartCreationStepTraitsInput{Finesse: 2, Strength: -1},
+			ExperiencesInput: &daggerheartv1.DaggerheartCreationStepExperiencesInput{
+				Experiences: []*daggerheartv1.DaggerheartExperience{{Name: "Guild Courier", Modifier: 2}},
+			},
+			BackgroundInput: &daggerheartv1.DaggerheartCreationStepBackgroundInput{Background: "Ran messages."},
+		}},
+	}}, nil
+}
+
 func (s discoveryClientStub) ListDiscoveryEntries(_ context.Context, _ *discoveryv1.ListDiscoveryEntriesRequest, _ ...grpc.CallOption) (*discoveryv1.ListDiscoveryEntriesResponse, error) {
 	return s.resp, s.err
 }
@@ -154,3 +191,20 @@ func TestPlayersLabel(t *testing.T) {
 		}
 	}
 }
+
+func TestGetOpenTableMapsListing(t *testing.T) {
+	t.Parallel()
+
+	table, err := NewGRPCGateway(discoveryClientStub{}).GetOpenTable(context.Background(), "camp-1")
+	if err != nil {
+		t.Fatalf("GetOpenTable() error = %v", err)
+	}
+	if table.CampaignID != "camp-1" || table.Schedule != "Fridays" || table.OpenSeats != 2 {
+		t.Fatalf("table = %+v", table)
+	}
+
+	_, err = NewGRPCGateway(discoveryClientStub{err: status.Error(codes.NotFound, "campaign listing not found")}).GetOpenTable(context.Background(), "camp-1")
+	if apperrors.HTTPStatus(err) != 404 {
+		t.Fatalf("status = %d, want 404", apperrors.HTTPStatus(err))
+	}
+}
@@ -6,13 +6,18 @@ import (
 	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/pagerender"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/publichandler"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/requestmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
 	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
 )
 
 // handlers defines an internal contract used at this web package boundary.
 type handlers struct {
 	publichandler.Base
-	service discoveryapp.Service
+	service      discoveryapp.Service
+	applications discoveryapp.ApplicationService
+	pregens      discoveryapp.PregenService
+	requestMeta  requestmeta.SchemePolicy
 }
 
 // newHandlersWithBase keeps package tests free to inject a custom base while
@@ -21,12 +26,31 @@ func newHandlersWithBase(base publichandler.Base, service discoveryapp.Service)
 	if service == nil {
 		service = discoveryapp.NewService(nil, nil)
 	}
-	return handlers{Base: base, service: service}
+	return handlers{
+		Base:         base,
+		service:      service,
+		applications: discoveryapp.NewApplicationService(nil),
+		pregens:      discoveryapp.NewPregenService(nil, nil, nil),
+	}
 }
 
 // newHandlers builds package wiring for the production discovery module seam.
-func newHandlers(service discoveryapp.Service) handlers {
-	return newHandlersWithBase(publichandler.NewBase(), service)
+func newHandlers(
+	service discoveryapp.Service,
+	applications discoveryapp.ApplicationService,
+	pregens discoveryapp.PregenService,
+	requestPrincipal principal.PrincipalResolver,
+	policy requestmeta.SchemePolicy,
+) handlers {
+	h := newHandlersWithBase(publichandler.NewBaseFromPrincipal(requestPrincipal), service)
+	if applications != nil {
+		h.applications = applications
+	}
+	if pregens != nil {
+		h.pregens = pregens
+	}
+	h.requestMeta = policy
+	return h
 }
 
 // handleIndex handles this route in the module transport layer.
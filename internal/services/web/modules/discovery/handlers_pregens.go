@@ -0,0 +1,78 @@
+package discovery
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/flash"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/pagerender"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/sessioncookie"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
+)
+
+// withEntryID extracts the pregen route parameter before delegating to
+// handlers.
+func (h handlers) withEntryID(fn func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return httpx.WithRequiredRouteParam("entryID", h.handlePregenNotFound, fn)
+}
+
+// handlePregen renders one pregenerated character with the campaign picker for
+// signed-in viewers.
+func (h handlers) handlePregen(w http.ResponseWriter, r *http.Request, entryID string) {
+	page, err := h.pregens.LoadPregen(r.Context(), h.RequestUserID(r), entryID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	loc, lang := h.PageLocalizer(w, r)
+	h.WritePublicPage(w, r, pagerender.PublicPage{
+		Title:      page.Pregen.Name,
+		MetaDesc:   webtemplates.T(loc, "layout.meta_description"),
+		Language:   lang,
+		StatusCode: http.StatusOK,
+		Body:       PregenFragment(mapPregenPageToView(page, loginRedirectForPregen(entryID)), loc),
+	})
+}
+
+// handlePregenUse creates the pregen in the chosen campaign and opens the new
+// character sheet.
+func (h handlers) handlePregenUse(w http.ResponseWriter, r *http.Request, entryID string) {
+	if !sessioncookie.AllowsMutationWithPolicy(r, h.requestMeta) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	userID := h.RequestUserID(r)
+	if strings.TrimSpace(userID) == "" {
+		http.Redirect(w, r, loginRedirectForPregen(entryID), http.StatusSeeOther)
+		return
+	}
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_use_pregen", routepath.DiscoverPregen(entryID)) {
+		return
+	}
+	campaignID := strings.TrimSpace(r.FormValue("campaign_id"))
+	characterID, err := h.pregens.UsePregen(r.Context(), userID, entryID, campaignID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	flash.Write(w, r, flash.NoticeSuccess("web.discovery.pregen.notice_created"))
+	http.Redirect(w, r, routepath.AppCampaignCharacter(campaignID, characterID), http.StatusSeeOther)
+}
+
+// handlePregenNotFound gives pregen routes a pregen-specific not-found
+// response.
+func (h handlers) handlePregenNotFound(w http.ResponseWriter, r *http.Request) {
+	h.WriteError(w, r, apperrors.E(apperrors.KindNotFound, "pregen not found"))
+}
+
+// loginRedirectForPregen keeps pregen URLs sticky across anonymous auth
+// entrypoints.
+func loginRedirectForPregen(entryID string) string {
+	values := url.Values{}
+	values.Set("next", routepath.DiscoverPregen(entryID))
+	return routepath.Login + "?" + values.Encode()
+}
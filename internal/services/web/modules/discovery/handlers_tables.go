@@ -0,0 +1,81 @@
+package discovery
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/flash"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/pagerender"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/sessioncookie"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
+)
+
+// withCampaignID extracts the open-table route parameter before delegating to
+// handlers.
+func (h handlers) withCampaignID(fn func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return httpx.WithRequiredRouteParam("campaignID", h.handleTableNotFound, fn)
+}
+
+// handleTable renders one open-table listing with the application form for
+// signed-in viewers.
+func (h handlers) handleTable(w http.ResponseWriter, r *http.Request, campaignID string) {
+	page, err := h.service.LoadOpenTable(r.Context(), h.RequestUserID(r), campaignID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	loc, lang := h.PageLocalizer(w, r)
+	h.WritePublicPage(w, r, pagerender.PublicPage{
+		Title:      page.Table.Title,
+		MetaDesc:   webtemplates.T(loc, "layout.meta_description"),
+		Language:   lang,
+		StatusCode: http.StatusOK,
+		Body:       OpenTableFragment(mapOpenTablePageToView(page, loginRedirectForTable(campaignID)), loc),
+	})
+}
+
+// handleTableApply submits the signed-in viewer's application and returns to
+// the dashboard while the GM reviews it.
+func (h handlers) handleTableApply(w http.ResponseWriter, r *http.Request, campaignID string) {
+	if !sessioncookie.AllowsMutationWithPolicy(r, h.requestMeta) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	userID := h.RequestUserID(r)
+	if strings.TrimSpace(userID) == "" {
+		http.Redirect(w, r, loginRedirectForTable(campaignID), http.StatusSeeOther)
+		return
+	}
+	if !httpx.ParseFormOrRedirectErrorNotice(w, r, "error.web.message.failed_to_submit_application", routepath.DiscoverTable(campaignID)) {
+		return
+	}
+	err := h.applications.SubmitApplication(r.Context(), userID, campaignID, discoveryapp.ApplicationInput{
+		Message:          r.FormValue("message"),
+		CharacterConcept: r.FormValue("character_concept"),
+	})
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	flash.Write(w, r, flash.NoticeSuccess("web.discovery.open_table.notice_applied"))
+	http.Redirect(w, r, routepath.AppDashboard, http.StatusSeeOther)
+}
+
+// handleTableNotFound gives open-table routes a listing-specific not-found
+// response.
+func (h handlers) handleTableNotFound(w http.ResponseWriter, r *http.Request) {
+	h.WriteError(w, r, apperrors.E(apperrors.KindNotFound, "open table not found"))
+}
+
+// loginRedirectForTable keeps open-table URLs sticky across anonymous auth
+// entrypoints.
+func loginRedirectForTable(campaignID string) string {
+	values := url.Values{}
+	values.Set("next", routepath.DiscoverTable(campaignID))
+	return routepath.Login + "?" + values.Encode()
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
 	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/publichandler"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 )
 
 type serviceStub struct {
@@ -21,6 +23,46 @@ func (s *serviceStub) LoadPage(context.Context) discoveryapp.Page {
 	return s.page
 }
 
+func (s *serviceStub) LoadOpenTable(_ context.Context, viewerUserID string, campaignID string) (discoveryapp.OpenTablePage, error) {
+	return discoveryapp.OpenTablePage{
+		Table:        discoveryapp.OpenTable{CampaignID: campaignID, Title: "Night Market", OpenSeats: 2},
+		ViewerUserID: viewerUserID,
+		CanApply:     viewerUserID != "",
+	}, nil
+}
+
+type applicationServiceStub struct {
+	campaignID string
+	input      discoveryapp.ApplicationInput
+}
+
+func (s *applicationServiceStub) SubmitApplication(_ context.Context, _ string, campaignID string, input discoveryapp.ApplicationInput) error {
+	s.campaignID = campaignID
+	s.input = input
+	return nil
+}
+
+type pregenServiceStub struct {
+	campaignID string
+}
+
+func (s *pregenServiceStub) LoadPregen(_ context.Context, viewerUserID string, entryID string) (discoveryapp.PregenPage, error) {
+	return discoveryapp.PregenPage{
+		Pregen: discoveryapp.Pregen{
+			EntryID: entryID,
+			Name:    "Vex Marrow",
+			Traits:  []discoveryapp.PregenTrait{{Name: "finesse", Value: 2}},
+		},
+		ViewerUserID: viewerUserID,
+		Campaigns:    []discoveryapp.PregenCampaign{{ID: "camp-1", Name: "Starfall"}},
+	}, nil
+}
+
+func (s *pregenServiceStub) UsePregen(_ context.Context, _ string, _ string, campaignID string) (string, error) {
+	s.campaignID = campaignID
+	return "char-1", nil
+}
+
 func TestHandleIndexRendersDiscoveryPageForDegradedServiceState(t *testing.T) {
 	t.Parallel()
 
@@ -89,3 +131,118 @@ func TestHandleIndexMapsServiceEntries(t *testing.T) {
 		t.Fatalf("body missing ready discovery status: %q", body)
 	}
 }
+
+func TestHandleTableOffersLoginToAnonymousViewers(t *testing.T) {
+	t.Parallel()
+
+	h := newHandlersWithBase(publichandler.NewBase(), &serviceStub{})
+	mux := http.NewServeMux()
+	registerRoutes(mux, h)
+
+	req := httptest.NewRequest(http.MethodGet, routepath.DiscoverTable("camp-1"), nil)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	if !strings.Contains(body, "Night Market") {
+		t.Fatalf("body missing listing title: %q", body)
+	}
+	if strings.Contains(body, `data-open-table-apply="true"`) {
+		t.Fatalf("anonymous viewer should not see apply form: %q", body)
+	}
+}
+
+func TestHandleTableApplySubmitsForSignedInViewer(t *testing.T) {
+	t.Parallel()
+
+	applications := &applicationServiceStub{}
+	h := newHandlersWithBase(publichandler.NewBase(publichandler.WithResolveUserID(func(*http.Request) string { return "user-1" })), &serviceStub{})
+	h.applications = applications
+	mux := http.NewServeMux()
+	registerRoutes(mux, h)
+
+	form := url.Values{"message": {"Fridays work"}, "character_concept": {"Exiled cartographer"}}
+	req := httptest.NewRequest(http.MethodPost, routepath.DiscoverTableApply("camp-1"), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusSeeOther {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusSeeOther)
+	}
+	if got := rr.Header().Get("Location"); got != routepath.AppDashboard {
+		t.Fatalf("Location = %q, want %q", got, routepath.AppDashboard)
+	}
+	if applications.campaignID != "camp-1" || applications.input.CharacterConcept != "Exiled cartographer" {
+		t.Fatalf("submitted = %q %+v", applications.campaignID, applications.input)
+	}
+}
+
+func TestHandleTableApplyRedirectsAnonymousViewerToLogin(t *testing.T) {
+	t.Parallel()
+
+	h := newHandlersWithBase(publichandler.NewBase(), &serviceStub{})
+	mux := http.NewServeMux()
+	registerRoutes(mux, h)
+
+	req := httptest.NewRequest(http.MethodPost, routepath.DiscoverTableApply("camp-1"), nil)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusSeeOther {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusSeeOther)
+	}
+	if got := rr.Header().Get("Location"); !strings.HasPrefix(got, routepath.Login+"?") {
+		t.Fatalf("Location = %q, want login redirect", got)
+	}
+}
+
+func TestHandlePregenOffersCampaignPickerToSignedInViewers(t *testing.T) {
+	t.Parallel()
+
+	h := newHandlersWithBase(publichandler.NewBase(publichandler.WithResolveUserID(func(*http.Request) string { return "user-1" })), &serviceStub{})
+	h.pregens = &pregenServiceStub{}
+	mux := http.NewServeMux()
+	registerRoutes(mux, h)
+
+	req := httptest.NewRequest(http.MethodGet, routepath.DiscoverPregen("pregen:vex-marrow"), nil)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	if !strings.Contains(body, "Vex Marrow") || !strings.Contains(body, `data-pregen-use="true"`) {
+		t.Fatalf("body missing pregen use form: %q", body)
+	}
+}
+
+func TestHandlePregenUseRedirectsToCreatedCharacter(t *testing.T) {
+	t.Parallel()
+
+	pregens := &pregenServiceStub{}
+	h := newHandlersWithBase(publichandler.NewBase(publichandler.WithResolveUserID(func(*http.Request) string { return "user-1" })), &serviceStub{})
+	h.pregens = pregens
+	mux := http.NewServeMux()
+	registerRoutes(mux, h)
+
+	form := url.Values{"campaign_id": {"camp-1"}}
+	req := httptest.NewRequest(http.MethodPost, routepath.DiscoverPregenUse("pregen:vex-marrow"), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusSeeOther {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusSeeOther)
+	}
+	if got, want := rr.Header().Get("Location"), routepath.AppCampaignCharacter("camp-1", "char-1"); got != want {
+		t.Fatalf("Location = %q, want %q", got, want)
+	}
+	if pregens.campaignID != "camp-1" {
+		t.Fatalf("campaign = %q, want camp-1", pregens.campaignID)
+	}
+}
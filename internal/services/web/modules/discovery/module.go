@@ -5,17 +5,27 @@ import (
 
 	"github.com/louisbranch/fracturing.space/internal/services/web/module"
 	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/requestmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
 	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 )
 
 // Module provides public discovery routes.
 type Module struct {
-	service discoveryapp.Service
+	service      discoveryapp.Service
+	applications discoveryapp.ApplicationService
+	pregens      discoveryapp.PregenService
+	requestMeta  requestmeta.SchemePolicy
+	principal    principal.PrincipalResolver
 }
 
 // Config defines constructor dependencies for a discovery module.
 type Config struct {
-	Service discoveryapp.Service
+	Service      discoveryapp.Service
+	Applications discoveryapp.ApplicationService
+	Pregens      discoveryapp.PregenService
+	RequestMeta  requestmeta.SchemePolicy
+	Principal    principal.PrincipalResolver
 }
 
 // New returns a discovery module with explicit dependencies.
@@ -24,7 +34,21 @@ func New(config Config) Module {
 	if service == nil {
 		service = discoveryapp.NewService(nil, nil)
 	}
-	return Module{service: service}
+	applications := config.Applications
+	if applications == nil {
+		applications = discoveryapp.NewApplicationService(nil)
+	}
+	pregens := config.Pregens
+	if pregens == nil {
+		pregens = discoveryapp.NewPregenService(nil, nil, nil)
+	}
+	return Module{
+		service:      service,
+		applications: applications,
+		pregens:      pregens,
+		requestMeta:  config.RequestMeta,
+		principal:    config.Principal,
+	}
 }
 
 // ID returns a stable module identifier.
@@ -33,7 +57,7 @@ func (Module) ID() string { return "discovery" }
 // Mount wires discovery route handlers.
 func (m Module) Mount() (module.Mount, error) {
 	mux := http.NewServeMux()
-	h := newHandlers(m.service)
+	h := newHandlers(m.service, m.applications, m.pregens, m.principal, m.requestMeta)
 	registerRoutes(mux, h)
 	return module.Mount{Prefix: routepath.DiscoverPrefix, CanonicalRoot: true, Handler: mux}, nil
 }
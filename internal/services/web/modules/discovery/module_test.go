@@ -17,6 +17,14 @@ func (stubGateway) ListStarterEntries(context.Context) ([]discoveryapp.StarterEn
 	return nil, nil
 }
 
+func (stubGateway) ListOpenTables(context.Context) ([]discoveryapp.OpenTable, error) {
+	return nil, nil
+}
+
+func (stubGateway) GetOpenTable(context.Context, string) (discoveryapp.OpenTable, error) {
+	return discoveryapp.OpenTable{}, nil
+}
+
 func TestMountServesDiscoveryGet(t *testing.T) {
 	t.Parallel()
 
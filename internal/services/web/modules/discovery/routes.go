@@ -3,6 +3,7 @@ package discovery
 import (
 	"net/http"
 
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
 	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 )
 
@@ -13,4 +14,10 @@ func registerRoutes(mux *http.ServeMux, h handlers) {
 	}
 	mux.HandleFunc(http.MethodGet+" "+routepath.Discover, h.handleIndex)
 	mux.HandleFunc(http.MethodGet+" "+routepath.DiscoverPrefix+"{rest...}", h.handleIndex)
+	mux.HandleFunc(http.MethodGet+" "+routepath.DiscoverTablePattern, h.withCampaignID(h.handleTable))
+	mux.HandleFunc(http.MethodPost+" "+routepath.DiscoverTableApplyPattern, h.withCampaignID(h.handleTableApply))
+	mux.HandleFunc(http.MethodGet+" "+routepath.DiscoverTableApplyPattern, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodGet+" "+routepath.DiscoverPregenPattern, h.withEntryID(h.handlePregen))
+	mux.HandleFunc(http.MethodPost+" "+routepath.DiscoverPregenUsePattern, h.withEntryID(h.handlePregenUse))
+	mux.HandleFunc(http.MethodGet+" "+routepath.DiscoverPregenUsePattern, httpx.MethodNotAllowed(http.MethodPost))
 }
@@ -1,7 +1,10 @@
 package discovery
 
 import (
+	"fmt"
+
 	discoveryapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery/app"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 )
 
 // DiscoveryPageView keeps discovery transport state explicit for the page template.
@@ -9,6 +12,8 @@ type DiscoveryPageView struct {
 	Status      string
 	Unavailable bool
 	Entries     []StarterEntryView
+	OpenTables  []OpenTableView
+	Pregens     []PregenView
 }
 
 // mapPageToView converts the app-layer page contract into template state.
@@ -17,6 +22,8 @@ func mapPageToView(page discoveryapp.Page) DiscoveryPageView {
 		Status:      string(page.Status),
 		Unavailable: page.Status == discoveryapp.PageStatusUnavailable,
 		Entries:     mapEntriesToView(page.Entries),
+		OpenTables:  mapOpenTablesToView(page.OpenTables),
+		Pregens:     mapPregensToView(page.Pregens),
 	}
 }
 
@@ -42,3 +49,109 @@ func mapEntriesToView(entries []discoveryapp.StarterEntry) []StarterEntryView {
 	}
 	return views
 }
+
+// mapOpenTablesToView converts open-table listings to template card state.
+func mapOpenTablesToView(tables []discoveryapp.OpenTable) []OpenTableView {
+	if len(tables) == 0 {
+		return nil
+	}
+	views := make([]OpenTableView, len(tables))
+	for i, table := range tables {
+		views[i] = mapOpenTableToView(table)
+	}
+	return views
+}
+
+// mapOpenTableToView converts one open-table listing to template state.
+func mapOpenTableToView(table discoveryapp.OpenTable) OpenTableView {
+	return OpenTableView{
+		CampaignID:  table.CampaignID,
+		Title:       table.Title,
+		Description: table.Description,
+		System:      table.System,
+		GmMode:      table.GmMode,
+		Schedule:    table.Schedule,
+		Tone:        table.Tone,
+		SafetyNotes: table.SafetyNotes,
+		OpenSeats:   table.OpenSeats,
+		DetailURL:   routepath.DiscoverTable(table.CampaignID),
+	}
+}
+
+// mapOpenTablePageToView converts the open-table landing contract into
+// template state, offering sign-in instead of the form to anonymous viewers.
+func mapOpenTablePageToView(page discoveryapp.OpenTablePage, loginURL string) OpenTablePageView {
+	view := OpenTablePageView{Table: mapOpenTableToView(page.Table)}
+	if page.CanApply {
+		view.ApplyURL = routepath.DiscoverTableApply(page.Table.CampaignID)
+	} else {
+		view.LoginURL = loginURL
+	}
+	return view
+}
+
+// mapPregensToView converts pregenerated characters to template card state.
+func mapPregensToView(pregens []discoveryapp.Pregen) []PregenView {
+	if len(pregens) == 0 {
+		return nil
+	}
+	views := make([]PregenView, len(pregens))
+	for i, pregen := range pregens {
+		views[i] = mapPregenToView(pregen)
+	}
+	return views
+}
+
+// mapPregenToView converts one pregenerated character to card state.
+func mapPregenToView(pregen discoveryapp.Pregen) PregenView {
+	return PregenView{
+		EntryID:    pregen.EntryID,
+		Name:       pregen.Name,
+		Pronouns:   pregen.Pronouns,
+		Summary:    pregen.Summary,
+		Playstyle:  pregen.Playstyle,
+		System:     pregen.System,
+		Difficulty: pregen.Difficulty,
+		Level:      pregen.Level,
+		Tags:       pregen.Tags,
+		DetailURL:  routepath.DiscoverPregen(pregen.EntryID),
+	}
+}
+
+// mapPregenPageToView converts the pregen preview contract into template
+// state. Signed-in viewers get the campaign picker; anonymous viewers get a
+// sign-in link instead.
+func mapPregenPageToView(page discoveryapp.PregenPage, loginURL string) PregenPageView {
+	view := PregenPageView{
+		Pregen:      mapPregenToView(page.Pregen),
+		Description: page.Pregen.Description,
+		Background:  page.Pregen.Background,
+		Connections: page.Pregen.Connections,
+	}
+	for _, trait := range page.Pregen.Traits {
+		view.Traits = append(view.Traits, PregenTraitView{
+			LabelKey: "game.character_creation.field." + trait.Name,
+			Value:    signedModifier(trait.Value),
+		})
+	}
+	for _, experience := range page.Pregen.Experiences {
+		view.Experiences = append(view.Experiences, PregenExperienceView{
+			Name:     experience.Name,
+			Modifier: signedModifier(experience.Modifier),
+		})
+	}
+	if page.ViewerUserID == "" {
+		view.LoginURL = loginURL
+		return view
+	}
+	view.UseURL = routepath.DiscoverPregenUse(page.Pregen.EntryID)
+	for _, campaign := range page.Campaigns {
+		view.Campaigns = append(view.Campaigns, PregenCampaignView{ID: campaign.ID, Name: campaign.Name})
+	}
+	return view
+}
+
+// signedModifier renders trait and experience modifiers with an explicit sign.
+func signedModifier(value int32) string {
+	return fmt.Sprintf("%+d", value)
+}
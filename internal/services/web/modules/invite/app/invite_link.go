@@ -0,0 +1,160 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+// InviteLinkStatus captures the public invite-link availability states visible
+// in web.
+type InviteLinkStatus string
+
+const (
+	InviteLinkStatusActive    InviteLinkStatus = "active"
+	InviteLinkStatusRevoked   InviteLinkStatus = "revoked"
+	InviteLinkStatusExpired   InviteLinkStatus = "expired"
+	InviteLinkStatusExhausted InviteLinkStatus = "exhausted"
+)
+
+// InviteLinkRole captures the seat role a link grants on claim.
+type InviteLinkRole string
+
+const (
+	InviteLinkRolePlayer InviteLinkRole = "player"
+	InviteLinkRoleGM     InviteLinkRole = "gm"
+)
+
+// InviteLinkPageState captures the viewer-specific landing experience for one
+// shareable invite link.
+type InviteLinkPageState string
+
+const (
+	InviteLinkPageStateAnonymous   InviteLinkPageState = "anonymous"
+	InviteLinkPageStateClaimable   InviteLinkPageState = "claimable"
+	InviteLinkPageStateUnavailable InviteLinkPageState = "unavailable"
+)
+
+// PublicInviteLink stores the public landing information for one invite link.
+type PublicInviteLink struct {
+	LinkID           string
+	CampaignID       string
+	CampaignName     string
+	CreatedByUserID  string
+	InviterUsername  string
+	Role             InviteLinkRole
+	RequiresApproval bool
+	Status           InviteLinkStatus
+}
+
+// InviteLinkPage stores invite-link data plus viewer-specific state for
+// rendering.
+type InviteLinkPage struct {
+	Link         PublicInviteLink
+	ViewerUserID string
+	State        InviteLinkPageState
+	CanClaim     bool
+}
+
+// InviteLinkClaimResult identifies the claimed campaign and whether the claim
+// still waits on GM approval.
+type InviteLinkClaimResult struct {
+	CampaignID string
+	UserIDs    []string
+	Pending    bool
+}
+
+// LinkGateway loads and claims shareable invite links.
+type LinkGateway interface {
+	GetPublicInviteLink(context.Context, string) (PublicInviteLink, error)
+	ClaimInviteLink(context.Context, string, PublicInviteLink) (bool, error)
+}
+
+// LinkService exposes invite-link landing workflows used by transport
+// handlers.
+type LinkService interface {
+	LoadInviteLink(context.Context, string, string) (InviteLinkPage, error)
+	ClaimInviteLink(context.Context, string, string) (InviteLinkClaimResult, error)
+}
+
+// linkService owns the invite-link landing decision logic behind the
+// transport layer.
+type linkService struct {
+	gateway LinkGateway
+}
+
+// NewLinkService constructs the public invite-link service with fail-closed
+// defaults.
+func NewLinkService(gateway LinkGateway) LinkService {
+	if gateway == nil {
+		gateway = unavailableLinkGateway{}
+	}
+	return linkService{gateway: gateway}
+}
+
+// LoadInviteLink resolves public link data and viewer-specific action state.
+func (s linkService) LoadInviteLink(ctx context.Context, viewerUserID string, linkID string) (InviteLinkPage, error) {
+	linkID = strings.TrimSpace(linkID)
+	if linkID == "" {
+		return InviteLinkPage{}, apperrors.E(apperrors.KindNotFound, "invite link not found")
+	}
+	link, err := s.gateway.GetPublicInviteLink(ctx, linkID)
+	if err != nil {
+		return InviteLinkPage{}, err
+	}
+	page := InviteLinkPage{
+		Link:         link,
+		ViewerUserID: useridOrBlank(viewerUserID),
+	}
+	switch {
+	case link.Status != InviteLinkStatusActive:
+		page.State = InviteLinkPageStateUnavailable
+	case page.ViewerUserID == "":
+		page.State = InviteLinkPageStateAnonymous
+	default:
+		page.State = InviteLinkPageStateClaimable
+		page.CanClaim = true
+	}
+	return page, nil
+}
+
+// ClaimInviteLink claims one invite link for the signed-in viewer.
+func (s linkService) ClaimInviteLink(ctx context.Context, viewerUserID string, linkID string) (InviteLinkClaimResult, error) {
+	viewerUserID, err := RequireUserID(viewerUserID)
+	if err != nil {
+		return InviteLinkClaimResult{}, err
+	}
+	page, err := s.LoadInviteLink(ctx, viewerUserID, linkID)
+	if err != nil {
+		return InviteLinkClaimResult{}, err
+	}
+	if !page.CanClaim {
+		return InviteLinkClaimResult{}, apperrors.E(apperrors.KindForbidden, "invite link cannot be claimed")
+	}
+	pending, err := s.gateway.ClaimInviteLink(ctx, viewerUserID, page.Link)
+	if err != nil {
+		return InviteLinkClaimResult{}, err
+	}
+	return InviteLinkClaimResult{
+		CampaignID: page.Link.CampaignID,
+		UserIDs:    inviteMutationUsers(PublicInvite{CreatedByUserID: page.Link.CreatedByUserID}, viewerUserID),
+		Pending:    pending,
+	}, nil
+}
+
+// unavailableLinkGateway fails closed when the invite module lacks link
+// wiring.
+type unavailableLinkGateway struct{}
+
+// GetPublicInviteLink rejects reads when no backing invite transport is
+// configured.
+func (unavailableLinkGateway) GetPublicInviteLink(context.Context, string) (PublicInviteLink, error) {
+	return PublicInviteLink{}, apperrors.E(apperrors.KindUnavailable, "invite service client is not configured")
+}
+
+// ClaimInviteLink rejects claims when no backing invite transport is
+// configured.
+func (unavailableLinkGateway) ClaimInviteLink(context.Context, string, PublicInviteLink) (bool, error) {
+	return false, apperrors.E(apperrors.KindUnavailable, "invite service client is not configured")
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+func TestLoadInviteLinkStates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		viewerUserID string
+		status       InviteLinkStatus
+		wantState    InviteLinkPageState
+		wantClaim    bool
+	}{
+		{name: "anonymous", status: InviteLinkStatusActive, wantState: InviteLinkPageStateAnonymous},
+		{name: "claimable", viewerUserID: "user-1", status: InviteLinkStatusActive, wantState: InviteLinkPageStateClaimable, wantClaim: true},
+		{name: "expired", viewerUserID: "user-1", status: InviteLinkStatusExpired, wantState: InviteLinkPageStateUnavailable},
+		{name: "exhausted", viewerUserID: "user-1", status: InviteLinkStatusExhausted, wantState: InviteLinkPageStateUnavailable},
+		{name: "revoked anonymous", status: InviteLinkStatusRevoked, wantState: InviteLinkPageStateUnavailable},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewLinkService(&linkGatewayStub{link: PublicInviteLink{LinkID: "link-1", Status: tc.status}})
+			page, err := svc.LoadInviteLink(context.Background(), tc.viewerUserID, "link-1")
+			if err != nil {
+				t.Fatalf("LoadInviteLink() error = %v", err)
+			}
+			if page.State != tc.wantState || page.CanClaim != tc.wantClaim {
+				t.Fatalf("page = (%q, %v), want (%q, %v)", page.State, page.CanClaim, tc.wantState, tc.wantClaim)
+			}
+		})
+	}
+}
+
+func TestClaimInviteLinkReportsPendingApproval(t *testing.T) {
+	t.Parallel()
+
+	gateway := linkGatewayStub{
+		link: PublicInviteLink{
+			LinkID:           "link-1",
+			CampaignID:       "camp-1",
+			CreatedByUserID:  "creator-1",
+			RequiresApproval: true,
+			Status:           InviteLinkStatusActive,
+		},
+		pending: true,
+	}
+
+	result, err := NewLinkService(&gateway).ClaimInviteLink(context.Background(), " user-1 ", "link-1")
+	if err != nil {
+		t.Fatalf("ClaimInviteLink() error = %v", err)
+	}
+	if gateway.claimViewer != "user-1" || gateway.claimLink.LinkID != "link-1" {
+		t.Fatalf("claim call = (%q, %+v), want user-1/link-1", gateway.claimViewer, gateway.claimLink)
+	}
+	if !result.Pending || result.CampaignID != "camp-1" {
+		t.Fatalf("result = %+v, want pending camp-1", result)
+	}
+	if len(result.UserIDs) != 2 || result.UserIDs[1] != "creator-1" {
+		t.Fatalf("UserIDs = %v, want [user-1 creator-1]", result.UserIDs)
+	}
+}
+
+func TestClaimInviteLinkRejectsUnavailableLink(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLinkService(&linkGatewayStub{
+		link: PublicInviteLink{LinkID: "link-1", Status: InviteLinkStatusExpired},
+	}).ClaimInviteLink(context.Background(), "user-1", "link-1")
+	if got := apperrors.HTTPStatus(err); got != 403 {
+		t.Fatalf("HTTPStatus(err) = %d, want 403", got)
+	}
+}
+
+func TestNewLinkServiceUsesUnavailableGatewayWhenNil(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLinkService(nil).LoadInviteLink(context.Background(), "user-1", "link-1")
+	if got := apperrors.HTTPStatus(err); got != 503 {
+		t.Fatalf("HTTPStatus(err) = %d, want 503", got)
+	}
+}
+
+type linkGatewayStub struct {
+	link        PublicInviteLink
+	pending     bool
+	claimViewer string
+	claimLink   PublicInviteLink
+}
+
+func (s *linkGatewayStub) GetPublicInviteLink(context.Context, string) (PublicInviteLink, error) {
+	return s.link, nil
+}
+
+func (s *linkGatewayStub) ClaimInviteLink(_ context.Context, viewerUserID string, link PublicInviteLink) (bool, error) {
+	s.claimViewer = viewerUserID
+	s.claimLink = link
+	return s.pending, nil
+}
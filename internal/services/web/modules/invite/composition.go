@@ -12,11 +12,12 @@ import (
 // CompositionConfig owns the startup wiring required to construct the
 // production invite module.
 type CompositionConfig struct {
-	InviteClient  invitegateway.InviteClient
-	AuthClient    invitegateway.AuthClient
-	RequestMeta   requestmeta.SchemePolicy
-	Principal     principal.PrincipalResolver
-	DashboardSync dashboardsync.Service
+	InviteClient     invitegateway.InviteClient
+	InviteLinkClient invitegateway.InviteLinkClient
+	AuthClient       invitegateway.AuthClient
+	RequestMeta      requestmeta.SchemePolicy
+	Principal        principal.PrincipalResolver
+	DashboardSync    dashboardsync.Service
 }
 
 // Compose builds the invite module from the exact startup dependencies the area
@@ -25,6 +26,7 @@ func Compose(config CompositionConfig) module.Module {
 	gateway := invitegateway.NewGRPCGateway(config.InviteClient, config.AuthClient)
 	return New(Config{
 		Service:       inviteapp.NewService(gateway),
+		LinkService:   inviteapp.NewLinkService(invitegateway.NewGRPCLinkGateway(config.InviteLinkClient, config.AuthClient)),
 		RequestMeta:   config.RequestMeta,
 		Principal:     config.Principal,
 		DashboardSync: config.DashboardSync,
@@ -9,8 +9,9 @@ import (
 
 // Dependencies contains public invite reads/mutations.
 type Dependencies struct {
-	InviteClient invitegateway.InviteClient
-	AuthClient   invitegateway.AuthClient
+	InviteClient     invitegateway.InviteClient
+	InviteLinkClient invitegateway.InviteLinkClient
+	AuthClient       invitegateway.AuthClient
 }
 
 // BindAuthDependency wires auth-backed clients into the invite dependency set.
@@ -27,4 +28,5 @@ func BindInviteDependency(deps *Dependencies, conn *grpc.ClientConn) {
 		return
 	}
 	deps.InviteClient = invitev1.NewInviteServiceClient(conn)
+	deps.InviteLinkClient = invitev1.NewInviteServiceClient(conn)
 }
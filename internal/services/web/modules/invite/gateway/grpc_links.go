@@ -0,0 +1,153 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	inviteapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/invite/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InviteLinkClient exposes public invite-link reads and claims.
+type InviteLinkClient interface {
+	GetPublicInviteLink(context.Context, *invitev1.GetPublicInviteLinkRequest, ...grpc.CallOption) (*invitev1.GetPublicInviteLinkResponse, error)
+	ClaimInviteLink(context.Context, *invitev1.ClaimInviteLinkRequest, ...grpc.CallOption) (*invitev1.ClaimInviteLinkResponse, error)
+}
+
+// GRPCLinkGateway adapts gRPC invite-link/auth clients to the public
+// invite-link gateway contract.
+type GRPCLinkGateway struct {
+	Links InviteLinkClient
+	Auth  AuthClient
+	Now   func() time.Time
+}
+
+// NewGRPCLinkGateway builds the production public invite-link gateway.
+func NewGRPCLinkGateway(links InviteLinkClient, auth AuthClient) inviteapp.LinkGateway {
+	if links == nil || auth == nil {
+		return nil
+	}
+	return GRPCLinkGateway{Links: links, Auth: auth, Now: time.Now}
+}
+
+// GetPublicInviteLink translates the public link read into the web app model.
+func (g GRPCLinkGateway) GetPublicInviteLink(ctx context.Context, linkID string) (inviteapp.PublicInviteLink, error) {
+	resp, err := g.Links.GetPublicInviteLink(ctx, &invitev1.GetPublicInviteLinkRequest{LinkId: strings.TrimSpace(linkID)})
+	if err != nil {
+		return inviteapp.PublicInviteLink{}, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_load_invite",
+			FallbackMessage: "failed to load invite",
+		})
+	}
+	if resp == nil || resp.GetLink() == nil {
+		return inviteapp.PublicInviteLink{}, apperrors.E(apperrors.KindNotFound, "invite link not found")
+	}
+	link := resp.GetLink()
+	role := inviteapp.InviteLinkRolePlayer
+	if link.GetDefaultRole() == invitev1.InviteLinkRole_INVITE_LINK_ROLE_GM {
+		role = inviteapp.InviteLinkRoleGM
+	}
+	return inviteapp.PublicInviteLink{
+		LinkID:           strings.TrimSpace(link.GetId()),
+		CampaignID:       strings.TrimSpace(link.GetCampaignId()),
+		CampaignName:     strings.TrimSpace(resp.GetCampaign().GetName()),
+		CreatedByUserID:  strings.TrimSpace(resp.GetCreatedByUser().GetId()),
+		InviterUsername:  strings.TrimSpace(resp.GetCreatedByUser().GetUsername()),
+		Role:             role,
+		RequiresApproval: link.GetRequiresApproval(),
+		Status:           g.linkStatus(link),
+	}, nil
+}
+
+// ClaimInviteLink issues a link-scoped join grant and claims a seat. It
+// reports true when the claim waits on GM approval.
+func (g GRPCLinkGateway) ClaimInviteLink(ctx context.Context, viewerUserID string, link inviteapp.PublicInviteLink) (bool, error) {
+	grantResp, err := g.Auth.IssueJoinGrant(ctx, &authv1.IssueJoinGrantRequest{
+		UserId:     viewerUserID,
+		CampaignId: link.CampaignID,
+		InviteId:   link.LinkID,
+	})
+	if err != nil {
+		return false, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnavailable,
+			FallbackKey:     "error.web.message.failed_to_accept_invite",
+			FallbackMessage: "failed to accept invite",
+		})
+	}
+	resp, err := g.Links.ClaimInviteLink(grpcauthctx.WithUserID(ctx, viewerUserID), &invitev1.ClaimInviteLinkRequest{
+		CampaignId: link.CampaignID,
+		LinkId:     link.LinkID,
+		JoinGrant:  grantResp.GetJoinGrant(),
+	})
+	if err != nil {
+		if mapped := mapClaimInviteLinkTransportError(err); mapped != nil {
+			return false, mapped
+		}
+		return false, apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+			FallbackKind:    apperrors.KindUnknown,
+			FallbackKey:     "error.web.message.failed_to_accept_invite",
+			FallbackMessage: "failed to accept invite",
+		})
+	}
+	return resp.GetClaim().GetStatus() == invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_PENDING_APPROVAL, nil
+}
+
+// linkStatus folds expiry and usage limits into the public availability
+// state so the landing page can explain why a link no longer works.
+func (g GRPCLinkGateway) linkStatus(link *invitev1.InviteLink) inviteapp.InviteLinkStatus {
+	if link.GetStatus() == invitev1.InviteLinkStatus_INVITE_LINK_REVOKED {
+		return inviteapp.InviteLinkStatusRevoked
+	}
+	now := time.Now
+	if g.Now != nil {
+		now = g.Now
+	}
+	if link.GetExpiresAt() != nil && !link.GetExpiresAt().AsTime().After(now()) {
+		return inviteapp.InviteLinkStatusExpired
+	}
+	if link.GetMaxUses() > 0 && link.GetUseCount() >= link.GetMaxUses() {
+		return inviteapp.InviteLinkStatusExhausted
+	}
+	return inviteapp.InviteLinkStatusActive
+}
+
+// mapClaimInviteLinkTransportError translates known link claim conflicts into
+// web-localized errors.
+func mapClaimInviteLinkTransportError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	switch st.Code() {
+	case codes.AlreadyExists:
+		return apperrors.EK(
+			apperrors.KindConflict,
+			"error.web.message.invite_link_already_claimed",
+			"invite link already claimed by this user",
+		)
+	case codes.FailedPrecondition:
+		switch strings.TrimSpace(st.Message()) {
+		case "user already has a seat in this campaign":
+			return apperrors.EK(
+				apperrors.KindConflict,
+				"error.web.message.invite_claim_user_already_in_campaign",
+				"user already has a participant in this campaign",
+			)
+		case "invite link has no remaining uses", "invite link expired", "invite link already revoked":
+			return apperrors.EK(
+				apperrors.KindConflict,
+				"error.web.message.invite_link_unavailable",
+				"invite link is no longer available",
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
+	inviteapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/invite/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetPublicInviteLinkFoldsExpiryAndUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		link *invitev1.InviteLink
+		want inviteapp.InviteLinkStatus
+	}{
+		{name: "active", link: &invitev1.InviteLink{Id: "link-1", Status: invitev1.InviteLinkStatus_INVITE_LINK_ACTIVE, MaxUses: 3, UseCount: 2}, want: inviteapp.InviteLinkStatusActive},
+		{name: "exhausted", link: &invitev1.InviteLink{Id: "link-1", Status: invitev1.InviteLinkStatus_INVITE_LINK_ACTIVE, MaxUses: 3, UseCount: 3}, want: inviteapp.InviteLinkStatusExhausted},
+		{name: "expired", link: &invitev1.InviteLink{Id: "link-1", Status: invitev1.InviteLinkStatus_INVITE_LINK_ACTIVE, ExpiresAt: timestamppb.New(now.Add(-time.Minute))}, want: inviteapp.InviteLinkStatusExpired},
+		{name: "revoked", link: &invitev1.InviteLink{Id: "link-1", Status: invitev1.InviteLinkStatus_INVITE_LINK_REVOKED}, want: inviteapp.InviteLinkStatusRevoked},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gateway := GRPCLinkGateway{
+				Links: &inviteLinkClientStub{publicResp: &invitev1.GetPublicInviteLinkResponse{
+					Link:     tc.link,
+					Campaign: &invitev1.InviteCampaignSummary{Name: "Skyfall"},
+				}},
+				Auth: &authClientStub{},
+				Now:  func() time.Time { return now },
+			}
+			link, err := gateway.GetPublicInviteLink(context.Background(), "link-1")
+			if err != nil {
+				t.Fatalf("GetPublicInviteLink() error = %v", err)
+			}
+			if link.Status != tc.want || link.CampaignName != "Skyfall" {
+				t.Fatalf("link = %+v, want status %q", link, tc.want)
+			}
+		})
+	}
+}
+
+func TestClaimInviteLinkIssuesLinkScopedGrant(t *testing.T) {
+	t.Parallel()
+
+	links := &inviteLinkClientStub{claimResp: &invitev1.ClaimInviteLinkResponse{
+		Claim: &invitev1.InviteLinkClaim{Status: invitev1.InviteLinkClaimStatus_INVITE_LINK_CLAIM_PENDING_APPROVAL},
+	}}
+	auth := &authClientStub{}
+	pending, err := GRPCLinkGateway{Links: links, Auth: auth}.ClaimInviteLink(context.Background(), "user-1", inviteapp.PublicInviteLink{
+		LinkID:     "link-1",
+		CampaignID: "camp-1",
+	})
+	if err != nil {
+		t.Fatalf("ClaimInviteLink() error = %v", err)
+	}
+	if !pending {
+		t.Fatal("expected pending approval result")
+	}
+	if auth.lastIssue.GetInviteId() != "link-1" || auth.lastIssue.GetParticipantId() != "" {
+		t.Fatalf("IssueJoinGrant request = %+v, want link id without participant", auth.lastIssue)
+	}
+	if links.lastClaim.GetJoinGrant() != "grant" || links.lastClaim.GetLinkId() != "link-1" {
+		t.Fatalf("ClaimInviteLink request = %+v", links.lastClaim)
+	}
+	if got := grpcauthctx.UserIDFromOutgoingContext(links.claimCtx); got != "user-1" {
+		t.Fatalf("claim user metadata = %q, want %q", got, "user-1")
+	}
+}
+
+func TestClaimInviteLinkMapsExhaustedLink(t *testing.T) {
+	t.Parallel()
+
+	links := &inviteLinkClientStub{claimErr: status.Error(codes.FailedPrecondition, "invite link has no remaining uses")}
+	_, err := GRPCLinkGateway{Links: links, Auth: &authClientStub{}}.ClaimInviteLink(context.Background(), "user-1", inviteapp.PublicInviteLink{LinkID: "link-1"})
+	if got := apperrors.LocalizationKey(err); got != "error.web.message.invite_link_unavailable" {
+		t.Fatalf("LocalizationKey(err) = %q", got)
+	}
+}
+
+type inviteLinkClientStub struct {
+	publicResp *invitev1.GetPublicInviteLinkResponse
+	claimResp  *invitev1.ClaimInviteLinkResponse
+	claimErr   error
+	claimCtx   context.Context
+	lastClaim  *invitev1.ClaimInviteLinkRequest
+}
+
+func (s *inviteLinkClientStub) GetPublicInviteLink(context.Context, *invitev1.GetPublicInviteLinkRequest, ...grpc.CallOption) (*invitev1.GetPublicInviteLinkResponse, error) {
+	return s.publicResp, nil
+}
+
+func (s *inviteLinkClientStub) ClaimInviteLink(ctx context.Context, req *invitev1.ClaimInviteLinkRequest, _ ...grpc.CallOption) (*invitev1.ClaimInviteLinkResponse, error) {
+	s.claimCtx = ctx
+	s.lastClaim = req
+	if s.claimErr != nil {
+		return nil, s.claimErr
+	}
+	return s.claimResp, nil
+}
@@ -23,6 +23,7 @@ import (
 type handlers struct {
 	publichandler.Base
 	service     inviteapp.Service
+	links       inviteapp.LinkService
 	requestMeta requestmeta.SchemePolicy
 	sync        DashboardSync
 }
@@ -30,6 +31,7 @@ type handlers struct {
 // newHandlers assembles the invite transport surface from explicit dependencies.
 func newHandlers(
 	s inviteapp.Service,
+	links inviteapp.LinkService,
 	requestPrincipal principal.PrincipalResolver,
 	policy requestmeta.SchemePolicy,
 	sync DashboardSync,
@@ -40,6 +42,7 @@ func newHandlers(
 	return handlers{
 		Base:        publichandler.NewBaseFromPrincipal(requestPrincipal),
 		service:     s,
+		links:       links,
 		requestMeta: policy,
 		sync:        sync,
 	}
@@ -0,0 +1,132 @@
+package invite
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	inviteapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/invite/app"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/flash"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/pagerender"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
+)
+
+// withLinkID extracts the invite-link route parameter before delegating to
+// handlers.
+func (h handlers) withLinkID(fn func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return httpx.WithRequiredRouteParam("linkID", h.handleNotFound, fn)
+}
+
+// handleInviteLink renders the shareable invite-link landing page.
+func (h handlers) handleInviteLink(w http.ResponseWriter, r *http.Request, linkID string) {
+	page, err := h.links.LoadInviteLink(r.Context(), h.userID(r), linkID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	loc, lang := h.PageLocalizer(w, r)
+	h.WritePublicPage(w, r, pagerender.PublicPage{
+		Title:      page.Link.CampaignName,
+		MetaDesc:   webtemplates.T(loc, "layout.meta_description"),
+		Language:   lang,
+		StatusCode: http.StatusOK,
+		Body:       PublicInvitePage(mapPublicInviteLinkView(page, loc), loc),
+	})
+}
+
+// handleInviteLinkAccept claims a seat through the link for the signed-in
+// viewer. Approval-gated links return to the dashboard until the GM decides.
+func (h handlers) handleInviteLinkAccept(w http.ResponseWriter, r *http.Request, linkID string) {
+	if !h.allowMutation(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	userID := h.userID(r)
+	if strings.TrimSpace(userID) == "" {
+		http.Redirect(w, r, loginRedirectForInviteLink(linkID), http.StatusSeeOther)
+		return
+	}
+	result, err := h.links.ClaimInviteLink(r.Context(), userID, linkID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	h.sync.InviteChanged(r.Context(), result.UserIDs, result.CampaignID)
+	if result.Pending {
+		flash.Write(w, r, flash.NoticeSuccess("web.invite.notice_link_claim_pending"))
+		http.Redirect(w, r, routepath.AppDashboard, http.StatusSeeOther)
+		return
+	}
+	flash.Write(w, r, flash.NoticeSuccess("web.invite.notice_joined_campaign"))
+	http.Redirect(w, r, routepath.AppCampaign(result.CampaignID), http.StatusSeeOther)
+}
+
+// loginRedirectForInviteLink keeps invite-link URLs sticky across anonymous
+// auth entrypoints.
+func loginRedirectForInviteLink(linkID string) string {
+	values := url.Values{}
+	values.Set("next", routepath.PublicInviteLink(linkID))
+	return routepath.Login + "?" + values.Encode()
+}
+
+// mapPublicInviteLinkView reuses the invite landing layout for link claims;
+// the seat field shows the role the link grants.
+func mapPublicInviteLinkView(page inviteapp.InviteLinkPage, loc webtemplates.Localizer) PublicInvitePageView {
+	view := PublicInvitePageView{
+		CampaignName:    page.Link.CampaignName,
+		ParticipantName: inviteLinkRoleLabel(page.Link.Role, loc),
+		StatusLabel:     inviteLinkStatusLabel(page.Link.Status, loc),
+	}
+	if username := strings.TrimSpace(page.Link.InviterUsername); username != "" {
+		view.InviterUsername = username
+		view.InviterProfileURL = routepath.UserProfile(username)
+	}
+	switch page.State {
+	case inviteapp.InviteLinkPageStateAnonymous:
+		view.Heading = webtemplates.T(loc, "web.invite.state.anonymous.heading")
+		view.Body = webtemplates.T(loc, "web.invite.state.anonymous.body")
+		view.LoginLabel = webtemplates.T(loc, "web.invite.action.login")
+		view.LoginURL = loginRedirectForInviteLink(page.Link.LinkID)
+	case inviteapp.InviteLinkPageStateClaimable:
+		view.Heading = webtemplates.T(loc, "web.invite.state.claimable.heading")
+		view.Body = webtemplates.T(loc, "web.invite.state.claimable.body")
+		if page.Link.RequiresApproval {
+			view.Heading = webtemplates.T(loc, "web.invite.state.link_approval.heading")
+			view.Body = webtemplates.T(loc, "web.invite.state.link_approval.body")
+		}
+		view.AcceptLabel = webtemplates.T(loc, "web.invite.action.accept")
+		view.AcceptURL = routepath.PublicInviteLink(page.Link.LinkID)
+	default:
+		view.Heading = webtemplates.T(loc, "web.invite.state.link_unavailable.heading")
+		view.Body = webtemplates.T(loc, "web.invite.state.link_unavailable.body")
+		view.DashboardLabel = webtemplates.T(loc, "web.invite.action.dashboard")
+		view.DashboardURL = routepath.AppDashboard
+	}
+	return view
+}
+
+// inviteLinkRoleLabel resolves the seat-role copy for one invite link.
+func inviteLinkRoleLabel(role inviteapp.InviteLinkRole, loc webtemplates.Localizer) string {
+	if role == inviteapp.InviteLinkRoleGM {
+		return webtemplates.T(loc, "web.invite.link_role.gm")
+	}
+	return webtemplates.T(loc, "web.invite.link_role.player")
+}
+
+// inviteLinkStatusLabel resolves invite-link availability copy.
+func inviteLinkStatusLabel(status inviteapp.InviteLinkStatus, loc webtemplates.Localizer) string {
+	switch status {
+	case inviteapp.InviteLinkStatusActive:
+		return webtemplates.T(loc, "web.invite.link_status.active")
+	case inviteapp.InviteLinkStatusRevoked:
+		return webtemplates.T(loc, "web.invite.status.revoked")
+	case inviteapp.InviteLinkStatusExpired:
+		return webtemplates.T(loc, "web.invite.link_status.expired")
+	case inviteapp.InviteLinkStatusExhausted:
+		return webtemplates.T(loc, "web.invite.link_status.exhausted")
+	default:
+		return webtemplates.T(loc, "web.invite.status.unspecified")
+	}
+}
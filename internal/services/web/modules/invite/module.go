@@ -17,6 +17,7 @@ type DashboardSync = dashboardsync.Service
 // Module provides public invite landing routes.
 type Module struct {
 	service     inviteapp.Service
+	links       inviteapp.LinkService
 	requestMeta requestmeta.SchemePolicy
 	principal   principal.PrincipalResolver
 	sync        DashboardSync
@@ -25,6 +26,7 @@ type Module struct {
 // Config defines constructor dependencies for the invite module.
 type Config struct {
 	Service       inviteapp.Service
+	LinkService   inviteapp.LinkService
 	RequestMeta   requestmeta.SchemePolicy
 	Principal     principal.PrincipalResolver
 	DashboardSync DashboardSync
@@ -36,12 +38,17 @@ func New(config Config) Module {
 	if service == nil {
 		service = inviteapp.NewService(nil)
 	}
+	links := config.LinkService
+	if links == nil {
+		links = inviteapp.NewLinkService(nil)
+	}
 	sync := config.DashboardSync
 	if sync == nil {
 		sync = dashboardsync.Noop{}
 	}
 	return Module{
 		service:     service,
+		links:       links,
 		requestMeta: config.RequestMeta,
 		principal:   config.Principal,
 		sync:        sync,
@@ -54,7 +61,7 @@ func (Module) ID() string { return "invite" }
 // Mount wires public invite route handlers.
 func (m Module) Mount() (module.Mount, error) {
 	mux := http.NewServeMux()
-	h := newHandlers(m.service, m.principal, m.requestMeta, m.sync)
+	h := newHandlers(m.service, m.links, m.principal, m.requestMeta, m.sync)
 	registerRoutes(mux, h)
 	return module.Mount{Prefix: routepath.InvitePrefix, Handler: mux}, nil
 }
@@ -17,6 +17,8 @@ func registerRoutes(mux *http.ServeMux, h handlers) {
 	mux.HandleFunc(http.MethodGet+" "+routepath.PublicInviteAcceptPattern, httpx.MethodNotAllowed(http.MethodPost))
 	mux.HandleFunc(http.MethodPost+" "+routepath.PublicInviteDeclinePattern, h.withInviteID(h.handleDecline))
 	mux.HandleFunc(http.MethodGet+" "+routepath.PublicInviteDeclinePattern, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodGet+" "+routepath.PublicInviteLinkPattern, h.withLinkID(h.handleInviteLink))
+	mux.HandleFunc(http.MethodPost+" "+routepath.PublicInviteLinkPattern, h.withLinkID(h.handleInviteLinkAccept))
 	mux.HandleFunc(http.MethodGet+" "+routepath.InvitePrefix+"{$}", h.handleNotFound)
 	mux.HandleFunc(http.MethodGet+" "+routepath.PublicInviteRestPattern, h.handleNotFound)
 	mux.HandleFunc(http.MethodPost+" "+routepath.PublicInviteRestPattern, h.handleNotFound)
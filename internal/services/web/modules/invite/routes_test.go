@@ -16,14 +16,14 @@ import (
 func TestRegisterRoutesHandlesNilMux(t *testing.T) {
 	t.Parallel()
 
-	registerRoutes(nil, newHandlers(inviteapp.NewService(&routeGatewayStub{}), nil, requestmeta.SchemePolicy{}, nil))
+	registerRoutes(nil, newHandlers(inviteapp.NewService(&routeGatewayStub{}), inviteapp.NewLinkService(routeLinkGatewayStub{}), nil, requestmeta.SchemePolicy{}, nil))
 }
 
 func TestRegisterRoutesInviteMethodContracts(t *testing.T) {
 	t.Parallel()
 
 	mux := http.NewServeMux()
-	registerRoutes(mux, newHandlers(inviteapp.NewService(&routeGatewayStub{}), principalWithUserID("user-1"), requestmeta.SchemePolicy{}, nil))
+	registerRoutes(mux, newHandlers(inviteapp.NewService(&routeGatewayStub{}), inviteapp.NewLinkService(routeLinkGatewayStub{}), principalWithUserID("user-1"), requestmeta.SchemePolicy{}, nil))
 
 	tests := []struct {
 		name         string
@@ -41,6 +41,9 @@ func TestRegisterRoutesInviteMethodContracts(t *testing.T) {
 		{name: "invite accept get", method: http.MethodGet, path: routepath.PublicInviteAccept("inv-1"), wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
 		{name: "invite decline post", method: http.MethodPost, path: routepath.PublicInviteDecline("inv-1"), wantStatus: http.StatusSeeOther, wantLocation: routepath.AppDashboard},
 		{name: "invite decline get", method: http.MethodGet, path: routepath.PublicInviteDecline("inv-1"), wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
+		{name: "invite link get", method: http.MethodGet, path: routepath.PublicInviteLink("link-1"), wantStatus: http.StatusOK},
+		{name: "invite link accept post", method: http.MethodPost, path: routepath.PublicInviteLink("link-1"), wantStatus: http.StatusSeeOther, wantLocation: routepath.AppCampaign("camp-1")},
+		{name: "invite link short", method: http.MethodGet, path: routepath.InviteLinkPrefix + "link-1", wantStatus: http.StatusNotFound},
 	}
 
 	for _, tc := range tests {
@@ -70,7 +73,7 @@ func TestRegisterRoutesRejectsCookieMutationWithoutSameOriginProof(t *testing.T)
 	t.Parallel()
 
 	mux := http.NewServeMux()
-	registerRoutes(mux, newHandlers(inviteapp.NewService(&routeGatewayStub{}), principalWithUserID("user-1"), requestmeta.SchemePolicy{}, nil))
+	registerRoutes(mux, newHandlers(inviteapp.NewService(&routeGatewayStub{}), inviteapp.NewLinkService(routeLinkGatewayStub{}), principalWithUserID("user-1"), requestmeta.SchemePolicy{}, nil))
 
 	req := httptest.NewRequest(http.MethodPost, routepath.PublicInviteAccept("inv-1"), nil)
 	req.AddCookie(&http.Cookie{Name: sessioncookie.Name, Value: "sess-1"})
@@ -87,7 +90,7 @@ func TestRegisterRoutesAllowsCookieMutationWithSameOriginProof(t *testing.T) {
 	t.Parallel()
 
 	mux := http.NewServeMux()
-	registerRoutes(mux, newHandlers(inviteapp.NewService(&routeGatewayStub{}), principalWithUserID("user-1"), requestmeta.SchemePolicy{}, nil))
+	registerRoutes(mux, newHandlers(inviteapp.NewService(&routeGatewayStub{}), inviteapp.NewLinkService(routeLinkGatewayStub{}), principalWithUserID("user-1"), requestmeta.SchemePolicy{}, nil))
 
 	req := httptest.NewRequest(http.MethodPost, "http://app.example.test"+routepath.PublicInviteAccept("inv-1"), nil)
 	req.Host = "app.example.test"
@@ -136,3 +139,19 @@ func principalWithUserID(userID string) principal.Principal {
 		nil,
 	)
 }
+
+type routeLinkGatewayStub struct{}
+
+func (routeLinkGatewayStub) GetPublicInviteLink(context.Context, string) (inviteapp.PublicInviteLink, error) {
+	return inviteapp.PublicInviteLink{
+		LinkID:       "link-1",
+		CampaignID:   "camp-1",
+		CampaignName: "Skyfall",
+		Role:         inviteapp.InviteLinkRolePlayer,
+		Status:       inviteapp.InviteLinkStatusActive,
+	}, nil
+}
+
+func (routeLinkGatewayStub) ClaimInviteLink(context.Context, string, inviteapp.PublicInviteLink) (bool, error) {
+	return false, nil
+}
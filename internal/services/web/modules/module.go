@@ -9,6 +9,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/profile"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/publicauth"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/settings"
+	"github.com/louisbranch/fracturing.space/internal/services/web/modules/status"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/dashboardsync"
 )
 
@@ -48,6 +49,9 @@ type Dependencies struct {
 
 	// Discovery owns starter/discovery list dependencies.
 	Discovery discovery.Dependencies
+
+	// Status owns public status history dependencies.
+	Status status.Dependencies
 }
 
 // DashboardSyncDependencies contains shared mutation-sync clients.
@@ -6,6 +6,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/invite"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/profile"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/publicauth"
+	"github.com/louisbranch/fracturing.space/internal/services/web/modules/status"
 	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
 )
 
@@ -19,8 +20,13 @@ func defaultPublicModules(deps Dependencies, requestPrincipal principal.Principa
 	})
 	if deps.Discovery.DiscoveryClient != nil {
 		publicModules = append(publicModules, discovery.Compose(discovery.CompositionConfig{
-			Client: deps.Discovery.DiscoveryClient,
-			Logger: opts.Logger,
+			Client:            deps.Discovery.DiscoveryClient,
+			ApplicationClient: deps.Discovery.ApplicationClient,
+			CampaignClient:    deps.Discovery.CampaignClient,
+			CharacterClient:   deps.Discovery.CharacterClient,
+			RequestMeta:       opts.RequestSchemePolicy,
+			Principal:         requestPrincipal,
+			Logger:            opts.Logger,
 		}))
 	}
 	if deps.Profile.AuthClient != nil {
@@ -33,11 +39,18 @@ func defaultPublicModules(deps Dependencies, requestPrincipal principal.Principa
 	}
 	if deps.Invite.InviteClient != nil && deps.Invite.AuthClient != nil {
 		publicModules = append(publicModules, invite.Compose(invite.CompositionConfig{
-			InviteClient:  deps.Invite.InviteClient,
-			AuthClient:    deps.Invite.AuthClient,
-			RequestMeta:   opts.RequestSchemePolicy,
-			Principal:     requestPrincipal,
-			DashboardSync: opts.DashboardSync,
+			InviteClient:     deps.Invite.InviteClient,
+			InviteLinkClient: deps.Invite.InviteLinkClient,
+			AuthClient:       deps.Invite.AuthClient,
+			RequestMeta:      opts.RequestSchemePolicy,
+			Principal:        requestPrincipal,
+			DashboardSync:    opts.DashboardSync,
+		}))
+	}
+	if deps.Status.StatusClient != nil {
+		publicModules = append(publicModules, status.Compose(status.CompositionConfig{
+			Client: deps.Status.StatusClient,
+			Logger: opts.Logger,
 		}))
 	}
 	return publicModules
@@ -21,6 +21,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/invite"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/notifications"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/profile"
+	"github.com/louisbranch/fracturing.space/internal/services/web/modules/status"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/dashboardsync"
 	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
 	"google.golang.org/grpc"
@@ -100,6 +101,29 @@ func TestDefaultPublicModulesExposeDiscoveryProfileInviteWhenDependenciesAreConf
 	}
 }
 
+func TestDefaultPublicModulesExposeStatusPageWhenStatusClientIsConfigured(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistryBuilder()
+	built := reg.Build(RegistryInput{
+		Dependencies: Dependencies{
+			AssetBaseURL: "https://cdn.example.com/assets",
+			Status: status.Dependencies{
+				StatusClient: &stubStatusClient{},
+			},
+		},
+		Principal:        principal.Principal{},
+		PublicOptions:    PublicModuleOptions{},
+		ProtectedOptions: ProtectedModuleOptions{},
+	})
+	if len(built.Public) != 4 {
+		t.Fatalf("public module count = %d, want %d", len(built.Public), 4)
+	}
+	if got := built.Public[3].ID(); got != "status" {
+		t.Fatalf("public module[3] id = %q, want %q", got, "status")
+	}
+}
+
 func TestPublicProfileModuleCanRenderWithOnlyAuthDependency(t *testing.T) {
 	t.Parallel()
 
@@ -17,11 +17,27 @@ type LocaleGateway interface {
 	SaveLocale(context.Context, string, string) error
 }
 
-// SecurityGateway loads and mutates authenticated passkey settings.
+// SecurityGateway loads and mutates authenticated passkey and web session
+// settings.
 type SecurityGateway interface {
 	ListPasskeys(context.Context, string) ([]SettingsPasskey, error)
 	BeginPasskeyRegistration(context.Context, string) (PasskeyChallenge, error)
 	FinishPasskeyRegistration(context.Context, string, json.RawMessage) error
+	RenamePasskey(context.Context, string, string, string) error
+	DeletePasskey(context.Context, string, string) error
+	ListWebSessions(context.Context, string) ([]SettingsWebSession, error)
+	RevokeWebSession(context.Context, string, string) error
+	RevokeOtherWebSessions(context.Context, string, string) (int, error)
+	BeginRecoveryCodeRotation(context.Context, string) (PasskeyChallenge, error)
+	FinishRecoveryCodeRotation(context.Context, string, string, json.RawMessage) (string, error)
+}
+
+// PrivacyGateway requests account exports and closes accounts.
+type PrivacyGateway interface {
+	ListAccountExports(context.Context, string) ([]SettingsAccountExport, error)
+	RequestAccountExport(context.Context, string) error
+	GetAccountExportArchive(context.Context, string, string) (AccountExportArchive, error)
+	DeleteAccount(context.Context, string, string) error
 }
 
 // AccountGateway groups account-owned settings gateway behavior.
@@ -29,6 +45,7 @@ type AccountGateway interface {
 	ProfileGateway
 	LocaleGateway
 	SecurityGateway
+	PrivacyGateway
 }
 
 // ProfileService exposes profile orchestration used by transport handlers.
@@ -43,11 +60,29 @@ type LocaleService interface {
 	SaveLocale(context.Context, string, string) error
 }
 
-// SecurityService exposes passkey security orchestration used by transport handlers.
+// SecurityService exposes passkey and session security orchestration used by
+// transport handlers. Session methods take the caller's current session ID so
+// the page can mark it and keep it signed in.
 type SecurityService interface {
 	ListPasskeys(context.Context, string) ([]SettingsPasskey, error)
 	BeginPasskeyRegistration(context.Context, string) (PasskeyChallenge, error)
 	FinishPasskeyRegistration(context.Context, string, json.RawMessage) error
+	RenamePasskey(context.Context, string, string, string) error
+	DeletePasskey(context.Context, string, string) error
+	ListWebSessions(context.Context, string, string) ([]SettingsWebSession, error)
+	RevokeWebSession(context.Context, string, string, string) error
+	RevokeOtherWebSessions(context.Context, string, string) (int, error)
+	BeginRecoveryCodeRotation(context.Context, string) (PasskeyChallenge, error)
+	FinishRecoveryCodeRotation(context.Context, string, string, json.RawMessage) (string, error)
+}
+
+// PrivacyService exposes account export and deletion orchestration used by
+// transport handlers.
+type PrivacyService interface {
+	ListAccountExports(context.Context, string) ([]SettingsAccountExport, error)
+	RequestAccountExport(context.Context, string) error
+	GetAccountExportArchive(context.Context, string, string) (AccountExportArchive, error)
+	DeleteAccount(context.Context, string, string) error
 }
 
 // AccountService groups account-owned settings orchestration.
@@ -55,4 +90,5 @@ type AccountService interface {
 	ProfileService
 	LocaleService
 	SecurityService
+	PrivacyService
 }
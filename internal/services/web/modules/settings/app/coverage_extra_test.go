@@ -43,6 +43,34 @@ func (s *securityGatewayStub) FinishPasskeyRegistration(_ context.Context, sessi
 	return s.finishErr
 }
 
+func (s *securityGatewayStub) RenamePasskey(context.Context, string, string, string) error {
+	return nil
+}
+
+func (s *securityGatewayStub) DeletePasskey(context.Context, string, string) error {
+	return nil
+}
+
+func (s *securityGatewayStub) ListWebSessions(context.Context, string) ([]SettingsWebSession, error) {
+	return nil, nil
+}
+
+func (s *securityGatewayStub) RevokeWebSession(context.Context, string, string) error {
+	return nil
+}
+
+func (s *securityGatewayStub) RevokeOtherWebSessions(context.Context, string, string) (int, error) {
+	return 0, nil
+}
+
+func (s *securityGatewayStub) BeginRecoveryCodeRotation(context.Context, string) (PasskeyChallenge, error) {
+	return PasskeyChallenge{}, nil
+}
+
+func (s *securityGatewayStub) FinishRecoveryCodeRotation(context.Context, string, string, json.RawMessage) (string, error) {
+	return "", nil
+}
+
 func TestSecurityServiceFlowsNormalizeValidateAndDelegate(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ type AccountServiceConfig struct {
 	ProfileGateway  ProfileGateway
 	LocaleGateway   LocaleGateway
 	SecurityGateway SecurityGateway
+	PrivacyGateway  PrivacyGateway
 }
 
 // AIServiceConfig keeps AI-surface gateway dependencies explicit.
@@ -18,6 +19,7 @@ type accountService struct {
 	profileGateway  ProfileGateway
 	localeGateway   LocaleGateway
 	securityGateway SecurityGateway
+	privacyGateway  PrivacyGateway
 }
 
 // aiService defines the AI-owned concrete service used by transport.
@@ -40,10 +42,15 @@ func NewAccountService(config AccountServiceConfig) AccountService {
 	if securityGateway == nil {
 		securityGateway = unavailableGateway{}
 	}
+	privacyGateway := config.PrivacyGateway
+	if privacyGateway == nil {
+		privacyGateway = unavailableGateway{}
+	}
 	return accountService{
 		profileGateway:  profileGateway,
 		localeGateway:   localeGateway,
 		securityGateway: securityGateway,
+		privacyGateway:  privacyGateway,
 	}
 }
 
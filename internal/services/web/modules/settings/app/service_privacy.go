@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+// ListAccountExports returns the user's recent account exports, newest first.
+func (s accountService) ListAccountExports(ctx context.Context, userID string) ([]SettingsAccountExport, error) {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	exports, err := s.privacyGateway.ListAccountExports(ctx, resolvedUserID)
+	if err != nil {
+		return nil, err
+	}
+	if exports == nil {
+		return []SettingsAccountExport{}, nil
+	}
+	normalized := make([]SettingsAccountExport, 0, len(exports))
+	for _, export := range exports {
+		normalized = append(normalized, normalizeSettingsAccountExport(export))
+	}
+	return normalized, nil
+}
+
+// RequestAccountExport queues a new account export for the user.
+func (s accountService) RequestAccountExport(ctx context.Context, userID string) error {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return err
+	}
+	return s.privacyGateway.RequestAccountExport(ctx, resolvedUserID)
+}
+
+// GetAccountExportArchive loads one ready export archive owned by the user.
+func (s accountService) GetAccountExportArchive(ctx context.Context, userID string, exportID string) (AccountExportArchive, error) {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return AccountExportArchive{}, err
+	}
+	exportID = strings.TrimSpace(exportID)
+	if exportID == "" {
+		return AccountExportArchive{}, apperrors.E(apperrors.KindNotFound, "account export not found")
+	}
+	archive, err := s.privacyGateway.GetAccountExportArchive(ctx, resolvedUserID, exportID)
+	if err != nil {
+		return AccountExportArchive{}, err
+	}
+	archive.Filename = strings.TrimSpace(archive.Filename)
+	if archive.Filename == "" {
+		archive.Filename = "fracturing-space-export.zip"
+	}
+	return archive, nil
+}
+
+// DeleteAccount closes the user's account once they repeat their username.
+func (s accountService) DeleteAccount(ctx context.Context, userID string, confirmUsername string) error {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return err
+	}
+	confirmUsername = strings.TrimSpace(confirmUsername)
+	if confirmUsername == "" {
+		return apperrors.EK(apperrors.KindInvalidInput, "web.settings.privacy.error_confirmation_required", "username confirmation is required")
+	}
+	return s.privacyGateway.DeleteAccount(ctx, resolvedUserID, confirmUsername)
+}
+
+// normalizeSettingsAccountExport normalizes one export row for stable rendering.
+func normalizeSettingsAccountExport(export SettingsAccountExport) SettingsAccountExport {
+	export.ID = strings.TrimSpace(export.ID)
+	export.Status = strings.TrimSpace(export.Status)
+	export.CreatedAt = strings.TrimSpace(export.CreatedAt)
+	export.ExpiresAt = strings.TrimSpace(export.ExpiresAt)
+	export.FailureMessage = strings.TrimSpace(export.FailureMessage)
+	if export.Status == "" {
+		export.Status = AccountExportStatusPending
+	}
+	if export.CreatedAt == "" {
+		export.CreatedAt = "-"
+	}
+	if export.ExpiresAt == "" {
+		export.ExpiresAt = "-"
+	}
+	export.Ready = export.Status == AccountExportStatusReady && export.ID != ""
+	return export
+}
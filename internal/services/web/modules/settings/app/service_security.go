@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
 
 	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
 )
@@ -57,11 +58,161 @@ func (s accountService) FinishPasskeyRegistration(ctx context.Context, sessionID
 	return s.securityGateway.FinishPasskeyRegistration(ctx, sessionID, credential)
 }
 
+// RenamePasskey sets the display label of one of the user's passkeys. An
+// empty label restores the numbered default.
+func (s accountService) RenamePasskey(ctx context.Context, userID string, credentialID string, label string) error {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return err
+	}
+	credentialID = strings.TrimSpace(credentialID)
+	if credentialID == "" {
+		return apperrors.E(apperrors.KindInvalidInput, "credential id is required")
+	}
+	label = strings.TrimSpace(label)
+	if utf8.RuneCountInString(label) > MaxPasskeyLabelLength {
+		return apperrors.EK(apperrors.KindInvalidInput, "web.settings.security.error_passkey_label_too_long", "passkey label is too long")
+	}
+	return s.securityGateway.RenamePasskey(ctx, resolvedUserID, credentialID, label)
+}
+
+// DeletePasskey removes one of the user's passkeys.
+func (s accountService) DeletePasskey(ctx context.Context, userID string, credentialID string) error {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return err
+	}
+	credentialID = strings.TrimSpace(credentialID)
+	if credentialID == "" {
+		return apperrors.E(apperrors.KindInvalidInput, "credential id is required")
+	}
+	return s.securityGateway.DeletePasskey(ctx, resolvedUserID, credentialID)
+}
+
+// ListWebSessions returns the user's signed-in browsers with the caller's
+// own session listed first.
+func (s accountService) ListWebSessions(ctx context.Context, userID string, currentSessionID string) ([]SettingsWebSession, error) {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.securityGateway.ListWebSessions(ctx, resolvedUserID)
+	if err != nil {
+		return nil, err
+	}
+	currentSessionID = strings.TrimSpace(currentSessionID)
+	normalized := make([]SettingsWebSession, 0, len(sessions))
+	for _, session := range sessions {
+		session = normalizeSettingsWebSession(session)
+		session.Current = currentSessionID != "" && session.ID == currentSessionID
+		if session.Current {
+			normalized = append([]SettingsWebSession{session}, normalized...)
+			continue
+		}
+		normalized = append(normalized, session)
+	}
+	return normalized, nil
+}
+
+// RevokeWebSession signs one other browser out. The current session is
+// refused so users sign themselves out through the regular logout flow.
+func (s accountService) RevokeWebSession(ctx context.Context, userID string, currentSessionID string, sessionID string) error {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return err
+	}
+	sessionID = strings.TrimSpace(sessionID)
+	if sessionID == "" {
+		return apperrors.E(apperrors.KindInvalidInput, "session id is required")
+	}
+	if sessionID == strings.TrimSpace(currentSessionID) {
+		return apperrors.EK(apperrors.KindInvalidInput, "web.settings.security.error_revoke_current_session", "current session cannot be revoked here")
+	}
+	return s.securityGateway.RevokeWebSession(ctx, resolvedUserID, sessionID)
+}
+
+// RevokeOtherWebSessions signs every browser except the current one out.
+func (s accountService) RevokeOtherWebSessions(ctx context.Context, userID string, currentSessionID string) (int, error) {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return 0, err
+	}
+	currentSessionID = strings.TrimSpace(currentSessionID)
+	if currentSessionID == "" {
+		return 0, apperrors.E(apperrors.KindInvalidInput, "current session id is required")
+	}
+	return s.securityGateway.RevokeOtherWebSessions(ctx, resolvedUserID, currentSessionID)
+}
+
+// BeginRecoveryCodeRotation starts the passkey re-authentication that guards
+// recovery code rotation.
+func (s accountService) BeginRecoveryCodeRotation(ctx context.Context, userID string) (PasskeyChallenge, error) {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return PasskeyChallenge{}, err
+	}
+	challenge, err := s.securityGateway.BeginRecoveryCodeRotation(ctx, resolvedUserID)
+	if err != nil {
+		return PasskeyChallenge{}, err
+	}
+	challenge.SessionID = strings.TrimSpace(challenge.SessionID)
+	if challenge.SessionID == "" {
+		return PasskeyChallenge{}, apperrors.E(apperrors.KindUnavailable, "passkey session is unavailable")
+	}
+	return challenge, nil
+}
+
+// FinishRecoveryCodeRotation verifies re-authentication and returns the new
+// recovery code for one-time display.
+func (s accountService) FinishRecoveryCodeRotation(ctx context.Context, userID string, sessionID string, credential json.RawMessage) (string, error) {
+	resolvedUserID, err := RequireUserID(userID)
+	if err != nil {
+		return "", err
+	}
+	sessionID = strings.TrimSpace(sessionID)
+	if sessionID == "" {
+		return "", apperrors.E(apperrors.KindInvalidInput, "session id is required")
+	}
+	if len(credential) == 0 {
+		return "", apperrors.E(apperrors.KindInvalidInput, "credential is required")
+	}
+	code, err := s.securityGateway.FinishRecoveryCodeRotation(ctx, resolvedUserID, sessionID, credential)
+	if err != nil {
+		return "", err
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return "", apperrors.E(apperrors.KindUnavailable, "recovery code is unavailable")
+	}
+	return code, nil
+}
+
+// normalizeSettingsWebSession fills placeholders for unknown device details.
+func normalizeSettingsWebSession(session SettingsWebSession) SettingsWebSession {
+	session.ID = strings.TrimSpace(session.ID)
+	session.UserAgent = strings.TrimSpace(session.UserAgent)
+	session.ClientIP = strings.TrimSpace(session.ClientIP)
+	session.CreatedAt = strings.TrimSpace(session.CreatedAt)
+	session.LastSeenAt = strings.TrimSpace(session.LastSeenAt)
+	if session.ClientIP == "" {
+		session.ClientIP = "-"
+	}
+	if session.CreatedAt == "" {
+		session.CreatedAt = "-"
+	}
+	if session.LastSeenAt == "" {
+		session.LastSeenAt = session.CreatedAt
+	}
+	return session
+}
+
 // normalizeSettingsPasskey normalizes one passkey row for stable rendering.
 func normalizeSettingsPasskey(passkey SettingsPasskey) SettingsPasskey {
 	if passkey.Number <= 0 {
 		passkey.Number = 1
 	}
+	passkey.CredentialID = strings.TrimSpace(passkey.CredentialID)
+	passkey.Label = strings.TrimSpace(passkey.Label)
 	passkey.CreatedAt = strings.TrimSpace(passkey.CreatedAt)
 	passkey.LastUsedAt = strings.TrimSpace(passkey.LastUsedAt)
 	if passkey.CreatedAt == "" {
@@ -18,6 +18,9 @@ type gatewayStub struct {
 	credentials []SettingsAICredentialOption
 	models      []SettingsAIModelOption
 	agents      []SettingsAIAgent
+	exports     []SettingsAccountExport
+	archive     AccountExportArchive
+	sessions    []SettingsWebSession
 	err         error
 
 	lastUserID       string
@@ -28,6 +31,10 @@ type gatewayStub struct {
 	lastAgentID      string
 	lastCredentialID string
 	lastCredential   string
+	lastExportID     string
+	lastConfirm      string
+	lastLabel        string
+	lastSessionID    string
 }
 
 func (g gatewayStub) LoadProfile(context.Context, string) (SettingsProfile, error) {
@@ -70,6 +77,52 @@ func (g *gatewayStub) FinishPasskeyRegistration(_ context.Context, sessionID str
 	g.lastCredential = string(credential)
 	return g.err
 }
+func (g *gatewayStub) RenamePasskey(_ context.Context, userID string, credentialID string, label string) error {
+	g.lastUserID = userID
+	g.lastCredentialID = credentialID
+	g.lastLabel = label
+	return g.err
+}
+func (g *gatewayStub) DeletePasskey(_ context.Context, userID string, credentialID string) error {
+	g.lastUserID = userID
+	g.lastCredentialID = credentialID
+	return g.err
+}
+func (g gatewayStub) ListWebSessions(context.Context, string) ([]SettingsWebSession, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+	return g.sessions, nil
+}
+func (g *gatewayStub) RevokeWebSession(_ context.Context, userID string, sessionID string) error {
+	g.lastUserID = userID
+	g.lastSessionID = sessionID
+	return g.err
+}
+func (g *gatewayStub) RevokeOtherWebSessions(_ context.Context, userID string, keepSessionID string) (int, error) {
+	g.lastUserID = userID
+	g.lastSessionID = keepSessionID
+	if g.err != nil {
+		return 0, g.err
+	}
+	return 2, nil
+}
+func (g *gatewayStub) BeginRecoveryCodeRotation(_ context.Context, userID string) (PasskeyChallenge, error) {
+	g.lastUserID = userID
+	if g.err != nil {
+		return PasskeyChallenge{}, g.err
+	}
+	return PasskeyChallenge{SessionID: "reauth-session-1", PublicKey: json.RawMessage(`{"publicKey":{}}`)}, nil
+}
+func (g *gatewayStub) FinishRecoveryCodeRotation(_ context.Context, userID string, sessionID string, credential json.RawMessage) (string, error) {
+	g.lastUserID = userID
+	g.lastSessionID = sessionID
+	g.lastCredential = string(credential)
+	if g.err != nil {
+		return "", g.err
+	}
+	return "fresh-recovery-code", nil
+}
 func (g gatewayStub) ListAIKeys(context.Context, string) ([]SettingsAIKey, error) {
 	if g.err != nil {
 		return nil, g.err
@@ -116,6 +169,29 @@ func (g *gatewayStub) RevokeAIKey(_ context.Context, userID string, credentialID
 	g.lastCredential = credentialID
 	return g.err
 }
+func (g gatewayStub) ListAccountExports(context.Context, string) ([]SettingsAccountExport, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+	return g.exports, nil
+}
+func (g *gatewayStub) RequestAccountExport(_ context.Context, userID string) error {
+	g.lastUserID = userID
+	return g.err
+}
+func (g *gatewayStub) GetAccountExportArchive(_ context.Context, userID string, exportID string) (AccountExportArchive, error) {
+	g.lastUserID = userID
+	g.lastExportID = exportID
+	if g.err != nil {
+		return AccountExportArchive{}, g.err
+	}
+	return g.archive, nil
+}
+func (g *gatewayStub) DeleteAccount(_ context.Context, userID string, confirmUsername string) error {
+	g.lastUserID = userID
+	g.lastConfirm = confirmUsername
+	return g.err
+}
 
 func TestNewServiceFailsClosedWhenGatewayMissing(t *testing.T) {
 	t.Parallel()
@@ -208,6 +284,18 @@ func TestUnavailableGatewayFailsClosed(t *testing.T) {
 	} else if got := apperrors.HTTPStatus(err); got != http.StatusServiceUnavailable {
 		t.Fatalf("DeleteAIAgent() status = %d, want %d", got, http.StatusServiceUnavailable)
 	}
+	if exports, err := gateway.ListAccountExports(ctx, "user-1"); err == nil {
+		t.Fatalf("ListAccountExports() error = nil, want unavailable error")
+	} else if got := apperrors.HTTPStatus(err); got != http.StatusServiceUnavailable {
+		t.Fatalf("ListAccountExports() status = %d, want %d", got, http.StatusServiceUnavailable)
+	} else if exports != nil {
+		t.Fatalf("ListAccountExports() = %+v, want nil", exports)
+	}
+	if err := gateway.DeleteAccount(ctx, "user-1", "alice"); err == nil {
+		t.Fatalf("DeleteAccount() error = nil, want unavailable error")
+	} else if got := apperrors.HTTPStatus(err); got != http.StatusServiceUnavailable {
+		t.Fatalf("DeleteAccount() status = %d, want %d", got, http.StatusServiceUnavailable)
+	}
 }
 
 func TestSaveProfileValidatesNameLength(t *testing.T) {
@@ -437,3 +525,101 @@ func TestAIAgentServiceFlowsValidateNormalizeAndDelegate(t *testing.T) {
 		t.Fatalf("agent id = %q, want %q", gateway.lastAgentID, "agent-1")
 	}
 }
+
+func TestPrivacyServiceFlowsValidateNormalizeAndDelegate(t *testing.T) {
+	t.Parallel()
+
+	gateway := &gatewayStub{
+		exports: []SettingsAccountExport{
+			{ID: " export-1 ", Status: AccountExportStatusReady, CreatedAt: " 2026-03-01 09:00 UTC "},
+			{ID: "export-2"},
+		},
+		archive: AccountExportArchive{Content: []byte("zip")},
+	}
+	svc := newService(gateway)
+	ctx := context.Background()
+
+	exports, err := svc.ListAccountExports(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("ListAccountExports() error = %v", err)
+	}
+	if len(exports) != 2 || exports[0].ID != "export-1" || !exports[0].Ready || exports[0].CreatedAt != "2026-03-01 09:00 UTC" {
+		t.Fatalf("exports[0] = %+v", exports[0])
+	}
+	if exports[1].Status != AccountExportStatusPending || exports[1].Ready || exports[1].ExpiresAt != "-" {
+		t.Fatalf("exports[1] = %+v", exports[1])
+	}
+
+	if err := svc.RequestAccountExport(ctx, "user-1"); err != nil {
+		t.Fatalf("RequestAccountExport() error = %v", err)
+	}
+	if _, err := svc.GetAccountExportArchive(ctx, "user-1", " "); apperrors.HTTPStatus(err) != http.StatusNotFound {
+		t.Fatalf("GetAccountExportArchive(blank) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusNotFound)
+	}
+	archive, err := svc.GetAccountExportArchive(ctx, "user-1", " export-1 ")
+	if err != nil {
+		t.Fatalf("GetAccountExportArchive() error = %v", err)
+	}
+	if gateway.lastExportID != "export-1" || archive.Filename == "" || string(archive.Content) != "zip" {
+		t.Fatalf("archive = %+v, export id = %q", archive, gateway.lastExportID)
+	}
+
+	if err := svc.DeleteAccount(ctx, "user-1", "  "); apperrors.HTTPStatus(err) != http.StatusBadRequest {
+		t.Fatalf("DeleteAccount(blank) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusBadRequest)
+	}
+	if err := svc.DeleteAccount(ctx, "user-1", " alice "); err != nil {
+		t.Fatalf("DeleteAccount() error = %v", err)
+	}
+	if gateway.lastConfirm != "alice" {
+		t.Fatalf("delete confirmation = %q, want %q", gateway.lastConfirm, "alice")
+	}
+}
+
+func TestSecurityCenterServiceFlowsValidateNormalizeAndDelegate(t *testing.T) {
+	t.Parallel()
+
+	gateway := &gatewayStub{
+		sessions: []SettingsWebSession{
+			{ID: " ws-1 ", UserAgent: " Firefox ", CreatedAt: "2026-03-01 09:00 UTC"},
+			{ID: "ws-2", ClientIP: "10.0.0.2"},
+		},
+	}
+	svc := newService(gateway)
+	ctx := context.Background()
+
+	sessions, err := svc.ListWebSessions(ctx, "user-1", "ws-2")
+	if err != nil {
+		t.Fatalf("ListWebSessions() error = %v", err)
+	}
+	if len(sessions) != 2 || sessions[0].ID != "ws-2" || !sessions[0].Current || sessions[0].CreatedAt != "-" {
+		t.Fatalf("sessions[0] = %+v", sessions[0])
+	}
+	if sessions[1].ID != "ws-1" || sessions[1].Current || sessions[1].UserAgent != "Firefox" || sessions[1].LastSeenAt != "2026-03-01 09:00 UTC" || sessions[1].ClientIP != "-" {
+		t.Fatalf("sessions[1] = %+v", sessions[1])
+	}
+
+	if err := svc.RevokeWebSession(ctx, "user-1", "ws-2", " ws-2 "); apperrors.HTTPStatus(err) != http.StatusBadRequest {
+		t.Fatalf("RevokeWebSession(current) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusBadRequest)
+	}
+	if err := svc.RevokeWebSession(ctx, "user-1", "ws-2", " ws-1 "); err != nil || gateway.lastSessionID != "ws-1" {
+		t.Fatalf("RevokeWebSession() error = %v, session = %q", err, gateway.lastSessionID)
+	}
+	if _, err := svc.RevokeOtherWebSessions(ctx, "user-1", " "); apperrors.HTTPStatus(err) != http.StatusBadRequest {
+		t.Fatalf("RevokeOtherWebSessions(blank) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusBadRequest)
+	}
+
+	if err := svc.RenamePasskey(ctx, "user-1", "cred-1", strings.Repeat("x", MaxPasskeyLabelLength+1)); apperrors.HTTPStatus(err) != http.StatusBadRequest {
+		t.Fatalf("RenamePasskey(long) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusBadRequest)
+	}
+	if err := svc.RenamePasskey(ctx, "user-1", " cred-1 ", " Laptop "); err != nil || gateway.lastCredentialID != "cred-1" || gateway.lastLabel != "Laptop" {
+		t.Fatalf("RenamePasskey() error = %v, credential = %q, label = %q", err, gateway.lastCredentialID, gateway.lastLabel)
+	}
+
+	code, err := svc.FinishRecoveryCodeRotation(ctx, "user-1", " reauth-session-1 ", json.RawMessage(`{"id":"cred-1"}`))
+	if err != nil || code != "fresh-recovery-code" || gateway.lastSessionID != "reauth-session-1" {
+		t.Fatalf("FinishRecoveryCodeRotation() = %q, %v, session = %q", code, err, gateway.lastSessionID)
+	}
+	if _, err := svc.FinishRecoveryCodeRotation(ctx, "user-1", "reauth-session-1", nil); apperrors.HTTPStatus(err) != http.StatusBadRequest {
+		t.Fatalf("FinishRecoveryCodeRotation(empty) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusBadRequest)
+	}
+}
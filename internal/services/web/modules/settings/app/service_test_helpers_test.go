@@ -13,6 +13,7 @@ func newService(gateway testGateway) testServiceBundle {
 			ProfileGateway:  gateway,
 			LocaleGateway:   gateway,
 			SecurityGateway: gateway,
+			PrivacyGateway:  gateway,
 		}),
 		AIService: NewAIService(AIServiceConfig{
 			AIKeyGateway:   gateway,
@@ -0,0 +1,25 @@
+package app
+
+// SettingsAccountExport stores one account export row rendered on the privacy page.
+type SettingsAccountExport struct {
+	ID             string
+	Status         string
+	CreatedAt      string
+	ExpiresAt      string
+	SizeBytes      int64
+	FailureMessage string
+	Ready          bool
+}
+
+// AccountExportArchive stores one downloadable export archive.
+type AccountExportArchive struct {
+	Filename string
+	Content  []byte
+}
+
+// Account export status labels shared by the gateway and templates.
+const (
+	AccountExportStatusPending = "pending"
+	AccountExportStatusReady   = "ready"
+	AccountExportStatusFailed  = "failed"
+)
@@ -4,11 +4,27 @@ import "encoding/json"
 
 // SettingsPasskey stores one passkey summary row rendered on the security page.
 type SettingsPasskey struct {
-	Number     int
+	CredentialID string
+	Number       int
+	Label        string
+	CreatedAt    string
+	LastUsedAt   string
+}
+
+// SettingsWebSession stores one signed-in browser row rendered on the
+// security page.
+type SettingsWebSession struct {
+	ID         string
+	UserAgent  string
+	ClientIP   string
 	CreatedAt  string
-	LastUsedAt string
+	LastSeenAt string
+	Current    bool
 }
 
+// MaxPasskeyLabelLength mirrors the auth service limit on passkey names.
+const MaxPasskeyLabelLength = 64
+
 // PasskeyChallenge stores authenticated passkey enrollment begin state.
 type PasskeyChallenge struct {
 	SessionID string
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+// ListAccountExports returns unavailable while settings is degraded.
+func (unavailableGateway) ListAccountExports(context.Context, string) ([]SettingsAccountExport, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// RequestAccountExport returns unavailable while settings is degraded.
+func (unavailableGateway) RequestAccountExport(context.Context, string) error {
+	return apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// GetAccountExportArchive returns unavailable while settings is degraded.
+func (unavailableGateway) GetAccountExportArchive(context.Context, string, string) (AccountExportArchive, error) {
+	return AccountExportArchive{}, apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// DeleteAccount returns unavailable while settings is degraded.
+func (unavailableGateway) DeleteAccount(context.Context, string, string) error {
+	return apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
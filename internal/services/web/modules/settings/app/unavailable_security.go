@@ -21,3 +21,38 @@ func (unavailableGateway) BeginPasskeyRegistration(context.Context, string) (Pas
 func (unavailableGateway) FinishPasskeyRegistration(context.Context, string, json.RawMessage) error {
 	return apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
 }
+
+// RenamePasskey returns unavailable while settings is degraded.
+func (unavailableGateway) RenamePasskey(context.Context, string, string, string) error {
+	return apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// DeletePasskey returns unavailable while settings is degraded.
+func (unavailableGateway) DeletePasskey(context.Context, string, string) error {
+	return apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// ListWebSessions returns unavailable while settings is degraded.
+func (unavailableGateway) ListWebSessions(context.Context, string) ([]SettingsWebSession, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// RevokeWebSession returns unavailable while settings is degraded.
+func (unavailableGateway) RevokeWebSession(context.Context, string, string) error {
+	return apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// RevokeOtherWebSessions returns unavailable while settings is degraded.
+func (unavailableGateway) RevokeOtherWebSessions(context.Context, string, string) (int, error) {
+	return 0, apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// BeginRecoveryCodeRotation returns unavailable while settings is degraded.
+func (unavailableGateway) BeginRecoveryCodeRotation(context.Context, string) (PasskeyChallenge, error) {
+	return PasskeyChallenge{}, apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
+
+// FinishRecoveryCodeRotation returns unavailable while settings is degraded.
+func (unavailableGateway) FinishRecoveryCodeRotation(context.Context, string, string, json.RawMessage) (string, error) {
+	return "", apperrors.E(apperrors.KindUnavailable, "settings service is not configured")
+}
@@ -19,6 +19,7 @@ type CompositionConfig struct {
 	SocialClient     settingsgateway.SocialClient
 	AccountClient    settingsgateway.AccountClient
 	PasskeyClient    settingsgateway.PasskeyClient
+	PrivacyClient    settingsgateway.PrivacyClient
 	CredentialClient settingsgateway.CredentialClient
 	AgentClient      settingsgateway.AgentClient
 }
@@ -38,6 +39,7 @@ func Compose(config CompositionConfig) module.Module {
 		config.SocialClient,
 		config.AccountClient,
 		config.PasskeyClient,
+		config.PrivacyClient,
 	)
 	aiGateway := settingsgateway.NewAIGateway(
 		config.CredentialClient,
@@ -49,6 +51,7 @@ func Compose(config CompositionConfig) module.Module {
 				ProfileGateway:  accountGateway,
 				LocaleGateway:   accountGateway,
 				SecurityGateway: accountGateway,
+				PrivacyGateway:  accountGateway,
 			}),
 			AI: settingsapp.NewAIService(settingsapp.AIServiceConfig{
 				AIKeyGateway:   aiGateway,
@@ -78,6 +81,7 @@ func newCompositionConfig(options ProtectedSurfaceOptions, deps Dependencies) Co
 		SocialClient:     deps.SocialClient,
 		AccountClient:    deps.AccountClient,
 		PasskeyClient:    deps.PasskeyClient,
+		PrivacyClient:    deps.PrivacyClient,
 		CredentialClient: deps.CredentialClient,
 		AgentClient:      deps.AgentClient,
 	}
@@ -90,6 +94,7 @@ func newSurfaceAvailability(config CompositionConfig) settingsSurfaceAvailabilit
 		profile:  config.SocialClient != nil,
 		locale:   config.AccountClient != nil,
 		security: config.PasskeyClient != nil,
+		privacy:  config.PrivacyClient != nil,
 		aiKeys:   config.CredentialClient != nil,
 		aiAgents: config.CredentialClient != nil && config.AgentClient != nil,
 	}
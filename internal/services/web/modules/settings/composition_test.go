@@ -35,7 +35,7 @@ func TestTestSettingsAvailabilityKeepsPartialGRPCSurfaceCoverageExplicit(t *test
 	t.Parallel()
 
 	availability := testSettingsAvailability(
-		settingsgateway.NewAccountGateway(&socialClientStub{}, nil, nil),
+		settingsgateway.NewAccountGateway(&socialClientStub{}, nil, nil, nil),
 		settingsgateway.NewAIGateway(&credentialClientStub{}, nil),
 	)
 	if !availability.profile {
@@ -14,6 +14,7 @@ type Dependencies struct {
 	SocialClient     settingsgateway.SocialClient
 	AccountClient    settingsgateway.AccountClient
 	PasskeyClient    settingsgateway.PasskeyClient
+	PrivacyClient    settingsgateway.PrivacyClient
 	CredentialClient settingsgateway.CredentialClient
 	AgentClient      settingsgateway.AgentClient
 }
@@ -26,6 +27,7 @@ func BindAuthDependency(deps *Dependencies, conn *grpc.ClientConn) {
 	}
 	deps.AccountClient = authv1.NewAccountServiceClient(conn)
 	deps.PasskeyClient = authv1.NewAuthServiceClient(conn)
+	deps.PrivacyClient = authv1.NewAccountServiceClient(conn)
 }
 
 // BindSocialDependency wires social-backed clients into the settings
@@ -17,6 +17,8 @@ type fakeGateway struct {
 	credentials      []settingsapp.SettingsAICredentialOption
 	models           []settingsapp.SettingsAIModelOption
 	agents           []settingsapp.SettingsAIAgent
+	exports          []settingsapp.SettingsAccountExport
+	archive          settingsapp.AccountExportArchive
 	loadProfileErr   error
 	loadLocaleErr    error
 	listAIKeysErr    error
@@ -28,6 +30,12 @@ type fakeGateway struct {
 	createAIAgentErr error
 	deleteAIAgentErr error
 	revokeAIKeyErr   error
+	requestExportErr error
+	archiveErr       error
+	deleteAccountErr error
+	sessions         []settingsapp.SettingsWebSession
+	securityErr      error
+	recoveryCode     string
 
 	lastSavedProfile         settingsapp.SettingsProfile
 	lastSavedLocale          string
@@ -39,6 +47,14 @@ type fakeGateway struct {
 	lastRequestedUserID      string
 	lastPasskeySessionID     string
 	lastPasskeyCredential    json.RawMessage
+	lastRequestedExportID    string
+	lastDeleteConfirmation   string
+	exportRequests           int
+	lastPasskeyLabel         string
+	lastPasskeyCredentialID  string
+	lastDeletedPasskeyID     string
+	lastRevokedSessionID     string
+	lastKeptSessionID        string
 }
 
 // newPopulatedFakeGateway returns a fakeGateway pre-loaded with rich canned
@@ -145,6 +161,63 @@ func (f *fakeGateway) FinishPasskeyRegistration(_ context.Context, sessionID str
 	return nil
 }
 
+func (f *fakeGateway) RenamePasskey(_ context.Context, userID string, credentialID string, label string) error {
+	f.lastRequestedUserID = userID
+	f.lastPasskeyCredentialID = credentialID
+	f.lastPasskeyLabel = label
+	return f.securityErr
+}
+
+func (f *fakeGateway) DeletePasskey(_ context.Context, userID string, credentialID string) error {
+	f.lastRequestedUserID = userID
+	f.lastDeletedPasskeyID = credentialID
+	return f.securityErr
+}
+
+func (f *fakeGateway) ListWebSessions(_ context.Context, userID string) ([]settingsapp.SettingsWebSession, error) {
+	f.lastRequestedUserID = userID
+	if f.securityErr != nil {
+		return nil, f.securityErr
+	}
+	return f.sessions, nil
+}
+
+func (f *fakeGateway) RevokeWebSession(_ context.Context, userID string, sessionID string) error {
+	f.lastRequestedUserID = userID
+	f.lastRevokedSessionID = sessionID
+	return f.securityErr
+}
+
+func (f *fakeGateway) RevokeOtherWebSessions(_ context.Context, userID string, keepSessionID string) (int, error) {
+	f.lastRequestedUserID = userID
+	f.lastKeptSessionID = keepSessionID
+	if f.securityErr != nil {
+		return 0, f.securityErr
+	}
+	return len(f.sessions), nil
+}
+
+func (f *fakeGateway) BeginRecoveryCodeRotation(_ context.Context, userID string) (settingsapp.PasskeyChallenge, error) {
+	f.lastRequestedUserID = userID
+	if f.securityErr != nil {
+		return settingsapp.PasskeyChallenge{}, f.securityErr
+	}
+	return settingsapp.PasskeyChallenge{
+		SessionID: "reauth-session-1",
+		PublicKey: json.RawMessage(`{"publicKey":{}}`),
+	}, nil
+}
+
+func (f *fakeGateway) FinishRecoveryCodeRotation(_ context.Context, userID string, sessionID string, credential json.RawMessage) (string, error) {
+	f.lastRequestedUserID = userID
+	f.lastPasskeySessionID = sessionID
+	f.lastPasskeyCredential = credential
+	if f.securityErr != nil {
+		return "", f.securityErr
+	}
+	return f.recoveryCode, nil
+}
+
 func (f *fakeGateway) ListAIKeys(_ context.Context, userID string) ([]settingsapp.SettingsAIKey, error) {
 	f.lastRequestedUserID = userID
 	if f.listAIKeysErr != nil {
@@ -213,3 +286,32 @@ func (f *fakeGateway) RevokeAIKey(_ context.Context, userID string, credentialID
 	f.lastRevokedCredentialID = credentialID
 	return f.revokeAIKeyErr
 }
+
+func (f *fakeGateway) ListAccountExports(_ context.Context, userID string) ([]settingsapp.SettingsAccountExport, error) {
+	f.lastRequestedUserID = userID
+	if f.exports == nil {
+		return []settingsapp.SettingsAccountExport{}, nil
+	}
+	return f.exports, nil
+}
+
+func (f *fakeGateway) RequestAccountExport(_ context.Context, userID string) error {
+	f.lastRequestedUserID = userID
+	f.exportRequests++
+	return f.requestExportErr
+}
+
+func (f *fakeGateway) GetAccountExportArchive(_ context.Context, userID string, exportID string) (settingsapp.AccountExportArchive, error) {
+	f.lastRequestedUserID = userID
+	f.lastRequestedExportID = exportID
+	if f.archiveErr != nil {
+		return settingsapp.AccountExportArchive{}, f.archiveErr
+	}
+	return f.archive, nil
+}
+
+func (f *fakeGateway) DeleteAccount(_ context.Context, userID string, confirmUsername string) error {
+	f.lastRequestedUserID = userID
+	f.lastDeleteConfirmation = confirmUsername
+	return f.deleteAccountErr
+}
@@ -21,11 +21,27 @@ type AccountClient interface {
 	UpdateProfile(context.Context, *authv1.UpdateProfileRequest, ...grpc.CallOption) (*authv1.UpdateProfileResponse, error)
 }
 
-// PasskeyClient exposes authenticated passkey settings operations.
+// PasskeyClient exposes authenticated passkey and web session settings
+// operations.
 type PasskeyClient interface {
 	ListPasskeys(context.Context, *authv1.ListPasskeysRequest, ...grpc.CallOption) (*authv1.ListPasskeysResponse, error)
 	BeginPasskeyRegistration(context.Context, *authv1.BeginPasskeyRegistrationRequest, ...grpc.CallOption) (*authv1.BeginPasskeyRegistrationResponse, error)
 	FinishPasskeyRegistration(context.Context, *authv1.FinishPasskeyRegistrationRequest, ...grpc.CallOption) (*authv1.FinishPasskeyRegistrationResponse, error)
+	RenamePasskey(context.Context, *authv1.RenamePasskeyRequest, ...grpc.CallOption) (*authv1.RenamePasskeyResponse, error)
+	DeletePasskey(context.Context, *authv1.DeletePasskeyRequest, ...grpc.CallOption) (*authv1.DeletePasskeyResponse, error)
+	ListWebSessions(context.Context, *authv1.ListWebSessionsRequest, ...grpc.CallOption) (*authv1.ListWebSessionsResponse, error)
+	RevokeUserWebSession(context.Context, *authv1.RevokeUserWebSessionRequest, ...grpc.CallOption) (*authv1.RevokeUserWebSessionResponse, error)
+	RevokeOtherWebSessions(context.Context, *authv1.RevokeOtherWebSessionsRequest, ...grpc.CallOption) (*authv1.RevokeOtherWebSessionsResponse, error)
+	BeginRecoveryCodeRotation(context.Context, *authv1.BeginRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.BeginRecoveryCodeRotationResponse, error)
+	FinishRecoveryCodeRotation(context.Context, *authv1.FinishRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.FinishRecoveryCodeRotationResponse, error)
+}
+
+// PrivacyClient exposes account export and deletion operations.
+type PrivacyClient interface {
+	RequestAccountExport(context.Context, *authv1.RequestAccountExportRequest, ...grpc.CallOption) (*authv1.RequestAccountExportResponse, error)
+	ListAccountExports(context.Context, *authv1.ListAccountExportsRequest, ...grpc.CallOption) (*authv1.ListAccountExportsResponse, error)
+	GetAccountExportArchive(context.Context, *authv1.GetAccountExportArchiveRequest, ...grpc.CallOption) (*authv1.GetAccountExportArchiveResponse, error)
+	DeleteAccount(context.Context, *authv1.DeleteAccountRequest, ...grpc.CallOption) (*authv1.DeleteAccountResponse, error)
 }
 
 // CredentialClient exposes AI credential listing and mutation operations.
@@ -48,16 +64,18 @@ type GRPCGateway struct {
 	SocialClient     SocialClient
 	AccountClient    AccountClient
 	PasskeyClient    PasskeyClient
+	PrivacyClient    PrivacyClient
 	CredentialClient CredentialClient
 	AgentClient      AgentClient
 }
 
 // NewAccountGateway builds the production account-owned settings gateway.
-func NewAccountGateway(socialClient SocialClient, accountClient AccountClient, passkeyClient PasskeyClient) GRPCGateway {
+func NewAccountGateway(socialClient SocialClient, accountClient AccountClient, passkeyClient PasskeyClient, privacyClient PrivacyClient) GRPCGateway {
 	return GRPCGateway{
 		SocialClient:  socialClient,
 		AccountClient: accountClient,
 		PasskeyClient: passkeyClient,
+		PrivacyClient: privacyClient,
 	}
 }
 
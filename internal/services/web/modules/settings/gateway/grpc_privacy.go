@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	settingsapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/settings/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAccountExports returns export rows for the privacy page, newest first.
+func (g GRPCGateway) ListAccountExports(ctx context.Context, userID string) ([]settingsapp.SettingsAccountExport, error) {
+	if g.PrivacyClient == nil {
+		return nil, apperrors.EK(apperrors.KindUnavailable, "error.web.message.account_service_client_is_not_configured", "account service client is not configured")
+	}
+	resp, err := g.PrivacyClient.ListAccountExports(ctx, &authv1.ListAccountExportsRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]settingsapp.SettingsAccountExport, 0, len(resp.GetExports()))
+	for _, export := range resp.GetExports() {
+		if export == nil {
+			continue
+		}
+		rows = append(rows, settingsapp.SettingsAccountExport{
+			ID:             strings.TrimSpace(export.GetId()),
+			Status:         accountExportStatusLabel(export.GetStatus()),
+			CreatedAt:      formatProtoTimestamp(export.GetCreatedAt()),
+			ExpiresAt:      formatProtoTimestamp(export.GetExpiresAt()),
+			SizeBytes:      export.GetArchiveSizeBytes(),
+			FailureMessage: strings.TrimSpace(export.GetLastError()),
+		})
+	}
+	return rows, nil
+}
+
+// RequestAccountExport queues one account export job.
+func (g GRPCGateway) RequestAccountExport(ctx context.Context, userID string) error {
+	if g.PrivacyClient == nil {
+		return apperrors.EK(apperrors.KindUnavailable, "error.web.message.account_service_client_is_not_configured", "account service client is not configured")
+	}
+	_, err := g.PrivacyClient.RequestAccountExport(ctx, &authv1.RequestAccountExportRequest{UserId: userID})
+	if status.Code(err) == codes.FailedPrecondition {
+		return apperrors.EK(apperrors.KindConflict, "web.settings.privacy.error_export_in_progress", "account export is already in progress")
+	}
+	return err
+}
+
+// GetAccountExportArchive downloads one ready export archive.
+func (g GRPCGateway) GetAccountExportArchive(ctx context.Context, userID string, exportID string) (settingsapp.AccountExportArchive, error) {
+	if g.PrivacyClient == nil {
+		return settingsapp.AccountExportArchive{}, apperrors.EK(apperrors.KindUnavailable, "error.web.message.account_service_client_is_not_configured", "account service client is not configured")
+	}
+	resp, err := g.PrivacyClient.GetAccountExportArchive(ctx, &authv1.GetAccountExportArchiveRequest{UserId: userID, ExportId: exportID})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.FailedPrecondition:
+			return settingsapp.AccountExportArchive{}, apperrors.E(apperrors.KindNotFound, "account export not found")
+		}
+		return settingsapp.AccountExportArchive{}, err
+	}
+	return settingsapp.AccountExportArchive{
+		Filename: resp.GetFilename(),
+		Content:  resp.GetArchive(),
+	}, nil
+}
+
+// DeleteAccount closes the account after the user repeats their username.
+func (g GRPCGateway) DeleteAccount(ctx context.Context, userID string, confirmUsername string) error {
+	if g.PrivacyClient == nil {
+		return apperrors.EK(apperrors.KindUnavailable, "error.web.message.account_service_client_is_not_configured", "account service client is not configured")
+	}
+	_, err := g.PrivacyClient.DeleteAccount(ctx, &authv1.DeleteAccountRequest{UserId: userID, ConfirmUsername: confirmUsername})
+	if status.Code(err) == codes.InvalidArgument {
+		return apperrors.EK(apperrors.KindInvalidInput, "web.settings.privacy.error_confirmation_mismatch", "username confirmation does not match")
+	}
+	return err
+}
+
+// accountExportStatusLabel maps export lifecycle enums to template labels.
+func accountExportStatusLabel(value authv1.AccountExportStatus) string {
+	switch value {
+	case authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_READY:
+		return settingsapp.AccountExportStatusReady
+	case authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_FAILED:
+		return settingsapp.AccountExportStatusFailed
+	default:
+		return settingsapp.AccountExportStatusPending
+	}
+}
@@ -10,6 +10,8 @@ import (
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	settingsapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/settings/app"
 	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -38,9 +40,11 @@ func (g GRPCGateway) ListPasskeys(ctx context.Context, userID string) ([]setting
 			continue
 		}
 		rows = append(rows, settingsapp.SettingsPasskey{
-			Number:     idx + 1,
-			CreatedAt:  formatProtoTimestamp(passkey.GetCreatedAt()),
-			LastUsedAt: formatProtoTimestamp(passkey.GetLastUsedAt()),
+			CredentialID: strings.TrimSpace(passkey.GetCredentialId()),
+			Number:       idx + 1,
+			Label:        strings.TrimSpace(passkey.GetLabel()),
+			CreatedAt:    formatProtoTimestamp(passkey.GetCreatedAt()),
+			LastUsedAt:   formatProtoTimestamp(passkey.GetLastUsedAt()),
 		})
 	}
 	return rows, nil
@@ -73,6 +77,122 @@ func (g GRPCGateway) FinishPasskeyRegistration(ctx context.Context, sessionID st
 	return err
 }
 
+// RenamePasskey sets the display label of one passkey.
+func (g GRPCGateway) RenamePasskey(ctx context.Context, userID string, credentialID string, label string) error {
+	if g.PasskeyClient == nil {
+		return apperrors.EK(apperrors.KindUnavailable, "error.web.message.auth_service_is_not_configured", "auth service client is not configured")
+	}
+	_, err := g.PasskeyClient.RenamePasskey(ctx, &authv1.RenamePasskeyRequest{UserId: userID, CredentialId: credentialID, Label: label})
+	switch status.Code(err) {
+	case codes.NotFound:
+		return apperrors.EK(apperrors.KindNotFound, "web.settings.security.error_passkey_not_found", "passkey not found")
+	case codes.InvalidArgument:
+		return apperrors.EK(apperrors.KindInvalidInput, "web.settings.security.error_passkey_label_too_long", "passkey label is invalid")
+	}
+	return err
+}
+
+// DeletePasskey removes one passkey; auth refuses to delete the last one.
+func (g GRPCGateway) DeletePasskey(ctx context.Context, userID string, credentialID string) error {
+	if g.PasskeyClient == nil {
+		return apperrors.EK(apperrors.KindUnavailable, "error.web.message.auth_service_is_not_configured", "auth service client is not configured")
+	}
+	_, err := g.PasskeyClient.DeletePasskey(ctx, &authv1.DeletePasskeyRequest{UserId: userID, CredentialId: credentialID})
+	switch status.Code(err) {
+	case codes.NotFound:
+		return apperrors.EK(apperrors.KindNotFound, "web.settings.security.error_passkey_not_found", "passkey not found")
+	case codes.FailedPrecondition:
+		return apperrors.EK(apperrors.KindConflict, "web.settings.security.error_last_passkey", "the last passkey cannot be deleted")
+	}
+	return err
+}
+
+// ListWebSessions returns the user's active web sessions.
+func (g GRPCGateway) ListWebSessions(ctx context.Context, userID string) ([]settingsapp.SettingsWebSession, error) {
+	if g.PasskeyClient == nil {
+		return nil, apperrors.EK(apperrors.KindUnavailable, "error.web.message.auth_service_is_not_configured", "auth service client is not configured")
+	}
+	resp, err := g.PasskeyClient.ListWebSessions(ctx, &authv1.ListWebSessionsRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]settingsapp.SettingsWebSession, 0, len(resp.GetSessions()))
+	for _, session := range resp.GetSessions() {
+		if session == nil {
+			continue
+		}
+		rows = append(rows, settingsapp.SettingsWebSession{
+			ID:         strings.TrimSpace(session.GetId()),
+			UserAgent:  strings.TrimSpace(session.GetUserAgent()),
+			ClientIP:   strings.TrimSpace(session.GetClientIp()),
+			CreatedAt:  formatProtoTimestamp(session.GetCreatedAt()),
+			LastSeenAt: formatProtoTimestamp(session.GetLastSeenAt()),
+		})
+	}
+	return rows, nil
+}
+
+// RevokeWebSession signs one of the user's browsers out.
+func (g GRPCGateway) RevokeWebSession(ctx context.Context, userID string, sessionID string) error {
+	if g.PasskeyClient == nil {
+		return apperrors.EK(apperrors.KindUnavailable, "error.web.message.auth_service_is_not_configured", "auth service client is not configured")
+	}
+	_, err := g.PasskeyClient.RevokeUserWebSession(ctx, &authv1.RevokeUserWebSessionRequest{UserId: userID, SessionId: sessionID})
+	if status.Code(err) == codes.NotFound {
+		return apperrors.EK(apperrors.KindNotFound, "web.settings.security.error_session_not_found", "web session not found")
+	}
+	return err
+}
+
+// RevokeOtherWebSessions signs every browser except the kept one out.
+func (g GRPCGateway) RevokeOtherWebSessions(ctx context.Context, userID string, keepSessionID string) (int, error) {
+	if g.PasskeyClient == nil {
+		return 0, apperrors.EK(apperrors.KindUnavailable, "error.web.message.auth_service_is_not_configured", "auth service client is not configured")
+	}
+	resp, err := g.PasskeyClient.RevokeOtherWebSessions(ctx, &authv1.RevokeOtherWebSessionsRequest{UserId: userID, KeepSessionId: keepSessionID})
+	if err != nil {
+		return 0, err
+	}
+	return int(resp.GetRevokedCount()), nil
+}
+
+// BeginRecoveryCodeRotation starts passkey re-authentication for recovery
+// code rotation.
+func (g GRPCGateway) BeginRecoveryCodeRotation(ctx context.Context, userID string) (settingsapp.PasskeyChallenge, error) {
+	if g.PasskeyClient == nil {
+		return settingsapp.PasskeyChallenge{}, apperrors.EK(apperrors.KindUnavailable, "error.web.message.auth_service_is_not_configured", "auth service client is not configured")
+	}
+	resp, err := g.PasskeyClient.BeginRecoveryCodeRotation(ctx, &authv1.BeginRecoveryCodeRotationRequest{UserId: userID})
+	if err != nil {
+		return settingsapp.PasskeyChallenge{}, err
+	}
+	return settingsapp.PasskeyChallenge{
+		SessionID: strings.TrimSpace(resp.GetSessionId()),
+		PublicKey: json.RawMessage(resp.GetCredentialRequestOptionsJson()),
+	}, nil
+}
+
+// FinishRecoveryCodeRotation completes re-authentication and returns the new
+// recovery code.
+func (g GRPCGateway) FinishRecoveryCodeRotation(ctx context.Context, userID string, sessionID string, credential json.RawMessage) (string, error) {
+	if g.PasskeyClient == nil {
+		return "", apperrors.EK(apperrors.KindUnavailable, "error.web.message.auth_service_is_not_configured", "auth service client is not configured")
+	}
+	resp, err := g.PasskeyClient.FinishRecoveryCodeRotation(ctx, &authv1.FinishRecoveryCodeRotationRequest{
+		UserId:                 userID,
+		SessionId:              sessionID,
+		CredentialResponseJson: credential,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied, codes.NotFound:
+			return "", apperrors.EK(apperrors.KindForbidden, "web.settings.security.error_reauthentication_failed", "passkey re-authentication failed")
+		}
+		return "", err
+	}
+	return resp.GetRecoveryCode(), nil
+}
+
 // passkeySortKey keeps security-page ordering policy in one place for stable gateway tests.
 func passkeySortKey(value *authv1.PasskeyCredentialSummary) (lastUsed time.Time, created time.Time) {
 	if value == nil {
@@ -52,6 +52,52 @@ func (passkeyStub) BeginPasskeyRegistration(context.Context, *authv1.BeginPasske
 func (passkeyStub) FinishPasskeyRegistration(context.Context, *authv1.FinishPasskeyRegistrationRequest, ...grpc.CallOption) (*authv1.FinishPasskeyRegistrationResponse, error) {
 	return &authv1.FinishPasskeyRegistrationResponse{}, nil
 }
+func (passkeyStub) RenamePasskey(context.Context, *authv1.RenamePasskeyRequest, ...grpc.CallOption) (*authv1.RenamePasskeyResponse, error) {
+	return &authv1.RenamePasskeyResponse{}, nil
+}
+func (passkeyStub) DeletePasskey(context.Context, *authv1.DeletePasskeyRequest, ...grpc.CallOption) (*authv1.DeletePasskeyResponse, error) {
+	return &authv1.DeletePasskeyResponse{}, nil
+}
+func (passkeyStub) ListWebSessions(context.Context, *authv1.ListWebSessionsRequest, ...grpc.CallOption) (*authv1.ListWebSessionsResponse, error) {
+	return &authv1.ListWebSessionsResponse{}, nil
+}
+func (passkeyStub) RevokeUserWebSession(context.Context, *authv1.RevokeUserWebSessionRequest, ...grpc.CallOption) (*authv1.RevokeUserWebSessionResponse, error) {
+	return &authv1.RevokeUserWebSessionResponse{}, nil
+}
+func (passkeyStub) RevokeOtherWebSessions(context.Context, *authv1.RevokeOtherWebSessionsRequest, ...grpc.CallOption) (*authv1.RevokeOtherWebSessionsResponse, error) {
+	return &authv1.RevokeOtherWebSessionsResponse{}, nil
+}
+func (passkeyStub) BeginRecoveryCodeRotation(context.Context, *authv1.BeginRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.BeginRecoveryCodeRotationResponse, error) {
+	return &authv1.BeginRecoveryCodeRotationResponse{}, nil
+}
+func (passkeyStub) FinishRecoveryCodeRotation(context.Context, *authv1.FinishRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.FinishRecoveryCodeRotationResponse, error) {
+	return &authv1.FinishRecoveryCodeRotationResponse{}, nil
+}
+
+type privacyStub struct {
+	exports    []*authv1.AccountExport
+	requestErr error
+	archiveErr error
+	deleteErr  error
+	lastDelete *authv1.DeleteAccountRequest
+}
+
+func (p *privacyStub) RequestAccountExport(context.Context, *authv1.RequestAccountExportRequest, ...grpc.CallOption) (*authv1.RequestAccountExportResponse, error) {
+	return &authv1.RequestAccountExportResponse{}, p.requestErr
+}
+func (p *privacyStub) ListAccountExports(context.Context, *authv1.ListAccountExportsRequest, ...grpc.CallOption) (*authv1.ListAccountExportsResponse, error) {
+	return &authv1.ListAccountExportsResponse{Exports: p.exports}, nil
+}
+func (p *privacyStub) GetAccountExportArchive(_ context.Context, req *authv1.GetAccountExportArchiveRequest, _ ...grpc.CallOption) (*authv1.GetAccountExportArchiveResponse, error) {
+	if p.archiveErr != nil {
+		return nil, p.archiveErr
+	}
+	return &authv1.GetAccountExportArchiveResponse{Filename: req.GetExportId() + ".zip", Archive: []byte("zip")}, nil
+}
+func (p *privacyStub) DeleteAccount(_ context.Context, req *authv1.DeleteAccountRequest, _ ...grpc.CallOption) (*authv1.DeleteAccountResponse, error) {
+	p.lastDelete = req
+	return &authv1.DeleteAccountResponse{}, p.deleteErr
+}
 
 type credentialStub struct {
 	listResp      *aiv1.ListCredentialsResponse
@@ -476,7 +522,7 @@ func TestGRPCGatewayPasskeyRegistrationFlowsAndConstructors(t *testing.T) {
 	t.Parallel()
 
 	passkeys := passkeyStub{}
-	accountGateway := NewAccountGateway(socialStub{}, &accountStub{}, passkeys)
+	accountGateway := NewAccountGateway(socialStub{}, &accountStub{}, passkeys, &privacyStub{})
 	aiGateway := NewAIGateway(&credentialStub{}, &agentStub{})
 	if accountGateway.PasskeyClient == nil || aiGateway.CredentialClient == nil || aiGateway.AgentClient == nil {
 		t.Fatal("constructors should wire provided clients")
@@ -496,6 +542,7 @@ func TestGRPCGatewayPasskeyRegistrationFlowsAndConstructors(t *testing.T) {
 }
 
 type passkeyListStub struct {
+	passkeyStub
 	resp *authv1.ListPasskeysResponse
 }
 
@@ -511,6 +558,66 @@ func (passkeyListStub) FinishPasskeyRegistration(context.Context, *authv1.Finish
 	return &authv1.FinishPasskeyRegistrationResponse{}, nil
 }
 
+func TestGRPCGatewaySecurityCenterMapsSessionsAndErrors(t *testing.T) {
+	t.Parallel()
+
+	seen := time.Date(2026, time.March, 9, 12, 0, 0, 0, time.UTC)
+	stub := &securityPasskeyStub{
+		sessions:  []*authv1.WebSession{{Id: " ws-1 ", UserAgent: "Firefox", ClientIp: "10.0.0.1", CreatedAt: timestamppb.New(seen), LastSeenAt: timestamppb.New(seen)}},
+		deleteErr: status.Error(codes.FailedPrecondition, "last passkey"),
+		finishErr: status.Error(codes.PermissionDenied, "denied"),
+	}
+	gateway := GRPCGateway{PasskeyClient: stub}
+
+	sessions, err := gateway.ListWebSessions(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("ListWebSessions() error = %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != "ws-1" || sessions[0].LastSeenAt != "2026-03-09 12:00 UTC" {
+		t.Fatalf("sessions = %+v", sessions)
+	}
+
+	if err := gateway.DeletePasskey(context.Background(), "user-1", "cred-1"); apperrors.HTTPStatus(err) != http.StatusConflict {
+		t.Fatalf("DeletePasskey() status = %d, want %d", apperrors.HTTPStatus(err), http.StatusConflict)
+	}
+	if _, err := gateway.FinishRecoveryCodeRotation(context.Background(), "user-1", "reauth-1", []byte(`{}`)); apperrors.HTTPStatus(err) != http.StatusForbidden {
+		t.Fatalf("FinishRecoveryCodeRotation() status = %d, want %d", apperrors.HTTPStatus(err), http.StatusForbidden)
+	}
+
+	revoked, err := gateway.RevokeOtherWebSessions(context.Background(), "user-1", "ws-1")
+	if err != nil {
+		t.Fatalf("RevokeOtherWebSessions() error = %v", err)
+	}
+	if revoked != 3 || stub.lastKeep != "ws-1" {
+		t.Fatalf("revoked = %d keep = %q", revoked, stub.lastKeep)
+	}
+}
+
+type securityPasskeyStub struct {
+	passkeyStub
+	sessions  []*authv1.WebSession
+	deleteErr error
+	finishErr error
+	lastKeep  string
+}
+
+func (s *securityPasskeyStub) ListWebSessions(context.Context, *authv1.ListWebSessionsRequest, ...grpc.CallOption) (*authv1.ListWebSessionsResponse, error) {
+	return &authv1.ListWebSessionsResponse{Sessions: s.sessions}, nil
+}
+
+func (s *securityPasskeyStub) DeletePasskey(context.Context, *authv1.DeletePasskeyRequest, ...grpc.CallOption) (*authv1.DeletePasskeyResponse, error) {
+	return nil, s.deleteErr
+}
+
+func (s *securityPasskeyStub) RevokeOtherWebSessions(_ context.Context, req *authv1.RevokeOtherWebSessionsRequest, _ ...grpc.CallOption) (*authv1.RevokeOtherWebSessionsResponse, error) {
+	s.lastKeep = req.GetKeepSessionId()
+	return &authv1.RevokeOtherWebSessionsResponse{RevokedCount: 3}, nil
+}
+
+func (s *securityPasskeyStub) FinishRecoveryCodeRotation(context.Context, *authv1.FinishRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.FinishRecoveryCodeRotationResponse, error) {
+	return nil, s.finishErr
+}
+
 func TestGRPCGatewayMissingClientBehavior(t *testing.T) {
 	t.Parallel()
 
@@ -541,3 +648,56 @@ func TestGRPCGatewayMissingClientBehavior(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCGatewayPrivacyMapsExportsAndErrors(t *testing.T) {
+	t.Parallel()
+
+	created := timestamppb.New(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	privacy := &privacyStub{exports: []*authv1.AccountExport{
+		{Id: "export-1", Status: authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_READY, ArchiveSizeBytes: 42, CreatedAt: created},
+		nil,
+		{Id: "export-2", Status: authv1.AccountExportStatus_ACCOUNT_EXPORT_STATUS_FAILED, LastError: " boom "},
+	}}
+	gateway := GRPCGateway{PrivacyClient: privacy}
+	ctx := context.Background()
+
+	rows, err := gateway.ListAccountExports(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("ListAccountExports() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("len(rows) = %d, want 2", len(rows))
+	}
+	if rows[0].Status != settingsapp.AccountExportStatusReady || rows[0].SizeBytes != 42 || rows[0].CreatedAt != "2026-03-01 09:00 UTC" {
+		t.Fatalf("rows[0] = %+v", rows[0])
+	}
+	if rows[1].Status != settingsapp.AccountExportStatusFailed || rows[1].FailureMessage != "boom" || rows[1].ExpiresAt != "-" {
+		t.Fatalf("rows[1] = %+v", rows[1])
+	}
+
+	privacy.requestErr = status.Error(codes.FailedPrecondition, "in progress")
+	if err := gateway.RequestAccountExport(ctx, "user-1"); apperrors.HTTPStatus(err) != http.StatusConflict {
+		t.Fatalf("RequestAccountExport() status = %d, want %d", apperrors.HTTPStatus(err), http.StatusConflict)
+	}
+
+	archive, err := gateway.GetAccountExportArchive(ctx, "user-1", "export-1")
+	if err != nil || archive.Filename != "export-1.zip" || string(archive.Content) != "zip" {
+		t.Fatalf("GetAccountExportArchive() = %+v, %v", archive, err)
+	}
+	privacy.archiveErr = status.Error(codes.FailedPrecondition, "not ready")
+	if _, err := gateway.GetAccountExportArchive(ctx, "user-1", "export-2"); apperrors.HTTPStatus(err) != http.StatusNotFound {
+		t.Fatalf("GetAccountExportArchive(not ready) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusNotFound)
+	}
+
+	privacy.deleteErr = status.Error(codes.InvalidArgument, "mismatch")
+	if err := gateway.DeleteAccount(ctx, "user-1", "bob"); apperrors.HTTPStatus(err) != http.StatusBadRequest {
+		t.Fatalf("DeleteAccount(mismatch) status = %d, want %d", apperrors.HTTPStatus(err), http.StatusBadRequest)
+	}
+	if privacy.lastDelete.GetConfirmUsername() != "bob" {
+		t.Fatalf("delete request = %+v", privacy.lastDelete)
+	}
+
+	if _, err := (GRPCGateway{}).ListAccountExports(ctx, "user-1"); apperrors.HTTPStatus(err) != http.StatusServiceUnavailable {
+		t.Fatalf("nil client status = %d, want %d", apperrors.HTTPStatus(err), http.StatusServiceUnavailable)
+	}
+}
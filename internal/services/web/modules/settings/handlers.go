@@ -16,13 +16,14 @@ type settingsSurfaceAvailability struct {
 	profile  bool
 	locale   bool
 	security bool
+	privacy  bool
 	aiKeys   bool
 	aiAgents bool
 }
 
 // anyAvailable reports whether at least one settings surface is available.
 func (a settingsSurfaceAvailability) anyAvailable() bool {
-	return a.profile || a.locale || a.security || a.aiKeys || a.aiAgents
+	return a.profile || a.locale || a.security || a.privacy || a.aiKeys || a.aiAgents
 }
 
 // defaultPath returns the first route that should own `/app/settings`.
@@ -34,6 +35,8 @@ func (a settingsSurfaceAvailability) defaultPath() string {
 		return routepath.AppSettingsLocale
 	case a.security:
 		return routepath.AppSettingsSecurity
+	case a.privacy:
+		return routepath.AppSettingsPrivacy
 	case a.aiKeys:
 		return routepath.AppSettingsAIKeys
 	case a.aiAgents:
@@ -0,0 +1,100 @@
+package settings
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	flashnotice "github.com/louisbranch/fracturing.space/internal/services/web/platform/flash"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
+	webi18n "github.com/louisbranch/fracturing.space/internal/services/web/platform/i18n"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/sessioncookie"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
+)
+
+// handlePrivacyGet handles this route in the module transport layer.
+func (h handlers) handlePrivacyGet(w http.ResponseWriter, r *http.Request) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	h.renderPrivacyPage(w, r, ctx, userID, http.StatusOK, SettingsPrivacyForm{})
+}
+
+// handlePrivacyExportCreate queues an account export and returns to the page.
+func (h handlers) handlePrivacyExportCreate(w http.ResponseWriter, r *http.Request) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := h.account.RequestAccountExport(ctx, userID); err != nil {
+		statusCode := apperrors.HTTPStatus(err)
+		if statusCode == http.StatusBadRequest || statusCode == http.StatusConflict {
+			h.writeFlashNotice(w, r, flashnotice.Notice{Kind: flashnotice.KindError, Key: apperrors.LocalizationKey(err)})
+			httpx.WriteRedirect(w, r, routepath.AppSettingsPrivacy)
+			return
+		}
+		h.WriteError(w, r, err)
+		return
+	}
+	h.writeFlashNotice(w, r, flashnotice.NoticeSuccess("web.settings.privacy.notice_export_requested"))
+	httpx.WriteRedirect(w, r, routepath.AppSettingsPrivacy)
+}
+
+// handlePrivacyExportDownload streams one ready export archive.
+func (h handlers) handlePrivacyExportDownload(w http.ResponseWriter, r *http.Request, exportID string) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	archive, err := h.account.GetAccountExportArchive(ctx, userID, exportID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": archive.Filename}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(archive.Content)))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(archive.Content)
+}
+
+// handlePrivacyDelete closes the account and signs the browser out.
+func (h handlers) handlePrivacyDelete(w http.ResponseWriter, r *http.Request) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := httpx.ParseFormInvalidInput(r, "error.web.message.failed_to_parse_account_delete_form", "failed to parse account delete form"); err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	confirmUsername := strings.TrimSpace(r.PostForm.Get("confirm_username"))
+	if err := h.account.DeleteAccount(ctx, userID, confirmUsername); err != nil {
+		statusCode := apperrors.HTTPStatus(err)
+		if statusCode == http.StatusBadRequest || statusCode == http.StatusConflict {
+			loc, lang := h.PageLocalizer(w, r)
+			h.renderPrivacyPage(w, r, ctx, userID, statusCode, SettingsPrivacyForm{
+				ErrorMessage: webi18n.LocalizeError(loc, err, lang),
+			})
+			return
+		}
+		h.WriteError(w, r, err)
+		return
+	}
+	sessioncookie.ClearWithPolicy(w, r, h.flashMeta)
+	h.writeFlashNotice(w, r, flashnotice.NoticeSuccess("web.settings.privacy.notice_deleted"))
+	httpx.WriteRedirect(w, r, routepath.Root)
+}
+
+// renderPrivacyPage centralizes this web behavior in one helper seam.
+func (h handlers) renderPrivacyPage(w http.ResponseWriter, r *http.Request, ctx context.Context, userID string, statusCode int, form SettingsPrivacyForm) {
+	loc, _ := h.PageLocalizer(w, r)
+	exports, err := h.account.ListAccountExports(ctx, userID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	h.writeSettingsPage(
+		w,
+		r,
+		loc,
+		statusCode,
+		routepath.AppSettingsPrivacy,
+		webtemplates.T(loc, "web.settings.page_privacy_title"),
+		SettingsPrivacyFragment(form, mapAccountExportTemplateRows(exports, loc), loc),
+	)
+}
@@ -3,9 +3,13 @@ package settings
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"strings"
 
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
 	flashnotice "github.com/louisbranch/fracturing.space/internal/services/web/platform/flash"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/httpx"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/sessioncookie"
 	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
 )
@@ -54,6 +58,111 @@ func (h handlers) handleSecurityPasskeyFinish(w http.ResponseWriter, r *http.Req
 	_ = httpx.WriteJSON(w, http.StatusOK, map[string]any{"redirect_url": routepath.AppSettingsSecurity})
 }
 
+// handleSecurityPasskeyRename relabels one passkey and returns to the page.
+func (h handlers) handleSecurityPasskeyRename(w http.ResponseWriter, r *http.Request, credentialID string) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := httpx.ParseFormInvalidInput(r, "error.web.message.failed_to_parse_passkey_rename_form", "failed to parse passkey rename form"); err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
+	label := strings.TrimSpace(r.PostForm.Get("label"))
+	if err := h.account.RenamePasskey(ctx, userID, credentialID, label); err != nil {
+		h.writeSecurityMutationError(w, r, err)
+		return
+	}
+	h.writeFlashNotice(w, r, flashnotice.NoticeSuccess("web.settings.security.notice_renamed"))
+	httpx.WriteRedirect(w, r, routepath.AppSettingsSecurity)
+}
+
+// handleSecurityPasskeyDelete removes one passkey; the last one is refused.
+func (h handlers) handleSecurityPasskeyDelete(w http.ResponseWriter, r *http.Request, credentialID string) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	if err := h.account.DeletePasskey(ctx, userID, credentialID); err != nil {
+		h.writeSecurityMutationError(w, r, err)
+		return
+	}
+	h.writeFlashNotice(w, r, flashnotice.NoticeSuccess("web.settings.security.notice_deleted"))
+	httpx.WriteRedirect(w, r, routepath.AppSettingsSecurity)
+}
+
+// handleSecuritySessionRevoke signs one other browser out.
+func (h handlers) handleSecuritySessionRevoke(w http.ResponseWriter, r *http.Request, sessionID string) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	currentSessionID, _ := sessioncookie.Read(r)
+	if err := h.account.RevokeWebSession(ctx, userID, currentSessionID, sessionID); err != nil {
+		h.writeSecurityMutationError(w, r, err)
+		return
+	}
+	h.writeFlashNotice(w, r, flashnotice.NoticeSuccess("web.settings.security.notice_session_revoked"))
+	httpx.WriteRedirect(w, r, routepath.AppSettingsSecurity)
+}
+
+// handleSecuritySessionsRevokeOthers signs every browser except this one out.
+func (h handlers) handleSecuritySessionsRevokeOthers(w http.ResponseWriter, r *http.Request) {
+	ctx, userID := h.RequestContextAndUserID(r)
+	currentSessionID, _ := sessioncookie.Read(r)
+	revoked, err := h.account.RevokeOtherWebSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		h.writeSecurityMutationError(w, r, err)
+		return
+	}
+	h.writeFlashNotice(w, r, flashnotice.NoticeSuccess("web.settings.security.notice_sessions_revoked", strconv.Itoa(revoked)))
+	httpx.WriteRedirect(w, r, routepath.AppSettingsSecurity)
+}
+
+// handleSecurityRecoveryStart begins passkey re-authentication for recovery
+// code rotation.
+func (h handlers) handleSecurityRecoveryStart(w http.ResponseWriter, r *http.Request) {
+	if !h.hasSameOriginProof(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	ctx, userID := h.RequestContextAndUserID(r)
+	start, err := h.account.BeginRecoveryCodeRotation(ctx, userID)
+	if err != nil {
+		h.writeJSONError(w, r, err)
+		return
+	}
+	_ = httpx.WriteJSON(w, http.StatusOK, map[string]any{
+		"session_id": start.SessionID,
+		"public_key": start.PublicKey,
+	})
+}
+
+// handleSecurityRecoveryFinish verifies re-authentication and returns the new
+// recovery code once; it is never stored in a flash cookie.
+func (h handlers) handleSecurityRecoveryFinish(w http.ResponseWriter, r *http.Request) {
+	if !h.hasSameOriginProof(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	input, err := parsePasskeyCredentialInput(r)
+	if err != nil {
+		h.writeJSONError(w, r, err)
+		return
+	}
+	ctx, userID := h.RequestContextAndUserID(r)
+	code, err := h.account.FinishRecoveryCodeRotation(ctx, userID, input.SessionID, input.Credential)
+	if err != nil {
+		h.writeJSONError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	_ = httpx.WriteJSON(w, http.StatusOK, map[string]any{"recovery_code": code})
+}
+
+// writeSecurityMutationError flashes user-correctable security failures back
+// onto the page and renders everything else as an error page.
+func (h handlers) writeSecurityMutationError(w http.ResponseWriter, r *http.Request, err error) {
+	statusCode := apperrors.HTTPStatus(err)
+	if statusCode == http.StatusBadRequest || statusCode == http.StatusConflict {
+		h.writeFlashNotice(w, r, flashnotice.Notice{Kind: flashnotice.KindError, Key: apperrors.LocalizationKey(err)})
+		httpx.WriteRedirect(w, r, routepath.AppSettingsSecurity)
+		return
+	}
+	h.WriteError(w, r, err)
+}
+
 // renderSecurityPage centralizes this web behavior in one helper seam.
 func (h handlers) renderSecurityPage(w http.ResponseWriter, r *http.Request, ctx context.Context, userID string, statusCode int) {
 	loc, _ := h.PageLocalizer(w, r)
@@ -62,6 +171,12 @@ func (h handlers) renderSecurityPage(w http.ResponseWriter, r *http.Request, ctx
 		h.WriteError(w, r, err)
 		return
 	}
+	currentSessionID, _ := sessioncookie.Read(r)
+	sessions, err := h.account.ListWebSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		h.WriteError(w, r, err)
+		return
+	}
 	h.writeSettingsPage(
 		w,
 		r,
@@ -69,7 +184,7 @@ func (h handlers) renderSecurityPage(w http.ResponseWriter, r *http.Request, ctx
 		statusCode,
 		routepath.AppSettingsSecurity,
 		webtemplates.T(loc, "web.settings.page_security_title"),
-		SettingsSecurityFragment(passkeys, loc),
+		SettingsSecurityFragment(passkeys, mapWebSessionTemplateRows(sessions), loc),
 	)
 }
 
@@ -56,6 +56,18 @@ func (h handlers) withAgentID(fn func(http.ResponseWriter, *http.Request, string
 	return httpx.WithRequiredRouteParam("agentID", h.WriteNotFound, fn)
 }
 
+// withExportID extracts the export ID path param and delegates to fn,
+// returning 404 when the param is missing.
+func (h handlers) withExportID(fn func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return httpx.WithRequiredRouteParam("exportID", h.WriteNotFound, fn)
+}
+
+// withSessionID extracts the web session ID path param and delegates to fn,
+// returning 404 when the param is missing.
+func (h handlers) withSessionID(fn func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return httpx.WithRequiredRouteParam("sessionID", h.WriteNotFound, fn)
+}
+
 // writeSettingsPage centralizes common settings page shell rendering.
 func (h handlers) writeSettingsPage(
 	w http.ResponseWriter,
@@ -242,6 +242,34 @@ func (f *passkeyClientStub) FinishPasskeyRegistration(_ context.Context, req *au
 	return &authv1.FinishPasskeyRegistrationResponse{}, nil
 }
 
+func (*passkeyClientStub) RenamePasskey(context.Context, *authv1.RenamePasskeyRequest, ...grpc.CallOption) (*authv1.RenamePasskeyResponse, error) {
+	return &authv1.RenamePasskeyResponse{}, nil
+}
+
+func (*passkeyClientStub) DeletePasskey(context.Context, *authv1.DeletePasskeyRequest, ...grpc.CallOption) (*authv1.DeletePasskeyResponse, error) {
+	return &authv1.DeletePasskeyResponse{}, nil
+}
+
+func (*passkeyClientStub) ListWebSessions(context.Context, *authv1.ListWebSessionsRequest, ...grpc.CallOption) (*authv1.ListWebSessionsResponse, error) {
+	return &authv1.ListWebSessionsResponse{}, nil
+}
+
+func (*passkeyClientStub) RevokeUserWebSession(context.Context, *authv1.RevokeUserWebSessionRequest, ...grpc.CallOption) (*authv1.RevokeUserWebSessionResponse, error) {
+	return &authv1.RevokeUserWebSessionResponse{}, nil
+}
+
+func (*passkeyClientStub) RevokeOtherWebSessions(context.Context, *authv1.RevokeOtherWebSessionsRequest, ...grpc.CallOption) (*authv1.RevokeOtherWebSessionsResponse, error) {
+	return &authv1.RevokeOtherWebSessionsResponse{}, nil
+}
+
+func (*passkeyClientStub) BeginRecoveryCodeRotation(context.Context, *authv1.BeginRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.BeginRecoveryCodeRotationResponse, error) {
+	return &authv1.BeginRecoveryCodeRotationResponse{}, nil
+}
+
+func (*passkeyClientStub) FinishRecoveryCodeRotation(context.Context, *authv1.FinishRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.FinishRecoveryCodeRotationResponse, error) {
+	return &authv1.FinishRecoveryCodeRotationResponse{}, nil
+}
+
 type credentialClientStub struct {
 	listResp      *aiv1.ListCredentialsResponse
 	listErr       error
@@ -16,6 +16,7 @@ import (
 	flashnotice "github.com/louisbranch/fracturing.space/internal/services/web/platform/flash"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/modulehandler"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/requestmeta"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/sessioncookie"
 	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -1199,6 +1200,326 @@ func settingsTestBase() modulehandler.Base {
 	return modulehandler.NewBase(func(*http.Request) string { return "user-1" }, nil, nil)
 }
 
+func TestMountPrivacyGetListsExportsWithDownloadLinks(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	gateway.exports = []settingsapp.SettingsAccountExport{
+		{ID: "export-1", Status: settingsapp.AccountExportStatusReady, CreatedAt: "2026-03-01 09:00 UTC", SizeBytes: 42},
+		{ID: "export-2", Status: settingsapp.AccountExportStatusPending},
+	}
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, routepath.AppSettingsPrivacy, nil)
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	if !strings.Contains(body, `id="settings-privacy"`) {
+		t.Fatalf("body missing privacy section: %q", body)
+	}
+	if got := strings.Count(body, "/download"); got != 1 {
+		t.Fatalf("download links = %d, want 1", got)
+	}
+	if !strings.Contains(body, routepath.AppSettingsPrivacyExportDownload("export-1")) {
+		t.Fatalf("body missing ready export download link")
+	}
+}
+
+func TestMountPrivacyExportPostRequestsAndRedirects(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, routepath.AppSettingsPrivacyExports, nil)
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if got := rr.Header().Get("Location"); got != routepath.AppSettingsPrivacy {
+		t.Fatalf("Location = %q, want %q", got, routepath.AppSettingsPrivacy)
+	}
+	if gateway.exportRequests != 1 || !responseHasCookieName(rr, flashnotice.CookieName) {
+		t.Fatalf("export requests = %d, flash cookie = %v", gateway.exportRequests, responseHasCookieName(rr, flashnotice.CookieName))
+	}
+}
+
+func TestMountPrivacyExportConflictFlashesAndRedirects(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	gateway.requestExportErr = apperrors.EK(apperrors.KindConflict, "web.settings.privacy.error_export_in_progress", "in progress")
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, routepath.AppSettingsPrivacyExports, nil)
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if !responseHasCookieName(rr, flashnotice.CookieName) {
+		t.Fatalf("response missing %q cookie", flashnotice.CookieName)
+	}
+}
+
+func TestMountPrivacyExportDownloadStreamsArchive(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	gateway.archive = settingsapp.AccountExportArchive{Filename: "export-1.zip", Content: []byte("zip")}
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, routepath.AppSettingsPrivacyExportDownload("export-1"), nil)
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if got := rr.Header().Get("Content-Type"); got != "application/zip" {
+		t.Fatalf("content-type = %q, want application/zip", got)
+	}
+	if got := rr.Header().Get("Content-Disposition"); got != `attachment; filename=export-1.zip` {
+		t.Fatalf("content-disposition = %q", got)
+	}
+	if rr.Body.String() != "zip" || gateway.lastRequestedExportID != "export-1" {
+		t.Fatalf("body = %q, export id = %q", rr.Body.String(), gateway.lastRequestedExportID)
+	}
+}
+
+func TestMountPrivacyExportDownloadMissingReturnsNotFound(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	gateway.archiveErr = apperrors.E(apperrors.KindNotFound, "account export not found")
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, routepath.AppSettingsPrivacyExportDownload("export-9"), nil)
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+}
+
+func TestMountPrivacyDeletePostClearsSessionAndRedirectsHome(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	form := url.Values{"confirm_username": {" rhea "}}
+	req := httptest.NewRequest(http.MethodPost, routepath.AppSettingsPrivacyDelete, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if got := rr.Header().Get("Location"); got != routepath.Root {
+		t.Fatalf("Location = %q, want %q", got, routepath.Root)
+	}
+	if gateway.lastDeleteConfirmation != "rhea" {
+		t.Fatalf("delete confirmation = %q, want %q", gateway.lastDeleteConfirmation, "rhea")
+	}
+	cookie := responseCookieByName(rr, sessioncookie.Name)
+	if cookie == nil || cookie.MaxAge >= 0 {
+		t.Fatalf("session cookie = %+v, want expired cookie", cookie)
+	}
+}
+
+func TestMountPrivacyDeleteMismatchRendersBadRequest(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	gateway.deleteAccountErr = apperrors.EK(apperrors.KindInvalidInput, "web.settings.privacy.error_confirmation_mismatch", "mismatch")
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	form := url.Values{"confirm_username": {"someone-else"}}
+	req := httptest.NewRequest(http.MethodPost, routepath.AppSettingsPrivacyDelete, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+	if responseHasCookieName(rr, sessioncookie.Name) {
+		t.Fatalf("session cookie should not be cleared on failed deletion")
+	}
+	if !strings.Contains(rr.Body.String(), "alert-error") {
+		t.Fatalf("body missing inline error")
+	}
+}
+
+func TestMountSecurityGetListsSessionsAndPasskeyActions(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	gateway.passkeys = []settingsapp.SettingsPasskey{{CredentialID: "cred-1", Number: 1, Label: "Laptop"}}
+	gateway.sessions = []settingsapp.SettingsWebSession{
+		{ID: "ws-1", UserAgent: "Firefox on Linux", ClientIP: "10.0.0.1"},
+		{ID: "ws-2", UserAgent: "Safari on iPhone", ClientIP: "10.0.0.2"},
+	}
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, routepath.AppSettingsSecurity, nil)
+	req.AddCookie(&http.Cookie{Name: sessioncookie.Name, Value: "ws-2"})
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	for _, want := range []string{"Firefox on Linux", "Safari on iPhone", routepath.AppSettingsSecuritySessionRevoke("ws-1"), routepath.AppSettingsSecuritySessionsRevokeOthers, routepath.AppSettingsSecurityPasskeyRename("cred-1"), `value="Laptop"`} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("body missing %q", want)
+		}
+	}
+	for _, unwanted := range []string{routepath.AppSettingsSecuritySessionRevoke("ws-2"), routepath.AppSettingsSecurityPasskeyDelete("cred-1")} {
+		if strings.Contains(body, unwanted) {
+			t.Fatalf("body unexpectedly contains %q", unwanted)
+		}
+	}
+}
+
+func TestMountSecuritySessionsRevokeOthersKeepsCurrentSession(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, routepath.AppSettingsSecuritySessionsRevokeOthers, nil)
+	req.AddCookie(&http.Cookie{Name: sessioncookie.Name, Value: "ws-current"})
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if got := rr.Header().Get("Location"); got != routepath.AppSettingsSecurity {
+		t.Fatalf("Location = %q, want %q", got, routepath.AppSettingsSecurity)
+	}
+	if gateway.lastKeptSessionID != "ws-current" || !responseHasCookieName(rr, flashnotice.CookieName) {
+		t.Fatalf("kept session = %q, flash cookie = %v", gateway.lastKeptSessionID, responseHasCookieName(rr, flashnotice.CookieName))
+	}
+}
+
+func TestMountSecuritySessionRevokeRefusesCurrentSession(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, routepath.AppSettingsSecuritySessionRevoke("ws-current"), nil)
+	req.AddCookie(&http.Cookie{Name: sessioncookie.Name, Value: "ws-current"})
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if gateway.lastRevokedSessionID != "" || !responseHasCookieName(rr, flashnotice.CookieName) {
+		t.Fatalf("revoked session = %q, flash cookie = %v", gateway.lastRevokedSessionID, responseHasCookieName(rr, flashnotice.CookieName))
+	}
+}
+
+func TestMountSecurityPasskeyRenameAndDelete(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase())
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	form := url.Values{"label": {" Work laptop "}}
+	req := httptest.NewRequest(http.MethodPost, routepath.AppSettingsSecurityPasskeyRename("cred-1"), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusFound {
+		t.Fatalf("rename status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if gateway.lastPasskeyCredentialID != "cred-1" || gateway.lastPasskeyLabel != "Work laptop" {
+		t.Fatalf("renamed %q to %q", gateway.lastPasskeyCredentialID, gateway.lastPasskeyLabel)
+	}
+
+	gateway.securityErr = apperrors.EK(apperrors.KindConflict, "web.settings.security.error_last_passkey", "last passkey")
+	req = httptest.NewRequest(http.MethodPost, routepath.AppSettingsSecurityPasskeyDelete("cred-1"), nil)
+	rr = httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusFound {
+		t.Fatalf("delete status = %d, want %d", rr.Code, http.StatusFound)
+	}
+	if got := rr.Header().Get("Location"); got != routepath.AppSettingsSecurity {
+		t.Fatalf("Location = %q, want %q", got, routepath.AppSettingsSecurity)
+	}
+	if gateway.lastDeletedPasskeyID != "cred-1" || !responseHasCookieName(rr, flashnotice.CookieName) {
+		t.Fatalf("deleted passkey = %q, flash cookie = %v", gateway.lastDeletedPasskeyID, responseHasCookieName(rr, flashnotice.CookieName))
+	}
+}
+
+func TestMountSecurityRecoveryFinishReturnsCodeWithoutCaching(t *testing.T) {
+	t.Parallel()
+
+	gateway := newPopulatedFakeGateway()
+	gateway.recoveryCode = "fresh-code"
+	m := newSettingsModuleFromGateways(gateway, nil, settingsTestBase(), withFlashMeta(requestmeta.SchemePolicy{}))
+	mount, err := m.Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "http://app.example.test"+routepath.AppSettingsSecurityRecoveryFinish, strings.NewReader(`{"session_id":"reauth-session-1","credential":{"id":"cred-1"}}`))
+	req.Host = "app.example.test"
+	req.Header.Set("Origin", "http://app.example.test")
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if got := rr.Header().Get("Cache-Control"); got != "no-store" {
+		t.Fatalf("Cache-Control = %q, want no-store", got)
+	}
+	if !strings.Contains(rr.Body.String(), `"recovery_code":"fresh-code"`) || gateway.lastPasskeySessionID != "reauth-session-1" {
+		t.Fatalf("body = %q, session = %q", rr.Body.String(), gateway.lastPasskeySessionID)
+	}
+	if responseHasCookieName(rr, flashnotice.CookieName) {
+		t.Fatal("recovery code must not be written to a flash cookie")
+	}
+}
+
 func responseHasCookieName(rr *httptest.ResponseRecorder, name string) bool {
 	return responseCookieByName(rr, name) != nil
 }
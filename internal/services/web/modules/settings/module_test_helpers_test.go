@@ -29,6 +29,7 @@ func newSettingsConfigFromGateways(
 				ProfileGateway:  accountGateway,
 				LocaleGateway:   accountGateway,
 				SecurityGateway: accountGateway,
+				PrivacyGateway:  accountGateway,
 			}),
 			AI: settingsapp.NewAIService(settingsapp.AIServiceConfig{
 				AIKeyGateway:   aiGateway,
@@ -90,10 +91,12 @@ func testSettingsAvailability(
 		availability.profile = gateway.SocialClient != nil
 		availability.locale = gateway.AccountClient != nil
 		availability.security = gateway.PasskeyClient != nil
+		availability.privacy = gateway.PrivacyClient != nil
 	} else if accountGateway != nil {
 		availability.profile = true
 		availability.locale = true
 		availability.security = true
+		availability.privacy = true
 	}
 	if gateway, ok := aiGateway.(settingsgateway.GRPCGateway); ok {
 		availability.aiKeys = gateway.CredentialClient != nil
@@ -7,7 +7,8 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 )
 
-// registerAccountRoutes wires profile, locale, and security settings routes.
+// registerAccountRoutes wires profile, locale, security, and privacy settings
+// routes.
 func registerAccountRoutes(mux *http.ServeMux, h handlers) {
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettings, h.redirectSettingsRoot)
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsProfile, h.handleProfileGet)
@@ -19,4 +20,22 @@ func registerAccountRoutes(mux *http.ServeMux, h handlers) {
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecurityPasskeysStart, httpx.MethodNotAllowed(http.MethodPost))
 	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsSecurityPasskeysFinish, h.handleSecurityPasskeyFinish)
 	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecurityPasskeysFinish, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsSecurityPasskeyRenamePattern, h.withCredentialID(h.handleSecurityPasskeyRename))
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecurityPasskeyRenamePattern, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsSecurityPasskeyDeletePattern, h.withCredentialID(h.handleSecurityPasskeyDelete))
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecurityPasskeyDeletePattern, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsSecuritySessionRevokePattern, h.withSessionID(h.handleSecuritySessionRevoke))
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecuritySessionRevokePattern, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsSecuritySessionsRevokeOthers, h.handleSecuritySessionsRevokeOthers)
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecuritySessionsRevokeOthers, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsSecurityRecoveryStart, h.handleSecurityRecoveryStart)
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecurityRecoveryStart, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsSecurityRecoveryFinish, h.handleSecurityRecoveryFinish)
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsSecurityRecoveryFinish, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsPrivacy, h.handlePrivacyGet)
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsPrivacyExports, h.handlePrivacyExportCreate)
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsPrivacyExports, httpx.MethodNotAllowed(http.MethodPost))
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsPrivacyExportDownloadPattern, h.withExportID(h.handlePrivacyExportDownload))
+	mux.HandleFunc(http.MethodPost+" "+routepath.AppSettingsPrivacyDelete, h.handlePrivacyDelete)
+	mux.HandleFunc(http.MethodGet+" "+routepath.AppSettingsPrivacyDelete, httpx.MethodNotAllowed(http.MethodPost))
 }
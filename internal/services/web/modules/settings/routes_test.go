@@ -76,6 +76,9 @@ func TestRegisterRoutesSettingsPathAndMethodContracts(t *testing.T) {
 		{name: "profile delete rejected", method: http.MethodDelete, path: routepath.AppSettingsProfile, wantStatus: http.StatusMethodNotAllowed},
 		{name: "passkey start get rejected", method: http.MethodGet, path: routepath.AppSettingsSecurityPasskeysStart, wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
 		{name: "passkey finish get rejected", method: http.MethodGet, path: routepath.AppSettingsSecurityPasskeysFinish, wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
+		{name: "passkey delete get rejected", method: http.MethodGet, path: routepath.AppSettingsSecurityPasskeyDelete("cred-1"), wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
+		{name: "session revoke get rejected", method: http.MethodGet, path: routepath.AppSettingsSecuritySessionRevoke("ws-1"), wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
+		{name: "recovery finish get rejected", method: http.MethodGet, path: routepath.AppSettingsSecurityRecoveryFinish, wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
 		{name: "ai key revoke get rejected", method: http.MethodGet, path: routepath.AppSettingsAIKeyRevoke("cred-1"), wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
 		{name: "ai agent delete get rejected", method: http.MethodGet, path: routepath.AppSettingsAIAgentDelete("agent-1"), wantStatus: http.StatusMethodNotAllowed, wantAllow: http.MethodPost},
 		{name: "unknown subpath", method: http.MethodGet, path: routepath.SettingsPrefix + "unknown", wantStatus: http.StatusNotFound},
@@ -233,6 +236,34 @@ func (staticGateway) FinishPasskeyRegistration(context.Context, string, json.Raw
 	return nil
 }
 
+func (staticGateway) RenamePasskey(context.Context, string, string, string) error {
+	return nil
+}
+
+func (staticGateway) DeletePasskey(context.Context, string, string) error {
+	return nil
+}
+
+func (staticGateway) ListWebSessions(context.Context, string) ([]settingsapp.SettingsWebSession, error) {
+	return []settingsapp.SettingsWebSession{}, nil
+}
+
+func (staticGateway) RevokeWebSession(context.Context, string, string) error {
+	return nil
+}
+
+func (staticGateway) RevokeOtherWebSessions(context.Context, string, string) (int, error) {
+	return 0, nil
+}
+
+func (staticGateway) BeginRecoveryCodeRotation(context.Context, string) (settingsapp.PasskeyChallenge, error) {
+	return settingsapp.PasskeyChallenge{SessionID: "reauth-session-1", PublicKey: json.RawMessage(`{"publicKey":{}}`)}, nil
+}
+
+func (staticGateway) FinishRecoveryCodeRotation(context.Context, string, string, json.RawMessage) (string, error) {
+	return "recovery-code", nil
+}
+
 func (staticGateway) ListAIKeys(context.Context, string) ([]settingsapp.SettingsAIKey, error) {
 	return []settingsapp.SettingsAIKey{}, nil
 }
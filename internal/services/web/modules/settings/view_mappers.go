@@ -9,7 +9,7 @@ import (
 
 // settingsSideMenu centralizes this web behavior in one helper seam.
 func settingsSideMenu(currentPath string, loc webtemplates.Localizer, availability settingsSurfaceAvailability) *webtemplates.AppSideMenu {
-	items := make([]webtemplates.AppSideMenuItem, 0, 3)
+	items := make([]webtemplates.AppSideMenuItem, 0, 4)
 	if availability.profile {
 		items = append(items, webtemplates.AppSideMenuItem{
 			Label:      webtemplates.T(loc, "layout.settings_user_profile"),
@@ -34,6 +34,14 @@ func settingsSideMenu(currentPath string, loc webtemplates.Localizer, availabili
 			IconID:     commonv1.IconId_ICON_ID_LOCK,
 		})
 	}
+	if availability.privacy {
+		items = append(items, webtemplates.AppSideMenuItem{
+			Label:      webtemplates.T(loc, "layout.settings_privacy"),
+			URL:        routepath.AppSettingsPrivacy,
+			MatchExact: true,
+			IconID:     commonv1.IconId_ICON_ID_NOTE,
+		})
+	}
 	aiItems := make([]webtemplates.AppSideMenuItem, 0, 2)
 	if availability.aiKeys {
 		aiItems = append(aiItems, webtemplates.AppSideMenuItem{
@@ -61,19 +69,63 @@ func settingsSideMenu(currentPath string, loc webtemplates.Localizer, availabili
 	return menu
 }
 
+// mapAccountExportTemplateRows maps account exports into privacy page rows.
+func mapAccountExportTemplateRows(exports []settingsapp.SettingsAccountExport, loc webtemplates.Localizer) []SettingsAccountExportRow {
+	rows := make([]SettingsAccountExportRow, 0, len(exports))
+	for _, export := range exports {
+		row := SettingsAccountExportRow{
+			ID:             export.ID,
+			Status:         export.Status,
+			CreatedAt:      export.CreatedAt,
+			ExpiresAt:      export.ExpiresAt,
+			Size:           "-",
+			FailureMessage: export.FailureMessage,
+		}
+		if export.Ready {
+			row.Size = webtemplates.T(loc, "web.settings.privacy.size_bytes", export.SizeBytes)
+			row.DownloadURL = routepath.AppSettingsPrivacyExportDownload(export.ID)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
 // mapPasskeyTemplateRows maps settings passkeys into template rows.
 func mapPasskeyTemplateRows(passkeys []settingsapp.SettingsPasskey) []SettingsPasskeyRow {
 	rows := make([]SettingsPasskeyRow, 0, len(passkeys))
 	for _, passkey := range passkeys {
 		rows = append(rows, SettingsPasskeyRow{
 			Number:     passkey.Number,
+			Label:      passkey.Label,
 			CreatedAt:  passkey.CreatedAt,
 			LastUsedAt: passkey.LastUsedAt,
+			RenameURL:  routepath.AppSettingsSecurityPasskeyRename(passkey.CredentialID),
+			DeleteURL:  routepath.AppSettingsSecurityPasskeyDelete(passkey.CredentialID),
+			CanDelete:  len(passkeys) > 1,
 		})
 	}
 	return rows
 }
 
+// mapWebSessionTemplateRows maps signed-in browsers into template rows.
+func mapWebSessionTemplateRows(sessions []settingsapp.SettingsWebSession) []SettingsWebSessionRow {
+	rows := make([]SettingsWebSessionRow, 0, len(sessions))
+	for _, session := range sessions {
+		row := SettingsWebSessionRow{
+			UserAgent:  session.UserAgent,
+			ClientIP:   session.ClientIP,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			Current:    session.Current,
+		}
+		if !session.Current {
+			row.RevokeURL = routepath.AppSettingsSecuritySessionRevoke(session.ID)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
 // mapAIKeyTemplateRows maps settings AI key values into template rows.
 func mapAIKeyTemplateRows(keys []settingsapp.SettingsAIKey) []SettingsAIKeyRow {
 	rows := make([]SettingsAIKeyRow, 0, len(keys))
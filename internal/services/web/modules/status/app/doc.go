@@ -0,0 +1,2 @@
+// Package app owns status-page orchestration and degraded-mode policy.
+package app
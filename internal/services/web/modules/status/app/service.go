@@ -0,0 +1,178 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+)
+
+const (
+	// UptimeWindow is the trailing window the public uptime table covers.
+	UptimeWindow = 7 * 24 * time.Hour
+	// IncidentWindow is how far back resolved incidents stay on the page.
+	IncidentWindow = 30 * 24 * time.Hour
+	// historyLimit caps the recent transitions shown below the incident list.
+	historyLimit = 50
+)
+
+// Status is the app-layer capability status label.
+type Status string
+
+const (
+	StatusUnknown     Status = "unknown"
+	StatusOperational Status = "operational"
+	StatusDegraded    Status = "degraded"
+	StatusUnavailable Status = "unavailable"
+	StatusMaintenance Status = "maintenance"
+)
+
+// CapabilityUptime is the app-layer uptime row for one capability.
+type CapabilityUptime struct {
+	Service     string
+	Capability  string
+	Percent     float64
+	Measured    bool
+	Degraded    time.Duration
+	Unavailable time.Duration
+	Maintenance time.Duration
+}
+
+// Transition is one recorded change in a capability's effective status.
+type Transition struct {
+	Service    string
+	Capability string
+	From       Status
+	To         Status
+	Detail     string
+	At         time.Time
+}
+
+// IncidentUpdate is one timeline entry on an incident.
+type IncidentUpdate struct {
+	Status  Status
+	Message string
+	At      time.Time
+}
+
+// Incident is an operator-authored account of one capability problem.
+type Incident struct {
+	ID         string
+	Service    string
+	Capability string
+	Title      string
+	Resolved   bool
+	OpenedAt   time.Time
+	ResolvedAt time.Time
+	Updates    []IncidentUpdate
+}
+
+// PageStatus describes the public status page availability contract.
+type PageStatus string
+
+const (
+	// PageStatusReady means status history was loaded successfully.
+	PageStatusReady PageStatus = "ready"
+	// PageStatusUnavailable means the status service could not provide history.
+	PageStatusUnavailable PageStatus = "unavailable"
+)
+
+// Gateway loads status history from the backing status service.
+type Gateway interface {
+	ListCapabilityUptime(ctx context.Context, start, end time.Time) ([]CapabilityUptime, error)
+	ListStatusHistory(ctx context.Context, start, end time.Time, limit int) ([]Transition, error)
+	ListIncidents(ctx context.Context, since time.Time) ([]Incident, error)
+}
+
+// Page is the explicit status-page contract returned to transport.
+type Page struct {
+	Status      PageStatus
+	Uptime      []CapabilityUptime
+	Incidents   []Incident
+	Transitions []Transition
+}
+
+// Service orchestrates status-page loading.
+type Service interface {
+	LoadPage(context.Context) Page
+}
+
+// service defines an internal contract used at this web package boundary.
+type service struct {
+	gateway Gateway
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// unavailableGateway preserves fail-closed gateway behavior while letting the
+// service return an explicit degraded page contract.
+type unavailableGateway struct{}
+
+// NewUnavailableGateway returns a gateway that always reports unavailable.
+func NewUnavailableGateway() Gateway {
+	return unavailableGateway{}
+}
+
+// NewService constructs a status service with explicit degraded-mode policy.
+func NewService(gateway Gateway, logger *slog.Logger) Service {
+	if gateway == nil {
+		gateway = NewUnavailableGateway()
+	}
+	return service{
+		gateway: gateway,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// LoadPage returns a status page contract. Any dependency failure degrades the
+// whole page so visitors never see uptime without the incidents explaining it.
+func (s service) LoadPage(ctx context.Context) Page {
+	end := s.now().UTC()
+	uptime, err := s.gateway.ListCapabilityUptime(ctx, end.Add(-UptimeWindow), end)
+	if err != nil {
+		return s.unavailable("uptime", err)
+	}
+	incidents, err := s.gateway.ListIncidents(ctx, end.Add(-IncidentWindow))
+	if err != nil {
+		return s.unavailable("incidents", err)
+	}
+	transitions, err := s.gateway.ListStatusHistory(ctx, end.Add(-UptimeWindow), end, historyLimit)
+	if err != nil {
+		return s.unavailable("history", err)
+	}
+	// Newest first reads naturally under the incident list.
+	slices.Reverse(transitions)
+	return Page{
+		Status:      PageStatusReady,
+		Uptime:      uptime,
+		Incidents:   incidents,
+		Transitions: transitions,
+	}
+}
+
+// unavailable logs a history read failure and degrades the page instead of
+// failing the whole request.
+func (s service) unavailable(section string, err error) Page {
+	if s.logger != nil {
+		s.logger.Warn("status history unavailable", "section", section, "error", err)
+	}
+	return Page{Status: PageStatusUnavailable}
+}
+
+// ListCapabilityUptime always returns an unavailable error.
+func (unavailableGateway) ListCapabilityUptime(context.Context, time.Time, time.Time) ([]CapabilityUptime, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "status service client is not configured")
+}
+
+// ListStatusHistory always returns an unavailable error.
+func (unavailableGateway) ListStatusHistory(context.Context, time.Time, time.Time, int) ([]Transition, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "status service client is not configured")
+}
+
+// ListIncidents always returns an unavailable error.
+func (unavailableGateway) ListIncidents(context.Context, time.Time) ([]Incident, error) {
+	return nil, apperrors.E(apperrors.KindUnavailable, "status service client is not configured")
+}
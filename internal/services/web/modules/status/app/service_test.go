@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type gatewayStub struct {
+	uptime      []CapabilityUptime
+	transitions []Transition
+	incidents   []Incident
+	uptimeErr   error
+	historyErr  error
+	incidentErr error
+	start, end  time.Time
+	since       time.Time
+}
+
+func (g *gatewayStub) ListCapabilityUptime(_ context.Context, start, end time.Time) ([]CapabilityUptime, error) {
+	g.start, g.end = start, end
+	return g.uptime, g.uptimeErr
+}
+
+func (g *gatewayStub) ListStatusHistory(context.Context, time.Time, time.Time, int) ([]Transition, error) {
+	return g.transitions, g.historyErr
+}
+
+func (g *gatewayStub) ListIncidents(_ context.Context, since time.Time) ([]Incident, error) {
+	g.since = since
+	return g.incidents, g.incidentErr
+}
+
+func TestNewServiceWithoutGatewayUsesExplicitDegradedContract(t *testing.T) {
+	t.Parallel()
+
+	page := NewService(nil, nil).LoadPage(context.Background())
+	if page.Status != PageStatusUnavailable {
+		t.Fatalf("Status = %q, want %q", page.Status, PageStatusUnavailable)
+	}
+}
+
+func TestLoadPageDegradesWhenAnySectionFails(t *testing.T) {
+	t.Parallel()
+
+	for name, gw := range map[string]*gatewayStub{
+		"uptime":    {uptimeErr: errors.New("boom")},
+		"history":   {historyErr: errors.New("boom")},
+		"incidents": {incidentErr: errors.New("boom")},
+	} {
+		page := NewService(gw, nil).LoadPage(context.Background())
+		if page.Status != PageStatusUnavailable {
+			t.Fatalf("%s failure: Status = %q, want %q", name, page.Status, PageStatusUnavailable)
+		}
+	}
+}
+
+func TestLoadPageUsesTrailingWindowsAndNewestFirstHistory(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 8, 12, 0, 0, 0, time.UTC)
+	gw := &gatewayStub{
+		uptime: []CapabilityUptime{{Capability: "ai.orchestration", Percent: 99.5, Measured: true}},
+		transitions: []Transition{
+			{Capability: "ai.orchestration", To: StatusDegraded, At: now.Add(-2 * time.Hour)},
+			{Capability: "ai.orchestration", To: StatusOperational, At: now.Add(-time.Hour)},
+		},
+	}
+	svc := service{gateway: gw, now: func() time.Time { return now }}
+
+	page := svc.LoadPage(context.Background())
+	if page.Status != PageStatusReady {
+		t.Fatalf("Status = %q, want %q", page.Status, PageStatusReady)
+	}
+	if !gw.start.Equal(now.Add(-UptimeWindow)) || !gw.end.Equal(now) {
+		t.Fatalf("uptime window = [%v, %v], want trailing %v", gw.start, gw.end, UptimeWindow)
+	}
+	if !gw.since.Equal(now.Add(-IncidentWindow)) {
+		t.Fatalf("incident since = %v, want %v", gw.since, now.Add(-IncidentWindow))
+	}
+	if len(page.Transitions) != 2 || page.Transitions[0].To != StatusOperational {
+		t.Fatalf("transitions = %+v, want newest first", page.Transitions)
+	}
+}
@@ -0,0 +1,25 @@
+package status
+
+import (
+	"log/slog"
+
+	module "github.com/louisbranch/fracturing.space/internal/services/web/module"
+	statusapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/app"
+	statusgateway "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/gateway"
+)
+
+// CompositionConfig owns the startup wiring required to construct the
+// production status module.
+type CompositionConfig struct {
+	Client statusgateway.StatusClient
+	Logger *slog.Logger
+}
+
+// Compose builds the status module from the exact startup dependencies the
+// area owns.
+func Compose(config CompositionConfig) module.Module {
+	gateway := statusgateway.NewGRPCGateway(config.Client)
+	return New(Config{
+		Service: statusapp.NewService(gateway, config.Logger),
+	})
+}
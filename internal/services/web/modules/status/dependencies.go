@@ -0,0 +1,22 @@
+package status
+
+import (
+	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
+	grpc "google.golang.org/grpc"
+
+	statusgateway "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/gateway"
+)
+
+// Dependencies contains status page feature clients.
+type Dependencies struct {
+	StatusClient statusgateway.StatusClient
+}
+
+// BindDependency wires status-backed clients into the status page dependency
+// set.
+func BindDependency(deps *Dependencies, conn *grpc.ClientConn) {
+	if deps == nil || conn == nil {
+		return
+	}
+	deps.StatusClient = statusv1.NewStatusServiceClient(conn)
+}
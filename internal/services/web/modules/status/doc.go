@@ -0,0 +1,6 @@
+// Package status owns the public status page transport routes and the
+// module-facing seam into status history orchestration.
+//
+// Transport stays in the root package. Area-local orchestration lives in
+// `status/app`, and backend protocol mapping lives in `status/gateway`.
+package status
@@ -0,0 +1,2 @@
+// Package gateway owns status backend protocol mapping for the web module.
+package gateway
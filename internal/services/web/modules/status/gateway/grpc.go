@@ -0,0 +1,145 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
+	statusapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StatusClient exposes status history operations needed by the status module.
+type StatusClient interface {
+	ListCapabilityUptime(ctx context.Context, in *statusv1.ListCapabilityUptimeRequest, opts ...grpc.CallOption) (*statusv1.ListCapabilityUptimeResponse, error)
+	ListStatusHistory(ctx context.Context, in *statusv1.ListStatusHistoryRequest, opts ...grpc.CallOption) (*statusv1.ListStatusHistoryResponse, error)
+	ListIncidents(ctx context.Context, in *statusv1.ListIncidentsRequest, opts ...grpc.CallOption) (*statusv1.ListIncidentsResponse, error)
+}
+
+// GRPCGateway implements statusapp.Gateway backed by the status gRPC service.
+type GRPCGateway struct {
+	client StatusClient
+}
+
+// NewGRPCGateway returns a statusapp.Gateway backed by the given status client.
+// Returns an unavailable gateway when client is nil (fail-closed).
+func NewGRPCGateway(client StatusClient) statusapp.Gateway {
+	if client == nil {
+		return statusapp.NewUnavailableGateway()
+	}
+	return GRPCGateway{client: client}
+}
+
+// ListCapabilityUptime fetches per-capability uptime over a window.
+func (g GRPCGateway) ListCapabilityUptime(ctx context.Context, start, end time.Time) ([]statusapp.CapabilityUptime, error) {
+	resp, err := g.client.ListCapabilityUptime(ctx, &statusv1.ListCapabilityUptimeRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	})
+	if err != nil {
+		return nil, mapStatusError(err)
+	}
+	uptimes := make([]statusapp.CapabilityUptime, 0, len(resp.GetCapabilities()))
+	for _, c := range resp.GetCapabilities() {
+		uptimes = append(uptimes, statusapp.CapabilityUptime{
+			Service:     strings.TrimSpace(c.GetService()),
+			Capability:  strings.TrimSpace(c.GetCapability()),
+			Percent:     c.GetUptimePercent(),
+			Measured:    c.UptimePercent != nil,
+			Degraded:    time.Duration(c.GetDegradedSeconds()) * time.Second,
+			Unavailable: time.Duration(c.GetUnavailableSeconds()) * time.Second,
+			Maintenance: time.Duration(c.GetMaintenanceSeconds()) * time.Second,
+		})
+	}
+	return uptimes, nil
+}
+
+// ListStatusHistory fetches recorded transitions over a window, oldest first.
+func (g GRPCGateway) ListStatusHistory(ctx context.Context, start, end time.Time, limit int) ([]statusapp.Transition, error) {
+	resp, err := g.client.ListStatusHistory(ctx, &statusv1.ListStatusHistoryRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		PageSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, mapStatusError(err)
+	}
+	transitions := make([]statusapp.Transition, 0, len(resp.GetTransitions()))
+	for _, t := range resp.GetTransitions() {
+		transitions = append(transitions, statusapp.Transition{
+			Service:    strings.TrimSpace(t.GetService()),
+			Capability: strings.TrimSpace(t.GetCapability()),
+			From:       statusLabel(t.GetFromStatus()),
+			To:         statusLabel(t.GetToStatus()),
+			Detail:     strings.TrimSpace(t.GetDetail()),
+			At:         t.GetOccurredAt().AsTime(),
+		})
+	}
+	return transitions, nil
+}
+
+// ListIncidents fetches open incidents and those active since the given time.
+func (g GRPCGateway) ListIncidents(ctx context.Context, since time.Time) ([]statusapp.Incident, error) {
+	resp, err := g.client.ListIncidents(ctx, &statusv1.ListIncidentsRequest{
+		Since: timestamppb.New(since),
+	})
+	if err != nil {
+		return nil, mapStatusError(err)
+	}
+	incidents := make([]statusapp.Incident, 0, len(resp.GetIncidents()))
+	for _, inc := range resp.GetIncidents() {
+		incidents = append(incidents, mapProtoToIncident(inc))
+	}
+	return incidents, nil
+}
+
+// mapProtoToIncident converts a proto Incident to the app-layer model.
+func mapProtoToIncident(inc *statusv1.Incident) statusapp.Incident {
+	out := statusapp.Incident{
+		ID:         strings.TrimSpace(inc.GetId()),
+		Service:    strings.TrimSpace(inc.GetService()),
+		Capability: strings.TrimSpace(inc.GetCapability()),
+		Title:      strings.TrimSpace(inc.GetTitle()),
+		Resolved:   inc.GetState() == statusv1.IncidentState_INCIDENT_STATE_RESOLVED,
+		OpenedAt:   inc.GetOpenedAt().AsTime(),
+	}
+	if inc.GetResolvedAt() != nil {
+		out.ResolvedAt = inc.GetResolvedAt().AsTime()
+	}
+	for _, update := range inc.GetUpdates() {
+		out.Updates = append(out.Updates, statusapp.IncidentUpdate{
+			Status:  statusLabel(update.GetStatus()),
+			Message: strings.TrimSpace(update.GetMessage()),
+			At:      update.GetCreatedAt().AsTime(),
+		})
+	}
+	return out
+}
+
+// statusLabel maps a proto capability status to the app-layer label.
+func statusLabel(status statusv1.CapabilityStatus) statusapp.Status {
+	switch status {
+	case statusv1.CapabilityStatus_CAPABILITY_STATUS_OPERATIONAL:
+		return statusapp.StatusOperational
+	case statusv1.CapabilityStatus_CAPABILITY_STATUS_DEGRADED:
+		return statusapp.StatusDegraded
+	case statusv1.CapabilityStatus_CAPABILITY_STATUS_UNAVAILABLE:
+		return statusapp.StatusUnavailable
+	case statusv1.CapabilityStatus_CAPABILITY_STATUS_MAINTENANCE:
+		return statusapp.StatusMaintenance
+	default:
+		return statusapp.StatusUnknown
+	}
+}
+
+// mapStatusError maps status transport failures into web unavailable errors.
+func mapStatusError(err error) error {
+	return apperrors.MapGRPCTransportError(err, apperrors.GRPCStatusMapping{
+		FallbackKind:    apperrors.KindUnavailable,
+		FallbackKey:     "error.web.message.failed_to_load_status_history",
+		FallbackMessage: "status service is unavailable",
+	})
+}
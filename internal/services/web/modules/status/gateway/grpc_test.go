@@ -0,0 +1,116 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	statusv1 "github.com/louisbranch/fracturing.space/api/gen/go/status/v1"
+	statusapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/app"
+	apperrors "github.com/louisbranch/fracturing.space/internal/services/web/platform/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// statusClientStub returns canned responses.
+type statusClientStub struct {
+	uptime    *statusv1.ListCapabilityUptimeResponse
+	history   *statusv1.ListStatusHistoryResponse
+	incidents *statusv1.ListIncidentsResponse
+	err       error
+}
+
+func (s statusClientStub) ListCapabilityUptime(context.Context, *statusv1.ListCapabilityUptimeRequest, ...grpc.CallOption) (*statusv1.ListCapabilityUptimeResponse, error) {
+	return s.uptime, s.err
+}
+
+func (s statusClientStub) ListStatusHistory(context.Context, *statusv1.ListStatusHistoryRequest, ...grpc.CallOption) (*statusv1.ListStatusHistoryResponse, error) {
+	return s.history, s.err
+}
+
+func (s statusClientStub) ListIncidents(context.Context, *statusv1.ListIncidentsRequest, ...grpc.CallOption) (*statusv1.ListIncidentsResponse, error) {
+	return s.incidents, s.err
+}
+
+func TestNewGRPCGatewayReturnsUnavailableWhenNil(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGRPCGateway(nil).ListIncidents(context.Background(), time.Now())
+	if apperrors.HTTPStatus(err) != 503 {
+		t.Fatalf("status = %d, want 503", apperrors.HTTPStatus(err))
+	}
+}
+
+func TestGRPCGatewayMapsUptimeMeasurement(t *testing.T) {
+	t.Parallel()
+
+	gw := NewGRPCGateway(statusClientStub{uptime: &statusv1.ListCapabilityUptimeResponse{
+		Capabilities: []*statusv1.CapabilityUptime{
+			{Service: "ai", Capability: "ai.orchestration", UptimePercent: proto.Float64(97.5), DegradedSeconds: 3600},
+			{Service: "game", Capability: "game.service"},
+		},
+	}})
+	uptime, err := gw.ListCapabilityUptime(context.Background(), time.Now().Add(-time.Hour), time.Now())
+	if err != nil {
+		t.Fatalf("ListCapabilityUptime: %v", err)
+	}
+	if len(uptime) != 2 || !uptime[0].Measured || uptime[0].Percent != 97.5 || uptime[0].Degraded != time.Hour {
+		t.Fatalf("uptime = %+v, want measured ai row with 1h degraded", uptime)
+	}
+	if uptime[1].Measured {
+		t.Fatalf("uptime[1] = %+v, want unmeasured row", uptime[1])
+	}
+}
+
+func TestGRPCGatewayMapsIncidentsAndTransitions(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	gw := NewGRPCGateway(statusClientStub{
+		incidents: &statusv1.ListIncidentsResponse{Incidents: []*statusv1.Incident{{
+			Id:         "inc-1",
+			Title:      " Provider outage ",
+			State:      statusv1.IncidentState_INCIDENT_STATE_RESOLVED,
+			OpenedAt:   timestamppb.New(opened),
+			ResolvedAt: timestamppb.New(opened.Add(time.Hour)),
+			Updates: []*statusv1.IncidentUpdate{{
+				Status:    statusv1.CapabilityStatus_CAPABILITY_STATUS_UNAVAILABLE,
+				Message:   "investigating",
+				CreatedAt: timestamppb.New(opened),
+			}},
+		}}},
+		history: &statusv1.ListStatusHistoryResponse{Transitions: []*statusv1.StatusTransition{{
+			Capability: "ai.orchestration",
+			FromStatus: statusv1.CapabilityStatus_CAPABILITY_STATUS_OPERATIONAL,
+			ToStatus:   statusv1.CapabilityStatus_CAPABILITY_STATUS_DEGRADED,
+			OccurredAt: timestamppb.New(opened),
+		}}},
+	})
+
+	incidents, err := gw.ListIncidents(context.Background(), opened)
+	if err != nil {
+		t.Fatalf("ListIncidents: %v", err)
+	}
+	if len(incidents) != 1 || incidents[0].Title != "Provider outage" || !incidents[0].Resolved || incidents[0].Updates[0].Status != statusapp.StatusUnavailable {
+		t.Fatalf("incidents = %+v, want resolved incident with unavailable update", incidents)
+	}
+	transitions, err := gw.ListStatusHistory(context.Background(), opened, opened.Add(time.Hour), 10)
+	if err != nil {
+		t.Fatalf("ListStatusHistory: %v", err)
+	}
+	if len(transitions) != 1 || transitions[0].From != statusapp.StatusOperational || transitions[0].To != statusapp.StatusDegraded {
+		t.Fatalf("transitions = %+v, want operational -> degraded", transitions)
+	}
+}
+
+func TestGRPCGatewayMapsTransportErrors(t *testing.T) {
+	t.Parallel()
+
+	gw := NewGRPCGateway(statusClientStub{err: status.Error(codes.Unavailable, "down")})
+	if _, err := gw.ListStatusHistory(context.Background(), time.Now(), time.Now(), 10); apperrors.HTTPStatus(err) != 503 {
+		t.Fatalf("status = %d, want 503", apperrors.HTTPStatus(err))
+	}
+}
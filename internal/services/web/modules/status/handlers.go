@@ -0,0 +1,44 @@
+package status
+
+import (
+	"net/http"
+
+	statusapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/app"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/pagerender"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/publichandler"
+	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
+)
+
+// handlers defines an internal contract used at this web package boundary.
+type handlers struct {
+	publichandler.Base
+	service statusapp.Service
+}
+
+// newHandlersWithBase keeps package tests free to inject a custom base while
+// module roots stay transport-only.
+func newHandlersWithBase(base publichandler.Base, service statusapp.Service) handlers {
+	if service == nil {
+		service = statusapp.NewService(nil, nil)
+	}
+	return handlers{Base: base, service: service}
+}
+
+// newHandlers builds package wiring for the production status module seam.
+func newHandlers(service statusapp.Service) handlers {
+	return newHandlersWithBase(publichandler.NewBase(), service)
+}
+
+// handleIndex handles this route in the module transport layer.
+func (h handlers) handleIndex(w http.ResponseWriter, r *http.Request) {
+	loc, lang := h.PageLocalizer(w, r)
+	page := h.service.LoadPage(r.Context())
+	view := mapPageToView(page, loc)
+	h.WritePublicPage(w, r, pagerender.PublicPage{
+		Title:      webtemplates.T(loc, "web.status.title"),
+		MetaDesc:   webtemplates.T(loc, "web.status.meta_description"),
+		Language:   lang,
+		StatusCode: http.StatusOK,
+		Body:       StatusFragment(view, loc),
+	})
+}
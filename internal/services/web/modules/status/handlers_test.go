@@ -0,0 +1,74 @@
+package status
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	statusapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/app"
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/publichandler"
+)
+
+type serviceStub struct {
+	page statusapp.Page
+}
+
+func (s serviceStub) LoadPage(context.Context) statusapp.Page {
+	return s.page
+}
+
+func TestHandleIndexRendersDegradedState(t *testing.T) {
+	t.Parallel()
+
+	h := newHandlersWithBase(publichandler.NewBase(), serviceStub{page: statusapp.Page{Status: statusapp.PageStatusUnavailable}})
+	rr := httptest.NewRecorder()
+	h.handleIndex(rr, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	if !strings.Contains(body, `data-status-page="unavailable"`) || !strings.Contains(body, `data-status-unavailable="true"`) {
+		t.Fatalf("body missing unavailable notice: %q", body)
+	}
+}
+
+func TestHandleIndexRendersHistory(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC)
+	h := newHandlersWithBase(publichandler.NewBase(), serviceStub{page: statusapp.Page{
+		Status: statusapp.PageStatusReady,
+		Uptime: []statusapp.CapabilityUptime{{
+			Service: "ai", Capability: "ai.orchestration", Percent: 98.21, Measured: true, Degraded: 3 * time.Hour,
+		}},
+		Incidents: []statusapp.Incident{{
+			ID: "inc-1", Capability: "ai.orchestration", Title: "AI provider outage", OpenedAt: opened,
+			Updates: []statusapp.IncidentUpdate{{Status: statusapp.StatusDegraded, Message: "fallback engaged", At: opened}},
+		}},
+		Transitions: []statusapp.Transition{{
+			Capability: "ai.orchestration", From: statusapp.StatusOperational, To: statusapp.StatusDegraded, At: opened,
+		}},
+	}})
+	rr := httptest.NewRecorder()
+	h.handleIndex(rr, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	body := rr.Body.String()
+	for _, want := range []string{
+		`data-status-page="ready"`,
+		"98.21%",
+		"3h",
+		`data-incident-id="inc-1"`,
+		"AI provider outage",
+		"fallback engaged",
+		"2025-06-01 22:00 UTC",
+		"Degraded",
+	} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("body missing %q: %q", want, body)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package status
+
+import (
+	"net/http"
+
+	"github.com/louisbranch/fracturing.space/internal/services/web/module"
+	statusapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/app"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+)
+
+// Module provides the public status page routes.
+type Module struct {
+	service statusapp.Service
+}
+
+// Config defines constructor dependencies for a status module.
+type Config struct {
+	Service statusapp.Service
+}
+
+// New returns a status module with explicit dependencies.
+func New(config Config) Module {
+	service := config.Service
+	if service == nil {
+		service = statusapp.NewService(nil, nil)
+	}
+	return Module{service: service}
+}
+
+// ID returns a stable module identifier.
+func (Module) ID() string { return "status" }
+
+// Mount wires status route handlers.
+func (m Module) Mount() (module.Mount, error) {
+	mux := http.NewServeMux()
+	h := newHandlers(m.service)
+	registerRoutes(mux, h)
+	return module.Mount{Prefix: routepath.StatusPrefix, CanonicalRoot: true, Handler: mux}, nil
+}
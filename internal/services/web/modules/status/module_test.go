@@ -0,0 +1,35 @@
+package status
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+)
+
+func TestModuleIDReturnsStatus(t *testing.T) {
+	t.Parallel()
+
+	if got := New(Config{}).ID(); got != "status" {
+		t.Fatalf("ID() = %q, want %q", got, "status")
+	}
+}
+
+func TestMountServesStatusRoot(t *testing.T) {
+	t.Parallel()
+
+	mount, err := New(Config{}).Mount()
+	if err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+	if mount.Prefix != routepath.StatusPrefix {
+		t.Fatalf("prefix = %q, want %q", mount.Prefix, routepath.StatusPrefix)
+	}
+	req := httptest.NewRequest(http.MethodGet, routepath.StatusPrefix, nil)
+	rr := httptest.NewRecorder()
+	mount.Handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+}
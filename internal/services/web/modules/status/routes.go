@@ -0,0 +1,17 @@
+package status
+
+import (
+	"net/http"
+
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+)
+
+// registerRoutes centralizes this web behavior in one helper seam.
+func registerRoutes(mux *http.ServeMux, h handlers) {
+	if mux == nil {
+		return
+	}
+	mux.HandleFunc(http.MethodGet+" "+routepath.Status, h.handleIndex)
+	mux.HandleFunc(http.MethodGet+" "+routepath.StatusPrefix+"{$}", h.handleIndex)
+	mux.HandleFunc(http.MethodGet+" "+routepath.StatusPrefix+"{rest...}", http.NotFound)
+}
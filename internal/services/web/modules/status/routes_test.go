@@ -0,0 +1,48 @@
+package status
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/web/platform/publichandler"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+)
+
+func TestRegisterRoutesHandlesNilMux(t *testing.T) {
+	t.Parallel()
+
+	registerRoutes(nil, newHandlersWithBase(publichandler.NewBase(), nil))
+}
+
+func TestRegisterRoutesStatusMethodContract(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	registerRoutes(mux, newHandlersWithBase(publichandler.NewBase(), nil))
+
+	getReq := httptest.NewRequest(http.MethodGet, routepath.Status, nil)
+	getRR := httptest.NewRecorder()
+	mux.ServeHTTP(getRR, getReq)
+	if getRR.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", getRR.Code, http.StatusOK)
+	}
+	if body := getRR.Body.String(); !strings.Contains(body, "status-root") {
+		t.Fatalf("body missing status marker: %q", body)
+	}
+
+	postReq := httptest.NewRequest(http.MethodPost, routepath.Status, nil)
+	postRR := httptest.NewRecorder()
+	mux.ServeHTTP(postRR, postReq)
+	if postRR.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", postRR.Code, http.StatusMethodNotAllowed)
+	}
+
+	unknownReq := httptest.NewRequest(http.MethodGet, routepath.StatusPrefix+"unknown", nil)
+	unknownRR := httptest.NewRecorder()
+	mux.ServeHTTP(unknownRR, unknownReq)
+	if unknownRR.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", unknownRR.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,143 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	statusapp "github.com/louisbranch/fracturing.space/internal/services/web/modules/status/app"
+	webtemplates "github.com/louisbranch/fracturing.space/internal/services/web/templates"
+)
+
+// statusTimeLayout renders event times in UTC so every visitor reads the same
+// timeline.
+const statusTimeLayout = "2006-01-02 15:04 UTC"
+
+// StatusPageView keeps status transport state explicit for the page template.
+type StatusPageView struct {
+	Status      string
+	Unavailable bool
+	WindowDays  int
+	Uptime      []UptimeRowView
+	Incidents   []IncidentView
+	Transitions []TransitionView
+}
+
+// mapPageToView converts the app-layer page contract into template state.
+func mapPageToView(page statusapp.Page, loc webtemplates.Localizer) StatusPageView {
+	view := StatusPageView{
+		Status:      string(page.Status),
+		Unavailable: page.Status == statusapp.PageStatusUnavailable,
+		WindowDays:  int(statusapp.UptimeWindow / (24 * time.Hour)),
+	}
+	for _, u := range page.Uptime {
+		row := UptimeRowView{
+			Service:     u.Service,
+			Capability:  u.Capability,
+			Percent:     webtemplates.T(loc, "web.status.uptime.no_data"),
+			Degraded:    formatDuration(u.Degraded),
+			Unavailable: formatDuration(u.Unavailable),
+			Maintenance: formatDuration(u.Maintenance),
+		}
+		if u.Measured {
+			row.Percent = fmt.Sprintf("%.2f%%", u.Percent)
+			row.Status = uptimeStatus(u.Percent)
+		}
+		view.Uptime = append(view.Uptime, row)
+	}
+	for _, inc := range page.Incidents {
+		item := IncidentView{
+			ID:         inc.ID,
+			Title:      inc.Title,
+			Service:    inc.Service,
+			Capability: inc.Capability,
+			Resolved:   inc.Resolved,
+			OpenedAt:   formatTime(inc.OpenedAt),
+		}
+		if inc.Resolved {
+			item.ResolvedAt = formatTime(inc.ResolvedAt)
+		}
+		// Latest update first, matching conventional incident pages.
+		for i := len(inc.Updates) - 1; i >= 0; i-- {
+			update := inc.Updates[i]
+			item.Updates = append(item.Updates, IncidentUpdateView{
+				Status:  statusLabel(loc, update.Status),
+				Badge:   statusBadgeClass(update.Status),
+				Message: update.Message,
+				At:      formatTime(update.At),
+			})
+		}
+		view.Incidents = append(view.Incidents, item)
+	}
+	for _, t := range page.Transitions {
+		view.Transitions = append(view.Transitions, TransitionView{
+			Service:    t.Service,
+			Capability: t.Capability,
+			From:       statusLabel(loc, t.From),
+			To:         statusLabel(loc, t.To),
+			Badge:      statusBadgeClass(t.To),
+			Detail:     t.Detail,
+			At:         formatTime(t.At),
+		})
+	}
+	return view
+}
+
+// statusLabel localizes one capability status.
+func statusLabel(loc webtemplates.Localizer, status statusapp.Status) string {
+	return webtemplates.T(loc, "web.status.state."+string(status))
+}
+
+// statusBadgeClass maps a capability status to a badge color.
+func statusBadgeClass(status statusapp.Status) string {
+	switch status {
+	case statusapp.StatusOperational:
+		return "badge-success"
+	case statusapp.StatusDegraded:
+		return "badge-warning"
+	case statusapp.StatusUnavailable:
+		return "badge-error"
+	case statusapp.StatusMaintenance:
+		return "badge-info"
+	default:
+		return "badge-ghost"
+	}
+}
+
+// uptimeStatus buckets an uptime percentage for row coloring.
+func uptimeStatus(percent float64) string {
+	switch {
+	case percent >= 99.9:
+		return "text-success"
+	case percent >= 99:
+		return "text-warning"
+	default:
+		return "text-error"
+	}
+}
+
+// formatDuration renders a duration at minute precision, or an empty string
+// for zero so quiet capabilities stay uncluttered.
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d <= 0 {
+		return ""
+	}
+	hours := int(d / time.Hour)
+	minutes := int((d % time.Hour) / time.Minute)
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+}
+
+// formatTime renders one incident timestamp in UTC, or blank when unset.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(statusTimeLayout)
+}
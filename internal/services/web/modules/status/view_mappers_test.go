@@ -0,0 +1,37 @@
+package status
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	for d, want := range map[time.Duration]string{
+		0:                               "",
+		20 * time.Second:                "",
+		45 * time.Minute:                "45m",
+		2 * time.Hour:                   "2h",
+		26*time.Hour + 5*time.Minute:    "26h 5m",
+		90*time.Minute + 29*time.Second: "1h 30m",
+	} {
+		if got := formatDuration(d); got != want {
+			t.Fatalf("formatDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestUptimeStatus(t *testing.T) {
+	t.Parallel()
+
+	if got := uptimeStatus(99.95); got != "text-success" {
+		t.Fatalf("uptimeStatus(99.95) = %q", got)
+	}
+	if got := uptimeStatus(99.5); got != "text-warning" {
+		t.Fatalf("uptimeStatus(99.5) = %q", got)
+	}
+	if got := uptimeStatus(90); got != "text-error" {
+		t.Fatalf("uptimeStatus(90) = %q", got)
+	}
+}
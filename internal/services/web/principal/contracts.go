@@ -40,11 +40,15 @@ type Dependencies struct {
 	NotificationClient NotificationClient
 	SocialClient       SocialClient
 	AssetBaseURL       string
+	// TrustForwardedFor reports session client addresses from
+	// X-Forwarded-For; enable only behind a proxy that sets it.
+	TrustForwardedFor bool
 }
 
 // authResolver owns session validation and user-id resolution.
 type authResolver struct {
-	sessionClient SessionClient
+	sessionClient     SessionClient
+	trustForwardedFor bool
 }
 
 // accountProfileResolver owns auth-backed profile loading shared by language
@@ -80,7 +84,8 @@ const viewerAvatarDeliveryWidthPX = 40
 func New(deps Dependencies) Resolver {
 	accountProfiles := accountProfileResolver{accountClient: deps.AccountClient}
 	auth := authResolver{
-		sessionClient: deps.SessionClient,
+		sessionClient:     deps.SessionClient,
+		trustForwardedFor: deps.TrustForwardedFor,
 	}
 	return Resolver{
 		auth: auth,
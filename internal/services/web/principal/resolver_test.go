@@ -30,6 +30,25 @@ func TestResolverResolveUserIDAndSignedIn(t *testing.T) {
 	}
 }
 
+func TestResolverForwardsSessionClientMetadata(t *testing.T) {
+	t.Parallel()
+
+	session := &fakeSessionClient{sessions: map[string]string{"ws-1": "user-1"}}
+	resolver := New(Dependencies{SessionClient: session, TrustForwardedFor: true})
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.RemoteAddr = "10.0.0.1:5000"
+	request.Header.Set("User-Agent", "Firefox")
+	request.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+	request.AddCookie(&http.Cookie{Name: "web_session", Value: "ws-1"})
+
+	if got := resolver.ResolveUserID(request); got != "user-1" {
+		t.Fatalf("ResolveUserID() = %q, want %q", got, "user-1")
+	}
+	if session.last.GetUserAgent() != "Firefox" || session.last.GetClientIp() != "203.0.113.7" {
+		t.Fatalf("GetWebSession request = %+v", session.last)
+	}
+}
+
 func TestResolverAuthRequiredRejectsUnknownSession(t *testing.T) {
 	t.Parallel()
 
@@ -129,10 +148,12 @@ func TestResolverMiddlewareSharesSessionAndAccountLookups(t *testing.T) {
 type fakeSessionClient struct {
 	sessions map[string]string
 	calls    int
+	last     *authv1.GetWebSessionRequest
 }
 
 func (f *fakeSessionClient) GetWebSession(_ context.Context, req *authv1.GetWebSessionRequest, _ ...grpc.CallOption) (*authv1.GetWebSessionResponse, error) {
 	f.calls++
+	f.last = req
 	userID, ok := f.sessions[req.GetSessionId()]
 	if !ok {
 		return nil, errors.New("session not found")
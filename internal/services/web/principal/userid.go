@@ -1,11 +1,11 @@
 package principal
 
 import (
-	"context"
 	"net/http"
 	"strings"
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/sessioncookie"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/userid"
 )
@@ -29,8 +29,10 @@ func (r Resolver) ResolveUserID(request *http.Request) string {
 }
 
 // resolveSessionUserID validates the session cookie value and normalizes the
-// resulting user id for downstream browser use.
-func (r authResolver) resolveSessionUserID(ctx context.Context, sessionID string) (string, bool) {
+// resulting user id for downstream browser use. The request's device details
+// ride along so auth can keep the account security center's last-seen data
+// current.
+func (r authResolver) resolveSessionUserID(request *http.Request, sessionID string) (string, bool) {
 	if r.sessionClient == nil {
 		return "", false
 	}
@@ -38,7 +40,11 @@ func (r authResolver) resolveSessionUserID(ctx context.Context, sessionID string
 	if sessionID == "" {
 		return "", false
 	}
-	resp, err := r.sessionClient.GetWebSession(ctx, &authv1.GetWebSessionRequest{SessionId: sessionID})
+	resp, err := r.sessionClient.GetWebSession(request.Context(), &authv1.GetWebSessionRequest{
+		SessionId: sessionID,
+		UserAgent: request.UserAgent(),
+		ClientIp:  ratelimit.ClientIP(request, r.trustForwardedFor),
+	})
 	if err != nil || resp == nil || resp.GetSession() == nil {
 		return "", false
 	}
@@ -58,7 +64,7 @@ func (r Resolver) resolveUserIDUncached(request *http.Request) string {
 	if !ok {
 		return ""
 	}
-	userID, ok := r.auth.resolveSessionUserID(request.Context(), sessionID)
+	userID, ok := r.auth.resolveSessionUserID(request, sessionID)
 	if !ok {
 		return ""
 	}
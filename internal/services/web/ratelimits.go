@@ -0,0 +1,24 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
+)
+
+// RateLimits are the web edge throttling defaults. Anonymous passkey
+// ceremonies are budgeted per client IP because no user exists yet; signed-in
+// passkey enrollment is budgeted per user.
+var RateLimits = ratelimit.Rules{
+	ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyLoginStart):                 {Key: ratelimit.KeyIP, Limit: 20, Period: time.Minute, Burst: 10},
+	ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyLoginFinish):                {Key: ratelimit.KeyIP, Limit: 20, Period: time.Minute, Burst: 10},
+	ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyRegisterCheck):              {Key: ratelimit.KeyIP, Limit: 60, Period: time.Minute, Burst: 20},
+	ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyRegisterStart):              {Key: ratelimit.KeyIP, Limit: 10, Period: time.Hour, Burst: 5},
+	ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyRegisterFinish):             {Key: ratelimit.KeyIP, Limit: 10, Period: time.Hour, Burst: 5},
+	ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyRecoveryStart):              {Key: ratelimit.KeyIP, Limit: 10, Period: time.Hour, Burst: 5},
+	ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyRecoveryFinish):             {Key: ratelimit.KeyIP, Limit: 10, Period: time.Hour, Burst: 5},
+	ratelimit.HTTPRule(http.MethodPost, routepath.AppSettingsSecurityPasskeysStart):  {Key: ratelimit.KeyUser, Limit: 10, Period: time.Hour, Burst: 5},
+	ratelimit.HTTPRule(http.MethodPost, routepath.AppSettingsSecurityPasskeysFinish): {Key: ratelimit.KeyUser, Limit: 10, Period: time.Hour, Burst: 5},
+}
@@ -5,6 +5,16 @@ const (
 	AppCampaignInviteSearchPattern = CampaignsPrefix + "{campaignID}/invites/search"
 	AppCampaignInviteCreatePattern = CampaignsPrefix + "{campaignID}/invites/create"
 	AppCampaignInviteRevokePattern = CampaignsPrefix + "{campaignID}/invites/revoke"
+
+	AppCampaignInviteLinkCreatePattern  = CampaignsPrefix + "{campaignID}/invites/links/create"
+	AppCampaignInviteLinkRevokePattern  = CampaignsPrefix + "{campaignID}/invites/links/revoke"
+	AppCampaignInviteLinkApprovePattern = CampaignsPrefix + "{campaignID}/invites/links/claims/approve"
+	AppCampaignInviteLinkDeclinePattern = CampaignsPrefix + "{campaignID}/invites/links/claims/decline"
+
+	AppCampaignListingPublishPattern     = CampaignsPrefix + "{campaignID}/invites/listing/publish"
+	AppCampaignListingClosePattern       = CampaignsPrefix + "{campaignID}/invites/listing/close"
+	AppCampaignApplicationAcceptPattern  = CampaignsPrefix + "{campaignID}/invites/applications/accept"
+	AppCampaignApplicationDeclinePattern = CampaignsPrefix + "{campaignID}/invites/applications/decline"
 )
 
 // AppCampaignInvites returns the campaign invites route.
@@ -26,3 +36,43 @@ func AppCampaignInviteSearch(campaignID string) string {
 func AppCampaignInviteRevoke(campaignID string) string {
 	return AppCampaignInvites(campaignID) + "/revoke"
 }
+
+// AppCampaignInviteLinkCreate returns the campaign invite-link create route.
+func AppCampaignInviteLinkCreate(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/links/create"
+}
+
+// AppCampaignInviteLinkRevoke returns the campaign invite-link revoke route.
+func AppCampaignInviteLinkRevoke(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/links/revoke"
+}
+
+// AppCampaignInviteLinkApprove returns the invite-link claim approval route.
+func AppCampaignInviteLinkApprove(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/links/claims/approve"
+}
+
+// AppCampaignInviteLinkDecline returns the invite-link claim decline route.
+func AppCampaignInviteLinkDecline(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/links/claims/decline"
+}
+
+// AppCampaignListingPublish returns the open-table listing publish route.
+func AppCampaignListingPublish(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/listing/publish"
+}
+
+// AppCampaignListingClose returns the open-table listing close route.
+func AppCampaignListingClose(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/listing/close"
+}
+
+// AppCampaignApplicationAccept returns the campaign application accept route.
+func AppCampaignApplicationAccept(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/applications/accept"
+}
+
+// AppCampaignApplicationDecline returns the campaign application decline route.
+func AppCampaignApplicationDecline(campaignID string) string {
+	return AppCampaignInvites(campaignID) + "/applications/decline"
+}
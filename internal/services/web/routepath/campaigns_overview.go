@@ -1,8 +1,16 @@
 package routepath
 
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
 const (
-	AppCampaignAIBindingPattern = CampaignsPrefix + "{campaignID}/ai-binding"
-	AppCampaignGamePattern      = CampaignsPrefix + "{campaignID}/game"
+	AppCampaignAIBindingPattern          = CampaignsPrefix + "{campaignID}/ai-binding"
+	AppCampaignAIArtifactsPattern        = CampaignsPrefix + "{campaignID}/ai-artifacts"
+	AppCampaignAIArtifactsRestorePattern = CampaignsPrefix + "{campaignID}/ai-artifacts/restore"
+	AppCampaignGamePattern               = CampaignsPrefix + "{campaignID}/game"
 )
 
 // AppCampaignAIBinding returns the campaign AI-binding page and submit route.
@@ -10,6 +18,39 @@ func AppCampaignAIBinding(campaignID string) string {
 	return AppCampaign(campaignID) + "/ai-binding"
 }
 
+// AppCampaignAIArtifacts returns the campaign AI artifact history route.
+func AppCampaignAIArtifacts(campaignID string) string {
+	return AppCampaign(campaignID) + "/ai-artifacts"
+}
+
+// AppCampaignAIArtifactHistory returns the AI artifact history route focused
+// on one artifact path.
+func AppCampaignAIArtifactHistory(campaignID string, path string) string {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return AppCampaignAIArtifacts(campaignID)
+	}
+	return AppCampaignAIArtifacts(campaignID) + "?" + url.Values{"path": {path}}.Encode()
+}
+
+// AppCampaignAIArtifactDiff returns the AI artifact history route comparing
+// two revisions of one artifact. A zero to revision compares against latest.
+func AppCampaignAIArtifactDiff(campaignID string, path string, from int, to int) string {
+	values := url.Values{
+		"path": {strings.TrimSpace(path)},
+		"from": {strconv.Itoa(from)},
+	}
+	if to > 0 {
+		values.Set("to", strconv.Itoa(to))
+	}
+	return AppCampaignAIArtifacts(campaignID) + "?" + values.Encode()
+}
+
+// AppCampaignAIArtifactsRestore returns the AI artifact rollback submit route.
+func AppCampaignAIArtifactsRestore(campaignID string) string {
+	return AppCampaignAIArtifacts(campaignID) + "/restore"
+}
+
 // AppCampaignGame returns the campaign game route.
 func AppCampaignGame(campaignID string) string {
 	return AppCampaign(campaignID) + "/game"
@@ -5,6 +5,9 @@ const (
 	AppCampaignSessionCreatePattern = CampaignsPrefix + "{campaignID}/sessions/create"
 	AppCampaignSessionPattern       = CampaignsPrefix + "{campaignID}/sessions/{sessionID}"
 	AppCampaignSessionEndPattern    = CampaignsPrefix + "{campaignID}/sessions/end"
+
+	AppCampaignSessionRecapPattern        = CampaignsPrefix + "{campaignID}/sessions/{sessionID}/recap"
+	AppCampaignSessionRecapPublishPattern = CampaignsPrefix + "{campaignID}/sessions/{sessionID}/recap/publish"
 )
 
 // AppCampaignSessions returns the campaign sessions route.
@@ -26,3 +29,13 @@ func AppCampaignSessionEnd(campaignID string) string {
 func AppCampaignSession(campaignID string, sessionID string) string {
 	return AppCampaignSessions(campaignID) + "/" + escapeSegment(sessionID)
 }
+
+// AppCampaignSessionRecap returns the session recap draft save route.
+func AppCampaignSessionRecap(campaignID string, sessionID string) string {
+	return AppCampaignSession(campaignID, sessionID) + "/recap"
+}
+
+// AppCampaignSessionRecapPublish returns the session recap publish route.
+func AppCampaignSessionRecapPublish(campaignID string, sessionID string) string {
+	return AppCampaignSessionRecap(campaignID, sessionID) + "/publish"
+}
@@ -3,4 +3,33 @@ package routepath
 const (
 	Discover       = "/discover"
 	DiscoverPrefix = "/discover/"
+
+	DiscoverTablesPrefix      = DiscoverPrefix + "tables/"
+	DiscoverTablePattern      = DiscoverTablesPrefix + "{campaignID}"
+	DiscoverTableApplyPattern = DiscoverTablesPrefix + "{campaignID}/apply"
+
+	DiscoverPregensPrefix    = DiscoverPrefix + "pregens/"
+	DiscoverPregenPattern    = DiscoverPregensPrefix + "{entryID}"
+	DiscoverPregenUsePattern = DiscoverPregensPrefix + "{entryID}/use"
 )
+
+// DiscoverTable returns the public open-table listing route for one campaign.
+func DiscoverTable(campaignID string) string {
+	return DiscoverTablesPrefix + escapeSegment(campaignID)
+}
+
+// DiscoverTableApply returns the open-table application submit route.
+func DiscoverTableApply(campaignID string) string {
+	return DiscoverTable(campaignID) + "/apply"
+}
+
+// DiscoverPregen returns the public preview route for one pregenerated
+// character.
+func DiscoverPregen(entryID string) string {
+	return DiscoverPregensPrefix + escapeSegment(entryID)
+}
+
+// DiscoverPregenUse returns the "use this pregen" submit route.
+func DiscoverPregenUse(entryID string) string {
+	return DiscoverPregen(entryID) + "/use"
+}
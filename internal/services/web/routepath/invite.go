@@ -6,6 +6,9 @@ const (
 	PublicInviteRestPattern    = InvitePrefix + "{inviteID}/{rest...}"
 	PublicInviteAcceptPattern  = InvitePrefix + "{inviteID}/accept"
 	PublicInviteDeclinePattern = InvitePrefix + "{inviteID}/decline"
+
+	InviteLinkPrefix        = InvitePrefix + "links/"
+	PublicInviteLinkPattern = InviteLinkPrefix + "{linkID}/join"
 )
 
 // PublicInvite returns the public invite route.
@@ -22,3 +25,10 @@ func PublicInviteAccept(inviteID string) string {
 func PublicInviteDecline(inviteID string) string {
 	return PublicInvite(inviteID) + "/decline"
 }
+
+// PublicInviteLink returns the public shareable invite-link route. The
+// trailing segment keeps link URLs distinct from invite action routes; GET
+// renders the landing page and POST claims a seat.
+func PublicInviteLink(linkID string) string {
+	return InviteLinkPrefix + escapeSegment(linkID) + "/join"
+}
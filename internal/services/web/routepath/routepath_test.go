@@ -88,6 +88,24 @@ func TestCampaignRouteBuilders(t *testing.T) {
 	if got := AppCampaignGame("camp-1"); got != "/app/campaigns/camp-1/game" {
 		t.Fatalf("AppCampaignGame() = %q", got)
 	}
+	if got := AppCampaignAIArtifacts("camp-1"); got != "/app/campaigns/camp-1/ai-artifacts" {
+		t.Fatalf("AppCampaignAIArtifacts() = %q", got)
+	}
+	if got := AppCampaignAIArtifactHistory("camp-1", "working/notes.md"); got != "/app/campaigns/camp-1/ai-artifacts?path=working%2Fnotes.md" {
+		t.Fatalf("AppCampaignAIArtifactHistory() = %q", got)
+	}
+	if got := AppCampaignAIArtifactHistory("camp-1", " "); got != "/app/campaigns/camp-1/ai-artifacts" {
+		t.Fatalf("AppCampaignAIArtifactHistory(empty) = %q", got)
+	}
+	if got := AppCampaignAIArtifactDiff("camp-1", "memory.md", 2, 0); got != "/app/campaigns/camp-1/ai-artifacts?from=2&path=memory.md" {
+		t.Fatalf("AppCampaignAIArtifactDiff() = %q", got)
+	}
+	if got := AppCampaignAIArtifactDiff("camp-1", "memory.md", 2, 5); got != "/app/campaigns/camp-1/ai-artifacts?from=2&path=memory.md&to=5" {
+		t.Fatalf("AppCampaignAIArtifactDiff(to) = %q", got)
+	}
+	if got := AppCampaignAIArtifactsRestore("camp-1"); got != "/app/campaigns/camp-1/ai-artifacts/restore" {
+		t.Fatalf("AppCampaignAIArtifactsRestore() = %q", got)
+	}
 	if got := AppCampaignCharacter("camp-1", "char-1"); got != "/app/campaigns/camp-1/characters/char-1" {
 		t.Fatalf("AppCampaignCharacter() = %q", got)
 	}
@@ -118,6 +136,18 @@ func TestCampaignRouteBuilders(t *testing.T) {
 	if got := AppCampaignInviteRevoke("camp-1"); got != "/app/campaigns/camp-1/invites/revoke" {
 		t.Fatalf("AppCampaignInviteRevoke() = %q", got)
 	}
+	if got := AppCampaignInviteLinkCreate("camp-1"); got != "/app/campaigns/camp-1/invites/links/create" {
+		t.Fatalf("AppCampaignInviteLinkCreate() = %q", got)
+	}
+	if got := PublicInviteLink("link 1"); got != "/invite/links/link%201/join" {
+		t.Fatalf("PublicInviteLink() = %q", got)
+	}
+	if got := DiscoverTableApply("camp 1"); got != "/discover/tables/camp%201/apply" {
+		t.Fatalf("DiscoverTableApply() = %q", got)
+	}
+	if got := DiscoverPregenUse("pregen:vex-marrow"); got != "/discover/pregens/pregen:vex-marrow/use" {
+		t.Fatalf("DiscoverPregenUse() = %q", got)
+	}
 }
 
 func TestServeMuxPatternConstants(t *testing.T) {
@@ -221,6 +251,9 @@ func TestNotificationAndSettingsRouteBuilders(t *testing.T) {
 	if got := AppSettingsAIKeyRevoke("cred-1"); got != "/app/settings/ai-keys/cred-1/revoke" {
 		t.Fatalf("AppSettingsAIKeyRevoke() = %q", got)
 	}
+	if got := AppSettingsPrivacyExportDownload("export-1"); got != "/app/settings/privacy/exports/export-1/download" {
+		t.Fatalf("AppSettingsPrivacyExportDownload() = %q", got)
+	}
 }
 
 func TestRouteBuildersEscapeSegments(t *testing.T) {
@@ -277,6 +310,15 @@ func TestRouteBuildersEscapeSegments(t *testing.T) {
 	if got := AppSettingsAIKeyRevoke("cred/1"); got != "/app/settings/ai-keys/cred%2F1/revoke" {
 		t.Fatalf("AppSettingsAIKeyRevoke() escaped = %q", got)
 	}
+	if got := AppSettingsSecurityPasskeyRename("cred/1"); got != "/app/settings/security/passkeys/cred%2F1/rename" {
+		t.Fatalf("AppSettingsSecurityPasskeyRename() escaped = %q", got)
+	}
+	if got := AppSettingsSecurityPasskeyDelete("cred/1"); got != "/app/settings/security/passkeys/cred%2F1/delete" {
+		t.Fatalf("AppSettingsSecurityPasskeyDelete() escaped = %q", got)
+	}
+	if got := AppSettingsSecuritySessionRevoke("ws/1"); got != "/app/settings/security/sessions/ws%2F1/revoke" {
+		t.Fatalf("AppSettingsSecuritySessionRevoke() escaped = %q", got)
+	}
 	if got := AppNotificationOpen("note/1"); got != "/app/notifications/note%2F1/open" {
 		t.Fatalf("AppNotificationOpen() escaped = %q", got)
 	}
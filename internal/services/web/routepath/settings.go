@@ -1,20 +1,52 @@
 package routepath
 
 const (
-	AppSettings                       = "/app/settings"
-	SettingsPrefix                    = "/app/settings/"
-	AppSettingsProfile                = "/app/settings/profile"
-	AppSettingsLocale                 = "/app/settings/locale"
-	AppSettingsSecurity               = "/app/settings/security"
-	AppSettingsSecurityPasskeysStart  = "/app/settings/security/passkeys/start"
-	AppSettingsSecurityPasskeysFinish = "/app/settings/security/passkeys/finish"
-	AppSettingsAIKeys                 = "/app/settings/ai-keys"
-	AppSettingsAIAgents               = "/app/settings/ai-agents"
-	AppSettingsAIKeyRevokePattern     = SettingsPrefix + "ai-keys/{credentialID}/revoke"
-	AppSettingsAIAgentDeletePattern   = SettingsPrefix + "ai-agents/{agentID}/delete"
-	AppSettingsRestPattern            = SettingsPrefix + "{rest...}"
+	AppSettings                             = "/app/settings"
+	SettingsPrefix                          = "/app/settings/"
+	AppSettingsProfile                      = "/app/settings/profile"
+	AppSettingsLocale                       = "/app/settings/locale"
+	AppSettingsSecurity                     = "/app/settings/security"
+	AppSettingsSecurityPasskeysStart        = "/app/settings/security/passkeys/start"
+	AppSettingsSecurityPasskeysFinish       = "/app/settings/security/passkeys/finish"
+	AppSettingsSecurityPasskeys             = "/app/settings/security/passkeys"
+	AppSettingsSecuritySessions             = "/app/settings/security/sessions"
+	AppSettingsSecuritySessionsRevokeOthers = "/app/settings/security/sessions/revoke-others"
+	AppSettingsSecurityRecoveryStart        = "/app/settings/security/recovery-code/start"
+	AppSettingsSecurityRecoveryFinish       = "/app/settings/security/recovery-code/finish"
+	AppSettingsPrivacy                      = "/app/settings/privacy"
+	AppSettingsPrivacyExports               = "/app/settings/privacy/exports"
+	AppSettingsPrivacyDelete                = "/app/settings/privacy/delete"
+	AppSettingsAIKeys                       = "/app/settings/ai-keys"
+	AppSettingsAIAgents                     = "/app/settings/ai-agents"
+	AppSettingsPrivacyExportDownloadPattern = SettingsPrefix + "privacy/exports/{exportID}/download"
+	AppSettingsSecurityPasskeyRenamePattern = SettingsPrefix + "security/passkeys/{credentialID}/rename"
+	AppSettingsSecurityPasskeyDeletePattern = SettingsPrefix + "security/passkeys/{credentialID}/delete"
+	AppSettingsSecuritySessionRevokePattern = SettingsPrefix + "security/sessions/{sessionID}/revoke"
+	AppSettingsAIKeyRevokePattern           = SettingsPrefix + "ai-keys/{credentialID}/revoke"
+	AppSettingsAIAgentDeletePattern         = SettingsPrefix + "ai-agents/{agentID}/delete"
+	AppSettingsRestPattern                  = SettingsPrefix + "{rest...}"
 )
 
+// AppSettingsPrivacyExportDownload returns the account export download route.
+func AppSettingsPrivacyExportDownload(exportID string) string {
+	return AppSettingsPrivacyExports + "/" + escapeSegment(exportID) + "/download"
+}
+
+// AppSettingsSecurityPasskeyRename returns the passkey rename route.
+func AppSettingsSecurityPasskeyRename(credentialID string) string {
+	return AppSettingsSecurityPasskeys + "/" + escapeSegment(credentialID) + "/rename"
+}
+
+// AppSettingsSecurityPasskeyDelete returns the passkey delete route.
+func AppSettingsSecurityPasskeyDelete(credentialID string) string {
+	return AppSettingsSecurityPasskeys + "/" + escapeSegment(credentialID) + "/delete"
+}
+
+// AppSettingsSecuritySessionRevoke returns the web session revoke route.
+func AppSettingsSecuritySessionRevoke(sessionID string) string {
+	return AppSettingsSecuritySessions + "/" + escapeSegment(sessionID) + "/revoke"
+}
+
 // AppSettingsAIKeyRevoke returns the AI key revoke route.
 func AppSettingsAIKeyRevoke(credentialID string) string {
 	return AppSettingsAIKeys + "/" + escapeSegment(credentialID) + "/revoke"
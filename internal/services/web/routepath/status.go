@@ -0,0 +1,6 @@
+package routepath
+
+const (
+	Status       = "/status"
+	StatusPrefix = "/status/"
+)
@@ -78,6 +78,7 @@ func TestRoutepathPackageStaysSplitByOwnedSurface(t *testing.T) {
 		"routepath/dashboard.go",
 		"routepath/notifications.go",
 		"routepath/settings.go",
+		"routepath/status.go",
 	} {
 		if _, err := os.Stat(path); err != nil {
 			t.Fatalf("routepath ownership file %s missing: %v", path, err)
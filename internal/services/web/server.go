@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	"github.com/louisbranch/fracturing.space/internal/platform/timeouts"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
 	sharedhttpx "github.com/louisbranch/fracturing.space/internal/services/shared/httpx"
 	"github.com/louisbranch/fracturing.space/internal/services/shared/playlaunchgrant"
 	websupport "github.com/louisbranch/fracturing.space/internal/services/shared/websupport"
@@ -30,6 +32,14 @@ type Config struct {
 	// RequestSchemePolicy controls scheme resolution for proxy headers.
 	RequestSchemePolicy requestmeta.SchemePolicy
 
+	// RateLimiter throttles edge routes listed in RateLimits. Nil disables
+	// limiting.
+	RateLimiter *ratelimit.Limiter
+
+	// TrustForwardedFor keys IP rate limits by X-Forwarded-For. Enable only
+	// behind a proxy that overwrites the header.
+	TrustForwardedFor bool
+
 	// PlayLaunchGrant signs web-to-play handoff grants for the game route.
 	PlayLaunchGrant playlaunchgrant.Config
 
@@ -60,7 +70,9 @@ func composeHandler(cfg Config, deps DependencyBundle) (http.Handler, error) {
 		logger = slog.Default()
 	}
 
-	principalResolver := principal.New(deps.Principal)
+	principalDeps := deps.Principal
+	principalDeps.TrustForwardedFor = cfg.TrustForwardedFor
+	principalResolver := principal.New(principalDeps)
 	h, err := composeAppHandler(principalResolver, logger, cfg, deps)
 	if err != nil {
 		return nil, err
@@ -73,9 +85,25 @@ func composeHandler(cfg Config, deps DependencyBundle) (http.Handler, error) {
 		sharedhttpx.RequestID("web"),
 		principalResolver.Middleware(),
 		observability.RequestLogger(logger),
+		cfg.RateLimiter.Middleware(ratelimit.HTTPOptions{
+			UserID:            principalResolver.ResolveUserID,
+			TrustForwardedFor: cfg.TrustForwardedFor,
+		}),
+		forwardClientIP(cfg.TrustForwardedFor),
 	), nil
 }
 
+// forwardClientIP carries the browser address into outgoing gRPC metadata so
+// backend IP rate limits bucket per browser instead of per web replica.
+func forwardClientIP(trustForwardedFor bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := grpcauthctx.WithClientIP(r.Context(), ratelimit.ClientIP(r, trustForwardedFor))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
 // composeAppHandler builds the web app handler by assembling the module
 // registry and passing the resulting module sets to the root mux composer.
 // This replaces the former composition/ package indirection.
@@ -7,11 +7,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/louisbranch/fracturing.space/internal/platform/grpcmeta"
 	websupport "github.com/louisbranch/fracturing.space/internal/services/shared/websupport"
 	module "github.com/louisbranch/fracturing.space/internal/services/web/module"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules"
 	"github.com/louisbranch/fracturing.space/internal/services/web/platform/requestmeta"
 	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestComposeAppHandlerBuildsRegistryInputAndRoutes(t *testing.T) {
@@ -193,3 +195,21 @@ func (s stubModule) ID() string {
 func (s stubModule) Mount() (module.Mount, error) {
 	return s.mount, s.err
 }
+
+func TestForwardClientIPAddsOutgoingMetadata(t *testing.T) {
+	t.Parallel()
+
+	var got string
+	handler := forwardClientIP(true)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		md, _ := metadata.FromOutgoingContext(r.Context())
+		got = grpcmeta.FirstMetadataValue(md, grpcmeta.ClientIPHeader)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "172.18.0.2:443"
+	req.Header.Set("X-Forwarded-For", "203.0.113.5, 172.18.0.2")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got != "203.0.113.5" {
+		t.Fatalf("client ip metadata = %q, want %q", got, "203.0.113.5")
+	}
+}
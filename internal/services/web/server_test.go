@@ -10,10 +10,12 @@ import (
 	"time"
 
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/ratelimit"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/discovery"
 	"github.com/louisbranch/fracturing.space/internal/services/web/modules/publicauth"
 	"github.com/louisbranch/fracturing.space/internal/services/web/principal"
+	"github.com/louisbranch/fracturing.space/internal/services/web/routepath"
 )
 
 func TestNewServerRequiresHTTPAddr(t *testing.T) {
@@ -234,6 +236,36 @@ func TestNewHandlerAddsRequestIDHeader(t *testing.T) {
 	}
 }
 
+func TestNewHandlerRateLimitsPasskeyLoginByClientIP(t *testing.T) {
+	t.Parallel()
+
+	h, err := newTestHandler(Config{
+		RateLimiter: ratelimit.New(ratelimit.NewMemoryStore(), ratelimit.Rules{
+			ratelimit.HTTPRule(http.MethodPost, routepath.PasskeyLoginStart): {Key: ratelimit.KeyIP, Limit: 1, Period: time.Minute},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, routepath.PasskeyLoginStart, strings.NewReader("{}"))
+		req.RemoteAddr = "203.0.113.7:5555"
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		return rr
+	}
+	if rr := serve(); rr.Code == http.StatusTooManyRequests {
+		t.Fatalf("first status = %d, want request to reach the route", rr.Code)
+	}
+	rr := serve()
+	if rr.Code != http.StatusTooManyRequests {
+		t.Fatalf("second status = %d, want %d", rr.Code, http.StatusTooManyRequests)
+	}
+	if rr.Header().Get("Retry-After") == "" || rr.Header().Get("X-Request-ID") == "" {
+		t.Fatalf("headers = %v, want Retry-After and request id", rr.Header())
+	}
+}
+
 func TestNewHandlerUsesConfiguredCampaignClient(t *testing.T) {
 	t.Parallel()
 
@@ -84,6 +84,34 @@ func (f *fakeWebAuthClient) FinishPasskeyRegistration(context.Context, *authv1.F
 	return &authv1.FinishPasskeyRegistrationResponse{User: &authv1.User{Id: "user-1", Username: "louis"}}, nil
 }
 
+func (f *fakeWebAuthClient) RenamePasskey(context.Context, *authv1.RenamePasskeyRequest, ...grpc.CallOption) (*authv1.RenamePasskeyResponse, error) {
+	return &authv1.RenamePasskeyResponse{}, nil
+}
+
+func (f *fakeWebAuthClient) DeletePasskey(context.Context, *authv1.DeletePasskeyRequest, ...grpc.CallOption) (*authv1.DeletePasskeyResponse, error) {
+	return &authv1.DeletePasskeyResponse{}, nil
+}
+
+func (f *fakeWebAuthClient) ListWebSessions(context.Context, *authv1.ListWebSessionsRequest, ...grpc.CallOption) (*authv1.ListWebSessionsResponse, error) {
+	return &authv1.ListWebSessionsResponse{}, nil
+}
+
+func (f *fakeWebAuthClient) RevokeUserWebSession(context.Context, *authv1.RevokeUserWebSessionRequest, ...grpc.CallOption) (*authv1.RevokeUserWebSessionResponse, error) {
+	return &authv1.RevokeUserWebSessionResponse{}, nil
+}
+
+func (f *fakeWebAuthClient) RevokeOtherWebSessions(context.Context, *authv1.RevokeOtherWebSessionsRequest, ...grpc.CallOption) (*authv1.RevokeOtherWebSessionsResponse, error) {
+	return &authv1.RevokeOtherWebSessionsResponse{}, nil
+}
+
+func (f *fakeWebAuthClient) BeginRecoveryCodeRotation(context.Context, *authv1.BeginRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.BeginRecoveryCodeRotationResponse, error) {
+	return &authv1.BeginRecoveryCodeRotationResponse{}, nil
+}
+
+func (f *fakeWebAuthClient) FinishRecoveryCodeRotation(context.Context, *authv1.FinishRecoveryCodeRotationRequest, ...grpc.CallOption) (*authv1.FinishRecoveryCodeRotationResponse, error) {
+	return &authv1.FinishRecoveryCodeRotationResponse{}, nil
+}
+
 func (f *fakeWebAuthClient) FinishPasskeyLogin(context.Context, *authv1.FinishPasskeyLoginRequest, ...grpc.CallOption) (*authv1.FinishPasskeyLoginResponse, error) {
 	return &authv1.FinishPasskeyLoginResponse{User: &authv1.User{Id: "user-1", Username: "louis"}}, nil
 }
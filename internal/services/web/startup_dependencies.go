@@ -255,7 +255,7 @@ var startupDependencyDescriptors = []StartupDependencyDescriptor{
 		Name:               DependencyNameStatus,
 		Policy:             StartupDependencyOptional,
 		Capability:         "web.status.integration",
-		Surfaces:           []string{"dashboard.health"},
+		Surfaces:           []string{"dashboard.health", "status"},
 		AddressField:       "StatusAddr",
 		DefaultGRPCService: serviceaddr.ServiceStatus,
 		Bind:               BindStatusDependency,
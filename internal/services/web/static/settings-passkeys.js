@@ -57,19 +57,41 @@
     return publicKey;
   }
 
+  function normalizeRequestOptions(options) {
+    var publicKey = options;
+    publicKey.challenge = base64UrlToBuffer(publicKey.challenge);
+    if (Array.isArray(publicKey.allowCredentials)) {
+      publicKey.allowCredentials = publicKey.allowCredentials.map(function (cred) {
+        return {
+          id: base64UrlToBuffer(cred.id),
+          transports: cred.transports,
+          type: cred.type
+        };
+      });
+    }
+    return publicKey;
+  }
+
   function credentialToJSON(credential) {
     if (!credential) {
       return null;
     }
-    return {
+    var response = credential.response;
+    var data = {
       id: credential.id,
       rawId: bufferToBase64Url(credential.rawId),
-      type: credential.type,
-      response: {
-        clientDataJSON: bufferToBase64Url(credential.response.clientDataJSON),
-        attestationObject: bufferToBase64Url(credential.response.attestationObject)
-      }
+      type: credential.type
     };
+    if (response) {
+      data.response = {
+        clientDataJSON: bufferToBase64Url(response.clientDataJSON),
+        attestationObject: response.attestationObject ? bufferToBase64Url(response.attestationObject) : undefined,
+        authenticatorData: response.authenticatorData ? bufferToBase64Url(response.authenticatorData) : undefined,
+        signature: response.signature ? bufferToBase64Url(response.signature) : undefined,
+        userHandle: response.userHandle ? bufferToBase64Url(response.userHandle) : undefined
+      };
+    }
+    return data;
   }
 
   async function readErrorMessage(response, fallback) {
@@ -102,10 +124,43 @@
   var startError = i18n.passkeyStartError || "Unable to start passkey registration.";
   var finishError = i18n.passkeyFinishError || "Unable to finish passkey registration.";
   var passkeyFailed = i18n.passkeyFailed || "Unable to add a passkey.";
+  var recoveryStartPath = i18n.recoveryStartPath || "";
+  var recoveryFinishPath = i18n.recoveryFinishPath || "";
+  var recoveryFailed = i18n.recoveryFailed || "Unable to rotate the recovery code.";
 
   var addButton = document.getElementById("settings-passkey-add");
+  var rotateButton = document.getElementById("settings-recovery-rotate");
+  var recoveryResult = document.getElementById("settings-recovery-result");
+  var recoveryCode = document.getElementById("settings-recovery-code");
   var errorEl = document.getElementById("settings-security-error");
 
+  if (rotateButton) {
+    rotateButton.addEventListener("click", async function () {
+      hide(errorEl);
+      hide(recoveryResult);
+      rotateButton.disabled = true;
+      try {
+        var start = await postJSON(recoveryStartPath, {}, recoveryFailed);
+        var publicKey = normalizeRequestOptions(start.public_key.publicKey);
+        var credential = await navigator.credentials.get({ publicKey: publicKey });
+        var finish = await postJSON(recoveryFinishPath, {
+          session_id: start.session_id,
+          credential: credentialToJSON(credential)
+        }, recoveryFailed);
+        if (recoveryCode) {
+          recoveryCode.textContent = finish.recovery_code || "";
+        }
+        if (recoveryResult) {
+          recoveryResult.hidden = false;
+        }
+      } catch (err) {
+        show(errorEl, err.message || recoveryFailed);
+      } finally {
+        rotateButton.disabled = false;
+      }
+    });
+  }
+
   if (!addButton) {
     return;
   }
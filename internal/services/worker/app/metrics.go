@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	platformotel "github.com/louisbranch/fracturing.space/internal/platform/otel"
+	workerdomain "github.com/louisbranch/fracturing.space/internal/services/worker/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/louisbranch/fracturing.space/internal/services/worker/app"
+
+var (
+	eventDuration = platformotel.Instrument(otel.Meter(meterName).Float64Histogram(
+		"fracturing.worker.event.duration",
+		metric.WithDescription("Time spent handling one leased outbox event."),
+		metric.WithUnit("s"),
+	))
+	eventsLeased = platformotel.Instrument(otel.Meter(meterName).Int64Counter(
+		"fracturing.worker.events.leased",
+		metric.WithDescription("Outbox events leased per source."),
+		metric.WithUnit("{event}"),
+	))
+)
+
+func (s *Server) recordLeased(ctx context.Context, count int) {
+	if count == 0 {
+		return
+	}
+	eventsLeased.Add(ctx, int64(count), metric.WithAttributes(attribute.String("source", s.name)))
+}
+
+// recordEvent records one handled event with its ack outcome. Event types come
+// from the handler map, so the attribute set stays bounded.
+func (s *Server) recordEvent(ctx context.Context, eventType string, outcome workerdomain.AckOutcome, started time.Time) {
+	eventDuration.Record(ctx, s.clock().Sub(started).Seconds(), metric.WithAttributes(
+		attribute.String("source", s.name),
+		attribute.String("event.type", eventType),
+		attribute.String("outcome", outcome.String()),
+	))
+}
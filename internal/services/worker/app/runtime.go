@@ -17,6 +17,7 @@ import (
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
 	notificationsv1 "github.com/louisbranch/fracturing.space/api/gen/go/notifications/v1"
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
 	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
 	platformgrpc "github.com/louisbranch/fracturing.space/internal/platform/grpc"
 	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
@@ -38,6 +39,7 @@ type RuntimeConfig struct {
 	InviteAddr        string
 	NotificationsAddr string
 	SocialAddr        string
+	PlayAddr          string
 	DBPath            string
 	Consumer          string
 	PollInterval      time.Duration
@@ -45,6 +47,9 @@ type RuntimeConfig struct {
 	MaxAttempts       int
 	RetryBackoff      time.Duration
 	RetryMaxDelay     time.Duration
+	// WebhookAllowLoopback lets webhook deliveries reach loopback receivers
+	// for local development.
+	WebhookAllowLoopback bool
 }
 
 const (
@@ -113,6 +118,7 @@ type Runtime struct {
 	inviteMc        *platformgrpc.ManagedConn
 	notificationsMc *platformgrpc.ManagedConn
 	socialMc        *platformgrpc.ManagedConn
+	playMc          *platformgrpc.ManagedConn
 
 	closeOnce sync.Once
 }
@@ -236,9 +242,30 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 		return nil, fmt.Errorf("worker: managed conn invite: %w", err)
 	}
 
+	var playMc *platformgrpc.ManagedConn
+	if normalized.PlayAddr != "" {
+		playMc, err = deps.newManagedConn(ctx, platformgrpc.ManagedConnConfig{
+			Name: "play",
+			Addr: normalized.PlayAddr,
+			Mode: platformgrpc.ModeOptional,
+			Logf: deps.logf,
+		})
+		if err != nil {
+			closeManagedConn(inviteMc, "invite")
+			closeManagedConn(socialMc, "social")
+			closeManagedConn(notificationsMc, "notifications")
+			closeManagedConn(gameMc, "game")
+			closeManagedConn(aiMc, "ai")
+			closeManagedConn(authMc, "auth")
+			closeWorkerStore(workerStore)
+			return nil, fmt.Errorf("worker: managed conn play: %w", err)
+		}
+	}
+
 	authClient := authv1.NewAuthServiceClient(authMc.Conn())
 	socialClient := socialv1.NewSocialServiceClient(socialMc.Conn())
 	if err := syncSocialUserDirectory(ctx, authClient, socialClient); err != nil {
+		closeManagedConn(playMc, "play")
 		closeManagedConn(inviteMc, "invite")
 		closeManagedConn(socialMc, "social")
 		closeManagedConn(notificationsMc, "notifications")
@@ -256,6 +283,10 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 	gameParticipantClient := gamev1.NewParticipantServiceClient(gameMc.Conn())
 	gameCampaignAIOrchestrationClient := gamev1.NewCampaignAIOrchestrationServiceClient(gameMc.Conn())
 	notificationsClient := notificationsv1.NewNotificationServiceClient(notificationsMc.Conn())
+	var transcriptClient playv1.TranscriptServiceClient
+	if playMc != nil {
+		transcriptClient = playv1.NewTranscriptServiceClient(playMc.Conn())
+	}
 
 	signupProfileHandler := workerdomain.NewSignupSocialProfileHandler(socialClient)
 	signupDirectoryHandler := workerdomain.NewSignupSocialDirectoryHandler(socialClient)
@@ -263,7 +294,26 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 	inviteCreatedHandler := workerdomain.NewInviteCreatedNotificationHandler(inviteClient, gameCampaignClient, gameParticipantClient, authClient, notificationsClient)
 	inviteAcceptedHandler := workerdomain.NewInviteAcceptedNotificationHandler(inviteClient, gameCampaignClient, gameParticipantClient, authClient, notificationsClient)
 	inviteDeclinedHandler := workerdomain.NewInviteDeclinedNotificationHandler(inviteClient, gameCampaignClient, gameParticipantClient, authClient, notificationsClient)
+	applicationSubmittedHandler := workerdomain.NewApplicationSubmittedNotificationHandler(gameCampaignClient, gameParticipantClient, authClient, notificationsClient)
+	applicationDeclinedHandler := workerdomain.NewApplicationDeclinedNotificationHandler(gameCampaignClient, notificationsClient)
 	aiGMTurnRequestedHandler := workerdomain.NewAIGMTurnRequestedHandler(gameCampaignAIOrchestrationClient, gameCampaignAIClient, aiCampaignClient)
+	aiPlayerTurnRequestedHandler := workerdomain.NewAIPlayerTurnRequestedHandler(gameCampaignAIOrchestrationClient, gameCampaignAIClient, aiCampaignClient, workerdomain.DefaultAIPlayerTurnMinInterval, nil)
+	sessionRecapDraftHandler := workerdomain.NewSessionRecapDraftRequestedHandler(gameCampaignAIOrchestrationClient, transcriptClient, aiv1.NewSessionRecapServiceClient(aiMc.Conn()))
+	sessionRecapPublishedHandler := workerdomain.NewSessionRecapPublishedHandler(gameCampaignClient, gameParticipantClient, notificationsClient)
+	campaignModerationHandler := workerdomain.NewCampaignModerationNotificationHandler(gameCampaignClient, gameParticipantClient, notificationsClient)
+	userSuspensionHandler := workerdomain.NewUserSuspensionNotificationHandler(notificationsClient)
+	accountDataClients := workerdomain.AccountDataClients{
+		Social:        socialClient,
+		Notifications: notificationsClient,
+		Invite:        inviteClient,
+		AI:            aiv1.NewAccountDataServiceClient(aiMc.Conn()),
+		Game:          gameParticipantClient,
+	}
+	if transcriptClient != nil {
+		accountDataClients.Play = transcriptClient
+	}
+	accountExportHandler := workerdomain.NewAccountExportRequestedHandler(authv1.NewAccountServiceClient(authMc.Conn()), accountDataClients, nil)
+	accountDeletedHandler := workerdomain.NewAccountDeletedHandler(accountDataClients)
 
 	loopConfig := Config{
 		Consumer:      normalized.Consumer,
@@ -275,13 +325,23 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 	}
 	normalizedLoopConfig := loopConfig.normalized()
 	recorder := newAttemptStoreRecorder(workerStore, normalizedLoopConfig.Consumer)
+	webhookDeliveryHandler := workerdomain.NewWebhookDeliveryHandler(
+		gamev1.NewWebhookServiceClient(gameMc.Conn()),
+		workerdomain.NewWebhookHTTPClient(normalized.WebhookAllowLoopback),
+		normalizedLoopConfig.MaxAttempts,
+		nil,
+	)
 
 	authLoop := New(
 		"auth",
 		newAuthOutboxClientAdapter(authClient),
 		recorder,
 		map[string]EventHandler{
-			"auth.signup_completed": signupHandler,
+			"auth.signup_completed":                            signupHandler,
+			workerdomain.AccountExportRequestedOutboxEventType: accountExportHandler,
+			workerdomain.AccountDeletedOutboxEventType:         accountDeletedHandler,
+			workerdomain.UserSuspendedOutboxEventType:          userSuspensionHandler,
+			workerdomain.UserUnsuspendedOutboxEventType:        userSuspensionHandler,
 		},
 		normalizedLoopConfig,
 		nil,
@@ -291,7 +351,12 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 		newGameOutboxClientAdapter(gamev1.NewIntegrationServiceClient(gameMc.Conn())),
 		recorder,
 		map[string]EventHandler{
-			gameintegration.AIGMTurnRequestedOutboxEventType: aiGMTurnRequestedHandler,
+			gameintegration.AIGMTurnRequestedOutboxEventType:          aiGMTurnRequestedHandler,
+			gameintegration.AIPlayerTurnRequestedOutboxEventType:      aiPlayerTurnRequestedHandler,
+			gameintegration.SessionRecapDraftRequestedOutboxEventType: sessionRecapDraftHandler,
+			gameintegration.SessionRecapPublishedOutboxEventType:      sessionRecapPublishedHandler,
+			gameintegration.CampaignModerationChangedOutboxEventType:  campaignModerationHandler,
+			gameintegration.WebhookDeliveryRequestedOutboxEventType:   webhookDeliveryHandler,
 		},
 		normalizedLoopConfig,
 		nil,
@@ -304,6 +369,8 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 			workerdomain.InviteNotificationCreatedOutboxEventType:  inviteCreatedHandler,
 			workerdomain.InviteNotificationClaimedOutboxEventType:  inviteAcceptedHandler,
 			workerdomain.InviteNotificationDeclinedOutboxEventType: inviteDeclinedHandler,
+			workerdomain.ApplicationSubmittedOutboxEventType:       applicationSubmittedHandler,
+			workerdomain.ApplicationDeclinedOutboxEventType:        applicationDeclinedHandler,
 		},
 		normalizedLoopConfig,
 		nil,
@@ -311,6 +378,7 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 
 	listener, err := deps.listenTCP("tcp", fmt.Sprintf(":%d", normalized.Port))
 	if err != nil {
+		closeManagedConn(playMc, "play")
 		closeManagedConn(inviteMc, "invite")
 		closeManagedConn(socialMc, "social")
 		closeManagedConn(notificationsMc, "notifications")
@@ -339,6 +407,7 @@ func newRuntime(ctx context.Context, cfg RuntimeConfig, deps runtimeDependencies
 		inviteMc:        inviteMc,
 		notificationsMc: notificationsMc,
 		socialMc:        socialMc,
+		playMc:          playMc,
 	}, nil
 }
 
@@ -363,7 +432,7 @@ func syncSocialUserDirectory(ctx context.Context, authClient authDirectoryBootst
 			return err
 		}
 		for _, user := range resp.GetUsers() {
-			if user == nil {
+			if user == nil || user.GetDeletedAt() != nil {
 				continue
 			}
 			userID := strings.TrimSpace(user.GetId())
@@ -467,6 +536,7 @@ func (r *Runtime) Close() {
 				log.Printf("close worker listener: %v", err)
 			}
 		}
+		closeManagedConn(r.playMc, "play")
 		closeManagedConn(r.socialMc, "social")
 		closeManagedConn(r.inviteMc, "invite")
 		closeManagedConn(r.notificationsMc, "notifications")
@@ -484,6 +554,7 @@ func normalizeRuntimeConfig(cfg RuntimeConfig) (RuntimeConfig, error) {
 	cfg.InviteAddr = strings.TrimSpace(cfg.InviteAddr)
 	cfg.NotificationsAddr = strings.TrimSpace(cfg.NotificationsAddr)
 	cfg.SocialAddr = strings.TrimSpace(cfg.SocialAddr)
+	cfg.PlayAddr = strings.TrimSpace(cfg.PlayAddr)
 	if cfg.AuthAddr == "" {
 		return RuntimeConfig{}, fmt.Errorf("auth address is required")
 	}
@@ -12,6 +12,7 @@ import (
 	workerdomain "github.com/louisbranch/fracturing.space/internal/services/worker/domain"
 	workersqlite "github.com/louisbranch/fracturing.space/internal/services/worker/storage/sqlite"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestAttemptStoreRecorder_EmptyConsumerUsesDefault(t *testing.T) {
@@ -183,6 +184,25 @@ func TestSyncSocialUserDirectory_BackfillsAllUsers(t *testing.T) {
 	}
 }
 
+func TestSyncSocialUserDirectory_SkipsDeletedUsers(t *testing.T) {
+	authClient := &authDirectoryBootstrapClientStub{
+		responses: []*authv1.ListUsersResponse{
+			{Users: []*authv1.User{
+				{Id: "user-1", Username: "alpha"},
+				{Id: "user-2", Username: "deleted-user-2", DeletedAt: timestamppb.Now()},
+			}},
+		},
+	}
+	socialClient := &socialDirectoryBootstrapClientStub{}
+
+	if err := syncSocialUserDirectory(context.Background(), authClient, socialClient); err != nil {
+		t.Fatalf("sync social user directory: %v", err)
+	}
+	if len(socialClient.requests) != 1 || socialClient.requests[0].GetUserId() != "user-1" {
+		t.Fatalf("sync requests = %+v, want only user-1", socialClient.requests)
+	}
+}
+
 func TestSyncSocialUserDirectory_PropagatesSyncErrors(t *testing.T) {
 	authClient := &authDirectoryBootstrapClientStub{
 		responses: []*authv1.ListUsersResponse{
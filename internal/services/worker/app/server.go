@@ -174,6 +174,7 @@ func (s *Server) runOnce(ctx context.Context) {
 		log.Printf("worker: lease %s integration outbox events: %v", s.name, err)
 		return
 	}
+	s.recordLeased(ctx, len(events))
 	for _, outboxEvent := range events {
 		s.processEvent(ctx, outboxEvent)
 	}
@@ -263,6 +264,7 @@ func (s *Server) ack(ctx context.Context, outboxEvent workerdomain.OutboxEvent,
 }
 
 func (s *Server) ackWithObservation(ctx context.Context, outboxEvent workerdomain.OutboxEvent, outcome workerdomain.AckOutcome, now time.Time, nextAttemptAt time.Time, lastError string) {
+	s.recordEvent(ctx, outboxEvent.GetEventType(), outcome, now)
 	if err := s.ack(ctx, outboxEvent, outcome, now, nextAttemptAt, lastError); err != nil {
 		log.Printf("worker: ack %s integration outbox event id=%s outcome=%s: %v", s.name, outboxEvent.GetId(), outcome.String(), err)
 		s.sleepAckBackoff(ctx)
@@ -0,0 +1,353 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	notificationsv1 "github.com/louisbranch/fracturing.space/api/gen/go/notifications/v1"
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Auth account lifecycle outbox event types.
+const (
+	AccountExportRequestedOutboxEventType = "auth.account_export_requested"
+	AccountDeletedOutboxEventType         = "auth.account_deleted"
+)
+
+// maxAccountExportFailureLen keeps stored failure text to one short line.
+const maxAccountExportFailureLen = 500
+
+type accountExportAuthClient interface {
+	ExportUserData(ctx context.Context, in *authv1.ExportUserDataRequest, opts ...grpc.CallOption) (*authv1.ExportUserDataResponse, error)
+	CompleteAccountExport(ctx context.Context, in *authv1.CompleteAccountExportRequest, opts ...grpc.CallOption) (*authv1.CompleteAccountExportResponse, error)
+}
+
+// AccountDataClients groups every service that holds personal data for a user.
+// Play is optional: without it, transcripts are neither exported nor redacted.
+type AccountDataClients struct {
+	Social        AccountSocialDataClient
+	Notifications AccountNotificationDataClient
+	Invite        AccountInviteDataClient
+	AI            AccountAIDataClient
+	Game          AccountGameParticipantClient
+	Play          AccountTranscriptClient
+}
+
+// AccountSocialDataClient exports and erases social records.
+type AccountSocialDataClient interface {
+	ExportUserData(ctx context.Context, in *socialv1.ExportUserDataRequest, opts ...grpc.CallOption) (*socialv1.ExportUserDataResponse, error)
+	DeleteUserData(ctx context.Context, in *socialv1.DeleteUserDataRequest, opts ...grpc.CallOption) (*socialv1.DeleteUserDataResponse, error)
+}
+
+// AccountNotificationDataClient exports and erases inbox records.
+type AccountNotificationDataClient interface {
+	ExportUserData(ctx context.Context, in *notificationsv1.ExportUserDataRequest, opts ...grpc.CallOption) (*notificationsv1.ExportUserDataResponse, error)
+	DeleteUserData(ctx context.Context, in *notificationsv1.DeleteUserDataRequest, opts ...grpc.CallOption) (*notificationsv1.DeleteUserDataResponse, error)
+}
+
+// AccountInviteDataClient exports and releases invite records.
+type AccountInviteDataClient interface {
+	ExportUserData(ctx context.Context, in *invitev1.ExportUserDataRequest, opts ...grpc.CallOption) (*invitev1.ExportUserDataResponse, error)
+	DeleteUserData(ctx context.Context, in *invitev1.DeleteUserDataRequest, opts ...grpc.CallOption) (*invitev1.DeleteUserDataResponse, error)
+}
+
+// AccountAIDataClient exports and erases AI credentials and agents.
+type AccountAIDataClient interface {
+	ExportUserData(ctx context.Context, in *aiv1.ExportUserDataRequest, opts ...grpc.CallOption) (*aiv1.ExportUserDataResponse, error)
+	DeleteUserData(ctx context.Context, in *aiv1.DeleteUserDataRequest, opts ...grpc.CallOption) (*aiv1.DeleteUserDataResponse, error)
+}
+
+// AccountGameParticipantClient lists and anonymizes the user's seats.
+type AccountGameParticipantClient interface {
+	ListUserParticipants(ctx context.Context, in *gamev1.ListUserParticipantsRequest, opts ...grpc.CallOption) (*gamev1.ListUserParticipantsResponse, error)
+	AnonymizeUserParticipants(ctx context.Context, in *gamev1.AnonymizeUserParticipantsRequest, opts ...grpc.CallOption) (*gamev1.AnonymizeUserParticipantsResponse, error)
+}
+
+// AccountTranscriptClient exports and redacts chat messages by seat.
+type AccountTranscriptClient interface {
+	ExportParticipantMessages(ctx context.Context, in *playv1.ExportParticipantMessagesRequest, opts ...grpc.CallOption) (*playv1.ExportParticipantMessagesResponse, error)
+	RedactParticipantMessages(ctx context.Context, in *playv1.RedactParticipantMessagesRequest, opts ...grpc.CallOption) (*playv1.RedactParticipantMessagesResponse, error)
+}
+
+type accountExportPayload struct {
+	ExportID string `json:"export_id"`
+	UserID   string `json:"user_id"`
+}
+
+type accountDeletedPayload struct {
+	UserID string `json:"user_id"`
+}
+
+// AccountExportRequestedHandler gathers a user's records from every service
+// into one zip archive and hands it back to auth for download.
+//
+// Each service contributes one JSON file. A permanent failure is recorded on
+// the export so the user can request another; transient failures retry.
+type AccountExportRequestedHandler struct {
+	auth    accountExportAuthClient
+	clients AccountDataClients
+	clock   func() time.Time
+}
+
+// NewAccountExportRequestedHandler creates the account export handler.
+func NewAccountExportRequestedHandler(auth accountExportAuthClient, clients AccountDataClients, clock func() time.Time) *AccountExportRequestedHandler {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &AccountExportRequestedHandler{auth: auth, clients: clients, clock: clock}
+}
+
+func (h *AccountExportRequestedHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.auth == nil || !h.clients.complete() {
+		return Permanent(fmt.Errorf("account export handler dependencies are not configured"))
+	}
+	var payload accountExportPayload
+	if err := decodeAccountPayload(event, &payload, "account export requested"); err != nil {
+		return Permanent(err)
+	}
+	payload.ExportID = strings.TrimSpace(payload.ExportID)
+	payload.UserID = strings.TrimSpace(payload.UserID)
+	if payload.ExportID == "" || payload.UserID == "" {
+		return Permanent(fmt.Errorf("export_id and user_id are required in account export requested payload"))
+	}
+
+	callCtx := serviceContext(ctx)
+	archive, err := h.buildArchive(callCtx, payload)
+	if err != nil {
+		if !IsPermanent(err) {
+			return err
+		}
+		if _, failErr := h.auth.CompleteAccountExport(callCtx, &authv1.CompleteAccountExportRequest{
+			ExportId: payload.ExportID,
+			Failure:  truncateAccountExportFailure(err),
+		}); failErr != nil {
+			return classifyAccountDataError("record account export failure", failErr)
+		}
+		return nil
+	}
+	if _, err := h.auth.CompleteAccountExport(callCtx, &authv1.CompleteAccountExportRequest{
+		ExportId: payload.ExportID,
+		Archive:  archive,
+	}); err != nil {
+		return classifyAccountDataError("complete account export", err)
+	}
+	return nil
+}
+
+func (h *AccountExportRequestedHandler) buildArchive(ctx context.Context, payload accountExportPayload) ([]byte, error) {
+	userID := payload.UserID
+	files := []accountArchiveFile{}
+
+	authData, err := h.auth.ExportUserData(ctx, &authv1.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, classifyAccountDataError("export auth data", err)
+	}
+	files = append(files, accountArchiveFile{name: "auth.json", body: []byte(authData.GetRecordsJson())})
+
+	socialData, err := h.clients.Social.ExportUserData(ctx, &socialv1.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, classifyAccountDataError("export social data", err)
+	}
+	files = append(files, accountArchiveFile{name: "social.json", body: []byte(socialData.GetRecordsJson())})
+
+	notificationData, err := h.clients.Notifications.ExportUserData(ctx, &notificationsv1.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, classifyAccountDataError("export notification data", err)
+	}
+	files = append(files, accountArchiveFile{name: "notifications.json", body: []byte(notificationData.GetRecordsJson())})
+
+	inviteData, err := h.clients.Invite.ExportUserData(ctx, &invitev1.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, classifyAccountDataError("export invite data", err)
+	}
+	files = append(files, accountArchiveFile{name: "invites.json", body: []byte(inviteData.GetRecordsJson())})
+
+	aiData, err := h.clients.AI.ExportUserData(ctx, &aiv1.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, classifyAccountDataError("export ai data", err)
+	}
+	files = append(files, accountArchiveFile{name: "ai.json", body: []byte(aiData.GetRecordsJson())})
+
+	participants, err := h.clients.Game.ListUserParticipants(ctx, &gamev1.ListUserParticipantsRequest{UserId: userID})
+	if err != nil {
+		return nil, classifyAccountDataError("list user participants", err)
+	}
+	gameJSON, err := protojson.Marshal(participants)
+	if err != nil {
+		return nil, Permanent(fmt.Errorf("encode game participants: %w", err))
+	}
+	files = append(files, accountArchiveFile{name: "game.json", body: gameJSON})
+
+	if h.clients.Play != nil {
+		seats := participantSeats(participants.GetParticipants())
+		messages := &playv1.ExportParticipantMessagesResponse{}
+		if len(seats) > 0 {
+			messages, err = h.clients.Play.ExportParticipantMessages(ctx, &playv1.ExportParticipantMessagesRequest{Seats: seats})
+			if err != nil {
+				return nil, classifyAccountDataError("export chat messages", err)
+			}
+		}
+		playJSON, err := protojson.Marshal(messages)
+		if err != nil {
+			return nil, Permanent(fmt.Errorf("encode chat messages: %w", err))
+		}
+		files = append(files, accountArchiveFile{name: "chat.json", body: playJSON})
+	}
+
+	return writeAccountArchive(payload, h.clock().UTC(), files)
+}
+
+// AccountDeletedHandler erases a closed account's personal data outside auth.
+//
+// Game seats are anonymized rather than removed so campaign journals keep
+// their hash chains. Chat messages are redacted before seats are released,
+// because the seat list is what links messages to the user. Every step is
+// idempotent, so retries resume safely after a partial failure.
+type AccountDeletedHandler struct {
+	clients AccountDataClients
+}
+
+// NewAccountDeletedHandler creates the account deletion handler.
+func NewAccountDeletedHandler(clients AccountDataClients) *AccountDeletedHandler {
+	return &AccountDeletedHandler{clients: clients}
+}
+
+func (h *AccountDeletedHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || !h.clients.complete() {
+		return Permanent(fmt.Errorf("account deletion handler dependencies are not configured"))
+	}
+	var payload accountDeletedPayload
+	if err := decodeAccountPayload(event, &payload, "account deleted"); err != nil {
+		return Permanent(err)
+	}
+	userID := strings.TrimSpace(payload.UserID)
+	if userID == "" {
+		return Permanent(fmt.Errorf("user_id is required in account deleted payload"))
+	}
+
+	callCtx := serviceContext(ctx)
+	participants, err := h.clients.Game.ListUserParticipants(callCtx, &gamev1.ListUserParticipantsRequest{UserId: userID})
+	if err != nil {
+		return classifyAccountDataError("list user participants", err)
+	}
+	if seats := participantSeats(participants.GetParticipants()); len(seats) > 0 && h.clients.Play != nil {
+		if _, err := h.clients.Play.RedactParticipantMessages(callCtx, &playv1.RedactParticipantMessagesRequest{Seats: seats}); err != nil {
+			return classifyAccountDataError("redact chat messages", err)
+		}
+	}
+	if _, err := h.clients.Game.AnonymizeUserParticipants(callCtx, &gamev1.AnonymizeUserParticipantsRequest{UserId: userID}); err != nil {
+		return classifyAccountDataError("anonymize user participants", err)
+	}
+	if _, err := h.clients.Social.DeleteUserData(callCtx, &socialv1.DeleteUserDataRequest{UserId: userID}); err != nil {
+		return classifyAccountDataError("delete social data", err)
+	}
+	if _, err := h.clients.Notifications.DeleteUserData(callCtx, &notificationsv1.DeleteUserDataRequest{UserId: userID}); err != nil {
+		return classifyAccountDataError("delete notification data", err)
+	}
+	if _, err := h.clients.Invite.DeleteUserData(callCtx, &invitev1.DeleteUserDataRequest{UserId: userID}); err != nil {
+		return classifyAccountDataError("delete invite data", err)
+	}
+	if _, err := h.clients.AI.DeleteUserData(callCtx, &aiv1.DeleteUserDataRequest{UserId: userID}); err != nil {
+		return classifyAccountDataError("delete ai data", err)
+	}
+	return nil
+}
+
+func (c AccountDataClients) complete() bool {
+	return c.Social != nil && c.Notifications != nil && c.Invite != nil && c.AI != nil && c.Game != nil
+}
+
+type accountArchiveFile struct {
+	name string
+	body []byte
+}
+
+type accountArchiveManifest struct {
+	ExportID    string    `json:"export_id"`
+	UserID      string    `json:"user_id"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Files       []string  `json:"files"`
+}
+
+func writeAccountArchive(payload accountExportPayload, generatedAt time.Time, files []accountArchiveFile) ([]byte, error) {
+	manifest := accountArchiveManifest{
+		ExportID:    payload.ExportID,
+		UserID:      payload.UserID,
+		GeneratedAt: generatedAt,
+		Files:       make([]string, 0, len(files)),
+	}
+	for _, file := range files {
+		manifest.Files = append(manifest.Files, file.name)
+	}
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, Permanent(fmt.Errorf("encode archive manifest: %w", err))
+	}
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, file := range append([]accountArchiveFile{{name: "manifest.json", body: manifestJSON}}, files...) {
+		entry, err := writer.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: generatedAt})
+		if err != nil {
+			return nil, Permanent(fmt.Errorf("create archive entry %s: %w", file.name, err))
+		}
+		if _, err := entry.Write(file.body); err != nil {
+			return nil, Permanent(fmt.Errorf("write archive entry %s: %w", file.name, err))
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, Permanent(fmt.Errorf("close archive: %w", err))
+	}
+	return buf.Bytes(), nil
+}
+
+func participantSeats(participants []*gamev1.Participant) []*playv1.ParticipantSeat {
+	seats := make([]*playv1.ParticipantSeat, 0, len(participants))
+	for _, participant := range participants {
+		if participant.GetCampaignId() == "" || participant.GetId() == "" {
+			continue
+		}
+		seats = append(seats, &playv1.ParticipantSeat{
+			CampaignId:    participant.GetCampaignId(),
+			ParticipantId: participant.GetId(),
+		})
+	}
+	return seats
+}
+
+func decodeAccountPayload(event OutboxEvent, target any, label string) error {
+	if event == nil {
+		return fmt.Errorf("outbox event is required")
+	}
+	if err := json.Unmarshal([]byte(event.GetPayloadJson()), target); err != nil {
+		return fmt.Errorf("decode %s payload: %w", label, err)
+	}
+	return nil
+}
+
+func classifyAccountDataError(op string, err error) error {
+	if isPermanentGameReadError(err) {
+		return Permanent(fmt.Errorf("%s: %w", op, err))
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
+
+func truncateAccountExportFailure(err error) string {
+	value := strings.TrimSpace(err.Error())
+	if len(value) > maxAccountExportFailureLen {
+		value = strings.TrimSpace(value[:maxAccountExportFailureLen])
+	}
+	return value
+}
@@ -0,0 +1,247 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	invitev1 "github.com/louisbranch/fracturing.space/api/gen/go/invite/v1"
+	notificationsv1 "github.com/louisbranch/fracturing.space/api/gen/go/notifications/v1"
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	socialv1 "github.com/louisbranch/fracturing.space/api/gen/go/social/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type accountCallLog struct {
+	calls []string
+}
+
+func (l *accountCallLog) record(call string) {
+	l.calls = append(l.calls, call)
+}
+
+type fakeAccountAuthClient struct {
+	log       *accountCallLog
+	completed []*authv1.CompleteAccountExportRequest
+}
+
+func (f *fakeAccountAuthClient) ExportUserData(context.Context, *authv1.ExportUserDataRequest, ...grpc.CallOption) (*authv1.ExportUserDataResponse, error) {
+	f.log.record("auth.export")
+	return &authv1.ExportUserDataResponse{RecordsJson: `{"user":{"username":"alice"}}`}, nil
+}
+
+func (f *fakeAccountAuthClient) CompleteAccountExport(_ context.Context, in *authv1.CompleteAccountExportRequest, _ ...grpc.CallOption) (*authv1.CompleteAccountExportResponse, error) {
+	f.completed = append(f.completed, in)
+	return &authv1.CompleteAccountExportResponse{}, nil
+}
+
+type fakeAccountSocialClient struct{ log *accountCallLog }
+
+func (f fakeAccountSocialClient) ExportUserData(context.Context, *socialv1.ExportUserDataRequest, ...grpc.CallOption) (*socialv1.ExportUserDataResponse, error) {
+	f.log.record("social.export")
+	return &socialv1.ExportUserDataResponse{RecordsJson: `{"profile":null}`}, nil
+}
+
+func (f fakeAccountSocialClient) DeleteUserData(context.Context, *socialv1.DeleteUserDataRequest, ...grpc.CallOption) (*socialv1.DeleteUserDataResponse, error) {
+	f.log.record("social.delete")
+	return &socialv1.DeleteUserDataResponse{}, nil
+}
+
+type fakeAccountNotificationClient struct{ log *accountCallLog }
+
+func (f fakeAccountNotificationClient) ExportUserData(context.Context, *notificationsv1.ExportUserDataRequest, ...grpc.CallOption) (*notificationsv1.ExportUserDataResponse, error) {
+	f.log.record("notifications.export")
+	return &notificationsv1.ExportUserDataResponse{RecordsJson: `{"notifications":[]}`}, nil
+}
+
+func (f fakeAccountNotificationClient) DeleteUserData(context.Context, *notificationsv1.DeleteUserDataRequest, ...grpc.CallOption) (*notificationsv1.DeleteUserDataResponse, error) {
+	f.log.record("notifications.delete")
+	return &notificationsv1.DeleteUserDataResponse{}, nil
+}
+
+type fakeAccountInviteClient struct{ log *accountCallLog }
+
+func (f fakeAccountInviteClient) ExportUserData(context.Context, *invitev1.ExportUserDataRequest, ...grpc.CallOption) (*invitev1.ExportUserDataResponse, error) {
+	f.log.record("invite.export")
+	return &invitev1.ExportUserDataResponse{RecordsJson: `{"invites":[]}`}, nil
+}
+
+func (f fakeAccountInviteClient) DeleteUserData(context.Context, *invitev1.DeleteUserDataRequest, ...grpc.CallOption) (*invitev1.DeleteUserDataResponse, error) {
+	f.log.record("invite.delete")
+	return &invitev1.DeleteUserDataResponse{}, nil
+}
+
+type fakeAccountAIClient struct {
+	log       *accountCallLog
+	exportErr error
+}
+
+func (f fakeAccountAIClient) ExportUserData(context.Context, *aiv1.ExportUserDataRequest, ...grpc.CallOption) (*aiv1.ExportUserDataResponse, error) {
+	f.log.record("ai.export")
+	if f.exportErr != nil {
+		return nil, f.exportErr
+	}
+	return &aiv1.ExportUserDataResponse{RecordsJson: `{"credentials":[]}`}, nil
+}
+
+func (f fakeAccountAIClient) DeleteUserData(context.Context, *aiv1.DeleteUserDataRequest, ...grpc.CallOption) (*aiv1.DeleteUserDataResponse, error) {
+	f.log.record("ai.delete")
+	return &aiv1.DeleteUserDataResponse{}, nil
+}
+
+type fakeAccountGameClient struct {
+	log          *accountCallLog
+	participants []*gamev1.Participant
+}
+
+func (f fakeAccountGameClient) ListUserParticipants(context.Context, *gamev1.ListUserParticipantsRequest, ...grpc.CallOption) (*gamev1.ListUserParticipantsResponse, error) {
+	f.log.record("game.list")
+	return &gamev1.ListUserParticipantsResponse{Participants: f.participants}, nil
+}
+
+func (f fakeAccountGameClient) AnonymizeUserParticipants(context.Context, *gamev1.AnonymizeUserParticipantsRequest, ...grpc.CallOption) (*gamev1.AnonymizeUserParticipantsResponse, error) {
+	f.log.record("game.anonymize")
+	return &gamev1.AnonymizeUserParticipantsResponse{}, nil
+}
+
+type fakeAccountTranscriptClient struct {
+	log   *accountCallLog
+	seats []*playv1.ParticipantSeat
+}
+
+func (f *fakeAccountTranscriptClient) ExportParticipantMessages(_ context.Context, in *playv1.ExportParticipantMessagesRequest, _ ...grpc.CallOption) (*playv1.ExportParticipantMessagesResponse, error) {
+	f.log.record("play.export")
+	f.seats = in.GetSeats()
+	return &playv1.ExportParticipantMessagesResponse{}, nil
+}
+
+func (f *fakeAccountTranscriptClient) RedactParticipantMessages(_ context.Context, in *playv1.RedactParticipantMessagesRequest, _ ...grpc.CallOption) (*playv1.RedactParticipantMessagesResponse, error) {
+	f.log.record("play.redact")
+	f.seats = in.GetSeats()
+	return &playv1.RedactParticipantMessagesResponse{}, nil
+}
+
+func newFakeAccountDataClients(log *accountCallLog) (AccountDataClients, *fakeAccountTranscriptClient) {
+	play := &fakeAccountTranscriptClient{log: log}
+	return AccountDataClients{
+		Social:        fakeAccountSocialClient{log: log},
+		Notifications: fakeAccountNotificationClient{log: log},
+		Invite:        fakeAccountInviteClient{log: log},
+		AI:            fakeAccountAIClient{log: log},
+		Game: fakeAccountGameClient{log: log, participants: []*gamev1.Participant{
+			{Id: "p1", CampaignId: "c1"},
+			{Id: "p7", CampaignId: "c2"},
+		}},
+		Play: play,
+	}, play
+}
+
+func TestAccountExportRequestedHandlerBuildsArchive(t *testing.T) {
+	log := &accountCallLog{}
+	auth := &fakeAccountAuthClient{log: log}
+	clients, play := newFakeAccountDataClients(log)
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	handler := NewAccountExportRequestedHandler(auth, clients, func() time.Time { return now })
+
+	err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: `{"export_id":"export-1","user_id":"user-1"}`})
+	if err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(auth.completed) != 1 || len(auth.completed[0].GetArchive()) == 0 || auth.completed[0].GetFailure() != "" {
+		t.Fatalf("completed = %+v, want one archive", auth.completed)
+	}
+	if len(play.seats) != 2 {
+		t.Fatalf("play seats = %d, want 2", len(play.seats))
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(auth.completed[0].GetArchive()), int64(len(auth.completed[0].GetArchive())))
+	if err != nil {
+		t.Fatalf("open archive: %v", err)
+	}
+	names := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		body, _ := io.ReadAll(rc)
+		rc.Close()
+		names[file.Name] = string(body)
+	}
+	for _, want := range []string{"manifest.json", "auth.json", "social.json", "notifications.json", "invites.json", "ai.json", "game.json", "chat.json"} {
+		if _, ok := names[want]; !ok {
+			t.Fatalf("archive files = %v, missing %s", names, want)
+		}
+	}
+	if !strings.Contains(names["manifest.json"], `"export_id": "export-1"`) {
+		t.Fatalf("manifest = %s", names["manifest.json"])
+	}
+	if !strings.Contains(names["auth.json"], "alice") {
+		t.Fatalf("auth.json = %s", names["auth.json"])
+	}
+}
+
+func TestAccountExportRequestedHandlerRecordsPermanentFailure(t *testing.T) {
+	log := &accountCallLog{}
+	auth := &fakeAccountAuthClient{log: log}
+	clients, _ := newFakeAccountDataClients(log)
+	clients.AI = fakeAccountAIClient{log: log, exportErr: status.Error(codes.PermissionDenied, "denied")}
+	handler := NewAccountExportRequestedHandler(auth, clients, nil)
+
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: `{"export_id":"export-1","user_id":"user-1"}`}); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(auth.completed) != 1 || !strings.Contains(auth.completed[0].GetFailure(), "export ai data") {
+		t.Fatalf("completed = %+v, want recorded failure", auth.completed)
+	}
+}
+
+func TestAccountExportRequestedHandlerRetriesTransientFailure(t *testing.T) {
+	log := &accountCallLog{}
+	auth := &fakeAccountAuthClient{log: log}
+	clients, _ := newFakeAccountDataClients(log)
+	clients.AI = fakeAccountAIClient{log: log, exportErr: status.Error(codes.Unavailable, "down")}
+	handler := NewAccountExportRequestedHandler(auth, clients, nil)
+
+	err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: `{"export_id":"export-1","user_id":"user-1"}`})
+	if err == nil || IsPermanent(err) {
+		t.Fatalf("err = %v, want retryable", err)
+	}
+	if len(auth.completed) != 0 {
+		t.Fatalf("completed = %+v, want none", auth.completed)
+	}
+}
+
+func TestAccountDeletedHandlerRedactsBeforeReleasingSeats(t *testing.T) {
+	log := &accountCallLog{}
+	clients, play := newFakeAccountDataClients(log)
+	handler := NewAccountDeletedHandler(clients)
+
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: `{"user_id":"user-1","deleted_at":"2026-03-01T09:00:00Z"}`}); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	want := []string{"game.list", "play.redact", "game.anonymize", "social.delete", "notifications.delete", "invite.delete", "ai.delete"}
+	if strings.Join(log.calls, ",") != strings.Join(want, ",") {
+		t.Fatalf("calls = %v, want %v", log.calls, want)
+	}
+	if len(play.seats) != 2 || play.seats[0].GetParticipantId() != "p1" {
+		t.Fatalf("redacted seats = %v", play.seats)
+	}
+}
+
+func TestAccountDeletedHandlerRejectsMissingUser(t *testing.T) {
+	clients, _ := newFakeAccountDataClients(&accountCallLog{})
+	err := NewAccountDeletedHandler(clients).Handle(context.Background(), outboxEventStub{payloadJSON: `{}`})
+	if !IsPermanent(err) {
+		t.Fatalf("err = %v, want permanent", err)
+	}
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"google.golang.org/grpc"
+)
+
+// DefaultAIPlayerTurnMinInterval spaces consecutive turns for one AI player so
+// a revision loop cannot monopolize the table.
+const DefaultAIPlayerTurnMinInterval = 20 * time.Second
+
+type workerAIPlayerTurnLister interface {
+	ListDueAIPlayerTurns(ctx context.Context, in *gamev1.ListDueAIPlayerTurnsRequest, opts ...grpc.CallOption) (*gamev1.ListDueAIPlayerTurnsResponse, error)
+}
+
+type workerCampaignPlayerTurnClient interface {
+	RunCampaignPlayerTurn(ctx context.Context, in *aiv1.RunCampaignPlayerTurnRequest, opts ...grpc.CallOption) (*aiv1.RunCampaignPlayerTurnResponse, error)
+}
+
+// AIPlayerTurnRequestedHandler runs due AI player turns for one player phase.
+//
+// The game decides which AI players are due, so humans are never pre-empted;
+// the handler only adds a per-participant minimum interval on top.
+type AIPlayerTurnRequestedHandler struct {
+	turns       workerAIPlayerTurnLister
+	game        workerCampaignAIServiceClient
+	ai          workerCampaignPlayerTurnClient
+	minInterval time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex
+	lastRun map[string]time.Time
+}
+
+func NewAIPlayerTurnRequestedHandler(turns workerAIPlayerTurnLister, game workerCampaignAIServiceClient, ai workerCampaignPlayerTurnClient, minInterval time.Duration, now func() time.Time) *AIPlayerTurnRequestedHandler {
+	if now == nil {
+		now = time.Now
+	}
+	return &AIPlayerTurnRequestedHandler{
+		turns:       turns,
+		game:        game,
+		ai:          ai,
+		minInterval: minInterval,
+		now:         now,
+		lastRun:     map[string]time.Time{},
+	}
+}
+
+func (h *AIPlayerTurnRequestedHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.turns == nil || h.game == nil || h.ai == nil {
+		return Permanent(fmt.Errorf("ai player turn handler dependencies are not configured"))
+	}
+	payload, err := decodeAIPlayerTurnRequestedPayload(event)
+	if err != nil {
+		return Permanent(err)
+	}
+	callCtx := serviceContext(ctx)
+	due, err := h.turns.ListDueAIPlayerTurns(callCtx, &gamev1.ListDueAIPlayerTurnsRequest{
+		CampaignId: payload.CampaignID,
+		SessionId:  payload.SessionID,
+		SceneId:    payload.SceneID,
+		PhaseId:    payload.PhaseID,
+	})
+	if err != nil {
+		return classifyAIGMTurnError("list due ai player turns", err)
+	}
+
+	var retryable, permanent []error
+	for _, turn := range due.GetTurns() {
+		participantID := strings.TrimSpace(turn.GetParticipantId())
+		if participantID == "" {
+			continue
+		}
+		if wait := h.reserve(payload.CampaignID, participantID); wait > 0 {
+			retryable = append(retryable, fmt.Errorf("ai player %s turn deferred for %s", participantID, wait))
+			continue
+		}
+		err := h.runTurn(callCtx, payload, participantID, strings.TrimSpace(turn.GetTurnToken()))
+		switch {
+		case err == nil:
+		case IsPermanent(err):
+			permanent = append(permanent, err)
+		default:
+			retryable = append(retryable, err)
+		}
+	}
+	// Any retryable failure retries the whole event; the game only lists
+	// players that are still due, so finished turns are not repeated.
+	if len(retryable) > 0 {
+		return errors.Join(retryable...)
+	}
+	return errors.Join(permanent...)
+}
+
+func (h *AIPlayerTurnRequestedHandler) runTurn(ctx context.Context, payload gameintegration.AIPlayerTurnRequestedOutboxPayload, participantID, turnToken string) error {
+	grant, err := h.game.IssueCampaignAISessionGrant(ctx, &gamev1.IssueCampaignAISessionGrantRequest{
+		CampaignId:    payload.CampaignID,
+		SessionId:     payload.SessionID,
+		ParticipantId: participantID,
+	})
+	if err != nil {
+		return classifyAIGMTurnError("issue ai player session grant", err)
+	}
+	token := strings.TrimSpace(grant.GetGrant().GetToken())
+	if token == "" {
+		return fmt.Errorf("issue ai player session grant: grant token is missing")
+	}
+	if _, err := h.ai.RunCampaignPlayerTurn(ctx, &aiv1.RunCampaignPlayerTurnRequest{
+		SessionGrant: token,
+		TurnToken:    turnToken,
+	}); err != nil {
+		return classifyAIGMTurnError("run campaign player turn", err)
+	}
+	return nil
+}
+
+// reserve records a run for one participant and returns how long the caller
+// must wait instead when the previous run is too recent.
+func (h *AIPlayerTurnRequestedHandler) reserve(campaignID, participantID string) time.Duration {
+	if h.minInterval <= 0 {
+		return 0
+	}
+	key := campaignID + ":" + participantID
+	now := h.now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if last, ok := h.lastRun[key]; ok {
+		if wait := h.minInterval - now.Sub(last); wait > 0 {
+			return wait
+		}
+	}
+	h.lastRun[key] = now
+	return 0
+}
+
+func decodeAIPlayerTurnRequestedPayload(event OutboxEvent) (gameintegration.AIPlayerTurnRequestedOutboxPayload, error) {
+	if event == nil {
+		return gameintegration.AIPlayerTurnRequestedOutboxPayload{}, fmt.Errorf("outbox event is required")
+	}
+	var payload gameintegration.AIPlayerTurnRequestedOutboxPayload
+	if err := json.Unmarshal([]byte(event.GetPayloadJson()), &payload); err != nil {
+		return payload, fmt.Errorf("decode ai player turn requested payload: %w", err)
+	}
+	payload.CampaignID = strings.TrimSpace(payload.CampaignID)
+	payload.SessionID = strings.TrimSpace(payload.SessionID)
+	payload.SceneID = strings.TrimSpace(payload.SceneID)
+	payload.PhaseID = strings.TrimSpace(payload.PhaseID)
+	payload.SourceEventType = strings.TrimSpace(payload.SourceEventType)
+	switch {
+	case payload.CampaignID == "":
+		return payload, fmt.Errorf("campaign_id is required in ai player turn requested payload")
+	case payload.SessionID == "":
+		return payload, fmt.Errorf("session_id is required in ai player turn requested payload")
+	case payload.SceneID == "":
+		return payload, fmt.Errorf("scene_id is required in ai player turn requested payload")
+	case payload.PhaseID == "":
+		return payload, fmt.Errorf("phase_id is required in ai player turn requested payload")
+	}
+	return payload, nil
+}
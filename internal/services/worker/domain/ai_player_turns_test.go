@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeAIPlayerTurnLister struct {
+	reqs []*gamev1.ListDueAIPlayerTurnsRequest
+	resp *gamev1.ListDueAIPlayerTurnsResponse
+	err  error
+}
+
+func (f *fakeAIPlayerTurnLister) ListDueAIPlayerTurns(_ context.Context, in *gamev1.ListDueAIPlayerTurnsRequest, _ ...grpc.CallOption) (*gamev1.ListDueAIPlayerTurnsResponse, error) {
+	f.reqs = append(f.reqs, in)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.resp != nil {
+		return f.resp, nil
+	}
+	return &gamev1.ListDueAIPlayerTurnsResponse{}, nil
+}
+
+type fakeCampaignPlayerTurnClient struct {
+	reqs []*aiv1.RunCampaignPlayerTurnRequest
+	err  error
+}
+
+func (f *fakeCampaignPlayerTurnClient) RunCampaignPlayerTurn(_ context.Context, in *aiv1.RunCampaignPlayerTurnRequest, _ ...grpc.CallOption) (*aiv1.RunCampaignPlayerTurnResponse, error) {
+	f.reqs = append(f.reqs, in)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &aiv1.RunCampaignPlayerTurnResponse{OutputText: "I follow."}, nil
+}
+
+const aiPlayerTurnPayload = `{"campaign_id":" camp-1 ","session_id":"sess-1","scene_id":"scene-1","phase_id":"phase-1","source_event_type":"scene.player_phase_yielded"}`
+
+func TestAIPlayerTurnRequestedHandlerRunsDueTurns(t *testing.T) {
+	t.Parallel()
+
+	turns := &fakeAIPlayerTurnLister{resp: &gamev1.ListDueAIPlayerTurnsResponse{
+		Turns: []*gamev1.AIPlayerTurn{{ParticipantId: "companion-1", TurnToken: "token-1"}},
+	}}
+	game := &fakeCampaignAIServiceClient{}
+	ai := &fakeCampaignPlayerTurnClient{}
+	handler := NewAIPlayerTurnRequestedHandler(turns, game, ai, 0, nil)
+
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: aiPlayerTurnPayload}); err != nil {
+		t.Fatalf("handle error = %v", err)
+	}
+	if len(turns.reqs) != 1 || turns.reqs[0].GetCampaignId() != "camp-1" || turns.reqs[0].GetPhaseId() != "phase-1" {
+		t.Fatalf("list requests = %#v", turns.reqs)
+	}
+	if len(game.reqs) != 1 || game.reqs[0].GetParticipantId() != "companion-1" {
+		t.Fatalf("grant requests = %#v", game.reqs)
+	}
+	if len(ai.reqs) != 1 || ai.reqs[0].GetSessionGrant() != "grant-1" || ai.reqs[0].GetTurnToken() != "token-1" {
+		t.Fatalf("run requests = %#v", ai.reqs)
+	}
+}
+
+func TestAIPlayerTurnRequestedHandlerNoopsWhenNothingIsDue(t *testing.T) {
+	t.Parallel()
+
+	turns := &fakeAIPlayerTurnLister{resp: &gamev1.ListDueAIPlayerTurnsResponse{WaitingReason: "waiting for human participants to yield"}}
+	game := &fakeCampaignAIServiceClient{}
+	ai := &fakeCampaignPlayerTurnClient{}
+	handler := NewAIPlayerTurnRequestedHandler(turns, game, ai, 0, nil)
+
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: aiPlayerTurnPayload}); err != nil {
+		t.Fatalf("handle error = %v", err)
+	}
+	if len(game.reqs) != 0 || len(ai.reqs) != 0 {
+		t.Fatalf("unexpected grant/run requests = %#v %#v", game.reqs, ai.reqs)
+	}
+}
+
+func TestAIPlayerTurnRequestedHandlerDefersTurnsWithinMinInterval(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	turns := &fakeAIPlayerTurnLister{resp: &gamev1.ListDueAIPlayerTurnsResponse{
+		Turns: []*gamev1.AIPlayerTurn{{ParticipantId: "companion-1", TurnToken: "token-1"}},
+	}}
+	ai := &fakeCampaignPlayerTurnClient{}
+	handler := NewAIPlayerTurnRequestedHandler(turns, &fakeCampaignAIServiceClient{}, ai, 20*time.Second, func() time.Time { return now })
+
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: aiPlayerTurnPayload}); err != nil {
+		t.Fatalf("first handle error = %v", err)
+	}
+	now = now.Add(5 * time.Second)
+	err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: aiPlayerTurnPayload})
+	if err == nil || IsPermanent(err) {
+		t.Fatalf("second handle error = %v, want retryable deferral", err)
+	}
+	if len(ai.reqs) != 1 {
+		t.Fatalf("run requests = %d, want 1", len(ai.reqs))
+	}
+	now = now.Add(20 * time.Second)
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: aiPlayerTurnPayload}); err != nil {
+		t.Fatalf("third handle error = %v", err)
+	}
+	if len(ai.reqs) != 2 {
+		t.Fatalf("run requests = %d, want 2", len(ai.reqs))
+	}
+}
+
+func TestAIPlayerTurnRequestedHandlerClassifiesFailures(t *testing.T) {
+	t.Parallel()
+
+	due := &gamev1.ListDueAIPlayerTurnsResponse{Turns: []*gamev1.AIPlayerTurn{{ParticipantId: "companion-1"}}}
+
+	handler := NewAIPlayerTurnRequestedHandler(&fakeAIPlayerTurnLister{}, &fakeCampaignAIServiceClient{}, &fakeCampaignPlayerTurnClient{}, 0, nil)
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: `{"campaign_id":"camp-1","session_id":"sess-1"}`}); !IsPermanent(err) {
+		t.Fatalf("invalid payload error = %v, want permanent", err)
+	}
+
+	handler = NewAIPlayerTurnRequestedHandler(&fakeAIPlayerTurnLister{resp: due}, &fakeCampaignAIServiceClient{err: status.Error(codes.FailedPrecondition, "not an ai player")}, &fakeCampaignPlayerTurnClient{}, 0, nil)
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: aiPlayerTurnPayload}); !IsPermanent(err) {
+		t.Fatalf("grant rejection error = %v, want permanent", err)
+	}
+
+	handler = NewAIPlayerTurnRequestedHandler(&fakeAIPlayerTurnLister{resp: due}, &fakeCampaignAIServiceClient{}, &fakeCampaignPlayerTurnClient{err: status.Error(codes.Unavailable, "ai down")}, 0, nil)
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: aiPlayerTurnPayload}); err == nil || IsPermanent(err) {
+		t.Fatalf("ai outage error = %v, want retryable", err)
+	}
+}
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	platformi18n "github.com/louisbranch/fracturing.space/internal/platform/i18n"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/notificationpayload"
+)
+
+// Campaign application outbox event types emitted by the invite service.
+// Accepted applications surface as invite-created events for the applicant.
+const (
+	ApplicationSubmittedOutboxEventType = "invite.application.submitted.v1"
+	ApplicationDeclinedOutboxEventType  = "invite.application.declined.v1"
+)
+
+// Campaign application notification message types.
+const (
+	ApplicationSubmittedMessageType = "campaign.application.submitted.v1"
+	ApplicationDeclinedMessageType  = "campaign.application.declined.v1"
+)
+
+// ApplicationNotificationOutboxPayload is the invite payload for campaign
+// application events.
+type ApplicationNotificationOutboxPayload struct {
+	ApplicationID    string `json:"application_id"`
+	CampaignID       string `json:"campaign_id"`
+	ApplicantUserID  string `json:"applicant_user_id"`
+	NotificationKind string `json:"notification_kind"`
+}
+
+// ApplicationSubmittedNotificationHandler tells the campaign's owners and
+// managers that a player applied to one of its open seats.
+type ApplicationSubmittedNotificationHandler struct {
+	campaigns     workerCampaignClient
+	participants  workerParticipantListClient
+	auth          workerAuthClient
+	notifications workerNotificationClient
+}
+
+// NewApplicationSubmittedNotificationHandler creates the GM-facing application notifier.
+func NewApplicationSubmittedNotificationHandler(campaigns workerCampaignClient, participants workerParticipantListClient, auth workerAuthClient, notifications workerNotificationClient) *ApplicationSubmittedNotificationHandler {
+	return &ApplicationSubmittedNotificationHandler{
+		campaigns:     campaigns,
+		participants:  participants,
+		auth:          auth,
+		notifications: notifications,
+	}
+}
+
+func (h *ApplicationSubmittedNotificationHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.campaigns == nil || h.participants == nil || h.notifications == nil {
+		return Permanent(fmt.Errorf("application submitted handler dependencies are not configured"))
+	}
+	payload, err := decodeApplicationPayload(event)
+	if err != nil {
+		return Permanent(err)
+	}
+
+	callCtx := workerGameReadContext(ctx)
+	campaignResp, err := h.campaigns.GetCampaign(callCtx, &gamev1.GetCampaignRequest{CampaignId: payload.CampaignID})
+	if err != nil {
+		return classifyGameReadError("load campaign", err)
+	}
+	campaignName := strings.TrimSpace(campaignResp.GetCampaign().GetName())
+	recipients, err := h.listManagerUserIDs(callCtx, payload.CampaignID)
+	if err != nil {
+		return err
+	}
+	applicantUsername, _ := lookupUsername(ctx, h.auth, payload.ApplicantUserID)
+
+	body := platformi18n.NewCopyRef("notification.campaign_application.submitted.body")
+	if applicantUsername != "" && campaignName != "" {
+		body = platformi18n.NewCopyRef("notification.campaign_application.submitted.body_summary", applicantUsername, campaignName)
+	}
+	message := notificationpayload.InAppPayload{
+		Title:   platformi18n.NewCopyRef("notification.campaign_application.submitted.title"),
+		Body:    body,
+		Facts:   applicationFacts(campaignName, applicantUsername),
+		Actions: []notificationpayload.PayloadAction{notificationpayload.OpenCampaignAction(payload.CampaignID)},
+	}
+	dedupeKey := "application:" + payload.ApplicationID + ":submitted"
+	for _, userID := range recipients {
+		if userID == payload.ApplicantUserID {
+			continue
+		}
+		if err := createNotification(ctx, h.notifications, userID, ApplicationSubmittedMessageType, message, dedupeKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listManagerUserIDs returns the distinct bound users whose seats can review
+// applications.
+func (h *ApplicationSubmittedNotificationHandler) listManagerUserIDs(ctx context.Context, campaignID string) ([]string, error) {
+	var userIDs []string
+	seen := map[string]struct{}{}
+	pageToken := ""
+	for {
+		resp, err := h.participants.ListParticipants(ctx, &gamev1.ListParticipantsRequest{
+			CampaignId: campaignID,
+			PageSize:   sessionRecapParticipantPageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, classifyGameReadError("list participants", err)
+		}
+		for _, participant := range resp.GetParticipants() {
+			switch participant.GetCampaignAccess() {
+			case gamev1.CampaignAccess_CAMPAIGN_ACCESS_OWNER, gamev1.CampaignAccess_CAMPAIGN_ACCESS_MANAGER:
+			default:
+				continue
+			}
+			userID := strings.TrimSpace(participant.GetUserId())
+			if userID == "" {
+				continue
+			}
+			if _, ok := seen[userID]; ok {
+				continue
+			}
+			seen[userID] = struct{}{}
+			userIDs = append(userIDs, userID)
+		}
+		pageToken = strings.TrimSpace(resp.GetNextPageToken())
+		if pageToken == "" {
+			return userIDs, nil
+		}
+	}
+}
+
+// ApplicationDeclinedNotificationHandler tells an applicant that the GM
+// declined their application.
+type ApplicationDeclinedNotificationHandler struct {
+	campaigns     workerCampaignClient
+	notifications workerNotificationClient
+}
+
+// NewApplicationDeclinedNotificationHandler creates the applicant-facing decline notifier.
+func NewApplicationDeclinedNotificationHandler(campaigns workerCampaignClient, notifications workerNotificationClient) *ApplicationDeclinedNotificationHandler {
+	return &ApplicationDeclinedNotificationHandler{
+		campaigns:     campaigns,
+		notifications: notifications,
+	}
+}
+
+func (h *ApplicationDeclinedNotificationHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.campaigns == nil || h.notifications == nil {
+		return Permanent(fmt.Errorf("application declined handler dependencies are not configured"))
+	}
+	payload, err := decodeApplicationPayload(event)
+	if err != nil {
+		return Permanent(err)
+	}
+	campaignResp, err := h.campaigns.GetCampaign(workerGameReadContext(ctx), &gamev1.GetCampaignRequest{CampaignId: payload.CampaignID})
+	if err != nil {
+		return classifyGameReadError("load campaign", err)
+	}
+	campaignName := strings.TrimSpace(campaignResp.GetCampaign().GetName())
+
+	body := platformi18n.NewCopyRef("notification.campaign_application.declined.body")
+	if campaignName != "" {
+		body = platformi18n.NewCopyRef("notification.campaign_application.declined.body_summary", campaignName)
+	}
+	return createNotification(ctx, h.notifications, payload.ApplicantUserID, ApplicationDeclinedMessageType, notificationpayload.InAppPayload{
+		Title: platformi18n.NewCopyRef("notification.campaign_application.declined.title"),
+		Body:  body,
+		Facts: applicationFacts(campaignName, ""),
+	}, "application:"+payload.ApplicationID+":declined")
+}
+
+func applicationFacts(campaignName, applicantUsername string) []notificationpayload.PayloadFact {
+	facts := inviteFacts(campaignName, "", "")
+	if applicantUsername = strings.TrimSpace(applicantUsername); applicantUsername != "" {
+		facts = append(facts, notificationpayload.PayloadFact{
+			Label: platformi18n.NewCopyRef("notification.fact.applicant"),
+			Value: "@" + applicantUsername,
+		})
+	}
+	return facts
+}
+
+func decodeApplicationPayload(event OutboxEvent) (ApplicationNotificationOutboxPayload, error) {
+	if event == nil {
+		return ApplicationNotificationOutboxPayload{}, fmt.Errorf("event is required")
+	}
+	var payload ApplicationNotificationOutboxPayload
+	if err := json.Unmarshal([]byte(event.GetPayloadJson()), &payload); err != nil {
+		return ApplicationNotificationOutboxPayload{}, fmt.Errorf("decode campaign application payload: %w", err)
+	}
+	payload.ApplicationID = strings.TrimSpace(payload.ApplicationID)
+	payload.CampaignID = strings.TrimSpace(payload.CampaignID)
+	payload.ApplicantUserID = strings.TrimSpace(payload.ApplicantUserID)
+	if payload.ApplicationID == "" || payload.CampaignID == "" || payload.ApplicantUserID == "" {
+		return ApplicationNotificationOutboxPayload{}, fmt.Errorf("application_id, campaign_id, and applicant_user_id are required in campaign application payload")
+	}
+	return payload, nil
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/notificationpayload"
+)
+
+func TestApplicationSubmittedNotificationHandlerNotifiesManagers(t *testing.T) {
+	notifications := &fakeNotificationClient{}
+	handler := NewApplicationSubmittedNotificationHandler(
+		&fakeCampaignReader{campaign: &gamev1.Campaign{Id: "campaign-1", Name: "Ashen Road"}},
+		&fakeParticipantLister{participants: []*gamev1.Participant{
+			{Id: "gm-1", UserId: "user-gm", CampaignAccess: gamev1.CampaignAccess_CAMPAIGN_ACCESS_OWNER},
+			{Id: "co-1", UserId: "user-co", CampaignAccess: gamev1.CampaignAccess_CAMPAIGN_ACCESS_MANAGER},
+			{Id: "p-1", UserId: "user-1", CampaignAccess: gamev1.CampaignAccess_CAMPAIGN_ACCESS_MEMBER},
+		}},
+		&fakeAuthReader{users: map[string]*authv1.User{"user-applicant": {Id: "user-applicant", Username: "wanderer"}}},
+		notifications,
+	)
+
+	err := handler.Handle(context.Background(), typedOutboxEventStub{
+		eventType:   ApplicationSubmittedOutboxEventType,
+		payloadJSON: `{"application_id":"app-1","campaign_id":"campaign-1","applicant_user_id":"user-applicant"}`,
+	})
+	if err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(notifications.requests) != 2 {
+		t.Fatalf("notification requests = %d, want 2", len(notifications.requests))
+	}
+	recipients := map[string]bool{}
+	for _, req := range notifications.requests {
+		if req.GetMessageType() != ApplicationSubmittedMessageType || req.GetDedupeKey() != "application:app-1:submitted" {
+			t.Fatalf("notification = %+v", req)
+		}
+		recipients[req.GetRecipientUserId()] = true
+	}
+	if !recipients["user-gm"] || !recipients["user-co"] {
+		t.Fatalf("recipients = %v, want owner and manager", recipients)
+	}
+	var payload notificationpayload.InAppPayload
+	if err := json.Unmarshal([]byte(notifications.requests[0].GetPayloadJson()), &payload); err != nil {
+		t.Fatalf("unmarshal payload json: %v", err)
+	}
+	if payload.Body.Key != "notification.campaign_application.submitted.body_summary" {
+		t.Fatalf("body = %+v", payload.Body)
+	}
+}
+
+func TestApplicationDeclinedNotificationHandlerNotifiesApplicant(t *testing.T) {
+	notifications := &fakeNotificationClient{}
+	handler := NewApplicationDeclinedNotificationHandler(
+		&fakeCampaignReader{campaign: &gamev1.Campaign{Id: "campaign-1", Name: "Ashen Road"}},
+		notifications,
+	)
+
+	err := handler.Handle(context.Background(), typedOutboxEventStub{
+		eventType:   ApplicationDeclinedOutboxEventType,
+		payloadJSON: `{"application_id":"app-1","campaign_id":"campaign-1","applicant_user_id":"user-applicant"}`,
+	})
+	if err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(notifications.requests) != 1 {
+		t.Fatalf("notification requests = %d, want 1", len(notifications.requests))
+	}
+	req := notifications.requests[0]
+	if req.GetRecipientUserId() != "user-applicant" || req.GetMessageType() != ApplicationDeclinedMessageType || req.GetDedupeKey() != "application:app-1:declined" {
+		t.Fatalf("notification = %+v", req)
+	}
+
+	err = handler.Handle(context.Background(), typedOutboxEventStub{eventType: ApplicationDeclinedOutboxEventType, payloadJSON: `{"application_id":"app-1"}`})
+	if !IsPermanent(err) {
+		t.Fatalf("missing fields error = %v, want permanent", err)
+	}
+}
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	platformi18n "github.com/louisbranch/fracturing.space/internal/platform/i18n"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/notificationpayload"
+)
+
+// Auth moderation outbox event types.
+const (
+	UserSuspendedOutboxEventType   = "auth.user_suspended"
+	UserUnsuspendedOutboxEventType = "auth.user_unsuspended"
+)
+
+// Moderation notification message types.
+const (
+	AccountSuspendedMessageType   = "auth.account.suspended.v1"
+	AccountUnsuspendedMessageType = "auth.account.unsuspended.v1"
+	CampaignLockedMessageType     = "campaign.moderation.locked.v1"
+	CampaignUnlockedMessageType   = "campaign.moderation.unlocked.v1"
+)
+
+// UserSuspensionOutboxPayload is the auth payload for suspension changes.
+type UserSuspensionOutboxPayload struct {
+	UserID    string `json:"user_id"`
+	ActionID  string `json:"action_id"`
+	Reason    string `json:"reason"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// UserSuspensionNotificationHandler tells a user that an operator suspended
+// or reinstated their account. The notice stays in the inbox so a suspended
+// user sees the reason once they can sign in again.
+type UserSuspensionNotificationHandler struct {
+	notifications workerNotificationClient
+}
+
+// NewUserSuspensionNotificationHandler creates the suspension notifier.
+func NewUserSuspensionNotificationHandler(notifications workerNotificationClient) *UserSuspensionNotificationHandler {
+	return &UserSuspensionNotificationHandler{notifications: notifications}
+}
+
+func (h *UserSuspensionNotificationHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.notifications == nil {
+		return Permanent(fmt.Errorf("user suspension handler dependencies are not configured"))
+	}
+	if event == nil {
+		return Permanent(fmt.Errorf("outbox event is required"))
+	}
+	var payload UserSuspensionOutboxPayload
+	if err := json.Unmarshal([]byte(event.GetPayloadJson()), &payload); err != nil {
+		return Permanent(fmt.Errorf("decode user suspension payload: %w", err))
+	}
+	payload.UserID = strings.TrimSpace(payload.UserID)
+	payload.ActionID = strings.TrimSpace(payload.ActionID)
+	if payload.UserID == "" || payload.ActionID == "" {
+		return Permanent(fmt.Errorf("user_id and action_id are required in user suspension payload"))
+	}
+
+	suspended := event.GetEventType() == UserSuspendedOutboxEventType
+	messageType := AccountUnsuspendedMessageType
+	message := notificationpayload.InAppPayload{
+		Title: platformi18n.NewCopyRef("notification.account.unsuspended.title"),
+		Body:  platformi18n.NewCopyRef("notification.account.unsuspended.body"),
+	}
+	if suspended {
+		messageType = AccountSuspendedMessageType
+		message = notificationpayload.InAppPayload{
+			Title: platformi18n.NewCopyRef("notification.account.suspended.title"),
+			Body:  platformi18n.NewCopyRef("notification.account.suspended.body"),
+			Facts: moderationFacts(payload.Reason, payload.ExpiresAt),
+		}
+	}
+	return createNotification(ctx, h.notifications, payload.UserID, messageType, message, "moderation:"+payload.ActionID)
+}
+
+// CampaignModerationNotificationHandler tells every seated user that an
+// operator locked or unlocked their campaign.
+type CampaignModerationNotificationHandler struct {
+	campaigns     workerCampaignClient
+	participants  workerParticipantListClient
+	notifications workerNotificationClient
+}
+
+// NewCampaignModerationNotificationHandler creates the campaign lock notifier.
+func NewCampaignModerationNotificationHandler(campaigns workerCampaignClient, participants workerParticipantListClient, notifications workerNotificationClient) *CampaignModerationNotificationHandler {
+	return &CampaignModerationNotificationHandler{
+		campaigns:     campaigns,
+		participants:  participants,
+		notifications: notifications,
+	}
+}
+
+func (h *CampaignModerationNotificationHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.campaigns == nil || h.participants == nil || h.notifications == nil {
+		return Permanent(fmt.Errorf("campaign moderation handler dependencies are not configured"))
+	}
+	if event == nil {
+		return Permanent(fmt.Errorf("outbox event is required"))
+	}
+	var payload gameintegration.CampaignModerationOutboxPayload
+	if err := json.Unmarshal([]byte(event.GetPayloadJson()), &payload); err != nil {
+		return Permanent(fmt.Errorf("decode campaign moderation payload: %w", err))
+	}
+	payload.CampaignID = strings.TrimSpace(payload.CampaignID)
+	if payload.CampaignID == "" {
+		return Permanent(fmt.Errorf("campaign_id is required in campaign moderation payload"))
+	}
+
+	callCtx := workerGameReadContext(ctx)
+	campaignResp, err := h.campaigns.GetCampaign(callCtx, &gamev1.GetCampaignRequest{CampaignId: payload.CampaignID})
+	if err != nil {
+		return classifyGameReadError("load campaign", err)
+	}
+	campaignName := strings.TrimSpace(campaignResp.GetCampaign().GetName())
+	recipients, err := h.listSeatedUserIDs(callCtx, payload.CampaignID)
+	if err != nil {
+		return err
+	}
+
+	messageType := CampaignUnlockedMessageType
+	message := notificationpayload.InAppPayload{
+		Title:   platformi18n.NewCopyRef("notification.campaign.moderation_unlocked.title"),
+		Body:    platformi18n.NewCopyRef("notification.campaign.moderation_unlocked.body"),
+		Facts:   inviteFacts(campaignName, "", ""),
+		Actions: []notificationpayload.PayloadAction{notificationpayload.OpenCampaignAction(payload.CampaignID)},
+	}
+	if payload.Locked {
+		messageType = CampaignLockedMessageType
+		message.Title = platformi18n.NewCopyRef("notification.campaign.moderation_locked.title")
+		message.Body = platformi18n.NewCopyRef("notification.campaign.moderation_locked.body")
+		message.Facts = append(message.Facts, moderationFacts(payload.Reason, "")...)
+	}
+	dedupeKey := "campaign-moderation:" + payload.CampaignID + ":" + event.GetId()
+	for _, userID := range recipients {
+		if err := createNotification(ctx, h.notifications, userID, messageType, message, dedupeKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listSeatedUserIDs returns the distinct bound user IDs of every seat.
+func (h *CampaignModerationNotificationHandler) listSeatedUserIDs(ctx context.Context, campaignID string) ([]string, error) {
+	var userIDs []string
+	seen := map[string]struct{}{}
+	pageToken := ""
+	for {
+		resp, err := h.participants.ListParticipants(ctx, &gamev1.ListParticipantsRequest{
+			CampaignId: campaignID,
+			PageSize:   sessionRecapParticipantPageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, classifyGameReadError("list participants", err)
+		}
+		for _, participant := range resp.GetParticipants() {
+			userID := strings.TrimSpace(participant.GetUserId())
+			if userID == "" {
+				continue
+			}
+			if _, ok := seen[userID]; ok {
+				continue
+			}
+			seen[userID] = struct{}{}
+			userIDs = append(userIDs, userID)
+		}
+		pageToken = strings.TrimSpace(resp.GetNextPageToken())
+		if pageToken == "" {
+			return userIDs, nil
+		}
+	}
+}
+
+func moderationFacts(reason, expiresAt string) []notificationpayload.PayloadFact {
+	var facts []notificationpayload.PayloadFact
+	if reason = strings.TrimSpace(reason); reason != "" {
+		facts = append(facts, notificationpayload.PayloadFact{
+			Label: platformi18n.NewCopyRef("notification.fact.reason"),
+			Value: reason,
+		})
+	}
+	if expiresAt = strings.TrimSpace(expiresAt); expiresAt != "" {
+		if parsed, err := time.Parse(time.RFC3339Nano, expiresAt); err == nil {
+			expiresAt = parsed.UTC().Format("2006-01-02 15:04 UTC")
+		}
+		facts = append(facts, notificationpayload.PayloadFact{
+			Label: platformi18n.NewCopyRef("notification.fact.suspended_until"),
+			Value: expiresAt,
+		})
+	}
+	return facts
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/notificationpayload"
+)
+
+type typedOutboxEventStub struct {
+	eventType   string
+	payloadJSON string
+}
+
+func (e typedOutboxEventStub) GetId() string          { return "evt-1" }
+func (e typedOutboxEventStub) GetEventType() string   { return e.eventType }
+func (e typedOutboxEventStub) GetPayloadJson() string { return e.payloadJSON }
+func (e typedOutboxEventStub) GetAttemptCount() int32 { return 0 }
+
+func TestUserSuspensionNotificationHandlerNotifiesUser(t *testing.T) {
+	notifications := &fakeNotificationClient{}
+	handler := NewUserSuspensionNotificationHandler(notifications)
+
+	err := handler.Handle(context.Background(), typedOutboxEventStub{
+		eventType:   UserSuspendedOutboxEventType,
+		payloadJSON: `{"user_id":"user-1","action_id":"act-1","reason":"spam","expires_at":"2026-03-04T09:00:00Z"}`,
+	})
+	if err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(notifications.requests) != 1 {
+		t.Fatalf("notification requests = %d, want 1", len(notifications.requests))
+	}
+	req := notifications.requests[0]
+	if req.GetRecipientUserId() != "user-1" || req.GetMessageType() != AccountSuspendedMessageType || req.GetDedupeKey() != "moderation:act-1" {
+		t.Fatalf("notification = %+v", req)
+	}
+	var payload notificationpayload.InAppPayload
+	if err := json.Unmarshal([]byte(req.GetPayloadJson()), &payload); err != nil {
+		t.Fatalf("unmarshal payload json: %v", err)
+	}
+	if len(payload.Facts) != 2 || payload.Facts[0].Value != "spam" || payload.Facts[1].Value != "2026-03-04 09:00 UTC" {
+		t.Fatalf("facts = %+v", payload.Facts)
+	}
+
+	err = handler.Handle(context.Background(), typedOutboxEventStub{eventType: UserSuspendedOutboxEventType, payloadJSON: `{"user_id":""}`})
+	if !IsPermanent(err) {
+		t.Fatalf("missing user error = %v, want permanent", err)
+	}
+}
+
+func TestCampaignModerationNotificationHandlerNotifiesSeatedUsers(t *testing.T) {
+	notifications := &fakeNotificationClient{}
+	handler := NewCampaignModerationNotificationHandler(
+		&fakeCampaignReader{campaign: &gamev1.Campaign{Id: "campaign-1", Name: "Ashen Road"}},
+		&fakeParticipantLister{participants: []*gamev1.Participant{
+			{Id: "gm-1", UserId: "user-gm", Role: gamev1.ParticipantRole_GM},
+			{Id: "p-1", UserId: "user-1", Role: gamev1.ParticipantRole_PLAYER},
+			{Id: "p-2", Role: gamev1.ParticipantRole_PLAYER},
+		}},
+		notifications,
+	)
+
+	err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: `{"campaign_id":"campaign-1","locked":true,"reason":"abuse report"}`})
+	if err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(notifications.requests) != 2 {
+		t.Fatalf("notification requests = %d, want 2", len(notifications.requests))
+	}
+	for _, req := range notifications.requests {
+		if req.GetMessageType() != CampaignLockedMessageType || req.GetDedupeKey() != "campaign-moderation:campaign-1:evt-1" {
+			t.Fatalf("notification = %+v", req)
+		}
+	}
+}
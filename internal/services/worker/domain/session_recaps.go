@@ -0,0 +1,295 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	platformi18n "github.com/louisbranch/fracturing.space/internal/platform/i18n"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/notificationpayload"
+	"google.golang.org/grpc"
+)
+
+// SessionRecapPublishedMessageType is the notification message type sent to
+// players when a session recap is recorded.
+const SessionRecapPublishedMessageType = "campaign.session_recap.published.v1"
+
+const (
+	// sessionRecapTranscriptPageSize is the page size used to read the play
+	// chat transcript.
+	sessionRecapTranscriptPageSize = 200
+	// sessionRecapTranscriptLimit bounds how many chat messages one recap
+	// draft includes.
+	sessionRecapTranscriptLimit = 1000
+	// sessionRecapEventPayloadLimit truncates individual journal payloads so a
+	// few large events cannot crowd out the rest of the session.
+	sessionRecapEventPayloadLimit = 400
+	// sessionRecapParticipantPageSize matches the game participant list cap.
+	sessionRecapParticipantPageSize = 10
+)
+
+type workerSessionRecapSourceClient interface {
+	GetSessionRecapSources(ctx context.Context, in *gamev1.GetSessionRecapSourcesRequest, opts ...grpc.CallOption) (*gamev1.GetSessionRecapSourcesResponse, error)
+}
+
+type workerTranscriptClient interface {
+	ListSessionTranscript(ctx context.Context, in *playv1.ListSessionTranscriptRequest, opts ...grpc.CallOption) (*playv1.ListSessionTranscriptResponse, error)
+}
+
+type workerSessionRecapDraftClient interface {
+	DraftSessionRecap(ctx context.Context, in *aiv1.DraftSessionRecapRequest, opts ...grpc.CallOption) (*aiv1.DraftSessionRecapResponse, error)
+}
+
+type workerParticipantListClient interface {
+	ListParticipants(ctx context.Context, in *gamev1.ListParticipantsRequest, opts ...grpc.CallOption) (*gamev1.ListParticipantsResponse, error)
+}
+
+// SessionRecapDraftRequestedHandler drafts a recap for an ended session with
+// the campaign's bound agent.
+//
+// Drafting is optional: campaigns without an AI agent, and sessions whose
+// recap was already recorded, are skipped. The draft stays pending in the AI
+// service until the GM edits and publishes it.
+type SessionRecapDraftRequestedHandler struct {
+	sources     workerSessionRecapSourceClient
+	transcripts workerTranscriptClient
+	drafts      workerSessionRecapDraftClient
+}
+
+// NewSessionRecapDraftRequestedHandler creates the recap drafting handler.
+// transcripts may be nil when the play service is not reachable; drafts are
+// then built from the session journal and GM interactions only.
+func NewSessionRecapDraftRequestedHandler(sources workerSessionRecapSourceClient, transcripts workerTranscriptClient, drafts workerSessionRecapDraftClient) *SessionRecapDraftRequestedHandler {
+	return &SessionRecapDraftRequestedHandler{
+		sources:     sources,
+		transcripts: transcripts,
+		drafts:      drafts,
+	}
+}
+
+func (h *SessionRecapDraftRequestedHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.sources == nil || h.drafts == nil {
+		return Permanent(fmt.Errorf("session recap draft handler dependencies are not configured"))
+	}
+	payload, err := decodeSessionRecapOutboxPayload(event, "session recap draft requested")
+	if err != nil {
+		return Permanent(err)
+	}
+	callCtx := serviceContext(ctx)
+	sources, err := h.sources.GetSessionRecapSources(callCtx, &gamev1.GetSessionRecapSourcesRequest{
+		CampaignId: payload.CampaignID,
+		SessionId:  payload.SessionID,
+	})
+	if err != nil {
+		return classifyAIGMTurnError("get session recap sources", err)
+	}
+	if !sources.GetAiAgentBound() || sources.GetRecapRecorded() {
+		return nil
+	}
+	transcript, err := h.loadTranscript(callCtx, payload)
+	if err != nil {
+		return err
+	}
+	if _, err := h.drafts.DraftSessionRecap(callCtx, &aiv1.DraftSessionRecapRequest{
+		CampaignId:     payload.CampaignID,
+		SessionId:      payload.SessionID,
+		SourceMarkdown: sessionRecapSourceMarkdown(sources, transcript),
+	}); err != nil {
+		return classifyAIGMTurnError("draft session recap", err)
+	}
+	return nil
+}
+
+func (h *SessionRecapDraftRequestedHandler) loadTranscript(ctx context.Context, payload gameintegration.SessionRecapOutboxPayload) ([]*playv1.TranscriptMessage, error) {
+	if h.transcripts == nil {
+		return nil, nil
+	}
+	var (
+		messages []*playv1.TranscriptMessage
+		after    int64
+	)
+	for len(messages) < sessionRecapTranscriptLimit {
+		resp, err := h.transcripts.ListSessionTranscript(ctx, &playv1.ListSessionTranscriptRequest{
+			CampaignId:      payload.CampaignID,
+			SessionId:       payload.SessionID,
+			AfterSequenceId: after,
+			PageSize:        sessionRecapTranscriptPageSize,
+		})
+		if err != nil {
+			return nil, classifyAIGMTurnError("list session transcript", err)
+		}
+		page := resp.GetMessages()
+		messages = append(messages, page...)
+		if !resp.GetHasMore() || len(page) == 0 {
+			break
+		}
+		after = page[len(page)-1].GetSequenceId()
+	}
+	if len(messages) > sessionRecapTranscriptLimit {
+		messages = messages[:sessionRecapTranscriptLimit]
+	}
+	return messages, nil
+}
+
+// sessionRecapSourceMarkdown renders the collected session material as one
+// Markdown document for the drafting agent.
+func sessionRecapSourceMarkdown(sources *gamev1.GetSessionRecapSourcesResponse, transcript []*playv1.TranscriptMessage) string {
+	names := make(map[string]string, len(sources.GetParticipants()))
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Session sources\n\nCampaign: %s\nSession: %s\n", sources.GetCampaignName(), sources.GetSessionName())
+
+	b.WriteString("\n## Participants\n\n")
+	for _, participant := range sources.GetParticipants() {
+		names[participant.GetParticipantId()] = participant.GetName()
+		fmt.Fprintf(&b, "- %s\n", participant.GetName())
+	}
+
+	b.WriteString("\n## GM narration\n")
+	for _, interaction := range sources.GetGmInteractions() {
+		fmt.Fprintf(&b, "\n### %s\n\n", interaction.GetTitle())
+		for _, beat := range interaction.GetBeats() {
+			if text := strings.TrimSpace(beat.GetText()); text != "" {
+				b.WriteString(text)
+				b.WriteString("\n\n")
+			}
+		}
+	}
+
+	b.WriteString("\n## Table chat\n\n")
+	for _, message := range transcript {
+		name := strings.TrimSpace(message.GetParticipantName())
+		if name == "" {
+			name = names[message.GetParticipantId()]
+		}
+		fmt.Fprintf(&b, "- %s: %s\n", name, strings.TrimSpace(message.GetBody()))
+	}
+
+	b.WriteString("\n## Event journal\n\n")
+	for _, evt := range sources.GetEvents() {
+		payload := strings.TrimSpace(evt.GetPayloadJson())
+		if len(payload) > sessionRecapEventPayloadLimit {
+			payload = payload[:sessionRecapEventPayloadLimit] + "…"
+		}
+		fmt.Fprintf(&b, "- %s %s\n", evt.GetType(), payload)
+	}
+	if sources.GetEventsTruncated() {
+		b.WriteString("- (later events omitted)\n")
+	}
+	return b.String()
+}
+
+// SessionRecapPublishedHandler notifies a campaign's players that a session
+// recap was recorded.
+type SessionRecapPublishedHandler struct {
+	campaigns     workerCampaignClient
+	participants  workerParticipantListClient
+	notifications workerNotificationClient
+}
+
+// NewSessionRecapPublishedHandler creates the recap publication notifier.
+func NewSessionRecapPublishedHandler(campaigns workerCampaignClient, participants workerParticipantListClient, notifications workerNotificationClient) *SessionRecapPublishedHandler {
+	return &SessionRecapPublishedHandler{
+		campaigns:     campaigns,
+		participants:  participants,
+		notifications: notifications,
+	}
+}
+
+func (h *SessionRecapPublishedHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.campaigns == nil || h.participants == nil || h.notifications == nil {
+		return Permanent(fmt.Errorf("session recap published handler dependencies are not configured"))
+	}
+	payload, err := decodeSessionRecapOutboxPayload(event, "session recap published")
+	if err != nil {
+		return Permanent(err)
+	}
+	callCtx := workerGameReadContext(ctx)
+	campaignResp, err := h.campaigns.GetCampaign(callCtx, &gamev1.GetCampaignRequest{CampaignId: payload.CampaignID})
+	if err != nil {
+		return classifyGameReadError("load campaign", err)
+	}
+	campaignName := strings.TrimSpace(campaignResp.GetCampaign().GetName())
+
+	recipients, err := h.listPlayerUserIDs(callCtx, payload.CampaignID)
+	if err != nil {
+		return err
+	}
+	message := sessionRecapPublishedMessagePayload(payload.CampaignID, campaignName)
+	dedupeKey := SessionRecapPublishedNotificationDedupeKey(payload.CampaignID, payload.SessionID)
+	for _, userID := range recipients {
+		if err := createNotification(ctx, h.notifications, userID, SessionRecapPublishedMessageType, message, dedupeKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listPlayerUserIDs returns the bound user IDs of every player seat.
+func (h *SessionRecapPublishedHandler) listPlayerUserIDs(ctx context.Context, campaignID string) ([]string, error) {
+	var userIDs []string
+	pageToken := ""
+	for {
+		resp, err := h.participants.ListParticipants(ctx, &gamev1.ListParticipantsRequest{
+			CampaignId: campaignID,
+			PageSize:   sessionRecapParticipantPageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, classifyGameReadError("list participants", err)
+		}
+		for _, participant := range resp.GetParticipants() {
+			userID := strings.TrimSpace(participant.GetUserId())
+			if userID == "" || participant.GetRole() != gamev1.ParticipantRole_PLAYER {
+				continue
+			}
+			userIDs = append(userIDs, userID)
+		}
+		pageToken = strings.TrimSpace(resp.GetNextPageToken())
+		if pageToken == "" {
+			return userIDs, nil
+		}
+	}
+}
+
+// SessionRecapPublishedNotificationDedupeKey returns a dedupe key that keeps
+// each player to one notification per session even if the recap is replaced.
+func SessionRecapPublishedNotificationDedupeKey(campaignID, sessionID string) string {
+	return "session-recap:" + campaignID + ":" + sessionID + ":published"
+}
+
+func sessionRecapPublishedMessagePayload(campaignID, campaignName string) notificationpayload.InAppPayload {
+	body := platformi18n.NewCopyRef("notification.session_recap.published.body")
+	if campaignName != "" {
+		body = platformi18n.NewCopyRef("notification.session_recap.published.body_summary", campaignName)
+	}
+	return notificationpayload.InAppPayload{
+		Title:   platformi18n.NewCopyRef("notification.session_recap.published.title"),
+		Body:    body,
+		Facts:   inviteFacts(campaignName, "", ""),
+		Actions: []notificationpayload.PayloadAction{notificationpayload.OpenCampaignAction(campaignID)},
+	}
+}
+
+func decodeSessionRecapOutboxPayload(event OutboxEvent, label string) (gameintegration.SessionRecapOutboxPayload, error) {
+	if event == nil {
+		return gameintegration.SessionRecapOutboxPayload{}, fmt.Errorf("outbox event is required")
+	}
+	var payload gameintegration.SessionRecapOutboxPayload
+	if err := json.Unmarshal([]byte(event.GetPayloadJson()), &payload); err != nil {
+		return payload, fmt.Errorf("decode %s payload: %w", label, err)
+	}
+	payload.CampaignID = strings.TrimSpace(payload.CampaignID)
+	payload.SessionID = strings.TrimSpace(payload.SessionID)
+	switch {
+	case payload.CampaignID == "":
+		return payload, fmt.Errorf("campaign_id is required in %s payload", label)
+	case payload.SessionID == "":
+		return payload, fmt.Errorf("session_id is required in %s payload", label)
+	}
+	return payload, nil
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	aiv1 "github.com/louisbranch/fracturing.space/api/gen/go/ai/v1"
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	playv1 "github.com/louisbranch/fracturing.space/api/gen/go/play/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/shared/notificationpayload"
+	"google.golang.org/grpc"
+)
+
+const sessionRecapPayloadJSON = `{"campaign_id":"campaign-1","session_id":"session-1"}`
+
+type fakeSessionRecapSourceClient struct {
+	resp *gamev1.GetSessionRecapSourcesResponse
+	err  error
+}
+
+func (f *fakeSessionRecapSourceClient) GetSessionRecapSources(context.Context, *gamev1.GetSessionRecapSourcesRequest, ...grpc.CallOption) (*gamev1.GetSessionRecapSourcesResponse, error) {
+	return f.resp, f.err
+}
+
+type fakeTranscriptClient struct {
+	pages    []*playv1.ListSessionTranscriptResponse
+	requests []*playv1.ListSessionTranscriptRequest
+}
+
+func (f *fakeTranscriptClient) ListSessionTranscript(_ context.Context, in *playv1.ListSessionTranscriptRequest, _ ...grpc.CallOption) (*playv1.ListSessionTranscriptResponse, error) {
+	f.requests = append(f.requests, in)
+	if len(f.pages) == 0 {
+		return &playv1.ListSessionTranscriptResponse{}, nil
+	}
+	page := f.pages[0]
+	f.pages = f.pages[1:]
+	return page, nil
+}
+
+type fakeSessionRecapDraftClient struct {
+	requests []*aiv1.DraftSessionRecapRequest
+}
+
+func (f *fakeSessionRecapDraftClient) DraftSessionRecap(_ context.Context, in *aiv1.DraftSessionRecapRequest, _ ...grpc.CallOption) (*aiv1.DraftSessionRecapResponse, error) {
+	f.requests = append(f.requests, in)
+	return &aiv1.DraftSessionRecapResponse{}, nil
+}
+
+type fakeParticipantLister struct {
+	participants []*gamev1.Participant
+}
+
+func (f *fakeParticipantLister) ListParticipants(context.Context, *gamev1.ListParticipantsRequest, ...grpc.CallOption) (*gamev1.ListParticipantsResponse, error) {
+	return &gamev1.ListParticipantsResponse{Participants: f.participants}, nil
+}
+
+func TestSessionRecapDraftRequestedHandlerSkipsWithoutAgentOrWhenRecorded(t *testing.T) {
+	for name, resp := range map[string]*gamev1.GetSessionRecapSourcesResponse{
+		"no agent":       {AiAgentBound: false},
+		"recap recorded": {AiAgentBound: true, RecapRecorded: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			drafts := &fakeSessionRecapDraftClient{}
+			handler := NewSessionRecapDraftRequestedHandler(&fakeSessionRecapSourceClient{resp: resp}, nil, drafts)
+			if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: sessionRecapPayloadJSON}); err != nil {
+				t.Fatalf("handle: %v", err)
+			}
+			if len(drafts.requests) != 0 {
+				t.Fatalf("draft requests = %d, want 0", len(drafts.requests))
+			}
+		})
+	}
+}
+
+func TestSessionRecapDraftRequestedHandlerDraftsFromSourcesAndTranscript(t *testing.T) {
+	sources := &fakeSessionRecapSourceClient{resp: &gamev1.GetSessionRecapSourcesResponse{
+		CampaignName: "Ashen Road",
+		SessionName:  "Session 3",
+		AiAgentBound: true,
+		Participants: []*gamev1.SessionRecapSourceParticipant{{ParticipantId: "p-1", Name: "Mira"}},
+	}}
+	transcripts := &fakeTranscriptClient{pages: []*playv1.ListSessionTranscriptResponse{
+		{Messages: []*playv1.TranscriptMessage{{SequenceId: 1, ParticipantId: "p-1", Body: "We take the bridge."}}, HasMore: true},
+		{Messages: []*playv1.TranscriptMessage{{SequenceId: 2, ParticipantName: "Oren", Body: "I cover the rear."}}},
+	}}
+	drafts := &fakeSessionRecapDraftClient{}
+	handler := NewSessionRecapDraftRequestedHandler(sources, transcripts, drafts)
+
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: sessionRecapPayloadJSON}); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(transcripts.requests) != 2 || transcripts.requests[1].GetAfterSequenceId() != 1 {
+		t.Fatalf("transcript requests = %+v, want two pages continuing after sequence 1", transcripts.requests)
+	}
+	if len(drafts.requests) != 1 {
+		t.Fatalf("draft requests = %d, want 1", len(drafts.requests))
+	}
+	req := drafts.requests[0]
+	if req.GetCampaignId() != "campaign-1" || req.GetSessionId() != "session-1" {
+		t.Fatalf("draft scope = %s/%s, want campaign-1/session-1", req.GetCampaignId(), req.GetSessionId())
+	}
+	for _, want := range []string{"Campaign: Ashen Road", "- Mira: We take the bridge.", "- Oren: I cover the rear."} {
+		if !strings.Contains(req.GetSourceMarkdown(), want) {
+			t.Fatalf("source markdown missing %q:\n%s", want, req.GetSourceMarkdown())
+		}
+	}
+}
+
+func TestSessionRecapPublishedHandlerNotifiesPlayers(t *testing.T) {
+	notifications := &fakeNotificationClient{}
+	handler := NewSessionRecapPublishedHandler(
+		&fakeCampaignReader{campaign: &gamev1.Campaign{Id: "campaign-1", Name: "Ashen Road"}},
+		&fakeParticipantLister{participants: []*gamev1.Participant{
+			{Id: "gm-1", UserId: "user-gm", Role: gamev1.ParticipantRole_GM},
+			{Id: "p-1", UserId: "user-1", Role: gamev1.ParticipantRole_PLAYER},
+			{Id: "p-2", Role: gamev1.ParticipantRole_PLAYER},
+		}},
+		notifications,
+	)
+
+	if err := handler.Handle(context.Background(), outboxEventStub{payloadJSON: sessionRecapPayloadJSON}); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(notifications.requests) != 1 {
+		t.Fatalf("notification requests = %d, want 1", len(notifications.requests))
+	}
+	req := notifications.requests[0]
+	if req.GetRecipientUserId() != "user-1" || req.GetMessageType() != SessionRecapPublishedMessageType {
+		t.Fatalf("notification = %+v, want player recap notification", req)
+	}
+	if req.GetDedupeKey() != SessionRecapPublishedNotificationDedupeKey("campaign-1", "session-1") {
+		t.Fatalf("dedupe key = %q", req.GetDedupeKey())
+	}
+	var payload notificationpayload.InAppPayload
+	if err := json.Unmarshal([]byte(req.GetPayloadJson()), &payload); err != nil {
+		t.Fatalf("unmarshal payload json: %v", err)
+	}
+	if payload.Body.Key != "notification.session_recap.published.body_summary" {
+		t.Fatalf("body key = %q, want campaign summary key", payload.Body.Key)
+	}
+}
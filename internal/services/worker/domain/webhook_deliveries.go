@@ -0,0 +1,251 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// webhookDeliveryTimeout bounds one POST so a slow receiver cannot hold a
+	// worker lease.
+	webhookDeliveryTimeout = 10 * time.Second
+	// webhookResponseDrainLimit caps how much of a response body is read
+	// before the connection is released.
+	webhookResponseDrainLimit = 64 << 10
+	// webhookErrorLimit truncates receiver errors stored in the delivery log.
+	webhookErrorLimit = 500
+	// webhookDialTimeout bounds connection setup within the delivery timeout.
+	webhookDialTimeout = 5 * time.Second
+)
+
+// errWebhookAddressBlocked marks a delivery refused because the endpoint
+// resolved to an address the worker must not reach.
+var errWebhookAddressBlocked = errors.New("webhook endpoint resolves to a blocked address")
+
+type workerWebhookClient interface {
+	GetWebhookDeliveryTarget(ctx context.Context, in *gamev1.GetWebhookDeliveryTargetRequest, opts ...grpc.CallOption) (*gamev1.GetWebhookDeliveryTargetResponse, error)
+	RecordWebhookDelivery(ctx context.Context, in *gamev1.RecordWebhookDeliveryRequest, opts ...grpc.CallOption) (*gamev1.RecordWebhookDeliveryResponse, error)
+}
+
+// WebhookDeliveryHandler POSTs one campaign event to one registered webhook
+// endpoint and records the attempt in the endpoint's delivery log.
+//
+// Retries, backoff, and dead-lettering come from the outbox loop: a 2xx
+// response succeeds, 4xx responses other than 408 and 429 are permanent, and
+// everything else is retried until the loop's attempt budget runs out.
+type WebhookDeliveryHandler struct {
+	webhooks    workerWebhookClient
+	httpClient  *http.Client
+	maxAttempts int
+	clock       func() time.Time
+}
+
+// NewWebhookDeliveryHandler creates the webhook delivery handler. maxAttempts
+// should match the outbox loop so the log can mark the final attempt dead. A
+// nil httpClient uses NewWebhookHTTPClient(false).
+func NewWebhookDeliveryHandler(webhooks workerWebhookClient, httpClient *http.Client, maxAttempts int, clock func() time.Time) *WebhookDeliveryHandler {
+	if httpClient == nil {
+		httpClient = NewWebhookHTTPClient(false)
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+	return &WebhookDeliveryHandler{
+		webhooks:    webhooks,
+		httpClient:  httpClient,
+		maxAttempts: maxAttempts,
+		clock:       clock,
+	}
+}
+
+// NewWebhookHTTPClient returns the client webhook deliveries are sent with.
+//
+// Endpoint URLs are user supplied, so the client refuses to connect to
+// loopback and the private and reserved ranges that
+// gameintegration.IsBlockedWebhookAddr blocks. The check runs on the resolved
+// address at dial time, which also covers hostnames that resolve or rebind to
+// internal addresses. Redirects are returned as the response
+// rather than followed, so a receiver cannot bounce the request inward.
+// allowLoopback admits loopback addresses for local development receivers.
+func NewWebhookHTTPClient(allowLoopback bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookDialTimeout,
+		Control: webhookDialControl(allowLoopback),
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   webhookDeliveryTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// webhookDialControl rejects connections to addresses webhook deliveries must
+// not reach.
+func webhookDialControl(allowLoopback bool) func(network, address string, _ syscall.RawConn) error {
+	return func(network, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errWebhookAddressBlocked, address)
+		}
+		addr, err := netip.ParseAddr(host)
+		if err != nil || gameintegration.IsBlockedWebhookAddr(addr, allowLoopback) {
+			return fmt.Errorf("%w: %s", errWebhookAddressBlocked, host)
+		}
+		return nil
+	}
+}
+
+func (h *WebhookDeliveryHandler) Handle(ctx context.Context, event OutboxEvent) error {
+	if h == nil || h.webhooks == nil {
+		return Permanent(fmt.Errorf("webhook delivery handler dependencies are not configured"))
+	}
+	payload, err := decodeWebhookDeliveryOutboxPayload(event)
+	if err != nil {
+		return Permanent(err)
+	}
+	callCtx := serviceContext(ctx)
+	targetReq := &gamev1.GetWebhookDeliveryTargetRequest{WebhookId: payload.WebhookID}
+	if !payload.Event.Test {
+		targetReq.EventSeq = payload.Event.Seq
+	}
+	target, err := h.webhooks.GetWebhookDeliveryTarget(callCtx, targetReq)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// The endpoint was deleted after the delivery was queued.
+			return nil
+		}
+		return classifyGameReadError("get webhook delivery target", err)
+	}
+	// The outbox row captured the payload at append time; game returns it as
+	// reads see it now, so identity redacted since then is never sent.
+	if current := target.GetEventPayloadJson(); current != "" {
+		payload.Event.Payload = json.RawMessage(current)
+	}
+
+	body, err := json.Marshal(payload.Event)
+	if err != nil {
+		return Permanent(fmt.Errorf("encode webhook event: %w", err))
+	}
+	started := h.clock()
+	responseStatus, deliveryErr := h.post(ctx, event.GetId(), target, payload.Event.Type, body, started)
+	attempt := int(event.GetAttemptCount()) + 1
+
+	deliveryStatus := gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	errText := ""
+	if deliveryErr != nil {
+		deliveryStatus = gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+		if IsPermanent(deliveryErr) || (h.maxAttempts > 0 && attempt >= h.maxAttempts) {
+			deliveryStatus = gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+		}
+		errText = truncateWebhookError(deliveryErr)
+	}
+	// The delivery log is best effort: failing to record an attempt must not
+	// re-send an event the receiver already accepted.
+	_, _ = h.webhooks.RecordWebhookDelivery(callCtx, &gamev1.RecordWebhookDeliveryRequest{Delivery: &gamev1.WebhookDelivery{
+		WebhookId:      target.GetWebhookId(),
+		CampaignId:     target.GetCampaignId(),
+		DeliveryId:     event.GetId(),
+		EventType:      payload.Event.Type,
+		EventSeq:       payload.Event.Seq,
+		Attempt:        int32(attempt),
+		Status:         deliveryStatus,
+		ResponseStatus: int32(responseStatus),
+		Error:          errText,
+		DurationMs:     h.clock().Sub(started).Milliseconds(),
+		Test:           payload.Event.Test,
+		CreatedAt:      timestamppb.New(started),
+	}})
+	return deliveryErr
+}
+
+// post sends one signed request and returns the receiver's status code, or 0
+// when no response arrived.
+func (h *WebhookDeliveryHandler) post(ctx context.Context, deliveryID string, target *gamev1.GetWebhookDeliveryTargetResponse, eventType string, body []byte, now time.Time) (int, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, webhookDeliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, target.GetUrl(), bytes.NewReader(body))
+	if err != nil {
+		return 0, Permanent(fmt.Errorf("build webhook request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "fracturing-space-webhooks/1")
+	req.Header.Set(gameintegration.WebhookSignatureHeader, gameintegration.SignWebhookPayload(target.GetSigningSecret(), now, body))
+	req.Header.Set(gameintegration.WebhookEventHeader, eventType)
+	req.Header.Set(gameintegration.WebhookDeliveryHeader, deliveryID)
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, errWebhookAddressBlocked) {
+			return 0, Permanent(fmt.Errorf("post webhook: %w", err))
+		}
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookResponseDrainLimit))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return resp.StatusCode, nil
+	case isPermanentWebhookStatus(resp.StatusCode):
+		return resp.StatusCode, Permanent(fmt.Errorf("webhook endpoint rejected delivery: %s", resp.Status))
+	default:
+		return resp.StatusCode, fmt.Errorf("webhook endpoint returned %s", resp.Status)
+	}
+}
+
+// isPermanentWebhookStatus treats client errors as final, except timeouts and
+// rate limits which the receiver expects to be retried.
+func isPermanentWebhookStatus(code int) bool {
+	if code == http.StatusRequestTimeout || code == http.StatusTooManyRequests {
+		return false
+	}
+	return code >= 400 && code < 500
+}
+
+func truncateWebhookError(err error) string {
+	value := strings.TrimSpace(err.Error())
+	if len(value) > webhookErrorLimit {
+		value = value[:webhookErrorLimit]
+	}
+	return value
+}
+
+func decodeWebhookDeliveryOutboxPayload(event OutboxEvent) (gameintegration.WebhookDeliveryOutboxPayload, error) {
+	if event == nil {
+		return gameintegration.WebhookDeliveryOutboxPayload{}, fmt.Errorf("outbox event is required")
+	}
+	var payload gameintegration.WebhookDeliveryOutboxPayload
+	if err := json.Unmarshal([]byte(event.GetPayloadJson()), &payload); err != nil {
+		return payload, fmt.Errorf("decode webhook delivery payload: %w", err)
+	}
+	payload.WebhookID = strings.TrimSpace(payload.WebhookID)
+	switch {
+	case payload.WebhookID == "":
+		return payload, fmt.Errorf("webhook_id is required in webhook delivery payload")
+	case strings.TrimSpace(payload.Event.Type) == "":
+		return payload, fmt.Errorf("event.type is required in webhook delivery payload")
+	}
+	return payload, nil
+}
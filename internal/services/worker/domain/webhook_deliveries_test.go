@@ -0,0 +1,294 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	gameintegration "github.com/louisbranch/fracturing.space/internal/services/game/integration"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const webhookTestSecret = "whsec_test"
+
+type fakeWebhookClient struct {
+	target    *gamev1.GetWebhookDeliveryTargetResponse
+	targetErr error
+	targetReq *gamev1.GetWebhookDeliveryTargetRequest
+	recorded  []*gamev1.WebhookDelivery
+}
+
+func (f *fakeWebhookClient) GetWebhookDeliveryTarget(_ context.Context, in *gamev1.GetWebhookDeliveryTargetRequest, _ ...grpc.CallOption) (*gamev1.GetWebhookDeliveryTargetResponse, error) {
+	f.targetReq = in
+	return f.target, f.targetErr
+}
+
+func (f *fakeWebhookClient) RecordWebhookDelivery(_ context.Context, in *gamev1.RecordWebhookDeliveryRequest, _ ...grpc.CallOption) (*gamev1.RecordWebhookDeliveryResponse, error) {
+	f.recorded = append(f.recorded, in.GetDelivery())
+	return &gamev1.RecordWebhookDeliveryResponse{}, nil
+}
+
+type webhookOutboxEventStub struct {
+	payloadJSON string
+	attempts    int32
+}
+
+func (e webhookOutboxEventStub) GetId() string          { return "outbox-1" }
+func (e webhookOutboxEventStub) GetEventType() string   { return "webhook.test" }
+func (e webhookOutboxEventStub) GetPayloadJson() string { return e.payloadJSON }
+func (e webhookOutboxEventStub) GetAttemptCount() int32 { return e.attempts }
+
+func webhookDeliveryPayloadJSON(t *testing.T) string {
+	t.Helper()
+	raw, err := json.Marshal(gameintegration.WebhookDeliveryOutboxPayload{
+		WebhookID: "wh-1",
+		Event: gameintegration.WebhookEvent{
+			CampaignID: "camp-1",
+			Seq:        7,
+			Type:       "session.started",
+			Timestamp:  time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+			Payload:    json.RawMessage(`{"session_id":"sess-1"}`),
+		},
+	})
+	if err != nil {
+		t.Fatalf("encode payload: %v", err)
+	}
+	return string(raw)
+}
+
+func newWebhookReceiver(t *testing.T, statusCode int) (*httptest.Server, *[]gameintegration.WebhookEvent) {
+	t.Helper()
+	var received []gameintegration.WebhookEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read body: %v", err)
+		}
+		if err := gameintegration.VerifyWebhookSignature(webhookTestSecret, r.Header.Get(gameintegration.WebhookSignatureHeader), body, time.Now(), 5*time.Minute); err != nil {
+			t.Errorf("verify signature: %v", err)
+		}
+		if got := r.Header.Get(gameintegration.WebhookDeliveryHeader); got != "outbox-1" {
+			t.Errorf("delivery header = %q, want outbox-1", got)
+		}
+		if got := r.Header.Get(gameintegration.WebhookEventHeader); got != "session.started" {
+			t.Errorf("event header = %q, want session.started", got)
+		}
+		var evt gameintegration.WebhookEvent
+		if err := json.Unmarshal(body, &evt); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		received = append(received, evt)
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+	return server, &received
+}
+
+func webhookTarget(url string) *gamev1.GetWebhookDeliveryTargetResponse {
+	return &gamev1.GetWebhookDeliveryTargetResponse{
+		WebhookId:     "wh-1",
+		CampaignId:    "camp-1",
+		Url:           url,
+		SigningSecret: webhookTestSecret,
+	}
+}
+
+func TestWebhookDeliveryHandlerSendsCurrentEventPayload(t *testing.T) {
+	server, received := newWebhookReceiver(t, http.StatusOK)
+	target := webhookTarget(server.URL)
+	target.EventPayloadJson = `{"participant_id":"p1","user_id":"","name":"Former participant"}`
+	client := &fakeWebhookClient{target: target}
+	handler := NewWebhookDeliveryHandler(client, server.Client(), 8, nil)
+
+	if err := handler.Handle(context.Background(), webhookOutboxEventStub{payloadJSON: webhookDeliveryPayloadJSON(t)}); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if client.targetReq.GetEventSeq() != 7 {
+		t.Fatalf("target request event seq = %d, want 7", client.targetReq.GetEventSeq())
+	}
+	if len(*received) != 1 || string((*received)[0].Payload) != target.EventPayloadJson {
+		t.Fatalf("received = %+v, want current payload", *received)
+	}
+}
+
+func TestWebhookDeliveryHandlerPostsSignedEventAndRecordsSuccess(t *testing.T) {
+	server, received := newWebhookReceiver(t, http.StatusNoContent)
+	client := &fakeWebhookClient{target: webhookTarget(server.URL)}
+	handler := NewWebhookDeliveryHandler(client, server.Client(), 8, nil)
+
+	if err := handler.Handle(context.Background(), webhookOutboxEventStub{payloadJSON: webhookDeliveryPayloadJSON(t)}); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(*received) != 1 || (*received)[0].Seq != 7 || string((*received)[0].Payload) != `{"session_id":"sess-1"}` {
+		t.Fatalf("received = %+v, want seq 7 with payload", *received)
+	}
+	if len(client.recorded) != 1 {
+		t.Fatalf("recorded = %d, want 1", len(client.recorded))
+	}
+	got := client.recorded[0]
+	if got.GetStatus() != gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED || got.GetResponseStatus() != http.StatusNoContent || got.GetAttempt() != 1 || got.GetDeliveryId() != "outbox-1" {
+		t.Fatalf("recorded delivery = %+v", got)
+	}
+}
+
+func TestWebhookDeliveryHandlerClassifiesReceiverFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		statusCode    int
+		attempts      int32
+		wantPermanent bool
+		wantStatus    gamev1.WebhookDeliveryStatus
+	}{
+		{name: "server error retries", statusCode: http.StatusBadGateway, wantStatus: gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED},
+		{name: "rate limit retries", statusCode: http.StatusTooManyRequests, wantStatus: gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED},
+		{name: "client error is permanent", statusCode: http.StatusGone, wantPermanent: true, wantStatus: gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD},
+		{name: "last attempt is dead", statusCode: http.StatusInternalServerError, attempts: 2, wantStatus: gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server, _ := newWebhookReceiver(t, tc.statusCode)
+			client := &fakeWebhookClient{target: webhookTarget(server.URL)}
+			handler := NewWebhookDeliveryHandler(client, server.Client(), 3, nil)
+
+			err := handler.Handle(context.Background(), webhookOutboxEventStub{payloadJSON: webhookDeliveryPayloadJSON(t), attempts: tc.attempts})
+			if err == nil {
+				t.Fatal("expected delivery error")
+			}
+			if IsPermanent(err) != tc.wantPermanent {
+				t.Fatalf("permanent = %v, want %v (err %v)", IsPermanent(err), tc.wantPermanent, err)
+			}
+			if len(client.recorded) != 1 {
+				t.Fatalf("recorded = %d, want 1", len(client.recorded))
+			}
+			got := client.recorded[0]
+			if got.GetStatus() != tc.wantStatus || got.GetResponseStatus() != int32(tc.statusCode) || got.GetAttempt() != tc.attempts+1 {
+				t.Fatalf("recorded delivery = %+v, want status %v", got, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveryHandlerDropsDeletedEndpoint(t *testing.T) {
+	client := &fakeWebhookClient{targetErr: status.Error(codes.NotFound, "webhook not found")}
+	handler := NewWebhookDeliveryHandler(client, nil, 8, nil)
+
+	if err := handler.Handle(context.Background(), webhookOutboxEventStub{payloadJSON: webhookDeliveryPayloadJSON(t)}); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if len(client.recorded) != 0 {
+		t.Fatalf("recorded = %d, want 0", len(client.recorded))
+	}
+}
+
+func TestWebhookDeliveryHandlerRejectsMalformedPayload(t *testing.T) {
+	handler := NewWebhookDeliveryHandler(&fakeWebhookClient{}, nil, 8, nil)
+	err := handler.Handle(context.Background(), webhookOutboxEventStub{payloadJSON: `{"webhook_id":""}`})
+	if !IsPermanent(err) {
+		t.Fatalf("err = %v, want permanent", err)
+	}
+}
+
+func TestWebhookDialControlRejectsInternalAddresses(t *testing.T) {
+	control := webhookDialControl(false)
+	for name, address := range map[string]string{
+		"loopback ipv4":        "127.0.0.1:443",
+		"loopback ipv6":        "[::1]:443",
+		"mapped loopback":      "[::ffff:127.0.0.1]:443",
+		"private 10/8":         "10.1.2.3:443",
+		"private 172.16/12":    "172.16.0.1:443",
+		"private 192.168/16":   "192.168.1.1:443",
+		"private ipv6":         "[fd00::1]:443",
+		"link-local ipv4":      "169.254.169.254:80",
+		"link-local ipv6":      "[fe80::1]:443",
+		"unspecified ipv4":     "0.0.0.0:443",
+		"unspecified ipv6":     "[::]:443",
+		"link-local multicast": "224.0.0.1:443",
+		"this network":         "0.1.2.3:443",
+		"carrier-grade nat":    "100.64.0.1:443",
+		"benchmarking":         "198.18.0.1:443",
+		"reserved ipv4":        "240.0.0.1:443",
+		"nat64":                "[64:ff9b::a9fe:a9fe]:443",
+		"mapped private":       "[::ffff:10.0.0.1]:443",
+		"6to4":                 "[2002:a00:1::1]:443",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := control("tcp", address, nil); !errors.Is(err, errWebhookAddressBlocked) {
+				t.Fatalf("control(%s) = %v, want blocked", address, err)
+			}
+		})
+	}
+	if err := control("tcp", "93.184.216.34:443", nil); err != nil {
+		t.Fatalf("public address rejected: %v", err)
+	}
+	if err := webhookDialControl(true)("tcp", "127.0.0.1:8080", nil); err != nil {
+		t.Fatalf("loopback rejected with allowLoopback: %v", err)
+	}
+	if err := webhookDialControl(true)("tcp", "10.1.2.3:443", nil); err == nil {
+		t.Fatal("private address accepted with allowLoopback")
+	}
+}
+
+func TestWebhookDeliveryHandlerDefaultClientRefusesLoopback(t *testing.T) {
+	hit := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hit = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	client := &fakeWebhookClient{target: &gamev1.GetWebhookDeliveryTargetResponse{
+		WebhookId:     "wh-1",
+		CampaignId:    "camp-1",
+		Url:           server.URL,
+		SigningSecret: webhookTestSecret,
+	}}
+	handler := NewWebhookDeliveryHandler(client, nil, 8, nil)
+
+	err := handler.Handle(context.Background(), webhookOutboxEventStub{payloadJSON: webhookDeliveryPayloadJSON(t)})
+	if !IsPermanent(err) || !errors.Is(err, errWebhookAddressBlocked) {
+		t.Fatalf("err = %v, want permanent blocked address", err)
+	}
+	if hit {
+		t.Fatal("default client reached a loopback receiver")
+	}
+	if len(client.recorded) != 1 || client.recorded[0].GetStatus() != gamev1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD {
+		t.Fatalf("recorded = %+v, want one dead delivery", client.recorded)
+	}
+}
+
+func TestWebhookHTTPClientDoesNotFollowRedirects(t *testing.T) {
+	followed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			followed = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+	client := &fakeWebhookClient{target: &gamev1.GetWebhookDeliveryTargetResponse{
+		WebhookId:     "wh-1",
+		CampaignId:    "camp-1",
+		Url:           server.URL + "/hook",
+		SigningSecret: webhookTestSecret,
+	}}
+	handler := NewWebhookDeliveryHandler(client, NewWebhookHTTPClient(true), 8, nil)
+
+	if err := handler.Handle(context.Background(), webhookOutboxEventStub{payloadJSON: webhookDeliveryPayloadJSON(t)}); err == nil {
+		t.Fatal("expected redirect response to fail the delivery")
+	}
+	if followed {
+		t.Fatal("webhook client followed a redirect")
+	}
+	if len(client.recorded) != 1 || client.recorded[0].GetResponseStatus() != http.StatusTemporaryRedirect {
+		t.Fatalf("recorded = %+v, want the redirect status", client.recorded)
+	}
+}
@@ -0,0 +1,148 @@
+//go:build integration
+
+package integration
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/orchestration/cassette"
+	evalsupport "github.com/louisbranch/fracturing.space/internal/test/aieval"
+)
+
+const (
+	// integrationAICassettePathEnv pins the cassette lane to one recorded file.
+	integrationAICassettePathEnv = "INTEGRATION_AI_CASSETTE_PATH"
+	// providerCassetteDir holds committed cassettes named <scenario>.json.
+	providerCassetteDir = "internal/test/integration/fixtures/ai_cassettes"
+	// unroutableResponsesURL proves replay never reaches a provider.
+	unroutableResponsesURL = "http://127.0.0.1:1/v1/responses"
+)
+
+// cassetteReplayScenarios lists the specs the offline cassette lane can drive.
+var cassetteReplayScenarios = []aiGMCampaignScenarioSpec{
+	aiGMBootstrapScenario,
+	aiGMReviewAdvanceScenario,
+	aiGMOOCReplaceScenario,
+	aiGMSceneSwitchScenario,
+	aiGMHopeExperienceScenario,
+	aiGMStanceCapabilityScenario,
+	aiGMNarratorAuthorityScenario,
+}
+
+// TestAIGMCampaignContextCassetteReplay replays provider cassettes recorded by
+// the live lane through the real AI tool loop and game RPCs without network.
+// Every offline spec must have a committed cassette, and every committed
+// cassette must map to a spec, so the replay path cannot silently go untested.
+func TestAIGMCampaignContextCassetteReplay(t *testing.T) {
+	if path := strings.TrimSpace(os.Getenv(integrationAICassettePathEnv)); path != "" {
+		scenario := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		runAIGMCampaignContextCassetteScenario(t, cassetteReplaySpec(t, scenario), path)
+		return
+	}
+	dir := filepath.Join(repoRoot(t), providerCassetteDir)
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatalf("glob provider cassettes: %v", err)
+	}
+	for _, path := range paths {
+		cassetteReplaySpec(t, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+	}
+	for _, spec := range cassetteReplayScenarios {
+		path := filepath.Join(dir, spec.Name+".json")
+		t.Run(spec.Name, func(t *testing.T) {
+			if _, err := os.Stat(path); err != nil {
+				t.Fatalf("provider cassette for %q is missing (%v); record it with go run ./cmd/aieval --scenario %s --cassette-mode record", spec.Name, err, spec.Name)
+			}
+			runAIGMCampaignContextCassetteScenario(t, spec, path)
+		})
+	}
+}
+
+// cassetteReplaySpec resolves the offline spec a cassette file replays.
+func cassetteReplaySpec(t *testing.T, scenario string) aiGMCampaignScenarioSpec {
+	t.Helper()
+	idx := slices.IndexFunc(cassetteReplayScenarios, func(spec aiGMCampaignScenarioSpec) bool {
+		return spec.Name == scenario
+	})
+	if idx < 0 {
+		t.Fatalf("provider cassette %q has no offline cassette spec", scenario)
+	}
+	return cassetteReplayScenarios[idx]
+}
+
+func runAIGMCampaignContextCassetteScenario(t *testing.T, spec aiGMCampaignScenarioSpec, path string) {
+	t.Helper()
+	recorded, err := cassette.Load(path)
+	if err != nil {
+		t.Fatalf("load provider cassette: %v", err)
+	}
+	model := ""
+	if len(recorded.Turns) > 0 && len(recorded.Turns[0].Exchanges) > 0 {
+		model = recorded.Turns[0].Exchanges[0].Input.Model
+	}
+
+	t.Setenv("FRACTURING_SPACE_AI_PROVIDER_CASSETTE_MODE", string(cassette.ModeReplay))
+	t.Setenv("FRACTURING_SPACE_AI_PROVIDER_CASSETTE_PATH", path)
+	result := runAIGMCampaignContextScenario(t, spec, aiGMCampaignScenarioOptions{
+		ResponsesURL:     unroutableResponsesURL,
+		Model:            model,
+		CredentialSecret: "cassette-replay-token",
+		AgentLabel:       "cassette-gm",
+	})
+	writeCassetteEvalOutputIfRequested(t, spec, recorded, result, path)
+	if result.RunStatus != evalsupport.RunStatusPassed {
+		t.Fatalf("cassette replay failed: %s (%s)", result.FailureSummary, result.FailureReason)
+	}
+	if err := requiredToolSetPresent(recorded.ToolNames(), spec.RequiredToolSet...); err != nil {
+		t.Fatalf("cassette tool coverage: %v", err)
+	}
+	spec.Assert(t, result)
+}
+
+// writeCassetteEvalOutputIfRequested lets cmd/aieval consume offline replays
+// through the same Promptfoo JSON contract as the live lane.
+func writeCassetteEvalOutputIfRequested(t *testing.T, spec aiGMCampaignScenarioSpec, recorded *cassette.Cassette, result aiGMCampaignScenarioResult, path string) {
+	t.Helper()
+	outputPath := strings.TrimSpace(os.Getenv(integrationAIEvalOutputPathEnv))
+	if outputPath == "" {
+		return
+	}
+	label := spec.Name
+	if scenario, ok := evalsupport.ScenarioByID(spec.Name); ok {
+		label = scenario.Label
+	}
+	model := ""
+	if len(recorded.Turns) > 0 && len(recorded.Turns[0].Exchanges) > 0 {
+		model = recorded.Turns[0].Exchanges[0].Input.Model
+	}
+	output := evalsupport.Output{
+		CaseID:         strings.TrimSpace(os.Getenv(integrationAIEvalCaseIDEnv)),
+		Scenario:       spec.Name,
+		Label:          label,
+		Model:          model,
+		PromptProfile:  strings.TrimSpace(os.Getenv(integrationAIPromptProfileEnv)),
+		RunStatus:      result.RunStatus,
+		MetricStatus:   result.MetricStatus,
+		FailureKind:    result.FailureKind,
+		FailureSummary: result.FailureSummary,
+		FailureReason:  result.FailureReason,
+		ToolNames:      recorded.ToolNames(),
+		OutputText:     result.OutputText,
+		MemoryContent:  result.MemoryContent,
+		SkillsReadOnly: result.SkillsReadOnly,
+		TurnCount:      len(recorded.Turns),
+		Artifacts:      evalsupport.ArtifactPaths{RawCapture: path},
+	}
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal cassette eval output: %v", err)
+	}
+	if err := os.WriteFile(outputPath, append(data, '\n'), 0o644); err != nil {
+		t.Fatalf("write cassette eval output: %v", err)
+	}
+}
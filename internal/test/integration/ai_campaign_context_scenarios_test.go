@@ -22,6 +22,8 @@ import (
 	grpcauthctx "github.com/louisbranch/fracturing.space/internal/services/shared/grpcauthctx"
 	evalsupport "github.com/louisbranch/fracturing.space/internal/test/aieval"
 	"github.com/louisbranch/fracturing.space/internal/test/testkit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -503,12 +505,10 @@ func runAIGMCampaignContextScenario(t *testing.T, spec aiGMCampaignScenarioSpec,
 		t.Fatalf("create campaign: %v", err)
 	}
 	campaignID := campaignResp.GetCampaign().GetId()
-	if _, err := campaignClient.SetCampaignAIBinding(ctxWithUser, &gamev1.SetCampaignAIBindingRequest{
+	setCampaignAIBindingWhenReachable(t, campaignClient, ctxWithUser, &gamev1.SetCampaignAIBindingRequest{
 		CampaignId: campaignID,
 		AiAgentId:  agentResp.GetAgent().GetId(),
-	}); err != nil {
-		t.Fatalf("set campaign ai binding: %v", err)
-	}
+	})
 	participantsResp, err := participantClient.ListParticipants(ctxWithUser, &gamev1.ListParticipantsRequest{
 		CampaignId: campaignID,
 		PageSize:   50,
@@ -1373,12 +1373,10 @@ func runMultiTurnScenario(t *testing.T, spec multiTurnScenarioSpec, opts aiGMCam
 		t.Fatalf("create campaign: %v", err)
 	}
 	campaignID := campaignResp.GetCampaign().GetId()
-	if _, err := campaignClient.SetCampaignAIBinding(ctxWithUser, &gamev1.SetCampaignAIBindingRequest{
+	setCampaignAIBindingWhenReachable(t, campaignClient, ctxWithUser, &gamev1.SetCampaignAIBindingRequest{
 		CampaignId: campaignID,
 		AiAgentId:  agentResp.GetAgent().GetId(),
-	}); err != nil {
-		t.Fatalf("set campaign ai binding: %v", err)
-	}
+	})
 	participantsResp, err := participantClient.ListParticipants(ctxWithUser, &gamev1.ListParticipantsRequest{
 		CampaignId: campaignID,
 		PageSize:   50,
@@ -1868,6 +1866,23 @@ func submitScenarioPlayerAction(t *testing.T, setup *aiGMCampaignScenarioSetup,
 	}
 }
 
+// setCampaignAIBindingWhenReachable retries while game's optional AI
+// connection is still backing off from dialing before the AI server listened.
+func setCampaignAIBindingWhenReachable(t *testing.T, client gamev1.CampaignServiceClient, ctx context.Context, req *gamev1.SetCampaignAIBindingRequest) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		_, err := client.SetCampaignAIBinding(ctx, req)
+		if err == nil {
+			return
+		}
+		if status.Code(err) != codes.Unavailable || time.Now().After(deadline) {
+			t.Fatalf("set campaign ai binding: %v", err)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
 func waitForGMReviewReady(t *testing.T, setup *aiGMCampaignScenarioSetup, sceneID string) {
 	t.Helper()
 	deadline := time.Now().Add(3 * time.Second)
@@ -12,6 +12,7 @@ import (
 // CampaignArtifactStore is an in-memory campaign-artifact repository fake.
 type CampaignArtifactStore struct {
 	CampaignArtifacts map[string]campaignartifact.Artifact
+	Revisions         map[string][]campaignartifact.Revision
 	PutErr            error
 	GetErr            error
 	ListErr           error
@@ -20,7 +21,10 @@ type CampaignArtifactStore struct {
 
 // NewCampaignArtifactStore creates an initialized campaign-artifact fake.
 func NewCampaignArtifactStore() *CampaignArtifactStore {
-	return &CampaignArtifactStore{CampaignArtifacts: make(map[string]campaignartifact.Artifact)}
+	return &CampaignArtifactStore{
+		CampaignArtifacts: make(map[string]campaignartifact.Artifact),
+		Revisions:         make(map[string][]campaignartifact.Revision),
+	}
 }
 
 func campaignArtifactKey(campaignID, path string) string {
@@ -67,3 +71,51 @@ func (s *CampaignArtifactStore) ListCampaignArtifacts(_ context.Context, campaig
 	})
 	return records, nil
 }
+
+// PutCampaignArtifactRevision stores one artifact snapshot and appends its
+// next revision.
+func (s *CampaignArtifactStore) PutCampaignArtifactRevision(ctx context.Context, record campaignartifact.Artifact, author campaignartifact.Author) (campaignartifact.Revision, error) {
+	if err := s.PutCampaignArtifact(ctx, record); err != nil {
+		return campaignartifact.Revision{}, err
+	}
+	if s.Revisions == nil {
+		s.Revisions = make(map[string][]campaignartifact.Revision)
+	}
+	key := campaignArtifactKey(record.CampaignID, record.Path)
+	revision := campaignartifact.Revision{
+		CampaignID: record.CampaignID,
+		Path:       record.Path,
+		Number:     len(s.Revisions[key]) + 1,
+		Content:    record.Content,
+		Author:     author.Normalize(),
+		SizeBytes:  len(record.Content),
+		CreatedAt:  record.UpdatedAt,
+	}
+	s.Revisions[key] = append(s.Revisions[key], revision)
+	return revision, nil
+}
+
+// ListCampaignArtifactRevisions returns one artifact's revisions newest first.
+func (s *CampaignArtifactStore) ListCampaignArtifactRevisions(_ context.Context, campaignID string, path string) ([]campaignartifact.Revision, error) {
+	if s.ListErr != nil {
+		return nil, s.ListErr
+	}
+	history := s.Revisions[campaignArtifactKey(campaignID, path)]
+	records := make([]campaignartifact.Revision, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		records = append(records, history[i])
+	}
+	return records, nil
+}
+
+// GetCampaignArtifactRevision returns one artifact revision by number.
+func (s *CampaignArtifactStore) GetCampaignArtifactRevision(_ context.Context, campaignID string, path string, number int) (campaignartifact.Revision, error) {
+	if s.GetErr != nil {
+		return campaignartifact.Revision{}, s.GetErr
+	}
+	history := s.Revisions[campaignArtifactKey(campaignID, path)]
+	if number < 1 || number > len(history) {
+		return campaignartifact.Revision{}, storage.ErrNotFound
+	}
+	return history[number-1], nil
+}
@@ -0,0 +1,50 @@
+package aifakes
+
+import (
+	"context"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/ai/sessionrecap"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+)
+
+// SessionRecapDraftStore is an in-memory session-recap draft repository fake.
+type SessionRecapDraftStore struct {
+	Drafts map[string]sessionrecap.Draft
+	PutErr error
+	GetErr error
+}
+
+// NewSessionRecapDraftStore creates an initialized session-recap draft fake.
+func NewSessionRecapDraftStore() *SessionRecapDraftStore {
+	return &SessionRecapDraftStore{Drafts: make(map[string]sessionrecap.Draft)}
+}
+
+// SessionRecapDraftKey returns the map key used for one campaign session.
+func SessionRecapDraftKey(campaignID, sessionID string) string {
+	return strings.TrimSpace(campaignID) + "\x00" + strings.TrimSpace(sessionID)
+}
+
+// PutSessionRecapDraft stores one session recap draft.
+func (s *SessionRecapDraftStore) PutSessionRecapDraft(_ context.Context, draft sessionrecap.Draft) error {
+	if s.PutErr != nil {
+		return s.PutErr
+	}
+	if s.Drafts == nil {
+		s.Drafts = make(map[string]sessionrecap.Draft)
+	}
+	s.Drafts[SessionRecapDraftKey(draft.CampaignID, draft.SessionID)] = draft
+	return nil
+}
+
+// GetSessionRecapDraft returns one session recap draft.
+func (s *SessionRecapDraftStore) GetSessionRecapDraft(_ context.Context, campaignID string, sessionID string) (sessionrecap.Draft, error) {
+	if s.GetErr != nil {
+		return sessionrecap.Draft{}, s.GetErr
+	}
+	draft, ok := s.Drafts[SessionRecapDraftKey(campaignID, sessionID)]
+	if !ok {
+		return sessionrecap.Draft{}, storage.ErrNotFound
+	}
+	return draft, nil
+}
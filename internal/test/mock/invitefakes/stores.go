@@ -6,6 +6,7 @@ package invitefakes
 import (
 	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/louisbranch/fracturing.space/internal/services/invite/storage"
@@ -121,6 +122,26 @@ func (s *InviteStore) UpdateInviteStatus(_ context.Context, inviteID string, sta
 	return nil
 }
 
+func (s *InviteStore) ReleaseRecipientInvites(_ context.Context, userID string, updatedAt time.Time) (int, error) {
+	if s.UpdateErr != nil {
+		return 0, s.UpdateErr
+	}
+	released := 0
+	for id, inv := range s.Invites {
+		if inv.RecipientUserID != userID {
+			continue
+		}
+		if inv.Status == storage.StatusPending {
+			inv.Status = storage.StatusRevoked
+		}
+		inv.RecipientUserID = ""
+		inv.UpdatedAt = updatedAt
+		s.Invites[id] = inv
+		released++
+	}
+	return released, nil
+}
+
 // OutboxStore is an in-memory OutboxStore fake with error injection.
 type OutboxStore struct {
 	Events     []storage.OutboxEvent
@@ -165,3 +186,404 @@ func (s *OutboxStore) LeaseOutboxEvents(_ context.Context, _ string, limit int,
 func (s *OutboxStore) AckOutboxEvent(_ context.Context, _ string, _ string, _ string, _ time.Time, _ string, _ time.Time) error {
 	return s.AckErr
 }
+
+// InviteLinkStore is an in-memory InviteLinkStore fake with error injection.
+type InviteLinkStore struct {
+	mu sync.Mutex
+
+	Links       map[string]storage.InviteLinkRecord
+	Claims      map[string]storage.InviteLinkClaimRecord
+	PutErr      error
+	GetErr      error
+	ListErr     error
+	ReserveErr  error
+	UpdateErr   error
+	CompleteErr error
+}
+
+var _ storage.InviteLinkStore = (*InviteLinkStore)(nil)
+
+// NewInviteLinkStore constructs an InviteLinkStore fake with initialized state.
+func NewInviteLinkStore() *InviteLinkStore {
+	return &InviteLinkStore{
+		Links:  make(map[string]storage.InviteLinkRecord),
+		Claims: make(map[string]storage.InviteLinkClaimRecord),
+	}
+}
+
+func (s *InviteLinkStore) GetInviteLink(_ context.Context, linkID string) (storage.InviteLinkRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.GetErr != nil {
+		return storage.InviteLinkRecord{}, s.GetErr
+	}
+	link, ok := s.Links[linkID]
+	if !ok {
+		return storage.InviteLinkRecord{}, storage.ErrNotFound
+	}
+	return link, nil
+}
+
+func (s *InviteLinkStore) PutInviteLink(_ context.Context, link storage.InviteLinkRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.PutErr != nil {
+		return s.PutErr
+	}
+	s.Links[link.ID] = link
+	return nil
+}
+
+func (s *InviteLinkStore) ListInviteLinks(_ context.Context, campaignID string, pageSize int, _ string) (storage.InviteLinkPage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ListErr != nil {
+		return storage.InviteLinkPage{}, s.ListErr
+	}
+	var out []storage.InviteLinkRecord
+	for _, link := range s.Links {
+		if link.CampaignID != campaignID {
+			continue
+		}
+		out = append(out, link)
+		if pageSize > 0 && len(out) >= pageSize {
+			break
+		}
+	}
+	return storage.InviteLinkPage{Links: out}, nil
+}
+
+func (s *InviteLinkStore) RevokeInviteLink(_ context.Context, linkID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	link, ok := s.Links[linkID]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	link.Status = storage.LinkStatusRevoked
+	link.UpdatedAt = updatedAt
+	s.Links[linkID] = link
+	return nil
+}
+
+func (s *InviteLinkStore) ReserveInviteLinkClaim(_ context.Context, claim storage.InviteLinkClaimRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ReserveErr != nil {
+		return s.ReserveErr
+	}
+	link, ok := s.Links[claim.LinkID]
+	if !ok || link.Status != storage.LinkStatusActive || link.Exhausted() {
+		return storage.ErrLinkUnavailable
+	}
+	for _, existing := range s.Claims {
+		if existing.LinkID == claim.LinkID && existing.UserID == claim.UserID {
+			return storage.ErrClaimExists
+		}
+	}
+	link.UseCount++
+	s.Links[link.ID] = link
+	s.Claims[claim.ID] = claim
+	return nil
+}
+
+func (s *InviteLinkStore) GetInviteLinkClaim(_ context.Context, claimID string) (storage.InviteLinkClaimRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.GetErr != nil {
+		return storage.InviteLinkClaimRecord{}, s.GetErr
+	}
+	claim, ok := s.Claims[claimID]
+	if !ok {
+		return storage.InviteLinkClaimRecord{}, storage.ErrNotFound
+	}
+	return claim, nil
+}
+
+func (s *InviteLinkStore) ListInviteLinkClaims(_ context.Context, campaignID, linkID, userID string, pageSize int, _ string) (storage.InviteLinkClaimPage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ListErr != nil {
+		return storage.InviteLinkClaimPage{}, s.ListErr
+	}
+	var out []storage.InviteLinkClaimRecord
+	for _, claim := range s.Claims {
+		if campaignID != "" && claim.CampaignID != campaignID {
+			continue
+		}
+		if linkID != "" && claim.LinkID != linkID {
+			continue
+		}
+		if userID != "" && claim.UserID != userID {
+			continue
+		}
+		out = append(out, claim)
+		if pageSize > 0 && len(out) >= pageSize {
+			break
+		}
+	}
+	return storage.InviteLinkClaimPage{Claims: out}, nil
+}
+
+func (s *InviteLinkStore) AcceptInviteLinkClaim(_ context.Context, claimID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	claim, ok := s.Claims[claimID]
+	if !ok || claim.Status != storage.ClaimStatusPending {
+		return storage.ErrNotFound
+	}
+	claim.Status = storage.ClaimStatusJoined
+	claim.UpdatedAt = updatedAt
+	s.Claims[claimID] = claim
+	return nil
+}
+
+func (s *InviteLinkStore) CompleteInviteLinkClaim(_ context.Context, claimID, participantID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	if s.CompleteErr != nil {
+		return s.CompleteErr
+	}
+	claim, ok := s.Claims[claimID]
+	if !ok || claim.Status != storage.ClaimStatusJoined || claim.ParticipantID != "" {
+		return storage.ErrNotFound
+	}
+	claim.ParticipantID = participantID
+	claim.UpdatedAt = updatedAt
+	s.Claims[claimID] = claim
+	return nil
+}
+
+func (s *InviteLinkStore) ReleaseInviteLinkClaim(_ context.Context, claimID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	claim, ok := s.Claims[claimID]
+	if !ok || claim.Status != storage.ClaimStatusJoined || claim.ParticipantID != "" {
+		return storage.ErrNotFound
+	}
+	claim.Status = storage.ClaimStatusPending
+	claim.UpdatedAt = updatedAt
+	s.Claims[claimID] = claim
+	return nil
+}
+
+func (s *InviteLinkStore) DeclineInviteLinkClaim(_ context.Context, claimID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	claim, ok := s.Claims[claimID]
+	if !ok || claim.Status != storage.ClaimStatusPending {
+		return storage.ErrNotFound
+	}
+	claim.Status = storage.ClaimStatusDeclined
+	claim.UpdatedAt = updatedAt
+	s.Claims[claimID] = claim
+	s.releaseUse(claim.LinkID)
+	return nil
+}
+
+func (s *InviteLinkStore) DeleteInviteLinkClaim(_ context.Context, claimID string, _ time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	claim, ok := s.Claims[claimID]
+	if !ok || claim.ParticipantID != "" || (claim.Status != storage.ClaimStatusPending && claim.Status != storage.ClaimStatusJoined) {
+		return storage.ErrNotFound
+	}
+	delete(s.Claims, claimID)
+	s.releaseUse(claim.LinkID)
+	return nil
+}
+
+func (s *InviteLinkStore) DeleteUserInviteLinkClaims(_ context.Context, userID string, _ time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return 0, s.UpdateErr
+	}
+	deleted := 0
+	for id, claim := range s.Claims {
+		if claim.UserID != userID {
+			continue
+		}
+		if claim.Status == storage.ClaimStatusPending {
+			s.releaseUse(claim.LinkID)
+		}
+		delete(s.Claims, id)
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (s *InviteLinkStore) releaseUse(linkID string) {
+	link, ok := s.Links[linkID]
+	if !ok || link.UseCount == 0 {
+		return
+	}
+	link.UseCount--
+	s.Links[linkID] = link
+}
+
+// CampaignApplicationStore is an in-memory CampaignApplicationStore fake with
+// error injection. Methods are safe for concurrent use so tests can race
+// status transitions.
+type CampaignApplicationStore struct {
+	mu sync.Mutex
+
+	Applications map[string]storage.CampaignApplicationRecord
+	PutErr       error
+	GetErr       error
+	ListErr      error
+	UpdateErr    error
+	CompleteErr  error
+}
+
+var _ storage.CampaignApplicationStore = (*CampaignApplicationStore)(nil)
+
+// NewCampaignApplicationStore constructs a CampaignApplicationStore fake with
+// initialized state.
+func NewCampaignApplicationStore() *CampaignApplicationStore {
+	return &CampaignApplicationStore{Applications: make(map[string]storage.CampaignApplicationRecord)}
+}
+
+func (s *CampaignApplicationStore) PutCampaignApplication(_ context.Context, app storage.CampaignApplicationRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.PutErr != nil {
+		return s.PutErr
+	}
+	for _, existing := range s.Applications {
+		if existing.CampaignID == app.CampaignID && existing.ApplicantUserID == app.ApplicantUserID &&
+			existing.Status == storage.ApplicationStatusPending && app.Status == storage.ApplicationStatusPending {
+			return storage.ErrApplicationExists
+		}
+	}
+	s.Applications[app.ID] = app
+	return nil
+}
+
+func (s *CampaignApplicationStore) GetCampaignApplication(_ context.Context, applicationID string) (storage.CampaignApplicationRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.GetErr != nil {
+		return storage.CampaignApplicationRecord{}, s.GetErr
+	}
+	app, ok := s.Applications[applicationID]
+	if !ok {
+		return storage.CampaignApplicationRecord{}, storage.ErrNotFound
+	}
+	return app, nil
+}
+
+func (s *CampaignApplicationStore) ListCampaignApplications(_ context.Context, campaignID, applicantUserID string, status storage.ApplicationStatus, pageSize int, _ string) (storage.CampaignApplicationPage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ListErr != nil {
+		return storage.CampaignApplicationPage{}, s.ListErr
+	}
+	var out []storage.CampaignApplicationRecord
+	for _, app := range s.Applications {
+		if campaignID != "" && app.CampaignID != campaignID {
+			continue
+		}
+		if applicantUserID != "" && app.ApplicantUserID != applicantUserID {
+			continue
+		}
+		if status != "" && app.Status != status {
+			continue
+		}
+		out = append(out, app)
+		if pageSize > 0 && len(out) >= pageSize {
+			break
+		}
+	}
+	return storage.CampaignApplicationPage{Applications: out}, nil
+}
+
+func (s *CampaignApplicationStore) ResolveCampaignApplication(_ context.Context, applicationID string, status storage.ApplicationStatus, inviteID, participantID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	app, ok := s.Applications[applicationID]
+	if !ok || app.Status != storage.ApplicationStatusPending {
+		return storage.ErrNotFound
+	}
+	app.Status = status
+	app.InviteID = inviteID
+	app.ParticipantID = participantID
+	app.UpdatedAt = updatedAt
+	s.Applications[applicationID] = app
+	return nil
+}
+
+func (s *CampaignApplicationStore) CompleteCampaignApplication(_ context.Context, applicationID, inviteID, participantID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	if s.CompleteErr != nil {
+		return s.CompleteErr
+	}
+	app, ok := s.Applications[applicationID]
+	if !ok || app.Status != storage.ApplicationStatusAccepted || app.ParticipantID != "" {
+		return storage.ErrNotFound
+	}
+	app.InviteID = inviteID
+	app.ParticipantID = participantID
+	app.UpdatedAt = updatedAt
+	s.Applications[applicationID] = app
+	return nil
+}
+
+func (s *CampaignApplicationStore) ReleaseCampaignApplication(_ context.Context, applicationID string, updatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return s.UpdateErr
+	}
+	app, ok := s.Applications[applicationID]
+	if !ok || app.Status != storage.ApplicationStatusAccepted || app.ParticipantID != "" {
+		return storage.ErrNotFound
+	}
+	app.Status = storage.ApplicationStatusPending
+	app.InviteID = ""
+	app.UpdatedAt = updatedAt
+	s.Applications[applicationID] = app
+	return nil
+}
+
+func (s *CampaignApplicationStore) DeleteUserCampaignApplications(_ context.Context, userID string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.UpdateErr != nil {
+		return 0, s.UpdateErr
+	}
+	deleted := 0
+	for id, app := range s.Applications {
+		if app.ApplicantUserID != userID {
+			continue
+		}
+		delete(s.Applications, id)
+		deleted++
+	}
+	return deleted, nil
+}
@@ -0,0 +1,188 @@
+// Package aireseal re-seals AI service secrets under the active encryption key.
+//
+// Operators rotate keys by adding a new key to FRACTURING_SPACE_AI_ENCRYPTION_KEYS,
+// making it active, restarting the AI service, and then running this command.
+// Rows already sealed under the active key are skipped, so an interrupted run
+// can simply be started again; the -after-* flags skip straight past rows a
+// previous run reported as processed.
+package aireseal
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/storage"
+	aisqlite "github.com/louisbranch/fracturing.space/internal/services/ai/storage/sqlite"
+)
+
+const defaultBatchSize = 100
+
+// Config holds re-seal command configuration.
+type Config struct {
+	DBPath               string
+	Keyring              secret.KeyringConfig
+	Timeout              time.Duration
+	BatchSize            int
+	DryRun               bool
+	AfterCredentialID    string
+	AfterProviderGrantID string
+	SkipCredentials      bool
+	SkipProviderGrants   bool
+}
+
+type envConfig struct {
+	DBPath                string        `env:"FRACTURING_SPACE_AI_DB_PATH"`
+	EncryptionKey         string        `env:"FRACTURING_SPACE_AI_ENCRYPTION_KEY"`
+	EncryptionKeys        string        `env:"FRACTURING_SPACE_AI_ENCRYPTION_KEYS"`
+	EncryptionActiveKeyID string        `env:"FRACTURING_SPACE_AI_ENCRYPTION_ACTIVE_KEY_ID"`
+	Timeout               time.Duration `env:"FRACTURING_SPACE_MAINTENANCE_TIMEOUT" envDefault:"10m"`
+}
+
+// ParseConfig parses env and flags into a Config.
+func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
+	var envCfg envConfig
+	if err := env.Parse(&envCfg); err != nil {
+		return Config{}, fmt.Errorf("parse env: %w", err)
+	}
+	cfg := Config{
+		DBPath: strings.TrimSpace(envCfg.DBPath),
+		Keyring: secret.KeyringConfig{
+			LegacyKey:   envCfg.EncryptionKey,
+			Keys:        envCfg.EncryptionKeys,
+			ActiveKeyID: envCfg.EncryptionActiveKeyID,
+		},
+		Timeout:   envCfg.Timeout,
+		BatchSize: defaultBatchSize,
+	}
+	if cfg.DBPath == "" {
+		cfg.DBPath = filepath.Join("data", "ai.db")
+	}
+
+	fs.StringVar(&cfg.DBPath, "db-path", cfg.DBPath, "path to AI sqlite database (default: FRACTURING_SPACE_AI_DB_PATH or data/ai.db)")
+	fs.DurationVar(&cfg.Timeout, "timeout", cfg.Timeout, "overall timeout")
+	fs.IntVar(&cfg.BatchSize, "batch-size", cfg.BatchSize, "rows to read per batch")
+	fs.BoolVar(&cfg.DryRun, "dry-run", false, "report rows that need re-sealing without writing")
+	fs.StringVar(&cfg.AfterCredentialID, "after-credential-id", "", "resume credentials after this ID")
+	fs.StringVar(&cfg.AfterProviderGrantID, "after-provider-grant-id", "", "resume provider grants after this ID")
+	fs.BoolVar(&cfg.SkipCredentials, "skip-credentials", false, "do not re-seal credentials")
+	fs.BoolVar(&cfg.SkipProviderGrants, "skip-provider-grants", false, "do not re-seal provider grants")
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Report summarizes one table's re-seal pass.
+type Report = secret.ResealReport
+
+// Run opens the AI store and re-seals every credential and provider grant
+// that is not sealed under the active key.
+func Run(ctx context.Context, cfg Config, out io.Writer, errOut io.Writer) error {
+	if out == nil {
+		out = io.Discard
+	}
+	if errOut == nil {
+		errOut = io.Discard
+	}
+	if strings.TrimSpace(cfg.DBPath) == "" {
+		return errors.New("-db-path is required")
+	}
+	if !cfg.Keyring.Configured() {
+		return errors.New("FRACTURING_SPACE_AI_ENCRYPTION_KEY or FRACTURING_SPACE_AI_ENCRYPTION_KEYS is required")
+	}
+	keyring, err := cfg.Keyring.Build()
+	if err != nil {
+		return fmt.Errorf("build encryption keyring: %w", err)
+	}
+
+	store, err := aisqlite.Open(cfg.DBPath)
+	if err != nil {
+		return fmt.Errorf("open ai sqlite store: %w", err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			fmt.Fprintf(errOut, "Warning: close ai store: %v\n", err)
+		}
+	}()
+
+	_, err = Reseal(ctx, store, keyring, cfg, out)
+	return err
+}
+
+// Reseal walks the configured tables and writes one progress line per batch
+// so operators can resume with the reported last ID after an interruption.
+func Reseal(ctx context.Context, store storage.SealedSecretStore, keyring *secret.Keyring, cfg Config, out io.Writer) ([]Report, error) {
+	if store == nil {
+		return nil, errors.New("store is required")
+	}
+	if keyring == nil {
+		return nil, errors.New("keyring is required")
+	}
+	if cfg.BatchSize <= 0 {
+		return nil, errors.New("-batch-size must be > 0")
+	}
+	if out == nil {
+		out = io.Discard
+	}
+
+	mode := "re-sealing"
+	if cfg.DryRun {
+		mode = "dry run"
+	}
+	fmt.Fprintf(out, "%s under key %q\n", mode, keyring.ActiveKeyID())
+
+	tables := make([]secret.ResealTable, 0, 2)
+	if !cfg.SkipCredentials {
+		tables = append(tables, secret.ResealTable{
+			Name:     "credentials",
+			After:    cfg.AfterCredentialID,
+			List:     sealedValues(store.ListCredentialCiphertexts),
+			Replace:  store.ReplaceCredentialCiphertext,
+			Conflict: storage.ErrConflict,
+		})
+	}
+	if !cfg.SkipProviderGrants {
+		tables = append(tables, secret.ResealTable{
+			Name:     "provider_grants",
+			After:    cfg.AfterProviderGrantID,
+			List:     sealedValues(store.ListProviderGrantCiphertexts),
+			Replace:  store.ReplaceProviderGrantCiphertext,
+			Conflict: storage.ErrConflict,
+		})
+	}
+
+	opts := secret.ResealOptions{BatchSize: cfg.BatchSize, DryRun: cfg.DryRun}
+	reports := make([]Report, 0, len(tables))
+	for _, table := range tables {
+		report, err := secret.ResealStored(ctx, keyring, table, opts, out)
+		reports = append(reports, report)
+		if err != nil {
+			return reports, err
+		}
+		fmt.Fprintf(out, "%s: done scanned=%d resealed=%d current=%d changed=%d\n", report.Table, report.Scanned, report.Resealed, report.Current, report.Changed)
+	}
+	return reports, nil
+}
+
+// sealedValues adapts an AI store listing to the shared re-seal helper.
+func sealedValues(list func(ctx context.Context, afterID string, limit int) ([]storage.SealedValue, error)) func(context.Context, string, int) ([]secret.StoredValue, error) {
+	return func(ctx context.Context, afterID string, limit int) ([]secret.StoredValue, error) {
+		rows, err := list(ctx, afterID, limit)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]secret.StoredValue, 0, len(rows))
+		for _, row := range rows {
+			values = append(values, secret.StoredValue{ID: row.ID, Ciphertext: row.Ciphertext})
+		}
+		return values, nil
+	}
+}
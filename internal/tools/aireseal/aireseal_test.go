@@ -0,0 +1,202 @@
+package aireseal
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/credential"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/provider"
+	"github.com/louisbranch/fracturing.space/internal/services/ai/providergrant"
+	aisqlite "github.com/louisbranch/fracturing.space/internal/services/ai/storage/sqlite"
+)
+
+var (
+	oldKey = []byte("0123456789abcdef0123456789abcdef")
+	newKey = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func TestParseConfigReadsEnvAndFlags(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_AI_DB_PATH", "/tmp/ai.db")
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_KEYS", "next:abc")
+	t.Setenv("FRACTURING_SPACE_AI_ENCRYPTION_ACTIVE_KEY_ID", "next")
+
+	cfg, err := ParseConfig(flag.NewFlagSet("ai-reseal", flag.ContinueOnError), []string{"-batch-size", "5", "-dry-run", "-after-credential-id", "cred-9"})
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+	if cfg.DBPath != "/tmp/ai.db" || cfg.Keyring.Keys != "next:abc" || cfg.Keyring.ActiveKeyID != "next" {
+		t.Fatalf("cfg = %+v", cfg)
+	}
+	if cfg.BatchSize != 5 || !cfg.DryRun || cfg.AfterCredentialID != "cred-9" {
+		t.Fatalf("cfg = %+v", cfg)
+	}
+}
+
+func TestResealRotatesStoredSecrets(t *testing.T) {
+	store := openStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	legacy, err := secret.NewAESGCMSealer(oldKey)
+	if err != nil {
+		t.Fatalf("new sealer: %v", err)
+	}
+	next, err := secret.NewKeyring("next", map[string][]byte{secret.DefaultKeyID: oldKey, "next": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	seal := func(s secret.Sealer, value string) string {
+		t.Helper()
+		sealed, err := s.Seal(value)
+		if err != nil {
+			t.Fatalf("seal: %v", err)
+		}
+		return sealed
+	}
+
+	for i, c := range []credential.Credential{
+		{ID: "cred-1", OwnerUserID: "user-1", Provider: provider.OpenAI, Label: "A", SecretCiphertext: seal(legacy, "sk-1")},
+		{ID: "cred-2", OwnerUserID: "user-1", Provider: provider.OpenAI, Label: "B", SecretCiphertext: seal(next, "sk-2")},
+		{ID: "cred-3", OwnerUserID: "user-2", Provider: provider.OpenAI, Label: "C", SecretCiphertext: seal(legacy, "sk-3")},
+	} {
+		c.Status = credential.StatusActive
+		c.CreatedAt = now.Add(time.Duration(i) * time.Minute)
+		c.UpdatedAt = c.CreatedAt
+		if err := store.PutCredential(ctx, c); err != nil {
+			t.Fatalf("put credential: %v", err)
+		}
+	}
+	if err := store.PutProviderGrant(ctx, providergrant.ProviderGrant{
+		ID: "grant-1", OwnerUserID: "user-1", Provider: provider.OpenAI, TokenCiphertext: seal(legacy, `{"access_token":"at"}`),
+		Status: providergrant.StatusActive, CreatedAt: now, UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("put provider grant: %v", err)
+	}
+
+	var out bytes.Buffer
+	dryRun, err := Reseal(ctx, store, next, Config{BatchSize: 2, DryRun: true}, &out)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if dryRun[0].Resealed != 2 || dryRun[0].Current != 1 {
+		t.Fatalf("dry run report = %+v", dryRun[0])
+	}
+	if got, _ := store.GetCredential(ctx, "cred-1"); secret.KeyID(got.SecretCiphertext) != "" {
+		t.Fatalf("dry run rewrote cred-1: %q", got.SecretCiphertext)
+	}
+
+	out.Reset()
+	reports, err := Reseal(ctx, store, next, Config{BatchSize: 2}, &out)
+	if err != nil {
+		t.Fatalf("reseal: %v", err)
+	}
+	if len(reports) != 2 || reports[0].Resealed != 2 || reports[0].LastID != "cred-3" || reports[1].Resealed != 1 {
+		t.Fatalf("reports = %+v", reports)
+	}
+	if !strings.Contains(out.String(), "credentials: scanned=2 resealed=1 last_id=cred-2") {
+		t.Fatalf("output = %q", out.String())
+	}
+
+	current, err := secret.NewKeyring("next", map[string][]byte{"next": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	for id, want := range map[string]string{"cred-1": "sk-1", "cred-2": "sk-2", "cred-3": "sk-3"} {
+		got, err := store.GetCredential(ctx, id)
+		if err != nil {
+			t.Fatalf("get credential %s: %v", id, err)
+		}
+		opened, err := current.Open(got.SecretCiphertext)
+		if err != nil || opened != want {
+			t.Fatalf("open %s = %q, %v; want %q", id, opened, err, want)
+		}
+		if !got.UpdatedAt.Equal(got.CreatedAt) {
+			t.Fatalf("%s UpdatedAt changed to %v", id, got.UpdatedAt)
+		}
+	}
+	grant, err := store.GetProviderGrant(ctx, "grant-1")
+	if err != nil {
+		t.Fatalf("get provider grant: %v", err)
+	}
+	if opened, err := current.Open(grant.TokenCiphertext); err != nil || opened != `{"access_token":"at"}` {
+		t.Fatalf("open grant = %q, %v", opened, err)
+	}
+
+	rerun, err := Reseal(ctx, store, next, Config{BatchSize: 10}, nil)
+	if err != nil {
+		t.Fatalf("rerun: %v", err)
+	}
+	if rerun[0].Resealed != 0 || rerun[0].Current != 3 || rerun[1].Current != 1 {
+		t.Fatalf("rerun reports = %+v", rerun)
+	}
+}
+
+func TestResealResumesAfterCursorAndStopsOnUnknownKey(t *testing.T) {
+	store := openStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	foreign, err := secret.NewKeyring("lost", map[string][]byte{"lost": oldKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	sealed, err := foreign.Seal("sk-lost")
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	for _, id := range []string{"cred-1", "cred-2"} {
+		if err := store.PutCredential(ctx, credential.Credential{
+			ID: id, OwnerUserID: "user-1", Provider: provider.OpenAI, Label: id, SecretCiphertext: sealed,
+			Status: credential.StatusActive, CreatedAt: now, UpdatedAt: now,
+		}); err != nil {
+			t.Fatalf("put credential: %v", err)
+		}
+	}
+
+	next, err := secret.NewKeyring("next", map[string][]byte{"next": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	reports, err := Reseal(ctx, store, next, Config{BatchSize: 10, SkipProviderGrants: true}, nil)
+	if err == nil || !strings.Contains(err.Error(), "credentials cred-1") {
+		t.Fatalf("Reseal() error = %v, want cred-1 failure", err)
+	}
+	if len(reports) != 1 || reports[0].LastID != "" {
+		t.Fatalf("reports = %+v", reports)
+	}
+
+	reports, err = Reseal(ctx, store, next, Config{BatchSize: 10, SkipProviderGrants: true, AfterCredentialID: "cred-2"}, nil)
+	if err != nil {
+		t.Fatalf("Reseal() after cursor error = %v", err)
+	}
+	if reports[0].Scanned != 0 {
+		t.Fatalf("reports = %+v", reports)
+	}
+}
+
+func TestRunRequiresKeys(t *testing.T) {
+	err := Run(context.Background(), Config{DBPath: filepath.Join(t.TempDir(), "ai.db"), BatchSize: 1}, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "FRACTURING_SPACE_AI_ENCRYPTION_KEY") {
+		t.Fatalf("Run() error = %v", err)
+	}
+}
+
+func openStore(t *testing.T) *aisqlite.Store {
+	t.Helper()
+	store, err := aisqlite.Open(filepath.Join(t.TempDir(), "ai.db"))
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := store.Close(); err != nil {
+			t.Fatalf("close store: %v", err)
+		}
+	})
+	return store
+}
@@ -0,0 +1,72 @@
+// Package main generates the public HTTP gateway OpenAPI document from the
+// gateway route table and game proto descriptors.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/gateway"
+	"github.com/louisbranch/fracturing.space/internal/tools/cli"
+)
+
+const defaultOutPath = "api/openapi/gateway.json"
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "openapigen: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	var rootFlag string
+	var outPath string
+	var check bool
+
+	fs := flag.NewFlagSet("openapigen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&rootFlag, "root", "", "repo root (defaults to locating go.mod)")
+	fs.StringVar(&outPath, "out", defaultOutPath, "generated OpenAPI document output path")
+	fs.BoolVar(&check, "check", false, "check the generated document for drift without writing")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	root, err := cli.ResolveRoot(strings.TrimSpace(rootFlag))
+	if err != nil {
+		return err
+	}
+	document, err := gateway.OpenAPIDocument()
+	if err != nil {
+		return fmt.Errorf("render openapi document: %w", err)
+	}
+
+	path := cli.ResolvePath(root, outPath)
+	if check {
+		current, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read generated output %s: %w", path, err)
+		}
+		if string(current) != string(document) {
+			return fmt.Errorf("generated output is stale: %s (run: go run ./internal/tools/openapigen)", path)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create output dir for %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, document, 0o644); err != nil {
+		return fmt.Errorf("write output %s: %w", path, err)
+	}
+	fmt.Fprintf(stdout, "wrote %s\n", path)
+	return nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunWritesThenChecksDocument(t *testing.T) {
+	root := t.TempDir()
+
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"-root", root}, &stdout, &stderr); err != nil {
+		t.Fatalf("generate: %v (stderr=%s)", err, stderr.String())
+	}
+	if err := run([]string{"-root", root, "-check"}, &stdout, &stderr); err != nil {
+		t.Fatalf("check fresh output: %v", err)
+	}
+
+	path := filepath.Join(root, defaultOutPath)
+	if err := os.WriteFile(path, []byte("{}\n"), 0o644); err != nil {
+		t.Fatalf("corrupt output: %v", err)
+	}
+	err := run([]string{"-root", root, "-check"}, &stdout, &stderr)
+	if err == nil || !strings.Contains(err.Error(), "stale") {
+		t.Fatalf("expected stale error, got %v", err)
+	}
+}
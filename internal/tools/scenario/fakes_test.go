@@ -50,6 +50,10 @@ func (f *fakeCampaignClient) RestoreCampaign(context.Context, *gamev1.RestoreCam
 	return nil, unimplemented("RestoreCampaign")
 }
 
+func (f *fakeCampaignClient) SetCampaignModerationLock(context.Context, *gamev1.SetCampaignModerationLockRequest, ...grpc.CallOption) (*gamev1.SetCampaignModerationLockResponse, error) {
+	return nil, unimplemented("SetCampaignModerationLock")
+}
+
 func (f *fakeCampaignClient) SetCampaignCover(context.Context, *gamev1.SetCampaignCoverRequest, ...grpc.CallOption) (*gamev1.SetCampaignCoverResponse, error) {
 	return nil, unimplemented("SetCampaignCover")
 }
@@ -101,6 +105,14 @@ func (f *fakeParticipantClient) GetParticipant(context.Context, *gamev1.GetParti
 	return nil, unimplemented("GetParticipant")
 }
 
+func (f *fakeParticipantClient) ListUserParticipants(context.Context, *gamev1.ListUserParticipantsRequest, ...grpc.CallOption) (*gamev1.ListUserParticipantsResponse, error) {
+	return nil, unimplemented("ListUserParticipants")
+}
+
+func (f *fakeParticipantClient) AnonymizeUserParticipants(context.Context, *gamev1.AnonymizeUserParticipantsRequest, ...grpc.CallOption) (*gamev1.AnonymizeUserParticipantsResponse, error) {
+	return nil, unimplemented("AnonymizeUserParticipants")
+}
+
 func (f *fakeParticipantClient) BindParticipant(context.Context, *gamev1.BindParticipantRequest, ...grpc.CallOption) (*gamev1.BindParticipantResponse, error) {
 	return nil, unimplemented("BindParticipant")
 }
@@ -378,6 +390,10 @@ func (f *fakeSnapshotClient) UpdateSnapshotState(ctx context.Context, in *gamev1
 	return nil, unimplemented("UpdateSnapshotState")
 }
 
+func (f *fakeSnapshotClient) CorrectEvent(context.Context, *gamev1.CorrectEventRequest, ...grpc.CallOption) (*gamev1.CorrectEventResponse, error) {
+	return nil, unimplemented("CorrectEvent")
+}
+
 // fakeSessionClient implements gamev1.SessionServiceClient for testing.
 type fakeSessionClient struct {
 	startSession   func(context.Context, *gamev1.StartSessionRequest, ...grpc.CallOption) (*gamev1.StartSessionResponse, error)
@@ -411,6 +427,10 @@ func (f *fakeSessionClient) ListActiveSessionsForUser(context.Context, *gamev1.L
 	return nil, unimplemented("ListActiveSessionsForUser")
 }
 
+func (f *fakeSessionClient) RecordSessionRecap(context.Context, *gamev1.RecordSessionRecapRequest, ...grpc.CallOption) (*gamev1.RecordSessionRecapResponse, error) {
+	return nil, unimplemented("RecordSessionRecap")
+}
+
 func (f *fakeSessionClient) EndSession(ctx context.Context, in *gamev1.EndSessionRequest, opts ...grpc.CallOption) (*gamev1.EndSessionResponse, error) {
 	if f.endSession != nil {
 		return f.endSession(ctx, in, opts...)
@@ -823,6 +843,10 @@ func (f *fakeDaggerheartClient) SessionDamageRoll(ctx context.Context, in *dagge
 	return nil, unimplemented("SessionDamageRoll")
 }
 
+func (f *fakeDaggerheartClient) SessionActionRollFlow(ctx context.Context, in *daggerheartv1.SessionActionRollFlowRequest, opts ...grpc.CallOption) (*daggerheartv1.SessionActionRollFlowResponse, error) {
+	return nil, unimplemented("SessionActionRollFlow")
+}
+
 func (f *fakeDaggerheartClient) SessionAttackFlow(ctx context.Context, in *daggerheartv1.SessionAttackFlowRequest, opts ...grpc.CallOption) (*daggerheartv1.SessionAttackFlowResponse, error) {
 	if f.sessionAttackFlow != nil {
 		return f.sessionAttackFlow(ctx, in, opts...)
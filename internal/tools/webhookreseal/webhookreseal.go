@@ -0,0 +1,157 @@
+// Package webhookreseal re-seals campaign webhook signing secrets under the
+// active game encryption key.
+//
+// Operators rotate keys by adding a new key to FRACTURING_SPACE_GAME_ENCRYPTION_KEYS,
+// making it active, restarting the game service, and then running this command.
+// Rows already sealed under the active key are skipped, so an interrupted run
+// can simply be started again; -after-webhook-id skips straight past rows a
+// previous run reported as processed.
+package webhookreseal
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteconn"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/webhooks"
+)
+
+const defaultBatchSize = 100
+
+// Config holds re-seal command configuration.
+type Config struct {
+	EventsDBPath   string
+	Keyring        secret.KeyringConfig
+	Timeout        time.Duration
+	BatchSize      int
+	DryRun         bool
+	AfterWebhookID string
+}
+
+type envConfig struct {
+	EventsDBPath          string        `env:"FRACTURING_SPACE_GAME_EVENTS_DB_PATH"`
+	EncryptionKey         string        `env:"FRACTURING_SPACE_GAME_ENCRYPTION_KEY"`
+	EncryptionKeys        string        `env:"FRACTURING_SPACE_GAME_ENCRYPTION_KEYS"`
+	EncryptionActiveKeyID string        `env:"FRACTURING_SPACE_GAME_ENCRYPTION_ACTIVE_KEY_ID"`
+	Timeout               time.Duration `env:"FRACTURING_SPACE_MAINTENANCE_TIMEOUT" envDefault:"10m"`
+}
+
+// ParseConfig parses env and flags into a Config.
+func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
+	var envCfg envConfig
+	if err := env.Parse(&envCfg); err != nil {
+		return Config{}, fmt.Errorf("parse env: %w", err)
+	}
+	cfg := Config{
+		EventsDBPath: strings.TrimSpace(envCfg.EventsDBPath),
+		Keyring: secret.KeyringConfig{
+			LegacyKey:   envCfg.EncryptionKey,
+			Keys:        envCfg.EncryptionKeys,
+			ActiveKeyID: envCfg.EncryptionActiveKeyID,
+		},
+		Timeout:   envCfg.Timeout,
+		BatchSize: defaultBatchSize,
+	}
+	if cfg.EventsDBPath == "" {
+		cfg.EventsDBPath = filepath.Join("data", "game-events.db")
+	}
+
+	fs.StringVar(&cfg.EventsDBPath, "events-db-path", cfg.EventsDBPath, "path to game events sqlite database (default: FRACTURING_SPACE_GAME_EVENTS_DB_PATH or data/game-events.db)")
+	fs.DurationVar(&cfg.Timeout, "timeout", cfg.Timeout, "overall timeout")
+	fs.IntVar(&cfg.BatchSize, "batch-size", cfg.BatchSize, "rows to read per batch")
+	fs.BoolVar(&cfg.DryRun, "dry-run", false, "report rows that need re-sealing without writing")
+	fs.StringVar(&cfg.AfterWebhookID, "after-webhook-id", "", "resume webhooks after this ID")
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Run opens the game events store and re-seals every webhook signing secret
+// that is not sealed under the active key.
+func Run(ctx context.Context, cfg Config, out io.Writer, errOut io.Writer) error {
+	if out == nil {
+		out = io.Discard
+	}
+	if errOut == nil {
+		errOut = io.Discard
+	}
+	if strings.TrimSpace(cfg.EventsDBPath) == "" {
+		return errors.New("-events-db-path is required")
+	}
+	if !cfg.Keyring.Configured() {
+		return errors.New("FRACTURING_SPACE_GAME_ENCRYPTION_KEY or FRACTURING_SPACE_GAME_ENCRYPTION_KEYS is required")
+	}
+	keyring, err := cfg.Keyring.Build()
+	if err != nil {
+		return fmt.Errorf("build encryption keyring: %w", err)
+	}
+
+	// The game service owns the events schema; the tool only binds the
+	// webhook table and never runs migrations.
+	sqlDB, err := sqliteconn.Open(cfg.EventsDBPath)
+	if err != nil {
+		return fmt.Errorf("open game events sqlite store: %w", err)
+	}
+	defer func() {
+		if err := sqlDB.Close(); err != nil {
+			fmt.Fprintf(errOut, "Warning: close game events store: %v\n", err)
+		}
+	}()
+
+	_, err = Reseal(ctx, webhooks.Bind(sqlDB), keyring, cfg, out)
+	return err
+}
+
+// Reseal walks the webhook table and writes one progress line per batch so
+// operators can resume with the reported last ID after an interruption.
+func Reseal(ctx context.Context, store storage.WebhookSecretStore, keyring *secret.Keyring, cfg Config, out io.Writer) (secret.ResealReport, error) {
+	if store == nil {
+		return secret.ResealReport{}, errors.New("store is required")
+	}
+	if keyring == nil {
+		return secret.ResealReport{}, errors.New("keyring is required")
+	}
+	if out == nil {
+		out = io.Discard
+	}
+
+	mode := "re-sealing"
+	if cfg.DryRun {
+		mode = "dry run"
+	}
+	fmt.Fprintf(out, "%s under key %q\n", mode, keyring.ActiveKeyID())
+
+	table := secret.ResealTable{
+		Name:  "webhooks",
+		After: cfg.AfterWebhookID,
+		List: func(ctx context.Context, afterID string, limit int) ([]secret.StoredValue, error) {
+			rows, err := store.ListWebhookSecretCiphertexts(ctx, afterID, limit)
+			if err != nil {
+				return nil, err
+			}
+			values := make([]secret.StoredValue, 0, len(rows))
+			for _, row := range rows {
+				values = append(values, secret.StoredValue{ID: row.ID, Ciphertext: row.Ciphertext})
+			}
+			return values, nil
+		},
+		Replace:  store.ReplaceWebhookSecretCiphertext,
+		Conflict: storage.ErrConflict,
+	}
+	report, err := secret.ResealStored(ctx, keyring, table, secret.ResealOptions{BatchSize: cfg.BatchSize, DryRun: cfg.DryRun}, out)
+	if err != nil {
+		return report, err
+	}
+	fmt.Fprintf(out, "%s: done scanned=%d resealed=%d current=%d changed=%d\n", report.Table, report.Scanned, report.Resealed, report.Current, report.Changed)
+	return report, nil
+}
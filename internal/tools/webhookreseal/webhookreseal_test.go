@@ -0,0 +1,137 @@
+package webhookreseal
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/louisbranch/fracturing.space/internal/platform/secret"
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqliteconn"
+	"github.com/louisbranch/fracturing.space/internal/platform/storage/sqlitemigrate"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/migrations"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite/webhooks"
+)
+
+var (
+	oldKey = []byte("0123456789abcdef0123456789abcdef")
+	newKey = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func TestParseConfigReadsEnvAndFlags(t *testing.T) {
+	t.Setenv("FRACTURING_SPACE_GAME_EVENTS_DB_PATH", "/tmp/game-events.db")
+	t.Setenv("FRACTURING_SPACE_GAME_ENCRYPTION_KEYS", "next:abc")
+	t.Setenv("FRACTURING_SPACE_GAME_ENCRYPTION_ACTIVE_KEY_ID", "next")
+
+	cfg, err := ParseConfig(flag.NewFlagSet("webhook-reseal", flag.ContinueOnError), []string{"-batch-size", "5", "-dry-run", "-after-webhook-id", "wh-9"})
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+	if cfg.EventsDBPath != "/tmp/game-events.db" || cfg.Keyring.Keys != "next:abc" || cfg.Keyring.ActiveKeyID != "next" {
+		t.Fatalf("cfg = %+v", cfg)
+	}
+	if cfg.BatchSize != 5 || !cfg.DryRun || cfg.AfterWebhookID != "wh-9" {
+		t.Fatalf("cfg = %+v", cfg)
+	}
+}
+
+func TestResealRotatesSealedSecrets(t *testing.T) {
+	store := openWebhookStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	old, err := secret.NewKeyring("old", map[string][]byte{"old": oldKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	for id, signingSecret := range map[string]string{"wh-1": "whsec_one", "wh-2": "whsec_two"} {
+		sealedOld, err := old.Seal(signingSecret)
+		if err != nil {
+			t.Fatalf("seal: %v", err)
+		}
+		if err := store.PutWebhookEndpoint(ctx, storage.WebhookEndpoint{
+			ID: id, CampaignID: "camp-1", URL: "https://hooks.example.com/" + id, EventTypes: []string{"session.*"},
+			SigningSecret: sealedOld, CreatedAt: now, UpdatedAt: now,
+		}); err != nil {
+			t.Fatalf("put webhook endpoint: %v", err)
+		}
+	}
+
+	next, err := secret.NewKeyring("next", map[string][]byte{"old": oldKey, "next": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	report, err := Reseal(ctx, store, next, Config{BatchSize: 10, DryRun: true}, nil)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if report.Resealed != 2 {
+		t.Fatalf("dry run report = %+v", report)
+	}
+	if got, _ := store.GetWebhookEndpoint(ctx, "wh-1"); secret.KeyID(got.SigningSecret) != "old" {
+		t.Fatalf("dry run rewrote wh-1: %q", got.SigningSecret)
+	}
+
+	var out bytes.Buffer
+	report, err = Reseal(ctx, store, next, Config{BatchSize: 1}, &out)
+	if err != nil {
+		t.Fatalf("reseal webhooks: %v", err)
+	}
+	if report.Resealed != 2 || report.LastID != "wh-2" {
+		t.Fatalf("report = %+v", report)
+	}
+	if !strings.Contains(out.String(), "webhooks: scanned=1 resealed=1 last_id=wh-1") {
+		t.Fatalf("output = %q", out.String())
+	}
+
+	current, err := secret.NewKeyring("next", map[string][]byte{"next": newKey})
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	for id, want := range map[string]string{"wh-1": "whsec_one", "wh-2": "whsec_two"} {
+		got, err := store.GetWebhookEndpoint(ctx, id)
+		if err != nil {
+			t.Fatalf("get webhook endpoint %s: %v", id, err)
+		}
+		opened, err := current.Open(got.SigningSecret)
+		if err != nil || opened != want {
+			t.Fatalf("open %s = %q, %v; want %q", id, opened, err, want)
+		}
+	}
+
+	rerun, err := Reseal(ctx, store, next, Config{BatchSize: 10}, nil)
+	if err != nil {
+		t.Fatalf("rerun: %v", err)
+	}
+	if rerun.Resealed != 0 || rerun.Current != 2 {
+		t.Fatalf("rerun report = %+v", rerun)
+	}
+}
+
+func TestRunRequiresKeys(t *testing.T) {
+	err := Run(context.Background(), Config{EventsDBPath: filepath.Join(t.TempDir(), "game-events.db"), BatchSize: 1}, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "FRACTURING_SPACE_GAME_ENCRYPTION_KEY") {
+		t.Fatalf("Run() error = %v", err)
+	}
+}
+
+func openWebhookStore(t *testing.T) *webhooks.Store {
+	t.Helper()
+	sqlDB, err := sqliteconn.Open(filepath.Join(t.TempDir(), "game-events.db"))
+	if err != nil {
+		t.Fatalf("open events db: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := sqlDB.Close(); err != nil {
+			t.Fatalf("close events db: %v", err)
+		}
+	})
+	if err := sqlitemigrate.ApplyMigrations(sqlDB, migrations.EventsFS, "events", time.Now); err != nil {
+		t.Fatalf("apply events migrations: %v", err)
+	}
+	return webhooks.Bind(sqlDB)
+}
@@ -34,6 +34,7 @@ check_small_tool_contract() {
 small_tools=(
   "internal/tools/i18ncheck/main.go"
   "internal/tools/i18nstatus/main.go"
+  "internal/tools/openapigen/main.go"
   "internal/tools/testruntimereport/main.go"
   "internal/tools/webdoccheck/main.go"
   "internal/tools/websmokeauth/main.go"
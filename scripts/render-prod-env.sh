@@ -163,6 +163,7 @@ if [[ -z "$join_public" || -z "$join_private" ]]; then
 fi
 
 ensure_base64_secret "FRACTURING_SPACE_PLAY_LAUNCH_GRANT_HMAC_KEY"
+ensure_base64_secret "FRACTURING_SPACE_GAME_ENCRYPTION_KEY"
 ensure_base64_secret "FRACTURING_SPACE_AI_ENCRYPTION_KEY"
 ensure_base64_secret "FRACTURING_SPACE_AI_SESSION_GRANT_HMAC_KEY"
 ensure_plain_secret "FRACTURING_SPACE_OAUTH_RESOURCE_SECRET"
@@ -173,6 +174,7 @@ required_keys=(
   "FRACTURING_SPACE_DOMAIN"
   "FRACTURING_SPACE_DAGGERHEART_REFERENCE_IMAGE"
   "FRACTURING_SPACE_GAME_EVENT_HMAC_KEY"
+  "FRACTURING_SPACE_GAME_ENCRYPTION_KEY"
   "FRACTURING_SPACE_JOIN_GRANT_PUBLIC_KEY"
   "FRACTURING_SPACE_JOIN_GRANT_PRIVATE_KEY"
   "FRACTURING_SPACE_PLAY_LAUNCH_GRANT_HMAC_KEY"